@@ -12,6 +12,10 @@ is not specified, and differs among the BLAS implementations.
 gonum.org/v1/gonum/blas/blas64 provides helpful wrapper functions to the BLAS
 interface. The rest of this text describes the layout of the data for the input types.
 
+The Level 3 routines [SD]gemm, [SD]syrk and [SD]trsm divide large problems into
+blocks that are computed concurrently by a pool of goroutines. The size of the
+pool is limited by runtime.GOMAXPROCS unless set by SetNumThreads.
+
 Note that in the function documentation, x[i] refers to the i^th element
 of the vector, which will be different from the i^th element of the slice if
 incX != 1.
@@ -77,6 +77,35 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		}
 		return
 	}
+	// The columns of B are solved independently when A is on the left,
+	// and the rows of B are solved independently when A is on the right,
+	// so panels of B are solved concurrently when A is large enough for
+	// the work on each panel to outweigh the cost of scheduling it.
+	if k >= blockSize {
+		if s == blas.Left {
+			if nb := blocks(n, blockSize); nb >= minParBlock {
+				parallel(nb, func(blk int) {
+					j := blk * blockSize
+					dtrsmSerial(s, ul, tA, d, m, min(blockSize, n-j), alpha, a, lda, b[j:], ldb)
+				})
+				return
+			}
+		} else {
+			if nb := blocks(m, blockSize); nb >= minParBlock {
+				parallel(nb, func(blk int) {
+					i := blk * blockSize
+					dtrsmSerial(s, ul, tA, d, min(blockSize, m-i), n, alpha, a, lda, b[i*ldb:], ldb)
+				})
+				return
+			}
+		}
+	}
+	dtrsmSerial(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+}
+
+// dtrsmSerial solves for X serially. The arguments must have been validated
+// and alpha must not be zero.
+func dtrsmSerial(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
 	nonUnit := d == blas.NonUnit
 	if s == blas.Left {
 		if tA == blas.NoTrans {
@@ -441,9 +470,30 @@ func (Implementation) Dsyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha flo
 		}
 		return
 	}
+	// Each row of the triangle of C is updated independently, so panels
+	// of rows are updated concurrently. The rows of the upper triangle
+	// shorten down the matrix and those of the lower triangle lengthen,
+	// so the panels are scheduled with the longest rows first.
+	nb := blocks(n, blockSize)
+	if nb < minParBlock {
+		dsyrkRows(ul, tA, 0, n, n, k, alpha, a, lda, beta, c, ldc)
+		return
+	}
+	parallel(nb, func(blk int) {
+		if ul == blas.Lower {
+			blk = nb - 1 - blk
+		}
+		lo := blk * blockSize
+		dsyrkRows(ul, tA, lo, min(lo+blockSize, n), n, k, alpha, a, lda, beta, c, ldc)
+	})
+}
+
+// dsyrkRows updates rows lo to hi-1 of C serially. The arguments must have
+// been validated and alpha must not be zero.
+func dsyrkRows(ul blas.Uplo, tA blas.Transpose, lo, hi, n, k int, alpha float64, a []float64, lda int, beta float64, c []float64, ldc int) {
 	if tA == blas.NoTrans {
 		if ul == blas.Upper {
-			for i := 0; i < n; i++ {
+			for i := lo; i < hi; i++ {
 				ctmp := c[i*ldc+i : i*ldc+n]
 				atmp := a[i*lda : i*lda+k]
 				if beta == 0 {
@@ -460,7 +510,7 @@ func (Implementation) Dsyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha flo
 			}
 			return
 		}
-		for i := 0; i < n; i++ {
+		for i := lo; i < hi; i++ {
 			ctmp := c[i*ldc : i*ldc+i+1]
 			atmp := a[i*lda : i*lda+k]
 			if beta == 0 {
@@ -477,7 +527,7 @@ func (Implementation) Dsyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha flo
 	}
 	// Cases where a is transposed.
 	if ul == blas.Upper {
-		for i := 0; i < n; i++ {
+		for i := lo; i < hi; i++ {
 			ctmp := c[i*ldc+i : i*ldc+n]
 			if beta == 0 {
 				for j := range ctmp {
@@ -497,7 +547,7 @@ func (Implementation) Dsyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha flo
 		}
 		return
 	}
-	for i := 0; i < n; i++ {
+	for i := lo; i < hi; i++ {
 		ctmp := c[i*ldc : i*ldc+i+1]
 		if beta != 1 {
 			for j := range ctmp {
@@ -0,0 +1,74 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// numThreads is the maximum number of goroutines used by the
+// parallel routines. Values less than one mean runtime.GOMAXPROCS.
+var numThreads atomic.Int64
+
+// SetNumThreads sets the maximum number of goroutines used concurrently by
+// each call to the parallel Level 3 routines of Implementation, [SD]gemm,
+// [SD]syrk and [SD]trsm, and returns the previous setting. If n is less
+// than one, the number of goroutines is limited by runtime.GOMAXPROCS,
+// which is the default. Setting n to one makes the routines serial.
+//
+// The results of the routines do not depend on the number of goroutines.
+// SetNumThreads is safe to call concurrently with the routines, which use
+// the setting in effect when they start.
+func SetNumThreads(n int) (prev int) {
+	return int(numThreads.Swap(int64(max(0, n))))
+}
+
+// NumThreads returns the maximum number of goroutines used concurrently by
+// each call to the parallel Level 3 routines of Implementation.
+func NumThreads() int {
+	n := int(numThreads.Load())
+	if n < 1 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return n
+}
+
+// parallel calls fn(i) for each i in [0, n) using a pool of at most
+// NumThreads goroutines, including the calling goroutine, and returns
+// when all calls have returned. The calls are handed to the goroutines
+// in increasing order of i as each becomes free, so tasks with more work
+// should be given lower indices.
+func parallel(n int, fn func(i int)) {
+	workers := min(NumThreads(), n)
+	if workers <= 1 {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+
+	var next atomic.Int64
+	work := func() {
+		for {
+			i := int(next.Add(1) - 1)
+			if i >= n {
+				return
+			}
+			fn(i)
+		}
+	}
+	var wg sync.WaitGroup
+	wg.Add(workers - 1)
+	for w := 1; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			work()
+		}()
+	}
+	work()
+	wg.Wait()
+}
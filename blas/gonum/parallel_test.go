@@ -0,0 +1,293 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestSetNumThreads(t *testing.T) {
+	defer SetNumThreads(SetNumThreads(0))
+
+	if got, want := NumThreads(), runtime.GOMAXPROCS(0); got != want {
+		t.Errorf("unexpected default number of threads: got:%d want:%d", got, want)
+	}
+	if prev := SetNumThreads(3); prev != 0 {
+		t.Errorf("unexpected previous setting: got:%d want:0", prev)
+	}
+	if got := NumThreads(); got != 3 {
+		t.Errorf("unexpected number of threads: got:%d want:3", got)
+	}
+	if prev := SetNumThreads(-2); prev != 3 {
+		t.Errorf("unexpected previous setting: got:%d want:3", prev)
+	}
+	if got, want := NumThreads(), runtime.GOMAXPROCS(0); got != want {
+		t.Errorf("unexpected number of threads after reset: got:%d want:%d", got, want)
+	}
+}
+
+func TestParallel(t *testing.T) {
+	defer SetNumThreads(SetNumThreads(0))
+
+	for _, threads := range []int{1, 2, 7} {
+		SetNumThreads(threads)
+		for _, n := range []int{0, 1, 5, 100} {
+			calls := make([]int32, n)
+			var running, peak atomic.Int32
+			parallel(n, func(i int) {
+				r := running.Add(1)
+				for {
+					p := peak.Load()
+					if r <= p || peak.CompareAndSwap(p, r) {
+						break
+					}
+				}
+				atomic.AddInt32(&calls[i], 1)
+				running.Add(-1)
+			})
+			for i, c := range calls {
+				if c != 1 {
+					t.Errorf("unexpected number of calls for task %d of %d with %d threads: %d", i, n, threads, c)
+				}
+			}
+			if p := int(peak.Load()); p > threads {
+				t.Errorf("too many concurrent calls for %d tasks with %d threads: %d", n, threads, p)
+			}
+		}
+	}
+}
+
+// level3Threads returns the results of fn run serially and with several
+// goroutines.
+func level3Threads(fn func() []float64) (serial, par []float64) {
+	defer SetNumThreads(SetNumThreads(1))
+	serial = fn()
+	SetNumThreads(8)
+	par = fn()
+	return serial, par
+}
+
+func randSlice(rnd *rand.Rand, n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rnd.NormFloat64()
+	}
+	return s
+}
+
+func TestDsyrkThreads(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	var impl Implementation
+	for _, n := range []int{blockSize*minParBlock - 3, blockSize*minParBlock + 17} {
+		for _, k := range []int{1, 40} {
+			for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+				for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+					for _, beta := range []float64{0, 0.5} {
+						name := fmt.Sprintf("n=%d,k=%d,uplo=%c,trans=%c,beta=%v", n, k, ul, tA, beta)
+						row, col := n, k
+						if tA == blas.Trans {
+							row, col = k, n
+						}
+						lda := col + 3
+						ldc := n + 2
+						a := randSlice(rnd, row*lda)
+						c := randSlice(rnd, n*ldc)
+						const alpha = 1.5
+
+						serial, par := level3Threads(func() []float64 {
+							cc := append([]float64(nil), c...)
+							impl.Dsyrk(ul, tA, n, k, alpha, a, lda, beta, cc, ldc)
+							return cc
+						})
+						if !floats.Same(serial, par) {
+							t.Errorf("%s: result depends on number of threads", name)
+						}
+
+						// Check against the definition.
+						for i := 0; i < n; i++ {
+							for j := 0; j < n; j++ {
+								if (ul == blas.Upper && j < i) || (ul == blas.Lower && j > i) {
+									if par[i*ldc+j] != c[i*ldc+j] {
+										t.Errorf("%s: element [%d,%d] outside triangle modified", name, i, j)
+									}
+									continue
+								}
+								var want float64
+								for l := 0; l < k; l++ {
+									if tA == blas.NoTrans {
+										want += a[i*lda+l] * a[j*lda+l]
+									} else {
+										want += a[l*lda+i] * a[l*lda+j]
+									}
+								}
+								want = alpha*want + beta*c[i*ldc+j]
+								if math.Abs(par[i*ldc+j]-want) > 1e-12*float64(k+1) {
+									t.Errorf("%s: unexpected element [%d,%d]: got:%v want:%v", name, i, j, par[i*ldc+j], want)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDtrsmThreads(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	var impl Implementation
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, test := range []struct{ m, n int }{
+			{m: blockSize + 5, n: blockSize*minParBlock + 9},
+			{m: blockSize*minParBlock + 9, n: blockSize + 5},
+			{m: 10, n: 10},
+		} {
+			m, n := test.m, test.n
+			k := n
+			if side == blas.Left {
+				k = m
+			}
+			for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+				for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+					for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+						name := fmt.Sprintf("side=%c,m=%d,n=%d,uplo=%c,trans=%c,diag=%c", side, m, n, ul, tA, d)
+						lda := k + 1
+						ldb := n + 3
+						// Keep A well conditioned.
+						a := randSlice(rnd, k*lda)
+						floats.Scale(0.1/math.Sqrt(float64(k)), a)
+						for i := 0; i < k; i++ {
+							a[i*lda+i] = 1 + rnd.Float64()
+						}
+						b := randSlice(rnd, m*ldb)
+						const alpha = 0.75
+
+						serial, par := level3Threads(func() []float64 {
+							x := append([]float64(nil), b...)
+							impl.Dtrsm(side, ul, tA, d, m, n, alpha, a, lda, x, ldb)
+							return x
+						})
+						if !floats.Same(serial, par) {
+							t.Errorf("%s: result depends on number of threads", name)
+						}
+
+						// Check that op(A) * X = alpha * B or X * op(A) = alpha * B.
+						tri := make([]float64, k*k)
+						for i := 0; i < k; i++ {
+							for j := 0; j < k; j++ {
+								switch {
+								case i == j && d == blas.Unit:
+									tri[i*k+j] = 1
+								case (ul == blas.Upper && j >= i) || (ul == blas.Lower && j <= i):
+									tri[i*k+j] = a[i*lda+j]
+								}
+							}
+						}
+						opA := func(i, j int) float64 {
+							if tA == blas.Trans {
+								return tri[j*k+i]
+							}
+							return tri[i*k+j]
+						}
+						for i := 0; i < m; i++ {
+							for j := 0; j < n; j++ {
+								var got float64
+								if side == blas.Left {
+									for l := 0; l < m; l++ {
+										got += opA(i, l) * par[l*ldb+j]
+									}
+								} else {
+									for l := 0; l < n; l++ {
+										got += par[i*ldb+l] * opA(l, j)
+									}
+								}
+								if want := alpha * b[i*ldb+j]; math.Abs(got-want) > 1e-10 {
+									t.Errorf("%s: unexpected element [%d,%d] of product: got:%v want:%v", name, i, j, got, want)
+									break
+								}
+							}
+						}
+						for i := 0; i < m; i++ {
+							for j := n; j < ldb && i*ldb+j < len(b); j++ {
+								if par[i*ldb+j] != b[i*ldb+j] {
+									t.Errorf("%s: element outside B modified", name)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgemmThreads(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	var impl Implementation
+	const m, n, k = blockSize*3 + 7, blockSize*2 + 1, blockSize + 9
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			lda, ldb := k, n
+			if tA == blas.Trans {
+				lda = m
+			}
+			if tB == blas.Trans {
+				ldb = k
+			}
+			a := randSlice(rnd, max(m, k)*lda)
+			b := randSlice(rnd, max(n, k)*ldb)
+			c := randSlice(rnd, m*n)
+			serial, par := level3Threads(func() []float64 {
+				cc := append([]float64(nil), c...)
+				impl.Dgemm(tA, tB, m, n, k, 1.5, a, lda, b, ldb, 0.5, cc, n)
+				return cc
+			})
+			if !floats.Same(serial, par) {
+				t.Errorf("tA=%c,tB=%c: result depends on number of threads", tA, tB)
+			}
+		}
+	}
+}
+
+func BenchmarkLevel3Threads(b *testing.B) {
+	defer SetNumThreads(SetNumThreads(0))
+	var impl Implementation
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 512
+	a := randSlice(rnd, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = n
+	}
+	c := randSlice(rnd, n*n)
+	for _, test := range []struct {
+		name    string
+		threads int
+	}{
+		{name: "serial", threads: 1},
+		{name: "parallel", threads: 0},
+	} {
+		SetNumThreads(test.threads)
+		b.Run("Dsyrk/"+test.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				impl.Dsyrk(blas.Upper, blas.NoTrans, n, n, 1, a, n, 0, c, n)
+			}
+		})
+		b.Run("Dtrsm/"+test.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				// Scaling by n keeps the magnitude of c
+				// roughly constant over iterations.
+				impl.Dtrsm(blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, n, n, n, a, n, c, n)
+			}
+		})
+	}
+}
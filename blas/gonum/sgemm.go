@@ -7,9 +7,6 @@
 package gonum
 
 import (
-	"runtime"
-	"sync"
-
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/internal/asm/f32"
 )
@@ -147,9 +144,8 @@ func sgemmParallel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []f
 	// This code computes one {i, j} block sequentially along the k dimension,
 	// and computes all of the {i, j} blocks concurrently. This
 	// partitioning allows Cij to be updated in-place without race-conditions.
-	// Instead of launching a goroutine for each possible concurrent computation,
-	// a number of worker goroutines are created and channels are used to pass
-	// available and completed cases.
+	// The blocks are computed by a pool of at most NumThreads worker
+	// goroutines, each taking the next block as it becomes free.
 	//
 	// http://alexkr.com/docs/matrixmult.pdf is a good reference on matrix-matrix
 	// multiplies, though this code does not copy matrices to attempt to eliminate
@@ -164,57 +160,42 @@ func sgemmParallel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []f
 		return
 	}
 
-	// workerLimit acts a number of maximum concurrent workers,
-	// with the limit set to the number of procs available.
-	workerLimit := make(chan struct{}, runtime.GOMAXPROCS(0))
-
-	// wg is used to wait for all
-	var wg sync.WaitGroup
-	wg.Add(parBlocks)
-	defer wg.Wait()
-
-	for i := 0; i < m; i += blockSize {
-		for j := 0; j < n; j += blockSize {
-			workerLimit <- struct{}{}
-			go func(i, j int) {
-				defer func() {
-					wg.Done()
-					<-workerLimit
-				}()
+	nj := blocks(n, blockSize)
+	parallel(parBlocks, func(blk int) {
+		i := (blk / nj) * blockSize
+		j := (blk % nj) * blockSize
 
-				leni := blockSize
-				if i+leni > m {
-					leni = m - i
-				}
-				lenj := blockSize
-				if j+lenj > n {
-					lenj = n - j
-				}
+		leni := blockSize
+		if i+leni > m {
+			leni = m - i
+		}
+		lenj := blockSize
+		if j+lenj > n {
+			lenj = n - j
+		}
 
-				cSub := sliceView32(c, ldc, i, j, leni, lenj)
+		cSub := sliceView32(c, ldc, i, j, leni, lenj)
 
-				// Compute A_ik B_kj for all k
-				for k := 0; k < maxKLen; k += blockSize {
-					lenk := blockSize
-					if k+lenk > maxKLen {
-						lenk = maxKLen - k
-					}
-					var aSub, bSub []float32
-					if aTrans {
-						aSub = sliceView32(a, lda, k, i, lenk, leni)
-					} else {
-						aSub = sliceView32(a, lda, i, k, leni, lenk)
-					}
-					if bTrans {
-						bSub = sliceView32(b, ldb, j, k, lenj, lenk)
-					} else {
-						bSub = sliceView32(b, ldb, k, j, lenk, lenj)
-					}
-					sgemmSerial(aTrans, bTrans, leni, lenj, lenk, aSub, lda, bSub, ldb, cSub, ldc, alpha)
-				}
-			}(i, j)
+		// Compute A_ik B_kj for all k
+		for k := 0; k < maxKLen; k += blockSize {
+			lenk := blockSize
+			if k+lenk > maxKLen {
+				lenk = maxKLen - k
+			}
+			var aSub, bSub []float32
+			if aTrans {
+				aSub = sliceView32(a, lda, k, i, lenk, leni)
+			} else {
+				aSub = sliceView32(a, lda, i, k, leni, lenk)
+			}
+			if bTrans {
+				bSub = sliceView32(b, ldb, j, k, lenj, lenk)
+			} else {
+				bSub = sliceView32(b, ldb, k, j, lenk, lenj)
+			}
+			sgemmSerial(aTrans, bTrans, leni, lenj, lenk, aSub, lda, bSub, ldb, cSub, ldc, alpha)
 		}
-	}
+	})
 }
 
 // sgemmSerial is serial matrix multiply
@@ -169,6 +169,9 @@ cat level3float64.go \
 \
 | gofmt -r 'float64 -> float32' \
 \
+| gofmt -r 'dsyrkRows -> ssyrkRows' \
+| gofmt -r 'dtrsmSerial -> strsmSerial' \
+\
 | gofmt -r 'f64.AxpyUnitaryTo -> f32.AxpyUnitaryTo' \
 | gofmt -r 'f64.AxpyUnitary -> f32.AxpyUnitary' \
 | gofmt -r 'f64.DotUnitary -> f32.DotUnitary' \
@@ -176,6 +179,7 @@ cat level3float64.go \
 \
 | sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
       -e 's_^// D_// S_' \
+      -e 's_^// d_// s_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level3float32.go
 
@@ -0,0 +1,183 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"sync"
+
+	"gonum.org/v1/gonum/cmplxs"
+	"gonum.org/v1/gonum/mat"
+)
+
+// DefaultComplexStep is the default step size used by the complex-step
+// functions. Since the complex-step approximation has no subtractive
+// cancellation, the step can be made small enough for the truncation error
+// to be far below the rounding error of the function evaluation.
+const DefaultComplexStep = 1e-20
+
+// ComplexStepSettings is the settings structure for computing complex-step
+// derivatives.
+type ComplexStepSettings struct {
+	// Step is the size of the imaginary step.
+	// If equal to 0, DefaultComplexStep will be used.
+	Step float64
+
+	Concurrent bool // Should the function calls be executed concurrently.
+}
+
+func (s *ComplexStepSettings) values() (step float64, concurrent bool) {
+	step = DefaultComplexStep
+	if s == nil {
+		return step, false
+	}
+	if s.Step < 0 {
+		panic("fd: negative complex step")
+	}
+	if s.Step != 0 {
+		step = s.Step
+	}
+	return step, s.Concurrent
+}
+
+// ComplexStepDerivative estimates the first derivative of the function f at
+// the real location x using the complex-step approximation
+//
+//	f'(x) ≈ Im(f(x + i*Step)) / Step.
+//
+// Unlike finite difference formulas, the approximation does not subtract
+// nearby function values, so the step can be made arbitrarily small and the
+// derivative is accurate to the precision of f. f must be the analytic
+// extension of a real function, that is, f must be real on the real axis and
+// must be computed using complex arithmetic that does not use the real and
+// imaginary parts separately, for example through abs, conj or comparisons.
+//
+// The step size is specified by settings. If settings is nil, a default step
+// size is used. The Concurrent field of settings is ignored.
+func ComplexStepDerivative(f func(complex128) complex128, x float64, settings *ComplexStepSettings) float64 {
+	step, _ := settings.values()
+	return imag(f(complex(x, step))) / step
+}
+
+// ComplexStepGradient estimates the gradient of the multivariate function f at
+// the real location x using the complex-step approximation. If dst is not nil,
+// the result will be stored in-place into dst and returned, otherwise a new
+// slice will be allocated first. The step size and other options are specified
+// by settings. If settings is nil, a default step size is used.
+//
+// See ComplexStepDerivative for the requirements on f. f is evaluated once
+// for each element of x.
+//
+// ComplexStepGradient panics if the length of dst and x is not equal.
+func ComplexStepGradient(dst []float64, f func([]complex128) complex128, x []float64, settings *ComplexStepSettings) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) {
+		panic("fd: slice length mismatch")
+	}
+	step, concurrent := settings.values()
+
+	zero := make([]float64, len(x))
+	eval := func(xc []complex128, i int) float64 {
+		// Copy x anew to protect against the function modifying
+		// the input data.
+		cmplxs.Complex(xc, x, zero)
+		xc[i] = complex(x[i], step)
+		return imag(f(xc)) / step
+	}
+
+	nWorkers := computeWorkers(concurrent, len(x))
+	if nWorkers == 1 {
+		xc := make([]complex128, len(x))
+		for i := range x {
+			dst[i] = eval(xc, i)
+		}
+		return dst
+	}
+
+	var wg sync.WaitGroup
+	jobs := make(chan int, nWorkers)
+	for i := 0; i < nWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			xc := make([]complex128, len(x))
+			for i := range jobs {
+				dst[i] = eval(xc, i)
+			}
+		}()
+	}
+	for i := range x {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return dst
+}
+
+// ComplexStepJacobian estimates the Jacobian matrix of the vector-valued
+// function f at the real location x using the complex-step approximation and
+// stores the result in-place into dst. The step size and other options are
+// specified by settings. If settings is nil, a default step size is used.
+//
+// See ComplexStepDerivative for the requirements on f and Jacobian for the
+// definition of the Jacobian matrix. f is evaluated once for each element
+// of x.
+//
+// dst must be non-nil and the number of its columns must equal the length of
+// x, otherwise ComplexStepJacobian will panic.
+func ComplexStepJacobian(dst *mat.Dense, f func(y, x []complex128), x []float64, settings *ComplexStepSettings) {
+	n := len(x)
+	if n == 0 {
+		panic("jacobian: x has zero length")
+	}
+	m, c := dst.Dims()
+	if c != n {
+		panic("jacobian: mismatched matrix size")
+	}
+	step, concurrent := settings.values()
+
+	zero := make([]float64, n)
+	eval := func(y, xc []complex128, col []float64, j int) {
+		cmplxs.Complex(xc, x, zero)
+		xc[j] = complex(x[j], step)
+		f(y, xc)
+		cmplxs.Imag(col, y)
+		for i, v := range col {
+			dst.Set(i, j, v/step)
+		}
+	}
+
+	nWorkers := computeWorkers(concurrent, n)
+	if nWorkers == 1 {
+		xc := make([]complex128, n)
+		y := make([]complex128, m)
+		col := make([]float64, m)
+		for j := range x {
+			eval(y, xc, col, j)
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	jobs := make(chan int, nWorkers)
+	for i := 0; i < nWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			xc := make([]complex128, n)
+			y := make([]complex128, m)
+			col := make([]float64, m)
+			for j := range jobs {
+				eval(y, xc, col, j)
+			}
+		}()
+	}
+	for j := range x {
+		jobs <- j
+	}
+	close(jobs)
+	wg.Wait()
+}
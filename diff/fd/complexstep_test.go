@@ -0,0 +1,156 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestComplexStepDerivative(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		f    func(complex128) complex128
+		df   func(float64) float64
+		x    float64
+		step float64
+	}{
+		{
+			f:  cmplx.Sin,
+			df: math.Cos,
+			x:  0.3,
+		},
+		{
+			f:  cmplx.Exp,
+			df: math.Exp,
+			x:  -2,
+		},
+		{
+			f:    cmplx.Exp,
+			df:   math.Exp,
+			x:    5,
+			step: 1e-100,
+		},
+		{
+			// Derivative at a point where finite differences
+			// lose all accuracy to cancellation.
+			f: func(z complex128) complex128 {
+				return cmplx.Exp(z) / cmplx.Sqrt(cmplx.Pow(cmplx.Sin(z), 3)+cmplx.Pow(cmplx.Cos(z), 3))
+			},
+			df: func(x float64) float64 {
+				s, c := math.Sincos(x)
+				d := s*s*s + c*c*c
+				return math.Exp(x)/math.Sqrt(d) - math.Exp(x)*(3*s*s*c-3*c*c*s)/(2*math.Pow(d, 1.5))
+			},
+			x: 1.5,
+		},
+		{
+			f: func(z complex128) complex128 {
+				return z * z * z
+			},
+			df: func(x float64) float64 {
+				return 3 * x * x
+			},
+			x: 1e8,
+		},
+	} {
+		var settings *ComplexStepSettings
+		if test.step != 0 {
+			settings = &ComplexStepSettings{Step: test.step}
+		}
+		got := ComplexStepDerivative(test.f, test.x, settings)
+		want := test.df(test.x)
+		if !scalar.EqualWithinRel(got, want, 1e-14) {
+			t.Errorf("Case %d: unexpected derivative: got %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestComplexStepGradient(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	rosen := func(x []complex128) (sum complex128) {
+		for i := 0; i < len(x)-1; i++ {
+			a := 1 - x[i]
+			b := x[i+1] - x[i]*x[i]
+			sum += a*a + 100*b*b
+		}
+		return sum
+	}
+	for _, n := range []int{1, 2, 5, 20} {
+		for _, concurrent := range []bool{false, true} {
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = rnd.Float64()
+			}
+			xcopy := make([]float64, n)
+			copy(xcopy, x)
+
+			want := make([]float64, n)
+			Rosenbrock{n}.FDf(x, want)
+
+			settings := &ComplexStepSettings{Concurrent: concurrent}
+			got := ComplexStepGradient(nil, rosen, x, settings)
+			if !floats.EqualApprox(got, want, 1e-13) {
+				t.Errorf("n=%d concurrent=%t: unexpected gradient: got %v, want %v", n, concurrent, got, want)
+			}
+			if !floats.Equal(x, xcopy) {
+				t.Errorf("n=%d concurrent=%t: x modified during call", n, concurrent)
+			}
+
+			dst := make([]float64, n)
+			for i := range dst {
+				dst[i] = math.NaN()
+			}
+			ComplexStepGradient(dst, rosen, x, settings)
+			if !floats.Equal(dst, got) {
+				t.Errorf("n=%d concurrent=%t: result mismatch for non-nil dst", n, concurrent)
+			}
+		}
+	}
+
+	if !Panics(func() {
+		ComplexStepGradient(make([]float64, 2), func([]complex128) complex128 { return 0 }, make([]float64, 3), nil)
+	}) {
+		t.Errorf("expected panic for mismatched lengths")
+	}
+	if !Panics(func() {
+		ComplexStepGradient(nil, func([]complex128) complex128 { return 0 }, make([]float64, 3), &ComplexStepSettings{Step: -1})
+	}) {
+		t.Errorf("expected panic for negative step")
+	}
+}
+
+func TestComplexStepJacobian(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	f := func(y, x []complex128) {
+		y[0] = x[0] + 1
+		y[1] = 5*x[2] + 1
+		y[2] = 4*x[1]*x[1] - 2*x[2] + 1
+		y[3] = x[2]*cmplx.Sin(x[0]) + 1
+	}
+	for _, concurrent := range []bool{false, true} {
+		x := make([]float64, 3)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		want := mat.NewDense(4, 3, nil)
+		vecFunc43Jac(want, x)
+
+		got := mat.NewDense(4, 3, nil)
+		ComplexStepJacobian(got, f, x, &ComplexStepSettings{Concurrent: concurrent})
+		if !mat.EqualApprox(got, want, 1e-14) {
+			t.Errorf("concurrent=%t: unexpected Jacobian:\ngot  %v\nwant %v",
+				concurrent, mat.Formatted(got, mat.Prefix("     ")), mat.Formatted(want, mat.Prefix("     ")))
+		}
+	}
+}
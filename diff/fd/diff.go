@@ -42,6 +42,11 @@ type Settings struct {
 	// Step is the distance between points of the stencil.
 	// If equal to 0, formula's default step will be used.
 	Step float64
+	// Steps holds the step for each coordinate of the location and
+	// takes precedence over Step. Steps is only used by Gradient, and
+	// may be computed by GradientSteps. If nil, Step is used for all
+	// coordinates.
+	Steps []float64
 
 	OriginKnown bool    // Flag that the value at the origin x is known.
 	OriginValue float64 // Value at the origin (only used if OriginKnown is true).
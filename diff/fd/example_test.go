@@ -7,6 +7,7 @@ package fd_test
 import (
 	"fmt"
 	"math"
+	"math/cmplx"
 
 	"gonum.org/v1/gonum/diff/fd"
 	"gonum.org/v1/gonum/mat"
@@ -66,3 +67,19 @@ func ExampleJacobian() {
 	//     ⎢       0        16        -2⎥
 	//     ⎣ 1.62091         0  0.841471⎦
 }
+
+func ExampleComplexStepDerivative() {
+	// f must be written using complex arithmetic so that it
+	// can be evaluated at complex arguments.
+	f := func(z complex128) complex128 {
+		return cmplx.Exp(z) * cmplx.Sin(z)
+	}
+	x := 1.0
+	df := fd.ComplexStepDerivative(f, x, nil)
+	fmt.Printf("f′(1) ≈ %.15f\n", df)
+	fmt.Printf("exact  %.15f\n", math.Exp(x)*(math.Sin(x)+math.Cos(x)))
+
+	// Output:
+	// f′(1) ≈ 3.756049227094727
+	// exact  3.756049227094727
+}
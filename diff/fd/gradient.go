@@ -13,8 +13,9 @@ import "gonum.org/v1/gonum/floats"
 // nil, the gradient will be estimated using the Forward formula and a default
 // step size.
 //
-// Gradient panics if the length of dst and x is not equal, or if the derivative
-// order of the formula is not 1.
+// Gradient panics if the length of dst and x is not equal, if settings.Steps is
+// not nil and its length is not equal to the length of x or it holds a
+// non-positive step, or if the derivative order of the formula is not 1.
 func Gradient(dst []float64, f func([]float64) float64, x []float64, settings *Settings) []float64 {
 	if dst == nil {
 		dst = make([]float64, len(x))
@@ -46,6 +47,22 @@ func Gradient(dst []float64, f func([]float64) float64, x []float64, settings *S
 		originValue = settings.OriginValue
 		concurrent = settings.Concurrent
 	}
+	steps := make([]float64, len(x))
+	if settings != nil && settings.Steps != nil {
+		if len(settings.Steps) != len(x) {
+			panic("fd: slice length mismatch")
+		}
+		for _, h := range settings.Steps {
+			if !(h > 0) {
+				panic("fd: non-positive step")
+			}
+		}
+		copy(steps, settings.Steps)
+	} else {
+		for i := range steps {
+			steps[i] = step
+		}
+	}
 
 	evals := len(formula.Stencil) * len(x)
 	nWorkers := computeWorkers(concurrent, evals)
@@ -72,10 +89,10 @@ func Gradient(dst []float64, f func([]float64) float64, x []float64, settings *S
 				// location. Secondly, it protects against the function
 				// modifying the input data.
 				copy(xcopy, x)
-				xcopy[i] += pt.Loc * step
+				xcopy[i] += pt.Loc * steps[i]
 				deriv += pt.Coeff * f(xcopy)
 			}
-			dst[i] = deriv / step
+			dst[i] = deriv / steps[i]
 		}
 		return dst
 	}
@@ -96,7 +113,7 @@ func Gradient(dst []float64, f func([]float64) float64, x []float64, settings *S
 				case run := <-sendChan:
 					// See above comment on the copy.
 					copy(xcopy, x)
-					xcopy[run.idx] += run.pt.Loc * step
+					xcopy[run.idx] += run.pt.Loc * steps[run.idx]
 					run.result = f(xcopy)
 					ansChan <- run
 				}
@@ -134,7 +151,7 @@ func Gradient(dst []float64, f func([]float64) float64, x []float64, settings *S
 		run := <-ansChan
 		dst[run.idx] += run.pt.Coeff * run.result
 	}
-	floats.Scale(1/step, dst)
+	floats.Div(dst, steps)
 	return dst
 }
 
@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"sync"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// HessianVec approximates the product of the Hessian matrix of a multivariate
+// function at the location x with the vector v, using only evaluations of the
+// gradient of the function computed by grad. That is
+//
+//	dst ≈ H(x) v = ∂/∂t ∇f(x + t v) at t = 0,
+//
+// where the directional derivative of the gradient is estimated by a finite
+// difference formula. The number of gradient evaluations is the number of
+// points in the stencil of the formula and does not depend on the length of x,
+// so HessianVec is suitable for optimization methods that need curvature
+// information where forming the full Hessian is too expensive.
+//
+// If dst is not nil, the result will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. Finite difference formula and
+// other options are specified by settings. If settings is nil, the product
+// will be estimated using the Forward formula and a default step size. The step
+// is taken along the unit vector in the direction of v, so the step size is the
+// distance between the points of the stencil. The OriginKnown and OriginValue
+// fields of settings are ignored.
+//
+// HessianVec panics if the lengths of dst, x and v are not equal, or if the
+// derivative order of the formula is not 1.
+func HessianVec(dst []float64, grad func(grad, x []float64), x, v []float64, settings *Settings) []float64 {
+	n := len(x)
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n || len(v) != n {
+		panic("fd: slice length mismatch")
+	}
+
+	// Default settings.
+	formula := Forward
+	step := formula.Step
+	var concurrent bool
+
+	// Use user settings if provided.
+	if settings != nil {
+		if !settings.Formula.isZero() {
+			formula = settings.Formula
+			step = formula.Step
+			checkFormula(formula)
+			if formula.Derivative != 1 {
+				panic(badDerivOrder)
+			}
+		}
+		if settings.Step != 0 {
+			if settings.Step < 0 {
+				panic(negativeStep)
+			}
+			step = settings.Step
+		}
+		concurrent = settings.Concurrent
+	}
+
+	for i := range dst {
+		dst[i] = 0
+	}
+	norm := floats.Norm(v, 2)
+	if norm == 0 {
+		return dst
+	}
+	// Scale the step so that the points of the stencil are
+	// step apart along v.
+	h := step / norm
+
+	// Each point of the stencil has its own gradient buffer so that
+	// the results are combined in the same order whether or not the
+	// evaluations are concurrent.
+	grads := make([][]float64, len(formula.Stencil))
+	eval := func(i int) {
+		xcopy := make([]float64, n)
+		floats.AddScaledTo(xcopy, x, formula.Stencil[i].Loc*h, v)
+		grads[i] = make([]float64, n)
+		grad(grads[i], xcopy)
+	}
+	nWorkers := computeWorkers(concurrent, len(formula.Stencil))
+	if nWorkers == 1 {
+		for i := range formula.Stencil {
+			eval(i)
+		}
+	} else {
+		var wg sync.WaitGroup
+		for i := range formula.Stencil {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				eval(i)
+			}(i)
+		}
+		wg.Wait()
+	}
+
+	for i, pt := range formula.Stencil {
+		floats.AddScaled(dst, pt.Coeff, grads[i])
+	}
+	floats.Scale(1/h, dst)
+	return dst
+}
@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// rosenbrockHess stores the Hessian of the Rosenbrock function at x in dst.
+func rosenbrockHess(dst *mat.SymDense, x []float64) {
+	dst.Zero()
+	for i := 0; i < len(x)-1; i++ {
+		dst.SetSym(i, i, dst.At(i, i)+2-400*(x[i+1]-x[i]*x[i])+800*x[i]*x[i])
+		dst.SetSym(i, i+1, -400*x[i])
+		dst.SetSym(i+1, i+1, dst.At(i+1, i+1)+200)
+	}
+}
+
+func TestHessianVec(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n        int
+		settings *Settings
+		tol      float64
+	}{
+		{n: 2, settings: nil, tol: 1e-4},
+		{n: 10, settings: nil, tol: 1e-4},
+		{n: 10, settings: &Settings{Formula: Backward}, tol: 1e-4},
+		{n: 10, settings: &Settings{Formula: Central}, tol: 1e-7},
+		{n: 10, settings: &Settings{Formula: Central, Concurrent: true}, tol: 1e-7},
+		{n: 50, settings: &Settings{Formula: Central, Step: 1e-5, Concurrent: true}, tol: 1e-7},
+	} {
+		r := Rosenbrock{test.n}
+		grad := func(g, x []float64) { r.FDf(x, g) }
+
+		x := make([]float64, test.n)
+		v := make([]float64, test.n)
+		for i := range x {
+			x[i] = rnd.Float64()
+			v[i] = rnd.NormFloat64()
+		}
+		xcopy := make([]float64, test.n)
+		copy(xcopy, x)
+
+		hess := mat.NewSymDense(test.n, nil)
+		rosenbrockHess(hess, x)
+		want := make([]float64, test.n)
+		mat.NewVecDense(test.n, want).MulVec(hess, mat.NewVecDense(test.n, v))
+
+		got := HessianVec(nil, grad, x, v, test.settings)
+		if !floats.EqualApprox(got, want, test.tol*floats.Norm(want, 2)) {
+			t.Errorf("n=%d: unexpected Hessian-vector product:\ngot  %v\nwant %v", test.n, got, want)
+		}
+		if !floats.Equal(x, xcopy) {
+			t.Errorf("n=%d: x modified during call", test.n)
+		}
+
+		// The product is linear in v.
+		floats.Scale(1e3, v)
+		scaled := HessianVec(make([]float64, test.n), grad, x, v, test.settings)
+		floats.Scale(1e-3, scaled)
+		if !floats.EqualApprox(scaled, got, 1e-9*floats.Norm(got, 2)) {
+			t.Errorf("n=%d: product not invariant to the scale of v", test.n)
+		}
+	}
+
+	zero := HessianVec(nil, func(g, x []float64) { panic("unexpected call") }, []float64{1, 2}, []float64{0, 0}, nil)
+	if !floats.Equal(zero, []float64{0, 0}) {
+		t.Errorf("unexpected product with zero vector: got %v", zero)
+	}
+
+	if !Panics(func() { HessianVec(nil, func(g, x []float64) {}, make([]float64, 2), make([]float64, 3), nil) }) {
+		t.Errorf("expected panic for mismatched lengths")
+	}
+	if !Panics(func() {
+		HessianVec(nil, func(g, x []float64) {}, make([]float64, 2), make([]float64, 2), &Settings{Formula: Central2nd})
+	}) {
+		t.Errorf("expected panic for second derivative formula")
+	}
+}
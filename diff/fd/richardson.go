@@ -0,0 +1,164 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import "math"
+
+// RichardsonSettings is the settings structure for DerivativeRichardson.
+type RichardsonSettings struct {
+	// Formula is the finite difference formula that is extrapolated.
+	// Zero value indicates the Central formula.
+	Formula Formula
+	// Step is the initial step size. Since the truncation error of the
+	// initial estimates is eliminated by the extrapolation, the initial
+	// step can be much larger than the default step of the formula.
+	// If equal to 0, a step of 0.1 will be used.
+	Step float64
+	// Ratio is the factor by which the step size is reduced between
+	// estimates. It must be greater than 1. If equal to 0, a ratio of 2
+	// will be used.
+	Ratio float64
+	// MaxIter is the maximum number of step sizes for which the formula
+	// is evaluated. If equal to 0, a maximum of 10 will be used.
+	MaxIter int
+	// Tol is the absolute error tolerance. The extrapolation terminates
+	// when the estimated error is less than Tol. If equal to 0, the
+	// extrapolation continues until MaxIter estimates have been made or
+	// until rounding error dominates the estimates.
+	Tol float64
+
+	OriginKnown bool    // Flag that the value at the origin x is known.
+	OriginValue float64 // Value at the origin (only used if OriginKnown is true).
+}
+
+// DerivativeRichardson estimates the derivative of the function f at the
+// given location using Richardson extrapolation of a finite difference
+// formula, and returns the estimate and an estimate of its absolute error.
+//
+// The formula is evaluated for a decreasing sequence of step sizes and the
+// estimates are combined in a Neville tableau to successively eliminate the
+// leading terms of the truncation error, as described in Ridders,
+// "Accurate computation of F′(x) and F′(x)F′′(x)", Adv. Eng. Softw.
+// 4(2):75-76, 1982. The powers of the step size in the truncation error are
+// determined from the stencil of the formula, so any formula can be
+// extrapolated. The extrapolation terminates when the estimated error is
+// less than the tolerance, or when the error of the latest extrapolation is
+// significantly larger than the best error so far, indicating that rounding
+// error dominates.
+//
+// The formula, the step sizes and other options are specified by settings. If
+// settings is nil, the first derivative will be estimated by extrapolating
+// the Central formula with default settings.
+//
+// DerivativeRichardson panics if the formula is not valid, if the step is
+// negative or if the ratio is not greater than 1.
+func DerivativeRichardson(f func(float64) float64, x float64, settings *RichardsonSettings) (deriv, errEst float64) {
+	// Default settings.
+	formula := Central
+	step := 0.1
+	ratio := 2.0
+	maxIter := 10
+	var tol, originValue float64
+	var originKnown bool
+
+	// Use user settings if provided.
+	if settings != nil {
+		if !settings.Formula.isZero() {
+			formula = settings.Formula
+			checkFormula(formula)
+		}
+		if settings.Step != 0 {
+			if settings.Step < 0 {
+				panic(negativeStep)
+			}
+			step = settings.Step
+		}
+		if settings.Ratio != 0 {
+			if !(settings.Ratio > 1) {
+				panic("fd: step ratio not greater than 1")
+			}
+			ratio = settings.Ratio
+		}
+		if settings.MaxIter > 0 {
+			maxIter = settings.MaxIter
+		}
+		tol = settings.Tol
+		originKnown = settings.OriginKnown
+		originValue = settings.OriginValue
+	}
+
+	// The value at the origin does not depend on the step.
+	originValue = getOrigin(originKnown, originValue, func() float64 { return f(x) }, formula.Stencil)
+	estimate := func(h float64) float64 {
+		var d float64
+		for _, pt := range formula.Stencil {
+			if pt.Loc == 0 {
+				d += pt.Coeff * originValue
+				continue
+			}
+			d += pt.Coeff * f(x+h*pt.Loc)
+		}
+		return d / math.Pow(h, float64(formula.Derivative))
+	}
+
+	powers := truncationPowers(formula, maxIter-1)
+	// factors[j] is ratio^powers[j] - 1.
+	factors := make([]float64, len(powers))
+	for j, p := range powers {
+		factors[j] = math.Pow(ratio, float64(p)) - 1
+	}
+
+	// prev and curr are consecutive rows of the Neville tableau.
+	prev := make([]float64, 0, maxIter)
+	curr := make([]float64, 0, maxIter)
+	h := step
+	prev = append(prev, estimate(h))
+	deriv = prev[0]
+	errEst = math.Inf(1)
+	for i := 1; i < maxIter; i++ {
+		h /= ratio
+		curr = append(curr[:0], estimate(h))
+		for j := 1; j <= i && j <= len(factors); j++ {
+			curr = append(curr, curr[j-1]+(curr[j-1]-prev[j-1])/factors[j-1])
+			e := math.Max(math.Abs(curr[j]-curr[j-1]), math.Abs(curr[j]-prev[j-1]))
+			if e <= errEst {
+				deriv, errEst = curr[j], e
+			}
+		}
+		// Stop if the highest order extrapolation has become
+		// worse than the best estimate by a significant factor.
+		if math.Abs(curr[len(curr)-1]-prev[len(prev)-1]) >= 2*errEst {
+			break
+		}
+		if errEst < tol {
+			break
+		}
+		prev, curr = curr, prev
+	}
+	return deriv, errEst
+}
+
+// truncationPowers returns up to n of the increasing powers of the step size
+// that appear in the truncation error of formula. The truncation error of a
+// formula for the derivative of order k has a term in h^(q-k) whenever the
+// moment sum_i Coeff_i * Loc_i^q of the stencil is not zero.
+func truncationPowers(formula Formula, n int) []int {
+	// Bound the search for stencils with few non-zero moments.
+	const maxOrder = 64
+	k := formula.Derivative
+	var powers []int
+	for q := k + 1; q <= k+maxOrder && len(powers) < n; q++ {
+		var moment, scale float64
+		for _, pt := range formula.Stencil {
+			lq := math.Pow(pt.Loc, float64(q))
+			moment += pt.Coeff * lq
+			scale += math.Abs(pt.Coeff * lq)
+		}
+		if math.Abs(moment) > 1e-12*scale {
+			powers = append(powers, q-k)
+		}
+	}
+	return powers
+}
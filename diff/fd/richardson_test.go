@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDerivativeRichardson(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		f        func(float64) float64
+		df       float64
+		x        float64
+		settings *RichardsonSettings
+		tol      float64
+	}{
+		{f: math.Sin, df: math.Cos(1), x: 1, tol: 1e-13},
+		{f: math.Exp, df: math.Exp(-2), x: -2, tol: 1e-13},
+		{f: math.Exp, df: math.Exp(3), x: 3, settings: &RichardsonSettings{Formula: Forward}, tol: 1e-11},
+		{f: math.Exp, df: math.Exp(3), x: 3, settings: &RichardsonSettings{Formula: Backward, Ratio: 1.4, MaxIter: 20}, tol: 1e-11},
+		{f: math.Log, df: 1 / 0.1, x: 0.1, settings: &RichardsonSettings{Step: 0.05}, tol: 1e-10},
+		{
+			f:        func(x float64) float64 { return math.Pow(math.Cos(x), 3) },
+			df:       -3,
+			x:        0,
+			settings: &RichardsonSettings{Formula: Central2nd, OriginKnown: true, OriginValue: 1},
+			tol:      1e-9,
+		},
+		{
+			f:        math.Atan,
+			df:       1 / (1 + 0.25),
+			x:        0.5,
+			settings: &RichardsonSettings{Tol: 1e-6},
+			tol:      1e-6,
+		},
+	} {
+		deriv, errEst := DerivativeRichardson(test.f, test.x, test.settings)
+		if math.Abs(deriv-test.df) > test.tol*math.Max(1, math.Abs(test.df)) {
+			t.Errorf("Case %d: unexpected derivative: got %v, want %v", i, deriv, test.df)
+		}
+		if !(errEst < test.tol*math.Max(1, math.Abs(test.df))) {
+			t.Errorf("Case %d: error estimate too large: %v", i, errEst)
+		}
+	}
+
+	for _, settings := range []*RichardsonSettings{
+		{Ratio: 1},
+		{Ratio: -2},
+		{Step: -1},
+		{Formula: Formula{Stencil: Central.Stencil, Derivative: 1}},
+	} {
+		if !Panics(func() { DerivativeRichardson(math.Sin, 0, settings) }) {
+			t.Errorf("expected panic for settings %+v", settings)
+		}
+	}
+}
+
+func TestTruncationPowers(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name    string
+		formula Formula
+		want    []int
+	}{
+		{name: "Forward", formula: Forward, want: []int{1, 2, 3, 4}},
+		{name: "Backward", formula: Backward, want: []int{1, 2, 3, 4}},
+		{name: "Central", formula: Central, want: []int{2, 4, 6, 8}},
+		{name: "Central2nd", formula: Central2nd, want: []int{2, 4, 6, 8}},
+		{name: "Forward2nd", formula: Forward2nd, want: []int{1, 2, 3, 4}},
+	} {
+		got := truncationPowers(test.formula, 4)
+		if len(got) != len(test.want) {
+			t.Errorf("%s: unexpected powers: got %v, want %v", test.name, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("%s: unexpected powers: got %v, want %v", test.name, got, test.want)
+				break
+			}
+		}
+	}
+}
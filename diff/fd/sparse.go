@@ -0,0 +1,354 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"slices"
+	"sort"
+	"sync"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph/coloring"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ mat.Matrix         = (*SparseJacobian)(nil)
+	_ mat.NonZeroDoer    = (*SparseJacobian)(nil)
+	_ mat.RowNonZeroDoer = (*SparseJacobian)(nil)
+	_ mat.ColNonZeroDoer = (*SparseJacobian)(nil)
+)
+
+// SparseJacobian is an m×n matrix with a fixed sparsity pattern for holding
+// a Jacobian matrix estimated by JacobianSparse. Elements outside the pattern
+// are zero. The elements in the pattern are stored in compressed sparse row
+// format.
+//
+// The columns of the pattern are partitioned into groups of structurally
+// orthogonal columns, that is, columns that do not have elements in the same
+// row. The columns of a group can be perturbed together by a single function
+// evaluation, so JacobianSparse evaluates the function once per group for each
+// point of the finite difference stencil instead of once per column.
+type SparseJacobian struct {
+	m, n int
+
+	indptr []int // Row i is stored in ind[indptr[i]:indptr[i+1]].
+	ind    []int
+	data   []float64
+
+	cols   [][]nonZero // The rows and storage indices of each column.
+	groups [][]int     // The structurally orthogonal column groups.
+}
+
+// nonZero is the row and storage index of an element in the pattern.
+type nonZero struct {
+	i, p int
+}
+
+// NewSparseJacobian returns a new m×n SparseJacobian with the given sparsity
+// pattern. pattern must have length m and pattern[i] holds the column indices
+// of the possibly non-zero elements of row i. Repeated column indices are
+// ignored. The elements of the pattern are initially zero.
+//
+// The column groups are found by coloring the column intersection graph with
+// the Dsatur heuristic of the graph/coloring package. The coloring is computed
+// once when the SparseJacobian is created, so a SparseJacobian should be
+// reused when the Jacobian is estimated repeatedly.
+//
+// NewSparseJacobian panics if m or n is not positive, if the length of pattern
+// is not m or if a column index is out of range.
+func NewSparseJacobian(m, n int, pattern [][]int) *SparseJacobian {
+	if m <= 0 || n <= 0 {
+		panic("jacobian: non-positive dimension")
+	}
+	if len(pattern) != m {
+		panic("jacobian: mismatched pattern length")
+	}
+
+	s := &SparseJacobian{
+		m:      m,
+		n:      n,
+		indptr: make([]int, m+1),
+		cols:   make([][]nonZero, n),
+	}
+	for i, row := range pattern {
+		row = slices.Clone(row)
+		slices.Sort(row)
+		row = slices.Compact(row)
+		if len(row) != 0 && (row[0] < 0 || n <= row[len(row)-1]) {
+			panic("jacobian: column index out of range")
+		}
+		for _, j := range row {
+			s.cols[j] = append(s.cols[j], nonZero{i: i, p: len(s.ind)})
+			s.ind = append(s.ind, j)
+		}
+		s.indptr[i+1] = len(s.ind)
+	}
+	s.data = make([]float64, len(s.ind))
+
+	// Columns are adjacent in the column intersection graph if they
+	// have elements in the same row. Columns without elements are
+	// not included since they do not need to be evaluated.
+	g := simple.NewUndirectedGraph()
+	for j, col := range s.cols {
+		if len(col) != 0 {
+			g.AddNode(simple.Node(j))
+		}
+	}
+	for i := 0; i < m; i++ {
+		row := s.ind[s.indptr[i]:s.indptr[i+1]]
+		for a, j := range row {
+			for _, k := range row[a+1:] {
+				if !g.HasEdgeBetween(int64(j), int64(k)) {
+					g.SetEdge(simple.Edge{F: simple.Node(j), T: simple.Node(k)})
+				}
+			}
+		}
+	}
+	_, colors, err := coloring.Dsatur(g, nil)
+	if err != nil {
+		panic(err)
+	}
+	sets := coloring.Sets(colors)
+	s.groups = make([][]int, 0, len(sets))
+	for _, set := range sets {
+		group := make([]int, len(set))
+		for i, id := range set {
+			group[i] = int(id)
+		}
+		slices.Sort(group)
+		s.groups = append(s.groups, group)
+	}
+	// Order the groups for a deterministic order of evaluation.
+	sort.Slice(s.groups, func(a, b int) bool {
+		return s.groups[a][0] < s.groups[b][0]
+	})
+	return s
+}
+
+// Dims returns the dimensions of the matrix.
+func (s *SparseJacobian) Dims() (r, c int) {
+	return s.m, s.n
+}
+
+// At returns the element at row i, column j.
+func (s *SparseJacobian) At(i, j int) float64 {
+	if uint(i) >= uint(s.m) {
+		panic(mat.ErrRowAccess)
+	}
+	if uint(j) >= uint(s.n) {
+		panic(mat.ErrColAccess)
+	}
+	lo, hi := s.indptr[i], s.indptr[i+1]
+	if p, ok := slices.BinarySearch(s.ind[lo:hi], j); ok {
+		return s.data[lo+p]
+	}
+	return 0
+}
+
+// T performs an implicit transpose by returning the receiver inside a Transpose.
+func (s *SparseJacobian) T() mat.Matrix {
+	return mat.Transpose{Matrix: s}
+}
+
+// NNZ returns the number of elements in the sparsity pattern.
+func (s *SparseJacobian) NNZ() int {
+	return len(s.ind)
+}
+
+// NumGroups returns the number of groups of structurally orthogonal columns,
+// which is the number of function evaluations needed by JacobianSparse for
+// each point of the finite difference stencil that is not at the origin.
+func (s *SparseJacobian) NumGroups() int {
+	return len(s.groups)
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of s. The function fn
+// takes a row/column index and the element value of s at (i, j).
+func (s *SparseJacobian) DoNonZero(fn func(i, j int, v float64)) {
+	for i := 0; i < s.m; i++ {
+		s.doRowNonZero(i, fn)
+	}
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of row i of s. The function fn
+// takes a row/column index and the element value of s at (i, j).
+func (s *SparseJacobian) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if uint(i) >= uint(s.m) {
+		panic(mat.ErrRowAccess)
+	}
+	s.doRowNonZero(i, fn)
+}
+
+func (s *SparseJacobian) doRowNonZero(i int, fn func(i, j int, v float64)) {
+	for p := s.indptr[i]; p < s.indptr[i+1]; p++ {
+		if v := s.data[p]; v != 0 {
+			fn(i, s.ind[p], v)
+		}
+	}
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of column j of s. The function fn
+// takes a row/column index and the element value of s at (i, j).
+func (s *SparseJacobian) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if uint(j) >= uint(s.n) {
+		panic(mat.ErrColAccess)
+	}
+	for _, nz := range s.cols[j] {
+		if v := s.data[nz.p]; v != 0 {
+			fn(nz.i, j, v)
+		}
+	}
+}
+
+// JacobianSparse approximates the Jacobian matrix of a vector-valued function f
+// at the location x and stores the result in-place into the elements of the
+// sparsity pattern of dst. The Jacobian matrix must be zero outside the pattern
+// of dst, otherwise the result is not correct. See Jacobian for the definition
+// of the Jacobian matrix.
+//
+// Finite difference formula and other options are specified by settings. If
+// settings is nil, the Jacobian will be estimated using the Forward formula and
+// a default step size.
+//
+// dst must be non-nil, the number of its columns must equal the length of x, and
+// the derivative order of the formula must be 1, otherwise JacobianSparse will panic.
+func JacobianSparse(dst *SparseJacobian, f func(y, x []float64), x []float64, settings *JacobianSettings) {
+	n := len(x)
+	if n == 0 {
+		panic("jacobian: x has zero length")
+	}
+	m, c := dst.Dims()
+	if c != n {
+		panic("jacobian: mismatched matrix size")
+	}
+
+	// Default settings.
+	formula := Forward
+	step := formula.Step
+	var originValue []float64
+	var concurrent bool
+
+	// Use user settings if provided.
+	if settings != nil {
+		if !settings.Formula.isZero() {
+			formula = settings.Formula
+			step = formula.Step
+			checkFormula(formula)
+			if formula.Derivative != 1 {
+				panic(badDerivOrder)
+			}
+		}
+		if settings.Step != 0 {
+			step = settings.Step
+		}
+		originValue = settings.OriginValue
+		if originValue != nil && len(originValue) != m {
+			panic("jacobian: mismatched OriginValue slice length")
+		}
+		concurrent = settings.Concurrent
+	}
+
+	for p := range dst.data {
+		dst.data[p] = 0
+	}
+
+	evals := len(dst.groups) * len(formula.Stencil)
+	hasOrigin := usesOrigin(formula.Stencil)
+	if hasOrigin {
+		evals -= len(dst.groups)
+		if originValue == nil {
+			xcopy := make([]float64, n)
+			copy(xcopy, x)
+			originValue = make([]float64, m)
+			f(originValue, xcopy)
+		}
+		for _, pt := range formula.Stencil {
+			if pt.Loc == 0 {
+				dst.addScaledGroup(nil, pt.Coeff, originValue)
+			}
+		}
+	}
+
+	nWorkers := computeWorkers(concurrent, evals)
+	if nWorkers == 1 {
+		xcopy := make([]float64, n)
+		y := make([]float64, m)
+		for _, group := range dst.groups {
+			for _, pt := range formula.Stencil {
+				if pt.Loc == 0 {
+					continue
+				}
+				copy(xcopy, x)
+				for _, j := range group {
+					xcopy[j] += pt.Loc * step
+				}
+				f(y, xcopy)
+				dst.addScaledGroup(group, pt.Coeff, y)
+			}
+		}
+	} else {
+		var (
+			wg sync.WaitGroup
+			mu = make([]sync.Mutex, len(dst.groups)) // Guard access to the elements of individual groups.
+		)
+		worker := func(jobs <-chan sparseJacJob) {
+			defer wg.Done()
+			xcopy := make([]float64, n)
+			y := make([]float64, m)
+			for job := range jobs {
+				group := dst.groups[job.g]
+				copy(xcopy, x)
+				for _, j := range group {
+					xcopy[j] += job.pt.Loc * step
+				}
+				f(y, xcopy)
+				mu[job.g].Lock()
+				dst.addScaledGroup(group, job.pt.Coeff, y)
+				mu[job.g].Unlock()
+			}
+		}
+		jobs := make(chan sparseJacJob, nWorkers)
+		for i := 0; i < nWorkers; i++ {
+			wg.Add(1)
+			go worker(jobs)
+		}
+		for g := range dst.groups {
+			for _, pt := range formula.Stencil {
+				if pt.Loc != 0 {
+					jobs <- sparseJacJob{g, pt}
+				}
+			}
+		}
+		close(jobs)
+		wg.Wait()
+	}
+
+	floats.Scale(1/step, dst.data)
+}
+
+// addScaledGroup adds alpha*y[i] to the elements (i, j) of the pattern of s
+// for each column j in group. If group is nil, all columns are used.
+func (s *SparseJacobian) addScaledGroup(group []int, alpha float64, y []float64) {
+	if group == nil {
+		for i := 0; i < s.m; i++ {
+			for p := s.indptr[i]; p < s.indptr[i+1]; p++ {
+				s.data[p] += alpha * y[i]
+			}
+		}
+		return
+	}
+	for _, j := range group {
+		for _, nz := range s.cols[j] {
+			s.data[nz.p] += alpha * y[nz.i]
+		}
+	}
+}
+
+type sparseJacJob struct {
+	g  int
+	pt Point
+}
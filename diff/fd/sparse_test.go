@@ -0,0 +1,181 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// tridiag is the residual of a finite difference discretization of the
+// boundary value problem u” = exp(u) with zero boundary values.
+func tridiag(y, x []float64) {
+	n := len(x)
+	h := 1 / float64(n+1)
+	for i := range x {
+		var l, r float64
+		if i > 0 {
+			l = x[i-1]
+		}
+		if i < n-1 {
+			r = x[i+1]
+		}
+		y[i] = (l-2*x[i]+r)/(h*h) - math.Exp(x[i])
+	}
+}
+
+func tridiagPattern(n int) [][]int {
+	pattern := make([][]int, n)
+	for i := range pattern {
+		for j := max(0, i-1); j < min(n, i+2); j++ {
+			pattern[i] = append(pattern[i], j)
+		}
+	}
+	return pattern
+}
+
+func TestJacobianSparse(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name    string
+		f       func(y, x []float64)
+		m, n    int
+		pattern [][]int
+		groups  int
+	}{
+		{
+			name:    "tridiagonal",
+			f:       tridiag,
+			m:       50,
+			n:       50,
+			pattern: tridiagPattern(50),
+			groups:  3,
+		},
+		{
+			name: "arrowhead",
+			f: func(y, x []float64) {
+				for i := 0; i < 9; i++ {
+					y[i] = x[i]*x[i] + math.Sin(x[9])
+				}
+				y[9] = math.Exp(x[9])
+				y[10] = x[0] * x[1]
+			},
+			m: 11,
+			n: 10,
+			pattern: func() [][]int {
+				p := make([][]int, 11)
+				for i := 0; i < 9; i++ {
+					p[i] = []int{9, i, i}
+				}
+				p[9] = []int{9}
+				p[10] = []int{1, 0}
+				return p
+			}(),
+			groups: 3,
+		},
+		{
+			name: "empty column",
+			f: func(y, x []float64) {
+				y[0] = x[0] * x[2]
+				y[1] = math.Cos(x[2])
+			},
+			m:       2,
+			n:       3,
+			pattern: [][]int{{0, 2}, {2}},
+			groups:  2,
+		},
+	} {
+		x := make([]float64, test.n)
+		for i := range x {
+			x[i] = rnd.Float64()
+		}
+		sparse := NewSparseJacobian(test.m, test.n, test.pattern)
+		if sparse.NumGroups() != test.groups {
+			t.Errorf("%s: unexpected number of groups: got %d, want %d", test.name, sparse.NumGroups(), test.groups)
+		}
+		for _, formula := range []Formula{Forward, Backward, Central} {
+			for _, concurrent := range []bool{false, true} {
+				settings := &JacobianSettings{
+					Formula:    formula,
+					Concurrent: concurrent,
+				}
+				want := mat.NewDense(test.m, test.n, nil)
+				Jacobian(want, test.f, x, settings)
+
+				// Fill with garbage to check that dst is overwritten.
+				for p := range sparse.data {
+					sparse.data[p] = math.NaN()
+				}
+				JacobianSparse(sparse, test.f, x, settings)
+				// The perturbations of the columns in a group do not
+				// interact, so the results must be identical.
+				if !mat.Equal(sparse, want) {
+					t.Errorf("%s: unexpected Jacobian for concurrent=%t:\ngot  %v\nwant %v", test.name, concurrent,
+						mat.Formatted(sparse, mat.Prefix("     ")), mat.Formatted(want, mat.Prefix("     ")))
+				}
+
+				var nnz int
+				sparse.DoNonZero(func(i, j int, v float64) {
+					nnz++
+					if v != want.At(i, j) {
+						t.Errorf("%s: unexpected DoNonZero value at (%d,%d): got %v, want %v", test.name, i, j, v, want.At(i, j))
+					}
+				})
+				if nnz > sparse.NNZ() {
+					t.Errorf("%s: too many non-zero elements: got %d, max %d", test.name, nnz, sparse.NNZ())
+				}
+			}
+		}
+	}
+}
+
+func TestJacobianSparseOrigin(t *testing.T) {
+	t.Parallel()
+	const n = 20
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i) / n
+	}
+	origin := make([]float64, n)
+	tridiag(origin, x)
+
+	sparse := NewSparseJacobian(n, n, tridiagPattern(n))
+	var calls int
+	f := func(y, x []float64) {
+		calls++
+		tridiag(y, x)
+	}
+	JacobianSparse(sparse, f, x, &JacobianSettings{OriginValue: origin})
+	if calls != sparse.NumGroups() {
+		t.Errorf("unexpected number of function calls: got %d, want %d", calls, sparse.NumGroups())
+	}
+	want := mat.NewDense(n, n, nil)
+	Jacobian(want, tridiag, x, nil)
+	if !mat.Equal(sparse, want) {
+		t.Errorf("unexpected Jacobian with known origin")
+	}
+}
+
+func TestNewSparseJacobianPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name    string
+		m, n    int
+		pattern [][]int
+	}{
+		{name: "zero rows", m: 0, n: 1, pattern: nil},
+		{name: "pattern length", m: 2, n: 2, pattern: [][]int{{0}}},
+		{name: "negative column", m: 1, n: 2, pattern: [][]int{{-1}}},
+		{name: "large column", m: 1, n: 2, pattern: [][]int{{0, 2}}},
+	} {
+		if !Panics(func() { NewSparseJacobian(test.m, test.n, test.pattern) }) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
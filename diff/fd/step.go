@@ -0,0 +1,323 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import "math"
+
+// StepSettings is the settings structure for selecting finite difference
+// step sizes.
+type StepSettings struct {
+	// Formula is the first derivative finite difference formula for
+	// which the step is selected.
+	// Zero value indicates the Forward formula.
+	Formula Formula
+
+	// Noise is the standard deviation of the noise in the function
+	// values, for example for functions evaluated by simulation. If
+	// Noise is positive the step is selected by balancing the noise and
+	// the truncation error of the formula.
+	Noise float64
+
+	// EstimateNoise specifies that the noise is estimated with
+	// EstimateNoise if Noise is zero. If the noise cannot be estimated,
+	// the step is selected as if the function were not noisy.
+	EstimateNoise bool
+
+	// InitialStep is the largest step considered when the function is
+	// not noisy. If equal to 0, a step of 0.1*max(1, |x|) is used.
+	InitialStep float64
+}
+
+// StepSize returns a step size for estimating the first derivative of f at x
+// with a finite difference formula.
+//
+// If the noise in the function values is not known and not estimated, the
+// step is selected with the algorithm of Stepleman and Winarsky, "Adaptive
+// numerical differentiation", Math. Comp. 33(148):1257-1264, 1979. The step
+// is repeatedly halved starting from the initial step until the difference
+// between consecutive derivative estimates, after it has decreased at the
+// rate of the truncation error of the formula, stops decreasing, which is
+// when rounding error starts to dominate the truncation error. If that does
+// not happen, the default step of the formula scaled by max(1, |x|) is
+// returned.
+//
+// If the noise is known or estimated, the step minimizes the bound on the sum
+// of the truncation error and the noise error of the formula, using an
+// estimate of the derivative of f that appears in the truncation error, as
+// described in Moré and Wild, "Estimating derivatives of noisy simulations",
+// ACM Trans. Math. Softw. 38(3):19, 2012.
+//
+// The formula and other options are specified by settings. If settings is
+// nil, the step is selected for the Forward formula without noise.
+//
+// StepSize panics if the formula is not valid, if the derivative order of the
+// formula is not 1, or if the noise or initial step are negative.
+func StepSize(f func(float64) float64, x float64, settings *StepSettings) float64 {
+	// Default settings.
+	formula := Forward
+	var noise, initial float64
+	var estimate bool
+
+	// Use user settings if provided.
+	if settings != nil {
+		if !settings.Formula.isZero() {
+			formula = settings.Formula
+			checkFormula(formula)
+			if formula.Derivative != 1 {
+				panic(badDerivOrder)
+			}
+		}
+		if settings.Noise < 0 {
+			panic("fd: negative noise")
+		}
+		if settings.InitialStep < 0 {
+			panic(negativeStep)
+		}
+		noise = settings.Noise
+		estimate = settings.EstimateNoise
+		initial = settings.InitialStep
+	}
+	if initial == 0 {
+		initial = 0.1 * math.Max(1, math.Abs(x))
+	}
+
+	if noise == 0 && estimate {
+		var ok bool
+		noise, ok = EstimateNoise(f, x, 0)
+		if !ok {
+			noise = 0
+		}
+	}
+	if noise > 0 {
+		return noisyStep(f, x, formula, noise)
+	}
+	return steplemanWinarsky(f, x, formula, initial)
+}
+
+// steplemanWinarsky returns the step size at which the differences between
+// derivative estimates of formula for a sequence of halved steps starting
+// at initial stop decreasing.
+func steplemanWinarsky(f func(float64) float64, x float64, formula Formula, initial float64) float64 {
+	origin := getOrigin(false, 0, func() float64 { return f(x) }, formula.Stencil)
+	estimate := func(h float64) float64 {
+		var d float64
+		for _, pt := range formula.Stencil {
+			if pt.Loc == 0 {
+				d += pt.Coeff * origin
+				continue
+			}
+			d += pt.Coeff * f(x+h*pt.Loc)
+		}
+		return d / h
+	}
+
+	// Bound the step well below the step that balances the truncation
+	// error with the rounding error.
+	const eps = 0x1p-52
+	p := truncationPowers(formula, 1)[0]
+	scale := math.Max(1, math.Abs(x))
+	hMin := 0.01 * math.Pow(eps, 1/float64(p+1)) * scale
+
+	// Once the step is small enough for the leading term of the
+	// truncation error to dominate, the differences decrease by a
+	// factor of about 2^p for each halving of the step. Only stop
+	// when the differences stop decreasing after they have decreased
+	// at that rate for two consecutive steps.
+	rate := math.Pow(2, -float64(p))
+	var asymptotic int
+	h := initial
+	prev := estimate(h)
+	h /= 2
+	curr := estimate(h)
+	diff := math.Abs(curr - prev)
+	for h/2 >= hMin {
+		next := estimate(h / 2)
+		d := math.Abs(next - curr)
+		if asymptotic >= 2 && d >= diff {
+			return h
+		}
+		if math.Abs(d/diff-rate) <= rate/2 {
+			asymptotic++
+		} else if asymptotic < 2 {
+			asymptotic = 0
+		}
+		h /= 2
+		curr, diff = next, d
+	}
+	if asymptotic < 2 {
+		// The truncation error was not resolved, for example
+		// because f is linear, so fall back to the default step.
+		return formula.Step * scale
+	}
+	return h
+}
+
+// noisyStep returns the step size that minimizes the bound on the sum of
+// the truncation error and the noise error of formula.
+func noisyStep(f func(float64) float64, x float64, formula Formula, noise float64) float64 {
+	// With the leading truncation error term c*f^(p+1)(x)*h^p and the
+	// noise error s*noise/h, the total error is minimized by
+	//	h = (s*noise / (p*c*|f^(p+1)(x)|))^(1/(p+1)).
+	p := truncationPowers(formula, 1)[0]
+	d := p + 1
+	var moment, s float64
+	for _, pt := range formula.Stencil {
+		moment += pt.Coeff * math.Pow(pt.Loc, float64(d))
+		s += math.Abs(pt.Coeff)
+	}
+	c := math.Abs(moment) / factorial(d)
+
+	deriv, ok := noisyDerivative(f, x, d, noise)
+	if !ok {
+		// The derivative is too small to be estimated, so use the
+		// step that is optimal for a derivative of unit magnitude
+		// relative to the scale of x.
+		deriv = 1 / math.Pow(math.Max(1, math.Abs(x)), float64(d-1))
+	}
+	return math.Pow(s*noise/(float64(p)*c*deriv), 1/float64(d))
+}
+
+// noisyDerivative returns an estimate of the absolute value of the
+// derivative of order d of f at x using a centered difference with a step
+// for which the difference is significantly larger than the noise. The
+// boolean return is false if no such step was found.
+func noisyDerivative(f func(float64) float64, x float64, d int, noise float64) (float64, bool) {
+	// The standard deviation of the noise in the centered difference
+	// of order d is sqrt(binomial(2d, d)) times the noise.
+	diffNoise := math.Sqrt(factorial(2*d)) / factorial(d) * noise
+	scale := math.Max(1, math.Abs(x))
+	h := math.Pow(noise, 1/float64(d+2)) * scale
+	for try := 0; try < 4; try++ {
+		var delta float64
+		binom := 1.0
+		for j := 0; j <= d; j++ {
+			v := f(x + (float64(d)/2-float64(j))*h)
+			if j%2 == 1 {
+				v = -v
+			}
+			delta += binom * v
+			binom = binom * float64(d-j) / float64(j+1)
+		}
+		delta = math.Abs(delta)
+		if delta >= 30*diffNoise {
+			return delta / math.Pow(h, float64(d)), true
+		}
+		h *= 10
+	}
+	return 0, false
+}
+
+func factorial(n int) float64 {
+	f := 1.0
+	for i := 2; i <= n; i++ {
+		f *= float64(i)
+	}
+	return f
+}
+
+// EstimateNoise estimates the standard deviation of the noise in the values
+// of f near x with the difference table method of Moré and Wild, "Estimating
+// computational noise", SIAM J. Sci. Comput. 33(3):1292-1314, 2011. f is
+// evaluated at 9 points spaced h apart centered on x. If h is zero, a spacing
+// of 1e-6*max(1, |x|) is used.
+//
+// The boolean return is false if the noise could not be estimated, which
+// usually means that h is too large or too small. A noise of zero with a true
+// boolean return means that the function values do not vary at the spacing h.
+//
+// EstimateNoise panics if h is negative.
+func EstimateNoise(f func(float64) float64, x, h float64) (noise float64, ok bool) {
+	if h < 0 {
+		panic(negativeStep)
+	}
+	if h == 0 {
+		h = 1e-6 * math.Max(1, math.Abs(x))
+	}
+
+	const (
+		m      = 8 // Number of differences of the function values.
+		levels = 6 // Number of levels of the difference table.
+	)
+	var vals [m + 1]float64
+	fmin, fmax := math.Inf(1), math.Inf(-1)
+	for i := range vals {
+		vals[i] = f(x + float64(i-m/2)*h)
+		fmin = math.Min(fmin, vals[i])
+		fmax = math.Max(fmax, vals[i])
+	}
+	if math.IsNaN(fmin) || math.IsInf(fmin, 0) || math.IsInf(fmax, 0) {
+		return math.NaN(), false
+	}
+	if fmax-fmin > 0.1*math.Max(math.Abs(fmax), math.Abs(fmin)) {
+		// The function varies too much, so h is too large.
+		return 0, false
+	}
+	if fmax == fmin {
+		return 0, true
+	}
+
+	// level[k] is the noise estimate from the differences of order k+1,
+	// and signChange[k] records whether those differences change sign
+	// as expected of differences dominated by noise.
+	var (
+		level      [levels]float64
+		signChange [levels]bool
+	)
+	gamma := 1.0 // gamma_k = (k!)^2 / (2k)!
+	for k := 1; k <= levels; k++ {
+		var sum float64
+		dmin, dmax := math.Inf(1), math.Inf(-1)
+		for i := 0; i <= m-k; i++ {
+			vals[i] = vals[i+1] - vals[i]
+			sum += vals[i] * vals[i]
+			dmin = math.Min(dmin, vals[i])
+			dmax = math.Max(dmax, vals[i])
+		}
+		gamma *= 0.5 * float64(k) / float64(2*k-1)
+		level[k-1] = math.Sqrt(gamma * sum / float64(m+1-k))
+		signChange[k-1] = dmin < 0 && dmax > 0
+	}
+
+	// Accept the first estimate that agrees with the two following
+	// estimates within a factor of 4 and comes from differences that
+	// change sign.
+	for k := 0; k+2 < levels; k++ {
+		lo := math.Min(level[k], math.Min(level[k+1], level[k+2]))
+		hi := math.Max(level[k], math.Max(level[k+1], level[k+2]))
+		if hi <= 4*lo && signChange[k] {
+			return level[k], true
+		}
+	}
+	return 0, false
+}
+
+// GradientSteps returns a step size for each coordinate of x for estimating
+// the gradient of f at x with a finite difference formula. The step for each
+// coordinate is selected by StepSize applied to f as a function of that
+// coordinate. If dst is not nil, the result will be stored in-place into dst
+// and returned, otherwise a new slice will be allocated first. The steps can
+// be used by Gradient through the Steps field of Settings.
+//
+// The formula and other options are specified by settings as for StepSize.
+//
+// GradientSteps panics if the length of dst and x is not equal.
+func GradientSteps(dst []float64, f func([]float64) float64, x []float64, settings *StepSettings) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) {
+		panic("fd: slice length mismatch")
+	}
+	xcopy := make([]float64, len(x))
+	for i := range x {
+		fi := func(xi float64) float64 {
+			copy(xcopy, x)
+			xcopy[i] = xi
+			return f(xcopy)
+		}
+		dst[i] = StepSize(fi, x[i], settings)
+	}
+	return dst
+}
@@ -0,0 +1,170 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// noisy returns f with added normally distributed noise with standard
+// deviation sigma. The noise is a deterministic function of the location.
+func noisy(f func(float64) float64, sigma float64) func(float64) float64 {
+	return func(x float64) float64 {
+		bits := math.Float64bits(x)
+		rnd := rand.New(rand.NewPCG(bits, bits>>32))
+		return f(x) + sigma*rnd.NormFloat64()
+	}
+}
+
+func TestStepSize(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		f        func(float64) float64
+		df       func(float64) float64
+		x        float64
+		settings *StepSettings
+		tol      float64
+	}{
+		{f: math.Exp, df: math.Exp, x: 1, tol: 1e-7},
+		{f: math.Exp, df: math.Exp, x: 1, settings: &StepSettings{Formula: Backward}, tol: 1e-7},
+		{f: math.Exp, df: math.Exp, x: 1, settings: &StepSettings{Formula: Central}, tol: 1e-9},
+		{f: math.Sin, df: math.Cos, x: 100, settings: &StepSettings{Formula: Central}, tol: 1e-8},
+		{f: math.Log, df: func(x float64) float64 { return 1 / x }, x: 1e-3, settings: &StepSettings{InitialStep: 1e-4}, tol: 1e-4},
+		{
+			f:        noisy(math.Sin, 1e-6),
+			df:       math.Cos,
+			x:        0.5,
+			settings: &StepSettings{Noise: 1e-6},
+			tol:      1e-2,
+		},
+		{
+			f:        noisy(math.Sin, 1e-6),
+			df:       math.Cos,
+			x:        0.5,
+			settings: &StepSettings{Formula: Central, EstimateNoise: true},
+			tol:      1e-3,
+		},
+		{
+			f:        noisy(math.Exp, 1e-9),
+			df:       math.Exp,
+			x:        2,
+			settings: &StepSettings{Formula: Central, EstimateNoise: true},
+			tol:      1e-5,
+		},
+	} {
+		formula := Forward
+		if test.settings != nil && !test.settings.Formula.isZero() {
+			formula = test.settings.Formula
+		}
+		h := StepSize(test.f, test.x, test.settings)
+		if !(h > 0) {
+			t.Errorf("Case %d: invalid step %v", i, h)
+			continue
+		}
+		got := Derivative(test.f, test.x, &Settings{Formula: formula, Step: h})
+		want := test.df(test.x)
+		if math.Abs(got-want) > test.tol*math.Max(1, math.Abs(want)) {
+			t.Errorf("Case %d: unexpected derivative with step %v: got %v, want %v", i, h, got, want)
+		}
+	}
+
+	for _, settings := range []*StepSettings{
+		{Noise: -1},
+		{InitialStep: -1},
+		{Formula: Central2nd},
+	} {
+		if !Panics(func() { StepSize(math.Sin, 0, settings) }) {
+			t.Errorf("expected panic for settings %+v", settings)
+		}
+	}
+}
+
+func TestEstimateNoise(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		f     func(float64) float64
+		x, h  float64
+		sigma float64
+		ok    bool
+	}{
+		{f: noisy(math.Sin, 1e-8), x: 1, sigma: 1e-8, ok: true},
+		{f: noisy(math.Exp, 1e-4), x: 3, h: 1e-5, sigma: 1e-4, ok: true},
+		{f: noisy(func(x float64) float64 { return 1 + x*x }, 1e-10), x: -2, sigma: 1e-10, ok: true},
+		{f: func(float64) float64 { return 3 }, x: 1, sigma: 0, ok: true},
+		// The spacing is too large for the noise to be detected.
+		{f: noisy(math.Exp, 1e-12), x: 1, h: 1e-1, ok: false},
+	} {
+		noise, ok := EstimateNoise(test.f, test.x, test.h)
+		if ok != test.ok {
+			t.Errorf("Case %d: unexpected ok: got %t, want %t (noise=%v)", i, ok, test.ok, noise)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if test.sigma == 0 {
+			if noise != 0 {
+				t.Errorf("Case %d: unexpected noise for constant function: %v", i, noise)
+			}
+			continue
+		}
+		if noise < test.sigma/4 || 4*test.sigma < noise {
+			t.Errorf("Case %d: unexpected noise estimate: got %v, want about %v", i, noise, test.sigma)
+		}
+	}
+}
+
+func TestGradientSteps(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, formula := range []Formula{Forward, Central} {
+		for _, concurrent := range []bool{false, true} {
+			const n = 6
+			r := Rosenbrock{n}
+			x := make([]float64, n)
+			for i := range x {
+				// Spread the coordinates over several
+				// orders of magnitude.
+				x[i] = rnd.Float64() * math.Pow(10, float64(i-2))
+			}
+			xcopy := make([]float64, n)
+			copy(xcopy, x)
+
+			steps := GradientSteps(nil, r.F, x, &StepSettings{Formula: formula})
+			if !floats.Equal(x, xcopy) {
+				t.Errorf("x modified during call")
+			}
+			want := make([]float64, n)
+			r.FDf(x, want)
+			got := Gradient(nil, r.F, x, &Settings{
+				Formula:    formula,
+				Steps:      steps,
+				Concurrent: concurrent,
+			})
+			tol := 1e-6
+			if formula.Stencil[0].Loc == -1 {
+				tol = 1e-8
+			}
+			if !floats.EqualApprox(got, want, tol*floats.Norm(want, math.Inf(1))) {
+				t.Errorf("unexpected gradient with steps %v:\ngot  %v\nwant %v", steps, got, want)
+			}
+		}
+	}
+
+	if !Panics(func() {
+		Gradient(nil, func([]float64) float64 { return 0 }, make([]float64, 2), &Settings{Steps: []float64{1}})
+	}) {
+		t.Errorf("expected panic for mismatched Steps length")
+	}
+	if !Panics(func() {
+		Gradient(nil, func([]float64) float64 { return 0 }, make([]float64, 2), &Settings{Steps: []float64{1, 0}})
+	}) {
+		t.Errorf("expected panic for zero step")
+	}
+}
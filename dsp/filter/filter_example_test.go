@@ -0,0 +1,28 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package filter_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/dsp/filter"
+)
+
+func ExampleSavitzkyGolay() {
+	// Samples of t² taken every 0.5 time units with
+	// a measurement error at t=2.
+	x := []float64{0, 0.25, 1, 2.25, 4.5, 6.25, 9, 12.25, 16}
+
+	smooth := filter.NewSavitzkyGolay(5, 2, 0, 0.5)
+	fmt.Printf("smoothed: %.3f\n", smooth.Filter(nil, x))
+
+	// The first derivative estimates 2t.
+	deriv := filter.NewSavitzkyGolay(5, 2, 1, 0.5)
+	fmt.Printf("slope:    %.3f\n", deriv.Filter(nil, x))
+
+	// Output:
+	// smoothed: [0.043 0.179 0.957 2.421 4.243 6.421 8.957 12.179 16.043]
+	// slope:    [-0.371 0.914 2.200 3.100 4.000 4.900 5.800 7.086 8.371]
+}
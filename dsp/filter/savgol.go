@@ -0,0 +1,171 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package filter
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// SavitzkyGolay is a Savitzky–Golay filter. The filter fits a polynomial
+// by least squares to the samples in a window around each point of a
+// uniformly sampled signal and evaluates the polynomial, or one of its
+// derivatives, at the point. For points in the interior of the signal
+// this is equivalent to convolution with a fixed set of coefficients.
+//
+// See A. Savitzky and M. J. E. Golay, "Smoothing and Differentiation of
+// Data by Simplified Least Squares Procedures", Analytical Chemistry
+// 36(8), 1964.
+type SavitzkyGolay struct {
+	window int
+	order  int
+	deriv  int
+	delta  float64
+
+	// weights holds in row p the weights of the samples
+	// of a window for the estimate at position p.
+	weights *mat.Dense
+}
+
+// NewSavitzkyGolay returns a new Savitzky–Golay filter that fits
+// polynomials of the given order to windows of the given length and
+// estimates the deriv-th derivative of the signal, with deriv zero
+// corresponding to smoothing. The samples of the signal are spaced
+// by delta, which scales the estimated derivatives.
+//
+// NewSavitzkyGolay panics if window is not a positive odd number, if
+// order is negative or not less than window, if deriv is negative or
+// greater than order, or if delta is not positive.
+func NewSavitzkyGolay(window, order, deriv int, delta float64) *SavitzkyGolay {
+	if window <= 0 || window%2 == 0 {
+		panic("filter: window length not positive and odd")
+	}
+	if order < 0 || order >= window {
+		panic("filter: invalid polynomial order")
+	}
+	if deriv < 0 || deriv > order {
+		panic("filter: invalid derivative order")
+	}
+	if !(delta > 0) {
+		panic("filter: non-positive sample spacing")
+	}
+	f := &SavitzkyGolay{
+		window:  window,
+		order:   order,
+		deriv:   deriv,
+		delta:   delta,
+		weights: mat.NewDense(window, window, nil),
+	}
+	for p := 0; p < window; p++ {
+		f.weights.SetRow(p, savgolWeights(window, order, deriv, p, delta))
+	}
+	return f
+}
+
+// savgolWeights returns the weights of the samples of a window for
+// the estimate of the deriv-th derivative at position p of the window.
+func savgolWeights(window, order, deriv, p int, delta float64) []float64 {
+	// The polynomial is fitted in the scaled coordinate u = z/s,
+	// where z is the offset from p in samples, to keep the design
+	// matrix well conditioned.
+	s := float64(max(p, window-1-p, 1))
+	a := mat.NewDense(window, order+1, nil)
+	for j := 0; j < window; j++ {
+		u := float64(j-p) / s
+		v := 1.0
+		for k := 0; k <= order; k++ {
+			a.Set(j, k, v)
+			v *= u
+		}
+	}
+
+	// The estimate of the coefficient c_d of the fitted polynomial is
+	// e_dᵀ (AᵀA)⁻¹ Aᵀ y = wᵀ y, where w = A (AᵀA)⁻¹ e_d is the
+	// minimum norm solution of Aᵀ w = e_d.
+	var qr mat.QR
+	qr.Factorize(a)
+	e := mat.NewVecDense(order+1, nil)
+	e.SetVec(deriv, 1)
+	var w mat.VecDense
+	err := qr.SolveVecTo(&w, true, e)
+	if err != nil {
+		panic("filter: ill-conditioned Savitzky–Golay fit")
+	}
+
+	// The deriv-th derivative with respect to time at u = 0 is
+	// deriv! c_d / (s*delta)^deriv.
+	scale := 1.0
+	for k := 2; k <= deriv; k++ {
+		scale *= float64(k)
+	}
+	scale /= math.Pow(s*delta, float64(deriv))
+	weights := w.RawVector().Data
+	floats.Scale(scale, weights)
+	return weights
+}
+
+// Len returns the window length of the filter.
+func (f *SavitzkyGolay) Len() int {
+	return f.window
+}
+
+// Coefficients returns the weights of the samples of a window for the
+// estimate at position p of the window, storing them in dst if it is
+// not nil. The weights for the central position, p = (Len()-1)/2, are
+// the convolution coefficients applied to the interior of a signal.
+// Coefficients panics if p is out of range or if dst is not nil and its
+// length is not the window length.
+func (f *SavitzkyGolay) Coefficients(dst []float64, p int) []float64 {
+	if p < 0 || p >= f.window {
+		panic("filter: position out of range")
+	}
+	if dst == nil {
+		dst = make([]float64, f.window)
+	}
+	if len(dst) != f.window {
+		panic("filter: destination length mismatch")
+	}
+	return mat.Row(dst, p, f.weights)
+}
+
+// Filter applies the filter to the signal x, storing the result in dst
+// and returning it. If dst is nil, a new slice is allocated. Points within
+// half a window of the ends of the signal are estimated from the fit to
+// the first or last window of the signal, so the polynomials of degree at
+// most the order of the filter are reproduced exactly over the whole signal.
+//
+// Filter panics if x is shorter than the window length or if dst is not nil
+// and its length is not the length of x. dst and x must not overlap.
+func (f *SavitzkyGolay) Filter(dst, x []float64) []float64 {
+	n := len(x)
+	if n < f.window {
+		panic("filter: signal shorter than window")
+	}
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n {
+		panic("filter: destination length mismatch")
+	}
+	half := f.window / 2
+	raw := f.weights.RawMatrix()
+	row := func(p int) []float64 {
+		return raw.Data[p*raw.Stride : p*raw.Stride+f.window]
+	}
+	for i := 0; i < half; i++ {
+		dst[i] = floats.Dot(row(i), x[:f.window])
+	}
+	center := row(half)
+	for i := half; i < n-half; i++ {
+		dst[i] = floats.Dot(center, x[i-half:i+half+1])
+	}
+	last := x[n-f.window:]
+	for i := n - half; i < n; i++ {
+		dst[i] = floats.Dot(row(i-(n-f.window)), last)
+	}
+	return dst
+}
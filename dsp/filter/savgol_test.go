@@ -0,0 +1,139 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package filter
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestSavitzkyGolayCoefficients(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		window, order, deriv int
+		delta                float64
+		want                 []float64
+	}{
+		// Tabulated values from Savitzky and Golay (1964).
+		{window: 5, order: 2, deriv: 0, delta: 1, want: []float64{-3.0 / 35, 12.0 / 35, 17.0 / 35, 12.0 / 35, -3.0 / 35}},
+		{window: 5, order: 3, deriv: 0, delta: 1, want: []float64{-3.0 / 35, 12.0 / 35, 17.0 / 35, 12.0 / 35, -3.0 / 35}},
+		{window: 7, order: 2, deriv: 0, delta: 1, want: []float64{-2.0 / 21, 3.0 / 21, 6.0 / 21, 7.0 / 21, 6.0 / 21, 3.0 / 21, -2.0 / 21}},
+		{window: 5, order: 2, deriv: 1, delta: 1, want: []float64{-2.0 / 10, -1.0 / 10, 0, 1.0 / 10, 2.0 / 10}},
+		{window: 5, order: 2, deriv: 1, delta: 0.5, want: []float64{-4.0 / 10, -2.0 / 10, 0, 2.0 / 10, 4.0 / 10}},
+		{window: 5, order: 2, deriv: 2, delta: 1, want: []float64{2.0 / 7, -1.0 / 7, -2.0 / 7, -1.0 / 7, 2.0 / 7}},
+		{window: 3, order: 0, deriv: 0, delta: 1, want: []float64{1.0 / 3, 1.0 / 3, 1.0 / 3}},
+		{window: 1, order: 0, deriv: 0, delta: 1, want: []float64{1}},
+	} {
+		f := NewSavitzkyGolay(test.window, test.order, test.deriv, test.delta)
+		got := f.Coefficients(nil, test.window/2)
+		if !floats.EqualApprox(got, test.want, 1e-14) {
+			t.Errorf("unexpected coefficients for window=%d order=%d deriv=%d: got:%v want:%v",
+				test.window, test.order, test.deriv, got, test.want)
+		}
+	}
+}
+
+func TestSavitzkyGolayPolynomial(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		window, order int
+		delta         float64
+	}{
+		{window: 5, order: 2, delta: 1},
+		{window: 7, order: 3, delta: 0.1},
+		{window: 11, order: 4, delta: 0.01},
+		{window: 21, order: 5, delta: 0.2},
+		{window: 9, order: 8, delta: 1},
+	} {
+		// A random polynomial of the order of the filter is reproduced
+		// exactly, along with its derivatives, including at the ends
+		// of the signal.
+		coef := make([]float64, test.order+1)
+		for i := range coef {
+			coef[i] = rnd.NormFloat64()
+		}
+		const n = 50
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = poly(coef, 0, float64(i-n/2)*test.delta)
+		}
+		for deriv := 0; deriv <= test.order; deriv++ {
+			f := NewSavitzkyGolay(test.window, test.order, deriv, test.delta)
+			got := f.Filter(nil, x)
+			for i, v := range got {
+				want := poly(coef, deriv, float64(i-n/2)*test.delta)
+				if !scalar.EqualWithinAbsOrRel(v, want, 1e-6, 1e-6) {
+					t.Errorf("unexpected derivative %d at %d for window=%d order=%d: got:%v want:%v",
+						deriv, i, test.window, test.order, v, want)
+					break
+				}
+			}
+		}
+	}
+}
+
+// poly returns the deriv-th derivative of the polynomial with the given
+// coefficients in increasing order of degree evaluated at x.
+func poly(coef []float64, deriv int, x float64) float64 {
+	var sum float64
+	for k := len(coef) - 1; k >= deriv; k-- {
+		c := coef[k]
+		for j := 0; j < deriv; j++ {
+			c *= float64(k - j)
+		}
+		sum = sum*x + c
+	}
+	return sum
+}
+
+func TestSavitzkyGolaySmoothing(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 1000
+	clean := make([]float64, n)
+	noisy := make([]float64, n)
+	for i := range clean {
+		clean[i] = math.Sin(2 * math.Pi * float64(i) / 200)
+		noisy[i] = clean[i] + 0.1*rnd.NormFloat64()
+	}
+	f := NewSavitzkyGolay(31, 3, 0, 1)
+	smooth := f.Filter(nil, noisy)
+	before := floats.Distance(noisy, clean, 2)
+	after := floats.Distance(smooth, clean, 2)
+	if after > before/2 {
+		t.Errorf("insufficient noise reduction: error before:%v after:%v", before, after)
+	}
+}
+
+func TestSavitzkyGolayPanics(t *testing.T) {
+	t.Parallel()
+	for _, fn := range []func(){
+		func() { NewSavitzkyGolay(4, 2, 0, 1) },
+		func() { NewSavitzkyGolay(0, 0, 0, 1) },
+		func() { NewSavitzkyGolay(5, 5, 0, 1) },
+		func() { NewSavitzkyGolay(5, 2, 3, 1) },
+		func() { NewSavitzkyGolay(5, 2, 0, 0) },
+		func() { NewSavitzkyGolay(5, 2, 0, 1).Filter(nil, make([]float64, 4)) },
+		func() { NewSavitzkyGolay(5, 2, 0, 1).Filter(make([]float64, 5), make([]float64, 6)) },
+		func() { NewSavitzkyGolay(5, 2, 0, 1).Coefficients(nil, 5) },
+	} {
+		if !panics(fn) {
+			t.Error("expected panic")
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
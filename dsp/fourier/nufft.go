@@ -0,0 +1,238 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fourier
+
+import "math"
+
+// NUFFT implements non-uniform Fast Fourier Transforms of type 1 and type 2
+// for complex sequences sampled at non-equispaced points.
+//
+// The transforms are computed by Gaussian gridding onto an oversampled
+// uniform grid followed by a uniform FFT and deconvolution, as described in
+// Greengard and Lee, "Accelerating the Nonuniform Fast Fourier Transform",
+// SIAM Review 46(3):443-454 (2004). The cost of a transform of m points into
+// n modes is O(m log(1/tol) + n log n).
+//
+// Sample locations are interpreted as angles in radians and are taken modulo
+// 2π. Fourier modes are ordered in the same way as the coefficients returned
+// by CmplxFFT; coefficient i corresponds to the integer wavenumber returned
+// by Mode(i).
+type NUFFT struct {
+	n  int // number of Fourier modes
+	m  int // length of the oversampled grid
+	sp int // half-width of the spreading kernel
+
+	tau    float64
+	fft    *CmplxFFT
+	grid   []complex128
+	deconv []float64
+	e3     []float64
+}
+
+// NewNUFFT returns a NUFFT initialized for work on n Fourier modes with
+// a requested relative accuracy of tol. NewNUFFT will panic if n is less
+// than 1 or if tol is not in the interval (0, 1).
+func NewNUFFT(n int, tol float64) *NUFFT {
+	var t NUFFT
+	t.Reset(n, tol)
+	return &t
+}
+
+// Len returns the number of Fourier modes handled by the transform.
+func (t *NUFFT) Len() int { return t.n }
+
+// Reset reinitializes the NUFFT for work on n Fourier modes with a requested
+// relative accuracy of tol. Reset will panic if n is less than 1 or if tol is
+// not in the interval (0, 1).
+func (t *NUFFT) Reset(n int, tol float64) {
+	if n < 1 {
+		panic("fourier: n less than 1")
+	}
+	if !(0 < tol && tol < 1) {
+		panic("fourier: tolerance out of range")
+	}
+
+	// The oversampling ratio, r, is nominally 2 and the kernel
+	// half-width is chosen following the error analysis of
+	// Greengard and Lee, section 2. Short transforms are
+	// oversampled further so the grid can hold the kernel.
+	const r = 2
+	sp := int(math.Ceil(-math.Log(tol) * (r - 0.5) / (math.Pi * (r - 1))))
+	sp = max(sp, 2)
+	m := max(r*n, 2*sp+2)
+
+	t.n = n
+	t.m = m
+	t.sp = sp
+	rm := float64(m) / float64(n)
+	t.tau = math.Pi * float64(sp) / (float64(n) * float64(n) * rm * (rm - 0.5))
+	if t.fft == nil {
+		t.fft = NewCmplxFFT(m)
+	} else {
+		t.fft.Reset(m)
+	}
+	t.grid = reuseComplex(t.grid, m)
+	t.deconv = reuseFloat(t.deconv, n)
+	for i := range t.deconv {
+		k := float64(t.Mode(i))
+		t.deconv[i] = math.Sqrt(math.Pi/t.tau) * math.Exp(k*k*t.tau)
+	}
+	t.e3 = reuseFloat(t.e3, sp+1)
+	h := 2 * math.Pi / float64(m)
+	for l := range t.e3 {
+		t.e3[l] = math.Exp(-(float64(l) * h) * (float64(l) * h) / (4 * t.tau))
+	}
+}
+
+// Mode returns the integer wavenumber of coefficient i. Mode will panic
+// if i is negative or greater than or equal to t.Len().
+func (t *NUFFT) Mode(i int) int {
+	if i < 0 || t.n <= i {
+		panic("fourier: index out of range")
+	}
+	if i < (t.n-1)/2+1 {
+		return i
+	}
+	return i - t.n
+}
+
+// Coefficients computes the type 1 (non-uniform to uniform) transform
+//
+//	dst[i] = \sum_j seq[j] exp(-i k x[j])
+//
+// where k = t.Mode(i), placing the result in dst and returning it. When x
+// holds the equispaced points 2πj/t.Len(), Coefficients computes the same
+// transform as CmplxFFT.Coefficients.
+//
+// If the lengths of x and seq differ, Coefficients will panic.
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal t.Len(), Coefficients will panic.
+func (t *NUFFT) Coefficients(dst []complex128, x []float64, seq []complex128) []complex128 {
+	if len(x) != len(seq) {
+		panic("fourier: sequence length mismatch")
+	}
+	if dst == nil {
+		dst = make([]complex128, t.n)
+	} else if len(dst) != t.n {
+		panic("fourier: destination length mismatch")
+	}
+
+	for i := range t.grid {
+		t.grid[i] = 0
+	}
+	w := make([]float64, 2*t.sp)
+	for j, xj := range x {
+		m0 := t.weights(w, xj)
+		c := seq[j]
+		for l, wl := range w {
+			t.grid[t.wrap(m0+l-t.sp+1)] += c * complex(wl, 0)
+		}
+	}
+
+	t.fft.Coefficients(t.grid, t.grid)
+	scale := 1 / float64(t.m)
+	for i := range dst {
+		dst[i] = t.grid[t.wrap(t.Mode(i))] * complex(t.deconv[i]*scale, 0)
+	}
+	return dst
+}
+
+// Sequence computes the type 2 (uniform to non-uniform) transform
+//
+//	dst[j] = \sum_i coeff[i] exp(i k x[j])
+//
+// where k = t.Mode(i), placing the result in dst and returning it. When x
+// holds the equispaced points 2πj/t.Len(), Sequence computes the same
+// transform as CmplxFFT.Sequence.
+//
+// If the length of coeff is not t.Len(), Sequence will panic.
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal the length of x, Sequence will panic.
+func (t *NUFFT) Sequence(dst []complex128, x []float64, coeff []complex128) []complex128 {
+	if len(coeff) != t.n {
+		panic("fourier: coefficients length mismatch")
+	}
+	if dst == nil {
+		dst = make([]complex128, len(x))
+	} else if len(dst) != len(x) {
+		panic("fourier: destination length mismatch")
+	}
+
+	for i := range t.grid {
+		t.grid[i] = 0
+	}
+	for i, c := range coeff {
+		t.grid[t.wrap(t.Mode(i))] = c * complex(t.deconv[i], 0)
+	}
+	t.fft.Sequence(t.grid, t.grid)
+
+	w := make([]float64, 2*t.sp)
+	scale := complex(1/float64(t.m), 0)
+	for j, xj := range x {
+		m0 := t.weights(w, xj)
+		var sum complex128
+		for l, wl := range w {
+			sum += t.grid[t.wrap(m0+l-t.sp+1)] * complex(wl, 0)
+		}
+		dst[j] = sum * scale
+	}
+	return dst
+}
+
+// weights fills w with the periodized Gaussian kernel weights for the
+// 2*t.sp grid points nearest to x and returns the index of the grid
+// point at or immediately below x. The weight for grid point m0+l-t.sp+1
+// is stored in w[l].
+func (t *NUFFT) weights(w []float64, x float64) (m0 int) {
+	h := 2 * math.Pi / float64(t.m)
+	x = math.Mod(x, 2*math.Pi)
+	if x < 0 {
+		x += 2 * math.Pi
+	}
+	m0 = int(x / h)
+	if m0 >= t.m {
+		m0 = t.m - 1
+	}
+	d := x - float64(m0)*h
+
+	// Fast Gaussian gridding: the kernel value at offset o from
+	// grid point m0 factors into e1 * e2^o * e3[|o|].
+	e1 := math.Exp(-d * d / (4 * t.tau))
+	e2 := math.Exp(math.Pi * d / (float64(t.m) * t.tau))
+	f := e1
+	for o := 0; o <= t.sp; o++ {
+		w[o+t.sp-1] = f * t.e3[o]
+		f *= e2
+	}
+	f = e1
+	for o := 1; o < t.sp; o++ {
+		f /= e2
+		w[t.sp-1-o] = f * t.e3[o]
+	}
+	return m0
+}
+
+// wrap returns i modulo the oversampled grid length.
+func (t *NUFFT) wrap(i int) int {
+	i %= t.m
+	if i < 0 {
+		i += t.m
+	}
+	return i
+}
+
+func reuseComplex(s []complex128, n int) []complex128 {
+	if n <= cap(s) {
+		return s[:n]
+	}
+	return make([]complex128, n)
+}
+
+func reuseFloat(s []float64, n int) []float64 {
+	if n <= cap(s) {
+		return s[:n]
+	}
+	return make([]float64, n)
+}
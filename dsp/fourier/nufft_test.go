@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fourier
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestNUFFT(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 7, 16, 31, 100} {
+		for _, m := range []int{1, 5, 50, 200} {
+			for _, tol := range []float64{1e-4, 1e-8, 1e-12} {
+				name := fmt.Sprintf("n=%d m=%d tol=%g", n, m, tol)
+				nufft := NewNUFFT(n, tol)
+
+				x := make([]float64, m)
+				for i := range x {
+					x[i] = 6 * math.Pi * (rnd.Float64() - 0.5)
+				}
+				c := randComplexes(m, rnd)
+				got := nufft.Coefficients(nil, x, c)
+				want := naiveNUFFT1(nufft, x, c)
+				if err := relErr(got, want, c); err > tol {
+					t.Errorf("unexpected type 1 error for %s: got:%g", name, err)
+				}
+
+				f := randComplexes(n, rnd)
+				got = nufft.Sequence(nil, x, f)
+				want = naiveNUFFT2(nufft, x, f)
+				if err := relErr(got, want, f); err > tol {
+					t.Errorf("unexpected type 2 error for %s: got:%g", name, err)
+				}
+			}
+		}
+	}
+}
+
+func TestNUFFTUniform(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	nufft := NewNUFFT(1, tol)
+	for _, n := range []int{1, 4, 9, 64, 101} {
+		nufft.Reset(n, tol)
+		fft := NewCmplxFFT(n)
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = 2 * math.Pi * float64(i) / float64(n)
+		}
+		seq := randComplexes(n, rnd)
+
+		got := nufft.Coefficients(nil, x, seq)
+		want := fft.Coefficients(nil, seq)
+		if !equalApprox(got, want, 1e-9*float64(n)) {
+			t.Errorf("unexpected result for uniform type 1 transform of length %d", n)
+		}
+
+		got = nufft.Sequence(nil, x, seq)
+		want = fft.Sequence(nil, seq)
+		if !equalApprox(got, want, 1e-9*float64(n)) {
+			t.Errorf("unexpected result for uniform type 2 transform of length %d", n)
+		}
+	}
+}
+
+func naiveNUFFT1(t *NUFFT, x []float64, c []complex128) []complex128 {
+	dst := make([]complex128, t.Len())
+	for i := range dst {
+		k := float64(t.Mode(i))
+		for j, xj := range x {
+			dst[i] += c[j] * cmplx.Exp(complex(0, -k*xj))
+		}
+	}
+	return dst
+}
+
+func naiveNUFFT2(t *NUFFT, x []float64, f []complex128) []complex128 {
+	dst := make([]complex128, len(x))
+	for j, xj := range x {
+		for i, fi := range f {
+			k := float64(t.Mode(i))
+			dst[j] += fi * cmplx.Exp(complex(0, k*xj))
+		}
+	}
+	return dst
+}
+
+// relErr returns the maximum absolute error between got and want
+// relative to the l1 norm of the transformed input.
+func relErr(got, want, in []complex128) float64 {
+	var norm float64
+	for _, v := range in {
+		norm += cmplx.Abs(v)
+	}
+	var err float64
+	for i := range got {
+		err = math.Max(err, cmplx.Abs(got[i]-want[i]))
+	}
+	return err / norm
+}
+
+func BenchmarkNUFFTCoefficients(b *testing.B) {
+	for _, n := range []int{64, 1024, 16384} {
+		nufft := NewNUFFT(n, 1e-10)
+		rnd := rand.New(rand.NewPCG(1, 1))
+		x := make([]float64, 4*n)
+		for i := range x {
+			x[i] = 2 * math.Pi * rnd.Float64()
+		}
+		c := randComplexes(len(x), rnd)
+		dst := make([]complex128, n)
+
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				nufft.Coefficients(dst, x, c)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package spectral provides spectral analysis of signals that are not
+// suited to the FFT based tools of the fourier package, such as unevenly
+// sampled time series.
+package spectral // import "gonum.org/v1/gonum/dsp/spectral"
@@ -0,0 +1,214 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral
+
+import (
+	"math"
+	"slices"
+)
+
+// LombScargle is the generalized Lomb–Scargle periodogram of an unevenly
+// sampled time series. The power at a frequency is the fraction of the
+// weighted variance of the series explained by the least squares fit of
+// a sinusoid of that frequency with a floating mean,
+//
+//	y(t) = a cos(2πft) + b sin(2πft) + c,
+//
+// and so lies in [0, 1].
+//
+// See M. Zechmeister and M. Kürster, "The generalised Lomb-Scargle
+// periodogram", Astronomy & Astrophysics 496, 2009.
+type LombScargle struct {
+	t, y, w []float64
+
+	// yy is the weighted variance of y.
+	yy float64
+}
+
+// NewLombScargle returns the periodogram of the series of observations y
+// at the times t. If dy is not nil, it holds the measurement uncertainties
+// of the observations, which are weighted by the inverse of their
+// variance. Otherwise all observations are weighted equally. The slices
+// are retained and must not be modified while the periodogram is in use.
+//
+// NewLombScargle panics if the lengths of t, y and a non-nil dy differ,
+// if there are fewer than four observations, if an element of dy is not
+// positive, or if y is constant.
+func NewLombScargle(t, y, dy []float64) *LombScargle {
+	n := len(t)
+	if len(y) != n || (dy != nil && len(dy) != n) {
+		panic("spectral: length mismatch")
+	}
+	if n < 4 {
+		panic("spectral: too few observations")
+	}
+	w := make([]float64, n)
+	var sum float64
+	for i := range w {
+		w[i] = 1
+		if dy != nil {
+			if !(dy[i] > 0) {
+				panic("spectral: non-positive uncertainty")
+			}
+			w[i] = 1 / (dy[i] * dy[i])
+		}
+		sum += w[i]
+	}
+	var mean float64
+	for i := range w {
+		w[i] /= sum
+		mean += w[i] * y[i]
+	}
+	var yy float64
+	for i, v := range y {
+		d := v - mean
+		yy += w[i] * d * d
+	}
+	if yy == 0 {
+		panic("spectral: constant series")
+	}
+	return &LombScargle{t: t, y: y, w: w, yy: yy}
+}
+
+// Power returns the normalized power of the periodogram at the frequency
+// f, in cycles per unit of time.
+func (ls *LombScargle) Power(f float64) float64 {
+	omega := 2 * math.Pi * f
+	var (
+		ym             float64
+		c, s           float64
+		yc, ys         float64
+		cc, ss, cs     float64
+		sinOmt, cosOmt float64
+	)
+	for i, t := range ls.t {
+		w := ls.w[i]
+		y := ls.y[i]
+		sinOmt, cosOmt = math.Sincos(omega * t)
+		wc := w * cosOmt
+		ws := w * sinOmt
+		ym += w * y
+		c += wc
+		s += ws
+		yc += wc * y
+		ys += ws * y
+		cc += wc * cosOmt
+		ss += ws * sinOmt
+		cs += wc * sinOmt
+	}
+	yc -= ym * c
+	ys -= ym * s
+	cc -= c * c
+	ss -= s * s
+	cs -= c * s
+	d := cc*ss - cs*cs
+	if d <= 0 {
+		// The sinusoids are indistinguishable from the mean
+		// at the sample times, so no variance is explained.
+		return 0
+	}
+	p := (ss*yc*yc + cc*ys*ys - 2*cs*yc*ys) / (ls.yy * d)
+	return math.Min(math.Max(p, 0), 1)
+}
+
+// Periodogram returns the normalized powers at the frequencies in freqs,
+// storing them in dst if it is not nil. Periodogram panics if dst is not
+// nil and its length is not the length of freqs.
+func (ls *LombScargle) Periodogram(dst, freqs []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(freqs))
+	}
+	if len(dst) != len(freqs) {
+		panic("spectral: destination length mismatch")
+	}
+	for i, f := range freqs {
+		dst[i] = ls.Power(f)
+	}
+	return dst
+}
+
+// Prob returns the probability that the power at a single frequency
+// chosen in advance exceeds p when the observations are Gaussian noise,
+//
+//	P(power > p) = (1-p)^((N-3)/2),
+//
+// where N is the number of observations.
+func (ls *LombScargle) Prob(p float64) float64 {
+	if p <= 0 {
+		return 1
+	}
+	if p >= 1 {
+		return 0
+	}
+	return math.Pow(1-p, 0.5*float64(len(ls.t)-3))
+}
+
+// FalseAlarm returns an upper bound estimate of the false alarm
+// probability of the highest power p of the periodogram searched over the
+// frequencies up to fmax, the probability that Gaussian noise gives a
+// power greater than p at some frequency up to fmax. The estimate is
+// accurate for small probabilities.
+//
+// The bound is that of R. V. Baluev, "Assessing the statistical
+// significance of periodogram peaks", Monthly Notices of the Royal
+// Astronomical Society 385(3), 2008, which accounts for the correlation
+// between the powers at nearby frequencies.
+func (ls *LombScargle) FalseAlarm(p, fmax float64) float64 {
+	if p <= 0 {
+		return 1
+	}
+	if p >= 1 {
+		return 0
+	}
+	n := float64(len(ls.t))
+	nh := n - 1 // Degrees of freedom of the null hypothesis.
+	nk := n - 3 // Degrees of freedom of the sinusoidal model.
+
+	// The effective time baseline is derived from the weighted
+	// variance of the observation times.
+	var mean, dt float64
+	for i, t := range ls.t {
+		mean += ls.w[i] * t
+	}
+	for i, t := range ls.t {
+		d := t - mean
+		dt += ls.w[i] * d * d
+	}
+	width := fmax * math.Sqrt(4*math.Pi*dt)
+
+	lg0, _ := math.Lgamma(nh / 2)
+	lg1, _ := math.Lgamma((nh - 1) / 2)
+	gamma := math.Sqrt(2/nh) * math.Exp(lg0-lg1)
+	tau := gamma * width * math.Pow(1-p, 0.5*(nk-1)) * math.Sqrt(0.5*nh*p)
+
+	// 1 - (1-Prob(p)) exp(-tau), computed to retain
+	// accuracy for small probabilities.
+	prob := ls.Prob(p)
+	fap := prob - math.Expm1(-tau)*(1-prob)
+	return math.Min(fap, 1)
+}
+
+// Frequencies returns a grid of frequencies suitable for evaluating the
+// periodogram of observations at the times t. The grid extends from the
+// spacing df = 1/(oversample*T), where T is the time span of t, up to fmax
+// in steps of df. An oversampling factor of about 5 to 10 resolves the
+// peaks of the periodogram, which have widths of about 1/T. Frequencies
+// panics if oversample or fmax is not positive or if t spans no time.
+func Frequencies(t []float64, oversample, fmax float64) []float64 {
+	if !(oversample > 0) || !(fmax > 0) {
+		panic("spectral: non-positive frequency grid parameter")
+	}
+	span := slices.Max(t) - slices.Min(t)
+	if !(span > 0) {
+		panic("spectral: zero time span")
+	}
+	df := 1 / (oversample * span)
+	n := int(fmax / df)
+	freqs := make([]float64, n)
+	for i := range freqs {
+		freqs[i] = float64(i+1) * df
+	}
+	return freqs
+}
@@ -0,0 +1,194 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// randomTimes returns n sorted random observation times in [0, span).
+func randomTimes(rnd *rand.Rand, n int, span float64) []float64 {
+	t := make([]float64, n)
+	for i := range t {
+		t[i] = rnd.Float64() * span
+	}
+	slices.Sort(t)
+	return t
+}
+
+// explained returns the fraction of the weighted variance of y explained
+// by the weighted least squares fit of a sinusoid of frequency f with a
+// constant offset.
+func explained(t, y, dy []float64, f float64) float64 {
+	n := len(t)
+	a := mat.NewDense(n, 3, nil)
+	b := mat.NewVecDense(n, nil)
+	c := mat.NewDense(n, 1, nil)
+	for i := range t {
+		w := 1.0
+		if dy != nil {
+			w = 1 / dy[i]
+		}
+		sin, cos := math.Sincos(2 * math.Pi * f * t[i])
+		a.SetRow(i, []float64{w * cos, w * sin, w})
+		b.SetVec(i, w*y[i])
+		c.Set(i, 0, w)
+	}
+	// Residual sum of squares of the full model and of the mean alone.
+	rss := func(a mat.Matrix) float64 {
+		var x, r mat.VecDense
+		err := x.SolveVec(a, b)
+		if err != nil {
+			panic(err)
+		}
+		r.MulVec(a, &x)
+		r.SubVec(b, &r)
+		return mat.Dot(&r, &r)
+	}
+	return 1 - rss(a)/rss(c)
+}
+
+func TestLombScarglePower(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, weighted := range []bool{false, true} {
+		for i := 0; i < 20; i++ {
+			n := 10 + rnd.IntN(50)
+			ts := randomTimes(rnd, n, 100)
+			y := make([]float64, n)
+			var dy []float64
+			if weighted {
+				dy = make([]float64, n)
+			}
+			for j := range y {
+				y[j] = 2 + math.Sin(2*math.Pi*0.13*ts[j]+1) + rnd.NormFloat64()
+				if weighted {
+					dy[j] = 0.5 + rnd.Float64()
+				}
+			}
+			ls := NewLombScargle(ts, y, dy)
+			for _, f := range []float64{0.01, 0.05, 0.13, 0.3, 1.7} {
+				got := ls.Power(f)
+				want := explained(ts, y, dy, f)
+				if !scalar.EqualWithinAbs(got, want, 1e-10) {
+					t.Errorf("unexpected power at f=%v for n=%d weighted=%t: got:%v want:%v", f, n, weighted, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestLombScarglePeak(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const (
+		n  = 80
+		f0 = 0.37
+	)
+	ts := randomTimes(rnd, n, 50)
+	y := make([]float64, n)
+	for i, v := range ts {
+		y[i] = 3*math.Cos(2*math.Pi*f0*v) + 0.5*rnd.NormFloat64()
+	}
+	ls := NewLombScargle(ts, y, nil)
+	freqs := Frequencies(ts, 10, 2)
+	p := ls.Periodogram(nil, freqs)
+	peak := floats.MaxIdx(p)
+	if math.Abs(freqs[peak]-f0) > 1/(10*(ts[n-1]-ts[0])) {
+		t.Errorf("unexpected peak frequency: got:%v want:%v", freqs[peak], f0)
+	}
+	if p[peak] < 0.9 {
+		t.Errorf("unexpected peak power: got:%v want >0.9", p[peak])
+	}
+	if fap := ls.FalseAlarm(p[peak], 2); fap > 1e-10 {
+		t.Errorf("unexpected false alarm probability of peak: %v", fap)
+	}
+
+	// A noiseless sinusoid is fitted exactly.
+	for i, v := range ts {
+		y[i] = 1 + math.Sin(2*math.Pi*f0*v)
+	}
+	if got := NewLombScargle(ts, y, nil).Power(f0); !scalar.EqualWithinAbs(got, 1, 1e-12) {
+		t.Errorf("unexpected power of noiseless sinusoid: got:%v want:1", got)
+	}
+}
+
+func TestLombScargleFalseAlarm(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const (
+		n      = 40
+		trials = 300
+		fmax   = 2.0
+		alpha  = 0.1
+	)
+	ts := randomTimes(rnd, n, 20)
+	freqs := Frequencies(ts, 5, fmax)
+	y := make([]float64, n)
+	var false1, falseAlarm int
+	for i := 0; i < trials; i++ {
+		for j := range y {
+			y[j] = rnd.NormFloat64()
+		}
+		ls := NewLombScargle(ts, y, nil)
+		p := ls.Periodogram(nil, freqs)
+		if ls.Prob(p[len(p)/2]) < alpha {
+			false1++
+		}
+		if ls.FalseAlarm(floats.Max(p), fmax) < alpha {
+			falseAlarm++
+		}
+	}
+	// The single frequency probability is exact, and the false
+	// alarm probability is an upper bound.
+	if got := float64(false1) / trials; math.Abs(got-alpha) > 0.05 {
+		t.Errorf("unexpected single frequency false positive rate: got:%v want:%v", got, alpha)
+	}
+	if got := float64(falseAlarm) / trials; got > alpha+0.03 || got < alpha/3 {
+		t.Errorf("unexpected false alarm rate: got:%v want:≤%v", got, alpha)
+	}
+
+	// The estimate decreases with power where it is informative.
+	ls := NewLombScargle(ts, y, nil)
+	prev := 1.0
+	for p := 0.0; p <= 1; p += 0.01 {
+		fap := ls.FalseAlarm(p, fmax)
+		if (fap < 0.5 && fap > prev) || fap < ls.Prob(p) || fap < 0 || fap > 1 {
+			t.Errorf("unexpected false alarm probability at %v: %v", p, fap)
+		}
+		prev = fap
+	}
+}
+
+func TestLombScarglePanics(t *testing.T) {
+	t.Parallel()
+	for _, fn := range []func(){
+		func() { NewLombScargle([]float64{1, 2, 3, 4}, []float64{1, 2, 3}, nil) },
+		func() { NewLombScargle([]float64{1, 2, 3}, []float64{1, 2, 3}, nil) },
+		func() { NewLombScargle([]float64{1, 2, 3, 4}, []float64{1, 2, 3, 4}, []float64{1, 1, 0, 1}) },
+		func() { NewLombScargle([]float64{1, 2, 3, 4}, []float64{1, 1, 1, 1}, nil) },
+		func() { Frequencies([]float64{1, 1}, 5, 1) },
+		func() { Frequencies([]float64{1, 2}, 0, 1) },
+	} {
+		if !panics(fn) {
+			t.Error("expected panic")
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
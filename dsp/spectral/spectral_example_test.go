@@ -0,0 +1,43 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/dsp/spectral"
+	"gonum.org/v1/gonum/floats"
+)
+
+func ExampleLombScargle() {
+	// Observations of a noisy signal with a period of 2.5 days
+	// made at irregular times over 30 days.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	t := make([]float64, 60)
+	for i := range t {
+		t[i] = 30 * rnd.Float64()
+	}
+	slices.Sort(t)
+	y := make([]float64, len(t))
+	for i, v := range t {
+		y[i] = 10 + math.Sin(2*math.Pi*v/2.5) + 0.5*rnd.NormFloat64()
+	}
+
+	const fmax = 2 // Cycles per day.
+	ls := spectral.NewLombScargle(t, y, nil)
+	freqs := spectral.Frequencies(t, 10, fmax)
+	power := ls.Periodogram(nil, freqs)
+	peak := floats.MaxIdx(power)
+
+	fmt.Printf("period: %.2f days\n", 1/freqs[peak])
+	fmt.Printf("false alarm probability: %.1e\n", ls.FalseAlarm(power[peak], fmax))
+
+	// Output:
+	// period: 2.49 days
+	// false alarm probability: 1.5e-08
+}
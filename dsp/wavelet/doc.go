@@ -0,0 +1,15 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package wavelet provides functions to perform Discrete Wavelet Transforms
+// using orthogonal wavelets.
+//
+// The transforms provided by the package use periodic extension of the
+// input sequence so that a transform of a sequence of length n yields
+// exactly n coefficients and the inverse transform reconstructs the
+// sequence exactly up to rounding error.
+//
+// See https://en.wikipedia.org/wiki/Discrete_wavelet_transform for more
+// details.
+package wavelet // import "gonum.org/v1/gonum/dsp/wavelet"
@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wavelet
+
+// DWT implements multi-level Discrete Wavelet Transforms and their inverses
+// for real sequences.
+//
+// The coefficients of a transform are held in a single slice of the same
+// length as the transformed sequence. For a transform of length n with L
+// levels, the first n/2^L elements hold the approximation coefficients of
+// the coarsest level, followed by the detail coefficients of each level from
+// the coarsest to the finest. The Approximation and Detail methods return
+// views into a coefficient slice for each of these parts.
+type DWT struct {
+	w      Wavelet
+	n      int
+	levels int
+
+	lo, hi []float64
+	work   []float64
+}
+
+// NewDWT returns a DWT initialized for work on sequences of length n
+// decomposed into the given number of levels using the wavelet w.
+// NewDWT will panic if levels is less than 1 or if n is not a positive
+// multiple of 2^levels.
+func NewDWT(w Wavelet, n, levels int) *DWT {
+	var t DWT
+	t.Reset(w, n, levels)
+	return &t
+}
+
+// MaxLevels returns the largest number of levels that a DWT may use for
+// sequences of length n. MaxLevels will panic if n is not positive.
+func MaxLevels(n int) int {
+	if n < 1 {
+		panic("wavelet: n less than 1")
+	}
+	var l int
+	for n%2 == 0 {
+		n /= 2
+		l++
+	}
+	return l
+}
+
+// Len returns the length of the acceptable input.
+func (t *DWT) Len() int { return t.n }
+
+// Levels returns the number of decomposition levels of the transform.
+func (t *DWT) Levels() int { return t.levels }
+
+// Wavelet returns the wavelet used by the transform.
+func (t *DWT) Wavelet() Wavelet { return t.w }
+
+// Reset reinitializes the DWT for work on sequences of length n decomposed
+// into the given number of levels using the wavelet w.
+// Reset will panic if levels is less than 1 or if n is not a positive
+// multiple of 2^levels.
+func (t *DWT) Reset(w Wavelet, n, levels int) {
+	if levels < 1 {
+		panic("wavelet: levels less than 1")
+	}
+	if n < 1 || levels > MaxLevels(n) {
+		panic("wavelet: length not a multiple of 2^levels")
+	}
+	t.w = w
+	t.n = n
+	t.levels = levels
+	t.lo = w.LowPass(reuse(t.lo, w.Len()))
+	t.hi = w.HighPass(reuse(t.hi, w.Len()))
+	t.work = reuse(t.work, n)
+}
+
+// Coefficients computes the wavelet coefficients of the input sequence,
+// placing the result in dst and returning it. The transform is orthonormal;
+// the sum of squares of the coefficients equals the sum of squares of the
+// sequence.
+//
+// If the length of seq is not t.Len(), Coefficients will panic.
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal t.Len(), Coefficients will panic.
+// It is safe to use the same slice for dst and seq.
+func (t *DWT) Coefficients(dst, seq []float64) []float64 {
+	if len(seq) != t.n {
+		panic("wavelet: sequence length mismatch")
+	}
+	if dst == nil {
+		dst = make([]float64, t.n)
+	} else if len(dst) != t.n {
+		panic("wavelet: destination length mismatch")
+	}
+	copy(dst, seq)
+	for n := t.n; n > t.n>>t.levels; n /= 2 {
+		t.analyze(dst[:n])
+	}
+	return dst
+}
+
+// Sequence computes the real sequence from the wavelet coefficients,
+// placing the result in dst and returning it. Sequence is the inverse
+// of Coefficients.
+//
+// If the length of coeff is not t.Len(), Sequence will panic.
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal t.Len(), Sequence will panic.
+// It is safe to use the same slice for dst and coeff.
+func (t *DWT) Sequence(dst, coeff []float64) []float64 {
+	if len(coeff) != t.n {
+		panic("wavelet: coefficients length mismatch")
+	}
+	if dst == nil {
+		dst = make([]float64, t.n)
+	} else if len(dst) != t.n {
+		panic("wavelet: destination length mismatch")
+	}
+	copy(dst, coeff)
+	for n := (t.n >> t.levels) * 2; n <= t.n; n *= 2 {
+		t.synthesize(dst[:n])
+	}
+	return dst
+}
+
+// Approximation returns the slice of coeff holding the approximation
+// coefficients of the coarsest level. The returned slice shares its
+// backing data with coeff. Approximation will panic if the length of
+// coeff is not t.Len().
+func (t *DWT) Approximation(coeff []float64) []float64 {
+	if len(coeff) != t.n {
+		panic("wavelet: coefficients length mismatch")
+	}
+	return coeff[:t.n>>t.levels]
+}
+
+// Detail returns the slice of coeff holding the detail coefficients of
+// the given level, where level 1 is the finest level and t.Levels() is the
+// coarsest. The returned slice shares its backing data with coeff.
+// Detail will panic if the length of coeff is not t.Len() or if level is
+// out of range.
+func (t *DWT) Detail(coeff []float64, level int) []float64 {
+	if len(coeff) != t.n {
+		panic("wavelet: coefficients length mismatch")
+	}
+	if level < 1 || t.levels < level {
+		panic("wavelet: level out of range")
+	}
+	n := t.n >> level
+	return coeff[n : 2*n]
+}
+
+// analyze performs a single level periodized analysis step on
+// seq in place, placing the approximation coefficients in the first
+// half of seq and the detail coefficients in the second half.
+func (t *DWT) analyze(seq []float64) {
+	n := len(seq)
+	h := n / 2
+	work := t.work[:n]
+	for k := 0; k < h; k++ {
+		var a, d float64
+		for j, lo := range t.lo {
+			v := seq[(2*k+j)%n]
+			a += lo * v
+			d += t.hi[j] * v
+		}
+		work[k] = a
+		work[h+k] = d
+	}
+	copy(seq, work)
+}
+
+// synthesize performs a single level periodized synthesis step on
+// coeff in place. It is the inverse of analyze.
+func (t *DWT) synthesize(coeff []float64) {
+	n := len(coeff)
+	h := n / 2
+	work := t.work[:n]
+	for i := range work {
+		work[i] = 0
+	}
+	for k := 0; k < h; k++ {
+		a := coeff[k]
+		d := coeff[h+k]
+		for j, lo := range t.lo {
+			work[(2*k+j)%n] += lo*a + t.hi[j]*d
+		}
+	}
+	copy(coeff, work)
+}
+
+func reuse(s []float64, n int) []float64 {
+	if n <= cap(s) {
+		return s[:n]
+	}
+	return make([]float64, n)
+}
@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wavelet
+
+// The scaling filter coefficients below are the low-pass reconstruction
+// filters of each wavelet, normalized so that the coefficients sum to √2.
+// The Daubechies and symlet filters were obtained by spectral factorization
+// of the Daubechies polynomial, and the coiflet filters by Newton refinement
+// of the tabulated values given in Daubechies, "Ten Lectures on Wavelets",
+// SIAM (1992). The orientation of each filter matches that of PyWavelets.
+
+// daubechies holds the Daubechies extremal phase scaling filters
+// indexed by the number of vanishing moments.
+var daubechies = map[int][]float64{
+	1: {
+		0.70710678118654757, 0.70710678118654757,
+	},
+	2: {
+		0.48296291314453421, 0.83651630373780805, 0.22414386804201333,
+		-0.12940952255126045,
+	},
+	3: {
+		0.33267055295008269, 0.80689150931109266, 0.45987750211849149,
+		-0.13501102001025464, -0.08544127388202663, 0.035226291885709561,
+	},
+	4: {
+		0.23037781330889656, 0.71484657055291589, 0.63088076792985903,
+		-0.027983769416859962, -0.18703481171909317, 0.030841381835560767,
+		0.03288301166688521, -0.010597401785069035,
+	},
+	5: {
+		0.1601023979741929, 0.60382926979718954, 0.72430852843777305,
+		0.1384281459013206, -0.24229488706638194, -0.03224486958463841,
+		0.077571493840045719, -0.0062414902127982856, -0.012580751999081997,
+		0.0033357252854737734,
+	},
+	6: {
+		0.11154074335010951, 0.49462389039845328, 0.75113390802109536,
+		0.31525035170919796, -0.22626469396544024, -0.12976686756726197,
+		0.097501605587323098, 0.02752286553030571, -0.031582039317486016,
+		0.00055384220116149569, 0.0047772575109455125, -0.0010773010853084805,
+	},
+	7: {
+		0.077852054085009142, 0.39653931948191706, 0.72913209084623531,
+		0.46978228740519262, -0.14390600392856429, -0.22403618499387487,
+		0.071309219266830148, 0.080612609151083051, -0.038029936935014337,
+		-0.016574541630666906, 0.012550998556099842, 0.00042957797292136532,
+		-0.00180164070404749, 0.00035371379997452002,
+	},
+	8: {
+		0.054415842243103987, 0.31287159091429995, 0.67563073629728976,
+		0.58535468365420651, -0.015829105256348851, -0.2840155429615463,
+		0.00047248457391249138, 0.12874742662047867, -0.01736930100180752,
+		-0.044088253930794699, 0.013981027917398222, 0.0087460940474057853,
+		-0.0048703529934515681, -0.00039174037337694808, 0.00067544940645056846,
+		-0.00011747678412476933,
+	},
+	9: {
+		0.038077947363878352, 0.2438346746125904, 0.60482312369011137,
+		0.65728807805130052, 0.13319738582500756, -0.29327378327917508,
+		-0.096840783222976637, 0.14854074933810643, 0.03072568147933312,
+		-0.067632829061329655, 0.00025094711483128154, 0.022361662123679148,
+		-0.0047232047577514032, -0.004281503682463432, 0.0018476468830562261,
+		0.00023038576352319627, -0.00025196318894271023, 3.9347320316271616e-05,
+	},
+	10: {
+		0.026670057900555568, 0.18817680007769166, 0.52720118893172574,
+		0.68845903945360409, 0.28117234366057742, -0.2498464243273158,
+		-0.19594627437737761, 0.12736934033579367, 0.093057364603572695,
+		-0.071394147166397803, -0.029457536821875015, 0.03321267405934053,
+		0.0036065535669563362, -0.010733175483330609, 0.0013953517470529004,
+		0.00199240529518506, -0.0006858566949597127, -0.00011646685512928527,
+		9.3588670320069578e-05, -1.3264202894521246e-05,
+	},
+}
+
+// symlets holds the Daubechies least asymmetric scaling filters
+// indexed by the number of vanishing moments.
+var symlets = map[int][]float64{
+	2: {
+		0.48296291314453421, 0.83651630373780805, 0.22414386804201333,
+		-0.12940952255126045,
+	},
+	3: {
+		0.33267055295008269, 0.80689150931109266, 0.45987750211849149,
+		-0.13501102001025464, -0.08544127388202663, 0.035226291885709561,
+	},
+	4: {
+		0.032223100604051466, -0.012603967262031317, -0.099219543576633512,
+		0.29785779560530612, 0.80373875180513221, 0.49761866763277496,
+		-0.029635527646002541, -0.075765714789502267,
+	},
+	5: {
+		0.019538882735249837, -0.021101834024689015, -0.17532808990805629,
+		0.016602105764510686, 0.63397896345679206, 0.72340769040404085,
+		0.19939753397685572, -0.039134249302313823, 0.029519490925706257,
+		0.027333068344998764,
+	},
+	6: {
+		0.01540410932704484, 0.0034907120842222069, -0.11799011114852016,
+		-0.048311742585698113, 0.49105594192797358, 0.78764114102865146,
+		0.33792942172816604, -0.072637522786376682, -0.021060292512370862,
+		0.044724901770781346, 0.0017677118642540034, -0.0078007083250323803,
+	},
+	7: {
+		0.010268176708464805, 0.004010244871522366, -0.10780823770328966,
+		-0.1400472404429334, 0.28862963175064787, 0.76776431700488301,
+		0.53610191709056865, 0.017441255086835812, -0.049552834937042607,
+		0.067892693501220527, 0.030515513165877892, -0.012636303403240569,
+		-0.0010473848886797341, 0.002681814568260148,
+	},
+	8: {
+		0.0018899503327676876, -0.0003029205147241345, -0.014952258337062199,
+		0.0038087520138945299, 0.049137179673730269, -0.027219029917103673,
+		-0.05194583810788115, 0.36444189483617734, 0.77718575169962967,
+		0.48135965125905245, -0.061273359067810743, -0.14329423835127247,
+		0.0076074873249764958, 0.03169508781152601, -0.00054213233180001549,
+		-0.0033824159510050002,
+	},
+	9: {
+		0.001400915525914659, 0.00061978088898551455, -0.01327196778181716,
+		-0.011528210207679185, 0.030224878858275114, 0.00058346274612519112,
+		-0.054568958430834008, 0.23876091460730545, 0.717897082764412,
+		0.61733844914093539, 0.035272488035270999, -0.19155083129728448,
+		-0.01823377077939543, 0.062077789302885628, 0.0088592674934003593,
+		-0.010264064027633146, -0.00047315449868004375, 0.001069490032908612,
+	},
+	10: {
+		0.00086257822622597211, 0.00071542054205433916, -0.0070567640625873182,
+		0.0005956827837425994, 0.049686126646942622, 0.026240365058449476,
+		-0.12155210554854953, -0.015019238839137685, 0.51370987334802631,
+		0.76695483656061092, 0.34021601302346077, -0.087878711511974239,
+		-0.067089907808383017, 0.033842354663575734, -0.00086875210968928975,
+		-0.023005461353497507, -0.0011404297952173261, 0.0050716491985317962,
+		0.00034014926631480982, -0.00041011591580439826,
+	},
+}
+
+// coiflets holds the coiflet scaling filters indexed by the order
+// of the wavelet. A coiflet of order n has 2n vanishing moments.
+var coiflets = map[int][]float64{
+	1: {
+		-0.07273261951252645, 0.33789766245748176, 0.85257202021160039,
+		0.38486484686485778, -0.07273261951252645, -0.015655728135791976,
+	},
+	2: {
+		0.016387336463203242, -0.041464936786871964, -0.067372554723725678,
+		0.38611006682276328, 0.81272363544941362, 0.41700518442323847,
+		-0.076488599078280622, -0.059434418646430794, 0.023680171946846851,
+		0.0056114348193710478, -0.0018232088709122449, -0.00072054944552030773,
+	},
+	3: {
+		-0.0037935128643728244, 0.0077825964256681944, 0.023452696142059574,
+		-0.065771911281440082, -0.06112339000294461, 0.40517690240904541,
+		0.7937772226260702, 0.42848347637746281, -0.071799821619165594,
+		-0.082301927106364747, 0.034555027573319318, 0.015880544863694012,
+		-0.0090079761367295921, -0.0025745176881738822, 0.0011175187708547881,
+		0.00046621695981540205, -7.0983302504960637e-05, -3.459977319842131e-05,
+	},
+}
@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wavelet
+
+import (
+	"math"
+	"sort"
+)
+
+// Shrinkage is a thresholding rule applied to wavelet coefficients.
+// It returns the shrunk value of the coefficient x for the threshold t.
+type Shrinkage func(x, t float64) float64
+
+// Soft is the soft thresholding rule. It returns sign(x)*max(|x|-t, 0).
+func Soft(x, t float64) float64 {
+	switch {
+	case x > t:
+		return x - t
+	case x < -t:
+		return x + t
+	default:
+		return 0
+	}
+}
+
+// Hard is the hard thresholding rule. It returns x if |x| > t and zero
+// otherwise.
+func Hard(x, t float64) float64 {
+	if math.Abs(x) > t {
+		return x
+	}
+	return 0
+}
+
+// NoiseSigma returns a robust estimate of the standard deviation of
+// additive white Gaussian noise from the finest level detail coefficients
+// of a noisy signal. The estimate is the median absolute deviation of the
+// coefficients divided by 0.6745.
+//
+// NoiseSigma will panic if detail is empty.
+func NoiseSigma(detail []float64) float64 {
+	if len(detail) == 0 {
+		panic("wavelet: zero length detail")
+	}
+	abs := make([]float64, len(detail))
+	for i, v := range detail {
+		abs[i] = math.Abs(v)
+	}
+	sort.Float64s(abs)
+	n := len(abs)
+	med := abs[n/2]
+	if n%2 == 0 {
+		med = (abs[n/2-1] + abs[n/2]) / 2
+	}
+	return med / 0.6744897501960817
+}
+
+// UniversalThreshold returns the universal threshold of Donoho and
+// Johnstone, σ√(2 ln n), for a signal of length n with noise standard
+// deviation sigma.
+func UniversalThreshold(sigma float64, n int) float64 {
+	return sigma * math.Sqrt(2*math.Log(float64(n)))
+}
+
+// Denoise performs wavelet shrinkage denoising of seq, placing the result in
+// dst and returning it. The detail coefficients at every level of the
+// transform are shrunk using the provided rule with the universal threshold
+// derived from the noise level estimated from the finest detail coefficients.
+// With the Soft rule this is the VisuShrink method of Donoho and Johnstone.
+//
+// If the length of seq is not t.Len(), Denoise will panic.
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal t.Len(), Denoise will panic.
+// It is safe to use the same slice for dst and seq.
+func (t *DWT) Denoise(dst, seq []float64, rule Shrinkage) []float64 {
+	coeff := t.Coefficients(dst, seq)
+	sigma := NoiseSigma(t.Detail(coeff, 1))
+	thresh := UniversalThreshold(sigma, t.n)
+	t.Shrink(coeff, thresh, rule)
+	return t.Sequence(coeff, coeff)
+}
+
+// Shrink applies the shrinkage rule with threshold thresh to all detail
+// coefficients held in coeff in place, leaving the approximation
+// coefficients unaltered. Shrink will panic if the length of coeff is
+// not t.Len().
+func (t *DWT) Shrink(coeff []float64, thresh float64, rule Shrinkage) {
+	for l := 1; l <= t.levels; l++ {
+		d := t.Detail(coeff, l)
+		for i, v := range d {
+			d[i] = rule(v, thresh)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wavelet
+
+import "fmt"
+
+// Wavelet is an orthogonal wavelet described by its scaling filter.
+type Wavelet struct {
+	name string
+	h    []float64
+}
+
+// Haar returns the Haar wavelet. The Haar wavelet is the Daubechies
+// wavelet with one vanishing moment.
+func Haar() Wavelet {
+	return Wavelet{name: "haar", h: daubechies[1]}
+}
+
+// Daubechies returns the Daubechies extremal phase wavelet with n vanishing
+// moments. The filters of the returned wavelet have length 2*n.
+// Daubechies will panic if n is not in the interval [1, 10].
+func Daubechies(n int) Wavelet {
+	h, ok := daubechies[n]
+	if !ok {
+		panic("wavelet: unsupported Daubechies order")
+	}
+	return Wavelet{name: fmt.Sprintf("db%d", n), h: h}
+}
+
+// Symlet returns the Daubechies least asymmetric wavelet with n vanishing
+// moments. The filters of the returned wavelet have length 2*n.
+// Symlet will panic if n is not in the interval [2, 10].
+func Symlet(n int) Wavelet {
+	h, ok := symlets[n]
+	if !ok {
+		panic("wavelet: unsupported symlet order")
+	}
+	return Wavelet{name: fmt.Sprintf("sym%d", n), h: h}
+}
+
+// Coiflet returns the coiflet of order n. The wavelet has 2*n vanishing
+// moments and its scaling function has 2*n-1 vanishing moments. The filters
+// of the returned wavelet have length 6*n.
+// Coiflet will panic if n is not in the interval [1, 3].
+func Coiflet(n int) Wavelet {
+	h, ok := coiflets[n]
+	if !ok {
+		panic("wavelet: unsupported coiflet order")
+	}
+	return Wavelet{name: fmt.Sprintf("coif%d", n), h: h}
+}
+
+// String returns the conventional short name of the wavelet, for example
+// "db4" or "sym8".
+func (w Wavelet) String() string { return w.name }
+
+// Len returns the length of the filters of the wavelet.
+func (w Wavelet) Len() int { return len(w.h) }
+
+// LowPass places the low-pass reconstruction filter of the wavelet into
+// dst and returns it. If dst is nil, a new slice is allocated and returned.
+// If dst is not nil and the length of dst does not equal w.Len(), LowPass
+// will panic.
+//
+// The low-pass filter coefficients, h, sum to √2 and are orthonormal to
+// their even shifts.
+func (w Wavelet) LowPass(dst []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(w.h))
+	} else if len(dst) != len(w.h) {
+		panic("wavelet: destination length mismatch")
+	}
+	copy(dst, w.h)
+	return dst
+}
+
+// HighPass places the high-pass reconstruction filter of the wavelet into
+// dst and returns it. If dst is nil, a new slice is allocated and returned.
+// If dst is not nil and the length of dst does not equal w.Len(), HighPass
+// will panic.
+//
+// The high-pass filter is the quadrature mirror of the low-pass filter,
+//
+//	g[k] = (-1)^k h[L-1-k],
+//
+// where L is the length of the filters.
+func (w Wavelet) HighPass(dst []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(w.h))
+	} else if len(dst) != len(w.h) {
+		panic("wavelet: destination length mismatch")
+	}
+	l := len(w.h)
+	for k := range dst {
+		dst[k] = w.h[l-1-k]
+		if k%2 == 1 {
+			dst[k] = -dst[k]
+		}
+	}
+	return dst
+}
@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wavelet_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/dsp/wavelet"
+)
+
+func ExampleDWT_Coefficients() {
+	// Decompose a short sequence into three levels
+	// using the Haar wavelet.
+	seq := []float64{1, 3, 5, 7, 2, 2, 8, 4}
+	dwt := wavelet.NewDWT(wavelet.Haar(), len(seq), 3)
+	coeff := dwt.Coefficients(nil, seq)
+
+	fmt.Printf("approximation: %.4f\n", dwt.Approximation(coeff))
+	for l := dwt.Levels(); l >= 1; l-- {
+		fmt.Printf("detail level %d: %.4f\n", l, dwt.Detail(coeff, l))
+	}
+
+	// Reconstruct the sequence from the coefficients.
+	fmt.Printf("sequence: %.4f\n", dwt.Sequence(nil, coeff))
+
+	// Output:
+	//
+	// approximation: [11.3137]
+	// detail level 3: [0.0000]
+	// detail level 2: [-4.0000 -4.0000]
+	// detail level 1: [-1.4142 -1.4142 0.0000 2.8284]
+	// sequence: [1.0000 3.0000 5.0000 7.0000 2.0000 2.0000 8.0000 4.0000]
+}
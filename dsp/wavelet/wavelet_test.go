@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wavelet
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func allWavelets() []Wavelet {
+	w := []Wavelet{Haar()}
+	for n := 1; n <= 10; n++ {
+		w = append(w, Daubechies(n))
+	}
+	for n := 2; n <= 10; n++ {
+		w = append(w, Symlet(n))
+	}
+	for n := 1; n <= 3; n++ {
+		w = append(w, Coiflet(n))
+	}
+	return w
+}
+
+func TestFilters(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	for _, w := range allWavelets() {
+		h := w.LowPass(nil)
+		g := w.HighPass(nil)
+		if sum := floats.Sum(h); math.Abs(sum-math.Sqrt2) > tol {
+			t.Errorf("unexpected low-pass sum for %v: got:%v want:%v", w, sum, math.Sqrt2)
+		}
+		for m := 0; 2*m < len(h); m++ {
+			var hh, gg, hg float64
+			for k := 0; k+2*m < len(h); k++ {
+				hh += h[k] * h[k+2*m]
+				gg += g[k] * g[k+2*m]
+			}
+			for k := range h {
+				for _, s := range []int{k + 2*m, k - 2*m} {
+					if 0 <= s && s < len(g) {
+						hg += h[k] * g[s]
+					}
+				}
+			}
+			if m == 0 {
+				hh--
+				gg--
+				hg /= 2
+			}
+			if math.Abs(hh) > tol || math.Abs(gg) > tol || math.Abs(hg) > tol {
+				t.Errorf("filters for %v not orthonormal at shift %d: hh=%g gg=%g hg=%g", w, 2*m, hh, gg, hg)
+			}
+		}
+	}
+}
+
+func TestVanishingMoments(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		w       Wavelet
+		moments int
+	}{
+		{w: Haar(), moments: 1},
+		{w: Daubechies(4), moments: 4},
+		{w: Daubechies(10), moments: 10},
+		{w: Symlet(7), moments: 7},
+		{w: Coiflet(2), moments: 4},
+		{w: Coiflet(3), moments: 6},
+	} {
+		g := test.w.HighPass(nil)
+		c := float64(len(g)-1) / 2
+		for p := 0; p < test.moments; p++ {
+			var m, scale float64
+			for k, v := range g {
+				x := math.Pow(float64(k)-c, float64(p))
+				m += x * v
+				scale += math.Abs(x * v)
+			}
+			if math.Abs(m) > 1e-10*scale {
+				t.Errorf("unexpected non-zero moment %d for %v: got:%g", p, test.w, m)
+			}
+		}
+	}
+}
+
+func TestDWT(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, w := range allWavelets() {
+		for _, n := range []int{2, 8, 24, 128, 1000} {
+			for levels := 1; levels <= MaxLevels(n); levels++ {
+				name := fmt.Sprintf("%v n=%d levels=%d", w, n, levels)
+				dwt := NewDWT(w, n, levels)
+
+				seq := make([]float64, n)
+				for i := range seq {
+					seq[i] = rnd.NormFloat64()
+				}
+				coeff := dwt.Coefficients(nil, seq)
+				if got, want := floats.Dot(coeff, coeff), floats.Dot(seq, seq); math.Abs(got-want) > tol*want {
+					t.Errorf("energy not preserved for %s: got:%v want:%v", name, got, want)
+				}
+				got := dwt.Sequence(nil, coeff)
+				if !floats.EqualApprox(got, seq, tol) {
+					t.Errorf("unexpected result for sequence(coefficients(x)) for %s", name)
+				}
+				dwt.Sequence(coeff, coeff)
+				if !floats.Equal(got, coeff) {
+					t.Errorf("unexpected result for in place sequence for %s", name)
+				}
+			}
+		}
+	}
+}
+
+func TestDWTHaar(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	dwt := NewDWT(Haar(), 8, 3)
+	seq := []float64{1, 3, 5, 7, 2, 2, 8, 4}
+	got := dwt.Coefficients(nil, seq)
+
+	s := math.Sqrt2
+	want := []float64{
+		32 / (2 * s),
+		(16 - 16) / (2 * s),
+		(4 - 12) / 2, (4 - 12) / 2,
+		-2 / s, -2 / s, 0, 4 / s,
+	}
+	if !floats.EqualApprox(got, want, tol) {
+		t.Errorf("unexpected Haar coefficients: got:%v want:%v", got, want)
+	}
+	if a := dwt.Approximation(got); len(a) != 1 || a[0] != got[0] {
+		t.Errorf("unexpected approximation view: got:%v", a)
+	}
+	for l, want := range [][]float64{1: want[4:], 2: want[2:4], 3: want[1:2]} {
+		if l == 0 {
+			continue
+		}
+		if d := dwt.Detail(got, l); !floats.EqualApprox(d, want, tol) {
+			t.Errorf("unexpected detail view at level %d: got:%v want:%v", l, d, want)
+		}
+	}
+}
+
+func TestDWTPolynomial(t *testing.T) {
+	t.Parallel()
+	// Detail coefficients away from the periodic boundary
+	// vanish for polynomials of degree less than the number
+	// of vanishing moments.
+	const n = 64
+	w := Daubechies(3)
+	dwt := NewDWT(w, n, 1)
+	seq := make([]float64, n)
+	for i := range seq {
+		x := float64(i) / n
+		seq[i] = 1 + 2*x - 3*x*x
+	}
+	d := dwt.Detail(dwt.Coefficients(nil, seq), 1)
+	for k, v := range d[:n/2-w.Len()/2] {
+		if math.Abs(v) > 1e-13 {
+			t.Errorf("unexpected non-zero detail coefficient at %d: got:%g", k, v)
+		}
+	}
+}
+
+func TestDenoise(t *testing.T) {
+	t.Parallel()
+	const (
+		n     = 1024
+		sigma = 0.2
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	clean := make([]float64, n)
+	noisy := make([]float64, n)
+	for i := range clean {
+		x := float64(i) / n
+		clean[i] = math.Sin(4*math.Pi*x) + 0.5*math.Sin(10*math.Pi*x)
+		noisy[i] = clean[i] + sigma*rnd.NormFloat64()
+	}
+	dwt := NewDWT(Symlet(8), n, 5)
+
+	est := NoiseSigma(dwt.Detail(dwt.Coefficients(nil, noisy), 1))
+	if math.Abs(est-sigma) > 0.1*sigma {
+		t.Errorf("unexpected noise estimate: got:%v want:%v", est, sigma)
+	}
+
+	before := floats.Distance(noisy, clean, 2)
+	for _, rule := range []struct {
+		name string
+		fn   Shrinkage
+	}{
+		{name: "soft", fn: Soft},
+		{name: "hard", fn: Hard},
+	} {
+		got := dwt.Denoise(nil, noisy, rule.fn)
+		after := floats.Distance(got, clean, 2)
+		if after > before/2 {
+			t.Errorf("insufficient noise reduction for %s thresholding: before:%v after:%v", rule.name, before, after)
+		}
+	}
+}
+
+func TestShrinkage(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, t       float64
+		soft, hard float64
+	}{
+		{x: 3, t: 1, soft: 2, hard: 3},
+		{x: -3, t: 1, soft: -2, hard: -3},
+		{x: 0.5, t: 1, soft: 0, hard: 0},
+		{x: -1, t: 1, soft: 0, hard: 0},
+	} {
+		if got := Soft(test.x, test.t); got != test.soft {
+			t.Errorf("unexpected Soft(%v, %v): got:%v want:%v", test.x, test.t, got, test.soft)
+		}
+		if got := Hard(test.x, test.t); got != test.hard {
+			t.Errorf("unexpected Hard(%v, %v): got:%v want:%v", test.x, test.t, got, test.hard)
+		}
+	}
+}
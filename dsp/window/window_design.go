@@ -0,0 +1,292 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import "math"
+
+// Kaiser can modify a sequence using the Kaiser window and return the result.
+// See https://en.wikipedia.org/wiki/Kaiser_window for details.
+//
+// The Kaiser window is an adjustable window.
+//
+// The sequence weights are
+//
+//	w[k] = I_0(β * sqrt(1 - ((k-M)/M)²)) / I_0(β), M = (N-1)/2,
+//
+// for k=0,1,...,N-1 where N is the length of the window and I_0 is the
+// modified Bessel function of the first kind of order zero.
+//
+// The properties of the window depend on the value of β (Beta). Larger
+// values of β give lower side lobes at the cost of a wider main lobe.
+// A β of zero gives the rectangular window. The KaiserSidelobe and
+// KaiserFilter functions return Kaiser windows meeting a specified
+// attenuation.
+type Kaiser struct {
+	Beta float64
+}
+
+// Transform applies the Kaiser transformation to seq in place, using the
+// value of the receiver as the β parameter, and returning the result.
+func (k Kaiser) Transform(seq []float64) []float64 {
+	m := float64(len(seq)-1) / 2
+	norm := besselI0(k.Beta)
+	for i := range seq {
+		seq[i] *= k.weight(float64(i), m, norm)
+	}
+	return seq
+}
+
+// TransformComplex applies the Kaiser transformation to seq in place, using
+// the value of the receiver as the β parameter, and returning the result.
+func (k Kaiser) TransformComplex(seq []complex128) []complex128 {
+	m := float64(len(seq)-1) / 2
+	norm := besselI0(k.Beta)
+	for i, v := range seq {
+		w := k.weight(float64(i), m, norm)
+		seq[i] = complex(w*real(v), w*imag(v))
+	}
+	return seq
+}
+
+func (k Kaiser) weight(i, m, norm float64) float64 {
+	if m == 0 {
+		return 1
+	}
+	r := (i - m) / m
+	return besselI0(k.Beta*math.Sqrt(math.Max(0, 1-r*r))) / norm
+}
+
+// KaiserSidelobe returns the Kaiser window with the highest side lobe of
+// its spectrum atten decibels below the main lobe. The value of atten is
+// the positive attenuation in dB. The returned window is suitable for
+// spectral analysis.
+//
+// The β parameter is calculated using the empirical formula given in
+// Kaiser and Schafer, "On the use of the I0-sinh window for spectrum
+// analysis", IEEE Trans. ASSP 28(1):105-107 (1980), which is accurate
+// for attenuations up to 120 dB.
+func KaiserSidelobe(atten float64) Kaiser {
+	var beta float64
+	switch {
+	case atten <= 13.26:
+		beta = 0
+	case atten <= 60:
+		beta = 0.76609*math.Pow(atten-13.26, 0.4) + 0.09834*(atten-13.26)
+	default:
+		beta = 0.12438 * (atten + 6.3)
+	}
+	return Kaiser{Beta: beta}
+}
+
+// KaiserFilter returns the Kaiser window and its length, n, for use in the
+// design of a windowed FIR low-pass filter with a stopband attenuation of
+// atten decibels and a transition band width of width, where width is
+// expressed as a fraction of the sampling frequency. The value of atten is
+// the positive attenuation in dB and width must be in the interval (0, 0.5).
+//
+// The β parameter and the filter length are calculated using the empirical
+// formulae given in Kaiser, "Nonrecursive digital filter design using the
+// I0-sinh window function", Proc. IEEE ISCAS (1974).
+//
+// KaiserFilter will panic if width is not in the interval (0, 0.5).
+func KaiserFilter(atten, width float64) (w Kaiser, n int) {
+	if !(0 < width && width < 0.5) {
+		panic("window: transition width out of range")
+	}
+	var beta float64
+	switch {
+	case atten > 50:
+		beta = 0.1102 * (atten - 8.7)
+	case atten >= 21:
+		beta = 0.5842*math.Pow(atten-21, 0.4) + 0.07886*(atten-21)
+	}
+	n = int(math.Ceil((atten-7.95)/(2.285*2*math.Pi*width))) + 1
+	return Kaiser{Beta: beta}, max(n, 1)
+}
+
+// DolphChebyshev can modify a sequence using the Dolph-Chebyshev window and
+// return the result.
+// See https://en.wikipedia.org/wiki/Window_function#Dolph–Chebyshev_window
+// for details.
+//
+// The Dolph-Chebyshev window is an adjustable window.
+//
+// The window minimizes the width of the main lobe of its spectrum for a
+// given side lobe level. All side lobes have equal height, Attenuation
+// decibels below the main lobe. The value of Attenuation is the positive
+// attenuation in dB.
+//
+// The sequence weights are calculated as the inverse discrete Fourier
+// transform of
+//
+//	W[k] = T_{N-1}(x_0 cos(π*k/N)), x_0 = cosh(acosh(10^(A/20))/(N-1)),
+//
+// for k=0,1,...,N-1 where N is the length of the window, A is the
+// attenuation and T_n is the Chebyshev polynomial of the first kind of
+// degree n. The weights are normalized to a maximum value of 1.
+//
+// Calculation of the weights has O(N²) time complexity.
+type DolphChebyshev struct {
+	Attenuation float64
+}
+
+// Transform applies the Dolph-Chebyshev transformation to seq in place,
+// using the value of the receiver as the attenuation parameter, and
+// returning the result.
+func (d DolphChebyshev) Transform(seq []float64) []float64 {
+	return Values(d.weights(len(seq))).Transform(seq)
+}
+
+// TransformComplex applies the Dolph-Chebyshev transformation to seq in
+// place, using the value of the receiver as the attenuation parameter, and
+// returning the result.
+func (d DolphChebyshev) TransformComplex(seq []complex128) []complex128 {
+	return Values(d.weights(len(seq))).TransformComplex(seq)
+}
+
+func (d DolphChebyshev) weights(n int) []float64 {
+	w := make([]float64, n)
+	if n == 0 {
+		return w
+	}
+	if n == 1 {
+		w[0] = 1
+		return w
+	}
+
+	order := float64(n - 1)
+	x0 := math.Cosh(math.Acosh(math.Pow(10, math.Abs(d.Attenuation)/20)) / order)
+	p := make([]float64, n)
+	for k := range p {
+		x := x0 * math.Cos(math.Pi*float64(k)/float64(n))
+		switch {
+		case x > 1:
+			p[k] = math.Cosh(order * math.Acosh(x))
+		case x < -1:
+			p[k] = math.Cosh(order * math.Acosh(-x))
+			if n%2 == 0 {
+				p[k] = -p[k]
+			}
+		default:
+			p[k] = math.Cos(order * math.Acos(x))
+		}
+	}
+
+	// Compute the real part of the DFT of p, shifted
+	// for even lengths, to obtain the centered window.
+	dft := func(j int) float64 {
+		var sum float64
+		for k, v := range p {
+			phase := -2 * math.Pi * float64(j*k) / float64(n)
+			if n%2 == 0 {
+				phase += math.Pi * float64(k) / float64(n)
+			}
+			sum += v * math.Cos(phase)
+		}
+		return sum
+	}
+	if n%2 == 1 {
+		h := (n + 1) / 2
+		for j := 0; j < h; j++ {
+			v := dft(j)
+			w[h-1+j] = v
+			w[h-1-j] = v
+		}
+	} else {
+		h := n/2 + 1
+		for j := 1; j < h; j++ {
+			v := dft(j)
+			w[h-2+j] = v
+			w[h-1-j] = v
+		}
+	}
+	var maxW float64
+	for _, v := range w {
+		maxW = math.Max(maxW, v)
+	}
+	for i := range w {
+		w[i] /= maxW
+	}
+	return w
+}
+
+// PlanckTaper can modify a sequence using the Planck-taper window and return
+// the result.
+// See https://en.wikipedia.org/wiki/Window_function#Planck-taper_window for
+// details.
+//
+// The Planck-taper window is an adjustable window.
+//
+// The sequence weights are
+//
+//	w[k] = 0, k = 0
+//	     = 1/(1 + exp(εL/k - εL/(εL - k))), 0 < k < εL
+//	     = 1, εL ≤ k ≤ L/2
+//	w[L-k] = w[k],
+//
+// with L = N - 1 for k=0,1,...,N-1 where N is the length of the window.
+//
+// The value of ε (Epsilon) is the fraction of the window over which each
+// taper acts and must be in the interval (0, 0.5]. The window is smooth,
+// being infinitely differentiable, and is equal to one over the central
+// portion of the window.
+type PlanckTaper struct {
+	Epsilon float64
+}
+
+// Transform applies the Planck-taper transformation to seq in place, using
+// the value of the receiver as the ε parameter, and returning the result.
+func (p PlanckTaper) Transform(seq []float64) []float64 {
+	l := float64(len(seq) - 1)
+	for i := range seq {
+		seq[i] *= p.weight(float64(i), l)
+	}
+	return seq
+}
+
+// TransformComplex applies the Planck-taper transformation to seq in place,
+// using the value of the receiver as the ε parameter, and returning the
+// result.
+func (p PlanckTaper) TransformComplex(seq []complex128) []complex128 {
+	l := float64(len(seq) - 1)
+	for i, v := range seq {
+		w := p.weight(float64(i), l)
+		seq[i] = complex(w*real(v), w*imag(v))
+	}
+	return seq
+}
+
+func (p PlanckTaper) weight(k, l float64) float64 {
+	if p.Epsilon <= 0 || l <= 0 {
+		return 1
+	}
+	k = math.Min(k, l-k)
+	el := math.Min(p.Epsilon, 0.5) * l
+	switch {
+	case k <= 0:
+		return 0
+	case k < el:
+		return 1 / (1 + math.Exp(el/k-el/(el-k)))
+	default:
+		return 1
+	}
+}
+
+// besselI0 returns the modified Bessel function of the first kind
+// of order zero evaluated at x.
+func besselI0(x float64) float64 {
+	// The power series converges for all x and the terms
+	// are all positive so there is no cancellation.
+	y := x * x / 4
+	sum := 1.0
+	term := 1.0
+	for k := 1; ; k++ {
+		term *= y / float64(k*k)
+		sum += term
+		if term < sum*1e-17 {
+			return sum
+		}
+	}
+}
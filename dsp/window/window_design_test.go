@@ -0,0 +1,202 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestKaiser(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	got := Kaiser{Beta: 0}.Transform(ones(20))
+	if !floats.EqualApprox(got, ones(20), tol) {
+		t.Errorf("unexpected result for zero β Kaiser window: got:%v", got)
+	}
+
+	// I_0(5) = 27.239871823604442.
+	got = Kaiser{Beta: 5}.Transform(ones(21))
+	if !scalar.EqualWithinAbsOrRel(got[0], 1/27.239871823604442, tol, tol) {
+		t.Errorf("unexpected Kaiser end weight: got:%v want:%v", got[0], 1/27.239871823604442)
+	}
+	if got[10] != 1 {
+		t.Errorf("unexpected Kaiser center weight: got:%v want:1", got[10])
+	}
+	for i := range got {
+		if got[i] != got[len(got)-1-i] {
+			t.Errorf("Kaiser window not symmetric at %d", i)
+		}
+	}
+
+	for _, atten := range []float64{30, 50, 60, 90, 110} {
+		w := KaiserSidelobe(atten).Transform(ones(101))
+		if got := sidelobeLevel(w); math.Abs(got+atten) > 1.5 {
+			t.Errorf("unexpected side lobe level for %v dB Kaiser window: got:%.2f dB", atten, got)
+		}
+	}
+}
+
+func TestKaiserFilter(t *testing.T) {
+	t.Parallel()
+	const cutoff = 0.2
+	for _, test := range []struct {
+		atten, width float64
+	}{
+		{atten: 40, width: 0.05},
+		{atten: 60, width: 0.02},
+		{atten: 80, width: 0.05},
+	} {
+		win, n := KaiserFilter(test.atten, test.width)
+
+		// Construct a windowed-sinc low-pass filter.
+		h := make([]float64, n)
+		m := float64(n-1) / 2
+		for i := range h {
+			x := float64(i) - m
+			if x == 0 {
+				h[i] = 2 * cutoff
+			} else {
+				h[i] = math.Sin(2*math.Pi*cutoff*x) / (math.Pi * x)
+			}
+		}
+		win.Transform(h)
+
+		var worst float64
+		for f := cutoff + test.width/2; f <= 0.5; f += 1e-4 {
+			worst = math.Max(worst, cmplx.Abs(dtft(h, f)))
+		}
+		// Kaiser's formulae are empirical, so allow a small shortfall.
+		if got := -20 * math.Log10(worst); got < test.atten-1 {
+			t.Errorf("unexpected stopband attenuation for %+v: got:%.2f dB", test, got)
+		}
+	}
+}
+
+func TestDolphChebyshev(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{1, 2, 31, 32} {
+		for _, atten := range []float64{40, 60, 100} {
+			name := fmt.Sprintf("n=%d atten=%v", n, atten)
+			w := DolphChebyshev{Attenuation: atten}.Transform(ones(n))
+			if floats.Max(w) != 1 {
+				t.Errorf("unexpected maximum weight for %s: got:%v", name, floats.Max(w))
+			}
+			for i := range w {
+				if !scalar.EqualWithinAbsOrRel(w[i], w[n-1-i], 1e-12, 1e-12) {
+					t.Errorf("window not symmetric for %s at %d", name, i)
+				}
+			}
+			if n < 3 {
+				continue
+			}
+			if got := sidelobeLevel(w); math.Abs(got+atten) > 0.1 {
+				t.Errorf("unexpected side lobe level for %s: got:%.3f dB", name, got)
+			}
+		}
+	}
+}
+
+func TestPlanckTaper(t *testing.T) {
+	t.Parallel()
+	const n = 21
+	got := PlanckTaper{Epsilon: 0.2}.Transform(ones(n))
+	if got[0] != 0 || got[n-1] != 0 {
+		t.Errorf("unexpected non-zero end weights: got:%v, %v", got[0], got[n-1])
+	}
+	// The taper is at half height at the middle of the taper region.
+	if !scalar.EqualWithinAbsOrRel(got[2], 0.5, 1e-14, 1e-14) {
+		t.Errorf("unexpected half taper weight: got:%v want:0.5", got[2])
+	}
+	for i := 4; i <= n-5; i++ {
+		if got[i] != 1 {
+			t.Errorf("unexpected central weight at %d: got:%v want:1", i, got[i])
+		}
+	}
+	for i := range got {
+		if got[i] != got[n-1-i] {
+			t.Errorf("Planck-taper window not symmetric at %d", i)
+		}
+		if i > 0 && i <= n/2 && got[i] < got[i-1] {
+			t.Errorf("Planck-taper window not monotonic at %d", i)
+		}
+	}
+
+	got = PlanckTaper{Epsilon: 0}.Transform(ones(n))
+	if !floats.Equal(got, ones(n)) {
+		t.Errorf("unexpected result for zero ε Planck-taper window: got:%v", got)
+	}
+}
+
+func TestDesignWindowsComplex(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, test := range []struct {
+		name    string
+		fn      func([]float64) []float64
+		fnCmplx func([]complex128) []complex128
+	}{
+		{name: "Kaiser", fn: Kaiser{Beta: 6}.Transform, fnCmplx: Kaiser{Beta: 6}.TransformComplex},
+		{name: "DolphChebyshev", fn: DolphChebyshev{Attenuation: 50}.Transform, fnCmplx: DolphChebyshev{Attenuation: 50}.TransformComplex},
+		{name: "PlanckTaper", fn: PlanckTaper{Epsilon: 0.3}.Transform, fnCmplx: PlanckTaper{Epsilon: 0.3}.TransformComplex},
+	} {
+		want := test.fn(ones(20))
+		src := make([]complex128, len(want))
+		for i := range src {
+			src[i] = complex(1, 1)
+		}
+		got := test.fnCmplx(src)
+		for i, v := range got {
+			if !scalar.EqualWithinAbsOrRel(real(v), want[i], tol, tol) || !scalar.EqualWithinAbsOrRel(imag(v), want[i], tol, tol) {
+				t.Errorf("unexpected result for complex %s window at %d: got:%v want:%v", test.name, i, v, want[i])
+			}
+		}
+	}
+}
+
+func ones(n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = 1
+	}
+	return s
+}
+
+// dtft returns the discrete time Fourier transform of
+// seq at the relative frequency f.
+func dtft(seq []float64, f float64) complex128 {
+	var sum complex128
+	for k, v := range seq {
+		sum += complex(v, 0) * cmplx.Exp(complex(0, -2*math.Pi*f*float64(k)))
+	}
+	return sum
+}
+
+// sidelobeLevel returns the level of the highest side lobe of the
+// spectrum of the window w relative to its main lobe in dB.
+func sidelobeLevel(w []float64) float64 {
+	const step = 1e-4
+	peak := cmplx.Abs(dtft(w, 0))
+	prev := peak
+	var inSidelobes bool
+	var level float64
+	for f := step; f <= 0.5; f += step {
+		a := cmplx.Abs(dtft(w, f))
+		if !inSidelobes && a > prev {
+			inSidelobes = true
+		}
+		if inSidelobes {
+			level = math.Max(level, a)
+		}
+		prev = a
+	}
+	return 20 * math.Log10(level/peak)
+}
@@ -0,0 +1,214 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// Accuracy specifies the accuracy of the element-wise elementary
+// functions Exp, Log, Sqrt and Tanh.
+type Accuracy int
+
+const (
+	// Precise computes each element with the corresponding function
+	// of the math package.
+	Precise Accuracy = iota
+
+	// Fast computes each element using polynomial and rational
+	// approximations that are evaluated without the special case
+	// handling of the math package for finite arguments in the normal
+	// range. The relative error of the results is less than 1e-15.
+	// Special values and arguments outside the normal range are
+	// handled as by the math package. On amd64 processors supporting
+	// AVX2, the exponentials used by Exp and Tanh are computed for four
+	// elements at a time.
+	Fast
+)
+
+// Exp replaces each element of dst with its exponential, e**dst[i],
+// computed with the given accuracy.
+func Exp(acc Accuracy, dst []float64) {
+	ExpTo(dst, acc, dst)
+}
+
+// ExpTo stores the exponential, e**s[i], of each element of s in dst
+// computed with the given accuracy.
+// It panics if the slice argument lengths do not match.
+func ExpTo(dst []float64, acc Accuracy, s []float64) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	switch acc {
+	case Precise:
+		for i, v := range s {
+			dst[i] = math.Exp(v)
+		}
+	case Fast:
+		f64.ExpUnitaryTo(dst, s)
+	default:
+		panic(badAccuracy)
+	}
+	return dst
+}
+
+// Log replaces each element of dst with its natural logarithm
+// computed with the given accuracy.
+func Log(acc Accuracy, dst []float64) {
+	LogTo(dst, acc, dst)
+}
+
+// LogTo stores the natural logarithm of each element of s in dst
+// computed with the given accuracy.
+// It panics if the slice argument lengths do not match.
+func LogTo(dst []float64, acc Accuracy, s []float64) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	switch acc {
+	case Precise:
+		for i, v := range s {
+			dst[i] = math.Log(v)
+		}
+	case Fast:
+		for i, v := range s {
+			dst[i] = logFast(v)
+		}
+	default:
+		panic(badAccuracy)
+	}
+	return dst
+}
+
+// Sqrt replaces each element of dst with its square root. The square
+// root is correctly rounded for both accuracies.
+func Sqrt(acc Accuracy, dst []float64) {
+	SqrtTo(dst, acc, dst)
+}
+
+// SqrtTo stores the square root of each element of s in dst. The square
+// root is correctly rounded for both accuracies.
+// It panics if the slice argument lengths do not match.
+func SqrtTo(dst []float64, acc Accuracy, s []float64) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	if acc != Precise && acc != Fast {
+		panic(badAccuracy)
+	}
+	for i, v := range s {
+		dst[i] = math.Sqrt(v)
+	}
+	return dst
+}
+
+// Tanh replaces each element of dst with its hyperbolic tangent
+// computed with the given accuracy.
+func Tanh(acc Accuracy, dst []float64) {
+	TanhTo(dst, acc, dst)
+}
+
+// TanhTo stores the hyperbolic tangent of each element of s in dst
+// computed with the given accuracy.
+// It panics if the slice argument lengths do not match.
+func TanhTo(dst []float64, acc Accuracy, s []float64) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	switch acc {
+	case Precise:
+		for i, v := range s {
+			dst[i] = math.Tanh(v)
+		}
+	case Fast:
+		// Compute e**(2*|s[i]|) in blocks so that dst may alias s.
+		var buf [64]float64
+		for off := 0; off < len(s); off += len(buf) {
+			e := buf[:min(len(buf), len(s)-off)]
+			for i := range e {
+				e[i] = 2 * math.Abs(s[off+i])
+			}
+			f64.ExpUnitaryTo(e, e)
+			for i := range e {
+				dst[off+i] = tanhFast(s[off+i], e[i])
+			}
+		}
+	default:
+		panic(badAccuracy)
+	}
+	return dst
+}
+
+const (
+	ln2Hi = 6.93147180369123816490e-01 // 0x3fe62e42fee00000
+	ln2Lo = 1.90821492927058770002e-10 // 0x3dea39ef35793c76
+)
+
+// Coefficients of the minimax approximation of log(1+f) used by
+// FreeBSD's e_log.c, as used by the math package.
+const (
+	logL1 = 6.666666666666735130e-01 // 0x3fe5555555555593
+	logL2 = 3.999999999940941908e-01 // 0x3fd999999997fa04
+	logL3 = 2.857142874366239149e-01 // 0x3fd2492494229359
+	logL4 = 2.222219843214978396e-01 // 0x3fcc71c51d8e78af
+	logL5 = 1.818357216161805012e-01 // 0x3fc7466496cb03de
+	logL6 = 1.531383769920937332e-01 // 0x3fc39a09d078c69f
+	logL7 = 1.479819860511658591e-01 // 0x3fc2f112df3e5244
+)
+
+// logFast returns the natural logarithm of x. Arguments that are not
+// positive normal numbers, and NaN, are handled by math.Log.
+func logFast(x float64) float64 {
+	if !(x >= 0x1p-1022 && x <= math.MaxFloat64) {
+		return math.Log(x)
+	}
+	// Reduce x = 2**k * (1+f) with sqrt(2)/2 <= 1+f < sqrt(2) without
+	// branching by offsetting the exponent bits so that the carry
+	// into the exponent occurs at sqrt(2).
+	const sqrtHalf = 0x3fe6a09e667f3bcd // bits of sqrt(2)/2
+	u := math.Float64bits(x) + (0x3ff0000000000000 - sqrtHalf)
+	k := float64(int64(u>>52) - 1023)
+	f := math.Float64frombits(u&(1<<52-1)+sqrtHalf) - 1
+
+	s := f / (2 + f)
+	s2 := s * s
+	s4 := s2 * s2
+	t1 := s2 * (logL1 + s4*(logL3+s4*(logL5+s4*logL7)))
+	t2 := s4 * (logL2 + s4*(logL4+s4*logL6))
+	R := t1 + t2
+	hfsq := 0.5 * f * f
+	return k*ln2Hi - ((hfsq - (s*(hfsq+R) + k*ln2Lo)) - f)
+}
+
+// Coefficients of the rational approximation of tanh used by Cephes'
+// tanh.c, as used by the math package.
+const (
+	tanhP0 = -9.64399179425052238628e-1
+	tanhP1 = -9.92877231001918586564e1
+	tanhP2 = -1.61468768441708447952e3
+	tanhQ0 = 1.12811678491632931402e2
+	tanhQ1 = 2.23548839060100448583e3
+	tanhQ2 = 4.84406305325125486048e3
+)
+
+// tanhFast returns the hyperbolic tangent of x given e2a = e**(2*|x|).
+// Arguments with magnitude greater than 22, where tanh(x) rounds to ±1,
+// and NaN are handled by math.Tanh.
+func tanhFast(x, e2a float64) float64 {
+	a := math.Abs(x)
+	if a < 0.625 {
+		if x == 0 {
+			return x // Preserve the sign of zero.
+		}
+		s := x * x
+		return x + x*s*((tanhP0*s+tanhP1)*s+tanhP2)/(((s+tanhQ0)*s+tanhQ1)*s+tanhQ2)
+	}
+	if !(a <= 22) {
+		return math.Tanh(x)
+	}
+	return math.Copysign(1-2/(e2a+1), x)
+}
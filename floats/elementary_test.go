@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+var elementaryFuncs = []struct {
+	name string
+	fn   func(dst []float64, acc Accuracy, s []float64) []float64
+	ref  func(float64) float64
+	// lo and hi bound the range of the random arguments.
+	lo, hi float64
+}{
+	{name: "Exp", fn: ExpTo, ref: math.Exp, lo: -745, hi: 710},
+	{name: "Log", fn: LogTo, ref: math.Log, lo: -10, hi: 700},
+	{name: "Sqrt", fn: SqrtTo, ref: math.Sqrt, lo: -1, hi: 1e10},
+	{name: "Tanh", fn: TanhTo, ref: math.Tanh, lo: -25, hi: 25},
+}
+
+func TestElementarySpecial(t *testing.T) {
+	t.Parallel()
+	special := []float64{
+		math.Inf(-1), -math.MaxFloat64, -1000, -709, -708, -22.5, -1, -0.625, -0.5,
+		-0x1p-1022, -0x1p-1074, math.Copysign(0, -1),
+		0, 0x1p-1074, 0x1p-1030, 0x1p-1022, 1e-300, 1e-10, 0.5, 0.625,
+		1, math.Sqrt2 / 2, math.Sqrt2, 2, math.E, 22, 22.5, 708, 709, 709.8, 1000,
+		math.MaxFloat64, math.Inf(1), math.NaN(),
+	}
+	for _, f := range elementaryFuncs {
+		for _, acc := range []Accuracy{Precise, Fast} {
+			got := f.fn(make([]float64, len(special)), acc, special)
+			for i, v := range special {
+				want := f.ref(v)
+				if !sameOrClose(got[i], want, 1e-15) {
+					t.Errorf("unexpected %s(%v) for accuracy %d: got:%v want:%v", f.name, v, acc, got[i], want)
+				}
+			}
+		}
+	}
+}
+
+func TestElementaryRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 100000
+	for _, f := range elementaryFuncs {
+		s := make([]float64, n)
+		for i := range s {
+			s[i] = f.lo + (f.hi-f.lo)*rnd.Float64()
+		}
+		// Include values near 1 where Log and Tanh lose precision
+		// in naive implementations.
+		for i := 0; i < n/10; i++ {
+			s[i] = 1 + 1e-3*rnd.NormFloat64()
+		}
+		for _, acc := range []Accuracy{Precise, Fast} {
+			got := f.fn(make([]float64, n), acc, s)
+			for i, v := range s {
+				want := f.ref(v)
+				if !sameOrClose(got[i], want, 1e-15) {
+					t.Errorf("unexpected %s(%v) for accuracy %d: got:%v want:%v", f.name, v, acc, got[i], want)
+				}
+			}
+		}
+	}
+}
+
+// sameOrClose returns whether a and b are equal, both NaN or within the
+// relative tolerance tol of each other.
+func sameOrClose(a, b, tol float64) bool {
+	if a == b || (math.IsNaN(a) && math.IsNaN(b)) {
+		return math.Signbit(a) == math.Signbit(b) || math.IsNaN(a)
+	}
+	return math.Abs(a-b) <= tol*math.Abs(b)
+}
+
+func TestElementaryInPlace(t *testing.T) {
+	t.Parallel()
+	s := []float64{0.5, 1, 2, 3}
+	for _, test := range []struct {
+		name    string
+		inPlace func(Accuracy, []float64)
+		to      func([]float64, Accuracy, []float64) []float64
+	}{
+		{name: "Exp", inPlace: Exp, to: ExpTo},
+		{name: "Log", inPlace: Log, to: LogTo},
+		{name: "Sqrt", inPlace: Sqrt, to: SqrtTo},
+		{name: "Tanh", inPlace: Tanh, to: TanhTo},
+	} {
+		for _, acc := range []Accuracy{Precise, Fast} {
+			want := test.to(make([]float64, len(s)), acc, s)
+			dst := append([]float64(nil), s...)
+			test.inPlace(acc, dst)
+			if !Equal(dst, want) {
+				t.Errorf("unexpected in place %s for accuracy %d: got:%v want:%v", test.name, acc, dst, want)
+			}
+		}
+		if !Panics(func() { test.to(make([]float64, 2), Precise, s) }) {
+			t.Errorf("%sTo did not panic with length mismatch", test.name)
+		}
+		if !Panics(func() { test.to(make([]float64, len(s)), Accuracy(-1), s) }) {
+			t.Errorf("%sTo did not panic with invalid accuracy", test.name)
+		}
+	}
+}
+
+func BenchmarkElementary(b *testing.B) {
+	for _, f := range elementaryFuncs {
+		for _, acc := range []Accuracy{Precise, Fast} {
+			b.Run(fmt.Sprintf("%s/acc=%d", f.name, acc), func(b *testing.B) {
+				rnd := rand.New(rand.NewPCG(1, 1))
+				s := make([]float64, Medium)
+				for i := range s {
+					s[i] = f.lo + (f.hi-f.lo)*rnd.Float64()
+					if f.name == "Log" || f.name == "Sqrt" {
+						s[i] = math.Abs(s[i])
+					}
+				}
+				dst := make([]float64, len(s))
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					f.fn(dst, acc, s)
+				}
+			})
+		}
+	}
+}
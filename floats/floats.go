@@ -7,6 +7,7 @@ package floats
 import (
 	"errors"
 	"math"
+	"math/bits"
 	"slices"
 	"sort"
 
@@ -19,6 +20,8 @@ const (
 	shortSpan    = "floats: slice length less than 2"
 	badLength    = "floats: slice lengths do not match"
 	badDstLength = "floats: destination slice length does not match input"
+	badAccuracy  = "floats: invalid accuracy"
+	badBlockSize = "floats: block size less than 1"
 )
 
 // Add adds, element-wise, the elements of s and dst, and stores the result in dst.
@@ -761,6 +764,8 @@ func SubTo(dst, s, t []float64) []float64 {
 }
 
 // Sum returns the sum of the elements of the slice.
+// See SumCompensated, SumExact, SumKahan and SumPairwise for
+// more accurate alternatives.
 func Sum(s []float64) float64 {
 	return f64.Sum(s)
 }
@@ -806,3 +811,141 @@ func SumCompensated(s []float64) float64 {
 	}
 	return sum + c
 }
+
+// SumExact returns the sum of the elements of the slice correctly rounded
+// to the nearest float64, with ties rounded to even. If the sum exceeds
+// the range of float64, SumExact returns an infinity. If the slice holds
+// infinities, SumExact returns their sum, and it returns NaN if the slice
+// holds a NaN. When the running sum exceeds the range of float64, elements
+// with magnitude near the smallest normal number may not be accounted for
+// exactly.
+//
+// SumExact uses Shewchuk's algorithm, which maintains the sum exactly as
+// a list of non-overlapping partial sums. See Shewchuk, "Adaptive
+// Precision Floating-Point Arithmetic and Fast Robust Geometric
+// Predicates", Discrete Comput. Geom. 18:305-363, 1997,
+// doi:10.1007/PL00009321. The cost of SumExact is proportional to the
+// number of partial sums, which is usually small but can be as large as
+// about 40 for slices spanning the range of float64.
+func SumExact(s []float64) float64 {
+	sum, ok := sumExact(s, 0)
+	if !ok {
+		// An intermediate sum overflowed. Sum the elements scaled
+		// by a power of two that prevents overflow, which loses
+		// only bits of the elements below the smallest normal.
+		k := bits.Len(uint(len(s)))
+		sum, _ = sumExact(s, k)
+		sum = math.Ldexp(sum, k)
+	}
+	return sum
+}
+
+// sumExact returns the correctly rounded sum of the elements of s each
+// multiplied by 2**-k. It returns false if an intermediate sum overflows.
+func sumExact(s []float64, k int) (sum float64, ok bool) {
+	var (
+		buf      [32]float64
+		partials = buf[:0]
+		special  float64 // Sum of the non-finite elements.
+		infSum   float64 // Sum of the infinite elements.
+	)
+	for _, x := range s {
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			if math.IsInf(x, 0) {
+				infSum += x
+			}
+			special += x
+			continue
+		}
+		if k != 0 {
+			x = math.Ldexp(x, -k)
+		}
+		i := 0
+		for _, y := range partials {
+			if math.Abs(x) < math.Abs(y) {
+				x, y = y, x
+			}
+			hi := x + y
+			lo := y - (hi - x)
+			if lo != 0 {
+				partials[i] = lo
+				i++
+			}
+			x = hi
+		}
+		if math.IsInf(x, 0) {
+			return 0, false
+		}
+		partials = append(partials[:i], x)
+	}
+	if special != 0 {
+		if math.IsNaN(infSum) {
+			return math.NaN(), true
+		}
+		return special, true
+	}
+
+	// Add the partials from the largest, stopping when the sum becomes
+	// inexact.
+	n := len(partials)
+	if n == 0 {
+		return 0, true
+	}
+	var lo float64
+	hi := partials[n-1]
+	for n--; n > 0; {
+		x := hi
+		y := partials[n-1]
+		n--
+		hi = x + y
+		lo = y - (hi - x)
+		if lo != 0 {
+			break
+		}
+	}
+	// Correct the rounding of hi when lo is exactly half a unit in the
+	// last place and the remaining partials have the same sign as lo.
+	if n > 0 && ((lo < 0 && partials[n-1] < 0) || (lo > 0 && partials[n-1] > 0)) {
+		y := lo * 2
+		x := hi + y
+		if y == x-hi {
+			hi = x
+		}
+	}
+	return hi, true
+}
+
+// SumKahan returns the sum of the elements of the slice calculated using
+// Kahan's compensated summation algorithm. SumKahan is more accurate than
+// Sum, but less accurate than SumCompensated when the magnitude of an
+// element exceeds that of the running sum.
+// See https://en.wikipedia.org/wiki/Kahan_summation_algorithm for details.
+func SumKahan(s []float64) float64 {
+	var sum, c float64
+	for _, x := range s {
+		// These type conversions are here to prevent a sufficiently
+		// smart compiler from optimising away these operations.
+		y := x - c
+		t := float64(sum + y)
+		c = float64(t-sum) - y
+		sum = t
+	}
+	return sum
+}
+
+// SumPairwise returns the sum of the elements of the slice calculated by
+// recursively summing the two halves of the slice, with slices of at most
+// block elements summed directly by Sum. The error bound of SumPairwise
+// grows with log(len(s)/block) + block rather than with len(s) as for
+// naive summation, at little additional cost. SumPairwise panics if block
+// is less than 1.
+func SumPairwise(s []float64, block int) float64 {
+	if block < 1 {
+		panic(badBlockSize)
+	}
+	if len(s) <= block {
+		return f64.Sum(s)
+	}
+	m := len(s) / 2
+	return SumPairwise(s[:m], block) + SumPairwise(s[m:], block)
+}
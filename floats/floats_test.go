@@ -7,6 +7,7 @@ package floats
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"math/rand/v2"
 	"sort"
 	"strconv"
@@ -1846,3 +1847,116 @@ func BenchmarkReverseSmall(b *testing.B)  { benchmarkReverse(b, Small) }
 func BenchmarkReverseMedium(b *testing.B) { benchmarkReverse(b, Medium) }
 func BenchmarkReverseLarge(b *testing.B)  { benchmarkReverse(b, Large) }
 func BenchmarkReverseHuge(b *testing.B)   { benchmarkReverse(b, Huge) }
+
+// exactSum returns the correctly rounded sum of the finite elements of s.
+func exactSum(s []float64) float64 {
+	sum := new(big.Float).SetPrec(4096)
+	for _, v := range s {
+		sum.Add(sum, new(big.Float).SetFloat64(v))
+	}
+	f, _ := sum.Float64()
+	return f
+}
+
+func TestSumExact(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for i, test := range []struct {
+		s    []float64
+		want float64
+	}{
+		{s: nil, want: 0},
+		{s: []float64{1}, want: 1},
+		{s: []float64{1e100, 1, -1e100}, want: 1},
+		{s: []float64{1, 1e100, 1, -1e100}, want: 2},
+		{s: []float64{0.1, 0.2, 0.3, -0.6}, want: exactSum([]float64{0.1, 0.2, 0.3, -0.6})},
+		{s: []float64{1.2e20, 0.1, -2.4e20, -0.1, 1.2e20, 0.2, 0.2}, want: 0.4},
+		// Ties across several partials round to even.
+		{s: []float64{1, 0x1p-53, 0x1p-106}, want: 1 + 0x1p-52},
+		{s: []float64{1, 0x1p-53, -0x1p-106}, want: 1},
+		{s: []float64{math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64}, want: math.MaxFloat64},
+		{s: []float64{math.MaxFloat64, math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64, -math.MaxFloat64}, want: math.MaxFloat64},
+		{s: []float64{math.MaxFloat64, math.MaxFloat64}, want: inf},
+		{s: []float64{-math.MaxFloat64, -math.MaxFloat64, 1}, want: -inf},
+		{s: []float64{1, inf, 2}, want: inf},
+		{s: []float64{-inf, 1, -inf}, want: -inf},
+		{s: []float64{inf, 1, -inf}, want: math.NaN()},
+		{s: []float64{1, math.NaN()}, want: math.NaN()},
+		{s: []float64{math.MaxFloat64, math.MaxFloat64, inf}, want: inf},
+	} {
+		got := SumExact(test.s)
+		if !scalar.Same(got, test.want) {
+			t.Errorf("unexpected SumExact for test %d: got:%v want:%v", i, got, test.want)
+		}
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 1; n <= 1000; n *= 10 {
+		for trial := 0; trial < 100; trial++ {
+			s := make([]float64, n)
+			for i := range s {
+				s[i] = math.Ldexp(rnd.NormFloat64(), rnd.IntN(200)-100)
+			}
+			// Add cancelling elements.
+			for i := 0; i < n/2; i++ {
+				s = append(s, -s[rnd.IntN(n)])
+			}
+			if got, want := SumExact(s), exactSum(s); got != want {
+				t.Errorf("unexpected SumExact for n=%d: got:%v want:%v", n, got, want)
+			}
+		}
+	}
+}
+
+func TestSumKahanPairwise(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 1000000
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = 0.1 + 1e-3*rnd.Float64()
+	}
+	want := exactSum(s)
+	naive := math.Abs(Sum(s) - want)
+	for _, test := range []struct {
+		name string
+		sum  float64
+		tol  float64
+	}{
+		{name: "SumKahan", sum: SumKahan(s), tol: 1e-16},
+		{name: "SumCompensated", sum: SumCompensated(s), tol: 1e-16},
+		{name: "SumPairwise(1)", sum: SumPairwise(s, 1), tol: 1e-14},
+		{name: "SumPairwise(128)", sum: SumPairwise(s, 128), tol: 1e-14},
+	} {
+		err := math.Abs(test.sum - want)
+		if err > test.tol*math.Abs(want) {
+			t.Errorf("unexpected error for %s: got:%v want:<=%v", test.name, err, test.tol*math.Abs(want))
+		}
+		if naive != 0 && err > naive {
+			t.Errorf("%s less accurate than Sum: %v > %v", test.name, err, naive)
+		}
+	}
+
+	for _, s := range [][]float64{nil, {1}, {1, 2, 3}} {
+		if got, want := SumPairwise(s, 1), Sum(s); got != want {
+			t.Errorf("unexpected SumPairwise for %v: got:%v want:%v", s, got, want)
+		}
+		if got, want := SumKahan(s), Sum(s); got != want {
+			t.Errorf("unexpected SumKahan for %v: got:%v want:%v", s, got, want)
+		}
+	}
+	if !Panics(func() { SumPairwise(s, 0) }) {
+		t.Errorf("SumPairwise did not panic with zero block size")
+	}
+}
+
+func benchmarkSumExact(b *testing.B, size int) {
+	s := randomSlice(size, rand.NewPCG(1, 1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SumExact(s)
+	}
+}
+func BenchmarkSumExactSmall(b *testing.B) { benchmarkSumExact(b, Small) }
+func BenchmarkSumExactMed(b *testing.B)   { benchmarkSumExact(b, Medium) }
+func BenchmarkSumExactLarge(b *testing.B) { benchmarkSumExact(b, Large) }
@@ -0,0 +1,357 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats
+
+import (
+	"math"
+	"runtime"
+	"sync"
+
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// DefaultParallelThreshold is the slice length below which the methods of
+// a Parallel with a zero Threshold operate serially.
+const DefaultParallelThreshold = 1 << 16
+
+// Parallel provides variants of the functions of the floats package that
+// split long slices into contiguous chunks processed concurrently. Slices
+// shorter than the threshold are processed serially by the corresponding
+// package level function.
+//
+// The reductions combine the results of the chunks in order, so they are
+// deterministic for given slice lengths and a given number of workers,
+// but may differ in the last bits from the serial functions.
+//
+// The zero value of Parallel is ready to use.
+type Parallel struct {
+	// Workers is the maximum number of goroutines used by an
+	// operation. If Workers is zero, runtime.GOMAXPROCS(0) is used.
+	Workers int
+
+	// Threshold is the minimum number of elements processed by each
+	// goroutine. If Threshold is zero, DefaultParallelThreshold is used.
+	Threshold int
+}
+
+// chunks returns the number of chunks used to process n elements and
+// the number of elements in each chunk but the last. The chunk size is a
+// multiple of 8 elements, so that chunks of aligned slices do not share
+// cache lines.
+func (p Parallel) chunks(n int) (k, size int) {
+	workers := p.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	threshold := p.Threshold
+	if threshold <= 0 {
+		threshold = DefaultParallelThreshold
+	}
+	k = min(workers, n/threshold)
+	if k <= 1 {
+		return 1, n
+	}
+	size = ((n+k-1)/k + 7) &^ 7
+	return (n + size - 1) / size, size
+}
+
+// do calls fn concurrently for each of the chunks of [0, n) with the
+// given size, passing the index of the chunk and its bounds.
+func do(n, size int, fn func(c, lo, hi int)) {
+	var wg sync.WaitGroup
+	for c, lo := 0, 0; lo < n; c, lo = c+1, lo+size {
+		hi := min(lo+size, n)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(c, lo, hi)
+		}()
+	}
+	wg.Wait()
+}
+
+// Add adds, element-wise, the elements of s and dst, and stores the result in dst.
+// It panics if the argument lengths do not match.
+func (p Parallel) Add(dst, s []float64) {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	k, size := p.chunks(len(dst))
+	if k == 1 {
+		Add(dst, s)
+		return
+	}
+	do(len(dst), size, func(_, lo, hi int) {
+		f64.AxpyUnitaryTo(dst[lo:hi], 1, s[lo:hi], dst[lo:hi])
+	})
+}
+
+// AddTo adds, element-wise, the elements of s and t and
+// stores the result in dst.
+// It panics if the argument lengths do not match.
+func (p Parallel) AddTo(dst, s, t []float64) []float64 {
+	if len(s) != len(t) {
+		panic(badLength)
+	}
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	k, size := p.chunks(len(dst))
+	if k == 1 {
+		return AddTo(dst, s, t)
+	}
+	do(len(dst), size, func(_, lo, hi int) {
+		f64.AxpyUnitaryTo(dst[lo:hi], 1, s[lo:hi], t[lo:hi])
+	})
+	return dst
+}
+
+// AddScaled performs dst = dst + alpha * s.
+// It panics if the slice argument lengths do not match.
+func (p Parallel) AddScaled(dst []float64, alpha float64, s []float64) {
+	if len(dst) != len(s) {
+		panic(badLength)
+	}
+	k, size := p.chunks(len(dst))
+	if k == 1 {
+		AddScaled(dst, alpha, s)
+		return
+	}
+	do(len(dst), size, func(_, lo, hi int) {
+		f64.AxpyUnitaryTo(dst[lo:hi], alpha, s[lo:hi], dst[lo:hi])
+	})
+}
+
+// AddScaledTo performs dst = y + alpha * s, where alpha is a scalar,
+// and dst, y and s are all slices.
+// It panics if the slice argument lengths do not match.
+func (p Parallel) AddScaledTo(dst, y []float64, alpha float64, s []float64) []float64 {
+	if len(s) != len(y) {
+		panic(badLength)
+	}
+	if len(dst) != len(y) {
+		panic(badDstLength)
+	}
+	k, size := p.chunks(len(dst))
+	if k == 1 {
+		return AddScaledTo(dst, y, alpha, s)
+	}
+	do(len(dst), size, func(_, lo, hi int) {
+		f64.AxpyUnitaryTo(dst[lo:hi], alpha, s[lo:hi], y[lo:hi])
+	})
+	return dst
+}
+
+// Dot computes the dot product of s1 and s2, i.e.
+// sum_{i = 1}^N s1[i]*s2[i].
+// It panics if the argument lengths do not match.
+func (p Parallel) Dot(s1, s2 []float64) float64 {
+	if len(s1) != len(s2) {
+		panic(badLength)
+	}
+	k, size := p.chunks(len(s1))
+	if k == 1 {
+		return Dot(s1, s2)
+	}
+	part := make([]float64, k)
+	do(len(s1), size, func(c, lo, hi int) {
+		part[c] = f64.DotUnitary(s1[lo:hi], s2[lo:hi])
+	})
+	var sum float64
+	for _, v := range part {
+		sum += v
+	}
+	return sum
+}
+
+// Max returns the maximum value in the input slice. If the slice is empty, Max will panic.
+func (p Parallel) Max(s []float64) float64 {
+	k, size := p.chunks(len(s))
+	if k == 1 {
+		return Max(s)
+	}
+	part := make([]int, k)
+	do(len(s), size, func(c, lo, hi int) {
+		part[c] = lo + MaxIdx(s[lo:hi])
+	})
+	return s[p.reduceIdx(s, part, func(a, b float64) bool { return a > b })]
+}
+
+// Min returns the minimum value in the input slice. If the slice is empty, Min will panic.
+func (p Parallel) Min(s []float64) float64 {
+	k, size := p.chunks(len(s))
+	if k == 1 {
+		return Min(s)
+	}
+	part := make([]int, k)
+	do(len(s), size, func(c, lo, hi int) {
+		part[c] = lo + MinIdx(s[lo:hi])
+	})
+	return s[p.reduceIdx(s, part, func(a, b float64) bool { return a < b })]
+}
+
+// reduceIdx returns the element of idx giving the first element of s that
+// is better than all others, consistent with the NaN handling of MaxIdx and
+// MinIdx.
+func (Parallel) reduceIdx(s []float64, idx []int, better func(a, b float64) bool) int {
+	ind := idx[0]
+	for _, i := range idx[1:] {
+		if better(s[i], s[ind]) || math.IsNaN(s[ind]) {
+			ind = i
+		}
+	}
+	return ind
+}
+
+// Mul performs element-wise multiplication between dst
+// and s and stores the value in dst.
+// It panics if the argument lengths do not match.
+func (p Parallel) Mul(dst, s []float64) {
+	if len(dst) != len(s) {
+		panic(badLength)
+	}
+	k, size := p.chunks(len(dst))
+	if k == 1 {
+		Mul(dst, s)
+		return
+	}
+	do(len(dst), size, func(_, lo, hi int) {
+		Mul(dst[lo:hi], s[lo:hi])
+	})
+}
+
+// MulTo performs element-wise multiplication between s
+// and t and stores the value in dst.
+// It panics if the argument lengths do not match.
+func (p Parallel) MulTo(dst, s, t []float64) []float64 {
+	if len(s) != len(t) {
+		panic(badLength)
+	}
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	k, size := p.chunks(len(dst))
+	if k == 1 {
+		return MulTo(dst, s, t)
+	}
+	do(len(dst), size, func(_, lo, hi int) {
+		MulTo(dst[lo:hi], s[lo:hi], t[lo:hi])
+	})
+	return dst
+}
+
+// Norm returns the L norm of the slice S, defined as
+// (sum_{i=1}^N s[i]^L)^{1/L}
+// Special cases:
+// L = math.Inf(1) gives the maximum absolute value.
+// Does not correctly compute the zero norm (use Count).
+func (p Parallel) Norm(s []float64, L float64) float64 {
+	k, size := p.chunks(len(s))
+	if k == 1 {
+		return Norm(s, L)
+	}
+	part := make([]float64, k)
+	do(len(s), size, func(c, lo, hi int) {
+		part[c] = Norm(s[lo:hi], L)
+	})
+	switch {
+	case L == 2:
+		// Combine the partial norms without overflow.
+		var norm float64
+		for _, v := range part {
+			norm = math.Hypot(norm, v)
+		}
+		return norm
+	case L == 1, math.IsInf(L, 1):
+		return Norm(part, L)
+	default:
+		var sum float64
+		for _, v := range part {
+			sum += math.Pow(v, L)
+		}
+		return math.Pow(sum, 1/L)
+	}
+}
+
+// Scale multiplies every element in dst by the scalar c.
+func (p Parallel) Scale(c float64, dst []float64) {
+	k, size := p.chunks(len(dst))
+	if k == 1 {
+		Scale(c, dst)
+		return
+	}
+	do(len(dst), size, func(_, lo, hi int) {
+		f64.ScalUnitary(c, dst[lo:hi])
+	})
+}
+
+// ScaleTo multiplies the elements in s by c and stores the result in dst.
+// It panics if the slice argument lengths do not match.
+func (p Parallel) ScaleTo(dst []float64, c float64, s []float64) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	k, size := p.chunks(len(dst))
+	if k == 1 {
+		return ScaleTo(dst, c, s)
+	}
+	do(len(dst), size, func(_, lo, hi int) {
+		f64.ScalUnitaryTo(dst[lo:hi], c, s[lo:hi])
+	})
+	return dst
+}
+
+// Sub subtracts, element-wise, the elements of s from dst.
+// It panics if the argument lengths do not match.
+func (p Parallel) Sub(dst, s []float64) {
+	if len(dst) != len(s) {
+		panic(badLength)
+	}
+	k, size := p.chunks(len(dst))
+	if k == 1 {
+		Sub(dst, s)
+		return
+	}
+	do(len(dst), size, func(_, lo, hi int) {
+		f64.AxpyUnitaryTo(dst[lo:hi], -1, s[lo:hi], dst[lo:hi])
+	})
+}
+
+// SubTo subtracts, element-wise, the elements of t from s and
+// stores the result in dst.
+// It panics if the argument lengths do not match.
+func (p Parallel) SubTo(dst, s, t []float64) []float64 {
+	if len(s) != len(t) {
+		panic(badLength)
+	}
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	k, size := p.chunks(len(dst))
+	if k == 1 {
+		return SubTo(dst, s, t)
+	}
+	do(len(dst), size, func(_, lo, hi int) {
+		f64.AxpyUnitaryTo(dst[lo:hi], -1, t[lo:hi], s[lo:hi])
+	})
+	return dst
+}
+
+// Sum returns the sum of the elements of the slice.
+func (p Parallel) Sum(s []float64) float64 {
+	k, size := p.chunks(len(s))
+	if k == 1 {
+		return Sum(s)
+	}
+	part := make([]float64, k)
+	do(len(s), size, func(c, lo, hi int) {
+		part[c] = f64.Sum(s[lo:hi])
+	})
+	var sum float64
+	for _, v := range part {
+		sum += v
+	}
+	return sum
+}
@@ -0,0 +1,201 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestParallel(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, p := range []Parallel{
+		{},
+		{Workers: 1, Threshold: 1},
+		{Workers: 3, Threshold: 1},
+		{Workers: 4, Threshold: 10},
+		{Workers: 16, Threshold: 7},
+	} {
+		for _, n := range []int{0, 1, 7, 8, 9, 40, 100, 1001} {
+			prefix := fmt.Sprintf("%+v n=%d", p, n)
+			s := randomSlice(n, src)
+			u := randomSlice(n, src)
+			for i := range s {
+				s[i] -= 0.5
+			}
+			const tol = 1e-12
+
+			if got, want := p.Sum(s), Sum(s); !scalarClose(got, want, tol) {
+				t.Errorf("%s: unexpected Sum: got:%v want:%v", prefix, got, want)
+			}
+			if got, want := p.Dot(s, u), Dot(s, u); !scalarClose(got, want, tol) {
+				t.Errorf("%s: unexpected Dot: got:%v want:%v", prefix, got, want)
+			}
+			for _, L := range []float64{1, 2, 3, math.Inf(1)} {
+				if got, want := p.Norm(s, L), Norm(s, L); !scalarClose(got, want, tol) {
+					t.Errorf("%s: unexpected Norm(%v): got:%v want:%v", prefix, L, got, want)
+				}
+			}
+			if n > 0 {
+				if got, want := p.Max(s), Max(s); got != want {
+					t.Errorf("%s: unexpected Max: got:%v want:%v", prefix, got, want)
+				}
+				if got, want := p.Min(s), Min(s); got != want {
+					t.Errorf("%s: unexpected Min: got:%v want:%v", prefix, got, want)
+				}
+			}
+
+			for _, test := range []struct {
+				name      string
+				par, want func(dst []float64)
+			}{
+				{
+					name: "Add",
+					par:  func(dst []float64) { p.Add(dst, s) },
+					want: func(dst []float64) { Add(dst, s) },
+				},
+				{
+					name: "AddTo",
+					par:  func(dst []float64) { p.AddTo(dst, s, u) },
+					want: func(dst []float64) { AddTo(dst, s, u) },
+				},
+				{
+					name: "AddScaled",
+					par:  func(dst []float64) { p.AddScaled(dst, 1.5, s) },
+					want: func(dst []float64) { AddScaled(dst, 1.5, s) },
+				},
+				{
+					name: "AddScaledTo",
+					par:  func(dst []float64) { p.AddScaledTo(dst, u, -2, s) },
+					want: func(dst []float64) { AddScaledTo(dst, u, -2, s) },
+				},
+				{
+					name: "Mul",
+					par:  func(dst []float64) { p.Mul(dst, s) },
+					want: func(dst []float64) { Mul(dst, s) },
+				},
+				{
+					name: "MulTo",
+					par:  func(dst []float64) { p.MulTo(dst, s, u) },
+					want: func(dst []float64) { MulTo(dst, s, u) },
+				},
+				{
+					name: "Scale",
+					par:  func(dst []float64) { p.Scale(3, dst) },
+					want: func(dst []float64) { Scale(3, dst) },
+				},
+				{
+					name: "ScaleTo",
+					par:  func(dst []float64) { p.ScaleTo(dst, 3, s) },
+					want: func(dst []float64) { ScaleTo(dst, 3, s) },
+				},
+				{
+					name: "Sub",
+					par:  func(dst []float64) { p.Sub(dst, s) },
+					want: func(dst []float64) { Sub(dst, s) },
+				},
+				{
+					name: "SubTo",
+					par:  func(dst []float64) { p.SubTo(dst, s, u) },
+					want: func(dst []float64) { SubTo(dst, s, u) },
+				},
+			} {
+				got := append([]float64(nil), u...)
+				want := append([]float64(nil), u...)
+				test.par(got)
+				test.want(want)
+				if !Equal(got, want) {
+					t.Errorf("%s: unexpected %s result: got:%v want:%v", prefix, test.name, got, want)
+				}
+			}
+		}
+	}
+}
+
+// scalarClose returns whether a and b are within the relative tolerance tol.
+func scalarClose(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol*math.Max(1, math.Abs(b))
+}
+
+func TestParallelMaxMinNaN(t *testing.T) {
+	t.Parallel()
+	p := Parallel{Workers: 4, Threshold: 2}
+	nan := math.NaN()
+	for _, test := range []struct {
+		s        []float64
+		max, min float64
+	}{
+		{s: []float64{nan, nan, nan, nan, nan, nan, nan, nan, 1, nan, nan, nan, nan, nan, nan, nan, 3, nan}, max: 3, min: 1},
+		{s: []float64{5, 1, 2, 3, 4, 5, 6, 7, nan, nan, nan, nan, nan, nan, nan, nan, -1, 9}, max: 9, min: -1},
+	} {
+		if got := p.Max(test.s); got != test.max {
+			t.Errorf("unexpected Max for %v: got:%v want:%v", test.s, got, test.max)
+		}
+		if got := p.Min(test.s); got != test.min {
+			t.Errorf("unexpected Min for %v: got:%v want:%v", test.s, got, test.min)
+		}
+	}
+	if got := p.Max([]float64{nan, nan, nan, nan, nan, nan, nan, nan, nan, nan}); !math.IsNaN(got) {
+		t.Errorf("unexpected Max for all NaN: got:%v want:NaN", got)
+	}
+}
+
+func TestParallelPanics(t *testing.T) {
+	t.Parallel()
+	p := Parallel{Workers: 2, Threshold: 1}
+	a := make([]float64, 20)
+	b := make([]float64, 21)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"Add", func() { p.Add(a, b) }},
+		{"AddTo", func() { p.AddTo(a, a, b) }},
+		{"AddScaled", func() { p.AddScaled(a, 1, b) }},
+		{"AddScaledTo", func() { p.AddScaledTo(b, a, 1, a) }},
+		{"Dot", func() { p.Dot(a, b) }},
+		{"Max", func() { p.Max(nil) }},
+		{"Min", func() { p.Min(nil) }},
+		{"Mul", func() { p.Mul(a, b) }},
+		{"MulTo", func() { p.MulTo(b, a, a) }},
+		{"ScaleTo", func() { p.ScaleTo(a, 1, b) }},
+		{"Sub", func() { p.Sub(a, b) }},
+		{"SubTo", func() { p.SubTo(a, b, b) }},
+	} {
+		if !Panics(test.fn) {
+			t.Errorf("%s did not panic with length mismatch", test.name)
+		}
+	}
+}
+
+func benchmarkParallelSum(b *testing.B, p Parallel, size int) {
+	s := randomSlice(size, rand.NewPCG(1, 1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Sum(s)
+	}
+}
+func BenchmarkParallelSumLarge(b *testing.B) { benchmarkParallelSum(b, Parallel{}, Large) }
+func BenchmarkParallelSumHuge(b *testing.B)  { benchmarkParallelSum(b, Parallel{}, Huge) }
+
+func benchmarkParallelAddScaledTo(b *testing.B, p Parallel, size int) {
+	src := rand.NewPCG(1, 1)
+	s := randomSlice(size, src)
+	y := randomSlice(size, src)
+	dst := make([]float64, size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.AddScaledTo(dst, y, 2, s)
+	}
+}
+func BenchmarkParallelAddScaledToLarge(b *testing.B) {
+	benchmarkParallelAddScaledTo(b, Parallel{}, Large)
+}
+func BenchmarkParallelAddScaledToHuge(b *testing.B) {
+	benchmarkParallelAddScaledTo(b, Parallel{}, Huge)
+}
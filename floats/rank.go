@@ -0,0 +1,260 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats
+
+import (
+	"cmp"
+	"math"
+	"math/bits"
+	"slices"
+)
+
+// ArgsortTo stores in inds the indices of the elements of s in increasing
+// order of their values, so that s[inds[0]] <= s[inds[1]] <= ..., without
+// modifying s. The order of equal elements is kept. NaN values are ordered
+// before all other values, as by slices.Sort.
+// It panics if the argument lengths do not match.
+func ArgsortTo(inds []int, s []float64) []int {
+	if len(inds) != len(s) {
+		panic(badDstLength)
+	}
+	for i := range inds {
+		inds[i] = i
+	}
+	slices.SortStableFunc(inds, func(a, b int) int {
+		return cmp.Compare(s[a], s[b])
+	})
+	return inds
+}
+
+// TiePolicy specifies the rank given by Rank to equal elements.
+type TiePolicy int
+
+const (
+	// RankAverage gives equal elements the average of the ranks
+	// they span, also known as fractional ranking.
+	RankAverage TiePolicy = iota
+	// RankMin gives equal elements the lowest of the ranks they
+	// span, also known as competition ranking.
+	RankMin
+	// RankMax gives equal elements the highest of the ranks they
+	// span.
+	RankMax
+	// RankDense gives equal elements the same rank, with the next
+	// distinct value given the next rank.
+	RankDense
+	// RankOrdinal gives each element a distinct rank, with equal
+	// elements ranked in the order they appear in the slice.
+	RankOrdinal
+)
+
+// Rank stores in dst the ranks of the elements of s, starting at 1 for the
+// smallest element, with the ranks of equal elements given by ties. NaN
+// elements are not ranked and are given a rank of NaN. Rank allocates a
+// slice of len(s) indices.
+// It panics if the argument lengths do not match or ties is not a valid
+// TiePolicy.
+func Rank(dst, s []float64, ties TiePolicy) []float64 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	if ties < RankAverage || RankOrdinal < ties {
+		panic("floats: invalid tie policy")
+	}
+	inds := make([]int, 0, len(s))
+	for i, v := range s {
+		if math.IsNaN(v) {
+			continue
+		}
+		inds = append(inds, i)
+	}
+	slices.SortStableFunc(inds, func(a, b int) int {
+		return cmp.Compare(s[a], s[b])
+	})
+	// Fill dst after reading s, since they may alias.
+	ranks := make([]float64, len(inds))
+	var dense float64
+	for i := 0; i < len(inds); {
+		j := i + 1
+		for j < len(inds) && s[inds[j]] == s[inds[i]] {
+			j++
+		}
+		dense++
+		for k := i; k < j; k++ {
+			switch ties {
+			case RankAverage:
+				ranks[k] = float64(i+1+j) / 2
+			case RankMin:
+				ranks[k] = float64(i + 1)
+			case RankMax:
+				ranks[k] = float64(j)
+			case RankDense:
+				ranks[k] = dense
+			case RankOrdinal:
+				ranks[k] = float64(k + 1)
+			}
+		}
+		i = j
+	}
+	for i, v := range s {
+		if math.IsNaN(v) {
+			dst[i] = math.NaN()
+		}
+	}
+	for k, i := range inds {
+		dst[i] = ranks[k]
+	}
+	return dst
+}
+
+// Select partially sorts s so that s[k] holds the value it would hold if s
+// were sorted in increasing order, with no larger values before it and no
+// smaller values after it, and returns s[k]. NaN values are ordered before
+// all other values, as by slices.Sort. The median of s with odd length is
+// Select(s, len(s)/2).
+//
+// Select uses the introselect algorithm described in Musser, "Introspective
+// sorting and selection algorithms", Softw. Pract. Exper. 27:983-993,
+// 1997, with a median of medians pivot as the fallback so that Select
+// takes O(n) time in the worst case.
+// It panics if k is not a valid index of s.
+func Select(s []float64, k int) float64 {
+	if k < 0 || len(s) <= k {
+		panic("floats: index out of range")
+	}
+	introselect(s, k, cmp.Less[float64])
+	return s[k]
+}
+
+// TopK returns the indices of the k largest elements of s in decreasing
+// order of their values, with equal elements in increasing order of index.
+// NaN values are ordered after all other values. If k is greater than
+// len(s), the indices of all the elements are returned. TopK will reslice
+// inds to have 0 length and append the indices to inds. TopK takes
+// O(n + k log k) time.
+// It panics if k is negative.
+func TopK(inds []int, s []float64, k int) []int {
+	if k < 0 {
+		panic("floats: negative k")
+	}
+	inds = inds[:0]
+	if k == 0 {
+		return inds
+	}
+	for i := range s {
+		inds = append(inds, i)
+	}
+	before := func(a, b int) bool {
+		if c := cmp.Compare(s[a], s[b]); c != 0 {
+			return c > 0
+		}
+		return a < b
+	}
+	if k < len(inds) {
+		introselect(inds, k-1, before)
+		inds = inds[:k]
+	}
+	slices.SortFunc(inds, func(a, b int) int {
+		if before(a, b) {
+			return -1
+		}
+		if before(b, a) {
+			return 1
+		}
+		return 0
+	})
+	return inds
+}
+
+// introselect partially sorts s so that s[k] holds the element it would
+// hold if s were sorted by less, with no element after it less than it and
+// no element before it greater than it.
+func introselect[T any](s []T, k int, less func(a, b T) bool) {
+	// Fall back to the median of medians pivot when the number of
+	// partitions exceeds twice that expected with good pivots.
+	depth := 2 * bits.Len(uint(len(s)))
+	lo, hi := 0, len(s)
+	for hi-lo > 12 {
+		var p int
+		if depth > 0 {
+			depth--
+			p = lo + medianOfThree(s[lo:hi], less)
+		} else {
+			p = lo + medianOfMedians(s[lo:hi], less)
+		}
+		lt, gt := partition3(s[lo:hi], p-lo, less)
+		switch {
+		case k < lo+lt:
+			hi = lo + lt
+		case k >= lo+gt:
+			lo += gt
+		default:
+			return
+		}
+	}
+	insertionSort(s[lo:hi], less)
+}
+
+// partition3 partitions s around the value of s[p] into the elements less
+// than the pivot, s[:lt], equal to the pivot, s[lt:gt], and greater than
+// the pivot, s[gt:].
+func partition3[T any](s []T, p int, less func(a, b T) bool) (lt, gt int) {
+	pivot := s[p]
+	lt, gt = 0, len(s)
+	for i := 0; i < gt; {
+		switch {
+		case less(s[i], pivot):
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case less(pivot, s[i]):
+			gt--
+			s[gt], s[i] = s[i], s[gt]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+// medianOfThree returns the index of the median of the first, middle and
+// last elements of s.
+func medianOfThree[T any](s []T, less func(a, b T) bool) int {
+	a, b, c := 0, len(s)/2, len(s)-1
+	if less(s[b], s[a]) {
+		a, b = b, a
+	}
+	if less(s[c], s[b]) {
+		b = c
+		if less(s[b], s[a]) {
+			b = a
+		}
+	}
+	return b
+}
+
+// medianOfMedians moves the medians of groups of five elements of s to
+// the front of s and returns the index of their median, which is
+// guaranteed to lie between the 30th and 70th percentiles of s.
+func medianOfMedians[T any](s []T, less func(a, b T) bool) int {
+	var m int
+	for i := 0; i < len(s); i += 5 {
+		j := min(i+5, len(s))
+		insertionSort(s[i:j], less)
+		mid := i + (j-i)/2
+		s[m], s[mid] = s[mid], s[m]
+		m++
+	}
+	introselect(s[:m], m/2, less)
+	return m / 2
+}
+
+func insertionSort[T any](s []T, less func(a, b T) bool) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && less(s[j], s[j-1]); j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestArgsortTo(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	s := []float64{3, 1, nan, 2, 1, -1}
+	orig := append([]float64(nil), s...)
+	got := ArgsortTo(make([]int, len(s)), s)
+	want := []int{2, 5, 1, 4, 3, 0}
+	if !slices.Equal(got, want) {
+		t.Errorf("unexpected indices: got:%v want:%v", got, want)
+	}
+	if !Same(s, orig) {
+		t.Errorf("ArgsortTo modified its input")
+	}
+	if !Panics(func() { ArgsortTo(make([]int, 2), s) }) {
+		t.Errorf("ArgsortTo did not panic with length mismatch")
+	}
+}
+
+func TestRank(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	s := []float64{10, 20, 10, nan, 30, 20, 10}
+	for _, test := range []struct {
+		ties TiePolicy
+		want []float64
+	}{
+		{ties: RankAverage, want: []float64{2, 4.5, 2, nan, 6, 4.5, 2}},
+		{ties: RankMin, want: []float64{1, 4, 1, nan, 6, 4, 1}},
+		{ties: RankMax, want: []float64{3, 5, 3, nan, 6, 5, 3}},
+		{ties: RankDense, want: []float64{1, 2, 1, nan, 3, 2, 1}},
+		{ties: RankOrdinal, want: []float64{1, 4, 2, nan, 6, 5, 3}},
+	} {
+		got := Rank(make([]float64, len(s)), s, test.ties)
+		if !Same(got, test.want) {
+			t.Errorf("unexpected ranks for policy %d: got:%v want:%v", test.ties, got, test.want)
+		}
+		inPlace := append([]float64(nil), s...)
+		Rank(inPlace, inPlace, test.ties)
+		if !Same(inPlace, test.want) {
+			t.Errorf("unexpected in place ranks for policy %d: got:%v want:%v", test.ties, inPlace, test.want)
+		}
+	}
+	if !Panics(func() { Rank(make([]float64, 2), s, RankAverage) }) {
+		t.Errorf("Rank did not panic with length mismatch")
+	}
+	if !Panics(func() { Rank(make([]float64, len(s)), s, TiePolicy(-1)) }) {
+		t.Errorf("Rank did not panic with invalid tie policy")
+	}
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 12, 13, 50, 1000} {
+		for _, gen := range []struct {
+			name string
+			fn   func(i int) float64
+		}{
+			{"random", func(int) float64 { return rnd.NormFloat64() }},
+			{"few distinct", func(int) float64 { return float64(rnd.IntN(3)) }},
+			{"increasing", func(i int) float64 { return float64(i) }},
+			{"decreasing", func(i int) float64 { return float64(-i) }},
+			{"organ pipe", func(i int) float64 { return float64(min(i, n-i)) }},
+			{"with NaN", func(int) float64 {
+				if rnd.IntN(4) == 0 {
+					return math.NaN()
+				}
+				return rnd.NormFloat64()
+			}},
+		} {
+			s := make([]float64, n)
+			for i := range s {
+				s[i] = gen.fn(i)
+			}
+			sorted := slices.Clone(s)
+			slices.Sort(sorted)
+			for _, k := range []int{0, n / 3, n / 2, n - 1} {
+				c := slices.Clone(s)
+				got := Select(c, k)
+				if !Same([]float64{got}, []float64{sorted[k]}) {
+					t.Errorf("%s n=%d k=%d: unexpected value: got:%v want:%v", gen.name, n, k, got, sorted[k])
+				}
+				for i, v := range c {
+					if (i < k && v > got) || (i > k && v < got) {
+						t.Errorf("%s n=%d k=%d: element %d out of place: %v", gen.name, n, k, i, v)
+					}
+				}
+				slices.Sort(c)
+				if !Same(c, sorted) {
+					t.Errorf("%s n=%d k=%d: Select changed the elements", gen.name, n, k)
+				}
+			}
+		}
+	}
+	if !Panics(func() { Select([]float64{1}, 1) }) {
+		t.Errorf("Select did not panic with out of range index")
+	}
+}
+
+func TestSelectMedianOfMedians(t *testing.T) {
+	t.Parallel()
+	// Exercise the fallback pivot selection directly.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	s := make([]float64, 1000)
+	for i := range s {
+		s[i] = float64(rnd.IntN(100))
+	}
+	for trial := 0; trial < 10; trial++ {
+		rnd.Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+		c := slices.Clone(s)
+		p := medianOfMedians(c, func(a, b float64) bool { return a < b })
+		var below, above int
+		for _, v := range c {
+			if v < c[p] {
+				below++
+			}
+			if v > c[p] {
+				above++
+			}
+		}
+		if below > 7*len(c)/10 || above > 7*len(c)/10 {
+			t.Errorf("poor pivot %v: %d elements below and %d above", c[p], below, above)
+		}
+	}
+}
+
+func TestTopK(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	s := []float64{3, nan, 5, 1, 5, 4, 2}
+	for _, test := range []struct {
+		k    int
+		want []int
+	}{
+		{k: 0, want: []int{}},
+		{k: 1, want: []int{2}},
+		{k: 3, want: []int{2, 4, 5}},
+		{k: 6, want: []int{2, 4, 5, 0, 6, 3}},
+		{k: 10, want: []int{2, 4, 5, 0, 6, 3, 1}},
+	} {
+		got := TopK(nil, s, test.k)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("unexpected top %d: got:%v want:%v", test.k, got, test.want)
+		}
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	r := make([]float64, 1000)
+	for i := range r {
+		r[i] = float64(rnd.IntN(50))
+	}
+	inds := ArgsortTo(make([]int, len(r)), r)
+	for k := 0; k <= len(r); k += 97 {
+		got := TopK(nil, r, k)
+		for i, j := range got {
+			if want := r[inds[len(r)-1-i]]; r[j] != want {
+				t.Errorf("unexpected value at %d of top %d: got:%v want:%v", i, k, r[j], want)
+			}
+			if i > 0 && r[j] == r[got[i-1]] && j < got[i-1] {
+				t.Errorf("equal elements out of index order at %d of top %d", i, k)
+			}
+		}
+	}
+	if !Panics(func() { TopK(nil, s, -1) }) {
+		t.Errorf("TopK did not panic with negative k")
+	}
+}
+
+func benchmarkSelect(b *testing.B, size int) {
+	s := randomSlice(size, rand.NewPCG(1, 1))
+	c := make([]float64, size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(c, s)
+		Select(c, size/2)
+	}
+}
+func BenchmarkSelectSmall(b *testing.B) { benchmarkSelect(b, Small) }
+func BenchmarkSelectMed(b *testing.B)   { benchmarkSelect(b, Medium) }
+func BenchmarkSelectLarge(b *testing.B) { benchmarkSelect(b, Large) }
@@ -0,0 +1,40 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats32_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/floats32"
+)
+
+func ExampleAddScaledTo() {
+	x := []float32{1, 2, 3, 4}
+	y := []float32{10, 20, 30, 40}
+	dst := make([]float32, len(x))
+
+	floats32.AddScaledTo(dst, y, 0.5, x)
+
+	fmt.Println("dst =", dst)
+	fmt.Println("max index =", floats32.MaxIdx(dst))
+
+	// Output:
+	// dst = [10.5 21 31.5 42]
+	// max index = 3
+}
+
+func ExampleDotFloat64() {
+	x := []float32{1, 2, 3}
+	y := []float32{4, 5, 6}
+
+	fmt.Println("float32 dot:", floats32.Dot(x, y))
+	fmt.Println("float64 dot:", floats32.DotFloat64(x, y))
+	fmt.Printf("norm: %.4f\n", floats32.Norm(x, 2))
+
+	// Output:
+	// float32 dot: 32
+	// float64 dot: 32
+	// norm: 3.7417
+}
@@ -0,0 +1,772 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats32
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"sort"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/internal/asm/f32"
+)
+
+const (
+	zeroLength   = "floats32: zero length slice"
+	shortSpan    = "floats32: slice length less than 2"
+	badLength    = "floats32: slice lengths do not match"
+	badDstLength = "floats32: destination slice length does not match input"
+)
+
+// Add adds, element-wise, the elements of s and dst, and stores the result in dst.
+// It panics if the argument lengths do not match.
+func Add(dst, s []float32) {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	f32.AxpyUnitaryTo(dst, 1, s, dst)
+}
+
+// AddTo adds, element-wise, the elements of s and t and
+// stores the result in dst.
+// It panics if the argument lengths do not match.
+func AddTo(dst, s, t []float32) []float32 {
+	if len(s) != len(t) {
+		panic(badLength)
+	}
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	f32.AxpyUnitaryTo(dst, 1, s, t)
+	return dst
+}
+
+// AddConst adds the scalar c to all of the values in dst.
+func AddConst(c float32, dst []float32) {
+	for i := range dst {
+		dst[i] += c
+	}
+}
+
+// AddScaled performs dst = dst + alpha * s.
+// It panics if the slice argument lengths do not match.
+func AddScaled(dst []float32, alpha float32, s []float32) {
+	if len(dst) != len(s) {
+		panic(badLength)
+	}
+	f32.AxpyUnitaryTo(dst, alpha, s, dst)
+}
+
+// AddScaledTo performs dst = y + alpha * s, where alpha is a scalar,
+// and dst, y and s are all slices.
+// It panics if the slice argument lengths do not match.
+//
+// At the return of the function, dst[i] = y[i] + alpha * s[i]
+func AddScaledTo(dst, y []float32, alpha float32, s []float32) []float32 {
+	if len(s) != len(y) {
+		panic(badLength)
+	}
+	if len(dst) != len(y) {
+		panic(badDstLength)
+	}
+	f32.AxpyUnitaryTo(dst, alpha, s, y)
+	return dst
+}
+
+// argsort is a helper that implements sort.Interface, as used by
+// Argsort and ArgsortStable.
+type argsort struct {
+	s    []float32
+	inds []int
+}
+
+func (a argsort) Len() int {
+	return len(a.s)
+}
+
+func (a argsort) Less(i, j int) bool {
+	return a.s[i] < a.s[j]
+}
+
+func (a argsort) Swap(i, j int) {
+	a.s[i], a.s[j] = a.s[j], a.s[i]
+	a.inds[i], a.inds[j] = a.inds[j], a.inds[i]
+}
+
+// Argsort sorts the elements of dst while tracking their original order.
+// At the conclusion of Argsort, dst will contain the original elements of dst
+// but sorted in increasing order, and inds will contain the original position
+// of the elements in the slice such that dst[i] = origDst[inds[i]].
+// It panics if the argument lengths do not match.
+func Argsort(dst []float32, inds []int) {
+	if len(dst) != len(inds) {
+		panic(badDstLength)
+	}
+	for i := range dst {
+		inds[i] = i
+	}
+
+	a := argsort{s: dst, inds: inds}
+	sort.Sort(a)
+}
+
+// ArgsortStable sorts the elements of dst while tracking their original order and
+// keeping the original order of equal elements. At the conclusion of ArgsortStable,
+// dst will contain the original elements of dst but sorted in increasing order,
+// and inds will contain the original position of the elements in the slice such
+// that dst[i] = origDst[inds[i]].
+// It panics if the argument lengths do not match.
+func ArgsortStable(dst []float32, inds []int) {
+	if len(dst) != len(inds) {
+		panic(badDstLength)
+	}
+	for i := range dst {
+		inds[i] = i
+	}
+
+	a := argsort{s: dst, inds: inds}
+	sort.Stable(a)
+}
+
+// Count applies the function f to every element of s and returns the number
+// of times the function returned true.
+func Count(f func(float32) bool, s []float32) int {
+	var n int
+	for _, val := range s {
+		if f(val) {
+			n++
+		}
+	}
+	return n
+}
+
+// CumProd finds the cumulative product of the first i elements in
+// s and puts them in place into the ith element of the
+// destination dst.
+// It panics if the argument lengths do not match.
+//
+// At the return of the function, dst[i] = s[i] * s[i-1] * s[i-2] * ...
+func CumProd(dst, s []float32) []float32 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	if len(dst) == 0 {
+		return dst
+	}
+	dst[0] = s[0]
+	for i := 1; i < len(s); i++ {
+		dst[i] = dst[i-1] * s[i]
+	}
+	return dst
+}
+
+// CumSum finds the cumulative sum of the first i elements in
+// s and puts them in place into the ith element of the
+// destination dst.
+// It panics if the argument lengths do not match.
+//
+// At the return of the function, dst[i] = s[i] + s[i-1] + s[i-2] + ...
+func CumSum(dst, s []float32) []float32 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	if len(dst) == 0 {
+		return dst
+	}
+	dst[0] = s[0]
+	for i := 1; i < len(s); i++ {
+		dst[i] = dst[i-1] + s[i]
+	}
+	return dst
+}
+
+// Distance computes the L-norm of s - t. See Norm for special cases.
+// It panics if the slice argument lengths do not match.
+func Distance(s, t []float32, L float64) float32 {
+	if len(s) != len(t) {
+		panic(badLength)
+	}
+	if len(s) == 0 {
+		return 0
+	}
+	if L == 2 {
+		return f32.L2DistanceUnitary(s, t)
+	}
+	var norm float32
+	if L == 1 {
+		for i, v := range s {
+			norm += abs(t[i] - v)
+		}
+		return norm
+	}
+	if math.IsInf(L, 1) {
+		for i, v := range s {
+			absDiff := abs(t[i] - v)
+			if absDiff > norm {
+				norm = absDiff
+			}
+		}
+		return norm
+	}
+	var sum float64
+	for i, v := range s {
+		sum += math.Pow(float64(abs(t[i]-v)), L)
+	}
+	return float32(math.Pow(sum, 1/L))
+}
+
+// Div performs element-wise division dst / s
+// and stores the value in dst.
+// It panics if the argument lengths do not match.
+func Div(dst, s []float32) {
+	if len(dst) != len(s) {
+		panic(badLength)
+	}
+	for i, val := range s {
+		dst[i] /= val
+	}
+}
+
+// DivTo performs element-wise division s / t
+// and stores the value in dst.
+// It panics if the argument lengths do not match.
+func DivTo(dst, s, t []float32) []float32 {
+	if len(s) != len(t) {
+		panic(badLength)
+	}
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	for i, val := range t {
+		dst[i] = s[i] / val
+	}
+	return dst
+}
+
+// Dot computes the dot product of s1 and s2, i.e.
+// sum_{i = 1}^N s1[i]*s2[i].
+// It panics if the argument lengths do not match.
+func Dot(s1, s2 []float32) float32 {
+	if len(s1) != len(s2) {
+		panic(badLength)
+	}
+	return f32.DotUnitary(s1, s2)
+}
+
+// DotFloat64 computes the dot product of s1 and s2 accumulating the
+// products in float64, i.e.
+// sum_{i = 1}^N float64(s1[i])*float64(s2[i]).
+// It panics if the argument lengths do not match.
+func DotFloat64(s1, s2 []float32) float64 {
+	if len(s1) != len(s2) {
+		panic(badLength)
+	}
+	return f32.DdotUnitary(s1, s2)
+}
+
+// Equal returns true when the slices have equal lengths and
+// all elements are numerically identical.
+func Equal(s1, s2 []float32) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i, val := range s1 {
+		if s2[i] != val {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualApprox returns true when the slices have equal lengths and
+// all element pairs have an absolute tolerance less than tol or a
+// relative tolerance less than tol.
+func EqualApprox(s1, s2 []float32, tol float32) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i, a := range s1 {
+		if !scalar.EqualWithinAbsOrRel(float64(a), float64(s2[i]), float64(tol), float64(tol)) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualFunc returns true when the slices have the same lengths
+// and the function returns true for all element pairs.
+func EqualFunc(s1, s2 []float32, f func(float32, float32) bool) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i, val := range s1 {
+		if !f(val, s2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualLengths returns true when all of the slices have equal length,
+// and false otherwise. It also returns true when there are no input slices.
+func EqualLengths(slices ...[]float32) bool {
+	// This length check is needed: http://play.golang.org/p/sdty6YiLhM
+	if len(slices) == 0 {
+		return true
+	}
+	l := len(slices[0])
+	for i := 1; i < len(slices); i++ {
+		if len(slices[i]) != l {
+			return false
+		}
+	}
+	return true
+}
+
+// Find applies f to every element of s and returns the indices of the first
+// k elements for which the f returns true, or all such elements
+// if k < 0.
+// Find will reslice inds to have 0 length, and will append
+// found indices to inds.
+// If k > 0 and there are fewer than k elements in s satisfying f,
+// all of the found elements will be returned along with an error.
+// At the return of the function, the input inds will be in an undetermined state.
+func Find(inds []int, f func(float32) bool, s []float32, k int) ([]int, error) {
+	// inds is also returned to allow for calling with nil.
+
+	// Reslice inds to have zero length.
+	inds = inds[:0]
+
+	// If zero elements requested, can just return.
+	if k == 0 {
+		return inds, nil
+	}
+
+	// If k < 0, return all of the found indices.
+	if k < 0 {
+		for i, val := range s {
+			if f(val) {
+				inds = append(inds, i)
+			}
+		}
+		return inds, nil
+	}
+
+	// Otherwise, find the first k elements.
+	nFound := 0
+	for i, val := range s {
+		if f(val) {
+			inds = append(inds, i)
+			nFound++
+			if nFound == k {
+				return inds, nil
+			}
+		}
+	}
+	// Finished iterating over the loop, which means k elements were not found.
+	return inds, errors.New("floats32: insufficient elements found")
+}
+
+// HasNaN returns true when the slice s has any values that are NaN and false
+// otherwise.
+func HasNaN(s []float32) bool {
+	for _, v := range s {
+		if isNaN(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// LogSpan returns a set of n equally spaced points in log space between,
+// l and u where N is equal to len(dst). The first element of the
+// resulting dst will be l and the final element of dst will be u.
+// It panics if the length of dst is less than 2.
+// Note that this call will return NaNs if either l or u are negative, and
+// will return all zeros if l or u is zero.
+// Also returns the mutated slice dst, so that it can be used in range, like:
+//
+//	for i, x := range LogSpan(dst, l, u) { ... }
+func LogSpan(dst []float32, l, u float32) []float32 {
+	n := len(dst)
+	if n < 2 {
+		panic(shortSpan)
+	}
+	ll := math.Log(float64(l))
+	lu := math.Log(float64(u))
+	step := (lu - ll) / float64(n-1)
+	for i := range dst {
+		dst[i] = float32(math.Exp(ll + step*float64(i)))
+	}
+	return dst
+}
+
+// LogSumExp returns the log of the sum of the exponentials of the values in s.
+// The sum is accumulated in float64.
+// Panics if s is an empty slice.
+func LogSumExp(s []float32) float32 {
+	// Want to do this in a numerically stable way which avoids
+	// overflow and underflow
+	// First, find the maximum value in the slice.
+	maxval := float64(Max(s))
+	if math.IsInf(maxval, 0) {
+		// If it's infinity either way, the logsumexp will be infinity as well
+		// returning now avoids NaNs
+		return float32(maxval)
+	}
+	var lse float64
+	// Compute the sumexp part
+	for _, val := range s {
+		lse += math.Exp(float64(val) - maxval)
+	}
+	// Take the log and add back on the constant taken out
+	return float32(math.Log(lse) + maxval)
+}
+
+// Max returns the maximum value in the input slice. If the slice is empty, Max will panic.
+func Max(s []float32) float32 {
+	return s[MaxIdx(s)]
+}
+
+// MaxIdx returns the index of the maximum value in the input slice. If several
+// entries have the maximum value, the first such index is returned.
+// It panics if s is zero length.
+func MaxIdx(s []float32) int {
+	if len(s) == 0 {
+		panic(zeroLength)
+	}
+	max := float32(math.NaN())
+	var ind int
+	for i, v := range s {
+		if isNaN(v) {
+			continue
+		}
+		if v > max || isNaN(max) {
+			max = v
+			ind = i
+		}
+	}
+	return ind
+}
+
+// Min returns the minimum value in the input slice.
+// It panics if s is zero length.
+func Min(s []float32) float32 {
+	return s[MinIdx(s)]
+}
+
+// MinIdx returns the index of the minimum value in the input slice. If several
+// entries have the minimum value, the first such index is returned.
+// It panics if s is zero length.
+func MinIdx(s []float32) int {
+	if len(s) == 0 {
+		panic(zeroLength)
+	}
+	min := float32(math.NaN())
+	var ind int
+	for i, v := range s {
+		if isNaN(v) {
+			continue
+		}
+		if v < min || isNaN(min) {
+			min = v
+			ind = i
+		}
+	}
+	return ind
+}
+
+// Mul performs element-wise multiplication between dst
+// and s and stores the value in dst.
+// It panics if the argument lengths do not match.
+func Mul(dst, s []float32) {
+	if len(dst) != len(s) {
+		panic(badLength)
+	}
+	for i, val := range s {
+		dst[i] *= val
+	}
+}
+
+// MulTo performs element-wise multiplication between s
+// and t and stores the value in dst.
+// It panics if the argument lengths do not match.
+func MulTo(dst, s, t []float32) []float32 {
+	if len(s) != len(t) {
+		panic(badLength)
+	}
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	for i, val := range t {
+		dst[i] = val * s[i]
+	}
+	return dst
+}
+
+// NearestIdx returns the index of the element in s
+// whose value is nearest to v. If several such
+// elements exist, the lowest index is returned.
+// It panics if s is zero length.
+func NearestIdx(s []float32, v float32) int {
+	if len(s) == 0 {
+		panic(zeroLength)
+	}
+	switch {
+	case isNaN(v):
+		return 0
+	case math.IsInf(float64(v), 1):
+		return MaxIdx(s)
+	case math.IsInf(float64(v), -1):
+		return MinIdx(s)
+	}
+	var ind int
+	dist := float32(math.NaN())
+	for i, val := range s {
+		newDist := abs(v - val)
+		// A NaN distance will not be closer.
+		if isNaN(newDist) {
+			continue
+		}
+		if newDist < dist || isNaN(dist) {
+			dist = newDist
+			ind = i
+		}
+	}
+	return ind
+}
+
+// Norm returns the L norm of the slice S, defined as
+// (sum_{i=1}^N s[i]^L)^{1/L}
+// Special cases:
+// L = math.Inf(1) gives the maximum absolute value.
+// Does not correctly compute the zero norm (use Count).
+func Norm(s []float32, L float64) float32 {
+	if len(s) == 0 {
+		return 0
+	}
+	if L == 2 {
+		return f32.L2NormUnitary(s)
+	}
+	var norm float32
+	if L == 1 {
+		for _, val := range s {
+			norm += abs(val)
+		}
+		return norm
+	}
+	if math.IsInf(L, 1) {
+		for _, val := range s {
+			norm = max(norm, abs(val))
+		}
+		return norm
+	}
+	var sum float64
+	for _, val := range s {
+		sum += math.Pow(float64(abs(val)), L)
+	}
+	return float32(math.Pow(sum, 1/L))
+}
+
+// Prod returns the product of the elements of the slice.
+// Returns 1 if len(s) = 0.
+func Prod(s []float32) float32 {
+	prod := float32(1)
+	for _, val := range s {
+		prod *= val
+	}
+	return prod
+}
+
+// Same returns true when the input slices have the same length and all
+// elements have the same value with NaN treated as the same.
+func Same(s, t []float32) bool {
+	if len(s) != len(t) {
+		return false
+	}
+	for i, v := range s {
+		w := t[i]
+		if v != w && !(isNaN(v) && isNaN(w)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Scale multiplies every element in dst by the scalar c.
+func Scale(c float32, dst []float32) {
+	if len(dst) > 0 {
+		f32.ScalUnitary(c, dst)
+	}
+}
+
+// ScaleTo multiplies the elements in s by c and stores the result in dst.
+// It panics if the slice argument lengths do not match.
+func ScaleTo(dst []float32, c float32, s []float32) []float32 {
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	if len(dst) > 0 {
+		f32.ScalUnitaryTo(dst, c, s)
+	}
+	return dst
+}
+
+// Span returns a set of N equally spaced points between l and u, where N
+// is equal to the length of the destination. The first element of the destination
+// is l, the final element of the destination is u.
+// It panics if the length of dst is less than 2.
+//
+// Span also returns the mutated slice dst, so that it can be used in range expressions,
+// like:
+//
+//	for i, x := range Span(dst, l, u) { ... }
+func Span(dst []float32, l, u float32) []float32 {
+	n := len(dst)
+	if n < 2 {
+		panic(shortSpan)
+	}
+	nan := float32(math.NaN())
+	inf := func(v float32) bool { return math.IsInf(float64(v), 0) }
+
+	// Special cases for Inf and NaN.
+	switch {
+	case isNaN(l):
+		for i := range dst[:len(dst)-1] {
+			dst[i] = nan
+		}
+		dst[len(dst)-1] = u
+		return dst
+	case isNaN(u):
+		for i := range dst[1:] {
+			dst[i+1] = nan
+		}
+		dst[0] = l
+		return dst
+	case inf(l) && inf(u):
+		for i := range dst[:len(dst)/2] {
+			dst[i] = l
+			dst[len(dst)-i-1] = u
+		}
+		if len(dst)%2 == 1 {
+			if l != u {
+				dst[len(dst)/2] = 0
+			} else {
+				dst[len(dst)/2] = l
+			}
+		}
+		return dst
+	case inf(l):
+		for i := range dst[:len(dst)-1] {
+			dst[i] = l
+		}
+		dst[len(dst)-1] = u
+		return dst
+	case inf(u):
+		for i := range dst[1:] {
+			dst[i+1] = u
+		}
+		dst[0] = l
+		return dst
+	}
+
+	// Compute the points in float64 so that the span
+	// of large slices is not distorted by rounding.
+	step := (float64(u) - float64(l)) / float64(n-1)
+	for i := range dst {
+		dst[i] = float32(float64(l) + step*float64(i))
+	}
+	return dst
+}
+
+// Sub subtracts, element-wise, the elements of s from dst.
+// It panics if the argument lengths do not match.
+func Sub(dst, s []float32) {
+	if len(dst) != len(s) {
+		panic(badLength)
+	}
+	f32.AxpyUnitaryTo(dst, -1, s, dst)
+}
+
+// SubTo subtracts, element-wise, the elements of t from s and
+// stores the result in dst.
+// It panics if the argument lengths do not match.
+func SubTo(dst, s, t []float32) []float32 {
+	if len(s) != len(t) {
+		panic(badLength)
+	}
+	if len(dst) != len(s) {
+		panic(badDstLength)
+	}
+	f32.AxpyUnitaryTo(dst, -1, t, s)
+	return dst
+}
+
+// Sum returns the sum of the elements of the slice.
+// See SumFloat64 and SumCompensated for more accurate alternatives.
+func Sum(s []float32) float32 {
+	return f32.Sum(s)
+}
+
+// SumFloat64 returns the sum of the elements of the slice accumulated
+// in float64.
+func SumFloat64(s []float32) float64 {
+	var sum float64
+	for _, v := range s {
+		sum += float64(v)
+	}
+	return sum
+}
+
+// SumCompensated returns the sum of the elements of the slice calculated with greater
+// accuracy than Sum at the expense of additional computation.
+func SumCompensated(s []float32) float32 {
+	// SumCompensated uses an improved version of Kahan's compensated
+	// summation algorithm proposed by Neumaier.
+	// See https://en.wikipedia.org/wiki/Kahan_summation_algorithm for details.
+	var sum, c float32
+	for _, x := range s {
+		// This type conversion is here to prevent a sufficiently smart compiler
+		// from optimising away these operations.
+		t := float32(sum + x)
+		if abs(sum) >= abs(x) {
+			c += (sum - t) + x
+		} else {
+			c += (x - t) + sum
+		}
+		sum = t
+	}
+	return sum + c
+}
+
+// Within returns the first index i where s[i] <= v < s[i+1]. Within panics if:
+//   - len(s) < 2
+//   - s is not sorted
+func Within(s []float32, v float32) int {
+	if len(s) < 2 {
+		panic(shortSpan)
+	}
+	if !slices.IsSorted(s) {
+		panic("floats32: input slice not sorted")
+	}
+	if v < s[0] || v >= s[len(s)-1] || isNaN(v) {
+		return -1
+	}
+	for i, f := range s[1:] {
+		if v < f {
+			return i
+		}
+	}
+	return -1
+}
+
+func abs(x float32) float32 {
+	return math.Float32frombits(math.Float32bits(x) &^ (1 << 31))
+}
+
+func isNaN(x float32) bool {
+	return x != x
+}
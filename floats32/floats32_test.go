@@ -0,0 +1,260 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats32
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func panics(fun func()) (b bool) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			b = true
+		}
+	}()
+	fun()
+	return
+}
+
+func randomSlice(n int, rnd *rand.Rand) []float32 {
+	s := make([]float32, n)
+	for i := range s {
+		s[i] = float32(rnd.NormFloat64())
+	}
+	return s
+}
+
+func widen(s []float32) []float64 {
+	w := make([]float64, len(s))
+	for i, v := range s {
+		w[i] = float64(v)
+	}
+	return w
+}
+
+func TestElementwise(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 3, 4, 7, 17, 100} {
+		s := randomSlice(n, rnd)
+		u := randomSlice(n, rnd)
+		for _, test := range []struct {
+			name string
+			fn   func(dst []float32)
+			want func(i int, dst float32) float32
+		}{
+			{"Add", func(dst []float32) { Add(dst, s) }, func(i int, d float32) float32 { return d + s[i] }},
+			{"AddTo", func(dst []float32) { AddTo(dst, s, u) }, func(i int, _ float32) float32 { return s[i] + u[i] }},
+			{"AddConst", func(dst []float32) { AddConst(2, dst) }, func(_ int, d float32) float32 { return d + 2 }},
+			{"AddScaled", func(dst []float32) { AddScaled(dst, 3, s) }, func(i int, d float32) float32 { return d + 3*s[i] }},
+			{"AddScaledTo", func(dst []float32) { AddScaledTo(dst, u, 3, s) }, func(i int, _ float32) float32 { return u[i] + 3*s[i] }},
+			{"Div", func(dst []float32) { Div(dst, s) }, func(i int, d float32) float32 { return d / s[i] }},
+			{"DivTo", func(dst []float32) { DivTo(dst, s, u) }, func(i int, _ float32) float32 { return s[i] / u[i] }},
+			{"Mul", func(dst []float32) { Mul(dst, s) }, func(i int, d float32) float32 { return d * s[i] }},
+			{"MulTo", func(dst []float32) { MulTo(dst, s, u) }, func(i int, _ float32) float32 { return s[i] * u[i] }},
+			{"Scale", func(dst []float32) { Scale(3, dst) }, func(_ int, d float32) float32 { return 3 * d }},
+			{"ScaleTo", func(dst []float32) { ScaleTo(dst, 3, s) }, func(i int, _ float32) float32 { return 3 * s[i] }},
+			{"Sub", func(dst []float32) { Sub(dst, s) }, func(i int, d float32) float32 { return d - s[i] }},
+			{"SubTo", func(dst []float32) { SubTo(dst, s, u) }, func(i int, _ float32) float32 { return s[i] - u[i] }},
+		} {
+			dst := randomSlice(n, rnd)
+			orig := append([]float32(nil), dst...)
+			test.fn(dst)
+			for i, v := range dst {
+				if want := test.want(i, orig[i]); v != want {
+					t.Errorf("unexpected %s result for n=%d at %d: got:%v want:%v", test.name, n, i, v, want)
+				}
+			}
+		}
+	}
+}
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	a := make([]float32, 2)
+	b := make([]float32, 3)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"Add", func() { Add(a, b) }},
+		{"AddTo", func() { AddTo(a, b, b) }},
+		{"AddScaled", func() { AddScaled(a, 1, b) }},
+		{"AddScaledTo", func() { AddScaledTo(a, a, 1, b) }},
+		{"Argsort", func() { Argsort(a, make([]int, 3)) }},
+		{"CumProd", func() { CumProd(a, b) }},
+		{"CumSum", func() { CumSum(a, b) }},
+		{"Distance", func() { Distance(a, b, 2) }},
+		{"Div", func() { Div(a, b) }},
+		{"DivTo", func() { DivTo(b, a, a) }},
+		{"Dot", func() { Dot(a, b) }},
+		{"DotFloat64", func() { DotFloat64(a, b) }},
+		{"LogSpan", func() { LogSpan(a[:1], 1, 2) }},
+		{"Max", func() { Max(nil) }},
+		{"Min", func() { Min(nil) }},
+		{"Mul", func() { Mul(a, b) }},
+		{"MulTo", func() { MulTo(a, b, b) }},
+		{"NearestIdx", func() { NearestIdx(nil, 0) }},
+		{"ScaleTo", func() { ScaleTo(a, 1, b) }},
+		{"Span", func() { Span(a[:1], 1, 2) }},
+		{"Sub", func() { Sub(a, b) }},
+		{"SubTo", func() { SubTo(b, a, a) }},
+		{"Within", func() { Within([]float32{2, 1}, 0) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("%s did not panic", test.name)
+		}
+	}
+}
+
+func TestReductions(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-5
+	for _, n := range []int{1, 2, 5, 16, 33, 1000} {
+		s := randomSlice(n, rnd)
+		u := randomSlice(n, rnd)
+		ws := widen(s)
+		wu := widen(u)
+		close := func(name string, got float32, want float64) {
+			t.Helper()
+			if math.Abs(float64(got)-want) > tol*math.Max(1, math.Abs(want)) {
+				t.Errorf("unexpected %s for n=%d: got:%v want:%v", name, n, got, want)
+			}
+		}
+		close("Sum", Sum(s), floats.Sum(ws))
+		close("SumCompensated", SumCompensated(s), floats.Sum(ws))
+		close("Dot", Dot(s, u), floats.Dot(ws, wu))
+		close("Prod", Prod(s[:min(n, 5)]), floats.Prod(ws[:min(n, 5)]))
+		close("LogSumExp", LogSumExp(s), floats.LogSumExp(ws))
+		for _, L := range []float64{1, 2, 3, math.Inf(1)} {
+			close("Norm", Norm(s, L), floats.Norm(ws, L))
+			close("Distance", Distance(s, u, L), floats.Distance(ws, wu, L))
+		}
+		if got, want := SumFloat64(s), floats.Sum(ws); math.Abs(got-want) > 1e-12*math.Max(1, math.Abs(want)) {
+			t.Errorf("unexpected SumFloat64 for n=%d: got:%v want:%v", n, got, want)
+		}
+		if got, want := DotFloat64(s, u), floats.Dot(ws, wu); math.Abs(got-want) > 1e-12*math.Max(1, math.Abs(want)) {
+			t.Errorf("unexpected DotFloat64 for n=%d: got:%v want:%v", n, got, want)
+		}
+		if got, want := MaxIdx(s), floats.MaxIdx(ws); got != want {
+			t.Errorf("unexpected MaxIdx for n=%d: got:%v want:%v", n, got, want)
+		}
+		if got, want := MinIdx(s), floats.MinIdx(ws); got != want {
+			t.Errorf("unexpected MinIdx for n=%d: got:%v want:%v", n, got, want)
+		}
+		if got, want := NearestIdx(s, 0.5), floats.NearestIdx(ws, 0.5); got != want {
+			t.Errorf("unexpected NearestIdx for n=%d: got:%v want:%v", n, got, want)
+		}
+
+		dst := make([]float32, n)
+		CumSum(dst, s)
+		if !EqualApprox(dst, narrow(floats.CumSum(make([]float64, n), ws)), 1e-4) {
+			t.Errorf("unexpected CumSum for n=%d", n)
+		}
+		CumProd(dst, s)
+		if !EqualApprox(dst, narrow(floats.CumProd(make([]float64, n), ws)), 1e-4) {
+			t.Errorf("unexpected CumProd for n=%d", n)
+		}
+
+		inds := make([]int, n)
+		sorted := append([]float32(nil), s...)
+		ArgsortStable(sorted, inds)
+		for i, v := range sorted {
+			if i > 0 && v < sorted[i-1] {
+				t.Errorf("Argsort result not sorted for n=%d", n)
+			}
+			if s[inds[i]] != v {
+				t.Errorf("Argsort indices do not match for n=%d", n)
+			}
+		}
+	}
+}
+
+func narrow(s []float64) []float32 {
+	w := make([]float32, len(s))
+	for i, v := range s {
+		w[i] = float32(v)
+	}
+	return w
+}
+
+func TestNaN(t *testing.T) {
+	t.Parallel()
+	nan := float32(math.NaN())
+	s := []float32{nan, 3, nan, 1, 2}
+	if !HasNaN(s) {
+		t.Errorf("HasNaN returned false for slice with NaN")
+	}
+	if HasNaN(s[1:2]) {
+		t.Errorf("HasNaN returned true for slice without NaN")
+	}
+	if got := Max(s); got != 3 {
+		t.Errorf("unexpected Max: got:%v want:3", got)
+	}
+	if got := Min(s); got != 1 {
+		t.Errorf("unexpected Min: got:%v want:1", got)
+	}
+	if !Same(s, append([]float32(nil), s...)) {
+		t.Errorf("Same returned false for identical slices with NaN")
+	}
+	if Equal(s, s) {
+		t.Errorf("Equal returned true for slices with NaN")
+	}
+	if !EqualFunc(s, s, func(a, b float32) bool { return a == b || (isNaN(a) && isNaN(b)) }) {
+		t.Errorf("EqualFunc returned false for NaN-aware comparison")
+	}
+	if got := Count(isNaN, s); got != 2 {
+		t.Errorf("unexpected Count: got:%d want:2", got)
+	}
+	inds, err := Find(nil, isNaN, s, 3)
+	if err == nil || len(inds) != 2 || inds[0] != 0 || inds[1] != 2 {
+		t.Errorf("unexpected Find result: got:%v %v", inds, err)
+	}
+}
+
+func TestSpan(t *testing.T) {
+	t.Parallel()
+	got := Span(make([]float32, 5), 0, 1)
+	if want := []float32{0, 0.25, 0.5, 0.75, 1}; !Equal(got, want) {
+		t.Errorf("unexpected Span: got:%v want:%v", got, want)
+	}
+	got = LogSpan(make([]float32, 3), 1, 100)
+	if want := []float32{1, 10, 100}; !EqualApprox(got, want, 1e-6) {
+		t.Errorf("unexpected LogSpan: got:%v want:%v", got, want)
+	}
+	inf := float32(math.Inf(1))
+	got = Span(make([]float32, 3), -inf, inf)
+	if want := []float32{-inf, 0, inf}; !Equal(got, want) {
+		t.Errorf("unexpected Span with infinite bounds: got:%v want:%v", got, want)
+	}
+	s := []float32{1, 2, 3, 4}
+	for _, test := range []struct {
+		v    float32
+		want int
+	}{{0, -1}, {1, 0}, {2.5, 1}, {3.9, 2}, {4, -1}} {
+		if got := Within(s, test.v); got != test.want {
+			t.Errorf("unexpected Within(%v): got:%d want:%d", test.v, got, test.want)
+		}
+	}
+	if !EqualLengths() || !EqualLengths(s, s) || EqualLengths(s, s[1:]) {
+		t.Errorf("unexpected EqualLengths result")
+	}
+}
+
+func BenchmarkDot(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	s := randomSlice(10000, rnd)
+	u := randomSlice(10000, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Dot(s, u)
+	}
+}
@@ -125,6 +125,24 @@ func DsaturExact(term Terminator, g graph.Undirected) (k int, colors map[int64]i
 	return k, colors, err
 }
 
+// ChromaticBounds returns lower and upper bounds on the chromatic number of g
+// and a vertex coloring of g achieving the upper bound. The lower bound is the
+// order of a maximum clique of g and the upper bound is the number of colors
+// used by the Brélaz Dsatur heuristic. If lower equals upper, the returned
+// coloring is a minimal coloring of g.
+func ChromaticBounds(g graph.Undirected) (lower, upper int, colors map[int64]int) {
+	if g.Nodes().Len() == 0 {
+		return 0, 0, nil
+	}
+	lower, _, _ = maximumClique(g)
+	upper, colors, err := Dsatur(g, nil)
+	if err != nil {
+		// Dsatur can only fail with an invalid partial coloring.
+		panic(err)
+	}
+	return lower, upper, colors
+}
+
 // dSaturColoring is a partial graph coloring.
 type dSaturColoring struct {
 	colors    map[int64]int
@@ -507,6 +507,29 @@ func TestDsaturExact(t *testing.T) {
 	}
 }
 
+func TestChromaticBounds(t *testing.T) {
+	for _, test := range coloringTests {
+		if test.long && !*runLong {
+			continue
+		}
+		lower, upper, colors := ChromaticBounds(test.g)
+		if lower > test.colors || test.colors > upper {
+			t.Errorf("chromatic bounds do not bracket chromatic number for %q: got:[%d,%d] want:%d",
+				test.name, lower, upper, test.colors)
+		}
+		if s := Sets(colors); len(s) != upper {
+			t.Errorf("mismatch between number of color sets and upper bound: |sets|=%d upper=%d", len(s), upper)
+		}
+		if missing, ok := isCompleteColoring(colors, test.g); !ok {
+			t.Errorf("incomplete coloring for %q: missing %d\ngot:%v", test.name, missing, colors)
+		}
+		if xid, yid, ok := isValidColoring(colors, test.g); !ok {
+			t.Errorf("invalid coloring for %q: %d--%d match color\ncolors:%v",
+				test.name, xid, yid, colors)
+		}
+	}
+}
+
 func TestRandomized(t *testing.T) {
 	for seed := uint64(1); seed <= 1000; seed++ {
 		for _, test := range coloringTests {
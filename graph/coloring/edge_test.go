@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coloring
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding/graph6"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestEdgeColoring(t *testing.T) {
+	type edgeColoringTest struct {
+		name string
+		g    graph.Undirected
+	}
+	tests := []edgeColoringTest{
+		{name: "empty", g: simple.NewUndirectedGraph()},
+		{name: "singleton", g: graph6.Graph("@")},
+		{name: "petersen", g: graph6.Graph("IheA@GUAo")},
+	}
+	for n := 2; n <= 9; n++ {
+		g := simple.NewUndirectedGraph()
+		gen.Complete(g, gen.IDRange{First: 0, Last: int64(n - 1)})
+		tests = append(tests, edgeColoringTest{name: fmt.Sprintf("K%d", n), g: g})
+	}
+	for _, size := range [][2]int{{3, 3}, {2, 5}, {4, 7}} {
+		g := simple.NewUndirectedGraph()
+		for u := 0; u < size[0]; u++ {
+			for v := 0; v < size[1]; v++ {
+				g.SetEdge(g.NewEdge(simple.Node(u), simple.Node(size[0]+v)))
+			}
+		}
+		tests = append(tests, edgeColoringTest{name: fmt.Sprintf("K%d,%d", size[0], size[1]), g: g})
+	}
+	for seed := uint64(1); seed <= 10; seed++ {
+		g := simple.NewUndirectedGraph()
+		err := gen.Gnp(g, 60, 0.2, rand.NewPCG(seed, seed))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		tests = append(tests, edgeColoringTest{name: fmt.Sprintf("Gnp seed=%d", seed), g: g})
+	}
+
+	for _, test := range tests {
+		k, colors := EdgeColoring(test.g)
+
+		var maxDegree, edges int
+		nodes := test.g.Nodes()
+		for nodes.Next() {
+			d := test.g.From(nodes.Node().ID()).Len()
+			maxDegree = max(maxDegree, d)
+			edges += d
+		}
+		edges /= 2
+		if k < maxDegree || maxDegree+1 < k {
+			t.Errorf("unexpected number of colors for %s: got:%d want:%d or %d", test.name, k, maxDegree, maxDegree+1)
+		}
+		if len(colors) != edges {
+			t.Errorf("unexpected number of colored edges for %s: got:%d want:%d", test.name, len(colors), edges)
+		}
+		used := make(map[int]bool)
+		for e, c := range colors {
+			if e[0] >= e[1] {
+				t.Errorf("unexpected edge key order for %s: %v", test.name, e)
+			}
+			if !test.g.HasEdgeBetween(e[0], e[1]) {
+				t.Errorf("colored edge not in graph for %s: %v", test.name, e)
+			}
+			if c < 0 || maxDegree < c {
+				t.Errorf("color out of range for %s: %d", test.name, c)
+			}
+			used[c] = true
+		}
+		if len(used) != k {
+			t.Errorf("mismatch between number of used colors and k for %s: used=%d k=%d", test.name, len(used), k)
+		}
+
+		nodes.Reset()
+		for nodes.Next() {
+			uid := nodes.Node().ID()
+			seen := make(map[int]int64)
+			to := test.g.From(uid)
+			for to.Next() {
+				vid := to.Node().ID()
+				key := [2]int64{uid, vid}
+				if vid < uid {
+					key = [2]int64{vid, uid}
+				}
+				c := colors[key]
+				if wid, ok := seen[c]; ok {
+					t.Errorf("invalid edge coloring for %s: %d--%d and %d--%d match color %d",
+						test.name, uid, wid, uid, vid, c)
+				}
+				seen[c] = vid
+			}
+		}
+	}
+}
+
+func TestEdgeColoringPetersen(t *testing.T) {
+	// The Petersen graph is class two, so any
+	// edge coloring must use four colors.
+	k, _ := EdgeColoring(graph6.Graph("IheA@GUAo"))
+	if k != 4 {
+		t.Errorf("unexpected number of colors for Petersen graph: got:%d want:4", k)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Communities is a partition of the nodes of a graph into communities.
+// A Communities value may be passed directly to functions taking a
+// [][]graph.Node community description, such as Q.
+type Communities [][]graph.Node
+
+// Q returns the modularity Q score of the graph g subdivided into the
+// receiver's communities at the given resolution. See the Q function
+// for details of the modularity calculation.
+func (c Communities) Q(g graph.Graph, resolution float64) float64 {
+	return Q(g, c, resolution)
+}
+
+// Membership returns a map from node IDs to the index of the
+// community holding the node.
+func (c Communities) Membership() map[int64]int {
+	var n int
+	for _, members := range c {
+		n += len(members)
+	}
+	m := make(map[int64]int, n)
+	for i, members := range c {
+		for _, v := range members {
+			m[v.ID()] = i
+		}
+	}
+	return m
+}
+
+// communitiesOf returns the Communities of nodes where the community of
+// nodes[i] is given by the label of[i]. Members of each community are
+// sorted by ID and communities are sorted by the ID of their first member.
+func communitiesOf(nodes []graph.Node, of []int) Communities {
+	index := make(map[int]int)
+	var c Communities
+	for i, n := range nodes {
+		j, ok := index[of[i]]
+		if !ok {
+			j = len(c)
+			index[of[i]] = j
+			c = append(c, nil)
+		}
+		c[j] = append(c[j], n)
+	}
+	for _, members := range c {
+		order.ByID(members)
+	}
+	order.BySliceIDs(c)
+	return c
+}
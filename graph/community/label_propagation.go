@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// LabelPropagation returns the communities of the undirected graph g found
+// by asynchronous label propagation. Each node starts with a unique label
+// and nodes are repeatedly visited in random order, adopting the label with
+// the largest total edge weight among their neighbors with ties broken at
+// random. Propagation stops when every node holds a label with the largest
+// total weight among its neighbors. If src is nil, rand.IntN is used as the
+// random generator. LabelPropagation will panic if g has any edge with
+// negative edge weight.
+//
+// Label propagation takes near-linear time and is suitable for very large
+// graphs, but does not optimize an explicit quality function; the returned
+// communities may be scored with Communities.Q.
+//
+// The algorithm is described in Raghavan, Albert and Kumara, "Near linear time
+// algorithm to detect community structures in large-scale networks", Phys. Rev.
+// E 76:036106, 2007, doi:10.1103/PhysRevE.76.036106.
+//
+// graph.Undirect may be used as a shim to allow community detection in
+// directed graphs.
+func LabelPropagation(g graph.Undirected, src rand.Source) Communities {
+	intn := rand.IntN
+	if src != nil {
+		intn = rand.New(src).IntN
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	l := newAdjacency(g, nodes)
+	n := len(nodes)
+	label := make([]int, n)
+	for i := range label {
+		label[i] = i
+	}
+
+	links := newLinkWeights(n)
+	var best []int
+	for {
+		changed := false
+		for _, v := range permutation(n, intn) {
+			if len(l.adj[v]) == 0 {
+				continue
+			}
+			links.reset()
+			for _, a := range l.adj[v] {
+				links.add(label[a.to], a.weight)
+			}
+			var most float64
+			best = best[:0]
+			for _, c := range links.touched {
+				switch w := links.weight[c]; {
+				case w > most:
+					most = w
+					best = append(best[:0], c)
+				case w == most:
+					best = append(best, c)
+				}
+			}
+			if links.weight[label[v]] == most {
+				// Keep the current label if it is
+				// one of the most frequent.
+				continue
+			}
+			label[v] = best[intn(len(best))]
+			changed = true
+		}
+		if !changed {
+			break
+		}
+	}
+	return communitiesOf(nodes, label)
+}
@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestLabelPropagation(t *testing.T) {
+	t.Parallel()
+
+	// A ring of four 5-cliques, each joined to
+	// the next by a single edge, and an isolated node.
+	const (
+		cliques = 4
+		size    = 5
+	)
+	g := simple.NewUndirectedGraph()
+	var want Communities
+	for c := 0; c < cliques; c++ {
+		var members []graph.Node
+		for i := 0; i < size; i++ {
+			u := simple.Node(c*size + i)
+			members = append(members, u)
+			for j := i + 1; j < size; j++ {
+				g.SetEdge(simple.Edge{F: u, T: simple.Node(c*size + j)})
+			}
+		}
+		want = append(want, members)
+		g.SetEdge(simple.Edge{F: simple.Node(c * size), T: simple.Node(((c+1)%cliques)*size + 1)})
+	}
+	isolated := simple.Node(cliques * size)
+	g.AddNode(isolated)
+	want = append(want, []graph.Node{isolated})
+
+	src := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 10; i++ {
+		got := LabelPropagation(g, src)
+		checkPartition(t, "clique ring", g, got)
+
+		// Label propagation may settle with adjacent cliques
+		// sharing a label, but must never split a clique.
+		m := got.Membership()
+		for _, c := range want {
+			for _, n := range c[1:] {
+				if m[n.ID()] != m[c[0].ID()] {
+					t.Errorf("unexpected split clique for run %d: %v", i, got)
+				}
+			}
+		}
+		if len(got[len(got)-1]) != 1 || got[len(got)-1][0].ID() != isolated.ID() {
+			t.Errorf("unexpected community for isolated node for run %d: %v", i, got)
+		}
+	}
+
+}
+
+func TestLabelPropagationLarge(t *testing.T) {
+	t.Parallel()
+	got := LabelPropagation(dupGraph, rand.NewPCG(1, 1))
+	checkPartition(t, "duplication graph", dupGraph, got)
+	if q := got.Q(dupGraph, 1); q <= 0 {
+		t.Errorf("unexpected non-positive Q for label propagation: %v", q)
+	}
+}
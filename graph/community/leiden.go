@@ -0,0 +1,457 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// leidenTheta is the randomness parameter θ used when refining
+// communities. It is the value used by Traag, Waltman and van Eck.
+const leidenTheta = 0.01
+
+// Leiden returns the communities of the undirected graph g found by
+// maximizing modularity at the given resolution using the Leiden algorithm.
+// If src is nil, the global rand functions are used as the random generator.
+// Leiden will panic if g has any edge with negative edge weight.
+//
+// The modularity maximized is
+//
+//	Q = 1/2m \sum_{ij} [ A_{ij} - (\gamma k_i k_j)/2m ] \delta(c_i,c_j),
+//
+// the same as for Modularize with an undirected graph. Unlike the Louvain
+// algorithm, the Leiden algorithm refines the partition before each
+// aggregation step and so guarantees that every returned community is
+// connected.
+//
+// The algorithm is described in Traag, Waltman and van Eck, "From Louvain to
+// Leiden: guaranteeing well-connected communities", Sci. Rep. 9:5233, 2019,
+// doi:10.1038/s41598-019-41695-z.
+//
+// graph.Undirect may be used as a shim to allow community detection in
+// directed graphs.
+func Leiden(g graph.Undirected, resolution float64, src rand.Source) Communities {
+	var (
+		intn    func(int) int
+		uniform func() float64
+	)
+	if src == nil {
+		intn = rand.IntN
+		uniform = rand.Float64
+	} else {
+		rnd := rand.New(src)
+		intn = rnd.IntN
+		uniform = rnd.Float64
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	l := newAdjacency(g, nodes)
+	if l.m2 == 0 {
+		// Modularity is undefined without edges,
+		// so leave each node in its own community.
+		of := make([]int, len(nodes))
+		for i := range of {
+			of[i] = i
+		}
+		return communitiesOf(nodes, of)
+	}
+
+	// Repeat the algorithm starting from the previous
+	// partition until the quality no longer improves.
+	partition := make([]int, len(nodes))
+	for i := range partition {
+		partition[i] = i
+	}
+	q := l.quality(partition, resolution)
+	for {
+		next := l.leiden(partition, resolution, intn, uniform)
+		nextQ := l.quality(next, resolution)
+		if nextQ <= q {
+			break
+		}
+		partition, q = next, nextQ
+	}
+	return communitiesOf(nodes, partition)
+}
+
+// leiden performs a single iteration of the Leiden algorithm on l starting
+// from the given partition and returns the resulting partition.
+func (l *adjacency) leiden(partition []int, resolution float64, intn func(int) int, uniform func() float64) []int {
+	// members holds the original node indices
+	// represented by each node of the current
+	// aggregate graph.
+	n := len(l.adj)
+	members := make([][]int, n)
+	for i := range members {
+		members[i] = []int{i}
+	}
+	partition = append([]int(nil), partition...)
+	for {
+		l.moveNodesFast(partition, resolution, intn)
+		if l.isSingletons(partition) {
+			break
+		}
+		refined := l.refine(partition, resolution, intn, uniform)
+
+		var aggregate *adjacency
+		aggregate, partition = l.aggregate(partition, refined)
+		next := make([][]int, len(aggregate.adj))
+		for v, c := range refined {
+			next[c] = append(next[c], members[v]...)
+		}
+		members = next
+		l = aggregate
+	}
+
+	of := make([]int, n)
+	for v, c := range partition {
+		for _, i := range members[v] {
+			of[i] = c
+		}
+	}
+	return of
+}
+
+// quality returns the modularity of partition at the given resolution,
+// excluding the constant contribution of self loops.
+func (l *adjacency) quality(partition []int, resolution float64) float64 {
+	in := make([]float64, len(l.adj))
+	total := make([]float64, len(l.adj))
+	for v, c := range partition {
+		total[c] += l.k[v]
+		for _, a := range l.adj[v] {
+			if partition[a.to] == c {
+				in[c] += a.weight
+			}
+		}
+	}
+	var q float64
+	for c := range in {
+		q += in[c] - resolution*total[c]*total[c]/l.m2
+	}
+	return q / l.m2
+}
+
+// adjacency is a weighted undirected graph with dense node indices.
+type adjacency struct {
+	adj [][]adjArc
+	k   []float64 // k[v] is the weighted degree of v.
+	m2  float64   // m2 is twice the total edge weight.
+}
+
+type adjArc struct {
+	to     int
+	weight float64
+}
+
+func newAdjacency(g graph.Undirected, nodes []graph.Node) *adjacency {
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	weight := positiveWeightFuncFor(g)
+	l := &adjacency{
+		adj: make([][]adjArc, len(nodes)),
+		k:   make([]float64, len(nodes)),
+	}
+	for u, n := range nodes {
+		uid := n.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			w := weight(uid, vid)
+			if w == 0 {
+				continue
+			}
+			if vid == uid {
+				// A self loop contributes twice
+				// its weight to the degree.
+				l.k[u] += 2 * w
+				continue
+			}
+			l.adj[u] = append(l.adj[u], adjArc{to: indexOf[vid], weight: w})
+			l.k[u] += w
+		}
+		l.m2 += l.k[u]
+	}
+	return l
+}
+
+// moveNodesFast performs the fast local moving phase of the Leiden
+// algorithm, updating partition in place.
+func (l *adjacency) moveNodesFast(partition []int, resolution float64, intn func(int) int) {
+	n := len(l.adj)
+	total := make([]float64, n)
+	size := make([]int, n)
+	for v, c := range partition {
+		total[c] += l.k[v]
+		size[c]++
+	}
+	var empty []int
+	for c := n - 1; c >= 0; c-- {
+		if size[c] == 0 {
+			empty = append(empty, c)
+		}
+	}
+
+	queue := permutation(n, intn)
+	queued := make([]bool, n)
+	for i := range queued {
+		queued[i] = true
+	}
+
+	links := newLinkWeights(n)
+	for len(queue) != 0 {
+		v := queue[0]
+		queue = queue[1:]
+		queued[v] = false
+
+		own := partition[v]
+		links.reset()
+		for _, a := range l.adj[v] {
+			links.add(partition[a.to], a.weight)
+		}
+
+		// Remove v from its community and find the
+		// community with the largest gain, preferring
+		// to stay in the current community.
+		total[own] -= l.k[v]
+		size[own]--
+		kv := l.k[v] * resolution / l.m2
+		best := own
+		bestGain := links.weight[own] - kv*total[own]
+		for _, c := range links.touched {
+			if gain := links.weight[c] - kv*total[c]; gain > bestGain {
+				best, bestGain = c, gain
+			}
+		}
+		if bestGain < 0 {
+			// Moving to an empty community has no gain.
+			// This can only happen when own is not empty,
+			// so there is always an empty community.
+			best = empty[len(empty)-1]
+			empty = empty[:len(empty)-1]
+		}
+		total[best] += l.k[v]
+		size[best]++
+		if best == own {
+			continue
+		}
+		if size[own] == 0 {
+			empty = append(empty, own)
+		}
+		partition[v] = best
+
+		for _, a := range l.adj[v] {
+			if !queued[a.to] && partition[a.to] != best {
+				queued[a.to] = true
+				queue = append(queue, a.to)
+			}
+		}
+	}
+}
+
+// isSingletons returns whether every community in partition holds a
+// single node.
+func (l *adjacency) isSingletons(partition []int) bool {
+	seen := make([]bool, len(partition))
+	for _, c := range partition {
+		if seen[c] {
+			return false
+		}
+		seen[c] = true
+	}
+	return true
+}
+
+// refine returns the refined partition of the Leiden algorithm where each
+// community of partition is split into well-connected sub-communities.
+func (l *adjacency) refine(partition []int, resolution float64, intn func(int) int, uniform func() float64) []int {
+	n := len(l.adj)
+	refined := make([]int, n)
+	total := make([]float64, n) // Total degree of each refined community.
+	size := make([]int, n)      // Number of nodes in each refined community.
+	ext := make([]float64, n)   // Weight from each refined community to the rest of its community.
+	commTotal := make([]float64, n)
+	for v, c := range partition {
+		refined[v] = v
+		total[v] = l.k[v]
+		size[v] = 1
+		commTotal[c] += l.k[v]
+	}
+	for v := range l.adj {
+		for _, a := range l.adj[v] {
+			if partition[a.to] == partition[v] {
+				ext[v] += a.weight
+			}
+		}
+	}
+
+	gamma := resolution / l.m2
+	links := newLinkWeights(n)
+	var (
+		cand  []int
+		probs []float64
+	)
+	for _, v := range permutation(n, intn) {
+		c := partition[v]
+		// Only consider well-connected nodes that
+		// have not yet been merged.
+		if size[refined[v]] != 1 || ext[v] < gamma*l.k[v]*(commTotal[c]-l.k[v]) {
+			continue
+		}
+
+		links.reset()
+		for _, a := range l.adj[v] {
+			if partition[a.to] == c {
+				links.add(refined[a.to], a.weight)
+			}
+		}
+
+		// Choose a well-connected refined community with
+		// a non-negative gain randomly, weighting the choice
+		// by the exponentiated gain.
+		own := refined[v]
+		cand = append(cand[:0], own)
+		probs = append(probs[:0], 0)
+		maxGain := 0.0
+		for _, r := range links.touched {
+			if r == own || ext[r] < gamma*total[r]*(commTotal[c]-total[r]) {
+				continue
+			}
+			gain := links.weight[r] - gamma*l.k[v]*total[r]
+			if gain < 0 {
+				continue
+			}
+			// Express the gain as a change in modularity.
+			gain *= 2 / l.m2
+			cand = append(cand, r)
+			probs = append(probs, gain)
+			maxGain = math.Max(maxGain, gain)
+		}
+		var sum float64
+		for i, gain := range probs {
+			probs[i] = math.Exp((gain - maxGain) / leidenTheta)
+			sum += probs[i]
+		}
+		choice := own
+		u := uniform() * sum
+		for i, p := range probs {
+			u -= p
+			if u < 0 {
+				choice = cand[i]
+				break
+			}
+		}
+		if choice == own {
+			continue
+		}
+
+		refined[v] = choice
+		total[choice] += l.k[v]
+		size[choice]++
+		size[own] = 0
+		ext[choice] += ext[v] - 2*links.weight[choice]
+	}
+	return refined
+}
+
+// aggregate returns the graph obtained by merging the nodes of each refined
+// community and the partition of the aggregate graph induced by partition.
+// The refined communities are relabeled in place to index the aggregate nodes.
+func (l *adjacency) aggregate(partition, refined []int) (*adjacency, []int) {
+	label := make(map[int]int)
+	for v, r := range refined {
+		j, ok := label[r]
+		if !ok {
+			j = len(label)
+			label[r] = j
+		}
+		refined[v] = j
+	}
+
+	n := len(label)
+	agg := &adjacency{
+		adj: make([][]adjArc, n),
+		k:   make([]float64, n),
+		m2:  l.m2,
+	}
+	next := make([]int, n)
+	links := newLinkWeights(n)
+	byNode := make([][]int, n)
+	for v, r := range refined {
+		byNode[r] = append(byNode[r], v)
+		next[r] = partition[v]
+	}
+	for r, vs := range byNode {
+		links.reset()
+		for _, v := range vs {
+			agg.k[r] += l.k[v]
+			for _, a := range l.adj[v] {
+				// Links within the refined community become
+				// a self loop, which only contributes to the
+				// degree of the aggregate node.
+				if s := refined[a.to]; s != r {
+					links.add(s, a.weight)
+				}
+			}
+		}
+		for _, s := range links.touched {
+			agg.adj[r] = append(agg.adj[r], adjArc{to: s, weight: links.weight[s]})
+		}
+	}
+
+	// Relabel the aggregate partition densely.
+	label = make(map[int]int)
+	for i, c := range next {
+		j, ok := label[c]
+		if !ok {
+			j = len(label)
+			label[c] = j
+		}
+		next[i] = j
+	}
+	return agg, next
+}
+
+// linkWeights accumulates the weights of links to communities.
+type linkWeights struct {
+	weight  []float64
+	touched []int
+}
+
+func newLinkWeights(n int) *linkWeights {
+	return &linkWeights{weight: make([]float64, n)}
+}
+
+// add adds w to the weight of links to community c.
+func (l *linkWeights) add(c int, w float64) {
+	if l.weight[c] == 0 {
+		l.touched = append(l.touched, c)
+	}
+	l.weight[c] += w
+}
+
+// reset clears all accumulated weights.
+func (l *linkWeights) reset() {
+	for _, c := range l.touched {
+		l.weight[c] = 0
+	}
+	l.touched = l.touched[:0]
+}
+
+// permutation returns a random permutation of [0, n).
+func permutation(n int, intn func(int) int) []int {
+	p := make([]int, n)
+	for i := range p {
+		j := intn(i + 1)
+		p[i] = p[j]
+		p[j] = i
+	}
+	return p
+}
@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+func TestLeiden(t *testing.T) {
+	t.Parallel()
+	for _, test := range communityUndirectedQTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		testLeiden(t, test, g)
+	}
+}
+
+func TestLeidenWeighted(t *testing.T) {
+	t.Parallel()
+	for _, test := range communityUndirectedQTests {
+		g := simple.NewWeightedUndirectedGraph(0, 0)
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: 1})
+			}
+		}
+		testLeiden(t, test, g)
+	}
+}
+
+func testLeiden(t *testing.T, test communityUndirectedQTest, g graph.Undirected) {
+	t.Helper()
+	const leidenIterations = 10
+
+	src := rand.New(rand.NewPCG(1, 1))
+	for _, structure := range test.structures {
+		if math.IsNaN(structure.want) {
+			got := Leiden(g, structure.resolution, src)
+			if len(got) != len(test.g) {
+				t.Errorf("unexpected number of communities for %q: got:%d want:%d", test.name, len(got), len(test.g))
+			}
+			continue
+		}
+		bestQ := math.Inf(-1)
+		for i := 0; i < leidenIterations; i++ {
+			got := Leiden(g, structure.resolution, src)
+			checkPartition(t, test.name, g, got)
+			checkConnected(t, test.name, g, got)
+			bestQ = math.Max(bestQ, got.Q(g, structure.resolution))
+		}
+		// The structures hold the best known partitions,
+		// but not all are optimal.
+		if bestQ < structure.want-structure.tol {
+			t.Errorf("unexpected Q for %q at resolution %v: got:%.4v want:>=%.4v",
+				test.name, structure.resolution, bestQ, structure.want)
+		}
+	}
+}
+
+func TestLeidenLarge(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	got := Leiden(dupGraph, 1, src)
+	checkPartition(t, "duplication graph", dupGraph, got)
+	checkConnected(t, "duplication graph", dupGraph, got)
+
+	leidenQ := got.Q(dupGraph, 1)
+	louvainQ := Q(dupGraph, Modularize(dupGraph, 1, src).Communities(), 1)
+	// Leiden and Louvain are both heuristics, but Leiden
+	// should be at least as good as Louvain on this graph.
+	if leidenQ < louvainQ-1e-3 {
+		t.Errorf("unexpected Q for Leiden: got:%.4v want:>=%.4v", leidenQ, louvainQ)
+	}
+}
+
+// checkPartition checks that every node of g is in exactly one
+// of the communities in c.
+func checkPartition(t *testing.T, name string, g graph.Graph, c Communities) {
+	t.Helper()
+	m := c.Membership()
+	var n int
+	for _, members := range c {
+		if len(members) == 0 {
+			t.Errorf("unexpected empty community for %q", name)
+		}
+		n += len(members)
+	}
+	nodes := g.Nodes()
+	if n != nodes.Len() || len(m) != nodes.Len() {
+		t.Errorf("unexpected number of nodes in partition for %q: got:%d want:%d", name, n, nodes.Len())
+	}
+	for nodes.Next() {
+		if _, ok := m[nodes.Node().ID()]; !ok {
+			t.Errorf("node %d missing from partition for %q", nodes.Node().ID(), name)
+		}
+	}
+}
+
+// checkConnected checks that the subgraph of g induced by each community
+// in c is connected.
+func checkConnected(t *testing.T, name string, g graph.Undirected, c Communities) {
+	t.Helper()
+	for i, members := range c {
+		sub := simple.NewUndirectedGraph()
+		in := make(map[int64]bool)
+		for _, n := range members {
+			sub.AddNode(n)
+			in[n.ID()] = true
+		}
+		for _, u := range members {
+			for _, v := range graph.NodesOf(g.From(u.ID())) {
+				if in[v.ID()] && u.ID() != v.ID() {
+					sub.SetEdge(simple.Edge{F: u, T: v})
+				}
+			}
+		}
+		if cc := topo.ConnectedComponents(sub); len(cc) != 1 {
+			t.Errorf("community %d of %q is not connected: %d components", i, name, len(cc))
+		}
+	}
+}
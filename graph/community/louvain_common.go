@@ -373,8 +373,8 @@ const (
 )
 
 // positiveWeightFuncFor returns a constructed weight function for the
-// positively weighted g. Unweighted graphs have unit weight for existing
-// edges.
+// positively weighted g. Unweighted graphs have weights given by
+// unweightedFuncFor.
 func positiveWeightFuncFor(g graph.Graph) func(xid, yid int64) float64 {
 	if wg, ok := g.(graph.Weighted); ok {
 		return func(xid, yid int64) float64 {
@@ -388,18 +388,12 @@ func positiveWeightFuncFor(g graph.Graph) func(xid, yid int64) float64 {
 			return w
 		}
 	}
-	return func(xid, yid int64) float64 {
-		e := g.Edge(xid, yid)
-		if e == nil {
-			return 0
-		}
-		return 1
-	}
+	return unweightedFuncFor(g)
 }
 
 // negativeWeightFuncFor returns a constructed weight function for the
-// negatively weighted g. Unweighted graphs have unit weight for existing
-// edges.
+// negatively weighted g. Unweighted graphs have weights given by
+// unweightedFuncFor.
 func negativeWeightFuncFor(g graph.Graph) func(xid, yid int64) float64 {
 	if wg, ok := g.(graph.Weighted); ok {
 		return func(xid, yid int64) float64 {
@@ -413,6 +407,18 @@ func negativeWeightFuncFor(g graph.Graph) func(xid, yid int64) float64 {
 			return -w
 		}
 	}
+	return unweightedFuncFor(g)
+}
+
+// unweightedFuncFor returns a constructed weight function for the
+// unweighted g. Edges of multigraphs have a weight equal to the number
+// of lines joining their nodes and other existing edges have unit weight.
+func unweightedFuncFor(g graph.Graph) func(xid, yid int64) float64 {
+	if mg, ok := g.(graph.Multigraph); ok {
+		return func(xid, yid int64) float64 {
+			return float64(len(graph.LinesOf(mg.Lines(xid, yid))))
+		}
+	}
 	return func(xid, yid int64) float64 {
 		e := g.Edge(xid, yid)
 		if e == nil {
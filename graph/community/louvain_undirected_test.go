@@ -13,6 +13,7 @@ import (
 
 	"gonum.org/v1/gonum/floats/scalar"
 	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
 	"gonum.org/v1/gonum/graph/simple"
 	"gonum.org/v1/gonum/internal/order"
 )
@@ -798,3 +799,38 @@ func BenchmarkLouvain(b *testing.B) {
 		Modularize(dupGraph, 1, src)
 	}
 }
+
+func TestQUndirectedMultigraph(t *testing.T) {
+	// Modularity of an unweighted multigraph must equal
+	// the modularity of the simple graph with edge weights
+	// equal to the number of lines joining each pair of
+	// nodes.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 20
+	mg := multi.NewUndirectedGraph()
+	sg := simple.NewWeightedUndirectedGraph(0, 0)
+	for i := 0; i < n; i++ {
+		mg.AddNode(multi.Node(i))
+		sg.AddNode(simple.Node(i))
+	}
+	for k := 0; k < 4*n; k++ {
+		u, v := rnd.IntN(n), rnd.IntN(n)
+		if u == v {
+			continue
+		}
+		mg.SetLine(mg.NewLine(multi.Node(u), multi.Node(v)))
+		w, _ := sg.Weight(int64(u), int64(v))
+		sg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: w + 1})
+	}
+	communities := make([][]graph.Node, 4)
+	for i := 0; i < n; i++ {
+		communities[i%4] = append(communities[i%4], simple.Node(i))
+	}
+	for _, resolution := range []float64{0.5, 1, 2} {
+		got := Q(mg, communities, resolution)
+		want := Q(sg, communities, resolution)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected Q for multigraph with resolution %v: got:%v want:%v", resolution, got, want)
+		}
+	}
+}
@@ -0,0 +1,434 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph"
+)
+
+// FitBlockModel returns the partition of the undirected graph g into at most k
+// blocks that maximizes the likelihood of a stochastic block model, and the
+// log-likelihood of the partition. If degreeCorrected is true, the degree
+// corrected stochastic block model is fitted, otherwise the standard model is
+// fitted. Edge weights are treated as edge multiplicities. FitBlockModel will
+// panic if k is less than one, starts is less than one or g has any edge with
+// negative edge weight.
+//
+// Up to a constant, the log-likelihood of the degree corrected model is
+//
+//	L = \sum_{rs} m_{rs} \log(m_{rs} / (\kappa_r \kappa_s)),
+//
+// where m_{rs} is the total weight of edges between blocks r and s, counting
+// edges within a block twice, and \kappa_r is the total degree of block r. The
+// log-likelihood of the standard model replaces \kappa_r with the number of
+// nodes in block r. The degree corrected model allows nodes within a block to
+// have heterogeneous degrees, so blocks are not distinguished by degree alone.
+//
+// The likelihood is maximized by greedily moving single nodes between blocks
+// until no move improves the likelihood, starting from a spectral clustering
+// of the nodes. The spectral clustering uses the k leading eigenvectors by
+// magnitude of the regularized normalized adjacency matrix, so both assortative
+// and disassortative block structure is found. The eigenvectors are clustered
+// by k-means using starts independent initializations and the best resulting
+// fit is returned. If src is nil, the global rand functions are used as the
+// random generator.
+//
+// The models are described in Karrer and Newman, "Stochastic blockmodels and
+// community structure in networks", Phys. Rev. E 83:016107, 2011,
+// doi:10.1103/PhysRevE.83.016107.
+//
+// graph.Undirect may be used as a shim to allow fitting directed graphs.
+func FitBlockModel(g graph.Undirected, k, starts int, degreeCorrected bool, src rand.Source) (Communities, float64) {
+	if k < 1 {
+		panic("community: invalid number of blocks")
+	}
+	if starts < 1 {
+		panic("community: invalid number of starts")
+	}
+	var (
+		intn    func(int) int
+		uniform func() float64
+	)
+	if src == nil {
+		intn = rand.IntN
+		uniform = rand.Float64
+	} else {
+		rnd := rand.New(src)
+		intn = rnd.IntN
+		uniform = rnd.Float64
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	l := newAdjacency(g, nodes)
+	b := newBlockModel(l, k, degreeCorrected)
+	x := l.spectralEmbedding(k, uniform)
+	var (
+		best  []int
+		bestL = math.Inf(-1)
+	)
+	for i := 0; i < starts; i++ {
+		copy(b.block, kMeans(x, k, intn, uniform))
+		b.count()
+		b.fit(intn)
+		if logL := b.logLikelihood(); logL > bestL {
+			best = append(best[:0], b.block...)
+			bestL = logL
+		}
+	}
+	return communitiesOf(nodes, best), bestL
+}
+
+// blockModel is a stochastic block model partition of a graph.
+type blockModel struct {
+	l *adjacency
+
+	// self is the weight of self loops of each
+	// node, counted twice.
+	self []float64
+
+	degreeCorrected bool
+
+	k     int
+	block []int     // block[v] is the block holding node v.
+	m     []float64 // m[r*k+s] is the total weight of edges between blocks r and s.
+	kappa []float64 // kappa[r] is the total degree of block r.
+	size  []int     // size[r] is the number of nodes in block r.
+}
+
+func newBlockModel(l *adjacency, k int, degreeCorrected bool) *blockModel {
+	n := len(l.adj)
+	b := &blockModel{
+		l:               l,
+		self:            make([]float64, n),
+		degreeCorrected: degreeCorrected,
+		k:               k,
+		block:           make([]int, n),
+		m:               make([]float64, k*k),
+		kappa:           make([]float64, k),
+		size:            make([]int, k),
+	}
+	for v, arcs := range l.adj {
+		b.self[v] = l.k[v]
+		for _, a := range arcs {
+			b.self[v] -= a.weight
+		}
+	}
+	return b
+}
+
+// count recalculates the block statistics from the block assignments.
+func (b *blockModel) count() {
+	for i := range b.m {
+		b.m[i] = 0
+	}
+	for r := range b.kappa {
+		b.kappa[r] = 0
+		b.size[r] = 0
+	}
+	for v, r := range b.block {
+		b.kappa[r] += b.l.k[v]
+		b.size[r]++
+		b.m[r*b.k+r] += b.self[v]
+		for _, a := range b.l.adj[v] {
+			b.m[r*b.k+b.block[a.to]] += a.weight
+		}
+	}
+}
+
+// fit greedily moves nodes between blocks until no single
+// move increases the likelihood.
+func (b *blockModel) fit(intn func(int) int) {
+	links := newLinkWeights(b.k)
+	for {
+		moved := false
+		for _, v := range permutation(len(b.block), intn) {
+			links.reset()
+			for _, a := range b.l.adj[v] {
+				links.add(b.block[a.to], a.weight)
+			}
+
+			r := b.block[v]
+			best := r
+			var bestGain float64
+			for s := 0; s < b.k; s++ {
+				if s == r {
+					continue
+				}
+				// Require a gain larger than the rounding error
+				// of the calculation to ensure termination.
+				gain := b.gain(v, r, s, links)
+				if gain > bestGain && gain > 1e-12*math.Abs(b.l.m2) {
+					best, bestGain = s, gain
+				}
+			}
+			if best != r {
+				b.move(v, r, best, links)
+				moved = true
+			}
+		}
+		if !moved {
+			return
+		}
+	}
+}
+
+// gain returns the change in log-likelihood from moving node v from block
+// r to block s, given the weights of links from v to each block.
+func (b *blockModel) gain(v, r, s int, links *linkWeights) float64 {
+	k := b.k
+	dr := links.weight[r]
+	ds := links.weight[s]
+	self := b.self[v]
+
+	var gain float64
+	for _, t := range links.touched {
+		if t == r || t == s {
+			continue
+		}
+		dt := links.weight[t]
+		gain += 2 * (xlogx(b.m[r*k+t]-dt) - xlogx(b.m[r*k+t]) +
+			xlogx(b.m[s*k+t]+dt) - xlogx(b.m[s*k+t]))
+	}
+	gain += xlogx(b.m[r*k+r]-2*dr-self) - xlogx(b.m[r*k+r])
+	gain += xlogx(b.m[s*k+s]+2*ds+self) - xlogx(b.m[s*k+s])
+	gain += 2 * (xlogx(b.m[r*k+s]+dr-ds) - xlogx(b.m[r*k+s]))
+
+	kv := b.l.k[v]
+	if b.degreeCorrected {
+		gain -= 2 * (xlogx(b.kappa[r]-kv) - xlogx(b.kappa[r]) +
+			xlogx(b.kappa[s]+kv) - xlogx(b.kappa[s]))
+	} else {
+		nr := float64(b.size[r])
+		ns := float64(b.size[s])
+		gain -= 2 * (xlogy(b.kappa[r]-kv, nr-1) - xlogy(b.kappa[r], nr) +
+			xlogy(b.kappa[s]+kv, ns+1) - xlogy(b.kappa[s], ns))
+	}
+	return gain
+}
+
+// move moves node v from block r to block s, updating the block
+// statistics given the weights of links from v to each block.
+func (b *blockModel) move(v, r, s int, links *linkWeights) {
+	k := b.k
+	dr := links.weight[r]
+	ds := links.weight[s]
+	for _, t := range links.touched {
+		if t == r || t == s {
+			continue
+		}
+		dt := links.weight[t]
+		b.m[r*k+t] -= dt
+		b.m[t*k+r] -= dt
+		b.m[s*k+t] += dt
+		b.m[t*k+s] += dt
+	}
+	b.m[r*k+r] -= 2*dr + b.self[v]
+	b.m[s*k+s] += 2*ds + b.self[v]
+	b.m[r*k+s] += dr - ds
+	b.m[s*k+r] += dr - ds
+
+	kv := b.l.k[v]
+	b.kappa[r] -= kv
+	b.kappa[s] += kv
+	b.size[r]--
+	b.size[s]++
+	b.block[v] = s
+}
+
+// logLikelihood returns the log-likelihood of the current partition.
+func (b *blockModel) logLikelihood() float64 {
+	var l float64
+	for _, m := range b.m {
+		l += xlogx(m)
+	}
+	for r, kappa := range b.kappa {
+		if b.degreeCorrected {
+			l -= 2 * xlogx(kappa)
+		} else {
+			l -= 2 * xlogy(kappa, float64(b.size[r]))
+		}
+	}
+	return l
+}
+
+// spectralIterations is the number of subspace iterations used
+// to find the leading eigenvectors of the adjacency matrix.
+const spectralIterations = 100
+
+// spectralEmbedding returns the unit length rows of the n×k matrix whose
+// columns span the k leading eigenvectors by magnitude of the normalized
+// adjacency matrix D_τ^{-1/2} A D_τ^{-1/2} where D_τ is the degree matrix
+// regularized by the mean degree τ. The eigenvectors are found by subspace
+// iteration from a random start.
+func (l *adjacency) spectralEmbedding(k int, uniform func() float64) [][]float64 {
+	n := len(l.adj)
+	tau := l.m2 / float64(n)
+	if tau == 0 {
+		tau = 1
+	}
+	scale := make([]float64, n)
+	for v, kv := range l.k {
+		scale[v] = 1 / math.Sqrt(kv+tau)
+	}
+
+	// cols holds the columns of the subspace basis.
+	cols := make([][]float64, k)
+	next := make([][]float64, k)
+	for j := range cols {
+		cols[j] = make([]float64, n)
+		next[j] = make([]float64, n)
+		for v := range cols[j] {
+			cols[j][v] = uniform() - 0.5
+		}
+	}
+	orthonormalize(cols, uniform)
+	for i := 0; i < spectralIterations; i++ {
+		for j, x := range cols {
+			y := next[j]
+			for v, arcs := range l.adj {
+				var sum float64
+				for _, a := range arcs {
+					sum += a.weight * scale[a.to] * x[a.to]
+				}
+				y[v] = scale[v] * sum
+			}
+		}
+		cols, next = next, cols
+		orthonormalize(cols, uniform)
+	}
+
+	rows := make([][]float64, n)
+	for v := range rows {
+		rows[v] = make([]float64, k)
+		for j, x := range cols {
+			rows[v][j] = x[v]
+		}
+		if norm := floats.Norm(rows[v], 2); norm != 0 {
+			floats.Scale(1/norm, rows[v])
+		}
+	}
+	return rows
+}
+
+// orthonormalize orthonormalizes the vectors in cols in place using
+// modified Gram-Schmidt. Vectors that are linearly dependent on earlier
+// vectors are replaced with random vectors.
+func orthonormalize(cols [][]float64, uniform func() float64) {
+	for j, x := range cols {
+		for attempt := 0; ; attempt++ {
+			for _, q := range cols[:j] {
+				floats.AddScaled(x, -floats.Dot(q, x), q)
+			}
+			norm := floats.Norm(x, 2)
+			if norm > 1e-10 || attempt == 2 {
+				if norm != 0 {
+					floats.Scale(1/norm, x)
+				}
+				break
+			}
+			for v := range x {
+				x[v] = uniform() - 0.5
+			}
+		}
+	}
+}
+
+// kMeansIterations is the maximum number of Lloyd iterations
+// performed by kMeans.
+const kMeansIterations = 100
+
+// kMeans returns a clustering of points into at most k clusters using
+// Lloyd's algorithm with k-means++ initialization.
+func kMeans(points [][]float64, k int, intn func(int) int, uniform func() float64) []int {
+	n := len(points)
+	label := make([]int, n)
+	if n == 0 {
+		return label
+	}
+
+	// Choose the initial centers by k-means++.
+	centers := make([][]float64, k)
+	dist := make([]float64, n)
+	centers[0] = append([]float64(nil), points[intn(n)]...)
+	for v, p := range points {
+		dist[v] = floats.Distance(p, centers[0], 2)
+		dist[v] *= dist[v]
+	}
+	for c := 1; c < k; c++ {
+		choice := intn(n)
+		if sum := floats.Sum(dist); sum > 0 {
+			u := uniform() * sum
+			for v, d := range dist {
+				u -= d
+				if u < 0 {
+					choice = v
+					break
+				}
+			}
+		}
+		centers[c] = append([]float64(nil), points[choice]...)
+		for v, p := range points {
+			d := floats.Distance(p, centers[c], 2)
+			dist[v] = math.Min(dist[v], d*d)
+		}
+	}
+
+	count := make([]int, k)
+	for i := 0; i < kMeansIterations; i++ {
+		changed := i == 0
+		for v, p := range points {
+			best := label[v]
+			bestDist := floats.Distance(p, centers[best], 2)
+			for c, center := range centers {
+				if d := floats.Distance(p, center, 2); d < bestDist {
+					best, bestDist = c, d
+				}
+			}
+			if best != label[v] {
+				label[v] = best
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+		for c := range centers {
+			count[c] = 0
+		}
+		for v, c := range label {
+			if count[c] == 0 {
+				for j := range centers[c] {
+					centers[c][j] = 0
+				}
+			}
+			floats.Add(centers[c], points[v])
+			count[c]++
+		}
+		for c, center := range centers {
+			if count[c] != 0 {
+				floats.Scale(1/float64(count[c]), center)
+			}
+		}
+	}
+	return label
+}
+
+// xlogx returns x log x, which is zero when x is not positive.
+func xlogx(x float64) float64 {
+	return xlogy(x, x)
+}
+
+// xlogy returns x log y, which is zero when x or y is not positive.
+// The clamp at zero absorbs rounding error in accumulated weights.
+func xlogy(x, y float64) float64 {
+	if x <= 0 || y <= 0 {
+		return 0
+	}
+	return x * math.Log(y)
+}
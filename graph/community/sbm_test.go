@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestFitBlockModelPlanted(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name      string
+		sizes     []int
+		pIn, pOut float64
+	}{
+		{name: "assortative", sizes: []int{50, 50, 50, 50}, pIn: 0.25, pOut: 0.02},
+		{name: "disassortative", sizes: []int{60, 60, 60}, pIn: 0.01, pOut: 0.15},
+		{name: "unequal", sizes: []int{30, 60, 120}, pIn: 0.3, pOut: 0.03},
+	} {
+		k := len(test.sizes)
+		p := mat.NewDense(k, k, nil)
+		for i := 0; i < k; i++ {
+			for j := 0; j < k; j++ {
+				if i == j {
+					p.Set(i, j, test.pIn)
+				} else {
+					p.Set(i, j, test.pOut)
+				}
+			}
+		}
+		for seed := uint64(1); seed <= 3; seed++ {
+			g := simple.NewUndirectedGraph()
+			blocks, err := gen.StochasticBlock(g, test.sizes, p, rand.NewPCG(seed, seed))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, degreeCorrected := range []bool{false, true} {
+				name := fmt.Sprintf("%s seed=%d degreeCorrected=%t", test.name, seed, degreeCorrected)
+				c, l := FitBlockModel(g, k, 10, degreeCorrected, rand.NewPCG(seed, seed))
+				checkPartition(t, name, g, c)
+				checkLogLikelihood(t, name, g, c, k, degreeCorrected, l)
+				if acc := blockAccuracy(blocks, c); acc < 0.99 {
+					t.Errorf("unexpected block recovery accuracy for %s: got:%v want:>=0.99", name, acc)
+				}
+			}
+		}
+	}
+}
+
+func TestFitBlockModelDegreeCorrected(t *testing.T) {
+	t.Parallel()
+	const (
+		n    = 200
+		k    = 2
+		pIn  = 0.08
+		pOut = 0.005
+	)
+	// Construct a degree corrected block model graph where
+	// the expected degree of each node is proportional to
+	// a heavy-tailed propensity.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	theta := make([]float64, n)
+	var sum float64
+	for i := range theta {
+		theta[i] = 1 / math.Sqrt(1-rnd.Float64())
+		sum += theta[i]
+	}
+	blocks := make([][]graph.Node, k)
+	g := simple.NewUndirectedGraph()
+	for i := range theta {
+		theta[i] *= n / sum
+		u := simple.Node(i)
+		g.AddNode(u)
+		blocks[i%k] = append(blocks[i%k], u)
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			p := pOut
+			if i%k == j%k {
+				p = pIn
+			}
+			if rnd.Float64() < math.Min(1, p*theta[i]*theta[j]) {
+				g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+			}
+		}
+	}
+
+	c, l := FitBlockModel(g, k, 10, true, rand.NewPCG(1, 1))
+	checkPartition(t, "degree corrected", g, c)
+	checkLogLikelihood(t, "degree corrected", g, c, k, true, l)
+	if acc := blockAccuracy(blocks, c); acc < 0.9 {
+		t.Errorf("unexpected block recovery accuracy: got:%v want:>=0.9", acc)
+	}
+}
+
+func TestFitBlockModelEmpty(t *testing.T) {
+	t.Parallel()
+	g := simple.NewUndirectedGraph()
+	for i := 0; i < 5; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	c, l := FitBlockModel(g, 2, 1, true, nil)
+	checkPartition(t, "no edges", g, c)
+	if l != 0 {
+		t.Errorf("unexpected log-likelihood for graph without edges: got:%v want:0", l)
+	}
+}
+
+// checkLogLikelihood checks that l is the log-likelihood of the partition c
+// of g calculated directly from the block statistics.
+func checkLogLikelihood(t *testing.T, name string, g graph.Undirected, c Communities, k int, degreeCorrected bool, l float64) {
+	t.Helper()
+	nodes := graph.NodesOf(g.Nodes())
+	b := newBlockModel(newAdjacency(g, nodes), k, degreeCorrected)
+	membership := c.Membership()
+	for v, n := range nodes {
+		b.block[v] = membership[n.ID()]
+	}
+	b.count()
+	want := b.logLikelihood()
+	if !scalar.EqualWithinAbsOrRel(l, want, 1e-10, 1e-10) {
+		t.Errorf("unexpected log-likelihood for %s: got:%v want:%v", name, l, want)
+	}
+}
+
+// blockAccuracy returns the fraction of nodes in the planted blocks that
+// are assigned to the community holding the majority of their block. If
+// two blocks share a majority community, blockAccuracy returns zero.
+func blockAccuracy(blocks [][]graph.Node, c Communities) float64 {
+	membership := c.Membership()
+	claimed := make(map[int]bool)
+	var n, correct int
+	for _, b := range blocks {
+		count := make(map[int]int)
+		var (
+			most     int
+			majority int
+		)
+		for _, u := range b {
+			m := membership[u.ID()]
+			count[m]++
+			if count[m] > most {
+				most, majority = count[m], m
+			}
+		}
+		if claimed[majority] {
+			return 0
+		}
+		claimed[majority] = true
+		n += len(b)
+		correct += most
+	}
+	return float64(correct) / float64(n)
+}
@@ -12,6 +12,7 @@ import (
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/graphs/gen"
 	"gonum.org/v1/gonum/graph/iterator"
+	"gonum.org/v1/gonum/graph/multi"
 	"gonum.org/v1/gonum/graph/simple"
 )
 
@@ -23,6 +24,7 @@ var complementTests = []struct {
 	{g: gnp(100, 0.5, rand.NewPCG(1, 1))},
 	{g: gnp(100, 0.95, rand.NewPCG(1, 1))},
 	{g: gnp(100, 1, rand.NewPCG(1, 1))},
+	{g: parallel(gnp(100, 0.5, rand.NewPCG(1, 1)), 3)},
 }
 
 func TestComplement(t *testing.T) {
@@ -58,6 +60,24 @@ func gnp(n int, p float64, src rand.Source) *simple.UndirectedGraph {
 	return g
 }
 
+// parallel returns a multigraph with n parallel lines
+// for each edge in g.
+func parallel(g *simple.UndirectedGraph, n int) *multi.UndirectedGraph {
+	m := multi.NewUndirectedGraph()
+	nodes := g.Nodes()
+	for nodes.Next() {
+		m.AddNode(nodes.Node())
+	}
+	edges := g.Edges()
+	for edges.Next() {
+		e := edges.Edge()
+		for range n {
+			m.SetLine(m.NewLine(e.From(), e.To()))
+		}
+	}
+	return m
+}
+
 var nodeFilterIteratorTests = []struct {
 	src, filter graph.Nodes
 	root        int64
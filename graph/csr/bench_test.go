@@ -0,0 +1,47 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csr
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+var benchGraph = func() *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	err := gen.Gnp(g, 10000, 1e-3, rand.NewPCG(1, 1))
+	if err != nil {
+		panic(err)
+	}
+	return g
+}()
+
+func BenchmarkBreadthFirst(b *testing.B) {
+	for _, bench := range []struct {
+		name string
+		g    graph.Undirected
+	}{
+		{name: "simple", g: benchGraph},
+		{name: "csr", g: NewUndirectedGraph(benchGraph)},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var bf traverse.BreadthFirst
+				bf.Walk(bench.g, simple.Node(0), nil)
+			}
+		})
+	}
+}
+
+func BenchmarkNewUndirectedGraph(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NewUndirectedGraph(benchGraph)
+	}
+}
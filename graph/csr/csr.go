@@ -0,0 +1,237 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csr
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// nodeIndex holds the nodes of a graph in order of increasing ID.
+type nodeIndex struct {
+	nodes []graph.Node
+	ids   []int64
+
+	// dense is whether the node IDs are
+	// the node indices.
+	dense bool
+}
+
+func newNodeIndex(g graph.Graph) nodeIndex {
+	nodes := graph.NodesOf(g.Nodes())
+	if len(nodes) > math.MaxInt32 {
+		panic("csr: too many nodes")
+	}
+	order.ByID(nodes)
+	ids := make([]int64, len(nodes))
+	dense := true
+	for i, n := range nodes {
+		ids[i] = n.ID()
+		dense = dense && ids[i] == int64(i)
+	}
+	return nodeIndex{nodes: nodes, ids: ids, dense: dense}
+}
+
+// index returns the index of the node with the given ID,
+// or -1 if it does not exist.
+func (n nodeIndex) index(id int64) int {
+	if n.dense {
+		if 0 <= id && id < int64(len(n.ids)) {
+			return int(id)
+		}
+		return -1
+	}
+	i := sort.Search(len(n.ids), func(i int) bool { return n.ids[i] >= id })
+	if i == len(n.ids) || n.ids[i] != id {
+		return -1
+	}
+	return i
+}
+
+// adjacency is a compressed sparse row adjacency structure.
+// The neighbors of node i are held in ascending order in
+// targets[offsets[i]:offsets[i+1]], with edge weights in
+// the corresponding elements of weights if it is not nil.
+type adjacency struct {
+	offsets []int
+	targets []int32
+	weights []float64
+}
+
+// newAdjacency returns the adjacency of the nodes in idx with
+// neighbors given by next. If weight is not nil, it is called
+// to obtain the weight of each edge.
+func newAdjacency(idx nodeIndex, next func(id int64) graph.Nodes, weight func(uid, vid int64) float64) adjacency {
+	a := adjacency{offsets: make([]int, len(idx.nodes)+1)}
+	var row []graph.Node
+	for i, u := range idx.nodes {
+		row = append(row[:0], graph.NodesOf(next(u.ID()))...)
+		order.ByID(row)
+		for _, v := range row {
+			a.targets = append(a.targets, int32(idx.index(v.ID())))
+			if weight != nil {
+				a.weights = append(a.weights, weight(u.ID(), v.ID()))
+			}
+		}
+		a.offsets[i+1] = len(a.targets)
+	}
+	a.targets = slices.Clip(a.targets)
+	a.weights = slices.Clip(a.weights)
+	return a
+}
+
+// row returns the neighbors of node i.
+func (a adjacency) row(i int) []int32 {
+	return a.targets[a.offsets[i]:a.offsets[i+1]:a.offsets[i+1]]
+}
+
+// find returns the position in targets of the edge from
+// node i to node j, or -1 if there is no such edge.
+func (a adjacency) find(i, j int) int {
+	row := a.row(i)
+	k, ok := slices.BinarySearch(row, int32(j))
+	if !ok {
+		return -1
+	}
+	return a.offsets[i] + k
+}
+
+// nodes implements the graph.Nodes and graph.NodeSlicer
+// interfaces for a row of an adjacency.
+type nodes struct {
+	nodes []graph.Node
+	row   []int32
+	idx   int
+}
+
+func newNodes(all []graph.Node, row []int32) graph.Nodes {
+	if len(row) == 0 {
+		return graph.Empty
+	}
+	return &nodes{nodes: all, row: row, idx: -1}
+}
+
+// Len returns the remaining number of nodes to be iterated over.
+func (n *nodes) Len() int {
+	if n.idx >= len(n.row) {
+		return 0
+	}
+	return len(n.row) - n.idx - 1
+}
+
+// Next returns whether the next call of Node will return a valid node.
+func (n *nodes) Next() bool {
+	if n.idx+1 < len(n.row) {
+		n.idx++
+		return true
+	}
+	n.idx = len(n.row)
+	return false
+}
+
+// Node returns the current node of the iterator. Next must have been
+// called prior to a call to Node.
+func (n *nodes) Node() graph.Node {
+	if n.idx < 0 || n.idx >= len(n.row) {
+		return nil
+	}
+	return n.nodes[n.row[n.idx]]
+}
+
+// NodeSlice returns all the remaining nodes in the iterator and advances
+// the iterator.
+func (n *nodes) NodeSlice() []graph.Node {
+	if n.idx >= len(n.row) {
+		return nil
+	}
+	s := make([]graph.Node, 0, n.Len())
+	for _, i := range n.row[n.idx+1:] {
+		s = append(s, n.nodes[i])
+	}
+	n.idx = len(n.row)
+	return s
+}
+
+// Reset returns the iterator to its initial state.
+func (n *nodes) Reset() {
+	n.idx = -1
+}
+
+// edges implements the graph.Edges and graph.WeightedEdges
+// interfaces for an adjacency.
+type edges struct {
+	nodes []graph.Node
+	adj   adjacency
+
+	// lower is whether only edges to nodes
+	// with indices no lower than the source
+	// node are iterated over.
+	lower bool
+
+	n, remaining int
+
+	i, k int
+}
+
+func newEdges(all []graph.Node, adj adjacency, n int, lower bool) *edges {
+	return &edges{nodes: all, adj: adj, n: n, remaining: n, lower: lower, k: -1}
+}
+
+// Len returns the remaining number of edges to be iterated over.
+func (e *edges) Len() int {
+	return e.remaining
+}
+
+// Next returns whether the next call of Edge or WeightedEdge will return
+// a valid edge.
+func (e *edges) Next() bool {
+	if e.remaining == 0 {
+		e.k = len(e.adj.targets)
+		return false
+	}
+	for {
+		e.k++
+		for e.k >= e.adj.offsets[e.i+1] {
+			e.i++
+		}
+		if !e.lower || int(e.adj.targets[e.k]) >= e.i {
+			e.remaining--
+			return true
+		}
+	}
+}
+
+// Edge returns the current edge of the iterator. Next must have been
+// called prior to a call to Edge.
+func (e *edges) Edge() graph.Edge {
+	if e.k < 0 || e.k >= len(e.adj.targets) {
+		return nil
+	}
+	if e.adj.weights != nil {
+		return e.WeightedEdge()
+	}
+	return simple.Edge{F: e.nodes[e.i], T: e.nodes[e.adj.targets[e.k]]}
+}
+
+// WeightedEdge returns the current edge of the iterator. Next must have
+// been called prior to a call to WeightedEdge.
+func (e *edges) WeightedEdge() graph.WeightedEdge {
+	if e.k < 0 || e.k >= len(e.adj.targets) {
+		return nil
+	}
+	return simple.WeightedEdge{F: e.nodes[e.i], T: e.nodes[e.adj.targets[e.k]], W: e.adj.weights[e.k]}
+}
+
+// Reset returns the iterator to its initial state.
+func (e *edges) Reset() {
+	e.remaining = e.n
+	e.i = 0
+	e.k = -1
+}
@@ -0,0 +1,282 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csr
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/parallel"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+// randomGraph adds n nodes and random weighted edges formed with
+// probability p to dst. If dense is false, node IDs are not the node
+// indices.
+func randomGraph(dst graph.WeightedBuilder, n int, p float64, dense bool, rnd *rand.Rand) {
+	nodes := make([]graph.Node, n)
+	for i := range nodes {
+		id := int64(i)
+		if !dense {
+			id = int64(3*i + 7)
+		}
+		nodes[i] = simple.Node(id)
+		dst.AddNode(nodes[i])
+	}
+	_, directed := dst.(graph.Directed)
+	for i, u := range nodes {
+		for j, v := range nodes {
+			if i == j || (!directed && j < i) || rnd.Float64() >= p {
+				continue
+			}
+			dst.SetWeightedEdge(dst.NewWeightedEdge(u, v, rnd.Float64()))
+		}
+	}
+}
+
+func TestUndirected(t *testing.T) {
+	t.Parallel()
+	for _, dense := range []bool{true, false} {
+		for seed := uint64(1); seed <= 5; seed++ {
+			src := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+			randomGraph(src, 50, 0.1, dense, rand.New(rand.NewPCG(seed, seed)))
+
+			g := NewWeightedUndirectedGraph(src, 0, math.Inf(1))
+			checkGraph(t, src, g)
+			if g.Len() != src.Nodes().Len() {
+				t.Errorf("unexpected number of nodes: got:%d want:%d", g.Len(), src.Nodes().Len())
+			}
+			if got, want := g.Edges().Len(), src.Edges().Len(); got != want {
+				t.Errorf("unexpected number of edges: got:%d want:%d", got, want)
+			}
+			var count int
+			var sum, wantSum float64
+			for it := g.WeightedEdges(); it.Next(); count++ {
+				e := it.WeightedEdge()
+				if e.From().ID() > e.To().ID() {
+					t.Errorf("unexpected edge orientation: %d--%d", e.From().ID(), e.To().ID())
+				}
+				sum += e.Weight()
+			}
+			for it := src.WeightedEdges(); it.Next(); {
+				wantSum += it.WeightedEdge().Weight()
+			}
+			if count != src.Edges().Len() || math.Abs(sum-wantSum) > 1e-12 {
+				t.Errorf("unexpected weighted edges: got:%d %v want:%d %v", count, sum, src.Edges().Len(), wantSum)
+			}
+			for i := 0; i < g.Len(); i++ {
+				u := g.NodeAt(i)
+				if g.IndexOf(u.ID()) != i {
+					t.Errorf("unexpected index of node %d: got:%d want:%d", u.ID(), g.IndexOf(u.ID()), i)
+				}
+				for k, j := range g.Out(i) {
+					if w, _ := src.Weight(u.ID(), g.NodeAt(int(j)).ID()); w != g.OutWeights(i)[k] {
+						t.Errorf("unexpected weight for edge %d--%d: got:%v want:%v", i, j, g.OutWeights(i)[k], w)
+					}
+				}
+			}
+
+			u := NewUndirectedGraph(src)
+			checkGraph(t, src, u)
+			if it := u.Edges(); !it.Next() {
+				t.Error("missing edges")
+			} else if _, ok := it.Edge().(graph.WeightedEdge); ok {
+				t.Error("unexpected weighted edge from unweighted graph")
+			}
+		}
+	}
+}
+
+func TestDirected(t *testing.T) {
+	t.Parallel()
+	for _, dense := range []bool{true, false} {
+		for seed := uint64(1); seed <= 5; seed++ {
+			src := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+			randomGraph(src, 50, 0.1, dense, rand.New(rand.NewPCG(seed, seed)))
+
+			g := NewWeightedDirectedGraph(src, 0, math.Inf(1))
+			checkGraph(t, src, g)
+			nodes := src.Nodes()
+			for nodes.Next() {
+				uid := nodes.Node().ID()
+				if got, want := fmt.Sprint(ids(g.To(uid))), fmt.Sprint(ids(src.To(uid))); got != want {
+					t.Errorf("unexpected to nodes for %d: got:%s want:%s", uid, got, want)
+				}
+				i := g.IndexOf(uid)
+				for k, j := range g.In(i) {
+					if w, _ := src.Weight(g.NodeAt(int(j)).ID(), uid); w != g.InWeights(i)[k] {
+						t.Errorf("unexpected weight for edge %d->%d: got:%v want:%v", j, i, g.InWeights(i)[k], w)
+					}
+				}
+			}
+			if got, want := g.WeightedEdges().Len(), src.Edges().Len(); got != want {
+				t.Errorf("unexpected number of edges: got:%d want:%d", got, want)
+			}
+
+			checkGraph(t, src, NewDirectedGraph(src))
+		}
+	}
+}
+
+// checkGraph checks that g holds the same nodes and edges as want.
+func checkGraph(t *testing.T, want, g graph.Graph) {
+	t.Helper()
+	if got, want := fmt.Sprint(ids(g.Nodes())), fmt.Sprint(ids(want.Nodes())); got != want {
+		t.Fatalf("unexpected nodes:\ngot: %s\nwant:%s", got, want)
+	}
+	nodes := graph.NodesOf(want.Nodes())
+	for _, u := range nodes {
+		uid := u.ID()
+		if g.Node(uid) != u {
+			t.Errorf("unexpected node for ID %d", uid)
+		}
+		if got, want := fmt.Sprint(ids(g.From(uid))), fmt.Sprint(ids(want.From(uid))); got != want {
+			t.Errorf("unexpected from nodes for %d:\ngot: %s\nwant:%s", uid, got, want)
+		}
+		for _, v := range nodes {
+			vid := v.ID()
+			if g.HasEdgeBetween(uid, vid) != want.HasEdgeBetween(uid, vid) {
+				t.Errorf("unexpected edge existence between %d and %d", uid, vid)
+			}
+			e, wantE := g.Edge(uid, vid), want.Edge(uid, vid)
+			if (e == nil) != (wantE == nil) {
+				t.Errorf("unexpected edge from %d to %d", uid, vid)
+				continue
+			}
+			if e == nil {
+				continue
+			}
+			if e.From().ID() != uid || e.To().ID() != vid {
+				t.Errorf("unexpected edge orientation for %d->%d: %d->%d", uid, vid, e.From().ID(), e.To().ID())
+			}
+			if we, ok := wantE.(graph.WeightedEdge); ok {
+				if ge, ok := e.(graph.WeightedEdge); ok && ge.Weight() != we.Weight() {
+					t.Errorf("unexpected weight for %d->%d: got:%v want:%v", uid, vid, ge.Weight(), we.Weight())
+				}
+			}
+		}
+	}
+	for _, id := range []int64{-1, 1 << 40} {
+		if g.Node(id) != nil || g.From(id) != graph.Empty || g.HasEdgeBetween(id, nodes[0].ID()) {
+			t.Errorf("unexpected result for absent node %d", id)
+		}
+	}
+	if w, ok := g.(graph.Weighted); ok {
+		uid := nodes[0].ID()
+		if x, ok := w.Weight(uid, uid); x != 0 || !ok {
+			t.Errorf("unexpected self weight: got:%v %t", x, ok)
+		}
+		if x, ok := w.Weight(uid, -1); !math.IsInf(x, 1) || ok {
+			t.Errorf("unexpected absent weight: got:%v %t", x, ok)
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	t.Parallel()
+	u := NewUndirectedGraph(simple.NewUndirectedGraph())
+	if u.Nodes() != graph.Empty || u.Edges() != graph.Empty || u.Len() != 0 || u.IndexOf(0) != -1 {
+		t.Error("unexpected non-empty undirected graph")
+	}
+	d := NewDirectedGraph(simple.NewDirectedGraph())
+	if d.Nodes() != graph.Empty || d.Edges() != graph.Empty || d.Len() != 0 || d.To(0) != graph.Empty {
+		t.Error("unexpected non-empty directed graph")
+	}
+}
+
+func TestIterators(t *testing.T) {
+	t.Parallel()
+	src := simple.NewDirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {0, 2}, {0, 3}, {2, 3}} {
+		src.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	g := NewDirectedGraph(src)
+
+	from := g.From(0)
+	if from.Len() != 3 {
+		t.Errorf("unexpected initial length: got:%d want:3", from.Len())
+	}
+	from.Next()
+	if from.Node().ID() != 1 || from.Len() != 2 {
+		t.Errorf("unexpected state after first node: %d %d", from.Node().ID(), from.Len())
+	}
+	if got := fmt.Sprint(ids(iteratorOf(from.(graph.NodeSlicer).NodeSlice()))); got != "[2 3]" {
+		t.Errorf("unexpected remaining nodes: got:%s want:[2 3]", got)
+	}
+	if from.Next() || from.Len() != 0 || from.Node() != nil {
+		t.Error("unexpected node after exhaustion")
+	}
+	from.Reset()
+	if got := fmt.Sprint(ids(from)); got != "[1 2 3]" {
+		t.Errorf("unexpected nodes after reset: got:%s want:[1 2 3]", got)
+	}
+
+	edges := g.Edges()
+	var got []string
+	for edges.Len() != 0 {
+		if !edges.Next() {
+			t.Fatal("unexpected end of edges")
+		}
+		e := edges.Edge()
+		got = append(got, fmt.Sprintf("%d->%d", e.From().ID(), e.To().ID()))
+	}
+	if edges.Next() || edges.Edge() != nil {
+		t.Error("unexpected edge after exhaustion")
+	}
+	if fmt.Sprint(got) != "[0->1 0->2 0->3 2->3]" {
+		t.Errorf("unexpected edges: %v", got)
+	}
+	edges.Reset()
+	if edges.Len() != 4 {
+		t.Errorf("unexpected length after reset: got:%d want:4", edges.Len())
+	}
+}
+
+func TestParallel(t *testing.T) {
+	t.Parallel()
+	src := simple.NewUndirectedGraph()
+	err := gen.Gnp(src, 500, 0.01, rand.NewPCG(1, 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	g := NewUndirectedGraph(src)
+	depth, _ := parallel.BreadthFirst(g, 0, 0)
+	var bf traverse.BreadthFirst
+	bf.Walk(src, g.NodeAt(0), func(n graph.Node, d int) bool {
+		if int(depth[g.IndexOf(n.ID())]) != d {
+			t.Errorf("unexpected depth of node %d: got:%d want:%d", n.ID(), depth[g.IndexOf(n.ID())], d)
+		}
+		return false
+	})
+}
+
+func ids(it graph.Nodes) []int64 {
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Node().ID())
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func iteratorOf(nodes []graph.Node) graph.Nodes {
+	return &sliceNodes{nodes: nodes, idx: -1}
+}
+
+type sliceNodes struct {
+	nodes []graph.Node
+	idx   int
+}
+
+func (n *sliceNodes) Len() int         { return len(n.nodes) - n.idx - 1 }
+func (n *sliceNodes) Next() bool       { n.idx++; return n.idx < len(n.nodes) }
+func (n *sliceNodes) Node() graph.Node { return n.nodes[n.idx] }
+func (n *sliceNodes) Reset()           { n.idx = -1 }
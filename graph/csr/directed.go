@@ -0,0 +1,236 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csr
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/iterator"
+	"gonum.org/v1/gonum/graph/parallel"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var (
+	dg  *DirectedGraph
+	wdg *WeightedDirectedGraph
+
+	_ graph.Graph            = dg
+	_ graph.Directed         = dg
+	_ parallel.Graph         = dg
+	_ graph.Graph            = wdg
+	_ graph.Weighted         = wdg
+	_ graph.Directed         = wdg
+	_ graph.WeightedDirected = wdg
+	_ parallel.Graph         = wdg
+)
+
+// DirectedGraph is an immutable directed graph held in compressed sparse row
+// form. The out and in edges of each node are both held.
+type DirectedGraph struct {
+	idx     nodeIndex
+	out, in adjacency
+}
+
+// NewDirectedGraph returns a DirectedGraph holding the nodes and edges of g.
+// The nodes of g are retained, but edges returned by the graph are
+// simple.Edge values. NewDirectedGraph will panic if g has more than
+// math.MaxInt32 nodes.
+func NewDirectedGraph(g graph.Directed) *DirectedGraph {
+	idx := newNodeIndex(g)
+	return &DirectedGraph{
+		idx: idx,
+		out: newAdjacency(idx, g.From, nil),
+		in:  newAdjacency(idx, g.To, nil),
+	}
+}
+
+// Len returns the number of nodes in g.
+func (g *DirectedGraph) Len() int {
+	return len(g.idx.nodes)
+}
+
+// IndexOf returns the index of the node with the given ID, or -1 if the node
+// is not in g.
+func (g *DirectedGraph) IndexOf(id int64) int {
+	return g.idx.index(id)
+}
+
+// NodeAt returns the node with index i.
+func (g *DirectedGraph) NodeAt(i int) graph.Node {
+	return g.idx.nodes[i]
+}
+
+// Out returns the indices of the nodes that can be reached directly from the
+// node with index i in ascending order. The returned slice must not be
+// modified.
+func (g *DirectedGraph) Out(i int) []int32 {
+	return g.out.row(i)
+}
+
+// In returns the indices of the nodes that can reach the node with index i
+// directly in ascending order. The returned slice must not be modified.
+func (g *DirectedGraph) In(i int) []int32 {
+	return g.in.row(i)
+}
+
+// Node returns the node with the given ID if it exists in the graph,
+// and nil otherwise.
+func (g *DirectedGraph) Node(id int64) graph.Node {
+	i := g.idx.index(id)
+	if i < 0 {
+		return nil
+	}
+	return g.idx.nodes[i]
+}
+
+// Nodes returns all the nodes in the graph in order of increasing ID.
+func (g *DirectedGraph) Nodes() graph.Nodes {
+	if len(g.idx.nodes) == 0 {
+		return graph.Empty
+	}
+	return iterator.NewOrderedNodes(g.idx.nodes)
+}
+
+// From returns all nodes in g that can be reached directly from n, in order
+// of increasing ID.
+func (g *DirectedGraph) From(id int64) graph.Nodes {
+	i := g.idx.index(id)
+	if i < 0 {
+		return graph.Empty
+	}
+	return newNodes(g.idx.nodes, g.out.row(i))
+}
+
+// To returns all nodes in g that can reach directly to n, in order of
+// increasing ID.
+func (g *DirectedGraph) To(id int64) graph.Nodes {
+	i := g.idx.index(id)
+	if i < 0 {
+		return graph.Empty
+	}
+	return newNodes(g.idx.nodes, g.in.row(i))
+}
+
+// HasEdgeBetween returns whether an edge exists between nodes x and y without
+// considering direction.
+func (g *DirectedGraph) HasEdgeBetween(xid, yid int64) bool {
+	return g.find(xid, yid) >= 0 || g.find(yid, xid) >= 0
+}
+
+// HasEdgeFromTo returns whether an edge exists in the graph from u to v.
+func (g *DirectedGraph) HasEdgeFromTo(uid, vid int64) bool {
+	return g.find(uid, vid) >= 0
+}
+
+// Edge returns the edge from u to v if such an edge exists and nil otherwise.
+// The node v must be directly reachable from u as defined by the From method.
+func (g *DirectedGraph) Edge(uid, vid int64) graph.Edge {
+	if g.find(uid, vid) < 0 {
+		return nil
+	}
+	return simple.Edge{F: g.Node(uid), T: g.Node(vid)}
+}
+
+// Edges returns all the edges in the graph.
+func (g *DirectedGraph) Edges() graph.Edges {
+	if len(g.out.targets) == 0 {
+		return graph.Empty
+	}
+	return newEdges(g.idx.nodes, g.out, len(g.out.targets), false)
+}
+
+// find returns the position in the out adjacency of
+// the edge from u to v, or -1 if it does not exist.
+func (g *DirectedGraph) find(uid, vid int64) int {
+	i, j := g.idx.index(uid), g.idx.index(vid)
+	if i < 0 || j < 0 {
+		return -1
+	}
+	return g.out.find(i, j)
+}
+
+// WeightedDirectedGraph is an immutable weighted directed graph held in
+// compressed sparse row form.
+type WeightedDirectedGraph struct {
+	DirectedGraph
+
+	self, absent float64
+}
+
+// NewWeightedDirectedGraph returns a WeightedDirectedGraph holding the nodes
+// and weighted edges of g, with the specified self and absent edge weight
+// values. The nodes of g are retained, but edges returned by the graph are
+// simple.WeightedEdge values. NewWeightedDirectedGraph will panic if g has
+// more than math.MaxInt32 nodes.
+func NewWeightedDirectedGraph(g graph.WeightedDirected, self, absent float64) *WeightedDirectedGraph {
+	idx := newNodeIndex(g)
+	return &WeightedDirectedGraph{
+		DirectedGraph: DirectedGraph{
+			idx: idx,
+			out: newAdjacency(idx, g.From, func(uid, vid int64) float64 {
+				return g.WeightedEdge(uid, vid).Weight()
+			}),
+			in: newAdjacency(idx, g.To, func(vid, uid int64) float64 {
+				return g.WeightedEdge(uid, vid).Weight()
+			}),
+		},
+		self:   self,
+		absent: absent,
+	}
+}
+
+// OutWeights returns the weights of the edges from the node with index i, in
+// the order of the indices returned by Out. The returned slice must not be
+// modified.
+func (g *WeightedDirectedGraph) OutWeights(i int) []float64 {
+	lo, hi := g.out.offsets[i], g.out.offsets[i+1]
+	return g.out.weights[lo:hi:hi]
+}
+
+// InWeights returns the weights of the edges to the node with index i, in
+// the order of the indices returned by In. The returned slice must not be
+// modified.
+func (g *WeightedDirectedGraph) InWeights(i int) []float64 {
+	lo, hi := g.in.offsets[i], g.in.offsets[i+1]
+	return g.in.weights[lo:hi:hi]
+}
+
+// Edge returns the edge from u to v if such an edge exists and nil otherwise.
+// The node v must be directly reachable from u as defined by the From method.
+func (g *WeightedDirectedGraph) Edge(uid, vid int64) graph.Edge {
+	return g.WeightedEdge(uid, vid)
+}
+
+// Weight returns the weight for the edge between x and y if Edge(x, y) returns a non-nil Edge.
+// If x and y are the same node or there is no joining edge between the two nodes the weight
+// value returned is either the graph's absent or self value. Weight returns true if an edge
+// exists between x and y or if x and y have the same ID, false otherwise.
+func (g *WeightedDirectedGraph) Weight(xid, yid int64) (w float64, ok bool) {
+	if xid == yid {
+		return g.self, true
+	}
+	k := g.find(xid, yid)
+	if k < 0 {
+		return g.absent, false
+	}
+	return g.out.weights[k], true
+}
+
+// WeightedEdge returns the weighted edge from u to v if such an edge exists and nil otherwise.
+// The node v must be directly reachable from u as defined by the From method.
+func (g *WeightedDirectedGraph) WeightedEdge(uid, vid int64) graph.WeightedEdge {
+	k := g.find(uid, vid)
+	if k < 0 {
+		return nil
+	}
+	return simple.WeightedEdge{F: g.Node(uid), T: g.Node(vid), W: g.out.weights[k]}
+}
+
+// WeightedEdges returns all the weighted edges in the graph.
+func (g *WeightedDirectedGraph) WeightedEdges() graph.WeightedEdges {
+	if len(g.out.targets) == 0 {
+		return graph.Empty
+	}
+	return newEdges(g.idx.nodes, g.out, len(g.out.targets), false)
+}
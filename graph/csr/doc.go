@@ -0,0 +1,17 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package csr provides immutable graph implementations holding adjacency in
+// compressed sparse row form.
+//
+// The graphs in csr are constructed from any graph.Graph and cannot be
+// altered. Nodes are indexed in order of increasing ID and the neighbors of
+// each node are held in a contiguous slice of int32 node indices, so the
+// graphs use much less memory than the map-based graphs of package simple
+// and are faster to traverse. In addition to the graph interfaces, the graphs
+// provide access to their adjacency by node index and implement the
+// parallel.Graph interface.
+//
+// All types in csr return the graph.Empty value for empty iterators.
+package csr // import "gonum.org/v1/gonum/graph/csr"
@@ -0,0 +1,243 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csr
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/iterator"
+	"gonum.org/v1/gonum/graph/parallel"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var (
+	ug  *UndirectedGraph
+	wug *WeightedUndirectedGraph
+
+	_ graph.Graph              = ug
+	_ graph.Undirected         = ug
+	_ parallel.Graph           = ug
+	_ graph.Graph              = wug
+	_ graph.Weighted           = wug
+	_ graph.Undirected         = wug
+	_ graph.WeightedUndirected = wug
+	_ parallel.Graph           = wug
+)
+
+// UndirectedGraph is an immutable undirected graph held in compressed sparse
+// row form. Each edge is held in the rows of both of its end points.
+type UndirectedGraph struct {
+	idx nodeIndex
+	adj adjacency
+
+	// edges is the number of edges.
+	edges int
+}
+
+// NewUndirectedGraph returns an UndirectedGraph holding the nodes and edges
+// of g. The nodes of g are retained, but edges returned by the graph are
+// simple.Edge values. NewUndirectedGraph will panic if g has more than
+// math.MaxInt32 nodes.
+func NewUndirectedGraph(g graph.Undirected) *UndirectedGraph {
+	idx := newNodeIndex(g)
+	adj := newAdjacency(idx, g.From, nil)
+	return &UndirectedGraph{idx: idx, adj: adj, edges: undirectedEdges(adj)}
+}
+
+// undirectedEdges returns the number of undirected edges in adj.
+func undirectedEdges(adj adjacency) int {
+	var n int
+	for i := 0; i < len(adj.offsets)-1; i++ {
+		for _, j := range adj.row(i) {
+			if int(j) >= i {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// Len returns the number of nodes in g.
+func (g *UndirectedGraph) Len() int {
+	return len(g.idx.nodes)
+}
+
+// IndexOf returns the index of the node with the given ID, or -1 if the node
+// is not in g.
+func (g *UndirectedGraph) IndexOf(id int64) int {
+	return g.idx.index(id)
+}
+
+// NodeAt returns the node with index i.
+func (g *UndirectedGraph) NodeAt(i int) graph.Node {
+	return g.idx.nodes[i]
+}
+
+// Out returns the indices of the neighbors of the node with index i in
+// ascending order. The returned slice must not be modified.
+func (g *UndirectedGraph) Out(i int) []int32 {
+	return g.adj.row(i)
+}
+
+// In returns the indices of the neighbors of the node with index i in
+// ascending order. In is identical to Out. The returned slice must not be
+// modified.
+func (g *UndirectedGraph) In(i int) []int32 {
+	return g.adj.row(i)
+}
+
+// Node returns the node with the given ID if it exists in the graph,
+// and nil otherwise.
+func (g *UndirectedGraph) Node(id int64) graph.Node {
+	i := g.idx.index(id)
+	if i < 0 {
+		return nil
+	}
+	return g.idx.nodes[i]
+}
+
+// Nodes returns all the nodes in the graph in order of increasing ID.
+func (g *UndirectedGraph) Nodes() graph.Nodes {
+	if len(g.idx.nodes) == 0 {
+		return graph.Empty
+	}
+	return iterator.NewOrderedNodes(g.idx.nodes)
+}
+
+// From returns all nodes in g that can be reached directly from n, in order
+// of increasing ID.
+func (g *UndirectedGraph) From(id int64) graph.Nodes {
+	i := g.idx.index(id)
+	if i < 0 {
+		return graph.Empty
+	}
+	return newNodes(g.idx.nodes, g.adj.row(i))
+}
+
+// HasEdgeBetween returns whether an edge exists between nodes x and y.
+func (g *UndirectedGraph) HasEdgeBetween(xid, yid int64) bool {
+	return g.find(xid, yid) >= 0
+}
+
+// Edge returns the edge from u to v if such an edge exists and nil otherwise.
+// The node v must be directly reachable from u as defined by the From method.
+func (g *UndirectedGraph) Edge(uid, vid int64) graph.Edge {
+	return g.EdgeBetween(uid, vid)
+}
+
+// EdgeBetween returns the edge between nodes x and y.
+func (g *UndirectedGraph) EdgeBetween(xid, yid int64) graph.Edge {
+	if g.find(xid, yid) < 0 {
+		return nil
+	}
+	return simple.Edge{F: g.Node(xid), T: g.Node(yid)}
+}
+
+// Edges returns all the edges in the graph.
+func (g *UndirectedGraph) Edges() graph.Edges {
+	if g.edges == 0 {
+		return graph.Empty
+	}
+	return newEdges(g.idx.nodes, g.adj, g.edges, true)
+}
+
+// find returns the position in the adjacency of the
+// edge between x and y, or -1 if it does not exist.
+func (g *UndirectedGraph) find(xid, yid int64) int {
+	i, j := g.idx.index(xid), g.idx.index(yid)
+	if i < 0 || j < 0 {
+		return -1
+	}
+	return g.adj.find(i, j)
+}
+
+// WeightedUndirectedGraph is an immutable weighted undirected graph held in
+// compressed sparse row form.
+type WeightedUndirectedGraph struct {
+	UndirectedGraph
+
+	self, absent float64
+}
+
+// NewWeightedUndirectedGraph returns a WeightedUndirectedGraph holding the
+// nodes and weighted edges of g, with the specified self and absent edge
+// weight values. The nodes of g are retained, but edges returned by the graph
+// are simple.WeightedEdge values. NewWeightedUndirectedGraph will panic if g
+// has more than math.MaxInt32 nodes.
+func NewWeightedUndirectedGraph(g graph.WeightedUndirected, self, absent float64) *WeightedUndirectedGraph {
+	idx := newNodeIndex(g)
+	adj := newAdjacency(idx, g.From, func(uid, vid int64) float64 {
+		return g.WeightedEdgeBetween(uid, vid).Weight()
+	})
+	return &WeightedUndirectedGraph{
+		UndirectedGraph: UndirectedGraph{idx: idx, adj: adj, edges: undirectedEdges(adj)},
+		self:            self,
+		absent:          absent,
+	}
+}
+
+// OutWeights returns the weights of the edges to the neighbors of the node
+// with index i, in the order of the indices returned by Out. The returned
+// slice must not be modified.
+func (g *WeightedUndirectedGraph) OutWeights(i int) []float64 {
+	lo, hi := g.adj.offsets[i], g.adj.offsets[i+1]
+	return g.adj.weights[lo:hi:hi]
+}
+
+// Edge returns the edge from u to v if such an edge exists and nil otherwise.
+// The node v must be directly reachable from u as defined by the From method.
+func (g *WeightedUndirectedGraph) Edge(uid, vid int64) graph.Edge {
+	return g.WeightedEdgeBetween(uid, vid)
+}
+
+// EdgeBetween returns the edge between nodes x and y.
+func (g *WeightedUndirectedGraph) EdgeBetween(xid, yid int64) graph.Edge {
+	return g.WeightedEdgeBetween(xid, yid)
+}
+
+// Weight returns the weight for the edge between x and y if Edge(x, y) returns a non-nil Edge.
+// If x and y are the same node or there is no joining edge between the two nodes the weight
+// value returned is either the graph's absent or self value. Weight returns true if an edge
+// exists between x and y or if x and y have the same ID, false otherwise.
+func (g *WeightedUndirectedGraph) Weight(xid, yid int64) (w float64, ok bool) {
+	if xid == yid {
+		return g.self, true
+	}
+	k := g.find(xid, yid)
+	if k < 0 {
+		return g.absent, false
+	}
+	return g.adj.weights[k], true
+}
+
+// WeightedEdge returns the weighted edge from u to v if such an edge exists and nil otherwise.
+// The node v must be directly reachable from u as defined by the From method.
+func (g *WeightedUndirectedGraph) WeightedEdge(uid, vid int64) graph.WeightedEdge {
+	return g.WeightedEdgeBetween(uid, vid)
+}
+
+// WeightedEdgeBetween returns the weighted edge between nodes x and y.
+func (g *WeightedUndirectedGraph) WeightedEdgeBetween(xid, yid int64) graph.WeightedEdge {
+	k := g.find(xid, yid)
+	if k < 0 {
+		return nil
+	}
+	return simple.WeightedEdge{F: g.Node(xid), T: g.Node(yid), W: g.adj.weights[k]}
+}
+
+// Edges returns all the edges in the graph.
+func (g *WeightedUndirectedGraph) Edges() graph.Edges {
+	if g.edges == 0 {
+		return graph.Empty
+	}
+	return newEdges(g.idx.nodes, g.adj, g.edges, true)
+}
+
+// WeightedEdges returns all the weighted edges in the graph.
+func (g *WeightedUndirectedGraph) WeightedEdges() graph.WeightedEdges {
+	if g.edges == 0 {
+		return graph.Empty
+	}
+	return newEdges(g.idx.nodes, g.adj, g.edges, true)
+}
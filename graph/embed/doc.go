@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package embed provides random walk based node embeddings.
+//
+// Node embeddings place the nodes of a graph in a vector space such that
+// nodes that co-occur on short random walks are close to each other. The
+// resulting vectors are held in a mat.Dense and may be used as input to
+// the statistical and machine learning functions in Gonum.
+package embed // import "gonum.org/v1/gonum/graph/embed"
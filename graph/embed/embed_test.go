@@ -0,0 +1,221 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embed
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+// barbell returns two k-cliques joined by a single edge between
+// node 0 and node k.
+func barbell(k int) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	for c := 0; c < 2; c++ {
+		for i := 0; i < k; i++ {
+			for j := i + 1; j < k; j++ {
+				g.SetEdge(simple.Edge{F: simple.Node(c*k + i), T: simple.Node(c*k + j)})
+			}
+		}
+	}
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(k)})
+	return g
+}
+
+func TestWalks(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		g    graph.Graph
+		p, q float64
+	}{
+		{name: "barbell", g: barbell(5), p: 1, q: 1},
+		{name: "barbell biased", g: barbell(5), p: 0.5, q: 2},
+		{name: "directed", g: func() graph.Graph {
+			g := simple.NewDirectedGraph()
+			for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}} {
+				g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+			}
+			return g
+		}(), p: 2, q: 0.5},
+		{name: "weighted", g: func() graph.Graph {
+			g := simple.NewWeightedUndirectedGraph(0, 0)
+			for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 2}} {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(e[0]), T: simple.Node(e[1]), W: float64(e[0] + 1)})
+			}
+			return g
+		}(), p: 1, q: 4},
+	} {
+		const (
+			n      = 5
+			length = 20
+		)
+		w := NewWalker(test.g, test.p, test.q, rand.NewPCG(1, 1))
+		walks := w.Walks(n, length)
+		nodes := test.g.Nodes().Len()
+		if len(walks) != n*nodes {
+			t.Errorf("unexpected number of walks for %s: got:%d want:%d", test.name, len(walks), n*nodes)
+		}
+		starts := make(map[int64]int)
+		for _, walk := range walks {
+			starts[walk[0].ID()]++
+			if len(walk) > length {
+				t.Errorf("walk too long for %s: %d", test.name, len(walk))
+			}
+			for i, v := range walk[1:] {
+				if !test.g.HasEdgeBetween(walk[i].ID(), v.ID()) || test.g.Edge(walk[i].ID(), v.ID()) == nil {
+					t.Errorf("walk for %s follows non-existent edge %d->%d", test.name, walk[i].ID(), v.ID())
+				}
+			}
+			last := walk[len(walk)-1].ID()
+			if len(walk) < length && test.g.From(last).Len() != 0 {
+				t.Errorf("walk for %s ended early at node %d with out edges", test.name, last)
+			}
+		}
+		for id, c := range starts {
+			if c != n {
+				t.Errorf("unexpected number of walks from node %d for %s: got:%d want:%d", id, test.name, c, n)
+			}
+		}
+	}
+}
+
+func TestWalkerBias(t *testing.T) {
+	t.Parallel()
+	// On a path graph each step from an inner node either
+	// returns to the previous node with weight 1/p or moves
+	// on with weight 1/q.
+	g := simple.NewUndirectedGraph()
+	for i := 0; i < 99; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 1)})
+	}
+	for _, test := range []struct {
+		p, q float64
+	}{
+		{p: 1, q: 1},
+		{p: 0.25, q: 1},
+		{p: 4, q: 1},
+		{p: 1, q: 0.1},
+	} {
+		w := NewWalker(g, test.p, test.q, rand.NewPCG(1, 2))
+		var returns, steps int
+		for i := 0; i < 2000; i++ {
+			walk := w.Walk(nil, simple.Node(50), 10)
+			for j := 2; j < len(walk); j++ {
+				inner := walk[j-1].ID()
+				if inner == 0 || inner == 99 {
+					continue
+				}
+				steps++
+				if walk[j].ID() == walk[j-2].ID() {
+					returns++
+				}
+			}
+		}
+		got := float64(returns) / float64(steps)
+		want := (1 / test.p) / (1/test.p + 1/test.q)
+		if math.Abs(got-want) > 0.02 {
+			t.Errorf("unexpected return proportion for p=%v q=%v: got:%.3f want:%.3f", test.p, test.q, got, want)
+		}
+	}
+}
+
+func TestDeepWalk(t *testing.T) {
+	t.Parallel()
+	const k = 6
+	g := barbell(k)
+	emb := DeepWalk(g, 10, 20, SkipGram{Dim: 16, Window: 4, Epochs: 2}, rand.NewPCG(1, 3))
+	if r, c := emb.Vectors.Dims(); r != 2*k || c != 16 {
+		t.Fatalf("unexpected embedding dimensions: got:%d×%d want:%d×16", r, c, 2*k)
+	}
+	for i, n := range emb.Nodes {
+		if n.ID() != int64(i) || emb.Index[n.ID()] != i {
+			t.Fatalf("unexpected node order: %v", emb.Nodes)
+		}
+	}
+	if emb.Vector(-1) != nil {
+		t.Error("expected nil vector for missing node")
+	}
+
+	// Nodes within a clique should be more similar
+	// to each other than to nodes in the other clique.
+	var within, between float64
+	var nWithin, nBetween int
+	for i := 0; i < 2*k; i++ {
+		for j := i + 1; j < 2*k; j++ {
+			c := cosine(emb.Vector(int64(i)), emb.Vector(int64(j)))
+			if i/k == j/k {
+				within += c
+				nWithin++
+			} else {
+				between += c
+				nBetween++
+			}
+		}
+	}
+	within /= float64(nWithin)
+	between /= float64(nBetween)
+	if within <= between+0.2 {
+		t.Errorf("embedding does not separate cliques: mean within:%.3f between:%.3f", within, between)
+	}
+}
+
+func TestNode2VecDeterministic(t *testing.T) {
+	t.Parallel()
+	g := barbell(4)
+	s := SkipGram{Dim: 8, Window: 3}
+	a := Node2Vec(g, 0.5, 2, 4, 10, s, rand.NewPCG(1, 4))
+	b := Node2Vec(g, 0.5, 2, 4, 10, s, rand.NewPCG(1, 4))
+	if !mat.Equal(a.Vectors, b.Vectors) {
+		t.Error("embedding is not deterministic for a given source")
+	}
+}
+
+func TestSkipGramEmpty(t *testing.T) {
+	t.Parallel()
+	emb := SkipGram{}.Train(nil, nil)
+	if len(emb.Nodes) != 0 || !emb.Vectors.IsEmpty() {
+		t.Errorf("unexpected embedding for no sequences: %+v", emb)
+	}
+}
+
+func TestEmbedPanics(t *testing.T) {
+	t.Parallel()
+	g := barbell(3)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "p", fn: func() { NewWalker(g, 0, 1, nil) }},
+		{name: "q", fn: func() { NewWalker(g, 1, math.NaN(), nil) }},
+		{name: "weight", fn: func() {
+			w := simple.NewWeightedUndirectedGraph(0, 0)
+			w.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: -1})
+			NewWalker(w, 1, 1, nil)
+		}},
+		{name: "start", fn: func() { NewWalker(g, 1, 1, nil).Walk(nil, simple.Node(-1), 5) }},
+		{name: "length", fn: func() { NewWalker(g, 1, 1, nil).Walks(1, 0) }},
+		{name: "walks", fn: func() { NewWalker(g, 1, 1, nil).Walks(0, 5) }},
+		{name: "dim", fn: func() { SkipGram{Dim: -1}.Train(nil, nil) }},
+	} {
+		panicked := func() (panicked bool) {
+			defer func() { panicked = recover() != nil }()
+			test.fn()
+			return false
+		}()
+		if !panicked {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func cosine(a, b mat.Vector) float64 {
+	return mat.Dot(a, b) / (mat.Norm(a, 2) * mat.Norm(b, 2))
+}
@@ -0,0 +1,252 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embed
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Embedding is a vector embedding of the nodes of a graph.
+type Embedding struct {
+	// Vectors holds the embedding vector
+	// of each node in its rows.
+	Vectors *mat.Dense
+
+	// Nodes holds the embedded nodes
+	// sorted by ID.
+	Nodes []graph.Node
+
+	// Index is a mapping from the node
+	// IDs to rows of the embedding.
+	Index map[int64]int
+}
+
+// Vector returns the embedding vector of the node with the given ID, or nil
+// if the node is not in the embedding. The returned vector shares its backing
+// data with the embedding.
+func (e Embedding) Vector(id int64) *mat.VecDense {
+	i, ok := e.Index[id]
+	if !ok {
+		return nil
+	}
+	return e.Vectors.RowView(i).(*mat.VecDense)
+}
+
+// SkipGram trains node embeddings from node sequences using the skip-gram
+// model with negative sampling of word2vec. Each node in a sequence is trained
+// to predict the nodes within a window around it, while being trained not to
+// predict nodes sampled from the unigram distribution of the nodes raised to
+// the 3/4 power.
+//
+// The model is described in Mikolov, Sutskever, Chen, Corrado and Dean,
+// "Distributed representations of words and phrases and their
+// compositionality", NIPS 2013.
+type SkipGram struct {
+	// Dim is the dimension of the embedding.
+	// If Dim is zero, 128 is used.
+	Dim int
+
+	// Window is the maximum distance between
+	// a node and the context nodes it predicts.
+	// For each node the window is chosen
+	// uniformly from [1, Window].
+	// If Window is zero, 10 is used.
+	Window int
+
+	// Negative is the number of negative
+	// samples for each context node.
+	// If Negative is zero, 5 is used.
+	Negative int
+
+	// Epochs is the number of passes over
+	// the sequences. If Epochs is zero,
+	// 1 is used.
+	Epochs int
+
+	// LearnRate is the initial learning rate
+	// of the stochastic gradient descent. The
+	// rate decays linearly to 1e-4 of its
+	// initial value during training.
+	// If LearnRate is zero, 0.025 is used.
+	LearnRate float64
+}
+
+func (s SkipGram) withDefaults() SkipGram {
+	if s.Dim == 0 {
+		s.Dim = 128
+	}
+	if s.Window == 0 {
+		s.Window = 10
+	}
+	if s.Negative == 0 {
+		s.Negative = 5
+	}
+	if s.Epochs == 0 {
+		s.Epochs = 1
+	}
+	if s.LearnRate == 0 {
+		s.LearnRate = 0.025
+	}
+	return s
+}
+
+// unigramPower is the power applied to node
+// frequencies for negative sampling.
+const unigramPower = 0.75
+
+// Train returns the embedding of the nodes in seqs. Train will panic if any
+// of the SkipGram parameters are negative.
+//
+// If src is nil, the global rand functions are used as the random generator.
+func (s SkipGram) Train(seqs [][]graph.Node, src rand.Source) Embedding {
+	if s.Dim < 0 || s.Window < 0 || s.Negative < 0 || s.Epochs < 0 || s.LearnRate < 0 {
+		panic("embed: negative skip-gram parameter")
+	}
+	s = s.withDefaults()
+
+	var (
+		intn    func(int) int
+		uniform func() float64
+	)
+	if src == nil {
+		intn = rand.IntN
+		uniform = rand.Float64
+	} else {
+		rnd := rand.New(src)
+		intn = rnd.IntN
+		uniform = rnd.Float64
+	}
+
+	// Index the nodes and count their occurrences.
+	index := make(map[int64]int)
+	var nodes []graph.Node
+	for _, seq := range seqs {
+		for _, n := range seq {
+			if _, ok := index[n.ID()]; !ok {
+				index[n.ID()] = 0
+				nodes = append(nodes, n)
+			}
+		}
+	}
+	order.ByID(nodes)
+	for i, n := range nodes {
+		index[n.ID()] = i
+	}
+	count := make([]float64, len(nodes))
+	var total int
+	idx := make([][]int, len(seqs))
+	for k, seq := range seqs {
+		idx[k] = make([]int, len(seq))
+		for j, n := range seq {
+			i := index[n.ID()]
+			idx[k][j] = i
+			count[i]++
+		}
+		total += len(seq)
+	}
+
+	if len(nodes) == 0 {
+		return Embedding{Vectors: &mat.Dense{}, Index: index}
+	}
+	dim := s.Dim
+	in := mat.NewDense(len(nodes), dim, nil)
+	raw := in.RawMatrix().Data
+	for i := range raw {
+		raw[i] = (uniform() - 0.5) / float64(dim)
+	}
+	out := make([]float64, len(nodes)*dim)
+
+	// Negative samples are drawn from the cumulative
+	// smoothed unigram distribution.
+	cum := make([]float64, len(nodes))
+	var sum float64
+	for i, c := range count {
+		sum += math.Pow(c, unigramPower)
+		cum[i] = sum
+	}
+	negative := func() int {
+		r := uniform() * sum
+		return min(sort.Search(len(cum), func(i int) bool { return cum[i] > r }), len(cum)-1)
+	}
+
+	row := func(m []float64, i int) []float64 { return m[i*dim : (i+1)*dim : (i+1)*dim] }
+	grad := make([]float64, dim)
+	var done int
+	steps := float64(s.Epochs*total + 1)
+	for range s.Epochs {
+		for _, seq := range idx {
+			for j, u := range seq {
+				rate := s.LearnRate * math.Max(1-float64(done)/steps, 1e-4)
+				done++
+
+				b := 1 + intn(s.Window)
+				for k := max(j-b, 0); k <= min(j+b, len(seq)-1); k++ {
+					if k == j {
+						continue
+					}
+					v := seq[k]
+					vec := row(raw, v)
+					clear(grad)
+					for d := 0; d <= s.Negative; d++ {
+						target, label := u, 1.0
+						if d > 0 {
+							target, label = negative(), 0
+							if target == u {
+								continue
+							}
+						}
+						o := row(out, target)
+						g := rate * (label - sigmoid(floats.Dot(vec, o)))
+						floats.AddScaled(grad, g, o)
+						floats.AddScaled(o, g, vec)
+					}
+					floats.Add(vec, grad)
+				}
+			}
+		}
+	}
+
+	return Embedding{Vectors: in, Nodes: nodes, Index: index}
+}
+
+func sigmoid(x float64) float64 {
+	return 1 / (1 + math.Exp(-x))
+}
+
+// Node2Vec returns the node2vec embedding of the nodes of g. The embedding is
+// trained by s from n biased random walks of up to length nodes from each node
+// of g, generated by a Walker with the return parameter p and the in-out
+// parameter q. Node2Vec will panic if the parameters are invalid for NewWalker,
+// Walker.Walks or SkipGram.Train.
+//
+// If src is nil, the global rand functions are used as the random generator.
+//
+// The embedding is described in Grover and Leskovec, "node2vec: Scalable
+// feature learning for networks", KDD '16, 855-864, 2016,
+// doi:10.1145/2939672.2939754.
+func Node2Vec(g graph.Graph, p, q float64, n, length int, s SkipGram, src rand.Source) Embedding {
+	walks := NewWalker(g, p, q, src).Walks(n, length)
+	return s.Train(walks, src)
+}
+
+// DeepWalk returns the DeepWalk embedding of the nodes of g. The embedding is
+// trained by s from n uniform random walks of up to length nodes from each node
+// of g. DeepWalk is equivalent to Node2Vec with p and q equal to 1.
+//
+// If src is nil, the global rand functions are used as the random generator.
+//
+// The embedding is described in Perozzi, Al-Rfou and Skiena, "DeepWalk: Online
+// learning of social representations", KDD '14, 701-710, 2014,
+// doi:10.1145/2623330.2623732.
+func DeepWalk(g graph.Graph, n, length int, s SkipGram, src rand.Source) Embedding {
+	return Node2Vec(g, 1, 1, n, length, s, src)
+}
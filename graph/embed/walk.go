@@ -0,0 +1,215 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embed
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Walker generates second-order biased random walks on a graph as described
+// for node2vec. A walk that arrived at node v from node t moves to a neighbor x
+// of v with probability proportional to w(v,x)·α(t,x) where w(v,x) is the
+// weight of the edge from v to x and
+//
+//	α(t,x) = 1/p if x is t,
+//	α(t,x) = 1   if x is a neighbor of t,
+//	α(t,x) = 1/q otherwise.
+//
+// The return parameter p controls the likelihood of immediately revisiting a
+// node and the in-out parameter q controls whether walks stay close to their
+// start or move outwards. When p and q are both 1, the walks are the first-order
+// random walks used by DeepWalk.
+type Walker struct {
+	p, q float64
+
+	nodes   []graph.Node
+	indexOf map[int64]int
+
+	// to and weight hold the indices and edge
+	// weights of the neighbors of each node,
+	// sorted by index.
+	to     [][]int
+	weight [][]float64
+
+	intn    func(int) int
+	uniform func() float64
+
+	prob []float64
+}
+
+// NewWalker returns a Walker for the graph g with the return parameter p and
+// the in-out parameter q. If g is a graph.Weighted, edge weights are used to
+// bias the walk, otherwise all edges have unit weight. Walks follow the edges
+// of g in their direction, so walks on directed graphs may end early at nodes
+// without out edges. NewWalker will panic if p or q is not positive, or if g
+// has a negative or NaN edge weight.
+//
+// If src is nil, the global rand functions are used as the random generator.
+func NewWalker(g graph.Graph, p, q float64, src rand.Source) *Walker {
+	if !(p > 0) || !(q > 0) {
+		panic("embed: invalid walk parameter")
+	}
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	w := &Walker{
+		p:       p,
+		q:       q,
+		nodes:   nodes,
+		indexOf: make(map[int64]int, len(nodes)),
+		to:      make([][]int, len(nodes)),
+		weight:  make([][]float64, len(nodes)),
+	}
+	if src == nil {
+		w.intn = rand.IntN
+		w.uniform = rand.Float64
+	} else {
+		rnd := rand.New(src)
+		w.intn = rnd.IntN
+		w.uniform = rnd.Float64
+	}
+	for i, n := range nodes {
+		w.indexOf[n.ID()] = i
+	}
+
+	weighted, isWeighted := g.(graph.Weighted)
+	for i, u := range nodes {
+		uid := u.ID()
+		var adj []int
+		to := g.From(uid)
+		for to.Next() {
+			adj = append(adj, w.indexOf[to.Node().ID()])
+		}
+		sort.Ints(adj)
+		weights := make([]float64, len(adj))
+		for k, j := range adj {
+			weights[k] = 1
+			if isWeighted {
+				weights[k], _ = weighted.Weight(uid, nodes[j].ID())
+				if !(weights[k] >= 0) {
+					panic("embed: invalid edge weight")
+				}
+			}
+		}
+		w.to[i] = adj
+		w.weight[i] = weights
+	}
+	return w
+}
+
+// Walk returns a random walk of up to length nodes starting from the node
+// start, appending the nodes to dst. The walk is shorter than length only if
+// it reaches a node with no out edges of positive weight. Walk will panic if
+// start is not in the graph or length is not positive.
+func (w *Walker) Walk(dst []graph.Node, start graph.Node, length int) []graph.Node {
+	i, ok := w.indexOf[start.ID()]
+	if !ok {
+		panic("embed: start node not in graph")
+	}
+	if length < 1 {
+		panic("embed: invalid walk length")
+	}
+	for _, j := range w.walk(nil, i, length) {
+		dst = append(dst, w.nodes[j])
+	}
+	return dst
+}
+
+// Walks returns n random walks of up to length nodes from each node of the
+// graph. The walks are generated in n rounds, with each round starting a walk
+// from every node in a random order. Walks will panic if n or length is not
+// positive.
+func (w *Walker) Walks(n, length int) [][]graph.Node {
+	if n < 1 {
+		panic("embed: invalid number of walks")
+	}
+	if length < 1 {
+		panic("embed: invalid walk length")
+	}
+	walks := make([][]graph.Node, 0, n*len(w.nodes))
+	perm := make([]int, len(w.nodes))
+	for i := range perm {
+		perm[i] = i
+	}
+	var buf []int
+	for range n {
+		for i := len(perm) - 1; i > 0; i-- {
+			j := w.intn(i + 1)
+			perm[i], perm[j] = perm[j], perm[i]
+		}
+		for _, i := range perm {
+			buf = w.walk(buf[:0], i, length)
+			walk := make([]graph.Node, len(buf))
+			for k, j := range buf {
+				walk[k] = w.nodes[j]
+			}
+			walks = append(walks, walk)
+		}
+	}
+	return walks
+}
+
+// walk appends a walk of up to length node indices starting from index i
+// to dst.
+func (w *Walker) walk(dst []int, i, length int) []int {
+	dst = append(dst, i)
+	prev := -1
+	for len(dst) < length {
+		cur := dst[len(dst)-1]
+		next := w.step(prev, cur)
+		if next < 0 {
+			break
+		}
+		dst = append(dst, next)
+		prev = cur
+	}
+	return dst
+}
+
+// step returns the index of the next node of a walk at cur that arrived from
+// prev, or -1 if the walk cannot continue. The value of prev is -1 for the
+// first step of a walk.
+func (w *Walker) step(prev, cur int) int {
+	to := w.to[cur]
+	weight := w.weight[cur]
+	if len(to) == 0 {
+		return -1
+	}
+	firstOrder := prev < 0 || (w.p == 1 && w.q == 1)
+	w.prob = w.prob[:0]
+	var sum float64
+	for k, x := range to {
+		p := weight[k]
+		if !firstOrder {
+			switch {
+			case x == prev:
+				p /= w.p
+			case w.adjacent(prev, x):
+			default:
+				p /= w.q
+			}
+		}
+		sum += p
+		w.prob = append(w.prob, sum)
+	}
+	if sum == 0 || math.IsInf(sum, 0) {
+		return -1
+	}
+	r := w.uniform() * sum
+	k := sort.Search(len(w.prob), func(k int) bool { return w.prob[k] > r })
+	return to[min(k, len(to)-1)]
+}
+
+// adjacent returns whether there is an edge from the node with index u to
+// the node with index v.
+func (w *Walker) adjacent(u, v int) bool {
+	to := w.to[u]
+	k := sort.SearchInts(to, v)
+	return k < len(to) && to[k] == v
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edgelist
+
+import (
+	"bytes"
+	"io"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+)
+
+// IDSetter is implemented by graph nodes that can set their edge list node ID.
+type IDSetter interface {
+	SetEdgeListID(id string)
+}
+
+// Unmarshal parses the white space delimited edge list in data and stores
+// the result in dst. The first line of data is treated as a header if it is
+// detected as one by DetectHeader. Unmarshal is equivalent to calling Decode
+// with a Reader reading from data.
+func Unmarshal(data []byte, dst encoding.Builder) error {
+	return Decode(NewReader(bytes.NewReader(data)), dst)
+}
+
+// Decode reads all the edges from r and stores them in dst.
+//
+// Nodes are created by dst.NewNode when their ID is first read and are given
+// their edge list ID if they implement IDSetter. Edge attributes are set on
+// edges that implement encoding.AttributeSetter. If dst is a
+// graph.WeightedBuilder, edges are created by NewWeightedEdge with the weight
+// read by r. Only the mapping from edge list IDs to nodes is held in memory
+// by Decode in addition to dst. Callers that need a different mapping, for
+// example using integer IDs directly as node IDs, should call Read on r.
+func Decode(r *Reader, dst encoding.Builder) error {
+	nodes := make(map[string]graph.Node)
+	node := func(id string) graph.Node {
+		n, ok := nodes[id]
+		if ok {
+			return n
+		}
+		n = dst.NewNode()
+		if s, ok := n.(IDSetter); ok {
+			s.SetEdgeListID(id)
+		}
+		dst.AddNode(n)
+		nodes[id] = n
+		return n
+	}
+
+	wb, weighted := dst.(graph.WeightedBuilder)
+	for {
+		el, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		from, to := node(el.From), node(el.To)
+		var e graph.Edge
+		if weighted {
+			e = wb.NewWeightedEdge(from, to, el.Weight)
+		} else {
+			e = dst.NewEdge(from, to)
+		}
+		if s, ok := e.(encoding.AttributeSetter); ok {
+			for _, a := range el.Attributes {
+				err = s.SetAttribute(a)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if we, ok := e.(graph.WeightedEdge); ok && weighted {
+			wb.SetWeightedEdge(we)
+		} else {
+			dst.SetEdge(e)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package edgelist implements reading and writing of graphs as delimited
+// edge lists.
+//
+// An edge list holds one edge per line, with the source and target node IDs
+// in the first two columns, an optional weight column and optional further
+// attribute columns. Columns may be separated by a delimiter, as in CSV and
+// TSV files, or by runs of white space. The first line may be a header naming
+// the columns; Reader detects headers by default.
+//
+// Reader provides streaming access to the edges of a list so that lists too
+// large to hold in memory may be processed, and Decode adds the edges read by
+// a Reader to a graph.
+package edgelist // import "gonum.org/v1/gonum/graph/encoding/edgelist"
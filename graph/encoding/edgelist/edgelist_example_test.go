@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edgelist_test
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"gonum.org/v1/gonum/graph/encoding/edgelist"
+)
+
+func ExampleReader() {
+	// The edge list could be a file too large
+	// to hold in memory.
+	const data = `from,to,distance,road
+London,Cambridge,64,M11
+Cambridge,Norwich,101,A11
+London,Norwich,188,
+`
+	r := edgelist.NewReader(strings.NewReader(data))
+	r.Comma = ','
+	r.Weight = "distance"
+
+	var total float64
+	for {
+		e, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s-%s %v\n", e.From, e.To, e.Attributes)
+		total += e.Weight
+	}
+	fmt.Println("total distance:", total)
+
+	// Output:
+	//
+	// London-Cambridge [{road M11}]
+	// Cambridge-Norwich [{road A11}]
+	// London-Norwich []
+	// total distance: 353
+}
@@ -0,0 +1,192 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edgelist
+
+import (
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+	const data = `source target weight
+a b 0.5
+b c 2
+c a 1.5
+`
+	dst := newUndirectedGraph()
+	err := Unmarshal([]byte(data), dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := dst.Nodes().Len(); n != 3 {
+		t.Errorf("unexpected number of nodes: got:%d want:3", n)
+	}
+	if n := dst.Edges().Len(); n != 3 {
+		t.Errorf("unexpected number of edges: got:%d want:3", n)
+	}
+
+	wdst := simple.NewWeightedDirectedGraph(0, 0)
+	err = Unmarshal([]byte(data), weightedGraph{wdst})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sum float64
+	edges := wdst.WeightedEdges()
+	for edges.Next() {
+		sum += edges.WeightedEdge().Weight()
+	}
+	if want := 4.0; sum != want {
+		t.Errorf("unexpected total weight: got:%v want:%v", sum, want)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		g     encoding.Builder
+		dst   func() encoding.Builder
+		comma rune
+	}{
+		{name: "undirected", g: newUndirectedGraph(), dst: func() encoding.Builder { return newUndirectedGraph() }, comma: ','},
+		{name: "directed", g: newDirectedGraph(), dst: func() encoding.Builder { return newDirectedGraph() }},
+	} {
+		g := test.g
+		var nodes []*node
+		for _, name := range []string{"a", "b b", "c,d", `"e"`} {
+			n := g.NewNode().(*node)
+			n.name = name
+			g.AddNode(n)
+			nodes = append(nodes, n)
+		}
+		for _, e := range []struct {
+			f, t  int
+			color string
+		}{{0, 1, "red"}, {1, 2, ""}, {2, 3, "blue green"}, {3, 0, "red"}, {0, 2, ""}} {
+			edge := g.NewEdge(nodes[e.f], nodes[e.t]).(*attrEdge)
+			edge.SetAttribute(encoding.Attribute{Key: "color", Value: e.color})
+			edge.SetAttribute(encoding.Attribute{Key: "visible", Value: "true"})
+			g.SetEdge(edge)
+		}
+
+		b, err := Marshal(g, test.comma)
+		if err != nil {
+			t.Fatalf("unexpected error marshaling %s graph: %v", test.name, err)
+		}
+		comma := test.comma
+		if comma == 0 {
+			comma = '\t'
+		}
+		if header := strings.Join([]string{"source", "target", "color", "visible"}, string(comma)); !strings.HasPrefix(string(b), header+"\n") {
+			t.Errorf("unexpected header for %s graph:\n%s", test.name, b)
+		}
+
+		dst := test.dst()
+		r := NewReader(strings.NewReader(string(b)))
+		r.Comma = comma
+		err = Decode(r, dst)
+		if err != nil {
+			t.Fatalf("unexpected error decoding %s graph: %v", test.name, err)
+		}
+		got, err := Marshal(dst, test.comma)
+		if err != nil {
+			t.Fatalf("unexpected error remarshaling %s graph: %v", test.name, err)
+		}
+		if string(got) != string(b) {
+			t.Errorf("round trip mismatch for %s graph:\ngot:\n%s\nwant:\n%s", test.name, got, b)
+		}
+	}
+}
+
+func TestMarshalWeighted(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(2), T: simple.Node(0), W: 2})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 1.5})
+	g.AddNode(simple.Node(3))
+	got, err := Marshal(g, ' ')
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = `source target weight
+0 1 1.5
+0 2 2
+`
+	if string(got) != want {
+		t.Errorf("unexpected marshaled graph:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+type directedGraph struct {
+	*simple.DirectedGraph
+}
+
+func newDirectedGraph() directedGraph {
+	return directedGraph{simple.NewDirectedGraph()}
+}
+
+func (g directedGraph) NewNode() graph.Node {
+	return &node{id: g.DirectedGraph.NewNode().ID()}
+}
+
+func (g directedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{from: from, to: to}
+}
+
+type undirectedGraph struct {
+	*simple.UndirectedGraph
+}
+
+func newUndirectedGraph() undirectedGraph {
+	return undirectedGraph{simple.NewUndirectedGraph()}
+}
+
+func (g undirectedGraph) NewNode() graph.Node {
+	return &node{id: g.UndirectedGraph.NewNode().ID()}
+}
+
+func (g undirectedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{from: from, to: to}
+}
+
+type weightedGraph struct {
+	*simple.WeightedDirectedGraph
+}
+
+func (g weightedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return simple.Edge{F: from, T: to}
+}
+
+func (g weightedGraph) SetEdge(e graph.Edge) {
+	g.SetWeightedEdge(simple.WeightedEdge{F: e.From(), T: e.To(), W: 1})
+}
+
+type node struct {
+	id   int64
+	name string
+}
+
+func (n *node) ID() int64                 { return n.id }
+func (n *node) EdgeListID() string        { return n.name }
+func (n *node) SetEdgeListID(name string) { n.name = name }
+
+type attrEdge struct {
+	from, to graph.Node
+	attrs    encoding.Attributes
+}
+
+func (e *attrEdge) Attributes() []encoding.Attribute           { return e.attrs.Attributes() }
+func (e *attrEdge) SetAttribute(attr encoding.Attribute) error { return e.attrs.SetAttribute(attr) }
+
+func (e *attrEdge) From() graph.Node { return e.from }
+func (e *attrEdge) To() graph.Node   { return e.to }
+func (e *attrEdge) ReversedEdge() graph.Edge {
+	return &attrEdge{from: e.to, to: e.from, attrs: e.attrs}
+}
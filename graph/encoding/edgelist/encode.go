@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edgelist
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Node is an edge list graph node.
+type Node interface {
+	// EdgeListID returns the edge list node ID.
+	EdgeListID() string
+}
+
+// Marshal returns the edge list encoding for the graph g with fields
+// delimited by comma. Marshal is equivalent to calling Encode with a
+// bytes.Buffer.
+func Marshal(g graph.Graph, comma rune) ([]byte, error) {
+	var buf bytes.Buffer
+	err := Encode(&buf, g, comma)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Encode writes the edge list encoding for the graph g to w with fields
+// delimited by comma. If comma is zero, fields are delimited by tabs. Fields
+// are quoted as in RFC 4180 when needed.
+//
+// The edge list starts with a header naming the columns "source" and
+// "target", followed by "weight" if g is a graph.Weighted, and then by the
+// sorted keys of the attributes of edges that implement encoding.Attributer.
+// A "weight" attribute is not written when g is a graph.Weighted.
+// Node IDs are the decimal representation of the node's ID unless the node
+// implements Node. Undirected edges are written once. Nodes without edges are
+// not represented in an edge list.
+func Encode(w io.Writer, g graph.Graph, comma rune) error {
+	if comma == 0 {
+		comma = '\t'
+	}
+	if !validDelim(comma) {
+		return fmt.Errorf("edgelist: invalid field delimiter %q", comma)
+	}
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	ids := make(map[int64]string, len(nodes))
+	seen := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		id := strconv.FormatInt(n.ID(), 10)
+		if n, ok := n.(Node); ok {
+			id = n.EdgeListID()
+		}
+		if seen[id] {
+			return fmt.Errorf("edgelist: duplicate node ID %q", id)
+		}
+		seen[id] = true
+		ids[n.ID()] = id
+	}
+
+	_, directed := g.(graph.Directed)
+	wg, weighted := g.(graph.Weighted)
+	var edges []graph.Edge
+	keys := make(map[string]bool)
+	for _, u := range nodes {
+		uid := u.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		for _, v := range to {
+			vid := v.ID()
+			if !directed && vid < uid {
+				continue
+			}
+			e := g.Edge(uid, vid)
+			if a, ok := e.(encoding.Attributer); ok {
+				for _, attr := range a.Attributes() {
+					if attr.Key != "" && attr.Value != "" && (!weighted || attr.Key != "weight") {
+						keys[attr.Key] = true
+					}
+				}
+			}
+			edges = append(edges, e)
+		}
+	}
+
+	header := []string{"source", "target"}
+	if weighted {
+		header = append(header, "weight")
+	}
+	fixed := len(header)
+	names := make([]string, 0, len(keys))
+	for k := range keys {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	header = append(header, names...)
+	col := make(map[string]int, len(names))
+	for i, k := range names {
+		col[k] = fixed + i
+	}
+
+	err := cw.Write(header)
+	if err != nil {
+		return err
+	}
+	rec := make([]string, len(header))
+	for _, e := range edges {
+		uid, vid := e.From().ID(), e.To().ID()
+		for i := range rec {
+			rec[i] = ""
+		}
+		rec[0], rec[1] = ids[uid], ids[vid]
+		if weighted {
+			w, _ := wg.Weight(uid, vid)
+			rec[2] = strconv.FormatFloat(w, 'g', -1, 64)
+		}
+		if a, ok := e.(encoding.Attributer); ok {
+			for _, attr := range a.Attributes() {
+				if i, ok := col[attr.Key]; ok && attr.Value != "" {
+					rec[i] = attr.Value
+				}
+			}
+		}
+		err = cw.Write(rec)
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// validDelim returns whether r is a valid field delimiter.
+func validDelim(r rune) bool {
+	return r != 0 && r != '"' && r != '\r' && r != '\n' && utf8.ValidRune(r) && r != utf8.RuneError
+}
@@ -0,0 +1,274 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edgelist
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gonum.org/v1/gonum/graph/encoding"
+)
+
+// HeaderMode specifies how the first record of an edge list is interpreted.
+type HeaderMode int
+
+const (
+	// DetectHeader treats the first record as a header if its first two
+	// fields are common names for edge end point columns, such as "source"
+	// and "target" or "from" and "to", or if its third field is not numeric
+	// while the third field of the second record is.
+	DetectHeader HeaderMode = iota
+
+	// NoHeader treats the first record as an edge.
+	NoHeader
+
+	// HasHeader treats the first record as a header.
+	HasHeader
+)
+
+// endPointNames holds the lower case column names
+// recognized as edge end point columns by DetectHeader.
+var endPointNames = map[string]bool{
+	"source": true, "target": true,
+	"from": true, "to": true,
+	"src": true, "dst": true,
+	"head": true, "tail": true,
+	"node1": true, "node2": true,
+	"u": true, "v": true,
+}
+
+// Edge is an edge read from an edge list.
+type Edge struct {
+	// From and To are the IDs of the
+	// edge's end points.
+	From, To string
+
+	// Weight is the edge weight. It is 1 if
+	// the edge list has no weight column.
+	Weight float64
+
+	// Attributes holds the non-empty values of
+	// the columns other than the end point and
+	// weight columns, keyed by the column name
+	// or, in the absence of a header, by the
+	// decimal column index.
+	Attributes []encoding.Attribute
+}
+
+// Reader reads edges from a delimited edge list. The exported fields of a
+// Reader may be set after calling NewReader and before the first call to Read
+// or Columns.
+type Reader struct {
+	// Comma is the field delimiter. If Comma
+	// is zero, fields are separated by runs of
+	// white space and may not be quoted.
+	// Otherwise fields may be quoted as in RFC
+	// 4180. Comma must not be '"', '\r', '\n',
+	// the Unicode replacement character or
+	// the Comment character.
+	Comma rune
+
+	// Comment, if not zero, is the comment
+	// character. Lines beginning with the
+	// Comment character are ignored.
+	Comment rune
+
+	// Header specifies how the first record
+	// is interpreted.
+	Header HeaderMode
+
+	// Weight is the case insensitive name of
+	// the weight column in a header. If Weight
+	// is empty, "weight" is used. Without a
+	// header, the third column, if present,
+	// is the weight column.
+	Weight string
+
+	r io.Reader
+
+	started bool
+	csv     *csv.Reader
+	lines   *bufio.Scanner
+	line    int
+
+	header  []string
+	weight  int
+	pending []record
+}
+
+// record is a record of an edge list with
+// the line number on which it starts.
+type record struct {
+	fields []string
+	line   int
+}
+
+// NewReader returns a new Reader that reads from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// Columns returns the column names of the edge list's header, or nil if the
+// edge list has no header. The returned slice must not be modified.
+func (r *Reader) Columns() ([]string, error) {
+	err := r.start()
+	return r.header, err
+}
+
+// Read reads the next edge from the edge list. If there are no more edges,
+// Read returns io.EOF.
+func (r *Reader) Read() (Edge, error) {
+	err := r.start()
+	if err != nil {
+		return Edge{}, err
+	}
+	var rec record
+	if len(r.pending) != 0 {
+		rec = r.pending[0]
+		r.pending = r.pending[1:]
+	} else {
+		rec, err = r.next()
+		if err != nil {
+			return Edge{}, err
+		}
+	}
+	return r.edge(rec)
+}
+
+// start prepares the Reader on the first call, reading the
+// header if there is one.
+func (r *Reader) start() error {
+	if r.started {
+		return nil
+	}
+	r.started = true
+	if r.Comma != 0 && (!validDelim(r.Comma) || r.Comma == r.Comment) {
+		return fmt.Errorf("edgelist: invalid field delimiter %q", r.Comma)
+	}
+	if r.Comma == 0 {
+		r.lines = bufio.NewScanner(r.r)
+		r.lines.Buffer(nil, 1<<30)
+	} else {
+		r.csv = csv.NewReader(r.r)
+		r.csv.Comma = r.Comma
+		r.csv.Comment = r.Comment
+		r.csv.FieldsPerRecord = -1
+		r.csv.TrimLeadingSpace = !unicode.IsSpace(r.Comma)
+	}
+	r.weight = 2
+
+	if r.Header == NoHeader {
+		return nil
+	}
+	first, err := r.next()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	isHeader := r.Header == HasHeader
+	if !isHeader {
+		isHeader = len(first.fields) >= 2 &&
+			endPointNames[strings.ToLower(first.fields[0])] &&
+			endPointNames[strings.ToLower(first.fields[1])]
+	}
+	if !isHeader && len(first.fields) >= 3 && !isNumeric(first.fields[2]) {
+		second, err := r.next()
+		switch {
+		case err == nil:
+			r.pending = append(r.pending, second)
+			isHeader = len(second.fields) >= 3 && isNumeric(second.fields[2])
+		case err != io.EOF:
+			return err
+		}
+	}
+	if !isHeader {
+		r.pending = append([]record{first}, r.pending...)
+		return nil
+	}
+
+	r.header = first.fields
+	name := r.Weight
+	if name == "" {
+		name = "weight"
+	}
+	r.weight = -1
+	for i, h := range r.header {
+		if i >= 2 && strings.EqualFold(strings.TrimSpace(h), name) {
+			r.weight = i
+			break
+		}
+	}
+	return nil
+}
+
+// next returns the next non-empty record from the underlying reader.
+func (r *Reader) next() (record, error) {
+	if r.csv != nil {
+		fields, err := r.csv.Read()
+		if err != nil {
+			return record{}, err
+		}
+		line, _ := r.csv.FieldPos(0)
+		return record{fields: fields, line: line}, nil
+	}
+	for r.lines.Scan() {
+		r.line++
+		text := r.lines.Text()
+		if r.Comment != 0 && strings.HasPrefix(text, string(r.Comment)) {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		return record{fields: fields, line: r.line}, nil
+	}
+	err := r.lines.Err()
+	if err == nil {
+		err = io.EOF
+	}
+	return record{}, err
+}
+
+// edge returns the edge described by rec.
+func (r *Reader) edge(rec record) (Edge, error) {
+	if len(rec.fields) < 2 {
+		return Edge{}, fmt.Errorf("edgelist: line %d: too few fields", rec.line)
+	}
+	e := Edge{From: rec.fields[0], To: rec.fields[1], Weight: 1}
+	for i, f := range rec.fields[2:] {
+		i += 2
+		if i == r.weight {
+			w, err := strconv.ParseFloat(strings.TrimSpace(f), 64)
+			if err != nil {
+				return Edge{}, fmt.Errorf("edgelist: line %d: invalid weight %q", rec.line, f)
+			}
+			e.Weight = w
+			continue
+		}
+		if f == "" {
+			continue
+		}
+		key := strconv.Itoa(i)
+		if i < len(r.header) {
+			key = r.header[i]
+		}
+		e.Attributes = append(e.Attributes, encoding.Attribute{Key: key, Value: f})
+	}
+	return e, nil
+}
+
+// isNumeric returns whether s is a decimal floating point number.
+func isNumeric(s string) bool {
+	_, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return err == nil
+}
@@ -0,0 +1,247 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edgelist
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/encoding"
+)
+
+var readerTests = []struct {
+	name   string
+	data   string
+	reader Reader
+
+	wantColumns []string
+	want        []Edge
+}{
+	{
+		name: "white space",
+		data: "a b\n\n  b\tc  \n",
+		want: []Edge{
+			{From: "a", To: "b", Weight: 1},
+			{From: "b", To: "c", Weight: 1},
+		},
+	},
+	{
+		name: "white space weighted",
+		data: "# comment\n1 2 0.5\n2 3 -1e2 red\n",
+		reader: Reader{
+			Comment: '#',
+		},
+		want: []Edge{
+			{From: "1", To: "2", Weight: 0.5},
+			{From: "2", To: "3", Weight: -100, Attributes: []encoding.Attribute{{Key: "3", Value: "red"}}},
+		},
+	},
+	{
+		name: "csv named header",
+		data: "Source,Target\n\"a, b\",c\n",
+		reader: Reader{
+			Comma: ',',
+		},
+		wantColumns: []string{"Source", "Target"},
+		want: []Edge{
+			{From: "a, b", To: "c", Weight: 1},
+		},
+	},
+	{
+		name: "csv detected header",
+		data: "a,b,cost,color\nx,y,2,red\ny,z,3,\n",
+		reader: Reader{
+			Comma:  ',',
+			Weight: "Cost",
+		},
+		wantColumns: []string{"a", "b", "cost", "color"},
+		want: []Edge{
+			{From: "x", To: "y", Weight: 2, Attributes: []encoding.Attribute{{Key: "color", Value: "red"}}},
+			{From: "y", To: "z", Weight: 3},
+		},
+	},
+	{
+		name: "csv header without weight",
+		data: "from;to;label\nx;y;2\n",
+		reader: Reader{
+			Comma: ';',
+		},
+		wantColumns: []string{"from", "to", "label"},
+		want: []Edge{
+			{From: "x", To: "y", Weight: 1, Attributes: []encoding.Attribute{{Key: "label", Value: "2"}}},
+		},
+	},
+	{
+		name: "no header",
+		data: "source target\nx y\n",
+		reader: Reader{
+			Header: NoHeader,
+		},
+		want: []Edge{
+			{From: "source", To: "target", Weight: 1},
+			{From: "x", To: "y", Weight: 1},
+		},
+	},
+	{
+		name: "has header",
+		data: "n m w\nx y 3\n",
+		reader: Reader{
+			Header: HasHeader,
+			Weight: "w",
+		},
+		wantColumns: []string{"n", "m", "w"},
+		want: []Edge{
+			{From: "x", To: "y", Weight: 3},
+		},
+	},
+	{
+		name: "header only",
+		data: "source\ttarget\tweight\n",
+		reader: Reader{
+			Comma: '\t',
+		},
+		wantColumns: []string{"source", "target", "weight"},
+	},
+	{
+		name: "empty",
+		data: "",
+	},
+}
+
+func TestReader(t *testing.T) {
+	t.Parallel()
+	for _, test := range readerTests {
+		r := NewReader(strings.NewReader(test.data))
+		r.Comma = test.reader.Comma
+		r.Comment = test.reader.Comment
+		r.Header = test.reader.Header
+		r.Weight = test.reader.Weight
+
+		columns, err := r.Columns()
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(columns, test.wantColumns) {
+			t.Errorf("unexpected columns for %q: got:%q want:%q", test.name, columns, test.wantColumns)
+		}
+		var got []Edge
+		for {
+			e, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Errorf("unexpected error for %q: %v", test.name, err)
+				break
+			}
+			got = append(got, e)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected edges for %q:\ngot: %+v\nwant:%+v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		data  string
+		comma rune
+		want  string
+	}{
+		{
+			name: "too few fields",
+			data: "a b\nc\n",
+			want: "edgelist: line 2: too few fields",
+		},
+		{
+			name: "invalid weight",
+			data: "a b 1\nc d e\n",
+			want: `edgelist: line 2: invalid weight "e"`,
+		},
+		{
+			name:  "invalid delimiter",
+			data:  "a b\n",
+			comma: '"',
+			want:  `edgelist: invalid field delimiter '"'`,
+		},
+		{
+			name:  "malformed csv",
+			data:  "a,\"b\n",
+			comma: ',',
+			want:  `parse error on line 1, column 6: extraneous or missing " in quoted-field`,
+		},
+	} {
+		r := NewReader(strings.NewReader(test.data))
+		r.Comma = test.comma
+		var err error
+		for err == nil {
+			_, err = r.Read()
+		}
+		if err == io.EOF || err.Error() != test.want {
+			t.Errorf("unexpected error for %s: got:%v want:%s", test.name, err, test.want)
+		}
+	}
+}
+
+// lines is an io.Reader that generates an edge list
+// of a path without holding the list in memory.
+type lines struct {
+	n, i int
+	buf  []byte
+}
+
+func (l *lines) Read(p []byte) (int, error) {
+	for len(l.buf) == 0 {
+		if l.i == l.n {
+			return 0, io.EOF
+		}
+		l.buf = fmt.Appendf(l.buf, "%d,%d,%d\n", l.i, l.i+1, l.i%7)
+		l.i++
+	}
+	n := copy(p, l.buf)
+	l.buf = l.buf[n:]
+	return n, nil
+}
+
+func TestReaderStreaming(t *testing.T) {
+	t.Parallel()
+	const n = 100000
+	r := NewReader(&lines{n: n})
+	r.Comma = ','
+	var (
+		count int
+		sum   float64
+	)
+	for {
+		e, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := fmt.Sprint(count); e.From != want {
+			t.Fatalf("unexpected edge source: got:%s want:%s", e.From, want)
+		}
+		sum += e.Weight
+		count++
+	}
+	if count != n {
+		t.Errorf("unexpected number of edges: got:%d want:%d", count, n)
+	}
+	var want float64
+	for i := 0; i < n; i++ {
+		want += float64(i % 7)
+	}
+	if sum != want {
+		t.Errorf("unexpected total weight: got:%v want:%v", sum, want)
+	}
+}
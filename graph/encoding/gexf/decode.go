@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gexf
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/formats/gexf12"
+)
+
+// IDSetter is implemented by graph nodes that can set their GEXF node ID.
+type IDSetter interface {
+	SetGEXFID(id string)
+}
+
+// Unmarshal parses the GEXF-encoded data and stores the result in dst.
+//
+// Nodes are created by dst.NewNode and are given their GEXF ID if they
+// implement IDSetter. Node and edge attributes, including declared default
+// values, labels and edge weights, and graph metadata are set on dst, nodes
+// and edges that implement encoding.AttributeSetter. If dst is a
+// graph.WeightedBuilder, edges are created by NewWeightedEdge with the GEXF
+// edge weight, which is 1 when the weight is absent. Dynamic attributes,
+// hierarchical nodes and visualization data are ignored.
+func Unmarshal(data []byte, dst encoding.Builder) error {
+	wb, weighted := dst.(graph.WeightedBuilder)
+	return unmarshal(data, dst, func(from, to graph.Node, w float64, attrs func() ([]encoding.Attribute, error)) error {
+		var e graph.Edge
+		if weighted {
+			e = wb.NewWeightedEdge(from, to, w)
+		} else {
+			e = dst.NewEdge(from, to)
+		}
+		if s, ok := e.(encoding.AttributeSetter); ok {
+			attrs, err := attrs()
+			if err != nil {
+				return err
+			}
+			err = setAttributes(s, attrs)
+			if err != nil {
+				return err
+			}
+		}
+		if we, ok := e.(graph.WeightedEdge); ok && weighted {
+			wb.SetWeightedEdge(we)
+		} else {
+			dst.SetEdge(e)
+		}
+		return nil
+	})
+}
+
+// UnmarshalMulti parses the GEXF-encoded data as a multigraph and stores the
+// result in dst.
+//
+// Each GEXF edge is added to dst as a new line, so parallel edges are
+// retained. Nodes and attributes are handled as described for Unmarshal. If
+// dst is a graph.WeightedMultigraphBuilder, lines are created by
+// NewWeightedLine with the GEXF edge weight, which is 1 when the weight is
+// absent.
+func UnmarshalMulti(data []byte, dst encoding.MultiBuilder) error {
+	wb, weighted := dst.(graph.WeightedMultigraphBuilder)
+	return unmarshal(data, dst, func(from, to graph.Node, w float64, attrs func() ([]encoding.Attribute, error)) error {
+		var l graph.Line
+		if weighted {
+			l = wb.NewWeightedLine(from, to, w)
+		} else {
+			l = dst.NewLine(from, to)
+		}
+		if s, ok := l.(encoding.AttributeSetter); ok {
+			attrs, err := attrs()
+			if err != nil {
+				return err
+			}
+			err = setAttributes(s, attrs)
+			if err != nil {
+				return err
+			}
+		}
+		if wl, ok := l.(graph.WeightedLine); ok && weighted {
+			wb.SetWeightedLine(wl)
+		} else {
+			dst.SetLine(l)
+		}
+		return nil
+	})
+}
+
+// unmarshal parses the GEXF-encoded data, adding its nodes to dst and
+// setting the graph metadata of dst. Each edge is added by calling setEdge
+// with the edge's end nodes and weight, and a function returning the
+// edge's attributes.
+func unmarshal(data []byte, dst graph.NodeAdder, setEdge func(from, to graph.Node, w float64, attrs func() ([]encoding.Attribute, error)) error) error {
+	var content gexf12.Content
+	err := xml.Unmarshal(data, &content)
+	if err != nil {
+		return err
+	}
+
+	if s, ok := dst.(encoding.AttributeSetter); ok && content.Meta != nil {
+		for _, a := range []encoding.Attribute{
+			{Key: "creator", Value: content.Meta.Creator},
+			{Key: "description", Value: content.Meta.Description},
+			{Key: "keywords", Value: content.Meta.Keywords},
+		} {
+			if a.Value == "" {
+				continue
+			}
+			err = s.SetAttribute(a)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	decls := make(map[string][]gexf12.Attribute)
+	for _, a := range content.Graph.Attributes {
+		decls[a.Class] = append(decls[a.Class], a.Attributes...)
+	}
+
+	nodes := make(map[string]graph.Node, len(content.Graph.Nodes.Nodes))
+	node := func(id string) graph.Node {
+		n, ok := nodes[id]
+		if ok {
+			return n
+		}
+		n = dst.NewNode()
+		if s, ok := n.(IDSetter); ok {
+			s.SetGEXFID(id)
+		}
+		dst.AddNode(n)
+		nodes[id] = n
+		return n
+	}
+	for _, el := range content.Graph.Nodes.Nodes {
+		if _, ok := nodes[el.ID]; ok {
+			return fmt.Errorf("gexf: duplicate node ID %q", el.ID)
+		}
+		n := node(el.ID)
+		s, ok := n.(encoding.AttributeSetter)
+		if !ok {
+			continue
+		}
+		attrs, err := attributesOf(decls["node"], el.AttValues)
+		if err != nil {
+			return err
+		}
+		if el.Label != "" {
+			attrs = append(attrs, encoding.Attribute{Key: "label", Value: el.Label})
+		}
+		err = setAttributes(s, attrs)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, el := range content.Graph.Edges.Edges {
+		w := el.Weight
+		if w == 0 {
+			w = 1
+		}
+		err = setEdge(node(el.Source), node(el.Target), w, func() ([]encoding.Attribute, error) {
+			attrs, err := attributesOf(decls["edge"], el.AttValues)
+			if err != nil {
+				return nil, err
+			}
+			if el.Label != "" {
+				attrs = append(attrs, encoding.Attribute{Key: "label", Value: el.Label})
+			}
+			if el.Weight != 0 {
+				attrs = append(attrs, encoding.Attribute{Key: "weight", Value: strconv.FormatFloat(el.Weight, 'g', -1, 64)})
+			}
+			return attrs, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attributesOf returns the attributes for the given attribute values,
+// including default values for absent attributes.
+func attributesOf(decls []gexf12.Attribute, values *gexf12.AttValues) ([]encoding.Attribute, error) {
+	var attrs []encoding.Attribute
+	set := make(map[string]bool)
+	if values != nil {
+		for _, v := range values.AttValues {
+			var title string
+			for _, d := range decls {
+				if d.ID == v.For {
+					title = d.Title
+					break
+				}
+			}
+			if title == "" {
+				return nil, fmt.Errorf("gexf: undeclared attribute %q", v.For)
+			}
+			attrs = append(attrs, encoding.Attribute{Key: title, Value: v.Value})
+			set[v.For] = true
+		}
+	}
+	for _, d := range decls {
+		if d.Default != "" && !set[d.ID] {
+			attrs = append(attrs, encoding.Attribute{Key: d.Title, Value: d.Default})
+		}
+	}
+	return attrs, nil
+}
+
+func setAttributes(dst encoding.AttributeSetter, attrs []encoding.Attribute) error {
+	for _, a := range attrs {
+		err := dst.SetAttribute(a)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gexf implements GEXF 1.2 marshaling and unmarshaling of graphs.
+//
+// Node and edge attributes are obtained from and set through the
+// encoding.Attributer and encoding.AttributeSetter interfaces. The "label"
+// attribute of nodes and edges and the "weight" attribute of edges are
+// mapped to the corresponding GEXF element attributes, and the "creator",
+// "description" and "keywords" graph attributes are mapped to the GEXF
+// metadata. Other node and edge attributes are written as typed GEXF
+// attribute values.
+//
+// Multigraphs are marshaled and unmarshaled with MarshalMulti and
+// UnmarshalMulti, which write each line as a GEXF edge, retaining
+// parallel edges.
+//
+// See https://gephi.org/gexf/format/ for a definition of GEXF.
+package gexf // import "gonum.org/v1/gonum/graph/encoding/gexf"
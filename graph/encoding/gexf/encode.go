@@ -0,0 +1,303 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gexf
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/formats/gexf12"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Node is a GEXF graph node.
+type Node interface {
+	// GEXFID returns the GEXF node ID.
+	GEXFID() string
+}
+
+// Marshal returns the GEXF encoding for the graph g, applying the prefix and
+// indent to the encoding.
+//
+// Node IDs are the decimal representation of the node's ID unless the node
+// implements Node. Graph, node and edge attributes are written for values
+// implementing encoding.Attributer. The type of each node and edge attribute
+// is the narrowest of "boolean", "long", "double" and "string" that represents
+// all the values of the attribute. Graph attributes other than "creator",
+// "description" and "keywords" are not written. If an edge is a
+// graph.WeightedEdge, its weight is written as the edge weight.
+func Marshal(g graph.Graph, prefix, indent string) ([]byte, error) {
+	_, directed := g.(graph.Directed)
+	return marshal(g, directed, prefix, indent, func(uid, vid int64) []edge {
+		return []edge{edgeOf(g.Edge(uid, vid))}
+	})
+}
+
+// MarshalMulti returns the GEXF encoding for the multigraph g, applying the
+// prefix and indent to the encoding.
+//
+// Each line of g is written as a GEXF edge, so parallel edges are written
+// as edges with distinct IDs and the same source and target. Node IDs and
+// attributes are written as described for Marshal, with graph.WeightedLine
+// values having their weight written as the edge weight.
+func MarshalMulti(g graph.Multigraph, prefix, indent string) ([]byte, error) {
+	_, directed := g.(graph.DirectedMultigraph)
+	return marshal(g, directed, prefix, indent, func(uid, vid int64) []edge {
+		lines := graph.LinesOf(g.Lines(uid, vid))
+		order.LinesByIDs(lines)
+		edges := make([]edge, len(lines))
+		for i, l := range lines {
+			edges[i] = edgeOf(l)
+		}
+		return edges
+	})
+}
+
+// nodeSet is the node iteration behavior shared by graphs and multigraphs.
+type nodeSet interface {
+	Nodes() graph.Nodes
+	From(id int64) graph.Nodes
+}
+
+// edge is a GEXF edge element without its source, target
+// and ID, and the attributes to be written as its values.
+type edge struct {
+	el    gexf12.Edge
+	attrs []encoding.Attribute
+}
+
+// edgeOf returns the GEXF edge for the edge or line e. The "label"
+// attribute of e and its weight, or its numeric "weight" attribute if
+// e is not weighted, are held in the edge element.
+func edgeOf(e interface{}) edge {
+	var d edge
+	w, weighted := e.(interface{ Weight() float64 })
+	if weighted {
+		d.el.Weight = w.Weight()
+	}
+	a, ok := e.(encoding.Attributer)
+	if !ok {
+		return d
+	}
+	for _, attr := range a.Attributes() {
+		switch attr.Key {
+		case "label":
+			d.el.Label = attr.Value
+			continue
+		case "weight":
+			if w, err := strconv.ParseFloat(attr.Value, 64); err == nil {
+				if !weighted {
+					d.el.Weight = w
+				}
+				continue
+			}
+		}
+		d.attrs = append(d.attrs, attr)
+	}
+	return d
+}
+
+// marshal returns the GEXF encoding for g. The edges
+// from uid to vid are returned by edges.
+func marshal(g nodeSet, directed bool, prefix, indent string, edges func(uid, vid int64) []edge) ([]byte, error) {
+	content := gexf12.Content{
+		Version: "1.2",
+		Graph: gexf12.Graph{
+			DefaultEdgeType: "undirected",
+			Mode:            "static",
+		},
+	}
+	if directed {
+		content.Graph.DefaultEdgeType = "directed"
+	}
+
+	if a, ok := g.(encoding.Attributer); ok {
+		var meta gexf12.Meta
+		for _, attr := range a.Attributes() {
+			switch attr.Key {
+			case "creator":
+				meta.Creator = attr.Value
+			case "description":
+				meta.Description = attr.Value
+			case "keywords":
+				meta.Keywords = attr.Value
+			}
+		}
+		if meta != (gexf12.Meta{}) {
+			content.Meta = &meta
+		}
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	ids := make(map[int64]string, len(nodes))
+	seen := make(map[string]bool, len(nodes))
+	nodeAttrs := newAttrSet()
+	for _, n := range nodes {
+		id := strconv.FormatInt(n.ID(), 10)
+		if n, ok := n.(Node); ok {
+			id = n.GEXFID()
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("gexf: duplicate node ID %q", id)
+		}
+		seen[id] = true
+		ids[n.ID()] = id
+
+		el := gexf12.Node{ID: id}
+		if a, ok := n.(encoding.Attributer); ok {
+			for _, attr := range a.Attributes() {
+				if attr.Key == "label" {
+					el.Label = attr.Value
+					continue
+				}
+				nodeAttrs.add(len(content.Graph.Nodes.Nodes), attr)
+			}
+		}
+		content.Graph.Nodes.Nodes = append(content.Graph.Nodes.Nodes, el)
+	}
+
+	edgeAttrs := newAttrSet()
+	for _, u := range nodes {
+		uid := u.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		for _, v := range to {
+			vid := v.ID()
+			if !directed && vid < uid {
+				continue
+			}
+			for _, e := range edges(uid, vid) {
+				el := e.el
+				el.ID = strconv.Itoa(len(content.Graph.Edges.Edges))
+				el.Source = ids[uid]
+				el.Target = ids[vid]
+				for _, attr := range e.attrs {
+					edgeAttrs.add(len(content.Graph.Edges.Edges), attr)
+				}
+				content.Graph.Edges.Edges = append(content.Graph.Edges.Edges, el)
+			}
+		}
+	}
+
+	if decl := nodeAttrs.declarations("node"); decl != nil {
+		content.Graph.Attributes = append(content.Graph.Attributes, *decl)
+		for i := range content.Graph.Nodes.Nodes {
+			content.Graph.Nodes.Nodes[i].AttValues = nodeAttrs.values(i)
+		}
+	}
+	if decl := edgeAttrs.declarations("edge"); decl != nil {
+		content.Graph.Attributes = append(content.Graph.Attributes, *decl)
+		for i := range content.Graph.Edges.Edges {
+			content.Graph.Edges.Edges[i].AttValues = edgeAttrs.values(i)
+		}
+	}
+
+	b, err := xml.MarshalIndent(content, prefix, indent)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(prefix+xml.Header), b...), nil
+}
+
+// attrSet collects the attributes of a class of graph elements.
+type attrSet struct {
+	// byElement holds the attributes of each
+	// element, keyed by element index.
+	byElement map[int][]encoding.Attribute
+
+	// byKey holds all the values of each
+	// attribute key.
+	byKey map[string][]string
+
+	// ids holds the GEXF attribute ID
+	// for each attribute key.
+	ids map[string]string
+}
+
+func newAttrSet() *attrSet {
+	return &attrSet{
+		byElement: make(map[int][]encoding.Attribute),
+		byKey:     make(map[string][]string),
+	}
+}
+
+// add adds an attribute of the element with index i to the set.
+func (s *attrSet) add(i int, attr encoding.Attribute) {
+	if attr.Key == "" || attr.Value == "" {
+		return
+	}
+	s.byElement[i] = append(s.byElement[i], attr)
+	s.byKey[attr.Key] = append(s.byKey[attr.Key], attr.Value)
+}
+
+// declarations returns the GEXF attribute declarations for the given
+// class of element, assigning attribute IDs. If there are no attributes
+// in the set, declarations returns nil.
+func (s *attrSet) declarations(class string) *gexf12.Attributes {
+	if len(s.byKey) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(s.byKey))
+	for k := range s.byKey {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	decl := gexf12.Attributes{Class: class}
+	s.ids = make(map[string]string, len(keys))
+	for i, k := range keys {
+		id := strconv.Itoa(i)
+		s.ids[k] = id
+		decl.Attributes = append(decl.Attributes, gexf12.Attribute{
+			ID:    id,
+			Title: k,
+			Type:  typeOf(s.byKey[k]),
+		})
+	}
+	return &decl
+}
+
+// values returns the GEXF attribute values for the element with index i.
+func (s *attrSet) values(i int) *gexf12.AttValues {
+	attrs := s.byElement[i]
+	if len(attrs) == 0 {
+		return nil
+	}
+	var v gexf12.AttValues
+	for _, a := range attrs {
+		v.AttValues = append(v.AttValues, gexf12.AttValue{For: s.ids[a.Key], Value: a.Value})
+	}
+	return &v
+}
+
+// typeOf returns the narrowest GEXF type that can represent all of values.
+func typeOf(values []string) string {
+	isBool, isLong, isDouble := true, true, true
+	for _, v := range values {
+		if v != "true" && v != "false" {
+			isBool = false
+		}
+		if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+			isLong = false
+		}
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			isDouble = false
+		}
+	}
+	switch {
+	case isBool:
+		return "boolean"
+	case isLong:
+		return "long"
+	case isDouble:
+		return "double"
+	default:
+		return "string"
+	}
+}
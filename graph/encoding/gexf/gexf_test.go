@@ -0,0 +1,484 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gexf
+
+import (
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// data is the data example from https://gephi.org/gexf/format/data.html.
+const data = `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">
+    <meta lastmodifieddate="2009-03-20">
+        <creator>Gephi.org</creator>
+        <description>A Web network</description>
+    </meta>
+    <graph defaultedgetype="directed">
+        <attributes class="node">
+            <attribute id="0" title="url" type="string"/>
+            <attribute id="1" title="indegree" type="float"/>
+            <attribute id="2" title="frog" type="boolean">
+                <default>true</default>
+            </attribute>
+        </attributes>
+        <nodes>
+            <node id="0" label="Gephi">
+                <attvalues>
+                    <attvalue for="0" value="http://gephi.org"/>
+                    <attvalue for="1" value="1"/>
+                </attvalues>
+            </node>
+            <node id="1" label="Webatlas">
+                <attvalues>
+                    <attvalue for="0" value="http://webatlas.fr"/>
+                    <attvalue for="1" value="2"/>
+                </attvalues>
+            </node>
+            <node id="2" label="RTGI">
+                <attvalues>
+                    <attvalue for="0" value="http://rtgi.fr"/>
+                    <attvalue for="1" value="1"/>
+                </attvalues>
+            </node>
+            <node id="3" label="BarabasiLab">
+                <attvalues>
+                    <attvalue for="0" value="http://barabasilab.com"/>
+                    <attvalue for="1" value="1"/>
+                    <attvalue for="2" value="false"/>
+                </attvalues>
+            </node>
+        </nodes>
+        <edges>
+            <edge id="0" source="0" target="1" weight="2.5"/>
+            <edge id="1" source="0" target="2"/>
+            <edge id="2" source="1" target="0"/>
+            <edge id="3" source="2" target="1"/>
+            <edge id="4" source="0" target="3" label="cites"/>
+        </edges>
+    </graph>
+</gexf>
+`
+
+func TestUnmarshalData(t *testing.T) {
+	t.Parallel()
+	dst := newDirectedGraph()
+	err := Unmarshal([]byte(data), dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := attr(dst.attrs, "creator"); got != "Gephi.org" {
+		t.Errorf("unexpected creator: got:%q want:%q", got, "Gephi.org")
+	}
+	if got := attr(dst.attrs, "description"); got != "A Web network" {
+		t.Errorf("unexpected description: got:%q want:%q", got, "A Web network")
+	}
+
+	want := map[string]map[string]string{
+		"0": {"label": "Gephi", "url": "http://gephi.org", "indegree": "1", "frog": "true"},
+		"1": {"label": "Webatlas", "url": "http://webatlas.fr", "indegree": "2", "frog": "true"},
+		"2": {"label": "RTGI", "url": "http://rtgi.fr", "indegree": "1", "frog": "true"},
+		"3": {"label": "BarabasiLab", "url": "http://barabasilab.com", "indegree": "1", "frog": "false"},
+	}
+	byName := make(map[string]*node)
+	nodes := dst.Nodes()
+	for nodes.Next() {
+		n := nodes.Node().(*node)
+		byName[n.name] = n
+		for k, v := range want[n.name] {
+			if got := attr(n.attrs, k); got != v {
+				t.Errorf("unexpected %s attribute for node %s: got:%q want:%q", k, n.name, got, v)
+			}
+		}
+		if len(n.attrs) != len(want[n.name]) {
+			t.Errorf("unexpected number of attributes for node %s: got:%d want:%d", n.name, len(n.attrs), len(want[n.name]))
+		}
+	}
+	if len(byName) != len(want) {
+		t.Errorf("unexpected number of nodes: got:%d want:%d", len(byName), len(want))
+	}
+	if n := dst.Edges().Len(); n != 5 {
+		t.Errorf("unexpected number of edges: got:%d want:5", n)
+	}
+	e := dst.Edge(byName["0"].ID(), byName["1"].ID()).(*attrEdge)
+	if got := attr(e.attrs, "weight"); got != "2.5" {
+		t.Errorf("unexpected edge weight attribute: got:%q want:%q", got, "2.5")
+	}
+	e = dst.Edge(byName["0"].ID(), byName["3"].ID()).(*attrEdge)
+	if got := attr(e.attrs, "label"); got != "cites" {
+		t.Errorf("unexpected edge label: got:%q want:%q", got, "cites")
+	}
+
+	wdst := weightedGraph{simple.NewWeightedDirectedGraph(0, 0)}
+	err = Unmarshal([]byte(data), wdst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sum float64
+	edges := wdst.WeightedEdges()
+	for edges.Next() {
+		sum += edges.WeightedEdge().Weight()
+	}
+	if want := 2.5 + 4; sum != want {
+		t.Errorf("unexpected total weight: got:%v want:%v", sum, want)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		g    interface {
+			encoding.Builder
+			encoding.AttributeSetter
+		}
+		dst func() encoding.Builder
+	}{
+		{name: "undirected", g: newUndirectedGraph(), dst: func() encoding.Builder { return newUndirectedGraph() }},
+		{name: "directed", g: newDirectedGraph(), dst: func() encoding.Builder { return newDirectedGraph() }},
+	} {
+		g := test.g
+		g.SetAttribute(encoding.Attribute{Key: "creator", Value: "gonum"})
+		var nodes []*node
+		for i, name := range []string{"a", "b", "c", "d"} {
+			n := g.NewNode().(*node)
+			n.name = name
+			n.SetAttribute(encoding.Attribute{Key: "rank", Value: string(rune('1' + i))})
+			if i%2 == 0 {
+				n.SetAttribute(encoding.Attribute{Key: "label", Value: "node <" + name + ">"})
+			}
+			g.AddNode(n)
+			nodes = append(nodes, n)
+		}
+		for _, e := range []struct {
+			f, t   int
+			weight string
+		}{{0, 1, "0.5"}, {1, 2, "2"}, {2, 3, "-1e-3"}, {3, 0, ""}, {0, 2, "7"}} {
+			edge := g.NewEdge(nodes[e.f], nodes[e.t]).(*attrEdge)
+			edge.SetAttribute(encoding.Attribute{Key: "weight", Value: e.weight})
+			edge.SetAttribute(encoding.Attribute{Key: "visible", Value: "true"})
+			g.SetEdge(edge)
+		}
+
+		b, err := Marshal(g, "", "\t")
+		if err != nil {
+			t.Fatalf("unexpected error marshaling %s graph: %v", test.name, err)
+		}
+		for _, want := range []string{
+			`<creator>gonum</creator>`,
+			`<attribute id="0" title="rank" type="long"></attribute>`,
+			`<attribute id="0" title="visible" type="boolean"></attribute>`,
+			`label="node &lt;a&gt;"`,
+			`weight="-0.001"`,
+		} {
+			if !strings.Contains(string(b), want) {
+				t.Errorf("missing %q in marshaled %s graph:\n%s", want, test.name, b)
+			}
+		}
+
+		dst := test.dst()
+		err = Unmarshal(b, dst)
+		if err != nil {
+			t.Fatalf("unexpected error unmarshaling %s graph: %v", test.name, err)
+		}
+		got, err := Marshal(dst, "", "\t")
+		if err != nil {
+			t.Fatalf("unexpected error remarshaling %s graph: %v", test.name, err)
+		}
+		if string(got) != string(b) {
+			t.Errorf("round trip mismatch for %s graph:\ngot:\n%s\nwant:\n%s", test.name, got, b)
+		}
+	}
+}
+
+func TestMarshalWeighted(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 1.5})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(2), T: simple.Node(1), W: 2})
+	got, err := Marshal(g, "", "  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">
+  <graph defaultedgetype="undirected" mode="static">
+    <nodes>
+      <node id="0"></node>
+      <node id="1"></node>
+      <node id="2"></node>
+    </nodes>
+    <edges>
+      <edge id="0" source="0" target="1" weight="1.5"></edge>
+      <edge id="1" source="1" target="2" weight="2"></edge>
+    </edges>
+  </graph>
+</gexf>`
+	if string(got) != want {
+		t.Errorf("unexpected marshaled graph:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRoundTripMulti(t *testing.T) {
+	t.Parallel()
+	g := newMultiGraph()
+	var nodes []*node
+	for _, name := range []string{"a", "b", "c"} {
+		n := g.NewNode().(*node)
+		n.name = name
+		g.AddNode(n)
+		nodes = append(nodes, n)
+	}
+	for _, e := range []struct {
+		f, t  int
+		label string
+		kind  string
+	}{{0, 1, "x", "road"}, {0, 1, "y", "rail"}, {1, 0, "z", "road"}, {1, 2, "x", ""}, {2, 2, "loop", "road"}, {0, 1, "", ""}} {
+		l := g.NewLine(nodes[e.f], nodes[e.t]).(*attrLine)
+		l.SetAttribute(encoding.Attribute{Key: "label", Value: e.label})
+		l.SetAttribute(encoding.Attribute{Key: "kind", Value: e.kind})
+		g.SetLine(l)
+	}
+
+	b, err := MarshalMulti(g, "", "\t")
+	if err != nil {
+		t.Fatalf("unexpected error marshaling multigraph: %v", err)
+	}
+	if got := strings.Count(string(b), `source="a" target="b"`); got != 3 {
+		t.Errorf("unexpected number of parallel edges: got:%d want:3\n%s", got, b)
+	}
+
+	dst := newMultiGraph()
+	err = UnmarshalMulti(b, dst)
+	if err != nil {
+		t.Fatalf("unexpected error unmarshaling multigraph: %v", err)
+	}
+	if got, want := dst.Lines(0, 1).Len(), 3; got != want {
+		t.Errorf("unexpected number of lines: got:%d want:%d", got, want)
+	}
+	got, err := MarshalMulti(dst, "", "\t")
+	if err != nil {
+		t.Fatalf("unexpected error remarshaling multigraph: %v", err)
+	}
+	if string(got) != string(b) {
+		t.Errorf("round trip mismatch for multigraph:\ngot:\n%s\nwant:\n%s", got, b)
+	}
+}
+
+func TestMarshalMultiWeighted(t *testing.T) {
+	t.Parallel()
+	g := multi.NewWeightedUndirectedGraph()
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(0), multi.Node(1), 1.5))
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(1), multi.Node(0), 2))
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(1), multi.Node(2), -1))
+	b, err := MarshalMulti(g, "", "  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">
+  <graph defaultedgetype="undirected" mode="static">
+    <nodes>
+      <node id="0"></node>
+      <node id="1"></node>
+      <node id="2"></node>
+    </nodes>
+    <edges>
+      <edge id="0" source="0" target="1" weight="1.5"></edge>
+      <edge id="1" source="0" target="1" weight="2"></edge>
+      <edge id="2" source="1" target="2" weight="-1"></edge>
+    </edges>
+  </graph>
+</gexf>`
+	if string(b) != want {
+		t.Errorf("unexpected marshaled multigraph:\ngot:\n%s\nwant:\n%s", b, want)
+	}
+
+	dst := weightedMultiGraph{multi.NewWeightedUndirectedGraph()}
+	err = UnmarshalMulti(b, dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sum float64
+	var n int
+	for _, e := range [][2]int64{{0, 1}, {1, 2}} {
+		lines := dst.WeightedLines(e[0], e[1])
+		for lines.Next() {
+			sum += lines.WeightedLine().Weight()
+			n++
+		}
+	}
+	if n != 3 || sum != 2.5 {
+		t.Errorf("unexpected unmarshaled lines: got %d lines with total weight %v, want 3 lines with total weight 2.5", n, sum)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "duplicate node",
+			data: `<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2"><graph><nodes><node id="a"/><node id="a"/></nodes></graph></gexf>`,
+			want: `gexf: duplicate node ID "a"`,
+		},
+		{
+			name: "undeclared attribute",
+			data: `<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2"><graph><nodes><node id="a"><attvalues><attvalue for="x" value="1"/></attvalues></node></nodes></graph></gexf>`,
+			want: `gexf: undeclared attribute "x"`,
+		},
+		{
+			name: "malformed",
+			data: `<gexf xmlns="http://www.gexf.net/1.2draft">`,
+			want: "XML syntax error on line 1: unexpected EOF",
+		},
+	} {
+		err := Unmarshal([]byte(test.data), newDirectedGraph())
+		if err == nil || err.Error() != test.want {
+			t.Errorf("unexpected error for %s: got:%v want:%s", test.name, err, test.want)
+		}
+	}
+}
+
+func attr(attrs encoding.Attributes, key string) string {
+	for _, a := range attrs {
+		if a.Key == key {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+type directedGraph struct {
+	*simple.DirectedGraph
+	attrs encoding.Attributes
+}
+
+func newDirectedGraph() *directedGraph {
+	return &directedGraph{DirectedGraph: simple.NewDirectedGraph()}
+}
+
+func (g *directedGraph) NewNode() graph.Node {
+	return &node{id: g.DirectedGraph.NewNode().ID()}
+}
+
+func (g *directedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{from: from, to: to}
+}
+
+func (g *directedGraph) Attributes() []encoding.Attribute { return g.attrs.Attributes() }
+func (g *directedGraph) SetAttribute(attr encoding.Attribute) error {
+	return g.attrs.SetAttribute(attr)
+}
+
+type undirectedGraph struct {
+	*simple.UndirectedGraph
+	attrs encoding.Attributes
+}
+
+func newUndirectedGraph() *undirectedGraph {
+	return &undirectedGraph{UndirectedGraph: simple.NewUndirectedGraph()}
+}
+
+func (g *undirectedGraph) NewNode() graph.Node {
+	return &node{id: g.UndirectedGraph.NewNode().ID()}
+}
+
+func (g *undirectedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{from: from, to: to}
+}
+
+func (g *undirectedGraph) Attributes() []encoding.Attribute { return g.attrs.Attributes() }
+func (g *undirectedGraph) SetAttribute(attr encoding.Attribute) error {
+	return g.attrs.SetAttribute(attr)
+}
+
+type weightedGraph struct {
+	*simple.WeightedDirectedGraph
+}
+
+func (g weightedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return simple.Edge{F: from, T: to}
+}
+
+func (g weightedGraph) SetEdge(e graph.Edge) {
+	g.SetWeightedEdge(simple.WeightedEdge{F: e.From(), T: e.To(), W: 1})
+}
+
+type node struct {
+	id    int64
+	name  string
+	attrs encoding.Attributes
+}
+
+func (n *node) ID() int64                                  { return n.id }
+func (n *node) GEXFID() string                             { return n.name }
+func (n *node) SetGEXFID(name string)                      { n.name = name }
+func (n *node) Attributes() []encoding.Attribute           { return n.attrs.Attributes() }
+func (n *node) SetAttribute(attr encoding.Attribute) error { return n.attrs.SetAttribute(attr) }
+
+type attrEdge struct {
+	from, to graph.Node
+	attrs    encoding.Attributes
+}
+
+func (e *attrEdge) From() graph.Node { return e.from }
+func (e *attrEdge) To() graph.Node   { return e.to }
+func (e *attrEdge) ReversedEdge() graph.Edge {
+	return &attrEdge{from: e.to, to: e.from, attrs: e.attrs}
+}
+func (e *attrEdge) Attributes() []encoding.Attribute           { return e.attrs.Attributes() }
+func (e *attrEdge) SetAttribute(attr encoding.Attribute) error { return e.attrs.SetAttribute(attr) }
+
+type multiGraph struct {
+	*multi.DirectedGraph
+}
+
+func newMultiGraph() multiGraph {
+	return multiGraph{multi.NewDirectedGraph()}
+}
+
+func (g multiGraph) NewNode() graph.Node {
+	return &node{id: g.DirectedGraph.NewNode().ID()}
+}
+
+func (g multiGraph) NewLine(from, to graph.Node) graph.Line {
+	return &attrLine{from: from, to: to, id: g.DirectedGraph.NewLine(from, to).ID()}
+}
+
+type weightedMultiGraph struct {
+	*multi.WeightedUndirectedGraph
+}
+
+func (g weightedMultiGraph) NewLine(from, to graph.Node) graph.Line {
+	return g.NewWeightedLine(from, to, 1)
+}
+
+func (g weightedMultiGraph) SetLine(l graph.Line) {
+	g.SetWeightedLine(multi.WeightedLine{F: l.From(), T: l.To(), W: 1, UID: l.ID()})
+}
+
+type attrLine struct {
+	from, to graph.Node
+	id       int64
+	attrs    encoding.Attributes
+}
+
+func (l *attrLine) From() graph.Node { return l.from }
+func (l *attrLine) To() graph.Node   { return l.to }
+func (l *attrLine) ID() int64        { return l.id }
+func (l *attrLine) ReversedLine() graph.Line {
+	return &attrLine{from: l.to, to: l.from, id: l.id, attrs: l.attrs}
+}
+func (l *attrLine) Attributes() []encoding.Attribute           { return l.attrs.Attributes() }
+func (l *attrLine) SetAttribute(attr encoding.Attribute) error { return l.attrs.SetAttribute(attr) }
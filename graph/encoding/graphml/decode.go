@@ -0,0 +1,220 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+)
+
+// IDSetter is implemented by graph nodes that can set their GraphML node ID.
+type IDSetter interface {
+	SetGraphMLID(id string)
+}
+
+// Unmarshal parses the GraphML-encoded data and stores the result in dst.
+// If the number of graphs encoded in data is not one, an error is returned.
+//
+// Nodes are created by dst.NewNode and are given their GraphML ID if they
+// implement IDSetter. Graph, node and edge attributes, including declared
+// default values, are set on dst, nodes and edges that implement
+// encoding.AttributeSetter. If dst is a graph.WeightedBuilder, edges with a
+// numeric "weight" attribute are created by NewWeightedEdge with that weight.
+// Nested graphs, hyperedges and ports are not supported.
+func Unmarshal(data []byte, dst encoding.Builder) error {
+	wb, weighted := dst.(graph.WeightedBuilder)
+	return unmarshal(data, dst, func(from, to graph.Node, attrs []encoding.Attribute) error {
+		var e graph.Edge
+		if w, ok := weightOf(attrs); weighted && ok {
+			e = wb.NewWeightedEdge(from, to, w)
+		} else {
+			e = dst.NewEdge(from, to)
+		}
+		if s, ok := e.(encoding.AttributeSetter); ok {
+			err := setAttributes(s, attrs)
+			if err != nil {
+				return err
+			}
+		}
+		if we, ok := e.(graph.WeightedEdge); ok && weighted {
+			wb.SetWeightedEdge(we)
+		} else {
+			dst.SetEdge(e)
+		}
+		return nil
+	})
+}
+
+// UnmarshalMulti parses the GraphML-encoded data as a multigraph and stores
+// the result in dst. If the number of graphs encoded in data is not one, an
+// error is returned.
+//
+// Each GraphML edge is added to dst as a new line, so parallel edges are
+// retained. Nodes and attributes are handled as described for Unmarshal. If
+// dst is a graph.WeightedMultigraphBuilder, edges with a numeric "weight"
+// attribute are created by NewWeightedLine with that weight.
+func UnmarshalMulti(data []byte, dst encoding.MultiBuilder) error {
+	wb, weighted := dst.(graph.WeightedMultigraphBuilder)
+	return unmarshal(data, dst, func(from, to graph.Node, attrs []encoding.Attribute) error {
+		var l graph.Line
+		if w, ok := weightOf(attrs); weighted && ok {
+			l = wb.NewWeightedLine(from, to, w)
+		} else {
+			l = dst.NewLine(from, to)
+		}
+		if s, ok := l.(encoding.AttributeSetter); ok {
+			err := setAttributes(s, attrs)
+			if err != nil {
+				return err
+			}
+		}
+		if wl, ok := l.(graph.WeightedLine); ok && weighted {
+			wb.SetWeightedLine(wl)
+		} else {
+			dst.SetLine(l)
+		}
+		return nil
+	})
+}
+
+// unmarshal parses the GraphML-encoded data, adding its nodes to dst and
+// setting the graph attributes of dst. Each edge is added by calling
+// setEdge with the edge's end nodes and attributes.
+func unmarshal(data []byte, dst graph.NodeAdder, setEdge func(from, to graph.Node, attrs []encoding.Attribute) error) error {
+	var doc document
+	err := xml.Unmarshal(data, &doc)
+	if err != nil {
+		return err
+	}
+	if len(doc.Graphs) != 1 {
+		return fmt.Errorf("graphml: invalid number of graphs; expected 1, got %d", len(doc.Graphs))
+	}
+	src := doc.Graphs[0]
+
+	keys := make(map[string]key, len(doc.Keys))
+	for _, k := range doc.Keys {
+		keys[k.ID] = k
+	}
+
+	if s, ok := dst.(encoding.AttributeSetter); ok {
+		attrs, err := attributesOf("graph", doc.Keys, keys, src.Data)
+		if err != nil {
+			return err
+		}
+		err = setAttributes(s, attrs)
+		if err != nil {
+			return err
+		}
+	}
+
+	nodes := make(map[string]graph.Node, len(src.Nodes))
+	node := func(id string) graph.Node {
+		n, ok := nodes[id]
+		if ok {
+			return n
+		}
+		n = dst.NewNode()
+		if s, ok := n.(IDSetter); ok {
+			s.SetGraphMLID(id)
+		}
+		dst.AddNode(n)
+		nodes[id] = n
+		return n
+	}
+	for _, el := range src.Nodes {
+		if _, ok := nodes[el.ID]; ok {
+			return fmt.Errorf("graphml: duplicate node ID %q", el.ID)
+		}
+		n := node(el.ID)
+		if s, ok := n.(encoding.AttributeSetter); ok {
+			attrs, err := attributesOf("node", doc.Keys, keys, el.Data)
+			if err != nil {
+				return err
+			}
+			err = setAttributes(s, attrs)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, el := range src.Edges {
+		attrs, err := attributesOf("edge", doc.Keys, keys, el.Data)
+		if err != nil {
+			return err
+		}
+		err = setEdge(node(el.Source), node(el.Target), attrs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attributesOf returns the attributes of an element of the given class
+// with the given data, including default values for absent keys.
+func attributesOf(class string, decls []key, keys map[string]key, d []data) ([]encoding.Attribute, error) {
+	var attrs []encoding.Attribute
+	set := make(map[string]bool)
+	for _, v := range d {
+		k, ok := keys[v.Key]
+		if !ok {
+			return nil, fmt.Errorf("graphml: undeclared key %q", v.Key)
+		}
+		name := k.Name
+		if name == "" {
+			name = k.ID
+		}
+		attrs = append(attrs, encoding.Attribute{Key: name, Value: valueOf(k, v.Value)})
+		set[k.ID] = true
+	}
+	for _, k := range decls {
+		if k.Default == nil || set[k.ID] || (k.For != class && k.For != "all" && k.For != "") {
+			continue
+		}
+		name := k.Name
+		if name == "" {
+			name = k.ID
+		}
+		attrs = append(attrs, encoding.Attribute{Key: name, Value: valueOf(k, *k.Default)})
+	}
+	return attrs, nil
+}
+
+// valueOf returns the attribute value text for the key k. Surrounding
+// white space is removed from values of keys with non-string types.
+func valueOf(k key, text string) string {
+	if k.Type == "" || k.Type == "string" {
+		return text
+	}
+	return strings.TrimSpace(text)
+}
+
+func setAttributes(dst encoding.AttributeSetter, attrs []encoding.Attribute) error {
+	for _, a := range attrs {
+		err := dst.SetAttribute(a)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// weightOf returns the value of the "weight" attribute in attrs
+// and whether it exists and is numeric.
+func weightOf(attrs []encoding.Attribute) (float64, bool) {
+	for _, a := range attrs {
+		if a.Key == "weight" {
+			w, err := strconv.ParseFloat(a.Value, 64)
+			return w, err == nil
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,17 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package graphml implements GraphML marshaling and unmarshaling of graphs.
+//
+// Node, edge and graph attributes are obtained from and set through the
+// encoding.Attributer and encoding.AttributeSetter interfaces and are written
+// as GraphML data elements with typed key declarations. Edge weights of
+// graph.WeightedEdge values are written as the "weight" attribute.
+//
+// Multigraphs are marshaled and unmarshaled with MarshalMulti and
+// UnmarshalMulti, which write each line as a GraphML edge, retaining
+// parallel edges.
+//
+// See http://graphml.graphdrawing.org/ for a definition of GraphML.
+package graphml // import "gonum.org/v1/gonum/graph/encoding/graphml"
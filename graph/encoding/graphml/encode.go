@@ -0,0 +1,259 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Node is a GraphML graph node.
+type Node interface {
+	// GraphMLID returns the GraphML node ID.
+	GraphMLID() string
+}
+
+// Marshal returns the GraphML encoding for the graph g, applying the prefix
+// and indent to the encoding. Name is used to specify the graph ID.
+//
+// Node IDs are the decimal representation of the node's ID unless the node
+// implements Node. Graph, node and edge attributes are written for values
+// implementing encoding.Attributer. The type of each attribute key is the
+// narrowest of "boolean", "long", "double" and "string" that represents all
+// the values of the attribute. If an edge is a graph.WeightedEdge without a
+// "weight" attribute, its weight is written as the "weight" attribute.
+func Marshal(g graph.Graph, name, prefix, indent string) ([]byte, error) {
+	_, directed := g.(graph.Directed)
+	return marshal(g, directed, name, prefix, indent, func(uid, vid int64) [][]encoding.Attribute {
+		return [][]encoding.Attribute{edgeAttributes(g.Edge(uid, vid))}
+	})
+}
+
+// MarshalMulti returns the GraphML encoding for the multigraph g, applying
+// the prefix and indent to the encoding. Name is used to specify the graph ID.
+//
+// Each line of g is written as a GraphML edge. Node IDs and attributes are
+// written as described for Marshal, with graph.WeightedLine values having
+// their weight written as the "weight" attribute.
+func MarshalMulti(g graph.Multigraph, name, prefix, indent string) ([]byte, error) {
+	_, directed := g.(graph.DirectedMultigraph)
+	return marshal(g, directed, name, prefix, indent, func(uid, vid int64) [][]encoding.Attribute {
+		lines := graph.LinesOf(g.Lines(uid, vid))
+		order.LinesByIDs(lines)
+		attrs := make([][]encoding.Attribute, len(lines))
+		for i, l := range lines {
+			attrs[i] = edgeAttributes(l)
+		}
+		return attrs
+	})
+}
+
+// nodeSet is the node iteration behavior shared by graphs and multigraphs.
+type nodeSet interface {
+	Nodes() graph.Nodes
+	From(id int64) graph.Nodes
+}
+
+// marshal returns the GraphML encoding for g. The attributes of the
+// edges from uid to vid are returned by edges.
+func marshal(g nodeSet, directed bool, name, prefix, indent string, edges func(uid, vid int64) [][]encoding.Attribute) ([]byte, error) {
+	doc := document{XMLNS: namespace}
+	el := graphElement{ID: name, EdgeDefault: "undirected"}
+	if directed {
+		el.EdgeDefault = "directed"
+	}
+
+	keys := newKeySet()
+	var graphAttrs []encoding.Attribute
+	if a, ok := g.(encoding.Attributer); ok {
+		graphAttrs = a.Attributes()
+		keys.add("graph", graphAttrs)
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	ids := make(map[int64]string, len(nodes))
+	seen := make(map[string]bool, len(nodes))
+	nodeAttrs := make([][]encoding.Attribute, len(nodes))
+	for i, n := range nodes {
+		id := strconv.FormatInt(n.ID(), 10)
+		if n, ok := n.(Node); ok {
+			id = n.GraphMLID()
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("graphml: duplicate node ID %q", id)
+		}
+		seen[id] = true
+		ids[n.ID()] = id
+		if a, ok := n.(encoding.Attributer); ok {
+			nodeAttrs[i] = a.Attributes()
+			keys.add("node", nodeAttrs[i])
+		}
+	}
+
+	type edgeAttrs struct {
+		from, to string
+		attrs    []encoding.Attribute
+	}
+	var edgeList []edgeAttrs
+	for _, u := range nodes {
+		uid := u.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		for _, v := range to {
+			vid := v.ID()
+			if !directed && vid < uid {
+				continue
+			}
+			for _, attrs := range edges(uid, vid) {
+				keys.add("edge", attrs)
+				edgeList = append(edgeList, edgeAttrs{from: ids[uid], to: ids[vid], attrs: attrs})
+			}
+		}
+	}
+
+	doc.Keys = keys.declarations()
+	el.Data = keys.data("graph", graphAttrs)
+	for i, n := range nodes {
+		el.Nodes = append(el.Nodes, nodeEl{ID: ids[n.ID()], Data: keys.data("node", nodeAttrs[i])})
+	}
+	for _, e := range edgeList {
+		el.Edges = append(el.Edges, edgeEl{Source: e.from, Target: e.to, Data: keys.data("edge", e.attrs)})
+	}
+	doc.Graphs = []graphElement{el}
+
+	b, err := xml.MarshalIndent(doc, prefix, indent)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(prefix+xml.Header), b...), nil
+}
+
+// edgeAttributes returns the attributes of the edge or line e, including
+// its weight if it is weighted and has no "weight" attribute.
+func edgeAttributes(e interface{}) []encoding.Attribute {
+	var attrs []encoding.Attribute
+	if a, ok := e.(encoding.Attributer); ok {
+		attrs = a.Attributes()
+	}
+	if w, ok := e.(interface{ Weight() float64 }); ok && !hasKey(attrs, "weight") {
+		attrs = append(attrs[:len(attrs):len(attrs)], encoding.Attribute{
+			Key:   "weight",
+			Value: strconv.FormatFloat(w.Weight(), 'g', -1, 64),
+		})
+	}
+	return attrs
+}
+
+// keySet collects the attribute keys and values used in a graph.
+type keySet struct {
+	// values holds the attribute values for each
+	// key name, keyed by the element class.
+	values map[string]map[string][]string
+
+	// ids holds the key ID for each key name
+	// keyed by the element class.
+	ids map[string]map[string]string
+
+	keys []key
+}
+
+func newKeySet() *keySet {
+	return &keySet{
+		values: make(map[string]map[string][]string),
+		ids:    make(map[string]map[string]string),
+	}
+}
+
+// add adds the attributes of an element of the given class to the set.
+func (s *keySet) add(class string, attrs []encoding.Attribute) {
+	for _, a := range attrs {
+		if a.Key == "" || a.Value == "" {
+			continue
+		}
+		if s.values[class] == nil {
+			s.values[class] = make(map[string][]string)
+		}
+		s.values[class][a.Key] = append(s.values[class][a.Key], a.Value)
+	}
+}
+
+// declarations returns the key declarations for the
+// attributes added to the set, assigning key IDs.
+func (s *keySet) declarations() []key {
+	for _, class := range []string{"graph", "node", "edge"} {
+		names := make([]string, 0, len(s.values[class]))
+		for name := range s.values[class] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		s.ids[class] = make(map[string]string, len(names))
+		for _, name := range names {
+			id := fmt.Sprintf("d%d", len(s.keys))
+			s.ids[class][name] = id
+			s.keys = append(s.keys, key{
+				ID:   id,
+				For:  class,
+				Name: name,
+				Type: typeOf(s.values[class][name]),
+			})
+		}
+	}
+	return s.keys
+}
+
+// data returns the GraphML data elements for the attributes
+// of an element of the given class.
+func (s *keySet) data(class string, attrs []encoding.Attribute) []data {
+	var d []data
+	for _, a := range attrs {
+		if a.Key == "" || a.Value == "" {
+			continue
+		}
+		d = append(d, data{Key: s.ids[class][a.Key], Value: a.Value})
+	}
+	return d
+}
+
+// typeOf returns the narrowest GraphML type that can represent all of values.
+func typeOf(values []string) string {
+	isBool, isLong, isDouble := true, true, true
+	for _, v := range values {
+		if v != "true" && v != "false" {
+			isBool = false
+		}
+		if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+			isLong = false
+		}
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			isDouble = false
+		}
+	}
+	switch {
+	case isBool:
+		return "boolean"
+	case isLong:
+		return "long"
+	case isDouble:
+		return "double"
+	default:
+		return "string"
+	}
+}
+
+func hasKey(attrs []encoding.Attribute, key string) bool {
+	for _, a := range attrs {
+		if a.Key == key && a.Value != "" {
+			return true
+		}
+	}
+	return false
+}
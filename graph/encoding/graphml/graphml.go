@@ -0,0 +1,63 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import "encoding/xml"
+
+// namespace is the GraphML XML namespace.
+const namespace = "http://graphml.graphdrawing.org/xmlns"
+
+// document is a GraphML document.
+type document struct {
+	XMLName xml.Name       `xml:"graphml"`
+	XMLNS   string         `xml:"xmlns,attr,omitempty"`
+	Keys    []key          `xml:"key"`
+	Graphs  []graphElement `xml:"graph"`
+}
+
+// key is a GraphML attribute declaration.
+type key struct {
+	ID string `xml:"id,attr"`
+	// For may be one of "graph", "node",
+	// "edge" or "all".
+	For  string `xml:"for,attr,omitempty"`
+	Name string `xml:"attr.name,attr,omitempty"`
+	// Type may be one of "boolean", "int",
+	// "long", "float", "double" or "string".
+	Type    string  `xml:"attr.type,attr,omitempty"`
+	Default *string `xml:"default"`
+}
+
+// graphElement is a GraphML graph.
+type graphElement struct {
+	XMLName xml.Name `xml:"graph"`
+	ID      string   `xml:"id,attr,omitempty"`
+	// EdgeDefault may be one of
+	// "directed" or "undirected".
+	EdgeDefault string   `xml:"edgedefault,attr"`
+	Data        []data   `xml:"data"`
+	Nodes       []nodeEl `xml:"node"`
+	Edges       []edgeEl `xml:"edge"`
+}
+
+// nodeEl is a GraphML node.
+type nodeEl struct {
+	ID   string `xml:"id,attr"`
+	Data []data `xml:"data"`
+}
+
+// edgeEl is a GraphML edge.
+type edgeEl struct {
+	ID     string `xml:"id,attr,omitempty"`
+	Source string `xml:"source,attr"`
+	Target string `xml:"target,attr"`
+	Data   []data `xml:"data"`
+}
+
+// data is a GraphML attribute value.
+type data struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
@@ -0,0 +1,480 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// primer is the attribute example from the GraphML primer at
+// http://graphml.graphdrawing.org/primer/graphml-primer.html.
+const primer = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns"
+    xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+    xsi:schemaLocation="http://graphml.graphdrawing.org/xmlns
+     http://graphml.graphdrawing.org/xmlns/1.0/graphml.xsd">
+  <key id="d0" for="node" attr.name="color" attr.type="string">
+    <default>yellow</default>
+  </key>
+  <key id="d1" for="edge" attr.name="weight" attr.type="double"/>
+  <graph id="G" edgedefault="undirected">
+    <node id="n0">
+      <data key="d0">green</data>
+    </node>
+    <node id="n1"/>
+    <node id="n2">
+      <data key="d0">blue</data>
+    </node>
+    <node id="n3">
+      <data key="d0">red</data>
+    </node>
+    <node id="n4"/>
+    <node id="n5">
+      <data key="d0">turquoise</data>
+    </node>
+    <edge id="e0" source="n0" target="n2">
+      <data key="d1">1.0</data>
+    </edge>
+    <edge id="e1" source="n0" target="n1">
+      <data key="d1">1.0</data>
+    </edge>
+    <edge id="e2" source="n1" target="n3">
+      <data key="d1">2.0</data>
+    </edge>
+    <edge id="e3" source="n3" target="n2"/>
+    <edge id="e4" source="n2" target="n4"/>
+    <edge id="e5" source="n3" target="n5"/>
+    <edge id="e6" source="n5" target="n4">
+      <data key="d1">1.1</data>
+    </edge>
+  </graph>
+</graphml>
+`
+
+func TestUnmarshalPrimer(t *testing.T) {
+	t.Parallel()
+	dst := newUndirectedGraph()
+	err := Unmarshal([]byte(primer), dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantColor := map[string]string{
+		"n0": "green", "n1": "yellow", "n2": "blue",
+		"n3": "red", "n4": "yellow", "n5": "turquoise",
+	}
+	byName := make(map[string]*node)
+	nodes := dst.Nodes()
+	for nodes.Next() {
+		n := nodes.Node().(*node)
+		byName[n.name] = n
+		if got := attr(n.attrs, "color"); got != wantColor[n.name] {
+			t.Errorf("unexpected color for %s: got:%q want:%q", n.name, got, wantColor[n.name])
+		}
+	}
+	if len(byName) != len(wantColor) {
+		t.Errorf("unexpected number of nodes: got:%d want:%d", len(byName), len(wantColor))
+	}
+	for _, e := range []struct{ from, to, weight string }{
+		{"n0", "n2", "1.0"}, {"n0", "n1", "1.0"}, {"n1", "n3", "2.0"},
+		{"n3", "n2", ""}, {"n2", "n4", ""}, {"n3", "n5", ""}, {"n5", "n4", "1.1"},
+	} {
+		edge := dst.Edge(byName[e.from].ID(), byName[e.to].ID())
+		if edge == nil {
+			t.Errorf("missing edge %s--%s", e.from, e.to)
+			continue
+		}
+		if got := attr(edge.(*attrEdge).attrs, "weight"); got != e.weight {
+			t.Errorf("unexpected weight for %s--%s: got:%q want:%q", e.from, e.to, got, e.weight)
+		}
+	}
+
+	// Weighted destinations take their edge weights
+	// from the weight attribute.
+	wdst := weightedGraph{simple.NewWeightedUndirectedGraph(0, 0)}
+	err = Unmarshal([]byte(primer), wdst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sum float64
+	edges := wdst.WeightedEdges()
+	for edges.Next() {
+		sum += edges.WeightedEdge().Weight()
+	}
+	if want := 1.0 + 1 + 2 + 1 + 1 + 1 + 1.1; sum != want {
+		t.Errorf("unexpected total weight: got:%v want:%v", sum, want)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		g    interface {
+			encoding.Builder
+			encoding.AttributeSetter
+		}
+		dst func() encoding.Builder
+	}{
+		{name: "undirected", g: newUndirectedGraph(), dst: func() encoding.Builder { return newUndirectedGraph() }},
+		{name: "directed", g: newDirectedGraph(), dst: func() encoding.Builder { return newDirectedGraph() }},
+	} {
+		g := test.g
+		g.SetAttribute(encoding.Attribute{Key: "title", Value: "test graph"})
+		var nodes []*node
+		for i, name := range []string{"a", "b", "c", "d"} {
+			n := g.NewNode().(*node)
+			n.name = name
+			n.SetAttribute(encoding.Attribute{Key: "rank", Value: string(rune('1' + i))})
+			if i%2 == 0 {
+				n.SetAttribute(encoding.Attribute{Key: "label", Value: "node <" + name + ">"})
+			}
+			g.AddNode(n)
+			nodes = append(nodes, n)
+		}
+		for _, e := range []struct {
+			f, t   int
+			weight string
+		}{{0, 1, "0.5"}, {1, 2, "2"}, {2, 3, "-1e-3"}, {3, 0, ""}, {0, 2, "7"}} {
+			edge := g.NewEdge(nodes[e.f], nodes[e.t]).(*attrEdge)
+			edge.SetAttribute(encoding.Attribute{Key: "weight", Value: e.weight})
+			edge.SetAttribute(encoding.Attribute{Key: "visible", Value: "true"})
+			g.SetEdge(edge)
+		}
+
+		b, err := Marshal(g, "G", "", "\t")
+		if err != nil {
+			t.Fatalf("unexpected error marshaling %s graph: %v", test.name, err)
+		}
+		for _, want := range []string{
+			`<key id="d0" for="graph" attr.name="title" attr.type="string"></key>`,
+			`attr.name="rank" attr.type="long"`,
+			`attr.name="visible" attr.type="boolean"`,
+			`attr.name="weight" attr.type="double"`,
+			`node &lt;a&gt;`,
+		} {
+			if !strings.Contains(string(b), want) {
+				t.Errorf("missing %q in marshaled %s graph:\n%s", want, test.name, b)
+			}
+		}
+
+		dst := test.dst()
+		err = Unmarshal(b, dst)
+		if err != nil {
+			t.Fatalf("unexpected error unmarshaling %s graph: %v", test.name, err)
+		}
+		got, err := Marshal(dst, "G", "", "\t")
+		if err != nil {
+			t.Fatalf("unexpected error remarshaling %s graph: %v", test.name, err)
+		}
+		if string(got) != string(b) {
+			t.Errorf("round trip mismatch for %s graph:\ngot:\n%s\nwant:\n%s", test.name, got, b)
+		}
+	}
+}
+
+func TestMarshalWeighted(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 1.5})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(1), T: simple.Node(0), W: 2})
+	got, err := Marshal(g, "", "", "  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="edge" attr.name="weight" attr.type="double"></key>
+  <graph edgedefault="directed">
+    <node id="0"></node>
+    <node id="1"></node>
+    <edge source="0" target="1">
+      <data key="d0">1.5</data>
+    </edge>
+    <edge source="1" target="0">
+      <data key="d0">2</data>
+    </edge>
+  </graph>
+</graphml>`
+	if string(got) != want {
+		t.Errorf("unexpected marshaled graph:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRoundTripMulti(t *testing.T) {
+	t.Parallel()
+	g := newMultiGraph()
+	var nodes []*node
+	for _, name := range []string{"a", "b", "c"} {
+		n := g.NewNode().(*node)
+		n.name = name
+		g.AddNode(n)
+		nodes = append(nodes, n)
+	}
+	for _, e := range []struct {
+		f, t  int
+		label string
+	}{{0, 1, "x"}, {0, 1, "y"}, {1, 0, "z"}, {1, 2, "x"}, {2, 2, "loop"}, {0, 1, ""}} {
+		l := g.NewLine(nodes[e.f], nodes[e.t]).(*attrLine)
+		l.SetAttribute(encoding.Attribute{Key: "label", Value: e.label})
+		g.SetLine(l)
+	}
+
+	b, err := MarshalMulti(g, "G", "", "\t")
+	if err != nil {
+		t.Fatalf("unexpected error marshaling multigraph: %v", err)
+	}
+	if got := strings.Count(string(b), `<edge source="a" target="b">`); got != 3 {
+		t.Errorf("unexpected number of parallel edges: got:%d want:3\n%s", got, b)
+	}
+
+	dst := newMultiGraph()
+	err = UnmarshalMulti(b, dst)
+	if err != nil {
+		t.Fatalf("unexpected error unmarshaling multigraph: %v", err)
+	}
+	if got, want := dst.Lines(0, 1).Len(), 3; got != want {
+		t.Errorf("unexpected number of lines: got:%d want:%d", got, want)
+	}
+	got, err := MarshalMulti(dst, "G", "", "\t")
+	if err != nil {
+		t.Fatalf("unexpected error remarshaling multigraph: %v", err)
+	}
+	if string(got) != string(b) {
+		t.Errorf("round trip mismatch for multigraph:\ngot:\n%s\nwant:\n%s", got, b)
+	}
+}
+
+func TestMarshalMultiWeighted(t *testing.T) {
+	t.Parallel()
+	g := multi.NewWeightedUndirectedGraph()
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(0), multi.Node(1), 1.5))
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(1), multi.Node(0), 2))
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(1), multi.Node(2), -1))
+	b, err := MarshalMulti(g, "", "", "  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="edge" attr.name="weight" attr.type="double"></key>
+  <graph edgedefault="undirected">
+    <node id="0"></node>
+    <node id="1"></node>
+    <node id="2"></node>
+    <edge source="0" target="1">
+      <data key="d0">1.5</data>
+    </edge>
+    <edge source="0" target="1">
+      <data key="d0">2</data>
+    </edge>
+    <edge source="1" target="2">
+      <data key="d0">-1</data>
+    </edge>
+  </graph>
+</graphml>`
+	if string(b) != want {
+		t.Errorf("unexpected marshaled multigraph:\ngot:\n%s\nwant:\n%s", b, want)
+	}
+
+	dst := weightedMultiGraph{multi.NewWeightedUndirectedGraph()}
+	err = UnmarshalMulti(b, dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sum float64
+	var n int
+	for _, e := range [][2]int64{{0, 1}, {1, 2}} {
+		lines := dst.WeightedLines(e[0], e[1])
+		for lines.Next() {
+			sum += lines.WeightedLine().Weight()
+			n++
+		}
+	}
+	if n != 3 || sum != 2.5 {
+		t.Errorf("unexpected unmarshaled lines: got %d lines with total weight %v, want 3 lines with total weight 2.5", n, sum)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "no graph",
+			data: `<graphml xmlns="http://graphml.graphdrawing.org/xmlns"></graphml>`,
+			want: "graphml: invalid number of graphs; expected 1, got 0",
+		},
+		{
+			name: "two graphs",
+			data: `<graphml><graph edgedefault="directed"/><graph edgedefault="directed"/></graphml>`,
+			want: "graphml: invalid number of graphs; expected 1, got 2",
+		},
+		{
+			name: "duplicate node",
+			data: `<graphml><graph edgedefault="directed"><node id="a"/><node id="a"/></graph></graphml>`,
+			want: `graphml: duplicate node ID "a"`,
+		},
+		{
+			name: "undeclared key",
+			data: `<graphml><graph edgedefault="directed"><node id="a"><data key="k">x</data></node></graph></graphml>`,
+			want: `graphml: undeclared key "k"`,
+		},
+		{
+			name: "malformed",
+			data: `<graphml><graph>`,
+			want: "XML syntax error on line 1: unexpected EOF",
+		},
+	} {
+		err := Unmarshal([]byte(test.data), newDirectedGraph())
+		if err == nil || err.Error() != test.want {
+			t.Errorf("unexpected error for %s: got:%v want:%s", test.name, err, test.want)
+		}
+	}
+}
+
+func attr(attrs encoding.Attributes, key string) string {
+	for _, a := range attrs {
+		if a.Key == key {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+type directedGraph struct {
+	*simple.DirectedGraph
+	attrs encoding.Attributes
+}
+
+func (g *directedGraph) Attributes() []encoding.Attribute { return g.attrs.Attributes() }
+func (g *directedGraph) SetAttribute(attr encoding.Attribute) error {
+	return g.attrs.SetAttribute(attr)
+}
+
+func newDirectedGraph() *directedGraph {
+	return &directedGraph{DirectedGraph: simple.NewDirectedGraph()}
+}
+
+func (g *directedGraph) NewNode() graph.Node {
+	return &node{id: g.DirectedGraph.NewNode().ID()}
+}
+
+func (g *directedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{from: from, to: to}
+}
+
+type undirectedGraph struct {
+	*simple.UndirectedGraph
+	attrs encoding.Attributes
+}
+
+func (g *undirectedGraph) Attributes() []encoding.Attribute { return g.attrs.Attributes() }
+func (g *undirectedGraph) SetAttribute(attr encoding.Attribute) error {
+	return g.attrs.SetAttribute(attr)
+}
+
+func newUndirectedGraph() *undirectedGraph {
+	return &undirectedGraph{UndirectedGraph: simple.NewUndirectedGraph()}
+}
+
+func (g *undirectedGraph) NewNode() graph.Node {
+	return &node{id: g.UndirectedGraph.NewNode().ID()}
+}
+
+func (g *undirectedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{from: from, to: to}
+}
+
+type weightedGraph struct {
+	*simple.WeightedUndirectedGraph
+}
+
+func (g weightedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return simple.Edge{F: from, T: to}
+}
+
+func (g weightedGraph) SetEdge(e graph.Edge) {
+	g.SetWeightedEdge(simple.WeightedEdge{F: e.From(), T: e.To(), W: 1})
+}
+
+type node struct {
+	id    int64
+	name  string
+	attrs encoding.Attributes
+}
+
+func (n *node) Attributes() []encoding.Attribute           { return n.attrs.Attributes() }
+func (n *node) SetAttribute(attr encoding.Attribute) error { return n.attrs.SetAttribute(attr) }
+
+func (n *node) ID() int64                { return n.id }
+func (n *node) GraphMLID() string        { return n.name }
+func (n *node) SetGraphMLID(name string) { n.name = name }
+
+type attrEdge struct {
+	from, to graph.Node
+	attrs    encoding.Attributes
+}
+
+func (e *attrEdge) Attributes() []encoding.Attribute           { return e.attrs.Attributes() }
+func (e *attrEdge) SetAttribute(attr encoding.Attribute) error { return e.attrs.SetAttribute(attr) }
+
+func (e *attrEdge) From() graph.Node { return e.from }
+func (e *attrEdge) To() graph.Node   { return e.to }
+func (e *attrEdge) ReversedEdge() graph.Edge {
+	return &attrEdge{from: e.to, to: e.from, attrs: e.attrs}
+}
+
+type multiGraph struct {
+	*multi.DirectedGraph
+}
+
+func newMultiGraph() multiGraph {
+	return multiGraph{multi.NewDirectedGraph()}
+}
+
+func (g multiGraph) NewNode() graph.Node {
+	return &node{id: g.DirectedGraph.NewNode().ID()}
+}
+
+func (g multiGraph) NewLine(from, to graph.Node) graph.Line {
+	return &attrLine{from: from, to: to, id: g.DirectedGraph.NewLine(from, to).ID()}
+}
+
+type weightedMultiGraph struct {
+	*multi.WeightedUndirectedGraph
+}
+
+func (g weightedMultiGraph) NewLine(from, to graph.Node) graph.Line {
+	return g.NewWeightedLine(from, to, 1)
+}
+
+func (g weightedMultiGraph) SetLine(l graph.Line) {
+	g.SetWeightedLine(multi.WeightedLine{F: l.From(), T: l.To(), W: 1, UID: l.ID()})
+}
+
+type attrLine struct {
+	from, to graph.Node
+	id       int64
+	attrs    encoding.Attributes
+}
+
+func (l *attrLine) Attributes() []encoding.Attribute           { return l.attrs.Attributes() }
+func (l *attrLine) SetAttribute(attr encoding.Attribute) error { return l.attrs.SetAttribute(attr) }
+
+func (l *attrLine) From() graph.Node { return l.from }
+func (l *attrLine) To() graph.Node   { return l.to }
+func (l *attrLine) ID() int64        { return l.id }
+func (l *attrLine) ReversedLine() graph.Line {
+	return &attrLine{from: l.to, to: l.from, id: l.id, attrs: l.attrs}
+}
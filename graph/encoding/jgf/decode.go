@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jgf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+)
+
+// IDSetter is implemented by graph nodes that can set their JSON Graph Format
+// node ID.
+type IDSetter interface {
+	SetJGFID(id string)
+}
+
+// Unmarshal parses the JSON Graph Format-encoded data and stores the result
+// in dst. If the number of graphs encoded in data is not one, an error is
+// returned. Both version 1 and version 2 documents are accepted.
+//
+// Nodes are created by dst.NewNode and are given their JSON Graph Format ID
+// if they implement IDSetter. Graph, node and edge attributes, including the
+// fields written by Marshal, are set on dst, nodes and edges that implement
+// encoding.AttributeSetter. Metadata string values are set as the string and
+// other metadata values are set as their compact JSON text. If dst is a
+// graph.WeightedBuilder, edges with a numeric "weight" metadata value are
+// created by NewWeightedEdge with that weight. The graph and per-edge
+// "directed" fields are ignored; the directedness of dst is retained.
+func Unmarshal(data []byte, dst encoding.Builder) error {
+	var doc document
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return err
+	}
+	var src graphObject
+	switch {
+	case doc.Graph != nil && doc.Graphs == nil:
+		src = *doc.Graph
+	case doc.Graph == nil && len(doc.Graphs) == 1:
+		src = doc.Graphs[0]
+	default:
+		n := len(doc.Graphs)
+		if doc.Graph != nil {
+			n++
+		}
+		return fmt.Errorf("jgf: invalid number of graphs; expected 1, got %d", n)
+	}
+
+	if s, ok := dst.(encoding.AttributeSetter); ok {
+		err = setAttributes(s, []encoding.Attribute{
+			{Key: "id", Value: src.ID},
+			{Key: "type", Value: src.Type},
+			{Key: "label", Value: src.Label},
+		}, src.Metadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	nodes := make(map[string]graph.Node, len(src.Nodes))
+	node := func(id string) graph.Node {
+		n, ok := nodes[id]
+		if ok {
+			return n
+		}
+		n = dst.NewNode()
+		if s, ok := n.(IDSetter); ok {
+			s.SetJGFID(id)
+		}
+		dst.AddNode(n)
+		nodes[id] = n
+		return n
+	}
+	for _, el := range src.Nodes {
+		if _, ok := nodes[el.ID]; ok {
+			return fmt.Errorf("jgf: duplicate node ID %q", el.ID)
+		}
+		n := node(el.ID)
+		if s, ok := n.(encoding.AttributeSetter); ok {
+			err = setAttributes(s, []encoding.Attribute{{Key: "label", Value: el.Label}}, el.Metadata)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	wb, weighted := dst.(graph.WeightedBuilder)
+	for _, el := range src.Edges {
+		from, to := node(el.Source), node(el.Target)
+		var e graph.Edge
+		if w, ok := weightOf(el.Metadata); weighted && ok {
+			e = wb.NewWeightedEdge(from, to, w)
+		} else {
+			e = dst.NewEdge(from, to)
+		}
+		if s, ok := e.(encoding.AttributeSetter); ok {
+			err = setAttributes(s, []encoding.Attribute{
+				{Key: "id", Value: el.ID},
+				{Key: "relation", Value: el.Relation},
+				{Key: "label", Value: el.Label},
+			}, el.Metadata)
+			if err != nil {
+				return err
+			}
+		}
+		if we, ok := e.(graph.WeightedEdge); ok && weighted {
+			wb.SetWeightedEdge(we)
+		} else {
+			dst.SetEdge(e)
+		}
+	}
+	return nil
+}
+
+// setAttributes sets the non-empty field attributes and the metadata
+// values on dst. Metadata values are set in key order.
+func setAttributes(dst encoding.AttributeSetter, fields []encoding.Attribute, m metadata) error {
+	for _, a := range fields {
+		if a.Value == "" {
+			continue
+		}
+		err := dst.SetAttribute(a)
+		if err != nil {
+			return err
+		}
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v, err := valueOf(m[k])
+		if err != nil {
+			return err
+		}
+		err = dst.SetAttribute(encoding.Attribute{Key: k, Value: v})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// valueOf returns the attribute value for a JSON metadata value.
+func valueOf(raw json.RawMessage) (string, error) {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s, nil
+	}
+	var buf bytes.Buffer
+	err := json.Compact(&buf, raw)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// weightOf returns the value of the "weight" metadata
+// value and whether it exists and is numeric.
+func weightOf(m metadata) (float64, bool) {
+	raw, ok := m["weight"]
+	if !ok {
+		return 0, false
+	}
+	v, err := valueOf(raw)
+	if err != nil {
+		return 0, false
+	}
+	w, err := strconv.ParseFloat(v, 64)
+	return w, err == nil
+}
@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package jgf implements JSON Graph Format marshaling and unmarshaling of
+// graphs.
+//
+// Node, edge and graph attributes are obtained from and set through the
+// encoding.Attributer and encoding.AttributeSetter interfaces. Attributes
+// with a corresponding JSON Graph Format field, such as "label", are written
+// to that field and all other attributes are written to the element's
+// metadata. Edge weights of graph.WeightedEdge values are written as the
+// "weight" metadata value.
+//
+// See https://jsongraphformat.info/ for a definition of the JSON Graph Format.
+package jgf // import "gonum.org/v1/gonum/graph/encoding/jgf"
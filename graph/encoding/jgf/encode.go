@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jgf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Node is a JSON Graph Format graph node.
+type Node interface {
+	// JGFID returns the JSON Graph Format node ID.
+	JGFID() string
+}
+
+// Marshal returns the JSON Graph Format encoding for the graph g, applying
+// the prefix and indent to the encoding.
+//
+// Node IDs are the decimal representation of the node's ID unless the node
+// implements Node. Graph, node and edge attributes are written for values
+// implementing encoding.Attributer. The graph "id", "type" and "label"
+// attributes, the node "label" attribute and the edge "id", "relation" and
+// "label" attributes are written to the corresponding JSON Graph Format
+// fields and all other attributes are written as metadata. Metadata values
+// that are JSON numbers or booleans are written as such and all others are
+// written as JSON strings. If an edge is a graph.WeightedEdge without a
+// "weight" attribute, its weight is written as the "weight" metadata value.
+func Marshal(g graph.Graph, prefix, indent string) ([]byte, error) {
+	_, directed := g.(graph.Directed)
+	dst := graphObject{Directed: &directed}
+	if a, ok := g.(encoding.Attributer); ok {
+		dst.Metadata = fields(a.Attributes(), map[string]*string{
+			"id":    &dst.ID,
+			"type":  &dst.Type,
+			"label": &dst.Label,
+		})
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	ids := make(map[int64]string, len(nodes))
+	seen := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		id := strconv.FormatInt(n.ID(), 10)
+		if n, ok := n.(Node); ok {
+			id = n.JGFID()
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("jgf: duplicate node ID %q", id)
+		}
+		seen[id] = true
+		ids[n.ID()] = id
+
+		el := nodeObject{ID: id}
+		if a, ok := n.(encoding.Attributer); ok {
+			el.Metadata = fields(a.Attributes(), map[string]*string{"label": &el.Label})
+		}
+		dst.Nodes = append(dst.Nodes, el)
+	}
+
+	for _, u := range nodes {
+		uid := u.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		for _, v := range to {
+			vid := v.ID()
+			if !directed && vid < uid {
+				continue
+			}
+			e := g.Edge(uid, vid)
+			el := edgeObject{Source: ids[uid], Target: ids[vid]}
+			if a, ok := e.(encoding.Attributer); ok {
+				el.Metadata = fields(a.Attributes(), map[string]*string{
+					"id":       &el.ID,
+					"relation": &el.Relation,
+					"label":    &el.Label,
+				})
+			}
+			if we, ok := e.(graph.WeightedEdge); ok {
+				if _, ok := el.Metadata["weight"]; !ok {
+					if el.Metadata == nil {
+						el.Metadata = make(metadata)
+					}
+					el.Metadata["weight"] = rawValue(strconv.FormatFloat(we.Weight(), 'g', -1, 64))
+				}
+			}
+			dst.Edges = append(dst.Edges, el)
+		}
+	}
+
+	return json.MarshalIndent(document{Graph: &dst}, prefix, indent)
+}
+
+// fields stores the values of attributes with keys in named to the
+// corresponding strings and returns the remaining attributes as metadata.
+func fields(attrs []encoding.Attribute, named map[string]*string) metadata {
+	var m metadata
+	for _, a := range attrs {
+		if a.Key == "" || a.Value == "" {
+			continue
+		}
+		if f, ok := named[a.Key]; ok {
+			*f = a.Value
+			continue
+		}
+		if m == nil {
+			m = make(metadata)
+		}
+		m[a.Key] = rawValue(a.Value)
+	}
+	return m
+}
+
+// rawValue returns the JSON encoding of an attribute value. Values that are
+// JSON numbers or booleans are returned unaltered and all other values are
+// encoded as JSON strings.
+func rawValue(v string) json.RawMessage {
+	if v == "true" || v == "false" {
+		return json.RawMessage(v)
+	}
+	if _, err := strconv.ParseFloat(v, 64); err == nil {
+		var f float64
+		if json.Unmarshal([]byte(v), &f) == nil {
+			return json.RawMessage(v)
+		}
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jgf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// document is a JSON Graph Format document holding
+// either a single graph or a collection of graphs.
+type document struct {
+	Graph  *graphObject  `json:"graph,omitempty"`
+	Graphs []graphObject `json:"graphs,omitempty"`
+}
+
+// graphObject is a JSON Graph Format graph.
+type graphObject struct {
+	ID       string       `json:"id,omitempty"`
+	Type     string       `json:"type,omitempty"`
+	Label    string       `json:"label,omitempty"`
+	Directed *bool        `json:"directed,omitempty"`
+	Metadata metadata     `json:"metadata,omitempty"`
+	Nodes    nodes        `json:"nodes,omitempty"`
+	Edges    []edgeObject `json:"edges,omitempty"`
+}
+
+// nodeObject is a JSON Graph Format node.
+type nodeObject struct {
+	ID       string   `json:"id,omitempty"`
+	Label    string   `json:"label,omitempty"`
+	Metadata metadata `json:"metadata,omitempty"`
+}
+
+// edgeObject is a JSON Graph Format edge.
+type edgeObject struct {
+	ID       string   `json:"id,omitempty"`
+	Source   string   `json:"source"`
+	Target   string   `json:"target"`
+	Relation string   `json:"relation,omitempty"`
+	Directed *bool    `json:"directed,omitempty"`
+	Label    string   `json:"label,omitempty"`
+	Metadata metadata `json:"metadata,omitempty"`
+}
+
+// metadata is a JSON Graph Format metadata object.
+type metadata map[string]json.RawMessage
+
+// nodes is an ordered collection of JSON Graph Format nodes.
+// It is encoded as a JSON object keyed by node ID, as in
+// version 2 of the format, and may be decoded from either
+// an object or a version 1 array of nodes with IDs.
+type nodes []nodeObject
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n nodes) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, v := range n {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		id, err := json.Marshal(v.ID)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(id)
+		buf.WriteByte(':')
+		v.ID = ""
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Nodes held in an object are retained in document order.
+func (n *nodes) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*n = nil
+		return nil
+	case len(data) != 0 && data[0] == '[':
+		return json.Unmarshal(data, (*[]nodeObject)(n))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return errors.New("jgf: nodes is not an object or array")
+	}
+	*n = (*n)[:0]
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var v nodeObject
+		err = dec.Decode(&v)
+		if err != nil {
+			return err
+		}
+		v.ID = tok.(string)
+		*n = append(*n, v)
+	}
+	_, err = dec.Token()
+	return err
+}
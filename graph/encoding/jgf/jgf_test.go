@@ -0,0 +1,382 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jgf
+
+import (
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// carTypes is an example graph from the JSON Graph Format
+// specification at https://jsongraphformat.info/.
+const carTypes = `{
+  "graph": {
+    "directed": false,
+    "type": "graph type",
+    "label": "graph label",
+    "metadata": {
+      "user-defined": "values"
+    },
+    "nodes": {
+      "0": {
+        "label": "node label(0)",
+        "metadata": {
+          "type": "node type",
+          "user-defined": "values"
+        }
+      },
+      "1": {
+        "label": "node label(1)",
+        "metadata": {
+          "type": "node type",
+          "user-defined": "values"
+        }
+      }
+    },
+    "edges": [
+      {
+        "source": "0",
+        "relation": "edge relationship",
+        "target": "1",
+        "directed": false,
+        "label": "edge label",
+        "metadata": {
+          "user-defined": "values",
+          "weight": 2.5
+        }
+      }
+    ]
+  }
+}`
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+	dst := newUndirectedGraph()
+	err := Unmarshal([]byte(carTypes), dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := attr(dst.attrs, "type"); got != "graph type" {
+		t.Errorf("unexpected graph type: got:%q want:%q", got, "graph type")
+	}
+	if got := attr(dst.attrs, "user-defined"); got != "values" {
+		t.Errorf("unexpected graph metadata: got:%q want:%q", got, "values")
+	}
+	byName := make(map[string]*node)
+	nodes := dst.Nodes()
+	for nodes.Next() {
+		n := nodes.Node().(*node)
+		byName[n.name] = n
+		want := "node label(" + n.name + ")"
+		if got := attr(n.attrs, "label"); got != want {
+			t.Errorf("unexpected label for node %s: got:%q want:%q", n.name, got, want)
+		}
+	}
+	if len(byName) != 2 || byName["0"] == nil || byName["1"] == nil {
+		t.Fatalf("unexpected nodes: %v", byName)
+	}
+	e, ok := dst.Edge(byName["0"].ID(), byName["1"].ID()).(*attrEdge)
+	if !ok {
+		t.Fatal("missing edge")
+	}
+	for key, want := range map[string]string{
+		"relation": "edge relationship",
+		"label":    "edge label",
+		"weight":   "2.5",
+	} {
+		if got := attr(e.attrs, key); got != want {
+			t.Errorf("unexpected edge %s: got:%q want:%q", key, got, want)
+		}
+	}
+
+	wdst := weightedGraph{simple.NewWeightedUndirectedGraph(0, 0)}
+	err = Unmarshal([]byte(carTypes), wdst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	edges := wdst.WeightedEdges()
+	if !edges.Next() || edges.WeightedEdge().Weight() != 2.5 {
+		t.Error("unexpected weighted edges")
+	}
+}
+
+func TestUnmarshalVersion1(t *testing.T) {
+	t.Parallel()
+	const data = `{"graphs": [{
+  "directed": true,
+  "nodes": [{"id": "b"}, {"id": "a", "metadata": {"rank": 1, "pos": [1, 2]}}],
+  "edges": [{"source": "a", "target": "b"}, {"source": "b", "target": "c"}]
+}]}`
+	dst := newDirectedGraph()
+	err := Unmarshal([]byte(data), dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	byName := make(map[string]*node)
+	for id := int64(0); id < 3; id++ {
+		n := dst.Node(id).(*node)
+		names = append(names, n.name)
+		byName[n.name] = n
+	}
+	if got := strings.Join(names, " "); got != "b a c" {
+		t.Errorf("unexpected node order: got:%q want:%q", got, "b a c")
+	}
+	if got := attr(byName["a"].attrs, "rank"); got != "1" {
+		t.Errorf("unexpected rank: got:%q want:%q", got, "1")
+	}
+	if got := attr(byName["a"].attrs, "pos"); got != "[1,2]" {
+		t.Errorf("unexpected pos: got:%q want:%q", got, "[1,2]")
+	}
+	if !dst.HasEdgeFromTo(byName["a"].ID(), byName["b"].ID()) || !dst.HasEdgeFromTo(byName["b"].ID(), byName["c"].ID()) {
+		t.Error("missing edges")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		g    interface {
+			encoding.Builder
+			encoding.AttributeSetter
+		}
+		dst func() encoding.Builder
+	}{
+		{name: "undirected", g: newUndirectedGraph(), dst: func() encoding.Builder { return newUndirectedGraph() }},
+		{name: "directed", g: newDirectedGraph(), dst: func() encoding.Builder { return newDirectedGraph() }},
+	} {
+		g := test.g
+		g.SetAttribute(encoding.Attribute{Key: "label", Value: "test graph"})
+		g.SetAttribute(encoding.Attribute{Key: "version", Value: "1.0"})
+		var nodes []*node
+		for i, name := range []string{"a", "b", "c", "d"} {
+			n := g.NewNode().(*node)
+			n.name = name
+			n.SetAttribute(encoding.Attribute{Key: "rank", Value: string(rune('1' + i))})
+			if i%2 == 0 {
+				n.SetAttribute(encoding.Attribute{Key: "label", Value: `node "` + name + `"`})
+			}
+			g.AddNode(n)
+			nodes = append(nodes, n)
+		}
+		for _, e := range []struct {
+			f, t   int
+			weight string
+		}{{0, 1, "0.5"}, {1, 2, "2"}, {2, 3, "-1e-3"}, {3, 0, ""}, {0, 2, "7"}} {
+			edge := g.NewEdge(nodes[e.f], nodes[e.t]).(*attrEdge)
+			edge.SetAttribute(encoding.Attribute{Key: "weight", Value: e.weight})
+			edge.SetAttribute(encoding.Attribute{Key: "visible", Value: "true"})
+			edge.SetAttribute(encoding.Attribute{Key: "relation", Value: "knows"})
+			g.SetEdge(edge)
+		}
+
+		b, err := Marshal(g, "", "\t")
+		if err != nil {
+			t.Fatalf("unexpected error marshaling %s graph: %v", test.name, err)
+		}
+		for _, want := range []string{
+			`"label": "test graph"`,
+			`"version": 1.0`,
+			`"label": "node \"a\""`,
+			`"rank": 1`,
+			`"relation": "knows"`,
+			`"visible": true`,
+			`"weight": -1e-3`,
+		} {
+			if !strings.Contains(string(b), want) {
+				t.Errorf("missing %q in marshaled %s graph:\n%s", want, test.name, b)
+			}
+		}
+
+		dst := test.dst()
+		err = Unmarshal(b, dst)
+		if err != nil {
+			t.Fatalf("unexpected error unmarshaling %s graph: %v", test.name, err)
+		}
+		got, err := Marshal(dst, "", "\t")
+		if err != nil {
+			t.Fatalf("unexpected error remarshaling %s graph: %v", test.name, err)
+		}
+		if string(got) != string(b) {
+			t.Errorf("round trip mismatch for %s graph:\ngot:\n%s\nwant:\n%s", test.name, got, b)
+		}
+	}
+}
+
+func TestMarshalWeighted(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(1), T: simple.Node(0), W: 2})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 1.5})
+	got, err := Marshal(g, "", "  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = `{
+  "graph": {
+    "directed": true,
+    "nodes": {
+      "0": {},
+      "1": {}
+    },
+    "edges": [
+      {
+        "source": "0",
+        "target": "1",
+        "metadata": {
+          "weight": 1.5
+        }
+      },
+      {
+        "source": "1",
+        "target": "0",
+        "metadata": {
+          "weight": 2
+        }
+      }
+    ]
+  }
+}`
+	if string(got) != want {
+		t.Errorf("unexpected marshaled graph:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "no graph",
+			data: `{}`,
+			want: "jgf: invalid number of graphs; expected 1, got 0",
+		},
+		{
+			name: "two graphs",
+			data: `{"graphs": [{}, {}]}`,
+			want: "jgf: invalid number of graphs; expected 1, got 2",
+		},
+		{
+			name: "duplicate node",
+			data: `{"graph": {"nodes": [{"id": "a"}, {"id": "a"}]}}`,
+			want: `jgf: duplicate node ID "a"`,
+		},
+		{
+			name: "invalid nodes",
+			data: `{"graph": {"nodes": "a"}}`,
+			want: "jgf: nodes is not an object or array",
+		},
+		{
+			name: "malformed",
+			data: `{"graph": {`,
+			want: "unexpected end of JSON input",
+		},
+	} {
+		err := Unmarshal([]byte(test.data), newDirectedGraph())
+		if err == nil || err.Error() != test.want {
+			t.Errorf("unexpected error for %s: got:%v want:%s", test.name, err, test.want)
+		}
+	}
+}
+
+func attr(attrs encoding.Attributes, key string) string {
+	for _, a := range attrs {
+		if a.Key == key {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+type directedGraph struct {
+	*simple.DirectedGraph
+	attrs encoding.Attributes
+}
+
+func (g *directedGraph) Attributes() []encoding.Attribute { return g.attrs.Attributes() }
+func (g *directedGraph) SetAttribute(attr encoding.Attribute) error {
+	return g.attrs.SetAttribute(attr)
+}
+
+func newDirectedGraph() *directedGraph {
+	return &directedGraph{DirectedGraph: simple.NewDirectedGraph()}
+}
+
+func (g *directedGraph) NewNode() graph.Node {
+	return &node{id: g.DirectedGraph.NewNode().ID()}
+}
+
+func (g *directedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{from: from, to: to}
+}
+
+type undirectedGraph struct {
+	*simple.UndirectedGraph
+	attrs encoding.Attributes
+}
+
+func (g *undirectedGraph) Attributes() []encoding.Attribute { return g.attrs.Attributes() }
+func (g *undirectedGraph) SetAttribute(attr encoding.Attribute) error {
+	return g.attrs.SetAttribute(attr)
+}
+
+func newUndirectedGraph() *undirectedGraph {
+	return &undirectedGraph{UndirectedGraph: simple.NewUndirectedGraph()}
+}
+
+func (g *undirectedGraph) NewNode() graph.Node {
+	return &node{id: g.UndirectedGraph.NewNode().ID()}
+}
+
+func (g *undirectedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{from: from, to: to}
+}
+
+type weightedGraph struct {
+	*simple.WeightedUndirectedGraph
+}
+
+func (g weightedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return simple.Edge{F: from, T: to}
+}
+
+func (g weightedGraph) SetEdge(e graph.Edge) {
+	g.SetWeightedEdge(simple.WeightedEdge{F: e.From(), T: e.To(), W: 1})
+}
+
+type node struct {
+	id    int64
+	name  string
+	attrs encoding.Attributes
+}
+
+func (n *node) Attributes() []encoding.Attribute           { return n.attrs.Attributes() }
+func (n *node) SetAttribute(attr encoding.Attribute) error { return n.attrs.SetAttribute(attr) }
+
+func (n *node) ID() int64            { return n.id }
+func (n *node) JGFID() string        { return n.name }
+func (n *node) SetJGFID(name string) { n.name = name }
+
+type attrEdge struct {
+	from, to graph.Node
+	attrs    encoding.Attributes
+}
+
+func (e *attrEdge) Attributes() []encoding.Attribute           { return e.attrs.Attributes() }
+func (e *attrEdge) SetAttribute(attr encoding.Attribute) error { return e.attrs.SetAttribute(attr) }
+
+func (e *attrEdge) From() graph.Node { return e.from }
+func (e *attrEdge) To() graph.Node   { return e.to }
+func (e *attrEdge) ReversedEdge() graph.Edge {
+	return &attrEdge{from: e.to, to: e.from, attrs: e.attrs}
+}
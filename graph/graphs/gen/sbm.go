@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/mat"
+)
+
+// StochasticBlock constructs a stochastic block model graph in the destination,
+// dst. The graph has len(sizes) blocks where block i holds sizes[i] nodes.
+// An edge from a node in block i to a node in block j is formed with the
+// probability p.At(i, j). If dst is a graph.Directed, p may be asymmetric,
+// otherwise p must be symmetric. No self loops are added. If src is not nil
+// it is used as the random source, otherwise rand.Float64 is used. The graph
+// is constructed in O(n+m) time where m is the number of edges added.
+//
+// StochasticBlock returns the nodes of each block in the order of sizes.
+func StochasticBlock(dst graph.Builder, sizes []int, p mat.Matrix, src rand.Source) ([][]graph.Node, error) {
+	r, c := p.Dims()
+	if r != c || r != len(sizes) {
+		return nil, fmt.Errorf("gen: probability matrix dimension mismatch: %d×%d for %d blocks", r, c, len(sizes))
+	}
+	_, isDirected := dst.(graph.Directed)
+	for i, n := range sizes {
+		if n < 0 {
+			return nil, fmt.Errorf("gen: bad block size: sizes[%d]=%d", i, n)
+		}
+		for j := range sizes {
+			pij := p.At(i, j)
+			if pij < 0 || pij > 1 || math.IsNaN(pij) {
+				return nil, fmt.Errorf("gen: bad probability: p[%d][%d]=%v", i, j, pij)
+			}
+			if !isDirected && pij != p.At(j, i) {
+				return nil, fmt.Errorf("gen: asymmetric probability for undirected graph: p[%d][%d]=%v p[%d][%d]=%v", i, j, pij, j, i, p.At(j, i))
+			}
+		}
+	}
+
+	var rnd func() float64
+	if src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(src).Float64
+	}
+
+	blocks := make([][]graph.Node, len(sizes))
+	for i, n := range sizes {
+		blocks[i] = make([]graph.Node, n)
+		for j := range blocks[i] {
+			u := dst.NewNode()
+			dst.AddNode(u)
+			blocks[i][j] = u
+		}
+	}
+
+	for i, a := range blocks {
+		// Add edges within the block, with backward
+		// edges for directed graphs.
+		pii := p.At(i, i)
+		within(a, pii, rnd, func(u, v graph.Node) {
+			dst.SetEdge(dst.NewEdge(u, v))
+		})
+		if isDirected {
+			within(a, pii, rnd, func(u, v graph.Node) {
+				dst.SetEdge(dst.NewEdge(v, u))
+			})
+		}
+
+		// Add edges between blocks.
+		for j := i + 1; j < len(blocks); j++ {
+			b := blocks[j]
+			between(a, b, p.At(i, j), rnd, func(u, v graph.Node) {
+				dst.SetEdge(dst.NewEdge(u, v))
+			})
+			if isDirected {
+				between(a, b, p.At(j, i), rnd, func(u, v graph.Node) {
+					dst.SetEdge(dst.NewEdge(v, u))
+				})
+			}
+		}
+	}
+
+	return blocks, nil
+}
+
+// within calls fn for each pair of distinct nodes in nodes with
+// probability p, skipping over pairs with geometrically distributed
+// gaps as in Gnp.
+func within(nodes []graph.Node, p float64, rnd func() float64, fn func(u, v graph.Node)) {
+	if p == 0 {
+		return
+	}
+	n := len(nodes)
+	lp := math.Log(1 - p)
+	for v, w := 1, -1; v < n; {
+		w += 1 + int(math.Log(1-rnd())/lp)
+		for w >= v && v < n {
+			w -= v
+			v++
+		}
+		if v < n {
+			fn(nodes[w], nodes[v])
+		}
+	}
+}
+
+// between calls fn for each pair of nodes u in a and v in b with
+// probability p, skipping over pairs with geometrically distributed
+// gaps.
+func between(a, b []graph.Node, p float64, rnd func() float64, fn func(u, v graph.Node)) {
+	if p == 0 || len(b) == 0 {
+		return
+	}
+	n := len(b)
+	lp := math.Log(1 - p)
+	for u, v := 0, -1; u < len(a); {
+		v += 1 + int(math.Log(1-rnd())/lp)
+		for v >= n && u < len(a) {
+			v -= n
+			u++
+		}
+		if u < len(a) {
+			fn(a[u], b[v])
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestStochasticBlockUndirected(t *testing.T) {
+	t.Parallel()
+	sizes := []int{0, 1, 40, 60, 100}
+	p := mat.NewDense(5, 5, []float64{
+		0.5, 0.5, 0.5, 0.5, 0.5,
+		0.5, 0.5, 0.5, 0.5, 0.5,
+		0.5, 0.5, 0.6, 0.05, 0,
+		0.5, 0.5, 0.05, 0.3, 0.1,
+		0.5, 0.5, 0, 0.1, 1,
+	})
+	g := &gnUndirected{UndirectedBuilder: simple.NewUndirectedGraph()}
+	orig := g.NewNode()
+	g.AddNode(orig)
+	blocks, err := StochasticBlock(g, sizes, p, rand.NewPCG(1, 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.From(orig.ID()).Len() != 0 {
+		t.Errorf("edge added from already existing node")
+	}
+	if g.addSelfLoop {
+		t.Errorf("unexpected self edge")
+	}
+	if g.addMultipleEdge {
+		t.Errorf("unexpected multiple edge")
+	}
+	checkBlocks(t, g, blocks, sizes, p, false)
+}
+
+func TestStochasticBlockDirected(t *testing.T) {
+	t.Parallel()
+	sizes := []int{50, 0, 80, 70}
+	p := mat.NewDense(4, 4, []float64{
+		0.4, 0.5, 0.02, 0.2,
+		0.5, 0.5, 0.5, 0.5,
+		0.1, 0.5, 0.5, 0,
+		0, 0.5, 1, 0.3,
+	})
+	g := &gnDirected{DirectedBuilder: simple.NewDirectedGraph()}
+	orig := g.NewNode()
+	g.AddNode(orig)
+	blocks, err := StochasticBlock(g, sizes, p, rand.NewPCG(1, 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.From(orig.ID()).Len() != 0 {
+		t.Errorf("edge added from already existing node")
+	}
+	if g.addSelfLoop {
+		t.Errorf("unexpected self edge")
+	}
+	if g.addMultipleEdge {
+		t.Errorf("unexpected multiple edge")
+	}
+	checkBlocks(t, g, blocks, sizes, p, true)
+}
+
+// checkBlocks checks that blocks has the given sizes and that the edge
+// density between each pair of blocks of g is consistent with p.
+func checkBlocks(t *testing.T, g graph.Graph, blocks [][]graph.Node, sizes []int, p mat.Matrix, directed bool) {
+	t.Helper()
+	if len(blocks) != len(sizes) {
+		t.Fatalf("unexpected number of blocks: got:%d want:%d", len(blocks), len(sizes))
+	}
+	for i, b := range blocks {
+		if len(b) != sizes[i] {
+			t.Errorf("unexpected size of block %d: got:%d want:%d", i, len(b), sizes[i])
+		}
+	}
+	for i, a := range blocks {
+		for j, b := range blocks {
+			var pairs, edges int
+			for _, u := range a {
+				for _, v := range b {
+					if u.ID() == v.ID() {
+						continue
+					}
+					pairs++
+					if g.Edge(u.ID(), v.ID()) != nil {
+						edges++
+					}
+				}
+			}
+			if pairs == 0 {
+				continue
+			}
+			if !directed && i == j {
+				// Each unordered pair is counted twice.
+				pairs /= 2
+				edges /= 2
+			}
+			pij := p.At(i, j)
+			got := float64(edges) / float64(pairs)
+			// Allow four standard deviations.
+			tol := 4 * math.Sqrt(pij*(1-pij)/float64(pairs))
+			if math.Abs(got-pij) > tol {
+				t.Errorf("unexpected edge density between blocks %d and %d: got:%v want:%v±%v", i, j, got, pij, tol)
+			}
+		}
+	}
+}
+
+func TestStochasticBlockBadInput(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		dst  interface {
+			graph.Graph
+			graph.Builder
+		}
+		sizes []int
+		p     mat.Matrix
+	}{
+		{
+			name:  "dimension mismatch",
+			dst:   simple.NewUndirectedGraph(),
+			sizes: []int{2, 2, 2},
+			p:     mat.NewDense(2, 2, []float64{0.5, 0.5, 0.5, 0.5}),
+		},
+		{
+			name:  "negative size",
+			dst:   simple.NewUndirectedGraph(),
+			sizes: []int{2, -1},
+			p:     mat.NewDense(2, 2, []float64{0.5, 0.5, 0.5, 0.5}),
+		},
+		{
+			name:  "bad probability",
+			dst:   simple.NewDirectedGraph(),
+			sizes: []int{2, 2},
+			p:     mat.NewDense(2, 2, []float64{0.5, 1.5, 0.5, 0.5}),
+		},
+		{
+			name:  "asymmetric undirected",
+			dst:   simple.NewUndirectedGraph(),
+			sizes: []int{2, 2},
+			p:     mat.NewDense(2, 2, []float64{0.5, 0.1, 0.2, 0.5}),
+		},
+	} {
+		_, err := StochasticBlock(test.dst, test.sizes, test.p, nil)
+		if err == nil {
+			t.Errorf("expected error for %s", test.name)
+		}
+		if test.dst.Nodes().Len() != 0 {
+			t.Errorf("unexpected nodes added for %s", test.name)
+		}
+	}
+}
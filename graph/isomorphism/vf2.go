@@ -0,0 +1,439 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package isomorphism
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// NodeMatch is a node compatibility predicate. It returns whether node a
+// of the pattern graph may be mapped to node b of the target graph.
+type NodeMatch func(a, b graph.Node) bool
+
+// EdgeMatch is an edge compatibility predicate. It returns whether edge a
+// of the pattern graph may be mapped to edge b of the target graph. The
+// edges are those returned by the graphs' Edge methods, so for undirected
+// graphs the edge end points may be reversed relative to the mapping.
+type EdgeMatch func(a, b graph.Edge) bool
+
+// Isomorphic returns whether the graphs a and b are isomorphic, that is
+// whether there is a bijection between their nodes that preserves adjacency.
+// If nodeMatch or edgeMatch are not nil, mapped nodes and edges must also
+// satisfy the predicates. Isomorphic will panic if exactly one of a and b
+// is a graph.Directed.
+func Isomorphic(a, b graph.Graph, nodeMatch NodeMatch, edgeMatch EdgeMatch) bool {
+	var found bool
+	Isomorphisms(a, b, nodeMatch, edgeMatch, func(map[int64]int64) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
+// Isomorphisms calls fn with each isomorphism from graph a to graph b until
+// fn returns true. The isomorphism is given as a map from node IDs of a to
+// node IDs of b and is only valid during the call to fn. If nodeMatch or
+// edgeMatch are not nil, mapped nodes and edges must also satisfy the
+// predicates. Isomorphisms will panic if exactly one of a and b is a
+// graph.Directed.
+//
+// The isomorphisms are found by the VF2 algorithm using the node ordering of
+// VF2++. The algorithms are described in Cordella, Foggia, Sansone and Vento,
+// "A (sub)graph isomorphism algorithm for matching large graphs", IEEE Trans.
+// Pattern Anal. Mach. Intell. 26(10):1367-1372, 2004, doi:10.1109/TPAMI.2004.75
+// and Jüttner and Madarasi, "VF2++ — An improved subgraph isomorphism algorithm",
+// Discrete Appl. Math. 242:69-81, 2018, doi:10.1016/j.dam.2018.02.018.
+func Isomorphisms(a, b graph.Graph, nodeMatch NodeMatch, edgeMatch EdgeMatch, fn func(m map[int64]int64) (stop bool)) {
+	s := newState(a, b, isomorphism, nodeMatch, edgeMatch)
+	if len(s.p.nodes) != len(s.t.nodes) {
+		return
+	}
+	s.match(fn)
+}
+
+// SubgraphIsomorphic returns whether the graph sub is isomorphic to a subgraph
+// of g. If induced is true, the subgraph must be an induced subgraph of g, so
+// that nodes not adjacent in sub are not adjacent in g. Otherwise the
+// subgraph may omit edges between its nodes in g, a relation also known as
+// monomorphism. If nodeMatch or edgeMatch are not nil, mapped nodes and edges
+// must also satisfy the predicates. SubgraphIsomorphic will panic if exactly
+// one of sub and g is a graph.Directed.
+func SubgraphIsomorphic(sub, g graph.Graph, induced bool, nodeMatch NodeMatch, edgeMatch EdgeMatch) bool {
+	var found bool
+	SubgraphIsomorphisms(sub, g, induced, nodeMatch, edgeMatch, func(map[int64]int64) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
+// SubgraphIsomorphisms calls fn with each isomorphism from the graph sub to a
+// subgraph of g until fn returns true. The isomorphism is given as a map from
+// node IDs of sub to node IDs of g and is only valid during the call to fn.
+// Automorphisms of sub result in distinct isomorphisms to the same subgraph
+// of g. See SubgraphIsomorphic for the meaning of induced, nodeMatch and
+// edgeMatch. SubgraphIsomorphisms will panic if exactly one of sub and g is a
+// graph.Directed.
+//
+// See Isomorphisms for a description of the algorithm.
+func SubgraphIsomorphisms(sub, g graph.Graph, induced bool, nodeMatch NodeMatch, edgeMatch EdgeMatch, fn func(m map[int64]int64) (stop bool)) {
+	kind := monomorphism
+	if induced {
+		kind = inducedSubgraph
+	}
+	s := newState(sub, g, kind, nodeMatch, edgeMatch)
+	if len(s.p.nodes) > len(s.t.nodes) {
+		return
+	}
+	s.match(fn)
+}
+
+// problem is the kind of matching problem being solved.
+type problem int
+
+const (
+	isomorphism problem = iota
+	inducedSubgraph
+	monomorphism
+)
+
+// side is a graph being matched with dense node indices.
+type side struct {
+	g     graph.Graph
+	nodes []graph.Node
+	index map[int64]int
+
+	// out and in hold the indices of the successors
+	// and predecessors of each node, excluding self
+	// loops. For undirected graphs in is the same
+	// as out.
+	out, in [][]int
+
+	loop []bool
+}
+
+func newSide(g graph.Graph) *side {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	s := &side{
+		g:     g,
+		nodes: nodes,
+		index: make(map[int64]int, len(nodes)),
+		out:   make([][]int, len(nodes)),
+		loop:  make([]bool, len(nodes)),
+	}
+	for i, n := range nodes {
+		s.index[n.ID()] = i
+	}
+	for i, n := range nodes {
+		uid := n.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				s.loop[i] = true
+				continue
+			}
+			s.out[i] = append(s.out[i], s.index[vid])
+		}
+	}
+	d, ok := g.(graph.Directed)
+	if !ok {
+		s.in = s.out
+		return s
+	}
+	s.in = make([][]int, len(nodes))
+	for i, n := range nodes {
+		uid := n.ID()
+		from := d.To(uid)
+		for from.Next() {
+			if vid := from.Node().ID(); vid != uid {
+				s.in[i] = append(s.in[i], s.index[vid])
+			}
+		}
+	}
+	return s
+}
+
+// edge returns the edge from node i to node j.
+func (s *side) edge(i, j int) graph.Edge {
+	return s.g.Edge(s.nodes[i].ID(), s.nodes[j].ID())
+}
+
+// state is the VF2 matching state mapping nodes of the
+// pattern graph, p, to nodes of the target graph, t.
+type state struct {
+	p, t     *side
+	directed bool
+	kind     problem
+
+	edgeMatch EdgeMatch
+
+	// compat holds the target nodes compatible
+	// with each pattern node.
+	compat [][]bool
+
+	// order is the order in which pattern nodes are
+	// matched. parent holds for each position in order
+	// the position of an earlier adjacent pattern node
+	// or -1, and fromParent is whether the edge is
+	// from the parent to the node.
+	order      []int
+	parent     []int
+	fromParent []bool
+
+	core []int // core[u] is the target node mapped from pattern node u, or -1.
+	rev  []int // rev[v] is the pattern node mapped to target node v, or -1.
+
+	mapping map[int64]int64
+}
+
+func newState(p, t graph.Graph, kind problem, nodeMatch NodeMatch, edgeMatch EdgeMatch) *state {
+	_, pDirected := p.(graph.Directed)
+	_, tDirected := t.(graph.Directed)
+	if pDirected != tDirected {
+		panic("isomorphism: mixed directed and undirected graphs")
+	}
+	s := &state{
+		p:         newSide(p),
+		t:         newSide(t),
+		directed:  pDirected,
+		kind:      kind,
+		edgeMatch: edgeMatch,
+	}
+	s.compat = make([][]bool, len(s.p.nodes))
+	for u, pn := range s.p.nodes {
+		s.compat[u] = make([]bool, len(s.t.nodes))
+		for v, tn := range s.t.nodes {
+			s.compat[u][v] = nodeMatch == nil || nodeMatch(pn, tn)
+		}
+	}
+	s.core = make([]int, len(s.p.nodes))
+	for i := range s.core {
+		s.core[i] = -1
+	}
+	s.rev = make([]int, len(s.t.nodes))
+	for i := range s.rev {
+		s.rev[i] = -1
+	}
+	s.mapping = make(map[int64]int64, len(s.p.nodes))
+	s.orderNodes()
+	return s
+}
+
+// orderNodes sets the matching order of the pattern nodes using the VF2++
+// ordering. Each connected component is traversed breadth first from its
+// node with the fewest compatible target nodes, and nodes within each level
+// are ordered by decreasing number of already ordered neighbors, then by
+// decreasing degree and then by increasing number of compatible target nodes.
+func (s *state) orderNodes() {
+	n := len(s.p.nodes)
+	rarity := make([]int, n)
+	degree := make([]int, n)
+	for u := range s.p.nodes {
+		for _, ok := range s.compat[u] {
+			if ok {
+				rarity[u]++
+			}
+		}
+		degree[u] = len(s.p.out[u])
+		if s.directed {
+			degree[u] += len(s.p.in[u])
+		}
+	}
+	pos := make([]int, n)
+	for i := range pos {
+		pos[i] = -1
+	}
+	conn := make([]int, n)
+	seen := make([]bool, n)
+	better := func(u, v int) bool {
+		switch {
+		case conn[u] != conn[v]:
+			return conn[u] > conn[v]
+		case degree[u] != degree[v]:
+			return degree[u] > degree[v]
+		default:
+			return rarity[u] < rarity[v]
+		}
+	}
+	for len(s.order) < n {
+		root := -1
+		for u := 0; u < n; u++ {
+			if seen[u] {
+				continue
+			}
+			if root < 0 || rarity[u] < rarity[root] || (rarity[u] == rarity[root] && degree[u] > degree[root]) {
+				root = u
+			}
+		}
+		seen[root] = true
+		level := []int{root}
+		for len(level) != 0 {
+			var next []int
+			for len(level) != 0 {
+				best := 0
+				for i, u := range level {
+					if better(u, level[best]) {
+						best = i
+					}
+				}
+				u := level[best]
+				level[best] = level[len(level)-1]
+				level = level[:len(level)-1]
+
+				pos[u] = len(s.order)
+				s.order = append(s.order, u)
+				s.parent = append(s.parent, -1)
+				s.fromParent = append(s.fromParent, false)
+				for _, w := range s.p.in[u] {
+					if pos[w] >= 0 && pos[w] < pos[u] {
+						s.parent[pos[u]] = pos[w]
+						s.fromParent[pos[u]] = true
+						break
+					}
+				}
+				if s.parent[pos[u]] < 0 {
+					for _, w := range s.p.out[u] {
+						if pos[w] >= 0 && pos[w] < pos[u] {
+							s.parent[pos[u]] = pos[w]
+							break
+						}
+					}
+				}
+
+				for _, w := range s.neighbors(u) {
+					conn[w]++
+					if !seen[w] {
+						seen[w] = true
+						next = append(next, w)
+					}
+				}
+			}
+			level = next
+		}
+	}
+}
+
+// neighbors returns the successors and predecessors of pattern node u.
+func (s *state) neighbors(u int) []int {
+	if !s.directed {
+		return s.p.out[u]
+	}
+	return append(append([]int(nil), s.p.out[u]...), s.p.in[u]...)
+}
+
+// match calls fn with each complete mapping until fn returns true.
+func (s *state) match(fn func(map[int64]int64) bool) {
+	s.extend(0, fn)
+}
+
+// extend attempts to map the pattern node at position i of the
+// matching order, returning true if the search should stop.
+func (s *state) extend(i int, fn func(map[int64]int64) bool) bool {
+	if i == len(s.order) {
+		for u, v := range s.core {
+			s.mapping[s.p.nodes[u].ID()] = s.t.nodes[v].ID()
+		}
+		return fn(s.mapping)
+	}
+
+	u := s.order[i]
+	var candidates []int
+	switch p := s.parent[i]; {
+	case p < 0:
+		candidates = make([]int, len(s.t.nodes))
+		for v := range candidates {
+			candidates[v] = v
+		}
+	case s.fromParent[i]:
+		candidates = s.t.out[s.core[s.order[p]]]
+	default:
+		candidates = s.t.in[s.core[s.order[p]]]
+	}
+	for _, v := range candidates {
+		if s.rev[v] >= 0 || !s.compat[u][v] || !s.feasible(u, v) {
+			continue
+		}
+		s.core[u] = v
+		s.rev[v] = u
+		stop := s.extend(i+1, fn)
+		s.core[u] = -1
+		s.rev[v] = -1
+		if stop {
+			return true
+		}
+	}
+	return false
+}
+
+// feasible returns whether pattern node u can be mapped to target
+// node v consistently with the current partial mapping.
+func (s *state) feasible(u, v int) bool {
+	p, t := s.p, s.t
+	exact := s.kind != monomorphism
+	if s.kind == isomorphism {
+		if len(p.out[u]) != len(t.out[v]) || len(p.in[u]) != len(t.in[v]) {
+			return false
+		}
+	} else if len(p.out[u]) > len(t.out[v]) || len(p.in[u]) > len(t.in[v]) {
+		return false
+	}
+
+	if p.loop[u] {
+		if !t.loop[v] || (s.edgeMatch != nil && !s.edgeMatch(p.edge(u, u), t.edge(v, v))) {
+			return false
+		}
+	} else if exact && t.loop[v] {
+		return false
+	}
+
+	// Every mapped neighbor of u must be mapped to a neighbor
+	// of v with a compatible edge. For exact matching, v must
+	// also have no other mapped neighbors.
+	var mapped int
+	for _, w := range p.out[u] {
+		x := s.core[w]
+		if x < 0 {
+			continue
+		}
+		e := t.edge(v, x)
+		if e == nil || (s.edgeMatch != nil && !s.edgeMatch(p.edge(u, w), e)) {
+			return false
+		}
+		mapped++
+	}
+	if exact && mapped != countMapped(t.out[v], s.rev) {
+		return false
+	}
+	if !s.directed {
+		return true
+	}
+	mapped = 0
+	for _, w := range p.in[u] {
+		x := s.core[w]
+		if x < 0 {
+			continue
+		}
+		e := t.edge(x, v)
+		if e == nil || (s.edgeMatch != nil && !s.edgeMatch(p.edge(w, u), e)) {
+			return false
+		}
+		mapped++
+	}
+	return !exact || mapped == countMapped(t.in[v], s.rev)
+}
+
+// countMapped returns the number of nodes in nodes that have been mapped.
+func countMapped(nodes []int, rev []int) int {
+	var n int
+	for _, v := range nodes {
+		if rev[v] >= 0 {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,34 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package isomorphism_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/isomorphism"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func ExampleSubgraphIsomorphisms() {
+	// Count the triangles in the wheel graph with
+	// five spokes. Each triangle is found once for
+	// each of its six automorphisms.
+	g := simple.NewUndirectedGraph()
+	gen.Wheel(g, 0, gen.IDRange{First: 1, Last: 5})
+
+	triangle := simple.NewUndirectedGraph()
+	gen.Cycle(triangle, gen.IDRange{First: 0, Last: 2})
+
+	var n int
+	isomorphism.SubgraphIsomorphisms(triangle, g, false, nil, nil, func(map[int64]int64) bool {
+		n++
+		return false
+	})
+	fmt.Printf("triangles: %d\n", n/6)
+
+	// Output:
+	// triangles: 5
+}
@@ -0,0 +1,395 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package isomorphism
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+var automorphismTests = []struct {
+	name string
+	g    func() graph.Graph
+	want int
+}{
+	{
+		name: "empty",
+		g:    func() graph.Graph { return simple.NewUndirectedGraph() },
+		want: 1,
+	},
+	{
+		name: "K4",
+		g: func() graph.Graph {
+			g := simple.NewUndirectedGraph()
+			gen.Complete(g, gen.IDRange{First: 0, Last: 3})
+			return g
+		},
+		want: 24,
+	},
+	{
+		name: "C7",
+		g: func() graph.Graph {
+			g := simple.NewUndirectedGraph()
+			gen.Cycle(g, gen.IDRange{First: 0, Last: 6})
+			return g
+		},
+		want: 14,
+	},
+	{
+		name: "directed C7",
+		g: func() graph.Graph {
+			g := simple.NewDirectedGraph()
+			gen.Cycle(g, gen.IDRange{First: 0, Last: 6})
+			return g
+		},
+		want: 7,
+	},
+	{
+		name: "star",
+		g: func() graph.Graph {
+			g := simple.NewUndirectedGraph()
+			gen.Star(g, 0, gen.IDRange{First: 1, Last: 5})
+			return g
+		},
+		want: 120,
+	},
+	{
+		name: "Petersen",
+		g: func() graph.Graph {
+			g := simple.NewUndirectedGraph()
+			for i := int64(0); i < 5; i++ {
+				g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node((i + 1) % 5)})
+				g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 5)})
+				g.SetEdge(simple.Edge{F: simple.Node(i + 5), T: simple.Node((i+2)%5 + 5)})
+			}
+			return g
+		},
+		want: 120,
+	},
+	{
+		name: "two triangles and isolated nodes",
+		g: func() graph.Graph {
+			g := simple.NewUndirectedGraph()
+			gen.Cycle(g, gen.IDRange{First: 0, Last: 2})
+			gen.Cycle(g, gen.IDRange{First: 3, Last: 5})
+			g.AddNode(simple.Node(6))
+			g.AddNode(simple.Node(7))
+			return g
+		},
+		want: 6 * 6 * 2 * 2,
+	},
+}
+
+func TestAutomorphisms(t *testing.T) {
+	t.Parallel()
+	for _, test := range automorphismTests {
+		g := test.g()
+		var got int
+		Isomorphisms(g, g, nil, nil, func(m map[int64]int64) bool {
+			checkMapping(t, test.name, g, g, m, true)
+			got++
+			return false
+		})
+		if got != test.want {
+			t.Errorf("unexpected number of automorphisms of %s: got:%d want:%d", test.name, got, test.want)
+		}
+	}
+}
+
+func TestIsomorphicRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, directed := range []bool{false, true} {
+		for i := 0; i < 20; i++ {
+			name := fmt.Sprintf("random graph %d directed=%t", i, directed)
+			a := randomGraph(directed, 30, 0.15, rnd)
+			b := permuted(a, rnd)
+			if !Isomorphic(a, b, nil, nil) {
+				t.Errorf("expected isomorphism for %s", name)
+			}
+
+		}
+	}
+}
+
+func TestIsomorphismsBruteForce(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, directed := range []bool{false, true} {
+		for i := 0; i < 50; i++ {
+			a := randomGraph(directed, 6, 0.4, rnd)
+			b := randomGraph(directed, 6, 0.4, rnd)
+
+			// Move a single edge of a permuted copy
+			// of a to an unconnected pair.
+			moved := permuted(a, rnd)
+			edges := graph.EdgesOf(moved.Edges())
+			if len(edges) != 0 {
+				e := edges[rnd.IntN(len(edges))]
+				for {
+					u, v := rnd.Int64N(6), rnd.Int64N(6)
+					if u == v || moved.Edge(u, v) != nil {
+						continue
+					}
+					moved.RemoveEdge(e.From().ID(), e.To().ID())
+					moved.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+					break
+				}
+			}
+
+			for _, pair := range [][2]graph.Graph{{a, a}, {a, b}, {a, permuted(a, rnd)}, {a, moved}} {
+				var got int
+				Isomorphisms(pair[0], pair[1], nil, nil, func(map[int64]int64) bool {
+					got++
+					return false
+				})
+				want := bruteCount(pair[0], pair[1], isomorphism)
+				if got != want {
+					t.Errorf("unexpected number of isomorphisms for test %d directed=%t: got:%d want:%d", i, directed, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestSubgraphIsomorphisms(t *testing.T) {
+	t.Parallel()
+	k4 := simple.NewUndirectedGraph()
+	gen.Complete(k4, gen.IDRange{First: 0, Last: 3})
+	c5 := simple.NewUndirectedGraph()
+	gen.Cycle(c5, gen.IDRange{First: 0, Last: 4})
+	triangle := simple.NewUndirectedGraph()
+	gen.Cycle(triangle, gen.IDRange{First: 0, Last: 2})
+	path3 := simple.NewUndirectedGraph()
+	gen.Path(path3, gen.IDRange{First: 0, Last: 2})
+
+	for _, test := range []struct {
+		name    string
+		sub, g  graph.Graph
+		induced bool
+		want    int
+	}{
+		{name: "triangles in K4", sub: triangle, g: k4, want: 24},
+		{name: "induced triangles in K4", sub: triangle, g: k4, induced: true, want: 24},
+		{name: "paths in K4", sub: path3, g: k4, want: 24},
+		{name: "induced paths in K4", sub: path3, g: k4, induced: true, want: 0},
+		{name: "triangles in C5", sub: triangle, g: c5, want: 0},
+		{name: "paths in C5", sub: path3, g: c5, want: 10},
+		{name: "induced paths in C5", sub: path3, g: c5, induced: true, want: 10},
+		{name: "K4 in triangle", sub: k4, g: triangle, want: 0},
+	} {
+		var got int
+		SubgraphIsomorphisms(test.sub, test.g, test.induced, nil, nil, func(m map[int64]int64) bool {
+			checkMapping(t, test.name, test.sub, test.g, m, test.induced)
+			got++
+			return false
+		})
+		if got != test.want {
+			t.Errorf("unexpected number of matches for %s: got:%d want:%d", test.name, got, test.want)
+		}
+		if SubgraphIsomorphic(test.sub, test.g, test.induced, nil, nil) != (test.want != 0) {
+			t.Errorf("unexpected subgraph isomorphism result for %s", test.name)
+		}
+	}
+}
+
+func TestSubgraphIsomorphismsBruteForce(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, directed := range []bool{false, true} {
+		for i := 0; i < 30; i++ {
+			sub := randomGraph(directed, 4, 0.5, rnd)
+			g := randomGraph(directed, 7, 0.5, rnd)
+			for _, kind := range []problem{inducedSubgraph, monomorphism} {
+				var got int
+				SubgraphIsomorphisms(sub, g, kind == inducedSubgraph, nil, nil, func(map[int64]int64) bool {
+					got++
+					return false
+				})
+				want := bruteCount(sub, g, kind)
+				if got != want {
+					t.Errorf("unexpected number of matches for test %d directed=%t induced=%t: got:%d want:%d",
+						i, directed, kind == inducedSubgraph, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestMatchPredicates(t *testing.T) {
+	t.Parallel()
+	// A weighted 6-cycle with alternating weights and
+	// node colors given by ID parity.
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	for i := int64(0); i < 6; i++ {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node((i + 1) % 6), W: float64(1 + i%2)})
+	}
+	sameColor := func(a, b graph.Node) bool { return a.ID()%2 == b.ID()%2 }
+	sameWeight := func(a, b graph.Edge) bool {
+		return a.(graph.WeightedEdge).Weight() == b.(graph.WeightedEdge).Weight()
+	}
+	for _, test := range []struct {
+		name      string
+		nodeMatch NodeMatch
+		edgeMatch EdgeMatch
+		want      int
+	}{
+		{name: "unconstrained", want: 12},
+		{name: "colors", nodeMatch: sameColor, want: 6},
+		{name: "weights", edgeMatch: sameWeight, want: 6},
+		{name: "colors and weights", nodeMatch: sameColor, edgeMatch: sameWeight, want: 3},
+	} {
+		var got int
+		Isomorphisms(g, g, test.nodeMatch, test.edgeMatch, func(m map[int64]int64) bool {
+			for u, v := range m {
+				if test.nodeMatch != nil && !test.nodeMatch(g.Node(u), g.Node(v)) {
+					t.Errorf("mapping violates node predicate for %s", test.name)
+				}
+			}
+			got++
+			return false
+		})
+		if got != test.want {
+			t.Errorf("unexpected number of automorphisms for %s: got:%d want:%d", test.name, got, test.want)
+		}
+	}
+}
+
+func TestMixedDirectedness(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for mixed directed and undirected graphs")
+		}
+	}()
+	Isomorphic(simple.NewDirectedGraph(), simple.NewUndirectedGraph(), nil, nil)
+}
+
+// checkMapping checks that m is an injective adjacency preserving mapping
+// from sub to g. If exact is true, non-adjacency must also be preserved.
+func checkMapping(t *testing.T, name string, sub, g graph.Graph, m map[int64]int64, exact bool) {
+	t.Helper()
+	seen := make(map[int64]bool)
+	for _, v := range m {
+		if seen[v] {
+			t.Errorf("mapping for %s is not injective: %v", name, m)
+			return
+		}
+		seen[v] = true
+	}
+	for u, x := range m {
+		for v, y := range m {
+			hasSub := sub.Edge(u, v) != nil
+			hasG := g.Edge(x, y) != nil
+			if hasSub && !hasG || exact && hasG && !hasSub {
+				t.Errorf("mapping for %s does not preserve adjacency of %d-%d: %v", name, u, v, m)
+				return
+			}
+		}
+	}
+}
+
+// mutableGraph is a graph that can be modified and can list its edges.
+type mutableGraph interface {
+	graph.Graph
+	graph.Builder
+	graph.EdgeRemover
+	Edges() graph.Edges
+}
+
+func newGraph(directed bool) mutableGraph {
+	if directed {
+		return simple.NewDirectedGraph()
+	}
+	return simple.NewUndirectedGraph()
+}
+
+// randomGraph returns a random graph with nodes 0 to n-1 and edge probability p.
+func randomGraph(directed bool, n int, p float64, rnd *rand.Rand) mutableGraph {
+	g := newGraph(directed)
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == j || (!directed && j < i) {
+				continue
+			}
+			if rnd.Float64() < p {
+				g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+			}
+		}
+	}
+	return g
+}
+
+// permuted returns a copy of g with its node IDs randomly permuted.
+func permuted(g mutableGraph, rnd *rand.Rand) mutableGraph {
+	nodes := graph.NodesOf(g.Nodes())
+	perm := rnd.Perm(len(nodes))
+	id := make(map[int64]int64)
+	for i, n := range nodes {
+		id[n.ID()] = nodes[perm[i]].ID()
+	}
+	_, directed := g.(graph.Directed)
+	dst := newGraph(directed)
+	for _, n := range nodes {
+		dst.AddNode(simple.Node(id[n.ID()]))
+	}
+	for _, e := range graph.EdgesOf(g.Edges()) {
+		dst.SetEdge(simple.Edge{F: simple.Node(id[e.From().ID()]), T: simple.Node(id[e.To().ID()])})
+	}
+	return dst
+}
+
+// bruteCount returns the number of mappings of kind from sub to g by
+// exhaustive search over injective mappings.
+func bruteCount(sub, g graph.Graph, kind problem) int {
+	p := graph.NodesOf(sub.Nodes())
+	order.ByID(p)
+	t := graph.NodesOf(g.Nodes())
+	order.ByID(t)
+	if kind == isomorphism && len(p) != len(t) {
+		return 0
+	}
+	var (
+		count int
+		used  = make([]bool, len(t))
+		m     = make([]int64, len(p))
+		rec   func(i int)
+	)
+	rec = func(i int) {
+		if i == len(p) {
+			for u := range p {
+				for v := range p {
+					hasSub := sub.Edge(p[u].ID(), p[v].ID()) != nil
+					hasG := g.Edge(m[u], m[v]) != nil
+					if hasSub && !hasG || kind != monomorphism && hasG && !hasSub {
+						return
+					}
+				}
+			}
+			count++
+			return
+		}
+		for j, n := range t {
+			if used[j] {
+				continue
+			}
+			used[j] = true
+			m[i] = n.ID()
+			rec(i + 1)
+			used[j] = false
+		}
+	}
+	rec(0)
+	return count
+}
@@ -0,0 +1,172 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrNoAssignment is returned by the assignment functions when
+// no complete assignment of finite cost exists.
+var ErrNoAssignment = errors.New("matching: no complete assignment")
+
+// Assignment solves the linear assignment problem for the cost matrix c
+// using the Hungarian algorithm with node potentials. If c has no more
+// rows than columns, each row is assigned a distinct column; otherwise
+// each column is assigned a distinct row. The assignment minimizes the
+// sum of the costs of the assigned entries. Entries of c may be +Inf to
+// forbid an assignment.
+//
+// The returned assign holds the column assigned to each row, with -1 for
+// unassigned rows, and cost is the total cost of the assignment. If no
+// assignment of finite cost exists, Assignment returns ErrNoAssignment.
+//
+// The time complexity of Assignment is O(n^2.m) where n and m are the
+// smaller and larger dimensions of c.
+//
+// Assignment will panic if c contains a NaN or -Inf value.
+func Assignment(c mat.Matrix) (assign []int, cost float64, err error) {
+	r, k := c.Dims()
+	at := c.At
+	if r > k {
+		r, k = k, r
+		at = func(i, j int) float64 { return c.At(j, i) }
+	}
+	cols, err := hungarian(r, k, at)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, _ := c.Dims()
+	assign = make([]int, rows)
+	if rows == r {
+		copy(assign, cols)
+		for i, j := range assign {
+			cost += c.At(i, j)
+		}
+		return assign, cost, nil
+	}
+	for i := range assign {
+		assign[i] = -1
+	}
+	for j, i := range cols {
+		assign[i] = j
+		cost += c.At(i, j)
+	}
+	return assign, cost, nil
+}
+
+// Hungarian returns a minimum weight matching of the bipartite graph with
+// node partitions u and v whose edges are the edges of g from u to v. The
+// weight of each edge is given by g. Every node of the smaller of u and v is
+// matched. The From node of each returned edge is in u, and cost is the sum
+// of the weights of the returned edges. If no such matching exists,
+// Hungarian returns ErrNoAssignment.
+//
+// Hungarian will panic if an edge weight is NaN or -Inf.
+func Hungarian(g graph.Weighted, u, v []graph.Node) (matching []graph.WeightedEdge, cost float64, err error) {
+	if len(u) == 0 || len(v) == 0 {
+		return nil, 0, nil
+	}
+	c := mat.NewDense(len(u), len(v), nil)
+	for i, n := range u {
+		for j, m := range v {
+			w := math.Inf(1)
+			if e := g.WeightedEdge(n.ID(), m.ID()); e != nil {
+				w = e.Weight()
+			}
+			c.Set(i, j, w)
+		}
+	}
+	assign, cost, err := Assignment(c)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i, j := range assign {
+		if j >= 0 {
+			matching = append(matching, simple.WeightedEdge{F: u[i], T: v[j], W: c.At(i, j)})
+		}
+	}
+	return matching, cost, nil
+}
+
+// hungarian returns the minimum cost assignment of each of the n rows of
+// the cost matrix given by at to a distinct one of the m columns, with
+// n <= m. The returned slice holds the column assigned to each row.
+func hungarian(n, m int, at func(i, j int) float64) ([]int, error) {
+	// The implementation follows the shortest augmenting path
+	// formulation with row and column potentials, u and v, and
+	// 1-based indexing so that column 0 is a dummy column.
+	u := make([]float64, n+1)
+	v := make([]float64, m+1)
+	p := make([]int, m+1)   // p[j] is the row assigned to column j.
+	way := make([]int, m+1) // way[j] is the previous column on the path to j.
+	minv := make([]float64, m+1)
+	used := make([]bool, m+1)
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		for j := range minv {
+			minv[j] = math.Inf(1)
+			used[j] = false
+		}
+		for {
+			used[j0] = true
+			i0 := p[j0]
+			delta := math.Inf(1)
+			j1 := -1
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				c := at(i0-1, j-1)
+				if math.IsNaN(c) || math.IsInf(c, -1) {
+					panic("matching: invalid cost")
+				}
+				if cur := c - u[i0] - v[j]; cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			if j1 < 0 {
+				return nil, ErrNoAssignment
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assign := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if p[j] != 0 {
+			assign[p[j]-1] = j - 1
+		}
+	}
+	return assign, nil
+}
@@ -0,0 +1,37 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/graph/matching"
+	"gonum.org/v1/gonum/mat"
+)
+
+func ExampleAssignment() {
+	// Assign three workers to three jobs where each
+	// row gives the time taken by a worker for each job.
+	times := mat.NewDense(3, 3, []float64{
+		9, 2, 7,
+		6, 4, 3,
+		5, 8, 1,
+	})
+	assign, total, err := matching.Assignment(times)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for worker, job := range assign {
+		fmt.Printf("worker %d does job %d\n", worker, job)
+	}
+	fmt.Printf("total time: %v\n", total)
+
+	// Output:
+	// worker 0 does job 1
+	// worker 1 does job 0
+	// worker 2 does job 2
+	// total time: 9
+}
@@ -0,0 +1,218 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+var inf = math.Inf(1)
+
+var assignmentTests = []struct {
+	name string
+	cost *mat.Dense
+	want float64
+	err  error
+}{
+	{
+		name: "square",
+		cost: mat.NewDense(3, 3, []float64{
+			4, 1, 3,
+			2, 0, 5,
+			3, 2, 2,
+		}),
+		want: 5,
+	},
+	{
+		name: "wide",
+		cost: mat.NewDense(2, 4, []float64{
+			7, 3, 9, 1,
+			2, 8, 1, 6,
+		}),
+		want: 2,
+	},
+	{
+		name: "tall",
+		cost: mat.NewDense(3, 2, []float64{
+			7, 3,
+			1, 8,
+			2, 2,
+		}),
+		want: 3,
+	},
+	{
+		name: "negative",
+		cost: mat.NewDense(2, 2, []float64{
+			-5, -1,
+			-2, -3,
+		}),
+		want: -8,
+	},
+	{
+		name: "forbidden",
+		cost: mat.NewDense(3, 3, []float64{
+			1, inf, inf,
+			inf, inf, 1,
+			2, 1, inf,
+		}),
+		want: 3,
+	},
+	{
+		name: "infeasible",
+		cost: mat.NewDense(2, 3, []float64{
+			1, inf, inf,
+			2, inf, inf,
+		}),
+		err: ErrNoAssignment,
+	},
+}
+
+func TestAssignment(t *testing.T) {
+	t.Parallel()
+	for _, test := range assignmentTests {
+		assign, cost, err := Assignment(test.cost)
+		if err != test.err {
+			t.Errorf("unexpected error for %s: got:%v want:%v", test.name, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(cost, test.want, 1e-12, 1e-12) {
+			t.Errorf("unexpected cost for %s: got:%v want:%v", test.name, cost, test.want)
+		}
+		checkAssignment(t, test.name, test.cost, assign, cost)
+	}
+}
+
+func TestAssignmentRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 100; i++ {
+		r := 1 + rnd.IntN(6)
+		c := 1 + rnd.IntN(6)
+		cost := mat.NewDense(r, c, nil)
+		for j := 0; j < r; j++ {
+			for k := 0; k < c; k++ {
+				cost.Set(j, k, math.Round(100*rnd.NormFloat64())/10)
+			}
+		}
+		name := fmt.Sprintf("random matrix %d", i)
+		assign, got, err := Assignment(cost)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", name, err)
+			continue
+		}
+		want := bruteAssignment(cost, 0, make([]bool, max(r, c)))
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+			t.Errorf("unexpected cost for %s: got:%v want:%v", name, got, want)
+		}
+		checkAssignment(t, name, cost, assign, got)
+	}
+}
+
+func TestHungarian(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, inf)
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(10), W: 4},
+		{F: simple.Node(0), T: simple.Node(11), W: 1},
+		{F: simple.Node(1), T: simple.Node(10), W: 2},
+		{F: simple.Node(1), T: simple.Node(12), W: 5},
+		{F: simple.Node(2), T: simple.Node(11), W: 2},
+		{F: simple.Node(2), T: simple.Node(12), W: 2},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	u := []graph.Node{simple.Node(0), simple.Node(1), simple.Node(2)}
+	v := []graph.Node{simple.Node(10), simple.Node(11), simple.Node(12)}
+	got, cost, err := Hungarian(g, u, v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = 5
+	if cost != want {
+		t.Errorf("unexpected cost: got:%v want:%v", cost, want)
+	}
+	var sum float64
+	edges := make([]graph.Edge, len(got))
+	for i, e := range got {
+		sum += e.Weight()
+		edges[i] = e
+	}
+	if sum != cost {
+		t.Errorf("mismatched edge weight sum: got:%v want:%v", sum, cost)
+	}
+	if len(got) != len(u) {
+		t.Errorf("unexpected matching size: got:%d want:%d", len(got), len(u))
+	}
+	checkMatching(t, "Hungarian", g, edges)
+
+	g.RemoveEdge(0, 11)
+	g.RemoveEdge(2, 11)
+	_, _, err = Hungarian(g, u, v)
+	if err != ErrNoAssignment {
+		t.Errorf("unexpected error for infeasible assignment: got:%v want:%v", err, ErrNoAssignment)
+	}
+}
+
+// checkAssignment checks that assign is a complete assignment for the
+// cost matrix c with total cost.
+func checkAssignment(t *testing.T, name string, c mat.Matrix, assign []int, cost float64) {
+	t.Helper()
+	r, k := c.Dims()
+	if len(assign) != r {
+		t.Errorf("unexpected assignment length for %s: got:%d want:%d", name, len(assign), r)
+		return
+	}
+	used := make(map[int]bool)
+	var sum float64
+	for i, j := range assign {
+		if j < 0 {
+			continue
+		}
+		if used[j] {
+			t.Errorf("column %d assigned more than once for %s", j, name)
+		}
+		used[j] = true
+		sum += c.At(i, j)
+	}
+	if len(used) != min(r, k) {
+		t.Errorf("incomplete assignment for %s: got:%d want:%d", name, len(used), min(r, k))
+	}
+	if !scalar.EqualWithinAbsOrRel(sum, cost, 1e-12, 1e-12) {
+		t.Errorf("mismatched assignment cost for %s: got:%v want:%v", name, cost, sum)
+	}
+}
+
+// bruteAssignment returns the minimum cost of a complete assignment of
+// the rows of c from row i onward by exhaustive search.
+func bruteAssignment(c mat.Matrix, i int, used []bool) float64 {
+	r, k := c.Dims()
+	if r > k {
+		return bruteAssignment(c.T(), i, used)
+	}
+	if i == r {
+		return 0
+	}
+	best := math.Inf(1)
+	for j := 0; j < k; j++ {
+		if used[j] {
+			continue
+		}
+		used[j] = true
+		best = math.Min(best, c.At(i, j)+bruteAssignment(c, i+1, used))
+		used[j] = false
+	}
+	return best
+}
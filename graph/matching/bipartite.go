@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// ErrNotBipartite is returned by HopcroftKarp when the graph
+// is not bipartite.
+var ErrNotBipartite = errors.New("matching: graph is not bipartite")
+
+// Bipartition returns a partition of the nodes of g into two sets, u and v,
+// such that every edge of g joins a node in u to a node in v. Within each
+// connected component, the node first returned by g.Nodes is placed in u.
+// If g is not bipartite, ok is false and u and v are nil.
+func Bipartition(g graph.Undirected) (u, v []graph.Node, ok bool) {
+	side := make(map[int64]int)
+	nodes := g.Nodes()
+	for nodes.Next() {
+		n := nodes.Node()
+		if _, seen := side[n.ID()]; seen {
+			continue
+		}
+		side[n.ID()] = 0
+		u = append(u, n)
+		queue := []graph.Node{n}
+		for len(queue) != 0 {
+			x := queue[0]
+			queue = queue[1:]
+			xid := x.ID()
+			to := g.From(xid)
+			for to.Next() {
+				y := to.Node()
+				yid := y.ID()
+				s, seen := side[yid]
+				if !seen {
+					s = 1 - side[xid]
+					side[yid] = s
+					if s == 0 {
+						u = append(u, y)
+					} else {
+						v = append(v, y)
+					}
+					queue = append(queue, y)
+					continue
+				}
+				if s == side[xid] {
+					return nil, nil, false
+				}
+			}
+		}
+	}
+	return u, v, true
+}
+
+// HopcroftKarp returns a maximum cardinality matching of the bipartite
+// graph g using the algorithm of Hopcroft and Karp. The From node of each
+// returned edge is in the u partition returned by Bipartition.
+//
+// The time complexity of HopcroftKarp is O(|E|.sqrt(|V|)).
+//
+// If g is not bipartite, HopcroftKarp returns ErrNotBipartite.
+func HopcroftKarp(g graph.Undirected) ([]graph.Edge, error) {
+	u, v, ok := Bipartition(g)
+	if !ok {
+		return nil, ErrNotBipartite
+	}
+	indexOf := make(map[int64]int, len(v))
+	for j, n := range v {
+		indexOf[n.ID()] = j
+	}
+	adj := make([][]int, len(u))
+	for i, n := range u {
+		to := g.From(n.ID())
+		for to.Next() {
+			adj[i] = append(adj[i], indexOf[to.Node().ID()])
+		}
+	}
+
+	m := hopcroftKarp{
+		adj:   adj,
+		mateU: make([]int, len(u)),
+		mateV: make([]int, len(v)),
+		dist:  make([]int, len(u)),
+	}
+	for i := range m.mateU {
+		m.mateU[i] = -1
+	}
+	for j := range m.mateV {
+		m.mateV[j] = -1
+	}
+	for m.layers() {
+		for i, j := range m.mateU {
+			if j < 0 {
+				m.augment(i)
+			}
+		}
+	}
+
+	var matching []graph.Edge
+	for i, j := range m.mateU {
+		if j >= 0 {
+			matching = append(matching, simple.Edge{F: u[i], T: v[j]})
+		}
+	}
+	return matching, nil
+}
+
+// hopcroftKarp holds the state of a Hopcroft-Karp matching
+// between the u and v node partitions.
+type hopcroftKarp struct {
+	adj   [][]int // adj[i] holds the v neighbours of u node i.
+	mateU []int   // mateU[i] is the v node matched to u node i or -1.
+	mateV []int   // mateV[j] is the u node matched to v node j or -1.
+	dist  []int   // dist[i] is the layer of u node i.
+}
+
+// layers performs a breadth first search from the free u nodes along
+// alternating paths, recording the layer of each u node in dist. The
+// search stops at the first layer adjacent to a free v node. It returns
+// whether a free v node was reached.
+func (m *hopcroftKarp) layers() bool {
+	var queue []int
+	for i, j := range m.mateU {
+		if j < 0 {
+			m.dist[i] = 0
+			queue = append(queue, i)
+		} else {
+			m.dist[i] = math.MaxInt
+		}
+	}
+	limit := math.MaxInt
+	for len(queue) != 0 {
+		i := queue[0]
+		queue = queue[1:]
+		if m.dist[i] > limit {
+			break
+		}
+		for _, j := range m.adj[i] {
+			k := m.mateV[j]
+			if k < 0 {
+				limit = m.dist[i]
+			} else if m.dist[k] == math.MaxInt {
+				m.dist[k] = m.dist[i] + 1
+				queue = append(queue, k)
+			}
+		}
+	}
+	return limit != math.MaxInt
+}
+
+// augment searches for a shortest augmenting path from u node i through
+// the layers, flipping the matching along the path if one is found. It
+// returns whether an augmenting path was found.
+func (m *hopcroftKarp) augment(i int) bool {
+	for _, j := range m.adj[i] {
+		k := m.mateV[j]
+		if k < 0 || (m.dist[k] == m.dist[i]+1 && m.augment(k)) {
+			m.mateU[i] = j
+			m.mateV[j] = i
+			return true
+		}
+	}
+	// Remove i from the layered graph for this phase.
+	m.dist[i] = math.MaxInt
+	return false
+}
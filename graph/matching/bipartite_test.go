@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var hopcroftKarpTests = []struct {
+	name  string
+	edges []simple.Edge
+	nodes int
+	want  int
+	err   error
+}{
+	{
+		name:  "empty",
+		nodes: 3,
+		want:  0,
+	},
+	{
+		name: "path",
+		edges: []simple.Edge{
+			{F: simple.Node(0), T: simple.Node(1)},
+			{F: simple.Node(1), T: simple.Node(2)},
+			{F: simple.Node(2), T: simple.Node(3)},
+		},
+		want: 2,
+	},
+	{
+		name: "star",
+		edges: []simple.Edge{
+			{F: simple.Node(0), T: simple.Node(1)},
+			{F: simple.Node(0), T: simple.Node(2)},
+			{F: simple.Node(0), T: simple.Node(3)},
+		},
+		want: 1,
+	},
+	{
+		// A greedy matching of 0-4 and 1-5 blocks 2 and 3
+		// unless the matching is augmented.
+		name: "augmenting",
+		edges: []simple.Edge{
+			{F: simple.Node(0), T: simple.Node(4)},
+			{F: simple.Node(0), T: simple.Node(5)},
+			{F: simple.Node(1), T: simple.Node(4)},
+			{F: simple.Node(1), T: simple.Node(6)},
+			{F: simple.Node(2), T: simple.Node(5)},
+			{F: simple.Node(3), T: simple.Node(6)},
+			{F: simple.Node(3), T: simple.Node(7)},
+		},
+		want: 4,
+	},
+	{
+		name: "triangle",
+		edges: []simple.Edge{
+			{F: simple.Node(0), T: simple.Node(1)},
+			{F: simple.Node(1), T: simple.Node(2)},
+			{F: simple.Node(2), T: simple.Node(0)},
+		},
+		err: ErrNotBipartite,
+	},
+}
+
+func TestHopcroftKarp(t *testing.T) {
+	t.Parallel()
+	for _, test := range hopcroftKarpTests {
+		g := simple.NewUndirectedGraph()
+		for i := 0; i < test.nodes; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for _, e := range test.edges {
+			g.SetEdge(e)
+		}
+		got, err := HopcroftKarp(g)
+		if err != test.err {
+			t.Errorf("unexpected error for %s: got:%v want:%v", test.name, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if len(got) != test.want {
+			t.Errorf("unexpected matching size for %s: got:%d want:%d", test.name, len(got), test.want)
+		}
+		checkMatching(t, test.name, g, got)
+	}
+}
+
+func TestHopcroftKarpRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 100; i++ {
+		nu := 1 + rnd.IntN(7)
+		nv := 1 + rnd.IntN(7)
+		g := simple.NewUndirectedGraph()
+		for j := 0; j < nu+nv; j++ {
+			g.AddNode(simple.Node(j))
+		}
+		for a := 0; a < nu; a++ {
+			for b := nu; b < nu+nv; b++ {
+				if rnd.Float64() < 0.3 {
+					g.SetEdge(simple.Edge{F: simple.Node(a), T: simple.Node(b)})
+				}
+			}
+		}
+		name := fmt.Sprintf("random graph %d", i)
+		got, err := HopcroftKarp(g)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", name, err)
+			continue
+		}
+		want := bruteMaxMatching(g, 0, nu, make(map[int64]bool))
+		if len(got) != want {
+			t.Errorf("unexpected matching size for %s: got:%d want:%d", name, len(got), want)
+		}
+		checkMatching(t, name, g, got)
+	}
+}
+
+// checkMatching checks that the edges in m are edges of g
+// and that no two edges share a node.
+func checkMatching(t *testing.T, name string, g graph.Graph, m []graph.Edge) {
+	t.Helper()
+	seen := make(map[int64]bool)
+	for _, e := range m {
+		uid, vid := e.From().ID(), e.To().ID()
+		if !g.HasEdgeBetween(uid, vid) {
+			t.Errorf("matched edge %d-%d not in graph for %s", uid, vid, name)
+		}
+		if seen[uid] || seen[vid] {
+			t.Errorf("node matched more than once by edge %d-%d for %s", uid, vid, name)
+		}
+		seen[uid] = true
+		seen[vid] = true
+	}
+}
+
+// bruteMaxMatching returns the size of a maximum matching of the nodes
+// with IDs in [i, n) to unused nodes by exhaustive search.
+func bruteMaxMatching(g graph.Graph, i, n int, used map[int64]bool) int {
+	if i == n {
+		return 0
+	}
+	best := bruteMaxMatching(g, i+1, n, used)
+	to := graph.NodesOf(g.From(int64(i)))
+	for _, v := range to {
+		if used[v.ID()] {
+			continue
+		}
+		used[v.ID()] = true
+		best = max(best, 1+bruteMaxMatching(g, i+1, n, used))
+		used[v.ID()] = false
+	}
+	return best
+}
@@ -0,0 +1,696 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// MaxCardinality returns a maximum cardinality matching of the general
+// graph g using Edmonds' blossom algorithm. The matching is perfect if
+// it has half as many edges as g has nodes.
+//
+// The time complexity of MaxCardinality is O(|V|^3).
+func MaxCardinality(g graph.Undirected) []graph.Edge {
+	b := newBlossom(g, func(uid, vid int64) float64 { return 1 })
+	b.solve(true)
+	matching := make([]graph.Edge, 0, len(b.nodes)/2)
+	for v, p := range b.mate {
+		if p >= 0 && v < b.endpoint[p] {
+			matching = append(matching, simple.Edge{F: b.nodes[v], T: b.nodes[b.endpoint[p]]})
+		}
+	}
+	return matching
+}
+
+// MaxWeight returns a maximum weight matching of the general graph g and
+// its total weight using the primal-dual formulation of Edmonds' blossom
+// algorithm described by Galil in "Efficient algorithms for finding
+// maximum matching in graphs", ACM Computing Surveys 18(1):23-38 (1986).
+// If maxCardinality is true, the returned matching has maximum weight
+// among the matchings of maximum cardinality. Edges with non-positive
+// weight are only included in the matching when needed to maximize
+// cardinality.
+//
+// The result is exact when all edge weights are integers of moderate
+// magnitude. Otherwise floating point rounding may cause a matching of
+// slightly less than maximum weight to be returned.
+//
+// The time complexity of MaxWeight is O(|V|^3).
+func MaxWeight(g graph.WeightedUndirected, maxCardinality bool) (matching []graph.WeightedEdge, weight float64) {
+	b := newBlossom(g, func(uid, vid int64) float64 {
+		w, ok := g.Weight(uid, vid)
+		if !ok {
+			panic("matching: unexpected invalid weight")
+		}
+		return w
+	})
+	b.solve(maxCardinality)
+	for v, p := range b.mate {
+		if p >= 0 && v < b.endpoint[p] {
+			w := b.edges[p/2].w
+			matching = append(matching, simple.WeightedEdge{F: b.nodes[v], T: b.nodes[b.endpoint[p]], W: w})
+			weight += w
+		}
+	}
+	return matching, weight
+}
+
+// blossomEdge is an edge between vertices i and j with weight w.
+type blossomEdge struct {
+	i, j int
+	w    float64
+}
+
+// blossom holds the state of a maximum weight matching calculation.
+//
+// Vertices are numbered [0, n) and blossoms [n, 2n). Edge k has the two
+// endpoints 2k and 2k+1 where endpoint[2k] is edges[k].i and endpoint[2k+1]
+// is edges[k].j, so endpoint p is on the opposite side of the edge to
+// endpoint p^1.
+type blossom struct {
+	nodes []graph.Node
+	edges []blossomEdge
+	n     int
+
+	endpoint []int   // endpoint[p] is the vertex at endpoint p.
+	neighEnd [][]int // neighEnd[v] holds the remote endpoints of edges at v.
+
+	// mate[v] is the remote endpoint of the matched edge
+	// at vertex v, or -1 if v is single.
+	mate []int
+
+	// label is the label of each vertex and top-level blossom:
+	// 0 is unlabeled, 1 is an S-vertex or blossom and 2 is a
+	// T-vertex or blossom. labelEnd is the remote endpoint of
+	// the edge through which the label was assigned, or -1.
+	label    []int
+	labelEnd []int
+
+	inBlossom       []int     // inBlossom[v] is the top-level blossom containing v.
+	blossomParent   []int     // blossomParent[b] is the immediate parent of b, or -1.
+	blossomChilds   [][]int   // blossomChilds[b] holds the sub-blossoms of b in cycle order.
+	blossomBase     []int     // blossomBase[b] is the base vertex of b, or -1 if unused.
+	blossomEndps    [][]int   // blossomEndps[b][i] connects blossomChilds[b][i] and the next child.
+	bestEdge        []int     // bestEdge[b] is the least-slack edge to an S-blossom, or -1.
+	blossomBestEdge [][]int   // blossomBestEdge[b] holds the least-slack edges to neighbouring S-blossoms.
+	unusedBlossoms  []int     // unusedBlossoms holds the free blossom indices.
+	dual            []float64 // dual holds the dual variables of vertices and blossoms.
+	allowEdge       []bool    // allowEdge[k] is whether edge k has zero slack.
+
+	queue []int
+}
+
+// newBlossom returns the initial blossom state for g with edge
+// weights given by weight.
+func newBlossom(g graph.Graph, weight func(uid, vid int64) float64) *blossom {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	var edges []blossomEdge
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			j := indexOf[vid]
+			if i < j {
+				edges = append(edges, blossomEdge{i: i, j: j, w: weight(uid, vid)})
+			}
+		}
+	}
+
+	n := len(nodes)
+	b := &blossom{
+		nodes: nodes,
+		edges: edges,
+		n:     n,
+
+		endpoint: make([]int, 2*len(edges)),
+		neighEnd: make([][]int, n),
+
+		mate:     make([]int, n),
+		label:    make([]int, 2*n),
+		labelEnd: make([]int, 2*n),
+
+		inBlossom:       make([]int, n),
+		blossomParent:   make([]int, 2*n),
+		blossomChilds:   make([][]int, 2*n),
+		blossomBase:     make([]int, 2*n),
+		blossomEndps:    make([][]int, 2*n),
+		bestEdge:        make([]int, 2*n),
+		blossomBestEdge: make([][]int, 2*n),
+		dual:            make([]float64, 2*n),
+		allowEdge:       make([]bool, len(edges)),
+	}
+	var maxWeight float64
+	for k, e := range edges {
+		b.endpoint[2*k] = e.i
+		b.endpoint[2*k+1] = e.j
+		b.neighEnd[e.i] = append(b.neighEnd[e.i], 2*k+1)
+		b.neighEnd[e.j] = append(b.neighEnd[e.j], 2*k)
+		maxWeight = max(maxWeight, e.w)
+	}
+	for v := 0; v < n; v++ {
+		b.mate[v] = -1
+		b.inBlossom[v] = v
+		b.blossomBase[v] = v
+		b.blossomBase[n+v] = -1
+		b.dual[v] = maxWeight
+		b.unusedBlossoms = append(b.unusedBlossoms, n+v)
+	}
+	for i := range b.labelEnd {
+		b.labelEnd[i] = -1
+		b.blossomParent[i] = -1
+		b.bestEdge[i] = -1
+	}
+	return b
+}
+
+// slack returns twice the slack of edge k.
+func (b *blossom) slack(k int) float64 {
+	e := b.edges[k]
+	return b.dual[e.i] + b.dual[e.j] - 2*e.w
+}
+
+// leaves appends the vertices contained in blossom t to dst.
+func (b *blossom) leaves(dst []int, t int) []int {
+	if t < b.n {
+		return append(dst, t)
+	}
+	for _, c := range b.blossomChilds[t] {
+		dst = b.leaves(dst, c)
+	}
+	return dst
+}
+
+// assignLabel assigns label t to the top-level blossom containing vertex w
+// through the edge with remote endpoint p.
+func (b *blossom) assignLabel(w, t, p int) {
+	bw := b.inBlossom[w]
+	b.label[w], b.label[bw] = t, t
+	b.labelEnd[w], b.labelEnd[bw] = p, p
+	b.bestEdge[w], b.bestEdge[bw] = -1, -1
+	switch t {
+	case 1:
+		b.queue = b.leaves(b.queue, bw)
+	case 2:
+		// The base of a T-blossom is matched; label its mate S.
+		base := b.blossomBase[bw]
+		b.assignLabel(b.endpoint[b.mate[base]], 1, b.mate[base]^1)
+	}
+}
+
+// scanBlossom traces back from vertices v and w to discover either a new
+// blossom, returning its base, or an augmenting path, returning -1.
+func (b *blossom) scanBlossom(v, w int) int {
+	var path []int
+	base := -1
+	for v != -1 || w != -1 {
+		bv := b.inBlossom[v]
+		if b.label[bv]&4 != 0 {
+			base = b.blossomBase[bv]
+			break
+		}
+		path = append(path, bv)
+		b.label[bv] = 5
+		if b.labelEnd[bv] == -1 {
+			// The base of blossom bv is single.
+			v = -1
+		} else {
+			v = b.endpoint[b.labelEnd[bv]]
+			bv = b.inBlossom[v]
+			v = b.endpoint[b.labelEnd[bv]]
+		}
+		if w != -1 {
+			v, w = w, v
+		}
+	}
+	for _, bv := range path {
+		b.label[bv] = 1
+	}
+	return base
+}
+
+// addBlossom constructs a new blossom with the given base through the
+// S-vertices at the ends of edge k.
+func (b *blossom) addBlossom(base, k int) {
+	v, w := b.edges[k].i, b.edges[k].j
+	bb := b.inBlossom[base]
+	bv := b.inBlossom[v]
+	bw := b.inBlossom[w]
+
+	nb := b.unusedBlossoms[len(b.unusedBlossoms)-1]
+	b.unusedBlossoms = b.unusedBlossoms[:len(b.unusedBlossoms)-1]
+	b.blossomBase[nb] = base
+	b.blossomParent[nb] = -1
+	b.blossomParent[bb] = nb
+
+	// Trace back from v to base.
+	var path, endps []int
+	for bv != bb {
+		b.blossomParent[bv] = nb
+		path = append(path, bv)
+		endps = append(endps, b.labelEnd[bv])
+		v = b.endpoint[b.labelEnd[bv]]
+		bv = b.inBlossom[v]
+	}
+	path = append(path, bb)
+	slices.Reverse(path)
+	slices.Reverse(endps)
+	endps = append(endps, 2*k)
+	// Trace back from w to base.
+	for bw != bb {
+		b.blossomParent[bw] = nb
+		path = append(path, bw)
+		endps = append(endps, b.labelEnd[bw]^1)
+		w = b.endpoint[b.labelEnd[bw]]
+		bw = b.inBlossom[w]
+	}
+	b.blossomChilds[nb] = path
+	b.blossomEndps[nb] = endps
+
+	b.label[nb] = 1
+	b.labelEnd[nb] = b.labelEnd[bb]
+	b.dual[nb] = 0
+	for _, v := range b.leaves(nil, nb) {
+		if b.label[b.inBlossom[v]] == 2 {
+			// Former T-vertices become S-vertices.
+			b.queue = append(b.queue, v)
+		}
+		b.inBlossom[v] = nb
+	}
+
+	// Compute the least-slack edges to neighbouring S-blossoms.
+	bestEdgeTo := make([]int, 2*b.n)
+	for i := range bestEdgeTo {
+		bestEdgeTo[i] = -1
+	}
+	for _, bv := range path {
+		var lists [][]int
+		if b.blossomBestEdge[bv] == nil {
+			for _, v := range b.leaves(nil, bv) {
+				list := make([]int, len(b.neighEnd[v]))
+				for i, p := range b.neighEnd[v] {
+					list[i] = p / 2
+				}
+				lists = append(lists, list)
+			}
+		} else {
+			lists = [][]int{b.blossomBestEdge[bv]}
+		}
+		for _, list := range lists {
+			for _, k := range list {
+				j := b.edges[k].j
+				if b.inBlossom[j] == nb {
+					j = b.edges[k].i
+				}
+				bj := b.inBlossom[j]
+				if bj != nb && b.label[bj] == 1 && (bestEdgeTo[bj] == -1 || b.slack(k) < b.slack(bestEdgeTo[bj])) {
+					bestEdgeTo[bj] = k
+				}
+			}
+		}
+		b.blossomBestEdge[bv] = nil
+		b.bestEdge[bv] = -1
+	}
+	var best []int
+	for _, k := range bestEdgeTo {
+		if k != -1 {
+			best = append(best, k)
+		}
+	}
+	b.blossomBestEdge[nb] = best
+	b.bestEdge[nb] = -1
+	for _, k := range best {
+		if b.bestEdge[nb] == -1 || b.slack(k) < b.slack(b.bestEdge[nb]) {
+			b.bestEdge[nb] = k
+		}
+	}
+}
+
+// expandBlossom expands the top-level blossom t into its sub-blossoms.
+// If endStage is true, sub-blossoms with zero dual are also expanded.
+func (b *blossom) expandBlossom(t int, endStage bool) {
+	for _, s := range b.blossomChilds[t] {
+		b.blossomParent[s] = -1
+		switch {
+		case s < b.n:
+			b.inBlossom[s] = s
+		case endStage && b.dual[s] == 0:
+			b.expandBlossom(s, endStage)
+		default:
+			for _, v := range b.leaves(nil, s) {
+				b.inBlossom[v] = s
+			}
+		}
+	}
+
+	// If a T-blossom is expanded mid-stage, its sub-blossoms
+	// must be relabeled.
+	if !endStage && b.label[t] == 2 {
+		childs := b.blossomChilds[t]
+		endps := b.blossomEndps[t]
+		entryChild := b.inBlossom[b.endpoint[b.labelEnd[t]^1]]
+		j := slices.Index(childs, entryChild)
+		var jStep, endpTrick int
+		if j&1 != 0 {
+			// Go forward and wrap around.
+			j -= len(childs)
+			jStep = 1
+		} else {
+			// Go backward.
+			jStep = -1
+			endpTrick = 1
+		}
+		at := func(s []int, i int) int {
+			if i < 0 {
+				i += len(s)
+			}
+			return s[i]
+		}
+
+		// Move along the blossom until reaching the base.
+		p := b.labelEnd[t]
+		for j != 0 {
+			// Relabel the T-sub-blossom.
+			b.label[b.endpoint[p^1]] = 0
+			b.label[b.endpoint[at(endps, j-endpTrick)^endpTrick^1]] = 0
+			b.assignLabel(b.endpoint[p^1], 2, p)
+			// Step to the next S-sub-blossom and note its
+			// forward endpoint.
+			b.allowEdge[at(endps, j-endpTrick)/2] = true
+			j += jStep
+			p = at(endps, j-endpTrick) ^ endpTrick
+			// Step to the next T-sub-blossom.
+			b.allowEdge[p/2] = true
+			j += jStep
+		}
+		// Relabel the base T-sub-blossom without stepping
+		// through to its mate.
+		bv := at(childs, j)
+		b.label[b.endpoint[p^1]], b.label[bv] = 2, 2
+		b.labelEnd[b.endpoint[p^1]], b.labelEnd[bv] = p, p
+		b.bestEdge[bv] = -1
+		// Continue along the blossom until returning to the
+		// entry child, labeling vertices reachable from T.
+		j += jStep
+		for at(childs, j) != entryChild {
+			bv := at(childs, j)
+			if b.label[bv] == 1 {
+				j += jStep
+				continue
+			}
+			v := -1
+			for _, u := range b.leaves(nil, bv) {
+				if b.label[u] != 0 {
+					v = u
+					break
+				}
+			}
+			if v != -1 {
+				b.label[v] = 0
+				b.label[b.endpoint[b.mate[b.blossomBase[bv]]]] = 0
+				b.assignLabel(v, 2, b.labelEnd[v])
+			}
+			j += jStep
+		}
+	}
+
+	// Recycle the blossom.
+	b.label[t], b.labelEnd[t] = -1, -1
+	b.blossomChilds[t], b.blossomEndps[t] = nil, nil
+	b.blossomBase[t] = -1
+	b.blossomBestEdge[t] = nil
+	b.bestEdge[t] = -1
+	b.unusedBlossoms = append(b.unusedBlossoms, t)
+}
+
+// augmentBlossom swaps matched and unmatched edges over an alternating
+// path through blossom t between vertex v and the base vertex.
+func (b *blossom) augmentBlossom(t, v int) {
+	// Find the immediate sub-blossom of t containing v.
+	s := v
+	for b.blossomParent[s] != t {
+		s = b.blossomParent[s]
+	}
+	if s >= b.n {
+		b.augmentBlossom(s, v)
+	}
+
+	childs := b.blossomChilds[t]
+	endps := b.blossomEndps[t]
+	at := func(x []int, i int) int {
+		if i < 0 {
+			i += len(x)
+		}
+		return x[i]
+	}
+	i := slices.Index(childs, s)
+	j := i
+	var jStep, endpTrick int
+	if i&1 != 0 {
+		j -= len(childs)
+		jStep = 1
+	} else {
+		jStep = -1
+		endpTrick = 1
+	}
+	for j != 0 {
+		j += jStep
+		s = at(childs, j)
+		p := at(endps, j-endpTrick) ^ endpTrick
+		if s >= b.n {
+			b.augmentBlossom(s, b.endpoint[p])
+		}
+		j += jStep
+		s = at(childs, j)
+		if s >= b.n {
+			b.augmentBlossom(s, b.endpoint[p^1])
+		}
+		b.mate[b.endpoint[p]] = p ^ 1
+		b.mate[b.endpoint[p^1]] = p
+	}
+
+	// Rotate the sub-blossoms so the new base is first.
+	b.blossomChilds[t] = append(childs[i:len(childs):len(childs)], childs[:i]...)
+	b.blossomEndps[t] = append(endps[i:len(endps):len(endps)], endps[:i]...)
+	b.blossomBase[t] = b.blossomBase[b.blossomChilds[t][0]]
+}
+
+// augmentMatching swaps matched and unmatched edges over the augmenting
+// path through edge k.
+func (b *blossom) augmentMatching(k int) {
+	e := b.edges[k]
+	for _, sp := range [2][2]int{{e.i, 2*k + 1}, {e.j, 2 * k}} {
+		s, p := sp[0], sp[1]
+		for {
+			bs := b.inBlossom[s]
+			if bs >= b.n {
+				b.augmentBlossom(bs, s)
+			}
+			b.mate[s] = p
+			if b.labelEnd[bs] == -1 {
+				// Reached a single vertex.
+				break
+			}
+			t := b.endpoint[b.labelEnd[bs]]
+			bt := b.inBlossom[t]
+			s = b.endpoint[b.labelEnd[bt]]
+			j := b.endpoint[b.labelEnd[bt]^1]
+			if bt >= b.n {
+				b.augmentBlossom(bt, j)
+			}
+			b.mate[j] = b.labelEnd[bt]
+			p = b.labelEnd[bt] ^ 1
+		}
+	}
+}
+
+// solve computes the matching, leaving the result in mate.
+func (b *blossom) solve(maxCardinality bool) {
+	n := b.n
+	// Each stage finds an augmenting path and
+	// increases the size of the matching by one.
+	for range n {
+		for i := range b.label {
+			b.label[i] = 0
+			b.bestEdge[i] = -1
+		}
+		for i := n; i < 2*n; i++ {
+			b.blossomBestEdge[i] = nil
+		}
+		for i := range b.allowEdge {
+			b.allowEdge[i] = false
+		}
+		b.queue = b.queue[:0]
+		for v := 0; v < n; v++ {
+			if b.mate[v] == -1 && b.label[b.inBlossom[v]] == 0 {
+				b.assignLabel(v, 1, -1)
+			}
+		}
+
+		augmented := false
+		for {
+			// Grow the alternating trees from the S-vertices.
+			for len(b.queue) != 0 && !augmented {
+				v := b.queue[len(b.queue)-1]
+				b.queue = b.queue[:len(b.queue)-1]
+				for _, p := range b.neighEnd[v] {
+					k := p / 2
+					w := b.endpoint[p]
+					if b.inBlossom[v] == b.inBlossom[w] {
+						continue
+					}
+					var kSlack float64
+					if !b.allowEdge[k] {
+						kSlack = b.slack(k)
+						if kSlack <= 0 {
+							b.allowEdge[k] = true
+						}
+					}
+					switch {
+					case b.allowEdge[k]:
+						switch {
+						case b.label[b.inBlossom[w]] == 0:
+							b.assignLabel(w, 2, p^1)
+						case b.label[b.inBlossom[w]] == 1:
+							base := b.scanBlossom(v, w)
+							if base >= 0 {
+								b.addBlossom(base, k)
+							} else {
+								b.augmentMatching(k)
+								augmented = true
+							}
+						case b.label[w] == 0:
+							// w is inside a T-blossom but has
+							// not been reached from S yet.
+							b.label[w] = 2
+							b.labelEnd[w] = p ^ 1
+						}
+					case b.label[b.inBlossom[w]] == 1:
+						bv := b.inBlossom[v]
+						if b.bestEdge[bv] == -1 || kSlack < b.slack(b.bestEdge[bv]) {
+							b.bestEdge[bv] = k
+						}
+					case b.label[w] == 0:
+						if b.bestEdge[w] == -1 || kSlack < b.slack(b.bestEdge[w]) {
+							b.bestEdge[w] = k
+						}
+					}
+					if augmented {
+						break
+					}
+				}
+			}
+			if augmented {
+				break
+			}
+
+			// No augmenting path was found, so update the
+			// dual variables to create new tight edges.
+			deltaType := -1
+			var delta float64
+			var deltaEdge, deltaBlossom int
+			if !maxCardinality {
+				// Delta type 1: the minimum vertex dual.
+				deltaType = 1
+				delta = slices.Min(b.dual[:n])
+			}
+			for v := 0; v < n; v++ {
+				// Delta type 2: the minimum slack of edges between
+				// S-vertices and unlabeled vertices.
+				if b.label[b.inBlossom[v]] == 0 && b.bestEdge[v] != -1 {
+					d := b.slack(b.bestEdge[v])
+					if deltaType == -1 || d < delta {
+						delta = d
+						deltaType = 2
+						deltaEdge = b.bestEdge[v]
+					}
+				}
+			}
+			for t := 0; t < 2*n; t++ {
+				// Delta type 3: half the minimum slack of edges
+				// between S-blossoms.
+				if b.blossomParent[t] == -1 && b.label[t] == 1 && b.bestEdge[t] != -1 {
+					d := b.slack(b.bestEdge[t]) / 2
+					if deltaType == -1 || d < delta {
+						delta = d
+						deltaType = 3
+						deltaEdge = b.bestEdge[t]
+					}
+				}
+			}
+			for t := n; t < 2*n; t++ {
+				// Delta type 4: the minimum dual of T-blossoms.
+				if b.blossomBase[t] >= 0 && b.blossomParent[t] == -1 && b.label[t] == 2 && (deltaType == -1 || b.dual[t] < delta) {
+					delta = b.dual[t]
+					deltaType = 4
+					deltaBlossom = t
+				}
+			}
+			if deltaType == -1 {
+				// No further improvement is possible with
+				// maximum cardinality, so terminate the
+				// stage with a final dual update.
+				deltaType = 1
+				delta = max(0, slices.Min(b.dual[:n]))
+			}
+
+			for v := 0; v < n; v++ {
+				switch b.label[b.inBlossom[v]] {
+				case 1:
+					b.dual[v] -= delta
+				case 2:
+					b.dual[v] += delta
+				}
+			}
+			for t := n; t < 2*n; t++ {
+				if b.blossomBase[t] >= 0 && b.blossomParent[t] == -1 {
+					switch b.label[t] {
+					case 1:
+						b.dual[t] += delta
+					case 2:
+						b.dual[t] -= delta
+					}
+				}
+			}
+
+			if deltaType == 1 {
+				// The optimum has been reached.
+				break
+			}
+			switch deltaType {
+			case 2:
+				b.allowEdge[deltaEdge] = true
+				i := b.edges[deltaEdge].i
+				if b.label[b.inBlossom[i]] == 0 {
+					i = b.edges[deltaEdge].j
+				}
+				b.queue = append(b.queue, i)
+			case 3:
+				b.allowEdge[deltaEdge] = true
+				b.queue = append(b.queue, b.edges[deltaEdge].i)
+			case 4:
+				b.expandBlossom(deltaBlossom, false)
+			}
+		}
+		if !augmented {
+			break
+		}
+
+		// Expand S-blossoms with zero dual at the end of the stage.
+		for t := n; t < 2*n; t++ {
+			if b.blossomParent[t] == -1 && b.blossomBase[t] >= 0 && b.label[t] == 1 && b.dual[t] == 0 {
+				b.expandBlossom(t, true)
+			}
+		}
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var maxWeightTests = []struct {
+	name           string
+	edges          []simple.WeightedEdge
+	maxCardinality bool
+
+	wantWeight float64
+	wantSize   int
+}{
+	{
+		name: "single edge",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+		},
+		wantWeight: 1,
+		wantSize:   1,
+	},
+	{
+		name: "path",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 10},
+			{F: simple.Node(1), T: simple.Node(2), W: 11},
+			{F: simple.Node(2), T: simple.Node(3), W: 10},
+		},
+		wantWeight: 20,
+		wantSize:   2,
+	},
+	{
+		name: "negative weight",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 2},
+			{F: simple.Node(0), T: simple.Node(2), W: -2},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(1), T: simple.Node(3), W: -1},
+			{F: simple.Node(2), T: simple.Node(3), W: -6},
+		},
+		wantWeight: 2,
+		wantSize:   1,
+	},
+	{
+		name: "negative weight max cardinality",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 2},
+			{F: simple.Node(0), T: simple.Node(2), W: -2},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(1), T: simple.Node(3), W: -1},
+			{F: simple.Node(2), T: simple.Node(3), W: -6},
+		},
+		maxCardinality: true,
+		wantWeight:     -3,
+		wantSize:       2,
+	},
+	{
+		// An S-blossom is created and then used for augmentation.
+		name: "S-blossom",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 8},
+			{F: simple.Node(0), T: simple.Node(2), W: 9},
+			{F: simple.Node(1), T: simple.Node(2), W: 10},
+			{F: simple.Node(2), T: simple.Node(3), W: 7},
+			{F: simple.Node(0), T: simple.Node(5), W: 5},
+			{F: simple.Node(3), T: simple.Node(4), W: 6},
+		},
+		wantWeight: 21,
+		wantSize:   3,
+	},
+	{
+		// A nested S-blossom is created and then used for augmentation.
+		name: "nested S-blossom",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 9},
+			{F: simple.Node(0), T: simple.Node(2), W: 9},
+			{F: simple.Node(1), T: simple.Node(2), W: 10},
+			{F: simple.Node(1), T: simple.Node(3), W: 8},
+			{F: simple.Node(2), T: simple.Node(4), W: 8},
+			{F: simple.Node(3), T: simple.Node(4), W: 10},
+			{F: simple.Node(4), T: simple.Node(5), W: 6},
+		},
+		wantWeight: 23,
+		wantSize:   3,
+	},
+	{
+		// A T-blossom is created and expanded.
+		name: "T-blossom expansion",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 23},
+			{F: simple.Node(0), T: simple.Node(4), W: 22},
+			{F: simple.Node(0), T: simple.Node(5), W: 15},
+			{F: simple.Node(1), T: simple.Node(2), W: 25},
+			{F: simple.Node(2), T: simple.Node(3), W: 22},
+			{F: simple.Node(3), T: simple.Node(4), W: 25},
+			{F: simple.Node(3), T: simple.Node(7), W: 14},
+			{F: simple.Node(4), T: simple.Node(6), W: 13},
+		},
+		wantWeight: 67,
+		wantSize:   4,
+	},
+}
+
+func TestMaxWeight(t *testing.T) {
+	t.Parallel()
+	for _, test := range maxWeightTests {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		got, weight := MaxWeight(g, test.maxCardinality)
+		if weight != test.wantWeight {
+			t.Errorf("unexpected matching weight for %s: got:%v want:%v", test.name, weight, test.wantWeight)
+		}
+		if len(got) != test.wantSize {
+			t.Errorf("unexpected matching size for %s: got:%d want:%d", test.name, len(got), test.wantSize)
+		}
+		checkWeightedMatching(t, test.name, g, got, weight)
+	}
+}
+
+func TestMaxWeightRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 200; i++ {
+		n := 2 + rnd.IntN(8)
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for j := 0; j < n; j++ {
+			g.AddNode(simple.Node(j))
+		}
+		for u := 0; u < n; u++ {
+			for v := u + 1; v < n; v++ {
+				if rnd.Float64() < 0.5 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(rnd.IntN(20) - 4)})
+				}
+			}
+		}
+		for _, maxCard := range []bool{false, true} {
+			name := fmt.Sprintf("random graph %d maxCardinality=%t", i, maxCard)
+			got, weight := MaxWeight(g, maxCard)
+			wantSize, wantWeight := bruteMaxWeight(g, n, make([]bool, n), maxCard)
+			if weight != wantWeight {
+				t.Errorf("unexpected matching weight for %s: got:%v want:%v", name, weight, wantWeight)
+			}
+			if maxCard && len(got) != wantSize {
+				t.Errorf("unexpected matching size for %s: got:%d want:%d", name, len(got), wantSize)
+			}
+			checkWeightedMatching(t, name, g, got, weight)
+		}
+	}
+}
+
+func TestMaxCardinality(t *testing.T) {
+	t.Parallel()
+
+	// The Petersen graph has a perfect matching.
+	petersen := simple.NewUndirectedGraph()
+	for i := 0; i < 5; i++ {
+		petersen.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node((i + 1) % 5)})
+		petersen.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 5)})
+		petersen.SetEdge(simple.Edge{F: simple.Node(i + 5), T: simple.Node((i+2)%5 + 5)})
+	}
+	got := MaxCardinality(petersen)
+	if len(got) != 5 {
+		t.Errorf("unexpected matching size for Petersen graph: got:%d want:5", len(got))
+	}
+	checkMatching(t, "Petersen graph", petersen, got)
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 100; i++ {
+		n := 2 + rnd.IntN(9)
+		g := simple.NewUndirectedGraph()
+		err := gen.Gnp(g, n, 0.3, rnd)
+		if err != nil {
+			t.Fatalf("unexpected error generating graph: %v", err)
+		}
+		name := fmt.Sprintf("random graph %d", i)
+		got := MaxCardinality(g)
+		want := bruteMaxCardinality(g)
+		if len(got) != want {
+			t.Errorf("unexpected matching size for %s: got:%d want:%d", name, len(got), want)
+		}
+		checkMatching(t, name, g, got)
+
+		if _, _, ok := Bipartition(g); ok {
+			hk, err := HopcroftKarp(g)
+			if err != nil {
+				t.Errorf("unexpected error for %s: %v", name, err)
+			}
+			if len(hk) != len(got) {
+				t.Errorf("mismatched matching size with Hopcroft-Karp for %s: got:%d want:%d", name, len(got), len(hk))
+			}
+		}
+	}
+}
+
+// checkWeightedMatching checks that m is a matching in g with total weight.
+func checkWeightedMatching(t *testing.T, name string, g graph.Weighted, m []graph.WeightedEdge, weight float64) {
+	t.Helper()
+	edges := make([]graph.Edge, len(m))
+	var sum float64
+	for i, e := range m {
+		edges[i] = e
+		w, _ := g.Weight(e.From().ID(), e.To().ID())
+		if w != e.Weight() {
+			t.Errorf("mismatched edge weight for %s on edge %d-%d: got:%v want:%v", name, e.From().ID(), e.To().ID(), e.Weight(), w)
+		}
+		sum += w
+	}
+	if sum != weight {
+		t.Errorf("mismatched matching weight for %s: got:%v want:%v", name, weight, sum)
+	}
+	checkMatching(t, name, g, edges)
+}
+
+// bruteMaxWeight returns the size and weight of a maximum weight matching
+// of the unused nodes of g, which are numbered 0 to n-1, by exhaustive
+// search. If maxCard is true, only maximum cardinality matchings are
+// considered.
+func bruteMaxWeight(g graph.WeightedUndirected, n int, used []bool, maxCard bool) (size int, weight float64) {
+	u := 0
+	for u < n && used[u] {
+		u++
+	}
+	if u == n {
+		return 0, 0
+	}
+	used[u] = true
+	size, weight = bruteMaxWeight(g, n, used, maxCard)
+	for _, v := range graph.NodesOf(g.From(int64(u))) {
+		vid := v.ID()
+		if used[vid] {
+			continue
+		}
+		used[vid] = true
+		s, w := bruteMaxWeight(g, n, used, maxCard)
+		used[vid] = false
+		w += g.WeightedEdge(int64(u), vid).Weight()
+		s++
+		if (maxCard && (s > size || (s == size && w > weight))) || (!maxCard && w > weight) {
+			size, weight = s, w
+		}
+	}
+	used[u] = false
+	return size, weight
+}
+
+// bruteMaxCardinality returns the size of a maximum matching of g
+// by exhaustive search.
+func bruteMaxCardinality(g graph.Undirected) int {
+	nodes := graph.NodesOf(g.Nodes())
+	used := make(map[int64]bool)
+	var search func(i int) int
+	search = func(i int) int {
+		for i < len(nodes) && used[nodes[i].ID()] {
+			i++
+		}
+		if i == len(nodes) {
+			return 0
+		}
+		uid := nodes[i].ID()
+		used[uid] = true
+		best := search(i + 1)
+		for _, v := range graph.NodesOf(g.From(uid)) {
+			if used[v.ID()] {
+				continue
+			}
+			used[v.ID()] = true
+			best = max(best, 1+search(i+1))
+			used[v.ID()] = false
+		}
+		used[uid] = false
+		return best
+	}
+	return search(0)
+}
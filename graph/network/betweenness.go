@@ -17,7 +17,8 @@ import (
 //	C_B(v) = \sum_{s ≠ v ≠ t ∈ V} (\sigma_{st}(v) / \sigma_{st})
 //
 // where \sigma_{st} and \sigma_{st}(v) are the number of shortest paths from s to t,
-// and the subset of those paths containing v respectively.
+// and the subset of those paths containing v respectively. If g is a
+// graph.Multigraph, paths through distinct parallel lines are distinct.
 func Betweenness(g graph.Graph) map[int64]float64 {
 	// Brandes' algorithm for finding betweenness centrality for nodes in
 	// and unweighted graph:
@@ -33,7 +34,7 @@ func Betweenness(g graph.Graph) map[int64]float64 {
 	// http://wwwold.iit.cnr.it/staff/marco.pellegrini/papiri/asonam-final.pdf
 
 	cb := make(map[int64]float64)
-	brandes(g, func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64) {
+	brandes(g, nil, func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64) {
 		for stack.Len() != 0 {
 			w := stack.Pop()
 			for _, v := range p[w.ID()] {
@@ -58,7 +59,9 @@ func Betweenness(g graph.Graph) map[int64]float64 {
 // to t, and the subset of those paths containing e, respectively.
 //
 // If g is undirected, edges are retained such that u.ID < v.ID where u and v are
-// the nodes of e.
+// the nodes of e. If g is a graph.Multigraph, paths through distinct parallel
+// lines are distinct and the centrality of an edge is the sum of the
+// centralities of its lines.
 func EdgeBetweenness(g graph.Graph) map[[2]int64]float64 {
 	// Modified from Brandes' original algorithm as described in Algorithm 7
 	// with the exception that node betweenness is not calculated:
@@ -67,7 +70,7 @@ func EdgeBetweenness(g graph.Graph) map[[2]int64]float64 {
 
 	_, isUndirected := g.(graph.Undirected)
 	cb := make(map[[2]int64]float64)
-	brandes(g, func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64) {
+	brandes(g, nil, func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64) {
 		for stack.Len() != 0 {
 			w := stack.Pop()
 			for _, v := range p[w.ID()] {
@@ -87,8 +90,10 @@ func EdgeBetweenness(g graph.Graph) map[[2]int64]float64 {
 
 // brandes is the common code for Betweenness and EdgeBetweenness. It corresponds
 // to algorithm 1 in http://algo.uni-konstanz.de/publications/b-vspbc-08.pdf with
-// the accumulation loop provided by the accumulate closure.
-func brandes(g graph.Graph, accumulate func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64)) {
+// the accumulation loop provided by the accumulate closure. If sources is nil,
+// all nodes of g are used as sources. If g is a graph.Multigraph, predecessors
+// appear in p once for each line joining them to the node.
+func brandes(g graph.Graph, sources []graph.Node, accumulate func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64)) {
 	var (
 		nodes = graph.NodesOf(g.Nodes())
 		stack linear.NodeStack
@@ -97,8 +102,12 @@ func brandes(g graph.Graph, accumulate func(s graph.Node, stack linear.NodeStack
 		d     = make(map[int64]int, len(nodes))
 		delta = make(map[int64]float64, len(nodes))
 		queue linear.NodeQueue
+		lines = multiplicityFor(g)
 	)
-	for _, s := range nodes {
+	if sources == nil {
+		sources = nodes
+	}
+	for _, s := range sources {
 		stack = stack[:0]
 
 		for _, w := range nodes {
@@ -128,8 +137,13 @@ func brandes(g graph.Graph, accumulate func(s graph.Node, stack linear.NodeStack
 				}
 				// shortest path to w via v?
 				if d[wid] == d[vid]+1 {
-					sigma[wid] += sigma[vid]
-					p[wid] = append(p[wid], v)
+					// Each line from v to w in a multigraph
+					// is on a distinct shortest path.
+					m := lines(vid, wid)
+					sigma[wid] += float64(m) * sigma[vid]
+					for range m {
+						p[wid] = append(p[wid], v)
+					}
 				}
 			}
 		}
@@ -0,0 +1,155 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var katzTests = []struct {
+	name  string
+	g     graph.Graph
+	alpha float64
+	beta  float64
+
+	want map[int64]float64
+}{
+	{
+		name: "undirected star",
+		g: func() graph.Graph {
+			g := simple.NewUndirectedGraph()
+			for i := 1; i <= 4; i++ {
+				g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(i)})
+			}
+			return g
+		}(),
+		alpha: 0.1,
+		beta:  1,
+		want: map[int64]float64{
+			0: 1.4 / 0.96,
+			1: 1 + 0.14/0.96,
+			2: 1 + 0.14/0.96,
+			3: 1 + 0.14/0.96,
+			4: 1 + 0.14/0.96,
+		},
+	},
+	{
+		name: "directed path",
+		g: func() graph.Graph {
+			g := simple.NewDirectedGraph()
+			g.SetEdge(simple.Edge{F: simple.Node(A), T: simple.Node(B)})
+			g.SetEdge(simple.Edge{F: simple.Node(B), T: simple.Node(C)})
+			return g
+		}(),
+		alpha: 0.5,
+		beta:  2,
+		want: map[int64]float64{
+			A: 2,
+			B: 2 + 0.5*2,
+			C: 2 + 0.5*3,
+		},
+	},
+	{
+		name: "weighted directed path",
+		g: func() graph.Graph {
+			g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(A), T: simple.Node(B), W: 2})
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(B), T: simple.Node(C), W: 3})
+			return g
+		}(),
+		alpha: 0.1,
+		beta:  1,
+		want: map[int64]float64{
+			A: 1,
+			B: 1 + 0.1*2*1,
+			C: 1 + 0.1*3*1.2,
+		},
+	},
+	{
+		name:  "empty",
+		g:     simple.NewUndirectedGraph(),
+		alpha: 0.1,
+		beta:  1,
+		want:  map[int64]float64{},
+	},
+}
+
+func TestKatz(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	for _, test := range katzTests {
+		got, err := Katz(test.g, test.alpha, test.beta)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		checkCentrality(t, "Katz", test.name, got, test.want, tol)
+
+		got, err = KatzSparse(test.g, test.alpha, test.beta, 1e-14)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		checkCentrality(t, "KatzSparse", test.name, got, test.want, tol)
+	}
+}
+
+func TestKatzSparseRandom(t *testing.T) {
+	t.Parallel()
+	for i, test := range sampledCentralityTests {
+		g := sampledCentralityGraph(t, test.directed, test.n, test.p, uint64(i))
+		// The spectral radius of a graph is bounded by its maximum degree.
+		var maxDeg int
+		for _, n := range graph.NodesOf(g.Nodes()) {
+			maxDeg = max(maxDeg, g.From(n.ID()).Len())
+		}
+		alpha := 0.9 / float64(maxDeg)
+
+		want, err := Katz(g, alpha, 1)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		got, err := KatzSparse(g, alpha, 1, 1e-12)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		checkCentrality(t, "KatzSparse", test.name, got, want, 1e-10)
+	}
+}
+
+func TestKatzSparseDiverged(t *testing.T) {
+	t.Parallel()
+	// The spectral radius of K_4 is 3.
+	g := simple.NewUndirectedGraph()
+	for u := 0; u < 4; u++ {
+		for v := u + 1; v < 4; v++ {
+			g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+		}
+	}
+	_, err := KatzSparse(g, 0.5, 1, 1e-10)
+	if err != ErrKatzDiverged {
+		t.Errorf("unexpected error: got:%v want:%v", err, ErrKatzDiverged)
+	}
+}
+
+// checkCentrality checks that the centrality values got match want.
+func checkCentrality(t *testing.T, fn, name string, got, want map[int64]float64, tol float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("unexpected number of %s values for %s: got:%d want:%d", fn, name, len(got), len(want))
+	}
+	for id, w := range want {
+		if !scalar.EqualWithinAbsOrRel(got[id], w, tol, tol) {
+			t.Errorf("unexpected %s value for %s node %d: got:%v want:%v", fn, name, id, got[id], w)
+		}
+	}
+}
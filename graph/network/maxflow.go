@@ -0,0 +1,443 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// MaxFlow is the result of a maximum s-t flow calculation. Edge capacities
+// of the flow network are given by the edge weights of the analyzed graph.
+type MaxFlow struct {
+	// Value is the value of the maximum flow
+	// from the source to the sink.
+	Value float64
+
+	source, sink graph.Node
+
+	r residual
+}
+
+// Source returns the source node of the flow.
+func (f *MaxFlow) Source() graph.Node { return f.source }
+
+// Sink returns the sink node of the flow.
+func (f *MaxFlow) Sink() graph.Node { return f.sink }
+
+// Flow returns the flow along the edge from the node with ID uid to the
+// node with ID vid. If no such edge exists in the analyzed graph, Flow
+// returns zero.
+func (f *MaxFlow) Flow(uid, vid int64) float64 {
+	return f.r.edgeFlow(uid, vid)
+}
+
+// Edges returns all edges of the analyzed graph carrying a non-zero flow.
+// The weight of each returned edge is the flow along the edge.
+func (f *MaxFlow) Edges() []graph.WeightedEdge {
+	return f.r.flowEdges()
+}
+
+// MinCut returns a minimum s-t cut induced by the maximum flow. The nodes
+// in source are the nodes reachable from the source in the residual network
+// and the returned edges are the edges of the analyzed graph from the source
+// partition to the sink partition. The sum of capacities of the cut edges
+// is equal to the value of the maximum flow. The weight of each returned
+// edge is its capacity.
+func (f *MaxFlow) MinCut() (cut []graph.WeightedEdge, source []graph.Node) {
+	reach := make([]bool, len(f.r.nodes))
+	s := f.r.indexOf[f.source.ID()]
+	reach[s] = true
+	queue := []int{s}
+	for len(queue) != 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, a := range f.r.adj[u] {
+			v := f.r.to[a]
+			if !reach[v] && f.r.cap[a] > 0 {
+				reach[v] = true
+				queue = append(queue, v)
+			}
+		}
+	}
+	for u, ok := range reach {
+		if ok {
+			source = append(source, f.r.nodes[u])
+		}
+	}
+	for a := 0; a < 2*f.r.edges; a += 2 {
+		u := f.r.to[a+1]
+		v := f.r.to[a]
+		if reach[u] && !reach[v] {
+			cut = append(cut, simple.WeightedEdge{
+				F: f.r.nodes[u],
+				T: f.r.nodes[v],
+				W: f.r.orig[a/2],
+			})
+		}
+	}
+	return cut, source
+}
+
+// residual is a residual flow network. Arcs are stored in pairs
+// such that arc a^1 is the reversal of arc a. Even numbered arcs
+// correspond to forward arcs, and the first edges pairs of arcs
+// correspond to edges of the original graph. Nodes with indices
+// not less than len(nodes) are auxiliary nodes.
+type residual struct {
+	nodes   []graph.Node
+	indexOf map[int64]int
+	edges   int
+
+	adj  [][]int   // adj[u] holds the arcs leaving u.
+	to   []int     // to[a] is the head of arc a.
+	cap  []float64 // cap[a] is the residual capacity of arc a.
+	orig []float64 // orig[a/2] is the capacity of arc a.
+	cost []float64 // cost[a] is the unit cost of flow along arc a.
+}
+
+// newResidual returns the residual network for g with all flows zero.
+// newResidual will panic if any edge of g has a negative or NaN capacity.
+func newResidual(g graph.WeightedDirected) residual {
+	return newCostResidual(g, func(uid, vid int64) (capacity, cost float64) {
+		c, ok := g.Weight(uid, vid)
+		if !ok {
+			panic("network: unexpected invalid weight")
+		}
+		return c, 0
+	}, 0)
+}
+
+// newCostResidual returns the residual network for g with all flows zero
+// and edge capacities and costs given by fn. The network is allocated
+// with an additional aux auxiliary nodes.
+// newCostResidual will panic if any edge of g has a negative or NaN
+// capacity.
+func newCostResidual(g graph.Directed, fn CapacityCost, aux int) residual {
+	nodes := graph.NodesOf(g.Nodes())
+	r := residual{
+		nodes:   nodes,
+		indexOf: make(map[int64]int, len(nodes)),
+		adj:     make([][]int, len(nodes)+aux),
+	}
+	for i, n := range nodes {
+		r.indexOf[n.ID()] = i
+	}
+	for u, n := range nodes {
+		uid := n.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			c, cost := fn(uid, vid)
+			if !(c >= 0) {
+				panic("network: negative or NaN edge capacity")
+			}
+			r.addArc(u, r.indexOf[vid], c, cost)
+		}
+	}
+	r.edges = len(r.orig)
+	return r
+}
+
+// addArc adds an arc from u to v with the given capacity and cost
+// and its reversal to the network.
+func (r *residual) addArc(u, v int, capacity, cost float64) {
+	a := len(r.to)
+	r.to = append(r.to, v, u)
+	r.cap = append(r.cap, capacity, 0)
+	r.orig = append(r.orig, capacity)
+	r.cost = append(r.cost, cost, -cost)
+	r.adj[u] = append(r.adj[u], a)
+	r.adj[v] = append(r.adj[v], a+1)
+}
+
+// edgeFlow returns the flow along the edge from the node with ID uid
+// to the node with ID vid.
+func (r *residual) edgeFlow(uid, vid int64) float64 {
+	u, ok := r.indexOf[uid]
+	if !ok {
+		return 0
+	}
+	v, ok := r.indexOf[vid]
+	if !ok {
+		return 0
+	}
+	var flow float64
+	for _, a := range r.adj[u] {
+		if a%2 == 0 && a < 2*r.edges && r.to[a] == v {
+			flow += r.flow(a)
+		}
+	}
+	return flow
+}
+
+// flowEdges returns the edges of the original graph carrying a
+// non-zero flow, weighted by the flow.
+func (r *residual) flowEdges() []graph.WeightedEdge {
+	var edges []graph.WeightedEdge
+	for a := 0; a < 2*r.edges; a += 2 {
+		flow := r.flow(a)
+		if flow == 0 {
+			continue
+		}
+		edges = append(edges, simple.WeightedEdge{
+			F: r.nodes[r.to[a+1]],
+			T: r.nodes[r.to[a]],
+			W: flow,
+		})
+	}
+	return edges
+}
+
+// flow returns the flow along the original arc a.
+func (r *residual) flow(a int) float64 {
+	return r.cap[a^1]
+}
+
+// push moves d units of flow along arc a.
+func (r *residual) push(a int, d float64) {
+	r.cap[a] -= d
+	r.cap[a^1] += d
+}
+
+// terminals returns the indices of the source and sink nodes, panicking
+// if either is not in the network or if they are the same node.
+func (r *residual) terminals(s, t graph.Node) (int, int) {
+	si, ok := r.indexOf[s.ID()]
+	if !ok {
+		panic("network: source not in graph")
+	}
+	ti, ok := r.indexOf[t.ID()]
+	if !ok {
+		panic("network: sink not in graph")
+	}
+	if si == ti {
+		panic("network: source and sink are the same node")
+	}
+	return si, ti
+}
+
+// MaxFlowEdmondsKarp returns the maximum flow from s to t in g using the
+// Edmonds-Karp algorithm, augmenting the flow along shortest paths of the
+// residual network. The capacity of each edge is given by its weight.
+//
+// The time complexity of MaxFlowEdmondsKarp is O(|V|.|E|^2).
+//
+// MaxFlowEdmondsKarp will panic if s or t is not in g, if s and t are the
+// same node, or if any edge capacity is negative or NaN.
+func MaxFlowEdmondsKarp(g graph.WeightedDirected, s, t graph.Node) *MaxFlow {
+	r := newResidual(g)
+	si, ti := r.terminals(s, t)
+
+	// via[v] is the arc by which v was reached in the
+	// breadth first search, or -1 if v was not reached.
+	via := make([]int, len(r.nodes))
+	var value float64
+	for {
+		for i := range via {
+			via[i] = -1
+		}
+		queue := []int{si}
+		for len(queue) != 0 && via[ti] < 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, a := range r.adj[u] {
+				v := r.to[a]
+				if v != si && via[v] < 0 && r.cap[a] > 0 {
+					via[v] = a
+					queue = append(queue, v)
+				}
+			}
+		}
+		if via[ti] < 0 {
+			break
+		}
+
+		// Find the bottleneck of the path and augment.
+		d := math.Inf(1)
+		for v := ti; v != si; v = r.to[via[v]^1] {
+			d = math.Min(d, r.cap[via[v]])
+		}
+		for v := ti; v != si; v = r.to[via[v]^1] {
+			r.push(via[v], d)
+		}
+		value += d
+	}
+	return &MaxFlow{Value: value, source: s, sink: t, r: r}
+}
+
+// MaxFlowDinic returns the maximum flow from s to t in g using Dinic's
+// blocking flow algorithm. The capacity of each edge is given by its weight.
+//
+// The time complexity of MaxFlowDinic is O(|V|^2.|E|).
+//
+// MaxFlowDinic will panic if s or t is not in g, if s and t are the same
+// node, or if any edge capacity is negative or NaN.
+func MaxFlowDinic(g graph.WeightedDirected, s, t graph.Node) *MaxFlow {
+	r := newResidual(g)
+	si, ti := r.terminals(s, t)
+
+	level := make([]int, len(r.nodes))
+	next := make([]int, len(r.nodes))
+	var value float64
+	for r.levels(level, si, ti) {
+		for i := range next {
+			next[i] = 0
+		}
+		for {
+			d := r.blockingPath(level, next, si, ti, math.Inf(1))
+			if d == 0 {
+				break
+			}
+			value += d
+		}
+	}
+	return &MaxFlow{Value: value, source: s, sink: t, r: r}
+}
+
+// levels performs a breadth first search of the residual network from
+// s, recording the distance of each node from s in level. It returns
+// whether t is reachable from s.
+func (r *residual) levels(level []int, s, t int) bool {
+	for i := range level {
+		level[i] = -1
+	}
+	level[s] = 0
+	queue := []int{s}
+	for len(queue) != 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, a := range r.adj[u] {
+			v := r.to[a]
+			if level[v] < 0 && r.cap[a] > 0 {
+				level[v] = level[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+	return level[t] >= 0
+}
+
+// blockingPath finds an augmenting path from u to t in the level graph
+// with bottleneck at most limit, pushing flow along the path and returning
+// the amount pushed.
+func (r *residual) blockingPath(level, next []int, u, t int, limit float64) float64 {
+	if u == t {
+		return limit
+	}
+	for ; next[u] < len(r.adj[u]); next[u]++ {
+		a := r.adj[u][next[u]]
+		v := r.to[a]
+		if r.cap[a] <= 0 || level[v] != level[u]+1 {
+			continue
+		}
+		d := r.blockingPath(level, next, v, t, math.Min(limit, r.cap[a]))
+		if d > 0 {
+			r.push(a, d)
+			return d
+		}
+	}
+	return 0
+}
+
+// MaxFlowPushRelabel returns the maximum flow from s to t in g using the
+// FIFO push-relabel algorithm of Goldberg and Tarjan with the gap
+// heuristic. The capacity of each edge is given by its weight.
+//
+// The time complexity of MaxFlowPushRelabel is O(|V|^3).
+//
+// MaxFlowPushRelabel will panic if s or t is not in g, if s and t are the
+// same node, or if any edge capacity is negative or NaN.
+func MaxFlowPushRelabel(g graph.WeightedDirected, s, t graph.Node) *MaxFlow {
+	r := newResidual(g)
+	si, ti := r.terminals(s, t)
+
+	n := len(r.nodes)
+	height := make([]int, n)
+	count := make([]int, 2*n+1)
+	excess := make([]float64, n)
+	next := make([]int, n)
+	active := make([]bool, n)
+	var queue []int
+
+	height[si] = n
+	count[0] = n - 1
+	count[n] = 1
+	for _, a := range r.adj[si] {
+		c := r.cap[a]
+		if c == 0 {
+			continue
+		}
+		v := r.to[a]
+		r.push(a, c)
+		excess[v] += c
+		excess[si] -= c
+		if v != ti && !active[v] {
+			active[v] = true
+			queue = append(queue, v)
+		}
+	}
+
+	for len(queue) != 0 {
+		u := queue[0]
+		queue = queue[1:]
+		active[u] = false
+
+		// Discharge u.
+		for excess[u] > 0 {
+			if next[u] == len(r.adj[u]) {
+				// Relabel u.
+				old := height[u]
+				h := 2 * n
+				for _, a := range r.adj[u] {
+					if r.cap[a] > 0 {
+						h = min(h, height[r.to[a]]+1)
+					}
+				}
+				count[old]--
+				height[u] = h
+				count[h]++
+				next[u] = 0
+
+				// Gap heuristic: if no nodes remain at the old
+				// height, nodes above it below n cannot reach the
+				// sink and may be lifted to above the source.
+				if count[old] == 0 && old < n {
+					for v := range height {
+						if old < height[v] && height[v] < n {
+							count[height[v]]--
+							height[v] = n + 1
+							count[n+1]++
+							next[v] = 0
+						}
+					}
+				}
+				continue
+			}
+			a := r.adj[u][next[u]]
+			v := r.to[a]
+			if r.cap[a] > 0 && height[u] == height[v]+1 {
+				d := math.Min(excess[u], r.cap[a])
+				r.push(a, d)
+				excess[u] -= d
+				excess[v] += d
+				if v != si && v != ti && !active[v] {
+					active[v] = true
+					queue = append(queue, v)
+				}
+				if excess[u] == 0 {
+					break
+				}
+			}
+			next[u]++
+		}
+	}
+	return &MaxFlow{Value: excess[ti], source: s, sink: t, r: r}
+}
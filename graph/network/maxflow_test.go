@@ -0,0 +1,203 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var maxFlowFuncs = []struct {
+	name string
+	fn   func(graph.WeightedDirected, graph.Node, graph.Node) *MaxFlow
+}{
+	{name: "EdmondsKarp", fn: MaxFlowEdmondsKarp},
+	{name: "Dinic", fn: MaxFlowDinic},
+	{name: "PushRelabel", fn: MaxFlowPushRelabel},
+}
+
+var maxFlowTests = []struct {
+	name  string
+	edges []simple.WeightedEdge
+	s, t  int64
+	want  float64
+}{
+	{
+		// Example from Cormen et al., Introduction to Algorithms, figure 26.1.
+		name: "CLRS",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 16},
+			{F: simple.Node(0), T: simple.Node(2), W: 13},
+			{F: simple.Node(1), T: simple.Node(3), W: 12},
+			{F: simple.Node(2), T: simple.Node(1), W: 4},
+			{F: simple.Node(2), T: simple.Node(4), W: 14},
+			{F: simple.Node(3), T: simple.Node(2), W: 9},
+			{F: simple.Node(3), T: simple.Node(5), W: 20},
+			{F: simple.Node(4), T: simple.Node(3), W: 7},
+			{F: simple.Node(4), T: simple.Node(5), W: 4},
+		},
+		s: 0, t: 5,
+		want: 23,
+	},
+	{
+		name: "antiparallel",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 3},
+			{F: simple.Node(1), T: simple.Node(0), W: 2},
+			{F: simple.Node(1), T: simple.Node(2), W: 5},
+			{F: simple.Node(2), T: simple.Node(1), W: 1},
+		},
+		s: 0, t: 2,
+		want: 3,
+	},
+	{
+		name: "disconnected",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 3},
+			{F: simple.Node(2), T: simple.Node(3), W: 5},
+		},
+		s: 0, t: 3,
+		want: 0,
+	},
+	{
+		name: "fractional",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 0.5},
+			{F: simple.Node(0), T: simple.Node(2), W: 0.25},
+			{F: simple.Node(1), T: simple.Node(3), W: 0.125},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+		},
+		s: 0, t: 3,
+		want: 0.75,
+	},
+}
+
+func TestMaxFlow(t *testing.T) {
+	t.Parallel()
+	for _, test := range maxFlowTests {
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		for _, f := range maxFlowFuncs {
+			got := f.fn(g, g.Node(test.s), g.Node(test.t))
+			if !scalar.EqualWithinAbsOrRel(got.Value, test.want, 1e-12, 1e-12) {
+				t.Errorf("unexpected flow value for %s with %s: got:%v want:%v", test.name, f.name, got.Value, test.want)
+			}
+			checkFlow(t, fmt.Sprintf("%s with %s", test.name, f.name), g, got)
+		}
+	}
+}
+
+func TestMaxFlowRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 50; i++ {
+		const n = 8
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for j := 0; j < n; j++ {
+			g.AddNode(simple.Node(j))
+		}
+		for u := 0; u < n; u++ {
+			for v := 0; v < n; v++ {
+				if u != v && rnd.Float64() < 0.4 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(rnd.IntN(10))})
+				}
+			}
+		}
+		want := bruteMinCut(g, 0, n-1)
+		for _, f := range maxFlowFuncs {
+			got := f.fn(g, g.Node(0), g.Node(n-1))
+			name := fmt.Sprintf("random graph %d with %s", i, f.name)
+			if !scalar.EqualWithinAbsOrRel(got.Value, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected flow value for %s: got:%v want:%v", name, got.Value, want)
+			}
+			checkFlow(t, name, g, got)
+		}
+	}
+}
+
+// checkFlow checks that f is a feasible flow in g with value f.Value
+// and that its minimum cut has capacity equal to the flow value.
+func checkFlow(t *testing.T, name string, g *simple.WeightedDirectedGraph, f *MaxFlow) {
+	t.Helper()
+	const tol = 1e-12
+
+	net := make(map[int64]float64)
+	for _, e := range f.Edges() {
+		uid, vid := e.From().ID(), e.To().ID()
+		c, _ := g.Weight(uid, vid)
+		if e.Weight() < 0 || e.Weight() > c+tol {
+			t.Errorf("capacity constraint violated for %s on edge %d->%d: flow:%v capacity:%v", name, uid, vid, e.Weight(), c)
+		}
+		if got := f.Flow(uid, vid); got != e.Weight() {
+			t.Errorf("mismatched edge flow for %s on edge %d->%d: got:%v want:%v", name, uid, vid, got, e.Weight())
+		}
+		net[uid] -= e.Weight()
+		net[vid] += e.Weight()
+	}
+	sid, tid := f.Source().ID(), f.Sink().ID()
+	for id, v := range net {
+		switch id {
+		case sid:
+			v = -v
+			fallthrough
+		case tid:
+			if !scalar.EqualWithinAbsOrRel(v, f.Value, tol, tol) {
+				t.Errorf("unexpected net flow at terminal %d for %s: got:%v want:%v", id, name, v, f.Value)
+			}
+		default:
+			if math.Abs(v) > tol {
+				t.Errorf("flow not conserved at node %d for %s: net:%v", id, name, v)
+			}
+		}
+	}
+
+	cut, source := f.MinCut()
+	var capacity float64
+	for _, e := range cut {
+		capacity += e.Weight()
+	}
+	if !scalar.EqualWithinAbsOrRel(capacity, f.Value, tol, tol) {
+		t.Errorf("unexpected cut capacity for %s: got:%v want:%v", name, capacity, f.Value)
+	}
+	var hasSource bool
+	for _, n := range source {
+		if n.ID() == tid {
+			t.Errorf("sink in source partition for %s", name)
+		}
+		hasSource = hasSource || n.ID() == sid
+	}
+	if !hasSource {
+		t.Errorf("source not in source partition for %s", name)
+	}
+}
+
+// bruteMinCut returns the minimum s-t cut capacity of g by exhaustive
+// enumeration of node partitions. The nodes of g must be numbered 0 to n-1.
+func bruteMinCut(g *simple.WeightedDirectedGraph, s, t int) float64 {
+	n := g.Nodes().Len()
+	best := math.Inf(1)
+	for mask := 0; mask < 1<<n; mask++ {
+		if mask&(1<<s) == 0 || mask&(1<<t) != 0 {
+			continue
+		}
+		var c float64
+		for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+			if mask&(1<<e.From().ID()) != 0 && mask&(1<<e.To().ID()) == 0 {
+				c += e.Weight()
+			}
+		}
+		best = math.Min(best, c)
+	}
+	return best
+}
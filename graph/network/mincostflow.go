@@ -0,0 +1,262 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+var (
+	// ErrInfeasible is returned by MinCostFlow when the supplies
+	// and demands cannot be satisfied by the flow network.
+	ErrInfeasible = errors.New("network: infeasible flow")
+
+	// ErrNegativeCycle is returned by the minimum cost flow
+	// functions when the flow network contains a cycle of
+	// negative cost with positive capacity.
+	ErrNegativeCycle = errors.New("network: negative cost cycle")
+
+	// ErrUnbounded is returned by MinCostMaxFlow when the
+	// sink is reachable from the source through a path of
+	// infinite capacity.
+	ErrUnbounded = errors.New("network: unbounded flow")
+)
+
+// CapacityCost returns the capacity and the cost per unit of flow of the
+// edge from the node with ID uid to the node with ID vid.
+type CapacityCost func(uid, vid int64) (capacity, cost float64)
+
+// CostFlow is the result of a minimum cost flow calculation.
+type CostFlow struct {
+	// Value is the total amount of flow.
+	Value float64
+
+	// Cost is the total cost of the flow.
+	Cost float64
+
+	r residual
+}
+
+// Flow returns the flow along the edge from the node with ID uid to the
+// node with ID vid. If no such edge exists in the analyzed graph, Flow
+// returns zero.
+func (f *CostFlow) Flow(uid, vid int64) float64 {
+	return f.r.edgeFlow(uid, vid)
+}
+
+// Edges returns all edges of the analyzed graph carrying a non-zero flow.
+// The weight of each returned edge is the flow along the edge.
+func (f *CostFlow) Edges() []graph.WeightedEdge {
+	return f.r.flowEdges()
+}
+
+// MinCostFlow returns a minimum cost flow in g satisfying the node supplies
+// and demands given in supply. Positive values in supply are supplies and
+// negative values are demands; nodes not in supply have neither. The sum of
+// all supplies and demands must be zero. The capacity and cost of each edge
+// of g are given by fn. Edge costs may be negative, but the network must
+// not contain a cycle of negative cost.
+//
+// MinCostFlow uses the successive shortest path algorithm with Johnson
+// node potentials. If the supplies and demands cannot be met, MinCostFlow
+// returns ErrInfeasible. If the network contains a negative cost cycle
+// with positive capacity, ErrNegativeCycle is returned.
+//
+// MinCostFlow will panic if a node in supply is not in g or if any edge
+// capacity is negative or NaN.
+func MinCostFlow(g graph.Directed, fn CapacityCost, supply map[int64]float64) (*CostFlow, error) {
+	r := newCostResidual(g, fn, 2)
+	s := len(r.nodes)
+	t := s + 1
+	var total, balance float64
+	for id, b := range supply {
+		u, ok := r.indexOf[id]
+		if !ok {
+			panic("network: supply node not in graph")
+		}
+		switch {
+		case b > 0:
+			r.addArc(s, u, b, 0)
+			total += b
+		case b < 0:
+			r.addArc(u, t, -b, 0)
+		}
+		balance += b
+	}
+	if math.Abs(balance) > 1e-12*math.Max(1, total) {
+		return nil, ErrInfeasible
+	}
+
+	value, cost, err := r.successiveShortestPaths(s, t, total)
+	if err != nil {
+		return nil, err
+	}
+	if value < total*(1-1e-12) {
+		return nil, ErrInfeasible
+	}
+	return &CostFlow{Value: value, Cost: cost, r: r}, nil
+}
+
+// MinCostMaxFlow returns a maximum flow from s to t in g with minimum cost
+// among all maximum flows. The capacity and cost of each edge of g are given
+// by fn. Edge costs may be negative, but the network must not contain a cycle
+// of negative cost.
+//
+// MinCostMaxFlow uses the successive shortest path algorithm with Johnson
+// node potentials. If the network contains a negative cost cycle with
+// positive capacity, ErrNegativeCycle is returned. If t is reachable from s
+// through a path of infinite capacity, ErrUnbounded is returned.
+//
+// MinCostMaxFlow will panic if s or t is not in g, if s and t are the same
+// node, or if any edge capacity is negative or NaN.
+func MinCostMaxFlow(g graph.Directed, fn CapacityCost, s, t graph.Node) (*CostFlow, error) {
+	r := newCostResidual(g, fn, 0)
+	si, ti := r.terminals(s, t)
+	value, cost, err := r.successiveShortestPaths(si, ti, math.Inf(1))
+	if err != nil {
+		return nil, err
+	}
+	return &CostFlow{Value: value, Cost: cost, r: r}, nil
+}
+
+// successiveShortestPaths pushes up to limit units of flow from s to t along
+// successive minimum cost augmenting paths, returning the amount of flow
+// pushed and its cost.
+func (r *residual) successiveShortestPaths(s, t int, limit float64) (value, cost float64, err error) {
+	n := len(r.adj)
+	pot, ok := r.potentials(s)
+	if !ok {
+		return 0, 0, ErrNegativeCycle
+	}
+
+	dist := make([]float64, n)
+	via := make([]int, n)
+	for value < limit {
+		r.dijkstra(dist, via, pot, s)
+		if math.IsInf(dist[t], 1) {
+			break
+		}
+		for v, d := range dist {
+			if !math.IsInf(d, 1) {
+				pot[v] += d
+			}
+		}
+
+		d := limit - value
+		for v := t; v != s; v = r.to[via[v]^1] {
+			d = math.Min(d, r.cap[via[v]])
+		}
+		if math.IsInf(d, 1) {
+			return 0, 0, ErrUnbounded
+		}
+		for v := t; v != s; v = r.to[via[v]^1] {
+			a := via[v]
+			r.push(a, d)
+			cost += d * r.cost[a]
+		}
+		value += d
+	}
+	return value, cost, nil
+}
+
+// potentials returns node potentials for the residual network that make
+// all reduced arc costs of arcs with positive capacity non-negative. The
+// potentials are the Bellman-Ford shortest path distances from s, with
+// nodes unreachable from s given the largest finite distance. It returns
+// false if a negative cycle is reachable from s.
+func (r *residual) potentials(s int) ([]float64, bool) {
+	n := len(r.adj)
+	pot := make([]float64, n)
+	for i := range pot {
+		pot[i] = math.Inf(1)
+	}
+	pot[s] = 0
+	for i := 0; i < n; i++ {
+		var changed bool
+		for u, arcs := range r.adj {
+			if math.IsInf(pot[u], 1) {
+				continue
+			}
+			for _, a := range arcs {
+				v := r.to[a]
+				if r.cap[a] > 0 && pot[u]+r.cost[a] < pot[v] {
+					pot[v] = pot[u] + r.cost[a]
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			var maxPot float64
+			for _, p := range pot {
+				if !math.IsInf(p, 1) {
+					maxPot = math.Max(maxPot, p)
+				}
+			}
+			for i, p := range pot {
+				if math.IsInf(p, 1) {
+					pot[i] = maxPot
+				}
+			}
+			return pot, true
+		}
+	}
+	return nil, false
+}
+
+// dijkstra finds the shortest paths from s in the residual network using
+// reduced costs with respect to the node potentials pot, placing the
+// distances in dist and the arc used to reach each node in via.
+func (r *residual) dijkstra(dist []float64, via []int, pot []float64, s int) {
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		via[i] = -1
+	}
+	dist[s] = 0
+	q := costQueue{{node: s}}
+	for q.Len() != 0 {
+		mid := heap.Pop(&q).(costItem)
+		u := mid.node
+		if mid.dist > dist[u] {
+			continue
+		}
+		for _, a := range r.adj[u] {
+			if r.cap[a] <= 0 {
+				continue
+			}
+			v := r.to[a]
+			// Reduced costs are non-negative in exact arithmetic,
+			// so clamp any negative rounding error.
+			rc := math.Max(0, r.cost[a]+pot[u]-pot[v])
+			if d := dist[u] + rc; d < dist[v] {
+				dist[v] = d
+				via[v] = a
+				heap.Push(&q, costItem{node: v, dist: d})
+			}
+		}
+	}
+}
+
+type costItem struct {
+	node int
+	dist float64
+}
+
+// costQueue is a priority queue of nodes ordered by distance.
+type costQueue []costItem
+
+func (q costQueue) Len() int            { return len(q) }
+func (q costQueue) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q costQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *costQueue) Push(x interface{}) { *q = append(*q, x.(costItem)) }
+func (q *costQueue) Pop() interface{} {
+	t := *q
+	var n costItem
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
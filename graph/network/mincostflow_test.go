@@ -0,0 +1,297 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+type costEdge struct {
+	from, to       int64
+	capacity, cost float64
+}
+
+// costNetwork returns a directed graph holding the given edges and the
+// corresponding CapacityCost function.
+func costNetwork(edges []costEdge) (*simple.DirectedGraph, CapacityCost) {
+	g := simple.NewDirectedGraph()
+	type key struct{ u, v int64 }
+	attr := make(map[key]costEdge)
+	for _, e := range edges {
+		if g.Node(e.from) == nil {
+			g.AddNode(simple.Node(e.from))
+		}
+		if g.Node(e.to) == nil {
+			g.AddNode(simple.Node(e.to))
+		}
+		g.SetEdge(simple.Edge{F: simple.Node(e.from), T: simple.Node(e.to)})
+		attr[key{e.from, e.to}] = e
+	}
+	return g, func(uid, vid int64) (capacity, cost float64) {
+		e := attr[key{uid, vid}]
+		return e.capacity, e.cost
+	}
+}
+
+var minCostFlowTests = []struct {
+	name   string
+	edges  []costEdge
+	supply map[int64]float64
+
+	wantCost float64
+	wantErr  error
+}{
+	{
+		name: "transportation",
+		edges: []costEdge{
+			{from: 0, to: 2, capacity: math.Inf(1), cost: 1},
+			{from: 0, to: 3, capacity: math.Inf(1), cost: 4},
+			{from: 1, to: 2, capacity: math.Inf(1), cost: 3},
+			{from: 1, to: 3, capacity: math.Inf(1), cost: 2},
+		},
+		supply:   map[int64]float64{0: 4, 1: 3, 2: -5, 3: -2},
+		wantCost: 11,
+	},
+	{
+		name: "capacitated",
+		edges: []costEdge{
+			{from: 0, to: 1, capacity: 2, cost: 1},
+			{from: 0, to: 2, capacity: 4, cost: 5},
+			{from: 1, to: 2, capacity: 1, cost: 1},
+			{from: 1, to: 3, capacity: 3, cost: 6},
+			{from: 2, to: 3, capacity: 4, cost: 1},
+		},
+		supply:   map[int64]float64{0: 5, 3: -5},
+		wantCost: 28,
+	},
+	{
+		name: "negative cost",
+		edges: []costEdge{
+			{from: 0, to: 1, capacity: 2, cost: -3},
+			{from: 0, to: 2, capacity: 2, cost: 1},
+			{from: 1, to: 2, capacity: 2, cost: 2},
+		},
+		supply:   map[int64]float64{0: 3, 2: -3},
+		wantCost: -1,
+	},
+	{
+		name: "insufficient capacity",
+		edges: []costEdge{
+			{from: 0, to: 1, capacity: 2, cost: 1},
+		},
+		supply:  map[int64]float64{0: 3, 1: -3},
+		wantErr: ErrInfeasible,
+	},
+	{
+		name: "unbalanced",
+		edges: []costEdge{
+			{from: 0, to: 1, capacity: 5, cost: 1},
+		},
+		supply:  map[int64]float64{0: 3, 1: -2},
+		wantErr: ErrInfeasible,
+	},
+	{
+		name: "negative cycle",
+		edges: []costEdge{
+			{from: 0, to: 1, capacity: 1, cost: 1},
+			{from: 1, to: 2, capacity: 1, cost: -2},
+			{from: 2, to: 1, capacity: 1, cost: 1},
+		},
+		supply:  map[int64]float64{0: 1, 2: -1},
+		wantErr: ErrNegativeCycle,
+	},
+}
+
+func TestMinCostFlow(t *testing.T) {
+	t.Parallel()
+	for _, test := range minCostFlowTests {
+		g, fn := costNetwork(test.edges)
+		got, err := MinCostFlow(g, fn, test.supply)
+		if err != test.wantErr {
+			t.Errorf("unexpected error for %s: got:%v want:%v", test.name, err, test.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(got.Cost, test.wantCost, 1e-12, 1e-12) {
+			t.Errorf("unexpected cost for %s: got:%v want:%v", test.name, got.Cost, test.wantCost)
+		}
+		checkCostFlow(t, test.name, g, fn, test.supply, got)
+	}
+}
+
+func TestMinCostFlowRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 50; i++ {
+		const n = 7
+		var edges []costEdge
+		for u := 0; u < n; u++ {
+			for v := 0; v < n; v++ {
+				// Always include a ring to keep the network connected.
+				if u != v && (v == (u+1)%n || rnd.Float64() < 0.3) {
+					edges = append(edges, costEdge{
+						from: int64(u), to: int64(v),
+						capacity: float64(1 + rnd.IntN(9)),
+						cost:     float64(rnd.IntN(10)),
+					})
+				}
+			}
+		}
+		g, fn := costNetwork(edges)
+		supply := make(map[int64]float64)
+		for j := 0; j < 4; j++ {
+			b := float64(rnd.IntN(6))
+			supply[int64(rnd.IntN(n))] += b
+			supply[int64(rnd.IntN(n))] -= b
+		}
+
+		name := fmt.Sprintf("random network %d", i)
+		want, wantErr := lpMinCostFlow(edges, n, supply)
+		got, err := MinCostFlow(g, fn, supply)
+		if (err == nil) != (wantErr == nil) {
+			t.Errorf("mismatched feasibility for %s: got:%v want:%v", name, err, wantErr)
+			continue
+		}
+		if err != nil {
+			if err != ErrInfeasible {
+				t.Errorf("unexpected error for %s: %v", name, err)
+			}
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(got.Cost, want, 1e-8, 1e-8) {
+			t.Errorf("unexpected cost for %s: got:%v want:%v", name, got.Cost, want)
+		}
+		checkCostFlow(t, name, g, fn, supply, got)
+	}
+}
+
+func TestMinCostMaxFlowRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 50; i++ {
+		const n = 7
+		var edges []costEdge
+		wg := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for u := 0; u < n; u++ {
+			for v := 0; v < n; v++ {
+				if u != v && (v == (u+1)%n || rnd.Float64() < 0.3) {
+					e := costEdge{
+						from: int64(u), to: int64(v),
+						capacity: float64(1 + rnd.IntN(9)),
+						cost:     float64(rnd.IntN(10)),
+					}
+					edges = append(edges, e)
+					wg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: e.capacity})
+				}
+			}
+		}
+		g, fn := costNetwork(edges)
+
+		name := fmt.Sprintf("random network %d", i)
+		got, err := MinCostMaxFlow(g, fn, g.Node(0), g.Node(n-1))
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", name, err)
+			continue
+		}
+		wantValue := MaxFlowDinic(wg, wg.Node(0), wg.Node(n-1)).Value
+		if !scalar.EqualWithinAbsOrRel(got.Value, wantValue, 1e-12, 1e-12) {
+			t.Errorf("unexpected flow value for %s: got:%v want:%v", name, got.Value, wantValue)
+		}
+		supply := map[int64]float64{0: wantValue, n - 1: -wantValue}
+		wantCost, err := lpMinCostFlow(edges, n, supply)
+		if err != nil {
+			t.Fatalf("unexpected error from linear program for %s: %v", name, err)
+		}
+		if !scalar.EqualWithinAbsOrRel(got.Cost, wantCost, 1e-8, 1e-8) {
+			t.Errorf("unexpected cost for %s: got:%v want:%v", name, got.Cost, wantCost)
+		}
+		checkCostFlow(t, name, g, fn, supply, got)
+	}
+}
+
+func TestMinCostMaxFlowUnbounded(t *testing.T) {
+	t.Parallel()
+	g, fn := costNetwork([]costEdge{
+		{from: 0, to: 1, capacity: math.Inf(1), cost: 1},
+		{from: 1, to: 2, capacity: math.Inf(1), cost: 1},
+	})
+	_, err := MinCostMaxFlow(g, fn, g.Node(0), g.Node(2))
+	if err != ErrUnbounded {
+		t.Errorf("unexpected error: got:%v want:%v", err, ErrUnbounded)
+	}
+}
+
+// checkCostFlow checks that f is a feasible flow in g satisfying supply
+// and that its cost is consistent with the edge flows.
+func checkCostFlow(t *testing.T, name string, g graph.Directed, fn CapacityCost, supply map[int64]float64, f *CostFlow) {
+	t.Helper()
+	const tol = 1e-12
+
+	net := make(map[int64]float64)
+	var cost float64
+	for _, e := range f.Edges() {
+		uid, vid := e.From().ID(), e.To().ID()
+		c, w := fn(uid, vid)
+		if e.Weight() < 0 || e.Weight() > c+tol {
+			t.Errorf("capacity constraint violated for %s on edge %d->%d: flow:%v capacity:%v", name, uid, vid, e.Weight(), c)
+		}
+		if got := f.Flow(uid, vid); got != e.Weight() {
+			t.Errorf("mismatched edge flow for %s on edge %d->%d: got:%v want:%v", name, uid, vid, got, e.Weight())
+		}
+		net[uid] += e.Weight()
+		net[vid] -= e.Weight()
+		cost += e.Weight() * w
+	}
+	for _, n := range graph.NodesOf(g.Nodes()) {
+		id := n.ID()
+		if !scalar.EqualWithinAbsOrRel(net[id], supply[id], tol, tol) {
+			t.Errorf("unexpected net outflow at node %d for %s: got:%v want:%v", id, name, net[id], supply[id])
+		}
+	}
+	if !scalar.EqualWithinAbsOrRel(cost, f.Cost, 1e-10, 1e-10) {
+		t.Errorf("inconsistent flow cost for %s: got:%v want:%v", name, f.Cost, cost)
+	}
+}
+
+// lpMinCostFlow returns the minimum cost of a flow satisfying supply in the
+// network of n nodes with the given edges by solving the corresponding linear
+// program. The network must be connected.
+func lpMinCostFlow(edges []costEdge, n int, supply map[int64]float64) (float64, error) {
+	// Variables are the edge flows followed by the capacity slacks.
+	// Constraints are flow conservation at all but the last node,
+	// which is implied, followed by the capacity constraints.
+	m := len(edges)
+	A := mat.NewDense(n-1+m, 2*m, nil)
+	b := make([]float64, n-1+m)
+	c := make([]float64, 2*m)
+	for i := 0; i < n-1; i++ {
+		b[i] = supply[int64(i)]
+	}
+	for j, e := range edges {
+		if e.from < int64(n-1) {
+			A.Set(int(e.from), j, 1)
+		}
+		if e.to < int64(n-1) {
+			A.Set(int(e.to), j, -1)
+		}
+		A.Set(n-1+j, j, 1)
+		A.Set(n-1+j, m+j, 1)
+		b[n-1+j] = e.capacity
+		c[j] = e.cost
+	}
+	opt, _, err := lp.Simplex(c, A, b, 1e-10, nil)
+	return opt, err
+}
@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import "gonum.org/v1/gonum/graph"
+
+// multiplicityFor returns a function that returns the number of lines from
+// the node with ID uid to the node with ID vid if g is a graph.Multigraph.
+// Otherwise the returned function returns one.
+func multiplicityFor(g graph.Graph) func(uid, vid int64) int {
+	mg, ok := g.(graph.Multigraph)
+	if !ok {
+		return func(_, _ int64) int { return 1 }
+	}
+	return func(uid, vid int64) int {
+		return len(graph.LinesOf(mg.Lines(uid, vid)))
+	}
+}
+
+// weightedDirected returns g as a graph.WeightedDirected if it is one. If g
+// is an unweighted graph.DirectedMultigraph, the returned graph has edge
+// weights equal to the number of lines joining each pair of nodes.
+func weightedDirected(g graph.Directed) (graph.WeightedDirected, bool) {
+	if wg, ok := g.(graph.WeightedDirected); ok {
+		return wg, true
+	}
+	if mg, ok := g.(graph.DirectedMultigraph); ok {
+		return lineWeighted{Directed: g, lines: mg.Lines}, true
+	}
+	return nil, false
+}
+
+// lineWeighted is a directed multigraph with edge weights
+// equal to the number of lines joining each pair of nodes.
+type lineWeighted struct {
+	graph.Directed
+	lines func(uid, vid int64) graph.Lines
+}
+
+// Weight returns the number of lines from u to v and whether any exist.
+func (g lineWeighted) Weight(uid, vid int64) (w float64, ok bool) {
+	n := len(graph.LinesOf(g.lines(uid, vid)))
+	return float64(n), n != 0
+}
+
+// WeightedEdge returns the edge from u to v weighted by its line count.
+func (g lineWeighted) WeightedEdge(uid, vid int64) graph.WeightedEdge {
+	e := g.Edge(uid, vid)
+	if e == nil {
+		return nil
+	}
+	w, _ := g.Weight(uid, vid)
+	return weightedEdge{Edge: e, w: w}
+}
+
+// weightedEdge is an edge with an associated weight.
+type weightedEdge struct {
+	graph.Edge
+	w float64
+}
+
+func (e weightedEdge) Weight() float64 { return e.w }
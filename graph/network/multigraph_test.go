@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestBetweennessMultigraph(t *testing.T) {
+	t.Parallel()
+	// There are two lines between 0 and 1, so two of the
+	// three shortest paths between 0 and 3 pass through 1
+	// and two of the three between 1 and 2 pass through 0.
+	g := multi.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {0, 1}, {1, 3}, {0, 2}, {2, 3}} {
+		g.SetLine(g.NewLine(multi.Node(e[0]), multi.Node(e[1])))
+	}
+	want := map[int64]float64{0: 4.0 / 3, 1: 4.0 / 3, 2: 2.0 / 3, 3: 2.0 / 3}
+	got := Betweenness(g)
+	if len(got) != len(want) {
+		t.Errorf("unexpected betweenness: got:%v want:%v", got, want)
+	}
+	for id, w := range want {
+		if !scalar.EqualWithinAbsOrRel(got[id], w, 1e-12, 1e-12) {
+			t.Errorf("unexpected betweenness for %d: got:%v want:%v", id, got[id], w)
+		}
+	}
+
+	wantEdge := map[[2]int64]float64{
+		{0, 1}: 2 * (1 + 2.0/3 + 2.0/3),
+		{1, 3}: 2 * (1 + 2.0/3 + 1.0/3),
+		{0, 2}: 2 * (1 + 1.0/3 + 2.0/3),
+		{2, 3}: 2 * (1 + 1.0/3 + 1.0/3),
+	}
+	gotEdge := EdgeBetweenness(g)
+	if len(gotEdge) != len(wantEdge) {
+		t.Errorf("unexpected edge betweenness: got:%v want:%v", gotEdge, wantEdge)
+	}
+	for e, w := range wantEdge {
+		if !scalar.EqualWithinAbsOrRel(gotEdge[e], w, 1e-12, 1e-12) {
+			t.Errorf("unexpected edge betweenness for %v: got:%v want:%v", e, gotEdge[e], w)
+		}
+	}
+}
+
+func TestPageRankMultigraph(t *testing.T) {
+	t.Parallel()
+	// PageRank of an unweighted multigraph must equal the
+	// edge-weighted PageRank of the simple graph with edge
+	// weights equal to the number of lines joining each
+	// pair of nodes.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 15
+	mg := multi.NewDirectedGraph()
+	sg := simple.NewWeightedDirectedGraph(0, 0)
+	for i := 0; i < n; i++ {
+		mg.AddNode(multi.Node(i))
+		sg.AddNode(simple.Node(i))
+	}
+	for k := 0; k < 4*n; k++ {
+		u, v := rnd.IntN(n), rnd.IntN(n)
+		if u == v {
+			continue
+		}
+		mg.SetLine(mg.NewLine(multi.Node(u), multi.Node(v)))
+		w, _ := sg.Weight(int64(u), int64(v))
+		sg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: w + 1})
+	}
+
+	const tol = 1e-10
+	restart := map[int64]float64{0: 1, 1: 2}
+	for _, test := range []struct {
+		name      string
+		got, want map[int64]float64
+	}{
+		{name: "PageRank", got: PageRank(mg, 0.85, tol), want: PageRank(sg, 0.85, tol)},
+		{name: "PageRankSparse", got: PageRankSparse(mg, 0.85, tol), want: PageRankSparse(sg, 0.85, tol)},
+		{
+			name: "PersonalizedPageRank",
+			got:  PersonalizedPageRank(mg, 0.85, tol, restart),
+			want: PersonalizedPageRank(sg, 0.85, tol, restart),
+		},
+	} {
+		for id, w := range test.want {
+			if !scalar.EqualWithinAbsOrRel(test.got[id], w, 1e-6, 1e-6) {
+				t.Errorf("unexpected %s rank for %d: got:%v want:%v", test.name, id, test.got[id], w)
+			}
+		}
+	}
+}
@@ -18,8 +18,10 @@ import (
 // vector difference between iterations is below tol. The returned map is
 // keyed on the graph node IDs.
 // If g is a graph.WeightedDirected, an edge-weighted PageRank is calculated.
+// If g is an unweighted graph.DirectedMultigraph, edges are weighted by the
+// number of lines joining their nodes.
 func PageRank(g graph.Directed, damp, tol float64) map[int64]float64 {
-	if g, ok := g.(graph.WeightedDirected); ok {
+	if g, ok := weightedDirected(g); ok {
 		return edgeWeightedPageRank(g, damp, tol)
 	}
 	return pageRank(g, damp, tol)
@@ -30,8 +32,10 @@ func PageRank(g graph.Directed, damp, tol float64) map[int64]float64 {
 // vector difference between iterations is below tol. The returned map is
 // keyed on the graph node IDs.
 // If g is a graph.WeightedDirected, an edge-weighted PageRank is calculated.
+// If g is an unweighted graph.DirectedMultigraph, edges are weighted by the
+// number of lines joining their nodes.
 func PageRankSparse(g graph.Directed, damp, tol float64) map[int64]float64 {
-	if g, ok := g.(graph.WeightedDirected); ok {
+	if g, ok := weightedDirected(g); ok {
 		return edgeWeightedPageRankSparse(g, damp, tol)
 	}
 	return pageRankSparse(g, damp, tol)
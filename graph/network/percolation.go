@@ -0,0 +1,170 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"container/heap"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/linear"
+)
+
+// Percolation returns the percolation centrality for nodes in the graph g
+// with the given node percolation states.
+//
+//	PC(v) = 1/(n-2) \sum_{s ≠ v ≠ t ∈ V} (\sigma_{st}(v) / \sigma_{st}) x_s / (\sum_u x_u - x_v)
+//
+// where \sigma_{st} and \sigma_{st}(v) are the number of shortest paths from s to t,
+// and the subset of those paths containing v respectively, x_s is the percolation
+// state of s in [0, 1] and n is the number of nodes in g. Nodes missing from states
+// have a percolation state of zero. When all states are equal, the percolation
+// centrality is the betweenness centrality normalised by (n-1)(n-2).
+//
+// If g is a graph.Weighted, shortest paths are calculated using the edge weights,
+// otherwise each edge has unit length. Percolation will panic if g has a negative
+// edge weight.
+//
+// Percolation centrality is described in Piraveenan, Prokopenko and Hossain,
+// "Percolation centrality: quantifying graph-theoretic impact of nodes during
+// percolation in networks", PLoS ONE 8(1):e53095, 2013, doi:10.1371/journal.pone.0053095.
+func Percolation(g graph.Graph, states map[int64]float64) map[int64]float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	pc := make(map[int64]float64, len(nodes))
+	if len(nodes) < 3 {
+		for _, n := range nodes {
+			pc[n.ID()] = 0
+		}
+		return pc
+	}
+	var sum float64
+	for _, n := range nodes {
+		pc[n.ID()] = 0
+		sum += states[n.ID()]
+	}
+
+	accumulate := func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64) {
+		xs := states[s.ID()]
+		for stack.Len() != 0 {
+			w := stack.Pop()
+			for _, v := range p[w.ID()] {
+				delta[v.ID()] += sigma[v.ID()] / sigma[w.ID()] * (1 + delta[w.ID()])
+			}
+			if w.ID() != s.ID() && xs != 0 {
+				if d := delta[w.ID()]; d != 0 {
+					pc[w.ID()] += d * xs
+				}
+			}
+		}
+	}
+	if wg, ok := g.(graph.Weighted); ok {
+		brandesWeighted(wg, accumulate)
+	} else {
+		brandes(g, nil, accumulate)
+	}
+
+	norm := 1 / float64(len(nodes)-2)
+	for _, n := range nodes {
+		id := n.ID()
+		if pc[id] == 0 {
+			continue
+		}
+		pc[id] *= norm / (sum - states[id])
+	}
+	return pc
+}
+
+// brandesWeighted is the weighted equivalent of brandes, using Dijkstra's
+// algorithm in place of breadth-first search to find the shortest paths from
+// each node. Nodes are pushed onto the stack in order of non-decreasing
+// distance from the source.
+func brandesWeighted(g graph.Weighted, accumulate func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64)) {
+	var (
+		nodes = graph.NodesOf(g.Nodes())
+		stack linear.NodeStack
+		p     = make(map[int64][]graph.Node, len(nodes))
+		sigma = make(map[int64]float64, len(nodes))
+		d     = make(map[int64]float64, len(nodes))
+		delta = make(map[int64]float64, len(nodes))
+		done  = make(map[int64]bool, len(nodes))
+		queue brandesQueue
+	)
+	for _, s := range nodes {
+		stack = stack[:0]
+
+		for _, w := range nodes {
+			wid := w.ID()
+			p[wid] = p[wid][:0]
+			sigma[wid] = 0
+			d[wid] = math.Inf(1)
+			done[wid] = false
+		}
+		sigma[s.ID()] = 1
+		d[s.ID()] = 0
+
+		heap.Push(&queue, brandesItem{node: s})
+		for queue.Len() != 0 {
+			mid := heap.Pop(&queue).(brandesItem)
+			v := mid.node
+			vid := v.ID()
+			if done[vid] {
+				continue
+			}
+			done[vid] = true
+			stack.Push(v)
+			to := g.From(vid)
+			for to.Next() {
+				w := to.Node()
+				wid := w.ID()
+				if wid == vid {
+					continue
+				}
+				weight, ok := g.Weight(vid, wid)
+				if !ok {
+					panic("network: unexpected invalid weight")
+				}
+				if weight < 0 {
+					panic("network: negative edge weight")
+				}
+				switch joint := d[vid] + weight; {
+				case joint < d[wid]:
+					d[wid] = joint
+					sigma[wid] = sigma[vid]
+					p[wid] = append(p[wid][:0], v)
+					heap.Push(&queue, brandesItem{node: w, dist: joint})
+				case joint == d[wid] && !done[wid]:
+					sigma[wid] += sigma[vid]
+					p[wid] = append(p[wid], v)
+				}
+			}
+		}
+
+		for _, v := range nodes {
+			delta[v.ID()] = 0
+		}
+
+		// S returns vertices in order of non-increasing distance from s
+		accumulate(s, stack, p, delta, sigma)
+	}
+}
+
+type brandesItem struct {
+	node graph.Node
+	dist float64
+}
+
+// brandesQueue is a priority queue of nodes ordered by distance.
+type brandesQueue []brandesItem
+
+func (q brandesQueue) Len() int            { return len(q) }
+func (q brandesQueue) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q brandesQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *brandesQueue) Push(x interface{}) { *q = append(*q, x.(brandesItem)) }
+func (q *brandesQueue) Pop() interface{} {
+	t := *q
+	var n brandesItem
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestPercolation(t *testing.T) {
+	t.Parallel()
+
+	// The path A-B-C with only A percolated.
+	g := simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(A), T: simple.Node(B)})
+	g.SetEdge(simple.Edge{F: simple.Node(B), T: simple.Node(C)})
+	got := Percolation(g, map[int64]float64{A: 1})
+	checkCentrality(t, "Percolation", "path", got, map[int64]float64{A: 0, B: 1, C: 0}, 1e-12)
+
+	// The weighted triangle A-B-C with a long A-C edge
+	// so that the shortest path from A to C passes B.
+	wg := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	wg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(A), T: simple.Node(B), W: 1})
+	wg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(B), T: simple.Node(C), W: 1})
+	wg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(A), T: simple.Node(C), W: 3})
+	got = Percolation(wg, map[int64]float64{A: 0.5, B: 0.5, C: 0.5})
+	// Paths A→C and C→A pass through B, each contributing 0.5/(1.5-0.5).
+	checkCentrality(t, "Percolation", "weighted triangle", got, map[int64]float64{A: 0, B: 1, C: 0}, 1e-12)
+}
+
+func TestPercolationUniformStates(t *testing.T) {
+	t.Parallel()
+	for i, test := range betweennessTests {
+		g := simple.NewUndirectedGraph()
+		wg := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		states := make(map[int64]float64)
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+				wg.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+				wg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: 1})
+			}
+			states[int64(u)] = 1
+		}
+		n := float64(len(test.g))
+		want := make(map[int64]float64)
+		for u := range test.g {
+			want[int64(u)] = test.want[int64(u)] / ((n - 1) * (n - 2))
+		}
+		checkCentrality(t, "Percolation", fmt.Sprintf("unweighted betweenness test %d", i), Percolation(g, states), want, test.wantTol/((n-1)*(n-2)))
+		checkCentrality(t, "Percolation", fmt.Sprintf("weighted betweenness test %d", i), Percolation(wg, states), want, test.wantTol/((n-1)*(n-2)))
+	}
+}
+
+func TestPercolationWeighted(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, directed := range []bool{false, true} {
+		var g interface {
+			graph.Weighted
+			graph.WeightedBuilder
+		}
+		if directed {
+			g = simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		} else {
+			g = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		}
+		const n = 30
+		states := make(map[int64]float64)
+		for u := 0; u < n; u++ {
+			g.AddNode(simple.Node(u))
+			states[int64(u)] = 1
+		}
+		for u := 0; u < n; u++ {
+			for v := 0; v < n; v++ {
+				if u != v && rnd.Float64() < 0.15 {
+					// Small integer weights give many equal length paths.
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(1 + rnd.IntN(3))})
+				}
+			}
+		}
+		b := BetweennessWeighted(g, path.DijkstraAllPaths(g))
+		want := make(map[int64]float64)
+		for u := 0; u < n; u++ {
+			want[int64(u)] = b[int64(u)] / ((n - 1) * (n - 2))
+		}
+		name := "undirected"
+		if directed {
+			name = "directed"
+		}
+		checkCentrality(t, "Percolation", name, Percolation(g, states), want, 1e-12)
+	}
+}
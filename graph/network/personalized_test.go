@@ -0,0 +1,210 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestPersonalizedPageRankUniform(t *testing.T) {
+	t.Parallel()
+	for i, test := range pageRankTests {
+		g := simple.NewDirectedGraph()
+		restart := make(map[int64]float64)
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+			restart[int64(u)] = 1
+		}
+		prec := 1 - int(math.Log10(test.wantTol))
+
+		got := PersonalizedPageRank(g, test.damp, test.tol, restart)
+		for n := range test.g {
+			if !scalar.EqualWithinAbsOrRel(got[int64(n)], test.want[int64(n)], test.wantTol, test.wantTol) {
+				t.Errorf("unexpected personalized PageRank result for test %d:\ngot: %v\nwant:%v",
+					i, orderedFloats(got, prec), orderedFloats(test.want, prec))
+				break
+			}
+		}
+
+		got = NewIncrementalPageRank(g, test.damp, test.tol*1e-3, nil).Ranks()
+		for n := range test.g {
+			if !scalar.EqualWithinAbsOrRel(got[int64(n)], test.want[int64(n)], test.wantTol, test.wantTol) {
+				t.Errorf("unexpected incremental PageRank result for test %d:\ngot: %v\nwant:%v",
+					i, orderedFloats(got, prec), orderedFloats(test.want, prec))
+				break
+			}
+		}
+	}
+}
+
+func TestPersonalizedPageRank(t *testing.T) {
+	t.Parallel()
+	const damp = 0.85
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, weighted := range []bool{false, true} {
+		for i := 0; i < 20; i++ {
+			g := asDirected(randomDirectedGraph(20, 0.15, weighted, rnd), weighted)
+			restart := map[int64]float64{
+				int64(rnd.IntN(20)): 1,
+				int64(rnd.IntN(20)): 2,
+			}
+			name := fmt.Sprintf("random graph %d weighted=%t", i, weighted)
+			want := densePersonalizedPageRank(g, damp, restart)
+			got := PersonalizedPageRank(g, damp, 1e-14, restart)
+			checkCentrality(t, "PersonalizedPageRank", name, got, want, 1e-10)
+
+			got = NewIncrementalPageRank(g, damp, 1e-14, restart).Ranks()
+			checkCentrality(t, "IncrementalPageRank", name, got, want, 1e-10)
+		}
+	}
+}
+
+func TestIncrementalPageRank(t *testing.T) {
+	t.Parallel()
+	const (
+		n    = 50
+		damp = 0.85
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, weighted := range []bool{false, true} {
+		g := randomDirectedGraph(n, 0.05, weighted, rnd)
+		dg := asDirected(g, weighted)
+		restart := map[int64]float64{0: 1, 1: 1, 2: 1}
+		p := NewIncrementalPageRank(dg, damp, 1e-14, restart)
+		for i := 0; i < 100; i++ {
+			var updated []int64
+			switch op := rnd.IntN(10); {
+			case op < 4:
+				// Insert an edge.
+				u, v := rnd.IntN(n), rnd.IntN(n)
+				if u == v {
+					continue
+				}
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: edgeWeight(weighted, rnd)})
+				updated = append(updated, int64(u))
+			case op < 8:
+				// Delete all the out edges of a node.
+				u := int64(rnd.IntN(n))
+				for _, v := range graph.NodesOf(g.From(u)) {
+					g.RemoveEdge(u, v.ID())
+				}
+				updated = append(updated, u)
+			case op < 9:
+				// Add a new node with an edge in each direction.
+				id := g.NewNode().ID()
+				u := int64(rnd.IntN(n))
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(id), T: simple.Node(u), W: edgeWeight(weighted, rnd)})
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(id), W: edgeWeight(weighted, rnd)})
+				updated = append(updated, u)
+			default:
+				// Remove a node that was added.
+				nodes := graph.NodesOf(g.Nodes())
+				v := nodes[rnd.IntN(len(nodes))].ID()
+				if v < n {
+					continue
+				}
+				updated = append(updated, v)
+				for _, u := range graph.NodesOf(g.To(v)) {
+					updated = append(updated, u.ID())
+				}
+				g.RemoveNode(v)
+			}
+			p.Update(updated...)
+
+			name := fmt.Sprintf("update %d weighted=%t", i, weighted)
+			want := densePersonalizedPageRank(dg, damp, restart)
+			checkCentrality(t, "IncrementalPageRank", name, p.Ranks(), want, 1e-10)
+		}
+	}
+}
+
+// randomDirectedGraph returns a random directed graph with n nodes and edge
+// probability p. If weighted is false, all edge weights are one.
+func randomDirectedGraph(n int, p float64, weighted bool, rnd *rand.Rand) *simple.WeightedDirectedGraph {
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for u := 0; u < n; u++ {
+		g.AddNode(simple.Node(u))
+	}
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if u != v && rnd.Float64() < p {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: edgeWeight(weighted, rnd)})
+			}
+		}
+	}
+	return g
+}
+
+func edgeWeight(weighted bool, rnd *rand.Rand) float64 {
+	if !weighted {
+		return 1
+	}
+	return 0.5 + rnd.Float64()
+}
+
+// asDirected returns g, hiding its edge weights if weighted is false.
+func asDirected(g *simple.WeightedDirectedGraph, weighted bool) graph.Directed {
+	if weighted {
+		return g
+	}
+	return struct{ graph.Directed }{g}
+}
+
+// densePersonalizedPageRank returns the personalized PageRank of g with the given
+// damping factor and restart distribution by solving the PageRank linear system.
+func densePersonalizedPageRank(g graph.Directed, damp float64, restart map[int64]float64) map[int64]float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	e := mat.NewVecDense(len(nodes), nil)
+	for id, w := range normalizedRestart(g, restart) {
+		e.SetVec(indexOf[id], w)
+	}
+
+	// Solve (I - damp P) x = (1-damp) e where column j of P holds
+	// the transition probabilities from node j, or e if j is dangling.
+	a := mat.NewDense(len(nodes), len(nodes), nil)
+	for j, u := range nodes {
+		out := transitions(g, u.ID())
+		if out == nil {
+			for i := range nodes {
+				a.Set(i, j, -damp*e.AtVec(i))
+			}
+		}
+		for _, t := range out {
+			i := indexOf[t.id]
+			a.Set(i, j, a.At(i, j)-damp*t.p)
+		}
+		a.Set(j, j, a.At(j, j)+1)
+	}
+	e.ScaleVec(1-damp, e)
+	var x mat.VecDense
+	err := x.SolveVec(a, e)
+	if err != nil {
+		panic(err)
+	}
+
+	ranks := make(map[int64]float64, len(nodes))
+	for i, n := range nodes {
+		ranks[n.ID()] = x.AtVec(i)
+	}
+	return ranks
+}
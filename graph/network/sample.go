@@ -0,0 +1,273 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/linear"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+// BetweennessPivots returns an estimate of the non-zero betweenness centrality
+// for nodes in the unweighted graph g, computed from the shortest paths rooted
+// at k pivot nodes chosen uniformly at random without replacement. The estimate
+// is unbiased and is scaled to be comparable to the values returned by Betweenness.
+// If k is not less than the number of nodes in g, the exact betweenness is returned.
+//
+// The pivot approximation is described in Brandes and Pich, "Centrality
+// estimation in large networks", Int. J. Bifurc. Chaos 17(7):2303-2318, 2007,
+// doi:10.1142/S0218127407018403.
+//
+// If src is nil, rand.IntN is used as the random generator.
+func BetweennessPivots(g graph.Graph, k int, src rand.Source) map[int64]float64 {
+	if k < 1 {
+		panic("network: number of pivots must be positive")
+	}
+	nodes := graph.NodesOf(g.Nodes())
+	pivots := samplePivots(nodes, k, src)
+	scale := float64(len(nodes)) / float64(len(pivots))
+
+	cb := make(map[int64]float64)
+	brandes(g, pivots, func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64) {
+		for stack.Len() != 0 {
+			w := stack.Pop()
+			for _, v := range p[w.ID()] {
+				delta[v.ID()] += sigma[v.ID()] / sigma[w.ID()] * (1 + delta[w.ID()])
+			}
+			if w.ID() != s.ID() {
+				if d := delta[w.ID()]; d != 0 {
+					cb[w.ID()] += scale * d
+				}
+			}
+		}
+	})
+	return cb
+}
+
+// BetweennessApprox returns an estimate of the non-zero betweenness centrality
+// for nodes in the unweighted graph g, computed by sampling shortest paths
+// between uniformly chosen pairs of nodes. The returned values are scaled to
+// be comparable to the values returned by Betweenness.
+//
+// With probability at least 1-delta, the estimate for every node in g is within
+// epsilon·n·(n-1) of the exact betweenness, where n is the number of nodes in g.
+// The number of sampled paths depends on epsilon, delta and a bound on the
+// number of nodes in the longest shortest path of g, but not on the size of g.
+//
+// The approximation is described in Riondato and Kornaropoulos, "Fast
+// approximation of betweenness centrality through sampling", Data Min. Knowl.
+// Disc. 30:438-475, 2016, doi:10.1007/s10618-015-0423-0.
+//
+// BetweennessApprox will panic if epsilon or delta are not in (0, 1). If src is
+// nil, the global rand functions are used as the random generator.
+func BetweennessApprox(g graph.Graph, epsilon, delta float64, src rand.Source) map[int64]float64 {
+	if !(0 < epsilon && epsilon < 1) {
+		panic("network: epsilon out of range")
+	}
+	if !(0 < delta && delta < 1) {
+		panic("network: delta out of range")
+	}
+	var (
+		intn    func(int) int
+		uniform func() float64
+	)
+	if src == nil {
+		intn = rand.IntN
+		uniform = rand.Float64
+	} else {
+		rnd := rand.New(src)
+		intn = rnd.IntN
+		uniform = rnd.Float64
+	}
+
+	cb := make(map[int64]float64)
+	nodes := graph.NodesOf(g.Nodes())
+	n := len(nodes)
+	if n < 3 {
+		return cb
+	}
+
+	// Determine the sample size from the VC-dimension
+	// bound on the family of shortest path sets. The
+	// universal constant 0.5 is the value suggested by
+	// Riondato and Kornaropoulos.
+	vd := vertexDiameterBound(g, nodes)
+	vc := math.Floor(math.Log2(float64(max(vd-2, 1)))) + 1
+	r := int(math.Ceil(0.5 / (epsilon * epsilon) * (vc + math.Log(1/delta))))
+
+	scale := float64(n) * float64(n-1) / float64(r)
+	for range r {
+		s := nodes[intn(n)]
+		t := nodes[intn(n-1)]
+		if t.ID() == s.ID() {
+			t = nodes[n-1]
+		}
+		samplePath(g, s, t, uniform, func(v graph.Node) {
+			cb[v.ID()] += scale
+		})
+	}
+	return cb
+}
+
+// samplePath calls fn on each internal node of a shortest path from s to t in
+// the unweighted graph g chosen uniformly at random from all shortest paths
+// from s to t. If t is not reachable from s, fn is not called.
+func samplePath(g graph.Graph, s, t graph.Node, uniform func() float64, fn func(graph.Node)) {
+	var (
+		sid, tid = s.ID(), t.ID()
+		p        = make(map[int64][]graph.Node)
+		sigma    = map[int64]float64{sid: 1}
+		d        = map[int64]int{sid: 0}
+		queue    linear.NodeQueue
+		lines    = multiplicityFor(g)
+	)
+
+	// Perform a breadth-first search from s until all
+	// nodes one step closer to s than t have been expanded.
+	queue.Enqueue(s)
+	for queue.Len() != 0 {
+		v := queue.Dequeue()
+		vid := v.ID()
+		if dt, ok := d[tid]; ok && d[vid] >= dt {
+			break
+		}
+		to := g.From(vid)
+		for to.Next() {
+			w := to.Node()
+			wid := w.ID()
+			dw, ok := d[wid]
+			if !ok {
+				dw = d[vid] + 1
+				d[wid] = dw
+				queue.Enqueue(w)
+			}
+			if dw == d[vid]+1 {
+				m := lines(vid, wid)
+				sigma[wid] += float64(m) * sigma[vid]
+				for range m {
+					p[wid] = append(p[wid], v)
+				}
+			}
+		}
+	}
+	if _, ok := d[tid]; !ok {
+		return
+	}
+
+	// Walk back from t choosing each predecessor with
+	// probability proportional to its path count.
+	for w := tid; ; {
+		u := uniform() * sigma[w]
+		var v graph.Node
+		for _, v = range p[w] {
+			u -= sigma[v.ID()]
+			if u < 0 {
+				break
+			}
+		}
+		if v.ID() == sid {
+			return
+		}
+		fn(v)
+		w = v.ID()
+	}
+}
+
+// vertexDiameterBound returns an upper bound on the number of nodes in the
+// longest shortest path in the unweighted graph g.
+func vertexDiameterBound(g graph.Graph, nodes []graph.Node) int {
+	if _, ok := g.(graph.Undirected); !ok {
+		return len(nodes)
+	}
+
+	// For each connected component, the longest shortest
+	// path can be no longer than twice the eccentricity of
+	// any node in the component.
+	var (
+		bf  traverse.BreadthFirst
+		ecc int
+	)
+	for _, u := range nodes {
+		if bf.Visited(u) {
+			continue
+		}
+		bf.Walk(g, u, func(_ graph.Node, d int) bool {
+			ecc = max(ecc, d)
+			return false
+		})
+	}
+	return 2*ecc + 1
+}
+
+// ClosenessPivots returns an estimate of the closeness centrality for nodes in
+// the graph g, computed from the shortest paths rooted at k pivot nodes chosen
+// uniformly at random without replacement. The values are scaled to be comparable
+// to the values returned by Closeness. If k is not less than the number of nodes
+// in g, the exact closeness is returned. If g implements path.Weighted, edge
+// weights are used as distances.
+//
+// For directed graphs the incoming paths are used. Infinite distances are not
+// considered, so the estimate for a node that is not reachable from any pivot
+// is +Inf. The estimates are most useful for strongly connected graphs.
+//
+// The pivot approximation is described in Eppstein and Wang, "Fast approximation
+// of centrality", J. Graph Algorithms Appl. 8(1):39-45, 2004, doi:10.7155/jgaa.00081.
+// For a connected undirected graph with n nodes and diameter Δ, using k = ⌈ln(n)/ε²⌉
+// pivots estimates the mean distance from every node to all other nodes within εΔ
+// with high probability.
+//
+// If src is nil, rand.IntN is used as the random generator.
+func ClosenessPivots(g graph.Graph, k int, src rand.Source) map[int64]float64 {
+	if k < 1 {
+		panic("network: number of pivots must be positive")
+	}
+	nodes := graph.NodesOf(g.Nodes())
+	sum := make(map[int64]float64, len(nodes))
+	for _, u := range nodes {
+		sum[u.ID()] = 0
+	}
+	pivots := samplePivots(nodes, k, src)
+	for _, v := range pivots {
+		p := path.DijkstraFrom(v, g)
+		for uid := range sum {
+			d := p.WeightTo(uid)
+			if math.IsInf(d, 0) {
+				continue
+			}
+			sum[uid] += d
+		}
+	}
+
+	scale := float64(len(sum)) / float64(len(pivots))
+	c := make(map[int64]float64, len(sum))
+	for uid, s := range sum {
+		c[uid] = 1 / (scale * s)
+	}
+	return c
+}
+
+// samplePivots returns k nodes chosen uniformly at random without replacement
+// from nodes, reordering nodes. If k is not less than len(nodes), nodes is
+// returned.
+func samplePivots(nodes []graph.Node, k int, src rand.Source) []graph.Node {
+	if k >= len(nodes) {
+		return nodes
+	}
+	var intn func(int) int
+	if src == nil {
+		intn = rand.IntN
+	} else {
+		intn = rand.New(src).IntN
+	}
+	for i := range k {
+		j := i + intn(len(nodes)-i)
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+	return nodes[:k]
+}
@@ -0,0 +1,147 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestBetweennessPivotsExact(t *testing.T) {
+	t.Parallel()
+	for i, test := range betweennessTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		got := BetweennessPivots(g, len(test.g), nil)
+		prec := 1 - int(math.Log10(test.wantTol))
+		for n := range test.g {
+			if !scalar.EqualWithinAbsOrRel(got[int64(n)], test.want[int64(n)], test.wantTol, test.wantTol) {
+				t.Errorf("unexpected betweenness result for test %d:\ngot: %v\nwant:%v",
+					i, orderedFloats(got, prec), orderedFloats(test.want, prec))
+				break
+			}
+		}
+	}
+}
+
+var sampledCentralityTests = []struct {
+	name     string
+	directed bool
+	n        int
+	p        float64
+}{
+	{name: "sparse undirected", n: 300, p: 0.03},
+	{name: "dense undirected", n: 200, p: 0.1},
+	{name: "sparse directed", directed: true, n: 300, p: 0.02},
+}
+
+func sampledCentralityGraph(t *testing.T, directed bool, n int, p float64, seed uint64) graph.Graph {
+	var g interface {
+		graph.Graph
+		graph.Builder
+	}
+	if directed {
+		g = simple.NewDirectedGraph()
+	} else {
+		g = simple.NewUndirectedGraph()
+	}
+	err := gen.Gnp(g, n, p, rand.NewPCG(seed, seed))
+	if err != nil {
+		t.Fatalf("unexpected error generating graph: %v", err)
+	}
+	return g
+}
+
+func TestBetweennessApprox(t *testing.T) {
+	t.Parallel()
+	const (
+		epsilon = 0.03
+		delta   = 0.1
+	)
+	for i, test := range sampledCentralityTests {
+		g := sampledCentralityGraph(t, test.directed, test.n, test.p, uint64(i))
+		want := Betweenness(g)
+		got := BetweennessApprox(g, epsilon, delta, rand.NewPCG(1, 1))
+
+		// The failure probability of the bound is delta, but
+		// the test is deterministic for a given seed.
+		bound := epsilon * float64(test.n) * float64(test.n-1)
+		for _, n := range graph.NodesOf(g.Nodes()) {
+			id := n.ID()
+			if math.Abs(got[id]-want[id]) > bound {
+				t.Errorf("betweenness estimate out of bound for %s node %d: got:%v want:%v±%v",
+					test.name, id, got[id], want[id], bound)
+			}
+		}
+		if err := relativeL2Error(g, got, want); err > 0.3 {
+			t.Errorf("unexpected relative error for %s: got:%.3f want:<0.3", test.name, err)
+		}
+	}
+}
+
+func TestBetweennessPivots(t *testing.T) {
+	t.Parallel()
+	for i, test := range sampledCentralityTests {
+		g := sampledCentralityGraph(t, test.directed, test.n, test.p, uint64(i))
+		want := Betweenness(g)
+		got := BetweennessPivots(g, test.n/2, rand.NewPCG(1, 1))
+		if err := relativeL2Error(g, got, want); err > 0.25 {
+			t.Errorf("unexpected relative error for %s: got:%.3f want:<0.25", test.name, err)
+		}
+	}
+}
+
+func TestClosenessPivots(t *testing.T) {
+	t.Parallel()
+	for i, test := range sampledCentralityTests {
+		g := sampledCentralityGraph(t, test.directed, test.n, test.p, uint64(i))
+		p := path.DijkstraAllPaths(g)
+		want := Closeness(g, p)
+
+		got := ClosenessPivots(g, test.n, nil)
+		for _, n := range graph.NodesOf(g.Nodes()) {
+			id := n.ID()
+			if !scalar.EqualWithinAbsOrRel(got[id], want[id], 1e-12, 1e-12) {
+				t.Errorf("unexpected exact closeness for %s node %d: got:%v want:%v", test.name, id, got[id], want[id])
+			}
+		}
+
+		got = ClosenessPivots(g, test.n/4, rand.NewPCG(1, 1))
+		if err := relativeL2Error(g, got, want); err > 0.1 {
+			t.Errorf("unexpected relative error for %s: got:%.3f want:<0.1", test.name, err)
+		}
+	}
+}
+
+// relativeL2Error returns the relative Euclidean distance between the
+// centrality estimates got and the exact values want over the nodes of g.
+// Nodes with matching infinite values are not considered.
+func relativeL2Error(g graph.Graph, got, want map[int64]float64) float64 {
+	var diff, norm float64
+	for _, n := range graph.NodesOf(g.Nodes()) {
+		id := n.ID()
+		if math.IsInf(want[id], 0) && got[id] == want[id] {
+			continue
+		}
+		diff += (got[id] - want[id]) * (got[id] - want[id])
+		norm += want[id] * want[id]
+	}
+	return math.Sqrt(diff / norm)
+}
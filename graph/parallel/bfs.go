@@ -0,0 +1,150 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parallel
+
+import (
+	"sync/atomic"
+)
+
+const (
+	// bfsAlpha and bfsBeta are the direction switching
+	// parameters of the direction-optimizing search.
+	// The search switches to bottom-up steps when the
+	// frontier has more than 1/bfsAlpha of the edges
+	// from unvisited nodes, and back to top-down steps
+	// when the frontier has fewer than 1/bfsBeta of the
+	// nodes of the graph.
+	bfsAlpha = 15
+	bfsBeta  = 18
+)
+
+// BreadthFirst performs a breadth-first search of g from the node with index
+// from using the given number of concurrent workers, and returns the depth
+// and the search tree parent of each node. Nodes that are not reachable from
+// the start node have a depth and parent of -1, and the start node is its own
+// parent. If workers is not positive, runtime.GOMAXPROCS(0) workers are used.
+// BreadthFirst will panic if from is not a valid node index.
+//
+// The search is direction-optimizing, alternating between top-down steps that
+// expand the frontier along the out edges of its nodes and bottom-up steps
+// that search the in edges of unvisited nodes for frontier nodes, as
+// described in Beamer, Asanović and Patterson, "Direction-optimizing
+// breadth-first search", Sci. Program. 21(3-4):137-148, 2013,
+// doi:10.3233/SPR-130370. Which of several equally deep parents is chosen
+// for a node depends on scheduling.
+func BreadthFirst(g Graph, from, workers int) (depth, parent []int32) {
+	n := g.Len()
+	if from < 0 || n <= from {
+		panic("parallel: invalid start node")
+	}
+	depth = make([]int32, n)
+	parent = make([]int32, n)
+	var unexplored int
+	for i := range parent {
+		depth[i] = -1
+		parent[i] = -1
+		unexplored += len(g.Out(i))
+	}
+	depth[from] = 0
+	parent[from] = int32(from)
+	unexplored -= len(g.Out(from))
+
+	var (
+		queue    = []int32{int32(from)}
+		frontier []bool
+		next     []bool
+		size     = 1
+		locals   [][]int32
+	)
+	for d := int32(1); size != 0; d++ {
+		if queue != nil {
+			var edges int
+			for _, u := range queue {
+				edges += len(g.Out(int(u)))
+			}
+			if edges > unexplored/bfsAlpha {
+				// Switch to bottom-up steps.
+				if frontier == nil {
+					frontier = make([]bool, n)
+					next = make([]bool, n)
+				}
+				clear(frontier)
+				for _, u := range queue {
+					frontier[u] = true
+				}
+				queue = nil
+			}
+		} else if size < n/bfsBeta {
+			// Switch to top-down steps.
+			queue = make([]int32, 0, size)
+			for u, f := range frontier {
+				if f {
+					queue = append(queue, int32(u))
+				}
+			}
+		}
+
+		if queue != nil {
+			w := workersFor(workers, len(queue))
+			for len(locals) < w {
+				locals = append(locals, nil)
+			}
+			for i := range locals {
+				locals[i] = locals[i][:0]
+			}
+			parallelFor(len(queue), w, func(w, lo, hi int) {
+				for _, u := range queue[lo:hi] {
+					for _, v := range g.Out(int(u)) {
+						if atomic.LoadInt32(&parent[v]) >= 0 {
+							continue
+						}
+						if atomic.CompareAndSwapInt32(&parent[v], -1, u) {
+							depth[v] = d
+							locals[w] = append(locals[w], v)
+						}
+					}
+				}
+			})
+			queue = queue[:0]
+			for _, l := range locals[:w] {
+				queue = append(queue, l...)
+			}
+			size = len(queue)
+			for _, v := range queue {
+				unexplored -= len(g.Out(int(v)))
+			}
+			continue
+		}
+
+		w := workersFor(workers, n)
+		counts := make([]int, w)
+		explored := make([]int, w)
+		parallelFor(n, w, func(w, lo, hi int) {
+			for v := lo; v < hi; v++ {
+				next[v] = false
+				if parent[v] >= 0 {
+					continue
+				}
+				for _, u := range g.In(v) {
+					if frontier[u] {
+						parent[v] = u
+						depth[v] = d
+						next[v] = true
+						counts[w]++
+						explored[w] += len(g.Out(v))
+						break
+					}
+				}
+			}
+		})
+		size = 0
+		for i := range counts {
+			size += counts[i]
+			unexplored -= explored[i]
+		}
+		frontier, next = next, frontier
+	}
+	return depth, parent
+}
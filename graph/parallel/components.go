@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parallel
+
+import (
+	"sync/atomic"
+)
+
+// ConnectedComponents returns the weakly connected component label of each
+// node of g, found using the given number of concurrent workers. The label of
+// a node is the smallest index of the nodes in its component. If workers is
+// not positive, runtime.GOMAXPROCS(0) workers are used.
+//
+// The components are found by label propagation: each node repeatedly takes
+// the smallest label of its neighbors until no label changes. Propagation is
+// accelerated by also lowering the label of the node named by a node's label,
+// and by replacing each label with the label of the node it names after each
+// round, as in the Shiloach-Vishkin algorithm, so that the number of rounds
+// is much smaller than the diameter of the graph for most graphs.
+func ConnectedComponents(g Graph, workers int) []int32 {
+	n := g.Len()
+	label := make([]int32, n)
+	for i := range label {
+		label[i] = int32(i)
+	}
+	w := workersFor(workers, n)
+	changed := make([]bool, w)
+	for {
+		clear(changed)
+		parallelFor(n, w, func(w, lo, hi int) {
+			for v := lo; v < hi; v++ {
+				l := atomic.LoadInt32(&label[v])
+				m := l
+				for _, adj := range [2][]int32{g.Out(v), g.In(v)} {
+					for _, u := range adj {
+						m = min(m, atomic.LoadInt32(&label[u]))
+					}
+				}
+				if m == l {
+					continue
+				}
+				changed[w] = true
+				lower(label, int32(v), m)
+				lower(label, l, m)
+			}
+		})
+		parallelFor(n, w, func(_, lo, hi int) {
+			for v := lo; v < hi; v++ {
+				l := atomic.LoadInt32(&label[v])
+				for {
+					p := atomic.LoadInt32(&label[l])
+					if p == l {
+						break
+					}
+					l = p
+				}
+				lower(label, int32(v), l)
+			}
+		})
+		done := true
+		for _, c := range changed {
+			done = done && !c
+		}
+		if done {
+			return label
+		}
+	}
+}
+
+// lower atomically sets label[v] to l if l is smaller than label[v].
+func lower(label []int32, v, l int32) {
+	for {
+		old := atomic.LoadInt32(&label[v])
+		if old <= l || atomic.CompareAndSwapInt32(&label[v], old, l) {
+			return
+		}
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package parallel provides multi-core implementations of graph analysis
+// algorithms for large graphs.
+//
+// The algorithms operate on graphs with densely indexed nodes and adjacency
+// held in slices, as provided by compressed sparse row representations,
+// through the Graph interface. Any graph.Graph can be converted to a Graph
+// by Index. Results are returned in slices indexed by node index.
+package parallel // import "gonum.org/v1/gonum/graph/parallel"
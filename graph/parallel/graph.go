@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parallel
+
+import (
+	"math"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Graph is a graph with nodes identified by the indices [0, Len()) and
+// adjacency held in slices. The methods of a Graph must be safe for
+// concurrent use and the returned slices must not be modified.
+type Graph interface {
+	// Len returns the number of nodes in the graph.
+	Len() int
+
+	// Out returns the indices of the nodes that can be
+	// reached directly from node i.
+	Out(i int) []int32
+
+	// In returns the indices of the nodes that can reach
+	// node i directly. For undirected graphs, In and Out
+	// return the same nodes.
+	In(i int) []int32
+}
+
+// Index returns a Graph holding the adjacency of g and the nodes of g in
+// index order. Nodes are indexed in order of increasing ID. Self edges are
+// retained. Index will panic if g has more than math.MaxInt32 nodes.
+func Index(g graph.Graph) (Graph, []graph.Node) {
+	nodes := graph.NodesOf(g.Nodes())
+	if len(nodes) > math.MaxInt32 {
+		panic("parallel: too many nodes")
+	}
+	order.ByID(nodes)
+	indexOf := make(map[int64]int32, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = int32(i)
+	}
+
+	adjacency := func(next func(id int64) graph.Nodes) ([]int, []int32) {
+		offsets := make([]int, len(nodes)+1)
+		var targets []int32
+		for i, n := range nodes {
+			to := next(n.ID())
+			for to.Next() {
+				targets = append(targets, indexOf[to.Node().ID()])
+			}
+			slices.Sort(targets[offsets[i]:])
+			offsets[i+1] = len(targets)
+		}
+		return offsets, targets
+	}
+
+	var c compressed
+	c.outOffsets, c.out = adjacency(g.From)
+	if d, ok := g.(graph.Directed); ok {
+		c.inOffsets, c.in = adjacency(d.To)
+	} else {
+		c.inOffsets, c.in = c.outOffsets, c.out
+	}
+	return c, nodes
+}
+
+// compressed is a Graph held in compressed sparse row form.
+type compressed struct {
+	outOffsets []int
+	out        []int32
+	inOffsets  []int
+	in         []int32
+}
+
+func (c compressed) Len() int { return len(c.outOffsets) - 1 }
+
+func (c compressed) Out(i int) []int32 {
+	return c.out[c.outOffsets[i]:c.outOffsets[i+1]:c.outOffsets[i+1]]
+}
+
+func (c compressed) In(i int) []int32 {
+	return c.in[c.inOffsets[i]:c.inOffsets[i+1]:c.inOffsets[i+1]]
+}
+
+// chunk is the number of items claimed at a time by
+// each worker in a parallel loop.
+const chunk = 256
+
+// workersFor returns the number of workers to use for a loop over n
+// items when workers are requested. If workers is not positive, the
+// value of runtime.GOMAXPROCS(0) is used.
+func workersFor(workers, n int) int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return max(1, min(workers, (n+chunk-1)/chunk))
+}
+
+// parallelFor calls fn for the ranges [lo, hi) covering [0, n), using
+// the given number of concurrent workers. Each worker is identified by
+// an index w in [0, workers) and claims ranges of up to chunk items in
+// turn so that the work is balanced between workers.
+func parallelFor(n, workers int, fn func(w, lo, hi int)) {
+	if workers == 1 {
+		for lo := 0; lo < n; lo += chunk {
+			fn(0, lo, min(lo+chunk, n))
+		}
+		return
+	}
+	var (
+		next atomic.Int64
+		wg   sync.WaitGroup
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				lo := int(next.Add(chunk)) - chunk
+				if lo >= n {
+					return
+				}
+				fn(w, lo, min(lo+chunk, n))
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,261 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parallel
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+var testGraphs = []struct {
+	name string
+	g    func() graph.Graph
+}{
+	{name: "empty undirected", g: func() graph.Graph { return simple.NewUndirectedGraph() }},
+	{name: "path", g: func() graph.Graph { return pathGraph(2000) }},
+	{name: "sparse undirected", g: func() graph.Graph { return gnp(false, 2000, 0.001, 1) }},
+	{name: "dense undirected", g: func() graph.Graph { return gnp(false, 1000, 0.05, 2) }},
+	{name: "sparse directed", g: func() graph.Graph { return gnp(true, 2000, 0.001, 3) }},
+	{name: "dense directed", g: func() graph.Graph { return gnp(true, 1000, 0.02, 4) }},
+}
+
+func gnp(directed bool, n int, p float64, seed uint64) graph.Graph {
+	var g graph.Builder
+	if directed {
+		g = simple.NewDirectedGraph()
+	} else {
+		g = simple.NewUndirectedGraph()
+	}
+	err := gen.Gnp(g, n, p, rand.NewPCG(seed, seed))
+	if err != nil {
+		panic(fmt.Sprintf("parallel: bad test: %v", err))
+	}
+	return g.(graph.Graph)
+}
+
+func pathGraph(n int) graph.Graph {
+	g := simple.NewUndirectedGraph()
+	// Add the path in an order that makes label
+	// propagation from low indices slow.
+	for i := n - 1; i > 0; i-- {
+		g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i - 1)})
+	}
+	return g
+}
+
+func TestIndex(t *testing.T) {
+	t.Parallel()
+	g := simple.NewDirectedGraph()
+	for _, e := range [][2]int64{{10, 2}, {2, 7}, {10, 7}, {7, 2}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	ig, nodes := Index(g)
+	if ig.Len() != 3 {
+		t.Fatalf("unexpected number of nodes: got:%d want:3", ig.Len())
+	}
+	for i, want := range []int64{2, 7, 10} {
+		if nodes[i].ID() != want {
+			t.Errorf("unexpected node at index %d: got:%d want:%d", i, nodes[i].ID(), want)
+		}
+	}
+	for i, want := range []struct{ out, in string }{
+		{out: "[1]", in: "[1 2]"},
+		{out: "[0]", in: "[0 2]"},
+		{out: "[0 1]", in: "[]"},
+	} {
+		if got := fmt.Sprint(ig.Out(i)); got != want.out {
+			t.Errorf("unexpected out nodes of %d: got:%s want:%s", i, got, want.out)
+		}
+		if got := fmt.Sprint(ig.In(i)); got != want.in {
+			t.Errorf("unexpected in nodes of %d: got:%s want:%s", i, got, want.in)
+		}
+	}
+}
+
+func TestBreadthFirst(t *testing.T) {
+	t.Parallel()
+	for _, test := range testGraphs {
+		g := test.g()
+		ig, nodes := Index(g)
+		for _, workers := range []int{1, 4} {
+			for _, from := range []int{0, len(nodes) / 2} {
+				if from >= len(nodes) {
+					continue
+				}
+				depth, parent := BreadthFirst(ig, from, workers)
+
+				want := make(map[int64]int)
+				var bf traverse.BreadthFirst
+				bf.Walk(g, nodes[from], func(n graph.Node, d int) bool {
+					want[n.ID()] = d
+					return false
+				})
+				for v, n := range nodes {
+					d, ok := want[n.ID()]
+					if !ok {
+						d = -1
+					}
+					if int(depth[v]) != d {
+						t.Fatalf("unexpected depth of node %d from %d in %s graph with %d workers: got:%d want:%d",
+							v, from, test.name, workers, depth[v], d)
+					}
+					switch {
+					case d < 0:
+						if parent[v] != -1 {
+							t.Errorf("unexpected parent of unreachable node %d in %s graph: %d", v, test.name, parent[v])
+						}
+					case d == 0:
+						if int(parent[v]) != v {
+							t.Errorf("unexpected parent of start node in %s graph: %d", test.name, parent[v])
+						}
+					default:
+						p := parent[v]
+						if depth[p] != depth[v]-1 || g.Edge(nodes[p].ID(), n.ID()) == nil {
+							t.Errorf("invalid parent of node %d in %s graph: %d", v, test.name, p)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	t.Parallel()
+	for _, test := range testGraphs {
+		g := test.g()
+		ig, nodes := Index(g)
+		index := make(map[int64]int32)
+		for i, n := range nodes {
+			index[n.ID()] = int32(i)
+		}
+		u, ok := g.(graph.Undirected)
+		if !ok {
+			u = graph.Undirect{G: g.(graph.Directed)}
+		}
+		want := make([]int32, len(nodes))
+		cc := topo.ConnectedComponents(u)
+		for _, c := range cc {
+			l := int32(math.MaxInt32)
+			for _, n := range c {
+				l = min(l, index[n.ID()])
+			}
+			for _, n := range c {
+				want[index[n.ID()]] = l
+			}
+		}
+		for _, workers := range []int{1, 4} {
+			got := ConnectedComponents(ig, workers)
+			for i := range want {
+				if got[i] != want[i] {
+					t.Fatalf("unexpected label of node %d in %s graph with %d workers: got:%d want:%d",
+						i, test.name, workers, got[i], want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestTriangles(t *testing.T) {
+	t.Parallel()
+	for _, test := range testGraphs {
+		g, ok := test.g().(graph.Undirected)
+		if !ok {
+			continue
+		}
+		ig, nodes := Index(g)
+
+		// Count triangles by brute force.
+		want := make([]int64, len(nodes))
+		var wantTotal int64
+		for i, u := range nodes {
+			for j := i + 1; j < len(nodes); j++ {
+				v := nodes[j]
+				if !g.HasEdgeBetween(u.ID(), v.ID()) {
+					continue
+				}
+				for k := j + 1; k < len(nodes); k++ {
+					w := nodes[k]
+					if g.HasEdgeBetween(u.ID(), w.ID()) && g.HasEdgeBetween(v.ID(), w.ID()) {
+						want[i]++
+						want[j]++
+						want[k]++
+						wantTotal++
+					}
+				}
+			}
+		}
+
+		for _, workers := range []int{1, 4} {
+			got, total := Triangles(ig, workers)
+			if total != wantTotal {
+				t.Errorf("unexpected number of triangles in %s graph with %d workers: got:%d want:%d",
+					test.name, workers, total, wantTotal)
+			}
+			for i := range want {
+				if got[i] != want[i] {
+					t.Fatalf("unexpected number of triangles at node %d in %s graph with %d workers: got:%d want:%d",
+						i, test.name, workers, got[i], want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestClustering(t *testing.T) {
+	t.Parallel()
+	// A triangle with a pendant node and an isolated node.
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	g.AddNode(simple.Node(4))
+	ig, _ := Index(g)
+
+	local := LocalClustering(ig, 0)
+	want := []float64{1, 1, 1.0 / 3, 0, 0}
+	for i := range want {
+		if math.Abs(local[i]-want[i]) > 1e-15 {
+			t.Errorf("unexpected local clustering of node %d: got:%v want:%v", i, local[i], want[i])
+		}
+	}
+	// There is one triangle and five connected triples.
+	if got, want := Transitivity(ig, 0), 3.0/5; math.Abs(got-want) > 1e-15 {
+		t.Errorf("unexpected transitivity: got:%v want:%v", got, want)
+	}
+}
+
+func BenchmarkBreadthFirst(b *testing.B) {
+	for _, directed := range []bool{false, true} {
+		g, _ := Index(gnp(directed, 100000, 1e-4, 1))
+		b.Run(fmt.Sprintf("directed=%t", directed), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				BreadthFirst(g, 0, 0)
+			}
+		})
+	}
+}
+
+func BenchmarkConnectedComponents(b *testing.B) {
+	g, _ := Index(gnp(false, 100000, 1e-5, 1))
+	for i := 0; i < b.N; i++ {
+		ConnectedComponents(g, 0)
+	}
+}
+
+func BenchmarkTriangles(b *testing.B) {
+	g, _ := Index(gnp(false, 20000, 1e-3, 1))
+	for i := 0; i < b.N; i++ {
+		Triangles(g, 0)
+	}
+}
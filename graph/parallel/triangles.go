@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parallel
+
+import (
+	"math/bits"
+	"sync/atomic"
+)
+
+// Triangles returns the number of triangles holding each node of the
+// undirected graph g and the total number of triangles in g, found using the
+// given number of concurrent workers. Self edges are ignored and g must not
+// hold multiple edges between a pair of nodes. If workers is not positive,
+// runtime.GOMAXPROCS(0) workers are used.
+//
+// Triangles are counted by node iteration with hashing over edges oriented
+// from lower to higher degree nodes, so that each triangle is found once, as
+// described in Schank and Wagner, "Finding, counting and listing all
+// triangles in large graphs, an experimental study", WEA 2005, LNCS 3503:606-609,
+// doi:10.1007/11427186_54.
+func Triangles(g Graph, workers int) (perNode []int64, total int64) {
+	n := g.Len()
+	degree := degrees(g)
+	// higher returns whether u follows v in the
+	// orientation order of nodes.
+	higher := func(v, u int32) bool {
+		return degree[u] > degree[v] || (degree[u] == degree[v] && u > v)
+	}
+
+	perNode = make([]int64, n)
+	w := workersFor(workers, n)
+	sets := make([]int32Set, w)
+	totals := make([]int64, w)
+	parallelFor(n, w, func(w, lo, hi int) {
+		set := &sets[w]
+		for v := int32(lo); v < int32(hi); v++ {
+			set.reset(len(g.Out(int(v))))
+			for _, u := range g.Out(int(v)) {
+				if higher(v, u) {
+					set.add(u)
+				}
+			}
+			if set.len < 2 {
+				continue
+			}
+			var tv int64
+			for _, u := range g.Out(int(v)) {
+				if !higher(v, u) {
+					continue
+				}
+				var tu int64
+				for _, x := range g.Out(int(u)) {
+					if higher(u, x) && set.has(x) {
+						atomic.AddInt64(&perNode[x], 1)
+						tu++
+					}
+				}
+				if tu != 0 {
+					atomic.AddInt64(&perNode[u], tu)
+					tv += tu
+				}
+			}
+			if tv != 0 {
+				atomic.AddInt64(&perNode[v], tv)
+				totals[w] += tv
+			}
+		}
+	})
+	for _, t := range totals {
+		total += t
+	}
+	return perNode, total
+}
+
+// LocalClustering returns the local clustering coefficient of each node of
+// the undirected graph g, found using the given number of concurrent workers.
+// The local clustering coefficient of a node is the fraction of pairs of its
+// neighbors that are adjacent, and is zero for nodes with fewer than two
+// neighbors. The requirements on g are those of Triangles.
+func LocalClustering(g Graph, workers int) []float64 {
+	t, _ := Triangles(g, workers)
+	degree := degrees(g)
+	c := make([]float64, len(t))
+	for v, k := range degree {
+		if k < 2 {
+			continue
+		}
+		c[v] = 2 * float64(t[v]) / (float64(k) * float64(k-1))
+	}
+	return c
+}
+
+// Transitivity returns the transitivity, or global clustering coefficient,
+// of the undirected graph g, found using the given number of concurrent
+// workers. The transitivity is three times the number of triangles divided
+// by the number of connected triples of nodes, and is zero if g has no
+// connected triples. The requirements on g are those of Triangles.
+func Transitivity(g Graph, workers int) float64 {
+	_, t := Triangles(g, workers)
+	var triples float64
+	for _, k := range degrees(g) {
+		triples += float64(k) * float64(k-1) / 2
+	}
+	if triples == 0 {
+		return 0
+	}
+	return 3 * float64(t) / triples
+}
+
+// degrees returns the number of neighbors of each node of g,
+// excluding the node itself.
+func degrees(g Graph) []int32 {
+	degree := make([]int32, g.Len())
+	for v := range degree {
+		for _, u := range g.Out(v) {
+			if int(u) != v {
+				degree[v]++
+			}
+		}
+	}
+	return degree
+}
+
+// int32Set is an open addressing hash set of non-negative
+// int32 values.
+type int32Set struct {
+	slots []int32
+	shift uint
+	len   int
+}
+
+// reset empties the set and prepares it to hold up to n values.
+func (s *int32Set) reset(n int) {
+	size := 1 << bits.Len(uint(2*n))
+	if size > cap(s.slots) {
+		s.slots = make([]int32, size)
+	}
+	s.slots = s.slots[:size]
+	for i := range s.slots {
+		s.slots[i] = -1
+	}
+	s.shift = uint(32 - bits.Len(uint(size-1)))
+	s.len = 0
+}
+
+func (s *int32Set) hash(v int32) int {
+	return int((uint32(v) * 0x9e3779b1) >> s.shift)
+}
+
+// add adds v to the set.
+func (s *int32Set) add(v int32) {
+	mask := len(s.slots) - 1
+	for i := s.hash(v); ; i = (i + 1) & mask {
+		switch s.slots[i] {
+		case v:
+			return
+		case -1:
+			s.slots[i] = v
+			s.len++
+			return
+		}
+	}
+}
+
+// has returns whether v is in the set.
+func (s *int32Set) has(v int32) bool {
+	mask := len(s.slots) - 1
+	for i := s.hash(v); ; i = (i + 1) & mask {
+		switch s.slots[i] {
+		case v:
+			return true
+		case -1:
+			return false
+		}
+	}
+}
@@ -33,12 +33,7 @@ func BellmanFordFrom(u graph.Node, g traverse.Graph) (path Shortest, ok bool) {
 	path.dist[path.indexOf[u.ID()]] = 0
 	path.negCosts = make(map[negEdge]float64)
 
-	var weight Weighting
-	if wg, ok := g.(Weighted); ok {
-		weight = wg.Weight
-	} else {
-		weight = UniformCost(g)
-	}
+	weight := weightingFor(g)
 
 	// Queue to keep track which nodes need to be relaxed.
 	// Only nodes whose vertex distance changed in the previous iterations
@@ -114,12 +109,7 @@ func BellmanFordAllFrom(u graph.Node, g traverse.Graph) (path ShortestAlts, ok b
 	path.dist[path.indexOf[u.ID()]] = 0
 	path.negCosts = make(map[negEdge]float64)
 
-	var weight Weighting
-	if wg, ok := g.(Weighted); ok {
-		weight = wg.Weight
-	} else {
-		weight = UniformCost(g)
-	}
+	weight := weightingFor(g)
 
 	// Queue to keep track which nodes need to be relaxed.
 	// Only nodes whose vertex distance changed in the previous iterations
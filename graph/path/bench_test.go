@@ -27,6 +27,7 @@ var (
 	nswUndirected_10_2_5_2   = navigableSmallWorldUndirected(10, 2, 5, 2)
 	nswUndirected_100_5_10_2 = navigableSmallWorldUndirected(100, 5, 10, 2)
 	nswUndirected_100_5_20_2 = navigableSmallWorldUndirected(100, 5, 20, 2)
+	nswUndirected_100_1_0_2  = navigableSmallWorldUndirected(100, 1, 0, 2)
 )
 
 func gnpUndirected(n int, p float64) func() graph.Undirected {
@@ -190,3 +191,29 @@ func BenchmarkBellmanFordFrom(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkPointToPoint(b *testing.B) {
+	// A road-like 100×100 lattice with no long range links.
+	g := nswUndirected_100_1_0_2()
+	ch := NewContractionHierarchy(g)
+	s, t := simple.Node(0), simple.Node(100*100-1)
+	benchmarks := []struct {
+		name string
+		fn   func() float64
+	}{
+		{"DijkstraFromTo", func() float64 { _, w := DijkstraFromTo(s, t, g); return w }},
+		{"DijkstraBidirectional", func() float64 { _, w := DijkstraBidirectional(s, t, g); return w }},
+		{"ContractionHierarchy", func() float64 { _, w := ch.Between(s.ID(), t.ID()); return w }},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			var w float64
+			for i := 0; i < b.N; i++ {
+				w = bm.fn()
+			}
+			if w == 0 {
+				b.Fatal("unexpected path weight")
+			}
+		})
+	}
+}
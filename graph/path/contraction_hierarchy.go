@@ -0,0 +1,475 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// ContractionHierarchy is a shortest path index for fast repeated
+// point-to-point shortest path queries. It is constructed by contracting
+// the nodes of a graph in order of importance, adding shortcut edges that
+// preserve shortest path distances between the remaining nodes, as
+// described in Geisberger et al., "Contraction Hierarchies: Faster and
+// Simpler Hierarchical Routing in Road Networks", WEA 2008,
+// doi:10.1007/978-3-540-68552-4_24.
+//
+// Queries perform a bidirectional search that only moves upward in the
+// node ordering and so settle very few nodes on graphs with a hierarchical
+// structure such as road networks. A ContractionHierarchy is not updated
+// when the graph it was built from changes. It is safe for concurrent
+// queries.
+type ContractionHierarchy struct {
+	nodes   []graph.Node
+	indexOf map[int64]int
+
+	// up[u] holds the arcs from u to higher
+	// ranked nodes and down[v] holds the arcs
+	// into v from higher ranked nodes, with
+	// the arc tails recorded in to.
+	up, down [][]chArc
+
+	// via holds the contracted node bypassed
+	// by each shortcut arc.
+	via map[chPair]int
+}
+
+// chArc is an arc in a contraction hierarchy.
+type chArc struct {
+	to     int
+	weight float64
+}
+
+// chPair is the tail and head of a shortcut arc.
+type chPair struct{ from, to int }
+
+// chWitnessLimit is the maximum number of nodes settled by
+// a witness search during contraction. Stopping a witness
+// search early only adds unnecessary shortcuts.
+const chWitnessLimit = 100
+
+// NewContractionHierarchy returns a ContractionHierarchy for the graph g.
+// If g is directed, path directions are respected. If the graph does not
+// implement Weighted, UniformCost is used. NewContractionHierarchy will
+// panic if g has a negative edge weight.
+func NewContractionHierarchy(g graph.Graph) *ContractionHierarchy {
+	weight := weightingFor(g)
+
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	c := chBuilder{
+		out:     make([][]chArc, len(nodes)),
+		in:      make([][]chArc, len(nodes)),
+		deleted: make([]int, len(nodes)),
+		via:     make(map[chPair]int),
+		dist:    make([]float64, len(nodes)),
+		target:  make([]bool, len(nodes)),
+	}
+	for i := range nodes {
+		c.dist[i] = math.Inf(1)
+	}
+	for u, n := range nodes {
+		uid := n.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			w, ok := weight(uid, vid)
+			if !ok {
+				panic("contraction hierarchy: unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("contraction hierarchy: negative edge weight")
+			}
+			c.addArc(u, indexOf[vid], w, -1)
+		}
+	}
+
+	ch := &ContractionHierarchy{
+		nodes:   nodes,
+		indexOf: indexOf,
+		up:      make([][]chArc, len(nodes)),
+		down:    make([][]chArc, len(nodes)),
+	}
+
+	// Contract nodes in order of priority, lazily updating
+	// priorities when a node reaches the front of the queue.
+	q := make(chQueue, len(nodes))
+	for v := range nodes {
+		q[v] = chItem{node: v, priority: c.priority(v)}
+	}
+	heap.Init(&q)
+	for q.Len() != 0 {
+		v := heap.Pop(&q).(chItem).node
+		p := c.priority(v)
+		if q.Len() != 0 && p > q[0].priority {
+			heap.Push(&q, chItem{node: v, priority: p})
+			continue
+		}
+
+		ch.down[v] = c.in[v]
+		ch.up[v] = c.out[v]
+		c.shortcuts(v, true)
+		c.contract(v)
+	}
+	ch.via = c.via
+
+	return ch
+}
+
+// Weight returns the weight of the shortest path from u to v. If v is not
+// reachable from u, Weight returns +Inf.
+func (ch *ContractionHierarchy) Weight(uid, vid int64) float64 {
+	_, _, weight := ch.search(uid, vid)
+	return weight
+}
+
+// Between returns a shortest path from u to v and the weight of the path.
+// If v is not reachable from u, path is nil and weight is +Inf.
+func (ch *ContractionHierarchy) Between(uid, vid int64) (path []graph.Node, weight float64) {
+	fwd, bwd, weight := ch.search(uid, vid)
+	if math.IsInf(weight, 1) {
+		return nil, weight
+	}
+	s := ch.indexOf[uid]
+	t := ch.indexOf[vid]
+
+	// Collect the upward and downward chains of arcs
+	// through the meeting node and unpack shortcuts.
+	var chain []int
+	for n := fwd.meet; n != s; n = fwd.prev[n] {
+		chain = append(chain, n)
+	}
+	chain = append(chain, s)
+	slices.Reverse(chain)
+	for n := bwd.meet; n != t; {
+		n = bwd.prev[n]
+		chain = append(chain, n)
+	}
+	path = []graph.Node{ch.nodes[s]}
+	for i := 1; i < len(chain); i++ {
+		path = ch.unpack(path, chain[i-1], chain[i])
+	}
+	return path, weight
+}
+
+// unpack appends the nodes of the arc from u to v after u to dst,
+// recursively expanding shortcuts.
+func (ch *ContractionHierarchy) unpack(dst []graph.Node, u, v int) []graph.Node {
+	m, ok := ch.via[chPair{from: u, to: v}]
+	if !ok {
+		return append(dst, ch.nodes[v])
+	}
+	dst = ch.unpack(dst, u, m)
+	return ch.unpack(dst, m, v)
+}
+
+// chSearch is one direction of a contraction hierarchy query.
+type chSearch struct {
+	dist  map[int]float64
+	prev  map[int]int
+	queue chDistQueue
+	meet  int
+}
+
+// search performs the bidirectional upward search between u and v,
+// returning both search states and the shortest path weight.
+func (ch *ContractionHierarchy) search(uid, vid int64) (fwd, bwd *chSearch, weight float64) {
+	s, ok := ch.indexOf[uid]
+	if !ok {
+		return nil, nil, math.Inf(1)
+	}
+	t, ok := ch.indexOf[vid]
+	if !ok {
+		return nil, nil, math.Inf(1)
+	}
+	fwd = &chSearch{dist: map[int]float64{s: 0}, prev: make(map[int]int), queue: chDistQueue{{node: s}}}
+	bwd = &chSearch{dist: map[int]float64{t: 0}, prev: make(map[int]int), queue: chDistQueue{{node: t}}}
+	if s == t {
+		fwd.meet, bwd.meet = s, t
+		return fwd, bwd, 0
+	}
+
+	best := math.Inf(1)
+	for fwd.queue.Len() != 0 || bwd.queue.Len() != 0 {
+		for _, d := range []struct {
+			this, other *chSearch
+			arcs        [][]chArc
+		}{
+			{this: fwd, other: bwd, arcs: ch.up},
+			{this: bwd, other: fwd, arcs: ch.down},
+		} {
+			if d.this.queue.Len() == 0 {
+				continue
+			}
+			mid := d.this.queue.pop()
+			if mid.dist >= best {
+				// Searches in each direction may stop once
+				// they cannot improve on the best path.
+				d.this.queue = d.this.queue[:0]
+				continue
+			}
+			u := mid.node
+			if mid.dist > d.this.dist[u] {
+				continue
+			}
+			if od, ok := d.other.dist[u]; ok && mid.dist+od < best {
+				best = mid.dist + od
+				fwd.meet, bwd.meet = u, u
+			}
+			for _, a := range d.arcs[u] {
+				joint := mid.dist + a.weight
+				if old, ok := d.this.dist[a.to]; !ok || joint < old {
+					d.this.dist[a.to] = joint
+					d.this.prev[a.to] = u
+					d.this.queue.push(chDistItem{node: a.to, dist: joint})
+				}
+			}
+		}
+	}
+	return fwd, bwd, best
+}
+
+// chBuilder holds the state of a contraction hierarchy during
+// construction.
+type chBuilder struct {
+	// out and in hold the arcs between
+	// uncontracted nodes, with in holding
+	// arc tails in to.
+	out, in [][]chArc
+
+	deleted []int // deleted[v] is the number of contracted neighbours of v.
+	via     map[chPair]int
+
+	// dist, target and touched are the
+	// working state of witness searches.
+	dist    []float64
+	target  []bool
+	touched []int
+	queue   chDistQueue
+}
+
+// addArc adds an arc from u to v with weight w, bypassing the node via
+// if via is not negative. Parallel arcs are reduced to the lightest.
+func (c *chBuilder) addArc(u, v int, w float64, via int) {
+	if !setArc(&c.out[u], v, w) {
+		return
+	}
+	setArc(&c.in[v], u, w)
+	if via >= 0 {
+		c.via[chPair{from: u, to: v}] = via
+	}
+}
+
+// setArc sets the weight of the arc to v in arcs to w if the arc is
+// absent or heavier, returning whether arcs was changed.
+func setArc(arcs *[]chArc, v int, w float64) bool {
+	for i, a := range *arcs {
+		if a.to == v {
+			if a.weight <= w {
+				return false
+			}
+			(*arcs)[i].weight = w
+			return true
+		}
+	}
+	*arcs = append(*arcs, chArc{to: v, weight: w})
+	return true
+}
+
+// removeArc removes the arc to v from arcs.
+func removeArc(arcs *[]chArc, v int) {
+	for i, a := range *arcs {
+		if a.to == v {
+			last := len(*arcs) - 1
+			(*arcs)[i] = (*arcs)[last]
+			*arcs = (*arcs)[:last]
+			return
+		}
+	}
+}
+
+// priority returns the contraction priority of v. Nodes with lower
+// priority are contracted first.
+func (c *chBuilder) priority(v int) int {
+	return 2*(c.shortcuts(v, false)-len(c.in[v])-len(c.out[v])) + c.deleted[v]
+}
+
+// shortcuts returns the number of shortcuts required to contract v,
+// adding them to the graph if add is true.
+func (c *chBuilder) shortcuts(v int, add bool) int {
+	var n int
+	// Arcs added to c.out[v] by addArc while iterating
+	// are to nodes in c.in[v] and so are skipped.
+	in := c.in[v]
+	out := c.out[v]
+	for _, a := range in {
+		u := a.to
+		var maxOut float64
+		var targets int
+		for _, b := range out {
+			if b.to != u {
+				maxOut = max(maxOut, b.weight)
+				c.target[b.to] = true
+				targets++
+			}
+		}
+		c.witness(u, v, a.weight+maxOut, targets)
+		for _, b := range out {
+			if b.to == u {
+				continue
+			}
+			c.target[b.to] = false
+			if d := a.weight + b.weight; d < c.dist[b.to] {
+				n++
+				if add {
+					c.addArc(u, b.to, d, v)
+				}
+			}
+		}
+		c.resetWitness()
+	}
+	return n
+}
+
+// witness performs a bounded Dijkstra search from u in the graph of
+// uncontracted nodes excluding v, leaving distances in c.dist. The
+// search stops when the distance exceeds limit or when all of the
+// marked targets have been settled.
+func (c *chBuilder) witness(u, v int, limit float64, targets int) {
+	c.dist[u] = 0
+	c.touched = append(c.touched, u)
+	c.queue = append(c.queue[:0], chDistItem{node: u})
+	for settled := 0; c.queue.Len() != 0 && settled < chWitnessLimit && targets > 0; settled++ {
+		mid := c.queue.pop()
+		if mid.dist > c.dist[mid.node] {
+			continue
+		}
+		if mid.dist > limit {
+			break
+		}
+		if c.target[mid.node] {
+			targets--
+		}
+		for _, a := range c.out[mid.node] {
+			if a.to == v {
+				continue
+			}
+			joint := mid.dist + a.weight
+			if joint < c.dist[a.to] {
+				if math.IsInf(c.dist[a.to], 1) {
+					c.touched = append(c.touched, a.to)
+				}
+				c.dist[a.to] = joint
+				c.queue.push(chDistItem{node: a.to, dist: joint})
+			}
+		}
+	}
+}
+
+// resetWitness clears the state of the last witness search.
+func (c *chBuilder) resetWitness() {
+	for _, x := range c.touched {
+		c.dist[x] = math.Inf(1)
+	}
+	c.touched = c.touched[:0]
+}
+
+// contract removes v from the graph of uncontracted nodes.
+func (c *chBuilder) contract(v int) {
+	for _, a := range c.in[v] {
+		removeArc(&c.out[a.to], v)
+		c.deleted[a.to]++
+	}
+	for _, a := range c.out[v] {
+		removeArc(&c.in[a.to], v)
+		c.deleted[a.to]++
+	}
+	c.in[v] = nil
+	c.out[v] = nil
+}
+
+// chItem is a node in the contraction order queue.
+type chItem struct {
+	node     int
+	priority int
+}
+
+// chQueue is a priority queue of nodes ordered by contraction priority.
+type chQueue []chItem
+
+func (q chQueue) Len() int            { return len(q) }
+func (q chQueue) Less(i, j int) bool  { return q[i].priority < q[j].priority }
+func (q chQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *chQueue) Push(n interface{}) { *q = append(*q, n.(chItem)) }
+func (q *chQueue) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
+
+// chDistItem is a node and its distance in a contraction hierarchy search.
+type chDistItem struct {
+	node int
+	dist float64
+}
+
+// chDistQueue implements a no-dec binary heap of nodes ordered by
+// distance. It does not use container/heap to avoid boxing items in
+// the witness searches that dominate construction time.
+type chDistQueue []chDistItem
+
+func (q chDistQueue) Len() int { return len(q) }
+
+// push adds n to the heap.
+func (q *chDistQueue) push(n chDistItem) {
+	*q = append(*q, n)
+	h := *q
+	i := len(h) - 1
+	for i > 0 {
+		p := (i - 1) / 2
+		if h[p].dist <= h[i].dist {
+			break
+		}
+		h[p], h[i] = h[i], h[p]
+		i = p
+	}
+}
+
+// pop removes and returns the item with the smallest distance.
+func (q *chDistQueue) pop() chDistItem {
+	h := *q
+	n := h[0]
+	last := len(h) - 1
+	h[0] = h[last]
+	h = h[:last]
+	for i := 0; ; {
+		l := 2*i + 1
+		if l >= len(h) {
+			break
+		}
+		m := l
+		if r := l + 1; r < len(h) && h[r].dist < h[l].dist {
+			m = r
+		}
+		if h[i].dist <= h[m].dist {
+			break
+		}
+		h[i], h[m] = h[m], h[i]
+		i = m
+	}
+	*q = h
+	return n
+}
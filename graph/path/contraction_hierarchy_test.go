@@ -0,0 +1,122 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// randomWeightedGraph returns a random weighted graph with n nodes, each
+// pair being joined with probability p, and integer weights in [0, 10).
+func randomWeightedGraph(n int, p float64, directed bool, rnd *rand.Rand) graph.Weighted {
+	type builder interface {
+		graph.Weighted
+		AddNode(graph.Node)
+		SetWeightedEdge(graph.WeightedEdge)
+	}
+	var g builder
+	if directed {
+		g = simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	} else {
+		g = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	}
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if u == v || (!directed && v < u) {
+				continue
+			}
+			if rnd.Float64() < p {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(rnd.IntN(10))})
+			}
+		}
+	}
+	return g
+}
+
+func TestShortestPointToPoint(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		for _, directed := range []bool{false, true} {
+			const n = 40
+			g := randomWeightedGraph(n, 0.08, directed, rnd)
+			ch := NewContractionHierarchy(g)
+			for uid := int64(0); uid < n; uid++ {
+				want := DijkstraFrom(g.Node(uid), g)
+				for vid := int64(0); vid < n; vid++ {
+					name := fmt.Sprintf("graph %d directed=%t %d --> %d", i, directed, uid, vid)
+					wantW := want.WeightTo(vid)
+
+					path, weight := DijkstraBidirectional(g.Node(uid), g.Node(vid), g)
+					if weight != wantW {
+						t.Errorf("unexpected DijkstraBidirectional weight for %s: got:%v want:%v", name, weight, wantW)
+					}
+					checkPathWeight(t, "DijkstraBidirectional "+name, g, path, weight, uid, vid)
+
+					if got := ch.Weight(uid, vid); got != wantW {
+						t.Errorf("unexpected ContractionHierarchy.Weight for %s: got:%v want:%v", name, got, wantW)
+					}
+					path, weight = ch.Between(uid, vid)
+					if weight != wantW {
+						t.Errorf("unexpected ContractionHierarchy.Between weight for %s: got:%v want:%v", name, weight, wantW)
+					}
+					checkPathWeight(t, "ContractionHierarchy "+name, g, path, weight, uid, vid)
+				}
+			}
+		}
+	}
+}
+
+func TestShortestPointToPointAbsent(t *testing.T) {
+	t.Parallel()
+	g := simple.NewDirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	ch := NewContractionHierarchy(g)
+	for _, test := range []struct{ u, v int64 }{{0, 2}, {2, 0}, {1, 0}} {
+		if path, weight := DijkstraBidirectional(simple.Node(test.u), simple.Node(test.v), g); path != nil || !math.IsInf(weight, 1) {
+			t.Errorf("unexpected DijkstraBidirectional result for %d --> %d: got:%v,%v", test.u, test.v, path, weight)
+		}
+		if path, weight := ch.Between(test.u, test.v); path != nil || !math.IsInf(weight, 1) {
+			t.Errorf("unexpected ContractionHierarchy result for %d --> %d: got:%v,%v", test.u, test.v, path, weight)
+		}
+	}
+}
+
+// checkPathWeight checks that path is a path in g from uid to vid with the
+// given total weight.
+func checkPathWeight(t *testing.T, name string, g graph.Weighted, path []graph.Node, weight float64, uid, vid int64) {
+	t.Helper()
+	if math.IsInf(weight, 1) {
+		if path != nil {
+			t.Errorf("unexpected path for %s: %v", name, path)
+		}
+		return
+	}
+	if len(path) == 0 || path[0].ID() != uid || path[len(path)-1].ID() != vid {
+		t.Errorf("unexpected path terminals for %s: %v", name, path)
+		return
+	}
+	var sum float64
+	for i := 1; i < len(path); i++ {
+		w, ok := g.Weight(path[i-1].ID(), path[i].ID())
+		if !ok {
+			t.Errorf("path step %d --> %d not in graph for %s", path[i-1].ID(), path[i].ID(), name)
+			return
+		}
+		sum += w
+	}
+	if sum != weight {
+		t.Errorf("unexpected path weight for %s: got:%v want:%v", name, sum, weight)
+	}
+}
@@ -6,6 +6,8 @@ package path
 
 import (
 	"container/heap"
+	"math"
+	"slices"
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/traverse"
@@ -32,8 +34,8 @@ func DijkstraFrom(u graph.Node, g traverse.Graph) Shortest {
 //
 // The time complexity of DijkstraFromTo is O(|E|.log|V|).
 func DijkstraFromTo(u, t graph.Node, g traverse.Graph) (path []graph.Node, weight float64) {
-	// An implementation of Bidirectional Dijkstra's algorithm could be even
-	// more efficient, but it requires a transposed (or undirected) graph.
+	// DijkstraBidirectional can be more efficient, but it requires
+	// a transposed (or undirected) graph.
 	if t == nil {
 		panic("dijkstra: nil target node")
 	}
@@ -55,12 +57,7 @@ func dijkstraFrom(u, t graph.Node, g traverse.Graph) Shortest {
 		path = newShortestFrom(u, []graph.Node{u})
 	}
 
-	var weight Weighting
-	if wg, ok := g.(Weighted); ok {
-		weight = wg.Weight
-	} else {
-		weight = UniformCost(g)
-	}
+	weight := weightingFor(g)
 
 	// Dijkstra's algorithm here is implemented essentially as
 	// described in Function B.2 in figure 6 of UTCS Technical
@@ -109,6 +106,120 @@ func dijkstraFrom(u, t graph.Node, g traverse.Graph) Shortest {
 	return path
 }
 
+// DijkstraBidirectional returns a shortest path from s to t in the graph g
+// and its weight, using simultaneous searches forward from s and backward
+// from t. If g is a graph.Directed, the backward search follows the edges
+// returned by To, otherwise g is treated as undirected. If the graph does
+// not implement Weighted, UniformCost is used. If t is not reachable from
+// s, path is nil and weight is +Inf. DijkstraBidirectional will panic if
+// it encounters a negative edge weight.
+//
+// The time complexity of DijkstraBidirectional is O(|E|.log|V|), but it
+// typically explores far fewer nodes than DijkstraFromTo.
+func DijkstraBidirectional(s, t graph.Node, g graph.Graph) (path []graph.Node, weight float64) {
+	sid, tid := s.ID(), t.ID()
+	if g.Node(sid) == nil || g.Node(tid) == nil {
+		return nil, math.Inf(1)
+	}
+	if sid == tid {
+		return []graph.Node{g.Node(sid)}, 0
+	}
+
+	weightFn := weightingFor(g)
+	backward := g.From
+	if dg, ok := g.(graph.Directed); ok {
+		backward = dg.To
+	}
+	fwd := newBidirectionalSearch(sid, g.From, weightFn)
+	bwd := newBidirectionalSearch(tid, backward, func(xid, yid int64) (float64, bool) {
+		return weightFn(yid, xid)
+	})
+
+	best := math.Inf(1)
+	meet := int64(-1)
+	for fwd.queue.Len() != 0 && bwd.queue.Len() != 0 {
+		// The smallest queued distances bound the weight
+		// of any path not yet found.
+		if fwd.queue[0].dist+bwd.queue[0].dist >= best {
+			break
+		}
+		this, other := fwd, bwd
+		if bwd.queue.Len() < fwd.queue.Len() {
+			this, other = bwd, fwd
+		}
+		this.step(func(vid int64, d float64) {
+			if od, ok := other.dist[vid]; ok && d+od < best {
+				best = d + od
+				meet = vid
+			}
+		})
+	}
+	if meet == -1 {
+		return nil, math.Inf(1)
+	}
+
+	for id := meet; ; id = fwd.prev[id] {
+		path = append(path, g.Node(id))
+		if id == sid {
+			break
+		}
+	}
+	slices.Reverse(path)
+	for id := meet; id != tid; {
+		id = bwd.prev[id]
+		path = append(path, g.Node(id))
+	}
+	return path, best
+}
+
+// bidirectionalSearch is one direction of a bidirectional Dijkstra search.
+type bidirectionalSearch struct {
+	next   func(id int64) graph.Nodes
+	weight Weighting
+
+	dist  map[int64]float64
+	prev  map[int64]int64
+	queue priorityQueue
+}
+
+func newBidirectionalSearch(from int64, next func(id int64) graph.Nodes, weight Weighting) *bidirectionalSearch {
+	return &bidirectionalSearch{
+		next:   next,
+		weight: weight,
+		dist:   map[int64]float64{from: 0},
+		prev:   make(map[int64]int64),
+		queue:  priorityQueue{{node: node(from), dist: 0}},
+	}
+}
+
+// step settles the next node in the search, calling reached with the ID
+// and distance of each node whose distance is improved.
+func (s *bidirectionalSearch) step(reached func(id int64, dist float64)) {
+	mid := heap.Pop(&s.queue).(distanceNode)
+	uid := mid.node.ID()
+	if mid.dist > s.dist[uid] {
+		return
+	}
+	to := s.next(uid)
+	for to.Next() {
+		vid := to.Node().ID()
+		w, ok := s.weight(uid, vid)
+		if !ok {
+			panic("dijkstra: unexpected invalid weight")
+		}
+		if w < 0 {
+			panic("dijkstra: negative edge weight")
+		}
+		joint := mid.dist + w
+		if d, ok := s.dist[vid]; !ok || joint < d {
+			s.dist[vid] = joint
+			s.prev[vid] = uid
+			heap.Push(&s.queue, distanceNode{node: node(vid), dist: joint})
+			reached(vid, joint)
+		}
+	}
+}
+
 // DijkstraAllFrom returns a shortest-path tree for shortest paths from u to all nodes in
 // the graph g. If the graph does not implement Weighted, UniformCost is used.
 // DijkstraAllFrom will panic if g has a u-reachable negative edge weight.
@@ -131,12 +242,7 @@ func DijkstraAllFrom(u graph.Node, g traverse.Graph) ShortestAlts {
 		path = newShortestAltsFrom(u, []graph.Node{u})
 	}
 
-	var weight Weighting
-	if wg, ok := g.(Weighted); ok {
-		weight = wg.Weight
-	} else {
-		weight = UniformCost(g)
-	}
+	weight := weightingFor(g)
 
 	// Dijkstra's algorithm here is implemented essentially as
 	// described in Function B.2 in figure 6 of UTCS Technical
@@ -198,12 +304,7 @@ func DijkstraAllPaths(g graph.Graph) (paths AllShortest) {
 // of the nodes slice and the indexOf map. It returns nothing, but stores the
 // result of the work in the paths parameter which is a reference type.
 func dijkstraAllPaths(g graph.Graph, paths AllShortest) {
-	var weight Weighting
-	if wg, ok := g.(graph.Weighted); ok {
-		weight = wg.Weight
-	} else {
-		weight = UniformCost(g)
-	}
+	weight := weightingFor(g)
 
 	var Q priorityQueue
 	for i, u := range paths.nodes {
@@ -17,12 +17,7 @@ import (
 //
 // The time complexity of FloydWarshall is O(|V|^3).
 func FloydWarshall(g graph.Graph) (paths AllShortest, ok bool) {
-	var weight Weighting
-	if wg, ok := g.(Weighted); ok {
-		weight = wg.Weight
-	} else {
-		weight = UniformCost(g)
-	}
+	weight := weightingFor(g)
 
 	nodes := graph.NodesOf(g.Nodes())
 	paths = newAllShortest(nodes, true)
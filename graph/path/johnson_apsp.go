@@ -19,11 +19,7 @@ import (
 // The time complexity of JohnsonAllPaths is O(|V|.|E|+|V|^2.log|V|).
 func JohnsonAllPaths(g graph.Graph) (paths AllShortest, ok bool) {
 	adjusted := johnsonWeightAdjuster{Graph: g}
-	if wg, ok := g.(Weighted); ok {
-		adjusted.weight = wg.Weight
-	} else {
-		adjusted.weight = UniformCost(g)
-	}
+	adjusted.weight = weightingFor(g)
 
 	paths = newAllShortest(graph.NodesOf(g.Nodes()), false)
 
@@ -449,6 +449,23 @@ func (p ShortestAlts) AllToFunc(vid int64, fn func(path []graph.Node)) {
 	p.allTo(from, to, seen, []graph.Node{p.nodes[to]}, fn)
 }
 
+// CountTo returns the number of shortest paths to v. If v is not reachable,
+// CountTo returns zero. If a zero-weight cycle or a negative cycle lies on
+// a shortest path to v, the number of shortest walks is unbounded and
+// CountTo returns +Inf.
+func (p ShortestAlts) CountTo(vid int64) float64 {
+	from := p.indexOf[p.from.ID()]
+	to, toOK := p.indexOf[vid]
+	if !toOK || len(p.next[to]) == 0 {
+		if p.from.ID() == vid {
+			return 1
+		}
+		return 0
+	}
+	c := newPathCounter(len(p.nodes), from)
+	return c.count(to, func(i int) []int { return p.next[i] })
+}
+
 // allTo recursively constructs a slice of paths extending from the node
 // indexed into p.nodes by from to the node indexed by to. len(seen) must match
 // the number of nodes held by the receiver. The path parameter is the current
@@ -749,6 +766,31 @@ func (p AllShortest) AllBetweenFunc(uid, vid int64, fn func(path []graph.Node))
 	p.allBetween(from, to, seen, []graph.Node{n}, fn)
 }
 
+// CountBetween returns the number of shortest paths from u to v. If v is
+// not reachable from u, CountBetween returns zero. If a zero-weight cycle
+// or a negative cycle lies on a shortest path from u to v, the number of
+// shortest walks is unbounded and CountBetween returns +Inf.
+func (p AllShortest) CountBetween(uid, vid int64) float64 {
+	from, fromOK := p.indexOf[uid]
+	to, toOK := p.indexOf[vid]
+	if !fromOK || !toOK || len(p.at(from, to)) == 0 {
+		if uid == vid {
+			return 1
+		}
+		return 0
+	}
+	if math.Float64bits(p.dist.At(from, to)) == defacedBits {
+		return math.Inf(1)
+	}
+
+	if p.forward {
+		c := newPathCounter(len(p.nodes), to)
+		return c.count(from, func(i int) []int { return p.at(i, to) })
+	}
+	c := newPathCounter(len(p.nodes), from)
+	return c.count(to, func(i int) []int { return p.at(from, i) })
+}
+
 // allBetween recursively constructs a set of paths extending from the node
 // indexed into p.nodes by from to the node indexed by to. len(seen) must match
 // the number of nodes held by the receiver. The path parameter is the current
@@ -795,6 +837,51 @@ func (p AllShortest) allBetween(from, to int, seen []bool, path []graph.Node, fn
 	}
 }
 
+// pathCounter counts paths in a shortest path tree that may hold
+// alternative paths.
+type pathCounter struct {
+	root int
+
+	// state is 0 for unvisited nodes, 1 for nodes
+	// being visited and 2 for nodes with a known
+	// number of paths held in paths.
+	state []byte
+	paths []float64
+}
+
+// newPathCounter returns a pathCounter for n nodes counting paths
+// terminating at root.
+func newPathCounter(n, root int) pathCounter {
+	return pathCounter{
+		root:  root,
+		state: make([]byte, n),
+		paths: make([]float64, n),
+	}
+}
+
+// count returns the number of paths from i to the root following
+// the node indexes returned by next. If a cycle is reachable from i,
+// count returns +Inf.
+func (c pathCounter) count(i int, next func(int) []int) float64 {
+	if i == c.root {
+		return 1
+	}
+	switch c.state[i] {
+	case 1:
+		return math.Inf(1)
+	case 2:
+		return c.paths[i]
+	}
+	c.state[i] = 1
+	var n float64
+	for _, j := range next(i) {
+		n += c.count(j, next)
+	}
+	c.state[i] = 2
+	c.paths[i] = n
+	return n
+}
+
 type node int64
 
 func (n node) ID() int64 { return int64(n) }
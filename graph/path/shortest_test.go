@@ -124,6 +124,88 @@ func TestAllShortest(t *testing.T) {
 	}
 }
 
+func TestCountShortest(t *testing.T) {
+	for _, test := range shortestTests[:2] {
+		t.Run(fmt.Sprintf("Count_%d×%d|%v", test.n, test.d, test.p), func(t *testing.T) {
+			g := simple.NewDirectedGraph()
+			gen.SmallWorldsBB(g, test.n, test.d, test.p, rand.New(rand.NewPCG(test.seed, test.seed)))
+
+			dijkstra := DijkstraAllPaths(g)
+			floyd, ok := FloydWarshall(g)
+			if !ok {
+				t.Fatal("unexpected negative cycle")
+			}
+			for uid := int64(0); uid < int64(test.n); uid++ {
+				p := DijkstraAllFrom(g.Node(uid), g)
+				for vid := int64(0); vid < int64(test.n); vid++ {
+					paths, _ := p.AllTo(vid)
+					want := float64(len(paths))
+					if got := p.CountTo(vid); got != want {
+						t.Errorf("unexpected CountTo %d --> %d: got:%v want:%v", uid, vid, got, want)
+					}
+					if got := dijkstra.CountBetween(uid, vid); got != want {
+						t.Errorf("unexpected Dijkstra CountBetween %d --> %d: got:%v want:%v", uid, vid, got, want)
+					}
+					if got := floyd.CountBetween(uid, vid); got != want {
+						t.Errorf("unexpected Floyd-Warshall CountBetween %d --> %d: got:%v want:%v", uid, vid, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCountShortestLattice(t *testing.T) {
+	t.Parallel()
+	// The number of monotone lattice paths across an n×n
+	// grid is the central binomial coefficient.
+	const n = 20
+	g := simple.NewDirectedGraph()
+	id := func(i, j int) int64 { return int64(i*(n+1) + j) }
+	for i := 0; i <= n; i++ {
+		for j := 0; j <= n; j++ {
+			if i < n {
+				g.SetEdge(simple.Edge{F: simple.Node(id(i, j)), T: simple.Node(id(i+1, j))})
+			}
+			if j < n {
+				g.SetEdge(simple.Edge{F: simple.Node(id(i, j)), T: simple.Node(id(i, j+1))})
+			}
+		}
+	}
+	const want = 137846528820 // 40 choose 20
+	p := DijkstraAllFrom(g.Node(id(0, 0)), g)
+	if got := p.CountTo(id(n, n)); got != want {
+		t.Errorf("unexpected number of lattice paths: got:%v want:%v", got, want)
+	}
+	if got := p.CountTo(id(0, 0)); got != 1 {
+		t.Errorf("unexpected number of paths to source: got:%v want:1", got)
+	}
+	if got := p.CountTo(-1); got != 0 {
+		t.Errorf("unexpected number of paths to absent node: got:%v want:0", got)
+	}
+}
+
+func TestCountShortestZeroCycle(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 1},
+		{F: simple.Node(1), T: simple.Node(2), W: 0},
+		{F: simple.Node(2), T: simple.Node(1), W: 0},
+		{F: simple.Node(2), T: simple.Node(3), W: 1},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	p := DijkstraAllFrom(g.Node(0), g)
+	if got := p.CountTo(3); !math.IsInf(got, 1) {
+		t.Errorf("unexpected number of paths through zero weight cycle: got:%v want:+Inf", got)
+	}
+	all := DijkstraAllPaths(g)
+	if got := all.CountBetween(0, 3); !math.IsInf(got, 1) {
+		t.Errorf("unexpected number of paths through zero weight cycle: got:%v want:+Inf", got)
+	}
+}
+
 // allShortest implements an allocation-naive AllBetween.
 type allShortest AllShortest
 
@@ -186,3 +186,183 @@ func Kruskal(dst WeightedBuilder, g UndirectedWeightLister) float64 {
 	}
 	return w
 }
+
+// ChuLiuEdmonds generates a minimum spanning arborescence of g rooted at root,
+// placing the result in the destination, dst. A spanning arborescence is a
+// directed tree in which every node is reachable from the root by a unique
+// path. The destination is not cleared first. The weight of the minimum
+// spanning arborescence is returned. If not all nodes of g are reachable from
+// root, the arborescence spans the nodes that are reachable. Self edges in g
+// are ignored.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs.
+//
+// The algorithm is described in Chu and Liu, "On the shortest arborescence of a
+// directed graph", Sci. Sin. 14:1396-1400, 1965, and Edmonds, "Optimum
+// branchings", J. Res. Natl. Bur. Stand. 71B:233-240, 1967.
+//
+// The time complexity of ChuLiuEdmonds is O(|V|.|E|).
+//
+// If root is not in g or dst has nodes that exist in the arborescence,
+// ChuLiuEdmonds will panic.
+func ChuLiuEdmonds(dst WeightedBuilder, g graph.WeightedDirected, root graph.Node) float64 {
+	rid := root.ID()
+	if g.Node(rid) == nil {
+		panic("path: root not in graph")
+	}
+
+	// Find the nodes reachable from root.
+	nodes := []graph.Node{g.Node(rid)}
+	indexOf := map[int64]int{rid: 0}
+	for i := 0; i < len(nodes); i++ {
+		to := g.From(nodes[i].ID())
+		for to.Next() {
+			v := to.Node()
+			if _, ok := indexOf[v.ID()]; !ok {
+				indexOf[v.ID()] = len(nodes)
+				nodes = append(nodes, v)
+			}
+		}
+	}
+
+	var arcs []arborescenceArc
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			w, ok := g.Weight(uid, vid)
+			if !ok {
+				panic("path: unexpected invalid weight")
+			}
+			arcs = append(arcs, arborescenceArc{from: i, to: indexOf[vid], weight: w})
+		}
+	}
+
+	for _, u := range nodes {
+		dst.AddNode(u)
+	}
+	var w float64
+	for _, k := range minimumArborescence(len(nodes), 0, arcs) {
+		uid, vid := nodes[arcs[k].from].ID(), nodes[arcs[k].to].ID()
+		dst.SetWeightedEdge(g.WeightedEdge(uid, vid))
+		w += arcs[k].weight
+	}
+	return w
+}
+
+// arborescenceArc is a weighted arc between node indices.
+type arborescenceArc struct {
+	from, to int
+	weight   float64
+}
+
+// minimumArborescence returns the indices into arcs of the arcs of a minimum
+// spanning arborescence of the graph with n nodes rooted at root. Every node
+// must be reachable from root.
+func minimumArborescence(n, root int, arcs []arborescenceArc) []int {
+	// Find the lightest arc into each node.
+	in := make([]int, n)
+	for v := range in {
+		in[v] = -1
+	}
+	for k, a := range arcs {
+		if a.to == root || a.from == a.to {
+			continue
+		}
+		if in[a.to] < 0 || a.weight < arcs[in[a.to]].weight {
+			in[a.to] = k
+		}
+	}
+
+	// Find the cycles formed by the lightest arcs,
+	// labelling each node with its component in
+	// the graph obtained by contracting the cycles.
+	comp := make([]int, n)
+	mark := make([]int, n)
+	for v := range comp {
+		comp[v] = -1
+		mark[v] = -1
+	}
+	var (
+		c        int
+		inCycle  []bool
+		contract bool
+	)
+	for s := 0; s < n; s++ {
+		v := s
+		for v != root && mark[v] < 0 && comp[v] < 0 {
+			mark[v] = s
+			v = arcs[in[v]].from
+		}
+		if v != root && mark[v] == s && comp[v] < 0 {
+			// v is on a new cycle.
+			contract = true
+			for u := v; comp[u] < 0; u = arcs[in[u]].from {
+				comp[u] = c
+			}
+			inCycle = append(inCycle, true)
+			c++
+		}
+	}
+	if !contract {
+		var chosen []int
+		for v, k := range in {
+			if v != root {
+				chosen = append(chosen, k)
+			}
+		}
+		return chosen
+	}
+	for v := range comp {
+		if comp[v] < 0 {
+			comp[v] = c
+			inCycle = append(inCycle, false)
+			c++
+		}
+	}
+
+	// Contract the cycles, reducing the weight of each
+	// arc entering a cycle by the weight of the cycle
+	// arc it would replace.
+	var (
+		contracted []arborescenceArc
+		orig       []int
+	)
+	for k, a := range arcs {
+		cu, cv := comp[a.from], comp[a.to]
+		if cu == cv {
+			continue
+		}
+		w := a.weight
+		if inCycle[cv] {
+			w -= arcs[in[a.to]].weight
+		}
+		contracted = append(contracted, arborescenceArc{from: cu, to: cv, weight: w})
+		orig = append(orig, k)
+	}
+
+	// Expand the arborescence of the contracted graph,
+	// keeping all cycle arcs except the one replaced
+	// by the arc entering the cycle.
+	var chosen []int
+	replaced := make([]bool, n)
+	for _, k := range minimumArborescence(c, comp[root], contracted) {
+		a := orig[k]
+		chosen = append(chosen, a)
+		if inCycle[comp[arcs[a].to]] {
+			replaced[arcs[a].to] = true
+		}
+	}
+	for v, k := range in {
+		if v != root && inCycle[comp[v]] && !replaced[v] {
+			chosen = append(chosen, k)
+		}
+	}
+	return chosen
+}
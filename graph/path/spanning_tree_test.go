@@ -6,10 +6,12 @@ package path
 
 import (
 	"math"
+	"math/rand/v2"
 	"testing"
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/traverse"
 )
 
 func TestVerifySpanningTreeTests(t *testing.T) {
@@ -294,3 +296,139 @@ func TestPrim(t *testing.T) {
 		return Prim(dst, g)
 	}, t)
 }
+
+func TestChuLiuEdmonds(t *testing.T) {
+	t.Parallel()
+	// Example from Figure 1 of McDonald et al.,
+	// doi:10.3115/1220575.1220641 with scores
+	// negated to give a minimization problem.
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: -9},
+		{F: simple.Node(0), T: simple.Node(2), W: -10},
+		{F: simple.Node(0), T: simple.Node(3), W: -9},
+		{F: simple.Node(1), T: simple.Node(2), W: -20},
+		{F: simple.Node(1), T: simple.Node(3), W: -3},
+		{F: simple.Node(2), T: simple.Node(1), W: -30},
+		{F: simple.Node(2), T: simple.Node(3), W: -30},
+		{F: simple.Node(3), T: simple.Node(1), W: -11},
+		{F: simple.Node(3), T: simple.Node(2), W: 0},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	dst := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	w := ChuLiuEdmonds(dst, g, simple.Node(0))
+	if w != -70 {
+		t.Errorf("unexpected arborescence weight: got:%v want:-70", w)
+	}
+	for _, e := range [][2]int64{{0, 2}, {2, 1}, {2, 3}} {
+		if !dst.HasEdgeFromTo(e[0], e[1]) {
+			t.Errorf("expected edge %d->%d in arborescence", e[0], e[1])
+		}
+	}
+
+	for seed := uint64(1); seed <= 50; seed++ {
+		rnd := rand.New(rand.NewPCG(seed, seed))
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		const n = 7
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if i != j && rnd.Float64() < 0.4 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(rnd.IntN(20) - 5)})
+				}
+			}
+		}
+		root := simple.Node(rnd.IntN(n))
+
+		dst := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		got := ChuLiuEdmonds(dst, g, root)
+		want, reachable := minimumArborescenceBruteForce(g, root)
+
+		if got != want {
+			t.Errorf("unexpected arborescence weight for seed=%d: got:%v want:%v", seed, got, want)
+		}
+		if n := dst.Nodes().Len(); n != reachable {
+			t.Errorf("unexpected number of nodes for seed=%d: got:%d want:%d", seed, n, reachable)
+		}
+		var w float64
+		edges := dst.WeightedEdges()
+		for edges.Next() {
+			e := edges.WeightedEdge()
+			w += e.Weight()
+			if dst.To(e.To().ID()).Len() != 1 {
+				t.Errorf("node %d does not have a single parent for seed=%d", e.To().ID(), seed)
+			}
+		}
+		if w != got {
+			t.Errorf("returned weight does not match arborescence for seed=%d: got:%v want:%v", seed, got, w)
+		}
+		var bf traverse.BreadthFirst
+		var seen int
+		bf.Walk(dst, root, func(graph.Node, int) bool { seen++; return false })
+		if seen != reachable {
+			t.Errorf("arborescence does not reach all nodes for seed=%d: got:%d want:%d", seed, seen, reachable)
+		}
+	}
+}
+
+// minimumArborescenceBruteForce returns the weight of a minimum spanning
+// arborescence of the nodes of g reachable from root, and the number of
+// reachable nodes, by exhaustive search over parent choices.
+func minimumArborescenceBruteForce(g graph.WeightedDirected, root graph.Node) (float64, int) {
+	var nodes []graph.Node
+	var bf traverse.BreadthFirst
+	bf.Walk(g, root, func(n graph.Node, _ int) bool {
+		if n.ID() != root.ID() {
+			nodes = append(nodes, n)
+		}
+		return false
+	})
+	reachable := make(map[int64]bool)
+	reachable[root.ID()] = true
+	for _, n := range nodes {
+		reachable[n.ID()] = true
+	}
+
+	parents := make([][]int64, len(nodes))
+	for i, n := range nodes {
+		to := g.To(n.ID())
+		for to.Next() {
+			if uid := to.Node().ID(); reachable[uid] {
+				parents[i] = append(parents[i], uid)
+			}
+		}
+	}
+
+	best := math.Inf(1)
+	parent := make(map[int64]int64)
+	var search func(i int, w float64)
+	search = func(i int, w float64) {
+		if i == len(nodes) {
+			// Check that every node leads to the root.
+			for _, n := range nodes {
+				u := n.ID()
+				for steps := 0; u != root.ID(); steps++ {
+					if steps > len(nodes) {
+						return
+					}
+					u = parent[u]
+				}
+			}
+			best = math.Min(best, w)
+			return
+		}
+		for _, p := range parents[i] {
+			parent[nodes[i].ID()] = p
+			ew, _ := g.Weight(p, nodes[i].ID())
+			search(i+1, w+ew)
+		}
+	}
+	search(0, 0)
+	if len(nodes) == 0 {
+		best = 0
+	}
+	return best, len(nodes) + 1
+}
@@ -0,0 +1,252 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"container/heap"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/set"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// Steiner generates an approximate minimum Steiner tree of g connecting the
+// given terminal nodes, placing the result in the destination, dst. The
+// destination is not cleared first. The weight of the Steiner tree is
+// returned. The tree is no more than 2(1-1/l) times the weight of a minimum
+// Steiner tree, where l is the number of leaves in the minimum Steiner tree.
+// If the terminals are not all connected in g, a Steiner forest is constructed
+// in dst, connecting the terminals within each connected component of g, and
+// the sum of tree weights is returned.
+//
+// The tree is constructed by the algorithm of Kou, Markowsky and Berman,
+// "A fast algorithm for Steiner trees", Acta Inform. 15:141-145, 1981,
+// doi:10.1007/BF00288961: a minimum spanning tree of the metric closure of the
+// terminals is expanded into shortest paths in g, a minimum spanning tree of
+// the resulting subgraph is found and non-terminal leaves are then pruned.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs.
+//
+// The time complexity of Steiner is O(|T|.(|E|+|V|.log|V|)) where |T| is the
+// number of terminals. Steiner will panic if a terminal is not in g, if g has a
+// negative edge weight, or if dst has nodes that exist in the Steiner tree.
+func Steiner(dst WeightedBuilder, g graph.WeightedUndirected, terminals []graph.Node) float64 {
+	var terms []graph.Node
+	isTerminal := make(set.Ints[int64])
+	for _, t := range terminals {
+		tid := t.ID()
+		if g.Node(tid) == nil {
+			panic("path: terminal not in graph")
+		}
+		if isTerminal.Has(tid) {
+			continue
+		}
+		isTerminal.Add(tid)
+		terms = append(terms, t)
+	}
+	switch len(terms) {
+	case 0:
+		return 0
+	case 1:
+		dst.AddNode(g.Node(terms[0].ID()))
+		return 0
+	}
+
+	// Find a minimum spanning forest of the metric
+	// closure of the terminals by Prim's algorithm.
+	paths := make([]Shortest, len(terms))
+	for i, t := range terms {
+		paths[i] = DijkstraFrom(t, g)
+	}
+	parent := make([]int, len(terms))
+	dist := make([]float64, len(terms))
+	inTree := make([]bool, len(terms))
+	for i := range parent {
+		parent[i] = -1
+		dist[i] = math.Inf(1)
+	}
+	for range terms {
+		u := -1
+		for v, t := range inTree {
+			if !t && (u < 0 || dist[v] < dist[u]) {
+				u = v
+			}
+		}
+		inTree[u] = true
+		for v, t := range inTree {
+			if t {
+				continue
+			}
+			if w := paths[u].WeightTo(terms[v].ID()); w < dist[v] {
+				dist[v] = w
+				parent[v] = u
+			}
+		}
+	}
+
+	// Expand the closure edges into shortest
+	// paths and find a minimum spanning forest
+	// of the union of the paths.
+	sub := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, t := range terms {
+		sub.AddNode(g.Node(t.ID()))
+	}
+	for v, u := range parent {
+		if u < 0 {
+			continue
+		}
+		p, _ := paths[u].To(terms[v].ID())
+		for i, x := range p[1:] {
+			xid, yid := x.ID(), p[i].ID()
+			if sub.HasEdgeBetween(xid, yid) {
+				continue
+			}
+			sub.SetWeightedEdge(g.WeightedEdge(xid, yid))
+		}
+	}
+	tree := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	Kruskal(tree, sub)
+
+	// Repeatedly prune non-terminal leaves.
+	var leaves []int64
+	nodes := tree.Nodes()
+	for nodes.Next() {
+		uid := nodes.Node().ID()
+		if !isTerminal.Has(uid) && tree.From(uid).Len() <= 1 {
+			leaves = append(leaves, uid)
+		}
+	}
+	for len(leaves) != 0 {
+		uid := leaves[len(leaves)-1]
+		leaves = leaves[:len(leaves)-1]
+		var next int64 = -1
+		if to := tree.From(uid); to.Next() {
+			next = to.Node().ID()
+		}
+		tree.RemoveNode(uid)
+		if next >= 0 && !isTerminal.Has(next) && tree.From(next).Len() == 1 {
+			leaves = append(leaves, next)
+		}
+	}
+
+	var w float64
+	nodes = tree.Nodes()
+	for nodes.Next() {
+		dst.AddNode(g.Node(nodes.Node().ID()))
+	}
+	edges := tree.WeightedEdges()
+	for edges.Next() {
+		e := edges.WeightedEdge()
+		dst.SetWeightedEdge(g.WeightedEdge(e.From().ID(), e.To().ID()))
+		w += e.Weight()
+	}
+	return w
+}
+
+// KMST generates an approximate minimum weight tree in g spanning k nodes,
+// placing the result in the destination, dst. The destination is not cleared
+// first. The weight of the tree is returned. If no connected component of g
+// has k nodes, KMST returns +Inf and dst is not altered.
+//
+// The tree is found by growing a tree from each node of g by Prim's algorithm
+// until it spans k nodes and keeping the lightest tree found. The returned tree
+// is a minimum k-node tree when k is 1 or 2, or when g is connected and k is
+// the number of nodes in g, but is not within a constant factor of the minimum
+// in general.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs.
+//
+// The time complexity of KMST is O(|V|.k.d.log(k.d)) where d is the maximum
+// degree of g. KMST will panic if k is not in [1, |V|] or if dst has nodes that
+// exist in the tree.
+func KMST(dst WeightedBuilder, g graph.WeightedUndirected, k int) float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	if k < 1 || len(nodes) < k {
+		panic("path: invalid number of tree nodes")
+	}
+	if k == 1 {
+		dst.AddNode(nodes[0])
+		return 0
+	}
+
+	var (
+		best  []graph.WeightedEdge
+		bestW = math.Inf(1)
+	)
+	inTree := make(set.Ints[int64])
+	var tree []graph.WeightedEdge
+	for _, root := range nodes {
+		clear(inTree)
+		tree = tree[:0]
+		var (
+			w float64
+			q edgeQueue
+		)
+		grow := func(u graph.Node) {
+			uid := u.ID()
+			inTree.Add(uid)
+			to := g.From(uid)
+			for to.Next() {
+				v := to.Node()
+				if inTree.Has(v.ID()) {
+					continue
+				}
+				heap.Push(&q, g.WeightedEdge(uid, v.ID()))
+			}
+		}
+		grow(root)
+		for len(inTree) < k && q.Len() != 0 && w < bestW {
+			e := heap.Pop(&q).(graph.WeightedEdge)
+			v := e.To()
+			if inTree.Has(v.ID()) {
+				v = e.From()
+				if inTree.Has(v.ID()) {
+					continue
+				}
+			}
+			tree = append(tree, e)
+			w += e.Weight()
+			grow(v)
+		}
+		if len(inTree) == k && w < bestW {
+			best = append(best[:0], tree...)
+			bestW = w
+		}
+	}
+	if best == nil {
+		return math.Inf(1)
+	}
+
+	added := make(set.Ints[int64])
+	for _, e := range best {
+		for _, u := range []graph.Node{e.From(), e.To()} {
+			if !added.Has(u.ID()) {
+				added.Add(u.ID())
+				dst.AddNode(u)
+			}
+		}
+		dst.SetWeightedEdge(e)
+	}
+	return bestW
+}
+
+// edgeQueue is a priority queue of weighted edges keyed on weight.
+type edgeQueue []graph.WeightedEdge
+
+func (q edgeQueue) Len() int            { return len(q) }
+func (q edgeQueue) Less(i, j int) bool  { return q[i].Weight() < q[j].Weight() }
+func (q edgeQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *edgeQueue) Push(x interface{}) { *q = append(*q, x.(graph.WeightedEdge)) }
+func (q *edgeQueue) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
@@ -0,0 +1,264 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+func TestSteiner(t *testing.T) {
+	t.Parallel()
+	for seed := uint64(1); seed <= 20; seed++ {
+		g := randomSteinerGraph(9, 0.4, seed)
+		nodes := graph.NodesOf(g.Nodes())
+		rnd := rand.New(rand.NewPCG(seed, seed))
+		rnd.Shuffle(len(nodes), func(i, j int) { nodes[i], nodes[j] = nodes[j], nodes[i] })
+		for k := 1; k <= len(nodes); k++ {
+			name := fmt.Sprintf("seed=%d terminals=%d", seed, k)
+			terminals := nodes[:k]
+
+			dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+			w := Steiner(dst, g, terminals)
+			checkForest(t, name, dst, g, w)
+
+			// Each terminal is in the forest and each leaf is a terminal.
+			isTerminal := make(map[int64]bool)
+			for _, u := range terminals {
+				isTerminal[u.ID()] = true
+				if dst.Node(u.ID()) == nil {
+					t.Errorf("terminal %d not in Steiner tree for %s", u.ID(), name)
+				}
+			}
+			it := dst.Nodes()
+			for it.Next() {
+				uid := it.Node().ID()
+				if !isTerminal[uid] && dst.From(uid).Len() < 2 {
+					t.Errorf("non-terminal leaf %d in Steiner tree for %s", uid, name)
+				}
+			}
+
+			want := minimumSteiner(g, terminals)
+			if w < want-1e-9 {
+				t.Errorf("Steiner tree lighter than minimum for %s: got:%v want:%v", name, w, want)
+			}
+			if k > 1 && w > 2*want+1e-9 {
+				t.Errorf("Steiner tree exceeds approximation bound for %s: got:%v minimum:%v", name, w, want)
+			}
+			if k == 2 {
+				_, d := DijkstraFromTo(terminals[0], terminals[1], g)
+				if math.IsInf(d, 1) {
+					d = 0
+				}
+				if math.Abs(w-d) > 1e-9 {
+					t.Errorf("unexpected weight for two terminals for %s: got:%v want:%v", name, w, d)
+				}
+			}
+		}
+	}
+}
+
+func TestSteinerDisconnected(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 1},
+		{F: simple.Node(1), T: simple.Node(2), W: 1},
+		{F: simple.Node(3), T: simple.Node(4), W: 2},
+		{F: simple.Node(4), T: simple.Node(5), W: 2},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	w := Steiner(dst, g, []graph.Node{simple.Node(0), simple.Node(2), simple.Node(3), simple.Node(5)})
+	if w != 6 {
+		t.Errorf("unexpected Steiner forest weight: got:%v want:6", w)
+	}
+	if n := dst.Nodes().Len(); n != 6 {
+		t.Errorf("unexpected number of nodes in Steiner forest: got:%d want:6", n)
+	}
+}
+
+func TestKMST(t *testing.T) {
+	t.Parallel()
+	for seed := uint64(1); seed <= 20; seed++ {
+		g := randomSteinerGraph(9, 0.4, seed)
+		n := g.Nodes().Len()
+		for k := 1; k <= n; k++ {
+			name := fmt.Sprintf("seed=%d k=%d", seed, k)
+			dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+			w := KMST(dst, g, k)
+			want := minimumKTree(g, k)
+			if math.IsInf(want, 1) {
+				if !math.IsInf(w, 1) || dst.Nodes().Len() != 0 {
+					t.Errorf("expected no tree for %s: got weight %v", name, w)
+				}
+				continue
+			}
+			checkForest(t, name, dst, g, w)
+			if got := dst.Nodes().Len(); got != k {
+				t.Errorf("unexpected number of tree nodes for %s: got:%d want:%d", name, got, k)
+			}
+			if got := dst.Edges().Len(); got != k-1 {
+				t.Errorf("tree not connected for %s: got %d edges want:%d", name, got, k-1)
+			}
+			if w < want-1e-9 {
+				t.Errorf("k-tree lighter than minimum for %s: got:%v want:%v", name, w, want)
+			}
+			if (k <= 2 || k == n) && math.Abs(w-want) > 1e-9 {
+				t.Errorf("unexpected weight for %s: got:%v want:%v", name, w, want)
+			}
+		}
+	}
+}
+
+// randomSteinerGraph returns a random graph on n nodes with each
+// edge present with probability p and with integer weights in [1, 10].
+// For even seeds, the graph is made connected by adding weight 10 edges
+// along the path through the nodes in ID order.
+func randomSteinerGraph(n int, p float64, seed uint64) *simple.WeightedUndirectedGraph {
+	rnd := rand.New(rand.NewPCG(seed, seed))
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if rnd.Float64() < p {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(1 + rnd.IntN(10))})
+			}
+		}
+	}
+	if seed%2 == 0 {
+		for i := 1; i < n; i++ {
+			if !g.HasEdgeBetween(int64(i-1), int64(i)) {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i - 1), T: simple.Node(i), W: 10})
+			}
+		}
+	}
+	return g
+}
+
+// checkForest checks that dst is a forest of edges of g with the given weight.
+func checkForest(t *testing.T, name string, dst *simple.WeightedUndirectedGraph, g graph.WeightedUndirected, weight float64) {
+	t.Helper()
+	var w float64
+	edges := dst.WeightedEdges()
+	for edges.Next() {
+		e := edges.WeightedEdge()
+		gw, ok := g.Weight(e.From().ID(), e.To().ID())
+		if !ok || gw != e.Weight() {
+			t.Errorf("edge %d--%d not in graph for %s", e.From().ID(), e.To().ID(), name)
+		}
+		w += e.Weight()
+	}
+	if math.Abs(w-weight) > 1e-9 {
+		t.Errorf("returned weight does not match tree for %s: got:%v want:%v", name, weight, w)
+	}
+
+	var components int
+	var bf traverse.BreadthFirst
+	bf.WalkAll(dst, func() { components++ }, nil, nil)
+	if dst.Nodes().Len() != dst.Edges().Len()+components {
+		t.Errorf("result is not a forest for %s", name)
+	}
+}
+
+// minimumSteiner returns the weight of a minimum Steiner forest of g
+// connecting the given terminals, found by exhaustive search.
+func minimumSteiner(g *simple.WeightedUndirectedGraph, terminals []graph.Node) float64 {
+	isTerminal := make(map[int64]bool)
+	for _, u := range terminals {
+		isTerminal[u.ID()] = true
+	}
+	var others []graph.Node
+	for _, u := range graph.NodesOf(g.Nodes()) {
+		if !isTerminal[u.ID()] {
+			others = append(others, u)
+		}
+	}
+
+	// The minimum forest has the same number
+	// of components as the terminals have
+	// in the full graph.
+	_, components := induced(g, graph.NodesOf(g.Nodes()), terminals)
+	best := math.Inf(1)
+	for mask := 0; mask < 1<<len(others); mask++ {
+		nodes := append([]graph.Node(nil), terminals...)
+		for i, u := range others {
+			if mask&(1<<i) != 0 {
+				nodes = append(nodes, u)
+			}
+		}
+		w, c := induced(g, nodes, terminals)
+		if c == components {
+			best = math.Min(best, w)
+		}
+	}
+	return best
+}
+
+// minimumKTree returns the weight of a minimum tree in g spanning
+// k nodes, found by exhaustive search.
+func minimumKTree(g *simple.WeightedUndirectedGraph, k int) float64 {
+	all := graph.NodesOf(g.Nodes())
+	best := math.Inf(1)
+	for mask := 0; mask < 1<<len(all); mask++ {
+		var nodes []graph.Node
+		for i, u := range all {
+			if mask&(1<<i) != 0 {
+				nodes = append(nodes, u)
+			}
+		}
+		if len(nodes) != k {
+			continue
+		}
+		w, c := induced(g, nodes, nodes)
+		if c == 1 {
+			best = math.Min(best, w)
+		}
+	}
+	return best
+}
+
+// induced returns the weight of a minimum spanning forest of the subgraph
+// of g induced by nodes, and the number of components of the forest that
+// hold a node in terms.
+func induced(g *simple.WeightedUndirectedGraph, nodes, terms []graph.Node) (float64, int) {
+	sub := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, u := range nodes {
+		sub.AddNode(u)
+	}
+	for i, u := range nodes {
+		for _, v := range nodes[i+1:] {
+			if e := g.WeightedEdge(u.ID(), v.ID()); e != nil {
+				sub.SetWeightedEdge(e)
+			}
+		}
+	}
+	forest := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	w := Kruskal(forest, sub)
+
+	seen := make(map[int64]bool)
+	var components int
+	for _, u := range terms {
+		if seen[u.ID()] {
+			continue
+		}
+		components++
+		var bf traverse.BreadthFirst
+		bf.Walk(forest, u, func(n graph.Node, _ int) bool {
+			seen[n.ID()] = true
+			return false
+		})
+	}
+	return w, components
+}
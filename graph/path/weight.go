@@ -43,6 +43,158 @@ func UniformCost(g traverse.Graph) Weighting {
 	}
 }
 
+// MinLineWeight returns a Weighting for the weighted multigraph g that returns
+// the minimum weight of the lines from x to y for joined nodes, zero for node
+// identity and Inf for otherwise absent edges.
+func MinLineWeight(g graph.WeightedMultigraph) Weighting {
+	return func(xid, yid int64) (w float64, ok bool) {
+		if xid == yid {
+			return 0, true
+		}
+		lines := g.WeightedLines(xid, yid)
+		w = math.Inf(1)
+		for lines.Next() {
+			w = math.Min(w, lines.WeightedLine().Weight())
+			ok = true
+		}
+		return w, ok
+	}
+}
+
+// weightingFor returns the Weighting used by the shortest path functions
+// for g. Weighted graphs use their Weight method and all other graphs
+// have uniform cost.
+func weightingFor(g traverse.Graph) Weighting {
+	if wg, ok := g.(Weighted); ok {
+		return wg.Weight
+	}
+	return UniformCost(g)
+}
+
+// MinLineWeighted is a weighted multigraph that is weighted by the minimum
+// weight of the lines joining each pair of nodes. Passing a MinLineWeighted
+// to a path function finds paths over the lightest line between nodes,
+// ignoring the aggregate edge weight provided by the Weight method of G.
+// Directed multigraphs should be wrapped with MinLineWeightedDirected.
+type MinLineWeighted struct {
+	G interface {
+		graph.Graph
+		graph.WeightedMultigraph
+	}
+}
+
+var _ graph.Weighted = MinLineWeighted{}
+
+// Node returns the node with the given ID if it exists in the graph,
+// and nil otherwise.
+func (g MinLineWeighted) Node(id int64) graph.Node { return g.G.Node(id) }
+
+// Nodes returns all the nodes in the graph.
+func (g MinLineWeighted) Nodes() graph.Nodes { return g.G.Nodes() }
+
+// From returns all nodes in g that can be reached directly from u.
+func (g MinLineWeighted) From(uid int64) graph.Nodes { return g.G.From(uid) }
+
+// HasEdgeBetween returns whether an edge exists between nodes x and y.
+func (g MinLineWeighted) HasEdgeBetween(xid, yid int64) bool { return g.G.HasEdgeBetween(xid, yid) }
+
+// Edge returns the edge from u to v if such an edge exists and nil otherwise.
+func (g MinLineWeighted) Edge(uid, vid int64) graph.Edge { return g.G.Edge(uid, vid) }
+
+// WeightedEdge returns the edge from u to v if such an edge exists and nil
+// otherwise. The weight of the returned edge is the minimum weight of the
+// lines from u to v.
+func (g MinLineWeighted) WeightedEdge(uid, vid int64) graph.WeightedEdge {
+	return minLineEdge(g.G, uid, vid)
+}
+
+// Weight returns the minimum weight of the lines from x to y, zero if x and
+// y are the same node and +Inf if there is no line from x to y. Weight
+// returns true if x and y are the same node or a line joins them, and false
+// otherwise.
+func (g MinLineWeighted) Weight(xid, yid int64) (w float64, ok bool) {
+	return MinLineWeight(g.G)(xid, yid)
+}
+
+// MinLineWeightedDirected is a weighted directed multigraph that is weighted
+// by the minimum weight of the lines joining each pair of nodes. It is the
+// directed equivalent of MinLineWeighted.
+type MinLineWeightedDirected struct {
+	G interface {
+		graph.Directed
+		graph.WeightedMultigraph
+	}
+}
+
+var _ graph.WeightedDirected = MinLineWeightedDirected{}
+
+// Node returns the node with the given ID if it exists in the graph,
+// and nil otherwise.
+func (g MinLineWeightedDirected) Node(id int64) graph.Node { return g.G.Node(id) }
+
+// Nodes returns all the nodes in the graph.
+func (g MinLineWeightedDirected) Nodes() graph.Nodes { return g.G.Nodes() }
+
+// From returns all nodes in g that can be reached directly from u.
+func (g MinLineWeightedDirected) From(uid int64) graph.Nodes { return g.G.From(uid) }
+
+// To returns all nodes in g that can reach directly to v.
+func (g MinLineWeightedDirected) To(vid int64) graph.Nodes { return g.G.To(vid) }
+
+// HasEdgeBetween returns whether an edge exists between nodes x and y
+// without considering direction.
+func (g MinLineWeightedDirected) HasEdgeBetween(xid, yid int64) bool {
+	return g.G.HasEdgeBetween(xid, yid)
+}
+
+// HasEdgeFromTo returns whether an edge exists in the graph from u to v.
+func (g MinLineWeightedDirected) HasEdgeFromTo(uid, vid int64) bool {
+	return g.G.HasEdgeFromTo(uid, vid)
+}
+
+// Edge returns the edge from u to v if such an edge exists and nil otherwise.
+func (g MinLineWeightedDirected) Edge(uid, vid int64) graph.Edge { return g.G.Edge(uid, vid) }
+
+// WeightedEdge returns the edge from u to v if such an edge exists and nil
+// otherwise. The weight of the returned edge is the minimum weight of the
+// lines from u to v.
+func (g MinLineWeightedDirected) WeightedEdge(uid, vid int64) graph.WeightedEdge {
+	return minLineEdge(g.G, uid, vid)
+}
+
+// Weight returns the minimum weight of the lines from x to y, zero if x and
+// y are the same node and +Inf if there is no line from x to y. Weight
+// returns true if x and y are the same node or a line joins them, and false
+// otherwise.
+func (g MinLineWeightedDirected) Weight(xid, yid int64) (w float64, ok bool) {
+	return MinLineWeight(g.G)(xid, yid)
+}
+
+// minLineEdge returns the edge from u to v in g weighted by the minimum
+// weight of its lines, or nil if there is no such edge.
+func minLineEdge(g interface {
+	graph.Graph
+	graph.WeightedMultigraph
+}, uid, vid int64) graph.WeightedEdge {
+	e := g.Edge(uid, vid)
+	if e == nil {
+		return nil
+	}
+	w, _ := MinLineWeight(g)(uid, vid)
+	return minLineWeightedEdge{Edge: e, w: w}
+}
+
+// minLineWeightedEdge is an edge with the minimum weight of its lines.
+type minLineWeightedEdge struct {
+	graph.Edge
+	w float64
+}
+
+func (e minLineWeightedEdge) ReversedEdge() graph.Edge {
+	return minLineWeightedEdge{Edge: e.Edge.ReversedEdge(), w: e.w}
+}
+func (e minLineWeightedEdge) Weight() float64 { return e.w }
+
 // Heuristic returns an estimate of the cost of travelling between two nodes.
 type Heuristic func(x, y graph.Node) float64
 
@@ -0,0 +1,147 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestMinLineWeight(t *testing.T) {
+	t.Parallel()
+	g := multi.NewWeightedDirectedGraph()
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(0), multi.Node(1), 3))
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(0), multi.Node(1), 1))
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(0), multi.Node(1), 2))
+	weight := MinLineWeight(g)
+	for _, test := range []struct {
+		x, y int64
+		w    float64
+		ok   bool
+	}{
+		{x: 0, y: 1, w: 1, ok: true},
+		{x: 1, y: 0, w: math.Inf(1), ok: false},
+		{x: 0, y: 0, w: 0, ok: true},
+	} {
+		w, ok := weight(test.x, test.y)
+		if w != test.w || ok != test.ok {
+			t.Errorf("unexpected weight for %d->%d: got:(%v, %t) want:(%v, %t)", test.x, test.y, w, ok, test.w, test.ok)
+		}
+	}
+}
+
+func TestShortestPathMultigraph(t *testing.T) {
+	t.Parallel()
+	// Shortest paths in a weighted multigraph wrapped by
+	// MinLineWeightedDirected must be the shortest paths
+	// in the simple graph holding the lightest line between
+	// each pair of nodes, not the sum of line weights
+	// returned by the graph's Weight method.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 10; trial++ {
+		const n = 12
+		mg := multi.NewWeightedDirectedGraph()
+		sg := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for i := 0; i < n; i++ {
+			mg.AddNode(multi.Node(i))
+			sg.AddNode(simple.Node(i))
+		}
+		for k := 0; k < 4*n; k++ {
+			u, v := rnd.IntN(n), rnd.IntN(n)
+			if u == v {
+				continue
+			}
+			w := float64(1 + rnd.IntN(10))
+			mg.SetWeightedLine(mg.NewWeightedLine(multi.Node(u), multi.Node(v), w))
+			if old, ok := sg.Weight(int64(u), int64(v)); ok && old <= w {
+				continue
+			}
+			sg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: w})
+		}
+
+		for _, test := range []struct {
+			name string
+			fn   func(g graph.Graph) func(u, v int64) float64
+		}{
+			{name: "DijkstraFrom", fn: func(g graph.Graph) func(u, v int64) float64 {
+				return func(u, v int64) float64 { return DijkstraFrom(g.Node(u), g).WeightTo(v) }
+			}},
+			{name: "BellmanFordFrom", fn: func(g graph.Graph) func(u, v int64) float64 {
+				return func(u, v int64) float64 {
+					p, _ := BellmanFordFrom(g.Node(u), g)
+					return p.WeightTo(v)
+				}
+			}},
+			{name: "AStar", fn: func(g graph.Graph) func(u, v int64) float64 {
+				return func(u, v int64) float64 {
+					p, _ := AStar(g.Node(u), g.Node(v), g, nil)
+					return p.WeightTo(v)
+				}
+			}},
+			{name: "DijkstraAllPaths", fn: func(g graph.Graph) func(u, v int64) float64 {
+				return DijkstraAllPaths(g).Weight
+			}},
+			{name: "FloydWarshall", fn: func(g graph.Graph) func(u, v int64) float64 {
+				p, _ := FloydWarshall(g)
+				return p.Weight
+			}},
+			{name: "JohnsonAllPaths", fn: func(g graph.Graph) func(u, v int64) float64 {
+				p, _ := JohnsonAllPaths(g)
+				return p.Weight
+			}},
+		} {
+			got := test.fn(MinLineWeightedDirected{G: mg})
+			want := test.fn(sg)
+			for u := int64(0); u < n; u++ {
+				for v := int64(0); v < n; v++ {
+					if g, w := got(u, v), want(u, v); g != w {
+						t.Errorf("unexpected %s weight for %d->%d in trial %d: got:%v want:%v",
+							test.name, u, v, trial, g, w)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestShortestPathMultigraphWeight(t *testing.T) {
+	t.Parallel()
+	// Without wrapping, the path functions must use the
+	// multigraph's own Weight method.
+	g := multi.NewWeightedUndirectedGraph()
+	g.EdgeWeightFunc = func(lines graph.WeightedLines) float64 {
+		var w float64
+		for lines.Next() {
+			w = math.Max(w, lines.WeightedLine().Weight())
+		}
+		return w
+	}
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(0), multi.Node(1), 1))
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(0), multi.Node(1), 5))
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(1), multi.Node(2), 1))
+	g.SetWeightedLine(g.NewWeightedLine(multi.Node(0), multi.Node(2), 4))
+
+	for _, test := range []struct {
+		name string
+		g    graph.Graph
+		want float64
+	}{
+		{name: "Weight", g: g, want: 4},
+		{name: "MinLineWeighted", g: MinLineWeighted{G: g}, want: 2},
+	} {
+		if got := DijkstraFrom(g.Node(0), test.g).WeightTo(2); got != test.want {
+			t.Errorf("unexpected Dijkstra weight for %s: got:%v want:%v", test.name, got, test.want)
+		}
+		p, _ := FloydWarshall(test.g)
+		if got := p.Weight(0, 2); got != test.want {
+			t.Errorf("unexpected FloydWarshall weight for %s: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
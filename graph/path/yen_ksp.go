@@ -27,11 +27,7 @@ func YenKShortestPaths(g graph.Graph, k int, cost float64, s, t graph.Node) [][]
 		isDirected: isDirected,
 	}
 
-	if wg, ok := g.(Weighted); ok {
-		yk.weight = wg.Weight
-	} else {
-		yk.weight = UniformCost(g)
-	}
+	yk.weight = weightingFor(g)
 
 	shortest, weight := DijkstraFromTo(s, t, yk)
 	cost += weight // Set cost to absolute cost limit.
@@ -0,0 +1,243 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Eigenmap is a Laplacian eigenmap embedding of the nodes of a graph.
+type Eigenmap struct {
+	// Embedding holds the coordinates of
+	// each node in its rows.
+	Embedding *mat.Dense
+
+	// Values holds the eigenvalues corresponding
+	// to each dimension of the embedding.
+	Values []float64
+
+	// Nodes holds the input graph nodes.
+	Nodes []graph.Node
+
+	// Index is a mapping from the graph node
+	// IDs to rows of the embedding.
+	Index map[int64]int
+}
+
+// LaplacianEigenmap returns the dim-dimensional Laplacian eigenmap embedding of
+// the simple undirected graph g. The embedding coordinates are the solutions
+// of the generalized eigenproblem Ly = λDy for the dim smallest eigenvalues
+// after the first, where L is the Laplacian of g and D is the diagonal degree
+// matrix. The eigenpairs are found with SmallestEigen using the given tolerance
+// and random source. Nodes without edges are placed at the origin.
+// LaplacianEigenmap will panic if dim is not in [1, n-1] where n is the number
+// of nodes in g, or if g contains self edges.
+//
+// The embedding is described in Belkin and Niyogi, "Laplacian eigenmaps for
+// dimensionality reduction and data representation", Neural Comput.
+// 15(6):1373-1396, 2003, doi:10.1162/089976603321780317.
+func LaplacianEigenmap(g graph.Undirected, dim int, tol float64, src rand.Source) (Eigenmap, error) {
+	l := NewSparseSymNormLaplacian(g)
+	n := len(l.Nodes)
+	if dim < 1 || n <= dim {
+		panic("spectral: invalid embedding dimension")
+	}
+	values, vectors, err := SmallestEigen(l, dim+1, tol, src)
+	if err != nil {
+		return Eigenmap{}, err
+	}
+
+	// Solutions of the generalized problem are
+	// obtained from the eigenvectors u of the
+	// normalized Laplacian by y = D^(-1/2) u.
+	embedding := mat.NewDense(n, dim, nil)
+	for i := 0; i < n; i++ {
+		deg := l.row[i+1] - l.row[i]
+		if deg == 0 {
+			continue
+		}
+		scale := 1 / math.Sqrt(float64(deg))
+		for j := 0; j < dim; j++ {
+			embedding.Set(i, j, scale*vectors.At(i, j+1))
+		}
+	}
+	return Eigenmap{
+		Embedding: embedding,
+		Values:    values[1:],
+		Nodes:     l.Nodes,
+		Index:     l.Index,
+	}, nil
+}
+
+// kMeansStarts is the number of k-means initializations
+// tried by Cluster.
+const kMeansStarts = 10
+
+// Cluster returns a partition of the nodes of the simple undirected graph g
+// into at most k clusters using normalized spectral clustering. The nodes are
+// embedded using the eigenvectors of the symmetric normalized Laplacian for
+// the k smallest eigenvalues, the embedding rows are scaled to unit length and
+// the rows are clustered by k-means. The eigenpairs are found with SmallestEigen
+// using the given tolerance and random source. Members of each cluster are
+// sorted by ID and clusters are sorted by the ID of their first member.
+// Cluster will panic if k is not in [1, n] where n is the number of nodes in g,
+// or if g contains self edges.
+//
+// The algorithm is described in Ng, Jordan and Weiss, "On spectral clustering:
+// analysis and an algorithm", NIPS 2001.
+func Cluster(g graph.Undirected, k int, tol float64, src rand.Source) ([][]graph.Node, error) {
+	var (
+		intn    func(int) int
+		uniform func() float64
+	)
+	if src == nil {
+		intn = rand.IntN
+		uniform = rand.Float64
+	} else {
+		rnd := rand.New(src)
+		intn = rnd.IntN
+		uniform = rnd.Float64
+	}
+
+	l := NewSparseSymNormLaplacian(g)
+	n := len(l.Nodes)
+	if k < 1 || n < k {
+		panic("spectral: invalid number of clusters")
+	}
+	_, vectors, err := SmallestEigen(l, k, tol, src)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([][]float64, n)
+	for i := range points {
+		points[i] = mat.Row(nil, i, vectors)
+		if norm := floats.Norm(points[i], 2); norm != 0 {
+			floats.Scale(1/norm, points[i])
+		}
+	}
+	var (
+		best     []int
+		bestCost = math.Inf(1)
+	)
+	for i := 0; i < kMeansStarts; i++ {
+		label, cost := kMeans(points, k, intn, uniform)
+		if cost < bestCost {
+			best, bestCost = label, cost
+		}
+	}
+
+	index := make(map[int]int)
+	var clusters [][]graph.Node
+	for i, c := range best {
+		j, ok := index[c]
+		if !ok {
+			j = len(clusters)
+			index[c] = j
+			clusters = append(clusters, nil)
+		}
+		clusters[j] = append(clusters[j], l.Nodes[i])
+	}
+	for _, c := range clusters {
+		order.ByID(c)
+	}
+	order.BySliceIDs(clusters)
+	return clusters, nil
+}
+
+// kMeansIterations is the maximum number of Lloyd
+// iterations performed by kMeans.
+const kMeansIterations = 100
+
+// kMeans returns a clustering of points into at most k clusters using Lloyd's
+// algorithm with k-means++ initialization, and the sum of squared distances
+// from each point to its cluster center.
+func kMeans(points [][]float64, k int, intn func(int) int, uniform func() float64) (label []int, cost float64) {
+	n := len(points)
+	label = make([]int, n)
+
+	// Choose the initial centers by k-means++.
+	centers := make([][]float64, k)
+	dist := make([]float64, n)
+	centers[0] = append([]float64(nil), points[intn(n)]...)
+	for v, p := range points {
+		dist[v] = sqDistance(p, centers[0])
+	}
+	for c := 1; c < k; c++ {
+		choice := intn(n)
+		if sum := floats.Sum(dist); sum > 0 {
+			u := uniform() * sum
+			for v, d := range dist {
+				u -= d
+				if u < 0 {
+					choice = v
+					break
+				}
+			}
+		}
+		centers[c] = append([]float64(nil), points[choice]...)
+		for v, p := range points {
+			dist[v] = math.Min(dist[v], sqDistance(p, centers[c]))
+		}
+	}
+
+	count := make([]int, k)
+	for i := 0; i < kMeansIterations; i++ {
+		changed := i == 0
+		cost = 0
+		for v, p := range points {
+			best := label[v]
+			bestDist := sqDistance(p, centers[best])
+			for c, center := range centers {
+				if d := sqDistance(p, center); d < bestDist {
+					best, bestDist = c, d
+				}
+			}
+			if best != label[v] {
+				label[v] = best
+				changed = true
+			}
+			cost += bestDist
+		}
+		if !changed {
+			break
+		}
+
+		// Move each non-empty cluster's
+		// center to its centroid.
+		for c := range count {
+			count[c] = 0
+		}
+		for v, c := range label {
+			if count[c] == 0 {
+				for j := range centers[c] {
+					centers[c][j] = 0
+				}
+			}
+			floats.Add(centers[c], points[v])
+			count[c]++
+		}
+		for c, center := range centers {
+			if count[c] != 0 {
+				floats.Scale(1/float64(count[c]), center)
+			}
+		}
+	}
+	return label, cost
+}
+
+func sqDistance(a, b []float64) float64 {
+	var d float64
+	for i, v := range a {
+		d += (v - b[i]) * (v - b[i])
+	}
+	return d
+}
@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestCluster(t *testing.T) {
+	sizes := []int{40, 60, 80, 100}
+	p := mat.NewDense(4, 4, nil)
+	for i := range sizes {
+		for j := range sizes {
+			if i == j {
+				p.Set(i, j, 0.3)
+			} else {
+				p.Set(i, j, 0.01)
+			}
+		}
+	}
+	for seed := uint64(1); seed <= 5; seed++ {
+		g := simple.NewUndirectedGraph()
+		blocks, err := gen.StochasticBlock(g, sizes, p, rand.NewPCG(seed, seed))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		clusters, err := Cluster(g, len(sizes), 1e-8, rand.NewPCG(seed, seed))
+		if err != nil {
+			t.Errorf("unexpected error for seed %d: %v", seed, err)
+			continue
+		}
+		if !samePartition(clusters, blocks) {
+			t.Errorf("unexpected clusters for seed %d:\ngot: %v\nwant:%v", seed, clusters, blocks)
+		}
+	}
+}
+
+func TestLaplacianEigenmap(t *testing.T) {
+	// The Laplacian eigenmap of a cycle places the
+	// nodes on an ellipse in the order of the cycle.
+	const n = 100
+	g := simple.NewUndirectedGraph()
+	gen.Cycle(g, gen.IDRange{First: 0, Last: n - 1})
+	e, err := LaplacianEigenmap(g, 2, 1e-10, rand.NewPCG(1, 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The generalized eigenvalues of a cycle with degree
+	// two are half the Laplacian eigenvalues 2-2cos(2πk/n).
+	want := 1 - math.Cos(2*math.Pi/n)
+	for i, v := range e.Values {
+		if !scalar.EqualWithinAbsOrRel(v, want, 1e-8, 1e-8) {
+			t.Errorf("unexpected eigenvalue %d: got:%v want:%v", i, v, want)
+		}
+	}
+
+	var (
+		radius float64
+		last   float64
+		turns  float64
+	)
+	for id := int64(0); id <= n; id++ {
+		row := e.Embedding.RawRowView(e.Index[id%n])
+		r := math.Hypot(row[0], row[1])
+		if id == 0 {
+			radius = r
+		} else if !scalar.EqualWithinAbsOrRel(r, radius, 1e-6, 1e-6) {
+			t.Errorf("node %d not on circle: got radius %v want:%v", id, r, radius)
+		}
+		theta := math.Atan2(row[1], row[0])
+		if id != 0 {
+			d := math.Remainder(theta-last, 2*math.Pi)
+			if !scalar.EqualWithinAbsOrRel(math.Abs(d), 2*math.Pi/n, 1e-6, 1e-6) {
+				t.Errorf("unexpected angle between nodes %d and %d: got:%v want:%v", id-1, id%n, math.Abs(d), 2*math.Pi/n)
+			}
+			turns += d
+		}
+		last = theta
+	}
+	if !scalar.EqualWithinAbsOrRel(math.Abs(turns), 2*math.Pi, 1e-6, 1e-6) {
+		t.Errorf("embedding does not wind once around the origin: got:%v", turns)
+	}
+}
+
+// samePartition returns whether the partitions a and b of a set of nodes
+// are the same up to relabeling of the parts.
+func samePartition(a, b [][]graph.Node) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	part := make(map[int64]int)
+	for i, p := range a {
+		for _, n := range p {
+			part[n.ID()] = i
+		}
+	}
+	for _, p := range b {
+		if len(p) == 0 {
+			continue
+		}
+		want := part[p[0].ID()]
+		for _, n := range p {
+			if part[n.ID()] != want {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral
+
+import (
+	"errors"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrNotConverged is returned by SmallestEigen when the eigensolver
+// has not converged within its iteration limit.
+var ErrNotConverged = errors.New("spectral: eigensolver did not converge")
+
+// Operator is a symmetric linear operator. SparseLaplacian and
+// mat.SymBandDense satisfy Operator.
+type Operator interface {
+	// SymmetricDim returns the dimension of the operator.
+	SymmetricDim() int
+
+	// MulVecTo computes A⋅x storing the result into dst.
+	// The trans parameter is ignored by symmetric operators.
+	MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector)
+}
+
+// eigenMaxIter is the maximum number of LOBPCG
+// iterations performed by SmallestEigen.
+const eigenMaxIter = 10000
+
+// SmallestEigen returns the k smallest eigenvalues of the symmetric operator a
+// in ascending order and the corresponding orthonormal eigenvectors in the
+// columns of vectors. Iteration stops when the residual ‖A⋅x - λx‖₂ of each
+// returned eigenpair is at most tol. If src is nil, rand.Float64 is used to
+// generate the random initial subspace. SmallestEigen will panic if k is not
+// in [1, n] where n is the dimension of a, or if tol is not positive.
+//
+// The eigenpairs are found by the locally optimal block preconditioned conjugate
+// gradient method without preconditioning using a block size larger than k
+// to accelerate convergence. Only matrix-vector products with a are required,
+// so SmallestEigen is suitable for large sparse matrices such as Laplacians
+// held in a SparseLaplacian. Operators with a small dimension are solved
+// directly with mat.EigenSym.
+//
+// The method is described in Knyazev, "Toward the optimal preconditioned
+// eigensolver: locally optimal block preconditioned conjugate gradient method",
+// SIAM J. Sci. Comput. 23(2):517-541, 2001, doi:10.1137/S1064827500366124.
+func SmallestEigen(a Operator, k int, tol float64, src rand.Source) (values []float64, vectors *mat.Dense, err error) {
+	n := a.SymmetricDim()
+	if k < 1 || n < k {
+		panic("spectral: invalid number of eigenpairs")
+	}
+	if !(tol > 0) {
+		panic("spectral: invalid tolerance")
+	}
+	uniform := rand.Float64
+	if src != nil {
+		uniform = rand.New(src).Float64
+	}
+
+	m := min(n, k+max(k/2, 4))
+	if 3*m >= n {
+		return denseSmallestEigen(a, k)
+	}
+
+	// The search subspace [X R P] is held as a set of columns
+	// together with their images under a.
+	x := orthonormalize(randomColumns(n, m, uniform), 1e-8)
+	if len(x) < m {
+		return nil, nil, ErrNotConverged
+	}
+	theta, x, ax, _ := rayleighRitz(x, applyAll(a, x), m)
+
+	var p [][]float64
+	r := make([][]float64, m)
+	for i := range r {
+		r[i] = make([]float64, n)
+	}
+	for iter := 0; iter < eigenMaxIter; iter++ {
+		converged := true
+		for j := range r {
+			copy(r[j], ax[j])
+			floats.AddScaled(r[j], -theta[j], x[j])
+			if j < k && floats.Norm(r[j], 2) > tol {
+				converged = false
+			}
+		}
+		if converged {
+			return theta[:k:k], columnsToDense(x[:k]), nil
+		}
+
+		s := make([][]float64, 0, len(x)+len(r)+len(p))
+		s = append(s, x...)
+		for _, c := range append(r, p...) {
+			s = append(s, append([]float64(nil), c...))
+		}
+		s = orthonormalize(s, 1e-10)
+		theta, x, ax, p = rayleighRitz(s, applyAll(a, s), m)
+	}
+	return nil, nil, ErrNotConverged
+}
+
+// rayleighRitz returns the m smallest Ritz values and Ritz vectors of the
+// operator with respect to the orthonormal columns of s, given their images
+// as under the operator. It also returns the components of the Ritz vectors
+// that lie outside the span of the first m columns of s, the LOBPCG search
+// directions.
+func rayleighRitz(s, as [][]float64, m int) (theta []float64, x, ax, p [][]float64) {
+	c := len(s)
+	g := mat.NewSymDense(c, nil)
+	for i := range s {
+		for j := i; j < c; j++ {
+			g.SetSym(i, j, 0.5*(floats.Dot(s[i], as[j])+floats.Dot(s[j], as[i])))
+		}
+	}
+	var ed mat.EigenSym
+	if !ed.Factorize(g, true) {
+		panic("spectral: Rayleigh-Ritz eigendecomposition failed")
+	}
+	var y mat.Dense
+	ed.VectorsTo(&y)
+	theta = ed.Values(nil)[:m]
+
+	x = combine(s, &y, 0, m)
+	ax = combine(as, &y, 0, m)
+	if c > m {
+		p = combine(s, &y, m, m)
+	}
+	return theta, x, ax, p
+}
+
+// combine returns the m linear combinations of the columns of s starting at
+// column from with coefficients given by the first m columns of rows from
+// onward in y.
+func combine(s [][]float64, y *mat.Dense, from, m int) [][]float64 {
+	n := len(s[0])
+	dst := make([][]float64, m)
+	for j := range dst {
+		dst[j] = make([]float64, n)
+		for i := from; i < len(s); i++ {
+			floats.AddScaled(dst[j], y.At(i, j), s[i])
+		}
+	}
+	return dst
+}
+
+// orthonormalize orthonormalizes the columns of s in place using modified
+// Gram-Schmidt with reorthogonalization, dropping columns whose norm falls
+// below drop relative to their original norm. The retained columns are returned.
+func orthonormalize(s [][]float64, drop float64) [][]float64 {
+	kept := s[:0]
+	for _, c := range s {
+		norm0 := floats.Norm(c, 2)
+		if norm0 == 0 {
+			continue
+		}
+		for pass := 0; pass < 2; pass++ {
+			for _, q := range kept {
+				floats.AddScaled(c, -floats.Dot(q, c), q)
+			}
+		}
+		norm := floats.Norm(c, 2)
+		if norm <= drop*norm0 {
+			continue
+		}
+		floats.Scale(1/norm, c)
+		kept = append(kept, c)
+	}
+	return kept
+}
+
+// applyAll returns the images of the columns of s under a.
+func applyAll(a Operator, s [][]float64) [][]float64 {
+	n := a.SymmetricDim()
+	as := make([][]float64, len(s))
+	for i, c := range s {
+		as[i] = make([]float64, n)
+		a.MulVecTo(mat.NewVecDense(n, as[i]), false, mat.NewVecDense(n, c))
+	}
+	return as
+}
+
+// randomColumns returns m random columns of length n.
+func randomColumns(n, m int, uniform func() float64) [][]float64 {
+	s := make([][]float64, m)
+	for j := range s {
+		s[j] = make([]float64, n)
+		for i := range s[j] {
+			s[j][i] = uniform() - 0.5
+		}
+	}
+	return s
+}
+
+// columnsToDense returns a matrix holding the given columns.
+func columnsToDense(cols [][]float64) *mat.Dense {
+	d := mat.NewDense(len(cols[0]), len(cols), nil)
+	for j, c := range cols {
+		d.SetCol(j, c)
+	}
+	return d
+}
+
+// denseSmallestEigen returns the k smallest eigenpairs of a by forming the
+// dense matrix and performing a full eigendecomposition.
+func denseSmallestEigen(a Operator, k int) ([]float64, *mat.Dense, error) {
+	n := a.SymmetricDim()
+	d := mat.NewDense(n, n, nil)
+	e := mat.NewVecDense(n, nil)
+	for j := 0; j < n; j++ {
+		e.SetVec(j, 1)
+		a.MulVecTo(d.ColView(j).(*mat.VecDense), false, e)
+		e.SetVec(j, 0)
+	}
+	s := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.SetSym(i, j, 0.5*(d.At(i, j)+d.At(j, i)))
+		}
+	}
+	var ed mat.EigenSym
+	if !ed.Factorize(s, true) {
+		return nil, nil, ErrNotConverged
+	}
+	var v mat.Dense
+	ed.VectorsTo(&v)
+	return ed.Values(nil)[:k:k], mat.DenseCopyOf(v.Slice(0, n, 0, k)), nil
+}
@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestSmallestEigen(t *testing.T) {
+	const tol = 1e-8
+
+	ring := simple.NewUndirectedGraph()
+	gen.Cycle(ring, gen.IDRange{First: 0, Last: 199})
+
+	random := simple.NewUndirectedGraph()
+	err := gen.Gnp(random, 300, 0.03, rand.NewPCG(1, 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Two components give a repeated zero eigenvalue.
+	twoComponents := simple.NewUndirectedGraph()
+	gen.Complete(twoComponents, gen.IDRange{First: 0, Last: 49})
+	gen.Path(twoComponents, gen.IDRange{First: 50, Last: 149})
+
+	small := simple.NewUndirectedGraph()
+	gen.Star(small, 0, gen.IDRange{First: 1, Last: 9})
+
+	band := mat.NewSymBandDense(100, 1, nil)
+	for i := 0; i < 100; i++ {
+		band.SetSymBand(i, i, 2)
+		if i < 99 {
+			band.SetSymBand(i, i+1, -1)
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+		a    Operator
+		k    int
+	}{
+		{name: "ring Laplacian", a: NewSparseLaplacian(ring), k: 5},
+		{name: "ring normalized Laplacian", a: NewSparseSymNormLaplacian(ring), k: 6},
+		{name: "random Laplacian", a: NewSparseLaplacian(random), k: 4},
+		{name: "random normalized Laplacian", a: NewSparseSymNormLaplacian(random), k: 8},
+		{name: "two components", a: NewSparseLaplacian(twoComponents), k: 3},
+		{name: "small", a: NewSparseLaplacian(small), k: 3},
+		{name: "banded", a: band, k: 4},
+	} {
+		values, vectors, err := SmallestEigen(test.a, test.k, tol, rand.NewPCG(1, 1))
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		want := denseEigenvalues(test.a)[:test.k]
+		if !floats.EqualApprox(values, want, 1e-6) {
+			t.Errorf("unexpected eigenvalues for %s:\ngot: %v\nwant:%v", test.name, values, want)
+		}
+
+		n := test.a.SymmetricDim()
+		if r, c := vectors.Dims(); r != n || c != test.k {
+			t.Fatalf("unexpected eigenvector dimensions for %s: got:%d×%d want:%d×%d", test.name, r, c, n, test.k)
+		}
+		var gram mat.Dense
+		gram.Mul(vectors.T(), vectors)
+		if !mat.EqualApprox(&gram, eye(test.k), 1e-8) {
+			t.Errorf("eigenvectors not orthonormal for %s", test.name)
+		}
+		var av mat.VecDense
+		for j, v := range values {
+			x := vectors.ColView(j)
+			test.a.MulVecTo(&av, false, x)
+			av.AddScaledVec(&av, -v, x)
+			if norm := mat.Norm(&av, 2); norm > tol*(1+1e-8) {
+				t.Errorf("unexpected residual for %s eigenpair %d: got:%v want:<=%v", test.name, j, norm, tol)
+			}
+		}
+	}
+}
+
+func TestSmallestEigenFiedler(t *testing.T) {
+	// The Fiedler vector of a path is monotonic along the path.
+	const n = 200
+	g := simple.NewUndirectedGraph()
+	gen.Path(g, gen.IDRange{First: 0, Last: n - 1})
+	l := NewSparseLaplacian(g)
+	values, vectors, err := SmallestEigen(l, 2, 1e-9, rand.NewPCG(1, 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !scalar.EqualWithinAbs(values[0], 0, 1e-9) {
+		t.Errorf("unexpected smallest eigenvalue: got:%v want:0", values[0])
+	}
+	fiedler := make([]float64, n)
+	for id, i := range l.Index {
+		fiedler[id] = vectors.At(i, 1)
+	}
+	if fiedler[0] > fiedler[n-1] {
+		floats.Scale(-1, fiedler)
+	}
+	for i := 1; i < n; i++ {
+		if fiedler[i] <= fiedler[i-1] {
+			t.Errorf("Fiedler vector not monotonic at %d: %v <= %v", i, fiedler[i], fiedler[i-1])
+			break
+		}
+	}
+}
+
+// denseEigenvalues returns the eigenvalues of a in ascending order
+// calculated by a dense eigendecomposition.
+func denseEigenvalues(a Operator) []float64 {
+	var ed mat.EigenSym
+	ok := ed.Factorize(a.(mat.Symmetric), false)
+	if !ok {
+		panic("eigendecomposition failed")
+	}
+	return ed.Values(nil)
+}
+
+func eye(n int) *mat.Dense {
+	d := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		d.Set(i, i, 1)
+	}
+	return d
+}
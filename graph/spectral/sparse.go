@@ -0,0 +1,167 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/mat"
+)
+
+// SparseLaplacian is a graph Laplacian matrix held in compressed sparse row
+// form. SparseLaplacian implements mat.Symmetric, and matrix-vector products
+// with MulVecTo take time linear in the number of edges of the graph.
+type SparseLaplacian struct {
+	// Nodes holds the input graph nodes.
+	Nodes []graph.Node
+
+	// Index is a mapping from the graph
+	// node IDs to row and column indices.
+	Index map[int64]int
+
+	diag []float64
+
+	// The off-diagonal elements of row i are held in
+	// val[row[i]:row[i+1]] with the column indices in
+	// col[row[i]:row[i+1]] in ascending order.
+	row []int
+	col []int
+	val []float64
+}
+
+// NewSparseLaplacian returns a sparse Laplacian matrix for the simple undirected
+// graph g. The Laplacian is defined as D-A where D is a diagonal matrix holding
+// the degree of each node and A is the graph adjacency matrix of the input graph.
+// If g contains self edges, NewSparseLaplacian will panic.
+func NewSparseLaplacian(g graph.Undirected) *SparseLaplacian {
+	l := newSparseAdjacency(g)
+	for i := range l.diag {
+		l.diag[i] = float64(l.row[i+1] - l.row[i])
+	}
+	for i := range l.val {
+		l.val[i] = -1
+	}
+	return l
+}
+
+// NewSparseSymNormLaplacian returns a sparse symmetric normalized Laplacian
+// matrix for the simple undirected graph g. The normalized Laplacian is defined
+// as I-D^(-1/2)AD^(-1/2) where D is a diagonal matrix holding the degree of each
+// node and A is the graph adjacency matrix of the input graph. The diagonal
+// elements for nodes without edges are zero.
+// If g contains self edges, NewSparseSymNormLaplacian will panic.
+func NewSparseSymNormLaplacian(g graph.Undirected) *SparseLaplacian {
+	l := newSparseAdjacency(g)
+	invSqrtDeg := make([]float64, len(l.diag))
+	for i := range l.diag {
+		deg := l.row[i+1] - l.row[i]
+		if deg == 0 {
+			continue
+		}
+		l.diag[i] = 1
+		invSqrtDeg[i] = 1 / math.Sqrt(float64(deg))
+	}
+	for i := range l.diag {
+		for j := l.row[i]; j < l.row[i+1]; j++ {
+			l.val[j] = -invSqrtDeg[i] * invSqrtDeg[l.col[j]]
+		}
+	}
+	return l
+}
+
+// newSparseAdjacency returns a SparseLaplacian holding the sparsity
+// structure of the adjacency matrix of g with zero diagonal and values.
+func newSparseAdjacency(g graph.Undirected) *SparseLaplacian {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+
+	l := &SparseLaplacian{
+		Nodes: nodes,
+		Index: indexOf,
+		diag:  make([]float64, len(nodes)),
+		row:   make([]int, len(nodes)+1),
+	}
+	for i, u := range nodes {
+		uid := u.ID()
+		start := len(l.col)
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if uid == vid {
+				panic("spectral: self edge in graph")
+			}
+			l.col = append(l.col, indexOf[vid])
+		}
+		sort.Ints(l.col[start:])
+		l.row[i+1] = len(l.col)
+	}
+	l.val = make([]float64, len(l.col))
+	return l
+}
+
+// Dims returns the dimensions of the matrix.
+func (l *SparseLaplacian) Dims() (r, c int) {
+	return len(l.diag), len(l.diag)
+}
+
+// SymmetricDim returns the number of rows and columns of the matrix.
+func (l *SparseLaplacian) SymmetricDim() int {
+	return len(l.diag)
+}
+
+// At returns the element at row i, column j.
+func (l *SparseLaplacian) At(i, j int) float64 {
+	n := len(l.diag)
+	if uint(i) >= uint(n) {
+		panic(mat.ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(mat.ErrColAccess)
+	}
+	if i == j {
+		return l.diag[i]
+	}
+	cols := l.col[l.row[i]:l.row[i+1]]
+	k := sort.SearchInts(cols, j)
+	if k < len(cols) && cols[k] == j {
+		return l.val[l.row[i]+k]
+	}
+	return 0
+}
+
+// T returns the receiver, the transpose of a symmetric matrix.
+func (l *SparseLaplacian) T() mat.Matrix {
+	return l
+}
+
+// MulVecTo computes L⋅x storing the result into dst. The trans parameter
+// is ignored since the matrix is symmetric.
+func (l *SparseLaplacian) MulVecTo(dst *mat.VecDense, _ bool, x mat.Vector) {
+	n := len(l.diag)
+	if x.Len() != n {
+		panic(mat.ErrShape)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAsVec(n)
+	} else if dst.Len() != n {
+		panic(mat.ErrShape)
+	}
+	if dst == x {
+		// Work on a copy to avoid clobbering x.
+		x = mat.VecDenseCopyOf(x)
+	}
+	for i, d := range l.diag {
+		v := d * x.AtVec(i)
+		for k := l.row[i]; k < l.row[i+1]; k++ {
+			v += l.val[k] * x.AtVec(l.col[k])
+		}
+		dst.SetVec(i, v)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/iterator"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestSparseLaplacian(t *testing.T) {
+	const tol = 1e-14
+	for _, test := range []struct {
+		name   string
+		sparse func(graph.Undirected) *SparseLaplacian
+		dense  func(graph.Undirected) Laplacian
+	}{
+		{name: "Laplacian", sparse: NewSparseLaplacian, dense: NewLaplacian},
+		{name: "SymNormLaplacian", sparse: NewSparseSymNormLaplacian, dense: NewSymNormLaplacian},
+	} {
+		for seed := uint64(1); seed <= 5; seed++ {
+			g := simple.NewUndirectedGraph()
+			err := gen.Gnp(g, 30, 0.1, rand.NewPCG(seed, seed))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			name := fmt.Sprintf("%s seed=%d", test.name, seed)
+			sortedG := sortedNodeUndirected{g}
+			got := test.sparse(sortedG)
+			want := test.dense(sortedG)
+			if !mat.EqualApprox(got, want, tol) {
+				t.Errorf("unexpected sparse matrix for %s:\ngot:\n% .2v\nwant:\n% .2v",
+					name, mat.Formatted(got), mat.Formatted(want))
+			}
+
+			rnd := rand.New(rand.NewPCG(seed, seed))
+			x := mat.NewVecDense(got.SymmetricDim(), nil)
+			for i := 0; i < x.Len(); i++ {
+				x.SetVec(i, rnd.NormFloat64())
+			}
+			var gotVec, wantVec mat.VecDense
+			got.MulVecTo(&gotVec, false, x)
+			wantVec.MulVec(want, x)
+			if !mat.EqualApprox(&gotVec, &wantVec, tol) {
+				t.Errorf("unexpected matrix-vector product for %s:\ngot: %v\nwant:%v",
+					name, mat.Formatted(gotVec.T()), mat.Formatted(wantVec.T()))
+			}
+			got.MulVecTo(x, false, x)
+			if !mat.EqualApprox(x, &wantVec, tol) {
+				t.Errorf("unexpected in-place matrix-vector product for %s", name)
+			}
+		}
+	}
+}
+
+type sortedNodeUndirected struct {
+	graph.Undirected
+}
+
+func (g sortedNodeUndirected) Nodes() graph.Nodes {
+	n := graph.NodesOf(g.Undirected.Nodes())
+	order.ByID(n)
+	return iterator.NewOrderedNodes(n)
+}
@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temporal
+
+import "math"
+
+// Betweenness returns the non-zero temporal betweenness centrality for nodes
+// in the temporal graph g for paths departing at time start.
+//
+//	C_B(v) = \sum_{s ≠ v ≠ t ∈ V} (\sigma_{st}(v) / \sigma_{st})
+//
+// where \sigma_{st} is the number of prefix-foremost paths from s to t and
+// \sigma_{st}(v) is the number of those paths passing through v. A
+// prefix-foremost path is a time-respecting path in which every prefix
+// arrives at its final node at the earliest possible time. Distinct contacts
+// between the same pair of nodes give distinct paths. Where contacts of zero
+// duration allow nodes to be reached at the same time from each other, only
+// the contacts leaving the node reached first in the search are counted.
+//
+// Each ordered pair of nodes is counted, so for undirected temporal graphs
+// the paths from s to t and from t to s both contribute to the centrality.
+//
+// The centrality is calculated by Brandes' algorithm applied to the directed
+// acyclic graph of tight contacts found by an earliest arrival search from
+// each node, as described in Buß, Molter, Niedermeier and Rymar,
+// "Algorithmic aspects of temporal betweenness", KDD '20, 2084-2092, 2020,
+// doi:10.1145/3394486.3403259.
+func Betweenness(g Graph, start float64) map[int64]float64 {
+	cb := make(map[int64]float64)
+	var (
+		sigma, delta []float64
+		pred         [][]int
+	)
+	nodes := g.Nodes()
+	for nodes.Next() {
+		s := nodes.Node()
+		sigma = append(sigma[:0], 1)
+		pred = append(pred[:0], nil)
+		a := earliestArrival(g, s, start, math.Inf(1), func(u, v int, _ Contact) {
+			for len(sigma) <= v {
+				sigma = append(sigma, 0)
+				pred = append(pred, nil)
+			}
+			sigma[v] += sigma[u]
+			pred[v] = append(pred[v], u)
+		})
+		n := len(a.nodes)
+		if n < 3 {
+			continue
+		}
+		delta = resize(delta, n)
+		for w := n - 1; w > 0; w-- {
+			for _, v := range pred[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if d := delta[w]; d != 0 {
+				cb[a.nodes[w].ID()] += d
+			}
+		}
+	}
+	return cb
+}
+
+func resize(s []float64, n int) []float64 {
+	if cap(s) < n {
+		return make([]float64, n)
+	}
+	s = s[:n]
+	clear(s)
+	return s
+}
@@ -0,0 +1,14 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package temporal provides temporal graphs and algorithms for analysing
+// time-respecting paths through them.
+//
+// A temporal graph holds contacts: edges that may only be traversed during a
+// time interval and that take time to traverse. A time-respecting path is a
+// sequence of contacts in which each contact is departed no earlier than the
+// arrival of the previous contact. Reachability and path lengths in temporal
+// graphs are generally not those of the static graph obtained by collapsing
+// time, which can be constructed with Aggregate.
+package temporal // import "gonum.org/v1/gonum/graph/temporal"
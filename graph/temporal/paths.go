@@ -0,0 +1,187 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temporal
+
+import (
+	"container/heap"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Arrivals holds the earliest arrival times and time-respecting paths from
+// a single source node departing at a given time.
+type Arrivals struct {
+	from  graph.Node
+	start float64
+
+	indexOf map[int64]int
+
+	// nodes, arrival and via hold the nodes
+	// reached in the order of their arrival,
+	// their arrival times and the contacts
+	// used to reach them. The first element
+	// is the source node.
+	nodes   []graph.Node
+	arrival []float64
+	via     []Contact
+}
+
+// From returns the source node of the arrivals.
+func (a Arrivals) From() graph.Node { return a.from }
+
+// Start returns the time of departure from the source node.
+func (a Arrivals) Start() float64 { return a.start }
+
+// ArrivalAt returns the earliest time at which the node with ID vid can be
+// reached from the source. ArrivalAt returns +Inf if the node is not
+// reachable.
+func (a Arrivals) ArrivalAt(vid int64) float64 {
+	i, ok := a.indexOf[vid]
+	if !ok {
+		return math.Inf(1)
+	}
+	return a.arrival[i]
+}
+
+// To returns a time-respecting path of contacts from the source to the node
+// with ID vid arriving at the earliest possible time, and that arrival time.
+// If the node is not reachable, To returns a nil path and +Inf. The path to
+// the source node is empty.
+func (a Arrivals) To(vid int64) (path []Contact, arrival float64) {
+	i, ok := a.indexOf[vid]
+	if !ok {
+		return nil, math.Inf(1)
+	}
+	arrival = a.arrival[i]
+	path = []Contact{}
+	for i != 0 {
+		c := a.via[i]
+		path = append(path, c)
+		i = a.indexOf[c.From.ID()]
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, arrival
+}
+
+// Reached returns the nodes reachable from the source in order of their
+// earliest arrival times, beginning with the source.
+func (a Arrivals) Reached() []graph.Node {
+	return a.nodes[:len(a.nodes):len(a.nodes)]
+}
+
+// EarliestArrival returns the earliest arrival times and corresponding
+// time-respecting paths from the node from to all nodes reachable from it
+// in g when departing at time start. A contact may be taken if it can be
+// departed no earlier than the arrival at its From node. EarliestArrival
+// will panic if from is not in g.
+//
+// The search is a label-setting search analogous to Dijkstra's algorithm
+// and takes O(|C|.log|C|) time where |C| is the number of contacts.
+func EarliestArrival(g Graph, from graph.Node, start float64) Arrivals {
+	return earliestArrival(g, from, start, math.Inf(1), nil)
+}
+
+// Reachable returns the nodes of g that can be reached from the node from
+// by a time-respecting path departing no earlier than start and arriving
+// no later than end, in order of their earliest arrival times. The node from
+// is always included in the returned nodes. Reachable will panic if from is
+// not in g.
+func Reachable(g Graph, from graph.Node, start, end float64) []graph.Node {
+	return earliestArrival(g, from, start, end, nil).Reached()
+}
+
+// earliestArrival performs an earliest arrival search from the node from
+// departing at time start, ignoring arrivals later than end. If tight is not
+// nil, it is called for each node index u in the order nodes are reached with
+// the index and contact of each tight contact leaving u that reaches a node
+// that is reached after u. A contact is tight if arrival over it is at the
+// earliest arrival time of its To node.
+func earliestArrival(g Graph, from graph.Node, start, end float64, tight func(u, v int, c Contact)) Arrivals {
+	if g.Node(from.ID()) == nil {
+		panic("temporal: source node not in graph")
+	}
+
+	a := Arrivals{
+		from:    from,
+		start:   start,
+		indexOf: make(map[int64]int),
+	}
+	best := map[int64]arrival{from.ID(): {node: from, time: start}}
+	q := arrivalQueue{{node: from, time: start}}
+	for q.Len() != 0 {
+		u := heap.Pop(&q).(arrival)
+		uid := u.node.ID()
+		if _, done := a.indexOf[uid]; done {
+			continue
+		}
+		a.indexOf[uid] = len(a.nodes)
+		a.nodes = append(a.nodes, u.node)
+		a.arrival = append(a.arrival, u.time)
+		a.via = append(a.via, u.via)
+
+		for _, c := range g.ContactsFrom(uid) {
+			t, ok := c.Depart(u.time)
+			if !ok {
+				continue
+			}
+			t += c.Duration
+			if t > end {
+				continue
+			}
+			vid := c.To.ID()
+			if _, done := a.indexOf[vid]; done {
+				continue
+			}
+			if b, ok := best[vid]; ok && b.time <= t {
+				continue
+			}
+			v := arrival{node: c.To, time: t, via: c}
+			best[vid] = v
+			heap.Push(&q, v)
+		}
+	}
+
+	if tight != nil {
+		for ui, u := range a.nodes {
+			for _, c := range g.ContactsFrom(u.ID()) {
+				t, ok := c.Depart(a.arrival[ui])
+				if !ok {
+					continue
+				}
+				vi, ok := a.indexOf[c.To.ID()]
+				if !ok || vi <= ui || t+c.Duration != a.arrival[vi] {
+					continue
+				}
+				tight(ui, vi, c)
+			}
+		}
+	}
+
+	return a
+}
+
+// arrival is a node reached at a time via a contact.
+type arrival struct {
+	node graph.Node
+	time float64
+	via  Contact
+}
+
+// arrivalQueue is a priority queue of arrivals keyed on time.
+type arrivalQueue []arrival
+
+func (q arrivalQueue) Len() int            { return len(q) }
+func (q arrivalQueue) Less(i, j int) bool  { return q[i].time < q[j].time }
+func (q arrivalQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *arrivalQueue) Push(x interface{}) { *q = append(*q, x.(arrival)) }
+func (q *arrivalQueue) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
@@ -0,0 +1,223 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temporal
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/iterator"
+)
+
+// Contact is an edge of a temporal graph. Traversal of a contact may depart
+// its From node at any time in [Start, End] and arrives at its To node after
+// Duration. A contact with Start equal to End is an instantaneous contact.
+type Contact struct {
+	From, To   graph.Node
+	Start, End float64
+	Duration   float64
+}
+
+// Depart returns the earliest time at which c can be departed by a traveller
+// arriving at its From node at time t, and whether c can be departed.
+func (c Contact) Depart(t float64) (float64, bool) {
+	if t > c.End {
+		return 0, false
+	}
+	return math.Max(t, c.Start), true
+}
+
+// Reversed returns c with its From and To nodes swapped.
+func (c Contact) Reversed() Contact {
+	c.From, c.To = c.To, c.From
+	return c
+}
+
+// Graph is a temporal graph.
+type Graph interface {
+	// Node returns the node with the given ID if it
+	// exists in the graph, and nil otherwise.
+	Node(id int64) graph.Node
+
+	// Nodes returns all the nodes in the graph.
+	Nodes() graph.Nodes
+
+	// ContactsFrom returns all the contacts that may
+	// be traversed from the node with the given ID.
+	// The From node of each returned contact is
+	// the node with the given ID.
+	ContactsFrom(id int64) []Contact
+}
+
+var (
+	_ Graph = (*DirectedGraph)(nil)
+	_ Graph = (*UndirectedGraph)(nil)
+)
+
+// contacts holds the nodes and contacts of a temporal graph.
+type contacts struct {
+	nodes map[int64]graph.Node
+	from  map[int64][]Contact
+	all   []Contact
+}
+
+func newContacts() contacts {
+	return contacts{
+		nodes: make(map[int64]graph.Node),
+		from:  make(map[int64][]Contact),
+	}
+}
+
+func (g *contacts) addNode(n graph.Node) {
+	if _, exists := g.nodes[n.ID()]; exists {
+		panic(fmt.Sprintf("temporal: node ID collision: %d", n.ID()))
+	}
+	g.nodes[n.ID()] = n
+}
+
+func (g *contacts) addContact(c Contact) {
+	fid, tid := c.From.ID(), c.To.ID()
+	if fid == tid {
+		panic("temporal: adding self contact")
+	}
+	if !(c.Start <= c.End) {
+		panic("temporal: invalid contact interval")
+	}
+	if !(c.Duration >= 0) {
+		panic("temporal: invalid contact duration")
+	}
+	if _, ok := g.nodes[fid]; !ok {
+		g.nodes[fid] = c.From
+	}
+	if _, ok := g.nodes[tid]; !ok {
+		g.nodes[tid] = c.To
+	}
+	g.from[fid] = append(g.from[fid], c)
+	g.all = append(g.all, c)
+}
+
+func (g *contacts) nodeIterator() graph.Nodes {
+	if len(g.nodes) == 0 {
+		return graph.Empty
+	}
+	return iterator.NewNodes(g.nodes)
+}
+
+// DirectedGraph is a temporal graph with directed contacts.
+type DirectedGraph struct {
+	contacts
+}
+
+// NewDirectedGraph returns an empty DirectedGraph.
+func NewDirectedGraph() *DirectedGraph {
+	return &DirectedGraph{contacts: newContacts()}
+}
+
+// AddNode adds n to the graph. It panics if the added node ID matches an
+// existing node ID.
+func (g *DirectedGraph) AddNode(n graph.Node) {
+	g.addNode(n)
+}
+
+// AddContact adds the contact c to the graph, adding its nodes if they do
+// not already exist. AddContact will panic if the nodes of c have the same
+// ID, if c.End is less than c.Start or if c.Duration is negative.
+func (g *DirectedGraph) AddContact(c Contact) {
+	g.addContact(c)
+}
+
+// Node returns the node with the given ID if it exists in the graph,
+// and nil otherwise.
+func (g *DirectedGraph) Node(id int64) graph.Node {
+	return g.nodes[id]
+}
+
+// Nodes returns all the nodes in the graph.
+func (g *DirectedGraph) Nodes() graph.Nodes {
+	return g.nodeIterator()
+}
+
+// Contacts returns all the contacts in the graph in the order they were
+// added. The returned slice must not be modified.
+func (g *DirectedGraph) Contacts() []Contact {
+	return g.all
+}
+
+// ContactsFrom returns all the contacts from the node with the given ID in
+// the order they were added. The returned slice must not be modified.
+func (g *DirectedGraph) ContactsFrom(id int64) []Contact {
+	return g.from[id]
+}
+
+// UndirectedGraph is a temporal graph with undirected contacts. Each contact
+// may be traversed in either direction.
+type UndirectedGraph struct {
+	contacts
+}
+
+// NewUndirectedGraph returns an empty UndirectedGraph.
+func NewUndirectedGraph() *UndirectedGraph {
+	return &UndirectedGraph{contacts: newContacts()}
+}
+
+// AddNode adds n to the graph. It panics if the added node ID matches an
+// existing node ID.
+func (g *UndirectedGraph) AddNode(n graph.Node) {
+	g.addNode(n)
+}
+
+// AddContact adds the contact c to the graph, adding its nodes if they do
+// not already exist. AddContact will panic if the nodes of c have the same
+// ID, if c.End is less than c.Start or if c.Duration is negative.
+func (g *UndirectedGraph) AddContact(c Contact) {
+	g.addContact(c)
+	r := c.Reversed()
+	g.from[r.From.ID()] = append(g.from[r.From.ID()], r)
+}
+
+// Node returns the node with the given ID if it exists in the graph,
+// and nil otherwise.
+func (g *UndirectedGraph) Node(id int64) graph.Node {
+	return g.nodes[id]
+}
+
+// Nodes returns all the nodes in the graph.
+func (g *UndirectedGraph) Nodes() graph.Nodes {
+	return g.nodeIterator()
+}
+
+// Contacts returns all the contacts in the graph in the order they were
+// added, each in the orientation in which it was added. The returned slice
+// must not be modified.
+func (g *UndirectedGraph) Contacts() []Contact {
+	return g.all
+}
+
+// ContactsFrom returns all the contacts involving the node with the given
+// ID, oriented to leave that node, in the order they were added. The
+// returned slice must not be modified.
+func (g *UndirectedGraph) ContactsFrom(id int64) []Contact {
+	return g.from[id]
+}
+
+// Aggregate adds the nodes of g to dst and an edge for each pair of nodes
+// joined by a contact that may be departed during [start, end]. Aggregate
+// will panic if dst has nodes that exist in g.
+func Aggregate(dst graph.Builder, g Graph, start, end float64) {
+	nodes := g.Nodes()
+	for nodes.Next() {
+		dst.AddNode(nodes.Node())
+	}
+	nodes.Reset()
+	for nodes.Next() {
+		for _, c := range g.ContactsFrom(nodes.Node().ID()) {
+			if c.End < start || end < c.Start {
+				continue
+			}
+			dst.SetEdge(dst.NewEdge(c.From, c.To))
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temporal_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/temporal"
+)
+
+func ExampleEarliestArrival() {
+	// A bus from A to B departs between 8 and 9 and takes
+	// half an hour; a train from B to C leaves at 9 and takes
+	// an hour, and a direct coach from A to C leaves at 7
+	// and takes four hours.
+	const (
+		A = simple.Node(iota)
+		B
+		C
+	)
+	g := temporal.NewDirectedGraph()
+	g.AddContact(temporal.Contact{From: A, To: B, Start: 8, End: 9, Duration: 0.5})
+	g.AddContact(temporal.Contact{From: B, To: C, Start: 9, End: 9, Duration: 1})
+	g.AddContact(temporal.Contact{From: A, To: C, Start: 7, End: 7, Duration: 4})
+
+	for _, start := range []float64{7, 8.75} {
+		a := temporal.EarliestArrival(g, A, start)
+		path, arrival := a.To(C.ID())
+		fmt.Printf("leaving at %v arrive at %v via %d contacts\n", start, arrival, len(path))
+	}
+
+	// Output:
+	//
+	// leaving at 7 arrive at 10 via 2 contacts
+	// leaving at 8.75 arrive at +Inf via 0 contacts
+}
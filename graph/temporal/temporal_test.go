@@ -0,0 +1,474 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temporal
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+func contact(u, v int64, start, end, duration float64) Contact {
+	return Contact{From: simple.Node(u), To: simple.Node(v), Start: start, End: end, Duration: duration}
+}
+
+var earliestArrivalTests = []struct {
+	name     string
+	directed bool
+	contacts []Contact
+	from     int64
+	start    float64
+
+	want map[int64]float64
+	path map[int64][]int64
+}{
+	{
+		// 0 -> 1 is only available after 1 -> 2,
+		// so 2 is not reachable from 0 even though
+		// it is in the aggregated static graph.
+		name:     "order",
+		directed: true,
+		contacts: []Contact{
+			contact(0, 1, 5, 5, 1),
+			contact(1, 2, 1, 3, 1),
+			contact(2, 3, 0, 10, 1),
+		},
+		from:  0,
+		start: 0,
+		want:  map[int64]float64{0: 0, 1: 6},
+		path:  map[int64][]int64{0: {0}, 1: {0, 1}},
+	},
+	{
+		name:     "wait",
+		directed: true,
+		contacts: []Contact{
+			contact(0, 1, 0, 10, 1),
+			contact(1, 2, 4, 6, 2),
+			contact(0, 2, 0, 0, 10),
+		},
+		from:  0,
+		start: 0,
+		want:  map[int64]float64{0: 0, 1: 1, 2: 6},
+		path:  map[int64][]int64{0: {0}, 1: {0, 1}, 2: {0, 1, 2}},
+	},
+	{
+		name:     "late start",
+		directed: true,
+		contacts: []Contact{
+			contact(0, 1, 0, 10, 1),
+			contact(1, 2, 4, 6, 2),
+			contact(0, 2, 0, 0, 10),
+		},
+		from:  0,
+		start: 6,
+		want:  map[int64]float64{0: 6, 1: 7},
+		path:  map[int64][]int64{0: {0}, 1: {0, 1}},
+	},
+	{
+		name: "undirected",
+		contacts: []Contact{
+			contact(1, 0, 0, 1, 0),
+			contact(2, 1, 2, 2, 0),
+			contact(3, 1, 0, 1, 0),
+		},
+		from:  0,
+		start: 0,
+		want:  map[int64]float64{0: 0, 1: 0, 2: 2, 3: 0},
+		path:  map[int64][]int64{0: {0}, 1: {0, 1}, 2: {0, 1, 2}, 3: {0, 1, 3}},
+	},
+}
+
+func newGraph(directed bool, contacts []Contact) Graph {
+	if directed {
+		g := NewDirectedGraph()
+		for _, c := range contacts {
+			g.AddContact(c)
+		}
+		return g
+	}
+	g := NewUndirectedGraph()
+	for _, c := range contacts {
+		g.AddContact(c)
+	}
+	return g
+}
+
+func TestEarliestArrival(t *testing.T) {
+	t.Parallel()
+	for _, test := range earliestArrivalTests {
+		g := newGraph(test.directed, test.contacts)
+		a := EarliestArrival(g, simple.Node(test.from), test.start)
+		if a.From().ID() != test.from || a.Start() != test.start {
+			t.Errorf("unexpected source for %q: got:%d@%v want:%d@%v",
+				test.name, a.From().ID(), a.Start(), test.from, test.start)
+		}
+		nodes := g.Nodes()
+		for nodes.Next() {
+			vid := nodes.Node().ID()
+			want, ok := test.want[vid]
+			if !ok {
+				want = math.Inf(1)
+			}
+			if got := a.ArrivalAt(vid); got != want {
+				t.Errorf("unexpected arrival at %d for %q: got:%v want:%v", vid, test.name, got, want)
+			}
+			p, arr := a.To(vid)
+			if arr != want {
+				t.Errorf("unexpected path arrival at %d for %q: got:%v want:%v", vid, test.name, arr, want)
+			}
+			if got := pathIDs(test.from, p); !reflect.DeepEqual(got, test.path[vid]) {
+				t.Errorf("unexpected path to %d for %q: got:%v want:%v", vid, test.name, got, test.path[vid])
+			}
+		}
+	}
+}
+
+func pathIDs(from int64, p []Contact) []int64 {
+	if p == nil {
+		return nil
+	}
+	ids := []int64{from}
+	for _, c := range p {
+		ids = append(ids, c.To.ID())
+	}
+	return ids
+}
+
+func TestEarliestArrivalRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 50; trial++ {
+		directed := trial%2 == 0
+		g := newGraph(directed, randomContacts(rnd, 15, 60, trial%3 == 0))
+		nodes := graph.NodesOf(g.Nodes())
+		for _, s := range nodes {
+			start := rnd.Float64() * 5
+			a := EarliestArrival(g, s, start)
+			want := bruteArrival(g, s, start)
+			for _, v := range nodes {
+				vid := v.ID()
+				if got := a.ArrivalAt(vid); got != want[vid] {
+					t.Fatalf("unexpected arrival at %d from %d in trial %d: got:%v want:%v",
+						vid, s.ID(), trial, got, want[vid])
+				}
+				p, arr := a.To(vid)
+				if math.IsInf(arr, 1) {
+					continue
+				}
+				if !isTimeRespecting(s.ID(), vid, start, arr, p) {
+					t.Fatalf("invalid path to %d from %d in trial %d: %v", vid, s.ID(), trial, p)
+				}
+			}
+
+			end := start + rnd.Float64()*10
+			var want2 []int64
+			for _, v := range nodes {
+				if want[v.ID()] <= end {
+					want2 = append(want2, v.ID())
+				}
+			}
+			got := ids(Reachable(g, s, start, end))
+			sort.Slice(want2, func(i, j int) bool { return want2[i] < want2[j] })
+			if !reflect.DeepEqual(got, want2) {
+				t.Fatalf("unexpected reachable set from %d in trial %d: got:%v want:%v", s.ID(), trial, got, want2)
+			}
+		}
+	}
+}
+
+func ids(nodes []graph.Node) []int64 {
+	ids := make([]int64, len(nodes))
+	for i, n := range nodes {
+		ids[i] = n.ID()
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func isTimeRespecting(from, to int64, start, arrival float64, p []Contact) bool {
+	t := start
+	at := from
+	for _, c := range p {
+		if c.From.ID() != at {
+			return false
+		}
+		d, ok := c.Depart(t)
+		if !ok {
+			return false
+		}
+		t = d + c.Duration
+		at = c.To.ID()
+	}
+	return at == to && t == arrival
+}
+
+// randomContacts returns m random contacts between n nodes. If zero is true
+// some contacts have zero duration.
+func randomContacts(rnd *rand.Rand, n, m int, zero bool) []Contact {
+	var c []Contact
+	for len(c) < m {
+		u, v := rnd.IntN(n), rnd.IntN(n)
+		if u == v {
+			continue
+		}
+		start := float64(rnd.IntN(20))
+		end := start + float64(rnd.IntN(4))
+		duration := float64(1 + rnd.IntN(3))
+		if zero && rnd.IntN(3) == 0 {
+			duration = 0
+		}
+		c = append(c, contact(int64(u), int64(v), start, end, duration))
+	}
+	return c
+}
+
+// bruteArrival returns the earliest arrival times from s departing at start
+// found by relaxing all contacts until no arrival time changes.
+func bruteArrival(g Graph, s graph.Node, start float64) map[int64]float64 {
+	arrival := make(map[int64]float64)
+	nodes := graph.NodesOf(g.Nodes())
+	for _, n := range nodes {
+		arrival[n.ID()] = math.Inf(1)
+	}
+	arrival[s.ID()] = start
+	for changed := true; changed; {
+		changed = false
+		for _, u := range nodes {
+			for _, c := range g.ContactsFrom(u.ID()) {
+				d, ok := c.Depart(arrival[u.ID()])
+				if !ok {
+					continue
+				}
+				if t := d + c.Duration; t < arrival[c.To.ID()] {
+					arrival[c.To.ID()] = t
+					changed = true
+				}
+			}
+		}
+	}
+	return arrival
+}
+
+var betweennessTests = []struct {
+	name     string
+	directed bool
+	contacts []Contact
+	start    float64
+
+	want map[int64]float64
+}{
+	{
+		// The static path 0-1-2 is not time-respecting
+		// from 0 to 2, but is from 2 to 0.
+		name: "undirected line",
+		contacts: []Contact{
+			contact(0, 1, 2, 2, 1),
+			contact(1, 2, 0, 0, 1),
+		},
+		want: map[int64]float64{1: 1},
+	},
+	{
+		// Two contacts from 1 to 3 with equal arrival
+		// and one from 2 to 3; 0 reaches 1 and 2 at the
+		// same time.
+		name:     "diamond",
+		directed: true,
+		contacts: []Contact{
+			contact(0, 1, 0, 0, 1),
+			contact(0, 2, 0, 0, 1),
+			contact(1, 3, 1, 1, 1),
+			contact(1, 3, 0, 2, 1),
+			contact(2, 3, 1, 5, 1),
+		},
+		want: map[int64]float64{1: 2.0 / 3, 2: 1.0 / 3},
+	},
+	{
+		name:     "waiting is not foremost",
+		directed: true,
+		contacts: []Contact{
+			contact(0, 1, 0, 0, 1),
+			contact(1, 2, 5, 5, 1),
+			contact(0, 2, 0, 0, 2),
+		},
+		want: map[int64]float64{},
+	},
+}
+
+func TestBetweenness(t *testing.T) {
+	t.Parallel()
+	for _, test := range betweennessTests {
+		g := newGraph(test.directed, test.contacts)
+		got := Betweenness(g, test.start)
+		if !equalCentrality(got, test.want, 1e-12) {
+			t.Errorf("unexpected betweenness for %q: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestBetweennessRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for trial := 0; trial < 30; trial++ {
+		g := newGraph(trial%2 == 0, randomContacts(rnd, 10, 40, false))
+		got := Betweenness(g, 0)
+		want := bruteBetweenness(g, 0)
+		if !equalCentrality(got, want, 1e-9) {
+			t.Errorf("unexpected betweenness in trial %d: got:%v want:%v", trial, got, want)
+		}
+	}
+}
+
+// bruteBetweenness returns the temporal betweenness of g found by enumerating
+// all prefix-foremost paths. All contacts in g must have positive duration.
+func bruteBetweenness(g Graph, start float64) map[int64]float64 {
+	cb := make(map[int64]float64)
+	nodes := graph.NodesOf(g.Nodes())
+	for _, s := range nodes {
+		foremost := bruteArrival(g, s, start)
+		sigma := make(map[int64]float64)
+		through := make(map[[2]int64]float64)
+		var walk func(u int64, t float64, inner []int64)
+		walk = func(u int64, t float64, inner []int64) {
+			for _, c := range g.ContactsFrom(u) {
+				d, ok := c.Depart(t)
+				if !ok {
+					continue
+				}
+				v := c.To.ID()
+				a := d + c.Duration
+				if a != foremost[v] {
+					continue
+				}
+				sigma[v]++
+				for _, w := range inner {
+					through[[2]int64{w, v}]++
+				}
+				walk(v, a, append(inner[:len(inner):len(inner)], v))
+			}
+		}
+		walk(s.ID(), start, nil)
+		for k, n := range through {
+			cb[k[0]] += n / sigma[k[1]]
+		}
+	}
+	return cb
+}
+
+func equalCentrality(a, b map[int64]float64, tol float64) bool {
+	for k, v := range a {
+		if !scalar.EqualWithinAbsOrRel(v, b[k], tol, tol) {
+			return false
+		}
+	}
+	for k, v := range b {
+		if !scalar.EqualWithinAbsOrRel(v, a[k], tol, tol) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAggregate(t *testing.T) {
+	t.Parallel()
+	g := NewDirectedGraph()
+	g.AddNode(simple.Node(4))
+	for _, c := range []Contact{
+		contact(0, 1, 0, 1, 1),
+		contact(1, 2, 3, 4, 1),
+		contact(2, 3, 5, 6, 1),
+		contact(1, 0, 2, 2, 1),
+	} {
+		g.AddContact(c)
+	}
+	dst := simple.NewDirectedGraph()
+	Aggregate(dst, g, 1, 4)
+	if dst.Nodes().Len() != 5 {
+		t.Errorf("unexpected number of nodes: got:%d want:5", dst.Nodes().Len())
+	}
+	var got [][2]int64
+	edges := dst.Edges()
+	for edges.Next() {
+		e := edges.Edge()
+		got = append(got, [2]int64{e.From().ID(), e.To().ID()})
+	}
+	sort.Slice(got, func(i, j int) bool {
+		return got[i][0] < got[j][0] || (got[i][0] == got[j][0] && got[i][1] < got[j][1])
+	})
+	want := [][2]int64{{0, 1}, {1, 0}, {1, 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected edges: got:%v want:%v", got, want)
+	}
+
+	// The aggregated graph connects nodes that
+	// are not connected by time-respecting paths.
+	u := NewUndirectedGraph()
+	u.AddContact(contact(0, 1, 2, 2, 0))
+	u.AddContact(contact(1, 2, 1, 1, 0))
+	static := simple.NewUndirectedGraph()
+	Aggregate(static, u, 0, 10)
+	if len(topo.ConnectedComponents(static)) != 1 {
+		t.Error("expected aggregated graph to be connected")
+	}
+	if got := ids(Reachable(u, simple.Node(0), 0, 10)); !reflect.DeepEqual(got, []int64{0, 1}) {
+		t.Errorf("unexpected reachable nodes: got:%v want:[0 1]", got)
+	}
+}
+
+func TestContactsPanic(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "self", fn: func() { NewDirectedGraph().AddContact(contact(1, 1, 0, 1, 1)) }},
+		{name: "interval", fn: func() { NewDirectedGraph().AddContact(contact(0, 1, 2, 1, 1)) }},
+		{name: "NaN", fn: func() { NewUndirectedGraph().AddContact(contact(0, 1, math.NaN(), 1, 1)) }},
+		{name: "duration", fn: func() { NewUndirectedGraph().AddContact(contact(0, 1, 0, 1, -1)) }},
+		{name: "collision", fn: func() {
+			g := NewDirectedGraph()
+			g.AddContact(contact(0, 1, 0, 1, 1))
+			g.AddNode(simple.Node(1))
+		}},
+		{name: "source", fn: func() { EarliestArrival(NewDirectedGraph(), simple.Node(0), 0) }},
+	} {
+		panicked := func() (panicked bool) {
+			defer func() { panicked = recover() != nil }()
+			test.fn()
+			return false
+		}()
+		if !panicked {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func TestUndirectedContacts(t *testing.T) {
+	t.Parallel()
+	g := NewUndirectedGraph()
+	g.AddContact(contact(0, 1, 0, 1, 1))
+	g.AddContact(contact(2, 1, 0, 1, 1))
+	if n := len(g.Contacts()); n != 2 {
+		t.Errorf("unexpected number of contacts: got:%d want:2", n)
+	}
+	for id, want := range map[int64][]int64{0: {1}, 1: {0, 2}, 2: {1}} {
+		var got []int64
+		for _, c := range g.ContactsFrom(id) {
+			if c.From.ID() != id {
+				t.Errorf("unexpected contact orientation from %d: %v", id, c)
+			}
+			got = append(got, c.To.ID())
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected contacts from %d: got:%v want:%v", id, got, want)
+		}
+	}
+}
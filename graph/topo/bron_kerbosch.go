@@ -40,10 +40,27 @@ func KCore(k int, g graph.Undirected) []graph.Node {
 	return core
 }
 
-// degeneracyOrdering is the common code for DegeneracyOrdering and KCore. It
-// returns l, the nodes of g in optimal ordering for coloring number and
-// s, a set of relative offsets into l for each k-core, where k is an index
-// into s.
+// CoreNumbers returns the core number of each node of the undirected graph g
+// keyed by node ID. The core number of a node is the largest k for which the
+// node is in the k-core of g.
+func CoreNumbers(g graph.Undirected) map[int64]int {
+	order, offsets := degeneracyOrdering(g)
+
+	cores := make(map[int64]int, len(order))
+	var offset int
+	for k, n := range offsets {
+		for _, v := range order[offset : offset+n] {
+			cores[v.ID()] = k
+		}
+		offset += n
+	}
+	return cores
+}
+
+// degeneracyOrdering is the common code for DegeneracyOrdering, KCore and
+// CoreNumbers. It returns l, the nodes of g in optimal ordering for coloring
+// number and s, a set of relative offsets into l for each k-core, where k is
+// an index into s.
 func degeneracyOrdering(g graph.Undirected) (l []graph.Node, s []int) {
 	nodes := graph.NodesOf(g.Nodes())
 
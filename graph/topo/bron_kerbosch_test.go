@@ -126,6 +126,32 @@ func TestKCore(t *testing.T) {
 	}
 }
 
+func TestCoreNumbers(t *testing.T) {
+	for i, test := range vOrderTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+
+		want := make(map[int64]int)
+		for k, c := range test.wantCore {
+			for _, id := range c {
+				want[id] = k
+			}
+		}
+		got := CoreNumbers(g)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected core numbers for test %d:\ngot: %v\nwant:%v", i, got, want)
+		}
+	}
+}
+
 var bronKerboschTests = []struct {
 	name string
 	g    []intset
@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"cmp"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Condensation builds the condensation of the directed graph g in dst using
+// Component nodes and CondensationEdge edges. Each node of the condensation
+// represents a strongly connected component of g and the condensation has an
+// edge from a to b when g has an edge from a node in a to a node in b. The
+// condensation is a directed acyclic graph. Components are given IDs in a
+// topological order of the condensation. The dst graph is not cleared.
+func Condensation(dst Builder, g graph.Directed) {
+	sccs := TarjanSCC(g)
+
+	// TarjanSCC returns the components in
+	// reverse topological order.
+	slices.Reverse(sccs)
+	components := make([]Component, len(sccs))
+	componentOf := make(map[int64]int)
+	for id, c := range sccs {
+		order.ByID(c)
+		components[id] = Component{id: int64(id), nodes: c}
+		for _, n := range c {
+			componentOf[n.ID()] = id
+		}
+		dst.AddNode(components[id])
+	}
+
+	for id, c := range components {
+		edges := make(map[int][]graph.Edge)
+		var to []int
+		for _, u := range c.nodes {
+			uid := u.ID()
+			for _, v := range graph.NodesOf(g.From(uid)) {
+				vid := v.ID()
+				cv := componentOf[vid]
+				if cv == id {
+					continue
+				}
+				if edges[cv] == nil {
+					to = append(to, cv)
+				}
+				edges[cv] = append(edges[cv], g.Edge(uid, vid))
+			}
+		}
+		slices.Sort(to)
+		for _, cv := range to {
+			e := edges[cv]
+			slices.SortFunc(e, func(a, b graph.Edge) int {
+				if c := cmp.Compare(a.From().ID(), b.From().ID()); c != 0 {
+					return c
+				}
+				return cmp.Compare(a.To().ID(), b.To().ID())
+			})
+			dst.SetEdge(CondensationEdge{from: c, to: components[cv], edges: e})
+		}
+	}
+}
+
+// Component is a node in a condensation graph.
+type Component struct {
+	id    int64
+	nodes []graph.Node
+}
+
+// ID returns the node ID.
+func (n Component) ID() int64 { return n.id }
+
+// Nodes returns the nodes in the strongly connected component sorted by ID.
+func (n Component) Nodes() []graph.Node { return n.nodes }
+
+// CondensationEdge is an edge in a condensation graph.
+type CondensationEdge struct {
+	from, to Component
+	edges    []graph.Edge
+}
+
+// From returns the from node of the edge.
+func (e CondensationEdge) From() graph.Node { return e.from }
+
+// To returns the to node of the edge.
+func (e CondensationEdge) To() graph.Node { return e.to }
+
+// ReversedEdge returns a new CondensationEdge with
+// the edge end points swapped. The edges of the
+// new edge are shared with the receiver.
+func (e CondensationEdge) ReversedEdge() graph.Edge { e.from, e.to = e.to, e.from; return e }
+
+// Edges returns the edges of the original graph from nodes in the from
+// component to nodes in the to component, sorted by the IDs of their from
+// and to nodes.
+func (e CondensationEdge) Edges() []graph.Edge { return e.edges }
@@ -0,0 +1,115 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestCondensation(t *testing.T) {
+	g := simple.NewDirectedGraph()
+	for _, e := range [][2]int64{
+		{0, 1}, {1, 2}, {2, 0}, // Component {0, 1, 2}.
+		{3, 4}, {4, 3}, // Component {3, 4}.
+		{2, 3}, {1, 3}, {4, 5}, {0, 5},
+	} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	dst := simple.NewDirectedGraph()
+	Condensation(dst, g)
+
+	wantNodes := [][]int64{{0, 1, 2}, {3, 4}, {5}}
+	if n := dst.Nodes().Len(); n != len(wantNodes) {
+		t.Fatalf("unexpected number of components: got:%d want:%d", n, len(wantNodes))
+	}
+	for id, want := range wantNodes {
+		c := dst.Node(int64(id)).(Component)
+		if got := ids(c.Nodes()); !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected nodes for component %d: got:%v want:%v", id, got, want)
+		}
+	}
+
+	wantEdges := map[[2]int64][][2]int64{
+		{0, 1}: {{1, 3}, {2, 3}},
+		{0, 2}: {{0, 5}},
+		{1, 2}: {{4, 5}},
+	}
+	edges := dst.Edges()
+	if edges.Len() != len(wantEdges) {
+		t.Errorf("unexpected number of edges: got:%d want:%d", edges.Len(), len(wantEdges))
+	}
+	for edges.Next() {
+		e := edges.Edge().(CondensationEdge)
+		k := [2]int64{e.From().ID(), e.To().ID()}
+		var got [][2]int64
+		for _, ge := range e.Edges() {
+			got = append(got, [2]int64{ge.From().ID(), ge.To().ID()})
+		}
+		if !reflect.DeepEqual(got, wantEdges[k]) {
+			t.Errorf("unexpected edges for %v: got:%v want:%v", k, got, wantEdges[k])
+		}
+	}
+}
+
+func TestCondensationRandom(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		const n = 30
+		g := simple.NewDirectedGraph()
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for k := 0; k < 40; k++ {
+			u, v := rnd.IntN(n), rnd.IntN(n)
+			if u != v {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		dst := simple.NewDirectedGraph()
+		Condensation(dst, g)
+
+		componentOf := make(map[int64]int64)
+		nodes := dst.Nodes()
+		for nodes.Next() {
+			c := nodes.Node().(Component)
+			for _, v := range c.Nodes() {
+				componentOf[v.ID()] = c.ID()
+			}
+		}
+		if len(componentOf) != n {
+			t.Errorf("unexpected number of nodes in components in trial %d: got:%d want:%d", trial, len(componentOf), n)
+		}
+		for _, u := range graph.NodesOf(g.Nodes()) {
+			for _, v := range graph.NodesOf(g.From(u.ID())) {
+				cu, cv := componentOf[u.ID()], componentOf[v.ID()]
+				if cu == cv {
+					if !PathExistsIn(g, v, u) {
+						t.Errorf("nodes %d and %d are not strongly connected in trial %d", u.ID(), v.ID(), trial)
+					}
+					continue
+				}
+				if cu > cv {
+					t.Errorf("components not in topological order in trial %d: %d->%d", trial, cu, cv)
+				}
+				if !dst.HasEdgeFromTo(cu, cv) {
+					t.Errorf("missing condensation edge %d->%d in trial %d", cu, cv, trial)
+				}
+			}
+		}
+	}
+}
+
+func ids(nodes []graph.Node) []int64 {
+	ids := make([]int64, len(nodes))
+	for i, n := range nodes {
+		ids[i] = n.ID()
+	}
+	return ids
+}
@@ -0,0 +1,538 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/set"
+)
+
+// DynamicConnectivity maintains the connected components of an undirected
+// graph subject to node and edge insertions and deletions, answering
+// connectivity queries without recomputing the components after each change.
+//
+// DynamicConnectivity implements the algorithm of Holm, de Lichtenberg and
+// Thorup, "Poly-logarithmic deterministic fully-dynamic algorithms for
+// connectivity, minimum spanning tree, 2-edge, and biconnectivity", J. ACM
+// 48(4):723-760, 2001, doi:10.1145/502090.502095, with spanning forests held
+// as Euler tour trees. Connectivity queries take O(log n) expected time and
+// edge insertions and deletions take O(log^2 n) amortized expected time, where
+// n is the number of nodes.
+type DynamicConnectivity struct {
+	// ids maps node IDs to vertex indices.
+	ids   map[int64]int
+	verts []dcVertex
+	free  []int
+
+	edges map[[2]int]*dcEdge
+
+	components int
+}
+
+// dcVertex is a vertex of a DynamicConnectivity.
+type dcVertex struct {
+	id int64
+
+	// adj holds the neighbors of the vertex.
+	adj set.Ints[int]
+
+	// tok holds the Euler tour token of the
+	// vertex in the spanning forest of each
+	// level, and nonTree holds the neighbors
+	// joined to the vertex by non-tree edges
+	// of each level.
+	tok     []*ettNode
+	nonTree []set.Ints[int]
+}
+
+// dcEdge is an edge of a DynamicConnectivity.
+type dcEdge struct {
+	level int
+	tree  bool
+
+	// arcs holds the pair of Euler tour
+	// tokens of a tree edge in the spanning
+	// forest of each level up to the level
+	// of the edge.
+	arcs [][2]*ettNode
+}
+
+// NewDynamicConnectivity returns a new DynamicConnectivity holding the nodes
+// and edges of g. If g is nil, the returned DynamicConnectivity is empty.
+func NewDynamicConnectivity(g graph.Undirected) *DynamicConnectivity {
+	d := &DynamicConnectivity{
+		ids:   make(map[int64]int),
+		edges: make(map[[2]int]*dcEdge),
+	}
+	if g == nil {
+		return d
+	}
+	nodes := g.Nodes()
+	for nodes.Next() {
+		uid := nodes.Node().ID()
+		d.AddNode(uid)
+		to := g.From(uid)
+		for to.Next() {
+			d.AddEdge(uid, to.Node().ID())
+		}
+	}
+	return d
+}
+
+// Len returns the number of nodes held by d.
+func (d *DynamicConnectivity) Len() int {
+	return len(d.ids)
+}
+
+// Components returns the number of connected components of the graph held
+// by d.
+func (d *DynamicConnectivity) Components() int {
+	return d.components
+}
+
+// AddNode adds the node with the given ID to d if it is not already present.
+func (d *DynamicConnectivity) AddNode(id int64) {
+	d.vertex(id)
+}
+
+// RemoveNode removes the node with the given ID and its incident edges from
+// d. If the node is not present, RemoveNode is a no-op.
+func (d *DynamicConnectivity) RemoveNode(id int64) {
+	u, ok := d.ids[id]
+	if !ok {
+		return
+	}
+	for v := range d.verts[u].adj {
+		d.removeEdge(u, v)
+	}
+	delete(d.ids, id)
+	d.verts[u] = dcVertex{}
+	d.free = append(d.free, u)
+	d.components--
+}
+
+// HasEdgeBetween returns whether an edge exists between the nodes with IDs
+// xid and yid in d.
+func (d *DynamicConnectivity) HasEdgeBetween(xid, yid int64) bool {
+	u, ok := d.ids[xid]
+	if !ok {
+		return false
+	}
+	v, ok := d.ids[yid]
+	if !ok {
+		return false
+	}
+	return d.verts[u].adj.Has(v)
+}
+
+// AddEdge adds an edge between the nodes with IDs uid and vid to d, adding
+// the nodes if they are not already present. If the edge is already present
+// or uid and vid are equal, only the nodes are added.
+func (d *DynamicConnectivity) AddEdge(uid, vid int64) {
+	u, v := d.vertex(uid), d.vertex(vid)
+	if u == v || d.verts[u].adj.Has(v) {
+		return
+	}
+	d.verts[u].adj.Add(v)
+	d.verts[v].adj.Add(u)
+
+	e := &dcEdge{}
+	d.edges[edgeKey(u, v)] = e
+	if d.connected(u, v, 0) {
+		d.addNonTree(u, v, 0)
+		return
+	}
+	e.tree = true
+	d.link(e, u, v, 0)
+	d.components--
+}
+
+// RemoveEdge removes the edge between the nodes with IDs uid and vid from d.
+// The nodes are not removed. If the edge is not present, RemoveEdge is a
+// no-op.
+func (d *DynamicConnectivity) RemoveEdge(uid, vid int64) {
+	u, ok := d.ids[uid]
+	if !ok {
+		return
+	}
+	v, ok := d.ids[vid]
+	if !ok || !d.verts[u].adj.Has(v) {
+		return
+	}
+	d.removeEdge(u, v)
+}
+
+// Connected returns whether the nodes with IDs uid and vid are in the same
+// connected component of the graph held by d. Connected returns false if
+// either node is not present.
+func (d *DynamicConnectivity) Connected(uid, vid int64) bool {
+	u, ok := d.ids[uid]
+	if !ok {
+		return false
+	}
+	v, ok := d.ids[vid]
+	if !ok {
+		return false
+	}
+	return d.connected(u, v, 0)
+}
+
+// ComponentSize returns the number of nodes in the connected component
+// holding the node with the given ID, or zero if the node is not present.
+func (d *DynamicConnectivity) ComponentSize(id int64) int {
+	u, ok := d.ids[id]
+	if !ok {
+		return 0
+	}
+	return ettRoot(d.tok(u, 0)).verts
+}
+
+// vertex returns the index of the vertex with the given ID,
+// adding it if it does not exist.
+func (d *DynamicConnectivity) vertex(id int64) int {
+	u, ok := d.ids[id]
+	if ok {
+		return u
+	}
+	if n := len(d.free); n != 0 {
+		u = d.free[n-1]
+		d.free = d.free[:n-1]
+	} else {
+		u = len(d.verts)
+		d.verts = append(d.verts, dcVertex{})
+	}
+	d.verts[u] = dcVertex{id: id, adj: make(set.Ints[int])}
+	d.ids[id] = u
+	d.components++
+	return u
+}
+
+// tok returns the Euler tour token of vertex u in the
+// spanning forest of level i, creating it if needed.
+func (d *DynamicConnectivity) tok(u, i int) *ettNode {
+	v := &d.verts[u]
+	for len(v.tok) <= i {
+		v.tok = append(v.tok, nil)
+	}
+	if v.tok[i] == nil {
+		v.tok[i] = newETTNode(u, [2]int{-1, -1})
+	}
+	return v.tok[i]
+}
+
+// connected returns whether u and v are connected
+// in the spanning forest of level i.
+func (d *DynamicConnectivity) connected(u, v, i int) bool {
+	return ettRoot(d.tok(u, i)) == ettRoot(d.tok(v, i))
+}
+
+// link adds the tree edge e between u and v to the spanning
+// forest of level i, which must be one more than the highest
+// level of the forests already holding e.
+func (d *DynamicConnectivity) link(e *dcEdge, u, v, i int) {
+	a := newETTNode(-1, edgeKey(u, v))
+	b := newETTNode(-1, edgeKey(u, v))
+	ettMerge(ettMerge(ettReroot(d.tok(u, i)), a), ettMerge(ettReroot(d.tok(v, i)), b))
+	e.arcs = append(e.arcs, [2]*ettNode{a, b})
+	if i == e.level {
+		a.setFlag(treeFlag, true)
+	}
+}
+
+// addNonTree adds the non-tree edge between u and v at level i.
+func (d *DynamicConnectivity) addNonTree(u, v, i int) {
+	for _, p := range [2][2]int{{u, v}, {v, u}} {
+		x := &d.verts[p[0]]
+		for len(x.nonTree) <= i {
+			x.nonTree = append(x.nonTree, nil)
+		}
+		if x.nonTree[i] == nil {
+			x.nonTree[i] = make(set.Ints[int])
+		}
+		x.nonTree[i].Add(p[1])
+		d.tok(p[0], i).setFlag(nonTreeFlag, true)
+	}
+}
+
+// removeNonTree removes the non-tree edge between u and v at level i.
+func (d *DynamicConnectivity) removeNonTree(u, v, i int) {
+	for _, p := range [2][2]int{{u, v}, {v, u}} {
+		x := &d.verts[p[0]]
+		x.nonTree[i].Remove(p[1])
+		if len(x.nonTree[i]) == 0 {
+			d.tok(p[0], i).setFlag(nonTreeFlag, false)
+		}
+	}
+}
+
+// removeEdge removes the edge between u and v, which must exist.
+func (d *DynamicConnectivity) removeEdge(u, v int) {
+	d.verts[u].adj.Remove(v)
+	d.verts[v].adj.Remove(u)
+	k := edgeKey(u, v)
+	e := d.edges[k]
+	delete(d.edges, k)
+	if !e.tree {
+		d.removeNonTree(u, v, e.level)
+		return
+	}
+
+	for _, arcs := range e.arcs {
+		ettCut(arcs[0], arcs[1])
+	}
+	for i := e.level; i >= 0; i-- {
+		if d.replace(u, v, i) {
+			return
+		}
+	}
+	d.components++
+}
+
+// replace searches for a replacement for a deleted tree edge between u and v
+// at level i, linking it into the spanning forests of levels i and lower if
+// it is found. Tree edges and non-tree edges of level i in the smaller of the
+// two trees holding u and v that do not reconnect the trees are raised to
+// level i+1.
+func (d *DynamicConnectivity) replace(u, v, i int) bool {
+	small := ettRoot(d.tok(u, i))
+	if other := ettRoot(d.tok(v, i)); other.verts < small.verts {
+		small = other
+	}
+
+	// Raise the tree edges of level i. Raising an
+	// edge only alters the flags of the level i
+	// forest, so small remains its tree's root.
+	for {
+		a := small.find(treeFlag)
+		if a == nil {
+			break
+		}
+		e := d.edges[a.edge]
+		a.setFlag(treeFlag, false)
+		e.level++
+		d.link(e, a.edge[0], a.edge[1], e.level)
+	}
+
+	var neighbors []int
+	for {
+		t := small.find(nonTreeFlag)
+		if t == nil {
+			return false
+		}
+		x := t.vert
+		neighbors = neighbors[:0]
+		for y := range d.verts[x].nonTree[i] {
+			neighbors = append(neighbors, y)
+		}
+		for _, y := range neighbors {
+			d.removeNonTree(x, y, i)
+			e := d.edges[edgeKey(x, y)]
+			if ettRoot(d.tok(y, i)) == small {
+				e.level++
+				d.addNonTree(x, y, e.level)
+				continue
+			}
+			e.tree = true
+			for j := 0; j <= i; j++ {
+				d.link(e, x, y, j)
+			}
+			return true
+		}
+	}
+}
+
+func edgeKey(u, v int) [2]int {
+	if v < u {
+		u, v = v, u
+	}
+	return [2]int{u, v}
+}
+
+// Flags marking Euler tour tokens for replacement edge searches.
+const (
+	// treeFlag marks one of the tokens of each
+	// tree edge of the forest's level.
+	treeFlag = 1 << iota
+
+	// nonTreeFlag marks the token of each vertex
+	// with non-tree edges of the forest's level.
+	nonTreeFlag
+)
+
+// ettNode is a token in an Euler tour tree. Euler tours are held as sequences
+// of tokens in treaps keyed on tour position. Each vertex of a tree has a
+// single token and each edge has a pair of tokens, one for each direction in
+// which the tour traverses the edge.
+type ettNode struct {
+	left, right, parent *ettNode
+	prio                uint64
+
+	// vert is the vertex index of a vertex
+	// token and edge is the edge key of an
+	// edge token.
+	vert int
+	edge [2]int
+
+	// flags holds the flags of the token
+	// and anyFlags holds the union of the
+	// flags in the subtree rooted at the
+	// token.
+	flags, anyFlags int
+
+	// size and verts hold the number of
+	// tokens and vertex tokens in the
+	// subtree rooted at the token.
+	size, verts int
+}
+
+func newETTNode(vert int, edge [2]int) *ettNode {
+	n := &ettNode{prio: rand.Uint64(), vert: vert, edge: edge}
+	n.update()
+	return n
+}
+
+// update recomputes the subtree values of n from its children.
+func (n *ettNode) update() {
+	n.size = 1
+	n.verts = 0
+	if n.vert >= 0 {
+		n.verts = 1
+	}
+	n.anyFlags = n.flags
+	for _, c := range [2]*ettNode{n.left, n.right} {
+		if c != nil {
+			n.size += c.size
+			n.verts += c.verts
+			n.anyFlags |= c.anyFlags
+		}
+	}
+}
+
+// setFlag sets or clears the flag f on n.
+func (n *ettNode) setFlag(f int, on bool) {
+	if on {
+		n.flags |= f
+	} else {
+		n.flags &^= f
+	}
+	for ; n != nil; n = n.parent {
+		n.update()
+	}
+}
+
+// find returns a token with the flag f in the subtree rooted at n,
+// or nil if there is no such token.
+func (n *ettNode) find(f int) *ettNode {
+	if n.anyFlags&f == 0 {
+		return nil
+	}
+	for n.flags&f == 0 {
+		if n.left != nil && n.left.anyFlags&f != 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return n
+}
+
+func ettSize(n *ettNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// ettRoot returns the root of the treap holding n.
+func ettRoot(n *ettNode) *ettNode {
+	for n.parent != nil {
+		n = n.parent
+	}
+	return n
+}
+
+// ettIndex returns the position of n in its Euler tour.
+func ettIndex(n *ettNode) int {
+	i := ettSize(n.left)
+	for ; n.parent != nil; n = n.parent {
+		if n == n.parent.right {
+			i += ettSize(n.parent.left) + 1
+		}
+	}
+	return i
+}
+
+// ettSplit splits the tour rooted at t into the first k tokens
+// and the remaining tokens.
+func ettSplit(t *ettNode, k int) (l, r *ettNode) {
+	if t == nil {
+		return nil, nil
+	}
+	if k <= ettSize(t.left) {
+		l, t.left = ettSplit(t.left, k)
+		if t.left != nil {
+			t.left.parent = t
+		}
+		r = t
+	} else {
+		t.right, r = ettSplit(t.right, k-ettSize(t.left)-1)
+		if t.right != nil {
+			t.right.parent = t
+		}
+		l = t
+	}
+	t.update()
+	if l != nil {
+		l.parent = nil
+	}
+	if r != nil {
+		r.parent = nil
+	}
+	return l, r
+}
+
+// ettMerge returns the root of the concatenation of the tours
+// rooted at a and b.
+func ettMerge(a, b *ettNode) *ettNode {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	}
+	if a.prio > b.prio {
+		a.right = ettMerge(a.right, b)
+		a.right.parent = a
+		a.update()
+		return a
+	}
+	b.left = ettMerge(a, b.left)
+	b.left.parent = b
+	b.update()
+	return b
+}
+
+// ettReroot rotates the tour holding the vertex token n to
+// start at n and returns the root of the rotated tour.
+func ettReroot(n *ettNode) *ettNode {
+	l, r := ettSplit(ettRoot(n), ettIndex(n))
+	return ettMerge(r, l)
+}
+
+// ettCut removes the edge with the tokens a and b from its tour,
+// splitting the tour into the tours of the two resulting trees.
+func ettCut(a, b *ettNode) {
+	i, j := ettIndex(a), ettIndex(b)
+	if j < i {
+		i, j = j, i
+	}
+	l, r := ettSplit(ettRoot(a), i)
+	_, r = ettSplit(r, 1)
+	_, r = ettSplit(r, j-i-1)
+	_, r = ettSplit(r, 1)
+	ettMerge(l, r)
+}
@@ -0,0 +1,129 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestDynamicConnectivity(t *testing.T) {
+	for _, test := range []struct {
+		n, ops int
+		// add is the probability that
+		// an operation adds an edge.
+		add float64
+	}{
+		{n: 2, ops: 100, add: 0.5},
+		{n: 10, ops: 1000, add: 0.5},
+		{n: 50, ops: 3000, add: 0.6},
+		{n: 200, ops: 2000, add: 0.55},
+	} {
+		rnd := rand.New(rand.NewPCG(uint64(test.n), 1))
+		d := NewDynamicConnectivity(nil)
+		g := simple.NewUndirectedGraph()
+		for i := 0; i < test.n; i++ {
+			d.AddNode(int64(i))
+			g.AddNode(simple.Node(i))
+		}
+		for op := 0; op < test.ops; op++ {
+			u, v := int64(rnd.IntN(test.n)), int64(rnd.IntN(test.n))
+			switch {
+			case rnd.Float64() < 0.01:
+				d.RemoveNode(u)
+				g.RemoveNode(u)
+				d.AddNode(u)
+				g.AddNode(simple.Node(u))
+			case rnd.Float64() < test.add:
+				d.AddEdge(u, v)
+				if u != v {
+					g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+				}
+			default:
+				d.RemoveEdge(u, v)
+				g.RemoveEdge(u, v)
+			}
+			if d.HasEdgeBetween(u, v) != g.HasEdgeBetween(u, v) {
+				t.Fatalf("unexpected edge existence for n=%d op=%d", test.n, op)
+			}
+
+			cc := ConnectedComponents(g)
+			if d.Components() != len(cc) {
+				t.Fatalf("unexpected number of components for n=%d op=%d: got:%d want:%d",
+					test.n, op, d.Components(), len(cc))
+			}
+			comp := make(map[int64]int)
+			for i, c := range cc {
+				for _, n := range c {
+					comp[n.ID()] = i
+				}
+			}
+			for i := 0; i < 5; i++ {
+				x, y := int64(rnd.IntN(test.n)), int64(rnd.IntN(test.n))
+				if got, want := d.Connected(x, y), comp[x] == comp[y]; got != want {
+					t.Fatalf("unexpected connectivity of %d and %d for n=%d op=%d: got:%t want:%t",
+						x, y, test.n, op, got, want)
+				}
+				if got, want := d.ComponentSize(x), len(cc[comp[x]]); got != want {
+					t.Fatalf("unexpected component size of %d for n=%d op=%d: got:%d want:%d",
+						x, test.n, op, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestNewDynamicConnectivity(t *testing.T) {
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {3, 4}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	g.AddNode(simple.Node(5))
+	d := NewDynamicConnectivity(g)
+	if d.Len() != 6 {
+		t.Errorf("unexpected number of nodes: got:%d want:6", d.Len())
+	}
+	if d.Components() != 3 {
+		t.Errorf("unexpected number of components: got:%d want:3", d.Components())
+	}
+	if !d.Connected(0, 2) || d.Connected(2, 3) || d.Connected(5, 6) {
+		t.Error("unexpected connectivity")
+	}
+
+	// Removing one edge of the triangle leaves it connected.
+	d.RemoveEdge(0, 1)
+	if !d.Connected(0, 1) {
+		t.Error("unexpected disconnection after removal of cycle edge")
+	}
+	d.RemoveEdge(1, 2)
+	if d.Connected(0, 1) || d.Components() != 4 {
+		t.Error("unexpected connection after removal of bridge")
+	}
+	d.RemoveNode(3)
+	if d.Len() != 5 || d.Components() != 4 || d.ComponentSize(4) != 1 || d.ComponentSize(3) != 0 {
+		t.Error("unexpected state after node removal")
+	}
+}
+
+func BenchmarkDynamicConnectivity(b *testing.B) {
+	const n = 10000
+	rnd := rand.New(rand.NewPCG(1, 1))
+	d := NewDynamicConnectivity(nil)
+	for i := 0; i < 2*n; i++ {
+		d.AddEdge(int64(rnd.IntN(n)), int64(rnd.IntN(n)))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u, v := int64(rnd.IntN(n)), int64(rnd.IntN(n))
+		if d.HasEdgeBetween(u, v) {
+			d.RemoveEdge(u, v)
+		} else {
+			d.AddEdge(u, v)
+		}
+		d.Connected(int64(rnd.IntN(n)), int64(rnd.IntN(n)))
+	}
+}
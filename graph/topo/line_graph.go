@@ -0,0 +1,144 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// LineGraph builds the line graph of g in dst using LineGraphNode and
+// LineGraphEdge nodes and edges. Each node of the line graph represents an
+// edge of g, or a line of g if g is a graph.Multigraph. If g is directed, the
+// line graph has an edge from a to b when the head of a is the tail of b.
+// Otherwise the line graph has an edge between a and b when they share an end
+// point. Line graph nodes are given IDs in the order of the IDs of their end
+// points, and the line IDs if g is a multigraph. Self edges are not added to
+// the line graph. The dst graph is not cleared.
+func LineGraph(dst Builder, g graph.Graph) {
+	_, directed := g.(graph.Directed)
+	mg, isMulti := g.(graph.Multigraph)
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+
+	// in and out hold the line graph nodes
+	// for the edges into and out of each node
+	// of g. For undirected graphs only out is
+	// used and holds all incident edges.
+	var (
+		lg      []LineGraphNode
+		in, out = make(map[int64][]LineGraphNode), make(map[int64][]LineGraphNode)
+	)
+	add := func(uid, vid int64, e graph.Edge, l graph.Line) {
+		n := LineGraphNode{id: int64(len(lg)), edge: e, line: l}
+		lg = append(lg, n)
+		dst.AddNode(n)
+		out[uid] = append(out[uid], n)
+		switch {
+		case directed:
+			in[vid] = append(in[vid], n)
+		case uid != vid:
+			out[vid] = append(out[vid], n)
+		}
+	}
+	for _, u := range nodes {
+		uid := u.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		for _, v := range to {
+			vid := v.ID()
+			if !directed && vid < uid {
+				continue
+			}
+			if !isMulti {
+				add(uid, vid, g.Edge(uid, vid), nil)
+				continue
+			}
+			lines := graph.LinesOf(mg.Lines(uid, vid))
+			order.LinesByIDs(lines)
+			for _, l := range lines {
+				if !directed && l.From().ID() != uid {
+					l = l.ReversedLine()
+				}
+				add(uid, vid, nil, l)
+			}
+		}
+	}
+
+	if directed {
+		for _, v := range nodes {
+			vid := v.ID()
+			for _, a := range in[vid] {
+				for _, b := range out[vid] {
+					if a.id == b.id {
+						continue
+					}
+					dst.SetEdge(LineGraphEdge{from: a, to: b, nodes: []graph.Node{v}})
+				}
+			}
+		}
+		return
+	}
+
+	// Edges sharing both end points are joined
+	// once with both end points as common nodes.
+	common := make(map[[2]int64][]graph.Node)
+	var pairs [][2]int64
+	for _, v := range nodes {
+		incident := out[v.ID()]
+		for i, a := range incident {
+			for _, b := range incident[i+1:] {
+				k := [2]int64{a.id, b.id}
+				if common[k] == nil {
+					pairs = append(pairs, k)
+				}
+				common[k] = append(common[k], v)
+			}
+		}
+	}
+	for _, k := range pairs {
+		dst.SetEdge(LineGraphEdge{from: lg[k[0]], to: lg[k[1]], nodes: common[k]})
+	}
+}
+
+// LineGraphNode is a node in a line graph.
+type LineGraphNode struct {
+	id   int64
+	edge graph.Edge
+	line graph.Line
+}
+
+// ID returns the node ID.
+func (n LineGraphNode) ID() int64 { return n.id }
+
+// Edge returns the edge of the original graph represented by the node,
+// or nil if the original graph is a multigraph.
+func (n LineGraphNode) Edge() graph.Edge { return n.edge }
+
+// Line returns the line of the original multigraph represented by the
+// node, or nil if the original graph is not a multigraph.
+func (n LineGraphNode) Line() graph.Line { return n.line }
+
+// LineGraphEdge is an edge in a line graph.
+type LineGraphEdge struct {
+	from, to LineGraphNode
+	nodes    []graph.Node
+}
+
+// From returns the from node of the edge.
+func (e LineGraphEdge) From() graph.Node { return e.from }
+
+// To returns the to node of the edge.
+func (e LineGraphEdge) To() graph.Node { return e.to }
+
+// ReversedEdge returns a new LineGraphEdge with
+// the edge end points swapped. The nodes of the
+// new edge are shared with the receiver.
+func (e LineGraphEdge) ReversedEdge() graph.Edge { e.from, e.to = e.to, e.from; return e }
+
+// Nodes returns the nodes of the original graph shared by the edges
+// corresponding to the from and to nodes in the line graph.
+func (e LineGraphEdge) Nodes() []graph.Node { return e.nodes }
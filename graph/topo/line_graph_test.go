@@ -0,0 +1,169 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// lineGraphEdge is a line graph edge between line graph
+// nodes with the nodes in common in the original graph.
+type lineGraphEdge struct {
+	from, to int64
+	nodes    []int64
+}
+
+var lineGraphTests = []struct {
+	name  string
+	build func() graph.Graph
+
+	// wantNodes holds the end point IDs of
+	// the edge represented by each node.
+	wantNodes [][2]int64
+	wantEdges []lineGraphEdge
+}{
+	{
+		name: "path",
+		build: func() graph.Graph {
+			g := simple.NewUndirectedGraph()
+			for _, e := range [][2]int64{{1, 0}, {1, 2}, {3, 2}} {
+				g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+			}
+			return g
+		},
+		wantNodes: [][2]int64{{0, 1}, {1, 2}, {2, 3}},
+		wantEdges: []lineGraphEdge{
+			{from: 0, to: 1, nodes: []int64{1}},
+			{from: 1, to: 2, nodes: []int64{2}},
+		},
+	},
+	{
+		name: "star",
+		build: func() graph.Graph {
+			g := simple.NewUndirectedGraph()
+			for _, v := range []int64{1, 2, 3} {
+				g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(v)})
+			}
+			return g
+		},
+		wantNodes: [][2]int64{{0, 1}, {0, 2}, {0, 3}},
+		wantEdges: []lineGraphEdge{
+			{from: 0, to: 1, nodes: []int64{0}},
+			{from: 0, to: 2, nodes: []int64{0}},
+			{from: 1, to: 2, nodes: []int64{0}},
+		},
+	},
+	{
+		name: "directed",
+		build: func() graph.Graph {
+			g := simple.NewDirectedGraph()
+			for _, e := range [][2]int64{{0, 1}, {1, 2}, {1, 0}} {
+				g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+			}
+			return g
+		},
+		wantNodes: [][2]int64{{0, 1}, {1, 0}, {1, 2}},
+		wantEdges: []lineGraphEdge{
+			{from: 0, to: 1, nodes: []int64{1}},
+			{from: 0, to: 2, nodes: []int64{1}},
+			{from: 1, to: 0, nodes: []int64{0}},
+		},
+	},
+	{
+		name: "multigraph",
+		build: func() graph.Graph {
+			g := multi.NewUndirectedGraph()
+			for _, e := range [][2]int64{{1, 0}, {0, 1}, {1, 2}, {2, 2}} {
+				g.SetLine(g.NewLine(multi.Node(e[0]), multi.Node(e[1])))
+			}
+			return g
+		},
+		wantNodes: [][2]int64{{0, 1}, {0, 1}, {1, 2}, {2, 2}},
+		wantEdges: []lineGraphEdge{
+			{from: 0, to: 1, nodes: []int64{0, 1}},
+			{from: 0, to: 2, nodes: []int64{1}},
+			{from: 1, to: 2, nodes: []int64{1}},
+			{from: 2, to: 3, nodes: []int64{2}},
+		},
+	},
+	{
+		name: "directed multigraph",
+		build: func() graph.Graph {
+			g := multi.NewDirectedGraph()
+			for _, e := range [][2]int64{{0, 1}, {0, 1}, {1, 1}} {
+				g.SetLine(g.NewLine(multi.Node(e[0]), multi.Node(e[1])))
+			}
+			return g
+		},
+		wantNodes: [][2]int64{{0, 1}, {0, 1}, {1, 1}},
+		wantEdges: []lineGraphEdge{
+			{from: 0, to: 2, nodes: []int64{1}},
+			{from: 1, to: 2, nodes: []int64{1}},
+		},
+	},
+}
+
+func TestLineGraph(t *testing.T) {
+	for _, test := range lineGraphTests {
+		g := test.build()
+		dst := simple.NewDirectedGraph()
+		LineGraph(dst, g)
+
+		nodes := graph.NodesOf(dst.Nodes())
+		if len(nodes) != len(test.wantNodes) {
+			t.Errorf("unexpected number of nodes for %s: got:%d want:%d", test.name, len(nodes), len(test.wantNodes))
+			continue
+		}
+		_, isMulti := g.(graph.Multigraph)
+		for _, n := range nodes {
+			ln := n.(LineGraphNode)
+			var e interface {
+				From() graph.Node
+				To() graph.Node
+			}
+			if isMulti {
+				if ln.Edge() != nil {
+					t.Errorf("unexpected edge for multigraph line graph node in %s", test.name)
+				}
+				e = ln.Line()
+			} else {
+				if ln.Line() != nil {
+					t.Errorf("unexpected line for line graph node in %s", test.name)
+				}
+				e = ln.Edge()
+			}
+			got := [2]int64{e.From().ID(), e.To().ID()}
+			if want := test.wantNodes[ln.ID()]; got != want {
+				t.Errorf("unexpected edge for node %d in %s: got:%v want:%v", ln.ID(), test.name, got, want)
+			}
+		}
+
+		var got []lineGraphEdge
+		edges := dst.Edges()
+		for edges.Next() {
+			e := edges.Edge().(LineGraphEdge)
+			var common []int64
+			for _, n := range e.Nodes() {
+				common = append(common, n.ID())
+			}
+			got = append(got, lineGraphEdge{from: e.From().ID(), to: e.To().ID(), nodes: common})
+		}
+		slices.SortFunc(got, func(a, b lineGraphEdge) int {
+			if a.from != b.from {
+				return int(a.from - b.from)
+			}
+			return int(a.to - b.to)
+		})
+		if !reflect.DeepEqual(got, test.wantEdges) {
+			t.Errorf("unexpected edges for %s:\ngot: %v\nwant:%v", test.name, got, test.wantEdges)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	// heldKarpIterations is the maximum number of
+	// subgradient steps taken by HeldKarpBound.
+	heldKarpIterations = 1000
+
+	// heldKarpPatience is the number of steps without
+	// improvement of the bound after which the step
+	// size scale is halved.
+	heldKarpPatience = 20
+
+	// heldKarpMinScale is the step size scale below
+	// which HeldKarpBound terminates.
+	heldKarpMinScale = 1e-6
+)
+
+// HeldKarpBound returns the Held-Karp lower bound on the length of an optimal
+// tour over the cities of d. The bound is the maximum over node penalties of
+// the penalized minimum 1-tree length, found by subgradient optimization. The
+// step sizes are scaled by the gap to the length of a tour found by the Greedy
+// and TwoOpt heuristics. The returned bound is less than or equal to the
+// optimal tour length, and for many instances is within one or two percent of
+// it.
+//
+// Each subgradient step of HeldKarpBound takes O(n^2) time where n is the
+// number of cities.
+//
+// The bound is described in Held and Karp, "The traveling-salesman problem and
+// minimum spanning trees: Part II", Math. Program. 1:6-25, 1971,
+// doi:10.1007/BF01584070.
+func HeldKarpBound(d mat.Symmetric) float64 {
+	n := d.SymmetricDim()
+	if n <= 3 {
+		return length(d, identity(n))
+	}
+
+	upper := TwoOpt(d, Greedy(d))
+	pi := make([]float64, n)
+	deg := make([]int, n)
+	best := math.Inf(-1)
+	scale := 2.0
+	var stale int
+	for range heldKarpIterations {
+		bound := oneTree(d, pi, deg)
+		if bound > best {
+			best = bound
+			stale = 0
+		} else {
+			stale++
+			if stale == heldKarpPatience {
+				scale /= 2
+				stale = 0
+			}
+		}
+
+		var norm float64
+		for _, k := range deg {
+			norm += float64((k - 2) * (k - 2))
+		}
+		if norm == 0 {
+			// The 1-tree is a tour so the
+			// bound is the optimal length.
+			return bound
+		}
+		if scale < heldKarpMinScale || upper-best <= improvementTol*upper {
+			break
+		}
+		step := scale * (upper - bound) / norm
+		for i, k := range deg {
+			pi[i] += step * float64(k-2)
+		}
+	}
+	return math.Min(best, upper)
+}
+
+// oneTree returns the length of the minimum 1-tree of the cities of d with
+// distances penalized by pi, less twice the sum of pi. The degree of each city
+// in the 1-tree is stored in deg. The 1-tree is a minimum spanning tree of the
+// cities other than city 0 with the two shortest edges from city 0 added.
+func oneTree(d mat.Symmetric, pi []float64, deg []int) float64 {
+	n := len(pi)
+	cost := func(i, j int) float64 { return d.At(i, j) + pi[i] + pi[j] }
+
+	for i := range deg {
+		deg[i] = 0
+	}
+	var l float64
+	parent := minimumSpanningTree(n-1, func(i, j int) float64 { return cost(i+1, j+1) })
+	for v, u := range parent {
+		if u < 0 {
+			continue
+		}
+		l += cost(u+1, v+1)
+		deg[u+1]++
+		deg[v+1]++
+	}
+
+	first, second := -1, -1
+	for v := 1; v < n; v++ {
+		switch c := cost(0, v); {
+		case first < 0 || c < cost(0, first):
+			first, second = v, first
+		case second < 0 || c < cost(0, second):
+			second = v
+		}
+	}
+	l += cost(0, first) + cost(0, second)
+	deg[0] = 2
+	deg[first]++
+	deg[second]++
+
+	for _, p := range pi {
+		l -= 2 * p
+	}
+	return l
+}
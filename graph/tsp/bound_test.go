@@ -0,0 +1,46 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestHeldKarpBound(t *testing.T) {
+	t.Parallel()
+	for n := 1; n <= 10; n++ {
+		for seed := uint64(1); seed <= 5; seed++ {
+			d := euclidean(n, seed)
+			bound := HeldKarpBound(d)
+			opt := optimal(d)
+			if bound > opt*(1+1e-9) {
+				t.Errorf("bound exceeds optimal length for n=%d seed=%d: got:%v optimal:%v", n, seed, bound, opt)
+			}
+			if bound < 0.9*opt {
+				t.Errorf("unexpectedly weak bound for n=%d seed=%d: got:%v optimal:%v", n, seed, bound, opt)
+			}
+		}
+	}
+}
+
+func TestHeldKarpBoundTight(t *testing.T) {
+	t.Parallel()
+	// For cities on a circle the 1-tree bound
+	// reaches the optimal tour length.
+	const n = 30
+	d := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			d.SetSym(i, j, 2*math.Sin(math.Pi*float64(j-i)/n))
+		}
+	}
+	want := 2 * n * math.Sin(math.Pi/n)
+	if got := HeldKarpBound(d); math.Abs(got-want) > 1e-6*want {
+		t.Errorf("unexpected bound for cities on a circle: got:%v want:%v", got, want)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/graph/matching"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+// NearestNeighbor returns a tour over the cities of d constructed by starting
+// at the city start and repeatedly moving to the closest unvisited city.
+// NearestNeighbor will panic if start is not a valid index of d.
+//
+// The time complexity of NearestNeighbor is O(n^2) where n is the number of
+// cities.
+func NearestNeighbor(d mat.Symmetric, start int) []int {
+	n := d.SymmetricDim()
+	if start < 0 || n <= start {
+		panic("tsp: invalid start city")
+	}
+	tour := make([]int, 0, n)
+	visited := make([]bool, n)
+	u := start
+	for {
+		tour = append(tour, u)
+		visited[u] = true
+		next := -1
+		best := math.Inf(1)
+		for v := 0; v < n; v++ {
+			if visited[v] {
+				continue
+			}
+			if dist := d.At(u, v); next < 0 || dist < best {
+				next, best = v, dist
+			}
+		}
+		if next < 0 {
+			return tour
+		}
+		u = next
+	}
+}
+
+// Greedy returns a tour over the cities of d constructed by the greedy edge
+// heuristic. Edges are considered in order of increasing length and are added
+// to the tour when they do not give a city more than two tour edges and do not
+// close a cycle shorter than the full tour.
+//
+// The time complexity of Greedy is O(n^2 log n) where n is the number of
+// cities.
+func Greedy(d mat.Symmetric) []int {
+	n := d.SymmetricDim()
+	if n <= 3 {
+		return identity(n)
+	}
+
+	type edge struct {
+		u, v int
+		w    float64
+	}
+	edges := make([]edge, 0, n*(n-1)/2)
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			edges = append(edges, edge{u: u, v: v, w: d.At(u, v)})
+		}
+	}
+	sort.SliceStable(edges, func(i, j int) bool { return edges[i].w < edges[j].w })
+
+	adj := make([][]int, n)
+	sets := newDisjointSets(n)
+	added := 0
+	for _, e := range edges {
+		if len(adj[e.u]) == 2 || len(adj[e.v]) == 2 {
+			continue
+		}
+		if !sets.union(e.u, e.v) {
+			continue
+		}
+		adj[e.u] = append(adj[e.u], e.v)
+		adj[e.v] = append(adj[e.v], e.u)
+		added++
+		if added == n-1 {
+			break
+		}
+	}
+
+	// The added edges form a Hamiltonian path.
+	// Walk it from one of its ends.
+	start := 0
+	for u, a := range adj {
+		if len(a) == 1 {
+			start = u
+			break
+		}
+	}
+	tour := make([]int, 0, n)
+	prev, u := -1, start
+	for {
+		tour = append(tour, u)
+		next := -1
+		for _, v := range adj[u] {
+			if v != prev {
+				next = v
+			}
+		}
+		if next < 0 {
+			return tour
+		}
+		prev, u = u, next
+	}
+}
+
+// Christofides returns a tour over the cities of d constructed by the
+// Christofides algorithm. The tour is formed by shortcutting an Euler circuit
+// of the union of a minimum spanning tree of the cities and a minimum weight
+// perfect matching of the odd degree vertices of the tree. The matching is
+// found with matching.MaxWeight. If d is a metric, the returned tour is no
+// more than 3/2 times the length of an optimal tour. Christofides will panic
+// if d has non-finite elements.
+//
+// The algorithm is described in Christofides, "Worst-case analysis of a new
+// heuristic for the travelling salesman problem", Report 388, Graduate School
+// of Industrial Administration, CMU, 1976.
+//
+// The time complexity of Christofides is O(n^3) where n is the number of
+// cities.
+func Christofides(d mat.Symmetric) []int {
+	n := d.SymmetricDim()
+	if n <= 3 {
+		return identity(n)
+	}
+
+	parent := minimumSpanningTree(n, d.At)
+	adj := make([][]int, n)
+	var ends [][2]int
+	addEdge := func(u, v int) {
+		adj[u] = append(adj[u], len(ends))
+		adj[v] = append(adj[v], len(ends))
+		ends = append(ends, [2]int{u, v})
+	}
+	for v, u := range parent {
+		if u >= 0 {
+			addEdge(u, v)
+		}
+	}
+
+	var odd []int
+	for u, a := range adj {
+		if len(a)%2 != 0 {
+			odd = append(odd, u)
+		}
+	}
+	// A minimum weight perfect matching is a maximum weight
+	// maximum cardinality matching of the complete graph
+	// with weights reflected about the largest distance.
+	var longest float64
+	for i, u := range odd {
+		for _, v := range odd[i+1:] {
+			w := d.At(u, v)
+			if math.IsNaN(w) || math.IsInf(w, 0) {
+				panic("tsp: non-finite distance")
+			}
+			longest = math.Max(longest, w)
+		}
+	}
+	mg := simple.NewWeightedUndirectedGraph(0, 0)
+	for i, u := range odd {
+		for j := i + 1; j < len(odd); j++ {
+			mg.SetWeightedEdge(mg.NewWeightedEdge(simple.Node(i), simple.Node(j), longest+1-d.At(u, odd[j])))
+		}
+	}
+	m, _ := matching.MaxWeight(mg, true)
+	if 2*len(m) != len(odd) {
+		panic("tsp: no perfect matching")
+	}
+	for _, e := range m {
+		addEdge(odd[e.From().ID()], odd[e.To().ID()])
+	}
+
+	// Find an Euler circuit by Hierholzer's algorithm
+	// and shortcut repeated cities.
+	used := make([]bool, len(ends))
+	next := make([]int, n)
+	stack := []int{0}
+	visited := make([]bool, n)
+	tour := make([]int, 0, n)
+	for len(stack) != 0 {
+		u := stack[len(stack)-1]
+		for next[u] < len(adj[u]) && used[adj[u][next[u]]] {
+			next[u]++
+		}
+		if next[u] == len(adj[u]) {
+			stack = stack[:len(stack)-1]
+			if !visited[u] {
+				visited[u] = true
+				tour = append(tour, u)
+			}
+			continue
+		}
+		e := adj[u][next[u]]
+		used[e] = true
+		v := ends[e][0]
+		if v == u {
+			v = ends[e][1]
+		}
+		stack = append(stack, v)
+	}
+	return tour
+}
+
+// minimumSpanningTree returns the parent of each vertex in a minimum spanning
+// tree of the complete graph on n vertices with edge weights given by w,
+// rooted at vertex 0, using Prim's algorithm.
+func minimumSpanningTree(n int, w func(i, j int) float64) []int {
+	parent := make([]int, n)
+	dist := make([]float64, n)
+	inTree := make([]bool, n)
+	for v := range dist {
+		parent[v] = -1
+		dist[v] = math.Inf(1)
+	}
+	dist[0] = 0
+	for range n {
+		u := -1
+		for v, t := range inTree {
+			if !t && (u < 0 || dist[v] < dist[u]) {
+				u = v
+			}
+		}
+		inTree[u] = true
+		for v, t := range inTree {
+			if t {
+				continue
+			}
+			if wv := w(u, v); wv < dist[v] {
+				dist[v] = wv
+				parent[v] = u
+			}
+		}
+	}
+	return parent
+}
+
+// disjointSets is a union-find structure over [0, n).
+type disjointSets []int
+
+func newDisjointSets(n int) disjointSets {
+	s := make(disjointSets, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func (s disjointSets) find(u int) int {
+	for s[u] != u {
+		s[u] = s[s[u]]
+		u = s[u]
+	}
+	return u
+}
+
+// union merges the sets holding u and v. It returns
+// false if u and v were already in the same set.
+func (s disjointSets) union(u, v int) bool {
+	u, v = s.find(u), s.find(v)
+	if u == v {
+		return false
+	}
+	s[u] = v
+	return true
+}
+
+// identity returns the tour [0, 1, ..., n-1].
+func identity(n int) []int {
+	tour := make([]int, n)
+	for i := range tour {
+		tour[i] = i
+	}
+	return tour
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"fmt"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var constructors = []struct {
+	name string
+	fn   func(mat.Symmetric) []int
+}{
+	{name: "NearestNeighbor", fn: func(d mat.Symmetric) []int { return NearestNeighbor(d, 0) }},
+	{name: "Greedy", fn: Greedy},
+	{name: "Christofides", fn: Christofides},
+}
+
+func TestConstruct(t *testing.T) {
+	t.Parallel()
+	for _, c := range constructors {
+		for n := 1; n <= 9; n++ {
+			for seed := uint64(1); seed <= 5; seed++ {
+				d := euclidean(n, seed)
+				name := fmt.Sprintf("%s n=%d seed=%d", c.name, n, seed)
+				tour := c.fn(d)
+				l := Length(d, tour)
+				opt := optimal(d)
+				if l < opt*(1-1e-12) {
+					t.Errorf("tour shorter than optimal for %s: got:%v optimal:%v", name, l, opt)
+				}
+				if c.name == "Christofides" && l > 1.5*opt*(1+1e-12) {
+					t.Errorf("Christofides tour exceeds approximation bound for %s: got:%v optimal:%v", name, l, opt)
+				}
+			}
+		}
+
+		d := euclidean(200, 1)
+		tour := c.fn(d)
+		checkTour(d, tour)
+	}
+}
+
+func TestChristofidesBound(t *testing.T) {
+	t.Parallel()
+	// Cities on a line form a metric with an optimal
+	// tour of twice the distance between the ends.
+	const n = 11
+	d := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			d.SetSym(i, j, float64(j-i))
+		}
+	}
+	tour := Christofides(d)
+	if l := Length(d, tour); l != 2*(n-1) {
+		t.Errorf("unexpected tour length for cities on a line: got:%v want:%d", l, 2*(n-1))
+	}
+}
+
+func TestNearestNeighbor(t *testing.T) {
+	t.Parallel()
+	d := mat.NewSymDense(4, []float64{
+		0, 1, 5, 2,
+		1, 0, 1, 6,
+		5, 1, 0, 1,
+		2, 6, 1, 0,
+	})
+	got := NearestNeighbor(d, 0)
+	want := []int{0, 1, 2, 3}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("unexpected tour: got:%v want:%v", got, want)
+	}
+	got = NearestNeighbor(d, 3)
+	want = []int{3, 2, 1, 0}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("unexpected tour: got:%v want:%v", got, want)
+	}
+}
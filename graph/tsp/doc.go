@@ -0,0 +1,12 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tsp provides heuristics and lower bounds for the symmetric
+// travelling salesman problem.
+//
+// Problem instances are given as symmetric distance matrices and tours are
+// permutations of the matrix indices, with the return from the last city to
+// the first implied. Distances calculates the distance matrix for the nodes
+// of a graph.
+package tsp // import "gonum.org/v1/gonum/graph/tsp"
@@ -0,0 +1,287 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// improvementTol is the relative reduction in the length of
+// the tour edges involved in a move required for the move to
+// be accepted by the improvement heuristics.
+const improvementTol = 1e-12
+
+// TwoOpt improves tour in place by applying 2-opt moves until no move
+// shortens the tour, and returns the length of the improved tour. A 2-opt move
+// replaces two tour edges with the two edges that reconnect the tour after
+// reversing the path between them. TwoOpt will panic if tour is not a
+// permutation of the indices of d.
+func TwoOpt(d mat.Symmetric, tour []int) float64 {
+	checkTour(d, tour)
+	n := len(tour)
+	if n < 4 {
+		return length(d, tour)
+	}
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < n-2; i++ {
+			for j := i + 2; j < n; j++ {
+				if i == 0 && j == n-1 {
+					continue
+				}
+				a, b := tour[i], tour[i+1]
+				c, e := tour[j], tour[(j+1)%n]
+				removed := d.At(a, b) + d.At(c, e)
+				delta := d.At(a, c) + d.At(b, e) - removed
+				if delta < -improvementTol*removed {
+					reverse(tour[i+1 : j+1])
+					improved = true
+				}
+			}
+		}
+	}
+	return length(d, tour)
+}
+
+// OrOpt improves tour in place by applying Or-opt moves until no move shortens
+// the tour, and returns the length of the improved tour. An Or-opt move removes
+// a path of one to three consecutive cities from the tour and reinserts it,
+// possibly reversed, between two other adjacent cities. OrOpt will panic if
+// tour is not a permutation of the indices of d.
+func OrOpt(d mat.Symmetric, tour []int) float64 {
+	checkTour(d, tour)
+	n := len(tour)
+	moved := make([]int, 0, n)
+	for improved := true; improved; {
+		improved = false
+		for seg := 1; seg <= 3 && seg+3 <= n; seg++ {
+			for i := 0; i < n; i++ {
+				// The segment is tour[i:i+seg] taken
+				// cyclically, between p and q.
+				at := func(k int) int { return tour[(i+k)%n] }
+				p, s1, s2, q := at(n-1), at(0), at(seg-1), at(seg)
+				removed := d.At(p, s1) + d.At(s2, q)
+				gain := removed - d.At(p, q)
+				if gain <= 0 {
+					continue
+				}
+				for j := seg; j < n-1; j++ {
+					a, b := at(j), at(j+1)
+					ab := d.At(a, b)
+					forward := d.At(a, s1) + d.At(s2, b) - ab
+					backward := d.At(a, s2) + d.At(s1, b) - ab
+					cost := math.Min(forward, backward)
+					if cost-gain >= -improvementTol*(removed+ab) {
+						continue
+					}
+
+					moved = moved[:0]
+					for k := seg; k <= j; k++ {
+						moved = append(moved, at(k))
+					}
+					start := len(moved)
+					for k := 0; k < seg; k++ {
+						moved = append(moved, at(k))
+					}
+					if backward < forward {
+						reverse(moved[start:])
+					}
+					for k := j + 1; k < n; k++ {
+						moved = append(moved, at(k))
+					}
+					copy(tour, moved)
+					improved = true
+					break
+				}
+			}
+		}
+	}
+	return length(d, tour)
+}
+
+const (
+	// lkNeighbors is the number of nearest neighbors
+	// considered as candidates for each city.
+	lkNeighbors = 10
+
+	// lkDepth is the maximum number of 2-opt moves
+	// in a single Lin-Kernighan move sequence.
+	lkDepth = 50
+)
+
+// LinKernighan improves tour in place using a Lin-Kernighan style variable
+// depth search until no improving move sequence is found, and returns the
+// length of the improved tour. Each move sequence is built from a chain of
+// 2-opt moves chosen from the nearest neighbors of each city, and is kept
+// only if it shortens the tour. LinKernighan will panic if tour is not a
+// permutation of the indices of d.
+//
+// The search is described in Lin and Kernighan, "An effective heuristic
+// algorithm for the traveling-salesman problem", Oper. Res. 21(2):498-516,
+// 1973, doi:10.1287/opre.21.2.498.
+func LinKernighan(d mat.Symmetric, tour []int) float64 {
+	checkTour(d, tour)
+	n := len(tour)
+	if n < 4 {
+		return length(d, tour)
+	}
+
+	lk := linKernighan{
+		d:         d,
+		tour:      tour,
+		pos:       make([]int, n),
+		neighbors: make([][]int, n),
+		added:     make(map[[2]int]bool),
+		removed:   make(map[[2]int]bool),
+	}
+	for i, c := range tour {
+		lk.pos[c] = i
+	}
+	k := min(lkNeighbors, n-1)
+	for c := range lk.neighbors {
+		others := make([]int, 0, n-1)
+		for v := 0; v < n; v++ {
+			if v != c {
+				others = append(others, v)
+			}
+		}
+		sort.SliceStable(others, func(i, j int) bool { return d.At(c, others[i]) < d.At(c, others[j]) })
+		lk.neighbors[c] = others[:k]
+	}
+
+	// Each pass breaks the tour edge following each city.
+	// The tour is reversed between passes so that the edge
+	// preceding each city is also considered.
+	l := length(d, tour)
+	for stale := 0; stale < 2; {
+		improved := false
+		for c := 0; c < n; c++ {
+			if gain := lk.improve(c, l); gain > 0 {
+				l -= gain
+				improved = true
+			}
+		}
+		if improved {
+			stale = 0
+		} else {
+			stale++
+		}
+		lk.reverse(0, n-1)
+	}
+	return length(d, tour)
+}
+
+// linKernighan holds the state of a Lin-Kernighan search.
+type linKernighan struct {
+	d    mat.Symmetric
+	tour []int
+	pos  []int
+
+	neighbors [][]int
+
+	added, removed map[[2]int]bool
+}
+
+// improve attempts to find an improving move sequence beginning with the
+// removal of the tour edge following t1 from a tour with the given length.
+// The best move sequence is applied and its gain returned. If no improving
+// sequence is found, the tour is left unaltered and improve returns zero.
+func (lk *linKernighan) improve(t1 int, length float64) float64 {
+	clear(lk.added)
+	clear(lk.removed)
+
+	t2 := lk.succ(t1)
+	lk.removed[edgeKey(t1, t2)] = true
+	g := lk.d.At(t1, t2)
+	var (
+		moves     [][2]int
+		bestGain  float64
+		bestDepth int
+	)
+	for len(moves) < lkDepth {
+		t3, t4 := -1, -1
+		best := math.Inf(-1)
+		for _, c := range lk.neighbors[t2] {
+			if c == t1 || c == lk.succ(t2) {
+				continue
+			}
+			g1 := g - lk.d.At(t2, c)
+			if g1 <= 0 {
+				break
+			}
+			p := lk.pred(c)
+			if lk.removed[edgeKey(t2, c)] || lk.added[edgeKey(c, p)] {
+				continue
+			}
+			if v := g1 + lk.d.At(c, p); v > best {
+				t3, t4, best = c, p, v
+			}
+		}
+		if t3 < 0 {
+			break
+		}
+
+		// Replace the edges t1-t2 and t4-t3 with t2-t3
+		// and t1-t4, leaving t4 following t1.
+		i, j := lk.pos[t2], lk.pos[t4]
+		lk.reverse(i, j)
+		moves = append(moves, [2]int{i, j})
+		lk.added[edgeKey(t2, t3)] = true
+		lk.removed[edgeKey(t3, t4)] = true
+		g = best
+
+		if gain := g - lk.d.At(t4, t1); gain > bestGain {
+			bestGain = gain
+			bestDepth = len(moves)
+		}
+		t2 = t4
+	}
+
+	if bestGain <= improvementTol*length {
+		bestDepth, bestGain = 0, 0
+	}
+	for k := len(moves) - 1; k >= bestDepth; k-- {
+		lk.reverse(moves[k][0], moves[k][1])
+	}
+	return bestGain
+}
+
+func (lk *linKernighan) succ(c int) int {
+	return lk.tour[(lk.pos[c]+1)%len(lk.tour)]
+}
+
+func (lk *linKernighan) pred(c int) int {
+	n := len(lk.tour)
+	return lk.tour[(lk.pos[c]+n-1)%n]
+}
+
+// reverse reverses the cities in tour positions i through j,
+// taken cyclically.
+func (lk *linKernighan) reverse(i, j int) {
+	n := len(lk.tour)
+	m := (j-i+n)%n + 1
+	for k := 0; k < m/2; k++ {
+		a, b := (i+k)%n, (j-k+n)%n
+		lk.tour[a], lk.tour[b] = lk.tour[b], lk.tour[a]
+		lk.pos[lk.tour[a]] = a
+		lk.pos[lk.tour[b]] = b
+	}
+}
+
+func edgeKey(u, v int) [2]int {
+	if v < u {
+		u, v = v, u
+	}
+	return [2]int{u, v}
+}
+
+func reverse(s []int) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
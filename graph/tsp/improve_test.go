@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var improvers = []struct {
+	name string
+	fn   func(mat.Symmetric, []int) float64
+}{
+	{name: "TwoOpt", fn: TwoOpt},
+	{name: "OrOpt", fn: OrOpt},
+	{name: "LinKernighan", fn: LinKernighan},
+}
+
+func TestImprove(t *testing.T) {
+	t.Parallel()
+	for _, imp := range improvers {
+		for n := 1; n <= 9; n++ {
+			for seed := uint64(1); seed <= 5; seed++ {
+				d := euclidean(n, seed)
+				name := fmt.Sprintf("%s n=%d seed=%d", imp.name, n, seed)
+				tour := identity(n)
+				before := Length(d, tour)
+				l := imp.fn(d, tour)
+				if got := Length(d, tour); got != l {
+					t.Errorf("returned length does not match tour for %s: got:%v want:%v", name, l, got)
+				}
+				if l > before*(1+1e-12) {
+					t.Errorf("tour lengthened for %s: got:%v before:%v", name, l, before)
+				}
+				if opt := optimal(d); l < opt*(1-1e-12) {
+					t.Errorf("tour shorter than optimal for %s: got:%v optimal:%v", name, l, opt)
+				}
+			}
+		}
+	}
+}
+
+func TestImproveLarge(t *testing.T) {
+	t.Parallel()
+	const n = 300
+	for seed := uint64(1); seed <= 3; seed++ {
+		d := euclidean(n, seed)
+		lower := HeldKarpBound(d)
+		start := NearestNeighbor(d, 0)
+		initial := Length(d, start)
+		for _, imp := range improvers {
+			name := fmt.Sprintf("%s seed=%d", imp.name, seed)
+			tour := append([]int(nil), start...)
+			l := imp.fn(d, tour)
+			checkTour(d, tour)
+			if l >= initial {
+				t.Errorf("tour not improved for %s: got:%v initial:%v", name, l, initial)
+			}
+			if l < lower {
+				t.Errorf("tour shorter than lower bound for %s: got:%v bound:%v", name, l, lower)
+			}
+			if imp.name == "LinKernighan" && l > 1.06*lower {
+				t.Errorf("unexpectedly long Lin-Kernighan tour for %s: got:%v bound:%v", name, l, lower)
+			}
+		}
+	}
+}
+
+func TestTwoOptLocalOptimum(t *testing.T) {
+	t.Parallel()
+	for seed := uint64(1); seed <= 5; seed++ {
+		d := euclidean(50, seed)
+		tour := NearestNeighbor(d, 0)
+		TwoOpt(d, tour)
+		n := len(tour)
+		for i := 0; i < n; i++ {
+			for j := i + 2; j < n; j++ {
+				if i == 0 && j == n-1 {
+					continue
+				}
+				a, b := tour[i], tour[i+1]
+				c, e := tour[j], tour[(j+1)%n]
+				delta := d.At(a, c) + d.At(b, e) - d.At(a, b) - d.At(c, e)
+				if delta < -1e-9 {
+					t.Errorf("improving 2-opt move remains for seed=%d: %d-%d %d-%d delta=%v", seed, a, b, c, e, delta)
+				}
+			}
+		}
+	}
+}
+
+func TestLinKernighanOptimal(t *testing.T) {
+	t.Parallel()
+	// Cities on a circle have the circle
+	// order as their unique optimal tour.
+	const n = 60
+	d := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			d.SetSym(i, j, 2*math.Sin(math.Pi*float64(j-i)/n))
+		}
+	}
+	want := 2 * n * math.Sin(math.Pi/n)
+	tour := Greedy(d)
+	// Scramble the greedy tour.
+	for i := 0; i < n; i += 3 {
+		tour[i], tour[(i*7)%n] = tour[(i*7)%n], tour[i]
+	}
+	if got := LinKernighan(d, tour); math.Abs(got-want) > 1e-9 {
+		t.Errorf("unexpected tour length for cities on a circle: got:%v want:%v", got, want)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Distances returns the nodes of g sorted by ID and the matrix of shortest
+// path distances between them. The distance between nodes i and j is held in
+// element i, j of d. If g is not a graph.Weighted, edges have unit weight.
+// Distances between nodes that are not connected are +Inf. If g has no nodes,
+// d is nil.
+//
+// Tours over the returned matrix visit each node of g once, with each step of
+// the tour corresponding to a shortest path in g.
+func Distances(g graph.Undirected) (nodes []graph.Node, d *mat.SymDense) {
+	nodes = graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	paths := path.DijkstraAllPaths(g)
+	d = mat.NewSymDense(len(nodes), nil)
+	for i, u := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			d.SetSym(i, j, paths.Weight(u.ID(), nodes[j].ID()))
+		}
+	}
+	return nodes, d
+}
+
+// Length returns the length of the closed tour over the cities of d.
+// Length will panic if tour is not a permutation of the indices of d.
+func Length(d mat.Symmetric, tour []int) float64 {
+	checkTour(d, tour)
+	return length(d, tour)
+}
+
+func length(d mat.Symmetric, tour []int) float64 {
+	var l float64
+	for i, u := range tour {
+		l += d.At(u, tour[(i+1)%len(tour)])
+	}
+	return l
+}
+
+// checkTour panics if tour is not a permutation of the indices of d.
+func checkTour(d mat.Symmetric, tour []int) {
+	n := d.SymmetricDim()
+	if len(tour) != n {
+		panic("tsp: tour length mismatch")
+	}
+	seen := make([]bool, n)
+	for _, u := range tour {
+		if u < 0 || n <= u || seen[u] {
+			panic("tsp: invalid tour")
+		}
+		seen[u] = true
+	}
+}
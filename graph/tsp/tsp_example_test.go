@@ -0,0 +1,44 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/tsp"
+)
+
+func Example() {
+	// Find a short tour visiting each of the towns
+	// connected by a road network.
+	roads := simple.NewWeightedUndirectedGraph(0, 0)
+	for _, r := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 4},
+		{F: simple.Node(1), T: simple.Node(2), W: 3},
+		{F: simple.Node(2), T: simple.Node(3), W: 5},
+		{F: simple.Node(3), T: simple.Node(0), W: 2},
+		{F: simple.Node(0), T: simple.Node(2), W: 9},
+		{F: simple.Node(3), T: simple.Node(4), W: 1},
+		{F: simple.Node(4), T: simple.Node(1), W: 3},
+	} {
+		roads.SetWeightedEdge(r)
+	}
+	towns, d := tsp.Distances(roads)
+
+	tour := tsp.Christofides(d)
+	length := tsp.LinKernighan(d, tour)
+	fmt.Printf("tour length: %v\n", length)
+	fmt.Printf("lower bound: %.4g\n", tsp.HeldKarpBound(d))
+	for _, i := range tour {
+		fmt.Print(towns[i].ID(), " ")
+	}
+	fmt.Println()
+
+	// Output:
+	// tour length: 16
+	// lower bound: 12.67
+	// 0 2 1 4 3
+}
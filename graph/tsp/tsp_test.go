@@ -0,0 +1,147 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestDistances(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 1},
+		{F: simple.Node(1), T: simple.Node(2), W: 2},
+		{F: simple.Node(0), T: simple.Node(2), W: 4},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	g.AddNode(simple.Node(3))
+
+	nodes, d := Distances(g)
+	for i, n := range nodes {
+		if n.ID() != int64(i) {
+			t.Fatalf("unexpected node order: got:%v", nodes)
+		}
+	}
+	inf := math.Inf(1)
+	want := mat.NewSymDense(4, []float64{
+		0, 1, 3, inf,
+		1, 0, 2, inf,
+		3, 2, 0, inf,
+		inf, inf, inf, 0,
+	})
+	if !mat.Equal(d, want) {
+		t.Errorf("unexpected distances:\ngot:\n%v\nwant:\n%v", mat.Formatted(d), mat.Formatted(want))
+	}
+
+	// Unweighted graphs have unit edge weights.
+	c := simple.NewUndirectedGraph()
+	gen.Cycle(c, gen.IDRange{First: 0, Last: 9})
+	_, d = Distances(c)
+	if got := d.At(0, 5); got != 5 {
+		t.Errorf("unexpected cycle distance: got:%v want:5", got)
+	}
+	if got := Length(d, identity(10)); got != 10 {
+		t.Errorf("unexpected cycle tour length: got:%v want:10", got)
+	}
+
+	nodes, d = Distances(simple.NewUndirectedGraph())
+	if nodes != nil || d != nil {
+		t.Errorf("unexpected result for empty graph: nodes=%v d=%v", nodes, d)
+	}
+}
+
+func TestLengthPanics(t *testing.T) {
+	t.Parallel()
+	d := euclidean(5, 1)
+	for _, tour := range [][]int{
+		{0, 1, 2, 3},
+		{0, 1, 2, 3, 3},
+		{0, 1, 2, 3, 5},
+		{-1, 1, 2, 3, 4},
+	} {
+		panicked := func() (panicked bool) {
+			defer func() { panicked = recover() != nil }()
+			Length(d, tour)
+			return false
+		}()
+		if !panicked {
+			t.Errorf("expected panic for invalid tour %v", tour)
+		}
+	}
+}
+
+// euclidean returns the distance matrix of n cities placed
+// uniformly at random in the unit square.
+func euclidean(n int, seed uint64) *mat.SymDense {
+	rnd := rand.New(rand.NewPCG(seed, seed))
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i := range x {
+		x[i] = rnd.Float64()
+		y[i] = rnd.Float64()
+	}
+	d := mat.NewSymDense(n, nil)
+	for i := range x {
+		for j := i + 1; j < n; j++ {
+			d.SetSym(i, j, math.Hypot(x[i]-x[j], y[i]-y[j]))
+		}
+	}
+	return d
+}
+
+// optimal returns the length of an optimal tour over the cities
+// of d calculated by the Held-Karp dynamic programming algorithm.
+func optimal(d mat.Symmetric) float64 {
+	n := d.SymmetricDim()
+	if n <= 3 {
+		return length(d, identity(n))
+	}
+	// best[s][v] is the length of the shortest path from city
+	// 0 through the cities in s ending at v, where s is a set
+	// of cities excluding city 0 represented as a bit mask
+	// shifted right by one.
+	m := n - 1
+	best := make([][]float64, 1<<m)
+	for s := range best {
+		best[s] = make([]float64, m)
+		for v := range best[s] {
+			best[s][v] = math.Inf(1)
+		}
+	}
+	for v := 0; v < m; v++ {
+		best[1<<v][v] = d.At(0, v+1)
+	}
+	for s := 1; s < len(best); s++ {
+		if bits.OnesCount(uint(s)) < 2 {
+			continue
+		}
+		for v := 0; v < m; v++ {
+			if s&(1<<v) == 0 {
+				continue
+			}
+			prev := s &^ (1 << v)
+			for u := 0; u < m; u++ {
+				if prev&(1<<u) == 0 {
+					continue
+				}
+				best[s][v] = math.Min(best[s][v], best[prev][u]+d.At(u+1, v+1))
+			}
+		}
+	}
+	l := math.Inf(1)
+	for v := 0; v < m; v++ {
+		l = math.Min(l, best[len(best)-1][v]+d.At(v+1, 0))
+	}
+	return l
+}
@@ -0,0 +1,288 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !noasm && !gccgo && !safe
+// +build !noasm,!gccgo,!safe
+
+#include "textflag.h"
+
+// The kernels in this file process 16 elements per iteration in four
+// independent YMM registers, then 4 elements per iteration and then
+// single elements. Unaligned loads and stores are used throughout since
+// they carry no penalty on processors supporting AVX2 when the data are
+// aligned.
+
+#define X_PTR SI
+#define Y_PTR DX
+#define DST_PTR DI
+#define IDX AX
+#define LEN CX
+#define TAIL BX
+
+// func sumAVX2(x []float64) float64
+TEXT ·sumAVX2(SB), NOSPLIT, $0
+	MOVQ   x_base+0(FP), X_PTR // X_PTR = &x
+	MOVQ   x_len+8(FP), LEN    // LEN = len(x)
+	XORQ   IDX, IDX            // i = 0
+	VXORPD Y0, Y0, Y0          // sum_i = 0
+	VXORPD Y1, Y1, Y1
+	VXORPD Y2, Y2, Y2
+	VXORPD Y3, Y3, Y3
+	MOVQ   LEN, TAIL
+	SHRQ   $4, LEN             // LEN = floor( n / 16 )
+	JZ     sum_tail4
+
+sum_loop16:
+	VADDPD (X_PTR)(IDX*8), Y0, Y0 // sum_i += x[i:i+4]
+	VADDPD 32(X_PTR)(IDX*8), Y1, Y1
+	VADDPD 64(X_PTR)(IDX*8), Y2, Y2
+	VADDPD 96(X_PTR)(IDX*8), Y3, Y3
+	ADDQ   $16, IDX               // i += 16
+	DECQ   LEN
+	JNZ    sum_loop16             // } while --LEN > 0
+
+	VADDPD Y2, Y0, Y0
+	VADDPD Y3, Y1, Y1
+	VADDPD Y1, Y0, Y0
+
+sum_tail4:
+	ANDQ $15, TAIL
+	MOVQ TAIL, LEN
+	SHRQ $2, LEN   // LEN = floor( TAIL / 4 )
+	JZ   sum_reduce
+
+sum_loop4:
+	VADDPD (X_PTR)(IDX*8), Y0, Y0 // sum_i += x[i:i+4]
+	ADDQ   $4, IDX
+	DECQ   LEN
+	JNZ    sum_loop4
+
+sum_reduce:
+	VEXTRACTF128 $1, Y0, X1
+	VADDPD       X1, X0, X0
+	VHADDPD      X0, X0, X0 // sum = sum_0 + sum_1 + sum_2 + sum_3
+	ANDQ         $3, TAIL
+	JZ           sum_end
+
+sum_loop1:
+	VADDSD (X_PTR)(IDX*8), X0, X0 // sum += x[i]
+	INCQ   IDX
+	DECQ   TAIL
+	JNZ    sum_loop1
+
+sum_end:
+	VZEROUPPER
+	MOVSD X0, ret+24(FP)
+	RET
+
+// func dotUnitaryAVX2(x, y []float64) (sum float64)
+//
+// The partial sums are accumulated in the same order as in the SSE2
+// implementation so the results of the two implementations are identical.
+TEXT ·dotUnitaryAVX2(SB), NOSPLIT, $0
+	MOVQ   x_base+0(FP), X_PTR  // X_PTR = &x
+	MOVQ   x_len+8(FP), LEN     // LEN = len(x)
+	MOVQ   y_base+24(FP), Y_PTR // Y_PTR = &y
+	XORQ   IDX, IDX             // i = 0
+	VXORPD Y0, Y0, Y0           // sum_i = 0
+	MOVQ   LEN, TAIL
+	SHRQ   $4, LEN              // LEN = floor( n / 16 )
+	JZ     dot_tail4
+
+dot_loop16:
+	VMOVUPD (X_PTR)(IDX*8), Y4
+	VMOVUPD 32(X_PTR)(IDX*8), Y5
+	VMOVUPD 64(X_PTR)(IDX*8), Y6
+	VMOVUPD 96(X_PTR)(IDX*8), Y7
+	VMULPD  (Y_PTR)(IDX*8), Y4, Y4   // p_j = x[i+4j:i+4j+4] * y[i+4j:i+4j+4]
+	VMULPD  32(Y_PTR)(IDX*8), Y5, Y5
+	VMULPD  64(Y_PTR)(IDX*8), Y6, Y6
+	VMULPD  96(Y_PTR)(IDX*8), Y7, Y7
+	VADDPD  Y4, Y0, Y0               // sum_i += p_j
+	VADDPD  Y5, Y0, Y0
+	VADDPD  Y6, Y0, Y0
+	VADDPD  Y7, Y0, Y0
+	ADDQ    $16, IDX                 // i += 16
+	DECQ    LEN
+	JNZ     dot_loop16               // } while --LEN > 0
+
+dot_tail4:
+	ANDQ $15, TAIL
+	MOVQ TAIL, LEN
+	SHRQ $2, LEN   // LEN = floor( TAIL / 4 )
+	JZ   dot_tail1
+
+dot_loop4:
+	VMOVUPD (X_PTR)(IDX*8), Y4
+	VMULPD  (Y_PTR)(IDX*8), Y4, Y4
+	VADDPD  Y4, Y0, Y0
+	ADDQ    $4, IDX
+	DECQ    LEN
+	JNZ     dot_loop4
+
+dot_tail1:
+	VEXTRACTF128 $1, Y0, X1 // X1 = { sum_2, sum_3 }
+	ANDQ         $3, TAIL
+	JZ           dot_reduce
+
+dot_loop1:
+	VMOVSD (X_PTR)(IDX*8), X4
+	VMULSD (Y_PTR)(IDX*8), X4, X4 // sum_0 += x[i] * y[i]
+	VADDSD X4, X0, X0
+	INCQ   IDX
+	DECQ   TAIL
+	JNZ    dot_loop1
+
+dot_reduce:
+	VADDPD  X1, X0, X0 // sum = sum_0 + sum_2 + sum_1 + sum_3
+	VHADDPD X0, X0, X0
+	VZEROUPPER
+	MOVSD   X0, sum+48(FP)
+	RET
+
+// axpyKernelAVX2 computes dst[i] = alpha*x[i] + y[i] for LEN elements
+// with alpha broadcast in Y0 and returns to the caller of the function
+// jumping to it. The product and sum are rounded separately so the
+// results match the SSE2 implementations.
+TEXT axpyKernelAVX2<>(SB), NOSPLIT, $0
+	XORQ IDX, IDX
+	MOVQ LEN, TAIL
+	SHRQ $4, LEN   // LEN = floor( n / 16 )
+	JZ   tail4
+
+loop16:
+	VMULPD  (X_PTR)(IDX*8), Y0, Y1   // Y_i = alpha * x[i:i+4]
+	VMULPD  32(X_PTR)(IDX*8), Y0, Y2
+	VMULPD  64(X_PTR)(IDX*8), Y0, Y3
+	VMULPD  96(X_PTR)(IDX*8), Y0, Y4
+	VADDPD  (Y_PTR)(IDX*8), Y1, Y1   // Y_i += y[i:i+4]
+	VADDPD  32(Y_PTR)(IDX*8), Y2, Y2
+	VADDPD  64(Y_PTR)(IDX*8), Y3, Y3
+	VADDPD  96(Y_PTR)(IDX*8), Y4, Y4
+	VMOVUPD Y1, (DST_PTR)(IDX*8)     // dst[i:i+4] = Y_i
+	VMOVUPD Y2, 32(DST_PTR)(IDX*8)
+	VMOVUPD Y3, 64(DST_PTR)(IDX*8)
+	VMOVUPD Y4, 96(DST_PTR)(IDX*8)
+	ADDQ    $16, IDX                 // i += 16
+	DECQ    LEN
+	JNZ     loop16                   // } while --LEN > 0
+
+tail4:
+	ANDQ $15, TAIL
+	MOVQ TAIL, LEN
+	SHRQ $2, LEN   // LEN = floor( TAIL / 4 )
+	JZ   tail1
+
+loop4:
+	VMULPD  (X_PTR)(IDX*8), Y0, Y1
+	VADDPD  (Y_PTR)(IDX*8), Y1, Y1
+	VMOVUPD Y1, (DST_PTR)(IDX*8)
+	ADDQ    $4, IDX
+	DECQ    LEN
+	JNZ     loop4
+
+tail1:
+	ANDQ $3, TAIL
+	JZ   end
+
+loop1:
+	VMULSD (X_PTR)(IDX*8), X0, X1 // dst[i] = alpha * x[i] + y[i]
+	VADDSD (Y_PTR)(IDX*8), X1, X1
+	VMOVSD X1, (DST_PTR)(IDX*8)
+	INCQ   IDX
+	DECQ   TAIL
+	JNZ    loop1
+
+end:
+	VZEROUPPER
+	RET
+
+// func axpyUnitaryAVX2(alpha float64, x, y []float64)
+TEXT ·axpyUnitaryAVX2(SB), NOSPLIT, $0
+	MOVQ         x_base+8(FP), X_PTR  // X_PTR = &x
+	MOVQ         y_base+32(FP), Y_PTR // Y_PTR = &y
+	MOVQ         Y_PTR, DST_PTR       // DST_PTR = &y
+	MOVQ         x_len+16(FP), LEN    // LEN = min( len(x), len(y) )
+	CMPQ         y_len+40(FP), LEN
+	CMOVQLE      y_len+40(FP), LEN
+	VBROADCASTSD alpha+0(FP), Y0      // Y0 = { alpha, alpha, alpha, alpha }
+	JMP          axpyKernelAVX2<>(SB)
+
+// func axpyUnitaryToAVX2(dst []float64, alpha float64, x, y []float64)
+TEXT ·axpyUnitaryToAVX2(SB), NOSPLIT, $0
+	MOVQ         dst_base+0(FP), DST_PTR // DST_PTR = &dst
+	MOVQ         x_base+32(FP), X_PTR    // X_PTR = &x
+	MOVQ         y_base+56(FP), Y_PTR    // Y_PTR = &y
+	MOVQ         x_len+40(FP), LEN       // LEN = min( len(x), len(y), len(dst) )
+	CMPQ         y_len+64(FP), LEN
+	CMOVQLE      y_len+64(FP), LEN
+	CMPQ         dst_len+8(FP), LEN
+	CMOVQLE      dst_len+8(FP), LEN
+	VBROADCASTSD alpha+24(FP), Y0
+	JMP          axpyKernelAVX2<>(SB)
+
+// scalKernelAVX2 computes dst[i] = alpha*x[i] for LEN elements with alpha
+// broadcast in Y0 and returns to the caller of the function jumping to it.
+TEXT scalKernelAVX2<>(SB), NOSPLIT, $0
+	XORQ IDX, IDX
+	MOVQ LEN, TAIL
+	SHRQ $4, LEN
+	JZ   tail4
+
+loop16:
+	VMULPD  (X_PTR)(IDX*8), Y0, Y1   // Y_i = alpha * x[i:i+4]
+	VMULPD  32(X_PTR)(IDX*8), Y0, Y2
+	VMULPD  64(X_PTR)(IDX*8), Y0, Y3
+	VMULPD  96(X_PTR)(IDX*8), Y0, Y4
+	VMOVUPD Y1, (DST_PTR)(IDX*8)     // dst[i:i+4] = Y_i
+	VMOVUPD Y2, 32(DST_PTR)(IDX*8)
+	VMOVUPD Y3, 64(DST_PTR)(IDX*8)
+	VMOVUPD Y4, 96(DST_PTR)(IDX*8)
+	ADDQ    $16, IDX
+	DECQ    LEN
+	JNZ     loop16
+
+tail4:
+	ANDQ $15, TAIL
+	MOVQ TAIL, LEN
+	SHRQ $2, LEN
+	JZ   tail1
+
+loop4:
+	VMULPD  (X_PTR)(IDX*8), Y0, Y1
+	VMOVUPD Y1, (DST_PTR)(IDX*8)
+	ADDQ    $4, IDX
+	DECQ    LEN
+	JNZ     loop4
+
+tail1:
+	ANDQ $3, TAIL
+	JZ   end
+
+loop1:
+	VMULSD (X_PTR)(IDX*8), X0, X1 // dst[i] = alpha * x[i]
+	VMOVSD X1, (DST_PTR)(IDX*8)
+	INCQ   IDX
+	DECQ   TAIL
+	JNZ    loop1
+
+end:
+	VZEROUPPER
+	RET
+
+// func scalUnitaryAVX2(alpha float64, x []float64)
+TEXT ·scalUnitaryAVX2(SB), NOSPLIT, $0
+	MOVQ         x_base+8(FP), X_PTR // X_PTR = &x
+	MOVQ         X_PTR, DST_PTR      // DST_PTR = &x
+	MOVQ         x_len+16(FP), LEN   // LEN = len(x)
+	VBROADCASTSD alpha+0(FP), Y0
+	JMP          scalKernelAVX2<>(SB)
+
+// func scalUnitaryToAVX2(dst []float64, alpha float64, x []float64)
+TEXT ·scalUnitaryToAVX2(SB), NOSPLIT, $0
+	MOVQ         dst_base+0(FP), DST_PTR // DST_PTR = &dst
+	MOVQ         x_base+32(FP), X_PTR    // X_PTR = &x
+	MOVQ         x_len+40(FP), LEN       // LEN = len(x)
+	VBROADCASTSD alpha+24(FP), Y0
+	JMP          scalKernelAVX2<>(SB)
@@ -49,6 +49,11 @@
 
 // func AxpyUnitary(alpha float64, x, y []float64)
 TEXT ·AxpyUnitary(SB), NOSPLIT, $0
+	CMPB ·useAVX2(SB), $0
+	JE   sse2
+	JMP  ·axpyUnitaryAVX2(SB)
+
+sse2:
 	MOVQ    x_base+8(FP), X_PTR  // X_PTR := &x
 	MOVQ    y_base+32(FP), Y_PTR // Y_PTR := &y
 	MOVQ    x_len+16(FP), LEN    // LEN = min( len(x), len(y) )
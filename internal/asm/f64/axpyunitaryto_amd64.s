@@ -49,6 +49,11 @@
 
 // func AxpyUnitaryTo(dst []float64, alpha float64, x, y []float64)
 TEXT ·AxpyUnitaryTo(SB), NOSPLIT, $0
+	CMPB ·useAVX2(SB), $0
+	JE   sse2
+	JMP  ·axpyUnitaryToAVX2(SB)
+
+sse2:
 	MOVQ    dst_base+0(FP), DST_PTR // DST_PTR := &dst
 	MOVQ    x_base+32(FP), X_PTR    // X_PTR := &x
 	MOVQ    y_base+56(FP), Y_PTR    // Y_PTR := &y
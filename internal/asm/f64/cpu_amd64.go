@@ -0,0 +1,56 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !noasm && !gccgo && !safe
+// +build !noasm,!gccgo,!safe
+
+package f64
+
+// useAVX2 indicates whether the AVX2 implementations of Sum,
+// DotUnitary, AxpyUnitary, AxpyUnitaryTo, ScalUnitary, ScalUnitaryTo
+// and ExpUnitaryTo are used in place of the SSE2 and Go implementations.
+var useAVX2 = hasAVX2()
+
+// hasAVX2 returns whether the processor and operating system
+// support the AVX2 instruction set.
+func hasAVX2() bool {
+	const (
+		osxsave = 1 << 27
+		avx     = 1 << 28
+		avx2    = 1 << 5
+
+		// xmmYmm is the XCR0 mask for the XMM and
+		// YMM register state saved by the OS.
+		xmmYmm = 1<<1 | 1<<2
+	)
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	if ecx1&(osxsave|avx) != osxsave|avx {
+		return false
+	}
+	if xcr0, _ := xgetbv(); xcr0&xmmYmm != xmmYmm {
+		return false
+	}
+	_, ebx7, _, _ := cpuid(7, 0)
+	return ebx7&avx2 != 0
+}
+
+// cpuid executes the CPUID instruction with the given EAX and ECX inputs.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// xgetbv returns the low and high words of the XCR0 register.
+func xgetbv() (eax, edx uint32)
+
+// The AVX2 implementations have the same semantics as
+// the functions they are dispatched from.
+
+func sumAVX2(x []float64) float64
+func dotUnitaryAVX2(x, y []float64) (sum float64)
+func axpyUnitaryAVX2(alpha float64, x, y []float64)
+func axpyUnitaryToAVX2(dst []float64, alpha float64, x, y []float64)
+func scalUnitaryAVX2(alpha float64, x []float64)
+func scalUnitaryToAVX2(dst []float64, alpha float64, x []float64)
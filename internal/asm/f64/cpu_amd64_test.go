@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !noasm && !gccgo && !safe
+// +build !noasm,!gccgo,!safe
+
+package f64_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	. "gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// withAVX2 runs fn with the AVX2 implementations enabled if avx2 is true
+// and disabled otherwise, skipping if AVX2 is requested but not available.
+func withAVX2(t *testing.T, avx2 bool, fn func(t *testing.T)) {
+	t.Run(fmt.Sprintf("avx2=%t", avx2), func(t *testing.T) {
+		if avx2 && !HasAVX2() {
+			t.Skip("AVX2 not supported")
+		}
+		defer SetAVX2(SetAVX2(avx2))
+		fn(t)
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	for _, avx2 := range []bool{false, true} {
+		withAVX2(t, avx2, func(t *testing.T) {
+			TestSum(t)
+			TestDotUnitary(t)
+			TestAxpyUnitary(t)
+			TestAxpyUnitaryTo(t)
+			TestScalUnitary(t)
+			TestScalUnitaryTo(t)
+			TestExpUnitaryTo(t)
+		})
+	}
+}
+
+func TestDispatchRandom(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, avx2 := range []bool{false, true} {
+		withAVX2(t, avx2, func(t *testing.T) {
+			for n := 0; n < 100; n++ {
+				for off := 0; off < 4; off++ {
+					x := normSlice(rnd, n+off)[off:]
+					y := normSlice(rnd, n+off)[off:]
+					alpha := rnd.NormFloat64()
+
+					var wantSum, wantDot float64
+					for i, v := range x {
+						wantSum += v
+						wantDot += v * y[i]
+					}
+					tol := 1e-14 * float64(n+1)
+					if got := Sum(x); math.Abs(got-wantSum) > tol {
+						t.Errorf("unexpected Sum for n=%d: got:%v want:%v", n, got, wantSum)
+					}
+					if got := DotUnitary(x, y); math.Abs(got-wantDot) > tol {
+						t.Errorf("unexpected DotUnitary for n=%d: got:%v want:%v", n, got, wantDot)
+					}
+					// DotUnitary accumulates in the same order in
+					// all implementations.
+					prev := SetAVX2(false)
+					sse2Dot := DotUnitary(x, y)
+					SetAVX2(prev)
+					if got := DotUnitary(x, y); got != sse2Dot {
+						t.Errorf("DotUnitary differs from SSE2 for n=%d: got:%v want:%v", n, got, sse2Dot)
+					}
+
+					// The element-wise kernels round identically to
+					// the reference implementation.
+					dst := guardVector(make([]float64, n), -1, 1)
+					AxpyUnitaryTo(dst[1:n+1], alpha, x, y)
+					for i, v := range x {
+						if want := alpha*v + y[i]; dst[i+1] != want {
+							t.Errorf("unexpected AxpyUnitaryTo for n=%d at %d: got:%v want:%v", n, i, dst[i+1], want)
+						}
+					}
+					if !isValidGuard(dst, -1, 1) {
+						t.Errorf("guard violated by AxpyUnitaryTo for n=%d", n)
+					}
+					ScalUnitaryTo(dst[1:n+1], alpha, x)
+					for i, v := range x {
+						if want := alpha * v; dst[i+1] != want {
+							t.Errorf("unexpected ScalUnitaryTo for n=%d at %d: got:%v want:%v", n, i, dst[i+1], want)
+						}
+					}
+					if !isValidGuard(dst, -1, 1) {
+						t.Errorf("guard violated by ScalUnitaryTo for n=%d", n)
+					}
+
+					want := make([]float64, n)
+					for i, v := range x {
+						want[i] = y[i] + alpha*v
+					}
+					AxpyUnitary(alpha, x, y)
+					for i := range y {
+						if y[i] != want[i] {
+							t.Errorf("unexpected AxpyUnitary for n=%d at %d: got:%v want:%v", n, i, y[i], want[i])
+						}
+					}
+					for i, v := range y {
+						want[i] = alpha * v
+					}
+					ScalUnitary(alpha, y)
+					for i := range y {
+						if y[i] != want[i] {
+							t.Errorf("unexpected ScalUnitary for n=%d at %d: got:%v want:%v", n, i, y[i], want[i])
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func normSlice(rnd *rand.Rand, n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rnd.NormFloat64()
+	}
+	return s
+}
+
+func BenchmarkDispatch(b *testing.B) {
+	for _, avx2 := range []bool{false, true} {
+		if avx2 && !HasAVX2() {
+			continue
+		}
+		for _, n := range []int{100, 10000} {
+			x := make([]float64, n)
+			y := make([]float64, n)
+			for i := range x {
+				x[i] = float64(i)
+				y[i] = float64(n - i)
+			}
+			name := fmt.Sprintf("avx2=%t/n=%d", avx2, n)
+			run := func(op string, fn func()) {
+				b.Run(op+"/"+name, func(b *testing.B) {
+					defer SetAVX2(SetAVX2(avx2))
+					for i := 0; i < b.N; i++ {
+						fn()
+					}
+				})
+			}
+			run("Sum", func() { Sum(x) })
+			run("DotUnitary", func() { DotUnitary(x, y) })
+			run("AxpyUnitaryTo", func() { AxpyUnitaryTo(y, 1, x, y) })
+			run("ScalUnitary", func() { ScalUnitary(1, x) })
+		}
+	}
+}
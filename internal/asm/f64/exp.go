@@ -0,0 +1,40 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64
+
+import "math"
+
+const (
+	ln2Hi = 6.93147180369123816490e-01 // 0x3fe62e42fee00000
+	ln2Lo = 1.90821492927058770002e-10 // 0x3dea39ef35793c76
+	log2e = 1.44269504088896338700e+00 // 0x3ff71547652b82fe
+)
+
+// expFast returns e**x with a relative error less than 1e-15. Arguments
+// with magnitude greater than 708, whose results are not normal, and NaN
+// are handled by math.Exp.
+func expFast(x float64) float64 {
+	if !(math.Abs(x) <= 708) {
+		return math.Exp(x)
+	}
+	// Reduce x = k*ln2 + r with |r| <= ln2/2. Adding the shift rounds
+	// x*log2e to an integer held in the low bits of the mantissa.
+	const shift = 0x1.8p52
+	kf := x*log2e + shift
+	ki := int64(math.Float64bits(kf)) - int64(math.Float64bits(shift))
+	k := kf - shift
+	r := (x - k*ln2Hi) - k*ln2Lo
+
+	// Evaluate the Taylor series of e**r to degree 13, for which the
+	// truncation error is less than 2.5e-16 over the reduced range.
+	// The odd and even terms are evaluated independently to shorten
+	// the dependency chain.
+	r2 := r * r
+	even := 1 + r2*(1.0/2+r2*(1.0/24+r2*(1.0/720+r2*(1.0/40320+r2*(1.0/3628800+r2*(1.0/479001600))))))
+	odd := r * (1 + r2*(1.0/6+r2*(1.0/120+r2*(1.0/5040+r2*(1.0/362880+r2*(1.0/39916800+r2*(1.0/6227020800)))))))
+
+	// Scale by 2**k. Since |k| <= 1022 the scale is a normal number.
+	return (even + odd) * math.Float64frombits(uint64(ki+1023)<<52)
+}
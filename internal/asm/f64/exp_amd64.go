@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !noasm && !gccgo && !safe
+// +build !noasm,!gccgo,!safe
+
+package f64
+
+// ExpUnitaryTo is
+//
+//	for i, v := range s {
+//		dst[i] = math.Exp(v)
+//	}
+//
+// computed with a relative error less than 1e-15.
+func ExpUnitaryTo(dst, s []float64) {
+	dst = dst[:len(s)]
+	for useAVX2 && len(s) >= 4 {
+		n := expUnitaryToAVX2(dst, s)
+		dst, s = dst[n:], s[n:]
+		if len(s) < 4 {
+			break
+		}
+		// The next block holds an argument outside the range
+		// of the vector kernel.
+		for i, v := range s[:4] {
+			dst[i] = expFast(v)
+		}
+		dst, s = dst[4:], s[4:]
+	}
+	for i, v := range s {
+		dst[i] = expFast(v)
+	}
+}
+
+// expUnitaryToAVX2 stores e**s[i] in dst[i] for blocks of four elements
+// of s, stopping before the first block holding an argument that is NaN
+// or has magnitude greater than 708, and returns the number of elements
+// stored.
+func expUnitaryToAVX2(dst, s []float64) (n int)
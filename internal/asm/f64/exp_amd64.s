@@ -0,0 +1,195 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !noasm && !gccgo && !safe
+// +build !noasm,!gccgo,!safe
+
+#include "textflag.h"
+
+#define X_PTR SI
+#define DST_PTR DI
+#define IDX AX
+#define LEN CX
+#define MASK DX
+
+// Each constant is repeated to fill a YMM register.
+#define ABSMASK expdata<>+0(SB)
+#define BOUND expdata<>+32(SB)
+#define LOG2E expdata<>+64(SB)
+#define SHIFT expdata<>+96(SB)
+#define LN2HI expdata<>+128(SB)
+#define LN2LO expdata<>+160(SB)
+#define BIAS expdata<>+192(SB)
+#define C1 expdata<>+224(SB)
+#define C2 expdata<>+256(SB)
+#define C3 expdata<>+288(SB)
+#define C4 expdata<>+320(SB)
+#define C5 expdata<>+352(SB)
+#define C6 expdata<>+384(SB)
+#define C7 expdata<>+416(SB)
+#define C8 expdata<>+448(SB)
+#define C9 expdata<>+480(SB)
+#define C10 expdata<>+512(SB)
+#define C11 expdata<>+544(SB)
+#define C12 expdata<>+576(SB)
+#define C13 expdata<>+608(SB)
+
+DATA expdata<>+0(SB)/8, $0x7FFFFFFFFFFFFFFF // |x| mask
+DATA expdata<>+8(SB)/8, $0x7FFFFFFFFFFFFFFF
+DATA expdata<>+16(SB)/8, $0x7FFFFFFFFFFFFFFF
+DATA expdata<>+24(SB)/8, $0x7FFFFFFFFFFFFFFF
+DATA expdata<>+32(SB)/8, $0x4086200000000000 // 708
+DATA expdata<>+40(SB)/8, $0x4086200000000000
+DATA expdata<>+48(SB)/8, $0x4086200000000000
+DATA expdata<>+56(SB)/8, $0x4086200000000000
+DATA expdata<>+64(SB)/8, $0x3FF71547652B82FE // log2(e)
+DATA expdata<>+72(SB)/8, $0x3FF71547652B82FE
+DATA expdata<>+80(SB)/8, $0x3FF71547652B82FE
+DATA expdata<>+88(SB)/8, $0x3FF71547652B82FE
+DATA expdata<>+96(SB)/8, $0x4338000000000000 // 1.5 * 2**52
+DATA expdata<>+104(SB)/8, $0x4338000000000000
+DATA expdata<>+112(SB)/8, $0x4338000000000000
+DATA expdata<>+120(SB)/8, $0x4338000000000000
+DATA expdata<>+128(SB)/8, $0x3FE62E42FEE00000 // ln2Hi
+DATA expdata<>+136(SB)/8, $0x3FE62E42FEE00000
+DATA expdata<>+144(SB)/8, $0x3FE62E42FEE00000
+DATA expdata<>+152(SB)/8, $0x3FE62E42FEE00000
+DATA expdata<>+160(SB)/8, $0x3DEA39EF35793C76 // ln2Lo
+DATA expdata<>+168(SB)/8, $0x3DEA39EF35793C76
+DATA expdata<>+176(SB)/8, $0x3DEA39EF35793C76
+DATA expdata<>+184(SB)/8, $0x3DEA39EF35793C76
+DATA expdata<>+192(SB)/8, $0x00000000000003FF // exponent bias
+DATA expdata<>+200(SB)/8, $0x00000000000003FF
+DATA expdata<>+208(SB)/8, $0x00000000000003FF
+DATA expdata<>+216(SB)/8, $0x00000000000003FF
+DATA expdata<>+224(SB)/8, $0x3FF0000000000000 // 1
+DATA expdata<>+232(SB)/8, $0x3FF0000000000000
+DATA expdata<>+240(SB)/8, $0x3FF0000000000000
+DATA expdata<>+248(SB)/8, $0x3FF0000000000000
+DATA expdata<>+256(SB)/8, $0x3FE0000000000000 // 1/2!
+DATA expdata<>+264(SB)/8, $0x3FE0000000000000
+DATA expdata<>+272(SB)/8, $0x3FE0000000000000
+DATA expdata<>+280(SB)/8, $0x3FE0000000000000
+DATA expdata<>+288(SB)/8, $0x3FC5555555555555 // 1/3!
+DATA expdata<>+296(SB)/8, $0x3FC5555555555555
+DATA expdata<>+304(SB)/8, $0x3FC5555555555555
+DATA expdata<>+312(SB)/8, $0x3FC5555555555555
+DATA expdata<>+320(SB)/8, $0x3FA5555555555555 // 1/4!
+DATA expdata<>+328(SB)/8, $0x3FA5555555555555
+DATA expdata<>+336(SB)/8, $0x3FA5555555555555
+DATA expdata<>+344(SB)/8, $0x3FA5555555555555
+DATA expdata<>+352(SB)/8, $0x3F81111111111111 // 1/5!
+DATA expdata<>+360(SB)/8, $0x3F81111111111111
+DATA expdata<>+368(SB)/8, $0x3F81111111111111
+DATA expdata<>+376(SB)/8, $0x3F81111111111111
+DATA expdata<>+384(SB)/8, $0x3F56C16C16C16C17 // 1/6!
+DATA expdata<>+392(SB)/8, $0x3F56C16C16C16C17
+DATA expdata<>+400(SB)/8, $0x3F56C16C16C16C17
+DATA expdata<>+408(SB)/8, $0x3F56C16C16C16C17
+DATA expdata<>+416(SB)/8, $0x3F2A01A01A01A01A // 1/7!
+DATA expdata<>+424(SB)/8, $0x3F2A01A01A01A01A
+DATA expdata<>+432(SB)/8, $0x3F2A01A01A01A01A
+DATA expdata<>+440(SB)/8, $0x3F2A01A01A01A01A
+DATA expdata<>+448(SB)/8, $0x3EFA01A01A01A01A // 1/8!
+DATA expdata<>+456(SB)/8, $0x3EFA01A01A01A01A
+DATA expdata<>+464(SB)/8, $0x3EFA01A01A01A01A
+DATA expdata<>+472(SB)/8, $0x3EFA01A01A01A01A
+DATA expdata<>+480(SB)/8, $0x3EC71DE3A556C734 // 1/9!
+DATA expdata<>+488(SB)/8, $0x3EC71DE3A556C734
+DATA expdata<>+496(SB)/8, $0x3EC71DE3A556C734
+DATA expdata<>+504(SB)/8, $0x3EC71DE3A556C734
+DATA expdata<>+512(SB)/8, $0x3E927E4FB7789F5C // 1/10!
+DATA expdata<>+520(SB)/8, $0x3E927E4FB7789F5C
+DATA expdata<>+528(SB)/8, $0x3E927E4FB7789F5C
+DATA expdata<>+536(SB)/8, $0x3E927E4FB7789F5C
+DATA expdata<>+544(SB)/8, $0x3E5AE64567F544E4 // 1/11!
+DATA expdata<>+552(SB)/8, $0x3E5AE64567F544E4
+DATA expdata<>+560(SB)/8, $0x3E5AE64567F544E4
+DATA expdata<>+568(SB)/8, $0x3E5AE64567F544E4
+DATA expdata<>+576(SB)/8, $0x3E21EED8EFF8D898 // 1/12!
+DATA expdata<>+584(SB)/8, $0x3E21EED8EFF8D898
+DATA expdata<>+592(SB)/8, $0x3E21EED8EFF8D898
+DATA expdata<>+600(SB)/8, $0x3E21EED8EFF8D898
+DATA expdata<>+608(SB)/8, $0x3DE6124613A86D09 // 1/13!
+DATA expdata<>+616(SB)/8, $0x3DE6124613A86D09
+DATA expdata<>+624(SB)/8, $0x3DE6124613A86D09
+DATA expdata<>+632(SB)/8, $0x3DE6124613A86D09
+GLOBL expdata<>+0(SB), RODATA, $640
+
+// func expUnitaryToAVX2(dst, s []float64) (n int)
+//
+// The arguments are processed in blocks of 4 using the algorithm of
+// expFast. Processing stops before the first block holding an argument
+// with magnitude greater than 708 or a NaN, and the number of elements
+// processed is returned.
+TEXT ·expUnitaryToAVX2(SB), NOSPLIT, $0
+	MOVQ dst_base+0(FP), DST_PTR // DST_PTR = &dst
+	MOVQ s_base+24(FP), X_PTR    // X_PTR = &s
+	MOVQ s_len+32(FP), LEN       // LEN = len(s)
+	XORQ IDX, IDX                // i = 0
+	SHRQ $2, LEN                 // LEN = floor( n / 4 )
+	JZ   exp_end
+
+exp_loop:
+	VMOVUPD   (X_PTR)(IDX*8), Y0 // x = s[i:i+4]
+	VANDPD    ABSMASK, Y0, Y1
+	VCMPPD    $6, BOUND, Y1, Y1  // !(|x| <= 708)
+	VMOVMSKPD Y1, MASK
+	TESTQ     MASK, MASK
+	JNZ       exp_end            // if any argument is out of range { return }
+
+	// Reduce x = k*ln2 + r with |r| <= ln2/2.
+	VMULPD LOG2E, Y0, Y1
+	VADDPD SHIFT, Y1, Y1 // k is held in the low bits of Y1
+	VSUBPD SHIFT, Y1, Y2 // k
+	VMULPD LN2HI, Y2, Y3
+	VSUBPD Y3, Y0, Y0    // x - k*ln2Hi
+	VMULPD LN2LO, Y2, Y2
+	VSUBPD Y2, Y0, Y0    // r = (x - k*ln2Hi) - k*ln2Lo
+	VMULPD Y0, Y0, Y2    // r2 = r * r
+
+	// Evaluate the even and odd terms of the Taylor series.
+	VMOVUPD C12, Y3
+	VMOVUPD C13, Y4
+	VMULPD  Y2, Y3, Y3
+	VMULPD  Y2, Y4, Y4
+	VADDPD  C10, Y3, Y3
+	VADDPD  C11, Y4, Y4
+	VMULPD  Y2, Y3, Y3
+	VMULPD  Y2, Y4, Y4
+	VADDPD  C8, Y3, Y3
+	VADDPD  C9, Y4, Y4
+	VMULPD  Y2, Y3, Y3
+	VMULPD  Y2, Y4, Y4
+	VADDPD  C6, Y3, Y3
+	VADDPD  C7, Y4, Y4
+	VMULPD  Y2, Y3, Y3
+	VMULPD  Y2, Y4, Y4
+	VADDPD  C4, Y3, Y3
+	VADDPD  C5, Y4, Y4
+	VMULPD  Y2, Y3, Y3
+	VMULPD  Y2, Y4, Y4
+	VADDPD  C2, Y3, Y3
+	VADDPD  C3, Y4, Y4
+	VMULPD  Y2, Y3, Y3
+	VMULPD  Y2, Y4, Y4
+	VADDPD  C1, Y3, Y3 // even
+	VADDPD  C1, Y4, Y4
+	VMULPD  Y0, Y4, Y4 // odd
+	VADDPD  Y4, Y3, Y3 // even + odd
+
+	// Scale by 2**k.
+	VPADDQ  BIAS, Y1, Y1
+	VPSLLQ  $52, Y1, Y1
+	VMULPD  Y1, Y3, Y3
+	VMOVUPD Y3, (DST_PTR)(IDX*8) // dst[i:i+4] = e**x
+
+	ADDQ $4, IDX  // i += 4
+	DECQ LEN
+	JNZ  exp_loop // } while --LEN > 0
+
+exp_end:
+	VZEROUPPER
+	MOVQ IDX, n+48(FP)
+	RET
@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 || noasm || gccgo || safe
+// +build !amd64 noasm gccgo safe
+
+package f64
+
+// ExpUnitaryTo is
+//
+//	for i, v := range s {
+//		dst[i] = math.Exp(v)
+//	}
+//
+// computed with a relative error less than 1e-15.
+func ExpUnitaryTo(dst, s []float64) {
+	for i, v := range s {
+		dst[i] = expFast(v)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	. "gonum.org/v1/gonum/internal/asm/f64"
+)
+
+func TestExpUnitaryTo(t *testing.T) {
+	const (
+		gd  = -1.5
+		tol = 1e-15
+	)
+	special := []float64{
+		math.Inf(-1), -1000, -745, -709, -708, -1, math.Copysign(0, -1), 0,
+		1e-300, 0.5, 1, 708, 709, 710, math.Inf(1), math.NaN(),
+	}
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 40; n++ {
+		for _, off := range align1 {
+			for _, withSpecial := range []bool{false, true} {
+				s := make([]float64, n)
+				for i := range s {
+					s[i] = 1400*rnd.Float64() - 700
+					if withSpecial && rnd.IntN(5) == 0 {
+						s[i] = special[rnd.IntN(len(special))]
+					}
+				}
+				prefix := fmt.Sprintf("n=%d off=%d special=%t", n, off, withSpecial)
+
+				dst := guardVector(make([]float64, n+off), gd, 1)
+				ExpUnitaryTo(dst[1+off:len(dst)-1], s)
+				for i, v := range s {
+					if got, want := dst[1+off+i], math.Exp(v); !sameApprox(got, want, tol) {
+						t.Errorf("%s: unexpected result for exp(%v): got:%v want:%v", prefix, v, got, want)
+					}
+				}
+				if !isValidGuard(dst, gd, 1) {
+					t.Errorf("%s: guard violated", prefix)
+				}
+
+				inPlace := append([]float64(nil), s...)
+				ExpUnitaryTo(inPlace, inPlace)
+				for i, v := range inPlace {
+					if !scalar.Same(v, dst[1+off+i]) {
+						t.Errorf("%s: unexpected in place result at %d: got:%v want:%v", prefix, i, v, dst[1+off+i])
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkExpUnitaryTo(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{10, 1000} {
+		s := make([]float64, n)
+		for i := range s {
+			s[i] = 20*rnd.Float64() - 10
+		}
+		dst := make([]float64, n)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ExpUnitaryTo(dst, s)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !noasm && !gccgo && !safe
+// +build !noasm,!gccgo,!safe
+
+package f64
+
+// HasAVX2 is exported for testing.
+var HasAVX2 = hasAVX2
+
+// SetAVX2 sets whether the AVX2 implementations are used
+// and returns the previous setting.
+func SetAVX2(use bool) (old bool) {
+	old, useAVX2 = useAVX2, use
+	return old
+}
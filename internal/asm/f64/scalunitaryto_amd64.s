@@ -51,6 +51,11 @@
 // func ScalUnitaryTo(dst []float64, alpha float64, x []float64)
 // This function assumes len(dst) >= len(x).
 TEXT ·ScalUnitaryTo(SB), NOSPLIT, $0
+	CMPB ·useAVX2(SB), $0
+	JE   sse2
+	JMP  ·scalUnitaryToAVX2(SB)
+
+sse2:
 	MOVQ x_base+32(FP), X_PTR    // X_PTR = &x
 	MOVQ dst_base+0(FP), DST_PTR // DST_PTR = &dst
 	MOVDDUP_ALPHA                // ALPHA = { alpha, alpha }
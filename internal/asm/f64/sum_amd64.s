@@ -17,6 +17,11 @@
 
 // func Sum(x []float64) float64
 TEXT ·Sum(SB), NOSPLIT, $0
+	CMPB ·useAVX2(SB), $0
+	JE   sse2
+	JMP  ·sumAVX2(SB)
+
+sse2:
 	MOVQ x_base+0(FP), X_PTR // X_PTR = &x
 	MOVQ x_len+8(FP), LEN    // LEN = len(x)
 	XORQ IDX, IDX            // i = 0
@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas/blas64"
+
+// Dgbtrf computes the LU factorization of an m×n band matrix A with kl
+// sub-diagonals and ku super-diagonals using partial pivoting with row
+// interchanges. The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is a lower triangular matrix with unit
+// diagonal elements and at most kl non-zero elements below the diagonal in
+// each column, and U is an upper triangular band matrix with kl+ku
+// super-diagonals.
+//
+// On entry, the band matrix A is stored in the first kl+ku+1 columns of the
+// m×(2*kl+ku+1) matrix ab, so that A[i,j] is stored in ab[i*ldab+j-i+kl]. For
+// example, when m = n = 6, kl = 1 and ku = 2, ab contains
+//
+//	On entry:
+//	  *   a00  a01  a02   +
+//	 a10  a11  a12  a13   +
+//	 a21  a22  a23  a24   +
+//	 a32  a33  a34  a35   +
+//	 a43  a44  a45   *    +
+//	 a54  a55   *    *    +
+//
+// where the elements marked * are not used and the last kl columns, marked
+// +, are workspace for the elements of U introduced by the row interchanges
+// and need not be set on entry. On return, U is stored in the last kl+ku+1
+// columns of ab, so that U[i,j] is stored in ab[i*ldab+j-i+kl], and the
+// multipliers of L below the diagonal are stored in the first kl columns of
+// ab, so that L[i,j] for i > j is stored in ab[i*ldab+j-i+kl]. ldab must be
+// at least 2*kl+ku+1.
+//
+// ipiv contains a sequence of row interchanges. It indicates that row i of
+// the matrix was interchanged with ipiv[i]. ipiv must have length min(m,n),
+// and Dgbtrf will panic otherwise. ipiv is zero-indexed. As in Dgetrf, the
+// multipliers of L are not permuted by later interchanges, so L is
+// represented by ipiv together with the stored multipliers.
+//
+// Dgbtrf returns whether the matrix A is nonsingular. The LU decomposition
+// will be computed regardless of the singularity of A, but the result should
+// not be used to solve a system of equation.
+func (Implementation) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	kv := kl + ku
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	rows := min(m, n+kl)
+	switch {
+	case len(ab) < (rows-1)*ldab+kl+kv+1:
+		panic(shortAB)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	// Zero the workspace for the fill-in elements of U.
+	for i := 0; i < rows; i++ {
+		fill := ab[i*ldab+kv+1 : i*ldab+kl+kv+1]
+		for j := range fill {
+			fill[j] = 0
+		}
+	}
+
+	bi := blas64.Implementation()
+	ok = true
+	// ju is the index of the last column affected
+	// by the current stage of the factorization.
+	var ju int
+	for j := 0; j < mn; j++ {
+		// The elements of column j on and below the diagonal
+		// are stored with stride ldab-1 starting at A[j,j].
+		km := min(kl, m-j-1)
+		var jp int
+		if km > 0 {
+			jp = bi.Idamax(km+1, ab[j*ldab+kl:], ldab-1)
+		}
+		ipiv[j] = j + jp
+		if ab[(j+jp)*ldab+kl-jp] == 0 {
+			// The matrix is singular. Continue the
+			// factorization as Dgetrf does.
+			ok = false
+			continue
+		}
+		ju = max(ju, min(j+ku+jp, n-1))
+
+		// Apply the interchange to columns j to ju.
+		if jp != 0 {
+			bi.Dswap(ju-j+1, ab[(j+jp)*ldab+kl-jp:], 1, ab[j*ldab+kl:], 1)
+		}
+		if km > 0 {
+			// Compute the multipliers.
+			bi.Dscal(km, 1/ab[j*ldab+kl], ab[(j+1)*ldab+kl-1:], ldab-1)
+
+			// Update the trailing submatrix within the band.
+			if ju > j {
+				bi.Dger(km, ju-j, -1, ab[(j+1)*ldab+kl-1:], ldab-1, ab[j*ldab+kl+1:], 1, ab[(j+1)*ldab+kl:], ldab-1)
+			}
+		}
+	}
+	return ok
+}
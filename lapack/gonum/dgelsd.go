@@ -0,0 +1,220 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgelsd computes the minimum-norm solution to the linear least squares
+// problem
+//
+//	minimize over x |b - A*x|_2
+//
+// where A is an m×n matrix which may be rank-deficient, using the singular
+// value decomposition of A. Several right hand side vectors b and solution
+// vectors x can be handled in a single call; they are stored as the columns
+// of the m×nrhs right hand side matrix B and the n×nrhs solution matrix X.
+//
+// The effective rank of A is determined by treating as zero those singular
+// values which are less than or equal to rcond times the largest singular
+// value. If rcond is negative, machine precision is used instead. The
+// solution is then the pseudo-inverse of the rank-truncated A applied to B.
+//
+// The problem is solved in three steps. A is reduced to bidiagonal form by
+// orthogonal transformations, the singular value decomposition of the
+// bidiagonal matrix is used to solve the reduced least squares problem, and
+// the solution is transformed back to the solution of the original problem.
+// The reference implementation solves the bidiagonal problem with a divide
+// and conquer method; this implementation uses the implicit zero-shift QR
+// algorithm of Dbdsqr, which gives the same solution.
+//
+// On entry, a contains the m×n matrix A. On return, a is overwritten.
+//
+// On entry, b contains the m×nrhs right hand side matrix B and must have at
+// least max(m,n) rows. On return, the first n rows of b contain the n×nrhs
+// solution matrix X. If m >= n and the rank of A is n, the residual sum of
+// squares for the solution in the i-th column is given by the sum of squares
+// of the elements n to m-1 of that column.
+//
+// s must have length at least min(m,n) and on return contains the singular
+// values of A in decreasing order.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// 3*min(m,n) + min(m,n)² + max(4*min(m,n), m, n, nrhs) if min(m,n) > 0 and at
+// least 1 otherwise, and Dgelsd will panic otherwise. For optimal performance lwork should be larger. If
+// lwork == -1, instead of performing Dgelsd, the optimal work length will be
+// stored into work[0].
+//
+// Dgelsd returns the effective rank of A and whether the singular value
+// decomposition converged. If ok is false, the solution has not been
+// computed.
+func (impl Implementation) Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int) (rank int, ok bool) {
+	mn := min(m, n)
+	mx := max(m, n)
+	minwrk := 1
+	if mn > 0 {
+		minwrk = 3*mn + mn*mn + max(4*mn, mx, nrhs)
+	}
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case lwork < max(1, minwrk) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if mn == 0 || nrhs == 0 {
+		impl.Dlaset(blas.All, mx, nrhs, 0, 0, b, ldb)
+		work[0] = float64(minwrk)
+		return 0, true
+	}
+
+	// Compute the optimal workspace from the
+	// requirements of the reduction to bidiagonal
+	// form and of the application of Q and P.
+	ie := 0
+	itauq := ie + mn
+	itaup := itauq + mn
+	ivt := itaup + mn
+	iwork := ivt + mn*mn
+	impl.Dgebrd(m, n, a, lda, nil, nil, nil, nil, work, -1)
+	lwkopt := int(work[0])
+	impl.Dormbr(lapack.ApplyQ, blas.Left, blas.Trans, m, nrhs, n, a, lda, nil, b, ldb, work, -1)
+	lwkopt = max(lwkopt, int(work[0]))
+	impl.Dormbr(lapack.ApplyP, blas.Left, blas.NoTrans, n, nrhs, m, a, lda, nil, b, ldb, work, -1)
+	lwkopt = max(iwork+max(lwkopt, int(work[0])), minwrk)
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (mx-1)*ldb+nrhs:
+		panic(shortB)
+	case len(s) < mn:
+		panic(shortS)
+	}
+
+	// Scale the input matrices if they contain extreme values.
+	smlnum := dlamchS / dlamchP
+	bignum := 1 / smlnum
+	anrm := impl.Dlange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iascl int
+	switch {
+	case anrm == 0:
+		// A is all zeros, so the minimum-norm solution is zero.
+		impl.Dlaset(blas.All, mx, nrhs, 0, 0, b, ldb)
+		for i := range s[:mn] {
+			s[i] = 0
+		}
+		work[0] = float64(lwkopt)
+		return 0, true
+	case anrm < smlnum:
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+		iascl = 1
+	case anrm > bignum:
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+		iascl = 2
+	}
+	bnrm := impl.Dlange(lapack.MaxAbs, m, nrhs, b, ldb, nil)
+	var ibscl int
+	switch {
+	case bnrm > 0 && bnrm < smlnum:
+		impl.Dlascl(lapack.General, 0, 0, bnrm, smlnum, m, nrhs, b, ldb)
+		ibscl = 1
+	case bnrm > bignum:
+		impl.Dlascl(lapack.General, 0, 0, bnrm, bignum, m, nrhs, b, ldb)
+		ibscl = 2
+	}
+	// Clear the rows of B that are used only for the solution.
+	if m < n {
+		impl.Dlaset(blas.All, n-m, nrhs, 0, 0, b[m*ldb:], ldb)
+	}
+
+	// Reduce A to bidiagonal form A = Q * B * Pᵀ,
+	// which is upper bidiagonal if m >= n and lower
+	// bidiagonal otherwise, and compute Qᵀ * B.
+	impl.Dgebrd(m, n, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+	impl.Dormbr(lapack.ApplyQ, blas.Left, blas.Trans, m, nrhs, n, a, lda, work[itauq:itauq+mn], b, ldb, work[iwork:], lwork-iwork)
+
+	// Compute the singular value decomposition of the
+	// bidiagonal matrix, B = U * S * Vᵀ, overwriting
+	// the first mn rows of Qᵀ * B with Uᵀ * Qᵀ * B.
+	uplo := blas.Upper
+	if m < n {
+		uplo = blas.Lower
+	}
+	vt := work[ivt : ivt+mn*mn]
+	impl.Dlaset(blas.All, mn, mn, 0, 1, vt, mn)
+	ok = impl.Dbdsqr(uplo, mn, mn, 0, nrhs, s, work[ie:], vt, mn, nil, 1, b, ldb, work[iwork:])
+	if !ok {
+		return 0, false
+	}
+
+	// Multiply by the pseudo-inverse of S, treating the
+	// singular values below the threshold as zero.
+	thr := rcond
+	if thr < 0 {
+		thr = dlamchE
+	}
+	thr = max(thr*s[0], dlamchS)
+	for i := 0; i < mn; i++ {
+		row := b[i*ldb : i*ldb+nrhs]
+		if s[i] > thr {
+			impl.Drscl(nrhs, s[i], row, 1)
+			rank++
+		} else {
+			for j := range row {
+				row[j] = 0
+			}
+		}
+	}
+
+	// Multiply by V, column by column.
+	bi := blas64.Implementation()
+	tmp := work[iwork : iwork+mn]
+	for j := 0; j < nrhs; j++ {
+		bi.Dgemv(blas.Trans, mn, mn, 1, vt, mn, b[j:], ldb, 0, tmp, 1)
+		bi.Dcopy(mn, tmp, 1, b[j:], ldb)
+	}
+
+	// Back-transform the solution of the bidiagonal
+	// problem to the solution of the original problem.
+	impl.Dormbr(lapack.ApplyP, blas.Left, blas.NoTrans, n, nrhs, m, a, lda, work[itaup:itaup+mn], b, ldb, work[iwork:], lwork-iwork)
+
+	// Undo the scaling.
+	switch iascl {
+	case 1:
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, n, nrhs, b, ldb)
+		impl.Dlascl(lapack.General, 0, 0, smlnum, anrm, mn, 1, s, 1)
+	case 2:
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, n, nrhs, b, ldb)
+		impl.Dlascl(lapack.General, 0, 0, bignum, anrm, mn, 1, s, 1)
+	}
+	switch ibscl {
+	case 1:
+		impl.Dlascl(lapack.General, 0, 0, smlnum, bnrm, n, nrhs, b, ldb)
+	case 2:
+		impl.Dlascl(lapack.General, 0, 0, bignum, bnrm, n, nrhs, b, ldb)
+	}
+
+	work[0] = float64(lwkopt)
+	return rank, true
+}
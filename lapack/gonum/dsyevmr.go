@@ -0,0 +1,1052 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+const (
+	// mrrrMaxDepth is the maximum depth of the tree of
+	// representations used to resolve clusters of eigenvalues.
+	mrrrMaxDepth = 8
+
+	// mrrrMinRelGap is the relative gap below which
+	// eigenvalues are treated as a cluster.
+	mrrrMinRelGap = 1e-3
+
+	// mrrrMaxGrowth is the maximum element growth of a shifted
+	// representation relative to the spectral diameter.
+	mrrrMaxGrowth = 8
+)
+
+// Dsyevmr computes selected eigenvalues and, optionally, eigenvectors of an n×n
+// real symmetric matrix A. A is reduced to tridiagonal form and the
+// eigenvectors of the tridiagonal matrix are computed by a variant of the
+// method of Multiple Relatively Robust Representations (MRRR), which computes
+// k eigenvectors in O(n*k) operations after the reduction.
+//
+// Dsyevmr is a Gonum routine with no counterpart in reference LAPACK. Its
+// tridiagonal eigensolver is not a port of the reference dstemr and dlarrv,
+// and its results may differ from those of dsyevr. Its signature also differs
+// from dsyevr: there are no isuppz or liwork parameters, iwork has a fixed
+// length and abstol is only used when eigenvectors are not computed.
+//
+// rng specifies the eigenvalues that are computed:
+//   - lapack.EVRangeAll: all eigenvalues,
+//   - lapack.EVRangeValue: the eigenvalues in the half-open interval (vl,vu],
+//   - lapack.EVRangeIndex: the il-th through iu-th smallest eigenvalues,
+//     counting from zero.
+//
+// If rng == lapack.EVRangeValue, vl must be less than vu. If
+// rng == lapack.EVRangeIndex, il and iu must satisfy 0 <= il <= iu < n when
+// n > 0, and il == 0 and iu == -1 when n == 0. Otherwise vl, vu, il and iu
+// are not referenced.
+//
+// On entry, a contains the elements of the symmetric matrix A in the
+// triangular portion specified by uplo. On return, that portion of a is
+// overwritten.
+//
+// abstol is the absolute tolerance to which eigenvalues are computed by
+// bisection when eigenvectors are not wanted. If abstol is not positive, a
+// tolerance of eps*|T| is used, where T is the tridiagonal matrix similar to A.
+// The eigenvalues returned with eigenvectors are always computed to high
+// relative accuracy with respect to the tridiagonal matrix.
+//
+// Dsyevmr returns the number of eigenvalues found, m, and the first m elements
+// of w contain them in ascending order. w must have length at least n.
+//
+// If jobz == lapack.EVCompute, the first m columns of the n×ncol matrix Z
+// contain the orthonormal eigenvectors of A corresponding to the returned
+// eigenvalues, where ncol is iu-il+1 if rng == lapack.EVRangeIndex and n
+// otherwise. ldz must be at least max(1,ncol) and len(z) at least
+// (n-1)*ldz+ncol. If jobz == lapack.EVNone, z is not referenced.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= max(1,30*n), and Dsyevmr will panic otherwise. If
+// lwork == -1, instead of computing Dsyevmr the optimal work length is stored
+// into work[0]. iwork must have length at least 4*n.
+//
+// ok is false if the computation of some eigenvalues or eigenvectors failed to
+// converge. In that case m is still the number of selected eigenvalues and the
+// first m elements of w hold approximations to them in ascending order, but
+// some of the eigenvalues and, if computed, the corresponding eigenvectors in
+// z are inaccurate.
+func (impl Implementation) Dsyevmr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork []int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	ncol := n
+	if rng == lapack.EVRangeIndex {
+		ncol = iu - il + 1
+	}
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case rng == lapack.EVRangeValue && !(vl < vu):
+		panic(notVlLTVu)
+	case rng == lapack.EVRangeIndex && (il < 0 || il > max(0, n-1)):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(il, n-1) || iu > n-1):
+		panic(badIu)
+	case wantz && ldz < max(1, ncol):
+		panic(badLdZ)
+	case lwork < max(1, 30*n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0, true
+	}
+
+	var opts string
+	if uplo == blas.Upper {
+		opts = "U"
+	} else {
+		opts = "L"
+	}
+	nb := impl.Ilaenv(1, "DSYTRD", opts, n, -1, -1, -1)
+	lworkopt := max(30*n, (nb+3)*n)
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	case len(iwork) < 4*n:
+		panic(shortIWork)
+	case wantz && len(z) < (n-1)*ldz+ncol:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		work[0] = 30
+		if rng == lapack.EVRangeValue && (a[0] <= vl || vu < a[0]) {
+			return 0, true
+		}
+		w[0] = a[0]
+		if wantz {
+			z[0] = 1
+		}
+		return 1, true
+	}
+
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Min(math.Sqrt(bignum), 1/math.Sqrt(math.Sqrt(safmin)))
+
+	// Scale matrix to allowable range, if necessary.
+	anrm := impl.Dlansy(lapack.MaxAbs, uplo, n, a, lda, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Dlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+		abstol *= sigma
+		if rng == lapack.EVRangeValue {
+			vl *= sigma
+			vu *= sigma
+		}
+	}
+
+	// Reduce A to tridiagonal form T = Qᵀ * A * Q.
+	d := work[:n]
+	e := work[n : 2*n]
+	tau := work[2*n : 3*n]
+	impl.Dsytrd(uplo, n, a, lda, d, e, tau, work[3*n:], lwork-3*n)
+
+	bi := blas64.Implementation()
+	if rng == lapack.EVRangeAll && !wantz {
+		copy(w, d)
+		ok = impl.Dsterf(n, w, e)
+		if !ok {
+			// Dsterf does not sort the eigenvalues when
+			// it fails to converge.
+			impl.Dlasrt(lapack.SortIncreasing, n, w)
+		}
+		if scaled {
+			bi.Dscal(n, 1/sigma, w, 1)
+		}
+		work[0] = float64(lworkopt)
+		return n, ok
+	}
+
+	// Split T into unreduced blocks at negligible off-diagonal elements.
+	tnrm := impl.Dlanst(lapack.MaxColumnSum, n, d, e)
+	var emax2 float64
+	for i := 0; i < n-1; i++ {
+		if math.Abs(e[i]) <= eps*tnrm {
+			e[i] = 0
+		}
+		emax2 = math.Max(emax2, e[i]*e[i])
+	}
+	pivmin := safmin * math.Max(1, emax2)
+	atol := abstol
+	if atol <= 0 {
+		atol = eps * tnrm
+	}
+
+	// Find the window (wl,wu] of eigenvalues of T to compute.
+	gl, gu := gershgorin(d, e, pivmin)
+	var wl, wu float64
+	switch rng {
+	case lapack.EVRangeAll:
+		wl, wu = gl, gu
+	case lapack.EVRangeValue:
+		wl, wu = vl, vu
+	case lapack.EVRangeIndex:
+		wl, _ = bisectTridiag(d, e, il, gl, gu, atol, pivmin)
+		_, wu = bisectTridiag(d, e, iu, gl, gu, atol, pivmin)
+	}
+	total := sturmCount(d, e, wu, pivmin) - sturmCount(d, e, wl, pivmin)
+	switch rng {
+	case lapack.EVRangeAll:
+		m = n
+	case lapack.EVRangeValue:
+		m = total
+	case lapack.EVRangeIndex:
+		m = iu - il + 1
+	}
+	if m == 0 {
+		work[0] = float64(lworkopt)
+		return 0, true
+	}
+
+	// Count the eigenvalues of each unreduced block in the window,
+	// storing the index of the first and the number of eigenvalues in
+	// first and count. If eigenvectors are not wanted, or if eigenvalues
+	// tied at the ends of an index range must be resolved, compute the
+	// eigenvalues in the window by bisection, storing their blocks in blk.
+	blk := iwork[:n]
+	first := iwork[n : 2*n]
+	count := iwork[2*n : 3*n]
+	bisect := !wantz || total > m
+	var pos, nblk int
+	for b0 := 0; b0 < n; nblk++ {
+		b1 := blockEnd(e, b0)
+		db, eb := d[b0:b1+1], e[b0:b1]
+		first[nblk] = sturmCount(db, eb, wl, pivmin)
+		count[nblk] = sturmCount(db, eb, wu, pivmin) - first[nblk]
+		if bisect {
+			lo, hi := gershgorin(db, eb, pivmin)
+			for k := first[nblk]; k < first[nblk]+count[nblk]; k++ {
+				var up float64
+				lo, up = bisectTridiag(db, eb, k, lo, hi, atol, pivmin)
+				w[pos] = lo + (up-lo)/2
+				blk[pos] = nblk
+				pos++
+			}
+		}
+		b0 = b1 + 1
+	}
+	if total > m {
+		// Select the eigenvalues with the wanted indices, keeping
+		// the eigenvalues of each block in order.
+		start := il - sturmCount(d, e, wl, pivmin)
+		sort.Stable(blockEigenvalues{w: w[:total], blk: blk[:total]})
+		for b := 0; b < nblk; b++ {
+			count[b] = 0
+		}
+		for i := 0; i < start; i++ {
+			first[blk[i]]++
+		}
+		for i := start; i < start+m; i++ {
+			count[blk[i]]++
+		}
+		copy(w, w[start:start+m])
+	}
+	if !wantz {
+		impl.Dlasrt(lapack.SortIncreasing, m, w)
+		if scaled {
+			bi.Dscal(m, 1/sigma, w, 1)
+		}
+		work[0] = float64(lworkopt)
+		return m, true
+	}
+
+	// Compute the eigenpairs of each block with wanted eigenvalues
+	// by the MRRR algorithm.
+	impl.Dlaset(blas.All, n, m, 0, 0, z, ldz)
+	reps := work[6*n+1 : 6*n+1+2*n*(mrrrMaxDepth+1)]
+	scratch := work[6*n+1+2*n*(mrrrMaxDepth+1):]
+	ok = true
+	pos, nblk = 0, 0
+	for b0 := 0; b0 < n; nblk++ {
+		b1 := blockEnd(e, b0)
+		if count[nblk] > 0 {
+			if b0 == b1 {
+				w[pos] = d[b0]
+				z[b0*ldz+pos] = 1
+			} else {
+				size := b1 - b0 + 1
+				s := mrrr{
+					d:      d[b0 : b1+1],
+					e:      e[b0:b1],
+					pivmin: pivmin,
+					s0:     first[nblk],
+					s1:     first[nblk] + count[nblk] - 1,
+					lo:     work[3*n : 4*n],
+					hi:     work[4*n : 5*n],
+					rg:     work[5*n : 6*n+1],
+					lp:     scratch[:size],
+					sv:     scratch[n : n+size],
+					um:     scratch[2*n : 2*n+size],
+					pv:     scratch[3*n : 3*n+size],
+					zv:     scratch[4*n : 4*n+size],
+					piv:    iwork[3*n : 3*n+size],
+					w:      w[pos:],
+					z:      z[b0*ldz+pos:],
+					ldz:    ldz,
+					ok:     true,
+				}
+				for l := range s.repD {
+					s.repD[l] = reps[2*l*n : 2*l*n+size]
+					s.repL[l] = reps[(2*l+1)*n : (2*l+1)*n+size]
+				}
+				s.solve()
+				ok = ok && s.ok
+			}
+			pos += count[nblk]
+		}
+		b0 = b1 + 1
+	}
+
+	// Sort the eigenvalues in increasing order, with their eigenvectors.
+	for i := 0; i < m-1; i++ {
+		k := i
+		for j := i + 1; j < m; j++ {
+			if w[j] < w[k] {
+				k = j
+			}
+		}
+		if k != i {
+			w[i], w[k] = w[k], w[i]
+			bi.Dswap(n, z[i:], ldz, z[k:], ldz)
+		}
+	}
+
+	// Back-transform the eigenvectors of T to those of A by applying
+	// the elementary reflectors of Q stored in a.
+	v := work[3*n : 3*n+m]
+	if uplo == blas.Upper {
+		// Q = H_{n-2} * ... * H_0, where H_i has v[i] = 1 and
+		// v[0:i] stored in A[0:i,i+1].
+		for i := 0; i < n-1; i++ {
+			aii := a[i*lda+i+1]
+			a[i*lda+i+1] = 1
+			impl.Dlarf(blas.Left, i+1, m, a[i+1:], lda, tau[i], z, ldz, v)
+			a[i*lda+i+1] = aii
+		}
+	} else {
+		// Q = H_0 * ... * H_{n-2}, where H_i has v[i+1] = 1 and
+		// v[i+2:n] stored in A[i+2:n,i].
+		for i := n - 2; i >= 0; i-- {
+			aii := a[(i+1)*lda+i]
+			a[(i+1)*lda+i] = 1
+			impl.Dlarf(blas.Left, n-i-1, m, a[(i+1)*lda+i:], lda, tau[i], z[(i+1)*ldz:], ldz, v)
+			a[(i+1)*lda+i] = aii
+		}
+	}
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		bi.Dscal(m, 1/sigma, w, 1)
+	}
+	work[0] = float64(lworkopt)
+	return m, ok
+}
+
+// blockEnd returns the index of the last row of the unreduced block of a
+// symmetric tridiagonal matrix with off-diagonal e that starts at row b0.
+func blockEnd(e []float64, b0 int) int {
+	b1 := b0
+	for b1 < len(e) && e[b1] != 0 {
+		b1++
+	}
+	return b1
+}
+
+// gershgorin returns an interval containing the eigenvalues of the symmetric
+// tridiagonal matrix with diagonal d and off-diagonal e, widened to allow for
+// rounding errors in Sturm counts.
+func gershgorin(d, e []float64, pivmin float64) (gl, gu float64) {
+	n := len(d)
+	gl, gu = d[0], d[0]
+	for i, di := range d {
+		var r float64
+		if i > 0 {
+			r += math.Abs(e[i-1])
+		}
+		if i < n-1 {
+			r += math.Abs(e[i])
+		}
+		gl = math.Min(gl, di-r)
+		gu = math.Max(gu, di+r)
+	}
+	fudge := 2.1*dlamchP*math.Max(math.Abs(gl), math.Abs(gu))*float64(n) + 4.2*pivmin
+	return gl - fudge, gu + fudge
+}
+
+// sturmCount returns the number of eigenvalues of the symmetric tridiagonal
+// matrix with diagonal d and off-diagonal e that are less than or equal to x.
+func sturmCount(d, e []float64, x, pivmin float64) int {
+	var cnt int
+	q := d[0] - x
+	for i := 0; ; i++ {
+		if math.Abs(q) < pivmin {
+			q = -pivmin
+		}
+		if q <= 0 {
+			cnt++
+		}
+		if i == len(d)-1 {
+			return cnt
+		}
+		q = d[i+1] - x - e[i]*e[i]/q
+	}
+}
+
+// bisectTridiag returns an interval (lo,hi] of width at most about atol
+// containing the k-th smallest eigenvalue of the symmetric tridiagonal matrix
+// with diagonal d and off-diagonal e. On entry, (lo,hi] must contain the
+// eigenvalue.
+func bisectTridiag(d, e []float64, k int, lo, hi, atol, pivmin float64) (float64, float64) {
+	for {
+		if hi-lo <= atol+2*dlamchP*math.Max(math.Abs(lo), math.Abs(hi)) {
+			return lo, hi
+		}
+		mid := lo + (hi-lo)/2
+		if mid == lo || mid == hi {
+			return lo, hi
+		}
+		if sturmCount(d, e, mid, pivmin) > k {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+}
+
+// blockEigenvalues sorts eigenvalues with the indices of their blocks.
+type blockEigenvalues struct {
+	w   []float64
+	blk []int
+}
+
+func (b blockEigenvalues) Len() int           { return len(b.w) }
+func (b blockEigenvalues) Less(i, j int) bool { return b.w[i] < b.w[j] }
+func (b blockEigenvalues) Swap(i, j int) {
+	b.w[i], b.w[j] = b.w[j], b.w[i]
+	b.blk[i], b.blk[j] = b.blk[j], b.blk[i]
+}
+
+// mrrr holds the state of the computation of eigenpairs of an unreduced
+// symmetric tridiagonal block T by the MRRR algorithm.
+//
+// The eigenvalues are computed to high relative accuracy by bisection in a
+// root representation L*D*Lᵀ = T - sigma*I that determines them to high
+// relative accuracy. Eigenvalues with large relative gaps have their
+// eigenvectors computed from twisted factorizations of the representation.
+// Clusters of eigenvalues are shifted close to one end to obtain a child
+// representation in which their relative gaps are large, recursively
+// forming a tree of representations.
+type mrrr struct {
+	// d and e are the diagonal and off-diagonal of T.
+	d, e   []float64
+	pivmin float64
+	spdiam float64
+	tnrm   float64
+
+	// s0 and s1 are the indices of the first and last wanted
+	// eigenvalues. lo and hi hold the intervals containing the
+	// eigenvalues with indices from k0, relative to the shift of the
+	// representation in which they were last refined, and rg[j] holds
+	// the gap between the eigenvalues with indices k0+j-1 and k0+j.
+	s0, s1, k0 int
+	lo, hi, rg []float64
+
+	// repD and repL hold the factors of the representations
+	// of T - sigma*I on each level of the tree.
+	repD, repL [mrrrMaxDepth + 1][]float64
+	sigma      [mrrrMaxDepth + 1]float64
+
+	// lp, sv, um, pv and zv are scratch space for twisted
+	// factorizations and inverse iteration.
+	lp, sv, um, pv, zv []float64
+	piv                []int
+
+	// w and z hold the wanted eigenvalues and
+	// the rows of T of the wanted eigenvectors.
+	w   []float64
+	z   []float64
+	ldz int
+
+	// failed indicates that no suitable representation was found
+	// for some eigenvalue, and ok that inverse iteration converged.
+	failed bool
+	ok     bool
+}
+
+// solve computes the wanted eigenpairs of T.
+func (s *mrrr) solve() {
+	n := len(s.d)
+	eps := dlamchP
+	gl, gu := gershgorin(s.d, s.e, s.pivmin)
+	s.spdiam = gu - gl
+	s.tnrm = math.Max(math.Abs(gl), math.Abs(gu))
+
+	// Choose the root representation with the shift just outside the
+	// end of the spectrum nearer to the wanted eigenvalues.
+	left := s.s0+s.s1 < n
+	var lambda float64
+	if left {
+		lambda, _ = bisectTridiag(s.d, s.e, 0, gl, gu, eps*s.spdiam, s.pivmin)
+	} else {
+		_, lambda = bisectTridiag(s.d, s.e, n-1, gl, gu, eps*s.spdiam, s.pivmin)
+	}
+	tau := math.Max(s.spdiam*eps*float64(n)+2*s.pivmin, 2*eps*math.Abs(lambda))
+	for try := 0; ; try++ {
+		sigma := lambda - tau
+		if !left {
+			sigma = lambda + tau
+		}
+		if s.root(sigma, left) {
+			break
+		}
+		if try == 64 {
+			// Shifting outside the Gershgorin interval
+			// gives a definite matrix.
+			if left {
+				s.root(gl-tau, left)
+			} else {
+				s.root(gu+tau, left)
+			}
+			break
+		}
+		tau *= 2
+	}
+
+	// Compute the wanted eigenvalues and their neighbors to high
+	// relative accuracy in the root representation.
+	s.k0 = max(0, s.s0-1)
+	k1 := min(n-1, s.s1+1)
+	size := k1 - s.k0 + 1
+	s.lo = s.lo[:size]
+	s.hi = s.hi[:size]
+	s.rg = s.rg[:size+1]
+	lo := gl - s.sigma[0]
+	for j := range s.lo {
+		s.lo[j] = lo
+		s.hi[j] = gu - s.sigma[0]
+		s.refine(0, s.k0+j)
+		lo = s.lo[j]
+	}
+	s.rg[0] = math.Inf(1)
+	s.rg[size] = math.Inf(1)
+	s.gaps(0, size-1)
+
+	s.process(0, s.k0, k1)
+	if s.failed {
+		s.inverse()
+	}
+}
+
+// root computes the root representation L*D*Lᵀ = T - sigma*I, returning
+// whether it is positive definite if left is true and negative definite
+// otherwise.
+func (s *mrrr) root(sigma float64, left bool) bool {
+	D, L := s.repD[0], s.repL[0]
+	dd := s.d[0] - sigma
+	for i := 0; ; i++ {
+		if !(left && dd > 0 || !left && dd < 0) || math.IsInf(dd, 0) {
+			return false
+		}
+		D[i] = dd
+		if i == len(s.d)-1 {
+			break
+		}
+		L[i] = s.e[i] / dd
+		dd = s.d[i+1] - sigma - L[i]*s.e[i]
+	}
+	s.sigma[0] = sigma
+	return true
+}
+
+// count returns the number of eigenvalues of the representation on level l
+// that are less than x.
+func (s *mrrr) count(l int, x float64) int {
+	D, L := s.repD[l], s.repL[l]
+	n := len(D)
+	var cnt int
+	t := -x
+	for i := 0; i < n-1; i++ {
+		dp := D[i] + t
+		if dp < 0 {
+			cnt++
+		}
+		tmp := t / dp
+		if math.IsNaN(tmp) {
+			// A zero pivot followed by an infinite one.
+			tmp = 1
+		}
+		t = tmp*L[i]*L[i]*D[i] - x
+	}
+	if D[n-1]+t < 0 {
+		cnt++
+	}
+	return cnt
+}
+
+// refine computes the interval containing the eigenvalue with index k of the
+// representation on level l to high relative accuracy, starting from the
+// stored interval.
+func (s *mrrr) refine(l, k int) {
+	eps := dlamchP
+	j := k - s.k0
+	lo, hi := s.lo[j], s.hi[j]
+
+	// Widen the interval until it contains the eigenvalue.
+	wid := math.Max(math.Max(hi-lo, 4*eps*math.Max(math.Abs(lo), math.Abs(hi))), s.pivmin)
+	for s.count(l, lo) > k {
+		lo -= wid
+		wid *= 2
+	}
+	wid = math.Max(math.Max(hi-lo, 4*eps*math.Max(math.Abs(lo), math.Abs(hi))), s.pivmin)
+	for s.count(l, hi) <= k {
+		hi += wid
+		wid *= 2
+	}
+
+	for it := 0; it < 200; it++ {
+		if hi-lo <= 4*eps*math.Max(math.Abs(lo), math.Abs(hi)) {
+			break
+		}
+		mid := lo + (hi-lo)/2
+		if mid == lo || mid == hi {
+			break
+		}
+		if s.count(l, mid) <= k {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	s.lo[j], s.hi[j] = lo, hi
+}
+
+// gaps updates the gaps between the eigenvalues in the entries j0 to j1.
+func (s *mrrr) gaps(j0, j1 int) {
+	for j := j0 + 1; j <= j1; j++ {
+		s.rg[j] = math.Max(0, s.lo[j]-s.hi[j-1])
+	}
+}
+
+// process computes the wanted eigenpairs for the eigenvalues with indices
+// a to b, refined in the representation on level l.
+func (s *mrrr) process(l, a, b int) {
+	c0 := a
+	for k := a; k <= b && !s.failed; k++ {
+		j := k - s.k0
+		mid := s.lo[j] + (s.hi[j]-s.lo[j])/2
+		if k < b && s.rg[j+1] < mrrrMinRelGap*math.Abs(mid) {
+			continue
+		}
+		s.cluster(l, c0, k)
+		c0 = k + 1
+	}
+}
+
+// cluster computes the wanted eigenpairs for the cluster of eigenvalues
+// with indices c0 to c1 in the representation on level l.
+func (s *mrrr) cluster(l, c0, c1 int) {
+	if c1 < s.s0 || s.s1 < c0 {
+		return
+	}
+	if c0 == c1 {
+		s.failed = !s.singleton(l, c0)
+		return
+	}
+	if l == mrrrMaxDepth || !s.child(l, c0, c1) {
+		s.failed = true
+		return
+	}
+	s.process(l+1, c0, c1)
+}
+
+// child computes the representation on level l+1 by shifting the
+// representation on level l close to an end of the cluster of eigenvalues
+// with indices c0 to c1, and refines the eigenvalues of the cluster in the
+// new representation. The shift with the smallest element growth among those
+// tried is used, and child returns false if its growth is too large.
+func (s *mrrr) child(l, c0, c1 int) bool {
+	eps := dlamchP
+	j0, j1 := c0-s.k0, c1-s.k0
+	left, right := s.lo[j0], s.hi[j1]
+	width := right - left
+	avgap := width / float64(c1-c0)
+	lgap, rgap := s.rg[j0], s.rg[j1+1]
+	bound := mrrrMaxGrowth * s.spdiam
+	best, bestGrowth := 0.0, math.Inf(1)
+	for try := 0; try < 3; try++ {
+		var ldelta, rdelta float64
+		switch try {
+		case 0:
+			ldelta = 4*eps*math.Abs(left) + 2*s.pivmin
+			rdelta = 4*eps*math.Abs(right) + 2*s.pivmin
+		case 1:
+			ldelta = math.Min(avgap/2, lgap/4)
+			rdelta = math.Min(avgap/2, rgap/4)
+		case 2:
+			ldelta = math.Min(width, lgap/2)
+			rdelta = math.Min(width, rgap/2)
+		}
+		if g := s.shift(l, left-ldelta); g < bestGrowth {
+			best, bestGrowth = left-ldelta, g
+		}
+		if g := s.shift(l, right+rdelta); g < bestGrowth {
+			best, bestGrowth = right+rdelta, g
+		}
+		if bestGrowth <= bound {
+			break
+		}
+	}
+	// Moderate element growth is accepted if no
+	// shift satisfies the stricter bound.
+	if bestGrowth > s.spdiam/math.Sqrt(eps) {
+		return false
+	}
+	tau := best
+	s.shift(l, tau)
+	s.sigma[l+1] = s.sigma[l] + tau
+	for j := j0; j <= j1; j++ {
+		s.lo[j] -= tau
+		s.hi[j] -= tau
+		s.refine(l+1, s.k0+j)
+	}
+	s.gaps(j0, j1)
+	return true
+}
+
+// shift computes the representation on level l+1 as the factorization of the
+// representation on level l shifted by tau, returning the element growth. The
+// returned growth is infinite if the factorization is not finite.
+func (s *mrrr) shift(l int, tau float64) (growth float64) {
+	D, L := s.repD[l], s.repL[l]
+	Dc, Lc := s.repD[l+1], s.repL[l+1]
+	n := len(D)
+	t := -tau
+	for i := 0; i < n-1; i++ {
+		dp := D[i] + t
+		Dc[i] = dp
+		Lc[i] = L[i] * D[i] / dp
+		t = Lc[i]*L[i]*t - tau
+		growth = math.Max(growth, math.Abs(dp))
+	}
+	Dc[n-1] = D[n-1] + t
+	growth = math.Max(growth, math.Abs(Dc[n-1]))
+	if math.IsNaN(growth) {
+		return math.Inf(1)
+	}
+	return growth
+}
+
+// singleton computes the eigenpair for the eigenvalue with index k, which
+// has a large relative gap in the representation on level l, by Rayleigh
+// quotient iteration with twisted factorizations. singleton returns false if
+// the computation failed.
+func (s *mrrr) singleton(l, k int) bool {
+	const maxIter = 20
+	eps := dlamchP
+	n := len(s.d)
+	j := k - s.k0
+	lo, hi := s.lo[j], s.hi[j]
+	gap := math.Min(s.rg[j], s.rg[j+1])
+	var gaptol float64
+	if !math.IsInf(gap, 1) {
+		gaptol = gap * eps
+	}
+	tol := 4 * math.Log(float64(n)) * eps
+	lambda := lo + (hi-lo)/2
+	var ztz, rqcorr float64
+	for it := 0; ; it++ {
+		var gamma float64
+		gamma, ztz = s.twisted(l, lambda, gaptol)
+		if math.IsNaN(gamma) || math.IsNaN(ztz) || math.IsInf(ztz, 0) {
+			return false
+		}
+		rqcorr = gamma / ztz
+		resid := math.Abs(gamma) / math.Sqrt(ztz)
+		if resid <= tol*gap || math.Abs(rqcorr) <= 4*eps*math.Abs(lambda) || it == maxIter {
+			break
+		}
+		if s.count(l, lambda) <= k {
+			lo = lambda
+		} else {
+			hi = lambda
+		}
+		if next := lambda + rqcorr; lo < next && next < hi {
+			lambda = next
+		} else {
+			lambda = lo + (hi-lo)/2
+		}
+	}
+	if next := lambda + rqcorr; lo <= next && next <= hi {
+		lambda = next
+	}
+
+	col := k - s.s0
+	scale := 1 / math.Sqrt(ztz)
+	for i, v := range s.zv {
+		s.z[i*s.ldz+col] = scale * v
+	}
+	s.w[col] = s.sigma[l] + lambda
+	return true
+}
+
+// twisted computes an approximate eigenvector of the representation on level
+// l for the eigenvalue approximation lambda from the twisted factorization
+// with the smallest twist element, storing it in zv with unit element at the
+// twist index. Elements of the vector are truncated to zero once they become
+// negligible relative to gaptol. twisted returns the twist element and the
+// squared norm of the vector.
+func (s *mrrr) twisted(l int, lambda, gaptol float64) (gamma, ztz float64) {
+	D, L := s.repD[l], s.repL[l]
+	n := len(D)
+
+	// Stationary transform L*D*Lᵀ - lambda*I = L₊*D₊*L₊ᵀ from the top.
+	t := -lambda
+	for i := 0; i < n-1; i++ {
+		s.sv[i] = t
+		dp := D[i] + t
+		if math.Abs(dp) < s.pivmin {
+			dp = -s.pivmin
+		}
+		s.lp[i] = L[i] * D[i] / dp
+		t = s.lp[i]*L[i]*t - lambda
+	}
+	s.sv[n-1] = t
+
+	// Progressive transform L*D*Lᵀ - lambda*I = U₋*D₋*U₋ᵀ from the bottom.
+	p := D[n-1] - lambda
+	s.pv[n-1] = p
+	for i := n - 2; i >= 0; i-- {
+		dm := L[i]*L[i]*D[i] + p
+		if math.Abs(dm) < s.pivmin {
+			dm = -s.pivmin
+		}
+		tmp := D[i] / dm
+		s.um[i] = L[i] * tmp
+		p = p*tmp - lambda
+		s.pv[i] = p
+	}
+
+	// Choose the twist index with the smallest twist element.
+	r := 0
+	gamma = s.sv[0] + s.pv[0] + lambda
+	for i := 1; i < n; i++ {
+		g := s.sv[i] + s.pv[i] + lambda
+		if math.Abs(g) < math.Abs(gamma) {
+			gamma = g
+			r = i
+		}
+	}
+
+	z := s.zv
+	z[r] = 1
+	ztz = 1
+	i := r - 1
+	for ; i >= 0; i-- {
+		if z[i+1] != 0 {
+			z[i] = -s.lp[i] * z[i+1]
+		} else {
+			z[i] = -(L[i+1] * D[i+1] / (L[i] * D[i])) * z[i+2]
+		}
+		if (math.Abs(z[i])+math.Abs(z[i+1]))*math.Abs(L[i]*D[i]) < gaptol {
+			z[i] = 0
+			break
+		}
+		ztz += z[i] * z[i]
+	}
+	for i--; i >= 0; i-- {
+		z[i] = 0
+	}
+	i = r
+	for ; i < n-1; i++ {
+		if z[i] != 0 {
+			z[i+1] = -s.um[i] * z[i]
+		} else {
+			z[i+1] = -(L[i-1] * D[i-1] / (L[i] * D[i])) * z[i-1]
+		}
+		if (math.Abs(z[i])+math.Abs(z[i+1]))*math.Abs(L[i]*D[i]) < gaptol {
+			z[i+1] = 0
+			break
+		}
+		ztz += z[i+1] * z[i+1]
+	}
+	for i += 2; i < n; i++ {
+		z[i] = 0
+	}
+	return gamma, ztz
+}
+
+// inverse computes the wanted eigenpairs of T by bisection and inverse
+// iteration, orthogonalizing eigenvectors with close eigenvalues. It is used
+// when no suitable representation can be found for some eigenvalue.
+func (s *mrrr) inverse() {
+	const (
+		maxIter = 5
+		extra   = 2
+	)
+	eps := dlamchP
+	n := len(s.d)
+	bi := blas64.Implementation()
+	dztol := math.Sqrt(0.1 / float64(n))
+	ortol := 1e-3 * s.tnrm
+	pertol := eps * s.tnrm
+	// The scratch space holds the diagonal, first and second
+	// superdiagonals and multipliers of the LU factorization
+	// of T - lambda*I, and the iterated vector.
+	dd, du, du2, dl, x := s.lp, s.sv, s.um, s.pv, s.zv
+	gl, gu := gershgorin(s.d, s.e, s.pivmin)
+	lo := gl
+	var prev float64
+	var start int
+	for k := s.s0; k <= s.s1; k++ {
+		var hi float64
+		lo, hi = bisectTridiag(s.d, s.e, k, lo, gu, pertol, s.pivmin)
+		lambda := lo + (hi-lo)/2
+		if k > s.s0 && lambda-prev < 10*eps*math.Abs(lambda) {
+			// Perturb close eigenvalues to obtain
+			// different iterates.
+			lambda = prev + 10*eps*math.Abs(lambda)
+		}
+		if k == s.s0 || lambda-prev > ortol {
+			start = k
+		}
+		prev = lambda
+
+		// Factorize T - lambda*I = P*L*U with partial pivoting,
+		// perturbing small pivots.
+		for i := range dd {
+			dd[i] = s.d[i] - lambda
+		}
+		copy(du, s.e)
+		copy(dl, s.e)
+		for i := 0; i < n-1; i++ {
+			du2[i] = 0
+			if math.Abs(dd[i]) >= math.Abs(dl[i]) {
+				s.piv[i] = 0
+				if math.Abs(dd[i]) < pertol {
+					dd[i] = math.Copysign(pertol, dd[i])
+				}
+				fact := dl[i] / dd[i]
+				dl[i] = fact
+				dd[i+1] -= fact * du[i]
+			} else {
+				s.piv[i] = 1
+				fact := dd[i] / dl[i]
+				dd[i] = dl[i]
+				dl[i] = fact
+				tmp := du[i]
+				du[i] = dd[i+1]
+				dd[i+1] = tmp - fact*dd[i+1]
+				if i < n-2 {
+					du2[i] = du[i+1]
+					du[i+1] = -fact * du[i+1]
+				}
+			}
+		}
+		if math.Abs(dd[n-1]) < pertol {
+			dd[n-1] = math.Copysign(pertol, dd[n-1])
+		}
+
+		// Iterate from a pseudo-random starting vector.
+		seed := uint64(k+1) * 0x9e3779b97f4a7c15
+		for i := range x {
+			seed ^= seed << 13
+			seed ^= seed >> 7
+			seed ^= seed << 17
+			x[i] = float64(seed>>11)/(1<<53)*2 - 1
+		}
+		var nrmchk int
+		for it := 0; it < maxIter; it++ {
+			scl := float64(n) * s.tnrm * math.Max(eps, math.Abs(dd[n-1])) / bi.Dasum(n, x, 1)
+			bi.Dscal(n, scl, x, 1)
+
+			// Solve P*L*U*x = x.
+			for i := 0; i < n-1; i++ {
+				if s.piv[i] == 1 {
+					x[i], x[i+1] = x[i+1], x[i]
+				}
+				x[i+1] -= dl[i] * x[i]
+			}
+			x[n-1] /= dd[n-1]
+			x[n-2] = (x[n-2] - du[n-2]*x[n-1]) / dd[n-2]
+			for i := n - 3; i >= 0; i-- {
+				x[i] = (x[i] - du[i]*x[i+1] - du2[i]*x[i+2]) / dd[i]
+			}
+
+			// Orthogonalize against the previous eigenvectors
+			// with eigenvalues closer than ortol.
+			for kk := start; kk < k; kk++ {
+				col := kk - s.s0
+				dot := bi.Ddot(n, x, 1, s.z[col:], s.ldz)
+				bi.Daxpy(n, -dot, s.z[col:], s.ldz, x, 1)
+			}
+
+			if math.Abs(x[bi.Idamax(n, x, 1)]) >= dztol {
+				nrmchk++
+				if nrmchk == extra+1 {
+					break
+				}
+			}
+		}
+		if nrmchk < extra+1 {
+			s.ok = false
+		}
+
+		scl := 1 / bi.Dnrm2(n, x, 1)
+		if x[bi.Idamax(n, x, 1)] < 0 {
+			scl = -scl
+		}
+		col := k - s.s0
+		for i, v := range x {
+			s.z[i*s.ldz+col] = scl * v
+		}
+		s.w[col] = lambda
+	}
+}
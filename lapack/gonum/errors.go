@@ -14,6 +14,7 @@ const (
 	badEVComp           = "lapack: bad EVComp"
 	badEVHowMany        = "lapack: bad EVHowMany"
 	badEVJob            = "lapack: bad EVJob"
+	badEVRange          = "lapack: bad EVRange"
 	badEVSide           = "lapack: bad EVSide"
 	badGSVDJob          = "lapack: bad GSVDJob"
 	badGenOrtho         = "lapack: bad GenOrtho"
@@ -40,10 +41,12 @@ const (
 	badIhi      = "lapack: ihi out of range"
 	badIhiz     = "lapack: ihiz out of range"
 	badIlo      = "lapack: ilo out of range"
+	badIl       = "lapack: il out of range"
 	badIloz     = "lapack: iloz out of range"
 	badIlst     = "lapack: ilst out of range"
 	badIsave    = "lapack: bad isave value"
 	badIspec    = "lapack: bad ispec value"
+	badIu       = "lapack: iu out of range"
 	badJ1       = "lapack: j1 out of range"
 	badJpvt     = "lapack: bad element of jpvt"
 	badK1       = "lapack: k1 out of range"
@@ -84,6 +87,7 @@ const (
 	nbLT0       = "lapack: nb < 0"
 	nccLT0      = "lapack: ncc < 0"
 	ncvtLT0     = "lapack: ncvt < 0"
+	notVlLTVu   = "lapack: vl >= vu"
 	negANorm    = "lapack: anorm < 0"
 	negZ        = "lapack: negative z value"
 	nhLT0       = "lapack: nh < 0"
@@ -131,6 +135,7 @@ const (
 	shortIsgn  = "lapack: insufficient length of isgn"
 	shortQ     = "lapack: insufficient length of q"
 	shortRHS   = "lapack: insufficient length of rhs"
+	shortRWork = "lapack: insufficient length of rwork"
 	shortS     = "lapack: insufficient length of s"
 	shortScale = "lapack: insufficient length of scale"
 	shortT     = "lapack: insufficient length of t"
@@ -7,11 +7,15 @@ package gonum
 import "gonum.org/v1/gonum/lapack"
 
 // Implementation is the native Go implementation of LAPACK routines. It
-// is built on top of calls to the return of blas64.Implementation(), so while
-// this code is in pure Go, the underlying BLAS implementation may not be.
+// is built on top of calls to the return of blas64.Implementation() and
+// cblas128.Implementation(), so while this code is in pure Go, the underlying
+// BLAS implementation may not be.
 type Implementation struct{}
 
-var _ lapack.Float64 = Implementation{}
+var (
+	_ lapack.Float64    = Implementation{}
+	_ lapack.Complex128 = Implementation{}
+)
 
 func abs(a int) int {
 	if a < 0 {
@@ -22,6 +22,21 @@ func TestDhseqr(t *testing.T) {
 	testlapack.DhseqrTest(t, impl)
 }
 
+func TestDgbcon(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbconTest(t, impl)
+}
+
+func TestDgbtrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrfTest(t, impl)
+}
+
+func TestDgbtrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrsTest(t, impl)
+}
+
 func TestDgebak(t *testing.T) {
 	t.Parallel()
 	testlapack.DgebakTest(t, impl)
@@ -82,6 +97,11 @@ func TestDgels(t *testing.T) {
 	testlapack.DgelsTest(t, impl)
 }
 
+func TestDgelsd(t *testing.T) {
+	t.Parallel()
+	testlapack.DgelsdTest(t, impl)
+}
+
 func TestDgerq2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dgerq2Test(t, impl)
@@ -618,6 +638,11 @@ func TestDsyev(t *testing.T) {
 	testlapack.DsyevTest(t, impl)
 }
 
+func TestDsyevmr(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyevmrTest(t, impl)
+}
+
 func TestDsytd2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dsytd2Test(t, impl)
@@ -677,3 +702,28 @@ func TestIladlr(t *testing.T) {
 	t.Parallel()
 	testlapack.IladlrTest(t, impl)
 }
+
+func TestZgeqp3(t *testing.T) {
+	t.Parallel()
+	testlapack.Zgeqp3Test(t, impl)
+}
+
+func TestZggsvd3(t *testing.T) {
+	t.Parallel()
+	testlapack.Zggsvd3Test(t, impl)
+}
+
+func TestZggsvp3(t *testing.T) {
+	t.Parallel()
+	testlapack.Zggsvp3Test(t, impl)
+}
+
+func TestZlags2(t *testing.T) {
+	t.Parallel()
+	testlapack.Zlags2Test(t, impl)
+}
+
+func TestZlarfg(t *testing.T) {
+	t.Parallel()
+	testlapack.ZlarfgTest(t, impl)
+}
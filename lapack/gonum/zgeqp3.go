@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zgeqp3 computes a QR factorization with column pivoting of the complex
+// m×n matrix A: A*P = Q*R using Level 2 BLAS.
+//
+// The matrix Q is represented as a product of elementary reflectors
+//
+//	Q = H_0 H_1 . . . H_{k-1}, where k = min(m,n).
+//
+// Each H_i has the form
+//
+//	H_i = I - tau * v * v^H
+//
+// where tau is a complex scalar and v is a vector with v[0:i] = 0 and
+// v[i] = 1; v[i+1:m] is stored on exit in A[i+1:m,i], and tau in tau[i].
+// The diagonal of R is real.
+//
+// jpvt specifies a column pivot to be applied to A. On entry, if jpvt[j] is at
+// least zero, the jth column of A is permuted to the front of A*P (a leading
+// column), if jpvt[j] is -1 the jth column of A is a free column. If jpvt[j] <
+// -1, Zgeqp3 will panic. On return, jpvt holds the permutation that was
+// applied; the jth column of A*P was the jpvt[j] column of A. jpvt must have
+// length n or Zgeqp3 will panic.
+//
+// tau holds the scalar factors of the elementary reflectors. It must have
+// length min(m,n), otherwise Zgeqp3 will panic.
+//
+// rwork must have length at least 2*n, otherwise Zgeqp3 will panic.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// n+1, otherwise Zgeqp3 will panic. On return, work[0] will contain the
+// optimal value of lwork.
+//
+// If lwork == -1, instead of performing Zgeqp3, only the optimal value of lwork
+// will be stored in work[0].
+//
+// Zgeqp3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zgeqp3(m, n int, a []complex128, lda int, jpvt []int, tau, work []complex128, lwork int, rwork []float64) {
+	minmn := min(m, n)
+	iws := n + 1
+	if minmn == 0 {
+		iws = 1
+	}
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < iws && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		work[0] = complex(float64(iws), 0)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(jpvt) != n:
+		panic(badLenJpvt)
+	case len(tau) < minmn:
+		panic(shortTau)
+	case len(rwork) < 2*n:
+		panic(shortRWork)
+	}
+
+	for _, v := range jpvt {
+		if v < -1 || n <= v {
+			panic(badJpvt)
+		}
+	}
+
+	bi := cblas128.Implementation()
+
+	// Move initial columns up front.
+	var nfxd int
+	for j := 0; j < n; j++ {
+		if jpvt[j] == -1 {
+			jpvt[j] = j
+			continue
+		}
+		if j != nfxd {
+			bi.Zswap(m, a[j:], lda, a[nfxd:], lda)
+			jpvt[j], jpvt[nfxd] = jpvt[nfxd], j
+		} else {
+			jpvt[j] = j
+		}
+		nfxd++
+	}
+
+	// Factorize nfxd columns.
+	//
+	// Compute the QR factorization of nfxd columns and update remaining columns.
+	if nfxd > 0 {
+		na := min(m, nfxd)
+		impl.Zgeqr2(m, na, a, lda, tau[:na], work)
+		if na < n {
+			impl.Zunm2r(blas.Left, blas.ConjTrans, m, n-na, na, a, lda, tau[:na], a[na:], lda, work)
+		}
+	}
+
+	if nfxd < minmn {
+		// Factorize free columns.
+		//
+		// Initialize partial column norms. The first n
+		// elements of rwork store the exact column norms.
+		sm := m - nfxd
+		for j := nfxd; j < n; j++ {
+			rwork[j] = bi.Dznrm2(sm, a[nfxd*lda+j:], lda)
+			rwork[n+j] = rwork[j]
+		}
+		impl.Zlaqp2(m, n-nfxd, nfxd, a[nfxd:], lda, jpvt[nfxd:], tau[nfxd:],
+			rwork[nfxd:n], rwork[n+nfxd:2*n], work)
+	}
+
+	work[0] = complex(float64(iws), 0)
+}
@@ -0,0 +1,78 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Zgerq2 computes an RQ factorization of the complex m×n matrix A,
+//
+//	A = R * Q.
+//
+// On exit, if m <= n, the upper triangle of the subarray
+// A[0:m, n-m:n] contains the m×m upper triangular matrix R.
+// If m >= n, the elements on and above the (m-n)-th subdiagonal
+// contain the m×n upper trapezoidal matrix R.
+// The remaining elements, with tau, represent the
+// unitary matrix Q as a product of min(m,n) elementary
+// reflectors.
+//
+// The matrix Q is represented as a product of elementary reflectors
+//
+//	Q = H_0^H H_1^H . . . H_{min(m,n)-1}^H.
+//
+// Each H(i) has the form
+//
+//	H_i = I - tau_i * v * v^H
+//
+// where v is a vector with conj(v[0:n-k+i-1]) stored in A[m-k+i, 0:n-k+i-1],
+// v[n-k+i:n] = 0 and v[n-k+i] = 1.
+//
+// tau must have length min(m,n) and work must have length m, otherwise
+// Zgerq2 will panic.
+//
+// Zgerq2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zgerq2(m, n int, a []complex128, lda int, tau, work []complex128) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case len(work) < m:
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	k := min(m, n)
+	if k == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	}
+
+	for i := k - 1; i >= 0; i-- {
+		// Generate elementary reflector H[i] to annihilate
+		// A[m-k+i, 0:n-k+i-1].
+		mki := m - k + i
+		nki := n - k + i
+		impl.Zlacgv(nki+1, a[mki*lda:], 1)
+		var aii complex128
+		aii, tau[i] = impl.Zlarfg(nki+1, a[mki*lda+nki], a[mki*lda:], 1)
+
+		// Apply H[i] to A[0:m-k+i-1, 0:n-k+i] from the right.
+		a[mki*lda+nki] = 1
+		impl.Zlarf(blas.Right, mki, nki+1, a[mki*lda:], 1, tau[i], a, lda, work)
+		a[mki*lda+nki] = aii
+		if nki > 0 {
+			impl.Zlacgv(nki, a[mki*lda:], 1)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zggsvd3 computes the generalized singular value decomposition (GSVD)
+// of a complex m×n matrix A and p×n matrix B:
+//
+//	U^H*A*Q = D1*[ 0 R ]
+//
+//	V^H*B*Q = D2*[ 0 R ]
+//
+// where U, V and Q are unitary matrices.
+//
+// Zggsvd3 returns k and l, the dimensions of the sub-blocks. k+l
+// is the effective numerical rank of the (m+p)×n matrix [ A^H B^H ]^H.
+// R is a (k+l)×(k+l) nonsingular upper triangular matrix with a real
+// diagonal, and D1 and D2 are real m×(k+l) and p×(k+l) diagonal matrices
+// with the same structure as described in the documentation of Dggsvd3.
+// R is stored in A[0:min(k+l,m), n-k-l:n] and, if m-k-l < 0, the
+// trailing part R33 is stored in B[m-k:l, n+m-k-l:n] on exit.
+//
+// Zggsvd3 computes C, S, R, and optionally the unitary transformation
+// matrices U, V and Q.
+//
+// jobU, jobV and jobQ are options for computing the unitary matrices. The behavior
+// is as follows
+//
+//	jobU == lapack.GSVDU        Compute unitary matrix U
+//	jobU == lapack.GSVDNone     Do not compute unitary matrix.
+//
+// The behavior is the same for jobV and jobQ with the exception that instead of
+// lapack.GSVDU these accept lapack.GSVDV and lapack.GSVDQ respectively.
+// The matrices U, V and Q must be m×m, p×p and n×n respectively unless the
+// relevant job parameter is lapack.GSVDNone.
+//
+// alpha and beta must have length n or Zggsvd3 will panic. On exit, alpha and
+// beta contain the generalized singular value pairs of A and B
+//
+//	alpha[0:k] = 1,
+//	beta[0:k]  = 0,
+//
+// if m-k-l >= 0,
+//
+//	alpha[k:k+l] = diag(C),
+//	beta[k:k+l]  = diag(S),
+//
+// if m-k-l < 0,
+//
+//	alpha[k:m]= C, alpha[m:k+l]= 0
+//	beta[k:m] = S, beta[m:k+l] = 1.
+//
+// if k+l < n,
+//
+//	alpha[k+l:n] = 0 and
+//	beta[k+l:n]  = 0.
+//
+// On exit, iwork contains the permutation required to sort alpha descending.
+//
+// iwork must have length n, rwork must have length at least 2*n, work must
+// have length at least max(1, lwork), and lwork must be -1 or greater than n,
+// otherwise Zggsvd3 will panic. If lwork is -1, work[0] holds the optimal
+// lwork on return, but Zggsvd3 does not perform the GSVD.
+func (impl Implementation) Zggsvd3(jobU, jobV, jobQ lapack.GSVDJob, m, n, p int, a []complex128, lda int, b []complex128, ldb int, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128, lwork int, rwork []float64, iwork []int) (k, l int, ok bool) {
+	wantu := jobU == lapack.GSVDU
+	wantv := jobV == lapack.GSVDV
+	wantq := jobQ == lapack.GSVDQ
+	switch {
+	case !wantu && jobU != lapack.GSVDNone:
+		panic(badGSVDJob + "U")
+	case !wantv && jobV != lapack.GSVDNone:
+		panic(badGSVDJob + "V")
+	case !wantq && jobQ != lapack.GSVDNone:
+		panic(badGSVDJob + "Q")
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case p < 0:
+		panic(pLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldu < 1, wantu && ldu < m:
+		panic(badLdU)
+	case ldv < 1, wantv && ldv < p:
+		panic(badLdV)
+	case ldq < 1, wantq && ldq < n:
+		panic(badLdQ)
+	case len(iwork) < n:
+		panic(shortIWork)
+	case lwork < 1 && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Determine optimal work length.
+	impl.Zggsvp3(jobU, jobV, jobQ,
+		m, p, n,
+		a, lda,
+		b, ldb,
+		0, 0,
+		u, ldu,
+		v, ldv,
+		q, ldq,
+		iwork[:n], rwork,
+		work, work, -1)
+	lwkopt := n + int(real(work[0]))
+	lwkopt = max(lwkopt, 2*n)
+	lwkopt = max(lwkopt, 1)
+	work[0] = complex(float64(lwkopt), 0)
+	if lwork == -1 {
+		return 0, 0, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (p-1)*ldb+n:
+		panic(shortB)
+	case wantu && len(u) < (m-1)*ldu+m:
+		panic(shortU)
+	case wantv && len(v) < (p-1)*ldv+p:
+		panic(shortV)
+	case wantq && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(alpha) != n:
+		panic(badLenAlpha)
+	case len(beta) != n:
+		panic(badLenBeta)
+	case len(rwork) < 2*n:
+		panic(shortRWork)
+	case len(work) < 2*n:
+		panic(shortWork)
+	}
+
+	// Compute the Frobenius norm of matrices A and B.
+	anorm := impl.Zlange(lapack.Frobenius, m, n, a, lda, nil)
+	bnorm := impl.Zlange(lapack.Frobenius, p, n, b, ldb, nil)
+
+	// Get machine precision and set up threshold for determining
+	// the effective numerical rank of the matrices A and B.
+	tola := float64(max(m, n)) * math.Max(anorm, dlamchS) * dlamchP
+	tolb := float64(max(p, n)) * math.Max(bnorm, dlamchS) * dlamchP
+
+	// Preprocessing.
+	k, l = impl.Zggsvp3(jobU, jobV, jobQ,
+		m, p, n,
+		a, lda,
+		b, ldb,
+		tola, tolb,
+		u, ldu,
+		v, ldv,
+		q, ldq,
+		iwork[:n], rwork,
+		work[:n], work[n:], lwork-n)
+
+	// Compute the GSVD of two upper "triangular" matrices.
+	_, ok = impl.Ztgsja(jobU, jobV, jobQ,
+		m, p, n,
+		k, l,
+		a, lda,
+		b, ldb,
+		tola, tolb,
+		alpha, beta,
+		u, ldu,
+		v, ldv,
+		q, ldq,
+		work)
+
+	// Sort the singular values and store the pivot indices in iwork
+	// Copy alpha to rwork, then sort alpha in rwork.
+	copy(rwork[:n], alpha)
+	ibnd := min(l, m-k)
+	for i := 0; i < ibnd; i++ {
+		// Scan for largest alpha_{k+i}.
+		isub := i
+		smax := rwork[k+i]
+		for j := i + 1; j < ibnd; j++ {
+			if v := rwork[k+j]; v > smax {
+				isub = j
+				smax = v
+			}
+		}
+		if isub != i {
+			rwork[k+isub] = rwork[k+i]
+			rwork[k+i] = smax
+			iwork[k+i] = k + isub
+		} else {
+			iwork[k+i] = k + i
+		}
+	}
+
+	work[0] = complex(float64(lwkopt), 0)
+
+	return k, l, ok
+}
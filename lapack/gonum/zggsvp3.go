@@ -0,0 +1,288 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zggsvp3 computes unitary matrices U, V and Q such that
+//
+//	                 n-k-l  k    l
+//	U^H*A*Q =     k [ 0    A12  A13 ] if m-k-l >= 0;
+//	              l [ 0     0   A23 ]
+//	          m-k-l [ 0     0    0  ]
+//
+//	                 n-k-l  k    l
+//	U^H*A*Q =     k [ 0    A12  A13 ] if m-k-l < 0;
+//	            m-k [ 0     0   A23 ]
+//
+//	                 n-k-l  k    l
+//	V^H*B*Q =     l [ 0     0   B13 ]
+//	            p-l [ 0     0    0  ]
+//
+// where the k×k matrix A12 and l×l matrix B13 are non-singular
+// upper triangular with real diagonals. A23 is l×l upper triangular
+// if m-k-l >= 0, otherwise A23 is (m-k)×l upper trapezoidal.
+//
+// Zggsvp3 returns k and l, the dimensions of the sub-blocks. k+l
+// is the effective numerical rank of the (m+p)×n matrix [ A^H B^H ]^H.
+//
+// jobU, jobV and jobQ are options for computing the unitary matrices. The behavior
+// is as follows
+//
+//	jobU == lapack.GSVDU        Compute unitary matrix U
+//	jobU == lapack.GSVDNone     Do not compute unitary matrix.
+//
+// The behavior is the same for jobV and jobQ with the exception that instead of
+// lapack.GSVDU these accept lapack.GSVDV and lapack.GSVDQ respectively.
+// The matrices U, V and Q must be m×m, p×p and n×n respectively unless the
+// relevant job parameter is lapack.GSVDNone.
+//
+// tola and tolb are the thresholds used to determine the effective numerical
+// rank of A and B. Generally, they are
+//
+//	tola = max(m, n)*norm(A)*eps,
+//	tolb = max(p, n)*norm(B)*eps.
+//
+// Where eps is the machine epsilon.
+//
+// iwork must have length n, rwork must have length at least 2*n, tau must have
+// length at least n, work must have length at least max(1, lwork), and lwork
+// must be -1 or greater than zero, otherwise Zggsvp3 will panic.
+//
+// Zggsvp3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zggsvp3(jobU, jobV, jobQ lapack.GSVDJob, m, p, n int, a []complex128, lda int, b []complex128, ldb int, tola, tolb float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, iwork []int, rwork []float64, tau, work []complex128, lwork int) (k, l int) {
+	wantu := jobU == lapack.GSVDU
+	wantv := jobV == lapack.GSVDV
+	wantq := jobQ == lapack.GSVDQ
+	switch {
+	case !wantu && jobU != lapack.GSVDNone:
+		panic(badGSVDJob + "U")
+	case !wantv && jobV != lapack.GSVDNone:
+		panic(badGSVDJob + "V")
+	case !wantq && jobQ != lapack.GSVDNone:
+		panic(badGSVDJob + "Q")
+	case m < 0:
+		panic(mLT0)
+	case p < 0:
+		panic(pLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldu < 1, wantu && ldu < m:
+		panic(badLdU)
+	case ldv < 1, wantv && ldv < p:
+		panic(badLdV)
+	case ldq < 1, wantq && ldq < n:
+		panic(badLdQ)
+	case len(iwork) != n:
+		panic(shortWork)
+	case lwork < 1 && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	var lwkopt int
+	impl.Zgeqp3(p, n, b, ldb, iwork, tau, work, -1, rwork)
+	lwkopt = int(real(work[0]))
+	if wantv {
+		lwkopt = max(lwkopt, p)
+	}
+	lwkopt = max(lwkopt, min(n, p))
+	lwkopt = max(lwkopt, m)
+	if wantq {
+		lwkopt = max(lwkopt, n)
+	}
+	impl.Zgeqp3(m, n, a, lda, iwork, tau, work, -1, rwork)
+	lwkopt = max(lwkopt, int(real(work[0])))
+	lwkopt = max(1, lwkopt)
+	if lwork == -1 {
+		work[0] = complex(float64(lwkopt), 0)
+		return 0, 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (p-1)*ldb+n:
+		panic(shortB)
+	case wantu && len(u) < (m-1)*ldu+m:
+		panic(shortU)
+	case wantv && len(v) < (p-1)*ldv+p:
+		panic(shortV)
+	case wantq && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(rwork) < 2*n:
+		panic(shortRWork)
+	case len(tau) < n:
+		// tau check must come after lwkopt query since
+		// the Zggsvd3 call for lwkopt query may have
+		// lwork == -1, and tau is provided by work.
+		panic(shortTau)
+	}
+
+	const forward = true
+
+	// QR with column pivoting of B: B*P = V*[ S11 S12 ].
+	//                                       [  0   0  ]
+	for i := range iwork[:n] {
+		iwork[i] = -1
+	}
+	impl.Zgeqp3(p, n, b, ldb, iwork, tau[:min(p, n)], work, lwork, rwork)
+
+	// Update A := A*P.
+	impl.Zlapmt(forward, m, n, a, lda, iwork)
+
+	// Determine the effective rank of matrix B.
+	for i := 0; i < min(p, n); i++ {
+		if cmplx.Abs(b[i*ldb+i]) > tolb {
+			l++
+		}
+	}
+
+	if wantv {
+		// Copy the details of V, and form V.
+		impl.Zlaset(blas.All, p, p, 0, 0, v, ldv)
+		if p > 1 {
+			impl.Zlacpy(blas.Lower, p-1, min(p, n), b[ldb:], ldb, v[ldv:], ldv)
+		}
+		impl.Zung2r(p, p, min(p, n), v, ldv, tau[:min(p, n)], work)
+	}
+
+	// Clean up B.
+	for i := 1; i < l; i++ {
+		r := b[i*ldb : i*ldb+i]
+		for j := range r {
+			r[j] = 0
+		}
+	}
+	if p > l {
+		impl.Zlaset(blas.All, p-l, n, 0, 0, b[l*ldb:], ldb)
+	}
+
+	if wantq {
+		// Set Q = I and update Q := Q*P.
+		impl.Zlaset(blas.All, n, n, 0, 1, q, ldq)
+		impl.Zlapmt(forward, n, n, q, ldq, iwork)
+	}
+
+	if p >= l && n != l {
+		// RQ factorization of [ S11 S12 ]: [ S11 S12 ] = [ 0 S12 ]*Z.
+		impl.Zgerq2(l, n, b, ldb, tau, work)
+
+		// Update A := A*Z^H.
+		impl.Zunmr2(blas.Right, blas.ConjTrans, m, n, l, b, ldb, tau, a, lda, work)
+
+		if wantq {
+			// Update Q := Q*Z^H.
+			impl.Zunmr2(blas.Right, blas.ConjTrans, n, n, l, b, ldb, tau, q, ldq, work)
+		}
+
+		// Clean up B.
+		impl.Zlaset(blas.All, l, n-l, 0, 0, b, ldb)
+		for i := 1; i < l; i++ {
+			r := b[i*ldb+n-l : i*ldb+i+n-l]
+			for j := range r {
+				r[j] = 0
+			}
+		}
+	}
+
+	// Let              N-L     L
+	//            A = [ A11    A12 ] M,
+	//
+	// then the following does the complete QR decomposition of A11:
+	//
+	//          A11 = U*[  0  T12 ]*P1^H.
+	//                  [  0   0  ]
+	for i := range iwork[:n-l] {
+		iwork[i] = -1
+	}
+	impl.Zgeqp3(m, n-l, a, lda, iwork[:n-l], tau[:min(m, n-l)], work, lwork, rwork)
+
+	// Determine the effective rank of A11.
+	for i := 0; i < min(m, n-l); i++ {
+		if cmplx.Abs(a[i*lda+i]) > tola {
+			k++
+		}
+	}
+
+	// Update A12 := U^H*A12, where A12 = A[0:m, n-l:n].
+	impl.Zunm2r(blas.Left, blas.ConjTrans, m, l, min(m, n-l), a, lda, tau[:min(m, n-l)], a[n-l:], lda, work)
+
+	if wantu {
+		// Copy the details of U, and form U.
+		impl.Zlaset(blas.All, m, m, 0, 0, u, ldu)
+		if m > 1 {
+			impl.Zlacpy(blas.Lower, m-1, min(m, n-l), a[lda:], lda, u[ldu:], ldu)
+		}
+		k := min(m, n-l)
+		impl.Zung2r(m, m, k, u, ldu, tau[:k], work)
+	}
+
+	if wantq {
+		// Update Q[0:n, 0:n-l] := Q[0:n, 0:n-l]*P1.
+		impl.Zlapmt(forward, n, n-l, q, ldq, iwork[:n-l])
+	}
+
+	// Clean up A: set the strictly lower triangular part of
+	// A[0:k, 0:k] = 0, and A[k:m, 0:n-l] = 0.
+	for i := 1; i < k; i++ {
+		r := a[i*lda : i*lda+i]
+		for j := range r {
+			r[j] = 0
+		}
+	}
+	if m > k {
+		impl.Zlaset(blas.All, m-k, n-l, 0, 0, a[k*lda:], lda)
+	}
+
+	if n-l > k {
+		// RQ factorization of [ T11 T12 ] = [ 0 T12 ]*Z1.
+		impl.Zgerq2(k, n-l, a, lda, tau, work)
+
+		if wantq {
+			// Update Q[0:n, 0:n-l] := Q[0:n, 0:n-l]*Z1^H.
+			impl.Zunmr2(blas.Right, blas.ConjTrans, n, n-l, k, a, lda, tau, q, ldq, work)
+		}
+
+		// Clean up A.
+		impl.Zlaset(blas.All, k, n-l-k, 0, 0, a, lda)
+		for i := 1; i < k; i++ {
+			r := a[i*lda+n-k-l : i*lda+i+n-k-l]
+			for j := range r {
+				r[j] = 0
+			}
+		}
+	}
+
+	if m > k {
+		// QR factorization of A[k:m, n-l:n].
+		impl.Zgeqr2(m-k, l, a[k*lda+n-l:], lda, tau[:min(m-k, l)], work)
+		if wantu {
+			// Update U[:, k:m] := U[:, k:m]*U1.
+			impl.Zunm2r(blas.Right, blas.NoTrans, m, m-k, min(m-k, l), a[k*lda+n-l:], lda, tau[:min(m-k, l)], u[k:], ldu, work)
+		}
+
+		// Clean up A.
+		for i := k + 1; i < m; i++ {
+			r := a[i*lda+n-l : i*lda+min(n-l+i-k, n)]
+			for j := range r {
+				r[j] = 0
+			}
+		}
+	}
+
+	work[0] = complex(float64(lwkopt), 0)
+	return k, l
+}
@@ -0,0 +1,215 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Zlags2 computes 2-by-2 unitary matrices U, V and Q with the
+// triangles of A and B specified by upper.
+//
+// If upper is true
+//
+//	U^H*A*Q = U^H*[ a1 a2 ]*Q = [ x  0 ]
+//	              [ 0  a3 ]     [ x  x ]
+//
+// and
+//
+//	V^H*B*Q = V^H*[ b1 b2 ]*Q = [ x  0 ]
+//	              [ 0  b3 ]     [ x  x ]
+//
+// otherwise
+//
+//	U^H*A*Q = U^H*[ a1 0  ]*Q = [ x  x ]
+//	              [ a2 a3 ]     [ 0  x ]
+//
+// and
+//
+//	V^H*B*Q = V^H*[ b1 0  ]*Q = [ x  x ]
+//	              [ b2 b3 ]     [ 0  x ].
+//
+// The diagonal elements a1, a3, b1 and b3 are real. The rows of the
+// transformed A and B are parallel, where
+//
+//	U = [        csu  snu ], V = [        csv snv ], Q = [        csq snq ]
+//	    [ -conj(snu)  csu ]      [ -conj(snv) csv ]      [ -conj(snq) csq ]
+//
+// Zlags2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlags2(upper bool, a1 float64, a2 complex128, a3, b1 float64, b2 complex128, b3 float64) (csu float64, snu complex128, csv float64, snv complex128, csq float64, snq complex128) {
+	if upper {
+		// Input matrices A and B are upper triangular matrices.
+		//
+		// Form matrix C = A*adj(B) = [ a b ]
+		//                            [ 0 d ]
+		a := a1 * b3
+		d := a3 * b1
+		b := a2*complex(b1, 0) - complex(a1, 0)*b2
+		fb := cmplx.Abs(b)
+
+		// Transform complex 2-by-2 matrix C to real matrix by unitary
+		// diagonal matrix diag(1,d1).
+		d1 := complex(1, 0)
+		if fb != 0 {
+			d1 = b / complex(fb, 0)
+		}
+
+		// The SVD of real 2-by-2 triangular C.
+		//
+		//  [ csl -snl ]*[ a b ]*[  csr  snr ] = [ r 0 ]
+		//  [ snl  csl ] [ 0 d ] [ -snr  csr ]   [ 0 t ]
+		_, _, snr, csr, snl, csl := impl.Dlasv2(a, fb, d)
+
+		if math.Abs(csl) >= math.Abs(snl) || math.Abs(csr) >= math.Abs(snr) {
+			// Compute the [0, 0] and [0, 1] elements of U^H*A and V^H*B,
+			// and [0, 1] element of |U|^H*|A| and |V|^H*|B|.
+
+			ua11r := csl * a1
+			ua12 := complex(csl, 0)*a2 + d1*complex(snl*a3, 0)
+
+			vb11r := csr * b1
+			vb12 := complex(csr, 0)*b2 + d1*complex(snr*b3, 0)
+
+			aua12 := math.Abs(csl)*abs1(a2) + math.Abs(snl)*math.Abs(a3)
+			avb12 := math.Abs(csr)*abs1(b2) + math.Abs(snr)*math.Abs(b3)
+
+			// Zero [0, 1] elements of U^H*A and V^H*B.
+			switch {
+			case math.Abs(ua11r)+abs1(ua12) == 0:
+				csq, snq, _ = impl.Zlartg(complex(-vb11r, 0), cmplx.Conj(vb12))
+			case math.Abs(vb11r)+abs1(vb12) == 0:
+				csq, snq, _ = impl.Zlartg(complex(-ua11r, 0), cmplx.Conj(ua12))
+			case aua12/(math.Abs(ua11r)+abs1(ua12)) <= avb12/(math.Abs(vb11r)+abs1(vb12)):
+				csq, snq, _ = impl.Zlartg(complex(-ua11r, 0), cmplx.Conj(ua12))
+			default:
+				csq, snq, _ = impl.Zlartg(complex(-vb11r, 0), cmplx.Conj(vb12))
+			}
+
+			csu = csl
+			snu = -d1 * complex(snl, 0)
+			csv = csr
+			snv = -d1 * complex(snr, 0)
+		} else {
+			// Compute the [1, 0] and [1, 1] elements of U^H*A and V^H*B,
+			// and [1, 1] element of |U|^H*|A| and |V|^H*|B|.
+
+			ua21 := -cmplx.Conj(d1) * complex(snl*a1, 0)
+			ua22 := -cmplx.Conj(d1)*complex(snl, 0)*a2 + complex(csl*a3, 0)
+
+			vb21 := -cmplx.Conj(d1) * complex(snr*b1, 0)
+			vb22 := -cmplx.Conj(d1)*complex(snr, 0)*b2 + complex(csr*b3, 0)
+
+			aua22 := math.Abs(snl)*abs1(a2) + math.Abs(csl)*math.Abs(a3)
+			avb22 := math.Abs(snr)*abs1(b2) + math.Abs(csr)*math.Abs(b3)
+
+			// Zero [1, 1] elements of U^H*A and V^H*B, and then swap.
+			switch {
+			case abs1(ua21)+abs1(ua22) == 0:
+				csq, snq, _ = impl.Zlartg(-cmplx.Conj(vb21), cmplx.Conj(vb22))
+			case abs1(vb21)+abs1(vb22) == 0:
+				csq, snq, _ = impl.Zlartg(-cmplx.Conj(ua21), cmplx.Conj(ua22))
+			case aua22/(abs1(ua21)+abs1(ua22)) <= avb22/(abs1(vb21)+abs1(vb22)):
+				csq, snq, _ = impl.Zlartg(-cmplx.Conj(ua21), cmplx.Conj(ua22))
+			default:
+				csq, snq, _ = impl.Zlartg(-cmplx.Conj(vb21), cmplx.Conj(vb22))
+			}
+
+			csu = snl
+			snu = d1 * complex(csl, 0)
+			csv = snr
+			snv = d1 * complex(csr, 0)
+		}
+	} else {
+		// Input matrices A and B are lower triangular matrices.
+		//
+		// Form matrix C = A*adj(B) = [ a 0 ]
+		//                            [ c d ]
+		a := a1 * b3
+		d := a3 * b1
+		c := a2*complex(b3, 0) - complex(a3, 0)*b2
+		fc := cmplx.Abs(c)
+
+		// Transform complex 2-by-2 matrix C to real matrix by unitary
+		// diagonal matrix diag(d1,1).
+		d1 := complex(1, 0)
+		if fc != 0 {
+			d1 = c / complex(fc, 0)
+		}
+
+		// The SVD of real 2-by-2 triangular C.
+		//
+		// [ csl -snl ]*[ a 0 ]*[  csr  snr ] = [ r 0 ]
+		// [ snl  csl ] [ c d ] [ -snr  csr ]   [ 0 t ]
+		_, _, snr, csr, snl, csl := impl.Dlasv2(a, fc, d)
+
+		if math.Abs(csr) >= math.Abs(snr) || math.Abs(csl) >= math.Abs(snl) {
+			// Compute the [1, 0] and [1, 1] elements of U^H*A and V^H*B,
+			// and [1, 0] element of |U|^H*|A| and |V|^H*|B|.
+
+			ua21 := -d1*complex(snr*a1, 0) + complex(csr, 0)*a2
+			ua22r := csr * a3
+
+			vb21 := -d1*complex(snl*b1, 0) + complex(csl, 0)*b2
+			vb22r := csl * b3
+
+			aua21 := math.Abs(snr)*math.Abs(a1) + math.Abs(csr)*abs1(a2)
+			avb21 := math.Abs(snl)*math.Abs(b1) + math.Abs(csl)*abs1(b2)
+
+			// Zero [1, 0] elements of U^H*A and V^H*B.
+			switch {
+			case abs1(ua21)+math.Abs(ua22r) == 0:
+				csq, snq, _ = impl.Zlartg(complex(vb22r, 0), vb21)
+			case abs1(vb21)+math.Abs(vb22r) == 0:
+				csq, snq, _ = impl.Zlartg(complex(ua22r, 0), ua21)
+			case aua21/(abs1(ua21)+math.Abs(ua22r)) <= avb21/(abs1(vb21)+math.Abs(vb22r)):
+				csq, snq, _ = impl.Zlartg(complex(ua22r, 0), ua21)
+			default:
+				csq, snq, _ = impl.Zlartg(complex(vb22r, 0), vb21)
+			}
+
+			csu = csr
+			snu = -cmplx.Conj(d1) * complex(snr, 0)
+			csv = csl
+			snv = -cmplx.Conj(d1) * complex(snl, 0)
+		} else {
+			// Compute the [0, 0] and [0, 1] elements of U^H*A and V^H*B,
+			// and [0, 0] element of |U|^H*|A| and |V|^H*|B|.
+
+			ua11 := complex(csr*a1, 0) + cmplx.Conj(d1)*complex(snr, 0)*a2
+			ua12 := cmplx.Conj(d1) * complex(snr*a3, 0)
+
+			vb11 := complex(csl*b1, 0) + cmplx.Conj(d1)*complex(snl, 0)*b2
+			vb12 := cmplx.Conj(d1) * complex(snl*b3, 0)
+
+			aua11 := math.Abs(csr)*math.Abs(a1) + math.Abs(snr)*abs1(a2)
+			avb11 := math.Abs(csl)*math.Abs(b1) + math.Abs(snl)*abs1(b2)
+
+			// Zero [0, 0] elements of U^H*A and V^H*B, and then swap.
+			switch {
+			case abs1(ua11)+abs1(ua12) == 0:
+				csq, snq, _ = impl.Zlartg(vb12, vb11)
+			case abs1(vb11)+abs1(vb12) == 0:
+				csq, snq, _ = impl.Zlartg(ua12, ua11)
+			case aua11/(abs1(ua11)+abs1(ua12)) <= avb11/(abs1(vb11)+abs1(vb12)):
+				csq, snq, _ = impl.Zlartg(ua12, ua11)
+			default:
+				csq, snq, _ = impl.Zlartg(vb12, vb11)
+			}
+
+			csu = snr
+			snu = cmplx.Conj(d1) * complex(csr, 0)
+			csv = snl
+			snv = cmplx.Conj(d1) * complex(csl, 0)
+		}
+	}
+
+	return csu, snu, csv, snv, csq, snq
+}
+
+// abs1 returns |real(z)| + |imag(z)|.
+func abs1(z complex128) float64 {
+	return math.Abs(real(z)) + math.Abs(imag(z))
+}
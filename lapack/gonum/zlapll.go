@@ -0,0 +1,59 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zlapll returns the smallest singular value of the complex n×2 matrix
+// A = [ x y ]. The function first computes the QR factorization of A = Q*R,
+// and then computes the SVD of the 2-by-2 upper triangular matrix r.
+//
+// The contents of x and y are overwritten during the call.
+//
+// Zlapll is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlapll(n int, x []complex128, incX int, y []complex128, incY int) float64 {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case incX <= 0:
+		panic(badIncX)
+	case incY <= 0:
+		panic(badIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(x) < 1+(n-1)*incX:
+		panic(shortX)
+	case len(y) < 1+(n-1)*incY:
+		panic(shortY)
+	}
+
+	// Quick return if possible.
+	if n == 1 {
+		return 0
+	}
+
+	// Compute the QR factorization of the N-by-2 matrix [ X Y ].
+	a11, tau := impl.Zlarfg(n, x[0], x[incX:], incX)
+	x[0] = 1
+
+	bi := cblas128.Implementation()
+	c := -cmplx.Conj(tau) * bi.Zdotc(n, x, incX, y, incY)
+	bi.Zaxpy(n, c, x, incX, y, incY)
+	a22, _ := impl.Zlarfg(n-1, y[incY], y[min(2*incY, len(y)):], incY)
+
+	// Compute the SVD of 2-by-2 upper triangular matrix.
+	ssmin, _ := impl.Dlas2(cmplx.Abs(a11), cmplx.Abs(y[0]), cmplx.Abs(a22))
+	return ssmin
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Zlartg generates a plane rotation so that
+//
+//	[        cs sn] * [f] = [r]
+//	[-conj(sn) cs]   [g] = [0]
+//
+// where cs is real and cs*cs + |sn|*|sn| = 1.
+//
+// This is a more accurate version of BLAS Zrotg that uses scaling to avoid
+// overflow or underflow, with the other differences that
+//   - cs >= 0
+//   - if g = 0, then cs = 1 and sn = 0
+//   - if f = 0 and g != 0, then cs = 0 and sn is chosen so that r is real
+//
+// Zlartg is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlartg(f, g complex128) (cs float64, sn, r complex128) {
+	if g == 0 {
+		return 1, 0, f
+	}
+
+	g1 := cmplx.Abs(g)
+
+	if f == 0 {
+		return 0, cmplx.Conj(g) / complex(g1, 0), complex(g1, 0)
+	}
+
+	const safmin = dlamchS
+	const safmax = 1 / safmin
+
+	f1 := cmplx.Abs(f)
+
+	// Scale f and g by a common factor to avoid overflow
+	// and underflow in the computation of d.
+	u := math.Min(math.Max(safmin, math.Max(f1, g1)), safmax)
+	fs := f1 / u
+	gs := g1 / u
+	d := math.Sqrt(fs*fs + gs*gs)
+	cs = fs / d
+
+	// phase is f/|f|.
+	phase := f / complex(f1, 0)
+	sn = phase * cmplx.Conj(g) / complex(g1, 0) * complex(gs/d, 0)
+	r = phase * complex(d*u, 0)
+	return cs, sn, r
+}
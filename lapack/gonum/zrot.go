@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math/cmplx"
+
+// Zrot applies a plane rotation with real cosine c and complex sine s
+// to the vectors x and y:
+//
+//	[ x_i ] = [        c  s ] [ x_i ]
+//	[ y_i ]   [ -conj(s)  c ] [ y_i ]
+//
+// for i = 0, ..., n-1.
+//
+// Zrot is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zrot(n int, x []complex128, incX int, y []complex128, incY int, c float64, s complex128) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case incX <= 0:
+		panic(badIncX)
+	case incY <= 0:
+		panic(badIncY)
+	}
+
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(x) < 1+(n-1)*incX:
+		panic(shortX)
+	case len(y) < 1+(n-1)*incY:
+		panic(shortY)
+	}
+
+	var ix, iy int
+	cc := complex(c, 0)
+	for i := 0; i < n; i++ {
+		xi := x[ix]
+		yi := y[iy]
+		x[ix] = cc*xi + s*yi
+		y[iy] = cc*yi - cmplx.Conj(s)*xi
+		ix += incX
+		iy += incY
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Ztgsja computes the generalized singular value decomposition (GSVD)
+// of two complex upper triangular or trapezoidal matrices A and B.
+//
+// A and B have the following forms, which may be obtained by the
+// preprocessing subroutine Zggsvp3 from a general m×n matrix A and p×n
+// matrix B:
+//
+//	          n-k-l  k    l
+//	A =    k [  0   A12  A13 ] if m-k-l >= 0;
+//	       l [  0    0   A23 ]
+//	   m-k-l [  0    0    0  ]
+//
+//	          n-k-l  k    l
+//	A =    k [  0   A12  A13 ] if m-k-l < 0;
+//	     m-k [  0    0   A23 ]
+//
+//	          n-k-l  k    l
+//	B =    l [  0    0   B13 ]
+//	     p-l [  0    0    0  ]
+//
+// where the k×k matrix A12 and l×l matrix B13 are non-singular
+// upper triangular. A23 is l×l upper triangular if m-k-l >= 0,
+// otherwise A23 is (m-k)×l upper trapezoidal.
+//
+// On exit,
+//
+//	U^H*A*Q = D1*[ 0 R ], V^H*B*Q = D2*[ 0 R ],
+//
+// where U, V and Q are unitary matrices, R is a non-singular upper
+// triangular matrix, and D1 and D2 are real diagonal matrices with the
+// structure described in the documentation of Dtgsja.
+//
+// The computation of the unitary transformation matrices U, V or Q
+// is optional. These matrices may either be formed explicitly, or they
+// may be post-multiplied into input matrices U1, V1, or Q1.
+//
+// Ztgsja essentially uses a variant of Kogbetliantz algorithm to reduce
+// min(l,m-k)×l triangular or trapezoidal matrix A23 and l×l
+// matrix B13 to the form:
+//
+//	U1^H*A13*Q1 = C1*R1; V1^H*B13*Q1 = S1*R1,
+//
+// where U1, V1 and Q1 are unitary matrices. C1 and S1 are real diagonal
+// matrices satisfying
+//
+//	C1^2 + S1^2 = I,
+//
+// and R1 is an l×l non-singular upper triangular matrix.
+//
+// jobU, jobV and jobQ are options for computing the unitary matrices. The behavior
+// is as follows
+//
+//	jobU == lapack.GSVDU        Compute unitary matrix U
+//	jobU == lapack.GSVDUnit     Use unit-initialized matrix
+//	jobU == lapack.GSVDNone     Do not compute unitary matrix.
+//
+// The behavior is the same for jobV and jobQ with the exception that instead of
+// lapack.GSVDU these accept lapack.GSVDV and lapack.GSVDQ respectively.
+// The matrices U, V and Q must be m×m, p×p and n×n respectively unless the
+// relevant job parameter is lapack.GSVDNone.
+//
+// k and l specify the sub-blocks in the input matrices A and B:
+//
+//	A23 = A[k:min(k+l,m), n-l:n) and B13 = B[0:l, n-l:n]
+//
+// of A and B, whose GSVD is going to be computed by Ztgsja.
+//
+// tola and tolb are the convergence criteria for the Jacobi-Kogbetliantz
+// iteration procedure. Generally, they are the same as used in the preprocessing
+// step, for example,
+//
+//	tola = max(m, n)*norm(A)*eps,
+//	tolb = max(p, n)*norm(B)*eps,
+//
+// where eps is the machine epsilon.
+//
+// work must have length at least 2*n, otherwise Ztgsja will panic.
+//
+// alpha and beta must have length n or Ztgsja will panic. On exit, alpha and
+// beta contain the generalized singular value pairs of A and B as described
+// in the documentation of Dtgsja.
+//
+// On exit, A[n-k:n, 0:min(k+l,m)] contains the triangular matrix R or part of R
+// and if necessary, B[m-k:l, n+m-k-l:n] contains a part of R. The diagonal
+// of R is real.
+//
+// Ztgsja returns whether the routine converged and the number of iteration cycles
+// that were run.
+//
+// Ztgsja is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Ztgsja(jobU, jobV, jobQ lapack.GSVDJob, m, p, n, k, l int, a []complex128, lda int, b []complex128, ldb int, tola, tolb float64, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128) (cycles int, ok bool) {
+	const maxit = 40
+
+	initu := jobU == lapack.GSVDUnit
+	wantu := initu || jobU == lapack.GSVDU
+
+	initv := jobV == lapack.GSVDUnit
+	wantv := initv || jobV == lapack.GSVDV
+
+	initq := jobQ == lapack.GSVDUnit
+	wantq := initq || jobQ == lapack.GSVDQ
+
+	switch {
+	case !initu && !wantu && jobU != lapack.GSVDNone:
+		panic(badGSVDJob + "U")
+	case !initv && !wantv && jobV != lapack.GSVDNone:
+		panic(badGSVDJob + "V")
+	case !initq && !wantq && jobQ != lapack.GSVDNone:
+		panic(badGSVDJob + "Q")
+	case m < 0:
+		panic(mLT0)
+	case p < 0:
+		panic(pLT0)
+	case n < 0:
+		panic(nLT0)
+
+	case lda < max(1, n):
+		panic(badLdA)
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+
+	case ldb < max(1, n):
+		panic(badLdB)
+	case len(b) < (p-1)*ldb+n:
+		panic(shortB)
+
+	case len(alpha) != n:
+		panic(badLenAlpha)
+	case len(beta) != n:
+		panic(badLenBeta)
+
+	case ldu < 1, wantu && ldu < m:
+		panic(badLdU)
+	case wantu && len(u) < (m-1)*ldu+m:
+		panic(shortU)
+
+	case ldv < 1, wantv && ldv < p:
+		panic(badLdV)
+	case wantv && len(v) < (p-1)*ldv+p:
+		panic(shortV)
+
+	case ldq < 1, wantq && ldq < n:
+		panic(badLdQ)
+	case wantq && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+
+	case len(work) < 2*n:
+		panic(shortWork)
+	}
+
+	// Initialize U, V and Q, if necessary
+	if initu {
+		impl.Zlaset(blas.All, m, m, 0, 1, u, ldu)
+	}
+	if initv {
+		impl.Zlaset(blas.All, p, p, 0, 1, v, ldv)
+	}
+	if initq {
+		impl.Zlaset(blas.All, n, n, 0, 1, q, ldq)
+	}
+
+	bi := cblas128.Implementation()
+	minTol := math.Min(tola, tolb)
+
+	// Loop until convergence.
+	upper := false
+	for cycles = 1; cycles <= maxit; cycles++ {
+		upper = !upper
+
+		for i := 0; i < l-1; i++ {
+			for j := i + 1; j < l; j++ {
+				var a1, a3 float64
+				var a2 complex128
+				if k+i < m {
+					a1 = real(a[(k+i)*lda+n-l+i])
+				}
+				if k+j < m {
+					a3 = real(a[(k+j)*lda+n-l+j])
+				}
+
+				b1 := real(b[i*ldb+n-l+i])
+				b3 := real(b[j*ldb+n-l+j])
+
+				var b2 complex128
+				if upper {
+					if k+i < m {
+						a2 = a[(k+i)*lda+n-l+j]
+					}
+					b2 = b[i*ldb+n-l+j]
+				} else {
+					if k+j < m {
+						a2 = a[(k+j)*lda+n-l+i]
+					}
+					b2 = b[j*ldb+n-l+i]
+				}
+
+				csu, snu, csv, snv, csq, snq := impl.Zlags2(upper, a1, a2, a3, b1, b2, b3)
+
+				// Update (k+i)-th and (k+j)-th rows of matrix A: U^H*A.
+				if k+j < m {
+					impl.Zrot(l, a[(k+j)*lda+n-l:], 1, a[(k+i)*lda+n-l:], 1, csu, cmplx.Conj(snu))
+				}
+
+				// Update i-th and j-th rows of matrix B: V^H*B.
+				impl.Zrot(l, b[j*ldb+n-l:], 1, b[i*ldb+n-l:], 1, csv, cmplx.Conj(snv))
+
+				// Update (n-l+i)-th and (n-l+j)-th columns of matrices
+				// A and B: A*Q and B*Q.
+				impl.Zrot(min(k+l, m), a[n-l+j:], lda, a[n-l+i:], lda, csq, snq)
+				impl.Zrot(l, b[n-l+j:], ldb, b[n-l+i:], ldb, csq, snq)
+
+				if upper {
+					if k+i < m {
+						a[(k+i)*lda+n-l+j] = 0
+					}
+					b[i*ldb+n-l+j] = 0
+				} else {
+					if k+j < m {
+						a[(k+j)*lda+n-l+i] = 0
+					}
+					b[j*ldb+n-l+i] = 0
+				}
+
+				// Ensure that the diagonal elements of A and B are real.
+				if k+i < m {
+					a[(k+i)*lda+n-l+i] = complex(real(a[(k+i)*lda+n-l+i]), 0)
+				}
+				if k+j < m {
+					a[(k+j)*lda+n-l+j] = complex(real(a[(k+j)*lda+n-l+j]), 0)
+				}
+				b[i*ldb+n-l+i] = complex(real(b[i*ldb+n-l+i]), 0)
+				b[j*ldb+n-l+j] = complex(real(b[j*ldb+n-l+j]), 0)
+
+				// Update unitary matrices U, V, Q, if desired.
+				if wantu && k+j < m {
+					impl.Zrot(m, u[k+j:], ldu, u[k+i:], ldu, csu, snu)
+				}
+				if wantv {
+					impl.Zrot(p, v[j:], ldv, v[i:], ldv, csv, snv)
+				}
+				if wantq {
+					impl.Zrot(n, q[n-l+j:], ldq, q[n-l+i:], ldq, csq, snq)
+				}
+			}
+		}
+
+		if !upper {
+			// The matrices A13 and B13 were lower triangular at the start
+			// of the cycle, and are now upper triangular.
+			//
+			// Convergence test: test the parallelism of the corresponding
+			// rows of A and B.
+			var error float64
+			for i := 0; i < min(l, m-k); i++ {
+				bi.Zcopy(l-i, a[(k+i)*lda+n-l+i:], 1, work, 1)
+				bi.Zcopy(l-i, b[i*ldb+n-l+i:], 1, work[l:], 1)
+				ssmin := impl.Zlapll(l-i, work, 1, work[l:], 1)
+				error = math.Max(error, ssmin)
+			}
+			if math.Abs(error) <= minTol {
+				// The algorithm has converged.
+				// Compute the generalized singular value pairs (alpha, beta)
+				// and set the triangular matrix R to array A.
+				for i := 0; i < k; i++ {
+					alpha[i] = 1
+					beta[i] = 0
+				}
+
+				for i := 0; i < min(l, m-k); i++ {
+					a1 := real(a[(k+i)*lda+n-l+i])
+					b1 := real(b[i*ldb+n-l+i])
+					gamma := b1 / a1
+					if !math.IsInf(gamma, 0) {
+						// Change sign if necessary.
+						if gamma < 0 {
+							bi.Zdscal(l-i, -1, b[i*ldb+n-l+i:], 1)
+							if wantv {
+								bi.Zdscal(p, -1, v[i:], ldv)
+							}
+						}
+						beta[k+i], alpha[k+i], _ = impl.Dlartg(math.Abs(gamma), 1)
+
+						if alpha[k+i] >= beta[k+i] {
+							bi.Zdscal(l-i, 1/alpha[k+i], a[(k+i)*lda+n-l+i:], 1)
+						} else {
+							bi.Zdscal(l-i, 1/beta[k+i], b[i*ldb+n-l+i:], 1)
+							bi.Zcopy(l-i, b[i*ldb+n-l+i:], 1, a[(k+i)*lda+n-l+i:], 1)
+						}
+					} else {
+						alpha[k+i] = 0
+						beta[k+i] = 1
+						bi.Zcopy(l-i, b[i*ldb+n-l+i:], 1, a[(k+i)*lda+n-l+i:], 1)
+					}
+				}
+
+				for i := m; i < k+l; i++ {
+					alpha[i] = 0
+					beta[i] = 1
+				}
+				if k+l < n {
+					for i := k + l; i < n; i++ {
+						alpha[i] = 0
+						beta[i] = 0
+					}
+				}
+
+				return cycles, true
+			}
+		}
+	}
+
+	// The algorithm has not converged after maxit cycles.
+	return cycles, false
+}
@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// Zunmr2 multiplies a general complex matrix C by a unitary matrix from an RQ
+// factorization determined by Zgerq2.
+//
+//	C = Q * C    if side == blas.Left and trans == blas.NoTrans
+//	C = Q^H * C  if side == blas.Left and trans == blas.ConjTrans
+//	C = C * Q    if side == blas.Right and trans == blas.NoTrans
+//	C = C * Q^H  if side == blas.Right and trans == blas.ConjTrans
+//
+// If side == blas.Left, a is a matrix of size k×m, and if side == blas.Right
+// a is of size k×n.
+//
+// tau contains the Householder factors and is of length at least k and this function
+// will panic otherwise.
+//
+// work is temporary storage of length at least n if side == blas.Left
+// and at least m if side == blas.Right and this function will panic otherwise.
+//
+// Zunmr2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zunmr2(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case lda < max(1, nq):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (k-1)*lda+nq:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(work) < nw:
+		panic(shortWork)
+	}
+
+	notrans := trans == blas.NoTrans
+	apply := func(i int) {
+		taui := tau[i]
+		if notrans {
+			taui = cmplx.Conj(taui)
+		}
+		mi, ni := m, n
+		if left {
+			mi = m - k + i + 1
+		} else {
+			ni = n - k + i + 1
+		}
+		j := nq - k + i
+		if j > 0 {
+			impl.Zlacgv(j, a[i*lda:], 1)
+		}
+		aii := a[i*lda+j]
+		a[i*lda+j] = 1
+		impl.Zlarf(side, mi, ni, a[i*lda:], 1, taui, c, ldc, work)
+		a[i*lda+j] = aii
+		if j > 0 {
+			impl.Zlacgv(j, a[i*lda:], 1)
+		}
+	}
+
+	if left == notrans {
+		for i := k - 1; i >= 0; i-- {
+			apply(i)
+		}
+		return
+	}
+	for i := 0; i < k; i++ {
+		apply(i)
+	}
+}
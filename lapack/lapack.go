@@ -7,7 +7,9 @@ package lapack
 import "gonum.org/v1/gonum/blas"
 
 // Complex128 defines the public complex128 LAPACK API supported by gonum/lapack.
-type Complex128 interface{}
+type Complex128 interface {
+	Zggsvd3(jobU, jobV, jobQ GSVDJob, m, n, p int, a []complex128, lda int, b []complex128, ldb int, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128, lwork int, rwork []float64, iwork []int) (k, l int, ok bool)
+}
 
 // Float64 defines the public float64 LAPACK API supported by gonum/lapack.
 type Float64 interface {
@@ -152,6 +154,15 @@ const (
 	EVNone    EVJob = 'N' // Do not compute eigenvectors.
 )
 
+// EVRange specifies which eigenvalues are computed in Dsyevmr.
+type EVRange byte
+
+const (
+	EVRangeAll   EVRange = 'A' // Compute all eigenvalues.
+	EVRangeValue EVRange = 'V' // Compute the eigenvalues in a half-open interval of values.
+	EVRangeIndex EVRange = 'I' // Compute the eigenvalues in a range of indices.
+)
+
 // LeftEVJob specifies whether left eigenvectors are computed in Dgeev.
 type LeftEVJob byte
 
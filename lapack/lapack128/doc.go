@@ -0,0 +1,15 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lapack128 provides a set of convenient wrapper functions for
+// complex128 LAPACK calls, as specified in the netlib standard
+// (www.netlib.org).
+//
+// The native Go routines are used by default, and the Use function can be used
+// to set an alternative implementation.
+//
+// Only a small subset of the complex LAPACK routines is currently provided.
+// Please open up an issue if there is a specific function you need and/or are
+// willing to implement.
+package lapack128 // import "gonum.org/v1/gonum/lapack/lapack128"
@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack128
+
+import (
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/gonum"
+)
+
+var lapack128 lapack.Complex128 = gonum.Implementation{}
+
+// Use sets the LAPACK complex128 implementation to be used by subsequent BLAS calls.
+// The default implementation is native.Implementation.
+func Use(l lapack.Complex128) {
+	lapack128 = l
+}
+
+// Ggsvd3 computes the generalized singular value decomposition (GSVD)
+// of an m×n matrix A and p×n matrix B:
+//
+//	U^H*A*Q = D1*[ 0 R ]
+//
+//	V^H*B*Q = D2*[ 0 R ]
+//
+// where U, V and Q are unitary matrices.
+//
+// Ggsvd3 returns k and l, the dimensions of the sub-blocks. k+l
+// is the effective numerical rank of the (m+p)×n matrix [ A^H B^H ]^H.
+// R is a (k+l)×(k+l) nonsingular upper triangular matrix with a real
+// diagonal, and D1 and D2 are real m×(k+l) and p×(k+l) diagonal matrices
+// with the structure described in the documentation of lapack64.Ggsvd3.
+//
+// jobU, jobV and jobQ are options for computing the unitary matrices. The behavior
+// is as follows
+//
+//	jobU == lapack.GSVDU        Compute unitary matrix U
+//	jobU == lapack.GSVDNone     Do not compute unitary matrix.
+//
+// The behavior is the same for jobV and jobQ with the exception that instead of
+// lapack.GSVDU these accept lapack.GSVDV and lapack.GSVDQ respectively.
+// The matrices U, V and Q must be m×m, p×p and n×n respectively unless the
+// relevant job parameter is lapack.GSVDNone.
+//
+// alpha and beta must have length n or Ggsvd3 will panic. On exit, alpha and
+// beta contain the generalized singular value pairs of A and B
+//
+//	alpha[0:k] = 1,
+//	beta[0:k]  = 0,
+//
+// if m-k-l >= 0,
+//
+//	alpha[k:k+l] = diag(C),
+//	beta[k:k+l]  = diag(S),
+//
+// if m-k-l < 0,
+//
+//	alpha[k:m]= C, alpha[m:k+l]= 0
+//	beta[k:m] = S, beta[m:k+l] = 1.
+//
+// if k+l < n,
+//
+//	alpha[k+l:n] = 0 and
+//	beta[k+l:n]  = 0.
+//
+// On exit, iwork contains the permutation required to sort alpha descending.
+//
+// iwork must have length n, rwork must have length at least 2*n, work must
+// have length at least max(1, lwork), and lwork must be -1 or greater than n,
+// otherwise Ggsvd3 will panic. If lwork is -1, work[0] holds the optimal lwork
+// on return, but Ggsvd3 does not perform the GSVD.
+func Ggsvd3(jobU, jobV, jobQ lapack.GSVDJob, a, b cblas128.General, alpha, beta []float64, u, v, q cblas128.General, work []complex128, lwork int, rwork []float64, iwork []int) (k, l int, ok bool) {
+	return lapack128.Zggsvd3(jobU, jobV, jobQ, a.Rows, a.Cols, b.Rows, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), alpha, beta, u.Data, max(1, u.Stride), v.Data, max(1, v.Stride), q.Data, max(1, q.Stride), work, lwork, rwork, iwork)
+}
@@ -166,6 +166,55 @@ func Pstrf(a blas64.Symmetric, piv []int, tol float64, work []float64) (t blas64
 	return t, rank, ok
 }
 
+// Gbcon estimates the reciprocal of the condition number of the n×n band
+// matrix A given the LU decomposition of the matrix computed by Gbtrf. The
+// condition number computed may be based on the 1-norm or the ∞-norm.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 3*n and Gbcon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Gbcon will panic otherwise.
+//
+// Dgbcon is not part of the lapack.Float64 interface and so calls to Gbcon are
+// always executed by the Gonum implementation.
+func Gbcon(norm lapack.MatrixNorm, a blas64.Band, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	return gonum.Implementation{}.Dgbcon(norm, a.Cols, a.KL, a.KU, a.Data, max(1, a.Stride), ipiv, anorm, work, iwork)
+}
+
+// Gbtrf computes the LU decomposition of the m×n band matrix A with KL
+// sub-diagonals and KU super-diagonals using partial pivoting with row
+// interchanges. The stride of a must be at least 2*KL+KU+1 so that a can hold
+// the KL additional super-diagonals of U introduced by the interchanges. On
+// return, a contains the factorization in the format described by the Gonum
+// implementation of Dgbtrf, and ipiv, which must have length min(m,n),
+// contains the row interchanges.
+//
+// Gbtrf returns whether the matrix A is nonsingular. The LU decomposition will
+// be computed regardless of the singularity of A, but the result should not be
+// used to solve a system of equation.
+//
+// Dgbtrf is not part of the lapack.Float64 interface and so calls to Gbtrf are
+// always executed by the Gonum implementation.
+func Gbtrf(a blas64.Band, ipiv []int) bool {
+	return gonum.Implementation{}.Dgbtrf(a.Rows, a.Cols, a.KL, a.KU, a.Data, max(1, a.Stride), ipiv)
+}
+
+// Gbtrs solves a system of equations
+//
+//	A * X = B   if trans == blas.NoTrans
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n band matrix and a contains its LU decomposition computed
+// by Gbtrf. On entry, b contains the right-hand side matrix B, on return it
+// contains the solution matrix X.
+//
+// Dgbtrs is not part of the lapack.Float64 interface and so calls to Gbtrs are
+// always executed by the Gonum implementation.
+func Gbtrs(trans blas.Transpose, a blas64.Band, b blas64.General, ipiv []int) {
+	gonum.Implementation{}.Dgbtrs(trans, a.Cols, a.KL, a.KU, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
 // Gecon estimates the reciprocal of the condition number of the n×n matrix A
 // given the LU decomposition of the matrix. The condition number computed may
 // be based on the 1-norm or the ∞-norm.
@@ -215,6 +264,36 @@ func Gels(trans blas.Transpose, a blas64.General, b blas64.General, work []float
 	return lapack64.Dgels(trans, a.Rows, a.Cols, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), work, lwork)
 }
 
+// Gelsd computes the minimum-norm solution to the linear least squares
+// problem
+//
+//	minimize over x |b - A*x|_2
+//
+// where A is an m×n matrix which may be rank-deficient, using the singular
+// value decomposition of A. Singular values of A less than or equal to rcond
+// times the largest singular value are treated as zero. If rcond is negative,
+// machine precision is used instead.
+//
+// On entry, b contains the m×nrhs right hand side matrix B and must have at
+// least max(m,n) rows. On return, the first n rows of b contain the solution
+// matrix X. a is overwritten and s, which must have length at least min(m,n),
+// contains the singular values of A in decreasing order.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// lwork must be at least 3*min(m,n) + min(m,n)² + max(4*min(m,n), m, n, nrhs)
+// if min(m,n) > 0 and at least 1 otherwise, and Gelsd will panic otherwise.
+// If lwork == -1, instead of performing Gelsd, the optimal work length will
+// be stored into work[0].
+//
+// Gelsd returns the effective rank of A and whether the singular value
+// decomposition converged.
+//
+// Dgelsd is not part of the lapack.Float64 interface and so calls to Gelsd are
+// always executed by the Gonum implementation.
+func Gelsd(a, b blas64.General, s []float64, rcond float64, work []float64, lwork int) (rank int, ok bool) {
+	return gonum.Implementation{}.Dgelsd(a.Rows, a.Cols, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), s, rcond, work, lwork)
+}
+
 // Geqp3 computes a QR factorization with column pivoting of the m×n matrix A:
 //
 //	A*P = Q*R
@@ -528,6 +607,57 @@ func Ggsvd3(jobU, jobV, jobQ lapack.GSVDJob, a, b blas64.General, alpha, beta []
 	return lapack64.Dggsvd3(jobU, jobV, jobQ, a.Rows, a.Cols, b.Rows, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), alpha, beta, u.Data, max(1, u.Stride), v.Data, max(1, v.Stride), q.Data, max(1, q.Stride), work, lwork, iwork)
 }
 
+// Ggsvp3 computes orthogonal matrices U, V and Q such that
+//
+//	                n-k-l  k    l
+//	Uᵀ*A*Q =     k [ 0    A12  A13 ] if m-k-l >= 0;
+//	             l [ 0     0   A23 ]
+//	         m-k-l [ 0     0    0  ]
+//
+//	                n-k-l  k    l
+//	Uᵀ*A*Q =     k [ 0    A12  A13 ] if m-k-l < 0;
+//	           m-k [ 0     0   A23 ]
+//
+//	                n-k-l  k    l
+//	Vᵀ*B*Q =     l [ 0     0   B13 ]
+//	           p-l [ 0     0    0  ]
+//
+// where the k×k matrix A12 and l×l matrix B13 are non-singular upper
+// triangular, as the preprocessing step of the GSVD computed by Ggsvd3.
+// Ggsvp3 returns k and l, the dimensions of the sub-blocks. k+l is the
+// effective numerical rank of the (m+p)×n matrix [ Aᵀ Bᵀ ]ᵀ determined
+// using the tolerances tola and tolb.
+//
+// iwork must have length n, tau must have length n, work must have length
+// at least max(1, lwork), and lwork must be -1 or greater than zero, otherwise
+// Ggsvp3 will panic. If lwork is -1, work[0] holds the optimal lwork on
+// return, but Ggsvp3 does not perform the preprocessing.
+//
+// Dggsvp3 is not part of the lapack.Float64 interface and so calls to Ggsvp3
+// are always executed by the Gonum implementation.
+func Ggsvp3(jobU, jobV, jobQ lapack.GSVDJob, a, b blas64.General, tola, tolb float64, u, v, q blas64.General, iwork []int, tau, work []float64, lwork int) (k, l int) {
+	return gonum.Implementation{}.Dggsvp3(jobU, jobV, jobQ, a.Rows, b.Rows, a.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), tola, tolb, u.Data, max(1, u.Stride), v.Data, max(1, v.Stride), q.Data, max(1, q.Stride), iwork, tau, work, lwork)
+}
+
+// Tgsja computes the generalized singular value decomposition (GSVD) of two
+// real upper triangular or trapezoidal matrices A and B that have the form
+// returned by Ggsvp3 with sub-block dimensions k and l. tola and tolb are
+// the convergence criteria for the Jacobi-Kogbetliantz iteration procedure.
+// On return, alpha and beta contain the generalized singular value pairs of
+// A and B as described for Ggsvd3.
+//
+// work must have length at least 2*n, where n is the number of columns of A
+// and B.
+//
+// Tgsja returns the number of iteration cycles that were run and whether
+// the procedure converged.
+//
+// Dtgsja is not part of the lapack.Float64 interface and so calls to Tgsja
+// are always executed by the Gonum implementation.
+func Tgsja(jobU, jobV, jobQ lapack.GSVDJob, k, l int, a, b blas64.General, tola, tolb float64, alpha, beta []float64, u, v, q blas64.General, work []float64) (cycles int, ok bool) {
+	return gonum.Implementation{}.Dtgsja(jobU, jobV, jobQ, a.Rows, b.Rows, a.Cols, k, l, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), tola, tolb, alpha, beta, u.Data, max(1, u.Stride), v.Data, max(1, v.Stride), q.Data, max(1, q.Stride), work)
+}
+
 // Gtsv solves one of the equations
 //
 //	A * X = B   if trans == blas.NoTrans
@@ -799,6 +929,40 @@ func Syev(jobz lapack.EVJob, a blas64.Symmetric, w, work []float64, lwork int) (
 	return lapack64.Dsyev(jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), w, work, lwork)
 }
 
+// Syevmr computes selected eigenvalues and, optionally, eigenvectors of a real
+// symmetric matrix A by a variant of the method of Multiple Relatively Robust
+// Representations. It is not the reference LAPACK dsyevr algorithm; see
+// gonum.Implementation.Dsyevmr for details.
+//
+// rng specifies the eigenvalues that are computed: all eigenvalues if
+// rng == lapack.EVRangeAll, the eigenvalues in the half-open interval (vl,vu]
+// if rng == lapack.EVRangeValue, or the il-th through iu-th smallest
+// eigenvalues, counting from zero, if rng == lapack.EVRangeIndex.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. On return, that portion of a is overwritten.
+//
+// Syevmr returns the number of eigenvalues found, m, and the first m elements
+// of w contain them in ascending order. w must have length at least n. If
+// jobz == lapack.EVCompute, the first m columns of z contain the orthonormal
+// eigenvectors of A corresponding to the eigenvalues. z must have n rows, and
+// iu-il+1 columns if rng == lapack.EVRangeIndex and n columns otherwise.
+//
+// Work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= max(1,30*n), and Syevmr will panic otherwise. If
+// lwork == -1, instead of computing Syevmr the optimal work length is stored
+// into work[0]. iwork must have length at least 4*n.
+//
+// Syevmr returns whether the computation converged. If it did not, m and the
+// ascending order of w are as above, but some eigenvalues and eigenvectors
+// are inaccurate.
+//
+// Dsyevmr is not part of the lapack.Float64 interface and so calls to Syevmr are
+// always executed by the Gonum implementation.
+func Syevmr(jobz lapack.EVJob, rng lapack.EVRange, a blas64.Symmetric, vl, vu float64, il, iu int, abstol float64, w []float64, z blas64.General, work []float64, lwork int, iwork []int) (m int, ok bool) {
+	return gonum.Implementation{}.Dsyevmr(jobz, rng, a.Uplo, a.N, a.Data, max(1, a.Stride), vl, vu, il, iu, abstol, w, z.Data, max(1, z.Stride), work, lwork, iwork)
+}
+
 // Tbtrs solves a triangular system of the form
 //
 //	A * X = B   if trans == blas.NoTrans
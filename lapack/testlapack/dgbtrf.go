@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+type Dgbtrfer interface {
+	Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+}
+
+// DgbtrfTest tests Dgbtrf by generating a random m×n band matrix A, computing
+// its LU factorization and checking that the product P*L*U reconstructs A.
+func DgbtrfTest(t *testing.T, impl Dgbtrfer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 31} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31} {
+			for _, kl := range []int{0, 1, 2, 5, 12} {
+				for _, ku := range []int{0, 1, 2, 5, 12} {
+					for _, extra := range []int{0, 3} {
+						dgbtrfTest(t, impl, rnd, m, n, kl, ku, 2*kl+ku+1+extra)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrfTest(t *testing.T, impl Dgbtrfer, rnd *rand.Rand, m, n, kl, ku, ldab int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("m=%v,n=%v,kl=%v,ku=%v,ldab=%v", m, n, kl, ku, ldab)
+
+	ab := randBand(m, n, kl, ku, ldab, rnd)
+	a := bandToGeneral(m, n, kl, ku, ab, ldab)
+
+	mn := min(m, n)
+	ipiv := make([]int, mn)
+	ok := impl.Dgbtrf(m, n, kl, ku, ab, ldab, ipiv)
+	if !ok {
+		t.Fatalf("%v: unexpected singular matrix", name)
+	}
+	if mn == 0 {
+		return
+	}
+
+	// Form the m×n matrix U padded with zero rows.
+	kv := kl + ku
+	lda := n
+	lu := make([]float64, m*lda)
+	for i := 0; i < mn; i++ {
+		for j := i; j <= min(i+kv, n-1); j++ {
+			lu[i*lda+j] = ab[i*ldab+j-i+kl]
+		}
+	}
+	// Apply the multipliers and the row interchanges
+	// in the reverse order of the factorization.
+	for j := mn - 1; j >= 0; j-- {
+		for i := j + 1; i <= min(j+kl, m-1); i++ {
+			l := ab[i*ldab+j-i+kl]
+			for k := 0; k < n; k++ {
+				lu[i*lda+k] += l * lu[j*lda+k]
+			}
+		}
+		if p := ipiv[j]; p != j {
+			for k := 0; k < n; k++ {
+				lu[j*lda+k], lu[p*lda+k] = lu[p*lda+k], lu[j*lda+k]
+			}
+		}
+	}
+
+	var dist float64
+	for i := range a {
+		dist = math.Max(dist, math.Abs(a[i]-lu[i]))
+	}
+	if dist > tol*float64(max(m, n)) {
+		t.Errorf("%v: P*L*U does not reconstruct A; |A - P*L*U| = %v", name, dist)
+	}
+}
+
+// randBand returns an m×n random band matrix with kl sub-diagonals and ku
+// super-diagonals in the storage format used by Dgbtrf. The workspace
+// elements of ab are filled with NaN.
+func randBand(m, n, kl, ku, ldab int, rnd *rand.Rand) []float64 {
+	ab := nanSlice(m * ldab)
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kl); j <= min(i+ku, n-1); j++ {
+			ab[i*ldab+j-i+kl] = rnd.NormFloat64()
+		}
+	}
+	return ab
+}
+
+// bandToGeneral returns the m×n band matrix with kl sub-diagonals and ku
+// super-diagonals stored in ab as a dense matrix with stride n.
+func bandToGeneral(m, n, kl, ku int, ab []float64, ldab int) []float64 {
+	a := make([]float64, m*n)
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kl); j <= min(i+ku, n-1); j++ {
+			a[i*n+j] = ab[i*ldab+j-i+kl]
+		}
+	}
+	return a
+}
@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgbtrser interface {
+	Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int)
+
+	Dgbtrfer
+}
+
+// DgbtrsTest tests Dgbtrs by generating a random n×n band matrix A and
+// checking that the solution X of A*X = B or Aᵀ*X = B has a small residual.
+func DgbtrsTest(t *testing.T, impl Dgbtrser) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 40} {
+		for _, kl := range []int{0, 1, 2, 5, 12} {
+			for _, ku := range []int{0, 1, 2, 5, 12} {
+				for _, nrhs := range []int{0, 1, 2, 5} {
+					for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+						for _, ldab := range []int{2*kl + ku + 1, 2*kl + ku + 4} {
+							for _, ldb := range []int{max(1, nrhs), nrhs + 3} {
+								dgbtrsTest(t, impl, rnd, trans, n, kl, ku, nrhs, ldab, ldb)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrsTest(t *testing.T, impl Dgbtrser, rnd *rand.Rand, trans blas.Transpose, n, kl, ku, nrhs, ldab, ldb int) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("trans=%v,n=%v,kl=%v,ku=%v,nrhs=%v,ldab=%v,ldb=%v", string(trans), n, kl, ku, nrhs, ldab, ldb)
+
+	// Generate a random band matrix with a dominant diagonal.
+	ab := randBand(n, n, kl, ku, ldab, rnd)
+	for i := 0; i < n; i++ {
+		ab[i*ldab+kl] += float64(kl + ku + 1)
+	}
+	a := blas64.General{Rows: n, Cols: n, Stride: max(1, n), Data: bandToGeneral(n, n, kl, ku, ab, ldab)}
+
+	ipiv := make([]int, n)
+	ok := impl.Dgbtrf(n, n, kl, ku, ab, ldab, ipiv)
+	if !ok {
+		t.Fatalf("%v: bad test matrix, Dgbtrf failed", name)
+	}
+
+	b := randomGeneral(n, nrhs, ldb, rnd)
+	x := cloneGeneral(b)
+	impl.Dgbtrs(trans, n, kl, ku, nrhs, ab, ldab, ipiv, x.Data, x.Stride)
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	// Compute the residual B - op(A)*X.
+	blas64.Gemm(trans, blas.NoTrans, -1, a, x, 1, b)
+	resid := dlange(lapack.MaxColumnSum, n, nrhs, b.Data, b.Stride)
+	xnorm := dlange(lapack.MaxColumnSum, n, nrhs, x.Data, x.Stride)
+	anorm := dlange(lapack.MaxColumnSum, n, n, a.Data, a.Stride)
+	if resid > tol*anorm*xnorm*float64(n) {
+		t.Errorf("%v: unexpected residual; |B - op(A)*X| = %v", name, resid)
+	}
+}
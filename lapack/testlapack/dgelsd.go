@@ -0,0 +1,173 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dgelsder interface {
+	Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int) (rank int, ok bool)
+}
+
+// DgelsdTest tests Dgelsd by generating random matrices A with known singular
+// value decompositions and prescribed rank and checking that the computed
+// singular values, effective rank and minimum-norm least squares solution
+// match those obtained from the known decomposition.
+func DgelsdTest(t *testing.T, impl Dgelsder) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 27} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 27} {
+			for _, nrhs := range []int{0, 1, 2, 5} {
+				for _, r := range []int{0, 1, min(m, n) / 2, min(m, n)} {
+					for _, scale := range []float64{1, 1e295} {
+						for _, extra := range []int{0, 3} {
+							for _, wl := range []worklen{minimumWork, optimumWork} {
+								dgelsdTest(t, impl, rnd, m, n, nrhs, min(r, m, n), scale, n+extra, nrhs+extra, wl)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgelsdTest(t *testing.T, impl Dgelsder, rnd *rand.Rand, m, n, nrhs, r int, scale float64, lda, ldb int, wl worklen) {
+	const (
+		rcond = 1e-10
+		tol   = 1e-10
+	)
+
+	name := fmt.Sprintf("m=%v,n=%v,nrhs=%v,rank=%v,scale=%v,lda=%v,ldb=%v,work=%v", m, n, nrhs, r, scale, lda, ldb, wl)
+
+	lda = max(1, lda)
+	ldb = max(1, ldb)
+	mn := min(m, n)
+	mx := max(m, n)
+
+	// Generate A = U * Σ * Vᵀ with r singular values between
+	// scale and 1e-3*scale, and the remaining singular values
+	// well below the rank threshold, or zero if r is zero.
+	sigma := make([]float64, mn)
+	for i := range sigma {
+		switch {
+		case i < r:
+			sigma[i] = scale * math.Pow(10, -3*float64(i)/float64(max(1, r-1)))
+		case r == 0:
+			// A is zero.
+			sigma[i] = 0
+		default:
+			sigma[i] = scale * 1e-14 * rnd.Float64()
+		}
+	}
+	u := randomOrthogonal(m, rnd)
+	v := randomOrthogonal(n, rnd)
+	a := nanGeneral(m, n, lda)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			var aij float64
+			for k := 0; k < mn; k++ {
+				aij += u.Data[i*u.Stride+k] * sigma[k] * v.Data[j*v.Stride+k]
+			}
+			a.Data[i*lda+j] = aij
+		}
+	}
+	b := randomGeneral(mx, nrhs, ldb, rnd)
+	bCopy := cloneGeneral(b)
+
+	// Compute the minimum-norm solution of the rank-r
+	// problem, X = V_r * Σ_r⁻¹ * U_rᵀ * B.
+	want := make([]float64, n*max(1, nrhs))
+	for k := 0; k < r; k++ {
+		for j := 0; j < nrhs; j++ {
+			var c float64
+			for i := 0; i < m; i++ {
+				c += u.Data[i*u.Stride+k] * bCopy.Data[i*ldb+j]
+			}
+			c /= sigma[k]
+			for i := 0; i < n; i++ {
+				want[i*max(1, nrhs)+j] += v.Data[i*v.Stride+k] * c
+			}
+		}
+	}
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = 1
+		if mn > 0 {
+			lwork = 3*mn + mn*mn + max(4*mn, mx, nrhs)
+		}
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dgelsd(m, n, nrhs, a.Data, lda, b.Data, ldb, nil, rcond, work, -1)
+		lwork = int(work[0])
+	}
+	work := make([]float64, lwork)
+	s := make([]float64, mn)
+
+	rank, ok := impl.Dgelsd(m, n, nrhs, a.Data, lda, b.Data, ldb, s, rcond, work, lwork)
+	if !ok {
+		t.Fatalf("%v: Dgelsd did not converge", name)
+	}
+	if nrhs == 0 || mn == 0 {
+		return
+	}
+
+	if rank != r {
+		t.Errorf("%v: unexpected rank: got %v, want %v", name, rank, r)
+	}
+	for i, sv := range s {
+		want := sigma[i]
+		if math.Abs(sv-want) > tol*scale {
+			t.Errorf("%v: unexpected singular value %v: got %v, want %v", name, i, sv, want)
+		}
+	}
+
+	var maxErr, maxX float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			w := want[i*nrhs+j]
+			maxErr = math.Max(maxErr, math.Abs(b.Data[i*ldb+j]-w))
+			maxX = math.Max(maxX, math.Abs(w))
+		}
+	}
+	if maxErr > tol*math.Max(maxX, 1/scale) {
+		t.Errorf("%v: unexpected solution; max error = %v, max |x| = %v", name, maxErr, maxX)
+	}
+
+	// Check that the columns of the residual B - A*X
+	// are orthogonal to the range of A.
+	if r == mn {
+		aCopy := blas64.General{Rows: m, Cols: n, Stride: n, Data: make([]float64, m*n)}
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				var aij float64
+				for k := 0; k < mn; k++ {
+					aij += u.Data[i*u.Stride+k] * sigma[k] * v.Data[j*v.Stride+k]
+				}
+				aCopy.Data[i*n+j] = aij
+			}
+		}
+		x := blas64.General{Rows: n, Cols: nrhs, Stride: ldb, Data: b.Data}
+		resid := cloneGeneral(blas64.General{Rows: m, Cols: nrhs, Stride: ldb, Data: bCopy.Data})
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, aCopy, x, 1, resid)
+		atr := zeros(n, nrhs, nrhs)
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, aCopy, resid, 0, atr)
+		for _, v := range atr.Data {
+			if math.Abs(v) > tol*scale*float64(m) {
+				t.Errorf("%v: residual not orthogonal to the range of A: |Aᵀ*(B-A*X)| = %v", name, v)
+				break
+			}
+		}
+	}
+}
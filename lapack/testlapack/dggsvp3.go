@@ -24,6 +24,8 @@ func Dggsvp3Test(t *testing.T, impl Dggsvp3er) {
 	rnd := rand.New(rand.NewPCG(1, 1))
 	for cas, test := range []struct {
 		m, p, n, lda, ldb, ldu, ldv, ldq int
+
+		rank int // rank is the rank of A if non-zero.
 	}{
 		{m: 3, p: 3, n: 5, lda: 0, ldb: 0, ldu: 0, ldv: 0, ldq: 0},
 		{m: 5, p: 5, n: 5, lda: 0, ldb: 0, ldu: 0, ldv: 0, ldq: 0},
@@ -44,6 +46,9 @@ func Dggsvp3Test(t *testing.T, impl Dggsvp3er) {
 		{m: 10, p: 5, n: 5, lda: 10, ldb: 10, ldu: 20, ldv: 10, ldq: 10},
 		{m: 10, p: 10, n: 10, lda: 20, ldb: 20, ldu: 20, ldv: 20, ldq: 20},
 		{m: 10, p: 10, n: 10, lda: 20, ldb: 20, ldu: 20, ldv: 20, ldq: 20},
+		{m: 10, p: 2, n: 10, lda: 0, ldb: 0, ldu: 0, ldv: 0, ldq: 0, rank: 4},
+		{m: 5, p: 3, n: 10, lda: 0, ldb: 0, ldu: 0, ldv: 0, ldq: 0, rank: 3},
+		{m: 10, p: 2, n: 10, lda: 20, ldb: 20, ldu: 20, ldv: 20, ldq: 20, rank: 4},
 	} {
 		m := test.m
 		p := test.p
@@ -70,6 +75,12 @@ func Dggsvp3Test(t *testing.T, impl Dggsvp3er) {
 		}
 
 		a := randomGeneral(m, n, lda, rnd)
+		if test.rank != 0 {
+			// Construct a rank deficient A.
+			x := randomGeneral(m, test.rank, test.rank, rnd)
+			y := randomGeneral(test.rank, n, n, rnd)
+			blas64.Gemm(blas.NoTrans, blas.NoTrans, 1/float64(test.rank), x, y, 0, a)
+		}
 		aCopy := cloneGeneral(a)
 		b := randomGeneral(p, n, ldb, rnd)
 		bCopy := cloneGeneral(b)
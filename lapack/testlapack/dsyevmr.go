@@ -0,0 +1,222 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyevmrer interface {
+	Dsyever
+	Dsyevmr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork []int) (m int, ok bool)
+}
+
+func DsyevmrTest(t *testing.T, impl Dsyevmrer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 21, 50, 100} {
+		for _, kind := range []string{"random", "cluster", "multiple", "graded", "identity", "zero", "wilkinson"} {
+			a := dsyevmrMatrix(kind, n, rnd)
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				dsyevmrTest(t, impl, kind, uplo, a)
+			}
+		}
+	}
+}
+
+// dsyevmrMatrix returns an n×n symmetric matrix of the given kind.
+func dsyevmrMatrix(kind string, n int, rnd *rand.Rand) blas64.General {
+	a := blas64.General{
+		Rows:   n,
+		Cols:   n,
+		Stride: max(1, n),
+		Data:   make([]float64, n*max(1, n)),
+	}
+	d := make([]float64, n)
+	switch kind {
+	case "random":
+		for i := range d {
+			d[i] = rnd.NormFloat64()
+		}
+	case "cluster":
+		// Half of the eigenvalues in a tight cluster.
+		for i := range d {
+			if i < n/2 {
+				d[i] = 1 + float64(i)*1e-10
+			} else {
+				d[i] = rnd.NormFloat64()
+			}
+		}
+	case "multiple":
+		// Eigenvalues with high multiplicity.
+		for i := range d {
+			d[i] = float64(i % 3)
+		}
+	case "graded":
+		for i := range d {
+			d[i] = math.Pow(10, -16*float64(i)/float64(max(1, n-1)))
+		}
+	case "identity":
+		for i := range d {
+			d[i] = 1
+		}
+	case "zero":
+	case "wilkinson":
+		// The Wilkinson matrix has pairs of
+		// extremely close eigenvalues.
+		for i := 0; i < n; i++ {
+			a.Data[i*a.Stride+i] = math.Abs(float64(n-1)/2 - float64(i))
+			if i < n-1 {
+				a.Data[i*a.Stride+i+1] = 1
+				a.Data[(i+1)*a.Stride+i] = 1
+			}
+		}
+		return a
+	default:
+		panic("bad kind")
+	}
+	if n > 0 {
+		Dlagsy(n, n-1, d, a.Data, a.Stride, rnd, make([]float64, 2*n))
+	}
+	return a
+}
+
+func dsyevmrTest(t *testing.T, impl Dsyevmrer, kind string, uplo blas.Uplo, a blas64.General) {
+	const tol = 1e-13
+
+	n := a.Rows
+	anrm := math.Max(1, dlange(lapack.MaxAbs, n, n, a.Data, a.Stride))
+
+	// Compute all eigenvalues with Dsyev for reference.
+	want := make([]float64, n)
+	if n > 0 {
+		aCopy := make([]float64, len(a.Data))
+		copy(aCopy, a.Data)
+		work := make([]float64, 3*n)
+		impl.Dsyev(lapack.EVNone, uplo, n, aCopy, a.Stride, want, work, len(work))
+	}
+
+	type rangeCase struct {
+		rng    lapack.EVRange
+		vl, vu float64
+		il, iu int
+		// want are the expected eigenvalues.
+		want []float64
+	}
+	cases := []rangeCase{{rng: lapack.EVRangeAll, want: want}}
+	if n == 0 {
+		cases = append(cases, rangeCase{rng: lapack.EVRangeIndex, il: 0, iu: -1})
+	}
+	for _, r := range [][2]int{{0, 0}, {n - 1, n - 1}, {0, n / 2}, {n / 3, 2 * n / 3}} {
+		if n == 0 {
+			break
+		}
+		cases = append(cases, rangeCase{rng: lapack.EVRangeIndex, il: r[0], iu: r[1], want: want[r[0] : r[1]+1]})
+	}
+	// Value ranges have bounds between eigenvalues that are
+	// well separated, so the expected values are unambiguous.
+	bound := func(b int) (float64, bool) {
+		switch {
+		case n == 0:
+			return float64(2*b - 1), true
+		case b == 0:
+			return want[0] - 1, true
+		case b == n:
+			return want[n-1] + 1, true
+		case want[b]-want[b-1] > 1e-6*anrm:
+			return want[b-1] + (want[b]-want[b-1])/2, true
+		}
+		return 0, false
+	}
+	for _, r := range [][2]int{{0, n}, {0, n / 2}, {n / 4, 3 * n / 4}, {n / 2, n / 2}} {
+		vl, okl := bound(r[0])
+		vu, oku := bound(r[1])
+		if !okl || !oku || !(vl < vu) {
+			continue
+		}
+		cases = append(cases, rangeCase{rng: lapack.EVRangeValue, vl: vl, vu: vu, want: want[r[0]:r[1]]})
+	}
+
+	for _, rc := range cases {
+		for _, jobz := range []lapack.EVJob{lapack.EVCompute, lapack.EVNone} {
+			for _, extra := range []int{0, 3} {
+				for _, optwork := range []bool{true, false} {
+					name := fmt.Sprintf("kind=%v,n=%v,uplo=%c,rng=%c,vl=%v,vu=%v,il=%v,iu=%v,jobz=%c,extra=%v,optwork=%v",
+						kind, n, uplo, rc.rng, rc.vl, rc.vu, rc.il, rc.iu, jobz, extra, optwork)
+					wantz := jobz == lapack.EVCompute
+
+					lda := max(1, n) + extra
+					aCopy := make([]float64, max(0, (n-1)*lda+n))
+					for i := 0; i < n; i++ {
+						copy(aCopy[i*lda:i*lda+n], a.Data[i*a.Stride:i*a.Stride+n])
+					}
+					ncol := n
+					if rc.rng == lapack.EVRangeIndex {
+						ncol = rc.iu - rc.il + 1
+					}
+					ldz := max(1, ncol) + extra
+					var z []float64
+					if wantz {
+						z = nanSlice(max(0, (n-1)*ldz+ncol))
+					}
+					w := nanSlice(n)
+					iwork := make([]int, 4*n)
+
+					lwork := max(1, 30*n)
+					if optwork {
+						work := make([]float64, 1)
+						impl.Dsyevmr(jobz, rc.rng, uplo, n, nil, lda, rc.vl, rc.vu, rc.il, rc.iu, 0, nil, nil, ldz, work, -1, nil)
+						lwork = int(work[0])
+					}
+					work := make([]float64, lwork)
+
+					m, ok := impl.Dsyevmr(jobz, rc.rng, uplo, n, aCopy, lda, rc.vl, rc.vu, rc.il, rc.iu, 0, w, z, ldz, work, lwork, iwork)
+					if !ok {
+						t.Errorf("%v: unexpected failure", name)
+					}
+					if m != len(rc.want) {
+						t.Errorf("%v: unexpected number of eigenvalues: got %v, want %v", name, m, len(rc.want))
+						continue
+					}
+					for i := 0; i < m; i++ {
+						if math.Abs(w[i]-rc.want[i]) > tol*float64(n)*anrm {
+							t.Errorf("%v: unexpected eigenvalue %v: got %v, want %v", name, i, w[i], rc.want[i])
+						}
+					}
+					if !wantz || m == 0 {
+						continue
+					}
+
+					// Check that Z is orthonormal and A*Z = Z*Λ.
+					zm := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z}
+					ztz := blas64.General{Rows: m, Cols: m, Stride: m, Data: make([]float64, m*m)}
+					blas64.Gemm(blas.Trans, blas.NoTrans, 1, zm, zm, 0, ztz)
+					for i := 0; i < m; i++ {
+						ztz.Data[i*m+i] -= 1
+					}
+					if resid := dlange(lapack.MaxAbs, m, m, ztz.Data, m); resid > tol*float64(n) {
+						t.Errorf("%v: eigenvectors not orthonormal: |Zᵀ*Z-I| = %v", name, resid)
+					}
+					az := blas64.General{Rows: n, Cols: m, Stride: m, Data: make([]float64, n*m)}
+					blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, zm, 0, az)
+					for i := 0; i < n; i++ {
+						for j := 0; j < m; j++ {
+							az.Data[i*m+j] -= w[j] * z[i*ldz+j]
+						}
+					}
+					if resid := dlange(lapack.MaxAbs, n, m, az.Data, m); resid > tol*float64(n)*anrm {
+						t.Errorf("%v: unexpected residual: |A*Z-Z*Λ| = %v", name, resid)
+					}
+				}
+			}
+		}
+	}
+}
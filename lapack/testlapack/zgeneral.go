@@ -0,0 +1,204 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// nanZGeneral allocates a new r×c complex general matrix filled with NaN values.
+func nanZGeneral(r, c, stride int) cblas128.General {
+	if r < 0 || c < 0 {
+		panic("bad matrix size")
+	}
+	if r == 0 || c == 0 {
+		return cblas128.General{Stride: max(1, stride)}
+	}
+	if stride < c {
+		panic("bad stride")
+	}
+	data := make([]complex128, (r-1)*stride+c)
+	for i := range data {
+		data[i] = cmplx.NaN()
+	}
+	return cblas128.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: stride,
+		Data:   data,
+	}
+}
+
+// randomZGeneral allocates a new r×c complex general matrix filled with random
+// numbers. Out-of-range elements are filled with NaN values.
+func randomZGeneral(r, c, stride int, rnd *rand.Rand) cblas128.General {
+	ans := nanZGeneral(r, c, stride)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			ans.Data[i*ans.Stride+j] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+	}
+	return ans
+}
+
+// zerosZGeneral returns an m×n complex matrix with given stride filled with zeros.
+func zerosZGeneral(m, n, stride int) cblas128.General {
+	a := nanZGeneral(m, n, stride)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			a.Data[i*a.Stride+j] = 0
+		}
+	}
+	return a
+}
+
+// cloneZGeneral allocates and returns an exact copy of the given complex
+// general matrix.
+func cloneZGeneral(a cblas128.General) cblas128.General {
+	c := a
+	c.Data = make([]complex128, len(a.Data))
+	copy(c.Data, a.Data)
+	return c
+}
+
+// copyZGeneral copies the leading elements of src into dst.
+func copyZGeneral(dst, src cblas128.General) {
+	r := min(dst.Rows, src.Rows)
+	c := min(dst.Cols, src.Cols)
+	for i := 0; i < r; i++ {
+		copy(dst.Data[i*dst.Stride:i*dst.Stride+c], src.Data[i*src.Stride:i*src.Stride+c])
+	}
+}
+
+// equalApproxZGeneral returns whether the complex general matrices a and b
+// are approximately equal within given tolerance.
+func equalApproxZGeneral(a, b cblas128.General, tol float64) bool {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		panic("bad input")
+	}
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			diff := cmplx.Abs(a.Data[i*a.Stride+j] - b.Data[i*b.Stride+j])
+			if math.IsNaN(diff) || diff > tol {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// residualUnitary returns the residual
+//
+//	|I - Q * Q^H|  if m < n or (m == n and rowwise == true),
+//	|I - Q^H * Q|  otherwise.
+//
+// It can be used to check that the complex matrix Q is unitary.
+func residualUnitary(q cblas128.General, rowwise bool) float64 {
+	m, n := q.Rows, q.Cols
+	if m == 0 || n == 0 {
+		return 0
+	}
+	minmn := min(m, n)
+	work := cblas128.General{
+		Rows:   minmn,
+		Cols:   minmn,
+		Stride: minmn,
+		Data:   make([]complex128, minmn*minmn),
+	}
+	for i := 0; i < minmn; i++ {
+		work.Data[i*work.Stride+i] = 1
+	}
+	if m < n || (m == n && rowwise) {
+		cblas128.Gemm(blas.NoTrans, blas.ConjTrans, -1, q, q, 1, work)
+	} else {
+		cblas128.Gemm(blas.ConjTrans, blas.NoTrans, -1, q, q, 1, work)
+	}
+	var resid float64
+	for _, v := range work.Data {
+		resid = math.Max(resid, cmplx.Abs(v))
+	}
+	return resid / float64(minmn)
+}
+
+// constructZGSVDresults returns the matrices [ 0 R ], D1 and D2 described
+// in the documentation of Ztgsja and Zggsvd3, and the result matrix in
+// the documentation for Zggsvp3.
+func constructZGSVDresults(n, p, m, k, l int, a, b cblas128.General, alpha, beta []float64) (zeroR, d1, d2 cblas128.General) {
+	// [ 0 R ]
+	zeroR = zerosZGeneral(k+l, n, n)
+	dst := zeroR
+	dst.Rows = min(m, k+l)
+	dst.Cols = k + l
+	dst.Data = zeroR.Data[n-k-l:]
+	src := a
+	src.Rows = min(m, k+l)
+	src.Cols = k + l
+	src.Data = a.Data[n-k-l:]
+	copyZGeneral(dst, src)
+	if m < k+l {
+		// [ 0 R ]
+		dst.Rows = k + l - m
+		dst.Cols = k + l - m
+		dst.Data = zeroR.Data[m*zeroR.Stride+n-(k+l-m):]
+		src = b
+		src.Rows = k + l - m
+		src.Cols = k + l - m
+		src.Data = b.Data[(m-k)*b.Stride+n+m-k-l:]
+		copyZGeneral(dst, src)
+	}
+
+	// D1
+	d1 = zerosZGeneral(m, k+l, k+l)
+	for i := 0; i < k; i++ {
+		d1.Data[i*d1.Stride+i] = 1
+	}
+	for i := k; i < min(m, k+l); i++ {
+		d1.Data[i*d1.Stride+i] = complex(alpha[i], 0)
+	}
+
+	// D2
+	d2 = zerosZGeneral(p, k+l, k+l)
+	for i := 0; i < min(l, m-k); i++ {
+		d2.Data[i*d2.Stride+i+k] = complex(beta[k+i], 0)
+	}
+	for i := m - k; i < l; i++ {
+		d2.Data[i*d2.Stride+i+k] = 1
+	}
+
+	return zeroR, d1, d2
+}
+
+// constructZGSVPresults returns the matrices [ 0 A12 A13; 0 0 A23 ] and
+// [ 0 0 B13 ] described in the documentation of Zggsvp3.
+func constructZGSVPresults(n, p, m, k, l int, a, b cblas128.General) (zeroA, zeroB cblas128.General) {
+	zeroA = zerosZGeneral(m, n, n)
+	dst := zeroA
+	dst.Rows = min(m, k+l)
+	dst.Cols = k + l
+	dst.Data = zeroA.Data[n-k-l:]
+	src := a
+	src.Rows = min(m, k+l)
+	src.Cols = k + l
+	src.Data = a.Data[n-k-l:]
+	copyZGeneral(dst, src)
+
+	zeroB = zerosZGeneral(p, n, n)
+	dst = zeroB
+	dst.Rows = l
+	dst.Cols = l
+	dst.Data = zeroB.Data[n-l:]
+	src = b
+	src.Rows = l
+	src.Cols = l
+	src.Data = b.Data[n-l:]
+	copyZGeneral(dst, src)
+
+	return zeroA, zeroB
+}
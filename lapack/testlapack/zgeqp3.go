@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zgeqp3er interface {
+	Zgeqp3(m, n int, a []complex128, lda int, jpvt []int, tau, work []complex128, lwork int, rwork []float64)
+	Zung2r(m, n, k int, a []complex128, lda int, tau []complex128, work []complex128)
+	Zlapmt(forward bool, m, n int, x []complex128, ldx int, k []int)
+}
+
+func Zgeqp3Test(t *testing.T, impl Zgeqp3er) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 12, 23} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 12, 23} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				zgeqp3Test(t, impl, rnd, m, n, lda)
+			}
+		}
+	}
+}
+
+func zgeqp3Test(t *testing.T, impl Zgeqp3er, rnd *rand.Rand, m, n, lda int) {
+	const (
+		tol = 1e-14
+
+		all = iota
+		some
+		none
+	)
+	for _, free := range []int{all, some, none} {
+		name := fmt.Sprintf("m=%d,n=%d,lda=%d,free=%d", m, n, lda, free)
+
+		// Allocate m×n matrix A and fill it with random numbers.
+		a := randomZGeneral(m, n, lda, rnd)
+		// Store a copy of A for later comparison.
+		aCopy := cloneZGeneral(a)
+		// Allocate a slice of column pivots.
+		jpvt := make([]int, n)
+		for j := range jpvt {
+			switch free {
+			case all:
+				jpvt[j] = -1
+			case some:
+				jpvt[j] = rnd.IntN(2) - 1 // -1 or 0
+			case none:
+				jpvt[j] = 0
+			}
+		}
+		k := min(m, n)
+		tau := make([]complex128, k)
+		rwork := make([]float64, 2*n)
+
+		// Get optimal workspace size for Zgeqp3.
+		work := make([]complex128, 1)
+		impl.Zgeqp3(m, n, a.Data, a.Stride, jpvt, tau, work, -1, rwork)
+		lwork := int(real(work[0]))
+		work = make([]complex128, lwork)
+
+		// Compute a QR factorization of A with column pivoting.
+		impl.Zgeqp3(m, n, a.Data, a.Stride, jpvt, tau, work, lwork, rwork)
+
+		if m == 0 || n == 0 {
+			continue
+		}
+
+		// Check that the diagonal of R is real.
+		for i := 0; i < k; i++ {
+			if imag(a.Data[i*a.Stride+i]) != 0 {
+				t.Errorf("%v: R[%d,%d] not real: %v", name, i, i, a.Data[i*a.Stride+i])
+			}
+		}
+		if free == all {
+			// Check that the magnitude of the diagonal of R is
+			// non-increasing.
+			for i := 1; i < k; i++ {
+				prev := math.Abs(real(a.Data[(i-1)*a.Stride+i-1]))
+				if math.Abs(real(a.Data[i*a.Stride+i])) > prev*(1+tol) {
+					t.Errorf("%v: diagonal of R increases at %d", name, i)
+				}
+			}
+		}
+
+		// Compute Q based on the elementary reflectors stored in A.
+		q := zerosZGeneral(m, m, m)
+		for i := 0; i < m; i++ {
+			for j := 0; j < min(i, k); j++ {
+				q.Data[i*q.Stride+j] = a.Data[i*a.Stride+j]
+			}
+		}
+		impl.Zung2r(m, m, k, q.Data, q.Stride, tau, make([]complex128, m))
+		// Check that Q is unitary.
+		if resid := residualUnitary(q, false); resid > tol*float64(max(m, n)) {
+			t.Errorf("%v: Q not unitary; resid=%v", name, resid)
+		}
+
+		// Copy the upper triangle of A into R.
+		r := zerosZGeneral(m, n, n)
+		for i := 0; i < m; i++ {
+			for j := i; j < n; j++ {
+				r.Data[i*r.Stride+j] = a.Data[i*a.Stride+j]
+			}
+		}
+		// Compute Q*R - A*P.
+		qrap := cloneZGeneral(aCopy)
+		impl.Zlapmt(true, qrap.Rows, qrap.Cols, qrap.Data, qrap.Stride, jpvt)
+		cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, q, r, -1, qrap)
+		var resid float64
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				resid = math.Max(resid, cmplx.Abs(qrap.Data[i*qrap.Stride+j]))
+			}
+		}
+		if resid > tol*float64(max(m, n)) {
+			t.Errorf("%v: |Q*R - A*P|=%v", name, resid)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zggsvd3er interface {
+	Zggsvd3(jobU, jobV, jobQ lapack.GSVDJob, m, n, p int, a []complex128, lda int, b []complex128, ldb int, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128, lwork int, rwork []float64, iwork []int) (k, l int, ok bool)
+}
+
+func Zggsvd3Test(t *testing.T, impl Zggsvd3er) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for cas, test := range []struct {
+		m, p, n, lda, ldb, ldu, ldv, ldq int
+
+		rankB int // rank of B; zero means full rank.
+
+		ok bool
+	}{
+		{m: 3, p: 3, n: 5, ok: true},
+		{m: 5, p: 5, n: 5, ok: true},
+		{m: 5, p: 5, n: 10, ok: true},
+		{m: 10, p: 5, n: 5, ok: true},
+		{m: 10, p: 10, n: 10, ok: true},
+		{m: 3, p: 7, n: 5, ok: true},
+		{m: 2, p: 3, n: 6, ok: true},
+		{m: 6, p: 6, n: 4, rankB: 2, ok: true},
+		{m: 5, p: 5, n: 5, lda: 10, ldb: 10, ldu: 10, ldv: 10, ldq: 10, ok: true},
+		{m: 5, p: 5, n: 10, lda: 20, ldb: 20, ldu: 10, ldv: 10, ldq: 20, ok: true},
+		{m: 10, p: 5, n: 5, lda: 10, ldb: 10, ldu: 20, ldv: 10, ldq: 10, ok: true},
+		{m: 10, p: 10, n: 10, lda: 20, ldb: 20, ldu: 20, ldv: 20, ldq: 20, ok: true},
+	} {
+		m := test.m
+		p := test.p
+		n := test.n
+		lda := test.lda
+		if lda == 0 {
+			lda = n
+		}
+		ldb := test.ldb
+		if ldb == 0 {
+			ldb = n
+		}
+		ldu := test.ldu
+		if ldu == 0 {
+			ldu = m
+		}
+		ldv := test.ldv
+		if ldv == 0 {
+			ldv = p
+		}
+		ldq := test.ldq
+		if ldq == 0 {
+			ldq = n
+		}
+
+		a := randomZGeneral(m, n, lda, rnd)
+		aCopy := cloneZGeneral(a)
+		var b cblas128.General
+		if test.rankB == 0 {
+			b = randomZGeneral(p, n, ldb, rnd)
+		} else {
+			// Construct a rank deficient B as a product of
+			// random p×rankB and rankB×n matrices.
+			b = nanZGeneral(p, n, ldb)
+			cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1,
+				randomZGeneral(p, test.rankB, test.rankB, rnd),
+				randomZGeneral(test.rankB, n, n, rnd),
+				0, b)
+		}
+		bCopy := cloneZGeneral(b)
+
+		alpha := make([]float64, n)
+		beta := make([]float64, n)
+
+		u := nanZGeneral(m, m, ldu)
+		v := nanZGeneral(p, p, ldv)
+		q := nanZGeneral(n, n, ldq)
+
+		rwork := make([]float64, 2*n)
+		iwork := make([]int, n)
+
+		work := []complex128{0}
+		impl.Zggsvd3(lapack.GSVDU, lapack.GSVDV, lapack.GSVDQ,
+			m, n, p,
+			a.Data, a.Stride,
+			b.Data, b.Stride,
+			alpha, beta,
+			u.Data, u.Stride,
+			v.Data, v.Stride,
+			q.Data, q.Stride,
+			work, -1, rwork, iwork)
+
+		lwork := int(real(work[0]))
+		work = make([]complex128, lwork)
+
+		k, l, ok := impl.Zggsvd3(lapack.GSVDU, lapack.GSVDV, lapack.GSVDQ,
+			m, n, p,
+			a.Data, a.Stride,
+			b.Data, b.Stride,
+			alpha, beta,
+			u.Data, u.Stride,
+			v.Data, v.Stride,
+			q.Data, q.Stride,
+			work, lwork, rwork, iwork)
+
+		if !ok {
+			if test.ok {
+				t.Errorf("test %d unexpectedly did not converge", cas)
+			}
+			continue
+		}
+
+		if test.rankB != 0 && l != test.rankB {
+			t.Errorf("test %d: unexpected rank of B: got %d, want %d", cas, l, test.rankB)
+		}
+
+		// Check that U, V and Q are unitary.
+		if resid := residualUnitary(u, false); resid > tol {
+			t.Errorf("Case %v: U is not unitary; resid=%v, want<=%v", cas, resid, tol)
+		}
+		if resid := residualUnitary(v, false); resid > tol {
+			t.Errorf("Case %v: V is not unitary; resid=%v, want<=%v", cas, resid, tol)
+		}
+		if resid := residualUnitary(q, false); resid > tol {
+			t.Errorf("Case %v: Q is not unitary; resid=%v, want<=%v", cas, resid, tol)
+		}
+
+		// Check C^2 + S^2 = I.
+		var elements []float64
+		if m-k-l >= 0 {
+			elements = alpha[k : k+l]
+		} else {
+			elements = alpha[k:m]
+		}
+		for i := range elements {
+			i += k
+			d := alpha[i]*alpha[i] + beta[i]*beta[i]
+			if !scalar.EqualWithinAbsOrRel(d, 1, tol, tol) {
+				t.Errorf("test %d: alpha_%d^2 + beta_%d^2 != 1: got: %v", cas, i, i, d)
+			}
+		}
+
+		zeroR, d1, d2 := constructZGSVDresults(n, p, m, k, l, a, b, alpha, beta)
+
+		// Check that the diagonal of R is real.
+		for i := 0; i < k+l; i++ {
+			if r := zeroR.Data[i*zeroR.Stride+n-k-l+i]; imag(r) != 0 {
+				t.Errorf("test %d: R[%d,%d] not real: %v", cas, i, i, r)
+			}
+		}
+
+		// Check U^H*A*Q = D1*[ 0 R ].
+		uTmp := nanZGeneral(m, n, n)
+		cblas128.Gemm(blas.ConjTrans, blas.NoTrans, 1, u, aCopy, 0, uTmp)
+		uAns := nanZGeneral(m, n, n)
+		cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, uTmp, q, 0, uAns)
+
+		d10r := nanZGeneral(m, n, n)
+		cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, d1, zeroR, 0, d10r)
+
+		if !equalApproxZGeneral(uAns, d10r, tol) {
+			t.Errorf("test %d: U^H*A*Q != D1*[ 0 R ]\nU^H*A*Q:\n%+v\nD1*[ 0 R ]:\n%+v",
+				cas, uAns, d10r)
+		}
+
+		// Check V^H*B*Q = D2*[ 0 R ].
+		vTmp := nanZGeneral(p, n, n)
+		cblas128.Gemm(blas.ConjTrans, blas.NoTrans, 1, v, bCopy, 0, vTmp)
+		vAns := nanZGeneral(p, n, n)
+		cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, vTmp, q, 0, vAns)
+
+		d20r := nanZGeneral(p, n, n)
+		cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, d2, zeroR, 0, d20r)
+
+		if !equalApproxZGeneral(vAns, d20r, tol*float64(n)) {
+			t.Errorf("test %d: V^H*B*Q != D2*[ 0 R ]\nV^H*B*Q:\n%+v\nD2*[ 0 R ]:\n%+v",
+				cas, vAns, d20r)
+		}
+	}
+}
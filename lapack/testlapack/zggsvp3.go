@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zggsvp3er interface {
+	Zlange(norm lapack.MatrixNorm, m, n int, a []complex128, lda int, work []float64) float64
+	Zggsvp3(jobU, jobV, jobQ lapack.GSVDJob, m, p, n int, a []complex128, lda int, b []complex128, ldb int, tola, tolb float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, iwork []int, rwork []float64, tau, work []complex128, lwork int) (k, l int)
+}
+
+func Zggsvp3Test(t *testing.T, impl Zggsvp3er) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for cas, test := range []struct {
+		m, p, n, lda, ldb, ldu, ldv, ldq int
+	}{
+		{m: 3, p: 3, n: 5, lda: 0, ldb: 0, ldu: 0, ldv: 0, ldq: 0},
+		{m: 5, p: 5, n: 5, lda: 0, ldb: 0, ldu: 0, ldv: 0, ldq: 0},
+		{m: 5, p: 5, n: 10, lda: 0, ldb: 0, ldu: 0, ldv: 0, ldq: 0},
+		{m: 10, p: 5, n: 5, lda: 0, ldb: 0, ldu: 0, ldv: 0, ldq: 0},
+		{m: 10, p: 10, n: 10, lda: 0, ldb: 0, ldu: 0, ldv: 0, ldq: 0},
+		{m: 3, p: 7, n: 5, lda: 0, ldb: 0, ldu: 0, ldv: 0, ldq: 0},
+		{m: 5, p: 5, n: 5, lda: 10, ldb: 10, ldu: 10, ldv: 10, ldq: 10},
+		{m: 5, p: 5, n: 10, lda: 20, ldb: 20, ldu: 10, ldv: 10, ldq: 20},
+		{m: 10, p: 5, n: 5, lda: 10, ldb: 10, ldu: 20, ldv: 10, ldq: 10},
+		{m: 10, p: 10, n: 10, lda: 20, ldb: 20, ldu: 20, ldv: 20, ldq: 20},
+	} {
+		m := test.m
+		p := test.p
+		n := test.n
+		lda := test.lda
+		if lda == 0 {
+			lda = n
+		}
+		ldb := test.ldb
+		if ldb == 0 {
+			ldb = n
+		}
+		ldu := test.ldu
+		if ldu == 0 {
+			ldu = m
+		}
+		ldv := test.ldv
+		if ldv == 0 {
+			ldv = p
+		}
+		ldq := test.ldq
+		if ldq == 0 {
+			ldq = n
+		}
+
+		a := randomZGeneral(m, n, lda, rnd)
+		aCopy := cloneZGeneral(a)
+		b := randomZGeneral(p, n, ldb, rnd)
+		bCopy := cloneZGeneral(b)
+
+		tola := float64(max(m, n)) * impl.Zlange(lapack.Frobenius, m, n, a.Data, a.Stride, nil) * dlamchE
+		tolb := float64(max(p, n)) * impl.Zlange(lapack.Frobenius, p, n, b.Data, b.Stride, nil) * dlamchE
+
+		u := nanZGeneral(m, m, ldu)
+		v := nanZGeneral(p, p, ldv)
+		q := nanZGeneral(n, n, ldq)
+
+		iwork := make([]int, n)
+		rwork := make([]float64, 2*n)
+		tau := make([]complex128, n)
+
+		work := []complex128{0}
+		impl.Zggsvp3(lapack.GSVDU, lapack.GSVDV, lapack.GSVDQ,
+			m, p, n,
+			a.Data, a.Stride,
+			b.Data, b.Stride,
+			tola, tolb,
+			u.Data, u.Stride,
+			v.Data, v.Stride,
+			q.Data, q.Stride,
+			iwork, rwork, tau,
+			work, -1)
+
+		lwork := int(real(work[0]))
+		work = make([]complex128, lwork)
+
+		k, l := impl.Zggsvp3(lapack.GSVDU, lapack.GSVDV, lapack.GSVDQ,
+			m, p, n,
+			a.Data, a.Stride,
+			b.Data, b.Stride,
+			tola, tolb,
+			u.Data, u.Stride,
+			v.Data, v.Stride,
+			q.Data, q.Stride,
+			iwork, rwork, tau,
+			work, lwork)
+
+		// Check that U, V and Q are unitary.
+		if resid := residualUnitary(u, false); resid > tol {
+			t.Errorf("Case %v: U is not unitary; resid=%v, want<=%v", cas, resid, tol)
+		}
+		if resid := residualUnitary(v, false); resid > tol {
+			t.Errorf("Case %v: V is not unitary; resid=%v, want<=%v", cas, resid, tol)
+		}
+		if resid := residualUnitary(q, false); resid > tol {
+			t.Errorf("Case %v: Q is not unitary; resid=%v, want<=%v", cas, resid, tol)
+		}
+
+		zeroA, zeroB := constructZGSVPresults(n, p, m, k, l, a, b)
+
+		// Check U^H*A*Q = [ 0 RA ].
+		uTmp := nanZGeneral(m, n, n)
+		cblas128.Gemm(blas.ConjTrans, blas.NoTrans, 1, u, aCopy, 0, uTmp)
+		uAns := nanZGeneral(m, n, n)
+		cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, uTmp, q, 0, uAns)
+
+		if !equalApproxZGeneral(uAns, zeroA, tol*float64(n)) {
+			t.Errorf("test %d: U^H*A*Q != [ 0 RA ]\nU^H*A*Q:\n%+v\n[ 0 RA ]:\n%+v",
+				cas, uAns, zeroA)
+		}
+
+		// Check V^H*B*Q = [ 0 RB ].
+		vTmp := nanZGeneral(p, n, n)
+		cblas128.Gemm(blas.ConjTrans, blas.NoTrans, 1, v, bCopy, 0, vTmp)
+		vAns := nanZGeneral(p, n, n)
+		cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, vTmp, q, 0, vAns)
+
+		if !equalApproxZGeneral(vAns, zeroB, tol*float64(n)) {
+			t.Errorf("test %d: V^H*B*Q != [ 0 RB ]\nV^H*B*Q:\n%+v\n[ 0 RB ]:\n%+v",
+				cas, vAns, zeroB)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+type Zlags2er interface {
+	Zlags2(upper bool, a1 float64, a2 complex128, a3, b1 float64, b2 complex128, b3 float64) (csu float64, snu complex128, csv float64, snv complex128, csq float64, snq complex128)
+}
+
+func Zlags2Test(t *testing.T, impl Zlags2er) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, upper := range []bool{true, false} {
+		for i := 0; i < 100; i++ {
+			// Generate randomly the elements of a 2×2 matrix A with
+			// a real diagonal
+			//  [ a1 a2 ] or [ a1 0  ]
+			//  [ 0  a3 ]    [ a2 a3 ]
+			a1 := rnd.Float64()
+			a2 := complex(rnd.Float64(), rnd.Float64())
+			a3 := rnd.Float64()
+			// Generate randomly the elements of a 2×2 matrix B.
+			//  [ b1 b2 ] or [ b1 0  ]
+			//  [ 0  b3 ]    [ b2 b3 ]
+			b1 := rnd.Float64()
+			b2 := complex(rnd.Float64(), rnd.Float64())
+			b3 := rnd.Float64()
+
+			// Compute unitary matrices U, V, Q
+			//  U = [        csu snu ], V = [        csv snv ], Q = [        csq snq ]
+			//      [ -conj(snu) csu ]      [ -conj(snv) csv ]      [ -conj(snq) csq ]
+			// that transform A and B.
+			csu, snu, csv, snv, csq, snq := impl.Zlags2(upper, a1, a2, a3, b1, b2, b3)
+
+			// Create U, V, Q explicitly as dense matrices.
+			u := rotation2x2(csu, snu)
+			v := rotation2x2(csv, snv)
+			q := rotation2x2(csq, snq)
+			for _, m := range []struct {
+				name string
+				g    cblas128.General
+			}{{"U", u}, {"V", v}, {"Q", q}} {
+				if resid := residualUnitary(m.g, false); resid > tol {
+					t.Errorf("%s not unitary: resid=%v", m.name, resid)
+				}
+			}
+
+			// Create A and B explicitly as dense matrices.
+			a := cblas128.General{Rows: 2, Cols: 2, Stride: 2}
+			b := cblas128.General{Rows: 2, Cols: 2, Stride: 2}
+			if upper {
+				a.Data = []complex128{complex(a1, 0), a2, 0, complex(a3, 0)}
+				b.Data = []complex128{complex(b1, 0), b2, 0, complex(b3, 0)}
+			} else {
+				a.Data = []complex128{complex(a1, 0), 0, a2, complex(a3, 0)}
+				b.Data = []complex128{complex(b1, 0), 0, b2, complex(b3, 0)}
+			}
+
+			tmp := cblas128.General{Rows: 2, Cols: 2, Stride: 2, Data: make([]complex128, 4)}
+			// Transform A as U^H*A*Q.
+			cblas128.Gemm(blas.ConjTrans, blas.NoTrans, 1, u, a, 0, tmp)
+			cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, tmp, q, 0, a)
+			// Transform B as V^H*B*Q.
+			cblas128.Gemm(blas.ConjTrans, blas.NoTrans, 1, v, b, 0, tmp)
+			cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, tmp, q, 0, b)
+
+			// Extract elements of transformed A and B that should be equal to zero.
+			var gotA, gotB complex128
+			if upper {
+				gotA = a.Data[1]
+				gotB = b.Data[1]
+			} else {
+				gotA = a.Data[2]
+				gotB = b.Data[2]
+			}
+			// Check that they are indeed zero.
+			if !scalar.EqualWithinAbsOrRel(cmplx.Abs(gotA), 0, tol, tol) {
+				t.Errorf("unexpected non-zero value for zero triangle of U^H*A*Q: %v", gotA)
+			}
+			if !scalar.EqualWithinAbsOrRel(cmplx.Abs(gotB), 0, tol, tol) {
+				t.Errorf("unexpected non-zero value for zero triangle of V^H*B*Q: %v", gotB)
+			}
+		}
+	}
+}
+
+// rotation2x2 returns the 2×2 unitary matrix
+//
+//	[        c s ]
+//	[ -conj(s) c ].
+func rotation2x2(c float64, s complex128) cblas128.General {
+	return cblas128.General{
+		Rows:   2,
+		Cols:   2,
+		Stride: 2,
+		Data:   []complex128{complex(c, 0), s, -cmplx.Conj(s), complex(c, 0)},
+	}
+}
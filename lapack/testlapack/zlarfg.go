@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+type Zlarfger interface {
+	Zlarfg(n int, alpha complex128, x []complex128, incX int) (beta, tau complex128)
+}
+
+func ZlarfgTest(t *testing.T, impl Zlarfger) {
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 4, 5, 10} {
+		for _, incX := range []int{1, 4} {
+			for _, scale := range []float64{1, 1e-300} {
+				name := fmt.Sprintf("n=%d,incX=%d,scale=%g", n, incX, scale)
+
+				alpha := complex(scale*rnd.NormFloat64(), scale*rnd.NormFloat64())
+				var x []complex128
+				if n > 1 {
+					x = make([]complex128, 1+(n-2)*incX)
+				}
+				for i := 0; i < n-1; i++ {
+					x[i*incX] = complex(scale*rnd.NormFloat64(), scale*rnd.NormFloat64())
+				}
+				// Store [alpha; x] for later comparison.
+				want := make([]complex128, n)
+				want[0] = alpha
+				for i := 1; i < n; i++ {
+					want[i] = x[(i-1)*incX]
+				}
+
+				beta, tau := impl.Zlarfg(n, alpha, x, incX)
+
+				if imag(beta) != 0 {
+					t.Errorf("%v: beta not real: %v", name, beta)
+				}
+				if tau != 0 && (real(tau) < 1-tol || real(tau) > 2+tol || cmplx.Abs(tau-1) > 1+tol) {
+					t.Errorf("%v: tau out of range: %v", name, tau)
+				}
+
+				// Form v = [1; x] and apply H^H = I - conj(tau) v v^H
+				// to [alpha; x].
+				v := make([]complex128, n)
+				v[0] = 1
+				for i := 1; i < n; i++ {
+					v[i] = x[(i-1)*incX]
+				}
+				var vhw complex128
+				for i := range v {
+					vhw += cmplx.Conj(v[i]) * want[i]
+				}
+				for i := range want {
+					got := want[i] - cmplx.Conj(tau)*v[i]*vhw
+					w := complex128(0)
+					if i == 0 {
+						w = beta
+					}
+					if cmplx.Abs(got-w) > tol*scale*float64(n) {
+						t.Errorf("%v: unexpected element %d of H^H*[alpha; x]: got %v, want %v", name, i, got, w)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigmat_test
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mat/bigmat"
+)
+
+func ExampleLU_SolveTo() {
+	// Construct the 12×12 Hilbert matrix, which has a condition
+	// number near 1e16, with 200 bits of precision.
+	const n = 12
+	a := bigmat.NewDense(n, n, 200, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			h := new(big.Float).SetPrec(200)
+			h.Quo(big.NewFloat(1), big.NewFloat(float64(i+j+1)))
+			a.SetFloat(i, j, h)
+		}
+	}
+
+	// Compute the right-hand side for a solution of all ones.
+	ones := make([]float64, n)
+	for i := range ones {
+		ones[i] = 1
+	}
+	var b bigmat.Dense
+	b.Mul(a, bigmat.NewDense(n, 1, 200, ones))
+
+	var lu bigmat.LU
+	lu.Factorize(a)
+	var x bigmat.Dense
+	err := lu.SolveTo(&x, &b)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("x = %.6f\n", mat.Formatted(x.T(), mat.Squeeze()))
+
+	// Output:
+	// x = [1.000000  1.000000  1.000000  1.000000  1.000000  1.000000  1.000000  1.000000  1.000000  1.000000  1.000000  1.000000]
+}
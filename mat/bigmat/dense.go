@@ -0,0 +1,235 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigmat
+
+import (
+	"math/big"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const zeroPrec = "bigmat: zero precision"
+
+// Dense is a dense matrix of big.Float values stored in row-major order.
+//
+// The zero value of Dense is an empty matrix that may be used as the
+// receiver of the arithmetic methods, which allocate the result.
+type Dense struct {
+	rows, cols int
+	prec       uint
+	data       []big.Float
+}
+
+var _ mat.Matrix = (*Dense)(nil)
+
+// NewDense returns a new r×c matrix with elements of prec bits of
+// precision holding the elements of data in row-major order. If data is
+// nil, a zero matrix is allocated. The elements of data are rounded to
+// prec bits, so they are represented exactly if prec is at least 53.
+//
+// NewDense panics if r or c is not positive, if prec is zero, if data is
+// not nil and its length is not r*c, or if data contains a NaN.
+func NewDense(r, c int, prec uint, data []float64) *Dense {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(mat.ErrZeroLength)
+		}
+		panic(mat.ErrNegativeDimension)
+	}
+	if data != nil && len(data) != r*c {
+		panic(mat.ErrShape)
+	}
+	m := newDense(r, c, prec)
+	for i, v := range data {
+		m.data[i].SetFloat64(v)
+	}
+	return m
+}
+
+// NewDenseFrom returns a new matrix with elements of prec bits of
+// precision holding the elements of a. NewDenseFrom panics if prec is
+// zero or if a contains a NaN.
+func NewDenseFrom(a mat.Matrix, prec uint) *Dense {
+	r, c := a.Dims()
+	if r == 0 || c == 0 {
+		panic(mat.ErrZeroLength)
+	}
+	m := newDense(r, c, prec)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.data[i*c+j].SetFloat64(a.At(i, j))
+		}
+	}
+	return m
+}
+
+// newDense returns a new zero r×c matrix with elements of prec bits of
+// precision.
+func newDense(r, c int, prec uint) *Dense {
+	if prec == 0 {
+		panic(zeroPrec)
+	}
+	m := &Dense{rows: r, cols: c, prec: prec, data: make([]big.Float, r*c)}
+	for i := range m.data {
+		m.data[i].SetPrec(prec)
+	}
+	return m
+}
+
+// reuseAs prepares the receiver to hold an r×c result, allocating it with
+// prec bits of precision if it is empty. reuseAs panics if the receiver
+// is not empty and its dimensions are not r×c.
+func (m *Dense) reuseAs(r, c int, prec uint) {
+	if m.IsEmpty() {
+		*m = *newDense(r, c, prec)
+		return
+	}
+	if m.rows != r || m.cols != c {
+		panic(mat.ErrShape)
+	}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *Dense) Dims() (r, c int) {
+	return m.rows, m.cols
+}
+
+// Prec returns the precision of the matrix elements in bits.
+func (m *Dense) Prec() uint {
+	return m.prec
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations.
+func (m *Dense) IsEmpty() bool {
+	return m.rows == 0
+}
+
+// At returns the element at row i, column j rounded to the nearest
+// float64.
+func (m *Dense) At(i, j int) float64 {
+	f, _ := m.at(i, j).Float64()
+	return f
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// mat.Transpose.
+func (m *Dense) T() mat.Matrix {
+	return mat.Transpose{Matrix: m}
+}
+
+// Float returns a copy of the element at row i, column j.
+func (m *Dense) Float(i, j int) *big.Float {
+	return new(big.Float).Copy(m.at(i, j))
+}
+
+// Set sets the element at row i, column j to the value v rounded to the
+// precision of the matrix. Set panics if v is NaN.
+func (m *Dense) Set(i, j int, v float64) {
+	m.at(i, j).SetFloat64(v)
+}
+
+// SetFloat sets the element at row i, column j to the value v rounded to
+// the precision of the matrix.
+func (m *Dense) SetFloat(i, j int, v *big.Float) {
+	m.at(i, j).Set(v)
+}
+
+// at returns a pointer to the element at row i, column j.
+func (m *Dense) at(i, j int) *big.Float {
+	if uint(i) >= uint(m.rows) {
+		panic(mat.ErrRowAccess)
+	}
+	if uint(j) >= uint(m.cols) {
+		panic(mat.ErrColAccess)
+	}
+	return &m.data[i*m.cols+j]
+}
+
+// Copy returns a copy of the receiver with elements of prec bits of
+// precision. If prec is zero, the precision of the receiver is used.
+func (m *Dense) Copy(prec uint) *Dense {
+	if prec == 0 {
+		prec = m.prec
+	}
+	c := newDense(m.rows, m.cols, prec)
+	for i := range m.data {
+		c.data[i].Set(&m.data[i])
+	}
+	return c
+}
+
+// Add adds a and b element-wise, placing the result in the receiver.
+// If the receiver is empty, it is allocated with the larger of the
+// precisions of a and b. Add panics if the dimensions of a, b and a
+// non-empty receiver do not match.
+func (m *Dense) Add(a, b *Dense) {
+	if a.rows != b.rows || a.cols != b.cols {
+		panic(mat.ErrShape)
+	}
+	m.reuseAs(a.rows, a.cols, max(a.prec, b.prec))
+	for i := range m.data {
+		m.data[i].Add(&a.data[i], &b.data[i])
+	}
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver.
+// If the receiver is empty, it is allocated with the larger of the
+// precisions of a and b. Sub panics if the dimensions of a, b and a
+// non-empty receiver do not match.
+func (m *Dense) Sub(a, b *Dense) {
+	if a.rows != b.rows || a.cols != b.cols {
+		panic(mat.ErrShape)
+	}
+	m.reuseAs(a.rows, a.cols, max(a.prec, b.prec))
+	for i := range m.data {
+		m.data[i].Sub(&a.data[i], &b.data[i])
+	}
+}
+
+// Scale multiplies the elements of a by f, placing the result in the
+// receiver. If the receiver is empty, it is allocated with the precision
+// of a. Scale panics if the dimensions of a and a non-empty receiver do
+// not match.
+func (m *Dense) Scale(f *big.Float, a *Dense) {
+	m.reuseAs(a.rows, a.cols, a.prec)
+	for i := range m.data {
+		m.data[i].Mul(&a.data[i], f)
+	}
+}
+
+// Mul takes the matrix product of a and b, placing the result in the
+// receiver. If the receiver is empty, it is allocated with the larger of
+// the precisions of a and b. Each element of the product is accumulated
+// with the precision of the receiver. Mul panics if the number of columns
+// of a does not equal the number of rows of b, or if the receiver is not
+// empty and does not have the dimensions of the product.
+//
+// The receiver may be a or b.
+func (m *Dense) Mul(a, b *Dense) {
+	if a.cols != b.rows {
+		panic(mat.ErrShape)
+	}
+	prec := max(a.prec, b.prec)
+	if !m.IsEmpty() {
+		prec = m.prec
+	}
+	// Compute into new storage so that the receiver may alias
+	// the operands.
+	p := newDense(a.rows, b.cols, prec)
+	var t big.Float
+	t.SetPrec(prec)
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < b.cols; j++ {
+			s := &p.data[i*p.cols+j]
+			for k := 0; k < a.cols; k++ {
+				t.Mul(&a.data[i*a.cols+k], &b.data[k*b.cols+j])
+				s.Add(s, &t)
+			}
+		}
+	}
+	m.reuseAs(p.rows, p.cols, prec)
+	m.data = p.data
+}
@@ -0,0 +1,183 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigmat
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// randDense returns a random r×c matrix with elements of prec bits of
+// precision holding normally distributed float64 values.
+func randDense(rnd *rand.Rand, r, c int, prec uint) *Dense {
+	data := make([]float64, r*c)
+	for i := range data {
+		data[i] = rnd.NormFloat64()
+	}
+	return NewDense(r, c, prec, data)
+}
+
+// maxDiff returns the maximum absolute difference between the elements
+// of a and b, rounded to float64.
+func maxDiff(a, b *Dense) float64 {
+	var d Dense
+	d.Sub(a, b)
+	var m float64
+	for i := range d.data {
+		v, _ := d.data[i].Float64()
+		m = math.Max(m, math.Abs(v))
+	}
+	return m
+}
+
+func TestDense(t *testing.T) {
+	t.Parallel()
+	m := NewDense(2, 3, 100, []float64{1, 2, 3, 4, 5, 6})
+	if r, c := m.Dims(); r != 2 || c != 3 {
+		t.Errorf("unexpected dimensions: got:%d×%d want:2×3", r, c)
+	}
+	if m.Prec() != 100 {
+		t.Errorf("unexpected precision: got:%d want:100", m.Prec())
+	}
+	if !mat.Equal(m, mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})) {
+		t.Errorf("unexpected matrix:\n%v", mat.Formatted(m))
+	}
+	if !mat.Equal(m.T(), mat.NewDense(3, 2, []float64{1, 4, 2, 5, 3, 6})) {
+		t.Errorf("unexpected transpose:\n%v", mat.Formatted(m.T()))
+	}
+
+	third := new(big.Float).SetPrec(100).Quo(big.NewFloat(1), big.NewFloat(3))
+	m.SetFloat(1, 2, third)
+	if got := m.Float(1, 2); got.Cmp(third) != 0 || got.Prec() != 100 {
+		t.Errorf("unexpected element: got:%v want:%v", got, third)
+	}
+	if got := m.At(1, 2); got != 1.0/3 {
+		t.Errorf("unexpected rounded element: got:%v want:%v", got, 1.0/3)
+	}
+	// The returned element is a copy.
+	m.Float(0, 0).SetInt64(10)
+	if m.At(0, 0) != 1 {
+		t.Error("matrix modified through copy of element")
+	}
+	m.Set(0, 0, 7)
+	if m.At(0, 0) != 7 {
+		t.Errorf("unexpected element after Set: got:%v want:7", m.At(0, 0))
+	}
+
+	c := m.Copy(200)
+	if c.Prec() != 200 || maxDiff(c, m) != 0 {
+		t.Error("unexpected copy")
+	}
+
+	for _, fn := range []func(){
+		func() { NewDense(0, 1, 53, nil) },
+		func() { NewDense(-1, 1, 53, nil) },
+		func() { NewDense(2, 2, 53, make([]float64, 3)) },
+		func() { NewDense(1, 1, 0, nil) },
+		func() { NewDense(1, 1, 53, []float64{math.NaN()}) },
+		func() { m.At(2, 0) },
+		func() { m.At(0, 3) },
+	} {
+		if !panics(fn) {
+			t.Error("expected panic")
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		r, k, c int
+	}{
+		{r: 1, k: 1, c: 1},
+		{r: 3, k: 4, c: 2},
+		{r: 5, k: 5, c: 5},
+		{r: 2, k: 7, c: 6},
+	} {
+		a := randDense(rnd, test.r, test.k, 200)
+		b := randDense(rnd, test.k, test.c, 100)
+		fa := mat.DenseCopyOf(a)
+		fb := mat.DenseCopyOf(b)
+
+		var p Dense
+		p.Mul(a, b)
+		if p.Prec() != 200 {
+			t.Errorf("unexpected product precision: got:%d want:200", p.Prec())
+		}
+		var fp mat.Dense
+		fp.Mul(fa, fb)
+		if !mat.EqualApprox(&p, &fp, 1e-14) {
+			t.Errorf("unexpected product for %d×%d by %d×%d:\ngot:\n%v\nwant:\n%v",
+				test.r, test.k, test.k, test.c, mat.Formatted(&p), mat.Formatted(&fp))
+		}
+
+		// Products of float64 values are exact with 106 bits, so the
+		// sum of k products is exact with enough additional bits.
+		exact := NewDense(test.r, test.c, 2000, nil)
+		exact.Mul(a, b)
+		if exact.Prec() != 2000 {
+			t.Errorf("unexpected product precision: got:%d want:2000", exact.Prec())
+		}
+		if d := maxDiff(&p, exact); d > 1e-55 {
+			t.Errorf("product not accurate at precision 200: difference %v", d)
+		}
+
+		s := randDense(rnd, test.r, test.k, 100)
+		var sum, diff Dense
+		sum.Add(a, s)
+		diff.Sub(&sum, s)
+		if maxDiff(&diff, a) > 1e-58 {
+			t.Error("unexpected result of Add and Sub")
+		}
+		var fs, fsum mat.Dense
+		fs.CloneFrom(s)
+		fsum.Add(fa, &fs)
+		if !mat.EqualApprox(&sum, &fsum, 1e-15) {
+			t.Error("unexpected sum")
+		}
+
+		var scaled Dense
+		scaled.Scale(big.NewFloat(-2), a)
+		var fscaled mat.Dense
+		fscaled.Scale(-2, fa)
+		if !mat.Equal(&scaled, &fscaled) {
+			t.Error("unexpected scaled matrix")
+		}
+	}
+
+	// The receiver of Mul may alias an operand.
+	a := randDense(rnd, 3, 3, 100)
+	b := randDense(rnd, 3, 3, 100)
+	var want Dense
+	want.Mul(a, b)
+	a.Mul(a, b)
+	if maxDiff(a, &want) != 0 {
+		t.Error("unexpected product with aliased receiver")
+	}
+
+	for _, fn := range []func(){
+		func() { new(Dense).Mul(NewDense(2, 3, 53, nil), NewDense(2, 3, 53, nil)) },
+		func() { NewDense(2, 2, 53, nil).Mul(NewDense(2, 3, 53, nil), NewDense(3, 3, 53, nil)) },
+		func() { new(Dense).Add(NewDense(2, 3, 53, nil), NewDense(3, 2, 53, nil)) },
+		func() { NewDense(2, 2, 53, nil).Sub(NewDense(2, 3, 53, nil), NewDense(2, 3, 53, nil)) },
+	} {
+		if !panics(fn) {
+			t.Error("expected panic for mismatched dimensions")
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bigmat provides a dense matrix of arbitrary precision
+// floating point values and the LU and QR factorizations of such
+// matrices.
+//
+// The package is intended for computing high precision reference results,
+// for example to validate the results of floating point linear algebra
+// routines in tests, and for small problems that are too ill-conditioned
+// to be solved in float64 arithmetic. Each matrix has a precision in bits,
+// and all arithmetic storing its result in a matrix is performed with
+// math/big.Float values of that precision, rounding to nearest even.
+//
+// The matrix types implement mat.Matrix, so their values rounded to the
+// nearest float64 may be used with the functions of the mat package.
+// The package does not aim for speed; operations take time proportional
+// to the cost of the corresponding big.Float arithmetic.
+package bigmat // import "gonum.org/v1/gonum/mat/bigmat"
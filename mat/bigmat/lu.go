@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigmat
+
+import (
+	"math/big"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const badLU = "bigmat: invalid LU factorization"
+
+// LU is the LU factorization with partial pivoting of a square matrix A,
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is lower triangular with unit diagonal
+// elements, and U is upper triangular. The factorization is computed with
+// the precision of the factorized matrix.
+type LU struct {
+	lu       *Dense
+	piv      []int
+	sign     int
+	singular bool
+}
+
+// Factorize computes the LU factorization of the square matrix a and stores
+// the result in the receiver. The factorization completes regardless of the
+// singularity of a. Factorize panics if a is not square.
+func (lu *LU) Factorize(a *Dense) {
+	n := a.rows
+	if a.cols != n {
+		panic(mat.ErrSquare)
+	}
+	f := a.Copy(0)
+	lu.lu = f
+	lu.piv = make([]int, n)
+	for i := range lu.piv {
+		lu.piv[i] = i
+	}
+	lu.sign = 1
+	lu.singular = false
+
+	var t big.Float
+	t.SetPrec(f.prec)
+	for k := 0; k < n; k++ {
+		// Choose the element of largest magnitude in the column
+		// as the pivot.
+		p := k
+		for i := k + 1; i < n; i++ {
+			if cmpAbs(&f.data[i*n+k], &f.data[p*n+k]) > 0 {
+				p = i
+			}
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				f.data[k*n+j], f.data[p*n+j] = f.data[p*n+j], f.data[k*n+j]
+			}
+			lu.piv[k], lu.piv[p] = lu.piv[p], lu.piv[k]
+			lu.sign = -lu.sign
+		}
+		pivot := &f.data[k*n+k]
+		if pivot.Sign() == 0 {
+			lu.singular = true
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			l := &f.data[i*n+k]
+			l.Quo(l, pivot)
+			for j := k + 1; j < n; j++ {
+				t.Mul(l, &f.data[k*n+j])
+				f.data[i*n+j].Sub(&f.data[i*n+j], &t)
+			}
+		}
+	}
+}
+
+// cmpAbs compares the absolute values of x and y and returns -1, 0 or +1
+// as |x| is less than, equal to or greater than |y|.
+func cmpAbs(x, y *big.Float) int {
+	var ax, ay big.Float
+	return ax.Abs(x).Cmp(ay.Abs(y))
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (lu *LU) isValid() bool {
+	return lu.lu != nil
+}
+
+// Det returns the determinant of the factorized matrix. Det panics if the
+// receiver does not contain a factorization.
+func (lu *LU) Det() *big.Float {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	n := lu.lu.rows
+	det := new(big.Float).SetPrec(lu.lu.prec).SetInt64(int64(lu.sign))
+	for i := 0; i < n; i++ {
+		det.Mul(det, &lu.lu.data[i*n+i])
+	}
+	return det
+}
+
+// RowPivots returns the row permutation that represents the permutation
+// matrix P from the LU factorization
+//
+//	A = P * L * U.
+//
+// RowPivots panics if the receiver does not contain a factorization.
+func (lu *LU) RowPivots() []int {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	return append([]int(nil), lu.piv...)
+}
+
+// LTo extracts the lower triangular matrix from an LU factorization.
+//
+// If dst is empty, LTo allocates dst with the precision of the
+// factorization. LTo panics if dst is not empty and does not have the
+// dimensions of the factorized matrix, or if the receiver does not
+// contain a factorization.
+func (lu *LU) LTo(dst *Dense) *Dense {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	n := lu.lu.rows
+	dst.reuseAs(n, n, lu.lu.prec)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			switch {
+			case i == j:
+				dst.data[i*n+j].SetInt64(1)
+			case j < i:
+				dst.data[i*n+j].Set(&lu.lu.data[i*n+j])
+			default:
+				dst.data[i*n+j].SetInt64(0)
+			}
+		}
+	}
+	return dst
+}
+
+// UTo extracts the upper triangular matrix from an LU factorization.
+//
+// If dst is empty, UTo allocates dst with the precision of the
+// factorization. UTo panics if dst is not empty and does not have the
+// dimensions of the factorized matrix, or if the receiver does not
+// contain a factorization.
+func (lu *LU) UTo(dst *Dense) *Dense {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	n := lu.lu.rows
+	dst.reuseAs(n, n, lu.lu.prec)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if j < i {
+				dst.data[i*n+j].SetInt64(0)
+			} else {
+				dst.data[i*n+j].Set(&lu.lu.data[i*n+j])
+			}
+		}
+	}
+	return dst
+}
+
+// SolveTo solves the system of equations A * X = B for X using the LU
+// factorization of A and places the result in dst. If dst is empty, it
+// is allocated with the precision of the factorization.
+//
+// SolveTo returns mat.ErrSingular if an exactly zero pivot was encountered
+// during the factorization. SolveTo panics if the receiver does not
+// contain a factorization, if the number of rows of b does not match the
+// size of the factorized matrix, or if dst is not empty and does not have
+// the dimensions of b.
+func (lu *LU) SolveTo(dst, b *Dense) error {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	n := lu.lu.rows
+	if b.rows != n {
+		panic(mat.ErrShape)
+	}
+	if lu.singular {
+		return mat.ErrSingular
+	}
+	nrhs := b.cols
+	x := newDense(n, nrhs, lu.lu.prec)
+	if !dst.IsEmpty() {
+		x = newDense(n, nrhs, dst.prec)
+	}
+	for i, p := range lu.piv {
+		for j := 0; j < nrhs; j++ {
+			x.data[i*nrhs+j].Set(&b.data[p*nrhs+j])
+		}
+	}
+
+	var t big.Float
+	t.SetPrec(x.prec)
+	f := lu.lu
+	// Solve L * Y = P^T * B.
+	for i := 0; i < n; i++ {
+		for k := 0; k < i; k++ {
+			l := &f.data[i*n+k]
+			for j := 0; j < nrhs; j++ {
+				t.Mul(l, &x.data[k*nrhs+j])
+				x.data[i*nrhs+j].Sub(&x.data[i*nrhs+j], &t)
+			}
+		}
+	}
+	// Solve U * X = Y.
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			u := &f.data[i*n+k]
+			for j := 0; j < nrhs; j++ {
+				t.Mul(u, &x.data[k*nrhs+j])
+				x.data[i*nrhs+j].Sub(&x.data[i*nrhs+j], &t)
+			}
+		}
+		for j := 0; j < nrhs; j++ {
+			x.data[i*nrhs+j].Quo(&x.data[i*nrhs+j], &f.data[i*n+i])
+		}
+	}
+
+	dst.reuseAs(n, nrhs, x.prec)
+	dst.data = x.data
+	return nil
+}
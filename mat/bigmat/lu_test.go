@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigmat
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// hilbert returns the n×n Hilbert matrix with elements of prec bits of
+// precision.
+func hilbert(n int, prec uint) *Dense {
+	h := NewDense(n, n, prec, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			h.at(i, j).Quo(big.NewFloat(1), big.NewFloat(float64(i+j+1)))
+		}
+	}
+	return h
+}
+
+func TestLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		a := randDense(rnd, n, n, 256)
+		var lu LU
+		lu.Factorize(a)
+
+		// Reconstruct P * L * U.
+		var prod Dense
+		prod.Mul(lu.LTo(new(Dense)), lu.UTo(new(Dense)))
+		pa := NewDense(n, n, 256, nil)
+		for i, p := range lu.RowPivots() {
+			for j := 0; j < n; j++ {
+				pa.SetFloat(p, j, prod.at(i, j))
+			}
+		}
+		if d := maxDiff(pa, a); d > 1e-70 {
+			t.Errorf("unexpected reconstruction error for n=%d: %v", n, d)
+		}
+
+		var flu mat.LU
+		flu.Factorize(mat.DenseCopyOf(a))
+		det, _ := lu.Det().Float64()
+		if want := flu.Det(); math.Abs(det-want) > 1e-12*math.Abs(want) {
+			t.Errorf("unexpected determinant for n=%d: got:%v want:%v", n, det, want)
+		}
+
+		b := randDense(rnd, n, 2, 256)
+		var x Dense
+		err := lu.SolveTo(&x, b)
+		if err != nil {
+			t.Fatalf("unexpected error for n=%d: %v", n, err)
+		}
+		var ax Dense
+		ax.Mul(a, &x)
+		if d := maxDiff(&ax, b); d > 1e-65 {
+			t.Errorf("unexpected residual for n=%d: %v", n, d)
+		}
+	}
+}
+
+func TestLUIllConditioned(t *testing.T) {
+	t.Parallel()
+	// The Hilbert matrix of order 14 has a condition number near
+	// 1e19, so a float64 solution would have no correct digits.
+	const (
+		n    = 14
+		prec = 256
+	)
+	a := hilbert(n, prec)
+	want := NewDense(n, 1, prec, nil)
+	for i := 0; i < n; i++ {
+		want.Set(i, 0, float64(i+1))
+	}
+	var b Dense
+	b.Mul(a, want)
+
+	var lu LU
+	lu.Factorize(a)
+	var x Dense
+	err := lu.SolveTo(&x, &b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := maxDiff(&x, want); d > 1e-50 {
+		t.Errorf("unexpected solution error: %v", d)
+	}
+
+}
+
+func TestLUSingular(t *testing.T) {
+	t.Parallel()
+	a := NewDense(3, 3, 100, []float64{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9,
+	})
+	var lu LU
+	lu.Factorize(a)
+	if lu.Det().Sign() != 0 {
+		t.Errorf("unexpected non-zero determinant: %v", lu.Det())
+	}
+	err := lu.SolveTo(new(Dense), NewDense(3, 1, 100, nil))
+	if err != mat.ErrSingular {
+		t.Errorf("unexpected error: got:%v want:%v", err, mat.ErrSingular)
+	}
+
+	for _, fn := range []func(){
+		func() { lu.Factorize(NewDense(2, 3, 53, nil)) },
+		func() { new(LU).Det() },
+		func() { new(LU).SolveTo(new(Dense), a) },
+		func() { lu.SolveTo(new(Dense), NewDense(2, 1, 53, nil)) },
+	} {
+		if !panics(fn) {
+			t.Error("expected panic")
+		}
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigmat
+
+import (
+	"math/big"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const badQR = "bigmat: invalid QR factorization"
+
+// QR is the QR factorization of an m×n matrix A with m ≥ n,
+//
+//	A = Q * R
+//
+// where Q is an m×m orthogonal matrix and R is an m×n upper triangular
+// matrix. The factorization is computed with Householder reflections
+// using the precision of the factorized matrix.
+type QR struct {
+	// r holds the elements of R on and above the diagonal.
+	r *Dense
+
+	// v holds the Householder vectors in its columns; the vector of
+	// the kth reflection occupies rows k through m-1 of column k. The
+	// reflection is I - beta[k] * v_k * v_kᵀ.
+	v    *Dense
+	beta []big.Float
+}
+
+// Factorize computes the QR factorization of the m×n matrix a and stores
+// the result in the receiver. Factorize panics if m < n.
+func (qr *QR) Factorize(a *Dense) {
+	m, n := a.Dims()
+	if m < n {
+		panic(mat.ErrShape)
+	}
+	prec := a.prec
+	r := a.Copy(0)
+	v := newDense(m, n, prec)
+	beta := make([]big.Float, n)
+
+	var norm, s, t big.Float
+	norm.SetPrec(prec)
+	s.SetPrec(prec)
+	t.SetPrec(prec)
+	for k := 0; k < n; k++ {
+		beta[k].SetPrec(prec)
+
+		// Compute the Householder vector v = x - alpha*e_1 where x
+		// is the kth column of the active submatrix and alpha = -sign(x_0)*‖x‖,
+		// avoiding cancellation in the first element.
+		norm.SetInt64(0)
+		for i := k; i < m; i++ {
+			x := &r.data[i*n+k]
+			t.Mul(x, x)
+			norm.Add(&norm, &t)
+		}
+		if norm.Sign() == 0 {
+			// The column is zero, so the reflection is the identity.
+			continue
+		}
+		norm.Sqrt(&norm)
+		if r.data[k*n+k].Sign() >= 0 {
+			norm.Neg(&norm)
+		}
+		for i := k; i < m; i++ {
+			v.data[i*n+k].Set(&r.data[i*n+k])
+		}
+		vk := &v.data[k*n+k]
+		vk.Sub(vk, &norm)
+
+		// beta = 2/(vᵀv) = -1/(alpha*v_0), since vᵀv = -2*alpha*v_0.
+		beta[k].Mul(&norm, vk)
+		beta[k].Quo(big.NewFloat(-1), &beta[k])
+
+		// Apply the reflection to the remaining columns.
+		r.data[k*n+k].Set(&norm)
+		for i := k + 1; i < m; i++ {
+			r.data[i*n+k].SetInt64(0)
+		}
+		for j := k + 1; j < n; j++ {
+			s.SetInt64(0)
+			for i := k; i < m; i++ {
+				t.Mul(&v.data[i*n+k], &r.data[i*n+j])
+				s.Add(&s, &t)
+			}
+			s.Mul(&s, &beta[k])
+			for i := k; i < m; i++ {
+				t.Mul(&s, &v.data[i*n+k])
+				r.data[i*n+j].Sub(&r.data[i*n+j], &t)
+			}
+		}
+	}
+	qr.r = r
+	qr.v = v
+	qr.beta = beta
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (qr *QR) isValid() bool {
+	return qr.r != nil
+}
+
+// applyQT replaces the elements of x, an m×c matrix, with Qᵀ * x.
+func (qr *QR) applyQT(x *Dense) {
+	m, n := qr.v.Dims()
+	c := x.cols
+	var s, t big.Float
+	s.SetPrec(x.prec)
+	t.SetPrec(x.prec)
+	for k := 0; k < n; k++ {
+		if qr.beta[k].Sign() == 0 {
+			continue
+		}
+		for j := 0; j < c; j++ {
+			s.SetInt64(0)
+			for i := k; i < m; i++ {
+				t.Mul(&qr.v.data[i*n+k], &x.data[i*c+j])
+				s.Add(&s, &t)
+			}
+			s.Mul(&s, &qr.beta[k])
+			for i := k; i < m; i++ {
+				t.Mul(&s, &qr.v.data[i*n+k])
+				x.data[i*c+j].Sub(&x.data[i*c+j], &t)
+			}
+		}
+	}
+}
+
+// RTo extracts the m×n upper triangular matrix R from a QR factorization.
+//
+// If dst is empty, RTo allocates dst with the precision of the
+// factorization. RTo panics if dst is not empty and is not m×n, or if
+// the receiver does not contain a factorization.
+func (qr *QR) RTo(dst *Dense) *Dense {
+	if !qr.isValid() {
+		panic(badQR)
+	}
+	m, n := qr.r.Dims()
+	dst.reuseAs(m, n, qr.r.prec)
+	for i := range dst.data {
+		dst.data[i].Set(&qr.r.data[i])
+	}
+	return dst
+}
+
+// QTo extracts the m×m orthogonal matrix Q from a QR factorization.
+//
+// If dst is empty, QTo allocates dst with the precision of the
+// factorization. QTo panics if dst is not empty and is not m×m, or if
+// the receiver does not contain a factorization.
+func (qr *QR) QTo(dst *Dense) *Dense {
+	if !qr.isValid() {
+		panic(badQR)
+	}
+	m, _ := qr.r.Dims()
+	prec := qr.r.prec
+	if !dst.IsEmpty() {
+		prec = dst.prec
+	}
+	// Q = H_0 * ... * H_{n-1} is the transpose of Qᵀ, which is
+	// obtained by applying the reflections to the identity.
+	q := newDense(m, m, prec)
+	for i := 0; i < m; i++ {
+		q.data[i*m+i].SetInt64(1)
+	}
+	qr.applyQT(q)
+	dst.reuseAs(m, m, prec)
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			dst.data[i*m+j].Set(&q.data[j*m+i])
+		}
+	}
+	return dst
+}
+
+// SolveTo finds the minimum-norm solution X of the least squares problem
+//
+//	minimize over X ‖A * X - B‖_F
+//
+// using the QR factorization of A and places the result in dst. If dst is
+// empty, it is allocated with the precision of the factorization.
+//
+// SolveTo returns mat.ErrSingular if R has an exactly zero diagonal element.
+// SolveTo panics if the receiver does not contain a factorization, if the
+// number of rows of b is not the number of rows of A, or if dst is not
+// empty and is not n×c where c is the number of columns of b.
+func (qr *QR) SolveTo(dst, b *Dense) error {
+	if !qr.isValid() {
+		panic(badQR)
+	}
+	m, n := qr.r.Dims()
+	if b.rows != m {
+		panic(mat.ErrShape)
+	}
+	c := b.cols
+	prec := qr.r.prec
+	if !dst.IsEmpty() {
+		prec = dst.prec
+	}
+	for i := 0; i < n; i++ {
+		if qr.r.data[i*n+i].Sign() == 0 {
+			return mat.ErrSingular
+		}
+	}
+
+	y := b.Copy(prec)
+	qr.applyQT(y)
+
+	// Solve R[:n, :n] * X = (Qᵀ B)[:n, :].
+	x := newDense(n, c, prec)
+	var t big.Float
+	t.SetPrec(prec)
+	for i := n - 1; i >= 0; i-- {
+		for j := 0; j < c; j++ {
+			xi := &x.data[i*c+j]
+			xi.Set(&y.data[i*c+j])
+			for k := i + 1; k < n; k++ {
+				t.Mul(&qr.r.data[i*n+k], &x.data[k*c+j])
+				xi.Sub(xi, &t)
+			}
+			xi.Quo(xi, &qr.r.data[i*n+i])
+		}
+	}
+	dst.reuseAs(n, c, prec)
+	dst.data = x.data
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigmat
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestQR(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{m: 1, n: 1},
+		{m: 3, n: 3},
+		{m: 5, n: 3},
+		{m: 10, n: 4},
+	} {
+		a := randDense(rnd, test.m, test.n, 256)
+		var qr QR
+		qr.Factorize(a)
+		q := qr.QTo(new(Dense))
+		r := qr.RTo(new(Dense))
+
+		var prod Dense
+		prod.Mul(q, r)
+		if d := maxDiff(&prod, a); d > 1e-70 {
+			t.Errorf("unexpected reconstruction error for %d×%d: %v", test.m, test.n, d)
+		}
+
+		qt := NewDense(test.m, test.m, 256, nil)
+		for i := 0; i < test.m; i++ {
+			for j := 0; j < test.m; j++ {
+				qt.SetFloat(i, j, q.at(j, i))
+			}
+		}
+		var qtq Dense
+		qtq.Mul(qt, q)
+		eye := NewDense(test.m, test.m, 256, nil)
+		for i := 0; i < test.m; i++ {
+			eye.Set(i, i, 1)
+		}
+		if d := maxDiff(&qtq, eye); d > 1e-70 {
+			t.Errorf("Q not orthogonal for %d×%d: %v", test.m, test.n, d)
+		}
+		for i := 0; i < test.m; i++ {
+			for j := 0; j < min(i, test.n); j++ {
+				if r.at(i, j).Sign() != 0 {
+					t.Errorf("R not upper triangular for %d×%d at (%d, %d)", test.m, test.n, i, j)
+				}
+			}
+		}
+
+		b := randDense(rnd, test.m, 2, 256)
+		var x Dense
+		err := qr.SolveTo(&x, b)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var fqr mat.QR
+		fqr.Factorize(mat.DenseCopyOf(a))
+		var fx mat.Dense
+		err = fqr.SolveTo(&fx, false, mat.DenseCopyOf(b))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !mat.EqualApprox(&x, &fx, 1e-12) {
+			t.Errorf("unexpected least squares solution for %d×%d:\ngot:\n%v\nwant:\n%v",
+				test.m, test.n, mat.Formatted(&x), mat.Formatted(&fx))
+		}
+
+		// The residual of the least squares solution is orthogonal
+		// to the columns of A.
+		var ax, res, atr Dense
+		ax.Mul(a, &x)
+		res.Sub(&ax, b)
+		at := NewDense(test.n, test.m, 256, nil)
+		for i := 0; i < test.n; i++ {
+			for j := 0; j < test.m; j++ {
+				at.SetFloat(i, j, a.at(j, i))
+			}
+		}
+		atr.Mul(at, &res)
+		if d := maxDiff(&atr, NewDense(test.n, 2, 256, nil)); d > 1e-70 {
+			t.Errorf("residual not orthogonal for %d×%d: %v", test.m, test.n, d)
+		}
+	}
+
+	var qr QR
+	qr.Factorize(NewDense(3, 2, 100, []float64{1, 2, 0, 0, 0, 0}))
+	err := qr.SolveTo(new(Dense), NewDense(3, 1, 100, nil))
+	if err != mat.ErrSingular {
+		t.Errorf("unexpected error for rank deficient matrix: got:%v want:%v", err, mat.ErrSingular)
+	}
+	for _, fn := range []func(){
+		func() { qr.Factorize(NewDense(2, 3, 53, nil)) },
+		func() { new(QR).QTo(new(Dense)) },
+		func() { qr.SolveTo(new(Dense), NewDense(2, 1, 53, nil)) },
+	} {
+		if !panics(fn) {
+			t.Error("expected panic")
+		}
+	}
+}
@@ -0,0 +1,381 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// CGSVD is a type for creating and using the Generalized Singular Value
+// Decomposition (GSVD) of a pair of complex matrices.
+//
+// The generalized singular values of a complex pair are real, so the
+// singular values and the Σ₁ and Σ₂ matrices are real, while the singular
+// vectors and R are complex.
+type CGSVD struct {
+	kind GSVDKind
+
+	r, p, c, k, l int
+	s1, s2        []float64
+	a, b, u, v, q cblas128.General
+
+	work  []complex128
+	rwork []float64
+	iwork []int
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (gsvd *CGSVD) succFact() bool {
+	return gsvd.r != 0
+}
+
+// Factorize computes the generalized singular value decomposition (GSVD) of the input
+// the r×c complex matrix A and the p×c complex matrix B. The singular values of A and
+// B are computed in all cases, while the singular vectors are optionally computed
+// depending on the input kind.
+//
+// The full singular value decomposition (kind == GSVDAll) deconstructs A and B as
+//
+//	A = U * Σ₁ * [ 0 R ] * Qᴴ
+//
+//	B = V * Σ₂ * [ 0 R ] * Qᴴ
+//
+// where Σ₁ and Σ₂ are r×(k+l) and p×(k+l) real diagonal matrices of singular values,
+// U, V and Q are r×r, p×p and c×c unitary matrices of singular vectors and R is
+// a (k+l)×(k+l) upper triangular matrix with a real diagonal. k+l is the effective
+// numerical rank of the matrix [ Aᴴ Bᴴ ]ᴴ.
+//
+// It is frequently not necessary to compute the full GSVD. Computation time and
+// storage costs can be reduced using the appropriate kind. Either only the singular
+// values can be computed (kind == GSVDNone), or in conjunction with specific singular
+// vectors (kind bit set according to GSVDU, GSVDV and GSVDQ).
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, routines that require a successful factorization will panic.
+func (gsvd *CGSVD) Factorize(a, b CMatrix, kind GSVDKind) (ok bool) {
+	// kill the previous decomposition
+	gsvd.r = 0
+	gsvd.kind = 0
+
+	r, c := a.Dims()
+	gsvd.r, gsvd.c = r, c
+	p, c := b.Dims()
+	gsvd.p = p
+	if gsvd.c != c {
+		panic(ErrShape)
+	}
+	var jobU, jobV, jobQ lapack.GSVDJob
+	switch {
+	default:
+		panic("cgsvd: bad input kind")
+	case kind == GSVDNone:
+		jobU = lapack.GSVDNone
+		jobV = lapack.GSVDNone
+		jobQ = lapack.GSVDNone
+	case GSVDAll&kind != 0:
+		if GSVDU&kind != 0 {
+			jobU = lapack.GSVDU
+			gsvd.u = cblas128.General{
+				Rows:   r,
+				Cols:   r,
+				Stride: r,
+				Data:   useC(gsvd.u.Data, r*r),
+			}
+		}
+		if GSVDV&kind != 0 {
+			jobV = lapack.GSVDV
+			gsvd.v = cblas128.General{
+				Rows:   p,
+				Cols:   p,
+				Stride: p,
+				Data:   useC(gsvd.v.Data, p*p),
+			}
+		}
+		if GSVDQ&kind != 0 {
+			jobQ = lapack.GSVDQ
+			gsvd.q = cblas128.General{
+				Rows:   c,
+				Cols:   c,
+				Stride: c,
+				Data:   useC(gsvd.q.Data, c*c),
+			}
+		}
+	}
+
+	// A and B are destroyed on call, so copy the matrices.
+	aCopy := NewCDense(r, c, nil)
+	aCopy.Copy(a)
+	bCopy := NewCDense(p, c, nil)
+	bCopy.Copy(b)
+
+	gsvd.s1 = use(gsvd.s1, c)
+	gsvd.s2 = use(gsvd.s2, c)
+
+	gsvd.iwork = useInt(gsvd.iwork, c)
+	gsvd.rwork = use(gsvd.rwork, 2*c)
+
+	gsvd.work = useC(gsvd.work, 1)
+	lapack128.Ggsvd3(jobU, jobV, jobQ, aCopy.mat, bCopy.mat, gsvd.s1, gsvd.s2, gsvd.u, gsvd.v, gsvd.q, gsvd.work, -1, gsvd.rwork, gsvd.iwork)
+	gsvd.work = useC(gsvd.work, int(real(gsvd.work[0])))
+	gsvd.k, gsvd.l, ok = lapack128.Ggsvd3(jobU, jobV, jobQ, aCopy.mat, bCopy.mat, gsvd.s1, gsvd.s2, gsvd.u, gsvd.v, gsvd.q, gsvd.work, len(gsvd.work), gsvd.rwork, gsvd.iwork)
+	if ok {
+		gsvd.a = aCopy.mat
+		gsvd.b = bCopy.mat
+		gsvd.kind = kind
+	}
+	return ok
+}
+
+// Kind returns the GSVDKind of the decomposition. If no decomposition has been
+// computed, Kind returns -1.
+func (gsvd *CGSVD) Kind() GSVDKind {
+	if !gsvd.succFact() {
+		return -1
+	}
+	return gsvd.kind
+}
+
+// Rank returns the k and l terms of the rank of [ Aᴴ Bᴴ ]ᴴ.
+func (gsvd *CGSVD) Rank() (k, l int) {
+	return gsvd.k, gsvd.l
+}
+
+// GeneralizedValues returns the generalized singular values of the factorized matrices.
+// If the input slice is non-nil, the values will be stored in-place into the slice.
+// In this case, the slice must have length min(r,c)-k, and GeneralizedValues will
+// panic with ErrSliceLengthMismatch otherwise. If the input slice is nil,
+// a new slice of the appropriate length will be allocated and returned.
+//
+// GeneralizedValues will panic if the receiver does not contain a successful factorization.
+func (gsvd *CGSVD) GeneralizedValues(v []float64) []float64 {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	k := gsvd.k
+	d := min(gsvd.r, gsvd.c)
+	if v == nil {
+		v = make([]float64, d-k)
+	}
+	if len(v) != d-k {
+		panic(ErrSliceLengthMismatch)
+	}
+	floats.DivTo(v, gsvd.s1[k:d], gsvd.s2[k:d])
+	return v
+}
+
+// ValuesA returns the singular values of the factorized A matrix.
+// If the input slice is non-nil, the values will be stored in-place into the slice.
+// In this case, the slice must have length min(r,c)-k, and ValuesA will panic with
+// ErrSliceLengthMismatch otherwise. If the input slice is nil,
+// a new slice of the appropriate length will be allocated and returned.
+//
+// ValuesA will panic if the receiver does not contain a successful factorization.
+func (gsvd *CGSVD) ValuesA(s []float64) []float64 {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	k := gsvd.k
+	d := min(gsvd.r, gsvd.c)
+	if s == nil {
+		s = make([]float64, d-k)
+	}
+	if len(s) != d-k {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(s, gsvd.s1[k:d])
+	return s
+}
+
+// ValuesB returns the singular values of the factorized B matrix.
+// If the input slice is non-nil, the values will be stored in-place into the slice.
+// In this case, the slice must have length min(r,c)-k, and ValuesB will panic with
+// ErrSliceLengthMismatch otherwise. If the input slice is nil,
+// a new slice of the appropriate length will be allocated and returned.
+//
+// ValuesB will panic if the receiver does not contain a successful factorization.
+func (gsvd *CGSVD) ValuesB(s []float64) []float64 {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	k := gsvd.k
+	d := min(gsvd.r, gsvd.c)
+	if s == nil {
+		s = make([]float64, d-k)
+	}
+	if len(s) != d-k {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(s, gsvd.s2[k:d])
+	return s
+}
+
+// ZeroRTo extracts the matrix [ 0 R ] from the singular value decomposition,
+// storing the result into dst. [ 0 R ] is of size (k+l)×c.
+//
+// If dst is empty, ZeroRTo will resize dst to be (k+l)×c. When dst is
+// non-empty, ZeroRTo will panic if dst is not (k+l)×c. ZeroRTo will also panic
+// if the receiver does not contain a successful factorization.
+func (gsvd *CGSVD) ZeroRTo(dst *CDense) {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	r := gsvd.r
+	c := gsvd.c
+	k := gsvd.k
+	l := gsvd.l
+	h := min(k+l, r)
+	if dst.IsEmpty() {
+		dst.ReuseAs(k+l, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r2 != k+l || c != c2 {
+			panic(ErrShape)
+		}
+		dst.Zero()
+	}
+	// Only the upper triangle of R is copied since the elements
+	// below the diagonal are not referenced by Zggsvd3.
+	a := gsvd.a
+	for i := 0; i < h; i++ {
+		j := c - k - l + i
+		copy(dst.mat.Data[i*dst.mat.Stride+j:i*dst.mat.Stride+c], a.Data[i*a.Stride+j:i*a.Stride+c])
+	}
+	b := gsvd.b
+	for i := r; i < k+l; i++ {
+		j := c - k - l + i
+		copy(dst.mat.Data[i*dst.mat.Stride+j:i*dst.mat.Stride+c], b.Data[(i-k)*b.Stride+j:(i-k)*b.Stride+c])
+	}
+}
+
+// SigmaATo extracts the matrix Σ₁ from the singular value decomposition, storing
+// the result into dst. Σ₁ is size r×(k+l).
+//
+// If dst is empty, SigmaATo will resize dst to be r×(k+l). When dst is
+// non-empty, SigmaATo will panic if dst is not r×(k+l). SigmaATo will also
+// panic if the receiver does not contain a successful factorization.
+func (gsvd *CGSVD) SigmaATo(dst *Dense) {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	r := gsvd.r
+	k := gsvd.k
+	l := gsvd.l
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, k+l)
+	} else {
+		r2, c := dst.Dims()
+		if r2 != r || c != k+l {
+			panic(ErrShape)
+		}
+		dst.Zero()
+	}
+	for i := 0; i < k; i++ {
+		dst.set(i, i, 1)
+	}
+	for i := k; i < min(r, k+l); i++ {
+		dst.set(i, i, gsvd.s1[i])
+	}
+}
+
+// SigmaBTo extracts the matrix Σ₂ from the singular value decomposition, storing
+// the result into dst. Σ₂ is size p×(k+l).
+//
+// If dst is empty, SigmaBTo will resize dst to be p×(k+l). When dst is
+// non-empty, SigmaBTo will panic if dst is not p×(k+l). SigmaBTo will also
+// panic if the receiver does not contain a successful factorization.
+func (gsvd *CGSVD) SigmaBTo(dst *Dense) {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	r := gsvd.r
+	p := gsvd.p
+	k := gsvd.k
+	l := gsvd.l
+	if dst.IsEmpty() {
+		dst.ReuseAs(p, k+l)
+	} else {
+		r, c := dst.Dims()
+		if r != p || c != k+l {
+			panic(ErrShape)
+		}
+		dst.Zero()
+	}
+	for i := 0; i < min(l, r-k); i++ {
+		dst.set(i, i+k, gsvd.s2[k+i])
+	}
+	for i := r - k; i < l; i++ {
+		dst.set(i, i+k, 1)
+	}
+}
+
+// UTo extracts the matrix U from the singular value decomposition, storing
+// the result into dst. U is size r×r.
+//
+// If dst is empty, UTo will resize dst to be r×r. When dst is
+// non-empty, UTo will panic if dst is not r×r. UTo will also
+// panic if the receiver does not contain a successful factorization.
+func (gsvd *CGSVD) UTo(dst *CDense) {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	if gsvd.kind&GSVDU == 0 {
+		panic("mat: improper GSVD kind")
+	}
+	gsvd.copyTo(dst, gsvd.u)
+}
+
+// VTo extracts the matrix V from the singular value decomposition, storing
+// the result into dst. V is size p×p.
+//
+// If dst is empty, VTo will resize dst to be p×p. When dst is
+// non-empty, VTo will panic if dst is not p×p. VTo will also
+// panic if the receiver does not contain a successful factorization.
+func (gsvd *CGSVD) VTo(dst *CDense) {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	if gsvd.kind&GSVDV == 0 {
+		panic("mat: improper GSVD kind")
+	}
+	gsvd.copyTo(dst, gsvd.v)
+}
+
+// QTo extracts the matrix Q from the singular value decomposition, storing
+// the result into dst. Q is size c×c.
+//
+// If dst is empty, QTo will resize dst to be c×c. When dst is
+// non-empty, QTo will panic if dst is not c×c. QTo will also
+// panic if the receiver does not contain a successful factorization.
+func (gsvd *CGSVD) QTo(dst *CDense) {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	if gsvd.kind&GSVDQ == 0 {
+		panic("mat: improper GSVD kind")
+	}
+	gsvd.copyTo(dst, gsvd.q)
+}
+
+// copyTo copies the singular vectors in m into dst.
+func (gsvd *CGSVD) copyTo(dst *CDense, m cblas128.General) {
+	r := m.Rows
+	c := m.Cols
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(&CDense{
+		mat:     m,
+		capRows: r,
+		capCols: c,
+	})
+}
@@ -0,0 +1,251 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestCGSVD(t *testing.T) {
+	t.Parallel()
+
+	const tol = 1e-10
+	for _, test := range []struct {
+		m, p, n int
+	}{
+		{5, 3, 5},
+		{5, 3, 3},
+		{3, 3, 5},
+		{5, 5, 5},
+		{5, 5, 3},
+		{3, 5, 5},
+		{1, 4, 4},
+		{4, 1, 4},
+		{60, 50, 50},
+		{50, 50, 60},
+		{50, 60, 50},
+	} {
+		m := test.m
+		p := test.p
+		n := test.n
+		t.Run(fmt.Sprintf("%v", test), func(t *testing.T) {
+			t.Parallel()
+
+			rnd := rand.New(rand.NewPCG(1, 1))
+			for trial := 0; trial < 5; trial++ {
+				a := randCDense(rnd, m, n)
+				aCopy := NewCDense(m, n, nil)
+				aCopy.Copy(a)
+
+				b := randCDense(rnd, p, n)
+				bCopy := NewCDense(p, n, nil)
+				bCopy.Copy(b)
+
+				// Test Full decomposition.
+				var gsvd CGSVD
+				ok := gsvd.Factorize(a, b, GSVDAll)
+				if !ok {
+					t.Errorf("CGSVD factorization failed")
+				}
+				if !CEqual(a, aCopy) {
+					t.Errorf("A changed during call to CGSVD.Factorize with GSVDAll")
+				}
+				if !CEqual(b, bCopy) {
+					t.Errorf("B changed during call to CGSVD.Factorize with GSVDAll")
+				}
+				c, s, sigma1, sigma2, zeroR, u, v, q := extractCGSVD(&gsvd)
+
+				for _, unitary := range []struct {
+					name string
+					m    *CDense
+				}{
+					{name: "U", m: u},
+					{name: "V", m: v},
+					{name: "Q", m: q},
+				} {
+					r, _ := unitary.m.Dims()
+					if !CEqualApprox(cmul(unitary.m.H(), unitary.m), ceye(r), tol) {
+						t.Errorf("%s is not unitary", unitary.name)
+					}
+				}
+
+				k, l := gsvd.Rank()
+				for i := 0; i < k+l; i++ {
+					if d := zeroR.At(i, n-k-l+i); imag(d) != 0 {
+						t.Errorf("R[%d,%d] is not real: got:%v", i, i, d)
+					}
+					for j := 0; j < n-k-l+i; j++ {
+						if zeroR.At(i, j) != 0 {
+							t.Errorf("[ 0 R ] not upper trapezoidal at [%d,%d]", i, j)
+						}
+					}
+				}
+
+				// Check A = U * Σ₁ * [ 0 R ] * Qᴴ and B = V * Σ₂ * [ 0 R ] * Qᴴ.
+				gotA := cmul(cmul(cmul(u, ccomplexOf(sigma1)), zeroR), q.H())
+				if !CEqualApprox(gotA, a, tol) {
+					t.Errorf("Answer mismatch with GSVDAll: U * Σ₁ * [ 0 R ] * Qᴴ != A")
+				}
+				gotB := cmul(cmul(cmul(v, ccomplexOf(sigma2)), zeroR), q.H())
+				if !CEqualApprox(gotB, b, tol) {
+					t.Errorf("Answer mismatch with GSVDAll: V * Σ₂ * [ 0 R ] * Qᴴ != B")
+				}
+
+				// Check C^2 + S^2 = I.
+				for i := range c {
+					d := c[i]*c[i] + s[i]*s[i]
+					if !scalar.EqualWithinAbsOrRel(d, 1, 1e-14, 1e-14) {
+						t.Errorf("c_%d^2 + s_%d^2 != 1: got: %v", i, i, d)
+					}
+				}
+
+				// Test None decomposition.
+				ok = gsvd.Factorize(a, b, GSVDNone)
+				if !ok {
+					t.Errorf("CGSVD factorization failed")
+				}
+				if !CEqual(a, aCopy) {
+					t.Errorf("A changed during call to CGSVD with GSVDNone")
+				}
+				if !CEqual(b, bCopy) {
+					t.Errorf("B changed during call to CGSVD with GSVDNone")
+				}
+				cNone := gsvd.ValuesA(nil)
+				if !floats.EqualApprox(c, cNone, tol) {
+					t.Errorf("Singular value mismatch between GSVDAll and GSVDNone decomposition")
+				}
+				sNone := gsvd.ValuesB(nil)
+				if !floats.EqualApprox(s, sNone, tol) {
+					t.Errorf("Singular value mismatch between GSVDAll and GSVDNone decomposition")
+				}
+				if gsvd.Kind() != GSVDNone {
+					t.Errorf("unexpected kind: got:%v want:%v", gsvd.Kind(), GSVDNone)
+				}
+				if panicked, _ := panics(func() { gsvd.UTo(&CDense{}) }); !panicked {
+					t.Errorf("expected panic extracting U from GSVDNone decomposition")
+				}
+			}
+		})
+	}
+}
+
+func TestCGSVDReal(t *testing.T) {
+	t.Parallel()
+
+	// The generalized singular values of a real pair are the same
+	// whether the pair is factorized as real or complex matrices.
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, p, n int
+	}{
+		{5, 3, 5},
+		{5, 5, 3},
+		{3, 5, 5},
+	} {
+		a := NewDense(test.m, test.n, nil)
+		ac := NewCDense(test.m, test.n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+			ac.mat.Data[i] = complex(a.mat.Data[i], 0)
+		}
+		b := NewDense(test.p, test.n, nil)
+		bc := NewCDense(test.p, test.n, nil)
+		for i := range b.mat.Data {
+			b.mat.Data[i] = rnd.NormFloat64()
+			bc.mat.Data[i] = complex(b.mat.Data[i], 0)
+		}
+
+		var gsvd GSVD
+		if !gsvd.Factorize(a, b, GSVDNone) {
+			t.Fatalf("GSVD factorization failed for %v", test)
+		}
+		var cgsvd CGSVD
+		if !cgsvd.Factorize(ac, bc, GSVDNone) {
+			t.Fatalf("CGSVD factorization failed for %v", test)
+		}
+		// The values are not sorted by the factorization.
+		want := gsvd.GeneralizedValues(nil)
+		sort.Float64s(want)
+		got := cgsvd.GeneralizedValues(nil)
+		sort.Float64s(got)
+		if !floats.EqualApprox(got, want, tol) {
+			t.Errorf("generalized value mismatch for %v: got:%v want:%v", test, got, want)
+		}
+	}
+}
+
+func extractCGSVD(gsvd *CGSVD) (c, s []float64, s1, s2 *Dense, zR, u, v, q *CDense) {
+	s1 = &Dense{}
+	s2 = &Dense{}
+	zR = &CDense{}
+	u = &CDense{}
+	v = &CDense{}
+	q = &CDense{}
+	gsvd.SigmaATo(s1)
+	gsvd.SigmaBTo(s2)
+	gsvd.ZeroRTo(zR)
+	gsvd.UTo(u)
+	gsvd.VTo(v)
+	gsvd.QTo(q)
+	c = gsvd.ValuesA(nil)
+	s = gsvd.ValuesB(nil)
+	return c, s, s1, s2, zR, u, v, q
+}
+
+func randCDense(rnd *rand.Rand, r, c int) *CDense {
+	m := NewCDense(r, c, nil)
+	for i := range m.mat.Data {
+		m.mat.Data[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return m
+}
+
+// cmul returns the product of a and b computed naively.
+func cmul(a, b CMatrix) *CDense {
+	r, n := a.Dims()
+	n2, c := b.Dims()
+	if n != n2 {
+		panic(ErrShape)
+	}
+	m := NewCDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			var v complex128
+			for k := 0; k < n; k++ {
+				v += a.At(i, k) * b.At(k, j)
+			}
+			m.set(i, j, v)
+		}
+	}
+	return m
+}
+
+// ceye returns the n×n complex identity matrix.
+func ceye(n int) *CDense {
+	m := NewCDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.set(i, i, 1)
+	}
+	return m
+}
+
+// ccomplexOf returns a complex copy of the real matrix a.
+func ccomplexOf(a Matrix) *CDense {
+	r, c := a.Dims()
+	m := NewCDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.set(i, j, complex(a.At(i, j), 0))
+		}
+	}
+	return m
+}
@@ -13,3 +13,17 @@ const (
 	// Lower specifies a lower triangular matrix.
 	Lower TriKind = false
 )
+
+// Machine constants with the values returned by LAPACK's Dlamch.
+const (
+	// dlamchE is the machine epsilon. For IEEE this is 2^{-53}.
+	dlamchE = 0x1p-53
+
+	// dlamchP is base * eps.
+	dlamchP = 2 * dlamchE
+
+	// dlamchS is the "safe minimum", that is, the lowest number such that
+	// 1/dlamchS does not overflow, or also the smallest normal number.
+	// For IEEE this is 2^{-1022}.
+	dlamchS = 0x1p-1022
+)
@@ -525,6 +525,9 @@ func (m *Dense) Copy(a Matrix) (r, c int) {
 		default:
 			// Nothing to do.
 		}
+	case *COO, *CSR, *CSC:
+		nz, _ := asSparse(a)
+		m.copySparse(nz, r, c)
 	default:
 		m.checkOverlapMatrix(aU)
 		for i := 0; i < r; i++ {
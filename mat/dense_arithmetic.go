@@ -54,6 +54,10 @@ func (m *Dense) Add(a, b Matrix) {
 		defer restore()
 	}
 
+	if m.addSparse(a, b, 1) {
+		return
+	}
+
 	for r := 0; r < ar; r++ {
 		for c := 0; c < ac; c++ {
 			m.set(r, c, a.At(r, c)+b.At(r, c))
@@ -103,6 +107,10 @@ func (m *Dense) Sub(a, b Matrix) {
 		defer restore()
 	}
 
+	if m.addSparse(a, b, -1) {
+		return
+	}
+
 	for r := 0; r < ar; r++ {
 		for c := 0; c < ac; c++ {
 			m.set(r, c, a.At(r, c)-b.At(r, c))
@@ -441,6 +449,9 @@ func (m *Dense) Mul(a, b Matrix) {
 
 	m.checkOverlapMatrix(aU)
 	m.checkOverlapMatrix(bU)
+	if m.mulSparse(a, b) {
+		return
+	}
 	row := getFloat64s(ac, false)
 	defer putFloat64s(row)
 	for r := 0; r < ar; r++ {
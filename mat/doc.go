@@ -13,6 +13,7 @@
 // mat provides:
 //   - Interfaces for Matrix classes (Matrix, Symmetric, Triangular)
 //   - Concrete implementations (Dense, SymDense, TriDense, VecDense)
+//   - Sparse matrix implementations (COO, CSR, CSC)
 //   - Methods and functions for using matrix data (Add, Trace, SymRankOne)
 //   - Types for constructing and using matrix factorizations (QR, LU, etc.)
 //   - The complementary types for complex matrices, CMatrix, CSymDense, etc.
@@ -5,26 +5,37 @@
 package mat
 
 import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/lapack"
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
 
 const (
-	badFact   = "mat: use without successful factorization"
-	noVectors = "mat: eigenvectors not computed"
+	badFact      = "mat: use without successful factorization"
+	noVectors    = "mat: eigenvectors not computed"
+	partialEigen = "mat: partial eigendecomposition"
 )
 
-// EigenSym is a type for computing all eigenvalues and, optionally,
+// EigenSym is a type for computing all or some eigenvalues and, optionally,
 // eigenvectors of a symmetric matrix A.
 //
 // It is a Symmetric matrix represented by its spectral factorization. Once
 // computed, this representation is useful for extracting eigenvalues and
 // eigenvector, but At is slow.
 type EigenSym struct {
+	n int // The order of the factorized matrix.
+
+	// partial indicates that only some
+	// eigenvalues have been computed.
+	partial         bool
 	vectorsComputed bool
 
 	values  []float64
 	vectors *Dense
+
+	work  []float64
+	iwork []int
 }
 
 // Dims returns the dimensions of the matrix.
@@ -35,16 +46,20 @@ func (e *EigenSym) Dims() (r, c int) {
 
 // SymmetricDim implements the Symmetric interface.
 func (e *EigenSym) SymmetricDim() int {
-	return len(e.values)
+	return e.n
 }
 
 // At returns the element at row i, column j of the matrix A.
 //
-// At will panic if the eigenvectors have not been computed.
+// At will panic if the eigenvectors have not been computed or if the
+// factorization is partial.
 func (e *EigenSym) At(i, j int) float64 {
 	if !e.vectorsComputed {
 		panic(noVectors)
 	}
+	if e.partial {
+		panic(partialEigen)
+	}
 	n, _ := e.Dims()
 	if uint(i) >= uint(n) {
 		panic(ErrRowAccess)
@@ -80,49 +95,208 @@ func (e *EigenSym) T() Matrix {
 //
 // Factorize returns whether the factorization succeeded. If it returns false,
 // methods that require a successful factorization will panic.
+//
+// Factorize reuses the storage of the receiver where possible, so repeated
+// factorizations of matrices of the same order do not allocate. The slice
+// returned by RawValues and the matrix returned by RawQ for a previous
+// factorization are overwritten.
 func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
+	// Reuse the storage of the previous decomposition
+	// unless it is being factorized.
+	var data []float64
+	if e.vectors != nil && a != Symmetric(e) {
+		data = e.vectors.mat.Data
+	}
+	n := a.SymmetricDim()
+	sd := SymDense{
+		mat: blas64.Symmetric{
+			N:      n,
+			Stride: n,
+			Uplo:   blas.Upper,
+			Data:   use(data, n*n),
+		},
+		cap: n,
+	}
+	sd.CopySym(a)
+
 	// kill previous decomposition
-	e.vectorsComputed = false
-	e.values = e.values[:]
+	e.Reset()
+
+	jobz := lapack.EVNone
+	if vectors {
+		jobz = lapack.EVCompute
+	}
+	w := use(e.values, n)
+	e.work = use(e.work, 1)
+	lapack64.Syev(jobz, sd.mat, w, e.work, -1)
+	e.work = use(e.work, int(e.work[0]))
+	ok = lapack64.Syev(jobz, sd.mat, w, e.work, len(e.work))
+	if !ok {
+		e.values = w[:0]
+		return false
+	}
+	if e.vectors == nil {
+		e.vectors = &Dense{}
+	}
+	*e.vectors = Dense{
+		mat: blas64.General{
+			Rows:   n,
+			Cols:   n,
+			Stride: n,
+			Data:   sd.mat.Data,
+		},
+		capRows: n,
+		capCols: n,
+	}
+	e.n = n
+	e.vectorsComputed = vectors
+	e.values = w
+	return true
+}
 
+// FactorizeIndex computes the eigenvalues of the n×n symmetric matrix A with
+// indices lo to hi-1 in ascending order, counting from zero, and optionally
+// their eigenvectors. FactorizeIndex will panic unless 0 <= lo < hi <= n.
+//
+// The eigenpairs are computed by the method of Multiple Relatively Robust
+// Representations, which after the reduction of A to tridiagonal form needs
+// O(n*k) operations for k eigenpairs, so computing a few eigenpairs of a large
+// matrix is much faster than computing the full factorization with Factorize.
+//
+// The receiver holds a partial factorization with k = hi-lo eigenvalues, so
+// Values returns k values, VectorsTo stores an n×k matrix and At will panic.
+// If vectors is false, the eigenvectors are not computed and later calls to
+// VectorsTo will panic.
+//
+// FactorizeIndex returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+//
+// FactorizeIndex reuses the storage of the receiver where possible, so
+// repeated factorizations do not allocate.
+func (e *EigenSym) FactorizeIndex(a Symmetric, lo, hi int, vectors bool) (ok bool) {
 	n := a.SymmetricDim()
-	sd := NewSymDense(n, nil)
-	sd.CopySym(a)
+	if lo < 0 || hi <= lo || n < hi {
+		panic(ErrIndexOutOfRange)
+	}
+	return e.factorizeRange(a, lapack.EVRangeIndex, 0, 0, lo, hi-1, vectors)
+}
 
+// FactorizeValue computes the eigenvalues of the n×n symmetric matrix A in
+// the half-open interval (lo,hi] in ascending order, and optionally their
+// eigenvectors. FactorizeValue will panic if lo is not less than hi.
+//
+// The eigenpairs are computed by the method of Multiple Relatively Robust
+// Representations, which after the reduction of A to tridiagonal form needs
+// O(n*k) operations for k eigenpairs.
+//
+// The receiver holds a partial factorization with the k eigenvalues in the
+// interval, so Values returns k values, VectorsTo stores an n×k matrix and At
+// will panic. If no eigenvalues are in the interval, the factorization succeeds
+// with k = 0, and VectorsTo will panic. If vectors is false, the eigenvectors
+// are not computed and later calls to VectorsTo will panic.
+//
+// FactorizeValue returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+//
+// FactorizeValue reuses the storage of the receiver where possible, so
+// repeated factorizations do not allocate.
+func (e *EigenSym) FactorizeValue(a Symmetric, lo, hi float64, vectors bool) (ok bool) {
+	if !(lo < hi) {
+		panic("mat: invalid eigenvalue interval")
+	}
+	return e.factorizeRange(a, lapack.EVRangeValue, lo, hi, 0, 0, vectors)
+}
+
+// factorizeRange computes the partial spectral factorization of A for the
+// eigenvalues selected by rng, vl, vu, il and iu as specified by
+// lapack64.Syevmr.
+func (e *EigenSym) factorizeRange(a Symmetric, rng lapack.EVRange, vl, vu float64, il, iu int, vectors bool) (ok bool) {
+	n := a.SymmetricDim()
 	jobz := lapack.EVNone
 	if vectors {
 		jobz = lapack.EVCompute
 	}
-	w := make([]float64, n)
-	work := []float64{0}
-	lapack64.Syev(jobz, sd.mat, w, work, -1)
+	ncol := n
+	if rng == lapack.EVRangeIndex {
+		ncol = iu - il + 1
+	}
+	e.work = use(e.work, 1)
+	lapack64.Syevmr(jobz, rng, blas64.Symmetric{N: n, Stride: n, Uplo: blas.Upper}, vl, vu, il, iu, 0, nil, blas64.General{Stride: ncol}, e.work, -1, nil)
+	lwork := int(e.work[0])
+	e.work = use(e.work, n*n+lwork)
+	sd := SymDense{
+		mat: blas64.Symmetric{
+			N:      n,
+			Stride: n,
+			Uplo:   blas.Upper,
+			Data:   e.work[:n*n],
+		},
+		cap: n,
+	}
+	sd.CopySym(a)
+
+	// kill previous decomposition
+	e.Reset()
 
-	work = getFloat64s(int(work[0]), false)
-	ok = lapack64.Syev(jobz, sd.mat, w, work, len(work))
-	putFloat64s(work)
+	var data []float64
+	if e.vectors != nil {
+		data = e.vectors.mat.Data
+	}
+	z := blas64.General{Rows: n, Cols: ncol, Stride: ncol}
+	if vectors {
+		z.Data = use(data, n*ncol)
+	}
+	w := use(e.values, n)
+	e.iwork = useInt(e.iwork, 4*n)
+	m, ok := lapack64.Syevmr(jobz, rng, sd.mat, vl, vu, il, iu, 0, w, z, e.work[n*n:], lwork, e.iwork)
 	if !ok {
-		e.vectorsComputed = false
-		e.values = nil
-		e.vectors = nil
+		e.values = w[:0]
 		return false
 	}
+	if e.vectors == nil {
+		e.vectors = &Dense{}
+	}
+	*e.vectors = Dense{
+		mat: blas64.General{
+			Rows:   n,
+			Cols:   m,
+			Stride: ncol,
+			Data:   z.Data,
+		},
+		capRows: n,
+		capCols: ncol,
+	}
+	e.n = n
+	e.partial = true
 	e.vectorsComputed = vectors
-	e.values = w
-	e.vectors = NewDense(n, n, sd.mat.Data)
+	e.values = w[:m]
 	return true
 }
 
+// Reset discards the factorization, retaining the storage of the receiver
+// for reuse by a later call to Factorize, FactorizeIndex or FactorizeValue.
+func (e *EigenSym) Reset() {
+	e.n = 0
+	e.partial = false
+	e.vectorsComputed = false
+	e.values = e.values[:0]
+	if e.vectors != nil {
+		e.vectors.Reset()
+	}
+}
+
 // succFact returns whether the receiver contains a successful factorization.
 func (e *EigenSym) succFact() bool {
-	return len(e.values) != 0
+	return e.n != 0
 }
 
 // Values extracts the eigenvalues of the factorized n×n matrix A in ascending
-// order.
+// order. For a partial factorization, only the computed eigenvalues are
+// extracted.
 //
 // If dst is not nil, the values are stored in-place into dst and returned,
 // otherwise a new slice is allocated first. If dst is not nil, it must have
-// length equal to n.
+// length equal to the number of eigenvalues.
 //
 // If the receiver does not contain a successful factorization, Values will
 // panic.
@@ -155,12 +329,14 @@ func (e *EigenSym) RawValues() []float64 {
 }
 
 // VectorsTo stores the orthonormal eigenvectors of the factorized n×n matrix A
-// into the columns of dst.
-//
-// If dst is empty, VectorsTo will resize dst to be n×n. When dst is non-empty,
-// VectorsTo will panic if dst is not n×n. VectorsTo will also panic if the
-// eigenvectors were not computed during the factorization, or if the receiver
-// does not contain a successful factorization.
+// into the columns of dst. For a partial factorization with k eigenvalues, the
+// k corresponding eigenvectors are stored.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n, or n×k for a partial
+// factorization. When dst is non-empty, VectorsTo will panic if dst is not of
+// that size. VectorsTo will also panic if the eigenvectors were not computed
+// during the factorization, or if the receiver does not contain a successful
+// factorization.
 func (e *EigenSym) VectorsTo(dst *Dense) {
 	if !e.succFact() {
 		panic(badFact)
@@ -222,6 +398,12 @@ type Eigen struct {
 	values   []complex128
 	rVectors *CDense
 	lVectors *CDense
+
+	// a, vl, vr, wr, wi and work are storage
+	// reused by subsequent factorizations.
+	a, vl, vr Dense
+	wr, wi    []float64
+	work      []float64
 }
 
 // succFact returns whether the receiver contains a successful factorization.
@@ -254,77 +436,100 @@ func (e *Eigen) succFact() bool {
 //
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, methods that require a successful factorization will panic.
+//
+// Factorize reuses the storage of the receiver where possible, so repeated
+// factorizations of matrices of the same order allocate little.
 func (e *Eigen) Factorize(a Matrix, kind EigenKind) (ok bool) {
 	// kill previous factorization.
-	e.n = 0
-	e.kind = 0
+	e.Reset()
 	// Copy a because it is modified during the Lapack call.
 	r, c := a.Dims()
 	if r != c {
 		panic(ErrShape)
 	}
-	var sd Dense
-	sd.CloneFrom(a)
+	e.a.Reset()
+	e.a.reuseAsNonZeroed(r, c)
+	e.a.Copy(a)
 
 	left := kind&EigenLeft != 0
 	right := kind&EigenRight != 0
 
-	var vl, vr Dense
+	var vl, vr blas64.General
 	jobvl := lapack.LeftEVNone
 	jobvr := lapack.RightEVNone
 	if left {
-		vl = *NewDense(r, r, nil)
+		e.vl.Reset()
+		e.vl.reuseAsNonZeroed(r, r)
+		vl = e.vl.mat
 		jobvl = lapack.LeftEVCompute
 	}
 	if right {
-		vr = *NewDense(c, c, nil)
+		e.vr.Reset()
+		e.vr.reuseAsNonZeroed(c, c)
+		vr = e.vr.mat
 		jobvr = lapack.RightEVCompute
 	}
 
-	wr := getFloat64s(c, false)
-	defer putFloat64s(wr)
-	wi := getFloat64s(c, false)
-	defer putFloat64s(wi)
+	wr := use(e.wr, c)
+	e.wr = wr
+	wi := use(e.wi, c)
+	e.wi = wi
 
-	work := []float64{0}
-	lapack64.Geev(jobvl, jobvr, sd.mat, wr, wi, vl.mat, vr.mat, work, -1)
-	work = getFloat64s(int(work[0]), false)
-	first := lapack64.Geev(jobvl, jobvr, sd.mat, wr, wi, vl.mat, vr.mat, work, len(work))
-	putFloat64s(work)
+	e.work = use(e.work, 1)
+	lapack64.Geev(jobvl, jobvr, e.a.mat, wr, wi, vl, vr, e.work, -1)
+	e.work = use(e.work, int(e.work[0]))
+	first := lapack64.Geev(jobvl, jobvr, e.a.mat, wr, wi, vl, vr, e.work, len(e.work))
 
 	if first != 0 {
-		e.values = nil
+		e.Reset()
 		return false
 	}
 	e.n = r
 	e.kind = kind
 
 	// Construct complex eigenvalues from float64 data.
-	values := make([]complex128, r)
+	e.values = useC(e.values, r)
 	for i, v := range wr {
-		values[i] = complex(v, wi[i])
+		e.values[i] = complex(v, wi[i])
 	}
-	e.values = values
 
 	// Construct complex eigenvectors from float64 data.
-	var cvl, cvr CDense
 	if left {
-		cvl = *NewCDense(r, r, nil)
-		e.complexEigenTo(&cvl, &vl)
-		e.lVectors = &cvl
-	} else {
-		e.lVectors = nil
+		e.lVectors = reuseCDense(e.lVectors, r)
+		e.complexEigenTo(e.lVectors, &e.vl)
 	}
 	if right {
-		cvr = *NewCDense(c, c, nil)
-		e.complexEigenTo(&cvr, &vr)
-		e.rVectors = &cvr
-	} else {
-		e.rVectors = nil
+		e.rVectors = reuseCDense(e.rVectors, c)
+		e.complexEigenTo(e.rVectors, &e.vr)
 	}
 	return true
 }
 
+// Reset discards the factorization, retaining the storage of the receiver
+// for reuse by a later call to Factorize.
+func (e *Eigen) Reset() {
+	e.n = 0
+	e.kind = 0
+	e.values = e.values[:0]
+	if e.lVectors != nil {
+		e.lVectors.Reset()
+	}
+	if e.rVectors != nil {
+		e.rVectors.Reset()
+	}
+}
+
+// reuseCDense returns m resized to be n×n, allocating it if it is nil.
+func reuseCDense(m *CDense, n int) *CDense {
+	if m == nil {
+		m = &CDense{}
+	} else {
+		m.Reset()
+	}
+	m.reuseAsNonZeroed(n, n)
+	return m
+}
+
 // Kind returns the EigenKind of the decomposition. If no decomposition has been
 // computed, Kind returns -1.
 func (e *Eigen) Kind() EigenKind {
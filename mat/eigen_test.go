@@ -5,6 +5,7 @@
 package mat
 
 import (
+	"fmt"
 	"math"
 	"math/rand/v2"
 	"sort"
@@ -232,3 +233,263 @@ func TestEigenSym(t *testing.T) {
 		}
 	}
 }
+
+func TestEigenSymRange(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 20, 50} {
+		a := NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				a.SetSym(i, j, rnd.NormFloat64())
+			}
+		}
+		var full EigenSym
+		if !full.Factorize(a, false) {
+			t.Fatalf("unexpected failure for n=%d", n)
+		}
+		all := full.Values(nil)
+
+		check := func(name string, es *EigenSym, want []float64) {
+			t.Helper()
+			if r, c := es.Dims(); r != n || c != n {
+				t.Errorf("%s: unexpected dims: got:%d×%d want:%d×%d", name, r, c, n, n)
+			}
+			got := es.Values(nil)
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("%s: eigenvalue mismatch: got:%v want:%v", name, got, want)
+			}
+			if len(want) == 0 {
+				return
+			}
+			var v Dense
+			es.VectorsTo(&v)
+			if r, c := v.Dims(); r != n || c != len(want) {
+				t.Errorf("%s: unexpected eigenvector dims: got:%d×%d want:%d×%d", name, r, c, n, len(want))
+				return
+			}
+			var vtv Dense
+			vtv.Mul(v.T(), &v)
+			if !EqualApprox(&vtv, eye(len(want)), tol) {
+				t.Errorf("%s: eigenvectors not orthonormal", name)
+			}
+			var av, vl Dense
+			av.Mul(a, &v)
+			vl.Mul(&v, NewDiagDense(len(got), got))
+			if !EqualApprox(&av, &vl, tol) {
+				t.Errorf("%s: A*V != V*Λ", name)
+			}
+			if panicked, _ := panics(func() { es.At(0, 0) }); !panicked {
+				t.Errorf("%s: expected panic from At for partial factorization", name)
+			}
+		}
+
+		var es EigenSym
+		for _, r := range [][2]int{{0, 1}, {0, n}, {n - 1, n}, {n / 2, n/2 + 1}, {n / 3, 2*n/3 + 1}} {
+			lo, hi := r[0], r[1]
+			if !es.FactorizeIndex(a, lo, hi, true) {
+				t.Errorf("unexpected failure for n=%d, index range [%d,%d)", n, lo, hi)
+				continue
+			}
+			check(fmt.Sprintf("n=%d, index range [%d,%d)", n, lo, hi), &es, all[lo:hi])
+
+			if !es.FactorizeIndex(a, lo, hi, false) {
+				t.Errorf("unexpected failure for n=%d, index range [%d,%d) without vectors", n, lo, hi)
+				continue
+			}
+			if !floats.EqualApprox(es.Values(nil), all[lo:hi], tol) {
+				t.Errorf("n=%d, index range [%d,%d): eigenvalue mismatch without vectors", n, lo, hi)
+			}
+			if panicked, _ := panics(func() { es.VectorsTo(&Dense{}) }); !panicked {
+				t.Errorf("n=%d, index range [%d,%d): expected panic for vectors not computed", n, lo, hi)
+			}
+		}
+
+		// Intervals with bounds halfway between eigenvalues.
+		bound := func(i int) float64 {
+			switch i {
+			case 0:
+				return all[0] - 1
+			case n:
+				return all[n-1] + 1
+			}
+			return (all[i-1] + all[i]) / 2
+		}
+		for _, r := range [][2]int{{0, n}, {0, n / 2}, {n / 4, 3 * n / 4}, {n, n}} {
+			lo, hi := bound(r[0]), bound(r[1])
+			if r[0] == r[1] {
+				hi = lo + 0.5
+			}
+			if !es.FactorizeValue(a, lo, hi, true) {
+				t.Errorf("unexpected failure for n=%d, interval (%v,%v]", n, lo, hi)
+				continue
+			}
+			check(fmt.Sprintf("n=%d, interval (%v,%v]", n, lo, hi), &es, all[r[0]:r[1]])
+		}
+
+		if panicked, _ := panics(func() { es.FactorizeIndex(a, 0, n+1, true) }); !panicked {
+			t.Errorf("n=%d: expected panic for index out of range", n)
+		}
+		if panicked, _ := panics(func() { es.FactorizeIndex(a, 1, 1, true) }); !panicked {
+			t.Errorf("n=%d: expected panic for empty index range", n)
+		}
+		if panicked, _ := panics(func() { es.FactorizeValue(a, 1, 0, true) }); !panicked {
+			t.Errorf("n=%d: expected panic for empty interval", n)
+		}
+	}
+}
+
+func TestEigenReuse(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	var es EigenSym
+	var eg Eigen
+	for _, n := range []int{5, 2, 10, 10, 1, 7} {
+		a := NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		s := NewSymDense(n, nil)
+		s.SymOuterK(1, a)
+
+		for _, vectors := range []bool{true, false} {
+			var want EigenSym
+			if !want.Factorize(s, vectors) || !es.Factorize(s, vectors) {
+				t.Fatalf("n=%d: unexpected factorization failure", n)
+			}
+			if !floats.Equal(es.Values(nil), want.Values(nil)) {
+				t.Errorf("n=%d vectors=%t: eigenvalue mismatch with reused EigenSym", n, vectors)
+			}
+			if vectors && !Equal(es.RawQ(), want.RawQ()) {
+				t.Errorf("n=%d: eigenvector mismatch with reused EigenSym", n)
+			}
+		}
+
+		for _, kind := range []EigenKind{EigenBoth, EigenNone, EigenRight, EigenLeft} {
+			var want Eigen
+			if !want.Factorize(a, kind) || !eg.Factorize(a, kind) {
+				t.Fatalf("n=%d: unexpected factorization failure", n)
+			}
+			if eg.Kind() != kind {
+				t.Errorf("n=%d: unexpected kind: got:%d want:%d", n, eg.Kind(), kind)
+			}
+			got := eg.Values(nil)
+			for i, v := range want.Values(nil) {
+				if got[i] != v {
+					t.Errorf("n=%d kind=%d: eigenvalue mismatch with reused Eigen", n, kind)
+					break
+				}
+			}
+			if kind&EigenRight != 0 {
+				var vg, vw CDense
+				eg.VectorsTo(&vg)
+				want.VectorsTo(&vw)
+				if !CEqual(&vg, &vw) {
+					t.Errorf("n=%d kind=%d: right eigenvector mismatch with reused Eigen", n, kind)
+				}
+			}
+			if kind&EigenLeft != 0 {
+				var vg, vw CDense
+				eg.LeftVectorsTo(&vg)
+				want.LeftVectorsTo(&vw)
+				if !CEqual(&vg, &vw) {
+					t.Errorf("n=%d kind=%d: left eigenvector mismatch with reused Eigen", n, kind)
+				}
+			}
+		}
+	}
+
+	// Factorizing an EigenSym into itself must not corrupt the input.
+	s := NewSymDense(3, []float64{8, 2, 4, 2, 6, 10, 4, 10, 5})
+	es.Factorize(s, true)
+	want := es.Values(nil)
+	if !es.Factorize(&es, true) {
+		t.Fatal("unexpected failure factorizing EigenSym into itself")
+	}
+	if !floats.EqualApprox(es.Values(nil), want, 1e-12) {
+		t.Errorf("eigenvalue mismatch factorizing EigenSym into itself: got:%v want:%v", es.Values(nil), want)
+	}
+
+	es.Reset()
+	if es.RawValues() != nil {
+		t.Error("unexpected eigenvalues after Reset")
+	}
+	eg.Reset()
+	if eg.Kind() != -1 {
+		t.Error("unexpected kind after Reset")
+	}
+}
+
+func TestEigenSymReuseFailure(t *testing.T) {
+	t.Parallel()
+	good := NewSymDense(3, []float64{8, 2, 4, 2, 6, 10, 4, 10, 5})
+	bad := NewSymDense(3, []float64{8, 2, 4, 2, math.NaN(), 10, 4, 10, 5})
+
+	var want EigenSym
+	if !want.Factorize(good, true) {
+		t.Fatal("unexpected factorization failure")
+	}
+
+	var es EigenSym
+	if !es.Factorize(good, true) {
+		t.Fatal("unexpected factorization failure")
+	}
+	if es.Factorize(bad, true) {
+		t.Fatal("unexpected factorization success with NaN input")
+	}
+	if es.RawQ() != nil {
+		t.Error("unexpected eigenvectors after failed factorization")
+	}
+	if es.vectors != nil && !es.vectors.IsEmpty() {
+		t.Error("unexpected eigenvector storage after failed factorization")
+	}
+	if es.RawValues() != nil {
+		t.Error("unexpected eigenvalues after failed factorization")
+	}
+	if n := es.SymmetricDim(); n != 0 {
+		t.Errorf("unexpected dimension after failed factorization: got:%d want:0", n)
+	}
+	if panicked, _ := panics(func() { es.VectorsTo(NewDense(3, 3, nil)) }); !panicked {
+		t.Error("expected panic from VectorsTo after failed factorization")
+	}
+
+	if !es.Factorize(good, true) {
+		t.Fatal("unexpected failure refactorizing after failed factorization")
+	}
+	if !floats.Equal(es.Values(nil), want.Values(nil)) {
+		t.Errorf("eigenvalue mismatch after failed factorization: got:%v want:%v", es.Values(nil), want.Values(nil))
+	}
+	if !Equal(es.RawQ(), want.RawQ()) {
+		t.Error("eigenvector mismatch after failed factorization")
+	}
+}
+
+func TestEigenSymReuseAllocs(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 20
+	a := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	var es EigenSym
+	es.Factorize(a, true)
+	allocs := testing.AllocsPerRun(10, func() {
+		es.Factorize(a, true)
+	})
+	if allocs != 0 {
+		t.Errorf("unexpected allocations reusing EigenSym: got:%v want:0", allocs)
+	}
+
+	es.FactorizeIndex(a, 0, 3, true)
+	allocs = testing.AllocsPerRun(10, func() {
+		es.FactorizeIndex(a, 0, 3, true)
+	})
+	if allocs != 0 {
+		t.Errorf("unexpected allocations reusing EigenSym for a partial factorization: got:%v want:0", allocs)
+	}
+}
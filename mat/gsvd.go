@@ -5,6 +5,9 @@
 package mat
 
 import (
+	"math"
+	"sort"
+
 	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/lapack"
@@ -44,6 +47,7 @@ type GSVD struct {
 	kind GSVDKind
 
 	r, p, c, k, l int
+	tolA, tolB    float64
 	s1, s2        []float64
 	a, b, u, v, q blas64.General
 
@@ -78,7 +82,38 @@ func (gsvd *GSVD) succFact() bool {
 //
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, routines that require a successful factorization will panic.
+//
+// The effective numerical rank of [ Aᵀ Bᵀ ]ᵀ is determined using the default
+// tolerances
+//
+//	tolA = max(r,c) * max(‖A‖_F, λ) * ε,
+//	tolB = max(p,c) * max(‖B‖_F, λ) * ε,
+//
+// where ε is the machine precision and λ is the smallest normal number. Use
+// FactorizeTol to specify other tolerances.
 func (gsvd *GSVD) Factorize(a, b Matrix, kind GSVDKind) (ok bool) {
+	return gsvd.factorize(a, b, kind, -1, -1)
+}
+
+// FactorizeTol computes the generalized singular value decomposition of the
+// r×c matrix A and the p×c matrix B as described for Factorize, using the
+// tolerances tolA and tolB to determine the effective numerical rank of
+// [ Aᵀ Bᵀ ]ᵀ. Larger tolerances treat more of the data as noise and result
+// in a lower rank. The tolerances are also used as the convergence criteria
+// of the Jacobi-Kogbetliantz iteration that computes the decomposition.
+//
+// FactorizeTol returns whether the decomposition succeeded. FactorizeTol will
+// panic if tolA or tolB is negative or NaN.
+func (gsvd *GSVD) FactorizeTol(a, b Matrix, kind GSVDKind, tolA, tolB float64) (ok bool) {
+	if !(tolA >= 0) || !(tolB >= 0) {
+		panic("mat: invalid GSVD tolerance")
+	}
+	return gsvd.factorize(a, b, kind, tolA, tolB)
+}
+
+// factorize computes the GSVD of a and b. If tolA and tolB are negative the
+// default tolerances are used.
+func (gsvd *GSVD) factorize(a, b Matrix, kind GSVDKind, tolA, tolB float64) (ok bool) {
 	// kill the previous decomposition
 	gsvd.r = 0
 	gsvd.kind = 0
@@ -137,10 +172,24 @@ func (gsvd *GSVD) Factorize(a, b Matrix, kind GSVDKind) (ok bool) {
 
 	gsvd.iwork = useInt(gsvd.iwork, c)
 
-	gsvd.work = use(gsvd.work, 1)
-	lapack64.Ggsvd3(jobU, jobV, jobQ, aCopy.mat, bCopy.mat, gsvd.s1, gsvd.s2, gsvd.u, gsvd.v, gsvd.q, gsvd.work, -1, gsvd.iwork)
-	gsvd.work = use(gsvd.work, int(gsvd.work[0]))
-	gsvd.k, gsvd.l, ok = lapack64.Ggsvd3(jobU, jobV, jobQ, aCopy.mat, bCopy.mat, gsvd.s1, gsvd.s2, gsvd.u, gsvd.v, gsvd.q, gsvd.work, len(gsvd.work), gsvd.iwork)
+	if tolA < 0 {
+		// Use the tolerances computed by Ggsvd3.
+		gsvd.tolA = float64(max(r, c)) * math.Max(Norm(aCopy, 2), dlamchS) * dlamchP
+		gsvd.tolB = float64(max(p, c)) * math.Max(Norm(bCopy, 2), dlamchS) * dlamchP
+
+		gsvd.work = use(gsvd.work, 1)
+		lapack64.Ggsvd3(jobU, jobV, jobQ, aCopy.mat, bCopy.mat, gsvd.s1, gsvd.s2, gsvd.u, gsvd.v, gsvd.q, gsvd.work, -1, gsvd.iwork)
+		gsvd.work = use(gsvd.work, int(gsvd.work[0]))
+		gsvd.k, gsvd.l, ok = lapack64.Ggsvd3(jobU, jobV, jobQ, aCopy.mat, bCopy.mat, gsvd.s1, gsvd.s2, gsvd.u, gsvd.v, gsvd.q, gsvd.work, len(gsvd.work), gsvd.iwork)
+	} else {
+		gsvd.tolA, gsvd.tolB = tolA, tolB
+
+		gsvd.work = use(gsvd.work, 1)
+		lapack64.Ggsvp3(jobU, jobV, jobQ, aCopy.mat, bCopy.mat, tolA, tolB, gsvd.u, gsvd.v, gsvd.q, gsvd.iwork, gsvd.work, gsvd.work, -1)
+		gsvd.work = use(gsvd.work, max(c+int(gsvd.work[0]), 2*c, 1))
+		gsvd.k, gsvd.l = lapack64.Ggsvp3(jobU, jobV, jobQ, aCopy.mat, bCopy.mat, tolA, tolB, gsvd.u, gsvd.v, gsvd.q, gsvd.iwork, gsvd.work[:c], gsvd.work[c:], len(gsvd.work)-c)
+		_, ok = lapack64.Tgsja(jobU, jobV, jobQ, gsvd.k, gsvd.l, aCopy.mat, bCopy.mat, tolA, tolB, gsvd.s1, gsvd.s2, gsvd.u, gsvd.v, gsvd.q, gsvd.work)
+	}
 	if ok {
 		gsvd.a = aCopy.mat
 		gsvd.b = bCopy.mat
@@ -149,6 +198,19 @@ func (gsvd *GSVD) Factorize(a, b Matrix, kind GSVDKind) (ok bool) {
 	return ok
 }
 
+// Tolerances returns the tolerances used to determine the effective numerical
+// rank of [ Aᵀ Bᵀ ]ᵀ in the factorization. See the documentation for Factorize
+// and FactorizeTol for more information.
+//
+// Tolerances will panic if the receiver does not contain a successful
+// factorization.
+func (gsvd *GSVD) Tolerances() (tolA, tolB float64) {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	return gsvd.tolA, gsvd.tolB
+}
+
 // Kind returns the GSVDKind of the decomposition. If no decomposition has been
 // computed, Kind returns -1.
 func (gsvd *GSVD) Kind() GSVDKind {
@@ -188,6 +250,50 @@ func (gsvd *GSVD) GeneralizedValues(v []float64) []float64 {
 	return v
 }
 
+// SortedGeneralizedValues returns the generalized singular values of the
+// factorized matrices sorted in descending order, and the permutation that
+// sorts the values returned by GeneralizedValues. The i-th sorted value is
+// the value at index perm[i] of the values returned by GeneralizedValues,
+// and corresponds to the column k+perm[i] of Σ₁ and Σ₂ and the row k+perm[i]
+// of [ 0 R ]. Values that are equal keep their relative order.
+//
+// If the input slices are non-nil, the values and the permutation will be
+// stored in-place into the slices. In this case, the slices must have length
+// min(r,c)-k, and SortedGeneralizedValues will panic with
+// ErrSliceLengthMismatch otherwise. If an input slice is nil, a new slice of
+// the appropriate length will be allocated and returned.
+//
+// SortedGeneralizedValues will panic if the receiver does not contain a
+// successful factorization.
+func (gsvd *GSVD) SortedGeneralizedValues(v []float64, perm []int) ([]float64, []int) {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	d := min(gsvd.r, gsvd.c) - gsvd.k
+	if v == nil {
+		v = make([]float64, d)
+	}
+	if perm == nil {
+		perm = make([]int, d)
+	}
+	if len(v) != d || len(perm) != d {
+		panic(ErrSliceLengthMismatch)
+	}
+	for i := range perm {
+		perm[i] = i
+	}
+	// Since α² + β² = 1, the generalized values α/β are in
+	// the same order as α, which is never infinite.
+	alpha := gsvd.s1[gsvd.k:]
+	sort.SliceStable(perm, func(i, j int) bool {
+		return alpha[perm[i]] > alpha[perm[j]]
+	})
+	for i, j := range perm {
+		v[i] = alpha[j] / gsvd.s2[gsvd.k+j]
+	}
+	return v, perm
+}
+
 // ValuesA returns the singular values of the factorized A matrix.
 // If the input slice is non-nil, the values will be stored in-place into the slice.
 // In this case, the slice must have length min(r,c)-k, and ValuesA will panic with
@@ -434,3 +540,66 @@ func (gsvd *GSVD) QTo(dst *Dense) {
 	}
 	dst.Copy(tmp)
 }
+
+// ATo reconstructs the matrix A from the generalized singular value
+// decomposition,
+//
+//	A = U * Σ₁ * [ 0 R ] * Qᵀ,
+//
+// storing the result into dst. A is size r×c.
+//
+// If dst is empty, ATo will resize dst to be r×c. When dst is non-empty, ATo
+// will panic if dst is not r×c. ATo will also panic if the receiver does not
+// contain a successful factorization with U and Q computed.
+func (gsvd *GSVD) ATo(dst *Dense) {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	if gsvd.kind&(GSVDU|GSVDQ) != GSVDU|GSVDQ {
+		panic("mat: improper GSVD kind")
+	}
+	var u, sigma Dense
+	gsvd.UTo(&u)
+	gsvd.SigmaATo(&sigma)
+	gsvd.reconstructTo(dst, &u, &sigma)
+}
+
+// BTo reconstructs the matrix B from the generalized singular value
+// decomposition,
+//
+//	B = V * Σ₂ * [ 0 R ] * Qᵀ,
+//
+// storing the result into dst. B is size p×c.
+//
+// If dst is empty, BTo will resize dst to be p×c. When dst is non-empty, BTo
+// will panic if dst is not p×c. BTo will also panic if the receiver does not
+// contain a successful factorization with V and Q computed.
+func (gsvd *GSVD) BTo(dst *Dense) {
+	if !gsvd.succFact() {
+		panic(badFact)
+	}
+	if gsvd.kind&(GSVDV|GSVDQ) != GSVDV|GSVDQ {
+		panic("mat: improper GSVD kind")
+	}
+	var v, sigma Dense
+	gsvd.VTo(&v)
+	gsvd.SigmaBTo(&sigma)
+	gsvd.reconstructTo(dst, &v, &sigma)
+}
+
+// reconstructTo stores W * Σ * [ 0 R ] * Qᵀ into dst.
+func (gsvd *GSVD) reconstructTo(dst, w, sigma *Dense) {
+	r, _ := w.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, gsvd.c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r2 != r || c2 != gsvd.c {
+			panic(ErrShape)
+		}
+	}
+	var zeroR, q Dense
+	gsvd.ZeroRTo(&zeroR)
+	gsvd.QTo(&q)
+	dst.Product(w, sigma, &zeroR, q.T())
+}
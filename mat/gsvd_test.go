@@ -6,6 +6,7 @@ package mat
 
 import (
 	"fmt"
+	"math"
 	"math/rand/v2"
 	"testing"
 
@@ -130,3 +131,160 @@ func extractGSVD(gsvd *GSVD) (c, s []float64, s1, s2, zR, u, v, q *Dense) {
 	s = gsvd.ValuesB(nil)
 	return c, s, s1, s2, zR, u, v, q
 }
+
+func TestGSVDTol(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, p, n int
+	}{
+		{5, 3, 5},
+		{5, 3, 3},
+		{3, 3, 5},
+		{6, 4, 5},
+		{20, 10, 15},
+	} {
+		m, p, n := test.m, test.p, test.n
+		a := NewDense(m, n, nil)
+		a.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, a)
+		b := NewDense(p, n, nil)
+		b.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, b)
+
+		var want GSVD
+		if !want.Factorize(a, b, GSVDAll) {
+			t.Fatalf("%v: GSVD factorization failed", test)
+		}
+		tolA, tolB := want.Tolerances()
+		if !(tolA > 0 && tolB > 0) {
+			t.Errorf("%v: unexpected default tolerances: %v %v", test, tolA, tolB)
+		}
+
+		// Factorizing with the default tolerances must give the
+		// same decomposition as Factorize.
+		var got GSVD
+		if !got.FactorizeTol(a, b, GSVDAll, tolA, tolB) {
+			t.Fatalf("%v: GSVD factorization with tolerances failed", test)
+		}
+		if gotA, gotB := got.Tolerances(); gotA != tolA || gotB != tolB {
+			t.Errorf("%v: unexpected tolerances: got:%v,%v want:%v,%v", test, gotA, gotB, tolA, tolB)
+		}
+		wk, wl := want.Rank()
+		gk, gl := got.Rank()
+		if gk != wk || gl != wl {
+			t.Errorf("%v: rank mismatch: got:%d,%d want:%d,%d", test, gk, gl, wk, wl)
+		}
+		if !floats.EqualApprox(got.GeneralizedValues(nil), want.GeneralizedValues(nil), 1e-14) {
+			t.Errorf("%v: generalized values mismatch", test)
+		}
+
+		// Check the reconstruction of A and B.
+		var ra, rb Dense
+		got.ATo(&ra)
+		got.BTo(&rb)
+		if !EqualApprox(&ra, a, 1e-10) {
+			t.Errorf("%v: A not reconstructed\ngot:\n% 0.2f\nwant:\n% 0.2f", test, Formatted(&ra), Formatted(a))
+		}
+		if !EqualApprox(&rb, b, 1e-10) {
+			t.Errorf("%v: B not reconstructed\ngot:\n% 0.2f\nwant:\n% 0.2f", test, Formatted(&rb), Formatted(b))
+		}
+
+		// Check the sorted generalized values.
+		values := got.GeneralizedValues(nil)
+		sorted, perm := got.SortedGeneralizedValues(nil, nil)
+		for i, j := range perm {
+			if sorted[i] != values[j] {
+				t.Errorf("%v: sorted value %d does not match permutation: got:%v want:%v", test, i, sorted[i], values[j])
+			}
+			if i > 0 && sorted[i] > sorted[i-1] {
+				t.Errorf("%v: values not sorted: %v", test, sorted)
+			}
+		}
+	}
+}
+
+func TestGSVDTolRank(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const (
+		m, p, n = 8, 6, 6
+		noise   = 1e-9
+	)
+	// Construct A and B with rank 2 up to noise, such that
+	// [ Aᵀ Bᵀ ]ᵀ has rank 3 up to noise.
+	x := NewDense(n, 3, nil)
+	x.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, x)
+	ca := NewDense(m, 3, nil)
+	ca.Apply(func(_, j int, _ float64) float64 {
+		if j == 2 {
+			return 0
+		}
+		return rnd.NormFloat64()
+	}, ca)
+	cb := NewDense(p, 3, nil)
+	cb.Apply(func(_, j int, _ float64) float64 {
+		if j == 0 {
+			return 0
+		}
+		return rnd.NormFloat64()
+	}, cb)
+	var a, b Dense
+	a.Mul(ca, x.T())
+	b.Mul(cb, x.T())
+	a.Apply(func(_, _ int, v float64) float64 { return v + noise*rnd.NormFloat64() }, &a)
+	b.Apply(func(_, _ int, v float64) float64 { return v + noise*rnd.NormFloat64() }, &b)
+
+	var gsvd GSVD
+	if !gsvd.Factorize(&a, &b, GSVDAll) {
+		t.Fatal("GSVD factorization failed")
+	}
+	if k, l := gsvd.Rank(); k+l != n {
+		t.Errorf("unexpected rank with default tolerances: got:%d want:%d", k+l, n)
+	}
+
+	// Tolerances above the noise level recover the rank.
+	if !gsvd.FactorizeTol(&a, &b, GSVDAll, 1e-6, 1e-6) {
+		t.Fatal("GSVD factorization with tolerances failed")
+	}
+	if k, l := gsvd.Rank(); k+l != 3 {
+		t.Errorf("unexpected rank with tolerances: got:%d want:%d", k+l, 3)
+	}
+	var ra, rb Dense
+	gsvd.ATo(&ra)
+	gsvd.BTo(&rb)
+	if !EqualApprox(&ra, &a, 1e-6) {
+		t.Errorf("A not reconstructed to tolerance")
+	}
+	if !EqualApprox(&rb, &b, 1e-6) {
+		t.Errorf("B not reconstructed to tolerance")
+	}
+}
+
+func TestGSVDPanics(t *testing.T) {
+	t.Parallel()
+	a := NewDense(3, 3, []float64{1, 2, 3, 4, 5, 6, 7, 8, 10})
+	b := NewDense(2, 3, []float64{1, 0, 1, 0, 1, 1})
+	var gsvd GSVD
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "negative tolA", fn: func() { gsvd.FactorizeTol(a, b, GSVDAll, -1, 0) }},
+		{name: "NaN tolB", fn: func() { gsvd.FactorizeTol(a, b, GSVDAll, 0, math.NaN()) }},
+		{name: "ATo without Q", fn: func() {
+			gsvd.Factorize(a, b, GSVDU|GSVDV)
+			gsvd.ATo(&Dense{})
+		}},
+		{name: "BTo without V", fn: func() {
+			gsvd.Factorize(a, b, GSVDU|GSVDQ)
+			gsvd.BTo(&Dense{})
+		}},
+		{name: "sorted length", fn: func() {
+			gsvd.Factorize(a, b, GSVDNone)
+			gsvd.SortedGeneralizedValues(make([]float64, 10), nil)
+		}},
+	} {
+		if panicked, _ := panics(test.fn); !panicked {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
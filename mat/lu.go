@@ -485,3 +485,259 @@ func (lu *LU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
 		return nil
 	}
 }
+
+// BandLU is a square n×n band matrix represented by its LU factorization with
+// partial pivoting.
+//
+// The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is lower triangular with unit diagonal
+// elements and U is upper triangular. If A has kl sub-diagonals and ku
+// super-diagonals, L has at most kl non-zero elements below the diagonal in
+// each column and U is a band matrix with kl+ku super-diagonals, so the
+// factorization and the solution of linear systems require O(n·kl·(kl+ku))
+// operations.
+//
+// Note that this matrix representation is useful for solving linear systems
+// of equations. BandLU methods may only be called on a value that has been
+// initialized by a call to Factorize.
+type BandLU struct {
+	// lu holds the factorization in the format
+	// computed by lapack64.Gbtrf. Its KU field
+	// is the upper bandwidth of the original
+	// matrix and its stride is 2*KL+KU+1.
+	lu    blas64.Band
+	swaps []int
+	cond  float64
+	ok    bool // Whether A is nonsingular
+}
+
+// Factorize computes the LU factorization of the square band matrix A and
+// stores the result in the receiver. The LU decomposition will complete
+// regardless of the singularity of a.
+func (lu *BandLU) Factorize(a Banded) {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	kl, ku := a.Bandwidth()
+	stride := 2*kl + ku + 1
+	lu.lu = blas64.Band{
+		Rows:   n,
+		Cols:   n,
+		KL:     kl,
+		KU:     ku,
+		Stride: stride,
+		Data:   use(lu.lu.Data, n*stride),
+	}
+	data := lu.lu.Data
+	if rb, ok := a.(RawBander); ok {
+		raw := rb.RawBand()
+		for i := 0; i < n; i++ {
+			copy(data[i*stride:i*stride+kl+ku+1], raw.Data[i*raw.Stride:i*raw.Stride+kl+ku+1])
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+				data[i*stride+j-i+kl] = a.At(i, j)
+			}
+		}
+	}
+	anorm := lapack64.Langb(CondNorm, lu.lu)
+
+	lu.swaps = useInt(lu.swaps, n)
+	lu.ok = lapack64.Gbtrf(lu.lu, lu.swaps)
+
+	work := getFloat64s(3*n, false)
+	iwork := getInts(n, false)
+	lu.cond = 1 / lapack64.Gbcon(CondNorm, lu.lu, lu.swaps, anorm, work, iwork)
+	putInts(iwork)
+	putFloat64s(work)
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (lu *BandLU) isValid() bool {
+	return lu.lu.Rows > 0
+}
+
+// Dims returns the dimensions of the factorized matrix A.
+func (lu *BandLU) Dims() (r, c int) {
+	return lu.lu.Rows, lu.lu.Cols
+}
+
+// Bandwidth returns the lower and upper bandwidth values of the factorized
+// matrix A.
+func (lu *BandLU) Bandwidth() (kl, ku int) {
+	return lu.lu.KL, lu.lu.KU
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (lu *BandLU) Cond() float64 {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	return lu.cond
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (lu *BandLU) Reset() {
+	lu.lu.Rows, lu.lu.Cols = 0, 0
+	lu.lu.KL, lu.lu.KU = 0, 0
+	lu.lu.Stride = 0
+	lu.lu.Data = lu.lu.Data[:0]
+	lu.swaps = lu.swaps[:0]
+	lu.cond = math.Inf(1)
+	lu.ok = false
+}
+
+// IsEmpty returns whether the receiver is empty. Empty factorizations can be
+// the receiver for dimensionally restricted operations. The receiver can be
+// emptied using Reset.
+func (lu *BandLU) IsEmpty() bool {
+	return !lu.isValid()
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (lu *BandLU) Det() float64 {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	if !lu.ok {
+		return 0
+	}
+	det, sign := lu.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. Numerical stability in product and
+// division expressions is generally improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (lu *BandLU) LogDet() (det float64, sign float64) {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	n := lu.lu.Rows
+	logDiag := getFloat64s(n, false)
+	defer putFloat64s(logDiag)
+	sign = 1.0
+	for i := 0; i < n; i++ {
+		v := lu.lu.Data[i*lu.lu.Stride+lu.lu.KL]
+		if v < 0 {
+			sign *= -1
+		}
+		if lu.swaps[i] != i {
+			sign *= -1
+		}
+		logDiag[i] = math.Log(math.Abs(v))
+	}
+	return floats.Sum(logDiag), sign
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B   if trans == false
+//	Aᵀ * X = B  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution matrix X
+// is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveTo will panic if the
+// receiver does not contain a factorization.
+func (lu *BandLU) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	n := lu.lu.Rows
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	if !lu.ok {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _ := untranspose(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawMatrixer); ok {
+		dst.checkOverlap(rm.RawMatrix())
+	}
+
+	dst.Copy(b)
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	lapack64.Gbtrs(t, lu.lu, dst.mat, lu.swaps)
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	A * x = b   if trans == false
+//	Aᵀ * x = b  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution vector x
+// is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveVecTo will panic if the
+// receiver does not contain a factorization.
+func (lu *BandLU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	n := lu.lu.Rows
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+
+	switch rv := b.(type) {
+	default:
+		dst.reuseAsNonZeroed(n)
+		return lu.SolveTo(dst.asDense(), trans, b)
+	case RawVectorer:
+		if dst != b {
+			dst.checkOverlap(rv.RawVector())
+		}
+
+		if !lu.ok {
+			return Condition(math.Inf(1))
+		}
+
+		dst.reuseAsNonZeroed(n)
+		var restore func()
+		if dst == b {
+			dst, restore = dst.isolatedWorkspace(b)
+			defer restore()
+		}
+		dst.CopyVec(b)
+		t := blas.NoTrans
+		if trans {
+			t = blas.Trans
+		}
+		lapack64.Gbtrs(t, lu.lu, dst.asGeneral(), lu.swaps)
+		if lu.cond > ConditionTolerance {
+			return Condition(lu.cond)
+		}
+		return nil
+	}
+}
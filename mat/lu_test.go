@@ -5,8 +5,12 @@
 package mat
 
 import (
+	"fmt"
+	"math"
 	"math/rand/v2"
 	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
 )
 
 func TestLU(t *testing.T) {
@@ -176,3 +180,196 @@ func TestLUSolveVecTo(t *testing.T) {
 	}
 	// TODO(btracey): Add testOneInput test when such a function exists.
 }
+
+func TestBandLUSolveTo(t *testing.T) {
+	t.Parallel()
+
+	const (
+		nrhs = 4
+		tol  = 1e-14
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		for _, kl := range []int{0, 1, n / 2, n - 1} {
+			for _, ku := range []int{0, 2, n - 1} {
+				kl, ku := min(kl, n-1), min(ku, n-1)
+				a := randBandLU(n, kl, ku, rnd)
+
+				want := NewDense(n, nrhs, nil)
+				for i := 0; i < n; i++ {
+					for j := 0; j < nrhs; j++ {
+						want.Set(i, j, rnd.NormFloat64())
+					}
+				}
+
+				for _, trans := range []bool{false, true} {
+					var b Dense
+					if trans {
+						b.Mul(a.T(), want)
+					} else {
+						b.Mul(a, want)
+					}
+					for _, typ := range []Banded{a, (*basicBanded)(a)} {
+						name := fmt.Sprintf("Case n=%d,kl=%d,ku=%d,trans=%t,type=%T", n, kl, ku, trans, typ)
+
+						var lu BandLU
+						lu.Factorize(typ)
+
+						var got Dense
+						err := lu.SolveTo(&got, trans, &b)
+						if err != nil {
+							t.Errorf("%v: unexpected error from SolveTo: %v", name, err)
+							continue
+						}
+						var resid Dense
+						resid.Sub(want, &got)
+						diff := Norm(&resid, math.Inf(1))
+						if diff > tol*lu.Cond() {
+							t.Errorf("%v: unexpected solution; diff=%v", name, diff)
+						}
+
+						got.Copy(&b)
+						err = lu.SolveTo(&got, trans, &got)
+						if err != nil {
+							t.Errorf("%v: unexpected error from SolveTo when dst==b: %v", name, err)
+							continue
+						}
+						resid.Sub(want, &got)
+						diff = Norm(&resid, math.Inf(1))
+						if diff > tol*lu.Cond() {
+							t.Errorf("%v: unexpected solution when dst==b; diff=%v", name, diff)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBandLUSolveVecTo(t *testing.T) {
+	t.Parallel()
+
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		for _, kl := range []int{0, 1, n / 2, n - 1} {
+			for _, ku := range []int{0, 2, n - 1} {
+				kl, ku := min(kl, n-1), min(ku, n-1)
+				a := randBandLU(n, kl, ku, rnd)
+
+				want := NewVecDense(n, nil)
+				for i := 0; i < n; i++ {
+					want.SetVec(i, rnd.NormFloat64())
+				}
+
+				for _, trans := range []bool{false, true} {
+					var b VecDense
+					if trans {
+						b.MulVec(a.T(), want)
+					} else {
+						b.MulVec(a, want)
+					}
+					for _, typ := range []Vector{&b, (*basicVector)(VecDenseCopyOf(&b))} {
+						name := fmt.Sprintf("Case n=%d,kl=%d,ku=%d,trans=%t,type=%T", n, kl, ku, trans, typ)
+
+						var lu BandLU
+						lu.Factorize(a)
+
+						var got VecDense
+						err := lu.SolveVecTo(&got, trans, typ)
+						if err != nil {
+							t.Errorf("%v: unexpected error from SolveVecTo: %v", name, err)
+							continue
+						}
+						var resid VecDense
+						resid.SubVec(want, &got)
+						diff := Norm(&resid, math.Inf(1))
+						if diff > tol*lu.Cond() {
+							t.Errorf("%v: unexpected solution; diff=%v", name, diff)
+						}
+					}
+
+					got := VecDenseCopyOf(&b)
+					var lu BandLU
+					lu.Factorize(a)
+					err := lu.SolveVecTo(got, trans, got)
+					if err != nil {
+						t.Errorf("Case n=%d,kl=%d,ku=%d,trans=%t: unexpected error from SolveVecTo when dst==b: %v", n, kl, ku, trans, err)
+						continue
+					}
+					var resid VecDense
+					resid.SubVec(want, got)
+					diff := Norm(&resid, math.Inf(1))
+					if diff > tol*lu.Cond() {
+						t.Errorf("Case n=%d,kl=%d,ku=%d,trans=%t: unexpected solution when dst==b; diff=%v", n, kl, ku, trans, diff)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBandLUDetCond(t *testing.T) {
+	t.Parallel()
+
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		for _, kl := range []int{0, 1, n / 2, n - 1} {
+			for _, ku := range []int{0, 2, n - 1} {
+				kl, ku := min(kl, n-1), min(ku, n-1)
+				name := fmt.Sprintf("Case n=%d,kl=%d,ku=%d", n, kl, ku)
+				a := randBandLU(n, kl, ku, rnd)
+
+				var lu BandLU
+				lu.Factorize(a)
+				var luDense LU
+				luDense.Factorize(DenseCopyOf(a))
+
+				got := lu.Det()
+				want := luDense.Det()
+				if !scalar.EqualWithinRel(got, want, tol) {
+					t.Errorf("%v: unexpected determinant; got=%v, want=%v", name, got, want)
+				}
+
+				// Both condition numbers are estimates,
+				// so only check that they are close.
+				gotCond := lu.Cond()
+				wantCond := Cond(a, 1)
+				if gotCond > 10*wantCond || wantCond > 10*gotCond {
+					t.Errorf("%v: unexpected condition number; got=%v, want=%v", name, gotCond, wantCond)
+				}
+			}
+		}
+	}
+
+	// A singular matrix.
+	a := NewBandDense(3, 3, 1, 1, []float64{
+		0, 1, 2,
+		1, 2, 3,
+		0, 0, 0,
+	})
+	var lu BandLU
+	lu.Factorize(a)
+	if det := lu.Det(); det != 0 {
+		t.Errorf("unexpected determinant of singular matrix; got=%v, want=0", det)
+	}
+	var x VecDense
+	err := lu.SolveVecTo(&x, false, NewVecDense(3, []float64{1, 2, 3}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for singular matrix; got=%v, want Condition", err)
+	}
+}
+
+// randBandLU returns a random n×n band matrix with kl sub-diagonals and ku
+// super-diagonals that is well-conditioned but requires pivoting.
+func randBandLU(n, kl, ku int, rnd *rand.Rand) *BandDense {
+	a := NewBandDense(n, n, kl, ku, nil)
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			a.SetBand(i, j, rnd.NormFloat64())
+		}
+		a.SetBand(i, i, a.At(i, i)+2)
+	}
+	return a
+}
@@ -736,6 +736,13 @@ func TestMulVecToer(t *testing.T) {
 		NewTridiag(4, random(3), random(4), random(3)),
 		NewTridiag(7, random(6), random(7), random(6)),
 		NewTridiag(10, random(9), random(10), random(9)),
+		NewCOO(1, 1, []int{0, 0}, []int{0, 0}, random(2)),
+		NewCOO(4, 7, []int{3, 0, 3, 1}, []int{6, 0, 6, 2}, random(4)),
+		NewCSR(1, 1, []int{0, 1}, []int{0}, random(1)),
+		NewCSR(3, 5, []int{0, 2, 2, 5}, []int{1, 4, 0, 2, 3}, random(5)),
+		NewCSR(5, 3, []int{0, 1, 1, 3, 3, 4}, []int{2, 0, 1, 2}, random(4)),
+		NewCSC(3, 5, []int{0, 2, 2, 3, 3, 5}, []int{0, 2, 1, 0, 1}, random(5)),
+		NewCSC(4, 4, nil, nil, nil),
 	} {
 		// Dense copy of A used for computing the expected result.
 		var aDense Dense
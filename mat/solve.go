@@ -4,6 +4,8 @@
 
 package mat
 
+import "gonum.org/v1/gonum/lapack/lapack64"
+
 // Solve solves the linear least squares problem
 //
 //	minimize over x |b - A*x|_2
@@ -122,3 +124,92 @@ func (v *VecDense) SolveVec(a Matrix, b Vector) error {
 	m := v.asDense()
 	return m.Solve(a, b)
 }
+
+// SolveRank solves the linear least squares problem
+//
+//	minimize over x |b - A*x|_2 and |x|_2
+//
+// where A is an m×n matrix which may be rank-deficient, b is a given m element
+// vector and x is n element solution vector, using the singular value
+// decomposition of A. Singular values of A less than or equal to rcond times
+// the largest singular value are treated as zero, so that the solution is the
+// minimum-norm solution of the problem with A replaced by its closest matrix
+// of the returned effective rank. If rcond is negative, machine precision is
+// used instead.
+//
+// Unlike Solve, which assumes that A has full rank, SolveRank gives a
+// meaningful solution when A is rank-deficient or ill-conditioned, at the
+// cost of computing the singular value decomposition.
+//
+// Several right-hand side vectors b and solution vectors x can be handled in a
+// single call. Vectors b are stored in the columns of the m×k matrix B. Vectors
+// x will be stored into the n×k receiver.
+//
+// SolveRank returns the effective rank of A and whether the singular value
+// decomposition converged. If ok is false, the receiver is not modified.
+func (m *Dense) SolveRank(a, b Matrix, rcond float64) (rank int, ok bool) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br {
+		panic(ErrShape)
+	}
+	if !m.IsEmpty() {
+		if r, c := m.Dims(); r != ac || c != bc {
+			panic(ErrShape)
+		}
+	}
+
+	// Gelsd overwrites A and requires B to have
+	// max(m,n) rows, so work on copies of both.
+	aCopy := getDenseWorkspace(ar, ac, false)
+	defer putDenseWorkspace(aCopy)
+	aCopy.Copy(a)
+	x := getDenseWorkspace(max(ar, ac), bc, false)
+	defer putDenseWorkspace(x)
+	x.Copy(b)
+
+	s := getFloat64s(min(ar, ac), false)
+	defer putFloat64s(s)
+	work := []float64{0}
+	lapack64.Gelsd(aCopy.mat, x.mat, s, rcond, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	defer putFloat64s(work)
+	rank, ok = lapack64.Gelsd(aCopy.mat, x.mat, s, rcond, work, len(work))
+	if !ok {
+		return 0, false
+	}
+
+	m.reuseAsNonZeroed(ac, bc)
+	m.Copy(x.slice(0, ac, 0, bc))
+	return rank, true
+}
+
+// SolveVecRank solves the linear least squares problem
+//
+//	minimize over x |b - A*x|_2 and |x|_2
+//
+// where A is an m×n matrix which may be rank-deficient, using the singular
+// value decomposition of A as described for Dense.SolveRank. The solution
+// vector x will be stored into the receiver.
+//
+// SolveVecRank returns the effective rank of A and whether the singular value
+// decomposition converged. If ok is false, the receiver is not modified.
+func (v *VecDense) SolveVecRank(a Matrix, b Vector, rcond float64) (rank int, ok bool) {
+	if _, bc := b.Dims(); bc != 1 {
+		panic(ErrShape)
+	}
+	_, c := a.Dims()
+	if !v.IsEmpty() && v.Len() != c {
+		panic(ErrShape)
+	}
+
+	x := getDenseWorkspace(c, 1, false)
+	defer putDenseWorkspace(x)
+	rank, ok = x.SolveRank(a, b, rcond)
+	if !ok {
+		return 0, false
+	}
+	v.reuseAsNonZeroed(c)
+	v.CopyVec(x.ColView(0))
+	return rank, true
+}
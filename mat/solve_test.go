@@ -304,3 +304,96 @@ func TestSolveVec(t *testing.T) {
 	}
 	testTwoInput(t, "SolveVec", &VecDense{}, method, denseComparison, legalTypesMatrixVector, legalSizeSolve, 1e-12)
 }
+
+func TestSolveRank(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, r, bc int
+	}{
+		{5, 5, 5, 1},
+		{5, 5, 3, 2},
+		{10, 5, 5, 3},
+		{10, 5, 2, 3},
+		{5, 10, 5, 2},
+		{5, 10, 4, 2},
+		{20, 8, 1, 1},
+	} {
+		m, n, r, bc := test.m, test.n, test.r, test.bc
+
+		// Construct a rank-r matrix A = L*R perturbed by
+		// noise well below the rank threshold.
+		l := NewDense(m, r, nil)
+		l.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, l)
+		rt := NewDense(r, n, nil)
+		rt.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, rt)
+		var a Dense
+		a.Mul(l, rt)
+		a.Apply(func(_, _ int, v float64) float64 { return v + 1e-14*rnd.NormFloat64() }, &a)
+		b := NewDense(m, bc, nil)
+		b.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, b)
+
+		var x Dense
+		rank, ok := x.SolveRank(&a, b, 1e-10)
+		if !ok {
+			t.Fatalf("m=%d,n=%d,r=%d: unexpected failure", m, n, r)
+		}
+		if rank != r {
+			t.Errorf("m=%d,n=%d,r=%d: unexpected rank: got %d", m, n, r, rank)
+		}
+
+		var svd SVD
+		if !svd.Factorize(&a, SVDThin) {
+			t.Fatalf("m=%d,n=%d,r=%d: SVD failed", m, n, r)
+		}
+		var want Dense
+		svd.SolveTo(&want, b, r)
+		if !EqualApprox(&x, &want, tol) {
+			t.Errorf("m=%d,n=%d,r=%d: unexpected solution:\ngot: %v\nwant:%v", m, n, r, Formatted(&x), Formatted(&want))
+		}
+
+		var xv VecDense
+		rank, ok = xv.SolveVecRank(&a, b.ColView(0), 1e-10)
+		if !ok || rank != r {
+			t.Errorf("m=%d,n=%d,r=%d: unexpected vector result: rank=%d ok=%t", m, n, r, rank, ok)
+		}
+		if !EqualApprox(&xv, want.ColView(0), tol) {
+			t.Errorf("m=%d,n=%d,r=%d: unexpected vector solution", m, n, r)
+		}
+
+		if m == n {
+			// Check that the receiver may be the right-hand side.
+			bCopy := DenseCopyOf(b)
+			_, ok = bCopy.SolveRank(&a, bCopy, 1e-10)
+			if !ok || !EqualApprox(bCopy, &want, tol) {
+				t.Errorf("m=%d,n=%d,r=%d: unexpected solution when receiver is b", m, n, r)
+			}
+		}
+	}
+
+	// A negative rcond uses machine precision, so a full rank
+	// matrix gives the same solution as Solve.
+	a := NewDense(4, 3, []float64{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 10,
+		1, 0, 1,
+	})
+	b := NewDense(4, 1, []float64{1, 2, 3, 4})
+	var got, want Dense
+	rank, ok := got.SolveRank(a, b, -1)
+	if !ok || rank != 3 {
+		t.Errorf("unexpected result for full rank matrix: rank=%d ok=%t", rank, ok)
+	}
+	if err := want.Solve(a, b); err != nil {
+		t.Fatalf("unexpected error from Solve: %v", err)
+	}
+	if !EqualApprox(&got, &want, 1e-12) {
+		t.Errorf("unexpected solution for full rank matrix:\ngot: %v\nwant:%v", Formatted(&got), Formatted(&want))
+	}
+
+	if panicked, _ := panics(func() { got.SolveRank(a, NewDense(3, 1, nil), -1) }); !panicked {
+		t.Errorf("expected panic for mismatched right-hand side")
+	}
+}
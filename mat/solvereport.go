@@ -0,0 +1,205 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+// SolveReport describes the accuracy of a computed solution X of a system of
+// linear equations
+//
+//	A * X = B.
+//
+// A SolveReport is returned by the SolveReport and SolveReportTo methods, which
+// in addition to solving the system compute the residual of the solution and
+// optionally improve the solution by iterative refinement. All norms are
+// computed using CondNorm.
+type SolveReport struct {
+	// Cond is the estimate of the condition number of A
+	// computed from its factorization. The reciprocal
+	// condition number of A is 1/Cond.
+	Cond float64
+
+	// Residual is the norm of the residual of the
+	// returned solution,
+	//  ‖B - A * X‖.
+	Residual float64
+
+	// BackwardError is the normwise relative backward
+	// error of the returned solution,
+	//  ‖B - A * X‖ / (‖A‖ ‖X‖ + ‖B‖),
+	// which is the size of the smallest relative
+	// perturbation of A and B for which X is an exact
+	// solution. A backward stable solve gives a
+	// BackwardError of the order of machine epsilon.
+	BackwardError float64
+
+	// Refinements is the number of steps of iterative
+	// refinement that were applied to the solution.
+	Refinements int
+}
+
+// ErrorBound returns an estimate of the bound on the relative error of the
+// solution,
+//
+//	‖X - X_true‖ / ‖X_true‖ ≲ Cond * BackwardError,
+//
+// where X_true is the exact solution of the system. The bound holds to first
+// order in BackwardError.
+func (r SolveReport) ErrorBound() float64 {
+	return r.Cond * r.BackwardError
+}
+
+// SolveReportTo solves a system of linear equations
+//
+//	A * X = B   if trans == false
+//	Aᵀ * X = B  if trans == true
+//
+// using the LU factorization of A stored in the receiver, and reports on the
+// accuracy of the solution. The solution matrix X is stored into dst. The
+// matrix a must be the matrix A that was factorized; it is used to compute the
+// residual of the solution.
+//
+// After the solve, at most refine steps of iterative refinement are applied to
+// the solution. A step solves for a correction to X from the residual of X and
+// is only kept if it reduces the backward error. Refinement stops when the
+// backward error reaches machine precision or is not reduced by at least a
+// half. If refine is zero, the residual is computed but the solution is not
+// refined.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. If A is exactly singular,
+// dst is not modified, and the Residual and BackwardError fields of the
+// report are NaN. SolveReportTo will panic if the receiver does not contain
+// a factorization, if a does not match the dimensions of the factorization,
+// or if refine is negative.
+func (lu *LU) SolveReportTo(dst *Dense, trans bool, a, b Matrix, refine int) (SolveReport, error) {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	_, n := lu.lu.Dims()
+	if r, c := a.Dims(); r != n || c != n {
+		panic(ErrShape)
+	}
+	if trans {
+		a = a.T()
+	}
+	return solveReport(dst, a, b, lu.cond, refine, func(x *Dense, b Matrix) error {
+		return lu.SolveTo(x, trans, b)
+	})
+}
+
+// SolveReportTo finds the matrix X that solves A * X = B where A is represented
+// by the Cholesky decomposition, and reports on the accuracy of the solution.
+// The solution matrix X is stored into dst. The matrix a must be the matrix A
+// that was factorized; it is used to compute the residual of the solution.
+//
+// After the solve, at most refine steps of iterative refinement are applied to
+// the solution. See the documentation for LU.SolveReportTo for a description of
+// the refinement.
+//
+// If the Cholesky decomposition is singular or near-singular a Condition error
+// is returned. See the documentation for Condition for more information.
+// SolveReportTo will panic if the receiver does not contain a factorization, if
+// a does not match the dimensions of the factorization, or if refine is
+// negative.
+func (c *Cholesky) SolveReportTo(dst *Dense, a Symmetric, b Matrix, refine int) (SolveReport, error) {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	if a.SymmetricDim() != c.chol.mat.N {
+		panic(ErrShape)
+	}
+	return solveReport(dst, a, b, c.cond, refine, c.SolveTo)
+}
+
+// SolveReport solves the system of linear equations A * X = B for the square
+// matrix A using the LU factorization of A, and reports on the accuracy of the
+// solution. The solution matrix X is stored into the receiver.
+//
+// After the solve, at most refine steps of iterative refinement are applied to
+// the solution. See the documentation for LU.SolveReportTo for a description of
+// the refinement.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveReport will panic if
+// A is not square or if refine is negative.
+func (m *Dense) SolveReport(a, b Matrix, refine int) (SolveReport, error) {
+	if r, c := a.Dims(); r != c {
+		panic(ErrSquare)
+	}
+	var lu LU
+	lu.Factorize(a)
+	return lu.SolveReportTo(m, false, a, b, refine)
+}
+
+// solveReport solves A * X = B with solve, storing X into dst, and refines X
+// at most refine times using the residual computed with a.
+func solveReport(dst *Dense, a, b Matrix, cond float64, refine int, solve func(x *Dense, b Matrix) error) (SolveReport, error) {
+	if refine < 0 {
+		panic("mat: negative refinement count")
+	}
+	n, _ := a.Dims()
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	report := SolveReport{
+		Cond:          cond,
+		Residual:      math.NaN(),
+		BackwardError: math.NaN(),
+	}
+
+	// Work on copies so that dst may alias a or b.
+	bCopy := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(bCopy)
+	bCopy.Copy(b)
+	x := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(x)
+	err := solve(x, bCopy)
+	if err != nil && math.IsInf(float64(err.(Condition)), 1) {
+		return report, err
+	}
+
+	r := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(r)
+	xPrev := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(xPrev)
+	anorm := Norm(a, math.Inf(1))
+	bnorm := Norm(bCopy, math.Inf(1))
+	for {
+		// Compute the residual and the backward error of x.
+		r.Mul(a, x)
+		r.Sub(bCopy, r)
+		res := Norm(r, math.Inf(1))
+		berr := 0.0
+		if res != 0 {
+			berr = res / (anorm*Norm(x, math.Inf(1)) + bnorm)
+		}
+
+		if report.Refinements > 0 && !(berr < report.BackwardError) {
+			// The last step did not reduce the backward
+			// error, so undo it.
+			x.Copy(xPrev)
+			report.Refinements--
+			break
+		}
+		improved := report.Refinements == 0 || berr <= report.BackwardError/2
+		report.Residual = res
+		report.BackwardError = berr
+		if report.Refinements == refine || berr <= dlamchE || !improved {
+			break
+		}
+
+		// Solve for the correction to x and apply it.
+		xPrev.Copy(x)
+		solve(r, r)
+		x.Add(x, r)
+		report.Refinements++
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	dst.Copy(x)
+	return report, err
+}
@@ -0,0 +1,262 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// checkSolveReport checks that report is consistent with the solution x of
+// a * x = b.
+func checkSolveReport(t *testing.T, name string, report SolveReport, a, x, b Matrix, cond float64) {
+	t.Helper()
+	var r Dense
+	r.Mul(a, x)
+	r.Sub(b, &r)
+	res := Norm(&r, math.Inf(1))
+	if math.Abs(report.Residual-res) > 1e-14*math.Max(1, res) {
+		t.Errorf("%s: unexpected residual: got:%v want:%v", name, report.Residual, res)
+	}
+	berr := res / (Norm(a, math.Inf(1))*Norm(x, math.Inf(1)) + Norm(b, math.Inf(1)))
+	if math.Abs(report.BackwardError-berr) > 1e-14*berr {
+		t.Errorf("%s: unexpected backward error: got:%v want:%v", name, report.BackwardError, berr)
+	}
+	if report.Cond != cond {
+		t.Errorf("%s: unexpected condition number: got:%v want:%v", name, report.Cond, cond)
+	}
+	if report.ErrorBound() != report.Cond*report.BackwardError {
+		t.Errorf("%s: unexpected error bound", name)
+	}
+}
+
+func TestLUSolveReportTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 50} {
+		for _, bc := range []int{1, 3} {
+			for _, trans := range []bool{false, true} {
+				a := NewDense(n, n, nil)
+				a.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, a)
+				b := NewDense(n, bc, nil)
+				b.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, b)
+				var lu LU
+				lu.Factorize(a)
+				var op Matrix = a
+				if trans {
+					op = a.T()
+				}
+
+				var want Dense
+				err := lu.SolveTo(&want, trans, b)
+				if err != nil {
+					t.Fatalf("n=%d: unexpected error: %v", n, err)
+				}
+				for _, refine := range []int{0, 1, 5} {
+					var x Dense
+					report, err := lu.SolveReportTo(&x, trans, a, b, refine)
+					if err != nil {
+						t.Errorf("n=%d,bc=%d,trans=%t,refine=%d: unexpected error: %v", n, bc, trans, refine, err)
+						continue
+					}
+					if refine == 0 && !Equal(&x, &want) {
+						t.Errorf("n=%d,bc=%d,trans=%t: solution differs from SolveTo", n, bc, trans)
+					}
+					if !EqualApprox(&x, &want, 1e-10) {
+						t.Errorf("n=%d,bc=%d,trans=%t,refine=%d: unexpected solution", n, bc, trans, refine)
+					}
+					if report.Refinements > refine {
+						t.Errorf("n=%d,bc=%d,trans=%t,refine=%d: too many refinements: %d", n, bc, trans, refine, report.Refinements)
+					}
+					if report.BackwardError > 1e-14 {
+						t.Errorf("n=%d,bc=%d,trans=%t,refine=%d: backward error too large: %v", n, bc, trans, refine, report.BackwardError)
+					}
+					checkSolveReport(t, "LU", report, op, &x, b, lu.Cond())
+				}
+			}
+		}
+	}
+}
+
+func TestSolveReportHilbert(t *testing.T) {
+	t.Parallel()
+	// The Hilbert matrix is badly conditioned, so the
+	// solution is only accurate to the error bound.
+	const n = 10
+	a := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, 1/float64(i+j+1))
+		}
+	}
+	xTrue := NewDense(n, 1, nil)
+	for i := 0; i < n; i++ {
+		xTrue.Set(i, 0, float64(i+1))
+	}
+	var b Dense
+	b.Mul(a, xTrue)
+
+	var chol Cholesky
+	if !chol.Factorize(a) {
+		t.Fatal("Hilbert matrix not positive definite")
+	}
+	var lu LU
+	lu.Factorize(a)
+	for _, test := range []struct {
+		name  string
+		cond  float64
+		solve func(x *Dense, refine int) (SolveReport, error)
+	}{
+		{
+			name: "LU",
+			cond: lu.Cond(),
+			solve: func(x *Dense, refine int) (SolveReport, error) {
+				return lu.SolveReportTo(x, false, a, &b, refine)
+			},
+		},
+		{
+			name: "Cholesky",
+			cond: chol.Cond(),
+			solve: func(x *Dense, refine int) (SolveReport, error) {
+				return chol.SolveReportTo(x, a, &b, refine)
+			},
+		},
+	} {
+		var x0, x Dense
+		report0, err := test.solve(&x0, 0)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		report, err := test.solve(&x, 10)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		checkSolveReport(t, test.name, report0, a, &x0, &b, test.cond)
+		checkSolveReport(t, test.name, report, a, &x, &b, test.cond)
+		if report0.Refinements != 0 {
+			t.Errorf("%s: unexpected refinements without refinement: %d", test.name, report0.Refinements)
+		}
+		if report.Cond < 1e12 {
+			t.Errorf("%s: condition number of Hilbert matrix too small: %v", test.name, report.Cond)
+		}
+		if report.BackwardError > report0.BackwardError {
+			t.Errorf("%s: refinement increased the backward error: %v > %v", test.name, report.BackwardError, report0.BackwardError)
+		}
+
+		// Check that the error bound holds.
+		var diff Dense
+		diff.Sub(&x, xTrue)
+		relErr := Norm(&diff, math.Inf(1)) / Norm(xTrue, math.Inf(1))
+		if relErr > report.ErrorBound() {
+			t.Errorf("%s: error bound does not hold: %v > %v", test.name, relErr, report.ErrorBound())
+		}
+	}
+}
+
+func TestSolveReportPerturbed(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 20
+	a := NewDense(n, n, nil)
+	a.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, a)
+	b := NewDense(n, 1, nil)
+	b.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, b)
+
+	// Factorize a perturbation of A, so that the solution computed
+	// from the factorization is inaccurate and iterative refinement
+	// is needed to recover the solution.
+	perturbed := DenseCopyOf(a)
+	perturbed.Apply(func(_, _ int, v float64) float64 { return v + 1e-6*rnd.NormFloat64() }, perturbed)
+	var lu LU
+	lu.Factorize(perturbed)
+
+	var x0, x Dense
+	report0, err := lu.SolveReportTo(&x0, false, a, b, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	report, err := lu.SolveReportTo(&x, false, a, b, 20)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkSolveReport(t, "perturbed", report0, a, &x0, b, lu.Cond())
+	checkSolveReport(t, "perturbed", report, a, &x, b, lu.Cond())
+	if report0.BackwardError < 1e-10 {
+		t.Errorf("backward error of perturbed solve unexpectedly small: %v", report0.BackwardError)
+	}
+	if report.Refinements == 0 || report.Refinements > 20 {
+		t.Errorf("unexpected number of refinements: %d", report.Refinements)
+	}
+	if report.BackwardError > 1e-14 {
+		t.Errorf("refinement did not recover the solution: backward error %v", report.BackwardError)
+	}
+}
+
+func TestDenseSolveReport(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 20
+	a := NewDense(n, n, nil)
+	a.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, a)
+	b := NewDense(n, 2, nil)
+	b.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, b)
+
+	var want Dense
+	err := want.Solve(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Solve in place to check that the receiver may alias b.
+	x := DenseCopyOf(b)
+	report, err := x.SolveReport(a, x, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !EqualApprox(x, &want, 1e-10) {
+		t.Errorf("unexpected solution")
+	}
+	var lu LU
+	lu.Factorize(a)
+	checkSolveReport(t, "Dense", report, a, x, b, lu.Cond())
+
+	// A singular matrix returns a Condition error.
+	s := NewDense(2, 2, []float64{1, 2, 2, 4})
+	x = NewDense(2, 1, []float64{-1, -1})
+	report, err = x.SolveReport(s, NewDense(2, 1, []float64{1, 1}), 2)
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular matrix, got:%v", err)
+	}
+	if !math.IsNaN(report.Residual) || !math.IsNaN(report.BackwardError) {
+		t.Errorf("unexpected report for singular matrix: %+v", report)
+	}
+	if !Equal(x, NewDense(2, 1, []float64{-1, -1})) {
+		t.Errorf("receiver modified for singular matrix")
+	}
+}
+
+func TestSolveReportPanics(t *testing.T) {
+	t.Parallel()
+	a := NewDense(3, 3, []float64{4, 1, 0, 1, 4, 1, 0, 1, 4})
+	b := NewDense(3, 1, []float64{1, 2, 3})
+	var lu LU
+	lu.Factorize(a)
+	var x Dense
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "negative refine", fn: func() { lu.SolveReportTo(&x, false, a, b, -1) }},
+		{name: "a mismatch", fn: func() { lu.SolveReportTo(&x, false, NewDense(2, 2, nil), b, 1) }},
+		{name: "b mismatch", fn: func() { lu.SolveReportTo(&x, false, a, NewDense(2, 1, nil), 1) }},
+		{name: "non-square", fn: func() { x.SolveReport(NewDense(3, 2, nil), b, 1) }},
+		{name: "empty LU", fn: func() { (&LU{}).SolveReportTo(&x, false, a, b, 1) }},
+	} {
+		if panicked, _ := panics(test.fn); !panicked {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
@@ -0,0 +1,570 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+var (
+	cooMatrix *COO
+	_         Matrix      = cooMatrix
+	_         NonZeroDoer = cooMatrix
+
+	csrMatrix *CSR
+	_         Matrix         = csrMatrix
+	_         NonZeroDoer    = csrMatrix
+	_         RowNonZeroDoer = csrMatrix
+
+	cscMatrix *CSC
+	_         Matrix         = cscMatrix
+	_         NonZeroDoer    = cscMatrix
+	_         ColNonZeroDoer = cscMatrix
+)
+
+// COO is a sparse matrix in coordinate form, holding the row index, column
+// index and value of each stored element. COO is suited to the incremental
+// construction of a sparse matrix. A COO may hold more than one element
+// for a position, in which case the value at the position is the sum of
+// the stored values. Element access on a COO takes time linear in the
+// number of stored elements, so a COO should be converted to a CSR or CSC
+// with CSRCopyOf or CSCCopyOf before repeated use.
+type COO struct {
+	r, c int
+	rows []int
+	cols []int
+	data []float64
+}
+
+// NewCOO creates a new r×c sparse matrix in coordinate form with the
+// elements data[k] at row rows[k] and column cols[k]. The slices are used
+// as the backing data of the matrix, and changes to the elements of the
+// returned COO will be reflected in them. If the slices are nil, an empty
+// r×c matrix is returned.
+// NewCOO will panic if r or c is not positive, if the slices do not have
+// the same length or if an index is out of range.
+func NewCOO(r, c int, rows, cols []int, data []float64) *COO {
+	checkSparseDims(r, c)
+	if len(rows) != len(data) || len(cols) != len(data) {
+		panic(ErrSliceLengthMismatch)
+	}
+	for k := range data {
+		if uint(rows[k]) >= uint(r) || uint(cols[k]) >= uint(c) {
+			panic(ErrIndexOutOfRange)
+		}
+	}
+	return &COO{r: r, c: c, rows: rows, cols: cols, data: data}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *COO) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element at row i, column j, the sum of the values stored
+// for the position.
+func (m *COO) At(i, j int) float64 {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	var v float64
+	for k, row := range m.rows {
+		if row == i && m.cols[k] == j {
+			v += m.data[k]
+		}
+	}
+	return v
+}
+
+// T returns the transpose of the receiver as a COO sharing the
+// backing data of the receiver.
+func (m *COO) T() Matrix {
+	return &COO{r: m.c, c: m.r, rows: m.cols, cols: m.rows, data: m.data}
+}
+
+// NNZ returns the number of stored elements of the matrix.
+func (m *COO) NNZ() int {
+	return len(m.data)
+}
+
+// Append adds the value v to the element at row i, column j.
+func (m *COO) Append(i, j int, v float64) {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	m.rows = append(m.rows, i)
+	m.cols = append(m.cols, j)
+	m.data = append(m.data, v)
+}
+
+// DoNonZero calls the function fn for each of the non-zero stored elements
+// of m in the order they are stored. The function fn takes a row/column
+// index and the stored value. Positions with more than one stored element
+// may be visited more than once.
+func (m *COO) DoNonZero(fn func(i, j int, v float64)) {
+	for k, v := range m.data {
+		if v != 0 {
+			fn(m.rows[k], m.cols[k], v)
+		}
+	}
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (m *COO) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	r, c := m.r, m.c
+	rows, cols := m.rows, m.cols
+	if trans {
+		r, c = c, r
+		rows, cols = cols, rows
+	}
+	if x.Len() != c {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(r)
+	xs, restore := sparseMulVecOperand(dst, x)
+	defer restore()
+	dst.Zero()
+	for k, v := range m.data {
+		dst.mat.Data[rows[k]*dst.mat.Inc] += v * xs[cols[k]]
+	}
+}
+
+// CSR is a sparse matrix in compressed sparse row form. The stored elements
+// of each row are held in order of increasing column index, so element
+// access takes time logarithmic in the number of stored elements of the
+// row and matrix-vector products take time linear in the number of stored
+// elements.
+type CSR struct {
+	r, c int
+
+	// The stored elements of row i are held in
+	// data[indptr[i]:indptr[i+1]] with the column
+	// indices in ind[indptr[i]:indptr[i+1]] in
+	// strictly ascending order.
+	indptr []int
+	ind    []int
+	data   []float64
+}
+
+// NewCSR creates a new r×c sparse matrix in compressed sparse row form.
+// The stored elements of row i are data[indptr[i]:indptr[i+1]] at the
+// columns ind[indptr[i]:indptr[i+1]], which must be strictly increasing.
+// The slices are used as the backing data of the matrix, and changes to the
+// elements of data will be reflected in the returned CSR. If all of the
+// slices are nil, an r×c matrix with no stored elements is returned.
+// NewCSR will panic if r or c is not positive, if the slices do not have
+// consistent lengths, or if the indices are out of range or out of order.
+func NewCSR(r, c int, indptr, ind []int, data []float64) *CSR {
+	checkSparseDims(r, c)
+	if indptr == nil && ind == nil && data == nil {
+		indptr = make([]int, r+1)
+	}
+	checkCompressed(r, c, indptr, ind, data)
+	return &CSR{r: r, c: c, indptr: indptr, ind: ind, data: data}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *CSR) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element at row i, column j.
+func (m *CSR) At(i, j int) float64 {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	return compressedAt(m.indptr, m.ind, m.data, i, j)
+}
+
+// T returns the transpose of the receiver as a CSC sharing the
+// backing data of the receiver.
+func (m *CSR) T() Matrix {
+	return &CSC{r: m.c, c: m.r, indptr: m.indptr, ind: m.ind, data: m.data}
+}
+
+// NNZ returns the number of stored elements of the matrix.
+func (m *CSR) NNZ() int {
+	return len(m.data)
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of m
+// in row-major order. The function fn takes a row/column index and the
+// element value of m at (i, j).
+func (m *CSR) DoNonZero(fn func(i, j int, v float64)) {
+	for i := 0; i < m.r; i++ {
+		m.doRowNonZero(i, fn)
+	}
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of
+// row i of m in order of increasing column. The function fn takes a
+// row/column index and the element value of m at (i, j).
+func (m *CSR) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	m.doRowNonZero(i, fn)
+}
+
+func (m *CSR) doRowNonZero(i int, fn func(i, j int, v float64)) {
+	for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+		if v := m.data[k]; v != 0 {
+			fn(i, m.ind[k], v)
+		}
+	}
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (m *CSR) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	compressedMulVecTo(dst, trans, m.r, m.c, m.indptr, m.ind, m.data, x)
+}
+
+// CSC is a sparse matrix in compressed sparse column form. The stored
+// elements of each column are held in order of increasing row index, so
+// element access takes time logarithmic in the number of stored elements
+// of the column and matrix-vector products take time linear in the number
+// of stored elements.
+type CSC struct {
+	r, c int
+
+	// The stored elements of column j are held in
+	// data[indptr[j]:indptr[j+1]] with the row
+	// indices in ind[indptr[j]:indptr[j+1]] in
+	// strictly ascending order.
+	indptr []int
+	ind    []int
+	data   []float64
+}
+
+// NewCSC creates a new r×c sparse matrix in compressed sparse column form.
+// The stored elements of column j are data[indptr[j]:indptr[j+1]] at the
+// rows ind[indptr[j]:indptr[j+1]], which must be strictly increasing.
+// The slices are used as the backing data of the matrix, and changes to the
+// elements of data will be reflected in the returned CSC. If all of the
+// slices are nil, an r×c matrix with no stored elements is returned.
+// NewCSC will panic if r or c is not positive, if the slices do not have
+// consistent lengths, or if the indices are out of range or out of order.
+func NewCSC(r, c int, indptr, ind []int, data []float64) *CSC {
+	checkSparseDims(r, c)
+	if indptr == nil && ind == nil && data == nil {
+		indptr = make([]int, c+1)
+	}
+	checkCompressed(c, r, indptr, ind, data)
+	return &CSC{r: r, c: c, indptr: indptr, ind: ind, data: data}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *CSC) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element at row i, column j.
+func (m *CSC) At(i, j int) float64 {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	return compressedAt(m.indptr, m.ind, m.data, j, i)
+}
+
+// T returns the transpose of the receiver as a CSR sharing the
+// backing data of the receiver.
+func (m *CSC) T() Matrix {
+	return &CSR{r: m.c, c: m.r, indptr: m.indptr, ind: m.ind, data: m.data}
+}
+
+// NNZ returns the number of stored elements of the matrix.
+func (m *CSC) NNZ() int {
+	return len(m.data)
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of m
+// in column-major order. The function fn takes a row/column index and the
+// element value of m at (i, j).
+func (m *CSC) DoNonZero(fn func(i, j int, v float64)) {
+	for j := 0; j < m.c; j++ {
+		m.doColNonZero(j, fn)
+	}
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of m in order of increasing row. The function fn takes a
+// row/column index and the element value of m at (i, j).
+func (m *CSC) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	m.doColNonZero(j, fn)
+}
+
+func (m *CSC) doColNonZero(j int, fn func(i, j int, v float64)) {
+	for k := m.indptr[j]; k < m.indptr[j+1]; k++ {
+		if v := m.data[k]; v != 0 {
+			fn(m.ind[k], j, v)
+		}
+	}
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (m *CSC) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	compressedMulVecTo(dst, !trans, m.c, m.r, m.indptr, m.ind, m.data, x)
+}
+
+// CSRCopyOf returns a newly allocated CSR holding the non-zero elements
+// of a. Elements of a that are stored more than once, as is possible in
+// a COO, are summed.
+func CSRCopyOf(a Matrix) *CSR {
+	r, c := a.Dims()
+	indptr, ind, data := compress(r, c, a)
+	return &CSR{r: r, c: c, indptr: indptr, ind: ind, data: data}
+}
+
+// CSCCopyOf returns a newly allocated CSC holding the non-zero elements
+// of a. Elements of a that are stored more than once, as is possible in
+// a COO, are summed.
+func CSCCopyOf(a Matrix) *CSC {
+	r, c := a.Dims()
+	indptr, ind, data := compress(c, r, a.T())
+	return &CSC{r: r, c: c, indptr: indptr, ind: ind, data: data}
+}
+
+// checkSparseDims panics if r or c is not positive.
+func checkSparseDims(r, c int) {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+}
+
+// checkCompressed panics if indptr, ind and data do not describe a
+// compressed matrix with n major and m minor indices.
+func checkCompressed(n, m int, indptr, ind []int, data []float64) {
+	if len(indptr) != n+1 || len(ind) != len(data) {
+		panic(ErrSliceLengthMismatch)
+	}
+	if indptr[0] != 0 || indptr[n] != len(data) {
+		panic(ErrIndexOutOfRange)
+	}
+	for i := 0; i < n; i++ {
+		if indptr[i] > indptr[i+1] {
+			panic(ErrIndexOutOfRange)
+		}
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			if uint(ind[k]) >= uint(m) || (k > indptr[i] && ind[k] <= ind[k-1]) {
+				panic(ErrIndexOutOfRange)
+			}
+		}
+	}
+}
+
+// compressedAt returns the element at major index i and minor index j of
+// a compressed matrix.
+func compressedAt(indptr, ind []int, data []float64, i, j int) float64 {
+	lo, hi := indptr[i], indptr[i+1]
+	k := lo + sort.SearchInts(ind[lo:hi], j)
+	if k < hi && ind[k] == j {
+		return data[k]
+	}
+	return 0
+}
+
+// compressedMulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst,
+// where A is the r×c matrix held in compressed sparse row form.
+func compressedMulVecTo(dst *VecDense, trans bool, r, c int, indptr, ind []int, data []float64, x Vector) {
+	n, m := c, r
+	if trans {
+		n, m = r, c
+	}
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(m)
+	xs, restore := sparseMulVecOperand(dst, x)
+	defer restore()
+	if trans {
+		dst.Zero()
+		for i := 0; i < r; i++ {
+			xi := xs[i]
+			if xi == 0 {
+				continue
+			}
+			for k := indptr[i]; k < indptr[i+1]; k++ {
+				dst.mat.Data[ind[k]*dst.mat.Inc] += data[k] * xi
+			}
+		}
+		return
+	}
+	for i := 0; i < r; i++ {
+		var v float64
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			v += data[k] * xs[ind[k]]
+		}
+		dst.mat.Data[i*dst.mat.Inc] = v
+	}
+}
+
+// sparseMulVecOperand returns the elements of x in a contiguous slice that
+// does not overlap dst, and a function that releases any workspace used.
+func sparseMulVecOperand(dst *VecDense, x Vector) ([]float64, func()) {
+	xU, _ := untransposeExtract(x)
+	if xVec, ok := xU.(*VecDense); ok && xVec.mat.Inc == 1 && dst != xVec {
+		dst.checkOverlap(xVec.mat)
+		return xVec.mat.Data[:xVec.mat.N], func() {}
+	}
+	xCopy := getVecDenseWorkspace(x.Len(), false)
+	xCopy.CloneFromVec(x)
+	return xCopy.mat.Data, func() { putVecDenseWorkspace(xCopy) }
+}
+
+// compress returns the compressed sparse row form of the r×c matrix a.
+func compress(r, c int, a Matrix) (indptr, ind []int, data []float64) {
+	type element struct {
+		j int
+		v float64
+	}
+	rows := make([][]element, r)
+	if nz, ok := asSparse(a); ok {
+		nz.DoNonZero(func(i, j int, v float64) {
+			rows[i] = append(rows[i], element{j: j, v: v})
+		})
+	} else {
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				if v := a.At(i, j); v != 0 {
+					rows[i] = append(rows[i], element{j: j, v: v})
+				}
+			}
+		}
+	}
+
+	indptr = make([]int, r+1)
+	for i, row := range rows {
+		sort.SliceStable(row, func(a, b int) bool { return row[a].j < row[b].j })
+		for k := 0; k < len(row); {
+			e := row[k]
+			for k++; k < len(row) && row[k].j == e.j; k++ {
+				e.v += row[k].v
+			}
+			if e.v != 0 {
+				ind = append(ind, e.j)
+				data = append(data, e.v)
+			}
+		}
+		indptr[i+1] = len(data)
+	}
+	return indptr, ind, data
+}
+
+// asSparse returns a as a NonZeroDoer and true if a or the matrix
+// it transposes is a COO, CSR or CSC.
+func asSparse(a Matrix) (NonZeroDoer, bool) {
+	aU, trans := untransposeExtract(a)
+	switch aU.(type) {
+	case *COO, *CSR, *CSC:
+		if trans {
+			aU = aU.T()
+		}
+		return aU.(NonZeroDoer), true
+	}
+	return nil, false
+}
+
+// copySparse copies the top-left r×c block of the sparse matrix a
+// into the receiver.
+func (m *Dense) copySparse(a NonZeroDoer, r, c int) {
+	for i := 0; i < r; i++ {
+		zero(m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c])
+	}
+	a.DoNonZero(func(i, j int, v float64) {
+		if i < r && j < c {
+			m.mat.Data[i*m.mat.Stride+j] += v
+		}
+	})
+}
+
+// addSparse places a+alpha*b in the receiver if either of a and b is a
+// sparse matrix, and returns whether it did so. The receiver must have
+// the dimensions of a and b and must not be aliased by a transpose of
+// the receiver.
+func (m *Dense) addSparse(a, b Matrix, alpha float64) bool {
+	as, aok := asSparse(a)
+	bs, bok := asSparse(b)
+	if !aok && !bok {
+		return false
+	}
+	r, c := a.Dims()
+	if bok {
+		m.Copy(a)
+		bs.DoNonZero(func(i, j int, v float64) {
+			m.mat.Data[i*m.mat.Stride+j] += alpha * v
+		})
+		return true
+	}
+	m.Copy(b)
+	if alpha != 1 {
+		for i := 0; i < r; i++ {
+			f64.ScalUnitary(alpha, m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+c])
+		}
+	}
+	as.DoNonZero(func(i, j int, v float64) {
+		m.mat.Data[i*m.mat.Stride+j] += v
+	})
+	return true
+}
+
+// mulSparse places a*b in the receiver if either of a and b is a sparse
+// matrix, and returns whether it did so. The receiver must have the
+// dimensions of the product and must not be aliased by a or b.
+func (m *Dense) mulSparse(a, b Matrix) bool {
+	as, aok := asSparse(a)
+	bs, bok := asSparse(b)
+	if !aok && !bok {
+		return false
+	}
+	ar, ac := a.Dims()
+	_, bc := b.Dims()
+	for i := 0; i < ar; i++ {
+		zero(m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+bc])
+	}
+	if aok {
+		// Row i of the product accumulates a_ik times row k of b.
+		var bmat *Dense
+		if bd, ok := b.(*Dense); ok {
+			bmat = bd
+		} else {
+			bmat = getDenseWorkspace(ac, bc, false)
+			defer putDenseWorkspace(bmat)
+			bmat.Copy(b)
+		}
+		as.DoNonZero(func(i, k int, v float64) {
+			f64.AxpyUnitary(v, bmat.mat.Data[k*bmat.mat.Stride:k*bmat.mat.Stride+bc], m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+bc])
+		})
+		return true
+	}
+	// Column j of the product accumulates b_kj times column k of a,
+	// which is held contiguously as row k of aᵀ.
+	at := getDenseWorkspace(ac, ar, false)
+	defer putDenseWorkspace(at)
+	at.Copy(a.T())
+	bs.DoNonZero(func(k, j int, v float64) {
+		f64.AxpyInc(v, at.mat.Data[k*at.mat.Stride:k*at.mat.Stride+ar], m.mat.Data[j:], uintptr(ar), 1, uintptr(m.mat.Stride), 0, 0)
+	})
+	return true
+}
@@ -0,0 +1,59 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func ExampleCSRCopyOf() {
+	// Assemble the stiffness matrix of a one-dimensional finite
+	// element model with four interior nodes. Element e joins
+	// nodes e-1 and e, and nodes -1 and 4 are fixed boundaries.
+	const n = 4
+	coo := mat.NewCOO(n, n, nil, nil, nil)
+	for e := 0; e <= n; e++ {
+		for _, i := range []int{e - 1, e} {
+			for _, j := range []int{e - 1, e} {
+				if i < 0 || i >= n || j < 0 || j >= n {
+					continue
+				}
+				v := -1.0
+				if i == j {
+					v = 1
+				}
+				// Contributions to the same element are summed.
+				coo.Append(i, j, v)
+			}
+		}
+	}
+	a := mat.CSRCopyOf(coo)
+	fmt.Printf("stored elements: %d\n", a.NNZ())
+	fmt.Printf("A = %v\n\n", mat.Formatted(a, mat.Prefix("    ")))
+
+	// Solve for the displacements under a uniform load.
+	b := mat.NewVecDense(n, []float64{1, 1, 1, 1})
+	var x mat.VecDense
+	err := x.SolveVec(a, b)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("x = %.4g\n", mat.Formatted(&x, mat.Prefix("    ")))
+
+	// Output:
+	// stored elements: 10
+	// A = ⎡ 2  -1   0   0⎤
+	//     ⎢-1   2  -1   0⎥
+	//     ⎢ 0  -1   2  -1⎥
+	//     ⎣ 0   0  -1   2⎦
+	//
+	// x = ⎡2⎤
+	//     ⎢3⎥
+	//     ⎢3⎥
+	//     ⎣2⎦
+}
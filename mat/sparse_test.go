@@ -0,0 +1,459 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// randSparse returns an r×c dense matrix with approximately the given
+// density of non-zero elements.
+func randSparse(rnd *rand.Rand, r, c int, density float64) *Dense {
+	d := NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			if rnd.Float64() < density {
+				d.Set(i, j, rnd.NormFloat64())
+			}
+		}
+	}
+	return d
+}
+
+// randCOO returns a COO holding the elements of d with each non-zero element
+// split into two stored elements and the elements stored in random order.
+func randCOO(rnd *rand.Rand, d *Dense) *COO {
+	r, c := d.Dims()
+	m := NewCOO(r, c, nil, nil, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			if v := d.At(i, j); v != 0 {
+				w := rnd.NormFloat64()
+				m.Append(i, j, w)
+				m.Append(i, j, v-w)
+			}
+		}
+	}
+	rnd.Shuffle(len(m.data), func(a, b int) {
+		m.rows[a], m.rows[b] = m.rows[b], m.rows[a]
+		m.cols[a], m.cols[b] = m.cols[b], m.cols[a]
+		m.data[a], m.data[b] = m.data[b], m.data[a]
+	})
+	return m
+}
+
+// sparseForms returns d held in each of the sparse matrix types.
+func sparseForms(rnd *rand.Rand, d *Dense) []Matrix {
+	return []Matrix{randCOO(rnd, d), CSRCopyOf(d), CSCCopyOf(d)}
+}
+
+func TestNewCSR(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		r, c   int
+		indptr []int
+		ind    []int
+		data   []float64
+		panics bool
+		want   *Dense
+	}{
+		{
+			r: 2, c: 3,
+			want: NewDense(2, 3, nil),
+		},
+		{
+			r: 3, c: 3,
+			indptr: []int{0, 2, 2, 3},
+			ind:    []int{0, 2, 1},
+			data:   []float64{1, 2, 3},
+			want:   NewDense(3, 3, []float64{1, 0, 2, 0, 0, 0, 0, 3, 0}),
+		},
+		{
+			r: 0, c: 3,
+			panics: true,
+		},
+		{
+			r: 2, c: 2,
+			indptr: []int{0, 1},
+			ind:    []int{0},
+			data:   []float64{1},
+			panics: true,
+		},
+		{
+			r: 2, c: 2,
+			indptr: []int{0, 1, 2},
+			ind:    []int{0, 2},
+			data:   []float64{1, 2},
+			panics: true,
+		},
+		{
+			r: 1, c: 3,
+			indptr: []int{0, 2},
+			ind:    []int{2, 1},
+			data:   []float64{1, 2},
+			panics: true,
+		},
+		{
+			r: 1, c: 3,
+			indptr: []int{0, 2},
+			ind:    []int{1, 1},
+			data:   []float64{1, 2},
+			panics: true,
+		},
+		{
+			r: 2, c: 2,
+			indptr: []int{0, 2, 1},
+			ind:    []int{0, 1},
+			data:   []float64{1, 2},
+			panics: true,
+		},
+	} {
+		csr, csc := func() (csr *CSR, csc *CSC) {
+			panicked, _ := panics(func() { csr = NewCSR(test.r, test.c, test.indptr, test.ind, test.data) })
+			if panicked != test.panics {
+				t.Errorf("unexpected CSR panic status for test %d: got:%t want:%t", i, panicked, test.panics)
+			}
+			panicked, _ = panics(func() { csc = NewCSC(test.c, test.r, test.indptr, test.ind, test.data) })
+			if panicked != test.panics {
+				t.Errorf("unexpected CSC panic status for test %d: got:%t want:%t", i, panicked, test.panics)
+			}
+			return csr, csc
+		}()
+		if test.panics {
+			continue
+		}
+		if !Equal(csr, test.want) {
+			t.Errorf("unexpected CSR for test %d:\ngot:\n%v\nwant:\n%v", i, Formatted(csr), Formatted(test.want))
+		}
+		if !Equal(csc, test.want.T()) {
+			t.Errorf("unexpected CSC for test %d:\ngot:\n%v\nwant:\n%v", i, Formatted(csc), Formatted(test.want.T()))
+		}
+	}
+}
+
+func TestNewCOO(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		r, c   int
+		rows   []int
+		cols   []int
+		data   []float64
+		panics bool
+		want   *Dense
+	}{
+		{
+			r: 2, c: 2,
+			rows: []int{1, 0, 1},
+			cols: []int{0, 1, 0},
+			data: []float64{1, 2, 3},
+			want: NewDense(2, 2, []float64{0, 2, 4, 0}),
+		},
+		{
+			r: 2, c: 2,
+			rows:   []int{1, 0},
+			cols:   []int{0},
+			data:   []float64{1, 2},
+			panics: true,
+		},
+		{
+			r: 2, c: 2,
+			rows:   []int{2},
+			cols:   []int{0},
+			data:   []float64{1},
+			panics: true,
+		},
+		{
+			r: -1, c: 2,
+			panics: true,
+		},
+	} {
+		var m *COO
+		panicked, _ := panics(func() { m = NewCOO(test.r, test.c, test.rows, test.cols, test.data) })
+		if panicked != test.panics {
+			t.Errorf("unexpected panic status for test %d: got:%t want:%t", i, panicked, test.panics)
+		}
+		if test.panics {
+			continue
+		}
+		if !Equal(m, test.want) {
+			t.Errorf("unexpected COO for test %d:\ngot:\n%v\nwant:\n%v", i, Formatted(m), Formatted(test.want))
+		}
+		if !Equal(m.T(), test.want.T()) {
+			t.Errorf("unexpected COO transpose for test %d", i)
+		}
+	}
+}
+
+func TestSparseCopy(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{1, 1}, {1, 7}, {7, 1}, {5, 8}, {20, 13}} {
+		r, c := dims[0], dims[1]
+		want := randSparse(rnd, r, c, 0.3)
+		for _, a := range sparseForms(rnd, want) {
+			name := fmt.Sprintf("%T %d×%d", a, r, c)
+			if !EqualApprox(a, want, 1e-14) {
+				t.Errorf("unexpected elements for %s", name)
+			}
+			if !EqualApprox(a.T(), want.T(), 1e-14) {
+				t.Errorf("unexpected transpose elements for %s", name)
+			}
+			if !EqualApprox(DenseCopyOf(a), want, 1e-14) {
+				t.Errorf("unexpected dense copy for %s", name)
+			}
+			if !EqualApprox(DenseCopyOf(Transpose{a}), want.T(), 1e-14) {
+				t.Errorf("unexpected dense copy of transpose for %s", name)
+			}
+			csr := CSRCopyOf(a)
+			if !EqualApprox(csr, want, 1e-14) {
+				t.Errorf("unexpected CSR copy for %s", name)
+			}
+			for k := range csr.data {
+				if csr.data[k] == 0 {
+					t.Errorf("stored zero in CSR copy of %s", name)
+				}
+			}
+			if !EqualApprox(CSCCopyOf(a), want, 1e-14) {
+				t.Errorf("unexpected CSC copy for %s", name)
+			}
+
+			// Copying into a smaller matrix copies the top-left block.
+			if r > 1 && c > 1 {
+				small := NewDense(r-1, c-1, nil)
+				for i := range small.mat.Data {
+					small.mat.Data[i] = 1
+				}
+				small.Copy(a)
+				if !EqualApprox(small, want.Slice(0, r-1, 0, c-1), 1e-14) {
+					t.Errorf("unexpected block copy for %s", name)
+				}
+			}
+
+			var sum float64
+			a.(NonZeroDoer).DoNonZero(func(_, _ int, v float64) {
+				sum += v
+			})
+			if math.Abs(sum-Sum(want)) > 1e-12 {
+				t.Errorf("unexpected DoNonZero sum for %s: got:%v want:%v", name, sum, Sum(want))
+			}
+		}
+	}
+}
+
+func TestSparseDoRowColNonZero(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	d := randSparse(rnd, 9, 6, 0.4)
+	csr := CSRCopyOf(d)
+	for i := 0; i < 9; i++ {
+		got := NewDense(9, 6, nil)
+		last := -1
+		csr.DoRowNonZero(i, func(i, j int, v float64) {
+			if j <= last {
+				t.Errorf("columns out of order in row %d", i)
+			}
+			last = j
+			got.Set(i, j, v)
+		})
+		want := NewDense(9, 6, nil)
+		want.Slice(i, i+1, 0, 6).(*Dense).Copy(d.Slice(i, i+1, 0, 6))
+		if !Equal(got, want) {
+			t.Errorf("unexpected row %d", i)
+		}
+	}
+	csc := CSCCopyOf(d)
+	for j := 0; j < 6; j++ {
+		got := NewDense(9, 6, nil)
+		last := -1
+		csc.DoColNonZero(j, func(i, j int, v float64) {
+			if i <= last {
+				t.Errorf("rows out of order in column %d", j)
+			}
+			last = i
+			got.Set(i, j, v)
+		})
+		want := NewDense(9, 6, nil)
+		want.Slice(0, 9, j, j+1).(*Dense).Copy(d.Slice(0, 9, j, j+1))
+		if !Equal(got, want) {
+			t.Errorf("unexpected column %d", j)
+		}
+	}
+}
+
+func TestSparseMul(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][3]int{{1, 1, 1}, {3, 4, 5}, {10, 7, 2}, {6, 12, 9}} {
+		r, k, c := dims[0], dims[1], dims[2]
+		ad := randSparse(rnd, r, k, 0.3)
+		bd := randSparse(rnd, k, c, 0.3)
+		var want Dense
+		want.Mul(asBasicMatrix(ad), asBasicMatrix(bd))
+
+		as := append(sparseForms(rnd, ad), ad)
+		bs := append(sparseForms(rnd, bd), bd)
+		for _, a := range as {
+			for _, b := range bs {
+				name := fmt.Sprintf("%T×%T with dims %v", a, b, dims)
+				var got Dense
+				got.Mul(a, b)
+				if !EqualApprox(&got, &want, tol) {
+					t.Errorf("unexpected product for %s", name)
+				}
+
+				// Transposed operands.
+				got.Reset()
+				got.Mul(b.T(), a.T())
+				if !EqualApprox(&got, want.T(), tol) {
+					t.Errorf("unexpected transposed product for %s", name)
+				}
+				got.Reset()
+				got.Mul(Transpose{b}, Transpose{a})
+				if !EqualApprox(&got, want.T(), tol) {
+					t.Errorf("unexpected Transpose product for %s", name)
+				}
+			}
+		}
+
+		// The receiver may alias a dense operand.
+		if r == k && k == c {
+			for _, a := range sparseForms(rnd, ad) {
+				got := DenseCopyOf(bd)
+				got.Mul(a, got)
+				if !EqualApprox(got, &want, tol) {
+					t.Errorf("unexpected aliased product for %T", a)
+				}
+			}
+		}
+	}
+}
+
+func TestSparseAddSub(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{1, 1}, {4, 4}, {7, 3}} {
+		r, c := dims[0], dims[1]
+		ad := randSparse(rnd, r, c, 0.4)
+		bd := randSparse(rnd, r, c, 0.4)
+		var wantAdd, wantSub Dense
+		wantAdd.Add(ad, bd)
+		wantSub.Sub(ad, bd)
+
+		as := append(sparseForms(rnd, ad), ad)
+		bs := append(sparseForms(rnd, bd), bd)
+		for _, a := range as {
+			for _, b := range bs {
+				name := fmt.Sprintf("%T and %T with dims %v", a, b, dims)
+				var got Dense
+				got.Add(a, b)
+				if !EqualApprox(&got, &wantAdd, tol) {
+					t.Errorf("unexpected sum for %s", name)
+				}
+				got.Sub(a, b)
+				if !EqualApprox(&got, &wantSub, tol) {
+					t.Errorf("unexpected difference for %s", name)
+				}
+				got.Reset()
+				got.Sub(a.T(), b.T())
+				if !EqualApprox(&got, wantSub.T(), tol) {
+					t.Errorf("unexpected transposed difference for %s", name)
+				}
+			}
+		}
+
+		// The receiver may alias the dense operand.
+		if r == c {
+			for _, a := range sparseForms(rnd, ad) {
+				got := DenseCopyOf(bd)
+				got.Sub(a, got)
+				if !EqualApprox(got, &wantSub, tol) {
+					t.Errorf("unexpected aliased difference for %T", a)
+				}
+				got = DenseCopyOf(bd.T())
+				got.Sub(a, got.T())
+				if !EqualApprox(got, &wantSub, tol) {
+					t.Errorf("unexpected aliased transposed difference for %T", a)
+				}
+			}
+		}
+	}
+}
+
+func TestSparseMulVec(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{1, 1}, {5, 5}, {8, 3}, {3, 8}} {
+		r, c := dims[0], dims[1]
+		ad := randSparse(rnd, r, c, 0.4)
+		x := NewVecDense(c, nil)
+		for i := 0; i < c; i++ {
+			x.SetVec(i, rnd.NormFloat64())
+		}
+		var want VecDense
+		want.MulVec(ad, x)
+		for _, a := range sparseForms(rnd, ad) {
+			var got VecDense
+			got.MulVec(a, x)
+			if !EqualApprox(&got, &want, tol) {
+				t.Errorf("unexpected product for %T with dims %v", a, dims)
+			}
+			got.Reset()
+			got.MulVec(a.T().T(), asBasicVector(x))
+			if !EqualApprox(&got, &want, tol) {
+				t.Errorf("unexpected product with basic vector for %T with dims %v", a, dims)
+			}
+		}
+	}
+}
+
+func TestSparseSolve(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	// A diagonally dominant matrix is non-singular.
+	const n = 20
+	ad := randSparse(rnd, n, n, 0.2)
+	for i := 0; i < n; i++ {
+		ad.Set(i, i, float64(n))
+	}
+	bd := NewDense(n, 3, nil)
+	for i := range bd.mat.Data {
+		bd.mat.Data[i] = rnd.NormFloat64()
+	}
+	for _, a := range sparseForms(rnd, ad) {
+		var x Dense
+		err := x.Solve(a, bd)
+		if err != nil {
+			t.Errorf("unexpected error solving with %T: %v", a, err)
+			continue
+		}
+		var got Dense
+		got.Mul(a, &x)
+		if !EqualApprox(&got, bd, tol) {
+			t.Errorf("unexpected solution with %T", a)
+		}
+
+		var lu LU
+		lu.Factorize(a)
+		var xv VecDense
+		err = lu.SolveVecTo(&xv, false, bd.ColView(0))
+		if err != nil {
+			t.Errorf("unexpected error solving with LU of %T: %v", a, err)
+			continue
+		}
+		var gotv VecDense
+		gotv.MulVec(a, &xv)
+		if !EqualApprox(&gotv, bd.ColView(0), tol) {
+			t.Errorf("unexpected LU solution with %T", a)
+		}
+	}
+}
@@ -20,6 +20,11 @@ type SVD struct {
 	s  []float64
 	u  blas64.General
 	vt blas64.General
+
+	// a and work are storage reused by
+	// subsequent factorizations.
+	a    Dense
+	work []float64
 }
 
 // SVDKind specifies the treatment of singular vectors during an SVD
@@ -76,6 +81,9 @@ func (svd *SVD) succFact() bool {
 //
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, routines that require a successful factorization will panic.
+//
+// Factorize reuses the storage of the receiver where possible, so repeated
+// factorizations of matrices of the same size do not allocate.
 func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
 	// kill previous factorization
 	svd.s = svd.s[:0]
@@ -129,21 +137,30 @@ func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
 	}
 
 	// A is destroyed on call, so copy the matrix.
-	aCopy := DenseCopyOf(a)
+	svd.a.Reset()
+	svd.a.reuseAsNonZeroed(m, n)
+	svd.a.Copy(a)
 	svd.kind = kind
 	svd.s = use(svd.s, min(m, n))
 
-	work := []float64{0}
-	lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, -1)
-	work = getFloat64s(int(work[0]), false)
-	ok = lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, len(work))
-	putFloat64s(work)
+	svd.work = use(svd.work, 1)
+	lapack64.Gesvd(jobU, jobVT, svd.a.mat, svd.u, svd.vt, svd.s, svd.work, -1)
+	svd.work = use(svd.work, int(svd.work[0]))
+	ok = lapack64.Gesvd(jobU, jobVT, svd.a.mat, svd.u, svd.vt, svd.s, svd.work, len(svd.work))
 	if !ok {
+		svd.s = svd.s[:0]
 		svd.kind = 0
 	}
 	return ok
 }
 
+// Reset discards the factorization, retaining the storage of the receiver
+// for reuse by a later call to Factorize.
+func (svd *SVD) Reset() {
+	svd.s = svd.s[:0]
+	svd.kind = 0
+}
+
 // Kind returns the SVDKind of the decomposition. If no decomposition has been
 // computed, Kind returns -1.
 func (svd *SVD) Kind() SVDKind {
@@ -660,3 +660,65 @@ func TestSVDSolveVecTo(t *testing.T) {
 		}
 	}
 }
+
+func TestSVDReuse(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	var svd SVD
+	for _, dims := range [][2]int{{5, 3}, {2, 2}, {3, 8}, {10, 10}, {1, 4}} {
+		m, n := dims[0], dims[1]
+		a := NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		for _, kind := range []SVDKind{SVDFull, SVDNone, SVDThin, SVDThinU, SVDFullV} {
+			var want SVD
+			if !want.Factorize(a, kind) || !svd.Factorize(a, kind) {
+				t.Fatalf("m=%d n=%d: unexpected factorization failure", m, n)
+			}
+			if !floats.Equal(svd.Values(nil), want.Values(nil)) {
+				t.Errorf("m=%d n=%d kind=%d: singular value mismatch with reused SVD", m, n, kind)
+			}
+			if kind&(SVDThinU|SVDFullU) != 0 {
+				var ug, uw Dense
+				svd.UTo(&ug)
+				want.UTo(&uw)
+				if !Equal(&ug, &uw) {
+					t.Errorf("m=%d n=%d kind=%d: U mismatch with reused SVD", m, n, kind)
+				}
+			}
+			if kind&(SVDThinV|SVDFullV) != 0 {
+				var vg, vw Dense
+				svd.VTo(&vg)
+				want.VTo(&vw)
+				if !Equal(&vg, &vw) {
+					t.Errorf("m=%d n=%d kind=%d: V mismatch with reused SVD", m, n, kind)
+				}
+			}
+		}
+	}
+	svd.Reset()
+	if svd.Kind() != -1 {
+		t.Error("unexpected kind after Reset")
+	}
+}
+
+func TestSVDReuseAllocs(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := NewDense(20, 10, nil)
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 10; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var svd SVD
+	svd.Factorize(a, SVDThin)
+	allocs := testing.AllocsPerRun(10, func() {
+		svd.Factorize(a, SVDThin)
+	})
+	if allocs != 0 {
+		t.Errorf("unexpected allocations reusing SVD: got:%v want:0", allocs)
+	}
+}
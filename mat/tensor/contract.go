@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Unfold returns the mode-k unfolding of the tensor, the matrix with
+// t.Dim(k) rows whose row i holds the elements of t.Index(k, i) in row-major
+// order. The returned matrix does not share the data of the tensor. Unfold
+// panics if k is out of range.
+func (t *Dense) Unfold(k int) *mat.Dense {
+	if uint(k) >= uint(len(t.shape)) {
+		panic(badAxis)
+	}
+	v := t.Transpose(modeFirst(len(t.shape), k)...)
+	r := t.shape[k]
+	m := mat.NewDense(r, t.Len()/r, nil)
+	data := m.RawMatrix().Data
+	var i int
+	v.each(func(off int) {
+		data[i] = v.data[off]
+		i++
+	})
+	return m
+}
+
+// Fold returns the tensor with the given shape whose mode-k unfolding is m.
+// Fold is the inverse of Unfold. Fold panics if any dimension is not
+// positive, if k is out of range or if the dimensions of m do not match the
+// mode-k unfolding of a tensor with the given shape.
+func Fold(m mat.Matrix, k int, shape []int) *Dense {
+	if uint(k) >= uint(len(shape)) {
+		panic(badAxis)
+	}
+	perm := modeFirst(len(shape), k)
+	permuted := make([]int, len(shape))
+	for i, p := range perm {
+		permuted[i] = shape[p]
+	}
+	t := New(permuted, nil)
+	r, c := m.Dims()
+	if r != shape[k] || r*c != len(t.data) {
+		panic(badShape)
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			t.data[i*c+j] = m.At(i, j)
+		}
+	}
+
+	// Move axis k back from the front.
+	inv := make([]int, len(perm))
+	for i, p := range perm {
+		inv[p] = i
+	}
+	return t.Transpose(inv...).Clone()
+}
+
+// modeFirst returns the permutation of r axes that moves axis k to the front
+// and keeps the order of the other axes.
+func modeFirst(r, k int) []int {
+	perm := make([]int, 0, r)
+	perm = append(perm, k)
+	for i := 0; i < r; i++ {
+		if i != k {
+			perm = append(perm, i)
+		}
+	}
+	return perm
+}
+
+// Contract returns the contraction of the tensors a and b over the pairs of
+// axes aAxes[i] of a and bAxes[i] of b, the tensor
+//
+//	c[I, J] = Σ_K a[I, K] * b[K, J]
+//
+// where K is the multi-index of the contracted axes, and I and J are the
+// multi-indices of the remaining axes of a and b in their original order.
+// The axes of the returned tensor are the remaining axes of a followed by
+// the remaining axes of b. If no axes are given, Contract returns the outer
+// product of a and b, and if all the axes of a and b are contracted, the
+// returned tensor is a scalar.
+//
+// The contraction is computed with a single matrix multiplication after
+// arranging the contracted axes to be contiguous, which copies the elements
+// of a or b if they are not already in that order.
+//
+// Contract panics if aAxes and bAxes differ in length, if they contain an
+// out of range or repeated axis, or if the dimensions of a pair of axes
+// differ.
+func Contract(a *Dense, aAxes []int, b *Dense, bAxes []int) *Dense {
+	if len(aAxes) != len(bAxes) {
+		panic(badContract)
+	}
+	aFree := freeAxes(len(a.shape), aAxes)
+	bFree := freeAxes(len(b.shape), bAxes)
+	k := 1
+	for i, ax := range aAxes {
+		if a.shape[ax] != b.shape[bAxes[i]] {
+			panic(badShape)
+		}
+		k *= a.shape[ax]
+	}
+
+	at := a.Transpose(append(aFree, aAxes...)...)
+	bt := b.Transpose(append(bAxes, bFree...)...)
+	m := at.Len() / k
+	n := bt.Len() / k
+
+	var shape []int
+	for _, ax := range aFree {
+		shape = append(shape, a.shape[ax])
+	}
+	for _, ax := range bFree {
+		shape = append(shape, b.shape[ax])
+	}
+	c := New(shape, nil)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans,
+		1, general(at, m, k), general(bt, k, n),
+		0, blas64.General{Rows: m, Cols: n, Stride: n, Data: c.data},
+	)
+	return c
+}
+
+// freeAxes returns the axes of a rank r tensor that are not in axes in
+// increasing order. freeAxes panics if axes contains an out of range or
+// repeated axis.
+func freeAxes(r int, axes []int) []int {
+	used := make([]bool, r)
+	for _, ax := range axes {
+		if uint(ax) >= uint(r) || used[ax] {
+			panic(badContract)
+		}
+		used[ax] = true
+	}
+	free := make([]int, 0, r-len(axes))
+	for i, u := range used {
+		if !u {
+			free = append(free, i)
+		}
+	}
+	return free
+}
+
+// general returns the elements of t as an r×c row-major matrix, copying
+// them if t is not contiguous.
+func general(t *Dense, r, c int) blas64.General {
+	if !t.IsContiguous() {
+		t = t.Clone()
+	}
+	return blas64.General{Rows: r, Cols: c, Stride: c, Data: t.data[:r*c]}
+}
@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tensor provides a dense n-dimensional array of float64 values
+// and the basic operations on such arrays used by multilinear algebra.
+//
+// A Dense tensor has an arbitrary number of axes, its rank, and stores its
+// elements with a stride for each axis. Slicing, indexing and transposing a
+// tensor return views that share the elements of the original tensor, so
+// that rearranging the axes of a tensor does not copy its data. Reshaping
+// returns a view when the elements of the tensor are contiguous in row-major
+// order and a copy otherwise.
+//
+// The mode-k unfolding of a tensor is obtained as a mat.Dense by Unfold, and
+// the inverse operation is performed by Fold. Contract computes the
+// contraction of two tensors over pairs of axes by reducing it to a single
+// matrix multiplication, so contractions are performed with the speed of the
+// BLAS implementation used by the blas64 package.
+package tensor // import "gonum.org/v1/gonum/mat/tensor"
@@ -0,0 +1,332 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+const (
+	badDim      = "tensor: non-positive dimension"
+	badLength   = "tensor: data length does not match shape"
+	badIndex    = "tensor: index out of range"
+	badRank     = "tensor: number of indices does not match rank"
+	badAxis     = "tensor: axis out of range"
+	badPerm     = "tensor: invalid axis permutation"
+	badSlice    = "tensor: slice bounds out of range"
+	badReshape  = "tensor: reshape changes number of elements"
+	badShape    = "tensor: shape mismatch"
+	badContract = "tensor: invalid contraction axes"
+)
+
+// Dense is a dense tensor of float64 values.
+//
+// The element of a Dense tensor with index (i_0, i_1, ..., i_{r-1}) is
+// stored at position
+//
+//	i_0*stride_0 + i_1*stride_1 + ... + i_{r-1}*stride_{r-1}
+//
+// of its data, where r is the rank of the tensor. Tensors created by New and
+// the operations that allocate a new tensor store their elements contiguously
+// in row-major order, with the last index varying fastest. Views of a tensor
+// share its data and may have arbitrary strides.
+type Dense struct {
+	shape  []int
+	stride []int
+	data   []float64
+}
+
+// New returns a new tensor with the given shape holding the elements of data
+// in row-major order. If data is nil, a zero tensor is allocated. The shape
+// slice is copied and data is used as the backing slice of the tensor, so
+// changes to the elements of the tensor are reflected in data and vice versa.
+// A tensor with an empty shape is a scalar with a single element.
+//
+// New panics if any dimension is not positive or if data is not nil and its
+// length is not the product of the dimensions.
+func New(shape []int, data []float64) *Dense {
+	n := 1
+	for _, d := range shape {
+		if d <= 0 {
+			panic(badDim)
+		}
+		n *= d
+	}
+	if data == nil {
+		data = make([]float64, n)
+	}
+	if len(data) != n {
+		panic(badLength)
+	}
+	shape = append([]int(nil), shape...)
+	return &Dense{shape: shape, stride: rowMajor(shape), data: data}
+}
+
+// rowMajor returns the strides of a contiguous row-major tensor with the
+// given shape.
+func rowMajor(shape []int) []int {
+	stride := make([]int, len(shape))
+	s := 1
+	for i := len(shape) - 1; i >= 0; i-- {
+		stride[i] = s
+		s *= shape[i]
+	}
+	return stride
+}
+
+// Rank returns the number of axes of the tensor.
+func (t *Dense) Rank() int {
+	return len(t.shape)
+}
+
+// Shape returns a copy of the dimensions of the tensor.
+func (t *Dense) Shape() []int {
+	return append([]int(nil), t.shape...)
+}
+
+// Dim returns the dimension of the tensor along the given axis.
+func (t *Dense) Dim(axis int) int {
+	if uint(axis) >= uint(len(t.shape)) {
+		panic(badAxis)
+	}
+	return t.shape[axis]
+}
+
+// Len returns the number of elements of the tensor.
+func (t *Dense) Len() int {
+	n := 1
+	for _, d := range t.shape {
+		n *= d
+	}
+	return n
+}
+
+// At returns the element with the given index. At panics if the number of
+// indices is not the rank of the tensor or if an index is out of range.
+func (t *Dense) At(idx ...int) float64 {
+	return t.data[t.offset(idx)]
+}
+
+// Set sets the element with the given index to v. Set panics if the number
+// of indices is not the rank of the tensor or if an index is out of range.
+func (t *Dense) Set(v float64, idx ...int) {
+	t.data[t.offset(idx)] = v
+}
+
+// offset returns the position in the data of the element with the given
+// index.
+func (t *Dense) offset(idx []int) int {
+	if len(idx) != len(t.shape) {
+		panic(badRank)
+	}
+	var off int
+	for i, v := range idx {
+		if uint(v) >= uint(t.shape[i]) {
+			panic(badIndex)
+		}
+		off += v * t.stride[i]
+	}
+	return off
+}
+
+// Slice returns a view of the elements of the tensor with index i ≤ k < j
+// along the given axis. The returned tensor has the same rank as the
+// receiver and shares its data. Slice panics if the axis is out of range or
+// if the bounds do not satisfy 0 ≤ i < j ≤ t.Dim(axis).
+func (t *Dense) Slice(axis, i, j int) *Dense {
+	if uint(axis) >= uint(len(t.shape)) {
+		panic(badAxis)
+	}
+	if i < 0 || j <= i || t.shape[axis] < j {
+		panic(badSlice)
+	}
+	v := t.view()
+	v.shape[axis] = j - i
+	v.data = t.data[i*t.stride[axis]:]
+	return v
+}
+
+// Index returns a view of the elements of the tensor with index i along the
+// given axis. The returned tensor has the axis removed, so its rank is one
+// less than the rank of the receiver, and shares the data of the receiver.
+// Index panics if the axis or i is out of range.
+func (t *Dense) Index(axis, i int) *Dense {
+	if uint(axis) >= uint(len(t.shape)) {
+		panic(badAxis)
+	}
+	if uint(i) >= uint(t.shape[axis]) {
+		panic(badIndex)
+	}
+	return &Dense{
+		shape:  append(t.Shape()[:axis], t.shape[axis+1:]...),
+		stride: append(append([]int(nil), t.stride[:axis]...), t.stride[axis+1:]...),
+		data:   t.data[i*t.stride[axis]:],
+	}
+}
+
+// Transpose returns a view of the tensor with its axes permuted. Axis k of
+// the returned tensor is axis perm[k] of the receiver. If perm is empty, the
+// order of the axes is reversed. The returned tensor shares the data of the
+// receiver. Transpose panics if perm is not empty and is not a permutation
+// of the axes of the tensor.
+func (t *Dense) Transpose(perm ...int) *Dense {
+	r := len(t.shape)
+	if len(perm) == 0 {
+		perm = make([]int, r)
+		for i := range perm {
+			perm[i] = r - 1 - i
+		}
+	}
+	if len(perm) != r {
+		panic(badPerm)
+	}
+	seen := make([]bool, r)
+	v := &Dense{shape: make([]int, r), stride: make([]int, r), data: t.data}
+	for k, p := range perm {
+		if uint(p) >= uint(r) || seen[p] {
+			panic(badPerm)
+		}
+		seen[p] = true
+		v.shape[k] = t.shape[p]
+		v.stride[k] = t.stride[p]
+	}
+	return v
+}
+
+// IsContiguous returns whether the elements of the tensor are stored
+// contiguously in row-major order.
+func (t *Dense) IsContiguous() bool {
+	s := 1
+	for i := len(t.shape) - 1; i >= 0; i-- {
+		if t.shape[i] != 1 && t.stride[i] != s {
+			return false
+		}
+		s *= t.shape[i]
+	}
+	return true
+}
+
+// Reshape returns a tensor with the given shape holding the elements of the
+// receiver in row-major order. If the receiver is contiguous, the returned
+// tensor is a view sharing the data of the receiver, otherwise the elements
+// are copied. Reshape panics if any dimension is not positive or if the
+// number of elements of the shape is not the number of elements of the
+// tensor.
+func (t *Dense) Reshape(shape ...int) *Dense {
+	n := 1
+	for _, d := range shape {
+		if d <= 0 {
+			panic(badDim)
+		}
+		n *= d
+	}
+	if n != t.Len() {
+		panic(badReshape)
+	}
+	c := t
+	if !t.IsContiguous() {
+		c = t.Clone()
+	}
+	return New(shape, c.data[:n])
+}
+
+// Clone returns a copy of the tensor with its elements stored contiguously
+// in row-major order.
+func (t *Dense) Clone() *Dense {
+	c := New(t.shape, nil)
+	var i int
+	t.each(func(off int) {
+		c.data[i] = t.data[off]
+		i++
+	})
+	return c
+}
+
+// Copy copies the elements of src into the receiver, which may be a view of
+// another tensor. Copy panics if the shapes of src and the receiver differ.
+func (t *Dense) Copy(src *Dense) {
+	if !sameShape(t.shape, src.shape) {
+		panic(badShape)
+	}
+	if overlaps(t.data, src.data) {
+		src = src.Clone()
+	}
+	walk(t.shape, t.stride, src.stride, func(dst, off int) {
+		t.data[dst] = src.data[off]
+	})
+}
+
+// view returns a tensor sharing the data, shape and strides of the receiver
+// with copies of its shape and stride slices.
+func (t *Dense) view() *Dense {
+	return &Dense{
+		shape:  t.Shape(),
+		stride: append([]int(nil), t.stride...),
+		data:   t.data,
+	}
+}
+
+// each calls fn with the position in the data of each element of the tensor
+// in row-major order of the element indices.
+func (t *Dense) each(fn func(off int)) {
+	walk(t.shape, t.stride, t.stride, func(off, _ int) { fn(off) })
+}
+
+// walk calls fn with the positions of each element of two tensors with the
+// given shape and strides a and b in row-major order of the element indices.
+func walk(shape, a, b []int, fn func(offA, offB int)) {
+	idx := make([]int, len(shape))
+	var offA, offB int
+	for {
+		fn(offA, offB)
+		k := len(idx) - 1
+		for ; k >= 0; k-- {
+			idx[k]++
+			offA += a[k]
+			offB += b[k]
+			if idx[k] < shape[k] {
+				break
+			}
+			offA -= idx[k] * a[k]
+			offB -= idx[k] * b[k]
+			idx[k] = 0
+		}
+		if k < 0 {
+			return
+		}
+	}
+}
+
+// overlaps returns whether a and b share a backing array.
+func overlaps(a, b []float64) bool {
+	return cap(a) != 0 && cap(b) != 0 && &a[:cap(a)][cap(a)-1] == &b[:cap(b)][cap(b)-1]
+}
+
+func sameShape(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, d := range a {
+		if d != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualApprox returns whether the tensors a and b have the same shape and
+// their elements are equal within tol, either absolutely or relatively.
+func EqualApprox(a, b *Dense, tol float64) bool {
+	if !sameShape(a.shape, b.shape) {
+		return false
+	}
+	equal := true
+	walk(a.shape, a.stride, b.stride, func(offA, offB int) {
+		if !scalar.EqualWithinAbsOrRel(a.data[offA], b.data[offB], tol, tol) {
+			equal = false
+		}
+	})
+	return equal
+}
@@ -0,0 +1,38 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mat/tensor"
+)
+
+func ExampleContract() {
+	// Compute the mode-1 product of a 2×3×2 tensor with a
+	// 2×3 matrix, contracting the matrix columns with the
+	// second axis of the tensor.
+	x := tensor.New([]int{2, 3, 2}, []float64{
+		1, 2, 3, 4, 5, 6,
+		7, 8, 9, 10, 11, 12,
+	})
+	u := tensor.New([]int{2, 3}, []float64{
+		1, 0, -1,
+		1, 1, 1,
+	})
+	y := tensor.Contract(x, []int{1}, u, []int{1})
+	fmt.Println("shape:", y.Shape())
+
+	// Move the new axis back into the position of the
+	// contracted axis and show the mode-1 unfolding.
+	y = y.Transpose(0, 2, 1)
+	fmt.Printf("Y_(1) = %v\n", mat.Formatted(y.Unfold(1), mat.Prefix("        ")))
+
+	// Output:
+	// shape: [2 2 2]
+	// Y_(1) = ⎡-4  -4  -4  -4⎤
+	//         ⎣ 9  12  27  30⎦
+}
@@ -0,0 +1,383 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// sequence returns a contiguous tensor with the given shape holding the
+// values 0, 1, 2, ... in row-major order.
+func sequence(shape ...int) *Dense {
+	t := New(shape, nil)
+	for i := range t.data {
+		t.data[i] = float64(i)
+	}
+	return t
+}
+
+func randTensor(rnd *rand.Rand, shape ...int) *Dense {
+	t := New(shape, nil)
+	for i := range t.data {
+		t.data[i] = rnd.NormFloat64()
+	}
+	return t
+}
+
+// indices returns all the indices of a tensor with the given shape in
+// row-major order.
+func indices(shape []int) [][]int {
+	var all [][]int
+	idx := make([]int, len(shape))
+	for {
+		all = append(all, append([]int(nil), idx...))
+		k := len(idx) - 1
+		for ; k >= 0; k-- {
+			idx[k]++
+			if idx[k] < shape[k] {
+				break
+			}
+			idx[k] = 0
+		}
+		if k < 0 {
+			return all
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	data := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	shape := []int{2, 3, 2}
+	x := New(shape, data)
+	shape[0] = 5
+	if got := x.Shape(); !reflect.DeepEqual(got, []int{2, 3, 2}) {
+		t.Errorf("unexpected shape: got:%v want:[2 3 2]", got)
+	}
+	if x.Rank() != 3 || x.Len() != 12 || x.Dim(1) != 3 {
+		t.Errorf("unexpected rank, length or dimension: %d %d %d", x.Rank(), x.Len(), x.Dim(1))
+	}
+	if !x.IsContiguous() {
+		t.Error("new tensor not contiguous")
+	}
+	for i, idx := range indices(x.shape) {
+		if got := x.At(idx...); got != float64(i) {
+			t.Errorf("unexpected value at %v: got:%v want:%v", idx, got, i)
+		}
+	}
+	x.Set(-1, 1, 2, 0)
+	if data[10] != -1 {
+		t.Error("Set did not modify the backing data")
+	}
+
+	s := New(nil, []float64{3})
+	if s.Rank() != 0 || s.Len() != 1 || s.At() != 3 {
+		t.Errorf("unexpected scalar: rank=%d len=%d value=%v", s.Rank(), s.Len(), s.At())
+	}
+}
+
+func TestViews(t *testing.T) {
+	t.Parallel()
+	x := sequence(2, 3, 4)
+
+	sl := x.Slice(1, 1, 3)
+	if got := sl.Shape(); !reflect.DeepEqual(got, []int{2, 2, 4}) {
+		t.Errorf("unexpected slice shape: %v", got)
+	}
+	for _, idx := range indices(sl.shape) {
+		if got, want := sl.At(idx...), x.At(idx[0], idx[1]+1, idx[2]); got != want {
+			t.Errorf("unexpected slice value at %v: got:%v want:%v", idx, got, want)
+		}
+	}
+	if sl.IsContiguous() {
+		t.Error("slice unexpectedly contiguous")
+	}
+	sl.Set(-1, 0, 0, 0)
+	if x.At(0, 1, 0) != -1 {
+		t.Error("slice does not share data")
+	}
+	x.Set(4, 0, 1, 0)
+
+	ix := x.Index(2, 3)
+	if got := ix.Shape(); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("unexpected index shape: %v", got)
+	}
+	for _, idx := range indices(ix.shape) {
+		if got, want := ix.At(idx...), x.At(idx[0], idx[1], 3); got != want {
+			t.Errorf("unexpected index value at %v: got:%v want:%v", idx, got, want)
+		}
+	}
+	if got := x.Index(0, 1).Index(0, 2).At(1); got != 21 {
+		t.Errorf("unexpected repeated index value: got:%v want:21", got)
+	}
+
+	tr := x.Transpose(2, 0, 1)
+	if got := tr.Shape(); !reflect.DeepEqual(got, []int{4, 2, 3}) {
+		t.Errorf("unexpected transpose shape: %v", got)
+	}
+	for _, idx := range indices(tr.shape) {
+		if got, want := tr.At(idx...), x.At(idx[1], idx[2], idx[0]); got != want {
+			t.Errorf("unexpected transpose value at %v: got:%v want:%v", idx, got, want)
+		}
+	}
+	rev := x.Transpose()
+	for _, idx := range indices(rev.shape) {
+		if got, want := rev.At(idx...), x.At(idx[2], idx[1], idx[0]); got != want {
+			t.Errorf("unexpected reversed value at %v: got:%v want:%v", idx, got, want)
+		}
+	}
+	if !EqualApprox(rev.Transpose(), x, 0) {
+		t.Error("double transpose does not match tensor")
+	}
+}
+
+func TestReshape(t *testing.T) {
+	t.Parallel()
+	x := sequence(2, 3, 4)
+
+	// Reshaping a contiguous tensor returns a view.
+	r := x.Reshape(6, 4)
+	if !reflect.DeepEqual(r.Shape(), []int{6, 4}) {
+		t.Errorf("unexpected reshape shape: %v", r.Shape())
+	}
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 4; j++ {
+			if got := r.At(i, j); got != float64(4*i+j) {
+				t.Errorf("unexpected reshape value at (%d,%d): got:%v", i, j, got)
+			}
+		}
+	}
+	r.Set(-1, 5, 3)
+	if x.At(1, 2, 3) != -1 {
+		t.Error("reshape of contiguous tensor does not share data")
+	}
+	x.Set(23, 1, 2, 3)
+
+	// Reshaping a non-contiguous tensor copies.
+	tr := x.Transpose()
+	r = tr.Reshape(24)
+	for i, idx := range indices(tr.shape) {
+		if got, want := r.At(i), tr.At(idx...); got != want {
+			t.Errorf("unexpected reshaped transpose value at %d: got:%v want:%v", i, got, want)
+		}
+	}
+	r.Set(-1, 0)
+	if x.At(0, 0, 0) != 0 {
+		t.Error("reshape of non-contiguous tensor shares data")
+	}
+
+	// Slices of a leading axis remain contiguous.
+	sl := x.Slice(0, 1, 2)
+	if !sl.IsContiguous() {
+		t.Error("leading slice not contiguous")
+	}
+	if got := sl.Reshape(12).At(0); got != 12 {
+		t.Errorf("unexpected reshaped slice value: got:%v want:12", got)
+	}
+}
+
+func TestCopy(t *testing.T) {
+	t.Parallel()
+	x := sequence(3, 3)
+	want := x.Transpose().Clone()
+
+	// Copying a transpose of a tensor into itself must
+	// not overwrite elements before they are read.
+	x.Copy(x.Transpose())
+	if !EqualApprox(x, want, 0) {
+		t.Errorf("unexpected in-place transpose: got:%v want:%v", x.data, want.data)
+	}
+
+	y := sequence(2, 4)
+	y.Slice(1, 1, 3).Copy(New([]int{2, 2}, []float64{-1, -2, -3, -4}))
+	if want := []float64{0, -1, -2, 3, 4, -3, -4, 7}; !reflect.DeepEqual(y.data, want) {
+		t.Errorf("unexpected copy into view: got:%v want:%v", y.data, want)
+	}
+}
+
+func TestUnfoldFold(t *testing.T) {
+	t.Parallel()
+	x := sequence(2, 3, 2)
+	for _, test := range []struct {
+		k    int
+		want *mat.Dense
+	}{
+		{
+			k: 0,
+			want: mat.NewDense(2, 6, []float64{
+				0, 1, 2, 3, 4, 5,
+				6, 7, 8, 9, 10, 11,
+			}),
+		},
+		{
+			k: 1,
+			want: mat.NewDense(3, 4, []float64{
+				0, 1, 6, 7,
+				2, 3, 8, 9,
+				4, 5, 10, 11,
+			}),
+		},
+		{
+			k: 2,
+			want: mat.NewDense(2, 6, []float64{
+				0, 2, 4, 6, 8, 10,
+				1, 3, 5, 7, 9, 11,
+			}),
+		},
+	} {
+		got := x.Unfold(test.k)
+		if !mat.Equal(got, test.want) {
+			t.Errorf("unexpected mode-%d unfolding:\ngot:\n%v\nwant:\n%v", test.k, mat.Formatted(got), mat.Formatted(test.want))
+		}
+		if f := Fold(got, test.k, x.Shape()); !EqualApprox(f, x, 0) {
+			t.Errorf("mode-%d fold does not invert unfold", test.k)
+		}
+	}
+
+	// Unfolding a view gives the same result as unfolding a copy.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	y := randTensor(rnd, 3, 4, 5, 2).Transpose(1, 3, 0, 2).Slice(2, 1, 3)
+	for k := 0; k < y.Rank(); k++ {
+		if !mat.Equal(y.Unfold(k), y.Clone().Unfold(k)) {
+			t.Errorf("mode-%d unfolding of view differs from unfolding of copy", k)
+		}
+		if !EqualApprox(Fold(y.Unfold(k), k, y.Shape()), y, 0) {
+			t.Errorf("mode-%d fold does not invert unfold of view", k)
+		}
+	}
+}
+
+// naiveContract returns the contraction of a and b computed element by
+// element.
+func naiveContract(a *Dense, aAxes []int, b *Dense, bAxes []int) *Dense {
+	aFree := freeAxes(a.Rank(), aAxes)
+	bFree := freeAxes(b.Rank(), bAxes)
+	var shape, kShape []int
+	for _, ax := range aFree {
+		shape = append(shape, a.shape[ax])
+	}
+	for _, ax := range bFree {
+		shape = append(shape, b.shape[ax])
+	}
+	for _, ax := range aAxes {
+		kShape = append(kShape, a.shape[ax])
+	}
+	c := New(shape, nil)
+	aIdx := make([]int, a.Rank())
+	bIdx := make([]int, b.Rank())
+	for _, idx := range indices(shape) {
+		for i, ax := range aFree {
+			aIdx[ax] = idx[i]
+		}
+		for i, ax := range bFree {
+			bIdx[ax] = idx[len(aFree)+i]
+		}
+		var sum float64
+		for _, kIdx := range indices(kShape) {
+			for i, v := range kIdx {
+				aIdx[aAxes[i]] = v
+				bIdx[bAxes[i]] = v
+			}
+			sum += a.At(aIdx...) * b.At(bIdx...)
+		}
+		c.Set(sum, idx...)
+	}
+	return c
+}
+
+func TestContract(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		aShape, bShape []int
+		aAxes, bAxes   []int
+		wantShape      []int
+	}{
+		{aShape: []int{3, 4}, bShape: []int{4, 5}, aAxes: []int{1}, bAxes: []int{0}, wantShape: []int{3, 5}},
+		{aShape: []int{4, 3}, bShape: []int{5, 4}, aAxes: []int{0}, bAxes: []int{1}, wantShape: []int{3, 5}},
+		{aShape: []int{2, 3, 4}, bShape: []int{4, 3, 5}, aAxes: []int{1, 2}, bAxes: []int{1, 0}, wantShape: []int{2, 5}},
+		{aShape: []int{2, 3, 4}, bShape: []int{3, 2}, aAxes: []int{0, 1}, bAxes: []int{1, 0}, wantShape: []int{4}},
+		{aShape: []int{2, 3}, bShape: []int{4}, aAxes: nil, bAxes: nil, wantShape: []int{2, 3, 4}},
+		{aShape: []int{2, 3}, bShape: []int{2, 3}, aAxes: []int{0, 1}, bAxes: []int{0, 1}, wantShape: nil},
+		{aShape: []int{3, 2, 4, 2}, bShape: []int{2, 5, 4}, aAxes: []int{3, 2}, bAxes: []int{0, 2}, wantShape: []int{3, 2, 5}},
+	} {
+		a := randTensor(rnd, test.aShape...)
+		b := randTensor(rnd, test.bShape...)
+		got := Contract(a, test.aAxes, b, test.bAxes)
+		if !sameShape(got.shape, test.wantShape) {
+			t.Errorf("unexpected shape contracting %v and %v: got:%v want:%v", test.aShape, test.bShape, got.shape, test.wantShape)
+			continue
+		}
+		want := naiveContract(a, test.aAxes, b, test.bAxes)
+		if !EqualApprox(got, want, 1e-13) {
+			t.Errorf("unexpected contraction of %v and %v over %v and %v", test.aShape, test.bShape, test.aAxes, test.bAxes)
+		}
+
+		// Contracting views gives the same result.
+		at := a.Transpose().Clone().Transpose()
+		bt := b.Transpose().Clone().Transpose()
+		if !EqualApprox(Contract(at, test.aAxes, bt, test.bAxes), want, 1e-13) {
+			t.Errorf("unexpected contraction of views of %v and %v over %v and %v", test.aShape, test.bShape, test.aAxes, test.bAxes)
+		}
+	}
+
+	// Contraction of matrices over one axis is matrix multiplication.
+	a := randTensor(rnd, 4, 3)
+	b := randTensor(rnd, 3, 5)
+	var want mat.Dense
+	want.Mul(mat.NewDense(4, 3, a.data), mat.NewDense(3, 5, b.data))
+	got := Contract(a, []int{1}, b, []int{0})
+	if !mat.EqualApprox(mat.NewDense(4, 5, got.data), &want, 1e-14) {
+		t.Error("contraction differs from matrix multiplication")
+	}
+}
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	x := sequence(2, 3)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "zero dimension", fn: func() { New([]int{2, 0}, nil) }},
+		{name: "data length", fn: func() { New([]int{2, 2}, make([]float64, 3)) }},
+		{name: "index rank", fn: func() { x.At(1) }},
+		{name: "index range", fn: func() { x.At(2, 0) }},
+		{name: "negative index", fn: func() { x.Set(0, 0, -1) }},
+		{name: "dim axis", fn: func() { x.Dim(2) }},
+		{name: "slice axis", fn: func() { x.Slice(2, 0, 1) }},
+		{name: "empty slice", fn: func() { x.Slice(1, 1, 1) }},
+		{name: "slice bound", fn: func() { x.Slice(1, 0, 4) }},
+		{name: "index axis", fn: func() { x.Index(-1, 0) }},
+		{name: "index out of range", fn: func() { x.Index(0, 2) }},
+		{name: "short permutation", fn: func() { x.Transpose(0) }},
+		{name: "repeated permutation", fn: func() { x.Transpose(1, 1) }},
+		{name: "reshape length", fn: func() { x.Reshape(4, 2) }},
+		{name: "reshape dimension", fn: func() { x.Reshape(-2, -3) }},
+		{name: "copy shape", fn: func() { x.Copy(sequence(3, 2)) }},
+		{name: "unfold axis", fn: func() { x.Unfold(2) }},
+		{name: "fold shape", fn: func() { Fold(mat.NewDense(3, 2, nil), 0, []int{2, 3}) }},
+		{name: "contract length", fn: func() { Contract(x, []int{0}, x, nil) }},
+		{name: "contract axis", fn: func() { Contract(x, []int{2}, x, []int{0}) }},
+		{name: "contract repeated", fn: func() { Contract(x, []int{0, 0}, x, []int{0, 1}) }},
+		{name: "contract dimension", fn: func() { Contract(x, []int{0}, x, []int{1}) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -666,6 +666,15 @@ func (v *VecDense) MulVec(a Matrix, b Vector) {
 			blas64.Trmv(ta, aU.mat, v.mat)
 			return
 		}
+	case *COO:
+		aU.MulVecTo(v, trans, b)
+		return
+	case *CSR:
+		aU.MulVecTo(v, trans, b)
+		return
+	case *CSC:
+		aU.MulVecTo(v, trans, b)
+		return
 	case *Dense:
 		if fast {
 			aU.checkOverlap(v.asGeneral())
@@ -0,0 +1,220 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestBessel(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	for i, test := range []struct {
+		nu, x      float64
+		j, y, i, k float64
+	}{
+		// J, Y and I computed from the power series and K from its
+		// integral representation in 140 digit arithmetic.
+		{nu: 0.3, x: 0.1, j: 0.45272574599459659, y: -2.0018779347994434, i: 0.45447035229197415, k: 2.8050564750215723},
+		{nu: 0.3, x: 1, j: 0.74022247928102045, y: -0.24570419535649945, i: 1.0887949490168029, k: 0.43507602420880202},
+		{nu: 0.3, x: 2.5, j: 0.17564108274377367, y: 0.47018102218197988, i: 3.1939093578017905, k: 0.063313879296295560},
+		{nu: 0.3, x: 7, j: 0.25671452095138469, y: -0.15779522344121503, i: 167.42073258513862, k: 0.00042736373082278936},
+		{nu: 0.3, x: 20, j: 0.17731275838228065, y: -0.019617176049764751, i: 43457799.760321583, k: 5.7538625183587375e-10},
+		{nu: 0.3, x: 50, j: 0.0053100391078477327, y: -0.11271109864982048, i: 2.9298887214511478e+20, k: 3.4132081995368530e-23},
+		{nu: 1.5, x: 0.1, j: 0.0084020343015001429, y: -25.357166629911094, i: 0.0084188551860927696, k: 39.447835226769862},
+		{nu: 1.5, x: 1, j: 0.24029783912342701, y: -1.1024955751601792, i: 0.29352532634747980, k: 0.92213700889578912},
+		{nu: 1.5, x: 2.5, j: 0.52508026466400315, y: -0.14029358516674293, i: 1.8732783888376189, k: 0.091092320415613985},
+		{nu: 1.5, x: 7, j: -0.19905171329249355, y: -0.23060817748703461, i: 141.73467461112154, k: 0.00049367540617744143},
+		{nu: 1.5, x: 20, j: -0.064662866592310355, y: -0.16652110909428296, i: 41115758.958807482, k: 6.0651926734428169e-10},
+		{nu: 1.5, x: 50, j: -0.10947687298831804, y: 0.027428136761913822, i: 2.8666537159314642e+20, k: 3.4869924973662161e-23},
+		{nu: 2.7, x: 0.1, j: 0.000073573533983611231, y: -1603.6538527681420, i: 0.000073673024887757420, k: 2511.6154265701138},
+		{nu: 2.7, x: 1, j: 0.034471210173999081, y: -3.7515938969916572, i: 0.039459506028155935, k: 4.3742418261911628},
+		{nu: 2.7, x: 2.5, j: 0.28111387254859996, y: -0.64350225464025283, i: 0.65666017785546710, k: 0.20550458277606544},
+		{nu: 2.7, x: 7, j: -0.24636285151377016, y: 0.19312307517952294, i: 96.760607057905833, k: 0.00068917729825744923},
+		{nu: 2.7, x: 20, j: -0.15197566349407771, y: 0.094958684508446475, i: 36138099.542876630, k: 6.8576031276121800e-10},
+		{nu: 2.7, x: 50, j: 0.055048747482625450, y: 0.098589985171222462, i: 2.7243970535816865e+20, k: 3.6653766265231879e-23},
+		{nu: 10.25, x: 0.1, j: 7.0463344655577162e-21, y: -4.4074142973036743e+18, i: 7.0494668658026472e-21, k: 6.9194089567544506e+18},
+		{nu: 10.25, x: 1, j: 1.2257446397466691e-10, y: -254579546.62043595, i: 1.2814510428551208e-10, k: 378850458.51436540},
+		{nu: 10.25, x: 2.5, j: 0.0000013070113622155593, y: -24508.546888658556, i: 0.0000017255449659270687, k: 27458.038610292438},
+		{nu: 10.25, x: 7, j: 0.018352362190829232, y: -2.3633415006130480, i: 0.16376098720604367, k: 0.24583432832549879},
+		{nu: 10.25, x: 20, j: 0.16960947627734667, y: -0.090800298629596773, i: 3126360.3365637947, k: 7.1163073474780170e-9},
+		{nu: 10.25, x: 50, j: -0.10540198817035578, y: 0.043568700883024769, i: 1.0185382927438800e+20, k: 9.6183270102346904e-23},
+		{nu: -0.6, x: 0.1, j: 2.7033656855234838, y: 1.0730897225237078, i: 2.7373701049995359, k: 4.2143190968623234},
+		{nu: -0.6, x: 1, j: 0.29307747555550568, y: 0.75599523068199159, i: 1.1498782039484870, k: 0.47971569489286612},
+		{nu: -0.6, x: 2.5, j: -0.45301052501337318, y: 0.22659386709276253, i: 3.0038308172964535, k: 0.066296294083326925},
+		{nu: -0.6, x: 7, j: 0.19186166604376472, y: 0.23288228798156416, i: 163.95395726711656, k: 0.00043515765735550809},
+		{nu: -0.6, x: 20, j: 0.045960188224443265, y: 0.17240362859615572, i: 43157765.698763753, k: 5.7919008920152254e-10},
+		{nu: -0.6, x: 50, j: 0.11219016849395157, y: -0.012084762920176015, i: 2.9219081579677356e+20, k: 3.4223457187542741e-23},
+		{nu: -3.5, x: 0.1, j: -37884.866409788805, y: -0.0000024016486669206168, i: -37809.172328997634, k: 59390.509017321425},
+		{nu: -3.5, x: 1, j: -13.279443712150628, y: -0.0071862120189627005, i: -10.852406294524514, k: 17.059534664572099},
+		{nu: -3.5, x: 2.5, j: -1.0049676237115539, y: -0.13110255840487304, i: -0.017055071961946361, k: 0.43984577572110753},
+		{nu: -3.5, x: 7, j: 0.32241085449343214, y: 0.0034030375658630213, i: 67.010233710902555, k: 0.00095334765937837541},
+		{nu: -3.5, x: 20, j: 0.17847829369951281, y: -0.021517818131341249, i: 31837663.351655530, k: 7.7367308923737837e-10},
+		{nu: -3.5, x: 50, j: -0.016375092306288384, y: -0.11178059493928059, i: 2.5913379529279240e+20, k: 3.8497764618961209e-23},
+	} {
+		if got := BesselJ(test.nu, test.x); !scalar.EqualWithinAbsOrRel(got, test.j, tol, tol) {
+			t.Errorf("test %d BesselJ(%g, %g) failed: got %g want %g", i, test.nu, test.x, got, test.j)
+		}
+		if got := BesselY(test.nu, test.x); !scalar.EqualWithinAbsOrRel(got, test.y, tol, tol) {
+			t.Errorf("test %d BesselY(%g, %g) failed: got %g want %g", i, test.nu, test.x, got, test.y)
+		}
+		if got := BesselI(test.nu, test.x); !scalar.EqualWithinRel(got, test.i, tol) {
+			t.Errorf("test %d BesselI(%g, %g) failed: got %g want %g", i, test.nu, test.x, got, test.i)
+		}
+		if got := BesselK(test.nu, test.x); !scalar.EqualWithinRel(got, test.k, tol) {
+			t.Errorf("test %d BesselK(%g, %g) failed: got %g want %g", i, test.nu, test.x, got, test.k)
+		}
+		want := test.i * math.Exp(-test.x)
+		if got := BesselIScaled(test.nu, test.x); !scalar.EqualWithinRel(got, want, tol) {
+			t.Errorf("test %d BesselIScaled(%g, %g) failed: got %g want %g", i, test.nu, test.x, got, want)
+		}
+		want = test.k * math.Exp(test.x)
+		if got := BesselKScaled(test.nu, test.x); !scalar.EqualWithinRel(got, want, tol) {
+			t.Errorf("test %d BesselKScaled(%g, %g) failed: got %g want %g", i, test.nu, test.x, got, want)
+		}
+	}
+}
+
+func TestBesselIntegerOrder(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	for _, n := range []int{-7, -2, -1, 0, 1, 2, 5, 12, 30} {
+		for _, x := range []float64{-15, -0.5, 1e-3, 0.5, 1.9, 2, 3, 10, 29.9, 35, 100, 1e4} {
+			nu := float64(n)
+			got := BesselJ(nu, x)
+			want := math.Jn(n, x)
+			if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected value of BesselJ(%d, %g): got %g want %g", n, x, got, want)
+			}
+			if x <= 0 {
+				continue
+			}
+			got = BesselY(nu, x)
+			want = math.Yn(n, x)
+			if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected value of BesselY(%d, %g): got %g want %g", n, x, got, want)
+			}
+		}
+	}
+}
+
+func TestBesselWronskian(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	for _, nu := range []float64{0, 0.25, 0.5, 1, 3.75, 20.5, 40.3} {
+		for _, x := range []float64{1e-3, 0.3, 1.5, 2, 4.5, 12, 31, 250, 1e5} {
+			// J_{ν+1}(x) Y_ν(x) - J_ν(x) Y_{ν+1}(x) = 2/(πx).
+			got := BesselJ(nu+1, x)*BesselY(nu, x) - BesselJ(nu, x)*BesselY(nu+1, x)
+			want := 2 / (math.Pi * x)
+			if !scalar.EqualWithinRel(got, want, tol) {
+				t.Errorf("unexpected J-Y Wronskian for ν=%g x=%g: got %g want %g", nu, x, got, want)
+			}
+
+			// I_ν(x) K_{ν+1}(x) + I_{ν+1}(x) K_ν(x) = 1/x.
+			got = BesselIScaled(nu, x)*BesselKScaled(nu+1, x) + BesselIScaled(nu+1, x)*BesselKScaled(nu, x)
+			want = 1 / x
+			if !scalar.EqualWithinRel(got, want, tol) {
+				t.Errorf("unexpected I-K Wronskian for ν=%g x=%g: got %g want %g", nu, x, got, want)
+			}
+		}
+	}
+}
+
+func TestBesselHalfOrder(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	for _, x := range []float64{1e-5, 0.1, 1, 1.99, 2.01, 5, 29, 31, 800} {
+		// Closed forms for the Bessel functions of order 1/2.
+		s := math.Sqrt(2 / (math.Pi * x))
+		for _, test := range []struct {
+			name      string
+			got, want float64
+		}{
+			{name: "BesselJ(0.5)", got: BesselJ(0.5, x), want: s * math.Sin(x)},
+			{name: "BesselY(0.5)", got: BesselY(0.5, x), want: -s * math.Cos(x)},
+			{name: "BesselJ(-0.5)", got: BesselJ(-0.5, x), want: s * math.Cos(x)},
+			{name: "BesselY(-0.5)", got: BesselY(-0.5, x), want: s * math.Sin(x)},
+			{name: "BesselIScaled(0.5)", got: BesselIScaled(0.5, x), want: s * -math.Expm1(-2*x) / 2},
+			{name: "BesselIScaled(-0.5)", got: BesselIScaled(-0.5, x), want: s * (1 + math.Exp(-2*x)) / 2},
+			{name: "BesselKScaled(0.5)", got: BesselKScaled(0.5, x), want: math.Sqrt(math.Pi / (2 * x))},
+		} {
+			if !scalar.EqualWithinAbsOrRel(test.got, test.want, tol, tol) {
+				t.Errorf("unexpected value of %s at %g: got %g want %g", test.name, x, test.got, test.want)
+			}
+		}
+	}
+}
+
+func TestBesselSpecial(t *testing.T) {
+	t.Parallel()
+
+	nan := math.NaN()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		nu, x      float64
+		j, y, i, k float64
+	}{
+		{nu: nan, x: 1, j: nan, y: nan, i: nan, k: nan},
+		{nu: 1, x: nan, j: nan, y: nan, i: nan, k: nan},
+		{nu: inf, x: 1, j: nan, y: nan, i: nan, k: nan},
+		{nu: 0.5, x: -1, j: nan, y: nan, i: nan, k: nan},
+		{nu: 0, x: 0, j: 1, y: -inf, i: 1, k: inf},
+		{nu: 1.5, x: 0, j: 0, y: -inf, i: 0, k: inf},
+		{nu: -2, x: 0, j: 0, y: -inf, i: 0, k: inf},
+		{nu: -3, x: 0, j: 0, y: inf, i: 0, k: inf},
+		{nu: -0.5, x: 0, j: inf, y: 0, i: inf, k: inf},
+		{nu: -1.5, x: 0, j: -inf, y: 0, i: -inf, k: inf},
+		{nu: -0.3, x: 0, j: inf, y: -inf, i: inf, k: inf},
+		{nu: -1.3, x: 0, j: -inf, y: inf, i: -inf, k: inf},
+		{nu: 2.5, x: inf, j: 0, y: 0, i: inf, k: 0},
+	} {
+		for _, f := range []struct {
+			name string
+			fn   func(nu, x float64) float64
+			want float64
+		}{
+			{name: "BesselJ", fn: BesselJ, want: test.j},
+			{name: "BesselY", fn: BesselY, want: test.y},
+			{name: "BesselI", fn: BesselI, want: test.i},
+			{name: "BesselK", fn: BesselK, want: test.k},
+		} {
+			got := f.fn(test.nu, test.x)
+			if !(math.IsNaN(got) && math.IsNaN(f.want)) && got != f.want {
+				t.Errorf("unexpected value of %s(%g, %g): got %g want %g", f.name, test.nu, test.x, got, f.want)
+			}
+		}
+	}
+
+	// Integer orders are defined for negative x.
+	if got, want := BesselJ(3, -2), -BesselJ(3, 2); got != want {
+		t.Errorf("unexpected value of BesselJ(3, -2): got %g want %g", got, want)
+	}
+	if got, want := BesselI(2, -2), BesselI(2, 2); got != want {
+		t.Errorf("unexpected value of BesselI(2, -2): got %g want %g", got, want)
+	}
+
+	// Overflow for large order.
+	if got := BesselY(100.3, 1e-3); !math.IsInf(got, -1) {
+		t.Errorf("unexpected value of BesselY(100.3, 1e-3): got %g want -Inf", got)
+	}
+	if got := BesselK(100.3, 1e-3); !math.IsInf(got, 1) {
+		t.Errorf("unexpected value of BesselK(100.3, 1e-3): got %g want +Inf", got)
+	}
+
+	// The scaled functions do not overflow.
+	if got, want := BesselIScaled(0, 1e6), 1/math.Sqrt(2*math.Pi*1e6); !scalar.EqualWithinRel(got, want, 1e-6) {
+		t.Errorf("unexpected value of BesselIScaled(0, 1e6): got %g want %g", got, want)
+	}
+	if got, want := BesselKScaled(0, 1e6), math.Sqrt(math.Pi/2e6); !scalar.EqualWithinRel(got, want, 1e-6) {
+		t.Errorf("unexpected value of BesselKScaled(0, 1e6): got %g want %g", got, want)
+	}
+}
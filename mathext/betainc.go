@@ -4,7 +4,11 @@
 
 package mathext
 
-import "gonum.org/v1/gonum/mathext/internal/cephes"
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mathext/internal/cephes"
+)
 
 // RegIncBeta returns the value of the regularized incomplete beta function
 // I(x;a,b). It is defined as
@@ -25,9 +29,80 @@ func RegIncBeta(a, b float64, x float64) float64 {
 //
 // The domain of definition is 0 <= y <= 1, and the parameters a and b must be
 // positive. For other values of x, a, and b InvRegIncBeta will panic.
+// InvRegIncBeta returns a result with small relative error for all y,
+// including y close to 0 or 1, but returns 0 if the result underflows.
 func InvRegIncBeta(a, b float64, y float64) float64 {
-	if y < 0 || 1 < y {
+	if !(a > 0) || !(b > 0) || !(0 <= y && y <= 1) {
+		panic("mathext: parameter out of range")
+	}
+	switch {
+	case y == 0:
+		return 0
+	case y == 1:
+		return 1
+	case y <= 0.5:
+		x, _ := incBetaInv(a, b, y)
+		return x
+	}
+	// I(x;a,b) = 1 - I(1-x;b,a).
+	_, x := incBetaInv(b, a, 1-y)
+	return x
+}
+
+// InvRegIncBetaA computes the inverse of the regularized incomplete beta
+// function with respect to its first parameter. It returns the a for which
+//
+//	y = I(x;a,b)
+//
+// The parameter b must be positive and x and y must be between 0 and 1
+// inclusive, otherwise InvRegIncBetaA will panic. For 0 < x < 1, I(x;a,b)
+// decreases from 1 to 0 as a increases from 0 to infinity, so
+// InvRegIncBetaA returns +Inf for y = 0 and 0 for y = 1. It returns NaN
+// if x is 0 or 1, since I(x;a,b) then does not depend on a.
+//
+// The accuracy of the result is limited by that of RegIncBeta, which degrades
+// for parameters very much smaller or larger than 1. InvRegIncBetaA returns NaN if
+// RegIncBeta cannot be evaluated near the result.
+func InvRegIncBetaA(b, x, y float64) float64 {
+	if !(b > 0) || !(0 <= x && x <= 1) || !(0 <= y && y <= 1) {
 		panic("mathext: parameter out of range")
 	}
-	return cephes.Incbi(a, b, y)
+	switch {
+	case x == 0 || x == 1:
+		return math.NaN()
+	case y == 0:
+		return math.Inf(1)
+	case y == 1:
+		return 0
+	}
+	return incBetaParamInv(b, x, y, true)
+}
+
+// InvRegIncBetaB computes the inverse of the regularized incomplete beta
+// function with respect to its second parameter. It returns the b for which
+//
+//	y = I(x;a,b)
+//
+// The parameter a must be positive and x and y must be between 0 and 1
+// inclusive, otherwise InvRegIncBetaB will panic. For 0 < x < 1, I(x;a,b)
+// increases from 0 to 1 as b increases from 0 to infinity, so
+// InvRegIncBetaB returns 0 for y = 0 and +Inf for y = 1. It returns NaN
+// if x is 0 or 1, since I(x;a,b) then does not depend on b.
+//
+// The accuracy of the result is limited by that of RegIncBeta, which degrades
+// for parameters very much smaller or larger than 1. InvRegIncBetaB returns NaN if
+// RegIncBeta cannot be evaluated near the result.
+func InvRegIncBetaB(a, x, y float64) float64 {
+	if !(a > 0) || !(0 <= x && x <= 1) || !(0 <= y && y <= 1) {
+		panic("mathext: parameter out of range")
+	}
+	switch {
+	case x == 0 || x == 1:
+		return math.NaN()
+	case y == 0:
+		return 0
+	case y == 1:
+		return math.Inf(1)
+	}
+	return incBetaParamInv(a, x, y, false)
 }
@@ -0,0 +1,176 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mathext/internal/cephes"
+)
+
+// incBetaInv returns the x such that
+//
+//	RegIncBeta(a, b, x) = p
+//
+// for a, b > 0 and 0 < p ≤ 0.5, along with 1-x computed without cancellation.
+//
+// The equation log(I(x;a,b)) = log(p) is solved by Newton's method in the
+// logit t = log(x/(1-x)), safeguarded with bisection. The density of t for
+// a beta distributed x is log-concave, so log(I) is a concave function of t
+// and the iteration converges from any starting point.
+func incBetaInv(a, b, p float64) (x, xc float64) {
+	logP := math.Log(p)
+	lbeta := Lbeta(a, b)
+
+	// I(x;a,b) ~ x^a / (a B(a,b)) as x tends to zero.
+	ts := (logP + math.Log(a) + lbeta) / a
+	if ts < logMinFloat {
+		// The root underflows.
+		return 0, 1
+	}
+
+	// Start from the better of the small x approximation and the
+	// approximation of Incbi, which is accurate away from the tails.
+	x, xc = logistic(ts)
+	h := logRatio(incBeta(a, b, x, xc), p)
+	if x0 := cephes.Incbi(a, b, p); 0 < x0 && x0 < 1 {
+		h0 := logRatio(incBeta(a, b, x0, 1-x0), p)
+		if math.Abs(h0) < math.Abs(h) || math.IsNaN(h) {
+			x, xc = x0, 1-x0
+		}
+	}
+
+	// The bracket [lo, hi] is held by both x and 1-x, so that it can be
+	// compared with the iterates with full relative precision at both
+	// ends of the interval.
+	lo, loc := 0.0, 1.0
+	hi, hic := 1.0, 0.0
+	var prevStep float64
+	for i := 0; i < invMaxIterations; i++ {
+		v := incBeta(a, b, x, xc)
+		h := logRatio(v, p)
+		if h == 0 {
+			return x, xc
+		}
+		if h < 0 {
+			lo, loc = x, xc
+		} else {
+			hi, hic = x, xc
+		}
+
+		// The derivative of log(I) with respect to t is
+		// x^a (1-x)^b / (B(a,b) I).
+		slope := math.Exp(a*math.Log(x)+b*math.Log(xc)-lbeta) / v
+		step := h / slope
+		e := math.Exp(step)
+		xNext := x / (x + xc*e)
+		xcNext := xc * e / (x + xc*e)
+		inside := (lo < xNext && xNext < hi) || (hic < xcNext && xcNext < loc)
+		if !inside {
+			// Bisect the bracket in t.
+			t := (logit(lo, loc) + logit(hi, hic)) / 2
+			xNext, xcNext = logistic(t)
+			step = logit(x, xc) - t
+		}
+		if (xNext == x && xcNext == xc) || math.Abs(step) <= 4*machEp {
+			return xNext, xcNext
+		}
+		x, xc = xNext, xcNext
+		// Stop when the iteration is limited by the accuracy
+		// of the incomplete beta function.
+		if i > 0 && math.Abs(step) < 1e-8 && math.Abs(step) >= math.Abs(prevStep) {
+			break
+		}
+		prevStep = step
+	}
+	return x, xc
+}
+
+// incBeta returns I(x;a,b) given x and xc = 1-x. Above the mean the
+// complement is evaluated at xc, which unlike 1-x retains its relative
+// precision when x is close to 1.
+func incBeta(a, b, x, xc float64) float64 {
+	if x > 0.5 && x > a/(a+b) {
+		return 1 - cephes.Incbet(b, a, xc)
+	}
+	return cephes.Incbet(a, b, x)
+}
+
+// logit returns log(x/(1-x)) given x and xc = 1-x, clamped to the range
+// of the logarithms of the positive finite float64 values.
+func logit(x, xc float64) float64 {
+	t := math.Log(x) - math.Log(xc)
+	return math.Max(logMinFloat, math.Min(t, -logMinFloat))
+}
+
+// logistic returns 1/(1+e^-t) and 1/(1+e^t).
+func logistic(t float64) (x, xc float64) {
+	if t < 0 {
+		e := math.Exp(t)
+		return e / (1 + e), 1 / (1 + e)
+	}
+	e := math.Exp(-t)
+	return 1 / (1 + e), e / (1 + e)
+}
+
+// incBetaParamInv returns the a such that I(x;a,c) = y if first is true,
+// and the b such that I(x;c,b) = y otherwise, for c > 0, 0 < x < 1 and
+// 0 < y < 1. I(x;a,b) is decreasing in a and increasing in b.
+func incBetaParamInv(c, x, y float64, first bool) float64 {
+	// Solve for the logarithm of the parameter using the logarithm
+	// of the smaller tail.
+	xc := 1 - x
+	tail := func(s float64) (lower, upper float64) {
+		p := math.Exp(s)
+		if first {
+			return incBetaTails(p, c, x, xc)
+		}
+		return incBetaTails(c, p, x, xc)
+	}
+	sign := 1.0
+	if !first {
+		// Make the objective decreasing in s.
+		sign = -1
+	}
+	f := func(s float64, _ []float64) float64 {
+		lower, _ := tail(s)
+		return sign * logRatio(lower, y)
+	}
+	if y > 0.5 {
+		f = func(s float64, _ []float64) float64 {
+			_, upper := tail(s)
+			return -sign * logRatio(upper, 1-y)
+		}
+	}
+
+	// Start from the parameter for which x is the mean.
+	s0 := math.Log(c) + math.Log(x) - math.Log(xc)
+	if !first {
+		s0 = math.Log(c) + math.Log(xc) - math.Log(x)
+	}
+	return logParamRoot(f, math.Max(logMinFloat+1, math.Min(s0, logMaxFloat-1)))
+}
+
+// incBetaTails returns I(x;a,b) and 1 - I(x;a,b) given x and xc = 1-x. The
+// smaller tail is evaluated directly and the other is its complement. For
+// x < 1/4, xc does not retain the relative precision of x, so the upper tail
+// is evaluated at xc only if the cancellation in 1 - I(x;a,b) would lose more
+// precision than the rounding of xc.
+func incBetaTails(a, b, x, xc float64) (lower, upper float64) {
+	if x < 0.25 {
+		lower = cephes.Incbet(a, b, x)
+		if upper = 1 - lower; upper >= x || xc == 1 {
+			return lower, upper
+		}
+		upper = cephes.Incbet(b, a, xc)
+		return 1 - upper, upper
+	}
+	upper = cephes.Incbet(b, a, xc)
+	if upper <= 0.5 {
+		return 1 - upper, upper
+	}
+	lower = cephes.Incbet(a, b, x)
+	return lower, 1 - lower
+}
@@ -5,6 +5,7 @@
 package mathext
 
 import (
+	"math"
 	"testing"
 
 	"gonum.org/v1/gonum/floats"
@@ -75,3 +76,117 @@ func TestIncBeta(t *testing.T) {
 		}
 	}
 }
+
+func TestInvRegIncBetaTails(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	for i, test := range []struct {
+		a, b, y, want float64
+	}{
+		// Results computed with 100 digit arithmetic.
+		{a: 5, b: 7, y: 1e-300, want: 2.9313765200685082e-61},
+		{a: 100, b: 200, y: 1e-300, want: 0.00015335680098988799},
+		{a: 10000, b: 3, y: 1e-20, want: 0.99467975866391005},
+		{a: 50, b: 0.1, y: 1e-100, want: 0.011221983587021602},
+		{a: 2, b: 0.001, y: 1e-100, want: 4.4699015626767420e-49},
+		{a: 0.001, b: 2, y: 0.9, want: 6.4332726838039997e-47},
+		{a: 0.5, b: 2, y: 1e-200, want: 0}, // The result underflows.
+	} {
+		got := InvRegIncBeta(test.a, test.b, test.y)
+		// The relative error of x is amplified by 1/a
+		// for x close to zero.
+		if math.Abs(got-test.want) > tol*math.Max(1, 1/test.a)*test.want {
+			t.Errorf("test %d InvRegIncBeta(%g, %g, %g) failed: got %g want %g", i, test.a, test.b, test.y, got, test.want)
+		}
+	}
+
+	for _, y := range []float64{1e-300, 1e-100, 1e-20, 1e-5, 0.25, 0.5, 0.75, 1 - 1e-10} {
+		for _, c := range []struct {
+			name string
+			a, b float64
+			want float64
+		}{
+			// I(x;1,b) = 1 - (1-x)^b.
+			{name: "1, 3", a: 1, b: 3, want: -math.Expm1(math.Log1p(-y) / 3)},
+			// I(x;a,1) = x^a.
+			{name: "0.25, 1", a: 0.25, b: 1, want: math.Pow(y, 4)},
+			{name: "40, 1", a: 40, b: 1, want: math.Exp(math.Log(y) / 40)},
+			// I(x;1/2,1/2) = 2/π asin(√x).
+			{name: "0.5, 0.5", a: 0.5, b: 0.5, want: math.Pow(math.Sin(math.Pi*y/2), 2)},
+		} {
+			got := InvRegIncBeta(c.a, c.b, y)
+			if math.Abs(got-c.want) > tol*c.want {
+				t.Errorf("unexpected value of InvRegIncBeta(%s, %g): got %g want %g", c.name, y, got, c.want)
+			}
+		}
+	}
+}
+
+func TestInvRegIncBetaParams(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-9
+	for _, y := range []float64{1e-300, 1e-100, 1e-20, 1e-5, 0.25, 0.5, 0.75, 1 - 1e-5, 1 - 1e-12} {
+		for _, x := range []float64{1e-10, 0.01, 0.3, 0.5, 0.9, 1 - 1e-8} {
+			for _, test := range []struct {
+				name string
+				fn   func(c, x, y float64) float64
+				want float64
+			}{
+				// I(x;a,1) = x^a.
+				{name: "InvRegIncBetaA", fn: InvRegIncBetaA, want: math.Log(y) / math.Log(x)},
+				// I(x;1,b) = 1 - (1-x)^b.
+				{name: "InvRegIncBetaB", fn: InvRegIncBetaB, want: math.Log1p(-y) / math.Log1p(-x)},
+			} {
+				if test.want < 1e-3 || 1e6 < test.want {
+					// RegIncBeta is not accurate enough
+					// to determine the parameter.
+					continue
+				}
+				if got := test.fn(1, x, y); !scalar.EqualWithinRel(got, test.want, tol) {
+					t.Errorf("unexpected value of %s(1, %g, %g): got %g want %g", test.name, x, y, got, test.want)
+				}
+			}
+		}
+	}
+
+	for _, a := range []float64{0.01, 0.5, 2, 30, 1000} {
+		for _, b := range []float64{0.01, 0.5, 2, 30, 1000} {
+			for _, x := range []float64{0.001, 0.2, 0.5, 0.8, 0.999} {
+				y := RegIncBeta(a, b, x)
+				if y < 1e-280 || 1-y < 1e-12 {
+					// The parameters are poorly determined by y.
+					continue
+				}
+				if got := InvRegIncBetaA(b, x, y); !scalar.EqualWithinRel(got, a, 1e-7) {
+					t.Errorf("unexpected value of InvRegIncBetaA(%g, %g, %g): got %g want %g", b, x, y, got, a)
+				}
+				if got := InvRegIncBetaB(a, x, y); !scalar.EqualWithinRel(got, b, 1e-7) {
+					t.Errorf("unexpected value of InvRegIncBetaB(%g, %g, %g): got %g want %g", a, x, y, got, b)
+				}
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "InvRegIncBetaA(2, 0.5, 0)", got: InvRegIncBetaA(2, 0.5, 0), want: math.Inf(1)},
+		{name: "InvRegIncBetaA(2, 0.5, 1)", got: InvRegIncBetaA(2, 0.5, 1), want: 0},
+		{name: "InvRegIncBetaB(2, 0.5, 0)", got: InvRegIncBetaB(2, 0.5, 0), want: 0},
+		{name: "InvRegIncBetaB(2, 0.5, 1)", got: InvRegIncBetaB(2, 0.5, 1), want: math.Inf(1)},
+	} {
+		if test.got != test.want {
+			t.Errorf("unexpected value of %s: got %g want %g", test.name, test.got, test.want)
+		}
+	}
+	// The result is beyond the accuracy of RegIncBeta
+	// and must be reported as NaN rather than looping.
+	if got := InvRegIncBetaB(2, 1e-300, 0.5); !math.IsNaN(got) && !scalar.EqualWithinRel(got, 1.6783469900166608e300, 1e-6) {
+		t.Errorf("unexpected value of InvRegIncBetaB(2, 1e-300, 0.5): got %g", got)
+	}
+	if got := InvRegIncBetaA(2, 0, 0.5); !math.IsNaN(got) {
+		t.Errorf("unexpected value of InvRegIncBetaA(2, 0, 0.5): got %g want NaN", got)
+	}
+}
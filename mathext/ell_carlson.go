@@ -136,6 +136,132 @@ func EllipticRD(x, y, z float64) float64 {
 	return (1-3/14.0*E2+1/6.0*E3+9/88.0*E2*E2-3/22.0*E4-9/52.0*E2*E3+3/26.0*E5-1/16.0*E2*E2*E2+3/40.0*E3*E3+3/20.0*E2*E4+45/272.0*E2*E2*E3-9/68.0*(E3*E4+E2*E5))/(mul*An*math.Sqrt(An)) + 3*s
 }
 
+// EllipticRC computes the degenerate symmetric elliptic integral R_C(x,y):
+//
+//	R_C(x,y) = (1/2)\int_{0}^{\infty}{1/(\sqrt{t+x}(t+y))} dt.
+//
+// For y < 0 the Cauchy principal value of the integral is returned.
+// The arguments x, y must satisfy the following conditions, otherwise the function returns math.NaN():
+//
+//	0 ≤ x ≤ upper,
+//	lower ≤ |y| ≤ upper,
+//
+// where:
+//
+//	lower = 5/(2^1022) = 1.112536929253601e-307,
+//	upper = (2^1022)/5 = 8.988465674311580e+306.
+//
+// The definition of the symmetric elliptic integral R_C can be found in NIST
+// Digital Library of Mathematical Functions (http://dlmf.nist.gov/19.16.i).
+func EllipticRC(x, y float64) float64 {
+	// The algorithm is described by Carlson in http://dx.doi.org/10.1007/BF02198293
+	// (also available at https://arxiv.org/abs/math/9409227).
+	const (
+		lower = 5.0 / (1 << 256) / (1 << 256) / (1 << 256) / (1 << 254) // 5*2^-1022
+		upper = 1 / lower
+		tol   = 1.2674918778210762260320167734407048051023273568443e-02 // (3ε)^(1/8)
+	)
+	if x < 0 || math.IsNaN(x) || math.IsNaN(y) {
+		return math.NaN()
+	}
+	if upper < x || upper < math.Abs(y) || math.Abs(y) < lower {
+		return math.NaN()
+	}
+	if y < 0 {
+		// http://dlmf.nist.gov/19.20.E10
+		return math.Sqrt(x/(x-y)) * EllipticRC(x-y, -y)
+	}
+
+	A0 := (x + 2*y) / 3
+	An := A0
+	Q := math.Abs(A0-x) / tol
+	xn, yn := x, y
+	mul := 1.0
+
+	for Q >= mul*math.Abs(An) {
+		lambda := 2*math.Sqrt(xn)*math.Sqrt(yn) + yn
+		An = (An + lambda) * 0.25
+		xn = (xn + lambda) * 0.25
+		yn = (yn + lambda) * 0.25
+		mul *= 4
+	}
+
+	s := (y - A0) / (mul * An)
+
+	return (1 + s*s*(3/10.0+s*(1/7.0+s*(3/8.0+s*(9/22.0+s*(159/208.0+s*9/8.0)))))) / math.Sqrt(An)
+}
+
+// EllipticRJ computes the symmetric elliptic integral R_J(x,y,z,p):
+//
+//	R_J(x,y,z,p) = (3/2)\int_{0}^{\infty}{1/(s(t)(t+p))} dt,
+//	s(t) = \sqrt{(t+x)(t+y)(t+z)}.
+//
+// The arguments x, y, z, p must satisfy the following conditions, otherwise the function returns math.NaN():
+//
+//	0 ≤ x,y,z ≤ upper,
+//	lower ≤ p ≤ upper,
+//	lower ≤ x+y,y+z,z+x,
+//
+// where:
+//
+//	lower = (5/(2^1022))^(1/3) = 4.809554074311679e-103,
+//	upper = ((2^1022)/5)^(1/3) = 2.079194837087086e+102.
+//
+// The definition of the symmetric elliptic integral R_J can be found in NIST
+// Digital Library of Mathematical Functions (http://dlmf.nist.gov/19.16.E2).
+func EllipticRJ(x, y, z, p float64) float64 {
+	// The algorithm is described by Carlson in http://dx.doi.org/10.1007/BF02198293
+	// (also available at https://arxiv.org/abs/math/9409227).
+	const (
+		lower = 4.8095540743116787026618007863123676393525016818363e-103 // (5*2^-1022)^(1/3)
+		upper = 1 / lower
+		tol   = 9.0351169339315770474760122547068324993857488849382e-03 // (ε/5)^(1/8)
+	)
+	if x < 0 || y < 0 || z < 0 || math.IsNaN(x) || math.IsNaN(y) || math.IsNaN(z) || math.IsNaN(p) {
+		return math.NaN()
+	}
+	if upper < x || upper < y || upper < z || upper < p {
+		return math.NaN()
+	}
+	if x+y < lower || y+z < lower || z+x < lower || p < lower {
+		return math.NaN()
+	}
+
+	A0 := (x + y + z + 2*p) / 5
+	An := A0
+	delta := (p - x) * (p - y) * (p - z)
+	Q := math.Max(math.Max(math.Abs(A0-x), math.Abs(A0-y)), math.Max(math.Abs(A0-z), math.Abs(A0-p))) / tol
+	xn, yn, zn, pn := x, y, z, p
+	mul, mul3, s := 1.0, 1.0, 0.0
+
+	for Q >= mul*math.Abs(An) {
+		xnsqrt, ynsqrt, znsqrt, pnsqrt := math.Sqrt(xn), math.Sqrt(yn), math.Sqrt(zn), math.Sqrt(pn)
+		lambda := xnsqrt*ynsqrt + ynsqrt*znsqrt + znsqrt*xnsqrt
+		d := (pnsqrt + xnsqrt) * (pnsqrt + ynsqrt) * (pnsqrt + znsqrt)
+		e := delta / (mul3 * d * d)
+		s += EllipticRC(1, 1+e) / (mul * d)
+		An = (An + lambda) * 0.25
+		xn = (xn + lambda) * 0.25
+		yn = (yn + lambda) * 0.25
+		zn = (zn + lambda) * 0.25
+		pn = (pn + lambda) * 0.25
+		mul *= 4
+		mul3 *= 64
+	}
+
+	X := (A0 - x) / (mul * An)
+	Y := (A0 - y) / (mul * An)
+	Z := (A0 - z) / (mul * An)
+	P := -(X + Y + Z) / 2
+	E2 := X*Y + X*Z + Y*Z - 3*P*P
+	E3 := X*Y*Z + 2*E2*P + 4*P*P*P
+	E4 := (2*X*Y*Z + E2*P + 3*P*P*P) * P
+	E5 := X * Y * Z * P * P
+
+	// http://dlmf.nist.gov/19.36.E2
+	return (1-3/14.0*E2+1/6.0*E3+9/88.0*E2*E2-3/22.0*E4-9/52.0*E2*E3+3/26.0*E5)/(mul*An*math.Sqrt(An)) + 6*s
+}
+
 // EllipticF computes the Legendre's elliptic integral of the 1st kind F(phi,m), 0≤m<1:
 //
 //	F(\phi,m) = \int_{0}^{\phi} 1 / \sqrt{1-m\sin^2(\theta)} d\theta
@@ -166,3 +292,19 @@ func EllipticE(phi, m float64) float64 {
 	x, y := c*c, 1-m*s*s
 	return s * (EllipticRF(x, y, 1) - (m/3)*s*s*EllipticRD(x, y, 1))
 }
+
+// EllipticPi computes the Legendre's elliptic integral of the 3rd kind Π(n,phi,m), 0≤m<1, n\sin^2\phi<1, |phi|≤π/2:
+//
+//	Π(n,\phi,m) = \int_{0}^{\phi} 1 / ((1-n\sin^2(\theta))\sqrt{1-m\sin^2(\theta)}) d\theta
+//
+// Legendre's elliptic integrals can be expressed as symmetric elliptic integrals, in this case:
+//
+//	Π(n,\phi,m) = \sin\phi R_F(\cos^2\phi,1-m\sin^2\phi,1)+(n/3)\sin^3\phi R_J(\cos^2\phi,1-m\sin^2\phi,1,1-n\sin^2\phi)
+//
+// The definition of Π(phi,n,k) where k=sqrt(m) can be found in NIST Digital Library of Mathematical
+// Functions (http://dlmf.nist.gov/19.2.E7).
+func EllipticPi(n, phi, m float64) float64 {
+	s, c := math.Sincos(phi)
+	x, y, p := c*c, 1-m*s*s, 1-n*s*s
+	return s * (EllipticRF(x, y, 1) + (n/3)*s*s*EllipticRJ(x, y, 1, p))
+}
@@ -103,3 +103,112 @@ func TestEllipticE(t *testing.T) {
 		}
 	}
 }
+
+func TestEllipticRC(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	// Test values from Carlson, https://arxiv.org/abs/math/9409227.
+	for _, test := range []struct {
+		x, y, want float64
+	}{
+		{x: 0, y: 0.25, want: math.Pi},
+		{x: 2.25, y: 2, want: math.Ln2},
+		{x: 0.25, y: -2, want: math.Ln2 / 3},
+		{x: 4, y: 4, want: 0.5},
+		{x: 1, y: 1e-300, want: math.NaN()},
+		{x: -1, y: 1, want: math.NaN()},
+	} {
+		got := EllipticRC(test.x, test.y)
+		if !(math.IsNaN(got) && math.IsNaN(test.want)) && math.Abs(got-test.want) > tol*math.Abs(test.want) {
+			t.Errorf("unexpected value of EllipticRC(%v, %v): got %v want %v", test.x, test.y, got, test.want)
+		}
+	}
+
+	// R_C(x,y) = R_F(x,y,y), http://dlmf.nist.gov/19.16.i
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 1000; i++ {
+		x := rnd.Float64() * 10
+		y := rnd.Float64()*10 + 1e-3
+		got := EllipticRC(x, y)
+		want := EllipticRF(x, y, y)
+		if math.Abs(got-want) > tol*want {
+			t.Fatalf("unexpected value of EllipticRC(%v, %v): got %v want %v", x, y, got, want)
+		}
+	}
+}
+
+func TestEllipticRJ(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	// Test values from Carlson, https://arxiv.org/abs/math/9409227.
+	for _, test := range []struct {
+		x, y, z, p, want float64
+	}{
+		{x: 0, y: 1, z: 2, p: 3, want: 0.77688623778582},
+		{x: 2, y: 3, z: 4, p: 5, want: 0.14297579667157},
+		{x: 0, y: 0, z: 1, p: 1, want: math.NaN()},
+		{x: 1, y: 2, z: 3, p: -1, want: math.NaN()},
+	} {
+		got := EllipticRJ(test.x, test.y, test.z, test.p)
+		if !(math.IsNaN(got) && math.IsNaN(test.want)) && math.Abs(got-test.want) > tol*math.Abs(test.want) {
+			t.Errorf("unexpected value of EllipticRJ(%v, %v, %v, %v): got %v want %v", test.x, test.y, test.z, test.p, got, test.want)
+		}
+	}
+
+	// R_J(x,y,z,z) = R_D(x,y,z), http://dlmf.nist.gov/19.16.i
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 1000; i++ {
+		x := rnd.Float64() * 10
+		y := rnd.Float64() * 10
+		z := rnd.Float64()*10 + 1e-3
+		got := EllipticRJ(x, y, z, z)
+		want := EllipticRD(x, y, z)
+		if math.Abs(got-want) > tol*want {
+			t.Fatalf("unexpected value of EllipticRJ(%v, %v, %v, %v): got %v want %v", x, y, z, z, got, want)
+		}
+	}
+}
+
+func TestEllipticPi(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for i, test := range []struct {
+		n, phi, m, want float64
+	}{
+		// Results computed with tanh-sinh quadrature in 40 digit arithmetic.
+		{n: 0.5, phi: 0.7, m: 0.3, want: 0.77872203404749359},
+		{n: -2, phi: 1.2, m: 0.5, want: 0.84630033528324023},
+		{n: 0.9, phi: 1, m: 0.9, want: 1.8079307213465867},
+		{n: 0.3, phi: 1.5707963267948966, m: 0.8, want: 2.7937945927413254},
+		{n: -0.5, phi: -0.4, m: 0.2, want: -0.39212312620393070},
+		{n: 0.99, phi: 1.4, m: 0.1, want: 5.4623159237366013},
+		{n: 5, phi: 0.3, m: 0.6, want: 0.36520747945574959},
+	} {
+		got := EllipticPi(test.n, test.phi, test.m)
+		if math.Abs(got-test.want) > tol*math.Abs(test.want) {
+			t.Errorf("test %d EllipticPi(%v, %v, %v) failed: got %v want %v", i, test.n, test.phi, test.m, got, test.want)
+		}
+	}
+
+	// Π(0,phi,m) = F(phi,m) and Π(m,phi,m) = (E(phi,m) - m\sin\phi\cos\phi/\sqrt{1-m\sin^2\phi})/(1-m),
+	// http://dlmf.nist.gov/19.6.iii
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 1000; i++ {
+		phi := rnd.Float64() * math.Pi / 2
+		m := rnd.Float64() * 0.99
+		got := EllipticPi(0, phi, m)
+		want := EllipticF(phi, m)
+		if math.Abs(got-want) > tol*want {
+			t.Fatalf("unexpected value of EllipticPi(0, %v, %v): got %v want %v", phi, m, got, want)
+		}
+		s, c := math.Sincos(phi)
+		got = EllipticPi(m, phi, m)
+		want = (EllipticE(phi, m) - m*s*c/math.Sqrt(1-m*s*s)) / (1 - m)
+		if math.Abs(got-want) > 1e-12*want {
+			t.Fatalf("unexpected value of EllipticPi(%v, %v, %v): got %v want %v", m, phi, m, got, want)
+		}
+	}
+}
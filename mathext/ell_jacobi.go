@@ -0,0 +1,154 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+)
+
+// JacobiElliptic computes the Jacobi elliptic functions sn(u,m), cn(u,m) and dn(u,m), 0≤m≤1.
+// It returns math.NaN() for all three functions if m is not in [0,1].
+//
+//	sn(u,m) = \sin\phi,
+//	cn(u,m) = \cos\phi,
+//	dn(u,m) = \sqrt{1-m\sin^2\phi},
+//
+// where \phi = am(u,m) is the Jacobi amplitude, the inverse of Legendre's elliptic integral
+// of the 1st kind, u = F(\phi,m).
+//
+// The functions are computed with the arithmetic-geometric mean, descending Landen transformation
+// described in NIST Digital Library of Mathematical Functions (http://dlmf.nist.gov/22.20.ii).
+func JacobiElliptic(u, m float64) (sn, cn, dn float64) {
+	switch {
+	case m < 0 || 1 < m || math.IsNaN(m) || math.IsNaN(u):
+		return math.NaN(), math.NaN(), math.NaN()
+	case m == 0:
+		sn, cn = math.Sincos(u)
+		return sn, cn, 1
+	case m == 1:
+		// http://dlmf.nist.gov/22.5.E4
+		sech := 1 / math.Cosh(u)
+		return math.Tanh(u), sech, sech
+	}
+	sn, cn = math.Sincos(jacobiAmplitude(u, m))
+	// dn² = 1 - m sn² = 1 - m + m cn² avoids cancellation when sn² is close to 1/m.
+	return sn, cn, math.Sqrt((1 - m) + m*cn*cn)
+}
+
+// JacobiAmplitude computes the Jacobi amplitude am(u,m), 0≤m≤1. It returns math.NaN() if m is not in [0,1].
+//
+// The Jacobi amplitude is the inverse of Legendre's elliptic integral of the 1st kind,
+//
+//	am(F(\phi,m),m) = \phi.
+//
+// The definition of am(u,k) where k=sqrt(m) can be found in NIST Digital Library of Mathematical
+// Functions (http://dlmf.nist.gov/22.16.E1).
+func JacobiAmplitude(u, m float64) float64 {
+	switch {
+	case m < 0 || 1 < m || math.IsNaN(m) || math.IsNaN(u):
+		return math.NaN()
+	case m == 0:
+		return u
+	case m == 1:
+		// The Gudermannian function, http://dlmf.nist.gov/22.16.E3
+		return math.Atan(math.Sinh(u))
+	}
+	return jacobiAmplitude(u, m)
+}
+
+// jacobiAmplitude returns am(u,m) for 0<m<1.
+func jacobiAmplitude(u, m float64) float64 {
+	const (
+		eps     = 1.1102230246251565e-16 // 2^-53
+		maxIter = 64
+	)
+	var a, c [maxIter + 1]float64
+	a[0] = 1
+	b := math.Sqrt(1 - m)
+	c[0] = math.Sqrt(m)
+	n := 0
+	for math.Abs(c[n]) > eps && n < maxIter {
+		a[n+1] = (a[n] + b) / 2
+		c[n+1] = (a[n] - b) / 2
+		b = math.Sqrt(a[n] * b)
+		n++
+	}
+	phi := math.Ldexp(a[n]*u, n)
+	for ; n > 0; n-- {
+		phi = (phi + math.Asin(c[n]/a[n]*math.Sin(phi))) / 2
+	}
+	return phi
+}
+
+// InverseJacobiSn computes the inverse of the Jacobi elliptic function sn(u,m), -1≤x≤1, 0≤m≤1.
+// It returns math.NaN() if x or m are out of range.
+//
+//	arcsn(x,m) = F(\arcsin x,m) = x R_F(1-x^2,1-mx^2,1)
+//
+// The definition of arcsn(x,k) where k=sqrt(m) can be found in NIST Digital Library of Mathematical
+// Functions (http://dlmf.nist.gov/22.15.E12).
+func InverseJacobiSn(x, m float64) float64 {
+	if x < -1 || 1 < x || m < 0 || 1 < m || math.IsNaN(x) || math.IsNaN(m) {
+		return math.NaN()
+	}
+	if m == 1 {
+		return math.Atanh(x)
+	}
+	return x * EllipticRF((1-x)*(1+x), 1-m*x*x, 1)
+}
+
+// InverseJacobiCn computes the inverse of the Jacobi elliptic function cn(u,m), -1≤x≤1, 0≤m≤1.
+// It returns math.NaN() if x or m are out of range, or if m=1 and x<0.
+//
+//	arccn(x,m) = F(\arccos x,m) = \sqrt{1-x^2} R_F(x^2,1-m+mx^2,1), x≥0
+//	arccn(x,m) = 2K(m) - arccn(-x,m), x<0
+//
+// The result is in [0,2K(m)].
+//
+// The definition of arccn(x,k) where k=sqrt(m) can be found in NIST Digital Library of Mathematical
+// Functions (http://dlmf.nist.gov/22.15.E13).
+func InverseJacobiCn(x, m float64) float64 {
+	if x < -1 || 1 < x || m < 0 || 1 < m || math.IsNaN(x) || math.IsNaN(m) {
+		return math.NaN()
+	}
+	if x < 0 {
+		if m == 1 {
+			return math.NaN()
+		}
+		return 2*CompleteK(m) - InverseJacobiCn(-x, m)
+	}
+	if m == 1 {
+		// cn(u,1) = sech(u).
+		return math.Acosh(1 / x)
+	}
+	return math.Sqrt((1-x)*(1+x)) * EllipticRF(x*x, 1-m+m*x*x, 1)
+}
+
+// InverseJacobiDn computes the inverse of the Jacobi elliptic function dn(u,m), \sqrt{1-m}≤x≤1, 0≤m≤1.
+// It returns math.NaN() if x or m are out of range.
+//
+//	arcdn(x,m) = F(\arcsin\sqrt{(1-x^2)/m},m) = \sqrt{(1-x^2)/m} R_F((x^2-1+m)/m,x^2,1)
+//
+// The result is in [0,K(m)]. arcdn(1,0) is 0.
+//
+// The definition of arcdn(x,k) where k=sqrt(m) can be found in NIST Digital Library of Mathematical
+// Functions (http://dlmf.nist.gov/22.15.E14).
+func InverseJacobiDn(x, m float64) float64 {
+	if m < 0 || 1 < m || math.IsNaN(x) || math.IsNaN(m) {
+		return math.NaN()
+	}
+	mc := math.Sqrt(1 - m)
+	if x < mc || 1 < x {
+		return math.NaN()
+	}
+	if x == 1 {
+		return 0
+	}
+	if m == 1 {
+		// dn(u,1) = sech(u).
+		return math.Acosh(1 / x)
+	}
+	return math.Sqrt((1-x)*(1+x)/m) * EllipticRF((x-mc)*(x+mc)/m, x*x, 1)
+}
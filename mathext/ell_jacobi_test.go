@@ -0,0 +1,153 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestJacobiElliptic(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for i, test := range []struct {
+		u, m           float64
+		sn, cn, dn, am float64
+	}{
+		// Results computed with the arithmetic-geometric mean in 45 digit arithmetic.
+		{u: 0.5, m: 0.3, sn: 0.47421562271182063, cn: 0.88040873642646243, dn: 0.96567896474595120, am: 0.49407289371104724},
+		{u: 1, m: 0.5, sn: 0.80300182489564389, cn: 0.59597656767214067, dn: 0.82316100163159627, am: 0.93231507988385387},
+		{u: 2.5, m: 0.9, sn: 0.99969453845058613, cn: 0.024714971010898633, dn: 0.31709580068626359, am: 1.5460788389853683},
+		{u: -3, m: 0.1, sn: -0.22280914078101128, cn: -0.97486208603290522, dn: 0.99751471602099322, am: -2.9168975497550590},
+		{u: 10, m: 0.99, sn: -0.99142074486068203, cn: -0.13070924473766355, dn: 0.16405504440062647, am: 4.5813046521694899},
+		{u: 0.2, m: 0.999999, sn: 0.19737532151658491, cn: 0.98032799738466346, dn: 0.98032801725404251, am: 0.19867984832554043},
+		{u: 7, m: 0.7, sn: -0.89915043310408687, cn: 0.43763969044035871, dn: 0.65883985083942296, am: 5.1653609275097072},
+	} {
+		sn, cn, dn := JacobiElliptic(test.u, test.m)
+		if math.Abs(sn-test.sn) > tol || math.Abs(cn-test.cn) > tol || math.Abs(dn-test.dn) > tol {
+			t.Errorf("test %d JacobiElliptic(%v, %v) failed: got (%v, %v, %v) want (%v, %v, %v)",
+				i, test.u, test.m, sn, cn, dn, test.sn, test.cn, test.dn)
+		}
+		am := JacobiAmplitude(test.u, test.m)
+		if math.Abs(am-test.am) > tol*math.Abs(test.am) {
+			t.Errorf("test %d JacobiAmplitude(%v, %v) failed: got %v want %v", i, test.u, test.m, am, test.am)
+		}
+	}
+}
+
+// Testing JacobiElliptic and JacobiAmplitude using the identities from http://dlmf.nist.gov/22.6.i
+// and the relation to EllipticF.
+func TestJacobiEllipticIdentities(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	for i := 0; i < 10000; i++ {
+		u := 20 * (rnd.Float64() - 0.5)
+		m := rnd.Float64()
+		if i%10 == 0 {
+			m = 1 - math.Pow(10, -16*rnd.Float64())
+		}
+		sn, cn, dn := JacobiElliptic(u, m)
+		if math.Abs(sn*sn+cn*cn-1) > tol {
+			t.Fatalf("sn²+cn² != 1 for u=%v, m=%v: got %v", u, m, sn*sn+cn*cn)
+		}
+		if math.Abs(dn*dn+m*sn*sn-1) > 10*tol {
+			t.Fatalf("dn²+m sn² != 1 for u=%v, m=%v: got %v", u, m, dn*dn+m*sn*sn)
+		}
+
+		phi := (rnd.Float64() - 0.5) * math.Pi
+		u = EllipticF(phi, m)
+		if got := JacobiAmplitude(u, m); math.Abs(got-phi) > tol {
+			t.Fatalf("JacobiAmplitude(EllipticF(%v, %v), %v) != %v: got %v", phi, m, m, phi, got)
+		}
+	}
+
+	for _, u := range []float64{-3, -0.5, 0, 1e-3, 2} {
+		sn, cn, dn := JacobiElliptic(u, 0)
+		if sn != math.Sin(u) || cn != math.Cos(u) || dn != 1 {
+			t.Errorf("unexpected value of JacobiElliptic(%v, 0): got (%v, %v, %v)", u, sn, cn, dn)
+		}
+		sn, cn, dn = JacobiElliptic(u, 1)
+		if sn != math.Tanh(u) || cn != 1/math.Cosh(u) || dn != 1/math.Cosh(u) {
+			t.Errorf("unexpected value of JacobiElliptic(%v, 1): got (%v, %v, %v)", u, sn, cn, dn)
+		}
+	}
+	for _, m := range []float64{-0.1, 1.1, math.NaN()} {
+		sn, cn, dn := JacobiElliptic(1, m)
+		if !math.IsNaN(sn) || !math.IsNaN(cn) || !math.IsNaN(dn) {
+			t.Errorf("unexpected value of JacobiElliptic(1, %v): got (%v, %v, %v)", m, sn, cn, dn)
+		}
+		if am := JacobiAmplitude(1, m); !math.IsNaN(am) {
+			t.Errorf("unexpected value of JacobiAmplitude(1, %v): got %v", m, am)
+		}
+	}
+}
+
+func TestInverseJacobi(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	for i := 0; i < 10000; i++ {
+		m := rnd.Float64()
+		if i%10 == 0 {
+			m = 1
+		}
+		K := CompleteK(m)
+		if m == 1 {
+			// arcsn(x,1) = atanh(x) is ill-conditioned close to 1.
+			K = 3
+		}
+
+		// sn is invertible on [-K,K], but the inverse is ill-conditioned
+		// close to ±K where the derivative of sn vanishes.
+		u := K * (rnd.Float64() - 0.5)
+		sn, _, _ := JacobiElliptic(u, m)
+		if got := InverseJacobiSn(sn, m); math.Abs(got-u) > tol*math.Max(1, K) {
+			t.Fatalf("InverseJacobiSn(sn(%v, %v), %v) != %v: got %v", u, m, m, u, got)
+		}
+		// Check the inverse functions over their full range by the
+		// forward functions.
+		x := 2*rnd.Float64() - 1
+		if got, _, _ := JacobiElliptic(InverseJacobiSn(x, m), m); math.Abs(got-x) > tol {
+			t.Fatalf("sn(InverseJacobiSn(%v, %v), %v) != %v: got %v", x, m, m, x, got)
+		}
+		if m < 1 || x >= 0 {
+			if _, got, _ := JacobiElliptic(InverseJacobiCn(x, m), m); math.Abs(got-x) > tol {
+				t.Fatalf("cn(InverseJacobiCn(%v, %v), %v) != %v: got %v", x, m, m, x, got)
+			}
+		}
+		mc := math.Sqrt(1 - m)
+		x = mc + (1-mc)*rnd.Float64()
+		if _, _, got := JacobiElliptic(InverseJacobiDn(x, m), m); math.Abs(got-x) > tol {
+			t.Fatalf("dn(InverseJacobiDn(%v, %v), %v) != %v: got %v", x, m, m, x, got)
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "InverseJacobiSn(1, 0.5)", got: InverseJacobiSn(1, 0.5), want: CompleteK(0.5)},
+		{name: "InverseJacobiCn(0, 0.5)", got: InverseJacobiCn(0, 0.5), want: CompleteK(0.5)},
+		{name: "InverseJacobiCn(-1, 0.5)", got: InverseJacobiCn(-1, 0.5), want: 2 * CompleteK(0.5)},
+		{name: "InverseJacobiDn(sqrt(0.5), 0.5)", got: InverseJacobiDn(math.Sqrt(0.5), 0.5), want: CompleteK(0.5)},
+		{name: "InverseJacobiDn(1, 0)", got: InverseJacobiDn(1, 0), want: 0},
+		{name: "InverseJacobiSn(1, 1)", got: InverseJacobiSn(1, 1), want: math.Inf(1)},
+		{name: "InverseJacobiSn(1.5, 0.5)", got: InverseJacobiSn(1.5, 0.5), want: math.NaN()},
+		{name: "InverseJacobiCn(-0.5, 1)", got: InverseJacobiCn(-0.5, 1), want: math.NaN()},
+		{name: "InverseJacobiDn(0.5, 0.5)", got: InverseJacobiDn(0.5, 0.5), want: math.NaN()},
+		{name: "InverseJacobiDn(0.5, 0)", got: InverseJacobiDn(0.5, 0), want: math.NaN()},
+	} {
+		if !(math.IsNaN(test.got) && math.IsNaN(test.want)) && math.Abs(test.got-test.want) > 1e-15*math.Abs(test.want) &&
+			test.got != test.want {
+			t.Errorf("unexpected value of %s: got %v want %v", test.name, test.got, test.want)
+		}
+	}
+}
@@ -5,6 +5,8 @@
 package mathext
 
 import (
+	"math"
+
 	"gonum.org/v1/gonum/mathext/internal/cephes"
 )
 
@@ -39,10 +41,22 @@ func GammaIncRegComp(a, x float64) float64 {
 //	GammaIncReg(a, x) = y
 //
 // The input argument a must be positive and y must be between 0 and 1
-// inclusive or GammaIncRegInv will panic. GammaIncRegInv should return a positive
-// number, but can return NaN if there is a failure to converge.
+// inclusive or GammaIncRegInv will panic. GammaIncRegInv returns a positive
+// number with small relative error for all y, including y close to 0 or 1,
+// but returns 0 if the result underflows.
 func GammaIncRegInv(a, y float64) float64 {
-	return gammaIncRegInv(a, y)
+	if !(a > 0) || !(0 <= y && y <= 1) {
+		panic("mathext: parameter out of range")
+	}
+	switch {
+	case y == 0:
+		return 0
+	case y == 1:
+		return math.Inf(1)
+	case y <= 0.5:
+		return gammaIncInv(a, y, false)
+	}
+	return gammaIncInv(a, 1-y, true)
 }
 
 // GammaIncRegCompInv computes the inverse of the complemented regularized incomplete Gamma
@@ -51,8 +65,42 @@ func GammaIncRegInv(a, y float64) float64 {
 //	GammaIncRegComp(a, x) = y
 //
 // The input argument a must be positive and y must be between 0 and 1
-// inclusive or GammaIncRegCompInv will panic. GammaIncRegCompInv should return a
-// positive number, but can return 0 even with non-zero y due to underflow.
+// inclusive or GammaIncRegCompInv will panic. GammaIncRegCompInv returns a
+// positive number with small relative error for all y, including y close to
+// 0 or 1, but returns 0 if the result underflows.
 func GammaIncRegCompInv(a, y float64) float64 {
-	return cephes.IgamI(a, y)
+	if !(a > 0) || !(0 <= y && y <= 1) {
+		panic("mathext: parameter out of range")
+	}
+	switch {
+	case y == 0:
+		return math.Inf(1)
+	case y == 1:
+		return 0
+	case y <= 0.5:
+		return gammaIncInv(a, y, true)
+	}
+	return gammaIncInv(a, 1-y, false)
+}
+
+// GammaIncRegShapeInv computes the inverse of the regularized incomplete Gamma
+// integral with respect to its shape parameter. That is, it returns the a such that:
+//
+//	GammaIncReg(a, x) = y
+//
+// The input argument x must be positive and y must be between 0 and 1 inclusive
+// or GammaIncRegShapeInv will panic. GammaIncReg(a, x) decreases from 1 to 0 as
+// a increases from 0 to infinity, so GammaIncRegShapeInv returns +Inf for y = 0
+// and 0 for y = 1.
+func GammaIncRegShapeInv(x, y float64) float64 {
+	if !(x > 0) || !(0 <= y && y <= 1) {
+		panic("mathext: parameter out of range")
+	}
+	switch {
+	case y == 0:
+		return math.Inf(1)
+	case y == 1:
+		return 0
+	}
+	return gammaIncShapeInv(x, y)
 }
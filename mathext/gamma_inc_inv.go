@@ -14,45 +14,212 @@ import (
 )
 
 const (
-	allowedATol = 1e-306
-	allowedRTol = 1e-6
+	// logMinFloat and logMaxFloat bound the logarithms of the
+	// positive finite float64 values.
+	logMinFloat = -1074 * math.Ln2
+	logMaxFloat = 1024 * math.Ln2
+
+	// invMaxIterations is the maximum number of Newton and bisection
+	// steps of the inversions of the incomplete gamma and beta functions.
+	invMaxIterations = 200
 )
 
-func gammaIncReg(x float64, params []float64) float64 {
-	return cephes.Igam(params[0], x) - params[1]
+// gammaIncInv returns the x such that
+//
+//	GammaIncReg(a, x) = p,     if upper is false,
+//	GammaIncRegComp(a, x) = p, if upper is true,
+//
+// for a > 0 and 0 < p ≤ 0.5, so that p is the smaller of the two tails.
+//
+// The equation log(tail) = log(p) is solved by Newton's method in t = log(x),
+// safeguarded with bisection. The logarithms of both tails are concave
+// functions of t, since the density of log(X) for a gamma distributed X is
+// log-concave, so the iteration converges from any starting point and the
+// relative accuracy of the result is not limited by the size of p.
+func gammaIncInv(a, p float64, upper bool) float64 {
+	logP := math.Log(p)
+
+	// P(a, x) ≤ x^a / Γ(a+1), so the root of the lower tail equation
+	// with value v is bounded below by (v Γ(a+1))^(1/a).
+	lgam, _ := math.Lgamma(a + 1)
+	var tLo float64
+	if upper {
+		tLo = (math.Log1p(-p) + lgam) / a
+	} else {
+		tLo = (logP + lgam) / a
+	}
+	if tLo < logMinFloat {
+		// The root underflows.
+		return 0
+	}
+
+	// Use the Wilson-Hilferty approximation as the starting point
+	// unless it is smaller than the lower bound.
+	d := 1 / (9 * a)
+	z := cephes.Ndtri(p)
+	if upper {
+		z = -z
+	}
+	t := tLo
+	if w := 1 - d + z*math.Sqrt(d); w > 0 {
+		t = math.Max(t, math.Log(a)+3*math.Log(w))
+	}
+	if upper && -logP > a+1 {
+		// For the extreme upper tail use the leading term of the
+		// asymptotic expansion, Q(a, x) ~ x^(a-1) e^-x / Γ(a).
+		lgam -= math.Log(a)
+		x := -logP
+		for i := 0; i < 3 && x > 0; i++ {
+			x = -logP - lgam + (a-1)*math.Log(x)
+		}
+		if x > 0 {
+			t = math.Max(t, math.Log(x))
+		}
+	}
+	t = math.Min(t, logMaxFloat)
+
+	// The bracket is kept in terms of x rather than t since the
+	// Newton steps close to the root may be smaller than the
+	// spacing of the floating point numbers close to t.
+	xLo := math.Exp(tLo)
+	xHi := math.MaxFloat64
+	x := math.Exp(t)
+	var prevStep float64
+	for i := 0; i < invMaxIterations; i++ {
+		var v float64
+		if upper {
+			v = cephes.IgamC(a, x)
+		} else {
+			v = cephes.Igam(a, x)
+		}
+		h := logRatio(v, p)
+		if h == 0 {
+			return x
+		}
+		// The lower tail is increasing in x and the upper tail decreasing.
+		if (h < 0) != upper {
+			xLo = x
+		} else {
+			xHi = x
+		}
+
+		// The derivative of log(tail) with respect to t is
+		// ±x^a e^-x / (Γ(a) tail).
+		slope := cephes.IgamFac(a, x) / v
+		if upper {
+			slope = -slope
+		}
+		step := h / slope
+		xNext := x * math.Exp(-step)
+		if !(xLo < xNext && xNext < xHi) {
+			// Bisect the bracket in t.
+			xNext = math.Sqrt(xLo) * math.Sqrt(xHi)
+			step = math.Log(x / xNext)
+		}
+		if xNext == x || math.Abs(step) <= 4*machEp {
+			return xNext
+		}
+		x = xNext
+		// Stop when the iteration is limited by the accuracy
+		// of the incomplete gamma function.
+		if i > 0 && math.Abs(step) < 1e-8 && math.Abs(step) >= math.Abs(prevStep) {
+			break
+		}
+		prevStep = step
+	}
+	return x
+}
+
+// logRatio returns log(v/p) for positive p. The logarithm of the ratio
+// retains the relative precision of v/p close to the root, unlike the
+// difference of the logarithms.
+func logRatio(v, p float64) float64 {
+	r := v / p
+	if r == 0 || math.IsInf(r, 1) {
+		return math.Log(v) - math.Log(p)
+	}
+	return math.Log(r)
 }
 
-// gammaIncRegInv is the inverse of the regularized incomplete Gamma integral. That is, it
-// returns x such that:
+// gammaIncShapeInv returns the a such that
 //
-//	Igam(a, x) = y
+//	GammaIncReg(a, x) = y
 //
-// The input argument a must be positive and y must be between 0 and 1
-// inclusive or gammaIncRegInv will panic. gammaIncRegInv should return a
-// positive number, but can return NaN if there is a failure to converge.
-func gammaIncRegInv(a, y float64) float64 {
-	// For y not small, we just use
-	//  IgamI(a, 1-y)
-	// (inverse of the complemented incomplete Gamma integral). For y small,
-	// however, 1-y is about 1, and we lose digits.
-	if a <= 0 || y <= 0 || y >= 0.25 {
-		return cephes.IgamI(a, 1-y)
-	}
-
-	lo := 0.0
-	flo := -y
-	hi := cephes.IgamI(a, 0.75)
-	fhi := 0.25 - y
-
-	params := []float64{a, y}
-
-	// Also, after we generate a small interval by bisection above, false
-	// position will do a large step from an interval of width ~1e-4 to ~1e-14
-	// in one step (a=10, x=0.05, but similar for other values).
-	result, bestX, _, errEst := falsePosition(lo, hi, flo, fhi, 2*machEp, 2*machEp, 1e-2*a, gammaIncReg, params)
-	if result == fSolveMaxIterations && errEst > allowedATol+allowedRTol*math.Abs(bestX) {
-		bestX = math.NaN()
+// for x > 0 and 0 < y < 1. GammaIncReg(a, x) is decreasing in a.
+func gammaIncShapeInv(x, y float64) float64 {
+	// Solve for log(a) using the logarithm of the smaller tail.
+	f := func(s float64, _ []float64) float64 {
+		return logRatio(cephes.Igam(math.Exp(s), x), y)
+	}
+	if y > 0.5 {
+		f = func(s float64, _ []float64) float64 {
+			return -logRatio(cephes.IgamC(math.Exp(s), x), 1-y)
+		}
+	}
+	// Start from the shape for which x is close to the median.
+	return logParamRoot(f, math.Log(x+0.5))
+}
+
+// logParamRoot returns exp(s) for the root s of f, which must be decreasing
+// in s, searching outwards from s0. It returns 0 or +Inf if the root is
+// outside the range of the logarithms of the positive finite float64 values,
+// and NaN if f cannot be evaluated near the root or the root could not be
+// found to the required accuracy.
+func logParamRoot(f objectiveFunc, s0 float64) float64 {
+	lo := s0
+	hi := lo
+	flo := f(lo, nil)
+	fhi := flo
+	for flo < 0 {
+		lo -= 1
+		if lo < logMinFloat {
+			return 0
+		}
+		hi, fhi = lo+1, flo
+		flo = f(lo, nil)
+	}
+	for fhi > 0 {
+		hi += 1
+		if hi > logMaxFloat {
+			return math.Inf(1)
+		}
+		lo, flo = hi-1, fhi
+		fhi = f(hi, nil)
+	}
+	if math.IsNaN(flo) || math.IsNaN(fhi) {
+		return math.NaN()
+	}
+	// Shrink the bracket until the function is finite at both ends,
+	// since the tail may underflow at the expanded end.
+	for math.IsInf(flo, 0) || math.IsInf(fhi, 0) {
+		mid := (lo + hi) / 2
+		fmid := f(mid, nil)
+		if math.IsNaN(fmid) || mid == lo || mid == hi {
+			// The function cannot be evaluated
+			// accurately near the root.
+			return math.NaN()
+		}
+		if fmid > 0 {
+			lo, flo = mid, fmid
+		} else {
+			hi, fhi = mid, fmid
+		}
+	}
+	if flo == 0 {
+		return math.Exp(lo)
+	}
+	if fhi == 0 {
+		return math.Exp(hi)
 	}
 
-	return bestX
+	result, bestX, _, errEst := falsePosition(lo, hi, flo, fhi, 2*machEp, 2*machEp, 0, f, nil)
+	if result == fSolveMaxIterations && errEst > allowedATol+allowedRTol*math.Abs(bestX) {
+		return math.NaN()
+	}
+	return math.Exp(bestX)
 }
+
+const (
+	allowedATol = 1e-306
+	allowedRTol = 1e-6
+)
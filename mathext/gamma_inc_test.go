@@ -7,6 +7,8 @@ package mathext
 import (
 	"math"
 	"testing"
+
+	"gonum.org/v1/gonum/mathext/internal/cephes"
 )
 
 func TestGammaIncReg(t *testing.T) {
@@ -140,3 +142,109 @@ func TestGammaIncRegCompInv(t *testing.T) {
 		}
 	}
 }
+
+func TestGammaIncRegInvTails(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	for i, test := range []struct {
+		a, p, want float64
+	}{
+		// Results computed with 90 digit arithmetic.
+		{a: 0.1, p: 1e-20, want: 6.0730483624078825e-201},
+		{a: 0.5, p: 1e-100, want: 7.8539816339744831e-201},
+		{a: 2.5, p: 1e-50, want: 1.6167038902915642e-20},
+		{a: 3, p: 1e-300, want: 1.8171205928321397e-100},
+		{a: 10, p: 1e-300, want: 4.5287286881167648e-30},
+		{a: 100, p: 1e-300, want: 0.038006988916941887},
+		{a: 1000, p: 1e-300, want: 233.92836429052843},
+		{a: 0.01, p: 1e-5, want: 0}, // The result underflows.
+	} {
+		got := GammaIncRegInv(test.a, test.p)
+		if math.Abs(got-test.want) > tol*test.want {
+			t.Errorf("test %d GammaIncRegInv(%g, %g) failed: got %g want %g", i, test.a, test.p, got, test.want)
+		}
+	}
+	for i, test := range []struct {
+		a, q, want float64
+	}{
+		// Results computed with 90 digit arithmetic.
+		{a: 0.01, q: 0.3, want: 1.8309524563808437e-16},
+		{a: 0.1, q: 1e-50, want: 108.64905552874366},
+		{a: 0.5, q: 1e-300, want: 686.93631561119707},
+		{a: 2.5, q: 1e-200, want: 469.46291311867465},
+		{a: 3, q: 1e-300, want: 703.19649760046135},
+		{a: 10, q: 1e-100, want: 267.80299764163213},
+		{a: 100, q: 1e-300, want: 1017.3104288547139},
+		{a: 1000, q: 1e-300, want: 2666.7520733019953},
+	} {
+		got := GammaIncRegCompInv(test.a, test.q)
+		if math.Abs(got-test.want) > tol*test.want {
+			t.Errorf("test %d GammaIncRegCompInv(%g, %g) failed: got %g want %g", i, test.a, test.q, got, test.want)
+		}
+	}
+
+	// For a = 1, P(1, x) = 1 - e^-x.
+	for _, p := range []float64{1e-300, 1e-100, 1e-20, 1e-5, 0.25, 0.5, 0.75, 1 - 1e-10} {
+		if got, want := GammaIncRegInv(1, p), -math.Log1p(-p); math.Abs(got-want) > tol*want {
+			t.Errorf("unexpected value of GammaIncRegInv(1, %g): got %g want %g", p, got, want)
+		}
+		if got, want := GammaIncRegCompInv(1, p), -math.Log(p); math.Abs(got-want) > tol*want {
+			t.Errorf("unexpected value of GammaIncRegCompInv(1, %g): got %g want %g", p, got, want)
+		}
+	}
+}
+
+func TestGammaIncRegInvRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, a := range []float64{1e-3, 0.1, 0.5, 1, 2.5, 10, 100, 1e4, 1e6} {
+		for _, p := range []float64{1e-300, 1e-200, 1e-100, 1e-50, 1e-20, 1e-10, 1e-5, 0.01, 0.1, 0.3, 0.5} {
+			for _, upper := range []bool{false, true} {
+				var x, got float64
+				if upper {
+					x = GammaIncRegCompInv(a, p)
+					got = GammaIncRegComp(a, x)
+				} else {
+					x = GammaIncRegInv(a, p)
+					got = GammaIncReg(a, x)
+				}
+				if x == 0 {
+					// The result underflows, check that it is
+					// smaller than the smallest normal number.
+					if !upper && GammaIncReg(a, 0x1p-1022) < p {
+						t.Errorf("unexpected underflow of GammaIncRegInv(%g, %g)", a, p)
+					}
+					continue
+				}
+				// The relative error of the tail is bounded by the
+				// relative error of x times d log(tail)/d log(x).
+				tol := 1e-13 * math.Max(1, cephes.IgamFac(a, x)/got)
+				if math.Abs(got-p) > tol*p {
+					t.Errorf("round trip failed for a=%g, p=%g, upper=%t: got %g", a, p, upper, got)
+				}
+			}
+		}
+	}
+}
+
+func TestGammaIncRegShapeInv(t *testing.T) {
+	t.Parallel()
+	for _, a := range []float64{1e-3, 0.1, 0.5, 1, 2.5, 10, 100, 1e4} {
+		for _, x := range []float64{1e-10, 0.01, 1, 10, 0.5 * a, a, 2 * a} {
+			y := GammaIncReg(a, x)
+			if y == 0 || y > 0.99 {
+				// The shape is not determined by y to within
+				// the tolerance close to 1.
+				continue
+			}
+			if got := GammaIncRegShapeInv(x, y); math.Abs(got-a) > 1e-12*a {
+				t.Errorf("unexpected value of GammaIncRegShapeInv(%g, %g): got %g want %g", x, y, got, a)
+			}
+		}
+	}
+	if got := GammaIncRegShapeInv(1, 0); !math.IsInf(got, 1) {
+		t.Errorf("unexpected value of GammaIncRegShapeInv(1, 0): got %g want +Inf", got)
+	}
+	if got := GammaIncRegShapeInv(1, 1); got != 0 {
+		t.Errorf("unexpected value of GammaIncRegShapeInv(1, 1): got %g want 0", got)
+	}
+}
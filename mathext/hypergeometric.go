@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mathext/internal/cephes"
+)
+
+// Hyp1F1 returns the value of the confluent hypergeometric function of the
+// first kind, also known as Kummer's function M(a, b, x),
+//
+//	₁F₁(a; b; x) = \sum_{k=0}^∞ (a)_k / (b)_k x^k / k!,
+//
+// where (a)_k = a(a+1)...(a+k-1) is the rising factorial.
+//
+// For negative x, unless a is a non-positive integer, Kummer's transformation
+//
+//	₁F₁(a; b; x) = e^x ₁F₁(b-a; b; -x)
+//
+// is used to avoid cancellation in the alternating power series. The power
+// series and an asymptotic expansion for large |x| are evaluated, and the
+// result with the smaller estimated error is returned.
+//
+// Special cases are:
+//
+//	Hyp1F1(a, b, x) = NaN if a, b or x is NaN
+//	Hyp1F1(a, b, x) = +Inf if b is a non-positive integer, unless a is a
+//	                  non-positive integer greater than b.
+//
+// See https://dlmf.nist.gov/13.2 for more detailed information.
+func Hyp1F1(a, b, x float64) float64 {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x < 0 && !isNonPosInt(a) {
+		return math.Exp(x) * cephes.Hyperg(b-a, b, -x)
+	}
+	return cephes.Hyperg(a, b, x)
+}
+
+// Hyp2F1 returns the value of the Gauss hypergeometric function
+//
+//	₂F₁(a, b; c; x) = \sum_{k=0}^∞ (a)_k (b)_k / (c)_k x^k / k!,
+//
+// where (a)_k = a(a+1)...(a+k-1) is the rising factorial, for real x ≤ 1.
+//
+// The defining power series is used for |x| < 1 after reducing the
+// argument with the linear transformations of the function. The
+// transformations x → x/(x-1) and x → 1/x are used for x < -1, and the
+// transformation x → 1-x for x near 1, including the logarithmic cases
+// where c-a-b is an integer.
+//
+// Special cases are:
+//
+//	Hyp2F1(a, b, c, x) = NaN if a, b, c or x is NaN
+//	Hyp2F1(a, b, c, x) = NaN if x > 1, unless a or b is a non-positive integer
+//	Hyp2F1(a, b, c, 1) = +Inf if c-a-b ≤ 0, unless a or b is a non-positive integer
+//	Hyp2F1(a, b, c, x) = +Inf if c is a non-positive integer, unless a or b is
+//	                     a non-positive integer greater than c.
+//
+// See https://dlmf.nist.gov/15.2 for more detailed information.
+func Hyp2F1(a, b, c, x float64) float64 {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsNaN(c) || math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x > 1 && !isNonPosInt(a) && !isNonPosInt(b) {
+		// The function is complex valued.
+		return math.NaN()
+	}
+	return cephes.Hyp2f1(a, b, c, x)
+}
+
+// isNonPosInt returns whether x is a non-positive integer.
+func isNonPosInt(x float64) bool {
+	return x <= 0 && x == math.Trunc(x)
+}
@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestHyp1F1(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	for i, test := range []struct {
+		a, b, x, want float64
+	}{
+		// Results computed by summing the series with 80 digit arithmetic.
+		{a: 1, b: 2, x: 0.5, want: 1.2974425414002563},
+		{a: 0.5, b: 1.5, x: -2, want: 0.59814400666130410},
+		{a: 2.5, b: 1.5, x: 10, want: 168869.57109351816},
+		{a: -3, b: 2, x: 4.5, want: 0.5781250},
+		{a: -2.5, b: 3.5, x: 6, want: 0.099998926790731170},
+		{a: 1.5, b: 2.5, x: -30, want: 0.0080901079689773247},
+		{a: 0.1, b: 0.2, x: -0.3, want: 0.86879130796728063},
+		{a: 3, b: 1.5, x: -40, want: 0.0000071960339012819882},
+		{a: -0.5, b: 0.5, x: -5, want: 3.9638610431042209},
+		{a: 10, b: 3, x: 2, want: 141.71088859081424},
+		{a: 0.25, b: 5, x: 50, want: 315249338712329.97},
+		{a: 2, b: 4, x: -80, want: 0.00091406250000000000},
+		{a: -4.5, b: 1, x: -3, want: 88.253698719192049},
+		{a: 1, b: 1, x: 700, want: 1.0142320547350045e+304},
+		{a: 0.5, b: 0.5, x: 1e-10, want: 1.0000000001000000},
+		{a: -10, b: 1, x: 1, want: 0.41894593253968254},
+		{a: 5, b: -2.5, x: 1.5, want: -2611.8550493141155},
+		{a: -1.5, b: -2.5, x: 2, want: 1.4778112197861300},
+	} {
+		got := Hyp1F1(test.a, test.b, test.x)
+		if !scalar.EqualWithinRel(got, test.want, tol) {
+			t.Errorf("test %d Hyp1F1(%g, %g, %g) failed: got %g want %g", i, test.a, test.b, test.x, got, test.want)
+		}
+	}
+}
+
+func TestHyp1F1Special(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	for _, x := range []float64{-20, -3.5, -1, -1e-5, 0, 1e-5, 0.5, 2, 15} {
+		// ₁F₁(a; a; x) = e^x.
+		if got, want := Hyp1F1(2.5, 2.5, x), math.Exp(x); !scalar.EqualWithinRel(got, want, tol) {
+			t.Errorf("unexpected value of Hyp1F1(2.5, 2.5, %g): got %g want %g", x, got, want)
+		}
+		// ₁F₁(1; 2; x) = (e^x - 1)/x.
+		want := 1.0
+		if x != 0 {
+			want = math.Expm1(x) / x
+		}
+		if got := Hyp1F1(1, 2, x); !scalar.EqualWithinRel(got, want, tol) {
+			t.Errorf("unexpected value of Hyp1F1(1, 2, %g): got %g want %g", x, got, want)
+		}
+		// ₁F₁(1/2; 3/2; -x²) = √π erf(x) / 2x.
+		if x > 0 && x < 5 {
+			got := Hyp1F1(0.5, 1.5, -x*x)
+			want := math.Sqrt(math.Pi) * math.Erf(x) / (2 * x)
+			if !scalar.EqualWithinRel(got, want, tol) {
+				t.Errorf("unexpected value of Hyp1F1(0.5, 1.5, %g): got %g want %g", -x*x, got, want)
+			}
+		}
+	}
+
+	if got := Hyp1F1(1.5, -2, 0.5); !math.IsInf(got, 1) {
+		t.Errorf("unexpected value of Hyp1F1 at pole: got %g want +Inf", got)
+	}
+	for _, test := range [][3]float64{
+		{math.NaN(), 1, 1},
+		{1, math.NaN(), 1},
+		{1, 1, math.NaN()},
+	} {
+		if got := Hyp1F1(test[0], test[1], test[2]); !math.IsNaN(got) {
+			t.Errorf("unexpected value of Hyp1F1(%g, %g, %g): got %g want NaN", test[0], test[1], test[2], got)
+		}
+	}
+}
+
+func TestHyp2F1(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	for i, test := range []struct {
+		a, b, c, x, want float64
+	}{
+		// Results computed by summing the series with 80 digit arithmetic.
+		{a: 1, b: 1, c: 2, x: 0.5, want: 1.3862943611198906},
+		{a: 0.5, b: 0.5, c: 1.5, x: 0.25, want: 1.0471975511965977},
+		{a: 2.5, b: 1.5, c: 3, x: -0.75, want: 0.49080989779384925},
+		{a: 1.5, b: -0.5, c: 2.5, x: 0.95, want: 0.62426734430721598},
+		{a: 1, b: 2, c: 3, x: 0.99, want: 7.3771455687952074},
+		{a: -3, b: 2, c: 4, x: 0.7, want: 0.32240},
+		{a: 0.3, b: 0.7, c: 1.1, x: -3, want: 0.74886773097021626},
+		{a: 1.25, b: 2.5, c: 3.5, x: -10, want: 0.085120548179118518},
+		{a: 1, b: 1, c: 3, x: 0.995, want: 1.9565332454579628},
+		{a: 2, b: 3, c: 5, x: 0.999, want: 53.295718788451872},
+		{a: 0.5, b: 1, c: 1.5, x: -100, want: 0.14711276743037346},
+		{a: 3, b: -2.5, c: 1.5, x: 0.6, want: -0.19001042824527556},
+		{a: 1, b: 1, c: 1.5, x: 0.9, want: 4.1634859079941814},
+		{a: -0.5, b: 1.5, c: 0.75, x: 0.3, want: 0.65988840010607018},
+		{a: 2, b: 2, c: 4, x: -0.9, want: 0.50515448477320865},
+		{a: 1.5, b: 1.5, c: 3, x: 0.98, want: 7.2334177575579842},
+		{a: 0.2, b: 0.3, c: -0.5, x: 0.4, want: 0.89892488997386476},
+		{a: 5, b: 7, c: 3.5, x: 0.2, want: 8.7182338697603534},
+		{a: 10, b: -10.5, c: 3, x: 0.5, want: 0.0024580884988523617},
+	} {
+		got := Hyp2F1(test.a, test.b, test.c, test.x)
+		if !scalar.EqualWithinRel(got, test.want, tol) {
+			t.Errorf("test %d Hyp2F1(%g, %g, %g, %g) failed: got %g want %g", i, test.a, test.b, test.c, test.x, got, test.want)
+		}
+	}
+}
+
+func TestHyp2F1Special(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	for _, x := range []float64{-50, -2, -0.9, -0.5, -1e-5, 0, 1e-5, 0.3, 0.6, 0.95} {
+		// ₂F₁(1, 1; 2; x) = -log(1-x)/x.
+		want := 1.0
+		if x != 0 {
+			want = -math.Log1p(-x) / x
+		}
+		if got := Hyp2F1(1, 1, 2, x); !scalar.EqualWithinRel(got, want, tol) {
+			t.Errorf("unexpected value of Hyp2F1(1, 1, 2, %g): got %g want %g", x, got, want)
+		}
+		// ₂F₁(a, b; b; x) = (1-x)^-a.
+		if got, want := Hyp2F1(1.3, 0.7, 0.7, x), math.Pow(1-x, -1.3); !scalar.EqualWithinRel(got, want, tol) {
+			t.Errorf("unexpected value of Hyp2F1(1.3, 0.7, 0.7, %g): got %g want %g", x, got, want)
+		}
+		// ₂F₁(1/2, 1; 3/2; -x²) = atan(x)/x.
+		if x > 0 {
+			z := math.Sqrt(x)
+			got := Hyp2F1(0.5, 1, 1.5, -x)
+			want := math.Atan(z) / z
+			if !scalar.EqualWithinRel(got, want, tol) {
+				t.Errorf("unexpected value of Hyp2F1(0.5, 1, 1.5, %g): got %g want %g", -x, got, want)
+			}
+		}
+	}
+
+	// Gauss's theorem, ₂F₁(a, b; c; 1) = Γ(c)Γ(c-a-b) / (Γ(c-a)Γ(c-b)).
+	got := Hyp2F1(0.5, 1.25, 3, 1)
+	want := math.Gamma(3) * math.Gamma(1.25) / (math.Gamma(2.5) * math.Gamma(1.75))
+	if !scalar.EqualWithinRel(got, want, tol) {
+		t.Errorf("unexpected value of Hyp2F1(0.5, 1.25, 3, 1): got %g want %g", got, want)
+	}
+	if got := Hyp2F1(1, 2, 3, 1); !math.IsInf(got, 1) {
+		t.Errorf("unexpected value of Hyp2F1(1, 2, 3, 1): got %g want +Inf", got)
+	}
+	// The series terminates for non-positive integer a, so the function
+	// is a polynomial defined for all x.
+	if got := Hyp2F1(-1, 2, 2, 3); got != -2 {
+		t.Errorf("unexpected value of Hyp2F1(-1, 2, 2, 3): got %g want -2", got)
+	}
+	if got := Hyp2F1(1, 2, 3, 1.5); !math.IsNaN(got) {
+		t.Errorf("unexpected value of Hyp2F1(1, 2, 3, 1.5): got %g want NaN", got)
+	}
+	for _, test := range [][4]float64{
+		{math.NaN(), 1, 1, 0.5},
+		{1, math.NaN(), 1, 0.5},
+		{1, 1, math.NaN(), 0.5},
+		{1, 1, 1, math.NaN()},
+	} {
+		if got := Hyp2F1(test[0], test[1], test[2], test[3]); !math.IsNaN(got) {
+			t.Errorf("unexpected value of Hyp2F1(%g, %g, %g, %g): got %g want NaN", test[0], test[1], test[2], test[3], got)
+		}
+	}
+}
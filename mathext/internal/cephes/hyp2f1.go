@@ -0,0 +1,454 @@
+// Derived from SciPy's special/cephes/hyp2f1.c
+// https://github.com/scipy/scipy/blob/master/scipy/special/cephes/hyp2f1.c
+// Made freely available by Stephen L. Moshier without support or guarantee.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Copyright ©1984, ©1987, ©1992, ©2000 by Stephen L. Moshier
+// Portions Copyright ©2026 The Gonum Authors. All rights reserved.
+
+package cephes
+
+import "math"
+
+const (
+	hypEps        = 1e-13
+	hypEthresh    = 1e-12
+	hypMaxIter    = 10000
+	hypMaxRecurse = 10000
+)
+
+// Hyp2f1 computes the Gauss hypergeometric function
+//
+//	                     inf.
+//	                      -   a(i) b(i) x^i
+//	2F1(a,b;c;x)  =       >   -------------
+//	                      -    c(i) i!
+//	                     i=0
+//
+// where a(i) is the rising factorial a(a+1)...(a+i-1).
+//
+// The function is defined for real x < 1, and for x == 1 when
+// c-a-b > 0 or the series terminates. Cases addressed are:
+//
+//	Tests and escapes for negative integer a, b, or c.
+//	Linear transformation if c-a or c-b negative integer.
+//	Special case c = a or c = b.
+//	Linear transformation for x near +1.
+//	Transformation for x < -0.5.
+//	Psi function expansion if x > 0.5 and c-a-b integer.
+//	Conditionally, a recurrence on c to make c-a-b > 0.
+//
+// +Inf is returned when the function is not defined or the series
+// diverges.
+func Hyp2f1(a, b, c, x float64) float64 {
+	var err float64
+	ax := math.Abs(x)
+	s := 1 - x
+	ia := math.Round(a) // Nearest integer to a.
+	ib := math.Round(b)
+
+	if x == 0 {
+		return 1
+	}
+
+	d := c - a - b
+	id := math.Round(d)
+
+	if (a == 0 || b == 0) && c != 0 {
+		return 1
+	}
+
+	negIntA := a <= 0 && math.Abs(a-ia) < hypEps // a is a negative integer.
+	negIntB := b <= 0 && math.Abs(b-ib) < hypEps // b is a negative integer.
+
+	if d <= -1 && !(math.Abs(d-id) > hypEps && s < 0) && !(negIntA || negIntB) {
+		return math.Pow(s, d) * Hyp2f1(c-a, c-b, c, x)
+	}
+	if d <= 0 && x == 1 && !(negIntA || negIntB) {
+		return math.Inf(1)
+	}
+
+	if ax < 1 || x == -1 {
+		// 2F1(a,b;b;x) = (1-x)**(-a)
+		if math.Abs(b-c) < hypEps {
+			return math.Pow(s, -a)
+		}
+		if math.Abs(a-c) < hypEps {
+			return math.Pow(s, -b)
+		}
+	}
+
+	if c <= 0 {
+		ic := math.Round(c) // Nearest integer to c.
+		if math.Abs(c-ic) < hypEps {
+			// c is a negative integer. Check if
+			// termination occurs before explosion.
+			if (negIntA && ia > ic) || (negIntB && ib > ic) {
+				y, _ := hyt2f1(a, b, c, x)
+				return y
+			}
+			return math.Inf(1)
+		}
+	}
+
+	if negIntA || negIntB {
+		// The function is a polynomial.
+		y, _ := hyt2f1(a, b, c, x)
+		return y
+	}
+
+	t1 := math.Abs(b - a)
+	if x < -2 && math.Abs(t1-math.Round(t1)) > hypEps {
+		// This transform has a pole for b-a integer, and may
+		// produce large cancellation errors for |1/x| close 1.
+		p := Hyp2f1(a, 1-c+a, 1-b+a, 1/x)
+		q := Hyp2f1(b, 1-c+b, 1-a+b, 1/x)
+		p *= math.Pow(-x, -a)
+		q *= math.Pow(-x, -b)
+		t1 = math.Gamma(c)
+		s = t1 * math.Gamma(b-a) / (math.Gamma(b) * math.Gamma(c-a))
+		y := t1 * math.Gamma(a-b) / (math.Gamma(a) * math.Gamma(c-b))
+		return s*p + y*q
+	} else if x < -1 {
+		if math.Abs(a) < math.Abs(b) {
+			return math.Pow(s, -a) * Hyp2f1(a, c-b, c, x/(x-1))
+		}
+		return math.Pow(s, -b) * Hyp2f1(b, c-a, c, x/(x-1))
+	}
+
+	if ax > 1 {
+		// The series diverges.
+		return math.Inf(1)
+	}
+
+	p := c - a
+	ia = math.Round(p) // Nearest integer to c-a.
+	negIntCAOrCB := ia <= 0 && math.Abs(p-ia) < hypEps
+
+	r := c - b
+	ib = math.Round(r) // Nearest integer to c-b.
+	if ib <= 0 && math.Abs(r-ib) < hypEps {
+		negIntCAOrCB = true
+	}
+
+	id = math.Round(d) // Nearest integer to d.
+
+	if math.Abs(ax-1) < hypEps {
+		// |x| == 1
+		if x > 0 {
+			if negIntCAOrCB {
+				if d >= 0 {
+					// The transformation for c-a or c-b
+					// negative integer, AMS55 #15.3.3.
+					y, _ := hys2f1(c-a, c-b, c, x)
+					return math.Pow(s, d) * y
+				}
+				return math.Inf(1)
+			}
+			if d <= 0 {
+				return math.Inf(1)
+			}
+			return math.Gamma(c) * math.Gamma(d) / (math.Gamma(p) * math.Gamma(r))
+		}
+
+		if d <= -1 {
+			return math.Inf(1)
+		}
+	}
+
+	// Conditionally make d > 0 by recurrence on c, AMS55 #15.2.27.
+	if d < 0 {
+		// Try the power series first.
+		var y float64
+		y, err = hyt2f1(a, b, c, x)
+		if err < hypEthresh {
+			return y
+		}
+		// Apply the recurrence if power series fails.
+		aid := int(2 - id)
+		e := c + float64(aid)
+		d2 := Hyp2f1(a, b, e, x)
+		d1 := Hyp2f1(a, b, e+1, x)
+		q := a + b + 1
+		for i := 0; i < aid; i++ {
+			r := e - 1
+			y = (e*(r-(2*e-q)*x)*d2 + (e-a)*(e-b)*x*d1) / (e * r * s)
+			e = r
+			d1 = d2
+			d2 = y
+		}
+		return y
+	}
+
+	if negIntCAOrCB {
+		// The transformation for c-a or c-b negative integer,
+		// AMS55 #15.3.3.
+		y, _ := hys2f1(c-a, c-b, c, x)
+		return math.Pow(s, d) * y
+	}
+
+	y, _ := hyt2f1(a, b, c, x)
+	return y
+}
+
+// hyt2f1 applies transformations for |x| near 1 and then calls the power
+// series. It returns the result and an estimate of its relative error.
+func hyt2f1(a, b, c, x float64) (y, loss float64) {
+	ia := math.Round(a)
+	ib := math.Round(b)
+
+	negIntA := a <= 0 && math.Abs(a-ia) < hypEps // a is a negative integer.
+	negIntB := b <= 0 && math.Abs(b-ib) < hypEps // b is a negative integer.
+
+	var err float64
+	s := 1 - x
+	if x < -0.5 && !(negIntA || negIntB) {
+		if b > a {
+			y, err = hys2f1(a, c-b, c, -x/s)
+			y *= math.Pow(s, -a)
+		} else {
+			y, err = hys2f1(c-a, b, c, -x/s)
+			y *= math.Pow(s, -b)
+		}
+		return y, err
+	}
+
+	d := c - a - b
+	id := math.Round(d) // Nearest integer to d.
+
+	if x > 0.9 && !(negIntA || negIntB) {
+		if math.Abs(d-id) > hypEps {
+			// c-a-b is not an integer. Try the power series first.
+			y, err = hys2f1(a, b, c, x)
+			if err < hypEthresh {
+				return y, err
+			}
+			// If power series fails, then apply AMS55 #15.3.6.
+			q, err := hys2f1(a, b, 1-d, s)
+			w, sign := lgamSgn(d)
+			lg, sg := lgamSgn(c - a)
+			w -= lg
+			sign *= sg
+			lg, sg = lgamSgn(c - b)
+			w -= lg
+			sign *= sg
+			q *= sign * math.Exp(w)
+
+			r, err1 := hys2f1(c-a, c-b, d+1, s)
+			r *= math.Pow(s, d)
+			w, sign = lgamSgn(-d)
+			lg, sg = lgamSgn(a)
+			w -= lg
+			sign *= sg
+			lg, sg = lgamSgn(b)
+			w -= lg
+			sign *= sg
+			r *= sign * math.Exp(w)
+			y = q + r
+
+			// Estimate cancellation error.
+			q = math.Abs(q)
+			r = math.Abs(r)
+			if q > r {
+				r = q
+			}
+			err += err1 + (machEp*r)/y
+
+			y *= math.Gamma(c)
+			return y, err
+		}
+
+		// Psi function expansion, AMS55 #15.3.10, #15.3.11, #15.3.12.
+		//
+		// Although AMS55 does not explicitly state it, this expansion
+		// fails for negative integer a or b, since the psi and Gamma
+		// functions involved have poles.
+		var e, d1, d2 float64
+		var aid int
+		if id >= 0 {
+			e = d
+			d1 = d
+			d2 = 0
+			aid = int(id)
+		} else {
+			e = -d
+			d1 = 0
+			d2 = d
+			aid = int(-id)
+		}
+
+		ax := math.Log(s)
+
+		// Sum for t = 0.
+		y = psi(1) + psi(1+e) - psi(a+d1) - psi(b+d1) - ax
+		y /= math.Gamma(e + 1)
+
+		p := (a + d1) * (b + d1) * s / math.Gamma(e+2) // Poch for t=1.
+		t := 1.0
+		for {
+			r := psi(1+t) + psi(1+t+e) - psi(a+t+d1) - psi(b+t+d1) - ax
+			q := p * r
+			y += q
+			p *= s * (a + t + d1) / (t + 1)
+			p *= (b + t + d1) / (t + 1 + e)
+			t++
+			if t > hypMaxIter {
+				// Should never happen.
+				return math.NaN(), 1
+			}
+			if y != 0 && math.Abs(q/y) <= hypEps {
+				break
+			}
+		}
+
+		if id == 0 {
+			y *= math.Gamma(c) / (math.Gamma(a) * math.Gamma(b))
+			return y, err
+		}
+
+		y1 := 1.0
+		if aid != 1 {
+			t = 0
+			p = 1
+			for i := 1; i < aid; i++ {
+				r := 1 - e + t
+				p *= s * (a + t + d2) * (b + t + d2) / r
+				t++
+				p /= t
+				y1 += p
+			}
+		}
+		p = math.Gamma(c)
+		y1 *= math.Gamma(e) * p / (math.Gamma(a+d1) * math.Gamma(b+d1))
+
+		y *= p / (math.Gamma(a+d2) * math.Gamma(b+d2))
+		if aid&1 != 0 {
+			y = -y
+		}
+
+		q := math.Pow(s, id) // s to the id power.
+		if id > 0 {
+			y *= q
+		} else {
+			y1 *= q
+		}
+		y += y1
+		return y, err
+	}
+
+	// Use defining power series if no special cases.
+	return hys2f1(a, b, c, x)
+}
+
+// hys2f1 computes the defining power series expansion of the Gauss
+// hypergeometric function and an estimate of its loss of significance.
+func hys2f1(a, b, c, x float64) (s, loss float64) {
+	if math.Abs(b) > math.Abs(a) {
+		// Ensure that |a| > |b| ...
+		a, b = b, a
+	}
+
+	ib := math.Round(b)
+	var intFlag bool
+	if math.Abs(b-ib) < hypEps && ib <= 0 && math.Abs(b) < math.Abs(a) {
+		// ... except when b is a smaller negative integer.
+		a, b = b, a
+		intFlag = true
+	}
+
+	if (math.Abs(a) > math.Abs(c)+1 || intFlag) && math.Abs(c-a) > 2 && math.Abs(a) > 2 {
+		// |a| >> |c| implies that large cancellation error is to
+		// be expected. Try to reduce it with the recurrence
+		// relations.
+		return hyp2f1ra(a, b, c, x)
+	}
+
+	var i int
+	var umax, k float64
+	s = 1
+	u := 1.0
+	for {
+		if math.Abs(c+k) < hypEps {
+			return math.Inf(1), 1
+		}
+		m := k + 1
+		u *= (a + k) * (b + k) * x / ((c + k) * m)
+		s += u
+		k = math.Abs(u) // Remember largest term summed.
+		if k > umax {
+			umax = k
+		}
+		k = m
+		i++
+		if i > hypMaxIter {
+			// Should never happen.
+			return s, 1
+		}
+		if s != 0 && math.Abs(u/s) <= machEp {
+			break
+		}
+	}
+
+	// Return estimated relative error.
+	return s, (machEp*umax)/math.Abs(s) + machEp*float64(i)
+}
+
+// hyp2f1ra evaluates the hypergeometric function by two-term recurrence
+// in a, AMS55 #15.2.10.
+//
+// This avoids some of the loss of precision in the strongly alternating
+// hypergeometric series, and can be used to reduce the a and b parameters
+// to smaller values.
+func hyp2f1ra(a, b, c, x float64) (f0, loss float64) {
+	// Don't cross c or zero.
+	var da float64
+	if (c < 0 && a <= c) || (c >= 0 && a >= c) {
+		da = math.Round(a - c)
+	} else {
+		da = math.Round(a)
+	}
+	t := a - da
+
+	if math.Abs(da) > hypMaxRecurse {
+		// Too expensive to compute this value, so give up.
+		return math.NaN(), 1
+	}
+
+	var f1, f2, err float64
+	if da < 0 {
+		// Recurse down.
+		f1, err = hys2f1(t, b, c, x)
+		loss += err
+		f0, err = hys2f1(t-1, b, c, x)
+		loss += err
+		t--
+		for n := 1; n < int(-da); n++ {
+			f2 = f1
+			f1 = f0
+			f0 = -(2*t-c-t*x+b*x)/(c-t)*f1 - t*(x-1)/(c-t)*f2
+			t--
+		}
+	} else {
+		// Recurse up.
+		f1, err = hys2f1(t, b, c, x)
+		loss += err
+		f0, err = hys2f1(t+1, b, c, x)
+		loss += err
+		t++
+		for n := 1; n < int(da); n++ {
+			f2 = f1
+			f1 = f0
+			f0 = -((2*t-c-t*x+b*x)*f1 + (c-t)*f2) / (t * (x - 1))
+			t++
+		}
+	}
+	return f0, loss
+}
+
+// lgamSgn returns the natural logarithm of the absolute value of the gamma
+// function of x and the sign of the gamma function.
+func lgamSgn(x float64) (float64, float64) {
+	lg, sign := math.Lgamma(x)
+	return lg, float64(sign)
+}
@@ -0,0 +1,280 @@
+// Derived from SciPy's special/cephes/hyperg.c
+// https://github.com/scipy/scipy/blob/master/scipy/special/cephes/hyperg.c
+// Made freely available by Stephen L. Moshier without support or guarantee.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Copyright ©1984, ©1987, ©1988 by Stephen L. Moshier
+// Portions Copyright ©2026 The Gonum Authors. All rights reserved.
+
+package cephes
+
+import "math"
+
+// Hyperg computes the confluent hypergeometric function
+//
+//	                        1           2
+//	                     a x    a(a+1) x
+//	 F ( a,b;x )  =  1 + ---- + --------- + ...
+//	1 1                  b 1!   b(b+1) 2!
+//
+// Many higher transcendental functions are special cases of
+// this power series.
+//
+// As is evident from the formula, b must not be a negative
+// integer or zero unless a is an integer with 0 >= a > b.
+//
+// The routine attempts both a direct summation of the series
+// and an asymptotic expansion. In each case error due to
+// roundoff, cancellation, and nonconvergence is estimated.
+// The result with smaller estimated error is returned.
+func Hyperg(a, b, x float64) float64 {
+	// See if a Kummer transformation will help.
+	temp := b - a
+	if math.Abs(temp) < 0.001*math.Abs(a) {
+		return math.Exp(x) * Hyperg(temp, b, -x)
+	}
+
+	psum, pcanc := hy1f1p(a, b, x)
+	if pcanc < 1e-15 {
+		return psum
+	}
+
+	// Try asymptotic series.
+	asum, acanc := hy1f1a(a, b, x)
+
+	// Pick the result with less estimated error.
+	if acanc < pcanc {
+		psum = asum
+	}
+	return psum
+}
+
+// hy1f1p computes the power series summation for the confluent
+// hypergeometric function and an estimate of its relative error.
+func hy1f1p(a, b, x float64) (sum, err float64) {
+	an := a
+	bn := b
+	a0 := 1.0
+	sum = 1.0
+	var c float64
+	n := 1.0
+	t := 1.0
+	var maxt float64
+	err = 1
+
+	maxn := 200 + 2*math.Abs(a) + 2*math.Abs(b)
+
+	for t > machEp {
+		if bn == 0 {
+			// Check bn first since if both an and bn are
+			// zero it is a singularity.
+			return math.Inf(1), err
+		}
+		if an == 0 {
+			// The series terminates.
+			break
+		}
+		if n > maxn {
+			// Too many terms; take the last one as error estimate.
+			c = math.Abs(c) + math.Abs(t)*50
+			break
+		}
+		u := x * (an / (bn * n))
+
+		// Check for blowup.
+		temp := math.Abs(u)
+		if temp > 1 && maxt > math.MaxFloat64/temp {
+			// Blowup: estimate 100% error.
+			return sum, 1
+		}
+
+		a0 *= u
+
+		y := a0 - c
+		sumc := sum + y
+		c = (sumc - sum) - y
+		sum = sumc
+
+		t = math.Abs(a0)
+		if t > maxt {
+			maxt = t
+		}
+
+		an++
+		bn++
+		n++
+	}
+
+	// Estimate error due to roundoff and cancellation. The compensated
+	// summation does not account for cancellation of large terms, so
+	// include the rounding error of the largest term.
+	err = math.Abs(c) + machEp*maxt
+	if sum != 0 {
+		err /= math.Abs(sum)
+	}
+	if math.IsNaN(err) {
+		err = 1
+	}
+	return sum, err
+}
+
+// hy1f1a computes the asymptotic formula for the confluent hypergeometric
+// function
+//
+//	       (    -a
+//	 --    ( |z|
+//	|  (b) ( -------- 2f0( a, 1+a-b, -1/x )
+//	       (  --
+//	       ( |  (b-a)
+//
+//	                               x    a-b                     )
+//	                              e  |x|                        )
+//	                            + -------- 2f0( b-a, 1-a, 1/x ) )
+//	                               --                           )
+//	                              |  (a)                        )
+//
+// and an estimate of its relative error.
+func hy1f1a(a, b, x float64) (asum, err float64) {
+	if x == 0 {
+		return math.Inf(1), 1
+	}
+	temp := math.Log(math.Abs(x))
+	t := x + temp*(a-b)
+	u := -temp * a
+
+	if b > 0 {
+		temp = lgam(b)
+		t += temp
+		u += temp
+	}
+
+	h1, err1 := hyp2f0(a, a-b+1, -1/x, 1)
+
+	temp = math.Exp(u) / math.Gamma(b-a)
+	h1 *= temp
+	err1 *= temp
+
+	h2, err2 := hyp2f0(b-a, 1-a, 1/x, 2)
+
+	if a < 0 {
+		temp = math.Exp(t) / math.Gamma(a)
+	} else {
+		temp = math.Exp(t - lgam(a))
+	}
+	h2 *= temp
+	err2 *= temp
+
+	if x < 0 {
+		asum = h1
+	} else {
+		asum = h2
+	}
+
+	acanc := math.Abs(err1) + math.Abs(err2)
+
+	if b < 0 {
+		temp = math.Gamma(b)
+		asum *= temp
+		acanc *= math.Abs(temp)
+	}
+
+	if asum != 0 {
+		acanc /= math.Abs(asum)
+	}
+	if math.IsNaN(acanc) {
+		acanc = 1
+	}
+	if math.IsInf(asum, 0) {
+		acanc = 0
+	}
+
+	// Fudge factor, since error of asymptotic formula
+	// often seems this much larger than advertised.
+	acanc *= 30
+
+	return asum, acanc
+}
+
+// hyp2f0 computes the hypergeometric function 2F0 and an estimate of its
+// absolute error. The series is divergent unless a or b is a non-positive
+// integer, so its leading part is used as an asymptotic expansion. typ
+// selects the converging factor applied to the last term.
+func hyp2f0(a, b, x float64, typ int) (sum, err float64) {
+	an := a
+	bn := b
+	a0 := 1.0
+	alast := 1.0
+	n := 1.0
+	t := 1.0
+	tlast := 1e9
+	var maxt float64
+
+	converged := true
+	for {
+		if an == 0 || bn == 0 {
+			break
+		}
+
+		u := an * (bn * x / n)
+
+		// Check for blowup.
+		temp := math.Abs(u)
+		if temp > 1 && maxt > math.MaxFloat64/temp {
+			return sum, math.Inf(1)
+		}
+
+		a0 *= u
+		t = math.Abs(a0)
+
+		// Terminating condition for asymptotic series: the series
+		// is divergent (if a or b is not a negative integer), but
+		// its leading part can be used as an asymptotic expansion.
+		if t > tlast {
+			converged = false
+			break
+		}
+
+		tlast = t
+		sum += alast // The sum is one term behind.
+		alast = a0
+
+		if n > 200 {
+			converged = false
+			break
+		}
+
+		an++
+		bn++
+		n++
+		if t > maxt {
+			maxt = t
+		}
+		if t <= machEp {
+			break
+		}
+	}
+
+	if converged {
+		// Estimate error due to roundoff and cancellation.
+		err = math.Abs(machEp * (n + maxt))
+		alast = a0
+		return sum + alast, err
+	}
+
+	// The following converging factors are supposed to improve
+	// accuracy, but do not actually seem to accomplish very much.
+	n--
+	x = 1 / x
+
+	switch typ {
+	case 1:
+		alast *= 0.5 + (0.125+0.25*b-0.5*a+0.25*x-0.25*n)/x
+	case 2:
+		alast *= 2.0/3.0 - b + 2*a + x - n
+	}
+
+	// Estimate error due to roundoff, cancellation, and nonconvergence.
+	err = machEp*(n+maxt) + math.Abs(a0)
+	return sum + alast, err
+}
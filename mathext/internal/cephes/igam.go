@@ -138,6 +138,16 @@ func IgamC(a, x float64) float64 {
 	return igamCSeries(a, x)
 }
 
+// IgamFac computes
+//
+//	x^a * e^{-x} / Γ(a),
+//
+// the factor of the regularized incomplete gamma functions that is
+// evaluated accurately for a close to x.
+func IgamFac(a, x float64) float64 {
+	return igamFac(a, x)
+}
+
 // igamFac computes
 //
 //	x^a * e^{-x} / Γ(a)
@@ -0,0 +1,130 @@
+// Derived from SciPy's special/cephes/psi.c
+// https://github.com/scipy/scipy/blob/master/scipy/special/cephes/psi.c
+// Made freely available by Stephen L. Moshier without support or guarantee.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Copyright ©1984, ©1987, ©1992, ©2000 by Stephen L. Moshier
+// Portions Copyright ©2026 The Gonum Authors. All rights reserved.
+
+package cephes
+
+import "math"
+
+var psiA = [...]float64{
+	8.33333333333333333333e-2,
+	-2.10927960927960927961e-2,
+	7.57575757575757575758e-3,
+	-4.16666666666666666667e-3,
+	3.96825396825396825397e-3,
+	-8.33333333333333333333e-3,
+	8.33333333333333333333e-2,
+}
+
+// psi computes the digamma function, the logarithmic derivative of the
+// gamma function.
+//
+// For integer x,
+//
+//	           n-1
+//	            -
+//	psi(n) = -EUL  +   >  1/k.
+//	            -
+//	           k=1
+//
+// If x is negative, it is transformed to a positive argument by the
+// reflection formula psi(1-x) = psi(x) + pi cot(pi x). For x in [1, 2]
+// a rational approximation is used, and for larger x the asymptotic
+// series is used after the recurrence psi(x+1) = psi(x) + 1/x moves x
+// into [1, 2] when x < 10.
+func psi(x float64) float64 {
+	var y float64
+	switch {
+	case math.IsNaN(x), math.IsInf(x, 1):
+		return x
+	case math.IsInf(x, -1):
+		return math.NaN()
+	case x == 0:
+		return math.Copysign(math.Inf(1), -x)
+	case x < 0:
+		// Argument reduction before evaluating tan(pi * x).
+		_, r := math.Modf(x)
+		if r == 0 {
+			return math.NaN()
+		}
+		y = -math.Pi / math.Tan(math.Pi*r)
+		x = 1 - x
+	}
+
+	// Check for positive integer up to 10.
+	if x <= 10 && x == math.Floor(x) {
+		n := int(x)
+		for i := 1; i < n; i++ {
+			y += 1 / float64(i)
+		}
+		return y - euler
+	}
+
+	// Use the recurrence relation to move x into [1, 2].
+	if x < 1 {
+		y -= 1 / x
+		x++
+	} else if x < 10 {
+		for x > 2 {
+			x--
+			y += 1 / x
+		}
+	}
+	if 1 <= x && x <= 2 {
+		return y + digammaImp12(x)
+	}
+
+	// x is large, use the asymptotic series.
+	return y + psiAsy(x)
+}
+
+var (
+	digammaP = [...]float64{
+		-0.0020713321167745952,
+		-0.045251321448739056,
+		-0.28919126444774784,
+		-0.65031853770896507,
+		-0.32555031186804491,
+		0.25479851061131551,
+	}
+	digammaQ = [...]float64{
+		-0.55789841321675513e-6,
+		0.0021284987017821144,
+		0.054151797245674225,
+		0.43593529692665969,
+		1.4606242909763515,
+		2.0767117023730469,
+		1.0,
+	}
+)
+
+// digammaImp12 computes the digamma function on [1, 2] with the rational
+// approximation taken from Boost.
+func digammaImp12(x float64) float64 {
+	const (
+		y     = 0.99558162689208984
+		root1 = 1569415565.0 / 1073741824.0
+		root2 = (381566830.0 / 1073741824.0) / 1073741824.0
+		root3 = 0.9016312093258695918615325266959189453125e-19
+	)
+	g := x - root1
+	g -= root2
+	g -= root3
+	r := polevl(x-1, digammaP[:], 5) / polevl(x-1, digammaQ[:], 6)
+	return g*y + g*r
+}
+
+// psiAsy computes the asymptotic series of the digamma function.
+func psiAsy(x float64) float64 {
+	var y float64
+	if x < 1e17 {
+		z := 1 / (x * x)
+		y = z * polevl(z, psiA[:], 6)
+	}
+	return math.Log(x) - 0.5/x - y
+}
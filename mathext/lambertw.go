@@ -0,0 +1,128 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import "math"
+
+const (
+	// invEHi and invELo are the leading and trailing parts of 1/e,
+	// the negative of the branch point of the Lambert W function.
+	invEHi = 0.36787944117144233
+	invELo = -1.2428753672788363e-17
+)
+
+// LambertW returns the value of the branch k of the Lambert W function at x.
+// The Lambert W function is the inverse of
+//
+//	f(w) = w e^w,
+//
+// so that W(x) e^{W(x)} = x. For real x, the function has two real branches.
+// The principal branch, k = 0, is defined for x ≥ -1/e and satisfies
+// W(x) ≥ -1. The lower branch, k = -1, is defined for -1/e ≤ x < 0 and
+// satisfies W(x) ≤ -1. The branches meet at the branch point W(-1/e) = -1.
+//
+// Close to the branch point, W is evaluated by its series expansion in
+// p = ±√(2(e x + 1)). Elsewhere, the value is refined from an initial
+// approximation with Halley's method.
+//
+// Special cases are:
+//
+//	LambertW(k, NaN) = NaN
+//	LambertW(k, x) = NaN for x < -1/e
+//	LambertW(k, -1/e) = -1
+//	LambertW(0, ±0) = ±0
+//	LambertW(0, +Inf) = +Inf
+//	LambertW(-1, x) = NaN for x > 0
+//	LambertW(-1, ±0) = -Inf
+//
+// LambertW panics if k is not 0 or -1.
+//
+// See https://dlmf.nist.gov/4.13 for more detailed information.
+func LambertW(k int, x float64) float64 {
+	if k != 0 && k != -1 {
+		panic("mathext: invalid Lambert W branch")
+	}
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x == 0:
+		if k == 0 {
+			return x
+		}
+		return math.Inf(-1)
+	case k == -1 && x > 0:
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return x
+	}
+
+	if x < -invEHi {
+		return math.NaN()
+	}
+	// q is e x + 1, computed to avoid cancellation close to the
+	// branch point.
+	q := math.E * ((x + invEHi) + invELo)
+	if q <= 0 {
+		// x is the floating point number closest to -1/e.
+		return -1
+	}
+
+	var w float64
+	switch {
+	case x < -0.25:
+		p := math.Sqrt(2 * q)
+		if k == -1 {
+			p = -p
+		}
+		w = lambertWBranchSeries(p)
+		if math.Abs(p) < 0.01 {
+			// The truncation error of the series is below
+			// the rounding error of the result.
+			return w
+		}
+	case k == -1:
+		l1 := math.Log(-x)
+		l2 := math.Log(-l1)
+		w = l1 - l2 + l2/l1
+	case x < 3:
+		w = math.Log1p(x)
+	default:
+		l1 := math.Log(x)
+		l2 := math.Log(l1)
+		w = l1 - l2 + l2/l1
+	}
+
+	// Refine the approximation with Halley's method applied to
+	// f(w) = w e^w - x.
+	for i := 0; i < 20; i++ {
+		ew := math.Exp(w)
+		f := w*ew - x
+		wp1 := w + 1
+		delta := f / (ew*wp1 - (w+2)*f/(2*wp1))
+		w -= delta
+		if math.Abs(delta) <= 0x1p-52*math.Abs(w) {
+			break
+		}
+	}
+	return w
+}
+
+// lambertWBranchSeries returns the series expansion of the Lambert W
+// function about the branch point in p = ±√(2(e x + 1)), where the sign of
+// p selects the branch.
+func lambertWBranchSeries(p float64) float64 {
+	// Coefficients of the series from https://dlmf.nist.gov/4.13.E6.
+	const (
+		c0 = -1
+		c1 = 1
+		c2 = -1.0 / 3
+		c3 = 11.0 / 72
+		c4 = -43.0 / 540
+		c5 = 769.0 / 17280
+		c6 = -221.0 / 8505
+		c7 = 680863.0 / 43545600
+	)
+	return c0 + p*(c1+p*(c2+p*(c3+p*(c4+p*(c5+p*(c6+p*c7))))))
+}
@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestLambertW(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for i, test := range []struct {
+		k       int
+		x, want float64
+	}{
+		// Results computed with Halley's method in 60 digit arithmetic.
+		{k: 0, x: -0.3678794411714423, want: -0.99999998469574587},
+		{k: 0, x: -0.36787, want: -0.99285272982152773},
+		{k: 0, x: -0.3, want: -0.48940222718021493},
+		{k: 0, x: -0.1, want: -0.11183255915896297},
+		{k: 0, x: -1e-5, want: -0.000010000100001500027},
+		{k: 0, x: 1e-10, want: 9.9999999990000004e-11},
+		{k: 0, x: 0.5, want: 0.35173371124919583},
+		{k: 0, x: 1, want: 0.56714329040978387},
+		{k: 0, x: 2.718281828459045, want: 0.99999999999999997},
+		{k: 0, x: 10, want: 1.7455280027406994},
+		{k: 0, x: 1000, want: 5.2496028524015962},
+		{k: 0, x: 1e10, want: 20.028685413304951},
+		{k: 0, x: 1e100, want: 224.84310644511850},
+		{k: 0, x: 1e300, want: 684.24720862976085},
+		{k: -1, x: -0.3678794411714423, want: -1.0000000153042543},
+		{k: -1, x: -0.36787, want: -1.0071814889510784},
+		{k: -1, x: -0.3, want: -1.7813370234216277},
+		{k: -1, x: -0.2, want: -2.5426413577735263},
+		{k: -1, x: -0.1, want: -3.5771520639572971},
+		{k: -1, x: -1e-3, want: -9.1180064704027401},
+		{k: -1, x: -1e-10, want: -26.295238819246926},
+		{k: -1, x: -1e-100, want: -235.72115887568531},
+		{k: -1, x: -1e-300, want: -697.32277629546016},
+	} {
+		got := LambertW(test.k, test.x)
+		if !scalar.EqualWithinRel(got, test.want, tol) {
+			t.Errorf("test %d LambertW(%d, %g) failed: got %g want %g", i, test.k, test.x, got, test.want)
+		}
+	}
+}
+
+func TestLambertWInverse(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for _, w := range []float64{-700, -100, -20, -3, -1.5, -1.01, -0.99, -0.5, -1e-8, 1e-300, 1e-8, 0.3, 1, 5, 50, 700} {
+		k := 0
+		if w < -1 {
+			k = -1
+		}
+		x := w * math.Exp(w)
+		got := LambertW(k, x)
+		// The Lambert W function is ill-conditioned close to
+		// the branch point.
+		tol := tol / math.Min(1, math.Abs(w+1))
+		if !scalar.EqualWithinRel(got, w, tol) {
+			t.Errorf("unexpected value of LambertW(%d, %g): got %g want %g", k, x, got, w)
+		}
+	}
+}
+
+func TestLambertWSpecial(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		k       int
+		x, want float64
+	}{
+		{k: 0, x: math.NaN(), want: math.NaN()},
+		{k: -1, x: math.NaN(), want: math.NaN()},
+		{k: 0, x: -0.37, want: math.NaN()},
+		{k: -1, x: -0.37, want: math.NaN()},
+		{k: 0, x: math.Inf(-1), want: math.NaN()},
+		{k: 0, x: math.Inf(1), want: math.Inf(1)},
+		{k: -1, x: 1, want: math.NaN()},
+		{k: -1, x: math.Inf(1), want: math.NaN()},
+		{k: 0, x: 0, want: 0},
+		{k: -1, x: 0, want: math.Inf(-1)},
+		{k: -1, x: math.Copysign(0, -1), want: math.Inf(-1)},
+		{k: 0, x: -1 / math.E, want: -1},
+		{k: -1, x: -1 / math.E, want: -1},
+	} {
+		got := LambertW(test.k, test.x)
+		if !(math.IsNaN(got) && math.IsNaN(test.want)) && got != test.want {
+			t.Errorf("unexpected value of LambertW(%d, %g): got %g want %g", test.k, test.x, got, test.want)
+		}
+	}
+	if got := LambertW(0, math.Copysign(0, -1)); !math.Signbit(got) {
+		t.Errorf("unexpected sign of LambertW(0, -0): got %g", got)
+	}
+
+	for _, k := range []int{-2, 1} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected panic for branch %d", k)
+				}
+			}()
+			LambertW(k, 1)
+		}()
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestMarcumQ(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	for i, test := range []struct {
+		nu, a, b, want float64
+	}{
+		// Results computed from the series of regularized incomplete
+		// gamma functions in 80 digit arithmetic.
+		{nu: 1, a: 0.5, b: 0.1, want: 0.99559715387918155},
+		{nu: 1, a: 1, b: 2, want: 0.26901206003591000},
+		{nu: 1, a: 2, b: 1, want: 0.91810769636940600},
+		{nu: 1, a: 3, b: 3.5, want: 0.36568020088635624},
+		{nu: 1, a: 5, b: 9, want: 0.000043135877402955175},
+		{nu: 1, a: 7, b: 2, want: 0.99999985278665936},
+		{nu: 1, a: 10, b: 10.5, want: 0.32594703743194997},
+		{nu: 1, a: 20, b: 30, want: 9.3495515963099420e-24},
+		{nu: 1, a: 0.1, b: 5, want: 0.0000039626401534420641},
+		{nu: 2.5, a: 0.5, b: 0.1, want: 0.99999953216930489},
+		{nu: 2.5, a: 1, b: 2, want: 0.65281029782524986},
+		{nu: 2.5, a: 2, b: 1, want: 0.99337236587314539},
+		{nu: 2.5, a: 3, b: 3.5, want: 0.54976748490714429},
+		{nu: 2.5, a: 5, b: 9, want: 0.00010554552645533664},
+		{nu: 2.5, a: 7, b: 2, want: 0.99999998208490017},
+		{nu: 2.5, a: 10, b: 10.5, want: 0.38035886538590399},
+		{nu: 2.5, a: 20, b: 30, want: 1.7228483059260167e-23},
+		{nu: 2.5, a: 0.1, b: 5, want: 0.00014245108372467537},
+		{nu: 0.3, a: 0.5, b: 0.1, want: 0.79951049273749105},
+		{nu: 0.3, a: 1, b: 2, want: 0.12468778390322374},
+		{nu: 0.3, a: 2, b: 1, want: 0.80212307874833806},
+		{nu: 0.3, a: 3, b: 3.5, want: 0.28681692530487707},
+		{nu: 0.3, a: 5, b: 9, want: 0.000027947620132610600},
+		{nu: 0.3, a: 7, b: 2, want: 0.99999962765982294},
+		{nu: 0.3, a: 10, b: 10.5, want: 0.30168957110890982},
+		{nu: 0.3, a: 20, b: 30, want: 7.0203487804484573e-24},
+		{nu: 0.3, a: 0.1, b: 5, want: 2.4730332204522572e-7},
+		{nu: 10, a: 0.5, b: 0.1, want: 1.0000000000000000},
+		{nu: 10, a: 1, b: 2, want: 0.99996917176841012},
+		{nu: 10, a: 2, b: 1, want: 0.99999999997467764},
+		{nu: 10, a: 3, b: 3.5, want: 0.99101836909982369},
+		{nu: 10, a: 5, b: 9, want: 0.0045467518872286940},
+		{nu: 10, a: 7, b: 2, want: 0.99999999999995139},
+		{nu: 10, a: 10, b: 10.5, want: 0.66567452254094067},
+		{nu: 10, a: 20, b: 30, want: 3.4619238772143247e-22},
+		{nu: 10, a: 0.1, b: 5, want: 0.20190948597208548},
+	} {
+		got := MarcumQ(test.nu, test.a, test.b)
+		if !scalar.EqualWithinRel(got, test.want, tol) {
+			t.Errorf("test %d MarcumQ(%g, %g, %g) failed: got %g want %g", i, test.nu, test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestMarcumQSymmetry(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	for _, a := range []float64{0.01, 0.4, 1, 2.5, 6, 15, 40, 120} {
+		for _, b := range []float64{0.01, 0.3, 1, 3, 7, 20, 45, 100} {
+			// Q_1(a, b) + Q_1(b, a) = 1 + exp(-(a²+b²)/2) I_0(ab).
+			got := MarcumQ(1, a, b) + MarcumQ(1, b, a)
+			want := 1 + BesselIScaled(0, a*b)*math.Exp(-(a-b)*(a-b)/2)
+			if !scalar.EqualWithinRel(got, want, tol) {
+				t.Errorf("unexpected value of MarcumQ(1, %g, %g) + MarcumQ(1, %g, %g): got %g want %g", a, b, b, a, got, want)
+			}
+		}
+	}
+}
+
+func TestMarcumQSpecial(t *testing.T) {
+	t.Parallel()
+
+	nan := math.NaN()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		nu, a, b, want float64
+	}{
+		{nu: nan, a: 1, b: 1, want: nan},
+		{nu: 1, a: nan, b: 1, want: nan},
+		{nu: 1, a: 1, b: nan, want: nan},
+		{nu: 0, a: 1, b: 1, want: nan},
+		{nu: 1, a: -1, b: 1, want: nan},
+		{nu: 1, a: 1, b: -1, want: nan},
+		{nu: 1.5, a: 2, b: 0, want: 1},
+		{nu: 1.5, a: 2, b: inf, want: 0},
+		{nu: 1.5, a: inf, b: 2, want: 1},
+		{nu: 1, a: 0, b: 2, want: math.Exp(-2)},
+		{nu: 3.5, a: 0, b: 2, want: GammaIncRegComp(3.5, 2)},
+	} {
+		got := MarcumQ(test.nu, test.a, test.b)
+		if !(math.IsNaN(got) && math.IsNaN(test.want)) && !scalar.EqualWithinRel(got, test.want, 1e-15) {
+			t.Errorf("unexpected value of MarcumQ(%g, %g, %g): got %g want %g", test.nu, test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestBivariateNormalCDF(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for i, test := range []struct {
+		x, y, rho, want float64
+	}{
+		// Results computed by integrating the density over the
+		// correlation with tanh-sinh quadrature in 40 digit arithmetic.
+		{x: 0, y: 0, rho: 0.5, want: 0.33333333333333333},
+		{x: 1, y: -0.5, rho: 0.2, want: 0.27575580849620518},
+		{x: -1, y: 2, rho: -0.5, want: 0.14538903692094032},
+		{x: 1.5, y: 1.5, rho: 0.9, want: 0.91033399817539241},
+		{x: -2, y: -3, rho: 0.95, want: 0.0013487851526788947},
+		{x: -2, y: -3, rho: -0.95, want: 6.2138994350233823e-24},
+		{x: 0.3, y: -0.3, rho: 0.999, want: 0.38208857781104736},
+		{x: 0.3, y: 0.3, rho: -0.999, want: 0.23582284437790527},
+		{x: -5, y: -6, rho: 0.7, want: 1.6769571000831870e-10},
+		{x: 3, y: -2, rho: -0.8, want: 0.021618705442085096},
+		{x: -1, y: -1, rho: 0.6, want: 0.072525871689886320},
+		{x: 2.5, y: 1, rho: 0.4, want: 0.83858348016106825},
+		{x: -8, y: -8, rho: 0.5, want: 1.7886605485901852e-21},
+		{x: 0.5, y: 1, rho: -0.3, want: 0.55820632582406200},
+	} {
+		got := BivariateNormalCDF(test.x, test.y, test.rho)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-15, tol) {
+			t.Errorf("test %d BivariateNormalCDF(%g, %g, %g) failed: got %g want %g", i, test.x, test.y, test.rho, got, test.want)
+		}
+		got = BivariateNormalCDF(test.y, test.x, test.rho)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-15, tol) {
+			t.Errorf("test %d BivariateNormalCDF(%g, %g, %g) failed: got %g want %g", i, test.y, test.x, test.rho, got, test.want)
+		}
+	}
+}
+
+func TestBivariateNormalCDFOwensT(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for _, x := range []float64{-3, -0.7, 0.4, 2} {
+		for _, y := range []float64{-2.5, -0.1, 0.8, 3} {
+			for _, rho := range []float64{-0.99, -0.6, -0.1, 0.2, 0.5, 0.93, 0.999} {
+				// Φ₂(x, y; ρ) = (Φ(x) + Φ(y))/2 - T(x, a_x) - T(y, a_y) - β
+				// with a_x = (y - ρx)/(x sqrt(1-ρ²)), a_y = (x - ρy)/(y sqrt(1-ρ²))
+				// and β = 1/2 if xy < 0 or xy = 0 and x+y < 0.
+				s := math.Sqrt(1 - rho*rho)
+				want := (normalCDF(x)+normalCDF(y))/2 - OwensT(x, (y-rho*x)/(x*s)) - OwensT(y, (x-rho*y)/(y*s))
+				if x*y < 0 {
+					want -= 0.5
+				}
+				got := BivariateNormalCDF(x, y, rho)
+				if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+					t.Errorf("unexpected value of BivariateNormalCDF(%g, %g, %g): got %g want %g", x, y, rho, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestBivariateNormalCDFSpecial(t *testing.T) {
+	t.Parallel()
+
+	nan := math.NaN()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		x, y, rho, want float64
+	}{
+		{x: nan, y: 0, rho: 0.5, want: nan},
+		{x: 0, y: nan, rho: 0.5, want: nan},
+		{x: 0, y: 0, rho: nan, want: nan},
+		{x: 0, y: 0, rho: 1.5, want: nan},
+		{x: -inf, y: 1, rho: 0.5, want: 0},
+		{x: 1, y: -inf, rho: 0.5, want: 0},
+		{x: inf, y: inf, rho: 0.5, want: 1},
+		{x: inf, y: 1, rho: 0.5, want: normalCDF(1)},
+		{x: -1, y: inf, rho: -0.5, want: normalCDF(-1)},
+		{x: 0.5, y: -1, rho: 0, want: normalCDF(0.5) * normalCDF(-1)},
+		{x: 0.5, y: -1, rho: 1, want: normalCDF(-1)},
+		{x: 0.5, y: -1, rho: -1, want: 0},
+		{x: 0.5, y: -0.2, rho: -1, want: normalCDF(0.5) - normalCDF(0.2)},
+	} {
+		got := BivariateNormalCDF(test.x, test.y, test.rho)
+		if !(math.IsNaN(got) && math.IsNaN(test.want)) && !scalar.EqualWithinAbsOrRel(got, test.want, 1e-15, 1e-15) {
+			t.Errorf("unexpected value of BivariateNormalCDF(%g, %g, %g): got %g want %g", test.x, test.y, test.rho, got, test.want)
+		}
+	}
+}
+
+func TestTrivariateNormalCDF(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	for i, test := range []struct {
+		x, y, z       float64
+		rxy, rxz, ryz float64
+		want          float64
+	}{
+		// Results computed by integrating the conditional bivariate
+		// normal probability with tanh-sinh quadrature in 30 digit
+		// arithmetic.
+		{x: 0.5, y: 1, z: -0.3, rxy: 0.3, rxz: 0.2, ryz: 0.4, want: 0.27545759741757499},
+		{x: 1, y: 1, z: 1, rxy: 0.5, rxz: 0.5, ryz: 0.5, want: 0.67777953297040876},
+		{x: -1, y: 0.5, z: 2, rxy: -0.4, rxz: 0.6, ryz: -0.2, want: 0.071961557681428838},
+		{x: 2, y: -1, z: 0, rxy: 0.8, rxz: 0.7, ryz: 0.9, want: 0.15795040176375992},
+		{x: -1.5, y: -1, z: -2, rxy: 0.2, rxz: -0.3, ryz: 0.85, want: 0.00023949994825343348},
+		{x: 0.2, y: 0.4, z: 0.6, rxy: -0.45, rxz: -0.45, ryz: -0.1, want: 0.17536480767488679},
+	} {
+		// The probability is invariant under permutations of the
+		// variables.
+		for _, p := range []struct {
+			x, y, z       float64
+			rxy, rxz, ryz float64
+		}{
+			{test.x, test.y, test.z, test.rxy, test.rxz, test.ryz},
+			{test.x, test.z, test.y, test.rxz, test.rxy, test.ryz},
+			{test.y, test.x, test.z, test.rxy, test.ryz, test.rxz},
+			{test.y, test.z, test.x, test.ryz, test.rxy, test.rxz},
+			{test.z, test.x, test.y, test.rxz, test.ryz, test.rxy},
+			{test.z, test.y, test.x, test.ryz, test.rxz, test.rxy},
+		} {
+			got := TrivariateNormalCDF(p.x, p.y, p.z, p.rxy, p.rxz, p.ryz)
+			if !scalar.EqualWithinAbsOrRel(got, test.want, tol, tol) {
+				t.Errorf("test %d TrivariateNormalCDF(%g, %g, %g, %g, %g, %g) failed: got %g want %g",
+					i, p.x, p.y, p.z, p.rxy, p.rxz, p.ryz, got, test.want)
+			}
+		}
+	}
+}
+
+func TestTrivariateNormalCDFOrthant(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for _, r := range [][3]float64{
+		{0, 0, 0},
+		{0.5, 0.5, 0.5},
+		{-0.5, -0.4, 0.3},
+		{0.9, 0.8, 0.95},
+		{-0.3, 0.6, 0.2},
+		{0.99, -0.2, -0.1},
+	} {
+		// Φ₃(0, 0, 0; R) = 1/8 + (asin rxy + asin rxz + asin ryz)/(4π).
+		want := 0.125 + (math.Asin(r[0])+math.Asin(r[1])+math.Asin(r[2]))/(4*math.Pi)
+		got := TrivariateNormalCDF(0, 0, 0, r[0], r[1], r[2])
+		if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected value of TrivariateNormalCDF at origin with correlations %v: got %g want %g", r, got, want)
+		}
+	}
+}
+
+func TestTrivariateNormalCDFSpecial(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		x, y, z       float64
+		rxy, rxz, ryz float64
+		want          float64
+	}{
+		{x: math.NaN(), y: 0, z: 0, want: math.NaN()},
+		{x: 0, y: 0, z: 0, rxy: 1.1, want: math.NaN()},
+		{x: 0, y: 0, z: 0, rxy: 0.9, rxz: 0.9, ryz: -0.9, want: math.NaN()},
+		{x: 1, y: -inf, z: 0, rxy: 0.5, want: 0},
+		{x: 1, y: 2, z: inf, rxy: 0.5, rxz: 0.2, ryz: 0.1, want: BivariateNormalCDF(1, 2, 0.5)},
+		{x: inf, y: 2, z: -1, rxy: 0.5, rxz: 0.2, ryz: 0.1, want: BivariateNormalCDF(2, -1, 0.1)},
+		{x: 1, y: 2, z: -1, rxy: 0.5, want: BivariateNormalCDF(1, 2, 0.5) * normalCDF(-1)},
+		{x: 0.3, y: -0.5, z: 1.2, want: normalCDF(0.3) * normalCDF(-0.5) * normalCDF(1.2)},
+	} {
+		got := TrivariateNormalCDF(test.x, test.y, test.z, test.rxy, test.rxz, test.ryz)
+		if !(math.IsNaN(got) && math.IsNaN(test.want)) && !scalar.EqualWithinAbsOrRel(got, test.want, tol, tol) {
+			t.Errorf("unexpected value of TrivariateNormalCDF(%g, %g, %g, %g, %g, %g): got %g want %g",
+				test.x, test.y, test.z, test.rxy, test.rxz, test.ryz, got, test.want)
+		}
+	}
+}
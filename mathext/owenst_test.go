@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestOwensT(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for i, test := range []struct {
+		h, a, want float64
+	}{
+		// Results computed with tanh-sinh quadrature in 40 digit arithmetic.
+		{h: 0, a: 0.25, want: 0.038989565188684663},
+		{h: 0, a: 0.9999, want: 0.12499204185494478},
+		{h: 0, a: 1, want: 0.12500000000000000},
+		{h: 0, a: 2, want: 0.17620819117478336},
+		{h: 0, a: 10, want: 0.23413724128472322},
+		{h: 0, a: 100, want: 0.24840850361754587},
+		{h: 0.0625, a: 0.25, want: 0.038911930234701367},
+		{h: 0.0625, a: 0.9999, want: 0.12468162776433827},
+		{h: 0.0625, a: 1, want: 0.12468955488507445},
+		{h: 0.0625, a: 2, want: 0.17558790610057711},
+		{h: 0.0625, a: 10, want: 0.23112902299510389},
+		{h: 0.0625, a: 100, want: 0.23754116548525163},
+		{h: 0.5, a: 0.25, want: 0.034320217127094210},
+		{h: 0.5, a: 0.9999, want: 0.10666486507437574},
+		{h: 0.5, a: 1, want: 0.10667106296144852},
+		{h: 0.5, a: 2, want: 0.14158060365397839},
+		{h: 0.5, a: 10, want: 0.15426876749825401},
+		{h: 0.5, a: 100, want: 0.15426876936299345},
+		{h: 1, a: 0.25, want: 0.023408245742525982},
+		{h: 1, a: 0.9999, want: 0.066738954381360955},
+		{h: 1, a: 1, want: 0.066741882165700967},
+		{h: 1, a: 2, want: 0.078468186993084096},
+		{h: 1, a: 10, want: 0.079327626965728526},
+		{h: 1, a: 100, want: 0.079327626965728526},
+		{h: 1.5, a: 0.25, want: 0.012372187964811940},
+		{h: 1.5, a: 0.9999, want: 0.031171160687053892},
+		{h: 1.5, a: 1, want: 0.031171999563740178},
+		{h: 1.5, a: 2, want: 0.033383245362167338},
+		{h: 1.5, a: 10, want: 0.033403600634429033},
+		{h: 1.5, a: 100, want: 0.033403600634429033},
+		{h: 3, a: 0.25, want: 0.00039631988089653345},
+		{h: 3, a: 0.9999, want: 0.00067403692091194956},
+		{h: 3, a: 1, want: 0.00067403790346714786},
+		{h: 3, a: 2, want: 0.00067494901553521615},
+		{h: 3, a: 10, want: 0.00067494901581504726},
+		{h: 3, a: 100, want: 0.00067494901581504726},
+		{h: 6.5, a: 0.25, want: 1.8137068872722727e-11},
+		{h: 6.5, a: 0.9999, want: 2.0080002918485605e-11},
+		{h: 6.5, a: 1, want: 2.0080002918489176e-11},
+		{h: 6.5, a: 2, want: 2.0080002919295589e-11},
+		{h: 6.5, a: 10, want: 2.0080002919295589e-11},
+		{h: 6.5, a: 100, want: 2.0080002919295589e-11},
+		{h: 10, a: 0.25, want: 3.7656959148034798e-24},
+		{h: 10, a: 0.9999, want: 3.8099265120802630e-24},
+		{h: 10, a: 1, want: 3.8099265120802630e-24},
+		{h: 10, a: 2, want: 3.8099265120802630e-24},
+		{h: 10, a: 10, want: 3.8099265120802630e-24},
+		{h: 10, a: 100, want: 3.8099265120802630e-24},
+	} {
+		for _, sign := range []float64{-1, 1} {
+			got := OwensT(sign*test.h, test.a)
+			if !scalar.EqualWithinRel(got, test.want, tol) {
+				t.Errorf("test %d OwensT(%g, %g) failed: got %g want %g", i, sign*test.h, test.a, got, test.want)
+			}
+			got = OwensT(sign*test.h, -test.a)
+			if !scalar.EqualWithinRel(got, -test.want, tol) {
+				t.Errorf("test %d OwensT(%g, %g) failed: got %g want %g", i, sign*test.h, -test.a, got, -test.want)
+			}
+		}
+	}
+}
+
+func TestOwensTSpecial(t *testing.T) {
+	t.Parallel()
+
+	nan := math.NaN()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		h, a, want float64
+	}{
+		{h: nan, a: 1, want: nan},
+		{h: 1, a: nan, want: nan},
+		{h: 2, a: 0, want: 0},
+		{h: inf, a: 1, want: 0},
+		{h: -inf, a: 3, want: 0},
+		{h: 0, a: inf, want: 0.25},
+		{h: 0, a: -inf, want: -0.25},
+		{h: 1.5, a: inf, want: (1 - normalCDF(1.5)) / 2},
+		{h: -1.5, a: -inf, want: -(1 - normalCDF(1.5)) / 2},
+	} {
+		got := OwensT(test.h, test.a)
+		if !(math.IsNaN(got) && math.IsNaN(test.want)) && !scalar.EqualWithinRel(got, test.want, 1e-15) {
+			t.Errorf("unexpected value of OwensT(%g, %g): got %g want %g", test.h, test.a, got, test.want)
+		}
+	}
+}
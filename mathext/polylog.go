@@ -0,0 +1,322 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Polylog returns the value of the polylogarithm of order s at x,
+//
+//	Li_s(x) = \sum_{k=1}^{\infty} x^k k^{-s},
+//
+// analytically continued to all real x ≤ 1.
+//
+// For |x| ≤ 1/2 the defining series is summed directly. For 1/2 < |x| ≤ 150
+// the expansion in μ = log(x), which is complex for negative x,
+//
+//	Li_s(e^μ) = Γ(1-s) (-μ)^{s-1} + \sum_{k=0}^{\infty} ζ(s-k) μ^k / k!,
+//
+// is used, where the terms that are singular for integer s are combined
+// analytically. For x < -150 the value is obtained from Li_s(1/x) by
+// Jonquière's inversion formula, the accuracy of which decreases for large
+// non-integer s.
+//
+// Special cases are:
+//
+//	Polylog(s, x) = NaN if s or x is NaN
+//	Polylog(s, x) = NaN for x > 1
+//	Polylog(s, 1) = ζ(s) for s > 1
+//	Polylog(s, 1) = +Inf for s ≤ 1
+//	Polylog(s, -1) = -η(s)
+//	Polylog(s, 0) = 0
+//
+// See https://dlmf.nist.gov/25.12 for more detailed information.
+func Polylog(s, x float64) float64 {
+	switch {
+	case math.IsNaN(s), math.IsNaN(x), math.IsInf(s, -1):
+		return math.NaN()
+	case x > 1:
+		// The function is complex valued.
+		return math.NaN()
+	case x == 0:
+		return x
+	case math.IsInf(s, 1):
+		return x
+	case math.IsInf(x, -1):
+		switch {
+		case s > 0:
+			return math.Inf(-1)
+		case s == 0:
+			return -1
+		}
+		return 0
+	case s == 0:
+		return x / (1 - x)
+	case x == 1:
+		if s > 1 {
+			return RiemannZeta(s)
+		}
+		return math.Inf(1)
+	case x == -1:
+		return -dirichletEtaAll(s)
+	case x < -150:
+		return polylogInversion(s, x)
+	case math.Abs(x) <= 0.5:
+		return polylogSeries(s, x)
+	}
+	mu := complex(math.Log(math.Abs(x)), 0)
+	if x < 0 {
+		mu += complex(0, math.Pi)
+	}
+	return real(polylogLog(s, mu))
+}
+
+// dirichletEtaAll returns the value of the Dirichlet eta function for all
+// real s.
+func dirichletEtaAll(s float64) float64 {
+	if s >= 0 {
+		return dirichletEta(s)
+	}
+	return -math.Expm1((1-s)*math.Ln2) * RiemannZeta(s)
+}
+
+// polylogSeries returns the sum of the defining series of the polylogarithm
+// for |x| ≤ 1/2.
+func polylogSeries(s, x float64) float64 {
+	const maxIter = 10000
+	var sum float64
+	xk := x
+	for k := 1; k <= maxIter; k++ {
+		t := xk * math.Pow(float64(k), -s)
+		sum += t
+		if math.Abs(t) <= 0x1p-53*math.Abs(sum) && float64(k) > -s {
+			break
+		}
+		xk *= x
+	}
+	return sum
+}
+
+// polylogLog returns the value of the polylogarithm at e^μ by the expansion
+// in μ, which converges for |μ| < 2π.
+func polylogLog(s float64, mu complex128) complex128 {
+	const maxIter = 1000
+
+	// For s close to a positive integer n, the term in μ^{n-1} of the
+	// sum and the term in (-μ)^{s-1} both have poles that cancel, so
+	// they are combined.
+	var sum complex128
+	m := -1
+	if s > 0.5 {
+		n := math.Round(s)
+		m = int(n) - 1
+		sum = polylogPair(s-n, m, mu)
+	} else {
+		sum = complex(math.Gamma(1-s), 0) * cmplx.Pow(-mu, complex(s-1, 0))
+	}
+
+	// The terms for k < s are summed directly, with p = μ^k / k!.
+	k := 0
+	p := complex(1, 0)
+	for ; float64(k) <= s; k++ {
+		if k != m {
+			sum += complex(RiemannZeta(s-float64(k)), 0) * p
+		}
+		p *= mu / complex(float64(k+1), 0)
+	}
+
+	// For k > s, ζ(s-k) grows factorially, so it is expanded with the
+	// reflection formula
+	//  ζ(s-k) μ^k / k! = 2 sin(π(s-k)/2) ζ(1-s+k) (2π)^{s-1} q_k,
+	// where q_k = Γ(k+1-s)/k! (μ/2π)^k.
+	q := p * complex(math.Gamma(float64(k)+1-s)/math.Pow(2*math.Pi, float64(k)), 0)
+	c := 2 * math.Pow(2*math.Pi, s-1)
+	var small int
+	for ; k < maxIter; k++ {
+		if k != m {
+			sin := sinPi((s - float64(k)) / 2)
+			t := complex(c*sin*RiemannZeta(1-s+float64(k)), 0) * q
+			sum += t
+			// ζ(s-k) is zero at negative even integers, so
+			// require two consecutive small terms.
+			if cmplx.Abs(t) <= 0x1p-53*cmplx.Abs(sum) {
+				small++
+				if small == 2 {
+					break
+				}
+			} else {
+				small = 0
+			}
+		}
+		q *= complex((float64(k)+1-s)/float64(k+1), 0) * mu / (2 * math.Pi)
+	}
+	return sum
+}
+
+// stieltjes holds the Stieltjes constants γ_k, the coefficients of the
+// Laurent expansion of the Riemann zeta function about s = 1,
+//
+//	ζ(1+ε) = 1/ε + \sum_{k=0}^{\infty} (-1)^k γ_k ε^k / k!.
+var stieltjes = [...]float64{
+	0.5772156649015328606065120900824024310422,
+	-0.0728158454836767248605863758749547830,
+	-0.0096903631928723184845303860352125293,
+	0.0020538344203033458661600465427533843,
+	0.0023253700654673000574681701775260680,
+	0.0007933238173010627017533348774444448,
+	-0.0002387693454301996098724218419080042,
+	-0.0005272895670577510460740975054788582,
+}
+
+// polylogPair returns the sum of the terms
+//
+//	ζ(1+ε) μ^m / m! + Γ(-m-ε) (-μ)^{m+ε}
+//
+// of the expansion of the polylogarithm of order s = m+1+ε in μ. Each term
+// has a pole at ε = 0, and the sum is computed as
+//
+//	μ^m / m! ((ζ(1+ε) - 1/ε) - (h(ε) - 1)/ε),
+//
+// where h(ε) = e^{ε log(-μ)} Γ(1-ε) m! / ((1+ε)(2+ε)...(m+ε)).
+func polylogPair(eps float64, m int, mu complex128) complex128 {
+	const small = 0.01
+
+	// zr is ζ(1+ε) - 1/ε.
+	var zr float64
+	if math.Abs(eps) < small {
+		f := 1.0
+		for k, g := range stieltjes {
+			zr += f * g
+			f *= -eps / float64(k+1)
+		}
+	} else {
+		zr = RiemannZeta(1+eps) - 1/eps
+	}
+
+	// g is log(h(ε))/ε.
+	var lg float64 // log(Γ(1-ε))/ε
+	if math.Abs(eps) < small {
+		// log(Γ(1-ε)) = γ ε + \sum_{k=2}^{\infty} ζ(k) ε^k / k.
+		lg = stieltjes[0]
+		f := eps
+		for k := 2; k <= 9; k++ {
+			lg += RiemannZeta(float64(k)) * f / float64(k)
+			f *= eps
+		}
+	} else {
+		v, _ := math.Lgamma(1 - eps)
+		lg = v / eps
+	}
+	for j := 1; j <= m; j++ {
+		if eps == 0 {
+			lg -= 1 / float64(j)
+		} else {
+			lg -= math.Log1p(eps/float64(j)) / eps
+		}
+	}
+	g := cmplx.Log(-mu) + complex(lg, 0)
+
+	// hm1 is (h(ε) - 1)/ε.
+	hm1 := g
+	if eps != 0 {
+		hm1 = cexpm1(complex(eps, 0)*g) / complex(eps, 0)
+	}
+
+	// p is μ^m / m!.
+	p := complex(1, 0)
+	for k := 1; k <= m; k++ {
+		p *= mu / complex(float64(k), 0)
+	}
+	return p * (complex(zr, 0) - hm1)
+}
+
+// cexpm1 returns e^z - 1, computed accurately for small |z|.
+func cexpm1(z complex128) complex128 {
+	x, y := real(z), imag(z)
+	s := math.Sin(y / 2)
+	return complex(math.Expm1(x)*math.Cos(y)-2*s*s, math.Exp(x)*math.Sin(y))
+}
+
+// polylogInversion returns the value of the polylogarithm for x < -1 using
+// Jonquière's inversion formula
+//
+//	Li_s(x) + e^{iπs} Li_s(1/x) = (2π)^s e^{iπs/2} / Γ(s) ζ(1-s, 1/2 + log(-x)/(2πi)).
+func polylogInversion(s, x float64) float64 {
+	inv := Polylog(s, 1/x)
+	if s <= 0 && s == math.Trunc(s) {
+		// 1/Γ(s) is zero at non-positive integers.
+		return -cosPi(s) * inv
+	}
+
+	var f float64
+	if math.Abs(s) < 170 {
+		f = math.Pow(2*math.Pi, s) / math.Gamma(s)
+	} else {
+		lg, sign := math.Lgamma(s)
+		f = float64(sign) * math.Exp(s*math.Log(2*math.Pi)-lg)
+	}
+	a := complex(0.5, -math.Log(-x)/(2*math.Pi))
+	z := complex(f, 0) * complex(cosPi(s/2), sinPi(s/2)) * hurwitzZeta(1-s, a)
+	return real(z) - cosPi(s)*inv
+}
+
+// bernoulliFactorial holds B_{2j} / (2j)! for j = 1, 2, ..., where B_{2j}
+// are the Bernoulli numbers.
+var bernoulliFactorial = [...]float64{
+	1.0 / 12,
+	-1.0 / 720,
+	1.0 / 30240,
+	-1.0 / 1209600,
+	1.0 / 47900160,
+	-691.0 / 1307674368000,
+	1.0 / 74724249600,
+	-3617.0 / 10670622842880000,
+	43867.0 / 5109094217170944000,
+	-174611.0 / 802857662698291200000,
+	77683.0 / 14101100039391805440000,
+	-236364091.0 / 1693824136731743669452800000,
+}
+
+// hurwitzZeta returns the value of the Hurwitz zeta function
+//
+//	ζ(σ, a) = \sum_{k=0}^{\infty} (k+a)^{-σ}
+//
+// for real σ ≠ 1 and complex a with positive real part, analytically
+// continued in σ, using Euler-Maclaurin summation.
+func hurwitzZeta(sigma float64, a complex128) complex128 {
+	// Shift the argument so that the Euler-Maclaurin correction terms
+	// decrease quickly, but not further than necessary, since the sum of
+	// the shifted terms suffers from cancellation for negative σ. For
+	// non-positive integer σ the correction terms terminate, so no shift
+	// is needed.
+	const radius = 5
+	n := 0
+	if r := cmplx.Abs(a); r < radius && (sigma > 0 || sigma != math.Trunc(sigma)) {
+		n = int(math.Ceil(math.Sqrt(radius*radius-imag(a)*imag(a)) - real(a)))
+	}
+	cs := complex(-sigma, 0)
+	var sum complex128
+	for k := 0; k < n; k++ {
+		sum += cmplx.Pow(complex(float64(k), 0)+a, cs)
+	}
+	w := complex(float64(n), 0) + a
+	ws := cmplx.Pow(w, cs)
+	sum += w*ws/complex(sigma-1, 0) + ws/2
+
+	// t is σ(σ+1)...(σ+2j-2) w^{-σ-2j+1}.
+	t := complex(sigma, 0) * ws / w
+	w2 := w * w
+	for j, b := range bernoulliFactorial {
+		d := complex(b, 0) * t
+		sum += d
+		if cmplx.Abs(d) <= 0x1p-53*cmplx.Abs(sum) {
+			break
+		}
+		t *= complex((sigma+float64(2*j+1))*(sigma+float64(2*j+2)), 0) / w2
+	}
+	return sum
+}
@@ -0,0 +1,229 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestPolylog(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	for i, test := range []struct {
+		s, x, want float64
+	}{
+		// Results computed in 50 digit arithmetic by summing the
+		// series for |x| ≤ 1, and by quadrature of the Fermi-Dirac
+		// integral for x < -1.
+		{s: -2.5, x: -0.9, want: 0.077011097737474344},
+		{s: -2.5, x: -0.6, want: 0.027240791340214668},
+		{s: -2.5, x: -0.3, want: -0.044036968925472044},
+		{s: -2.5, x: 0.3, want: 1.7373378685816279},
+		{s: -2.5, x: 0.6, want: 34.889555371047526},
+		{s: -2.5, x: 0.9, want: 8753.9487696780126},
+		{s: -2.5, x: 0.999, want: 104909755821.24727},
+		{s: -1, x: -100, want: -0.0098029604940692089},
+		{s: -1, x: -5, want: -0.13888888888888889},
+		{s: -1, x: -1.5, want: -0.24},
+		{s: -1, x: -0.9, want: -0.24930747922437673},
+		{s: -1, x: -0.6, want: -0.23437500000000000},
+		{s: -1, x: -0.3, want: -0.17751479289940828},
+		{s: -1, x: 0.3, want: 0.61224489795918363},
+		{s: -1, x: 0.6, want: 3.7499999999999994},
+		{s: -1, x: 0.9, want: 90.000000000000042},
+		{s: -1, x: 0.999, want: 998999.99999999822},
+		{s: 0.5, x: -100, want: -2.3641765458012842},
+		{s: 0.5, x: -5, want: -1.2972654048194185},
+		{s: 0.5, x: -1.5, want: -0.76770327187136354},
+		{s: 0.5, x: -0.9, want: -0.56552594845865277},
+		{s: 0.5, x: -0.6, want: -0.42786178860015140},
+		{s: 0.5, x: -0.3, want: -0.24875253590487477},
+		{s: 0.5, x: 0.3, want: 0.38477744513420899},
+		{s: 0.5, x: 0.6, want: 1.1222635249907178},
+		{s: 0.5, x: 0.9, want: 4.0219504274733613},
+		{s: 0.5, x: 0.999, want: 54.575749065445692},
+		{s: 1, x: -100, want: -4.6151205168412595},
+		{s: 1, x: -5, want: -1.7917594692280550},
+		{s: 1, x: -1.5, want: -0.91629073187415507},
+		{s: 1, x: -0.9, want: -0.64185388617239479},
+		{s: 1, x: -0.6, want: -0.47000362924573554},
+		{s: 1, x: -0.3, want: -0.26236426446749104},
+		{s: 1, x: 0.3, want: 0.35667494393873236},
+		{s: 1, x: 0.6, want: 0.91629073187415501},
+		{s: 1, x: 0.9, want: 2.3025850929940459},
+		{s: 1, x: 0.999, want: 6.9077552789821362},
+		{s: 1.5, x: -100, want: -7.8891019147215491},
+		{s: 1.5, x: -5, want: -2.2842112848731085},
+		{s: 1.5, x: -1.5, want: -1.0428690600045005},
+		{s: 1.5, x: -0.9, want: -0.70350075761860970},
+		{s: 1.5, x: -0.6, want: -0.50288700922065720},
+		{s: 1.5, x: -0.3, want: -0.27254108074465195},
+		{s: 1.5, x: 0.3, want: 0.33831109554480627},
+		{s: 1.5, x: 0.6, want: 0.79820885144423313},
+		{s: 1.5, x: 0.9, want: 1.6144385285663397},
+		{s: 1.5, x: 0.999, want: 2.5017084653413556},
+		{s: 2, x: -100, want: -12.238755177314939},
+		{s: 2, x: -5, want: -2.7492791260608083},
+		{s: 2, x: -1.5, want: -1.1473806603755708},
+		{s: 2, x: -0.9, want: -0.75216317921726164},
+		{s: 2, x: -0.6, want: -0.52810717404466652},
+		{s: 2, x: -0.3, want: -0.28007433375958289},
+		{s: 2, x: 0.3, want: 0.32612951007547606},
+		{s: 2, x: 0.6, want: 0.72758630771633336},
+		{s: 2, x: 0.9, want: 1.2997147230049588},
+		{s: 2, x: 0.999, want: 1.6370226052761177},
+		{s: 2.0000001, x: -100, want: -12.238756153085161},
+		{s: 2.0000001, x: -5, want: -2.7492792150696624},
+		{s: 2.0000001, x: -1.5, want: -1.1473806791639851},
+		{s: 2.0000001, x: -0.9, want: -0.75216318778479527},
+		{s: 2.0000001, x: -0.6, want: -0.52810717842609347},
+		{s: 2.0000001, x: -0.3, want: -0.28007433504704813},
+		{s: 2.0000001, x: 0.3, want: 0.32612950809570466},
+		{s: 2.0000001, x: 0.6, want: 0.72758629676340496},
+		{s: 2.0000001, x: 0.9, want: 1.2997146795536267},
+		{s: 2.0000001, x: 0.999, want: 1.6370225143341013},
+		{s: 3, x: -100, want: -23.862617597794986},
+		{s: 3, x: -5, want: -3.5375114376186075},
+		{s: 3, x: -1.5, want: -1.2978374501562501},
+		{s: 3, x: -0.9, want: -0.81863820154436386},
+		{s: 3, x: -0.6, want: -0.56143953465683420},
+		{s: 3, x: -0.3, want: -0.28964003414183097},
+		{s: 3, x: 0.3, want: 0.31240017789289261},
+		{s: 3, x: 0.6, want: 0.65600251363298066},
+		{s: 3, x: 0.9, want: 1.0496589501864399},
+		{s: 3, x: 0.999, want: 1.2004153539954643},
+		{s: 4.5, x: -100, want: -45.484768290930506},
+		{s: 4.5, x: -5, want: -4.3147288695543725},
+		{s: 4.5, x: -1.5, want: -1.4180762639289982},
+		{s: 4.5, x: -0.9, want: -0.86842336981143436},
+		{s: 4.5, x: -0.6, want: -0.58542084776855466},
+		{s: 4.5, x: -0.3, want: -0.29620069286790601},
+		{s: 4.5, x: 0.3, want: 0.30418775577602053},
+		{s: 4.5, x: 0.6, want: 0.61777965305420069},
+		{s: 4.5, x: 0.9, want: 0.94302589198157724},
+		{s: 4.5, x: 0.999, want: 1.0535808841387765},
+		{s: 10, x: -100, want: -94.581336655735078},
+		{s: 10, x: -5, want: -4.9772944827358437},
+		{s: 10, x: -1.5, want: -1.4978556954869268},
+		{s: 10, x: -0.9, want: -0.89922075740281490},
+		{s: 10, x: -0.6, want: -0.59965197915965038},
+		{s: 10, x: -0.3, want: -0.29991255913510276},
+		{s: 10, x: 0.3, want: 0.30008835585886275},
+		{s: 10, x: 0.6, want: 0.60035535292781399},
+		{s: 10, x: 0.9, want: 0.90080405852508214},
+		{s: 10, x: 0.999, want: 0.99999256776945136},
+		{s: 0.5, x: -150, want: -2.4758451839315119},
+		{s: 0.5, x: -1e3, want: -2.9368410719757180},
+		{s: 0.5, x: -1e5, want: -3.8163341658642282},
+		{s: 0.5, x: -1e10, want: -5.4103216113116429},
+		{s: 0.5, x: -1e100, want: -17.122198786304505},
+		{s: 2.5, x: -150, want: -21.008668078471823},
+		{s: 2.5, x: -1e3, want: -42.582423253162708},
+		{s: 2.5, x: -1e5, want: -141.61187075228489},
+		{s: 2.5, x: -1e10, want: -774.43312390083723},
+		{s: 2.5, x: -1e100, want: -242110.45182661467},
+		{s: 4.5, x: -150, want: -59.498362801262467},
+		{s: 4.5, x: -1e3, want: -181.98765816781016},
+		{s: 4.5, x: -1e5, want: -1368.7251853064409},
+		{s: 4.5, x: -1e10, want: -27039.464379776272},
+		{s: 4.5, x: -1e100, want: -815316095.38826063},
+		{s: 10, x: -150, want: -139.36191684926066},
+		{s: 10, x: -1e3, want: -802.02908018278587},
+		{s: 10, x: -1e5, want: -31569.913742808965},
+		{s: 10, x: -1e10, want: -15184369.897303793},
+		{s: 10, x: -1e100, want: -1.1577273934971907e+17},
+	} {
+		got := Polylog(test.s, test.x)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, tol, tol) {
+			t.Errorf("test %d Polylog(%g, %g) failed: got %g want %g", i, test.s, test.x, got, test.want)
+		}
+	}
+}
+
+func TestPolylogIdentities(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	for _, x := range []float64{-1e10, -200, -3, -1, -0.75, -0.5, -0.1, 0.1, 0.5, 0.6, 0.99, 0.999999} {
+		// Li_1(x) = -log(1-x).
+		if got, want := Polylog(1, x), -math.Log1p(-x); !scalar.EqualWithinRel(got, want, tol) {
+			t.Errorf("unexpected value of Polylog(1, %g): got %g want %g", x, got, want)
+		}
+		// Li_{-1}(x) = x/(1-x)².
+		if got, want := Polylog(-1, x), x/((1-x)*(1-x)); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected value of Polylog(-1, %g): got %g want %g", x, got, want)
+		}
+		// Li_2(x) + Li_2(1/x) = -π²/6 - log²(-x)/2 for x < 0.
+		if x < 0 {
+			l := math.Log(-x)
+			got := Polylog(2, x) + Polylog(2, 1/x)
+			want := -math.Pi*math.Pi/6 - l*l/2
+			if !scalar.EqualWithinRel(got, want, tol) {
+				t.Errorf("unexpected value of Li_2 inversion at %g: got %g want %g", x, got, want)
+			}
+		}
+		// Li_s(x) + Li_s(-x) = 2^{1-s} Li_s(x²).
+		for _, s := range []float64{-1.5, 0.3, 1.7, 3} {
+			if math.Abs(x) > 0.99 {
+				// The rounding error of x² is amplified
+				// close to the singularity at x = 1.
+				continue
+			}
+			got := Polylog(s, x) + Polylog(s, -x)
+			want := math.Pow(2, 1-s) * Polylog(s, x*x)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-11, 1e-11) {
+				t.Errorf("unexpected value of duplication formula for s=%g at %g: got %g want %g", s, x, got, want)
+			}
+		}
+	}
+
+	// The polylogarithm is continuous in s at integers.
+	for _, n := range []float64{1, 2, 3, 5} {
+		for _, x := range []float64{-20, -0.8, 0.7, 0.95} {
+			want := Polylog(n, x)
+			for _, eps := range []float64{1e-12, 1e-9, 1e-6} {
+				for _, s := range []float64{n - eps, n + eps} {
+					got := Polylog(s, x)
+					if !scalar.EqualWithinRel(got, want, 1e-5) || math.Abs(got-want) > 10*eps*math.Max(1, math.Abs(want)) {
+						t.Errorf("Polylog(%g, %g) not continuous: got %g want %g", s, x, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestPolylogSpecial(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		s, x, want float64
+	}{
+		{s: 2, x: 1, want: math.Pi * math.Pi / 6},
+		{s: 1, x: 1, want: math.Inf(1)},
+		{s: 0.5, x: 1, want: math.Inf(1)},
+		{s: 2, x: -1, want: -math.Pi * math.Pi / 12},
+		{s: 1, x: -1, want: -math.Ln2},
+		{s: 2, x: 0, want: 0},
+		{s: 0, x: 0.5, want: 1},
+		{s: 0, x: -3, want: -0.75},
+		{s: 2, x: 1.5, want: math.NaN()},
+		{s: math.NaN(), x: 0.5, want: math.NaN()},
+		{s: 2, x: math.NaN(), want: math.NaN()},
+		{s: math.Inf(1), x: 0.5, want: 0.5},
+		{s: 2, x: math.Inf(-1), want: math.Inf(-1)},
+		{s: -2, x: math.Inf(-1), want: 0},
+		{s: 0, x: math.Inf(-1), want: -1},
+	} {
+		got := Polylog(test.s, test.x)
+		if !(math.IsNaN(got) && math.IsNaN(test.want)) && !scalar.EqualWithinRel(got, test.want, 1e-15) {
+			t.Errorf("unexpected value of Polylog(%g, %g): got %g want %g", test.s, test.x, got, test.want)
+		}
+	}
+}
@@ -4,7 +4,11 @@
 
 package mathext
 
-import "gonum.org/v1/gonum/mathext/internal/cephes"
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mathext/internal/cephes"
+)
 
 // Zeta computes the Riemann zeta function of two arguments.
 //
@@ -20,3 +24,133 @@ import "gonum.org/v1/gonum/mathext/internal/cephes"
 func Zeta(x, q float64) float64 {
 	return cephes.Zeta(x, q)
 }
+
+// RiemannZeta returns the value of the Riemann zeta function at s,
+//
+//	ζ(s) = \sum_{k=1}^{\infty} k^{-s},
+//
+// analytically continued to all real s ≠ 1.
+//
+// For s > 1 the series is evaluated by Euler-Maclaurin summation. For
+// 0 ≤ s < 1 the zeta function is computed from the alternating Dirichlet
+// eta function,
+//
+//	ζ(s) = η(s) / (1 - 2^{1-s}),
+//
+// which is evaluated with the algorithm of Borwein, "An efficient algorithm
+// for the Riemann zeta function", Constructive, Experimental, and Non-Linear
+// Analysis, CMS Conf. Proc. 27:29-34, 2000. For s < 0 the reflection formula
+//
+//	ζ(s) = 2^s π^{s-1} sin(πs/2) Γ(1-s) ζ(1-s)
+//
+// is used.
+//
+// Special cases are:
+//
+//	RiemannZeta(1) = +Inf
+//	RiemannZeta(+Inf) = 1
+//	RiemannZeta(-Inf) = NaN
+//	RiemannZeta(NaN) = NaN
+//	RiemannZeta(s) = 0 for negative even integer s
+//
+// See https://dlmf.nist.gov/25.2 for more detailed information.
+func RiemannZeta(s float64) float64 {
+	switch {
+	case math.IsNaN(s), math.IsInf(s, -1):
+		return math.NaN()
+	case math.IsInf(s, 1):
+		return 1
+	case s == 1:
+		return math.Inf(1)
+	case s > 1:
+		return cephes.Zeta(s, 1)
+	case s >= 0:
+		// 1 - 2^{1-s} computed without cancellation close to s = 1.
+		return dirichletEta(s) / -math.Expm1((1-s)*math.Ln2)
+	}
+
+	// Use the reflection formula for negative s.
+	sin := sinPi(s / 2)
+	if sin == 0 {
+		return 0
+	}
+	z := cephes.Zeta(1-s, 1)
+	if 1-s < 170 {
+		return math.Pow(2*math.Pi, s) / math.Pi * sin * math.Gamma(1-s) * z
+	}
+	lg, _ := math.Lgamma(1 - s)
+	return sin * math.Exp(s*math.Log(2*math.Pi)-math.Log(math.Pi)+lg) * z
+}
+
+// etaTerms is the number of terms used by dirichletEta. The absolute error
+// of Borwein's algorithm for real s ≥ 0 is bounded by 3/(3+√8)^etaTerms.
+const etaTerms = 24
+
+// etaCoefs holds the weights 1 - d_k/d_n of Borwein's algorithm for the
+// Dirichlet eta function, where
+//
+//	d_k = n \sum_{i=0}^k (n+i-1)! 4^i / ((n-i)! (2i)!).
+var etaCoefs = func() [etaTerms]float64 {
+	const n = etaTerms
+	var d [n + 1]float64
+	t := 1.0 / n // The i = 0 term of the sum.
+	sum := t
+	d[0] = n * sum
+	for i := 1; i <= n; i++ {
+		t *= 4 * float64(n+i-1) * float64(n-i+1) / float64(2*i*(2*i-1))
+		sum += t
+		d[i] = n * sum
+	}
+	var c [n]float64
+	for k := range c {
+		c[k] = 1 - d[k]/d[n]
+	}
+	return c
+}()
+
+// dirichletEta returns the value of the Dirichlet eta function
+//
+//	η(s) = \sum_{k=1}^{\infty} (-1)^{k-1} k^{-s}
+//
+// for s ≥ 0.
+func dirichletEta(s float64) float64 {
+	var eta float64
+	for k := len(etaCoefs) - 1; k >= 0; k-- {
+		t := etaCoefs[k] * math.Pow(float64(k+1), -s)
+		if k%2 == 1 {
+			t = -t
+		}
+		eta += t
+	}
+	return eta
+}
+
+// sinPi returns sin(πx) with the argument reduced exactly, so that the
+// result is exactly zero for integer x.
+func sinPi(x float64) float64 {
+	// Reduce x to [0, 2) using sin(π(x+2k)) = sin(πx).
+	x = math.Mod(x, 2)
+	if x < 0 {
+		x += 2
+	}
+	switch {
+	case x == 0, x == 1:
+		return 0
+	case x <= 0.25:
+		return math.Sin(math.Pi * x)
+	case x <= 0.75:
+		return math.Cos(math.Pi * (x - 0.5))
+	case x <= 1.25:
+		return math.Sin(math.Pi * (1 - x))
+	case x <= 1.75:
+		return -math.Cos(math.Pi * (x - 1.5))
+	default:
+		return math.Sin(math.Pi * (x - 2))
+	}
+}
+
+// cosPi returns cos(πx) with the argument reduced exactly, so that the
+// result is exactly zero for half-integer x.
+func cosPi(x float64) float64 {
+	return sinPi(math.Mod(math.Abs(x), 2) + 0.5)
+}
@@ -7,6 +7,8 @@ package mathext
 import (
 	"math"
 	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
 )
 
 func TestZeta(t *testing.T) {
@@ -41,3 +43,66 @@ func TestZeta(t *testing.T) {
 		}
 	}
 }
+
+func TestRiemannZeta(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	for i, test := range []struct {
+		s, want float64
+	}{
+		// Results computed with Borwein's algorithm and the
+		// reflection formula in 50 digit arithmetic.
+		{s: -20.5, want: -108.21747505877606},
+		{s: -7, want: 0.0041666666666666667},
+		{s: -3, want: 0.0083333333333333333},
+		{s: -2.5, want: 0.0085169287778503305},
+		{s: -1, want: -0.083333333333333333},
+		{s: -0.5, want: -0.20788622497735457},
+		{s: -1e-8, want: -0.49999999081061477},
+		{s: 0, want: -0.50000000000000000},
+		{s: 1e-8, want: -0.50000000918938543},
+		{s: 0.25, want: -0.81327840526189166},
+		{s: 0.5, want: -1.4603545088095868},
+		{s: 0.9, want: -9.4301140194022546},
+		{s: 0.999999, want: -999999.42275565225},
+		{s: 1.000001, want: 1000000.5772980044},
+		{s: 1.5, want: 2.6123753486854883},
+		{s: 2, want: 1.6449340668482264},
+		{s: 3.7, want: 1.1062882414646792},
+		{s: 10, want: 1.0009945751278181},
+		{s: 30, want: 1.0000000009313274},
+		{s: 60, want: 1.0000000000000000},
+	} {
+		got := RiemannZeta(test.s)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, tol, tol) {
+			t.Errorf("test %d RiemannZeta(%g) failed: got %g want %g", i, test.s, got, test.want)
+		}
+	}
+
+	for s := 1.5; s < 30; s += 1.25 {
+		got, want := RiemannZeta(s), Zeta(s, 1)
+		if !scalar.EqualWithinRel(got, want, tol) {
+			t.Errorf("RiemannZeta(%g) does not match Zeta(%g, 1): got %g want %g", s, s, got, want)
+		}
+	}
+	for s := -2.0; s > -200; s -= 2 {
+		if got := RiemannZeta(s); got != 0 {
+			t.Errorf("RiemannZeta(%g) at trivial zero: got %g want 0", s, got)
+		}
+	}
+
+	for _, test := range []struct {
+		s, want float64
+	}{
+		{s: 1, want: math.Inf(1)},
+		{s: math.Inf(1), want: 1},
+		{s: math.Inf(-1), want: math.NaN()},
+		{s: math.NaN(), want: math.NaN()},
+	} {
+		got := RiemannZeta(test.s)
+		if !(math.IsNaN(got) && math.IsNaN(test.want)) && got != test.want {
+			t.Errorf("unexpected value of RiemannZeta(%g): got %g want %g", test.s, got, test.want)
+		}
+	}
+}
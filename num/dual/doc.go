@@ -7,6 +7,11 @@
 //
 // See https://en.wikipedia.org/wiki/Dual_number for details of their properties
 // and uses.
+//
+// The Multi type holds a dual number with an arbitrary number of
+// infinitesimal parts, allowing the gradient of a function of many
+// variables to be computed by forward-mode automatic differentiation in a
+// single evaluation with the Gradient function.
 package dual // imports "gonum.org/v1/gonum/num/dual"
 
 // TODO(kortschak): Handle special cases properly.
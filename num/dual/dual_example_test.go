@@ -32,3 +32,23 @@ func ExampleNumber_fike() {
 	// fn(1.5)=4.4978
 	// fn'(1.5)=4.0534
 }
+
+func ExampleGradient() {
+	// Calculate the value and gradient of the function
+	// f(x, y) = x*sin(y) + exp(x*y) at (1, 2).
+	fn := func(v []*dual.Multi) *dual.Multi {
+		x, y := v[0], v[1]
+		var a, b dual.Multi
+		a.Mul(x, b.Sin(y))
+		b.Mul(x, y)
+		b.Exp(&b)
+		return a.Add(&a, &b)
+	}
+	f, grad := dual.Gradient(nil, fn, []float64{1, 2})
+	fmt.Printf("f=%.4f\ngrad=%.4f\n", f, grad)
+
+	// Output:
+	//
+	// f=8.2984
+	// grad=[15.6874 6.9729]
+}
@@ -0,0 +1,275 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dual
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Multi is a float64 precision dual number with multiple infinitesimal
+// parts, a+b₁ϵ₁+b₂ϵ₂+…+bₙϵₙ where ϵᵢϵⱼ=0 for all i and j. Evaluating
+// a function with Multi arguments seeded by Variables computes the value
+// and the full gradient of the function in a single pass, in contrast to
+// Number which computes one directional derivative per evaluation.
+//
+// A nil or empty Emag is treated as all zeros, so constants may be
+// represented by a Multi with only the Real field set. The Emag of the
+// operands of an operation must otherwise have the same length.
+//
+// The methods of Multi follow the convention of math/big: the receiver
+// holds the result and is returned, and it may be one of the operands.
+// The storage of the receiver's Emag is reused when it is large enough.
+// The special cases of the methods are those of the corresponding
+// functions for Number.
+type Multi struct {
+	Real float64
+	Emag []float64
+}
+
+// Variables returns dual numbers holding the values in x with Emag seeded
+// to the n×n identity, where n = len(x), so that the Emag of a function
+// evaluated at the returned values is the gradient of the function at x.
+// The Emag of the returned values are stored contiguously.
+func Variables(x []float64) []*Multi {
+	n := len(x)
+	emag := make([]float64, n*n)
+	vars := make([]Multi, n)
+	v := make([]*Multi, n)
+	for i, xi := range x {
+		e := emag[i*n : (i+1)*n : (i+1)*n]
+		e[i] = 1
+		vars[i] = Multi{Real: xi, Emag: e}
+		v[i] = &vars[i]
+	}
+	return v
+}
+
+// Gradient evaluates the function f at x and returns its value and its
+// gradient, computed by forward-mode automatic differentiation with the
+// arguments of f constructed by Variables. The gradient is stored in dst
+// if it is not nil, and Gradient panics if the length of a non-nil dst is
+// not equal to len(x).
+func Gradient(dst []float64, f func(x []*Multi) *Multi, x []float64) (float64, []float64) {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) {
+		panic("dual: length mismatch")
+	}
+	v := f(Variables(x))
+	if len(v.Emag) == 0 {
+		for i := range dst {
+			dst[i] = 0
+		}
+	} else {
+		if len(v.Emag) != len(x) {
+			panic("dual: length mismatch")
+		}
+		copy(dst, v.Emag)
+	}
+	return v.Real, dst
+}
+
+// Format implements fmt.Formatter.
+func (d *Multi) Format(fs fmt.State, c rune) {
+	if d == nil {
+		fmt.Fprint(fs, "<nil>")
+		return
+	}
+	prec, pOk := fs.Precision()
+	if !pOk {
+		prec = -1
+	}
+	width, wOk := fs.Width()
+	if !wOk {
+		width = -1
+	}
+	switch c {
+	case 'v':
+		if fs.Flag('#') {
+			fmt.Fprintf(fs, "&%T{Real:%#v, Emag:%#v}", *d, d.Real, d.Emag)
+			return
+		}
+		if fs.Flag('+') {
+			fmt.Fprintf(fs, "&{Real:%+v, Emag:%+v}", d.Real, d.Emag)
+			return
+		}
+		c = 'g'
+		prec = -1
+		fallthrough
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		var b strings.Builder
+		b.WriteByte('(')
+		fmt.Fprintf(&b, fmtString(fs, c, prec, width, false), d.Real)
+		fim := fmtString(fs, c, prec, width, true)
+		for i, e := range d.Emag {
+			fmt.Fprintf(&b, fim+"ϵ%d", e, i+1)
+		}
+		b.WriteByte(')')
+		fmt.Fprint(fs, b.String())
+	default:
+		fmt.Fprintf(fs, "%%!%c(%T=%[2]v)", c, d)
+	}
+}
+
+// reuse returns the receiver's Emag resliced to length n.
+func (d *Multi) reuse(n int) []float64 {
+	if cap(d.Emag) < n {
+		return make([]float64, n)
+	}
+	return d.Emag[:n]
+}
+
+// zero is a constant zero dual number.
+var zero Multi
+
+// emagLen returns the common length of the Emag of x and y,
+// treating an empty Emag as matching any length.
+func emagLen(x, y *Multi) int {
+	nx, ny := len(x.Emag), len(y.Emag)
+	switch {
+	case nx == 0:
+		return ny
+	case ny == 0, nx == ny:
+		return nx
+	}
+	panic("dual: length mismatch")
+}
+
+// Set sets d to x and returns d.
+func (d *Multi) Set(x *Multi) *Multi {
+	if d == x {
+		return d
+	}
+	e := d.reuse(len(x.Emag))
+	copy(e, x.Emag)
+	d.Real = x.Real
+	d.Emag = e
+	return d
+}
+
+// linear sets the real part of d to r and its infinitesimal parts to the
+// linear combination of those of x and y with coefficients da and db, and
+// returns d. A nil y is treated as a constant.
+func (d *Multi) linear(r, da float64, x *Multi, db float64, y *Multi) *Multi {
+	if y == nil {
+		y = &zero
+	}
+	n := emagLen(x, y)
+	e := d.reuse(n)
+	for i := range e {
+		var v float64
+		if len(x.Emag) != 0 {
+			v += da * x.Emag[i]
+		}
+		if len(y.Emag) != 0 {
+			v += db * y.Emag[i]
+		}
+		e[i] = v
+	}
+	d.Real = r
+	d.Emag = e
+	return d
+}
+
+// Add sets d to the sum x+y and returns d.
+func (d *Multi) Add(x, y *Multi) *Multi {
+	return d.linear(x.Real+y.Real, 1, x, 1, y)
+}
+
+// Sub sets d to the difference x-y and returns d.
+func (d *Multi) Sub(x, y *Multi) *Multi {
+	return d.linear(x.Real-y.Real, 1, x, -1, y)
+}
+
+// Mul sets d to the dual product x*y and returns d.
+func (d *Multi) Mul(x, y *Multi) *Multi {
+	return d.linear(x.Real*y.Real, y.Real, x, x.Real, y)
+}
+
+// Div sets d to the dual quotient x/y and returns d.
+func (d *Multi) Div(x, y *Multi) *Multi {
+	q := x.Real / y.Real
+	return d.linear(q, 1/y.Real, x, -q/y.Real, y)
+}
+
+// Scale sets d to x scaled by f and returns d.
+func (d *Multi) Scale(f float64, x *Multi) *Multi {
+	return d.linear(f*x.Real, f, x, 0, nil)
+}
+
+// apply sets d to fn(x) for a function fn of Number and returns d.
+// The value and derivative of fn at x.Real are obtained by evaluating
+// fn with a unit infinitesimal part.
+func (d *Multi) apply(fn func(Number) Number, x *Multi) *Multi {
+	v := fn(Number{Real: x.Real, Emag: 1})
+	return d.linear(v.Real, v.Emag, x, 0, nil)
+}
+
+// Inv sets d to the dual inverse of x and returns d.
+func (d *Multi) Inv(x *Multi) *Multi { return d.apply(Inv, x) }
+
+// Abs sets d to the absolute value of x and returns d.
+func (d *Multi) Abs(x *Multi) *Multi { return d.apply(Abs, x) }
+
+// PowReal sets d to x**p, the base-x exponential of p, and returns d.
+func (d *Multi) PowReal(x *Multi, p float64) *Multi {
+	return d.apply(func(x Number) Number { return PowReal(x, p) }, x)
+}
+
+// Pow sets d to x**p, the base-x exponential of p, and returns d.
+func (d *Multi) Pow(x, p *Multi) *Multi {
+	// The partial derivatives with respect to x and p are
+	// obtained from separate evaluations of the scalar Pow.
+	dx := Pow(Number{Real: x.Real, Emag: 1}, Number{Real: p.Real})
+	dp := Pow(Number{Real: x.Real}, Number{Real: p.Real, Emag: 1})
+	return d.linear(dx.Real, dx.Emag, x, dp.Emag, p)
+}
+
+// Sqrt sets d to the square root of x and returns d.
+func (d *Multi) Sqrt(x *Multi) *Multi { return d.apply(Sqrt, x) }
+
+// Exp sets d to e**x, the base-e exponential of x, and returns d.
+func (d *Multi) Exp(x *Multi) *Multi { return d.apply(Exp, x) }
+
+// Log sets d to the natural logarithm of x and returns d.
+func (d *Multi) Log(x *Multi) *Multi { return d.apply(Log, x) }
+
+// Sin sets d to the sine of x and returns d.
+func (d *Multi) Sin(x *Multi) *Multi { return d.apply(Sin, x) }
+
+// Cos sets d to the cosine of x and returns d.
+func (d *Multi) Cos(x *Multi) *Multi { return d.apply(Cos, x) }
+
+// Tan sets d to the tangent of x and returns d.
+func (d *Multi) Tan(x *Multi) *Multi { return d.apply(Tan, x) }
+
+// Asin sets d to the inverse sine of x and returns d.
+func (d *Multi) Asin(x *Multi) *Multi { return d.apply(Asin, x) }
+
+// Acos sets d to the inverse cosine of x and returns d.
+func (d *Multi) Acos(x *Multi) *Multi { return d.apply(Acos, x) }
+
+// Atan sets d to the inverse tangent of x and returns d.
+func (d *Multi) Atan(x *Multi) *Multi { return d.apply(Atan, x) }
+
+// Sinh sets d to the hyperbolic sine of x and returns d.
+func (d *Multi) Sinh(x *Multi) *Multi { return d.apply(Sinh, x) }
+
+// Cosh sets d to the hyperbolic cosine of x and returns d.
+func (d *Multi) Cosh(x *Multi) *Multi { return d.apply(Cosh, x) }
+
+// Tanh sets d to the hyperbolic tangent of x and returns d.
+func (d *Multi) Tanh(x *Multi) *Multi { return d.apply(Tanh, x) }
+
+// Asinh sets d to the inverse hyperbolic sine of x and returns d.
+func (d *Multi) Asinh(x *Multi) *Multi { return d.apply(Asinh, x) }
+
+// Acosh sets d to the inverse hyperbolic cosine of x and returns d.
+func (d *Multi) Acosh(x *Multi) *Multi { return d.apply(Acosh, x) }
+
+// Atanh sets d to the inverse hyperbolic tangent of x and returns d.
+func (d *Multi) Atanh(x *Multi) *Multi { return d.apply(Atanh, x) }
@@ -0,0 +1,240 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dual
+
+import (
+	"fmt"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestMultiUnary(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, test := range []struct {
+		name   string
+		x      []float64
+		fnDual func(x Number) Number
+		fnMult func(d, x *Multi) *Multi
+	}{
+		{name: "inv", x: []float64{-2, -0.5, 0.5, 3}, fnDual: Inv, fnMult: (*Multi).Inv},
+		{name: "abs", x: []float64{-2, -0.5, 0.5, 3}, fnDual: Abs, fnMult: (*Multi).Abs},
+		{name: "sqrt", x: []float64{0.5, 1, 3}, fnDual: Sqrt, fnMult: (*Multi).Sqrt},
+		{name: "exp", x: []float64{-2, -0.5, 0, 0.5, 3}, fnDual: Exp, fnMult: (*Multi).Exp},
+		{name: "log", x: []float64{0.5, 1, 3}, fnDual: Log, fnMult: (*Multi).Log},
+		{name: "sin", x: []float64{-2, -0.5, 0, 0.5, 3}, fnDual: Sin, fnMult: (*Multi).Sin},
+		{name: "cos", x: []float64{-2, -0.5, 0, 0.5, 3}, fnDual: Cos, fnMult: (*Multi).Cos},
+		{name: "tan", x: []float64{-1, -0.5, 0, 0.5, 1}, fnDual: Tan, fnMult: (*Multi).Tan},
+		{name: "asin", x: []float64{-0.5, 0, 0.5}, fnDual: Asin, fnMult: (*Multi).Asin},
+		{name: "acos", x: []float64{-0.5, 0, 0.5}, fnDual: Acos, fnMult: (*Multi).Acos},
+		{name: "atan", x: []float64{-2, -0.5, 0, 0.5, 3}, fnDual: Atan, fnMult: (*Multi).Atan},
+		{name: "sinh", x: []float64{-2, -0.5, 0, 0.5, 3}, fnDual: Sinh, fnMult: (*Multi).Sinh},
+		{name: "cosh", x: []float64{-2, -0.5, 0, 0.5, 3}, fnDual: Cosh, fnMult: (*Multi).Cosh},
+		{name: "tanh", x: []float64{-2, -0.5, 0, 0.5, 3}, fnDual: Tanh, fnMult: (*Multi).Tanh},
+		{name: "asinh", x: []float64{-2, -0.5, 0, 0.5, 3}, fnDual: Asinh, fnMult: (*Multi).Asinh},
+		{name: "acosh", x: []float64{1.5, 2, 3}, fnDual: Acosh, fnMult: (*Multi).Acosh},
+		{name: "atanh", x: []float64{-0.5, 0, 0.5}, fnDual: Atanh, fnMult: (*Multi).Atanh},
+		{
+			name:   "powreal",
+			x:      []float64{0.5, 1, 3},
+			fnDual: func(x Number) Number { return PowReal(x, 2.5) },
+			fnMult: func(d, x *Multi) *Multi { return d.PowReal(x, 2.5) },
+		},
+	} {
+		emag := []float64{2, -1, 0}
+		for _, x := range test.x {
+			want := test.fnDual(Number{Real: x, Emag: 1})
+			var d Multi
+			got := test.fnMult(&d, &Multi{Real: x, Emag: emag})
+			if got != &d {
+				t.Errorf("%s did not return receiver", test.name)
+			}
+			if !scalar.EqualWithinAbsOrRel(got.Real, want.Real, tol, tol) {
+				t.Errorf("unexpected %s(%v) real part: got:%v want:%v", test.name, x, got.Real, want.Real)
+			}
+			for i, e := range emag {
+				if !scalar.EqualWithinAbsOrRel(got.Emag[i], want.Emag*e, tol, tol) {
+					t.Errorf("unexpected %s(%v) infinitesimal part %d: got:%v want:%v", test.name, x, i, got.Emag[i], want.Emag*e)
+				}
+			}
+
+			// Check in place operation.
+			x := &Multi{Real: x, Emag: append([]float64(nil), emag...)}
+			test.fnMult(x, x)
+			if !scalar.EqualWithinAbsOrRel(x.Real, got.Real, tol, tol) || !floats.EqualApprox(x.Emag, got.Emag, tol) {
+				t.Errorf("unexpected in place %s result: got:%v want:%v", test.name, x, got)
+			}
+		}
+	}
+}
+
+func TestMultiBinary(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, test := range []struct {
+		name   string
+		fnDual func(x, y Number) Number
+		fnMult func(d, x, y *Multi) *Multi
+	}{
+		{name: "add", fnDual: Add, fnMult: (*Multi).Add},
+		{name: "sub", fnDual: Sub, fnMult: (*Multi).Sub},
+		{name: "mul", fnDual: Mul, fnMult: (*Multi).Mul},
+		{name: "div", fnDual: func(x, y Number) Number { return Mul(x, Inv(y)) }, fnMult: (*Multi).Div},
+		{name: "pow", fnDual: Pow, fnMult: (*Multi).Pow},
+	} {
+		x := &Multi{Real: 1.5, Emag: []float64{1, 0, 2}}
+		y := &Multi{Real: 0.7, Emag: []float64{0, 1, -1}}
+		var got Multi
+		test.fnMult(&got, x, y)
+		for i := range x.Emag {
+			want := test.fnDual(Number{Real: x.Real, Emag: x.Emag[i]}, Number{Real: y.Real, Emag: y.Emag[i]})
+			if !scalar.EqualWithinAbsOrRel(got.Real, want.Real, tol, tol) {
+				t.Errorf("unexpected %s real part: got:%v want:%v", test.name, got.Real, want.Real)
+			}
+			if !scalar.EqualWithinAbsOrRel(got.Emag[i], want.Emag, tol, tol) {
+				t.Errorf("unexpected %s infinitesimal part %d: got:%v want:%v", test.name, i, got.Emag[i], want.Emag)
+			}
+		}
+
+		// Check constant operands.
+		c := &Multi{Real: y.Real}
+		test.fnMult(&got, x, c)
+		for i := range x.Emag {
+			want := test.fnDual(Number{Real: x.Real, Emag: x.Emag[i]}, Number{Real: y.Real})
+			if !scalar.EqualWithinAbsOrRel(got.Emag[i], want.Emag, tol, tol) {
+				t.Errorf("unexpected %s infinitesimal part %d with constant: got:%v want:%v", test.name, i, got.Emag[i], want.Emag)
+			}
+		}
+
+		// Check aliased operands.
+		want := test.fnMult(&Multi{}, x, x)
+		test.fnMult(x, x, x)
+		if x.Real != want.Real || !floats.Equal(x.Emag, want.Emag) {
+			t.Errorf("unexpected aliased %s result: got:%v want:%v", test.name, x, want)
+		}
+
+		if !panics(func() { test.fnMult(&got, &Multi{Emag: []float64{1}}, &Multi{Emag: []float64{1, 2}}) }) {
+			t.Errorf("expected panic for mismatched lengths in %s", test.name)
+		}
+	}
+}
+
+func TestGradient(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	// The Rosenbrock function.
+	rosen := func(x []*Multi) *Multi {
+		sum := &Multi{}
+		var a, b Multi
+		for i := 0; i < len(x)-1; i++ {
+			a.Mul(x[i], x[i])
+			a.Sub(x[i+1], &a)
+			a.Mul(&a, &a)
+			a.Scale(100, &a)
+			b.Sub(&Multi{Real: 1}, x[i])
+			b.Mul(&b, &b)
+			sum.Add(sum, &a)
+			sum.Add(sum, &b)
+		}
+		return sum
+	}
+	rosenGrad := func(x []float64) (float64, []float64) {
+		var f float64
+		g := make([]float64, len(x))
+		for i := 0; i < len(x)-1; i++ {
+			a := x[i+1] - x[i]*x[i]
+			b := 1 - x[i]
+			f += 100*a*a + b*b
+			g[i] += -400*a*x[i] - 2*b
+			g[i+1] += 200 * a
+		}
+		return f, g
+	}
+	for _, x := range [][]float64{
+		{1, 1},
+		{-1.2, 1},
+		{0.5, -0.3, 2, 1.1, -0.7},
+	} {
+		f, g := Gradient(nil, rosen, x)
+		wantF, wantG := rosenGrad(x)
+		if !scalar.EqualWithinAbsOrRel(f, wantF, tol, tol) {
+			t.Errorf("unexpected value at %v: got:%v want:%v", x, f, wantF)
+		}
+		if !floats.EqualApprox(g, wantG, tol) {
+			t.Errorf("unexpected gradient at %v: got:%v want:%v", x, g, wantG)
+		}
+
+		dst := make([]float64, len(x))
+		_, g = Gradient(dst, rosen, x)
+		if &g[0] != &dst[0] || !floats.EqualApprox(dst, wantG, tol) {
+			t.Errorf("gradient not stored in dst at %v", x)
+		}
+	}
+
+	_, g := Gradient(nil, func(x []*Multi) *Multi { return &Multi{Real: 1} }, []float64{1, 2})
+	if !floats.Equal(g, []float64{0, 0}) {
+		t.Errorf("unexpected gradient of constant: got:%v want:[0 0]", g)
+	}
+	if !panics(func() { Gradient(make([]float64, 1), rosen, []float64{1, 2}) }) {
+		t.Errorf("expected panic for mismatched dst length")
+	}
+}
+
+func TestVariables(t *testing.T) {
+	t.Parallel()
+	v := Variables([]float64{1, 2, 3})
+	for i, d := range v {
+		if d.Real != float64(i+1) {
+			t.Errorf("unexpected real part for variable %d: got:%v want:%v", i, d.Real, i+1)
+		}
+		for j, e := range d.Emag {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if e != want {
+				t.Errorf("unexpected seed for variable %d at %d: got:%v want:%v", i, j, e, want)
+			}
+		}
+	}
+
+	// Growing a variable in place must not overwrite its neighbours.
+	v[0].Set(&Multi{Emag: []float64{5, 5, 5, 5}})
+	if v[1].Emag[0] != 0 {
+		t.Errorf("variable storage overwritten: %v", v[1])
+	}
+}
+
+func TestMultiFormat(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		d      *Multi
+		format string
+		want   string
+	}{
+		{d: &Multi{Real: 1, Emag: []float64{2, -3}}, format: "%v", want: "(1+2ϵ1-3ϵ2)"},
+		{d: &Multi{Real: 1, Emag: []float64{2, -3}}, format: "%.2f", want: "(1.00+2.00ϵ1-3.00ϵ2)"},
+		{d: &Multi{Real: 1}, format: "%v", want: "(1)"},
+		{d: &Multi{Real: 1, Emag: []float64{2}}, format: "%+v", want: "&{Real:1, Emag:[2]}"},
+		{d: &Multi{Real: 1, Emag: []float64{2}}, format: "%#v", want: "&dual.Multi{Real:1, Emag:[]float64{2}}"},
+		{d: nil, format: "%v", want: "<nil>"},
+	} {
+		got := fmt.Sprintf(test.format, test.d)
+		if got != test.want {
+			t.Errorf("unexpected result for fmt.Sprintf(%q, %#v): got:%q, want:%q", test.format, test.d, got, test.want)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dualquat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/num/quat"
+)
+
+// The functions in this file operate on unit dual quaternions representing
+// rigid transformations in three dimensions. A unit dual quaternion r+eϵ
+// satisfies |r| = 1 and r·e = 0 and transforms a point p, held in the
+// imaginary parts of a quaternion, by
+//
+//	(r+eϵ)(1+pϵ)Conj(r+eϵ) = 1+(r p r̅ + t)ϵ,
+//
+// where t = 2 e r̅ is the translation of the transform. The product
+// Mul(b, a) of two transforms applies a and then b.
+
+// FromRotationTranslation returns the unit dual quaternion for the rigid
+// transformation that rotates by the unit quaternion rot and then translates
+// by trans, held in the imaginary parts of a quaternion.
+func FromRotationTranslation(rot, trans quat.Number) Number {
+	trans.Real = 0
+	return Number{
+		Real: rot,
+		Dual: quat.Scale(0.5, quat.Mul(trans, rot)),
+	}
+}
+
+// RotationTranslation returns the rotation and translation of the rigid
+// transformation represented by the unit dual quaternion d, so that
+// FromRotationTranslation(RotationTranslation(d)) = d.
+func RotationTranslation(d Number) (rot, trans quat.Number) {
+	trans = quat.Scale(2, quat.Mul(d.Dual, quat.Conj(d.Real)))
+	trans.Real = 0
+	return d.Real, trans
+}
+
+// Transform returns the point p, held in the imaginary parts of a quaternion,
+// transformed by the unit dual quaternion d.
+func Transform(d Number, p quat.Number) quat.Number {
+	p.Real = 0
+	rot, trans := RotationTranslation(d)
+	q := quat.Add(quat.Mul(quat.Mul(rot, p), quat.Conj(rot)), trans)
+	q.Real = 0
+	return q
+}
+
+// Normalize returns the unit dual quaternion closest to d, which represents
+// the same rigid transformation as d when d is a non-zero multiple of a unit
+// dual quaternion. The real part of d is scaled to unit length and the
+// component of the dual part parallel to the real part is removed.
+func Normalize(d Number) Number {
+	n := quat.Abs(d.Real)
+	r := quat.Scale(1/n, d.Real)
+	e := quat.Scale(1/n, d.Dual)
+	return Number{
+		Real: r,
+		Dual: quat.Sub(e, quat.Scale(dot(r, e), r)),
+	}
+}
+
+// Matrix returns the 4×4 homogeneous transformation matrix corresponding to
+// the unit dual quaternion d, so that the product of the matrix and the column
+// vector [x, y, z, 1] is the point (x, y, z) transformed by d.
+func Matrix(d Number) [4][4]float64 {
+	rot, trans := RotationTranslation(d)
+	r := quat.RotationMatrix(rot)
+	return [4][4]float64{
+		{r[0][0], r[0][1], r[0][2], trans.Imag},
+		{r[1][0], r[1][1], r[1][2], trans.Jmag},
+		{r[2][0], r[2][1], r[2][2], trans.Kmag},
+		{0, 0, 0, 1},
+	}
+}
+
+// FromMatrix returns the unit dual quaternion, with a real part that has a
+// non-negative real component, corresponding to the 4×4 homogeneous
+// transformation matrix m. The upper left 3×3 block of m must be a rotation
+// matrix, and the last row of m is ignored.
+func FromMatrix(m [4][4]float64) Number {
+	rot := quat.FromRotationMatrix([3][3]float64{
+		{m[0][0], m[0][1], m[0][2]},
+		{m[1][0], m[1][1], m[1][2]},
+		{m[2][0], m[2][1], m[2][2]},
+	})
+	return FromRotationTranslation(rot, quat.Number{Imag: m[0][3], Jmag: m[1][3], Kmag: m[2][3]})
+}
+
+// Sclerp returns the screw linear interpolation between the unit dual
+// quaternions d0 and d1 at t,
+//
+//	Sclerp(d0, d1, t) = d0 (d0⁻¹ d1)^t,
+//
+// with Sclerp(d0, d1, 0) = d0 and Sclerp(d0, d1, 1) = ±d1. The interpolated
+// transformations follow the screw motion from d0 to d1, that is, a rotation
+// around a fixed axis at a constant rate combined with a translation along
+// that axis at a constant rate, which is the rigid body counterpart of the
+// spherical linear interpolation of rotations. The interpolation follows the
+// shorter of the two rotations between d0 and d1, negating d1 if necessary.
+//
+// See Kavan, Collins, Žára and O'Sullivan, "Geometric skinning with
+// approximate dual quaternion blending", ACM Transactions on Graphics 27(4),
+// 2008.
+func Sclerp(d0, d1 Number, t float64) Number {
+	if dot(d0.Real, d1.Real) < 0 {
+		d1 = Scale(-1, d1)
+	}
+	// The quaternion conjugate is the inverse of a unit dual quaternion.
+	return Mul(d0, expPure(Scale(t, logUnit(Mul(ConjQuat(d0), d1)))))
+}
+
+// logUnit returns the logarithm of the unit dual quaternion d, which must
+// have a real part with a non-negative real component. The returned value
+// has zero scalar parts and is the product of the dual half angle and the
+// dual unit vector of the screw axis of d.
+func logUnit(d Number) Number {
+	r, e := d.Real, d.Dual
+	v := quat.Number{Imag: r.Imag, Jmag: r.Jmag, Kmag: r.Kmag}
+	a := math.Atan2(quat.Abs(v), r.Real)
+	f := 1 / sinc(a)
+	ev := quat.Number{Imag: e.Imag, Jmag: e.Jmag, Kmag: e.Kmag}
+	return Number{
+		Real: quat.Scale(f, v),
+		Dual: quat.Sub(quat.Scale(f, ev), quat.Scale(e.Real*cotDiff(a), v)),
+	}
+}
+
+// expPure returns the exponential of the dual quaternion d with zero
+// scalar parts, which is a unit dual quaternion.
+func expPure(d Number) Number {
+	u, w := d.Real, d.Dual
+	phi := quat.Abs(u)
+	sc := sinc(phi)
+	uw := dot(u, w)
+	re := quat.Scale(sc, u)
+	re.Real = math.Cos(phi)
+	du := quat.Add(quat.Scale(sc, w), quat.Scale(sincDiff(phi)*uw, u))
+	du.Real = -sc * uw
+	return Number{Real: re, Dual: du}
+}
+
+// sinc returns sin(x)/x.
+func sinc(x float64) float64 {
+	if math.Abs(x) < 1e-4 {
+		return 1 - x*x/6
+	}
+	return math.Sin(x) / x
+}
+
+// sincDiff returns (x cos(x) - sin(x))/x³, the derivative of sinc at x
+// divided by x.
+func sincDiff(x float64) float64 {
+	if math.Abs(x) < 1e-3 {
+		return -1.0/3 + x*x/30
+	}
+	sin, cos := math.Sincos(x)
+	return (x*cos - sin) / (x * x * x)
+}
+
+// cotDiff returns (1 - x cot(x))/sin²(x).
+func cotDiff(x float64) float64 {
+	if math.Abs(x) < 1e-3 {
+		return 1.0/3 + 2*x*x/15
+	}
+	sin, cos := math.Sincos(x)
+	return (1 - x*cos/sin) / (sin * sin)
+}
+
+// Blend returns the weighted blend of the rigid transformations represented
+// by the unit dual quaternions ds with the given weights, computed by dual
+// quaternion linear blending: the weighted sum of the dual quaternions is
+// normalized to a unit dual quaternion. Each dual quaternion is negated if
+// needed so that its rotation is in the same hemisphere as that of ds[0].
+// For two transformations, the blend approximates Sclerp and is equal to it
+// for equal weights.
+//
+// Blend panics if the lengths of ds and weights do not match or if ds is
+// empty. If the weighted sum has a zero real part, the result is NaN.
+//
+// See Kavan, Collins, Žára and O'Sullivan, "Geometric skinning with
+// approximate dual quaternion blending", ACM Transactions on Graphics 27(4),
+// 2008.
+func Blend(ds []Number, weights []float64) Number {
+	if len(ds) != len(weights) {
+		panic("dualquat: length mismatch")
+	}
+	if len(ds) == 0 {
+		panic("dualquat: no transformations to blend")
+	}
+	var sum Number
+	for i, d := range ds {
+		w := weights[i]
+		if dot(ds[0].Real, d.Real) < 0 {
+			w = -w
+		}
+		sum = Add(sum, Scale(w, d))
+	}
+	return Normalize(sum)
+}
+
+// dot returns the Euclidean inner product of x and y.
+func dot(x, y quat.Number) float64 {
+	return x.Real*y.Real + x.Imag*y.Imag + x.Jmag*y.Jmag + x.Kmag*y.Kmag
+}
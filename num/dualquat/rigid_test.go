@@ -0,0 +1,246 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dualquat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/num/quat"
+)
+
+// axisAngle returns the unit quaternion for a rotation by alpha
+// around the axis (x, y, z).
+func axisAngle(alpha, x, y, z float64) quat.Number {
+	n := math.Sqrt(x*x + y*y + z*z)
+	s, c := math.Sincos(alpha / 2)
+	return quat.Number{Real: c, Imag: s * x / n, Jmag: s * y / n, Kmag: s * z / n}
+}
+
+// vec returns the quaternion holding the vector (x, y, z).
+func vec(x, y, z float64) quat.Number {
+	return quat.Number{Imag: x, Jmag: y, Kmag: z}
+}
+
+// screw returns the unit dual quaternion for a rotation by alpha around the
+// axis (0, 0, 1) passing through the point p combined with a translation by
+// d along the axis.
+func screw(alpha, d float64, p quat.Number) Number {
+	to := FromRotationTranslation(quat.Number{Real: 1}, p)
+	from := FromRotationTranslation(quat.Number{Real: 1}, quat.Scale(-1, p))
+	return Mul(to, Mul(FromRotationTranslation(axisAngle(alpha, 0, 0, 1), vec(0, 0, d)), from))
+}
+
+// randRigid returns a random unit dual quaternion.
+func randRigid(rnd *rand.Rand) Number {
+	rot := quat.Number{Real: rnd.NormFloat64(), Imag: rnd.NormFloat64(), Jmag: rnd.NormFloat64(), Kmag: rnd.NormFloat64()}
+	rot = quat.Scale(1/quat.Abs(rot), rot)
+	return FromRotationTranslation(rot, vec(rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64()))
+}
+
+// sameRigid returns whether a and b represent the same rigid transformation
+// within tol.
+func sameRigid(a, b Number, tol float64) bool {
+	return sameDual(a, b, tol) || sameDual(a, Scale(-1, b), tol)
+}
+
+func TestRotationTranslation(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for i, test := range []struct {
+		rot, trans quat.Number
+		p, want    quat.Number
+	}{
+		{rot: quat.Number{Real: 1}, trans: vec(1, 2, 3), p: vec(1, 1, 1), want: vec(2, 3, 4)},
+		{rot: axisAngle(math.Pi/2, 0, 0, 1), trans: vec(0, 0, 0), p: vec(1, 0, 0), want: vec(0, 1, 0)},
+		{rot: axisAngle(math.Pi/2, 0, 0, 1), trans: vec(1, 0, 0), p: vec(1, 0, 0), want: vec(1, 1, 0)},
+		{rot: axisAngle(2*math.Pi/3, 1, 1, 1), trans: vec(3, 4, 5), p: vec(1, 0, 0), want: vec(3, 5, 5)},
+	} {
+		d := FromRotationTranslation(test.rot, test.trans)
+		got := Transform(d, test.p)
+		if !equalApprox(got, test.want, tol) {
+			t.Errorf("unexpected transformed point for test %d: got:%v want:%v", i, got, test.want)
+		}
+
+		// Check agreement with the transformation of points
+		// by conjugation.
+		pd := Mul(Mul(d, Number{Real: quat.Number{Real: 1}, Dual: test.p}), Conj(d))
+		if !equalApprox(pd.Dual, test.want, tol) {
+			t.Errorf("unexpected conjugated point for test %d: got:%v want:%v", i, pd.Dual, test.want)
+		}
+
+		rot, trans := RotationTranslation(d)
+		if !equalApprox(rot, test.rot, tol) || !equalApprox(trans, test.trans, tol) {
+			t.Errorf("unexpected round trip for test %d: got:(%v, %v) want:(%v, %v)",
+				i, rot, trans, test.rot, test.trans)
+		}
+
+		m := Matrix(d)
+		for j, w := range []float64{test.want.Imag, test.want.Jmag, test.want.Kmag} {
+			got := m[j][0]*test.p.Imag + m[j][1]*test.p.Jmag + m[j][2]*test.p.Kmag + m[j][3]
+			if math.Abs(got-w) > tol*4 {
+				t.Errorf("unexpected matrix transform for test %d: got element %d = %v want %v", i, j, got, w)
+			}
+		}
+		if m[3] != [4]float64{0, 0, 0, 1} {
+			t.Errorf("unexpected last matrix row for test %d: %v", i, m[3])
+		}
+		if got := FromMatrix(m); !sameRigid(got, d, tol) {
+			t.Errorf("unexpected matrix round trip for test %d: got:%v want:%v", i, got, d)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		d := randRigid(rnd)
+		got := Normalize(Scale(3.5, d))
+		if !sameDual(got, d, tol) {
+			t.Errorf("unexpected normalization of scaled dual quaternion: got:%v want:%v", got, d)
+		}
+
+		// Perturb the dual part along the real part.
+		p := Number{Real: d.Real, Dual: quat.Add(d.Dual, quat.Scale(0.1, d.Real))}
+		got = Normalize(p)
+		if !sameDual(got, d, tol) {
+			t.Errorf("unexpected normalization of perturbed dual quaternion: got:%v want:%v", got, d)
+		}
+	}
+}
+
+func TestSclerp(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	for i, test := range []struct {
+		d0, d1 Number
+		t      float64
+		want   Number
+	}{
+		// Pure translation.
+		{
+			d0:   FromRotationTranslation(quat.Number{Real: 1}, vec(0, 0, 0)),
+			d1:   FromRotationTranslation(quat.Number{Real: 1}, vec(2, 4, -6)),
+			t:    0.25,
+			want: FromRotationTranslation(quat.Number{Real: 1}, vec(0.5, 1, -1.5)),
+		},
+		// Pure rotation.
+		{
+			d0:   FromRotationTranslation(quat.Number{Real: 1}, vec(0, 0, 0)),
+			d1:   FromRotationTranslation(axisAngle(math.Pi/2, 1, 2, 3), vec(0, 0, 0)),
+			t:    0.5,
+			want: FromRotationTranslation(axisAngle(math.Pi/4, 1, 2, 3), vec(0, 0, 0)),
+		},
+		// Rotation around an axis not through the origin.
+		{
+			d0:   screw(0, 0, vec(1, 0, 0)),
+			d1:   screw(math.Pi/2, 0, vec(1, 0, 0)),
+			t:    0.5,
+			want: screw(math.Pi/4, 0, vec(1, 0, 0)),
+		},
+		// Helical motion.
+		{
+			d0:   screw(0.3, 1, vec(1, -2, 0)),
+			d1:   screw(2.3, 5, vec(1, -2, 0)),
+			t:    0.75,
+			want: screw(1.8, 4, vec(1, -2, 0)),
+		},
+		// Extrapolation.
+		{
+			d0:   screw(0.3, 1, vec(1, -2, 0)),
+			d1:   screw(0.8, 2, vec(1, -2, 0)),
+			t:    2,
+			want: screw(1.3, 3, vec(1, -2, 0)),
+		},
+		// Shorter rotation.
+		{
+			d0:   screw(0, 0, vec(1, 0, 0)),
+			d1:   Scale(-1, screw(math.Pi/2, 2, vec(1, 0, 0))),
+			t:    0.5,
+			want: screw(math.Pi/4, 1, vec(1, 0, 0)),
+		},
+		// Nearly equal transformations.
+		{
+			d0:   screw(1, 1, vec(1, 0, 0)),
+			d1:   screw(1+1e-9, 1+1e-9, vec(1, 0, 0)),
+			t:    0.5,
+			want: screw(1+5e-10, 1+5e-10, vec(1, 0, 0)),
+		},
+	} {
+		for _, x := range []float64{0, 1} {
+			got := Sclerp(test.d0, test.d1, x)
+			want := []Number{test.d0, test.d1}[int(x)]
+			if !sameRigid(got, want, tol) {
+				t.Errorf("unexpected end point for test %d at t=%v: got:%v want:%v", i, x, got, want)
+			}
+		}
+		got := Sclerp(test.d0, test.d1, test.t)
+		if !sameRigid(got, test.want, tol) {
+			t.Errorf("unexpected result for test %d: got:%v want:%v", i, got, test.want)
+		}
+		if !sameDual(Normalize(got), got, tol) {
+			t.Errorf("result not unit for test %d: %v", i, got)
+		}
+	}
+
+	// Sclerp agrees with Slerp for rotations and with
+	// linear interpolation of translations.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		d0 := randRigid(rnd)
+		d1 := randRigid(rnd)
+		x := rnd.Float64()
+		got := Sclerp(d0, d1, x)
+		rot, _ := RotationTranslation(got)
+		want := quat.Slerp(d0.Real, d1.Real, x)
+		if !equalApprox(rot, want, tol) && !equalApprox(rot, quat.Scale(-1, want), tol) {
+			t.Errorf("unexpected rotation: got:%v want:%v", rot, want)
+		}
+	}
+}
+
+func TestBlend(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		d0 := randRigid(rnd)
+		d1 := randRigid(rnd)
+		for _, w := range []float64{0, 0.5, 1} {
+			got := Blend([]Number{d0, Scale(-1, d1)}, []float64{1 - w, w})
+			want := Sclerp(d0, d1, w)
+			if !sameRigid(got, want, tol) {
+				t.Errorf("unexpected blend with weight %v: got:%v want:%v", w, got, want)
+			}
+		}
+	}
+
+	ds := []Number{randRigid(rnd), randRigid(rnd), randRigid(rnd)}
+	got := Blend(ds, []float64{0, 1, 0})
+	if !sameRigid(got, ds[1], tol) {
+		t.Errorf("unexpected blend with single non-zero weight: got:%v want:%v", got, ds[1])
+	}
+
+	for _, test := range []struct {
+		ds      []Number
+		weights []float64
+	}{
+		{ds: nil, weights: nil},
+		{ds: ds, weights: []float64{1}},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %d transforms and %d weights", len(test.ds), len(test.weights))
+				}
+			}()
+			Blend(test.ds, test.weights)
+		}()
+	}
+}
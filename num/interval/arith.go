@@ -0,0 +1,171 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import "math"
+
+// Add returns the sum of x and y.
+func Add(x, y Number) Number {
+	if x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	return Number{Min: addDown(x.Min, y.Min), Max: addUp(x.Max, y.Max)}
+}
+
+// Sub returns the difference of x and y, x-y.
+func Sub(x, y Number) Number {
+	if x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	return Number{Min: subDown(x.Min, y.Max), Max: subUp(x.Max, y.Min)}
+}
+
+// Neg returns the negation of x.
+func Neg(x Number) Number {
+	if x.IsEmpty() {
+		return x
+	}
+	return Number{Min: -x.Max, Max: -x.Min}
+}
+
+// Scale returns x scaled by the finite factor f.
+func Scale(f float64, x Number) Number {
+	return Mul(Point(f), x)
+}
+
+// Mul returns the product of x and y.
+func Mul(x, y Number) Number {
+	if x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	lo := math.Min(
+		math.Min(mulDown(x.Min, y.Min), mulDown(x.Min, y.Max)),
+		math.Min(mulDown(x.Max, y.Min), mulDown(x.Max, y.Max)),
+	)
+	hi := math.Max(
+		math.Max(mulUp(x.Min, y.Min), mulUp(x.Min, y.Max)),
+		math.Max(mulUp(x.Max, y.Min), mulUp(x.Max, y.Max)),
+	)
+	return Number{Min: lo, Max: hi}
+}
+
+// Div returns the quotient of x and y, x/y.
+//
+// Special cases are:
+//
+//	Div(x, [0, 0]) = Empty()
+//	Div(x, y) = Entire() if y contains zero in its interior
+//	Div(x, y) = Entire() if x and y both contain zero
+//
+// and the result is unbounded on one side if y has zero as a bound
+// and x does not contain zero.
+func Div(x, y Number) Number {
+	switch {
+	case x.IsEmpty() || y.IsEmpty():
+		return Empty()
+	case y.Min == 0 && y.Max == 0:
+		return Empty()
+	case !y.Contains(0):
+		lo := math.Min(
+			math.Min(divDown(x.Min, y.Min), divDown(x.Min, y.Max)),
+			math.Min(divDown(x.Max, y.Min), divDown(x.Max, y.Max)),
+		)
+		hi := math.Max(
+			math.Max(divUp(x.Min, y.Min), divUp(x.Min, y.Max)),
+			math.Max(divUp(x.Max, y.Min), divUp(x.Max, y.Max)),
+		)
+		return Number{Min: lo, Max: hi}
+	case x.Contains(0), y.Min < 0 && 0 < y.Max:
+		return Entire()
+	}
+
+	// y has zero as exactly one of its bounds and x does not contain zero.
+	inf := math.Inf(1)
+	switch {
+	case x.Min > 0 && y.Min == 0:
+		return Number{Min: divDown(x.Min, y.Max), Max: inf}
+	case x.Min > 0:
+		return Number{Min: -inf, Max: divUp(x.Min, y.Min)}
+	case y.Min == 0:
+		return Number{Min: -inf, Max: divUp(x.Max, y.Max)}
+	default:
+		return Number{Min: divDown(x.Max, y.Min), Max: inf}
+	}
+}
+
+// Inv returns the reciprocal of x, 1/x, with the special cases of Div.
+func Inv(x Number) Number {
+	return Div(Number{Min: 1, Max: 1}, x)
+}
+
+// Abs returns the set of absolute values of the members of x.
+func Abs(x Number) Number {
+	if x.IsEmpty() {
+		return x
+	}
+	return Number{Min: x.Mig(), Max: x.Mag()}
+}
+
+// Sqr returns the set of squares of the members of x. Unlike Mul(x, x),
+// the result does not contain negative numbers.
+func Sqr(x Number) Number {
+	return Pow(x, 2)
+}
+
+// Pow returns the set of the members of x raised to the integer power n.
+// Pow(x, 0) is [1, 1] for non-empty x, and for negative n the result is
+// the reciprocal of Pow(x, -n), with the special cases of Div.
+func Pow(x Number, n int) Number {
+	switch {
+	case x.IsEmpty():
+		return x
+	case n == 0:
+		return Number{Min: 1, Max: 1}
+	case n < 0:
+		return Inv(Pow(x, -n))
+	case n%2 == 1:
+		// Odd powers are monotonically increasing.
+		return Number{Min: powDown(x.Min, n), Max: powUp(x.Max, n)}
+	}
+	return Number{Min: powDown(x.Mig(), n), Max: powUp(x.Mag(), n)}
+}
+
+// powDown returns x**n rounded toward negative infinity for n > 0.
+func powDown(x float64, n int) float64 {
+	if x < 0 {
+		if n%2 == 1 {
+			return -powUp(-x, n)
+		}
+		x = -x
+	}
+	// Products of non-negative numbers rounded down are
+	// monotonic, so the repeated squaring bounds x**n.
+	p := 1.0
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			p = mulDown(p, x)
+		}
+		x = mulDown(x, x)
+	}
+	return p
+}
+
+// powUp returns x**n rounded toward positive infinity for n > 0.
+func powUp(x float64, n int) float64 {
+	if x < 0 {
+		if n%2 == 1 {
+			return -powDown(-x, n)
+		}
+		x = -x
+	}
+	p := 1.0
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			p = mulUp(p, x)
+		}
+		x = mulUp(x, x)
+	}
+	return p
+}
@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		got  Number
+		want Number
+	}{
+		{name: "add", got: Add(Number{1, 2}, Number{3, 4}), want: Number{4, 6}},
+		{name: "add rounding", got: Add(Number{0.1, 0.1}, Number{0.2, 0.2}), want: Number{0.3, 0.30000000000000004}},
+		{name: "add inexact", got: Add(Number{1, 1}, Number{0x1p-60, 0x1p-60}), want: Number{1, next(1)}},
+		{name: "add unbounded", got: Add(Number{-inf, 1}, Number{2, inf}), want: Entire()},
+		{name: "sub", got: Sub(Number{1, 2}, Number{3, 4}), want: Number{-3, -1}},
+		{name: "sub self", got: Sub(Number{1, 2}, Number{1, 2}), want: Number{-1, 1}},
+		{name: "neg", got: Neg(Number{1, 2}), want: Number{-2, -1}},
+		{name: "mul", got: Mul(Number{-1, 2}, Number{3, 4}), want: Number{-4, 8}},
+		{name: "mul negative", got: Mul(Number{-2, -1}, Number{-4, 3}), want: Number{-6, 8}},
+		{name: "mul zero unbounded", got: Mul(Number{0, 0}, Entire()), want: Number{0, 0}},
+		{name: "mul unbounded", got: Mul(Number{1, inf}, Number{-1, 2}), want: Entire()},
+		{name: "mul inexact", got: Mul(Number{0.1, 0.1}, Number{3, 3}), want: Number{0.3, 0.30000000000000004}},
+		{name: "scale", got: Scale(-2, Number{1, 3}), want: Number{-6, -2}},
+		{name: "div", got: Div(Number{1, 2}, Number{4, 8}), want: Number{0.125, 0.5}},
+		{name: "div inexact", got: Div(Number{1, 1}, Number{3, 3}), want: Number{1.0 / 3, next(1.0 / 3)}},
+		{name: "div zero denominator", got: Div(Number{1, 2}, Number{0, 0}), want: Empty()},
+		{name: "div zero interior", got: Div(Number{1, 2}, Number{-1, 1}), want: Entire()},
+		{name: "div both zero", got: Div(Number{-1, 2}, Number{0, 1}), want: Entire()},
+		{name: "div positive by zero min", got: Div(Number{1, 2}, Number{0, 4}), want: Number{0.25, inf}},
+		{name: "div positive by zero max", got: Div(Number{1, 2}, Number{-4, 0}), want: Number{-inf, -0.25}},
+		{name: "div negative by zero min", got: Div(Number{-2, -1}, Number{0, 4}), want: Number{-inf, -0.25}},
+		{name: "div negative by zero max", got: Div(Number{-2, -1}, Number{-4, 0}), want: Number{0.25, inf}},
+		{name: "div unbounded", got: Div(Number{-inf, 1}, Number{-inf, -1}), want: Number{-1, inf}},
+		{name: "inv", got: Inv(Number{2, 4}), want: Number{0.25, 0.5}},
+		{name: "abs", got: Abs(Number{-3, 2}), want: Number{0, 3}},
+		{name: "abs negative", got: Abs(Number{-3, -2}), want: Number{2, 3}},
+		{name: "sqr", got: Sqr(Number{-3, 2}), want: Number{0, 9}},
+		{name: "pow odd", got: Pow(Number{-3, 2}, 3), want: Number{-27, 8}},
+		{name: "pow even", got: Pow(Number{-3, -2}, 4), want: Number{16, 81}},
+		{name: "pow zero", got: Pow(Number{-3, 2}, 0), want: Number{1, 1}},
+		{name: "pow negative", got: Pow(Number{2, 4}, -2), want: Number{0.0625, 0.25}},
+		{name: "pow inexact", got: Pow(Number{1.1, 1.1}, 2), want: Number{1.2100000000000002, next(1.2100000000000002)}},
+		{name: "pow unbounded", got: Pow(Number{-inf, -2}, 3), want: Number{-inf, -8}},
+		{name: "empty add", got: Add(Empty(), Number{1, 2}), want: Empty()},
+		{name: "empty mul", got: Mul(Number{1, 2}, Empty()), want: Empty()},
+		{name: "empty div", got: Div(Empty(), Number{1, 2}), want: Empty()},
+		{name: "empty pow", got: Pow(Empty(), 0), want: Empty()},
+	} {
+		if test.got != test.want && !(test.got.IsEmpty() && test.want.IsEmpty()) {
+			t.Errorf("unexpected result for %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+}
+
+// member returns a random member of the bounded interval x.
+func member(rnd *rand.Rand, x Number) float64 {
+	switch rnd.IntN(4) {
+	case 0:
+		return x.Min
+	case 1:
+		return x.Max
+	}
+	return x.Min + rnd.Float64()*(x.Max-x.Min)
+}
+
+// randInterval returns a random bounded interval.
+func randInterval(rnd *rand.Rand) Number {
+	a := rnd.NormFloat64() * 10
+	b := rnd.NormFloat64() * 10
+	if rnd.IntN(4) == 0 {
+		b = a
+	}
+	return Number{Min: math.Min(a, b), Max: math.Max(a, b)}
+}
+
+func TestArithmeticContainment(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name  string
+		fn    func(x, y Number) Number
+		float func(x, y float64) float64
+	}{
+		{name: "add", fn: Add, float: func(x, y float64) float64 { return x + y }},
+		{name: "sub", fn: Sub, float: func(x, y float64) float64 { return x - y }},
+		{name: "mul", fn: Mul, float: func(x, y float64) float64 { return x * y }},
+		{name: "div", fn: Div, float: func(x, y float64) float64 { return x / y }},
+	} {
+		for i := 0; i < 1000; i++ {
+			x := randInterval(rnd)
+			y := randInterval(rnd)
+			z := test.fn(x, y)
+			for j := 0; j < 10; j++ {
+				a := member(rnd, x)
+				b := member(rnd, y)
+				v := test.float(a, b)
+				if math.IsNaN(v) || math.IsInf(v, 0) {
+					continue
+				}
+				// The rounded value lies within the interval because
+				// the interval bounds are rounded outward.
+				if !z.Contains(v) {
+					t.Errorf("%s(%v, %v) = %v does not contain %v %s %v = %v", test.name, x, y, z, a, test.name, b, v)
+				}
+			}
+		}
+	}
+
+	for i := 0; i < 1000; i++ {
+		x := randInterval(rnd)
+		n := rnd.IntN(9) - 4
+		z := Pow(x, n)
+		for j := 0; j < 10; j++ {
+			a := member(rnd, x)
+			v := math.Pow(a, float64(n))
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				continue
+			}
+			if !z.Contains(v) && !z.Contains(prev(v)) && !z.Contains(next(v)) {
+				t.Errorf("Pow(%v, %d) = %v does not contain %v", x, n, z, v)
+			}
+		}
+	}
+}
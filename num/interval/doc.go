@@ -0,0 +1,24 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package interval provides the real interval numeric type and functions
+// for verified computation.
+//
+// An interval Number [a, b] represents the set of real numbers x with
+// a ≤ x ≤ b. The arithmetic functions of the package return the tightest
+// interval with float64 bounds that contains every result of the operation
+// applied to members of the operands, so that the exact result of a
+// computation performed with intervals is guaranteed to lie within the
+// computed interval despite the rounding error of floating point arithmetic.
+// The elementary functions return intervals that are guaranteed to contain
+// the exact result, but may be wider than the tightest enclosure by a few
+// units in the last place.
+//
+// The Matrix type and the functions operating on slices of intervals allow
+// bounding the error of linear algebra computations, and SolveVec computes
+// an interval enclosure of the solution of a linear system.
+//
+// See Moore, Kearfott and Cloud, "Introduction to Interval Analysis",
+// SIAM, 2009 and the IEEE Standard for Interval Arithmetic, IEEE 1788-2015.
+package interval // import "gonum.org/v1/gonum/num/interval"
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import "math"
+
+// Sqrt returns the set of square roots of the non-negative members of x.
+// The bounds of the result are correctly rounded.
+func Sqrt(x Number) Number {
+	if x.IsEmpty() || x.Max < 0 {
+		return Empty()
+	}
+	return Number{Min: sqrtDown(math.Max(x.Min, 0)), Max: sqrtUp(x.Max)}
+}
+
+// Exp returns the set of e**v for members v of x.
+func Exp(x Number) Number {
+	if x.IsEmpty() {
+		return x
+	}
+	return Number{
+		Min: math.Max(expDown(x.Min), 0),
+		Max: expUp(x.Max),
+	}
+}
+
+func expDown(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	return down(math.Exp(x))
+}
+
+func expUp(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	return up(math.Exp(x))
+}
+
+// Log returns the set of natural logarithms of the positive members of x.
+func Log(x Number) Number {
+	if x.IsEmpty() || x.Max <= 0 {
+		return Empty()
+	}
+	lo := math.Inf(-1)
+	if x.Min > 0 {
+		lo = logDown(x.Min)
+	}
+	return Number{Min: lo, Max: logUp(x.Max)}
+}
+
+func logDown(x float64) float64 {
+	if x == 1 {
+		return 0
+	}
+	return down(math.Log(x))
+}
+
+func logUp(x float64) float64 {
+	if x == 1 {
+		return 0
+	}
+	return up(math.Log(x))
+}
+
+// Atan returns the set of inverse tangents of the members of x.
+func Atan(x Number) Number {
+	if x.IsEmpty() {
+		return x
+	}
+	return Number{Min: atanDown(x.Min), Max: atanUp(x.Max)}
+}
+
+func atanDown(x float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	return down(math.Atan(x))
+}
+
+func atanUp(x float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	return up(math.Atan(x))
+}
+
+// Tanh returns the set of hyperbolic tangents of the members of x.
+func Tanh(x Number) Number {
+	if x.IsEmpty() {
+		return x
+	}
+	lo, hi := 0.0, 0.0
+	if x.Min != 0 {
+		lo = math.Max(down(math.Tanh(x.Min)), -1)
+	}
+	if x.Max != 0 {
+		hi = math.Min(up(math.Tanh(x.Max)), 1)
+	}
+	return Number{Min: lo, Max: hi}
+}
+
+// Cos returns the set of cosines of the members of x.
+func Cos(x Number) Number {
+	if x.IsEmpty() {
+		return x
+	}
+	// The cosine has its maxima at 2kπ and its minima at (2k+1)π.
+	return periodic(x, math.Cos, 0)
+}
+
+// Sin returns the set of sines of the members of x.
+func Sin(x Number) Number {
+	if x.IsEmpty() {
+		return x
+	}
+	// The sine has its maxima at (2k+½)π and its minima at (2k+3/2)π.
+	return periodic(x, math.Sin, 0.5)
+}
+
+// periodic returns an enclosure of fn over x where fn is a sinusoid with
+// period 2π, range [-1, 1], maxima at (2k+off)π and minima at (2k+1+off)π
+// for integer k.
+func periodic(x Number, fn func(float64) float64, off float64) Number {
+	if math.IsInf(x.Min, 0) || math.IsInf(x.Max, 0) || x.Max-x.Min >= 2*math.Pi {
+		return Number{Min: -1, Max: 1}
+	}
+	a, b := fn(x.Min), fn(x.Max)
+	lo := math.Max(down(math.Min(a, b)), -1)
+	hi := math.Min(up(math.Max(a, b)), 1)
+
+	// Find the extrema within x in units of π. The bounds are widened
+	// to account for the error of the division so that an extremum
+	// close to a bound of x is included rather than missed.
+	const tol = 1e-12
+	p := x.Min/math.Pi - off
+	q := x.Max/math.Pi - off
+	kMin := math.Ceil(p - tol*(1+math.Abs(p)))
+	kMax := math.Floor(q + tol*(1+math.Abs(q)))
+	for k := kMin; k <= kMax && k <= kMin+1; k++ {
+		if math.Mod(k, 2) == 0 {
+			hi = 1
+		} else {
+			lo = -1
+		}
+	}
+	return Number{Min: lo, Max: hi}
+}
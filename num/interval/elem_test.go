@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestElementary(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		got  Number
+		want Number
+	}{
+		{name: "sqrt", got: Sqrt(Number{4, 9}), want: Number{2, 3}},
+		{name: "sqrt partial", got: Sqrt(Number{-4, 9}), want: Number{0, 3}},
+		{name: "sqrt inexact", got: Sqrt(Number{2, 2}), want: Number{prev(math.Sqrt2), math.Sqrt2}},
+		{name: "sqrt negative", got: Sqrt(Number{-4, -1}), want: Empty()},
+		{name: "exp zero", got: Exp(Number{0, 0}), want: Number{1, 1}},
+		{name: "exp unbounded", got: Exp(Entire()), want: Number{0, inf}},
+		{name: "log one", got: Log(Number{1, 1}), want: Number{0, 0}},
+		{name: "log partial", got: Log(Number{-1, 1}), want: Number{-inf, 0}},
+		{name: "log negative", got: Log(Number{-2, 0}), want: Empty()},
+		{name: "atan zero", got: Atan(Number{0, 0}), want: Number{0, 0}},
+		{name: "tanh unbounded", got: Tanh(Entire()), want: Number{-1, 1}},
+		{name: "sin period", got: Sin(Number{0, 7}), want: Number{-1, 1}},
+		{name: "cos unbounded", got: Cos(Number{0, inf}), want: Number{-1, 1}},
+		{name: "cos zero", got: Cos(Number{-0.5, 0.5}), want: Number{down(math.Cos(0.5)), 1}},
+		{name: "sin half pi", got: Sin(Number{1, 2}), want: Number{down(math.Sin(1)), 1}},
+		{name: "sin minimum", got: Sin(Number{4, 5}), want: Number{-1, up(math.Sin(4))}},
+		{name: "cos pi", got: Cos(Number{3, 3.5}), want: Number{-1, up(math.Cos(3.5))}},
+		{name: "empty", got: Exp(Empty()), want: Empty()},
+	} {
+		if test.got != test.want && !(test.got.IsEmpty() && test.want.IsEmpty()) {
+			t.Errorf("unexpected result for %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+
+	// The enclosures of π/2 and π contain the extrema.
+	pi := Number{math.Pi, next(math.Pi)}
+	if got := Cos(pi); !got.Contains(-1) {
+		t.Errorf("Cos(%v) = %v does not contain -1", pi, got)
+	}
+	if got := Sin(Scale(0.5, pi)); !got.Contains(1) {
+		t.Errorf("Sin(%v) = %v does not contain 1", Scale(0.5, pi), got)
+	}
+	if got := Sin(pi); !got.Contains(0) {
+		t.Errorf("Sin(%v) = %v does not contain 0", pi, got)
+	}
+}
+
+func TestElementaryContainment(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name  string
+		fn    func(Number) Number
+		float func(float64) float64
+		bound float64 // bound is the width of the range of fn.
+	}{
+		{name: "sqrt", fn: Sqrt, float: math.Sqrt, bound: inf},
+		{name: "exp", fn: Exp, float: math.Exp, bound: inf},
+		{name: "log", fn: Log, float: math.Log, bound: inf},
+		{name: "atan", fn: Atan, float: math.Atan, bound: math.Pi},
+		{name: "tanh", fn: Tanh, float: math.Tanh, bound: 2},
+		{name: "sin", fn: Sin, float: math.Sin, bound: 2},
+		{name: "cos", fn: Cos, float: math.Cos, bound: 2},
+	} {
+		for i := 0; i < 1000; i++ {
+			x := randInterval(rnd)
+			z := test.fn(x)
+			for j := 0; j < 10; j++ {
+				v := test.float(member(rnd, x))
+				if math.IsNaN(v) || math.IsInf(v, 0) {
+					continue
+				}
+				if !z.Contains(v) {
+					t.Errorf("%s(%v) = %v does not contain %v", test.name, x, z, v)
+				}
+			}
+			if z.Width() > up(test.bound) {
+				t.Errorf("%s(%v) = %v is too wide", test.name, x, z)
+			}
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Number is a float64 precision real interval, the set of real numbers x
+// with Min ≤ x ≤ Max. The bounds may be infinite to represent unbounded
+// intervals, but the interval [+∞, +∞] and [-∞, -∞] are not valid. The
+// empty interval is represented by Min = +∞ and Max = -∞, as returned by
+// Empty.
+//
+// The zero value of Number is the interval [0, 0].
+type Number struct {
+	Min, Max float64
+}
+
+// New returns the interval [min, max]. New panics if either bound is NaN,
+// if min > max or if the resulting interval would contain no real numbers.
+func New(min, max float64) Number {
+	switch {
+	case math.IsNaN(min) || math.IsNaN(max):
+		panic("interval: NaN bound")
+	case min > max:
+		panic("interval: min greater than max")
+	case math.IsInf(min, 1) || math.IsInf(max, -1):
+		panic("interval: infinite point interval")
+	}
+	return Number{Min: min, Max: max}
+}
+
+// Point returns the interval [x, x]. Point panics if x is infinite or NaN.
+func Point(x float64) Number {
+	return New(x, x)
+}
+
+// Empty returns the empty interval.
+func Empty() Number {
+	return Number{Min: math.Inf(1), Max: math.Inf(-1)}
+}
+
+// Entire returns the interval [-∞, +∞] containing all real numbers.
+func Entire() Number {
+	return Number{Min: math.Inf(-1), Max: math.Inf(1)}
+}
+
+// Parse returns the tightest interval containing the real number
+// represented by the decimal string s, for example "0.1", or containing
+// the two numbers in the string "[a, b]". Unlike conversion with
+// strconv.ParseFloat, the resulting interval contains the exact value
+// of the decimal number, even if it cannot be represented as a float64.
+func Parse(s string) (Number, error) {
+	t := strings.TrimSpace(s)
+	if strings.HasPrefix(t, "[") && strings.HasSuffix(t, "]") {
+		lo, hi, ok := strings.Cut(t[1:len(t)-1], ",")
+		if !ok {
+			return Number{}, fmt.Errorf("interval: cannot parse %q", s)
+		}
+		a, err := parseBound(lo, false)
+		if err != nil {
+			return Number{}, fmt.Errorf("interval: cannot parse %q: %w", s, err)
+		}
+		b, err := parseBound(hi, true)
+		if err != nil {
+			return Number{}, fmt.Errorf("interval: cannot parse %q: %w", s, err)
+		}
+		if !(a <= b) || math.IsInf(a, 1) || math.IsInf(b, -1) {
+			return Number{}, fmt.Errorf("interval: invalid interval %q", s)
+		}
+		return Number{Min: a, Max: b}, nil
+	}
+	a, err := parseBound(t, false)
+	if err != nil {
+		return Number{}, fmt.Errorf("interval: cannot parse %q: %w", s, err)
+	}
+	b, _ := parseBound(t, true)
+	if math.IsInf(a, 1) || math.IsInf(b, -1) {
+		return Number{}, fmt.Errorf("interval: invalid interval %q", s)
+	}
+	return Number{Min: a, Max: b}, nil
+}
+
+// parseBound returns the decimal number in s rounded toward positive
+// infinity if up is true and toward negative infinity otherwise.
+func parseBound(s string, up bool) (float64, error) {
+	s = strings.TrimSpace(s)
+	x, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		if ne, ok := err.(*strconv.NumError); !ok || ne.Err != strconv.ErrRange {
+			return 0, err
+		}
+	}
+	if math.IsInf(x, 0) {
+		switch strings.ToLower(strings.TrimLeft(s, "+-")) {
+		case "inf", "infinity":
+			return x, nil
+		}
+		// The number is finite but too large to represent.
+		if up == (x < 0) {
+			return math.Copysign(math.MaxFloat64, x), nil
+		}
+		return x, nil
+	}
+	if math.IsNaN(x) {
+		return 0, strconv.ErrSyntax
+	}
+	var exact big.Rat
+	if _, ok := exact.SetString(s); !ok {
+		return 0, strconv.ErrSyntax
+	}
+	var approx big.Rat
+	approx.SetFloat64(x)
+	switch c := approx.Cmp(&exact); {
+	case c < 0 && up:
+		return next(x), nil
+	case c > 0 && !up:
+		return prev(x), nil
+	}
+	return x, nil
+}
+
+// String returns a string representation of x, "[min, max]", with the
+// bounds printed with the shortest decimal representation that exactly
+// identifies them, or "[]" if x is empty.
+func (x Number) String() string {
+	if x.IsEmpty() {
+		return "[]"
+	}
+	return "[" + strconv.FormatFloat(x.Min, 'g', -1, 64) + ", " + strconv.FormatFloat(x.Max, 'g', -1, 64) + "]"
+}
+
+// IsEmpty returns whether x is the empty interval.
+func (x Number) IsEmpty() bool {
+	return !(x.Min <= x.Max)
+}
+
+// IsEntire returns whether x is the interval [-∞, +∞].
+func (x Number) IsEntire() bool {
+	return math.IsInf(x.Min, -1) && math.IsInf(x.Max, 1)
+}
+
+// IsPoint returns whether x contains exactly one number.
+func (x Number) IsPoint() bool {
+	return x.Min == x.Max
+}
+
+// Contains returns whether v is a member of x.
+func (x Number) Contains(v float64) bool {
+	return x.Min <= v && v <= x.Max
+}
+
+// Subset returns whether x is a subset of y.
+func (x Number) Subset(y Number) bool {
+	return x.IsEmpty() || (y.Min <= x.Min && x.Max <= y.Max)
+}
+
+// Interior returns whether x is contained in the interior of y.
+func (x Number) Interior(y Number) bool {
+	return x.IsEmpty() ||
+		((y.Min < x.Min || math.IsInf(y.Min, -1)) && (x.Max < y.Max || math.IsInf(y.Max, 1)))
+}
+
+// Mid returns the midpoint of x, which lies within x. Mid returns 0 for
+// the interval [-∞, +∞], ±math.MaxFloat64 for an interval unbounded on
+// one side and NaN for the empty interval.
+func (x Number) Mid() float64 {
+	switch {
+	case x.IsEmpty():
+		return math.NaN()
+	case x.IsEntire():
+		return 0
+	case math.IsInf(x.Min, -1):
+		return -math.MaxFloat64
+	case math.IsInf(x.Max, 1):
+		return math.MaxFloat64
+	case x.Min == x.Max:
+		return x.Min
+	}
+	m := x.Min/2 + x.Max/2
+	// Rounding of the halved subnormal bounds may place
+	// the computed midpoint outside x.
+	return math.Min(math.Max(m, x.Min), x.Max)
+}
+
+// Width returns an upper bound of the width of x, Max-Min. Width returns
+// NaN for the empty interval.
+func (x Number) Width() float64 {
+	if x.IsEmpty() {
+		return math.NaN()
+	}
+	return subUp(x.Max, x.Min)
+}
+
+// Rad returns an upper bound of the radius of x, the largest distance
+// from the midpoint of x to a member of x. Rad returns NaN for the empty
+// interval.
+func (x Number) Rad() float64 {
+	if x.IsEmpty() {
+		return math.NaN()
+	}
+	m := x.Mid()
+	return math.Max(subUp(m, x.Min), subUp(x.Max, m))
+}
+
+// Mag returns the magnitude of x, the largest absolute value of a member
+// of x. Mag returns NaN for the empty interval.
+func (x Number) Mag() float64 {
+	if x.IsEmpty() {
+		return math.NaN()
+	}
+	return math.Max(math.Abs(x.Min), math.Abs(x.Max))
+}
+
+// Mig returns the mignitude of x, the smallest absolute value of a member
+// of x. Mig returns NaN for the empty interval.
+func (x Number) Mig() float64 {
+	switch {
+	case x.IsEmpty():
+		return math.NaN()
+	case x.Contains(0):
+		return 0
+	}
+	return math.Min(math.Abs(x.Min), math.Abs(x.Max))
+}
+
+// Hull returns the smallest interval containing x and y.
+func Hull(x, y Number) Number {
+	switch {
+	case x.IsEmpty():
+		return y
+	case y.IsEmpty():
+		return x
+	}
+	return Number{Min: math.Min(x.Min, y.Min), Max: math.Max(x.Max, y.Max)}
+}
+
+// Intersect returns the intersection of x and y.
+func Intersect(x, y Number) Number {
+	z := Number{Min: math.Max(x.Min, y.Min), Max: math.Min(x.Max, y.Max)}
+	if z.IsEmpty() || x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	return z
+}
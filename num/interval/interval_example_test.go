@@ -0,0 +1,58 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/interval"
+)
+
+func ExampleParse() {
+	// The decimal 0.1 is not representable in floating point,
+	// so it is enclosed by the two adjacent floating point numbers.
+	x, err := interval.Parse("0.1")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(x)
+
+	// Ten additions of the enclosure enclose the exact sum.
+	var sum interval.Number
+	for i := 0; i < 10; i++ {
+		sum = interval.Add(sum, x)
+	}
+	fmt.Println(sum, sum.Contains(1))
+
+	// Output:
+	// [0.09999999999999999, 0.1]
+	// [0.9999999999999998, 1.0000000000000007] true
+}
+
+func ExampleSolveVec() {
+	a := mat.NewDense(3, 3, []float64{
+		4, -2, 1,
+		-2, 4, -2,
+		1, -2, 4,
+	})
+	b := []float64{11, -16, 17}
+
+	x, err := interval.SolveVec(a, b)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, v := range x {
+		fmt.Printf("%.6g\n", v.Mid())
+	}
+	fmt.Println(x[0].Contains(1), x[1].Contains(-2), x[2].Contains(3))
+
+	// Output:
+	// 1
+	// -2
+	// 3
+	// true true true
+}
@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+var inf = math.Inf(1)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		min, max float64
+		panics   bool
+	}{
+		{min: 0, max: 0},
+		{min: -1, max: 2},
+		{min: -inf, max: 2},
+		{min: -inf, max: inf},
+		{min: 2, max: 1, panics: true},
+		{min: math.NaN(), max: 1, panics: true},
+		{min: 1, max: math.NaN(), panics: true},
+		{min: inf, max: inf, panics: true},
+		{min: -inf, max: -inf, panics: true},
+	} {
+		got := panics(func() { New(test.min, test.max) })
+		if got != test.panics {
+			t.Errorf("unexpected panic status for New(%v, %v): got:%t want:%t", test.min, test.max, got, test.panics)
+		}
+	}
+	if !Empty().IsEmpty() {
+		t.Error("Empty is not empty")
+	}
+	if !Entire().IsEntire() || Entire().IsEmpty() {
+		t.Error("unexpected Entire properties")
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		s       string
+		want    Number
+		wantErr bool
+	}{
+		{s: "1", want: Number{1, 1}},
+		{s: "-2.5", want: Number{-2.5, -2.5}},
+		{s: "0.1", want: Number{prev(0.1), 0.1}},
+		{s: "0.3", want: Number{0.3, next(0.3)}},
+		{s: "-0.1", want: Number{-0.1, next(-0.1)}},
+		{s: "[0.1, 0.3]", want: Number{prev(0.1), next(0.3)}},
+		{s: " [ -1 , 2 ] ", want: Number{-1, 2}},
+		{s: "[-inf, 1]", want: Number{-inf, 1}},
+		{s: "1e400", want: Number{math.MaxFloat64, inf}},
+		{s: "-1e400", want: Number{-inf, -math.MaxFloat64}},
+		{s: "[2, 1]", wantErr: true},
+		{s: "[1 2]", wantErr: true},
+		{s: "inf", wantErr: true},
+		{s: "nan", wantErr: true},
+		{s: "x", wantErr: true},
+	} {
+		got, err := Parse(test.s)
+		if (err != nil) != test.wantErr {
+			t.Errorf("unexpected error status for Parse(%q): %v", test.s, err)
+			continue
+		}
+		if err == nil && got != test.want {
+			t.Errorf("unexpected result for Parse(%q): got:%v want:%v", test.s, got, test.want)
+		}
+	}
+
+	// The parsed interval contains the exact decimal value.
+	for _, s := range []string{"0.1", "0.7", "1.1", "3.14159", "2.718281828459045235360287"} {
+		x, err := Parse(s)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		exact, _ := new(big.Rat).SetString(s)
+		lo := new(big.Rat).SetFloat64(x.Min)
+		hi := new(big.Rat).SetFloat64(x.Max)
+		if lo.Cmp(exact) > 0 || hi.Cmp(exact) < 0 {
+			t.Errorf("Parse(%q) = %v does not contain exact value", s, x)
+		}
+		if next(x.Min) != x.Max {
+			t.Errorf("Parse(%q) = %v is not tight", s, x)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x    Number
+		want string
+	}{
+		{x: Number{1, 2}, want: "[1, 2]"},
+		{x: Number{prev(0.1), 0.1}, want: "[0.09999999999999999, 0.1]"},
+		{x: Entire(), want: "[-Inf, +Inf]"},
+		{x: Empty(), want: "[]"},
+	} {
+		if got := test.x.String(); got != test.want {
+			t.Errorf("unexpected string for %#v: got:%q want:%q", test.x, got, test.want)
+		}
+	}
+}
+
+func TestProperties(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x                    Number
+		mid, width, mag, mig float64
+	}{
+		{x: Number{1, 3}, mid: 2, width: 2, mag: 3, mig: 1},
+		{x: Number{-3, 1}, mid: -1, width: 4, mag: 3, mig: 0},
+		{x: Number{-3, -2}, mid: -2.5, width: 1, mag: 3, mig: 2},
+		{x: Number{2, 2}, mid: 2, width: 0, mag: 2, mig: 2},
+		{x: Number{-math.MaxFloat64, math.MaxFloat64}, mid: 0, width: inf, mag: math.MaxFloat64, mig: 0},
+		{x: Number{-inf, 1}, mid: -math.MaxFloat64, width: inf, mag: inf, mig: 0},
+		{x: Number{1, inf}, mid: math.MaxFloat64, width: inf, mag: inf, mig: 1},
+		{x: Entire(), mid: 0, width: inf, mag: inf, mig: 0},
+		{x: Number{0, 0.1}, mid: 0.05, width: 0.1, mag: 0.1, mig: 0},
+		{x: Number{1, next(1)}, mid: 1, width: 0x1p-52, mag: next(1), mig: 1},
+	} {
+		if got := test.x.Mid(); got != test.mid {
+			t.Errorf("unexpected Mid for %v: got:%v want:%v", test.x, got, test.mid)
+		}
+		if got := test.x.Width(); got != test.width {
+			t.Errorf("unexpected Width for %v: got:%v want:%v", test.x, got, test.width)
+		}
+		if got := test.x.Mag(); got != test.mag {
+			t.Errorf("unexpected Mag for %v: got:%v want:%v", test.x, got, test.mag)
+		}
+		if got := test.x.Mig(); got != test.mig {
+			t.Errorf("unexpected Mig for %v: got:%v want:%v", test.x, got, test.mig)
+		}
+		if !test.x.Contains(test.x.Mid()) {
+			t.Errorf("Mid %v not in %v", test.x.Mid(), test.x)
+		}
+		m := test.x.Mid()
+		r := test.x.Rad()
+		if !(subDown(m, r) <= test.x.Min && test.x.Max <= addUp(m, r)) {
+			t.Errorf("Rad %v of %v does not cover interval", r, test.x)
+		}
+	}
+	e := Empty()
+	for _, v := range []float64{e.Mid(), e.Width(), e.Rad(), e.Mag(), e.Mig()} {
+		if !math.IsNaN(v) {
+			t.Errorf("unexpected non-NaN property of empty interval: %v", v)
+		}
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x, y      Number
+		hull      Number
+		intersect Number
+		subset    bool
+		interior  bool
+	}{
+		{x: Number{1, 2}, y: Number{0, 3}, hull: Number{0, 3}, intersect: Number{1, 2}, subset: true, interior: true},
+		{x: Number{1, 3}, y: Number{0, 3}, hull: Number{0, 3}, intersect: Number{1, 3}, subset: true},
+		{x: Number{-1, 2}, y: Number{0, 3}, hull: Number{-1, 3}, intersect: Number{0, 2}},
+		{x: Number{-2, -1}, y: Number{1, 3}, hull: Number{-2, 3}, intersect: Empty()},
+		{x: Number{1, 2}, y: Entire(), hull: Entire(), intersect: Number{1, 2}, subset: true, interior: true},
+		{x: Entire(), y: Entire(), hull: Entire(), intersect: Entire(), subset: true, interior: true},
+		{x: Empty(), y: Number{1, 2}, hull: Number{1, 2}, intersect: Empty(), subset: true, interior: true},
+	} {
+		if got := Hull(test.x, test.y); got != test.hull {
+			t.Errorf("unexpected Hull(%v, %v): got:%v want:%v", test.x, test.y, got, test.hull)
+		}
+		got := Intersect(test.x, test.y)
+		if got != test.intersect && !(got.IsEmpty() && test.intersect.IsEmpty()) {
+			t.Errorf("unexpected Intersect(%v, %v): got:%v want:%v", test.x, test.y, got, test.intersect)
+		}
+		if got := test.x.Subset(test.y); got != test.subset {
+			t.Errorf("unexpected Subset(%v, %v): got:%t want:%t", test.x, test.y, got, test.subset)
+		}
+		if got := test.x.Interior(test.y); got != test.interior {
+			t.Errorf("unexpected Interior(%v, %v): got:%t want:%t", test.x, test.y, got, test.interior)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
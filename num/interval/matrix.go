@@ -0,0 +1,258 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Matrix is a dense matrix of intervals stored in row-major order.
+type Matrix struct {
+	rows, cols int
+	data       []Number
+}
+
+// NewMatrix returns a new r×c interval matrix holding the elements of data
+// in row-major order. If data is nil, a zero matrix is allocated. NewMatrix
+// panics if r or c is not positive or if len(data) is not r*c.
+func NewMatrix(r, c int, data []Number) *Matrix {
+	if r <= 0 || c <= 0 {
+		panic("interval: non-positive dimension")
+	}
+	if data == nil {
+		data = make([]Number, r*c)
+	}
+	if len(data) != r*c {
+		panic("interval: dimension mismatch")
+	}
+	return &Matrix{rows: r, cols: c, data: data}
+}
+
+// PointMatrix returns a new interval matrix with elements the point
+// intervals of the elements of a.
+func PointMatrix(a mat.Matrix) *Matrix {
+	r, c := a.Dims()
+	m := NewMatrix(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.data[i*c+j] = Point(a.At(i, j))
+		}
+	}
+	return m
+}
+
+// Dims returns the number of rows and columns of the matrix.
+func (m *Matrix) Dims() (r, c int) {
+	return m.rows, m.cols
+}
+
+// At returns the element at row i and column j.
+func (m *Matrix) At(i, j int) Number {
+	if uint(i) >= uint(m.rows) || uint(j) >= uint(m.cols) {
+		panic("interval: index out of range")
+	}
+	return m.data[i*m.cols+j]
+}
+
+// Set sets the element at row i and column j to v.
+func (m *Matrix) Set(i, j int, v Number) {
+	if uint(i) >= uint(m.rows) || uint(j) >= uint(m.cols) {
+		panic("interval: index out of range")
+	}
+	m.data[i*m.cols+j] = v
+}
+
+// Mid returns the matrix of midpoints of the elements of m.
+func (m *Matrix) Mid() *mat.Dense {
+	d := mat.NewDense(m.rows, m.cols, nil)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			d.Set(i, j, m.data[i*m.cols+j].Mid())
+		}
+	}
+	return d
+}
+
+// Rad returns the matrix of radii of the elements of m.
+func (m *Matrix) Rad() *mat.Dense {
+	d := mat.NewDense(m.rows, m.cols, nil)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			d.Set(i, j, m.data[i*m.cols+j].Rad())
+		}
+	}
+	return d
+}
+
+// Dot returns an enclosure of the inner product of x and y. Dot panics if
+// the lengths of x and y do not match.
+func Dot(x, y []Number) Number {
+	if len(x) != len(y) {
+		panic("interval: length mismatch")
+	}
+	var sum Number
+	for i, v := range x {
+		sum = Add(sum, Mul(v, y[i]))
+	}
+	return sum
+}
+
+// MulVec returns an enclosure of the product of the matrix a and the
+// vector x, storing the result in dst if it is not nil. MulVec panics
+// if the dimensions of a, x and a non-nil dst do not match.
+func MulVec(dst []Number, a *Matrix, x []Number) []Number {
+	if len(x) != a.cols {
+		panic("interval: dimension mismatch")
+	}
+	if dst == nil {
+		dst = make([]Number, a.rows)
+	}
+	if len(dst) != a.rows {
+		panic("interval: dimension mismatch")
+	}
+	for i := range dst {
+		dst[i] = Dot(a.data[i*a.cols:(i+1)*a.cols], x)
+	}
+	return dst
+}
+
+// MulMat returns an enclosure of the matrix product of a and b. MulMat
+// panics if the number of columns of a is not the number of rows of b.
+func MulMat(a, b *Matrix) *Matrix {
+	if a.cols != b.rows {
+		panic("interval: dimension mismatch")
+	}
+	m := NewMatrix(a.rows, b.cols, nil)
+	col := make([]Number, b.rows)
+	for j := 0; j < b.cols; j++ {
+		for k := range col {
+			col[k] = b.data[k*b.cols+j]
+		}
+		for i := 0; i < a.rows; i++ {
+			m.data[i*m.cols+j] = Dot(a.data[i*a.cols:(i+1)*a.cols], col)
+		}
+	}
+	return m
+}
+
+// Residual returns an enclosure of the exact residual b - a*x of the
+// approximate solution x of the linear system a*x = b. Residual panics
+// if the dimensions of a, x and b do not match.
+func Residual(a mat.Matrix, x, b []float64) []Number {
+	r, c := a.Dims()
+	if len(x) != c || len(b) != r {
+		panic("interval: dimension mismatch")
+	}
+	res := make([]Number, r)
+	for i := range res {
+		sum := Point(b[i])
+		for j, v := range x {
+			sum = Sub(sum, Mul(Point(a.At(i, j)), Point(v)))
+		}
+		res[i] = sum
+	}
+	return res
+}
+
+// SolveVec returns intervals enclosing the elements of the exact solution
+// of the square linear system a*x = b with the floating point matrix a and
+// vector b. The enclosure also proves that a is non-singular. SolveVec
+// returns an error if the solution cannot be verified, which happens
+// when a is singular or too ill-conditioned. SolveVec panics if a is not
+// square or if the length of b does not match the size of a.
+//
+// The solution is verified with the method of Rump, "Verification methods:
+// Rigorous results using floating-point arithmetic", Acta Numerica 19, 2010.
+// An approximate solution x̃ and approximate inverse R of a are computed in
+// floating point, and the error of x̃ is enclosed by an interval vector X
+// satisfying R(b - a x̃) + (I - R a) Y ⊂ int(Y) for Y obtained from X by
+// ε-inflation.
+func SolveVec(a mat.Matrix, b []float64) ([]Number, error) {
+	n, c := a.Dims()
+	if n != c || len(b) != n {
+		panic("interval: dimension mismatch")
+	}
+
+	// Compute the approximate solution and inverse. Condition
+	// warnings are ignored since the verification determines
+	// whether the approximations are usable.
+	var lu mat.LU
+	lu.Factorize(a)
+	if math.IsInf(lu.Cond(), 1) {
+		return nil, errors.New("interval: verification failed")
+	}
+	var xt mat.VecDense
+	err := lu.SolveVecTo(&xt, false, mat.NewVecDense(n, append([]float64(nil), b...)))
+	if err != nil && !isCondition(err) {
+		return nil, err
+	}
+	var rinv mat.Dense
+	err = lu.SolveTo(&rinv, false, eye(n))
+	if err != nil && !isCondition(err) {
+		return nil, err
+	}
+	x := xt.RawVector().Data
+	for _, v := range x {
+		if !isFinite(v) {
+			return nil, errors.New("interval: verification failed")
+		}
+	}
+
+	r := PointMatrix(&rinv)
+	z := MulVec(nil, r, Residual(a, x, b))
+	cm := MulMat(r, PointMatrix(a))
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			var delta Number
+			if i == j {
+				delta = Number{Min: 1, Max: 1}
+			}
+			cm.data[i*n+j] = Sub(delta, cm.data[i*n+j])
+		}
+	}
+
+	const maxIter = 15
+	errs := append([]Number(nil), z...)
+	y := make([]Number, n)
+	for iter := 0; iter < maxIter; iter++ {
+		for i, e := range errs {
+			w := 0.1*e.Rad() + math.SmallestNonzeroFloat64
+			y[i] = Number{Min: subDown(e.Min, w), Max: addUp(e.Max, w)}
+		}
+		verified := true
+		for i := range errs {
+			errs[i] = Add(z[i], Dot(cm.data[i*n:(i+1)*n], y))
+			if !errs[i].Interior(y[i]) {
+				verified = false
+			}
+		}
+		if verified {
+			sol := make([]Number, n)
+			for i, e := range errs {
+				sol[i] = Add(Point(x[i]), e)
+			}
+			return sol, nil
+		}
+	}
+	return nil, errors.New("interval: verification failed")
+}
+
+// isCondition returns whether err is a mat.Condition warning.
+func isCondition(err error) bool {
+	var c mat.Condition
+	return errors.As(err, &c)
+}
+
+// eye returns the n×n identity matrix.
+func eye(n int) *mat.Dense {
+	m := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
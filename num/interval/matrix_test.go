@@ -0,0 +1,182 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestMatrixProducts(t *testing.T) {
+	t.Parallel()
+	a := NewMatrix(2, 2, []Number{
+		{1, 2}, {-1, 0},
+		{0, 0}, {3, 3},
+	})
+	x := []Number{{1, 1}, {-1, 2}}
+	got := MulVec(nil, a, x)
+	want := []Number{{-1, 3}, {-3, 6}}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("unexpected MulVec result at %d: got:%v want:%v", i, got[i], want[i])
+		}
+	}
+	// The dependency between the factors is not tracked, so the
+	// enclosure of x·x includes negative values.
+	if got := Dot(x, x); got != (Number{-1, 5}) {
+		t.Errorf("unexpected Dot result: got:%v want:%v", got, Number{-1, 5})
+	}
+
+	b := NewMatrix(2, 1, []Number{x[0], x[1]})
+	m := MulMat(a, b)
+	if r, c := m.Dims(); r != 2 || c != 1 {
+		t.Fatalf("unexpected MulMat dimensions: got:%d×%d want:2×1", r, c)
+	}
+	for i := range want {
+		if m.At(i, 0) != want[i] {
+			t.Errorf("unexpected MulMat result at %d: got:%v want:%v", i, m.At(i, 0), want[i])
+		}
+	}
+
+	// The enclosure of a product of point matrices contains
+	// the floating point product.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	pa := mat.NewDense(3, 4, nil)
+	pb := mat.NewDense(4, 2, nil)
+	for _, p := range []*mat.Dense{pa, pb} {
+		r, c := p.Dims()
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				p.Set(i, j, rnd.NormFloat64())
+			}
+		}
+	}
+	var prod mat.Dense
+	prod.Mul(pa, pb)
+	enc := MulMat(PointMatrix(pa), PointMatrix(pb))
+	mid := enc.Mid()
+	rad := enc.Rad()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 2; j++ {
+			v := prod.At(i, j)
+			if !enc.At(i, j).Contains(v) {
+				t.Errorf("product enclosure %v at (%d, %d) does not contain %v", enc.At(i, j), i, j, v)
+			}
+			if math.Abs(mid.At(i, j)-v) > 1e-14 || rad.At(i, j) > 1e-14 {
+				t.Errorf("product enclosure %v at (%d, %d) is not tight", enc.At(i, j), i, j)
+			}
+		}
+	}
+
+	for _, fn := range []func(){
+		func() { NewMatrix(2, 2, make([]Number, 3)) },
+		func() { NewMatrix(0, 2, nil) },
+		func() { MulVec(nil, a, x[:1]) },
+		func() { MulMat(a, NewMatrix(3, 1, nil)) },
+		func() { Dot(x, x[:1]) },
+		func() { a.At(2, 0) },
+	} {
+		if !panics(fn) {
+			t.Error("expected panic for mismatched dimensions")
+		}
+	}
+}
+
+func TestSolveVec(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		a    *mat.Dense
+		b    []float64
+		want []float64 // want is the exact solution.
+	}{
+		{
+			a:    mat.NewDense(2, 2, []float64{2, 1, 1, 3}),
+			b:    []float64{3, 5},
+			want: []float64{0.8, 1.4},
+		},
+		{
+			a: mat.NewDense(3, 3, []float64{
+				4, -2, 1,
+				-2, 4, -2,
+				1, -2, 4,
+			}),
+			b:    []float64{11, -16, 17},
+			want: []float64{1, -2, 3},
+		},
+		{
+			// The solution is 1/3 in each element.
+			a:    mat.NewDense(2, 2, []float64{1, 2, 2, 1}),
+			b:    []float64{1, 1},
+			want: []float64{1.0 / 3, 1.0 / 3},
+		},
+	} {
+		got, err := SolveVec(test.a, test.b)
+		if err != nil {
+			t.Errorf("unexpected error for test %d: %v", i, err)
+			continue
+		}
+		for j, v := range test.want {
+			if !got[j].Contains(v) && !(got[j].Contains(prev(v)) && got[j].Contains(next(v))) {
+				t.Errorf("solution enclosure %v at %d for test %d does not contain %v", got[j], j, i, v)
+			}
+			if got[j].Width() > 1e-14 {
+				t.Errorf("solution enclosure %v at %d for test %d is too wide", got[j], j, i)
+			}
+		}
+	}
+
+	// Random well-conditioned systems with an exactly known solution
+	// given by integer elements.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 1; n <= 20; n++ {
+		a := mat.NewDense(n, n, nil)
+		x := make([]float64, n)
+		for i := 0; i < n; i++ {
+			x[i] = float64(rnd.IntN(21) - 10)
+			for j := 0; j < n; j++ {
+				a.Set(i, j, float64(rnd.IntN(21)-10))
+			}
+			a.Set(i, i, a.At(i, i)+float64(20*n))
+		}
+		b := make([]float64, n)
+		for i := range b {
+			for j, v := range x {
+				b[i] += a.At(i, j) * v
+			}
+		}
+		got, err := SolveVec(a, b)
+		if err != nil {
+			t.Errorf("unexpected error for n=%d: %v", n, err)
+			continue
+		}
+		for i, v := range x {
+			if !got[i].Contains(v) {
+				t.Errorf("solution enclosure %v at %d for n=%d does not contain %v", got[i], i, n, v)
+			}
+		}
+	}
+
+	// Singular and very ill-conditioned matrices are not verified.
+	hilbert := mat.NewDense(16, 16, nil)
+	for i := 0; i < 16; i++ {
+		for j := 0; j < 16; j++ {
+			hilbert.Set(i, j, 1/float64(i+j+1))
+		}
+	}
+	for _, a := range []*mat.Dense{
+		mat.NewDense(2, 2, []float64{1, 2, 2, 4}),
+		mat.NewDense(3, 3, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}),
+		hilbert,
+	} {
+		n, _ := a.Dims()
+		_, err := SolveVec(a, make([]float64, n))
+		if err == nil {
+			t.Errorf("expected error for matrix %v", mat.Formatted(a))
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import "math"
+
+// The functions in this file compute the results of the basic floating
+// point operations rounded toward negative or positive infinity. Go
+// provides only rounding to nearest, so the rounding error of the nearest
+// result is computed exactly with error-free transformations, and its sign
+// determines whether the rounded result must be moved to the adjacent
+// floating point number. Products and quotients with magnitudes close to
+// the underflow threshold, where the error is not representable, are
+// moved to the adjacent floating point number unconditionally, so they
+// may be one unit in the last place wider than the correctly rounded
+// result.
+
+// smallMag is the magnitude below which the error of a product or
+// quotient may not be representable.
+const smallMag = 0x1p-969
+
+// next returns the floating point number following x in the direction of
+// positive infinity.
+func next(x float64) float64 { return math.Nextafter(x, math.Inf(1)) }
+
+// prev returns the floating point number following x in the direction of
+// negative infinity.
+func prev(x float64) float64 { return math.Nextafter(x, math.Inf(-1)) }
+
+// isFinite returns whether x is neither infinite nor NaN.
+func isFinite(x float64) bool { return !math.IsInf(x, 0) && !math.IsNaN(x) }
+
+// addDown returns a+b rounded toward negative infinity.
+func addDown(a, b float64) float64 {
+	s := a + b
+	switch {
+	case math.IsInf(s, 1) && isFinite(a) && isFinite(b):
+		return math.MaxFloat64
+	case !isFinite(s):
+		return s
+	}
+	// The error of the sum is computed exactly by the TwoSum
+	// algorithm of Knuth.
+	bb := s - a
+	err := (a - (s - bb)) + (b - bb)
+	if err < 0 {
+		return prev(s)
+	}
+	return s
+}
+
+// addUp returns a+b rounded toward positive infinity.
+func addUp(a, b float64) float64 { return -addDown(-a, -b) }
+
+// subDown returns a-b rounded toward negative infinity.
+func subDown(a, b float64) float64 { return addDown(a, -b) }
+
+// subUp returns a-b rounded toward positive infinity.
+func subUp(a, b float64) float64 { return -addDown(-a, b) }
+
+// mulDown returns a*b rounded toward negative infinity, with the
+// product of zero and infinity taken to be zero.
+func mulDown(a, b float64) float64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	p := a * b
+	switch {
+	case math.IsInf(p, 1) && isFinite(a) && isFinite(b):
+		return math.MaxFloat64
+	case !isFinite(p):
+		return p
+	case p == 0 && (a < 0) == (b < 0):
+		return 0
+	case math.Abs(p) < smallMag:
+		return prev(p)
+	}
+	if math.FMA(a, b, -p) < 0 {
+		return prev(p)
+	}
+	return p
+}
+
+// mulUp returns a*b rounded toward positive infinity, with the
+// product of zero and infinity taken to be zero.
+func mulUp(a, b float64) float64 { return -mulDown(-a, b) }
+
+// divDown returns a/b rounded toward negative infinity for non-zero b,
+// with the quotient of infinities taken as the bound of the quotients
+// of large numbers.
+func divDown(a, b float64) float64 {
+	switch {
+	case a == 0:
+		return 0
+	case math.IsInf(b, 0):
+		if math.IsInf(a, 0) && (a < 0) != (b < 0) {
+			return math.Inf(-1)
+		}
+		// The quotients of finite numbers by unbounded numbers
+		// and of unbounded numbers of the same sign have zero
+		// as their greatest lower bound.
+		return 0
+	}
+	q := a / b
+	switch {
+	case math.IsInf(q, 1) && isFinite(a):
+		return math.MaxFloat64
+	case !isFinite(q):
+		return q
+	case q == 0 && (a < 0) == (b < 0):
+		return 0
+	case math.Abs(q) < smallMag:
+		return prev(q)
+	}
+	// The remainder a - q*b is exactly representable, and the
+	// exact quotient is q + r/b.
+	r := math.FMA(-q, b, a)
+	if (r < 0) != (b < 0) && r != 0 {
+		return prev(q)
+	}
+	return q
+}
+
+// divUp returns a/b rounded toward positive infinity for non-zero b.
+func divUp(a, b float64) float64 { return -divDown(-a, b) }
+
+// sqrtDown returns the square root of a ≥ 0 rounded toward negative
+// infinity.
+func sqrtDown(a float64) float64 {
+	if 0 < a && a < smallMag {
+		// Scale a so that the error of the root is representable.
+		// The scaling of both a and the root is exact.
+		return sqrtDown(a*0x1p200) * 0x1p-100
+	}
+	s := math.Sqrt(a)
+	if isFinite(s) && s != 0 && math.FMA(-s, s, a) < 0 {
+		return prev(s)
+	}
+	return s
+}
+
+// sqrtUp returns the square root of a ≥ 0 rounded toward positive
+// infinity.
+func sqrtUp(a float64) float64 {
+	if 0 < a && a < smallMag {
+		return sqrtUp(a*0x1p200) * 0x1p-100
+	}
+	s := math.Sqrt(a)
+	if isFinite(s) && math.FMA(-s, s, a) > 0 {
+		return next(s)
+	}
+	return s
+}
+
+// elemULPs is the number of units in the last place by which the results
+// of the math package elementary functions are widened to ensure that the
+// exact results are enclosed. The functions of the math package have
+// errors of at most one unit in the last place over the domains used.
+const elemULPs = 2
+
+// down returns x moved elemULPs floating point numbers toward negative
+// infinity.
+func down(x float64) float64 {
+	for i := 0; i < elemULPs; i++ {
+		x = prev(x)
+	}
+	return x
+}
+
+// up returns x moved elemULPs floating point numbers toward positive
+// infinity.
+func up(x float64) float64 {
+	for i := 0; i < elemULPs; i++ {
+		x = next(x)
+	}
+	return x
+}
@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+// directed returns the result of op applied to a and b computed with
+// big.Float in the given rounding mode and converted to float64, with
+// overflow to the largest finite number when rounding toward zero.
+func directed(op func(z, a, b *big.Float) *big.Float, a, b float64, mode big.RoundingMode) float64 {
+	x := new(big.Float).SetFloat64(a)
+	y := new(big.Float).SetFloat64(b)
+	z := new(big.Float).SetPrec(2000)
+	op(z, x, y)
+	f, _ := new(big.Float).SetMode(mode).SetPrec(53).Set(z).Float64()
+	// Float64 rounds to nearest for values beyond the range of float64.
+	switch {
+	case math.IsInf(f, 1) && mode == big.ToNegativeInf:
+		return math.MaxFloat64
+	case math.IsInf(f, -1) && mode == big.ToPositiveInf:
+		return -math.MaxFloat64
+	}
+	return f
+}
+
+// randFloat returns a random float64 with a random exponent spanning
+// the normal range.
+func randFloat(rnd *rand.Rand) float64 {
+	f := math.Ldexp(1+rnd.Float64(), rnd.IntN(2000)-1000)
+	if rnd.IntN(2) == 0 {
+		f = -f
+	}
+	return f
+}
+
+func TestDirectedRounding(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	type op struct {
+		name     string
+		down, up func(a, b float64) float64
+		big      func(z, a, b *big.Float) *big.Float
+	}
+	for _, test := range []op{
+		{name: "add", down: addDown, up: addUp, big: (*big.Float).Add},
+		{name: "sub", down: subDown, up: subUp, big: (*big.Float).Sub},
+		{name: "mul", down: mulDown, up: mulUp, big: (*big.Float).Mul},
+		{name: "div", down: divDown, up: divUp, big: (*big.Float).Quo},
+	} {
+		for i := 0; i < 10000; i++ {
+			a := randFloat(rnd)
+			b := randFloat(rnd)
+			switch i % 4 {
+			case 1:
+				// Operands of similar magnitude.
+				b = a * (1 + rnd.Float64())
+			case 2:
+				// Exactly representable results.
+				a, b = float64(rnd.IntN(1000)+1), float64(rnd.IntN(1000)+1)
+			}
+			wantDown := directed(test.big, a, b, big.ToNegativeInf)
+			wantUp := directed(test.big, a, b, big.ToPositiveInf)
+			gotDown := test.down(a, b)
+			gotUp := test.up(a, b)
+
+			// Results in the subnormal range are allowed to be
+			// one unit wider than the correctly rounded result.
+			if gotDown != wantDown && !(math.Abs(wantDown) < smallMag && gotDown == prev(wantDown)) {
+				t.Errorf("unexpected %s down result for %v and %v: got:%v want:%v", test.name, a, b, gotDown, wantDown)
+			}
+			if gotUp != wantUp && !(math.Abs(wantUp) < smallMag && gotUp == next(wantUp)) {
+				t.Errorf("unexpected %s up result for %v and %v: got:%v want:%v", test.name, a, b, gotUp, wantUp)
+			}
+		}
+	}
+
+	for i := 0; i < 10000; i++ {
+		a := math.Abs(randFloat(rnd))
+		if i%2 == 0 {
+			// Perfect squares.
+			s := float64(rnd.IntN(1 << 20))
+			a = s * s
+		}
+		exact := new(big.Float).SetPrec(2000).Sqrt(new(big.Float).SetFloat64(a))
+		wantDown, _ := new(big.Float).SetMode(big.ToNegativeInf).SetPrec(53).Set(exact).Float64()
+		wantUp, _ := new(big.Float).SetMode(big.ToPositiveInf).SetPrec(53).Set(exact).Float64()
+		if got := sqrtDown(a); got != wantDown {
+			t.Errorf("unexpected sqrt down result for %v: got:%v want:%v", a, got, wantDown)
+		}
+		if got := sqrtUp(a); got != wantUp {
+			t.Errorf("unexpected sqrt up result for %v: got:%v want:%v", a, got, wantUp)
+		}
+	}
+
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "addDown overflow", got: addDown(math.MaxFloat64, math.MaxFloat64), want: math.MaxFloat64},
+		{name: "addUp overflow", got: addUp(math.MaxFloat64, math.MaxFloat64), want: inf},
+		{name: "addUp negative overflow", got: addUp(-math.MaxFloat64, -math.MaxFloat64), want: -math.MaxFloat64},
+		{name: "addDown infinity", got: addDown(inf, 1), want: inf},
+		{name: "mulDown zero infinity", got: mulDown(0, inf), want: 0},
+		{name: "mulUp zero infinity", got: mulUp(-inf, 0), want: 0},
+		{name: "mulDown overflow", got: mulDown(math.MaxFloat64, 2), want: math.MaxFloat64},
+		{name: "mulUp infinity", got: mulUp(inf, -2), want: -inf},
+		{name: "divDown infinity", got: divDown(1, inf), want: 0},
+		{name: "divUp overflow", got: divUp(-math.MaxFloat64, 0.5), want: -math.MaxFloat64},
+		{name: "sqrtUp infinity", got: sqrtUp(inf), want: inf},
+		{name: "sqrtDown zero", got: sqrtDown(0), want: 0},
+	} {
+		if test.got != test.want {
+			t.Errorf("unexpected result for %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+}
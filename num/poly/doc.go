@@ -0,0 +1,25 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package poly provides polynomials with real coefficients and functions
+// for their evaluation, arithmetic, calculus, root finding and least
+// squares fitting.
+//
+// A Poly holds the coefficients of a polynomial in the monomial basis in
+// increasing order of degree. Poly values are evaluated with Horner's
+// scheme, or with the compensated Horner scheme of Graillat, Langlois and
+// Louvet, which gives results as accurate as if computed in twice the
+// working precision. The roots of a polynomial are computed as the
+// eigenvalues of its companion matrix.
+//
+// A Series holds the coefficients of a polynomial in the Chebyshev or
+// Legendre basis on an interval, the representation used by Fit for least
+// squares polynomial approximation, which is much better conditioned than
+// the monomial basis for polynomials of moderate or high degree.
+//
+// See Graillat, Langlois and Louvet, "Compensated Horner scheme",
+// Research Report RR2005-04, Université de Perpignan, 2005, and Edelman
+// and Murakami, "Polynomial roots from companion matrix eigenvalues",
+// Mathematics of Computation 64(210), 1995.
+package poly // import "gonum.org/v1/gonum/num/poly"
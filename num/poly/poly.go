@@ -0,0 +1,201 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poly
+
+import "math"
+
+// Poly is a polynomial with real coefficients in the monomial basis,
+//
+//	p(x) = p[0] + p[1]*x + p[2]*x² + ... + p[n]*xⁿ.
+//
+// The zero polynomial may be represented by a nil or empty Poly. The
+// functions of the package that return a Poly return it with trailing zero
+// coefficients removed.
+type Poly []float64
+
+// Degree returns the degree of p, the index of its last non-zero
+// coefficient. The degree of the zero polynomial is -1.
+func (p Poly) Degree() int {
+	return len(p.Trim()) - 1
+}
+
+// Trim returns p with trailing zero coefficients removed. The returned
+// Poly shares its coefficients with p.
+func (p Poly) Trim() Poly {
+	n := len(p)
+	for n > 0 && p[n-1] == 0 {
+		n--
+	}
+	return p[:n]
+}
+
+// Eval returns the value of p at x computed using Horner's scheme.
+func (p Poly) Eval(x float64) float64 {
+	var v float64
+	for i := len(p) - 1; i >= 0; i-- {
+		v = v*x + p[i]
+	}
+	return v
+}
+
+// EvalCompensated returns the value of p at x computed using the compensated
+// Horner scheme. The rounding errors of Horner's scheme are computed exactly
+// and accumulated in a correction term, so that the relative error of the
+// result is bounded by approximately ε + κ·ε², where ε is the machine epsilon
+// and κ is the condition number of the evaluation, compared to κ·ε for Eval.
+// EvalCompensated is useful for evaluating polynomials near their multiple
+// or clustered roots.
+func (p Poly) EvalCompensated(x float64) float64 {
+	var v, c float64
+	for i := len(p) - 1; i >= 0; i-- {
+		prod, errProd := twoProd(v, x)
+		var errSum float64
+		v, errSum = twoSum(prod, p[i])
+		c = c*x + (errProd + errSum)
+	}
+	return v + c
+}
+
+// twoProd returns the product of a and b and its rounding error.
+func twoProd(a, b float64) (p, e float64) {
+	p = a * b
+	return p, math.FMA(a, b, -p)
+}
+
+// twoSum returns the sum of a and b and its rounding error.
+func twoSum(a, b float64) (s, e float64) {
+	s = a + b
+	z := s - a
+	return s, (a - (s - z)) + (b - z)
+}
+
+// EvalComplex returns the value of p at the complex point z computed using
+// Horner's scheme.
+func (p Poly) EvalComplex(z complex128) complex128 {
+	var v complex128
+	for i := len(p) - 1; i >= 0; i-- {
+		v = v*z + complex(p[i], 0)
+	}
+	return v
+}
+
+// FromRoots returns the monic polynomial with the given real roots,
+//
+//	(x - roots[0]) * (x - roots[1]) * ... * (x - roots[n-1]).
+func FromRoots(roots ...float64) Poly {
+	p := make(Poly, len(roots)+1)
+	p[0] = 1
+	for k, r := range roots {
+		// Multiply the polynomial of degree k by (x - r).
+		for i := k + 1; i > 0; i-- {
+			p[i] = p[i-1] - r*p[i]
+		}
+		p[0] *= -r
+	}
+	return p.Trim()
+}
+
+// Add returns the sum of p and q.
+func Add(p, q Poly) Poly {
+	if len(p) < len(q) {
+		p, q = q, p
+	}
+	r := make(Poly, len(p))
+	copy(r, p)
+	for i, v := range q {
+		r[i] += v
+	}
+	return r.Trim()
+}
+
+// Sub returns the difference of p and q, p-q.
+func Sub(p, q Poly) Poly {
+	r := make(Poly, max(len(p), len(q)))
+	copy(r, p)
+	for i, v := range q {
+		r[i] -= v
+	}
+	return r.Trim()
+}
+
+// Scale returns p scaled by f.
+func Scale(f float64, p Poly) Poly {
+	r := make(Poly, len(p))
+	for i, v := range p {
+		r[i] = f * v
+	}
+	return r.Trim()
+}
+
+// Mul returns the product of p and q.
+func Mul(p, q Poly) Poly {
+	p, q = p.Trim(), q.Trim()
+	if len(p) == 0 || len(q) == 0 {
+		return Poly{}
+	}
+	r := make(Poly, len(p)+len(q)-1)
+	for i, u := range p {
+		for j, v := range q {
+			r[i+j] += u * v
+		}
+	}
+	return r.Trim()
+}
+
+// DivMod returns the quotient and remainder of the polynomial long division
+// of p by q, so that
+//
+//	p = q*quo + rem
+//
+// with the degree of rem less than the degree of q. DivMod panics if q is
+// the zero polynomial.
+func DivMod(p, q Poly) (quo, rem Poly) {
+	q = q.Trim()
+	if len(q) == 0 {
+		panic("poly: division by zero polynomial")
+	}
+	rem = make(Poly, len(p))
+	copy(rem, p)
+	rem = rem.Trim()
+	n := len(q) - 1
+	if len(rem)-1 < n {
+		return Poly{}, rem
+	}
+	quo = make(Poly, len(rem)-n)
+	lead := q[n]
+	for k := len(rem) - 1; k >= n; k-- {
+		c := rem[k] / lead
+		quo[k-n] = c
+		for j := 0; j <= n; j++ {
+			rem[k-n+j] -= c * q[j]
+		}
+		// Set the eliminated coefficient exactly to zero.
+		rem[k] = 0
+	}
+	return quo.Trim(), rem[:n].Trim()
+}
+
+// Deriv returns the derivative of p.
+func Deriv(p Poly) Poly {
+	if len(p) < 2 {
+		return Poly{}
+	}
+	r := make(Poly, len(p)-1)
+	for i := range r {
+		r[i] = float64(i+1) * p[i+1]
+	}
+	return r.Trim()
+}
+
+// Integ returns the antiderivative of p with the constant term c, so that
+// the returned polynomial has the value c at zero.
+func Integ(p Poly, c float64) Poly {
+	r := make(Poly, len(p)+1)
+	r[0] = c
+	for i, v := range p {
+		r[i+1] = v / float64(i+1)
+	}
+	return r.Trim()
+}
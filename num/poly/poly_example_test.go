@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poly_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/num/poly"
+)
+
+func ExampleRoots() {
+	// p(x) = (x² - 2x + 5)(x - 2) = x³ - 4x² + 9x - 10.
+	p := poly.Poly{-10, 9, -4, 1}
+	roots, err := poly.Roots(p)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, r := range roots {
+		fmt.Printf("%.3f\n", r)
+	}
+
+	// Output:
+	// (1.000-2.000i)
+	// (1.000+2.000i)
+	// (2.000+0.000i)
+}
+
+func ExampleFit() {
+	// Approximate exp on [0, 1] by a Chebyshev series of degree 4.
+	x := make([]float64, 50)
+	y := make([]float64, len(x))
+	for i := range x {
+		x[i] = float64(i) / float64(len(x)-1)
+		y[i] = math.Exp(x[i])
+	}
+	s, err := poly.Fit(x, y, nil, 4, poly.Chebyshev)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("s(0.5) = %.5f, exp(0.5) = %.5f\n", s.Eval(0.5), math.Exp(0.5))
+
+	// Output:
+	// s(0.5) = 1.64872, exp(0.5) = 1.64872
+}
@@ -0,0 +1,191 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poly
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// equalPoly returns whether the coefficients of a and b are equal within
+// tol, treating missing coefficients of the shorter polynomial as zero.
+func equalPoly(a, b Poly, tol float64) bool {
+	for i := 0; i < max(len(a), len(b)); i++ {
+		var u, v float64
+		if i < len(a) {
+			u = a[i]
+		}
+		if i < len(b) {
+			v = b[i]
+		}
+		if !scalar.EqualWithinAbsOrRel(u, v, tol, tol) {
+			return false
+		}
+	}
+	return true
+}
+
+func randPoly(n int, rnd *rand.Rand) Poly {
+	p := make(Poly, n)
+	for i := range p {
+		p[i] = rnd.NormFloat64()
+	}
+	return p
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, test := range []struct {
+		name string
+		got  Poly
+		want Poly
+	}{
+		{name: "trim", got: Poly{1, 2, 0, 0}.Trim(), want: Poly{1, 2}},
+		{name: "from roots", got: FromRoots(1, 2, 3), want: Poly{-6, 11, -6, 1}},
+		{name: "from no roots", got: FromRoots(), want: Poly{1}},
+		{name: "add", got: Add(Poly{1, 2}, Poly{3, 4, 5}), want: Poly{4, 6, 5}},
+		{name: "add cancel", got: Add(Poly{1, 2, 3}, Poly{0, 0, -3}), want: Poly{1, 2}},
+		{name: "sub", got: Sub(Poly{1, 2}, Poly{3, 4, 5}), want: Poly{-2, -2, -5}},
+		{name: "sub self", got: Sub(Poly{1, 2}, Poly{1, 2}), want: Poly{}},
+		{name: "scale", got: Scale(2, Poly{1, -2, 3}), want: Poly{2, -4, 6}},
+		{name: "scale zero", got: Scale(0, Poly{1, -2, 3}), want: Poly{}},
+		{name: "mul", got: Mul(Poly{-1, 1}, Poly{1, 1}), want: Poly{-1, 0, 1}},
+		{name: "mul zero", got: Mul(Poly{-1, 1}, nil), want: Poly{}},
+		{name: "deriv", got: Deriv(Poly{1, 2, 3, 4}), want: Poly{2, 6, 12}},
+		{name: "deriv constant", got: Deriv(Poly{5}), want: Poly{}},
+		{name: "integ", got: Integ(Poly{2, 6, 12}, 1), want: Poly{1, 2, 3, 4}},
+		{name: "integ zero", got: Integ(nil, 3), want: Poly{3}},
+	} {
+		if !equalPoly(test.got, test.want, tol) {
+			t.Errorf("unexpected result for %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestDegree(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		p    Poly
+		want int
+	}{
+		{p: nil, want: -1},
+		{p: Poly{0, 0}, want: -1},
+		{p: Poly{3}, want: 0},
+		{p: Poly{1, 2, 0}, want: 1},
+		{p: Poly{0, 0, 1}, want: 2},
+	} {
+		if got := test.p.Degree(); got != test.want {
+			t.Errorf("unexpected degree of %v: got:%d want:%d", test.p, got, test.want)
+		}
+	}
+}
+
+func TestDivMod(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, np := range []int{0, 1, 2, 5, 8} {
+		for _, nq := range []int{1, 2, 4, 9} {
+			p := randPoly(np, rnd)
+			q := randPoly(nq, rnd)
+			quo, rem := DivMod(p, q)
+			if rem.Degree() >= q.Degree() && rem.Degree() >= 0 {
+				t.Errorf("unexpected remainder degree for len(p)=%d len(q)=%d: got:%d want:<%d",
+					np, nq, rem.Degree(), q.Degree())
+			}
+			got := Add(Mul(q, quo), rem)
+			if !equalPoly(got, p, tol) {
+				t.Errorf("unexpected q*quo+rem for len(p)=%d len(q)=%d: got:%v want:%v", np, nq, got, p)
+			}
+		}
+	}
+
+	quo, rem := DivMod(FromRoots(1, 2, 3), FromRoots(2))
+	if !equalPoly(quo, FromRoots(1, 3), 1e-14) || len(rem) != 0 {
+		t.Errorf("unexpected exact division: got:%v,%v want:%v,[]", quo, rem, FromRoots(1, 3))
+	}
+
+	if !panics(func() { DivMod(Poly{1, 2}, Poly{0}) }) {
+		t.Error("expected panic for division by zero polynomial")
+	}
+}
+
+func TestEval(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 10} {
+		p := randPoly(n, rnd)
+		for i := 0; i < 5; i++ {
+			x := 2*rnd.Float64() - 1
+			var want float64
+			for k, c := range p {
+				want += c * math.Pow(x, float64(k))
+			}
+			if got := p.Eval(x); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected Eval(%v) for n=%d: got:%v want:%v", x, n, got, want)
+			}
+			if got := p.EvalCompensated(x); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected EvalCompensated(%v) for n=%d: got:%v want:%v", x, n, got, want)
+			}
+			z := complex(x, 0)
+			if got := p.EvalComplex(z); !scalar.EqualWithinAbsOrRel(real(got), want, tol, tol) || imag(got) != 0 {
+				t.Errorf("unexpected EvalComplex(%v) for n=%d: got:%v want:%v", z, n, got, want)
+			}
+
+			// Check the derivative against the antiderivative.
+			if got, want := Deriv(Integ(p, x)).Eval(x), p.Eval(x); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected derivative of antiderivative for n=%d: got:%v want:%v", n, got, want)
+			}
+		}
+	}
+
+	// The value of x²+1 at i is zero.
+	if got := (Poly{1, 0, 1}).EvalComplex(1i); got != 0 {
+		t.Errorf("unexpected EvalComplex(i): got:%v want:0", got)
+	}
+}
+
+func TestEvalCompensated(t *testing.T) {
+	t.Parallel()
+	// The expanded form of (x-0.75)⁷ has exactly representable
+	// coefficients and is evaluated near its multiple root, where
+	// Horner's scheme suffers from cancellation.
+	p := FromRoots(0.75, 0.75, 0.75, 0.75, 0.75, 0.75, 0.75)
+	for _, x := range []float64{0.74, 0.745, 0.749, 0.751, 0.755, 0.76} {
+		want := exactEval(p, x)
+		got := p.EvalCompensated(x)
+		if relErr := math.Abs(got-want) / math.Abs(want); relErr > 1e-8 {
+			t.Errorf("unexpected relative error of EvalCompensated(%v): got:%v want:<1e-8", x, relErr)
+		}
+	}
+}
+
+// exactEval returns the value of p at x computed in extended precision and
+// rounded to float64.
+func exactEval(p Poly, x float64) float64 {
+	const prec = 1024
+	bx := new(big.Float).SetPrec(prec).SetFloat64(x)
+	v := new(big.Float).SetPrec(prec)
+	for i := len(p) - 1; i >= 0; i-- {
+		v.Mul(v, bx)
+		v.Add(v, new(big.Float).SetPrec(prec).SetFloat64(p[i]))
+	}
+	f, _ := v.Float64()
+	return f
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,66 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poly
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Roots returns the complex roots of p, repeated according to their
+// multiplicity and sorted by increasing real part and then by increasing
+// imaginary part. The roots of a constant polynomial are empty.
+//
+// The non-zero roots are computed as the eigenvalues of the companion matrix
+// of p, which is balanced before the eigenvalues are computed. The computed
+// roots are the exact roots of a polynomial with coefficients that have small
+// relative perturbations, but multiple and clustered roots may be computed
+// with low accuracy.
+//
+// Roots returns an error if the eigenvalue computation does not converge.
+// Roots panics if p is the zero polynomial.
+func Roots(p Poly) ([]complex128, error) {
+	p = p.Trim()
+	if len(p) == 0 {
+		panic("poly: roots of zero polynomial")
+	}
+
+	// Zero coefficients of the lowest degrees
+	// correspond to roots at zero.
+	var zeros int
+	for p[zeros] == 0 {
+		zeros++
+	}
+	p = p[zeros:]
+	n := len(p) - 1
+	roots := make([]complex128, zeros, zeros+n)
+	if n > 0 {
+		// Form the companion matrix of the monic polynomial,
+		// with the negated coefficients in the first row.
+		c := mat.NewDense(n, n, nil)
+		for j := 0; j < n; j++ {
+			c.Set(0, j, -p[n-1-j]/p[n])
+		}
+		for i := 1; i < n; i++ {
+			c.Set(i, i-1, 1)
+		}
+		var eig mat.Eigen
+		if !eig.Factorize(c, mat.EigenNone) {
+			return nil, errors.New("poly: eigenvalue computation did not converge")
+		}
+		roots = append(roots, eig.Values(nil)...)
+	}
+
+	slices.SortFunc(roots, func(a, b complex128) int {
+		if c := cmp.Compare(real(a), real(b)); c != 0 {
+			return c
+		}
+		return cmp.Compare(imag(a), imag(b))
+	})
+	return roots, nil
+}
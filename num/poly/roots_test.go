@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poly
+
+import (
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestRoots(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	for _, test := range []struct {
+		name string
+		p    Poly
+		want []complex128
+	}{
+		{name: "constant", p: Poly{3}, want: nil},
+		{name: "linear", p: Poly{-3, 2}, want: []complex128{1.5}},
+		{name: "real", p: FromRoots(3, -1, 2), want: []complex128{-1, 2, 3}},
+		{name: "complex", p: Poly{1, 0, 1}, want: []complex128{-1i, 1i}},
+		{name: "mixed", p: Mul(Poly{5, -2, 1}, Poly{-4, 1}), want: []complex128{1 - 2i, 1 + 2i, 4}},
+		{name: "zero roots", p: Poly{0, 0, -1, 1}, want: []complex128{0, 0, 1}},
+		{name: "trailing zeros", p: Poly{-2, 1, 0, 0}, want: []complex128{2}},
+		{name: "monomial", p: Poly{0, 0, 0, 7}, want: []complex128{0, 0, 0}},
+	} {
+		got, err := Roots(test.p)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		if len(got) != len(test.want) {
+			t.Errorf("unexpected number of roots for %s: got:%d want:%d", test.name, len(got), len(test.want))
+			continue
+		}
+		for i, r := range got {
+			if cmplx.Abs(r-test.want[i]) > tol {
+				t.Errorf("unexpected roots for %s: got:%v want:%v", test.name, got, test.want)
+				break
+			}
+		}
+	}
+
+	if !panics(func() { Roots(Poly{0, 0}) }) {
+		t.Error("expected panic for roots of zero polynomial")
+	}
+}
+
+func TestRootsRandom(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-8
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{2, 3, 5, 10, 20} {
+		p := randPoly(n+1, rnd)
+		roots, err := Roots(p)
+		if err != nil {
+			t.Errorf("unexpected error for n=%d: %v", n, err)
+			continue
+		}
+		if len(roots) != n {
+			t.Errorf("unexpected number of roots for n=%d: got:%d", n, len(roots))
+			continue
+		}
+		for _, r := range roots {
+			// Compare the residual to the magnitude of the
+			// terms of the sum to allow for cancellation.
+			var scale float64
+			for k := len(p) - 1; k >= 0; k-- {
+				scale = scale*cmplx.Abs(r) + abs(p[k])
+			}
+			if res := cmplx.Abs(p.EvalComplex(r)); res > tol*scale {
+				t.Errorf("unexpected residual at root %v for n=%d: got:%v want:<%v", r, n, res, tol*scale)
+			}
+		}
+	}
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
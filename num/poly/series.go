@@ -0,0 +1,206 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poly
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Basis is a basis of orthogonal polynomials on the interval [-1, 1].
+type Basis int
+
+const (
+	// Chebyshev is the basis of Chebyshev polynomials of the first kind,
+	//
+	//	T₀(t) = 1, T₁(t) = t, Tₖ₊₁(t) = 2t Tₖ(t) - Tₖ₋₁(t).
+	Chebyshev Basis = iota
+	// Legendre is the basis of Legendre polynomials,
+	//
+	//	P₀(t) = 1, P₁(t) = t, (k+1) Pₖ₊₁(t) = (2k+1) t Pₖ(t) - k Pₖ₋₁(t).
+	Legendre
+)
+
+// recurrence returns the coefficients α and β of the three-term recurrence
+//
+//	φₖ₊₁(t) = α φₖ(t) + β φₖ₋₁(t)
+//
+// of the basis polynomials for k ≥ 1.
+func (b Basis) recurrence(k int, t float64) (alpha, beta float64) {
+	switch b {
+	case Chebyshev:
+		return 2 * t, -1
+	case Legendre:
+		kf := float64(k)
+		return (2*kf + 1) * t / (kf + 1), -kf / (kf + 1)
+	default:
+		panic(badBasis)
+	}
+}
+
+const badBasis = "poly: unknown basis"
+
+// Series is a polynomial represented as a linear combination of the basis
+// polynomials,
+//
+//	s(x) = Coeffs[0]*φ₀(t) + Coeffs[1]*φ₁(t) + ... + Coeffs[n]*φₙ(t),
+//
+// where t = (2x - (Min+Max)) / (Max-Min) maps the interval [Min, Max] onto
+// the interval [-1, 1] on which the basis polynomials are orthogonal. Min
+// must be less than Max.
+type Series struct {
+	Basis    Basis
+	Coeffs   []float64
+	Min, Max float64
+}
+
+// scale returns the value of t corresponding to x.
+func (s Series) scale(x float64) float64 {
+	return (2*x - (s.Min + s.Max)) / (s.Max - s.Min)
+}
+
+// Eval returns the value of the series at x computed using Clenshaw's
+// recurrence.
+func (s Series) Eval(x float64) float64 {
+	n := len(s.Coeffs) - 1
+	switch n {
+	case -1:
+		return 0
+	case 0:
+		return s.Coeffs[0]
+	}
+	t := s.scale(x)
+
+	// Sum the series backward so that b1 and b2
+	// hold bₖ and bₖ₊₁ of Clenshaw's recurrence.
+	var b1, b2 float64
+	for k := n; k >= 1; k-- {
+		alpha, _ := s.Basis.recurrence(k, t)
+		_, beta := s.Basis.recurrence(k+1, t)
+		b1, b2 = s.Coeffs[k]+alpha*b1+beta*b2, b1
+	}
+	_, beta := s.Basis.recurrence(1, t)
+	return s.Coeffs[0] + t*b1 + beta*b2
+}
+
+// Poly returns the series converted to the monomial basis in x. The
+// conversion may lose accuracy for series of high degree, since the
+// monomial basis is poorly conditioned.
+func (s Series) Poly() Poly {
+	n := len(s.Coeffs) - 1
+	if n < 0 {
+		return Poly{}
+	}
+
+	// Accumulate the series in the monomial basis in t,
+	// generating the basis polynomials by their recurrence.
+	t := Poly{0, 1}
+	prev, curr := Poly{1}, t
+	r := Poly{s.Coeffs[0]}
+	for k := 1; k <= n; k++ {
+		r = Add(r, Scale(s.Coeffs[k], curr))
+		if k == n {
+			break
+		}
+		// The coefficient α of the recurrence is proportional
+		// to t, so its value at t = 1 is the factor of t.
+		alpha, beta := s.Basis.recurrence(k, 1)
+		next := Add(Scale(alpha, Mul(t, curr)), Scale(beta, prev))
+		prev, curr = curr, next
+	}
+
+	// Substitute t = a*x + b using Horner's scheme.
+	a := 2 / (s.Max - s.Min)
+	b := -(s.Min + s.Max) / (s.Max - s.Min)
+	lin := Poly{b, a}
+	p := Poly{}
+	for i := len(r) - 1; i >= 0; i-- {
+		p = Add(Mul(p, lin), Poly{r[i]})
+	}
+	return p
+}
+
+// Fit returns the series of degree at most deg in the given basis that
+// minimizes the weighted sum of squared residuals
+//
+//	\sum_i weights[i] * (y[i] - s(x[i]))²
+//
+// on the interval spanned by x. If weights is nil, all the weights are one.
+// If all the values in x are equal, the series is fitted on the interval
+// [x[0]-1, x[0]+1].
+//
+// The least squares problem is solved using the singular value decomposition
+// of the weighted design matrix, so that when the problem is rank-deficient,
+// for example when there are fewer distinct values in x than deg+1, the
+// returned coefficients are the solution of minimum norm. Fit returns an
+// error if the singular value decomposition does not converge.
+//
+// Fit panics if x is empty, if the lengths of x, y and a non-nil weights
+// differ, if any weight is negative or if deg is negative.
+func Fit(x, y, weights []float64, deg int, basis Basis) (Series, error) {
+	switch {
+	case len(x) == 0:
+		panic("poly: no data")
+	case len(y) != len(x):
+		panic("poly: length mismatch")
+	case weights != nil && len(weights) != len(x):
+		panic("poly: length mismatch")
+	case deg < 0:
+		panic("poly: negative degree")
+	}
+	for _, w := range weights {
+		if w < 0 {
+			panic("poly: negative weight")
+		}
+	}
+	if basis != Chebyshev && basis != Legendre {
+		panic(badBasis)
+	}
+
+	s := Series{
+		Basis: basis,
+		Min:   floats.Min(x),
+		Max:   floats.Max(x),
+	}
+	if s.Min == s.Max {
+		s.Min--
+		s.Max++
+	}
+
+	m := len(x)
+	a := mat.NewDense(m, deg+1, nil)
+	b := mat.NewVecDense(m, nil)
+	for i, xi := range x {
+		sw := 1.0
+		if weights != nil {
+			sw = math.Sqrt(weights[i])
+		}
+		t := s.scale(xi)
+		row := a.RawRowView(i)
+		row[0] = sw
+		if deg > 0 {
+			row[1] = sw * t
+		}
+		for k := 1; k < deg; k++ {
+			alpha, beta := basis.recurrence(k, t)
+			row[k+1] = alpha*row[k] + beta*row[k-1]
+		}
+		b.SetVec(i, sw*y[i])
+	}
+
+	var c mat.VecDense
+	_, ok := c.SolveVecRank(a, b, -1)
+	if !ok {
+		return Series{}, errors.New("poly: singular value decomposition did not converge")
+	}
+	s.Coeffs = make([]float64, deg+1)
+	for k := range s.Coeffs {
+		s.Coeffs[k] = c.AtVec(k)
+	}
+	return s, nil
+}
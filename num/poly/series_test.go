@@ -0,0 +1,139 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poly
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestSeriesEval(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for k := 0; k <= 8; k++ {
+		coeffs := make([]float64, k+1)
+		coeffs[k] = 1
+		cheb := Series{Basis: Chebyshev, Coeffs: coeffs, Min: -1, Max: 1}
+		for i := 0; i < 10; i++ {
+			x := 2*rnd.Float64() - 1
+			want := math.Cos(float64(k) * math.Acos(x))
+			if got := cheb.Eval(x); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected T%d(%v): got:%v want:%v", k, x, got, want)
+			}
+			if got := cheb.Poly().Eval(x); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected monomial T%d(%v): got:%v want:%v", k, x, got, want)
+			}
+		}
+	}
+
+	// P₃(t) = (5t³ - 3t) / 2 on the interval [1, 5], with t = (x-3)/2.
+	leg := Series{Basis: Legendre, Coeffs: []float64{0, 0, 0, 1}, Min: 1, Max: 5}
+	for _, x := range []float64{1, 1.5, 2.25, 3, 4.5, 5} {
+		u := (x - 3) / 2
+		want := (5*u*u*u - 3*u) / 2
+		if got := leg.Eval(x); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected P3 at %v: got:%v want:%v", x, got, want)
+		}
+	}
+	want := Poly{-99.0 / 16, 123.0 / 16, -45.0 / 16, 5.0 / 16}
+	if got := leg.Poly(); !equalPoly(got, want, tol) {
+		t.Errorf("unexpected monomial form of P3: got:%v want:%v", got, want)
+	}
+
+	// A random series agrees with its monomial form.
+	for _, basis := range []Basis{Chebyshev, Legendre} {
+		s := Series{Basis: basis, Coeffs: randPoly(7, rnd), Min: -2, Max: 3}
+		p := s.Poly()
+		for i := 0; i < 10; i++ {
+			x := 5*rnd.Float64() - 2
+			if got, want := s.Eval(x), p.Eval(x); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected Eval for basis %d at %v: got:%v want:%v", basis, x, got, want)
+			}
+		}
+	}
+}
+
+func TestFit(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	want := Poly{1, -2, 0.5, 3}
+	for _, basis := range []Basis{Chebyshev, Legendre} {
+		x := make([]float64, 20)
+		y := make([]float64, len(x))
+		for i := range x {
+			x[i] = 4*rnd.Float64() - 1
+			y[i] = want.Eval(x[i])
+		}
+
+		// A cubic is recovered exactly by a fit of degree
+		// three or higher.
+		for _, deg := range []int{3, 5} {
+			s, err := Fit(x, y, nil, deg, basis)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := s.Poly(); !equalPoly(got, want, tol) {
+				t.Errorf("unexpected fit for basis %d deg=%d: got:%v want:%v", basis, deg, got, want)
+			}
+		}
+
+		// Outliers with zero weight do not change the fit.
+		weights := make([]float64, len(x)+2)
+		for i := range x {
+			weights[i] = 1 + rnd.Float64()
+		}
+		xo := append(append([]float64(nil), x...), 0.5, 1.5)
+		yo := append(append([]float64(nil), y...), 100, -100)
+		s, err := Fit(xo, yo, weights, 3, basis)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := s.Poly(); !equalPoly(got, want, tol) {
+			t.Errorf("unexpected weighted fit for basis %d: got:%v want:%v", basis, got, want)
+		}
+	}
+
+	// A straight line fitted to noisy data has the least squares
+	// slope and intercept.
+	x := []float64{0, 1, 2, 3, 4}
+	y := []float64{1.1, 2.9, 5.2, 6.8, 9.1}
+	s, err := Fit(x, y, nil, 1, Legendre)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := s.Poly(), (Poly{1.04, 1.99}); !equalPoly(got, want, 1e-12) {
+		t.Errorf("unexpected line fit: got:%v want:%v", got, want)
+	}
+
+	// Data at a single point give a rank-deficient problem.
+	s, err = Fit([]float64{2, 2, 2}, []float64{1, 2, 3}, nil, 2, Chebyshev)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := s.Eval(2); !scalar.EqualWithinAbsOrRel(got, 2, tol, tol) {
+		t.Errorf("unexpected value of rank-deficient fit: got:%v want:2", got)
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "empty", fn: func() { Fit(nil, nil, nil, 1, Chebyshev) }},
+		{name: "length mismatch", fn: func() { Fit([]float64{1, 2}, []float64{1}, nil, 1, Chebyshev) }},
+		{name: "weights mismatch", fn: func() { Fit([]float64{1, 2}, []float64{1, 2}, []float64{1}, 1, Chebyshev) }},
+		{name: "negative weight", fn: func() { Fit([]float64{1, 2}, []float64{1, 2}, []float64{1, -1}, 1, Chebyshev) }},
+		{name: "negative degree", fn: func() { Fit([]float64{1, 2}, []float64{1, 2}, nil, -1, Chebyshev) }},
+		{name: "unknown basis", fn: func() { Fit([]float64{1, 2}, []float64{1, 2}, nil, 1, Basis(-1)) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
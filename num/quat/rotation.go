@@ -0,0 +1,252 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quat
+
+import "math"
+
+// The functions in this file operate on unit quaternions representing
+// rotations in three dimensions. A vector v is rotated by a unit quaternion
+// q as q*v*conj(q), where v is held in the imaginary parts of a quaternion.
+
+// Slerp returns the spherical linear interpolation between the unit
+// quaternions q0 and q1 at t, with Slerp(q0, q1, 0) = q0 and
+// Slerp(q0, q1, 1) = ±q1. The interpolation follows the shorter of the
+// two arcs between the rotations represented by q0 and q1, negating q1 if
+// necessary, and so has constant angular velocity.
+func Slerp(q0, q1 Number, t float64) Number {
+	if dot(q0, q1) < 0 {
+		q1 = Scale(-1, q1)
+	}
+	return slerp(q0, q1, t)
+}
+
+// slerp returns the spherical linear interpolation between q0 and q1
+// along the arc joining them as points on the unit sphere.
+func slerp(q0, q1 Number, t float64) Number {
+	// The angle between q0 and q1 computed from the chord
+	// lengths is accurate for all angles.
+	theta := 2 * math.Atan2(Abs(Sub(q1, q0)), Abs(Add(q1, q0)))
+	s := sinc(theta)
+	w0 := (1 - t) * sinc((1-t)*theta) / s
+	w1 := t * sinc(t*theta) / s
+	return Add(Scale(w0, q0), Scale(w1, q1))
+}
+
+// sinc returns sin(x)/x.
+func sinc(x float64) float64 {
+	if math.Abs(x) < 1e-4 {
+		// The error of the truncated series is below x^4/120.
+		return 1 - x*x/6
+	}
+	return math.Sin(x) / x
+}
+
+// dot returns the Euclidean inner product of x and y.
+func dot(x, y Number) float64 {
+	return x.Real*y.Real + x.Imag*y.Imag + x.Jmag*y.Jmag + x.Kmag*y.Kmag
+}
+
+// Squad returns the spherical quadrangle interpolation between the unit
+// quaternions q0 and q1 at t, with control points s0 and s1,
+//
+//	Squad(q0, q1, s0, s1, t) = slerp(slerp(q0, q1, t), slerp(s0, s1, t), 2t(1-t)),
+//
+// where slerp is spherical linear interpolation along the arc joining its
+// arguments. When the control points are computed by SquadControl from a
+// sequence of key rotations, the curve formed by Squad between consecutive
+// keys has a continuous angular velocity at the keys.
+//
+// The arguments are interpolated without the choice of the shorter arc made
+// by Slerp, so consecutive keys should be in the same hemisphere, that is
+// have a non-negative inner product. This is ensured by negating each key
+// that has a negative inner product with its predecessor.
+//
+// See Shoemake, "Animating rotation with quaternion curves", SIGGRAPH 1985
+// and Dam, Koch and Lillholm, "Quaternions, interpolation and animation",
+// DIKU-TR-98/5, 1998.
+func Squad(q0, q1, s0, s1 Number, t float64) Number {
+	return slerp(slerp(q0, q1, t), slerp(s0, s1, t), 2*t*(1-t))
+}
+
+// SquadControl returns the control point for the unit quaternion q in a
+// sequence of key rotations for use with Squad, given the preceding and
+// following keys prev and next,
+//
+//	q exp(-(log(conj(q) next) + log(conj(q) prev))/4).
+//
+// For the first and last keys of a sequence, q itself may be used as the
+// control point, or the sequence may be extended by repeating them.
+func SquadControl(prev, q, next Number) Number {
+	if dot(q, prev) < 0 {
+		prev = Scale(-1, prev)
+	}
+	if dot(q, next) < 0 {
+		next = Scale(-1, next)
+	}
+	inv := Conj(q)
+	l := Add(logUnit(Mul(inv, next)), logUnit(Mul(inv, prev)))
+	return Mul(q, Exp(Scale(-0.25, l)))
+}
+
+// logUnit returns the logarithm of the unit quaternion q, which
+// has zero real part.
+func logUnit(q Number) Number {
+	l := Log(q)
+	l.Real = 0
+	return l
+}
+
+// RotationMatrix returns the 3×3 rotation matrix m corresponding to the unit
+// quaternion q, so that m×v is the vector v rotated by q. If q is not a unit
+// quaternion, the returned matrix is not a pure rotation.
+func RotationMatrix(q Number) [3][3]float64 {
+	w, i, j, k := q.Real, q.Imag, q.Jmag, q.Kmag
+	ii := 2 * i * i
+	jj := 2 * j * j
+	kk := 2 * k * k
+	wi := 2 * w * i
+	wj := 2 * w * j
+	wk := 2 * w * k
+	ij := 2 * i * j
+	jk := 2 * j * k
+	ki := 2 * k * i
+	return [3][3]float64{
+		{1 - (jj + kk), ij - wk, ki + wj},
+		{ij + wk, 1 - (ii + kk), jk - wi},
+		{ki - wj, jk + wi, 1 - (ii + jj)},
+	}
+}
+
+// FromRotationMatrix returns the unit quaternion with non-negative real
+// part corresponding to the 3×3 rotation matrix m. The result is normalized,
+// so that matrices that are close to rotations give close rotations.
+//
+// The quaternion is computed with the method of Shepperd, "Quaternion from
+// rotation matrix", Journal of Guidance and Control 1(3), 1978, which is
+// accurate for all rotations.
+func FromRotationMatrix(m [3][3]float64) Number {
+	tr := m[0][0] + m[1][1] + m[2][2]
+	var q Number
+	switch {
+	case tr >= m[0][0] && tr >= m[1][1] && tr >= m[2][2]:
+		q = Number{
+			Real: 1 + tr,
+			Imag: m[2][1] - m[1][2],
+			Jmag: m[0][2] - m[2][0],
+			Kmag: m[1][0] - m[0][1],
+		}
+	case m[0][0] >= m[1][1] && m[0][0] >= m[2][2]:
+		q = Number{
+			Real: m[2][1] - m[1][2],
+			Imag: 1 + 2*m[0][0] - tr,
+			Jmag: m[0][1] + m[1][0],
+			Kmag: m[0][2] + m[2][0],
+		}
+	case m[1][1] >= m[2][2]:
+		q = Number{
+			Real: m[0][2] - m[2][0],
+			Imag: m[0][1] + m[1][0],
+			Jmag: 1 + 2*m[1][1] - tr,
+			Kmag: m[1][2] + m[2][1],
+		}
+	default:
+		q = Number{
+			Real: m[1][0] - m[0][1],
+			Imag: m[0][2] + m[2][0],
+			Jmag: m[1][2] + m[2][1],
+			Kmag: 1 + 2*m[2][2] - tr,
+		}
+	}
+	if q.Real < 0 {
+		q = Scale(-1, q)
+	}
+	return unit(q)
+}
+
+// FromEulerZYX returns the unit quaternion for the rotation given by the
+// Tait-Bryan angles yaw, pitch and roll in the intrinsic z-y′-x″ convention
+// used in aeronautics, that is, a rotation by yaw around the z axis followed
+// by a rotation by pitch around the new y axis and a rotation by roll around
+// the new x axis. The angles are in radians.
+func FromEulerZYX(yaw, pitch, roll float64) Number {
+	sy, cy := math.Sincos(yaw / 2)
+	sp, cp := math.Sincos(pitch / 2)
+	sr, cr := math.Sincos(roll / 2)
+	return Number{
+		Real: cy*cp*cr + sy*sp*sr,
+		Imag: cy*cp*sr - sy*sp*cr,
+		Jmag: cy*sp*cr + sy*cp*sr,
+		Kmag: sy*cp*cr - cy*sp*sr,
+	}
+}
+
+// EulerZYX returns the Tait-Bryan angles in the convention of FromEulerZYX
+// for the rotation represented by the unit quaternion q. The returned yaw and
+// roll are in [-π, π] and the pitch is in [-π/2, π/2].
+//
+// When the pitch is ±π/2 the yaw and roll are not unique, a condition known
+// as gimbal lock, and EulerZYX returns a zero roll. Close to gimbal lock the
+// yaw and roll are individually ill-conditioned, although the rotation they
+// represent is accurate.
+//
+// The angles are computed from the sum and difference of the half yaw and
+// roll angles as described in Bernardes and Viollet, "Quaternion to Euler
+// angles conversion: A direct, general and computationally efficient
+// method", PLoS ONE 17(11), 2022, which is accurate for all rotations.
+func EulerZYX(q Number) (yaw, pitch, roll float64) {
+	w, x, y, z := q.Real, q.Imag, q.Jmag, q.Kmag
+
+	// With half angles a, b and c of yaw, pitch and roll,
+	//  w+y = (cos b + sin b) cos(a-c), z-x = (cos b + sin b) sin(a-c),
+	//  w-y = (cos b - sin b) cos(a+c), z+x = (cos b - sin b) sin(a+c).
+	p := math.Hypot(w+y, z-x)
+	m := math.Hypot(w-y, z+x)
+	pitch = 2*math.Atan2(p, m) - math.Pi/2
+
+	const eps = 0x1p-52
+	switch {
+	case m <= 4*eps*p:
+		yaw = 2 * math.Atan2(z-x, w+y)
+	case p <= 4*eps*m:
+		yaw = 2 * math.Atan2(z+x, w-y)
+	default:
+		sum := math.Atan2(z+x, w-y)
+		diff := math.Atan2(z-x, w+y)
+		yaw = sum + diff
+		roll = math.Remainder(sum-diff, 2*math.Pi)
+	}
+	return math.Remainder(yaw, 2*math.Pi), pitch, roll
+}
+
+// Integrate returns the orientation reached from the unit quaternion q
+// after rotating with the constant angular velocity omega for the time dt,
+//
+//	q exp(omega dt / 2),
+//
+// where omega is held in the imaginary parts of a quaternion and is expressed
+// in the rotating body frame, as measured for example by a gyroscope. The
+// angular velocity in the fixed frame, omega′, is related to that in the body
+// frame by omega′ = q omega conj(q). The result is exact for a constant angular
+// velocity, and is normalized to limit the accumulation of rounding error
+// over many steps.
+func Integrate(q, omega Number, dt float64) Number {
+	omega.Real = 0
+	return unit(Mul(q, Exp(Scale(dt/2, omega))))
+}
+
+// AngularVelocity returns the constant angular velocity in the body frame
+// that rotates the unit quaternion q0 to ±q1 in the time dt along the
+// shorter arc. It is the inverse of Integrate, so that
+//
+//	Integrate(q0, AngularVelocity(q0, q1, dt), dt) = ±q1.
+//
+// The angular velocity is held in the imaginary parts of the returned
+// quaternion.
+func AngularVelocity(q0, q1 Number, dt float64) Number {
+	if dot(q0, q1) < 0 {
+		q1 = Scale(-1, q1)
+	}
+	return Scale(2/dt, logUnit(Mul(Conj(q0), q1)))
+}
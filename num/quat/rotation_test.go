@@ -0,0 +1,266 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// axisAngle returns the unit quaternion for a rotation by alpha
+// around the axis (x, y, z).
+func axisAngle(alpha, x, y, z float64) Number {
+	n := math.Sqrt(x*x + y*y + z*z)
+	s, c := math.Sincos(alpha / 2)
+	return Number{Real: c, Imag: s * x / n, Jmag: s * y / n, Kmag: s * z / n}
+}
+
+// randRotation returns a random unit quaternion.
+func randRotation(rnd *rand.Rand) Number {
+	return unit(Number{
+		Real: rnd.NormFloat64(),
+		Imag: rnd.NormFloat64(),
+		Jmag: rnd.NormFloat64(),
+		Kmag: rnd.NormFloat64(),
+	})
+}
+
+// sameRotation returns whether a and b represent the same rotation
+// within tol.
+func sameRotation(a, b Number, tol float64) bool {
+	return equalApprox(a, b, tol) || equalApprox(a, Scale(-1, b), tol)
+}
+
+func TestSlerp(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for i, test := range []struct {
+		q0, q1 Number
+		t      float64
+		want   Number
+	}{
+		{q0: Number{Real: 1}, q1: axisAngle(math.Pi/2, 0, 0, 1), t: 0, want: Number{Real: 1}},
+		{q0: Number{Real: 1}, q1: axisAngle(math.Pi/2, 0, 0, 1), t: 1, want: axisAngle(math.Pi/2, 0, 0, 1)},
+		{q0: Number{Real: 1}, q1: axisAngle(math.Pi/2, 0, 0, 1), t: 0.5, want: axisAngle(math.Pi/4, 0, 0, 1)},
+		{q0: Number{Real: 1}, q1: axisAngle(math.Pi/2, 0, 0, 1), t: 0.25, want: axisAngle(math.Pi/8, 0, 0, 1)},
+		{q0: axisAngle(1, 1, 2, 3), q1: axisAngle(2, 1, 2, 3), t: 0.5, want: axisAngle(1.5, 1, 2, 3)},
+		{q0: axisAngle(1, 1, 2, 3), q1: axisAngle(2, 1, 2, 3), t: 2, want: axisAngle(3, 1, 2, 3)},
+
+		// Shorter arc.
+		{q0: Number{Real: 1}, q1: Scale(-1, axisAngle(math.Pi/2, 0, 0, 1)), t: 0.5, want: axisAngle(math.Pi/4, 0, 0, 1)},
+		{q0: axisAngle(-3, 1, 0, 0), q1: axisAngle(3, 1, 0, 0), t: 0.5, want: axisAngle(math.Pi, 1, 0, 0)},
+
+		// Nearly equal rotations.
+		{q0: Number{Real: 1}, q1: axisAngle(1e-10, 0, 1, 0), t: 0.5, want: axisAngle(5e-11, 0, 1, 0)},
+		{q0: Number{Real: 1}, q1: Number{Real: 1}, t: 0.5, want: Number{Real: 1}},
+	} {
+		got := Slerp(test.q0, test.q1, test.t)
+		if !sameRotation(got, test.want, tol) {
+			t.Errorf("unexpected result for test %d: got:%v want:%v", i, got, test.want)
+		}
+		if math.Abs(Abs(got)-1) > tol {
+			t.Errorf("result not unit for test %d: |q|=%v", i, Abs(got))
+		}
+	}
+}
+
+func TestSquad(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	// Keys with a constant angular step around a fixed axis have control
+	// points equal to the keys, so squad reduces to slerp.
+	keys := make([]Number, 5)
+	for i := range keys {
+		keys[i] = axisAngle(0.4*float64(i), 1, -1, 2)
+	}
+	for i := 1; i < len(keys)-2; i++ {
+		s0 := SquadControl(keys[i-1], keys[i], keys[i+1])
+		s1 := SquadControl(keys[i], keys[i+1], keys[i+2])
+		if !sameRotation(s0, keys[i], tol) {
+			t.Errorf("unexpected control point %d: got:%v want:%v", i, s0, keys[i])
+		}
+		for _, x := range []float64{0, 0.1, 0.5, 0.75, 1} {
+			got := Squad(keys[i], keys[i+1], s0, s1, x)
+			want := Slerp(keys[i], keys[i+1], x)
+			if !sameRotation(got, want, tol) {
+				t.Errorf("unexpected squad for key %d at t=%v: got:%v want:%v", i, x, got, want)
+			}
+		}
+	}
+
+	// For general keys, squad interpolates the keys and the angular velocity
+	// is continuous across them.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	keys = make([]Number, 6)
+	for i := range keys {
+		keys[i] = randRotation(rnd)
+		if i > 0 && dot(keys[i], keys[i-1]) < 0 {
+			keys[i] = Scale(-1, keys[i])
+		}
+	}
+	ctrl := make([]Number, len(keys))
+	ctrl[0] = keys[0]
+	ctrl[len(keys)-1] = keys[len(keys)-1]
+	for i := 1; i < len(keys)-1; i++ {
+		ctrl[i] = SquadControl(keys[i-1], keys[i], keys[i+1])
+	}
+	const h = 1e-6
+	for i := 0; i < len(keys)-1; i++ {
+		for _, x := range []float64{0, 1} {
+			got := Squad(keys[i], keys[i+1], ctrl[i], ctrl[i+1], x)
+			want := keys[i+int(x)]
+			if !equalApprox(got, want, tol) {
+				t.Errorf("unexpected squad at key %d t=%v: got:%v want:%v", i, x, got, want)
+			}
+		}
+		if i == 0 {
+			continue
+		}
+		q := keys[i]
+		before := AngularVelocity(Squad(keys[i-1], q, ctrl[i-1], ctrl[i], 1-h), q, h)
+		after := AngularVelocity(q, Squad(q, keys[i+1], ctrl[i], ctrl[i+1], h), h)
+		if !equalApprox(before, after, 1e-4) {
+			t.Errorf("discontinuous angular velocity at key %d: before:%v after:%v", i, before, after)
+		}
+	}
+}
+
+func TestRotationMatrix(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	qs := []Number{
+		{Real: 1},
+		axisAngle(math.Pi/2, 0, 0, 1),
+		axisAngle(math.Pi, 1, 0, 0),
+		axisAngle(math.Pi, 0, 1, 0),
+		axisAngle(math.Pi, 0, 0, 1),
+		axisAngle(math.Pi, 1, 1, 1),
+		axisAngle(math.Pi-1e-8, 1, 2, 3),
+		axisAngle(1e-8, 3, 2, 1),
+	}
+	for i := 0; i < 20; i++ {
+		qs = append(qs, randRotation(rnd))
+	}
+	for i, q := range qs {
+		m := RotationMatrix(q)
+		for _, v := range [][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {1, -2, 3}} {
+			r := Mul(Mul(q, Number{Imag: v[0], Jmag: v[1], Kmag: v[2]}), Conj(q))
+			want := [3]float64{r.Imag, r.Jmag, r.Kmag}
+			for j := range want {
+				got := m[j][0]*v[0] + m[j][1]*v[1] + m[j][2]*v[2]
+				if math.Abs(got-want[j]) > tol*4 {
+					t.Errorf("unexpected rotation of %v for test %d: got element %d = %v want %v", v, i, j, got, want[j])
+				}
+			}
+		}
+
+		got := FromRotationMatrix(m)
+		if !sameRotation(got, q, tol) {
+			t.Errorf("unexpected round trip for test %d: got:%v want:%v", i, got, q)
+		}
+		if got.Real < 0 {
+			t.Errorf("unexpected negative real part for test %d: %v", i, got)
+		}
+	}
+}
+
+func TestEulerZYX(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for i, test := range []struct {
+		yaw, pitch, roll float64
+		want             Number
+	}{
+		{want: Number{Real: 1}},
+		{yaw: math.Pi / 2, want: axisAngle(math.Pi/2, 0, 0, 1)},
+		{pitch: math.Pi / 3, want: axisAngle(math.Pi/3, 0, 1, 0)},
+		{roll: -math.Pi / 4, want: axisAngle(-math.Pi/4, 1, 0, 0)},
+		{
+			yaw: 0.3, pitch: -0.2, roll: 1.1,
+			want: Mul(Mul(axisAngle(0.3, 0, 0, 1), axisAngle(-0.2, 0, 1, 0)), axisAngle(1.1, 1, 0, 0)),
+		},
+	} {
+		got := FromEulerZYX(test.yaw, test.pitch, test.roll)
+		if !equalApprox(got, test.want, tol) {
+			t.Errorf("unexpected quaternion for test %d: got:%v want:%v", i, got, test.want)
+		}
+		yaw, pitch, roll := EulerZYX(got)
+		if math.Abs(yaw-test.yaw) > tol || math.Abs(pitch-test.pitch) > tol || math.Abs(roll-test.roll) > tol {
+			t.Errorf("unexpected angles for test %d: got:(%v, %v, %v) want:(%v, %v, %v)",
+				i, yaw, pitch, roll, test.yaw, test.pitch, test.roll)
+		}
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 50; i++ {
+		q := randRotation(rnd)
+		yaw, pitch, roll := EulerZYX(q)
+		if math.Abs(yaw) > math.Pi || math.Abs(pitch) > math.Pi/2 || math.Abs(roll) > math.Pi {
+			t.Errorf("angles out of range for %v: (%v, %v, %v)", q, yaw, pitch, roll)
+		}
+		got := FromEulerZYX(yaw, pitch, roll)
+		if !sameRotation(got, q, 1e-12) {
+			t.Errorf("unexpected round trip: got:%v want:%v", got, q)
+		}
+	}
+
+	// Gimbal lock.
+	for _, pitch := range []float64{math.Pi / 2, -math.Pi / 2} {
+		q := FromEulerZYX(0.7, pitch, 0.2)
+		yaw, gotPitch, roll := EulerZYX(q)
+		if roll != 0 {
+			t.Errorf("unexpected non-zero roll at gimbal lock: %v", roll)
+		}
+		if math.Abs(gotPitch-pitch) > 1e-7 {
+			t.Errorf("unexpected pitch at gimbal lock: got:%v want:%v", gotPitch, pitch)
+		}
+		got := FromEulerZYX(yaw, gotPitch, roll)
+		if !sameRotation(got, q, 1e-7) {
+			t.Errorf("unexpected round trip at gimbal lock: got:%v want:%v", got, q)
+		}
+	}
+}
+
+func TestIntegrate(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	// Constant rotation around the body z axis.
+	const (
+		rate  = 0.3
+		steps = 1000
+		dt    = 0.01
+	)
+	q0 := axisAngle(1, 1, 2, 3)
+	q := q0
+	for i := 0; i < steps; i++ {
+		q = Integrate(q, Number{Kmag: rate}, dt)
+	}
+	want := Mul(q0, axisAngle(rate*steps*dt, 0, 0, 1))
+	if !sameRotation(q, want, tol) {
+		t.Errorf("unexpected integrated rotation: got:%v want:%v", q, want)
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		q0 := randRotation(rnd)
+		q1 := randRotation(rnd)
+		const dt = 0.5
+		omega := AngularVelocity(q0, q1, dt)
+		if omega.Real != 0 {
+			t.Errorf("unexpected non-zero real part of angular velocity: %v", omega)
+		}
+		if rate := Abs(omega); rate > math.Pi/dt*(1+1e-14) {
+			t.Errorf("angular velocity not along shorter arc: %v", rate)
+		}
+		got := Integrate(q0, omega, dt)
+		if !sameRotation(got, q1, tol) {
+			t.Errorf("unexpected round trip: got:%v want:%v", got, q1)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import "math"
+
+// Bound is a bound constraint on a variable x, requiring that
+//
+//	Min <= x <= Max.
+//
+// Min may be -Inf and Max may be +Inf to leave the variable unbounded in
+// that direction.
+type Bound struct {
+	Min, Max float64
+}
+
+// boundedMethod is a Method that supports bound constraints on the location.
+type boundedMethod interface {
+	Method
+
+	// setBounds sets the bounds on the location for the next
+	// optimization run. If bounds is nil, the location is
+	// unconstrained.
+	setBounds(bounds []Bound)
+}
+
+// checkBounds panics if bounds is not nil and its length does not match dim
+// or any of the bounds is invalid.
+func checkBounds(bounds []Bound, dim int) {
+	if bounds == nil {
+		return
+	}
+	if len(bounds) != dim {
+		panic("optimize: bounds do not match problem dimension")
+	}
+	for _, b := range bounds {
+		if !(b.Min <= b.Max) || math.IsInf(b.Min, 1) || math.IsInf(b.Max, -1) {
+			panic("optimize: invalid bound")
+		}
+	}
+}
+
+// feasible returns whether x satisfies the bounds.
+func feasible(x []float64, bounds []Bound) bool {
+	for i, b := range bounds {
+		if x[i] < b.Min || b.Max < x[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// project projects x onto the bounds in place.
+func project(x []float64, bounds []Bound) {
+	for i, b := range bounds {
+		x[i] = math.Max(b.Min, math.Min(x[i], b.Max))
+	}
+}
+
+// projectedGradientNorm returns the infinity norm of the projected gradient
+//
+//	P(x - g) - x,
+//
+// where P is the projection onto the bounds. At a solution of the bound
+// constrained problem, the projected gradient is zero. If bounds is nil,
+// projectedGradientNorm returns the infinity norm of g.
+func projectedGradientNorm(x, g []float64, bounds []Bound) float64 {
+	var norm float64
+	for i, gi := range g {
+		if bounds != nil {
+			gi = x[i] - math.Max(bounds[i].Min, math.Min(x[i]-gi, bounds[i].Max))
+		}
+		norm = math.Max(norm, math.Abs(gi))
+	}
+	return norm
+}
@@ -85,10 +85,33 @@ type CmaEsChol struct {
 	// Src allows a random number generator to be supplied for generating samples.
 	// If Src is nil the generator in golang.org/x/math/rand is used.
 	Src rand.Source
+	// Restarts sets the number of times the optimization is restarted with a
+	// larger population when the stopping criterion given by StopLogDet is
+	// met, following the IPOP-CMA-ES strategy described in
+	//
+	//	Auger, Anne, and Nikolaus Hansen. "A restart CMA evolution strategy
+	//	with increasing population size." IEEE Congress on Evolutionary
+	//	Computation. 2005.
+	//
+	// At each restart the population size is multiplied by PopulationGrowth
+	// and the sampling distribution is reset to its initial mean, step size
+	// and covariance. Larger populations explore the function more globally,
+	// which makes restarts effective for functions with many local minima.
+	// If Restarts is 0, the optimization is not restarted. Restarts cannot
+	// be negative, or CmaEsChol will panic.
+	Restarts int
+	// PopulationGrowth sets the factor by which the population size is
+	// multiplied at each restart. If PopulationGrowth is 0, a default value
+	// of 2 is used. PopulationGrowth cannot be less than 1, or CmaEsChol will
+	// panic.
+	PopulationGrowth float64
 
 	// Fixed algorithm parameters.
 	dim                 int
 	pop                 int
+	growth              float64
+	restarts            int // Number of restarts performed
+	initMean            []float64
 	weights             []float64
 	muEff               float64
 	cc, cs, c1, cmu, ds float64
@@ -164,6 +187,43 @@ func (cma *CmaEsChol) Init(dim, tasks int) int {
 	} else if cma.pop < 0 {
 		panic("cma-es-chol: negative population size")
 	}
+	if cma.Restarts < 0 {
+		panic("cma-es-chol: negative number of restarts")
+	}
+	cma.growth = cma.PopulationGrowth
+	if cma.growth == 0 {
+		cma.growth = 2
+	} else if !(cma.growth >= 1) {
+		panic("cma-es-chol: population growth less than one")
+	}
+	cma.restarts = 0
+	if cma.InitStepSize < 0 {
+		panic("cma-es-chol: negative initial step size")
+	}
+	if cma.InitCholesky != nil && cma.InitCholesky.SymmetricDim() != dim {
+		panic("cma-es-chol: incorrect InitCholesky size")
+	}
+	cma.setPopulation(cma.pop)
+	cma.resetDistribution()
+	cma.mean = resize(cma.mean, dim) // mean location initialized at the start of Run
+	cma.initMean = resize(cma.initMean, dim)
+
+	cma.bestX = resize(cma.bestX, dim)
+	cma.bestF = math.Inf(1)
+
+	cma.sentIdx = 0
+	cma.receivedIdx = 0
+	cma.operation = nil
+	cma.updateErr = nil
+	t := min(tasks, cma.pop)
+	return t
+}
+
+// setPopulation sets the population size and the algorithm parameters that
+// depend on it, and allocates the memory for the function data.
+func (cma *CmaEsChol) setPopulation(pop int) {
+	cma.pop = pop
+	n := float64(cma.dim)
 	mu := cma.pop / 2
 	cma.weights = resize(cma.weights, mu)
 	for i := range cma.weights {
@@ -187,15 +247,20 @@ func (cma *CmaEsChol) Init(dim, tasks int) int {
 	cma.eChi = math.Sqrt(n) * (1 - 1.0/(4*n) + 1/(21*n*n))
 
 	// Allocate memory for function data.
-	cma.xs = mat.NewDense(cma.pop, dim, nil)
+	cma.xs = mat.NewDense(cma.pop, cma.dim, nil)
 	cma.fs = resize(cma.fs, cma.pop)
+	for i := range cma.fs {
+		cma.fs[i] = math.NaN()
+	}
+}
 
-	// Allocate and initialize adaptive parameters.
+// resetDistribution sets the step size, evolution paths and covariance of
+// the sampling distribution to their initial values.
+func (cma *CmaEsChol) resetDistribution() {
+	dim := cma.dim
 	cma.invSigma = 1 / cma.InitStepSize
 	if cma.InitStepSize == 0 {
 		cma.invSigma = 10.0 / 3
-	} else if cma.InitStepSize < 0 {
-		panic("cma-es-chol: negative initial step size")
 	}
 	cma.pc = resize(cma.pc, dim)
 	for i := range cma.pc {
@@ -205,12 +270,8 @@ func (cma *CmaEsChol) Init(dim, tasks int) int {
 	for i := range cma.ps {
 		cma.ps[i] = 0
 	}
-	cma.mean = resize(cma.mean, dim) // mean location initialized at the start of Run
 
 	if cma.InitCholesky != nil {
-		if cma.InitCholesky.SymmetricDim() != dim {
-			panic("cma-es-chol: incorrect InitCholesky size")
-		}
 		cma.chol.Clone(cma.InitCholesky)
 	} else {
 		// Set the initial Cholesky to I.
@@ -225,16 +286,14 @@ func (cma *CmaEsChol) Init(dim, tasks int) int {
 		}
 		cma.chol = chol
 	}
+}
 
-	cma.bestX = resize(cma.bestX, dim)
-	cma.bestF = math.Inf(1)
-
-	cma.sentIdx = 0
-	cma.receivedIdx = 0
-	cma.operation = nil
-	cma.updateErr = nil
-	t := min(tasks, cma.pop)
-	return t
+// restart restarts the optimization with a larger population.
+func (cma *CmaEsChol) restart() {
+	cma.restarts++
+	cma.setPopulation(int(math.Ceil(cma.growth * float64(cma.pop))))
+	cma.resetDistribution()
+	copy(cma.mean, cma.initMean)
 }
 
 func (cma *CmaEsChol) sendInitTasks(tasks []Task) {
@@ -253,12 +312,12 @@ func (cma *CmaEsChol) sendTask(idx int, task Task) {
 	cma.operation <- task
 }
 
-// bestIdx returns the best index in the functions. Returns -1 if all values
-// are NaN.
-func (cma *CmaEsChol) bestIdx() int {
+// bestIdx returns the index of the best function value in fs. Returns -1 if
+// all values are NaN.
+func bestIdx(fs []float64) int {
 	best := -1
 	bestVal := math.Inf(1)
-	for i, v := range cma.fs {
+	for i, v := range fs {
 		if math.IsNaN(v) {
 			continue
 		}
@@ -276,7 +335,7 @@ func (cma *CmaEsChol) bestIdx() int {
 func (cma *CmaEsChol) findBestAndUpdateTask(task Task) Task {
 	// Find and update the best location.
 	// Don't use floats because there may be NaN values.
-	best := cma.bestIdx()
+	best := bestIdx(cma.fs)
 	bestF := math.NaN()
 	bestX := cma.xs.RawRowView(0)
 	if best != -1 {
@@ -299,6 +358,7 @@ func (cma *CmaEsChol) findBestAndUpdateTask(task Task) Task {
 
 func (cma *CmaEsChol) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
 	copy(cma.mean, tasks[0].X)
+	copy(cma.initMean, tasks[0].X)
 	cma.operation = operations
 	// Send the initial tasks. We know there are at most as many tasks as elements
 	// of the population.
@@ -348,6 +408,10 @@ Loop:
 				case err != nil:
 					cma.updateErr = err
 					task.Op = MethodDone
+				case cma.methodConverged() != NotTerminated && cma.restarts < cma.Restarts:
+					cma.restart()
+					task.Op = MajorIteration
+					task.ID = -1
 				case cma.methodConverged() != NotTerminated:
 					task.Op = MethodDone
 				default:
@@ -375,7 +439,7 @@ Loop:
 	// found so far. Keep this separate from findBestAndUpdateTask so that
 	// we only send an iteration if we find a better location.
 	if !cma.ForgetBest {
-		best := cma.bestIdx()
+		best := bestIdx(cma.fs)
 		if best != -1 && cma.fs[best] < cma.bestF {
 			task := tasks[0]
 			task.F = cma.fs[best]
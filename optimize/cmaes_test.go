@@ -195,6 +195,48 @@ func cmaTestCases() []cmaTestCase {
 				return nil
 			},
 		},
+		{
+			// Test that restarts with an increasing population size
+			// escape the local minima.
+			dim: 2,
+			problem: Problem{
+				Func: functions.Rastrigin{}.Func,
+			},
+			initX: []float64{3, 3},
+			method: &CmaEsChol{
+				InitStepSize: 1,
+				Restarts:     7,
+			},
+			settings: &Settings{
+				Converger: NeverTerminate{},
+			},
+			good: func(result *Result, err error, concurrent int) error {
+				if result.Status != MethodConverge {
+					return errors.New("result not method converge")
+				}
+				if !floats.EqualApprox(result.X, []float64{0, 0}, 1e-6) {
+					return errors.New("global minimum not found")
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func TestCmaEsCholPanics(t *testing.T) {
+	t.Parallel()
+	p := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+	x := []float64{0, 0}
+	for _, test := range []struct {
+		name   string
+		method *CmaEsChol
+	}{
+		{name: "negative Restarts", method: &CmaEsChol{Restarts: -1}},
+		{name: "PopulationGrowth less than one", method: &CmaEsChol{PopulationGrowth: 0.5}},
+	} {
+		if !panics(func() { Minimize(p, x, nil, test.method) }) {
+			t.Errorf("%s: expected panic", test.name)
+		}
 	}
 }
 
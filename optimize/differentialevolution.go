@@ -0,0 +1,357 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method        = (*DifferentialEvolution)(nil)
+	_ Statuser      = (*DifferentialEvolution)(nil)
+	_ boundedMethod = (*DifferentialEvolution)(nil)
+)
+
+// DifferentialEvolution implements the differential evolution algorithm for
+// global optimization of functions without using derivatives. The algorithm
+// is described in
+//
+//	Storn, Rainer, and Kenneth Price. "Differential evolution – a simple and
+//	efficient heuristic for global optimization over continuous spaces."
+//	Journal of Global Optimization 11.4 (1997): 341-359.
+//
+// Differential evolution evolves a population of locations. At each major
+// iteration a trial location is generated for every member of the population
+// by adding the scaled difference of two randomly chosen members to a third
+// (mutation), and mixing the coordinates of the result with those of the
+// member (crossover). The member is replaced by the trial location if the
+// function value at the trial location is not greater. The trial locations of
+// an iteration are independent, so up to Population function evaluations can
+// be performed concurrently.
+//
+// The initial location is a member of the initial population. The coordinates
+// of the other members are sampled uniformly between the bounds of the Problem
+// if both bounds are finite, and from a normal distribution with mean given by
+// the initial location and standard deviation InitStepSize otherwise. The
+// function is only evaluated at locations that satisfy the bounds; when a
+// coordinate of a trial location lies outside a bound, it is replaced by a
+// random value between the bound and the coordinate of the mutated member.
+//
+// Differential evolution does not guarantee convergence to the global
+// minimum, but a large population increases its robustness for functions
+// with many local minima at the cost of more function evaluations.
+type DifferentialEvolution struct {
+	// Population sets the population size for the algorithm. If Population
+	// is 0, a default value of max(10*dim, 4) is used. Population must
+	// otherwise be at least 4, or DifferentialEvolution will panic.
+	Population int
+	// Mutation sets the scale of the difference of the members added in
+	// the mutation. If Mutation is 0, a default value of 0.8 is used.
+	// Mutation must be between 0 and 2, or DifferentialEvolution will panic.
+	Mutation float64
+	// CrossProbability sets the probability that a coordinate of the trial
+	// location is taken from the mutated member rather than from the
+	// member being replaced. At least one coordinate is always taken
+	// from the mutated member. If CrossProbability is 0, a default value
+	// of 0.9 is used. CrossProbability must be between 0 and 1, or
+	// DifferentialEvolution will panic.
+	CrossProbability float64
+	// InitStepSize sets the standard deviation of the coordinates of the
+	// initial population that do not have finite bounds. If InitStepSize
+	// is 0, a default value of 1 is used. InitStepSize cannot be negative,
+	// or DifferentialEvolution will panic.
+	InitStepSize float64
+	// FunctionTolerance sets the threshold for stopping the optimization
+	// when the population has converged. If the difference between the
+	// largest and smallest function values in the population is less than
+	// FunctionTolerance, the optimization run is concluded. If
+	// FunctionTolerance is 0, a default value of 1e-12 is used. If
+	// FunctionTolerance is NaN, the stopping criterion is not used.
+	FunctionTolerance float64
+	// Src allows a random number generator to be supplied for generating
+	// the population. If Src is nil, a randomly seeded source is used.
+	Src rand.Source
+
+	// Fixed algorithm parameters.
+	dim      int
+	pop      int
+	mutation float64
+	cross    float64
+	step     float64
+	tol      float64
+	bounds   []Bound
+	rnd      *rand.Rand
+
+	// Population and the trial locations.
+	xs, trials *mat.Dense
+	fs, ft     []float64
+
+	// Synchronization.
+	sentIdx     int
+	receivedIdx int
+}
+
+// Status returns the status of the method.
+func (de *DifferentialEvolution) Status() (Status, error) {
+	if de.converged() {
+		return MethodConverge, nil
+	}
+	return NotTerminated, nil
+}
+
+func (*DifferentialEvolution) Uses(has Available) (uses Available, err error) {
+	return Available{Bounds: has.Bounds}, nil
+}
+
+func (de *DifferentialEvolution) setBounds(bounds []Bound) {
+	de.bounds = bounds
+}
+
+func (de *DifferentialEvolution) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+
+	de.dim = dim
+	de.pop = de.Population
+	switch {
+	case de.pop == 0:
+		de.pop = max(10*dim, 4)
+	case de.pop < 4:
+		panic("optimize: differential evolution population less than 4")
+	}
+	de.mutation = de.Mutation
+	switch {
+	case de.mutation == 0:
+		de.mutation = 0.8
+	case !(0 < de.mutation && de.mutation <= 2):
+		panic("optimize: differential evolution mutation out of range")
+	}
+	de.cross = de.CrossProbability
+	switch {
+	case de.cross == 0:
+		de.cross = 0.9
+	case !(0 < de.cross && de.cross <= 1):
+		panic("optimize: differential evolution cross probability out of range")
+	}
+	de.step = de.InitStepSize
+	switch {
+	case de.step == 0:
+		de.step = 1
+	case de.step < 0:
+		panic("optimize: differential evolution negative initial step size")
+	}
+	de.tol = de.FunctionTolerance
+	if de.tol == 0 {
+		de.tol = 1e-12
+	}
+	src := de.Src
+	if src == nil {
+		src = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	de.rnd = rand.New(src)
+
+	de.xs = mat.NewDense(de.pop, dim, nil)
+	de.trials = mat.NewDense(de.pop, dim, nil)
+	de.fs = resize(de.fs, de.pop)
+	de.ft = resize(de.ft, de.pop)
+
+	de.sentIdx = 0
+	de.receivedIdx = 0
+	return min(tasks, de.pop)
+}
+
+// initPopulation generates the initial population as the trial locations
+// of the first iteration, with x0 as the first member.
+func (de *DifferentialEvolution) initPopulation(x0 []float64) {
+	copy(de.trials.RawRowView(0), x0)
+	for i := 1; i < de.pop; i++ {
+		x := de.trials.RawRowView(i)
+		for j := range x {
+			if de.bounds != nil {
+				b := de.bounds[j]
+				if !math.IsInf(b.Min, 0) && !math.IsInf(b.Max, 0) {
+					x[j] = b.Min + de.rnd.Float64()*(b.Max-b.Min)
+					continue
+				}
+			}
+			x[j] = x0[j] + de.step*de.rnd.NormFloat64()
+		}
+		project(x, de.bounds)
+	}
+	for i := range de.fs {
+		de.fs[i] = math.NaN()
+		de.ft[i] = math.NaN()
+	}
+}
+
+// generateTrials generates the trial locations for the next iteration from
+// the population.
+func (de *DifferentialEvolution) generateTrials() {
+	for i := 0; i < de.pop; i++ {
+		// Choose three distinct members other than i.
+		r0 := de.choose(i, -1, -1)
+		r1 := de.choose(i, r0, -1)
+		r2 := de.choose(i, r0, r1)
+		x := de.xs.RawRowView(i)
+		base := de.xs.RawRowView(r0)
+		x1 := de.xs.RawRowView(r1)
+		x2 := de.xs.RawRowView(r2)
+		trial := de.trials.RawRowView(i)
+		jRand := de.rnd.IntN(de.dim)
+		for j := range trial {
+			if j != jRand && de.rnd.Float64() >= de.cross {
+				trial[j] = x[j]
+				continue
+			}
+			v := base[j] + de.mutation*(x1[j]-x2[j])
+			if de.bounds != nil {
+				b := de.bounds[j]
+				switch {
+				case v < b.Min:
+					v = b.Min + de.rnd.Float64()*(base[j]-b.Min)
+				case v > b.Max:
+					v = b.Max - de.rnd.Float64()*(b.Max-base[j])
+				}
+			}
+			trial[j] = v
+		}
+		de.ft[i] = math.NaN()
+	}
+}
+
+// choose returns a random member index that differs from a, b and c.
+func (de *DifferentialEvolution) choose(a, b, c int) int {
+	for {
+		r := de.rnd.IntN(de.pop)
+		if r != a && r != b && r != c {
+			return r
+		}
+	}
+}
+
+// selectMembers replaces the members of the population by the trial locations
+// that have function values that are not greater.
+func (de *DifferentialEvolution) selectMembers() {
+	for i, f := range de.ft {
+		if f <= de.fs[i] || (math.IsNaN(de.fs[i]) && !math.IsNaN(f)) {
+			de.fs[i] = f
+			copy(de.xs.RawRowView(i), de.trials.RawRowView(i))
+		}
+	}
+}
+
+// converged returns whether the spread of the function values in the
+// population is below the tolerance.
+func (de *DifferentialEvolution) converged() bool {
+	if math.IsNaN(de.tol) {
+		return false
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, f := range de.fs {
+		if math.IsNaN(f) {
+			return false
+		}
+		lo = math.Min(lo, f)
+		hi = math.Max(hi, f)
+	}
+	return hi-lo < de.tol
+}
+
+// sendTask sends the trial location idx for evaluation.
+func (de *DifferentialEvolution) sendTask(operation chan<- Task, idx int, task Task) {
+	task.ID = idx
+	task.Op = FuncEvaluation
+	copy(task.X, de.trials.RawRowView(idx))
+	operation <- task
+}
+
+func (de *DifferentialEvolution) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	de.initPopulation(tasks[0].X)
+	// Send the initial tasks. There are at most as many tasks as members
+	// of the population.
+	for i, task := range tasks {
+		de.sendTask(operation, i, task)
+	}
+	de.sentIdx = len(tasks)
+
+Loop:
+	for {
+		task := <-result
+		switch task.Op {
+		default:
+			panic("unknown operation")
+		case PostIteration:
+			break Loop
+		case MajorIteration:
+			// Start evaluating the trial locations
+			// of the next iteration.
+			for i, task := range tasks {
+				de.sendTask(operation, i, task)
+			}
+			de.sentIdx = len(tasks)
+		case FuncEvaluation:
+			de.receivedIdx++
+			de.ft[task.ID] = task.F
+			switch {
+			case de.sentIdx < de.pop:
+				de.sendTask(operation, de.sentIdx, task)
+				de.sentIdx++
+			case de.receivedIdx < de.pop:
+				// Wait for the remaining evaluations.
+				continue Loop
+			default:
+				// All the trial locations have been evaluated.
+				de.receivedIdx = 0
+				de.sentIdx = 0
+				de.selectMembers()
+				best := bestIdx(de.fs)
+				task.F = math.NaN()
+				if best != -1 {
+					task.F = de.fs[best]
+					copy(task.X, de.xs.RawRowView(best))
+				}
+				task.ID = -1
+				if de.converged() {
+					task.Op = MethodDone
+				} else {
+					de.generateTrials()
+					task.Op = MajorIteration
+				}
+				operation <- task
+			}
+		}
+	}
+
+	// PostIteration was sent. Collect the remaining evaluations, and send
+	// a final MajorIteration if a trial location is better than the best
+	// member of the population.
+	for task := range result {
+		switch task.Op {
+		default:
+			panic("unknown operation")
+		case MajorIteration:
+		case FuncEvaluation:
+			de.ft[task.ID] = task.F
+		}
+	}
+	trial := bestIdx(de.ft)
+	best := bestIdx(de.fs)
+	if trial != -1 && (best == -1 || de.ft[trial] < de.fs[best]) {
+		task := tasks[0]
+		task.F = de.ft[trial]
+		copy(task.X, de.trials.RawRowView(trial))
+		task.Op = MajorIteration
+		task.ID = -1
+		operation <- task
+	}
+	close(operation)
+}
@@ -0,0 +1,183 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+type deTestCase struct {
+	name     string
+	problem  Problem
+	method   *DifferentialEvolution
+	initX    []float64
+	settings *Settings
+	good     func(result *Result, err error, concurrent int) error
+}
+
+func deTestCases() []deTestCase {
+	rastriginBounds := make([]Bound, 5)
+	for i := range rastriginBounds {
+		rastriginBounds[i] = Bound{Min: -5.12, Max: 5.12}
+	}
+	return []deTestCase{
+		{
+			name: "Rastrigin",
+			problem: Problem{
+				Func:   functions.Rastrigin{}.Func,
+				Bounds: rastriginBounds,
+			},
+			initX:  []float64{3, 3, 3, 3, 3},
+			method: &DifferentialEvolution{},
+			settings: &Settings{
+				Converger: NeverTerminate{},
+			},
+			good: func(result *Result, err error, concurrent int) error {
+				if result.Status != MethodConverge {
+					return errors.New("result not method converge")
+				}
+				if !floats.EqualApprox(result.X, make([]float64, 5), 1e-6) {
+					return errors.New("global minimum not found")
+				}
+				return nil
+			},
+		},
+		{
+			name: "ExtendedRosenbrock",
+			problem: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+			},
+			initX:  []float64{-1.2, 1, -1.2, 1},
+			method: &DifferentialEvolution{},
+			settings: &Settings{
+				Converger: NeverTerminate{},
+			},
+			good: func(result *Result, err error, concurrent int) error {
+				if result.Status != MethodConverge {
+					return errors.New("result not method converge")
+				}
+				if result.F > 1e-10 {
+					return errors.New("minimum not found")
+				}
+				return nil
+			},
+		},
+		{
+			name: "MajorIterations",
+			problem: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+			},
+			initX:  []float64{-1.2, 1, -1.2},
+			method: &DifferentialEvolution{Population: 50},
+			settings: &Settings{
+				MajorIterations: 10,
+				Converger:       NeverTerminate{},
+			},
+			good: func(result *Result, err error, concurrent int) error {
+				if result.Status != IterationLimit {
+					return errors.New("result not iteration limit")
+				}
+				threshLower := 10
+				threshUpper := 10
+				if concurrent != 0 {
+					// Could have one more from final update.
+					threshUpper++
+				}
+				if result.MajorIterations < threshLower || result.MajorIterations > threshUpper {
+					return errors.New("wrong number of iterations")
+				}
+				return nil
+			},
+		},
+		{
+			name: "FuncEvaluations",
+			problem: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+			},
+			initX:  []float64{-1.2, 1, -1.2, 1, -1.2},
+			method: &DifferentialEvolution{Population: 100},
+			settings: &Settings{
+				FuncEvaluations: 250, // Somewhere in the middle of an iteration.
+				Converger:       NeverTerminate{},
+			},
+			good: func(result *Result, err error, concurrent int) error {
+				if result.Status != FunctionEvaluationLimit {
+					return errors.New("result not function evaluations")
+				}
+				threshLower := 250
+				threshUpper := 251
+				if concurrent != 0 {
+					threshUpper = threshLower + concurrent
+				}
+				if result.FuncEvaluations < threshLower {
+					return errors.New("too few function evaluations")
+				}
+				if result.FuncEvaluations > threshUpper {
+					return errors.New("too many function evaluations")
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func TestDifferentialEvolution(t *testing.T) {
+	t.Parallel()
+	for _, test := range deTestCases() {
+		method := test.method
+		method.Src = rand.NewPCG(1, 1)
+		problem := test.problem
+		var infeasible bool
+		if problem.Bounds != nil {
+			fn := problem.Func
+			problem.Func = func(x []float64) float64 {
+				if !feasible(x, test.problem.Bounds) {
+					infeasible = true
+				}
+				return fn(x)
+			}
+		}
+		for _, concurrent := range []int{0, 0, 5} {
+			// Run twice serially to make sure there are no residual
+			// effects, then in parallel.
+			settings := *test.settings
+			settings.Concurrent = concurrent
+			result, err := Minimize(problem, test.initX, &settings, method)
+			if testErr := test.good(result, err, concurrent); testErr != nil {
+				t.Errorf("%s (concurrent=%d): %v", test.name, concurrent, testErr)
+			}
+			if infeasible {
+				t.Errorf("%s (concurrent=%d): function evaluated outside the bounds", test.name, concurrent)
+			}
+		}
+	}
+}
+
+func TestDifferentialEvolutionPanics(t *testing.T) {
+	t.Parallel()
+	p := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+	x := []float64{0, 0}
+	for _, test := range []struct {
+		name   string
+		method *DifferentialEvolution
+	}{
+		{name: "small Population", method: &DifferentialEvolution{Population: 3}},
+		{name: "negative Mutation", method: &DifferentialEvolution{Mutation: -0.5}},
+		{name: "large Mutation", method: &DifferentialEvolution{Mutation: 2.5}},
+		{name: "large CrossProbability", method: &DifferentialEvolution{CrossProbability: 1.5}},
+		{name: "NaN CrossProbability", method: &DifferentialEvolution{CrossProbability: math.NaN()}},
+		{name: "negative InitStepSize", method: &DifferentialEvolution{InitStepSize: -1}},
+	} {
+		if !panics(func() { Minimize(p, x, nil, test.method) }) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
@@ -39,6 +39,10 @@ var (
 	// ErrMissingHess signifies that a Method requires a Hessian function that
 	// is not supplied by Problem.
 	ErrMissingHess = errors.New("optimize: problem does not provide needed Hess function")
+
+	// ErrUnsupportedBounds signifies that a Method does not support the
+	// bound constraints specified by Problem.
+	ErrUnsupportedBounds = errors.New("optimize: method does not support bound constraints")
 )
 
 // ErrFunc is returned when an initial function value is invalid. The error
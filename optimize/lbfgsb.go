@@ -0,0 +1,574 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// lbfgsbEps is the machine epsilon used to safeguard LBFGSB.
+const lbfgsbEps = 0x1p-52
+
+var (
+	_ Method        = (*LBFGSB)(nil)
+	_ localMethod   = (*LBFGSB)(nil)
+	_ boundedMethod = (*LBFGSB)(nil)
+)
+
+// LBFGSB implements the limited-memory BFGS method for gradient-based
+// minimization subject to bound constraints on the variables (L-BFGS-B). The
+// bounds are specified by the Bounds field of Problem, and LBFGSB only
+// evaluates the function at locations that satisfy them. LBFGSB may also be
+// used for unconstrained problems.
+//
+// At each iteration LBFGSB builds a quadratic model of the function using a
+// limited-memory BFGS approximation of the Hessian, stored compactly from the
+// last Store iterations. It finds the generalized Cauchy point, the first
+// local minimizer of the model along the projected steepest descent path,
+// then minimizes the model over the variables that are not at a bound at the
+// Cauchy point, and finally performs a line search towards the resulting
+// point, which is projected onto the bounds.
+//
+// References:
+//   - Byrd, R.H., Lu, P., Nocedal, J. and Zhu, C.: A limited memory algorithm
+//     for bound constrained optimization. SIAM Journal on Scientific Computing
+//     16(5) (1995), 1190-1208
+//   - Morales, J.L. and Nocedal, J.: Remark on "Algorithm 778: L-BFGS-B:
+//     Fortran subroutines for large-scale bound constrained optimization".
+//     ACM Transactions on Mathematical Software 38(1) (2011), 7:1-7:4
+type LBFGSB struct {
+	// Store is the size of the limited-memory storage.
+	// If Store is 0, it will be defaulted to 10.
+	Store int
+	// GradStopThreshold sets the threshold for stopping if the infinity norm
+	// of the projected gradient gets too small. If GradStopThreshold is 0 it
+	// is defaulted to 1e-12, and if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	bounds []Bound
+	ls     MoreThuente
+
+	dim    int
+	x      []float64 // Location at the last major iteration
+	grad   []float64 // Gradient at the last major iteration
+	dir    []float64 // Search direction from x
+	lastOp Operation // Operation returned from the previous call to iterateLocal
+
+	// History, ordered from the oldest to the newest.
+	s, y       [][]float64
+	sNew, yNew []float64 // Storage for the next update
+	theta      float64   // Scaling of the initial Hessian approximation
+	m          mat.Dense // Middle matrix of the compact representation
+
+	// Workspace.
+	xcp   []float64 // Generalized Cauchy point
+	d     []float64 // Projected steepest descent direction
+	t     []float64 // Breakpoints
+	order []int     // Order of the breakpoints
+	free  []int     // Variables not at a bound at the Cauchy point
+}
+
+func (l *LBFGSB) Status() (Status, error) {
+	return l.status, l.err
+}
+
+func (*LBFGSB) Uses(has Available) (uses Available, err error) {
+	if !has.Grad {
+		return Available{}, ErrMissingGrad
+	}
+	return Available{Grad: true, Bounds: has.Bounds}, nil
+}
+
+func (l *LBFGSB) setBounds(bounds []Bound) {
+	l.bounds = bounds
+}
+
+func (l *LBFGSB) Init(dim, tasks int) int {
+	l.status = NotTerminated
+	l.err = nil
+	return 1
+}
+
+func (l *LBFGSB) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	l.status, l.err = localOptimizer{bounds: l.bounds}.run(l, l.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+func (l *LBFGSB) initLocal(loc *Location) (Operation, error) {
+	if l.Store == 0 {
+		l.Store = 10
+	}
+	dim := len(loc.X)
+	l.dim = dim
+	l.x = resize(l.x, dim)
+	copy(l.x, loc.X)
+	l.grad = resize(l.grad, dim)
+	copy(l.grad, loc.Gradient)
+	l.dir = resize(l.dir, dim)
+	l.xcp = resize(l.xcp, dim)
+	l.d = resize(l.d, dim)
+	l.t = resize(l.t, dim)
+	l.resetHistory()
+
+	return l.initNextLinesearch(loc)
+}
+
+func (l *LBFGSB) iterateLocal(loc *Location) (Operation, error) {
+	if l.lastOp == MajorIteration {
+		l.updateHistory(loc)
+		return l.initNextLinesearch(loc)
+	}
+
+	op, step, err := l.ls.Iterate(loc.F, floats.Dot(loc.Gradient, l.dir))
+	if err == ErrLinesearcherBound {
+		// The full step to the minimizer of the model
+		// gives sufficient decrease.
+		op, err = MajorIteration, nil
+	}
+	if err != nil {
+		l.lastOp = NoOperation
+		return l.lastOp, err
+	}
+	if op == MajorIteration {
+		l.lastOp = op
+		return l.lastOp, nil
+	}
+	return l.evaluate(loc, step, op)
+}
+
+// initNextLinesearch computes the search direction from the location at the
+// last major iteration and initializes the line search along it.
+func (l *LBFGSB) initNextLinesearch(loc *Location) (Operation, error) {
+	l.direction()
+	projGrad := floats.Dot(l.grad, l.dir)
+	if !(projGrad < 0) && len(l.s) != 0 {
+		// The model is not useful, so discard
+		// the history and start again.
+		l.resetHistory()
+		l.direction()
+		projGrad = floats.Dot(l.grad, l.dir)
+	}
+	if !(projGrad < 0) {
+		l.lastOp = NoOperation
+		return l.lastOp, ErrNonDescentDirection
+	}
+
+	// The search direction leads to a point that satisfies the bounds,
+	// so steps up to one are feasible. Longer steps up to the bounds are
+	// allowed once the model has been updated or if there are no bounds,
+	// and steps with sufficient decrease at the maximum are accepted.
+	step := 1.0
+	maxStep := 1.0
+	if len(l.s) == 0 {
+		step = math.Min(1, 1/floats.Norm(l.dir, 2))
+	}
+	if len(l.s) != 0 || l.bounds == nil {
+		maxStep = l.maxStep()
+	}
+	l.ls = MoreThuente{
+		DecreaseFactor:  1e-3,
+		CurvatureFactor: 0.9,
+		MaximumStep:     maxStep,
+	}
+	op := l.ls.Init(loc.F, projGrad, step)
+	return l.evaluate(loc, step, op)
+}
+
+// maxStep returns the largest step along the search direction that satisfies
+// the bounds, limited to 1e10.
+func (l *LBFGSB) maxStep() float64 {
+	maxStep := 1e10
+	for i, b := range l.bounds {
+		d := l.dir[i]
+		switch {
+		case d > 0:
+			maxStep = math.Min(maxStep, (b.Max-l.x[i])/d)
+		case d < 0:
+			maxStep = math.Min(maxStep, (b.Min-l.x[i])/d)
+		}
+	}
+	// Steps to the minimizer of the model are
+	// feasible up to rounding.
+	return math.Max(maxStep, 1)
+}
+
+// evaluate stores the point at the given step along the search direction
+// into loc.X and returns the evaluation op.
+func (l *LBFGSB) evaluate(loc *Location, step float64, op Operation) (Operation, error) {
+	floats.AddScaledTo(loc.X, l.x, step, l.dir)
+	// Guard against rounding taking the point
+	// outside the bounds.
+	project(loc.X, l.bounds)
+	if floats.Equal(loc.X, l.x) {
+		l.lastOp = NoOperation
+		return l.lastOp, ErrNoProgress
+	}
+	l.lastOp = op
+	return l.lastOp, nil
+}
+
+func (l *LBFGSB) resetHistory() {
+	l.s = l.s[:0]
+	l.y = l.y[:0]
+	l.theta = 1
+}
+
+// updateHistory adds the step to loc and the change in gradient to the
+// history if they satisfy the curvature condition, and stores loc as the
+// location at the last major iteration.
+func (l *LBFGSB) updateHistory(loc *Location) {
+	l.sNew = resize(l.sNew, l.dim)
+	l.yNew = resize(l.yNew, l.dim)
+	floats.SubTo(l.sNew, loc.X, l.x)
+	floats.SubTo(l.yNew, loc.Gradient, l.grad)
+	// Decrease in the function predicted by the gradient.
+	decrease := -floats.Dot(l.grad, l.sNew)
+	copy(l.x, loc.X)
+	copy(l.grad, loc.Gradient)
+
+	sDotY := floats.Dot(l.sNew, l.yNew)
+	yDotY := floats.Dot(l.yNew, l.yNew)
+	if !(sDotY > lbfgsbEps*decrease) {
+		// Skip the update to keep the Hessian
+		// approximation positive definite.
+		return
+	}
+	l.theta = yDotY / sDotY
+
+	// Find storage for the next update, reusing
+	// the oldest vectors if the history is full.
+	k := len(l.s)
+	var s, y []float64
+	switch {
+	case k == l.Store:
+		s, y = l.s[0], l.y[0]
+		copy(l.s, l.s[1:])
+		copy(l.y, l.y[1:])
+		l.s, l.y = l.s[:k-1], l.y[:k-1]
+	case k < cap(l.s):
+		s, y = l.s[:k+1][k], l.y[:k+1][k]
+	}
+	l.s = append(l.s, l.sNew)
+	l.y = append(l.y, l.yNew)
+	l.sNew, l.yNew = s, y
+}
+
+// middle computes the middle matrix M of the compact representation of the
+// Hessian approximation
+//
+//	B = θI - W M Wᵀ,
+//
+// where W = [Y θS] holds the history, and returns whether the computation
+// was successful.
+func (l *LBFGSB) middle() bool {
+	k := len(l.s)
+	var kk mat.Dense
+	kk.ReuseAs(2*k, 2*k)
+	for i := 0; i < k; i++ {
+		kk.Set(i, i, -floats.Dot(l.s[i], l.y[i]))
+		for j := 0; j < i; j++ {
+			v := floats.Dot(l.s[i], l.y[j])
+			kk.Set(k+i, j, v)
+			kk.Set(j, k+i, v)
+		}
+		for j := 0; j <= i; j++ {
+			v := l.theta * floats.Dot(l.s[i], l.s[j])
+			kk.Set(k+i, k+j, v)
+			kk.Set(k+j, k+i, v)
+		}
+	}
+	l.m.Reset()
+	err := l.m.Inverse(&kk)
+	if _, ok := err.(mat.Condition); ok {
+		// The inverse is computed even if K is
+		// ill-conditioned.
+		return true
+	}
+	return err == nil
+}
+
+// wRow stores row i of W = [Y θS] into dst.
+func (l *LBFGSB) wRow(dst []float64, i int) {
+	k := len(l.s)
+	for j := 0; j < k; j++ {
+		dst[j] = l.y[j][i]
+		dst[k+j] = l.theta * l.s[j][i]
+	}
+}
+
+// mulM stores M*v into dst.
+func (l *LBFGSB) mulM(dst, v []float64) {
+	if len(v) == 0 {
+		return
+	}
+	dv := mat.NewVecDense(len(dst), dst)
+	dv.MulVec(&l.m, mat.NewVecDense(len(v), v))
+}
+
+// direction computes the search direction from the location at the last
+// major iteration to the minimizer of the quadratic model over the free
+// variables at the generalized Cauchy point, and stores it in l.dir.
+func (l *LBFGSB) direction() {
+	if len(l.s) != 0 && !l.middle() {
+		l.resetHistory()
+	}
+	c := l.cauchyPoint()
+	l.subspaceMin(c)
+	floats.SubTo(l.dir, l.xcp, l.x)
+}
+
+// cauchyPoint computes the generalized Cauchy point, the first local minimizer
+// of the quadratic model along the projected steepest descent path, stores it
+// in l.xcp and returns c = Wᵀ(xcp - x).
+func (l *LBFGSB) cauchyPoint() []float64 {
+	// See Algorithm CP in Byrd et al. (1995).
+	x, g := l.x, l.grad
+	k := len(l.s)
+	theta := l.theta
+
+	copy(l.xcp, x)
+	l.order = l.order[:0]
+	var f1 float64
+	for i, gi := range g {
+		ti := math.Inf(1)
+		if l.bounds != nil {
+			switch {
+			case gi < 0:
+				ti = (x[i] - l.bounds[i].Max) / gi
+			case gi > 0:
+				ti = (x[i] - l.bounds[i].Min) / gi
+			}
+		}
+		l.t[i] = ti
+		if ti == 0 {
+			l.d[i] = 0
+			continue
+		}
+		l.d[i] = -gi
+		f1 -= gi * gi
+		if !math.IsInf(ti, 1) {
+			l.order = append(l.order, i)
+		}
+	}
+	sort.Slice(l.order, func(a, b int) bool {
+		return l.t[l.order[a]] < l.t[l.order[b]]
+	})
+
+	c := make([]float64, 2*k)
+	p := make([]float64, 2*k)
+	wb := make([]float64, 2*k)
+	mv := make([]float64, 2*k)
+	for j := 0; j < k; j++ {
+		p[j] = floats.Dot(l.y[j], l.d)
+		p[k+j] = theta * floats.Dot(l.s[j], l.d)
+	}
+	l.mulM(mv, p)
+	f2 := -theta*f1 - floats.Dot(p, mv)
+	f2Org := f2
+	if f1 == 0 {
+		return c
+	}
+	dtMin := -f1 / f2
+
+	var tOld float64
+	for _, b := range l.order {
+		dt := l.t[b] - tOld
+		if dtMin < dt {
+			break
+		}
+		// Fix variable b at its bound.
+		if l.d[b] > 0 {
+			l.xcp[b] = l.bounds[b].Max
+		} else {
+			l.xcp[b] = l.bounds[b].Min
+		}
+		zb := l.xcp[b] - x[b]
+		gb := g[b]
+		floats.AddScaled(c, dt, p)
+		l.wRow(wb, b)
+		l.mulM(mv, wb)
+		f1 += dt*f2 + gb*gb + theta*gb*zb - gb*floats.Dot(mv, c)
+		f2 -= theta*gb*gb + 2*gb*floats.Dot(mv, p) + gb*gb*floats.Dot(mv, wb)
+		f2 = math.Max(lbfgsbEps*f2Org, f2)
+		floats.AddScaled(p, gb, wb)
+		l.d[b] = 0
+		tOld = l.t[b]
+		if f1 >= 0 {
+			dtMin = 0
+			break
+		}
+		dtMin = -f1 / f2
+	}
+
+	dtMin = math.Max(0, dtMin)
+	t := tOld + dtMin
+	for i, di := range l.d {
+		if di != 0 {
+			l.xcp[i] = x[i] + t*di
+		}
+	}
+	project(l.xcp, l.bounds)
+	floats.AddScaled(c, dtMin, p)
+	return c
+}
+
+// subspaceMin minimizes the quadratic model over the variables that are not
+// at a bound at the generalized Cauchy point, and stores the result in l.xcp.
+// c is Wᵀ(xcp - x).
+func (l *LBFGSB) subspaceMin(c []float64) {
+	x, g := l.x, l.grad
+	l.free = l.free[:0]
+	for i, v := range l.xcp {
+		if l.bounds == nil || (l.bounds[i].Min < v && v < l.bounds[i].Max) {
+			l.free = append(l.free, i)
+		}
+	}
+	if len(l.free) == 0 {
+		return
+	}
+
+	// Find the step dz from the Cauchy point to the minimizer of the
+	// model over the free variables. Over all the variables the minimizer
+	// does not depend on the Cauchy point, and the two-loop recursion
+	// finds it more accurately than the compact representation.
+	dz := make([]float64, len(l.free))
+	if len(l.free) == l.dim {
+		l.newtonStep(dz)
+		for i, v := range l.xcp {
+			dz[i] += x[i] - v
+		}
+	} else if !l.reducedStep(dz, c) {
+		// Use the Cauchy point if the
+		// system cannot be solved.
+		return
+	}
+
+	// Project the minimizer of the model onto the bounds. If this does not
+	// give a descent direction, backtrack from the minimizer towards the
+	// Cauchy point until it satisfies the bounds.
+	xbar := make([]float64, len(l.free))
+	var gd float64
+	for fi, i := range l.free {
+		v := l.xcp[i] + dz[fi]
+		if l.bounds != nil {
+			v = math.Max(l.bounds[i].Min, math.Min(v, l.bounds[i].Max))
+		}
+		xbar[fi] = v
+	}
+	for i := range x {
+		gd += g[i] * (l.xcp[i] - x[i])
+	}
+	for fi, i := range l.free {
+		gd += g[i] * (xbar[fi] - l.xcp[i])
+	}
+	if gd >= 0 && l.bounds != nil {
+		alpha := 1.0
+		for fi, i := range l.free {
+			switch {
+			case dz[fi] > 0:
+				alpha = math.Min(alpha, (l.bounds[i].Max-l.xcp[i])/dz[fi])
+			case dz[fi] < 0:
+				alpha = math.Min(alpha, (l.bounds[i].Min-l.xcp[i])/dz[fi])
+			}
+		}
+		for fi, i := range l.free {
+			xbar[fi] = l.xcp[i] + alpha*dz[fi]
+		}
+	}
+	for fi, i := range l.free {
+		l.xcp[i] = xbar[fi]
+	}
+	project(l.xcp, l.bounds)
+}
+
+// reducedStep stores into dz the step from the generalized Cauchy point to
+// the minimizer of the quadratic model over the free variables, and returns
+// whether the step could be computed. c is Wᵀ(xcp - x).
+func (l *LBFGSB) reducedStep(dz, c []float64) bool {
+	// See the direct primal method in Section 5.1 of Byrd et al. (1995).
+	x, g := l.x, l.grad
+	k := len(l.s)
+	theta := l.theta
+
+	// Compute the reduced gradient of the model at the Cauchy point,
+	//  r = Zᵀ(g + θ(xcp - x) - W M c).
+	mc := make([]float64, 2*k)
+	l.mulM(mc, c)
+	wi := make([]float64, 2*k)
+	r := make([]float64, len(l.free))
+	for fi, i := range l.free {
+		l.wRow(wi, i)
+		r[fi] = g[i] + theta*(l.xcp[i]-x[i]) - floats.Dot(wi, mc)
+	}
+
+	// Solve the reduced system
+	//  (θI - Zᵀ W M Wᵀ Z) dz = -r
+	// using the Sherman-Morrison-Woodbury formula.
+	for fi, v := range r {
+		dz[fi] = -v / theta
+	}
+	if k != 0 {
+		u := make([]float64, 2*k)
+		var a mat.Dense
+		a.ReuseAs(2*k, 2*k)
+		for fi, i := range l.free {
+			l.wRow(wi, i)
+			floats.AddScaled(u, r[fi], wi)
+			a.RankOne(&a, 1, mat.NewVecDense(2*k, wi), mat.NewVecDense(2*k, wi))
+		}
+		v := make([]float64, 2*k)
+		l.mulM(v, u)
+		var n mat.Dense
+		n.Mul(&l.m, &a)
+		n.Scale(-1/theta, &n)
+		for i := 0; i < 2*k; i++ {
+			n.Set(i, i, n.At(i, i)+1)
+		}
+		var sol mat.VecDense
+		if sol.SolveVec(&n, mat.NewVecDense(2*k, v)) != nil {
+			return false
+		}
+		for fi, i := range l.free {
+			l.wRow(wi, i)
+			dz[fi] -= mat.Dot(&sol, mat.NewVecDense(2*k, wi)) / (theta * theta)
+		}
+	}
+	return true
+}
+
+// newtonStep stores -H g into dst, where H is the inverse of the Hessian
+// approximation, using the two-loop recursion.
+func (l *LBFGSB) newtonStep(dst []float64) {
+	k := len(l.s)
+	alpha := make([]float64, k)
+	copy(dst, l.grad)
+	for i := k - 1; i >= 0; i-- {
+		alpha[i] = floats.Dot(l.s[i], dst) / floats.Dot(l.s[i], l.y[i])
+		floats.AddScaled(dst, -alpha[i], l.y[i])
+	}
+	floats.Scale(1/l.theta, dst)
+	for i, a := range alpha {
+		beta := floats.Dot(l.y[i], dst) / floats.Dot(l.s[i], l.y[i])
+		floats.AddScaled(dst, a-beta, l.s[i])
+	}
+	floats.Scale(-1, dst)
+}
+
+func (*LBFGSB) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
@@ -0,0 +1,262 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+type boundedTest struct {
+	name   string
+	p      Problem
+	x      []float64
+	bounds []Bound
+	// want is the solution of the problem, or nil
+	// if only the optimality conditions are checked.
+	want []float64
+}
+
+func boundedTests() []boundedTest {
+	inf := math.Inf(1)
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	// Non-negative least squares
+	//  min_x ‖A x - b‖² subject to x >= 0.
+	const m, n = 20, 8
+	a := mat.NewDense(m, n, nil)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	b := make([]float64, m)
+	for i := range b {
+		b[i] = rnd.NormFloat64()
+	}
+	nonNeg := make([]Bound, n)
+	for i := range nonNeg {
+		nonNeg[i] = Bound{Min: 0, Max: inf}
+	}
+	leastSquares := Problem{
+		Func: func(x []float64) float64 {
+			r := make([]float64, m)
+			mat.NewVecDense(m, r).MulVec(a, mat.NewVecDense(n, x))
+			floats.Sub(r, b)
+			return floats.Dot(r, r)
+		},
+		Grad: func(grad, x []float64) {
+			r := make([]float64, m)
+			mat.NewVecDense(m, r).MulVec(a, mat.NewVecDense(n, x))
+			floats.Sub(r, b)
+			mat.NewVecDense(n, grad).MulVec(a.T(), mat.NewVecDense(m, r))
+			floats.Scale(2, grad)
+		},
+	}
+
+	// Shifted quadratic Σ (x_i - c_i)², whose solution
+	// is the projection of c onto the bounds.
+	c := []float64{-1, 2, 0.5, 3, -4}
+	quadratic := Problem{
+		Func: func(x []float64) float64 {
+			var f float64
+			for i, v := range x {
+				f += (v - c[i]) * (v - c[i])
+			}
+			return f
+		},
+		Grad: func(grad, x []float64) {
+			for i, v := range x {
+				grad[i] = 2 * (v - c[i])
+			}
+		},
+	}
+
+	rosen := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	return []boundedTest{
+		{
+			name:   "Quadratic",
+			p:      quadratic,
+			x:      []float64{0.5, 0.5, 0.5, 0.5, 0.5},
+			bounds: []Bound{{0, 1}, {0, 1}, {0, 1}, {-inf, 1}, {-2, inf}},
+			want:   []float64{0, 1, 0.5, 1, -2},
+		},
+		{
+			name:   "QuadraticInfeasibleStart",
+			p:      quadratic,
+			x:      []float64{10, -10, 10, -10, 10},
+			bounds: []Bound{{0, 1}, {0, 1}, {0, 1}, {-inf, 1}, {-2, inf}},
+			want:   []float64{0, 1, 0.5, 1, -2},
+		},
+		{
+			name:   "QuadraticFixed",
+			p:      quadratic,
+			x:      []float64{0, 0, 0, 0, 0},
+			bounds: []Bound{{0, 0}, {-inf, inf}, {3, 3}, {-inf, inf}, {-inf, inf}},
+			want:   []float64{0, 2, 3, 3, -4},
+		},
+		{
+			name:   "QuadraticInactive",
+			p:      quadratic,
+			x:      []float64{0, 0, 0, 0, 0},
+			bounds: []Bound{{-10, 10}, {-10, 10}, {-10, 10}, {-10, 10}, {-10, 10}},
+			want:   c,
+		},
+		{
+			name:   "Rosenbrock",
+			p:      rosen,
+			x:      []float64{-1.2, 1},
+			bounds: []Bound{{-1.5, 0.5}, {-1.5, 2}},
+			want:   []float64{0.5, 0.25},
+		},
+		{
+			name:   "RosenbrockUnbounded",
+			p:      rosen,
+			x:      []float64{-1.2, 1},
+			bounds: []Bound{{-inf, inf}, {-inf, inf}},
+			want:   []float64{1, 1},
+		},
+		{
+			name: "ExtendedRosenbrock",
+			p:    rosen,
+			x:    []float64{-1.2, 1, -1.2, 1, -1.2, 1, -1.2, 1},
+			bounds: []Bound{
+				{-2, 0.8}, {-2, 2}, {-2, 2}, {-2, 2},
+				{1.2, 2}, {-2, 2}, {-2, 2}, {-2, 0.5},
+			},
+		},
+		{
+			name:   "NonNegativeLeastSquares",
+			p:      leastSquares,
+			x:      make([]float64, n),
+			bounds: nonNeg,
+		},
+	}
+}
+
+func TestLBFGSB(t *testing.T) {
+	t.Parallel()
+	for _, test := range boundedTests() {
+		p := test.p
+		p.Bounds = test.bounds
+		var infeasible bool
+		fn := p.Func
+		p.Func = func(x []float64) float64 {
+			if !feasible(x, test.bounds) {
+				infeasible = true
+			}
+			return fn(x)
+		}
+
+		x := make([]float64, len(test.x))
+		copy(x, test.x)
+		settings := &Settings{
+			GradientThreshold: 1e-8,
+			Converger:         NeverTerminate{},
+		}
+		result, err := Minimize(p, x, settings, &LBFGSB{})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !floats.Equal(x, test.x) {
+			t.Errorf("%s: initial location modified", test.name)
+		}
+		if result.Status != GradientThreshold {
+			t.Errorf("%s: unexpected status: got:%v want:%v", test.name, result.Status, GradientThreshold)
+		}
+		if infeasible {
+			t.Errorf("%s: function evaluated outside the bounds", test.name)
+		}
+		if !feasible(result.X, test.bounds) {
+			t.Errorf("%s: solution does not satisfy the bounds: %v", test.name, result.X)
+		}
+
+		// Check the first-order optimality conditions, that the
+		// gradient is zero for the variables not at a bound and
+		// points into the bounds for the others.
+		grad := make([]float64, len(x))
+		test.p.Grad(grad, result.X)
+		if !floats.Equal(grad, result.Gradient) {
+			t.Errorf("%s: gradient at the solution not equal to the returned value", test.name)
+		}
+		if norm := projectedGradientNorm(result.X, grad, test.bounds); norm >= settings.GradientThreshold {
+			t.Errorf("%s: projected gradient norm %v not smaller than tolerance %v", test.name, norm, settings.GradientThreshold)
+		}
+		if test.want != nil && !floats.EqualApprox(result.X, test.want, 1e-6) {
+			t.Errorf("%s: unexpected solution: got:%v want:%v", test.name, result.X, test.want)
+		}
+	}
+}
+
+func TestLBFGSBDefault(t *testing.T) {
+	t.Parallel()
+	p := Problem{
+		Func:   functions.ExtendedRosenbrock{}.Func,
+		Grad:   functions.ExtendedRosenbrock{}.Grad,
+		Bounds: []Bound{{-1.5, 0.5}, {-1.5, 2}},
+	}
+	result, err := Minimize(p, []float64{-1.2, 1}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []float64{0.5, 0.25}
+	if !floats.EqualApprox(result.X, want, 1e-6) {
+		t.Errorf("unexpected solution: got:%v want:%v", result.X, want)
+	}
+}
+
+func TestBoundsPanics(t *testing.T) {
+	t.Parallel()
+	p := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	x := []float64{0.5, 0.5}
+	for _, test := range []struct {
+		name     string
+		bounds   []Bound
+		settings *Settings
+		method   Method
+	}{
+		{name: "length mismatch", bounds: []Bound{{0, 1}}, method: &LBFGSB{}},
+		{name: "min greater than max", bounds: []Bound{{0, 1}, {1, 0}}, method: &LBFGSB{}},
+		{name: "NaN bound", bounds: []Bound{{0, 1}, {math.NaN(), 1}}, method: &LBFGSB{}},
+		{name: "infinite bound", bounds: []Bound{{0, 1}, {math.Inf(1), math.Inf(1)}}, method: &LBFGSB{}},
+		{name: "unsupported LBFGS", bounds: []Bound{{0, 1}, {0, 1}}, method: &LBFGS{}},
+		{name: "unsupported NelderMead", bounds: []Bound{{0, 1}, {0, 1}}, method: &NelderMead{}},
+		{
+			name:   "infeasible InitValues",
+			bounds: []Bound{{0, 1}, {0, 0.25}},
+			settings: &Settings{
+				InitValues: &Location{F: 0.5, Gradient: []float64{1, 1}},
+			},
+			method: &LBFGSB{},
+		},
+	} {
+		p := p
+		p.Bounds = test.bounds
+		if !panics(func() { Minimize(p, x, test.settings, test.method) }) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
@@ -4,14 +4,15 @@
 
 package optimize
 
-import (
-	"math"
-
-	"gonum.org/v1/gonum/floats"
-)
+import "math"
 
 // localOptimizer is a helper type for running an optimization using a LocalMethod.
-type localOptimizer struct{}
+type localOptimizer struct {
+	// bounds are the bound constraints of the problem. If bounds
+	// is not nil, the projected gradient is used to check gradient
+	// convergence.
+	bounds []Bound
+}
 
 // run controls the optimization run for a localMethod. The calling method
 // must close the operation channel at the conclusion of the optimization. This
@@ -55,7 +56,7 @@ Loop:
 		case MajorIteration:
 			// The last operation was a MajorIteration. Check if the gradient
 			// is below the threshold.
-			if status := l.checkGradientConvergence(r.Gradient, gradThresh); status != NotTerminated {
+			if status := l.checkGradientConvergence(r.Location, gradThresh); status != NotTerminated {
 				l.finishMethodDone(operation, result, task)
 				return GradientThreshold, nil
 			}
@@ -109,18 +110,18 @@ func (l localOptimizer) checkStartingLocation(task Task, gradThresh float64) (St
 			return Failure, ErrGrad{Grad: v, Index: i}
 		}
 	}
-	status := l.checkGradientConvergence(task.Gradient, gradThresh)
+	status := l.checkGradientConvergence(task.Location, gradThresh)
 	return status, nil
 }
 
-func (localOptimizer) checkGradientConvergence(gradient []float64, gradThresh float64) Status {
-	if gradient == nil || math.IsNaN(gradThresh) {
+func (l localOptimizer) checkGradientConvergence(loc *Location, gradThresh float64) Status {
+	if loc.Gradient == nil || math.IsNaN(gradThresh) {
 		return NotTerminated
 	}
 	if gradThresh == 0 {
 		gradThresh = defaultGradientAbsTol
 	}
-	if norm := floats.Norm(gradient, math.Inf(1)); norm < gradThresh {
+	if norm := projectedGradientNorm(loc.X, loc.Gradient, l.bounds); norm < gradThresh {
 		return GradientThreshold
 	}
 	return NotTerminated
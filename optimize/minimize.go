@@ -9,7 +9,6 @@ import (
 	"math"
 	"time"
 
-	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/mat"
 )
 
@@ -126,7 +125,9 @@ type Method interface {
 //
 // The second argument specifies the initial location for the optimization.
 // Some Methods do not require an initial location, but initX must still be
-// specified for the dimension of the optimization problem.
+// specified for the dimension of the optimization problem. If p.Bounds is not
+// nil, an initial location that does not satisfy the bounds is projected onto
+// them, unless settings.InitValues is not nil in which case Minimize panics.
 //
 // The third argument contains the settings for the minimization. If settings
 // is nil, the zero value will be used, see the documentation of the Settings
@@ -169,6 +170,12 @@ func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*R
 	optLoc.F = math.Inf(1)
 
 	initOp, initLoc := getInitLocation(dim, initX, settings.InitValues)
+	if !feasible(initLoc.X, p.Bounds) {
+		if initOp != NoOperation {
+			panic("optimize: initial location with InitValues does not satisfy bounds")
+		}
+		project(initLoc.X, p.Bounds)
+	}
 
 	converger := settings.Converger
 	if converger == nil {
@@ -203,6 +210,9 @@ func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*R
 }
 
 func getDefaultMethod(p *Problem) Method {
+	if p.Bounds != nil && p.Grad != nil {
+		return &LBFGSB{}
+	}
 	if p.Grad != nil {
 		return &LBFGS{}
 	}
@@ -222,6 +232,11 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 	if initErr != nil {
 		panic(fmt.Sprintf("optimize: specified method inconsistent with Problem: %v", initErr))
 	}
+	if bm, ok := method.(boundedMethod); ok {
+		bm.setBounds(prob.Bounds)
+	} else if has.Bounds {
+		panic(fmt.Sprintf("optimize: specified method inconsistent with Problem: %v", ErrUnsupportedBounds))
+	}
 	newNTasks := method.Init(dim, nTasks)
 	if newNTasks > nTasks {
 		panic("optimize: too many tasks returned by Method")
@@ -349,7 +364,7 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 		case NoOperation:
 			// Just send the task back.
 		case MajorIteration:
-			status = performMajorIteration(optLoc, task.Location, stats, converger, startTime, settings)
+			status = performMajorIteration(optLoc, task.Location, stats, converger, startTime, settings, prob.Bounds)
 		case MethodDone:
 			methodDone = true
 			status = MethodConverge
@@ -456,6 +471,7 @@ func checkOptimization(p Problem, dim int, recorder Recorder) error {
 	if dim <= 0 {
 		panic("optimize: impossible problem dimension")
 	}
+	checkBounds(p.Bounds, dim)
 	if p.Status != nil {
 		_, err := p.Status()
 		if err != nil {
@@ -525,12 +541,12 @@ func updateEvaluationStats(stats *Stats, op Operation) {
 // the convergence criteria given by settings. Otherwise a corresponding status is
 // returned.
 // Unlike checkLimits, checkConvergence is called only at MajorIterations.
-func checkLocationConvergence(loc *Location, settings *Settings, converger Converger) Status {
+func checkLocationConvergence(loc *Location, settings *Settings, converger Converger, bounds []Bound) Status {
 	if math.IsInf(loc.F, -1) {
 		return FunctionNegativeInfinity
 	}
 	if loc.Gradient != nil && settings.GradientThreshold > 0 {
-		norm := floats.Norm(loc.Gradient, math.Inf(1))
+		norm := projectedGradientNorm(loc.X, loc.Gradient, bounds)
 		if norm < settings.GradientThreshold {
 			return GradientThreshold
 		}
@@ -574,7 +590,7 @@ func checkIterationLimits(loc *Location, stats *Stats, settings *Settings) Statu
 // performMajorIteration does all of the steps needed to perform a MajorIteration.
 // It increments the iteration count, updates the optimal location, and checks
 // the necessary convergence criteria.
-func performMajorIteration(optLoc, loc *Location, stats *Stats, converger Converger, startTime time.Time, settings *Settings) Status {
+func performMajorIteration(optLoc, loc *Location, stats *Stats, converger Converger, startTime time.Time, settings *Settings, bounds []Bound) Status {
 	optLoc.F = loc.F
 	copy(optLoc.X, loc.X)
 	if loc.Gradient == nil {
@@ -587,7 +603,7 @@ func performMajorIteration(optLoc, loc *Location, stats *Stats, converger Conver
 	}
 	stats.MajorIterations++
 	stats.Runtime = time.Since(startTime)
-	status := checkLocationConvergence(optLoc, settings, converger)
+	status := checkLocationConvergence(optLoc, settings, converger, bounds)
 	if status != NotTerminated {
 		return status
 	}
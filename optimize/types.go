@@ -136,31 +136,44 @@ type Problem struct {
 	// not able to evaluate itself. The user can use one of the pre-provided Status
 	// constants, or may call NewStatus to create a custom Status value.
 	Status func() (Status, error)
+
+	// Bounds specifies bound constraints on the location, so that
+	// Bounds[i].Min <= x[i] <= Bounds[i].Max for each variable. If Bounds is
+	// nil, the problem is unconstrained, otherwise its length must equal the
+	// dimension of the problem. Func, Grad and Hess are only evaluated at
+	// locations that satisfy the bounds. Only Methods that support bound
+	// constraints, such as LBFGSB, may be used to minimize a bounded problem.
+	Bounds []Bound
 }
 
 // Available describes the functions available to call in Problem.
 type Available struct {
 	Grad bool
 	Hess bool
+
+	// Bounds indicates that the Problem has bound constraints.
+	Bounds bool
 }
 
 func availFromProblem(prob Problem) Available {
-	return Available{Grad: prob.Grad != nil, Hess: prob.Hess != nil}
+	return Available{Grad: prob.Grad != nil, Hess: prob.Hess != nil, Bounds: prob.Bounds != nil}
 }
 
 // function tests if the Problem described by the receiver is suitable for an
 // unconstrained Method that only calls the function, and returns the result.
 func (has Available) function() (uses Available, err error) {
-	// TODO(btracey): This needs to be modified when optimize supports
-	// constrained optimization.
+	if has.Bounds {
+		return Available{}, ErrUnsupportedBounds
+	}
 	return Available{}, nil
 }
 
 // gradient tests if the Problem described by the receiver is suitable for an
 // unconstrained gradient-based Method, and returns the result.
 func (has Available) gradient() (uses Available, err error) {
-	// TODO(btracey): This needs to be modified when optimize supports
-	// constrained optimization.
+	if has.Bounds {
+		return Available{}, ErrUnsupportedBounds
+	}
 	if !has.Grad {
 		return Available{}, ErrMissingGrad
 	}
@@ -170,8 +183,9 @@ func (has Available) gradient() (uses Available, err error) {
 // hessian tests if the Problem described by the receiver is suitable for an
 // unconstrained Hessian-based Method, and returns the result.
 func (has Available) hessian() (uses Available, err error) {
-	// TODO(btracey): This needs to be modified when optimize supports
-	// constrained optimization.
+	if has.Bounds {
+		return Available{}, ErrUnsupportedBounds
+	}
 	if !has.Grad {
 		return Available{}, ErrMissingGrad
 	}
@@ -199,6 +213,9 @@ type Settings struct {
 	// that many Methods (LBFGS, CG, etc.) will converge with a small value of
 	// the gradient, and so to fully disable this setting the Method may need to
 	// be modified.
+	// For problems with bound constraints, the infinity norm of the projected
+	// gradient P(x - ∇f(x)) - x is used instead, where P is the projection
+	// onto the bounds.
 	// This setting has no effect if the gradient is not used by the Method.
 	GradientThreshold float64
 
@@ -1171,6 +1171,15 @@ func TestLBFGS(t *testing.T) {
 	testLocal(t, tests, &LBFGS{})
 }
 
+func TestLBFGSBUnconstrained(t *testing.T) {
+	t.Parallel()
+	var tests []unconstrainedTest
+	tests = append(tests, gradientDescentTests...)
+	tests = append(tests, cgTests...)
+	tests = append(tests, lbfgsTests...)
+	testLocal(t, tests, &LBFGSB{})
+}
+
 func TestNewton(t *testing.T) {
 	t.Parallel()
 	testLocal(t, newtonTests, &Newton{})
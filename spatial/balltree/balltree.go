@@ -0,0 +1,333 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package balltree
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"sort"
+)
+
+// Comparable is the element interface for values stored in a ball tree.
+type Comparable interface {
+	// Distance returns the distance between the receiver and the
+	// parameter. The returned distance must satisfy the properties
+	// of distances in a metric space.
+	//
+	// - a.Distance(a) == 0
+	// - a.Distance(b) >= 0
+	// - a.Distance(b) == b.Distance(a)
+	// - a.Distance(b) <= a.Distance(c)+c.Distance(b)
+	//
+	Distance(Comparable) float64
+}
+
+// Point represents a point in a Euclidean k-d space that satisfies the Comparable
+// interface.
+type Point []float64
+
+// Distance returns the Euclidean distance between c and the receiver. The concrete
+// type of c must be Point.
+func (p Point) Distance(c Comparable) float64 {
+	q := c.(Point)
+	var sum float64
+	for dim, c := range p {
+		d := c - q[dim]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// Node holds a ball of points in a ball tree. Every point in the subtree
+// rooted at the node is within Radius of Center. Leaf nodes hold their
+// points in Points and have nil children.
+type Node struct {
+	Center      Comparable
+	Radius      float64
+	Left, Right *Node
+	Points      []Comparable
+}
+
+// Tree implements a ball tree creation and nearest neighbor search.
+type Tree struct {
+	Root  *Node
+	Count int
+}
+
+// New returns a ball tree constructed from the values in p. Leaf nodes of the
+// tree hold at most leafSize values; if leafSize is less than one, a leaf size
+// of one is used. The order of elements in p will be altered after New returns.
+// Points in p must not be infinitely distant.
+//
+// Each node is split by the distances of its points to two distant pivot
+// points, placing the half of the points relatively closer to the first pivot
+// in the left child. The center of each node is the point of the node whose
+// greater distance to the pivots is least.
+func New(p []Comparable, leafSize int) (t *Tree, err error) {
+	defer func() {
+		switch r := recover(); r {
+		case nil:
+		case pointAtInfinity:
+			t = nil
+			err = pointAtInfinity
+		default:
+			panic(r)
+		}
+	}()
+
+	b := builder{leafSize: max(leafSize, 1), da: make([]float64, len(p)), db: make([]float64, len(p))}
+	t = &Tree{
+		Root:  b.build(p),
+		Count: len(p),
+	}
+	return t, nil
+}
+
+var pointAtInfinity = errors.New("balltree: point at infinity")
+
+type builder struct {
+	leafSize int
+	da, db   []float64
+}
+
+func (b *builder) build(s []Comparable) *Node {
+	if len(s) == 0 {
+		return nil
+	}
+
+	// Choose two distant pivots by a pair of
+	// farthest point scans.
+	da, db := b.da[:len(s)], b.db[:len(s)]
+	b.distances(da, s[0], s)
+	pa := s[argmax(da)]
+	b.distances(da, pa, s)
+	pb := s[argmax(da)]
+	b.distances(db, pb, s)
+
+	center := 0
+	for i := range s {
+		if math.Max(da[i], db[i]) < math.Max(da[center], db[center]) {
+			center = i
+		}
+	}
+	n := &Node{Center: s[center]}
+	for _, p := range s {
+		n.Radius = math.Max(n.Radius, n.Center.Distance(p))
+	}
+	if len(s) <= b.leafSize || n.Radius == 0 {
+		n.Points = s
+		return n
+	}
+
+	// Split at the median of the relative
+	// closeness to the two pivots.
+	for i := range da {
+		da[i] -= db[i]
+	}
+	sort.Sort(byDist{dists: da, points: s})
+	mid := len(s) / 2
+	n.Left = b.build(s[:mid])
+	n.Right = b.build(s[mid:])
+	return n
+}
+
+// distances fills dst with the distances from v to each element of s.
+func (b *builder) distances(dst []float64, v Comparable, s []Comparable) {
+	for i, p := range s {
+		d := v.Distance(p)
+		if math.IsInf(d, 0) {
+			panic(pointAtInfinity)
+		}
+		dst[i] = d
+	}
+}
+
+func argmax(s []float64) int {
+	var idx int
+	for i, v := range s {
+		if v > s[idx] {
+			idx = i
+		}
+	}
+	return idx
+}
+
+type byDist struct {
+	dists  []float64
+	points []Comparable
+}
+
+func (c byDist) Len() int           { return len(c.dists) }
+func (c byDist) Less(i, j int) bool { return c.dists[i] < c.dists[j] }
+func (c byDist) Swap(i, j int) {
+	c.dists[i], c.dists[j] = c.dists[j], c.dists[i]
+	c.points[i], c.points[j] = c.points[j], c.points[i]
+}
+
+// Len returns the number of elements in the tree.
+func (t *Tree) Len() int { return t.Count }
+
+var inf = math.Inf(1)
+
+// Nearest returns the nearest value to the query and the distance between them.
+func (t *Tree) Nearest(q Comparable) (Comparable, float64) {
+	if t.Root == nil {
+		return nil, inf
+	}
+	k := NewNKeeper(1)
+	t.NearestSet(k, q)
+	if k.Len() == 0 {
+		return nil, inf
+	}
+	return k.Heap[0].Comparable, k.Heap[0].Dist
+}
+
+// ComparableDist holds a Comparable and a distance to a specific query. A nil Comparable
+// is used to mark the end of the heap, so clients should not store nil values except for
+// this purpose.
+type ComparableDist struct {
+	Comparable Comparable
+	Dist       float64
+}
+
+// Heap is a max heap sorted on Dist.
+type Heap []ComparableDist
+
+func (h *Heap) Max() ComparableDist  { return (*h)[0] }
+func (h *Heap) Len() int             { return len(*h) }
+func (h *Heap) Less(i, j int) bool   { return (*h)[i].Comparable == nil || (*h)[i].Dist > (*h)[j].Dist }
+func (h *Heap) Swap(i, j int)        { (*h)[i], (*h)[j] = (*h)[j], (*h)[i] }
+func (h *Heap) Push(x interface{})   { (*h) = append(*h, x.(ComparableDist)) }
+func (h *Heap) Pop() (i interface{}) { i, *h = (*h)[len(*h)-1], (*h)[:len(*h)-1]; return i }
+
+// NKeeper is a Keeper that retains the n best ComparableDists that have been passed to Keep.
+type NKeeper struct {
+	Heap
+}
+
+// NewNKeeper returns an NKeeper with the max value of the heap set to infinite distance. The
+// returned NKeeper is able to retain at most n values.
+func NewNKeeper(n int) *NKeeper {
+	k := NKeeper{make(Heap, 1, n)}
+	k.Heap[0].Dist = inf
+	return &k
+}
+
+// Keep adds c to the heap if its distance is less than the maximum value of the heap. If adding
+// c would increase the size of the heap beyond the initial maximum length, the maximum value of
+// the heap is dropped.
+func (k *NKeeper) Keep(c ComparableDist) {
+	if c.Dist <= k.Heap[0].Dist { // Favour later finds to displace sentinel.
+		if len(k.Heap) == cap(k.Heap) {
+			k.Heap[0] = c
+			heap.Fix(k, 0)
+		} else {
+			heap.Push(k, c)
+		}
+	}
+}
+
+// DistKeeper is a Keeper that retains the ComparableDists within the specified distance of the
+// query that it is called to Keep.
+type DistKeeper struct {
+	Heap
+}
+
+// NewDistKeeper returns an DistKeeper with the maximum value of the heap set to d.
+func NewDistKeeper(d float64) *DistKeeper { return &DistKeeper{Heap{{Dist: d}}} }
+
+// Keep adds c to the heap if its distance is less than or equal to the max value of the heap.
+func (k *DistKeeper) Keep(c ComparableDist) {
+	if c.Dist <= k.Heap[0].Dist {
+		heap.Push(k, c)
+	}
+}
+
+// Keeper implements a conditional max heap sorted on the Dist field of the ComparableDist type.
+// Ball tree search is guided by the distance stored in the max value of the heap.
+type Keeper interface {
+	Keep(ComparableDist) // Keep conditionally pushes the provided ComparableDist onto the heap.
+	Max() ComparableDist // Max returns the maximum element of the Keeper.
+	heap.Interface
+}
+
+// NearestSet finds the nearest values to the query accepted by the provided Keeper, k.
+// k must be able to return a ComparableDist specifying the maximum acceptable distance
+// when Max() is called, and retains the results of the search in min sorted order after
+// the call to NearestSet returns.
+// If a sentinel ComparableDist with a nil Comparable is used by the Keeper to mark the
+// maximum distance, NearestSet will remove it before returning.
+func (t *Tree) NearestSet(k Keeper, q Comparable) {
+	if t.Root != nil {
+		t.Root.searchSet(q, q.Distance(t.Root.Center), k)
+	}
+
+	// Check whether we have retained a sentinel
+	// and flag removal if we have.
+	removeSentinel := k.Len() != 0 && k.Max().Comparable == nil
+
+	sort.Sort(sort.Reverse(k))
+
+	// This abuses the interface to drop the max.
+	// It is reasonable to do this because we know
+	// that the maximum value will now be at element
+	// zero, which is removed by the Pop method.
+	if removeSentinel {
+		k.Pop()
+	}
+}
+
+// searchSet searches the subtree rooted at n, whose center is at distance d
+// from the query.
+func (n *Node) searchSet(q Comparable, d float64, k Keeper) {
+	if d-n.Radius > k.Max().Dist {
+		return
+	}
+	if n.Points != nil {
+		for _, p := range n.Points {
+			k.Keep(ComparableDist{Comparable: p, Dist: q.Distance(p)})
+		}
+		return
+	}
+
+	// Search the nearer child first.
+	dl := q.Distance(n.Left.Center)
+	dr := q.Distance(n.Right.Center)
+	if dl <= dr {
+		n.Left.searchSet(q, dl, k)
+		n.Right.searchSet(q, dr, k)
+		return
+	}
+	n.Right.searchSet(q, dr, k)
+	n.Left.searchSet(q, dl, k)
+}
+
+// Operation is a function that operates on a Comparable. The tree depth of the
+// point is also provided. If done is returned true, the Operation is indicating
+// that no further work needs to be done and so the Do function should traverse
+// no further.
+type Operation func(Comparable, int) (done bool)
+
+// Do performs fn on all values stored in the tree. A boolean is returned indicating whether the
+// Do traversal was interrupted by an Operation returning true.
+func (t *Tree) Do(fn Operation) bool {
+	if t.Root == nil {
+		return false
+	}
+	return t.Root.do(fn, 0)
+}
+
+func (n *Node) do(fn Operation, depth int) (done bool) {
+	if n.Points != nil {
+		for _, p := range n.Points {
+			if fn(p, depth) {
+				return true
+			}
+		}
+		return false
+	}
+	return n.Left.do(fn, depth+1) || n.Right.do(fn, depth+1)
+}
@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package balltree_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/spatial/balltree"
+)
+
+func Example() {
+	// Example data from https://en.wikipedia.org/wiki/K-d_tree
+	points := []balltree.Comparable{
+		balltree.Point{2, 3},
+		balltree.Point{5, 4},
+		balltree.Point{9, 6},
+		balltree.Point{4, 7},
+		balltree.Point{8, 1},
+		balltree.Point{7, 2},
+	}
+	t, err := balltree.New(points, 2)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	q := balltree.Point{8, 7}
+	p, d := t.Nearest(q)
+	fmt.Printf("%v is closest point to %v, d=%f\n", p, q, d)
+
+	// Find the two nearest points to each of
+	// a batch of queries in parallel.
+	queries := []balltree.Comparable{q, balltree.Point{3, 3}}
+	for i, nearest := range t.NearestBatch(queries, 2, 0) {
+		fmt.Printf("%v:", queries[i])
+		for _, n := range nearest {
+			fmt.Printf(" %v", n.Comparable)
+		}
+		fmt.Println()
+	}
+
+	// Output:
+	// [9 6] is closest point to [8 7], d=1.414214
+	// [8 7]: [9 6] [4 7]
+	// [3 3]: [2 3] [5 4]
+}
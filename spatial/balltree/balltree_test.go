@@ -0,0 +1,153 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package balltree
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+)
+
+// manhattan is a point under the L1 metric.
+type manhattan []float64
+
+func (p manhattan) Distance(c Comparable) float64 {
+	q := c.(manhattan)
+	var sum float64
+	for i, v := range p {
+		sum += math.Abs(v - q[i])
+	}
+	return sum
+}
+
+func randomSet(n, dims int, l1 bool, rnd *rand.Rand) []Comparable {
+	s := make([]Comparable, n)
+	for i := range s {
+		p := make([]float64, dims)
+		for j := range p {
+			p[j] = rnd.Float64()
+		}
+		if l1 {
+			s[i] = manhattan(p)
+		} else {
+			s[i] = Point(p)
+		}
+	}
+	return s
+}
+
+// checkTree checks that every point is within the ball of each of its
+// ancestors and returns the number of points in the tree.
+func checkTree(t *testing.T, n *Node) []Comparable {
+	t.Helper()
+	if n == nil {
+		return nil
+	}
+	var points []Comparable
+	if n.Points != nil {
+		if n.Left != nil || n.Right != nil {
+			t.Errorf("leaf node has children")
+		}
+		points = n.Points
+	} else {
+		points = append(checkTree(t, n.Left), checkTree(t, n.Right)...)
+	}
+	for _, p := range points {
+		if d := n.Center.Distance(p); d > n.Radius {
+			t.Errorf("point outside ball: distance %v > radius %v", d, n.Radius)
+		}
+	}
+	return points
+}
+
+func TestTree(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, dims  int
+		leafSize int
+		l1       bool
+	}{
+		{n: 0, dims: 2, leafSize: 1},
+		{n: 1, dims: 2, leafSize: 1},
+		{n: 100, dims: 2, leafSize: 0},
+		{n: 1000, dims: 2, leafSize: 10},
+		{n: 1000, dims: 16, leafSize: 20},
+		{n: 1000, dims: 5, leafSize: 8, l1: true},
+	} {
+		data := randomSet(test.n, test.dims, test.l1, rnd)
+		// Add duplicates.
+		for i := 0; i < test.n/10; i++ {
+			data = append(data, data[rnd.IntN(len(data))])
+		}
+		orig := append([]Comparable(nil), data...)
+		tree, err := New(data, test.leafSize)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tree.Len() != len(orig) {
+			t.Errorf("unexpected length: got:%d want:%d", tree.Len(), len(orig))
+		}
+		if got := len(checkTree(t, tree.Root)); got != len(orig) {
+			t.Errorf("unexpected number of points in tree: got:%d want:%d", got, len(orig))
+		}
+		var count int
+		tree.Do(func(Comparable, int) bool {
+			count++
+			return false
+		})
+		if count != len(orig) {
+			t.Errorf("unexpected number of points visited: got:%d want:%d", count, len(orig))
+		}
+
+		queries := randomSet(50, test.dims, test.l1, rnd)
+		const k = 5
+		r := 0.1 * float64(test.dims)
+		nearest := tree.NearestBatch(queries, k, 0)
+		within := tree.RadiusBatch(queries, r, 2)
+		for i, q := range queries {
+			dists := make([]float64, len(orig))
+			for j, p := range orig {
+				dists[j] = q.Distance(p)
+			}
+			sort.Float64s(dists)
+
+			p, d := tree.Nearest(q)
+			if len(orig) == 0 {
+				if p != nil || !math.IsInf(d, 1) {
+					t.Errorf("unexpected nearest value for empty tree: %v %v", p, d)
+				}
+			} else if d != dists[0] || q.Distance(p) != d {
+				t.Errorf("unexpected nearest distance: got:%v want:%v", d, dists[0])
+			}
+
+			if want := min(k, len(orig)); len(nearest[i]) != want {
+				t.Errorf("unexpected number of nearest values: got:%d want:%d", len(nearest[i]), want)
+			}
+			for j, cd := range nearest[i] {
+				if cd.Dist != dists[j] || q.Distance(cd.Comparable) != cd.Dist {
+					t.Errorf("unexpected %dth nearest distance: got:%v want:%v", j, cd.Dist, dists[j])
+				}
+			}
+
+			want := sort.Search(len(dists), func(j int) bool { return dists[j] > r })
+			if len(within[i]) != want {
+				t.Errorf("unexpected number of values within radius: got:%d want:%d", len(within[i]), want)
+			}
+			for j, cd := range within[i] {
+				if cd.Dist != dists[j] {
+					t.Errorf("unexpected %dth distance within radius: got:%v want:%v", j, cd.Dist, dists[j])
+				}
+			}
+		}
+	}
+}
+
+func TestPointAtInfinity(t *testing.T) {
+	_, err := New([]Comparable{Point{0, 0}, Point{math.Inf(1), 0}, Point{1, 1}}, 1)
+	if err != pointAtInfinity {
+		t.Errorf("unexpected error: got:%v want:%v", err, pointAtInfinity)
+	}
+}
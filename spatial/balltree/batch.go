@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package balltree
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// NearestBatch returns the k nearest values to each of the queries, and
+// their distances from the query, in order of increasing distance. Fewer
+// than k values are returned for a query if the tree holds fewer than k
+// values. The queries are processed by the given number of concurrent
+// workers; if workers is less than one, runtime.GOMAXPROCS(0) workers are
+// used. NearestBatch will panic if k is less than one.
+func (t *Tree) NearestBatch(queries []Comparable, k, workers int) [][]ComparableDist {
+	if k < 1 {
+		panic("balltree: invalid number of neighbors")
+	}
+	results := make([][]ComparableDist, len(queries))
+	parallel(len(queries), workers, func(i int) {
+		keep := NewNKeeper(k)
+		t.NearestSet(keep, queries[i])
+		results[i] = keep.Heap
+	})
+	return results
+}
+
+// RadiusBatch returns the values within distance r of each of the queries,
+// and their distances from the query, in order of increasing distance. The
+// queries are processed by the given number of concurrent workers; if
+// workers is less than one, runtime.GOMAXPROCS(0) workers are used.
+func (t *Tree) RadiusBatch(queries []Comparable, r float64, workers int) [][]ComparableDist {
+	results := make([][]ComparableDist, len(queries))
+	parallel(len(queries), workers, func(i int) {
+		keep := NewDistKeeper(r)
+		t.NearestSet(keep, queries[i])
+		results[i] = keep.Heap
+	})
+	return results
+}
+
+// parallel calls fn for each integer in [0, n) using the given number of
+// concurrent workers.
+func parallel(n, workers int, fn func(i int)) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, n)
+	var (
+		next atomic.Int64
+		wg   sync.WaitGroup
+	)
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				fn(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
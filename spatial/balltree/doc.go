@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package balltree implements a ball tree. Ball trees provide an
+// efficient search for nearest neighbors in a metric space, and
+// degrade more gracefully with increasing dimension than k-d trees.
+//
+// See Omohundro, "Five balltree construction algorithms", ICSI
+// Technical Report TR-89-063, 1989 for details of ball trees.
+package balltree // import "gonum.org/v1/gonum/spatial/balltree"
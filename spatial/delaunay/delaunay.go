@@ -0,0 +1,539 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package delaunay
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/spatial/curve"
+	"gonum.org/v1/gonum/spatial/internal/predicate"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+var (
+	// ErrTooFewPoints is returned by New when there
+	// are fewer than three distinct points.
+	ErrTooFewPoints = errors.New("delaunay: fewer than three distinct points")
+
+	// ErrCollinear is returned by New when all
+	// the points lie on a single line.
+	ErrCollinear = errors.New("delaunay: all points are collinear")
+)
+
+// Triangulation is a Delaunay triangulation of a set of points.
+type Triangulation struct {
+	// Points holds the triangulated points.
+	Points []r2.Vec
+
+	// Triangles holds the indices into Points
+	// of the vertices of each triangle in
+	// counter-clockwise order.
+	Triangles [][3]int
+
+	// Neighbors holds the index of the triangle
+	// opposite each vertex of the corresponding
+	// element of Triangles, or -1 if the edge
+	// opposite the vertex is on the convex hull.
+	Neighbors [][3]int
+
+	// Hull holds the indices into Points of the
+	// vertices of the convex hull in counter-clockwise
+	// order. Points lying on hull edges are included.
+	Hull []int
+
+	// incident holds the index of a triangle
+	// incident to each point, or -1 if the point
+	// is a duplicate of another point.
+	incident []int
+}
+
+// New returns the Delaunay triangulation of the given points. The points are
+// retained by the returned Triangulation and must not be altered.
+//
+// Points that are equal to an earlier point are not included in the
+// triangulation; their index does not appear in the Triangles or Hull of the
+// returned Triangulation. When four or more points are cocircular, the choice
+// between the possible Delaunay triangulations is arbitrary.
+//
+// The triangulation is constructed by incremental Bowyer-Watson insertion of
+// the points in Hilbert curve order, using exact orientation and in-circle
+// predicates so that the result is correct for all finite input. New returns
+// ErrTooFewPoints if there are fewer than three distinct points and
+// ErrCollinear if all the points are collinear. New will panic if any point
+// has a non-finite coordinate.
+func New(points []r2.Vec) (*Triangulation, error) {
+	for _, p := range points {
+		if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) {
+			panic("delaunay: non-finite point")
+		}
+	}
+	order := hilbertOrder(points)
+
+	// Find the first three non-collinear points.
+	if len(order) < 3 {
+		return nil, ErrTooFewPoints
+	}
+	i0, i1, i2 := order[0], -1, -1
+	for _, i := range order[1:] {
+		if points[i] == points[i0] {
+			continue
+		}
+		if i1 < 0 {
+			i1 = i
+			continue
+		}
+		if predicate.Orient2D(points[i0], points[i1], points[i]) != 0 {
+			i2 = i
+			break
+		}
+	}
+	if i2 < 0 {
+		distinct := make(map[r2.Vec]bool)
+		for _, p := range points {
+			distinct[p] = true
+			if len(distinct) == 3 {
+				return nil, ErrCollinear
+			}
+		}
+		return nil, ErrTooFewPoints
+	}
+
+	m := newMesh(points, i0, i1, i2)
+	for _, i := range order {
+		if i != i0 && i != i1 && i != i2 {
+			m.insert(i)
+		}
+	}
+	return m.triangulation(), nil
+}
+
+// hilbertOrder returns the indices of points sorted by their position along
+// a Hilbert curve covering the bounding box of the points. Ties are broken by
+// index.
+func hilbertOrder(points []r2.Vec) []int {
+	order := make([]int, len(points))
+	for i := range order {
+		order[i] = i
+	}
+	if len(points) == 0 {
+		return order
+	}
+
+	const k = 16
+	h, err := curve.NewHilbert2D(k)
+	if err != nil {
+		panic(err)
+	}
+	b := bounds(points)
+	scale := 0.0
+	if size := b.Size(); size.X > 0 || size.Y > 0 {
+		scale = (1<<k - 1) / math.Max(size.X, size.Y)
+	}
+	pos := make([]int, len(points))
+	v := make([]int, 2)
+	for i, p := range points {
+		v[0] = int((p.X - b.Min.X) * scale)
+		v[1] = int((p.Y - b.Min.Y) * scale)
+		pos[i] = h.Pos(v)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if pos[a] != pos[b] {
+			return pos[a] < pos[b]
+		}
+		return a < b
+	})
+	return order
+}
+
+// bounds returns the bounding box of the non-empty slice of points.
+func bounds(points []r2.Vec) r2.Box {
+	b := r2.Box{Min: points[0], Max: points[0]}
+	for _, p := range points[1:] {
+		b.Min.X = math.Min(b.Min.X, p.X)
+		b.Min.Y = math.Min(b.Min.Y, p.Y)
+		b.Max.X = math.Max(b.Max.X, p.X)
+		b.Max.Y = math.Max(b.Max.Y, p.Y)
+	}
+	return b
+}
+
+// Triangle returns the ith triangle of the triangulation.
+func (t *Triangulation) Triangle(i int) r2.Triangle {
+	v := t.Triangles[i]
+	return r2.Triangle{t.Points[v[0]], t.Points[v[1]], t.Points[v[2]]}
+}
+
+// Locate returns the index of a triangle containing p, or -1 if p is outside
+// the convex hull of the triangulation. Points on an edge or vertex shared by
+// more than one triangle may be reported as being in any of the triangles.
+func (t *Triangulation) Locate(p r2.Vec) int {
+	tri := 0
+	for rot := 0; ; rot = (rot + 1) % 3 {
+		v := t.Triangles[tri]
+		next := tri
+		for k := range 3 {
+			i := (k + rot) % 3
+			a, b := t.Points[v[(i+1)%3]], t.Points[v[(i+2)%3]]
+			if predicate.Orient2D(a, b, p) < 0 {
+				next = t.Neighbors[tri][i]
+				break
+			}
+		}
+		if next == tri || next < 0 {
+			return next
+		}
+		tri = next
+	}
+}
+
+// Nearest returns the index of the triangulated point nearest to p. If more
+// than one point is at the minimum distance, the choice is arbitrary.
+//
+// The nearest point is found by a greedy walk on the edges of the
+// triangulation, which is exact for Delaunay triangulations.
+func (t *Triangulation) Nearest(p r2.Vec) int {
+	v := t.Triangles[0][0]
+	d := r2.Norm2(r2.Sub(t.Points[v], p))
+	var fan []int
+	for {
+		cur := v
+		fan = t.fan(fan[:0], cur)
+		for _, tri := range fan {
+			for _, u := range t.Triangles[tri] {
+				if du := r2.Norm2(r2.Sub(t.Points[u], p)); du < d {
+					v, d = u, du
+				}
+			}
+		}
+		if v == cur {
+			return v
+		}
+	}
+}
+
+// fan appends the indices of the triangles incident to the point v to dst
+// in counter-clockwise order around v and returns the result. If v is on the
+// convex hull, the first triangle holds the hull edge leaving v and the last
+// holds the hull edge arriving at v.
+func (t *Triangulation) fan(dst []int, v int) []int {
+	start := t.incident[v]
+	if start < 0 {
+		return dst
+	}
+	first := start
+	for {
+		prev := t.Neighbors[first][(t.vertexIndex(first, v)+2)%3]
+		if prev < 0 || prev == start {
+			break
+		}
+		first = prev
+	}
+	for tri := first; ; {
+		dst = append(dst, tri)
+		next := t.Neighbors[tri][(t.vertexIndex(tri, v)+1)%3]
+		if next < 0 || next == first {
+			return dst
+		}
+		tri = next
+	}
+}
+
+// vertexIndex returns the position of the point v in the triangle tri.
+func (t *Triangulation) vertexIndex(tri, v int) int {
+	for k, u := range t.Triangles[tri] {
+		if u == v {
+			return k
+		}
+	}
+	panic("delaunay: vertex not in triangle")
+}
+
+// ghost is the vertex at infinity. Every edge of the
+// convex hull is shared by a real triangle and a ghost
+// triangle holding the ghost vertex.
+const ghost = -1
+
+// mesh is a triangulation under construction.
+type mesh struct {
+	points []r2.Vec
+
+	tris []triangle
+	free []int
+
+	// last is a recently created
+	// real triangle used to start
+	// point location walks.
+	last int
+	rot  int
+
+	// mark holds the state of each triangle
+	// during cavity search, relative to stamp.
+	mark  []int
+	stamp int
+
+	stack    []int
+	boundary []boundaryEdge
+	start    map[int]int
+}
+
+// triangle is a mesh triangle. The vertices in v are in counter-clockwise
+// order, and n[i] is the triangle sharing the edge opposite v[i]. The ghost
+// vertex of a ghost triangle is always v[2].
+type triangle struct {
+	v, n [3]int
+	dead bool
+}
+
+func (t *triangle) isGhost() bool { return t.v[2] == ghost }
+
+// boundaryEdge is an edge of an insertion cavity.
+type boundaryEdge struct {
+	a, b int
+	// outside is the triangle
+	// outside the cavity.
+	outside int
+}
+
+// newMesh returns a mesh holding the triangle formed by the
+// points i0, i1 and i2, and the ghost triangles of its edges.
+func newMesh(points []r2.Vec, i0, i1, i2 int) *mesh {
+	if predicate.Orient2D(points[i0], points[i1], points[i2]) < 0 {
+		i1, i2 = i2, i1
+	}
+	m := &mesh{points: points, start: make(map[int]int)}
+	m.add(i0, i1, i2)
+	m.add(i1, i0, ghost)
+	m.add(i2, i1, ghost)
+	m.add(i0, i2, ghost)
+	for i := range m.tris {
+		for j := range m.tris[:i] {
+			m.link(i, j)
+		}
+	}
+	return m
+}
+
+// add adds the counter-clockwise triangle abc to the mesh and returns its index.
+func (m *mesh) add(a, b, c int) int {
+	switch ghost {
+	case a:
+		a, b, c = b, c, a
+	case b:
+		a, b, c = c, a, b
+	}
+	t := triangle{v: [3]int{a, b, c}, n: [3]int{-1, -1, -1}}
+	if len(m.free) != 0 {
+		i := m.free[len(m.free)-1]
+		m.free = m.free[:len(m.free)-1]
+		m.tris[i] = t
+		return i
+	}
+	m.tris = append(m.tris, t)
+	m.mark = append(m.mark, 0)
+	return len(m.tris) - 1
+}
+
+// link makes the triangles i and j neighbors if they share an edge.
+func (m *mesh) link(i, j int) {
+	var shared []int
+	for _, u := range m.tris[i].v {
+		for _, w := range m.tris[j].v {
+			if u == w {
+				shared = append(shared, u)
+			}
+		}
+	}
+	if len(shared) == 2 {
+		m.setNeighbor(i, shared[0], shared[1], j)
+		m.setNeighbor(j, shared[0], shared[1], i)
+	}
+}
+
+// setNeighbor sets the neighbor of the triangle t across its edge uw to nb.
+func (m *mesh) setNeighbor(t, u, w, nb int) {
+	tri := &m.tris[t]
+	for k, v := range tri.v {
+		if v != u && v != w {
+			tri.n[k] = nb
+			return
+		}
+	}
+}
+
+// inConflict returns whether the point p is within the circumcircle of the
+// triangle t. The circumcircle of a ghost triangle is the open half-plane
+// beyond its hull edge together with the interior of the edge.
+func (m *mesh) inConflict(t int, p r2.Vec) bool {
+	tri := &m.tris[t]
+	a, b := m.points[tri.v[0]], m.points[tri.v[1]]
+	if !tri.isGhost() {
+		return predicate.InCircle(a, b, m.points[tri.v[2]], p) > 0
+	}
+	switch o := predicate.Orient2D(a, b, p); {
+	case o > 0:
+		return true
+	case o < 0:
+		return false
+	}
+	// p is collinear with the hull edge, so
+	// compare along the axis on which the
+	// edge's extent is not degenerate.
+	if a.X != b.X {
+		return (a.X < p.X && p.X < b.X) || (b.X < p.X && p.X < a.X)
+	}
+	return (a.Y < p.Y && p.Y < b.Y) || (b.Y < p.Y && p.Y < a.Y)
+}
+
+// locate returns a triangle containing p, or a ghost triangle in conflict
+// with p if p is outside the convex hull of the mesh, by a visibility walk.
+func (m *mesh) locate(p r2.Vec) int {
+	t := m.last
+	for {
+		tri := &m.tris[t]
+		if tri.isGhost() {
+			return t
+		}
+		next := t
+		m.rot = (m.rot + 1) % 3
+		for k := range 3 {
+			i := (k + m.rot) % 3
+			a, b := m.points[tri.v[(i+1)%3]], m.points[tri.v[(i+2)%3]]
+			if predicate.Orient2D(a, b, p) < 0 {
+				next = tri.n[i]
+				break
+			}
+		}
+		if next == t {
+			return t
+		}
+		t = next
+	}
+}
+
+// insert inserts the point i into the mesh unless it
+// is equal to a point already in the mesh.
+func (m *mesh) insert(i int) {
+	p := m.points[i]
+	t := m.locate(p)
+	for _, v := range m.tris[t].v {
+		if v != ghost && m.points[v] == p {
+			return
+		}
+	}
+
+	// Find the cavity of triangles in conflict with p
+	// by a search from the triangle containing p.
+	m.stamp += 2
+	inCavity, outside := m.stamp, m.stamp+1
+	m.boundary = m.boundary[:0]
+	m.stack = append(m.stack[:0], t)
+	m.mark[t] = inCavity
+	for len(m.stack) != 0 {
+		t := m.stack[len(m.stack)-1]
+		m.stack = m.stack[:len(m.stack)-1]
+		tri := &m.tris[t]
+		for k, nb := range tri.n {
+			switch m.mark[nb] {
+			case inCavity:
+				continue
+			case outside:
+			default:
+				if m.inConflict(nb, p) {
+					m.mark[nb] = inCavity
+					m.stack = append(m.stack, nb)
+					continue
+				}
+				m.mark[nb] = outside
+			}
+			m.boundary = append(m.boundary, boundaryEdge{
+				a:       tri.v[(k+1)%3],
+				b:       tri.v[(k+2)%3],
+				outside: nb,
+			})
+		}
+		tri.dead = true
+		m.free = append(m.free, t)
+	}
+
+	// Fill the cavity with triangles joining
+	// its boundary edges to p.
+	clear(m.start)
+	for _, e := range m.boundary {
+		t := m.add(e.a, e.b, i)
+		m.setNeighbor(t, e.a, e.b, e.outside)
+		m.setNeighbor(e.outside, e.a, e.b, t)
+		m.start[e.a] = t
+		if !m.tris[t].isGhost() {
+			m.last = t
+		}
+	}
+	for _, e := range m.boundary {
+		t, next := m.start[e.a], m.start[e.b]
+		m.setNeighbor(t, e.b, i, next)
+		m.setNeighbor(next, e.b, i, t)
+	}
+}
+
+// triangulation returns the Triangulation represented by the mesh.
+func (m *mesh) triangulation() *Triangulation {
+	index := make([]int, len(m.tris))
+	var n int
+	for t, tri := range m.tris {
+		if tri.dead || tri.isGhost() {
+			index[t] = -1
+			continue
+		}
+		index[t] = n
+		n++
+	}
+
+	tr := &Triangulation{
+		Points:    m.points,
+		Triangles: make([][3]int, 0, n),
+		Neighbors: make([][3]int, 0, n),
+		incident:  make([]int, len(m.points)),
+	}
+	for i := range tr.incident {
+		tr.incident[i] = -1
+	}
+	next := make(map[int]int)
+	for t, tri := range m.tris {
+		if tri.dead {
+			continue
+		}
+		if tri.isGhost() {
+			// The real side of the hull edge
+			// is on the right of v[0]→v[1].
+			next[tri.v[1]] = tri.v[0]
+			continue
+		}
+		var nb [3]int
+		for k, u := range tri.n {
+			nb[k] = index[u]
+			tr.incident[tri.v[k]] = index[t]
+		}
+		tr.Triangles = append(tr.Triangles, tri.v)
+		tr.Neighbors = append(tr.Neighbors, nb)
+	}
+
+	first := -1
+	for v := range next {
+		if first < 0 || v < first {
+			first = v
+		}
+	}
+	tr.Hull = make([]int, 0, len(next))
+	for v := first; ; {
+		tr.Hull = append(tr.Hull, v)
+		v = next[v]
+		if v == first {
+			break
+		}
+	}
+	return tr
+}
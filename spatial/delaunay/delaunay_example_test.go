@@ -0,0 +1,53 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package delaunay_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/spatial/delaunay"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+func Example() {
+	points := []r2.Vec{
+		{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}, {X: 1, Y: 0.5},
+	}
+	tr, err := delaunay.New(points)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("triangles: %d\n", len(tr.Triangles))
+	fmt.Printf("hull: %v\n", tr.Hull)
+	fmt.Printf("nearest to (1.9, 1.8): %v\n", points[tr.Nearest(r2.Vec{X: 1.9, Y: 1.8})])
+
+	v := tr.Voronoi()
+	c := v.Cells[4]
+	fmt.Printf("cell of %v is bounded: %t\n", points[4], c.Bounded)
+	for _, k := range c.Vertices {
+		fmt.Printf("\t%.3f\n", v.Vertices[k])
+	}
+	c = v.Cells[0]
+	fmt.Printf("cell of %v is bounded: %t\n", points[0], c.Bounded)
+	for _, k := range c.Vertices {
+		fmt.Printf("\t%.3f\n", v.Vertices[k])
+	}
+	fmt.Printf("\trays: %.3f %.3f\n", c.Start, c.End)
+
+	// Output:
+	// triangles: 4
+	// hull: [0 1 2 3]
+	// nearest to (1.9, 1.8): {2 2}
+	// cell of {1 0.5} is bounded: true
+	// 	{1.000 -0.750}
+	// 	{1.875 1.000}
+	// 	{1.000 1.583}
+	// 	{0.125 1.000}
+	// cell of {0 0} is bounded: false
+	// 	{1.000 -0.750}
+	// 	{0.125 1.000}
+	// 	rays: {0.000 -1.000} {-1.000 0.000}
+}
@@ -0,0 +1,266 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package delaunay
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/spatial/internal/predicate"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+func randomPoints(n int, rnd *rand.Rand) []r2.Vec {
+	p := make([]r2.Vec, n)
+	for i := range p {
+		p[i] = r2.Vec{X: rnd.Float64(), Y: rnd.Float64()}
+	}
+	return p
+}
+
+func gridPoints(n int) []r2.Vec {
+	var p []r2.Vec
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			p = append(p, r2.Vec{X: float64(i), Y: float64(j)})
+		}
+	}
+	return p
+}
+
+func circlePoints(n int) []r2.Vec {
+	p := []r2.Vec{{X: 0, Y: 0}}
+	for i := 0; i < n; i++ {
+		theta := 2 * math.Pi * float64(i) / float64(n)
+		p = append(p, r2.Vec{X: math.Cos(theta), Y: math.Sin(theta)})
+	}
+	return p
+}
+
+var triangulationTests = []struct {
+	name   string
+	points []r2.Vec
+}{
+	{
+		name:   "triangle",
+		points: []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 1}},
+	},
+	{
+		name:   "square",
+		points: []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}},
+	},
+	{
+		name: "collinear_start",
+		points: []r2.Vec{
+			{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 0},
+			{X: 1.5, Y: 1}, {X: 1.5, Y: -1}, {X: 4, Y: 0}, {X: -1, Y: 0},
+		},
+	},
+	{
+		name: "duplicates",
+		points: []r2.Vec{
+			{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 0}, {X: 0, Y: 1},
+			{X: 1, Y: 0}, {X: 0.25, Y: 0.25}, {X: 0.25, Y: 0.25},
+		},
+	},
+	{
+		name:   "grid",
+		points: gridPoints(12),
+	},
+	{
+		name:   "circle",
+		points: circlePoints(64),
+	},
+	{
+		name:   "random",
+		points: randomPoints(1000, rand.New(rand.NewPCG(1, 1))),
+	},
+	{
+		name: "clustered",
+		points: func() []r2.Vec {
+			rnd := rand.New(rand.NewPCG(1, 2))
+			p := randomPoints(200, rnd)
+			for i := range p {
+				p[i] = r2.Add(r2.Vec{X: 1e6, Y: 1e6}, r2.Scale(1e-9, p[i]))
+			}
+			return p
+		}(),
+	},
+}
+
+func TestNew(t *testing.T) {
+	for _, test := range triangulationTests {
+		tr, err := New(test.points)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		checkTriangulation(t, test.name, tr)
+	}
+}
+
+func checkTriangulation(t *testing.T, name string, tr *Triangulation) {
+	t.Helper()
+
+	// Identify the distinct points, the first of
+	// each group of equal points.
+	var distinct []int
+	isDistinct := make([]bool, len(tr.Points))
+	seen := make(map[r2.Vec]bool)
+	for i, p := range tr.Points {
+		if !seen[p] {
+			seen[p] = true
+			isDistinct[i] = true
+			distinct = append(distinct, i)
+		}
+	}
+
+	used := make([]bool, len(tr.Points))
+	for i, v := range tr.Triangles {
+		for _, u := range v {
+			used[u] = true
+		}
+		if predicate.Orient2D(tr.Points[v[0]], tr.Points[v[1]], tr.Points[v[2]]) <= 0 {
+			t.Errorf("%s: triangle %d is not counter-clockwise: %v", name, i, v)
+		}
+		for _, u := range distinct {
+			if predicate.InCircle(tr.Points[v[0]], tr.Points[v[1]], tr.Points[v[2]], tr.Points[u]) > 0 {
+				t.Errorf("%s: point %d is inside the circumcircle of triangle %d", name, u, i)
+			}
+		}
+		for k, j := range tr.Neighbors[i] {
+			a, b := v[(k+1)%3], v[(k+2)%3]
+			if j < 0 {
+				continue
+			}
+			var back bool
+			for l, w := range tr.Triangles[j] {
+				if tr.Neighbors[j][l] == i {
+					back = true
+					if w == a || w == b {
+						t.Errorf("%s: triangles %d and %d are not joined by edge %d-%d", name, i, j, a, b)
+					}
+				}
+			}
+			if !back {
+				t.Errorf("%s: neighbor relation of triangles %d and %d is not symmetric", name, i, j)
+			}
+		}
+	}
+	for _, i := range distinct {
+		if !used[i] {
+			t.Errorf("%s: point %d is not in the triangulation", name, i)
+		}
+	}
+	for i, u := range used {
+		if u && !isDistinct[i] {
+			t.Errorf("%s: duplicate point %d is in the triangulation", name, i)
+		}
+	}
+
+	// Check that the hull is convex and contains
+	// all the points, and that the number of triangles
+	// is consistent with Euler's formula.
+	h := len(tr.Hull)
+	for k, a := range tr.Hull {
+		b := tr.Hull[(k+1)%h]
+		for _, u := range distinct {
+			if predicate.Orient2D(tr.Points[a], tr.Points[b], tr.Points[u]) < 0 {
+				t.Errorf("%s: point %d is outside hull edge %d-%d", name, u, a, b)
+			}
+		}
+	}
+	var onHull int
+	for _, nb := range tr.Neighbors {
+		for _, j := range nb {
+			if j < 0 {
+				onHull++
+			}
+		}
+	}
+	if onHull != h {
+		t.Errorf("%s: unexpected number of hull edges: got:%d want:%d", name, onHull, h)
+	}
+	if want := 2*len(distinct) - 2 - h; len(tr.Triangles) != want {
+		t.Errorf("%s: unexpected number of triangles: got:%d want:%d", name, len(tr.Triangles), want)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	for _, test := range []struct {
+		points []r2.Vec
+		want   error
+	}{
+		{points: nil, want: ErrTooFewPoints},
+		{points: []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 1}}, want: ErrTooFewPoints},
+		{points: []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 0}, {X: 1, Y: 1}}, want: ErrTooFewPoints},
+		{points: []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}}, want: ErrCollinear},
+		{points: []r2.Vec{{X: 0.1, Y: 0.3}, {X: 0.2, Y: 0.6}, {X: 0.1, Y: 0.3}, {X: 0.4, Y: 1.2}}, want: ErrCollinear},
+	} {
+		_, err := New(test.points)
+		if err != test.want {
+			t.Errorf("unexpected error for %v: got:%v want:%v", test.points, err, test.want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for non-finite point")
+		}
+	}()
+	New([]r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: math.NaN(), Y: 1}})
+}
+
+func TestLocateNearest(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 3))
+	for _, test := range triangulationTests {
+		tr, err := New(test.points)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", test.name, err)
+		}
+		b := bounds(tr.Points)
+		size := b.Size()
+		for i := 0; i < 200; i++ {
+			q := r2.Vec{
+				X: b.Min.X + (rnd.Float64()*1.4-0.2)*size.X,
+				Y: b.Min.Y + (rnd.Float64()*1.4-0.2)*size.Y,
+			}
+			if i < len(tr.Points) && i%4 == 0 {
+				q = tr.Points[i]
+			}
+
+			inHull := true
+			for k, a := range tr.Hull {
+				if predicate.Orient2D(tr.Points[a], tr.Points[tr.Hull[(k+1)%len(tr.Hull)]], q) < 0 {
+					inHull = false
+				}
+			}
+			got := tr.Locate(q)
+			switch {
+			case got < 0 && inHull:
+				t.Errorf("%s: failed to locate %v inside hull", test.name, q)
+			case got >= 0 && !inHull:
+				t.Errorf("%s: located %v outside hull in triangle %d", test.name, q, got)
+			case got >= 0:
+				v := tr.Triangles[got]
+				for k := range v {
+					if predicate.Orient2D(tr.Points[v[k]], tr.Points[v[(k+1)%3]], q) < 0 {
+						t.Errorf("%s: %v is not in triangle %d", test.name, q, got)
+					}
+				}
+			}
+
+			n := tr.Nearest(q)
+			want := math.Inf(1)
+			for _, p := range tr.Points {
+				want = math.Min(want, r2.Norm2(r2.Sub(p, q)))
+			}
+			if d := r2.Norm2(r2.Sub(tr.Points[n], q)); d != want {
+				t.Errorf("%s: unexpected nearest distance for %v: got:%v want:%v", test.name, q, d, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package delaunay
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// Voronoi is a Voronoi diagram of a set of sites.
+type Voronoi struct {
+	// Sites holds the sites of the diagram.
+	Sites []r2.Vec
+
+	// Vertices holds the vertices of the diagram.
+	Vertices []r2.Vec
+
+	// Cells holds the Voronoi cell of
+	// each element of Sites.
+	Cells []Cell
+}
+
+// Cell is a Voronoi cell.
+type Cell struct {
+	// Vertices holds the indices into the
+	// Voronoi Vertices of the vertices of the
+	// cell in counter-clockwise order. Vertices
+	// may be repeated when more than three sites
+	// are cocircular.
+	Vertices []int
+
+	// Bounded is whether the cell is bounded.
+	Bounded bool
+
+	// Start and End are the unit directions
+	// of the rays bounding an unbounded cell.
+	// The cell boundary arrives from infinity
+	// along the ray from the first vertex in
+	// the direction Start and leaves to infinity
+	// along the ray from the last vertex in the
+	// direction End.
+	Start, End r2.Vec
+}
+
+// Voronoi returns the Voronoi diagram dual to the triangulation. The ith
+// vertex of the diagram is the circumcenter of the ith triangle of t. The
+// cells of points that are not included in the triangulation because they
+// are duplicates of other points have no vertices.
+func (t *Triangulation) Voronoi() *Voronoi {
+	v := &Voronoi{
+		Sites:    t.Points,
+		Vertices: make([]r2.Vec, len(t.Triangles)),
+		Cells:    make([]Cell, len(t.Points)),
+	}
+	for i := range t.Triangles {
+		v.Vertices[i] = circumcenter(t.Triangle(i))
+	}
+	for i := range t.Points {
+		fan := t.fan(nil, i)
+		if len(fan) == 0 {
+			continue
+		}
+		c := &v.Cells[i]
+		c.Vertices = fan
+
+		first := t.Triangles[fan[0]]
+		last := t.Triangles[fan[len(fan)-1]]
+		next := first[(t.vertexIndex(fan[0], i)+1)%3]
+		prev := last[(t.vertexIndex(fan[len(fan)-1], i)+2)%3]
+		c.Bounded = t.Neighbors[fan[len(fan)-1]][(t.vertexIndex(fan[len(fan)-1], i)+1)%3] >= 0
+		if !c.Bounded {
+			// The rays are the outward normals
+			// of the hull edges at the site.
+			c.Start = outwardNormal(t.Points[i], t.Points[next])
+			c.End = outwardNormal(t.Points[prev], t.Points[i])
+		}
+	}
+	return v
+}
+
+// circumcenter returns the center of the circle through the vertices of t.
+func circumcenter(t r2.Triangle) r2.Vec {
+	b := r2.Sub(t[1], t[0])
+	c := r2.Sub(t[2], t[0])
+	d := 2 * r2.Cross(b, c)
+	b2, c2 := r2.Norm2(b), r2.Norm2(c)
+	return r2.Vec{
+		X: t[0].X + (c.Y*b2-b.Y*c2)/d,
+		Y: t[0].Y + (b.X*c2-c.X*b2)/d,
+	}
+}
+
+// outwardNormal returns the unit normal to the right of the edge from a to b.
+func outwardNormal(a, b r2.Vec) r2.Vec {
+	return r2.Unit(r2.Vec{X: b.Y - a.Y, Y: a.X - b.X})
+}
+
+// Polygon returns the vertices of the Voronoi cell of the ith site clipped
+// to the box b, in counter-clockwise order. Polygon returns nil if the cell
+// does not intersect the box or has no vertices.
+func (v *Voronoi) Polygon(i int, b r2.Box) []r2.Vec {
+	c := v.Cells[i]
+	if len(c.Vertices) == 0 {
+		return nil
+	}
+	poly := make([]r2.Vec, 0, len(c.Vertices)+2)
+	for _, k := range c.Vertices {
+		poly = append(poly, v.Vertices[k])
+	}
+	if !c.Bounded {
+		// Close the cell with points on its rays far
+		// enough away that the chord between them
+		// does not intersect the box.
+		center := b.Center()
+		r := r2.Norm(r2.Sub(b.Max, center))
+		for _, p := range poly {
+			r = math.Max(r, r2.Norm(r2.Sub(p, center)))
+		}
+		far := 4*r/r2.Norm(r2.Add(c.Start, c.End)) + 1
+		poly = append(poly, r2.Add(poly[len(poly)-1], r2.Scale(far, c.End)))
+		poly = append([]r2.Vec{r2.Add(poly[0], r2.Scale(far, c.Start))}, poly...)
+	}
+
+	for _, h := range []struct {
+		in func(p r2.Vec) bool
+		at func(p, q r2.Vec) float64
+	}{
+		{in: func(p r2.Vec) bool { return p.X >= b.Min.X }, at: func(p, q r2.Vec) float64 { return (b.Min.X - p.X) / (q.X - p.X) }},
+		{in: func(p r2.Vec) bool { return p.X <= b.Max.X }, at: func(p, q r2.Vec) float64 { return (b.Max.X - p.X) / (q.X - p.X) }},
+		{in: func(p r2.Vec) bool { return p.Y >= b.Min.Y }, at: func(p, q r2.Vec) float64 { return (b.Min.Y - p.Y) / (q.Y - p.Y) }},
+		{in: func(p r2.Vec) bool { return p.Y <= b.Max.Y }, at: func(p, q r2.Vec) float64 { return (b.Max.Y - p.Y) / (q.Y - p.Y) }},
+	} {
+		if len(poly) == 0 {
+			return nil
+		}
+		var clipped []r2.Vec
+		p := poly[len(poly)-1]
+		for _, q := range poly {
+			pIn, qIn := h.in(p), h.in(q)
+			if pIn != qIn {
+				clipped = append(clipped, r2.Add(p, r2.Scale(h.at(p, q), r2.Sub(q, p))))
+			}
+			if qIn {
+				clipped = append(clipped, q)
+			}
+			p = q
+		}
+		poly = clipped
+	}
+	if len(poly) == 0 {
+		return nil
+	}
+	return poly
+}
@@ -0,0 +1,98 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package delaunay
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+func TestVoronoi(t *testing.T) {
+	const tol = 1e-9
+	rnd := rand.New(rand.NewPCG(1, 4))
+	for _, test := range triangulationTests {
+		if test.name == "clustered" {
+			// The cells are too small for
+			// an absolute area tolerance.
+			continue
+		}
+		tr, err := New(test.points)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", test.name, err)
+		}
+		v := tr.Voronoi()
+
+		for i, c := range v.Vertices {
+			r := r2.Norm(r2.Sub(c, tr.Points[tr.Triangles[i][0]]))
+			for _, u := range tr.Triangles[i][1:] {
+				if d := r2.Norm(r2.Sub(c, tr.Points[u])); !scalar.EqualWithinAbsOrRel(d, r, tol, tol) {
+					t.Errorf("%s: vertex %d is not equidistant from the sites of triangle %d: %v != %v", test.name, i, i, d, r)
+				}
+			}
+		}
+
+		var hull int
+		for _, c := range v.Cells {
+			if len(c.Vertices) != 0 && !c.Bounded {
+				hull++
+			}
+		}
+		if hull != len(tr.Hull) {
+			t.Errorf("%s: unexpected number of unbounded cells: got:%d want:%d", test.name, hull, len(tr.Hull))
+		}
+
+		// The clipped cells must tile the box,
+		// and each must contain the points of
+		// the box nearest to its site.
+		b := bounds(tr.Points)
+		size := b.Size()
+		b.Min = r2.Sub(b.Min, r2.Scale(0.25, size))
+		b.Max = r2.Add(b.Max, r2.Scale(0.25, size))
+		size = b.Size()
+
+		var area float64
+		polys := make([][]r2.Vec, len(v.Cells))
+		for i := range v.Cells {
+			polys[i] = v.Polygon(i, b)
+			a := polygonArea(polys[i])
+			if a < -tol {
+				t.Errorf("%s: cell %d is not counter-clockwise", test.name, i)
+			}
+			area += a
+		}
+		if want := size.X * size.Y; !scalar.EqualWithinAbsOrRel(area, want, tol, tol) {
+			t.Errorf("%s: cells do not tile the box: got area:%v want:%v", test.name, area, want)
+		}
+		for range 200 {
+			q := r2.Vec{X: b.Min.X + rnd.Float64()*size.X, Y: b.Min.Y + rnd.Float64()*size.Y}
+			n := tr.Nearest(q)
+			if !inConvex(polys[n], q, tol) {
+				t.Errorf("%s: %v is not in the cell of its nearest site %d", test.name, q, n)
+			}
+		}
+	}
+}
+
+func polygonArea(p []r2.Vec) float64 {
+	var a float64
+	for i, u := range p {
+		a += r2.Cross(u, p[(i+1)%len(p)])
+	}
+	return a / 2
+}
+
+func inConvex(p []r2.Vec, q r2.Vec, tol float64) bool {
+	for i, u := range p {
+		e := r2.Sub(p[(i+1)%len(p)], u)
+		if r2.Cross(e, r2.Sub(q, u)) < -tol*math.Max(1, r2.Norm(e)) {
+			return false
+		}
+	}
+	return len(p) != 0
+}
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package geodesic provides distances, azimuths and positions along
+// geodesics on the surface of an ellipsoid of revolution and on a sphere.
+//
+// Latitudes, longitudes and azimuths are in degrees, with azimuths measured
+// clockwise from north, and distances are in the units of the ellipsoid or
+// sphere radius.
+package geodesic // import "gonum.org/v1/gonum/spatial/geodesic"
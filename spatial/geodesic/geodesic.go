@@ -0,0 +1,619 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geodesic
+
+import "math"
+
+// order is the order of the series expansions in the third flattening.
+const order = 6
+
+const (
+	nA3  = order
+	nC1  = order
+	nC1p = order
+	nC2  = order
+	nC3  = order
+	nA3x = nA3
+	nC3x = nC3 * (nC3 - 1) / 2
+
+	maxit1 = 20
+	maxit2 = maxit1 + 53 + 10
+)
+
+var (
+	tiny    = math.Sqrt(0x1p-1022)
+	tol0    = 0x1p-52
+	tol1    = 200 * tol0
+	tol2    = math.Sqrt(tol0)
+	tolb    = tol0
+	xthresh = 1000 * tol2
+)
+
+// WGS84 is the World Geodetic System 1984 ellipsoid used by GPS.
+var WGS84 = NewEllipsoid(6378137, 1/298.257223563)
+
+// Ellipsoid is an oblate ellipsoid of revolution on which geodesic problems
+// are solved. The solutions are found by the method of Karney, "Algorithms
+// for geodesics", J. Geod. 87:43-55, 2013, doi:10.1007/s00190-012-0578-z,
+// and are accurate to round-off for flattenings of up to about 0.01.
+type Ellipsoid struct {
+	a, f float64
+
+	f1, ep2, n, b, etol2 float64
+
+	a3x [nA3x]float64
+	c3x [nC3x]float64
+}
+
+// NewEllipsoid returns an ellipsoid with the equatorial radius a and the
+// flattening f. NewEllipsoid will panic if a is not positive and finite or
+// if f is not in [0, 1).
+func NewEllipsoid(a, f float64) *Ellipsoid {
+	if !(a > 0) || math.IsInf(a, 1) {
+		panic("geodesic: invalid equatorial radius")
+	}
+	if !(0 <= f && f < 1) {
+		panic("geodesic: invalid flattening")
+	}
+	e := &Ellipsoid{a: a, f: f}
+	e.f1 = 1 - f
+	e.ep2 = f * (2 - f) / (e.f1 * e.f1)
+	e.n = f / (2 - f)
+	e.b = a * e.f1
+	e.etol2 = 0.1 * tol2 / math.Sqrt(math.Max(0.001, f)*math.Min(1, 1-f/2)/2)
+	e.a3coeff()
+	e.c3coeff()
+	return e
+}
+
+// Radius returns the equatorial radius of the ellipsoid.
+func (e *Ellipsoid) Radius() float64 { return e.a }
+
+// Flattening returns the flattening of the ellipsoid.
+func (e *Ellipsoid) Flattening() float64 { return e.f }
+
+// Distance returns the length of the shortest geodesic between the points
+// at lat1, lon1 and lat2, lon2.
+func (e *Ellipsoid) Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	s12, _, _ := e.Inverse(lat1, lon1, lat2, lon2)
+	return s12
+}
+
+// Inverse solves the inverse geodesic problem, returning the length s12 of
+// the shortest geodesic between the points at lat1, lon1 and lat2, lon2 and
+// the azimuths of the geodesic at the two points, azi1 and azi2. The azimuth
+// azi2 is the forward azimuth at the second point. Latitudes outside
+// [-90, 90] result in NaN values.
+//
+// For nearly antipodal points, where the shortest geodesic is not unique,
+// one of the shortest geodesics is returned.
+func (e *Ellipsoid) Inverse(lat1, lon1, lat2, lon2 float64) (s12, azi1, azi2 float64) {
+	lon12, lon12s := angDiff(lon1, lon2)
+	lonsign := math.Copysign(1, lon12)
+	lon12 = lonsign * angRound(lon12)
+	lon12s = angRound((180 - lon12) - lonsign*lon12s)
+	lam12 := lon12 * math.Pi / 180
+	var slam12, clam12 float64
+	if lon12 > 90 {
+		slam12, clam12 = sincosd(lon12s)
+		clam12 = -clam12
+	} else {
+		slam12, clam12 = sincosd(lon12)
+	}
+
+	// Make lat1 <= -|lat2| so that the first point
+	// is furthest from the equator in the south.
+	lat1 = angRound(latFix(lat1))
+	lat2 = angRound(latFix(lat2))
+	swapp := 1.0
+	if math.Abs(lat1) < math.Abs(lat2) || math.IsNaN(lat2) {
+		swapp = -1
+		lonsign = -lonsign
+		lat1, lat2 = lat2, lat1
+	}
+	latsign := math.Copysign(1, -lat1)
+	lat1 *= latsign
+	lat2 *= latsign
+
+	sbet1, cbet1 := sincosd(lat1)
+	sbet1, cbet1 = norm(e.f1*sbet1, cbet1)
+	cbet1 = math.Max(tiny, cbet1)
+	sbet2, cbet2 := sincosd(lat2)
+	sbet2, cbet2 = norm(e.f1*sbet2, cbet2)
+	cbet2 = math.Max(tiny, cbet2)
+	if cbet1 < -sbet1 {
+		if cbet2 == cbet1 {
+			sbet2 = math.Copysign(sbet1, sbet2)
+		}
+	} else if math.Abs(sbet2) == -sbet1 {
+		cbet2 = cbet1
+	}
+	dn1 := math.Sqrt(1 + e.ep2*sbet1*sbet1)
+	dn2 := math.Sqrt(1 + e.ep2*sbet2*sbet2)
+
+	var (
+		c1a [nC1 + 1]float64
+		c2a [nC2 + 1]float64
+		c3a [nC3]float64
+
+		sig12, s12x, m12x          float64
+		salp1, calp1, salp2, calp2 float64
+	)
+
+	meridian := lat1 == -90 || slam12 == 0
+	if meridian {
+		// The geodesic is along a meridian unless it
+		// passes through a pole and is not shortest.
+		salp1, calp1 = slam12, clam12
+		salp2, calp2 = 0, 1
+		ssig1, csig1 := sbet1, calp1*cbet1
+		ssig2, csig2 := sbet2, calp2*cbet2
+		sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+		s12x, m12x = e.lengths(e.n, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a[:], c2a[:])
+		if sig12 < 1 || m12x >= 0 {
+			if sig12 < 3*tiny || (sig12 < tol0 && (s12x < 0 || m12x < 0)) {
+				s12x = 0
+			}
+			s12x *= e.b
+		} else {
+			meridian = false
+		}
+	}
+
+	switch {
+	case meridian:
+	case sbet1 == 0 && lon12s >= e.f*180:
+		// The geodesic is along the equator.
+		salp1, calp1 = 1, 0
+		salp2, calp2 = 1, 0
+		s12x = e.a * lam12
+	default:
+		var dnm float64
+		sig12, salp1, calp1, salp2, calp2, dnm = e.inverseStart(sbet1, cbet1, sbet2, cbet2, lam12, slam12, clam12)
+		if sig12 >= 0 {
+			// The points are close so the solution
+			// is found directly.
+			s12x = sig12 * e.b * dnm
+			break
+		}
+
+		// Find the azimuth at the first point by Newton's method,
+		// falling back to bisection if Newton's method fails.
+		var (
+			numit        int
+			tripn, tripb bool
+
+			salp1a, calp1a = tiny, 1.0
+			salp1b, calp1b = tiny, -1.0
+
+			ssig1, csig1, ssig2, csig2, eps float64
+		)
+		for {
+			var v, dv float64
+			v, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, dv = e.lambda12(sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam12, clam12, numit < maxit1, c1a[:], c2a[:], c3a[:])
+			limit := 1.0
+			if tripn {
+				limit = 8
+			}
+			if tripb || !(math.Abs(v) >= limit*tol0) || numit == maxit2 {
+				break
+			}
+			if v > 0 && (numit > maxit1 || calp1/salp1 > calp1b/salp1b) {
+				salp1b, calp1b = salp1, calp1
+			} else if v < 0 && (numit > maxit1 || calp1/salp1 < calp1a/salp1a) {
+				salp1a, calp1a = salp1, calp1
+			}
+			numit++
+			if numit < maxit1 && dv > 0 {
+				dalp1 := -v / dv
+				if math.Abs(dalp1) < math.Pi {
+					sdalp1, cdalp1 := math.Sincos(dalp1)
+					nsalp1 := salp1*cdalp1 + calp1*sdalp1
+					if nsalp1 > 0 {
+						calp1 = calp1*cdalp1 - salp1*sdalp1
+						salp1, calp1 = norm(nsalp1, calp1)
+						tripn = math.Abs(v) <= 16*tol0
+						continue
+					}
+				}
+			}
+			salp1, calp1 = norm((salp1a+salp1b)/2, (calp1a+calp1b)/2)
+			tripn = false
+			tripb = math.Abs(salp1a-salp1)+(calp1a-calp1) < tolb ||
+				math.Abs(salp1-salp1b)+(calp1-calp1b) < tolb
+		}
+		s12x, _ = e.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a[:], c2a[:])
+		s12x *= e.b
+	}
+
+	if swapp < 0 {
+		salp1, salp2 = salp2, salp1
+		calp1, calp2 = calp2, calp1
+	}
+	salp1 *= swapp * lonsign
+	calp1 *= swapp * latsign
+	salp2 *= swapp * lonsign
+	calp2 *= swapp * latsign
+	return 0 + s12x, atan2d(salp1, calp1), atan2d(salp2, calp2)
+}
+
+// Direct solves the direct geodesic problem, returning the position lat2,
+// lon2 reached by following the geodesic from lat1, lon1 with the initial
+// azimuth azi1 for the distance s12, and the forward azimuth azi2 of the
+// geodesic at that point. The distance s12 may be negative. The returned
+// longitude is in [-180, 180].
+func (e *Ellipsoid) Direct(lat1, lon1, azi1, s12 float64) (lat2, lon2, azi2 float64) {
+	lat1 = latFix(lat1)
+	salp1, calp1 := sincosd(angRound(azi1))
+	sbet1, cbet1 := sincosd(angRound(lat1))
+	sbet1, cbet1 = norm(e.f1*sbet1, cbet1)
+	cbet1 = math.Max(tiny, cbet1)
+
+	// Find the equatorial crossing of the geodesic
+	// and the auxiliary sphere quantities at the
+	// first point.
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+	ssig1, somg1 := sbet1, salp0*sbet1
+	csig1 := 1.0
+	if sbet1 != 0 || calp1 != 0 {
+		csig1 = cbet1 * calp1
+	}
+	comg1 := csig1
+	ssig1, csig1 = norm(ssig1, csig1)
+	k2 := calp0 * calp0 * e.ep2
+	eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+
+	var (
+		c1a  [nC1 + 1]float64
+		c1pa [nC1p + 1]float64
+		c3a  [nC3]float64
+	)
+	a1m1 := a1m1f(eps)
+	c1f(eps, c1a[:])
+	b11 := sinCosSeries(true, ssig1, csig1, c1a[:])
+	s, c := math.Sincos(b11)
+	stau1 := ssig1*c + csig1*s
+	ctau1 := csig1*c - ssig1*s
+	c1pf(eps, c1pa[:])
+	e.c3f(eps, c3a[:])
+	a3c := -e.f * salp0 * e.a3f(eps)
+	b31 := sinCosSeries(true, ssig1, csig1, c3a[:])
+
+	// Convert the distance to an arc length on
+	// the auxiliary sphere.
+	tau12 := s12 / (e.b * (1 + a1m1))
+	if math.IsInf(tau12, 0) {
+		tau12 = math.NaN()
+	}
+	s, c = math.Sincos(tau12)
+	b12 := -sinCosSeries(true, stau1*c+ctau1*s, ctau1*c-stau1*s, c1pa[:])
+	sig12 := tau12 - (b12 - b11)
+	ssig12, csig12 := math.Sincos(sig12)
+	if e.f > 0.01 {
+		// Refine the arc length with a Newton step.
+		ssig2 := ssig1*csig12 + csig1*ssig12
+		csig2 := csig1*csig12 - ssig1*ssig12
+		b12 = sinCosSeries(true, ssig2, csig2, c1a[:])
+		serr := (1+a1m1)*(sig12+(b12-b11)) - s12/e.b
+		sig12 -= serr / math.Sqrt(1+k2*ssig2*ssig2)
+		ssig12, csig12 = math.Sincos(sig12)
+	}
+
+	ssig2 := ssig1*csig12 + csig1*ssig12
+	csig2 := csig1*csig12 - ssig1*ssig12
+	sbet2 := calp0 * ssig2
+	cbet2 := math.Hypot(salp0, calp0*csig2)
+	if cbet2 == 0 {
+		cbet2 = tiny
+		csig2 = tiny
+	}
+	salp2, calp2 := salp0, calp0*csig2
+
+	somg2, comg2 := salp0*ssig2, csig2
+	omg12 := math.Atan2(somg2*comg1-comg2*somg1, comg2*comg1+somg2*somg1)
+	lam12 := omg12 + a3c*(sig12+(sinCosSeries(true, ssig2, csig2, c3a[:])-b31))
+	lon12 := lam12 * 180 / math.Pi
+
+	lat2 = atan2d(sbet2, e.f1*cbet2)
+	lon2 = angNormalize(angNormalize(lon1) + angNormalize(lon12))
+	azi2 = atan2d(salp2, calp2)
+	return lat2, lon2, azi2
+}
+
+// lengths returns the distance and reduced length, scaled by the polar
+// radius, between two points on a geodesic with the auxiliary sphere
+// parameters provided.
+func (e *Ellipsoid) lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2 float64, c1a, c2a []float64) (s12b, m12b float64) {
+	a1 := a1m1f(eps)
+	c1f(eps, c1a)
+	a2 := a2m1f(eps)
+	c2f(eps, c2a)
+	m0x := a1 - a2
+	a1++
+	a2++
+	b1 := sinCosSeries(true, ssig2, csig2, c1a) - sinCosSeries(true, ssig1, csig1, c1a)
+	b2 := sinCosSeries(true, ssig2, csig2, c2a) - sinCosSeries(true, ssig1, csig1, c2a)
+	s12b = a1 * (sig12 + b1)
+	j12 := m0x*sig12 + (a1*b1 - a2*b2)
+	m12b = dn2*(csig1*ssig2) - dn1*(ssig1*csig2) - csig1*csig2*j12
+	return s12b, m12b
+}
+
+// inverseStart returns a starting azimuth at the first point for the
+// solution of the inverse problem. If the points are sufficiently close,
+// sig12 is non-negative and the complete solution is returned.
+func (e *Ellipsoid) inverseStart(sbet1, cbet1, sbet2, cbet2, lam12, slam12, clam12 float64) (sig12, salp1, calp1, salp2, calp2, dnm float64) {
+	sig12 = -1
+	sbet12 := sbet2*cbet1 - cbet2*sbet1
+	cbet12 := cbet2*cbet1 + sbet2*sbet1
+	sbet12a := sbet2*cbet1 + cbet2*sbet1
+
+	shortline := cbet12 >= 0 && sbet12 < 0.5 && cbet2*lam12 < 0.5
+	var somg12, comg12 float64
+	if shortline {
+		sbetm2 := (sbet1 + sbet2) * (sbet1 + sbet2)
+		sbetm2 /= sbetm2 + (cbet1+cbet2)*(cbet1+cbet2)
+		dnm = math.Sqrt(1 + e.ep2*sbetm2)
+		omg12 := lam12 / (e.f1 * dnm)
+		somg12, comg12 = math.Sincos(omg12)
+	} else {
+		somg12, comg12 = slam12, clam12
+	}
+
+	salp1 = cbet2 * somg12
+	if comg12 >= 0 {
+		calp1 = sbet12 + cbet2*sbet1*somg12*somg12/(1+comg12)
+	} else {
+		calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+	}
+	ssig12 := math.Hypot(salp1, calp1)
+	csig12 := sbet1*sbet2 + cbet1*cbet2*comg12
+
+	switch {
+	case shortline && ssig12 < e.etol2:
+		// The points are close so the great
+		// circle solution is accurate.
+		salp2 = cbet1 * somg12
+		if comg12 >= 0 {
+			calp2 = sbet12 - cbet1*sbet2*somg12*somg12/(1+comg12)
+		} else {
+			calp2 = sbet12 - cbet1*sbet2*(1-comg12)
+		}
+		salp2, calp2 = norm(salp2, calp2)
+		sig12 = math.Atan2(ssig12, csig12)
+	case math.Abs(e.n) >= 0.1 || csig12 >= 0 || ssig12 >= 6*math.Abs(e.n)*math.Pi*cbet1*cbet1:
+		// Use the great circle azimuth.
+	default:
+		// The points are nearly antipodal so
+		// the azimuth is found by the astroid
+		// approximation.
+		lam12x := math.Atan2(-slam12, -clam12)
+		k2 := sbet1 * sbet1 * e.ep2
+		eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+		lamscale := e.f * cbet1 * e.a3f(eps) * math.Pi
+		betscale := lamscale * cbet1
+		x := lam12x / lamscale
+		y := sbet12a / betscale
+		if y > -tol1 && x > -1-xthresh {
+			salp1 = math.Min(1, -x)
+			calp1 = -math.Sqrt(1 - salp1*salp1)
+		} else {
+			k := astroid(x, y)
+			omg12a := lamscale * (-x * k / (1 + k))
+			somg12, comg12 = math.Sincos(omg12a)
+			comg12 = -comg12
+			salp1 = cbet2 * somg12
+			calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+		}
+	}
+	if !(sig12 >= 0) {
+		salp1, calp1 = norm(salp1, calp1)
+	}
+	return sig12, salp1, calp1, salp2, calp2, dnm
+}
+
+// lambda12 returns the longitude difference less the target longitude
+// difference for the geodesic leaving the first point with the azimuth
+// given by salp1 and calp1, and the derivative of the difference with
+// respect to the azimuth if diffp is true.
+func (e *Ellipsoid) lambda12(sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam120, clam120 float64, diffp bool, c1a, c2a, c3a []float64) (lam12, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, dlam12 float64) {
+	if sbet1 == 0 && calp1 == 0 {
+		// Break the degeneracy of equatorial lines.
+		calp1 = -tiny
+	}
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+
+	ssig1 = sbet1
+	somg1 := salp0 * sbet1
+	csig1 = calp1 * cbet1
+	comg1 := csig1
+	ssig1, csig1 = norm(ssig1, csig1)
+
+	if cbet2 != cbet1 {
+		salp2 = salp0 / cbet2
+	} else {
+		salp2 = salp1
+	}
+	if cbet2 != cbet1 || math.Abs(sbet2) != -sbet1 {
+		var d float64
+		if cbet1 < -sbet1 {
+			d = (cbet2 - cbet1) * (cbet1 + cbet2)
+		} else {
+			d = (sbet1 - sbet2) * (sbet1 + sbet2)
+		}
+		calp2 = math.Sqrt((calp1*cbet1)*(calp1*cbet1)+d) / cbet2
+	} else {
+		calp2 = math.Abs(calp1)
+	}
+
+	ssig2 = sbet2
+	somg2 := salp0 * sbet2
+	csig2 = calp2 * cbet2
+	comg2 := csig2
+	ssig2, csig2 = norm(ssig2, csig2)
+
+	sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+	somg12 := math.Max(0, comg1*somg2-somg1*comg2)
+	comg12 := comg1*comg2 + somg1*somg2
+	eta := math.Atan2(somg12*clam120-comg12*slam120, comg12*clam120+somg12*slam120)
+
+	k2 := calp0 * calp0 * e.ep2
+	eps = k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+	e.c3f(eps, c3a)
+	b312 := sinCosSeries(true, ssig2, csig2, c3a) - sinCosSeries(true, ssig1, csig1, c3a)
+	lam12 = eta - e.f*e.a3f(eps)*salp0*(sig12+b312)
+
+	if diffp {
+		if calp2 == 0 {
+			dlam12 = -2 * e.f1 * dn1 / sbet1
+		} else {
+			_, dlam12 = e.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a, c2a)
+			dlam12 *= e.f1 / (calp2 * cbet2)
+		}
+	} else {
+		dlam12 = math.NaN()
+	}
+	return lam12, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, dlam12
+}
+
+// a1m1f returns the scale factor A1-1 of the distance integral.
+func a1m1f(eps float64) float64 {
+	coeff := [...]float64{1, 4, 64, 0, 256}
+	const m = order / 2
+	t := polyval(m, coeff[:], eps*eps) / coeff[m+1]
+	return (t + eps) / (1 - eps)
+}
+
+// c1f places the coefficients C1[l] of the distance integral in c[1:].
+func c1f(eps float64, c []float64) {
+	coeff := [...]float64{
+		-1, 6, -16, 32,
+		-9, 64, -128, 2048,
+		9, -16, 768,
+		3, -5, 512,
+		-7, 1280,
+		-7, 2048,
+	}
+	series(eps, coeff[:], c, nC1)
+}
+
+// c1pf places the coefficients C1'[l] of the inverse of the distance
+// integral in c[1:].
+func c1pf(eps float64, c []float64) {
+	coeff := [...]float64{
+		205, -432, 768, 1536,
+		4005, -4736, 3840, 12288,
+		-225, 116, 384,
+		-7173, 2695, 7680,
+		3467, 7680,
+		38081, 61440,
+	}
+	series(eps, coeff[:], c, nC1p)
+}
+
+// a2m1f returns the scale factor A2-1 of the reduced length integral.
+func a2m1f(eps float64) float64 {
+	coeff := [...]float64{-11, -28, -192, 0, 256}
+	const m = order / 2
+	t := polyval(m, coeff[:], eps*eps) / coeff[m+1]
+	return (t - eps) / (1 + eps)
+}
+
+// c2f places the coefficients C2[l] of the reduced length integral in c[1:].
+func c2f(eps float64, c []float64) {
+	coeff := [...]float64{
+		1, 2, 16, 32,
+		35, 64, 384, 2048,
+		15, 80, 768,
+		7, 35, 512,
+		63, 1280,
+		77, 2048,
+	}
+	series(eps, coeff[:], c, nC2)
+}
+
+// series places the values of the n series in eps described by coeff
+// in c[1:n+1]. Each series is a polynomial in eps^2 multiplied by eps^l.
+func series(eps float64, coeff, c []float64, n int) {
+	eps2 := eps * eps
+	d := eps
+	var o int
+	for l := 1; l <= n; l++ {
+		m := (n - l) / 2
+		c[l] = d * polyval(m, coeff[o:], eps2) / coeff[o+m+1]
+		o += m + 2
+		d *= eps
+	}
+}
+
+// a3coeff computes the coefficients of the polynomial in eps of the
+// longitude integral scale factor A3.
+func (e *Ellipsoid) a3coeff() {
+	coeff := [...]float64{
+		-3, 128,
+		-2, -3, 64,
+		-1, -3, -1, 16,
+		3, -1, -2, 8,
+		1, -1, 2,
+		1, 1,
+	}
+	var o, k int
+	for j := nA3 - 1; j >= 0; j-- {
+		m := min(nA3-j-1, j)
+		e.a3x[k] = polyval(m, coeff[o:], e.n) / coeff[o+m+1]
+		k++
+		o += m + 2
+	}
+}
+
+// c3coeff computes the coefficients of the polynomials in eps of the
+// longitude integral coefficients C3[l].
+func (e *Ellipsoid) c3coeff() {
+	coeff := [...]float64{
+		3, 128,
+		2, 5, 128,
+		-1, 3, 3, 64,
+		-1, 0, 1, 8,
+		-1, 1, 4,
+		5, 256,
+		1, 3, 128,
+		-3, -2, 3, 64,
+		1, -3, 2, 32,
+		7, 512,
+		-10, 9, 384,
+		5, -9, 5, 192,
+		7, 512,
+		-14, 7, 512,
+		21, 2560,
+	}
+	var o, k int
+	for l := 1; l < nC3; l++ {
+		for j := nC3 - 1; j >= l; j-- {
+			m := min(nC3-j-1, j)
+			e.c3x[k] = polyval(m, coeff[o:], e.n) / coeff[o+m+1]
+			k++
+			o += m + 2
+		}
+	}
+}
+
+// a3f returns the longitude integral scale factor A3.
+func (e *Ellipsoid) a3f(eps float64) float64 {
+	return polyval(nA3-1, e.a3x[:], eps)
+}
+
+// c3f places the longitude integral coefficients C3[l] in c[1:].
+func (e *Ellipsoid) c3f(eps float64, c []float64) {
+	mult := 1.0
+	var o int
+	for l := 1; l < nC3; l++ {
+		m := nC3 - l - 1
+		mult *= eps
+		c[l] = mult * polyval(m, e.c3x[o:], eps)
+		o += m + 1
+	}
+}
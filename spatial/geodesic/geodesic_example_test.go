@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geodesic_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/spatial/geodesic"
+	"gonum.org/v1/gonum/spatial/vptree"
+)
+
+func ExampleEllipsoid_Inverse() {
+	// Find the distance and initial bearing
+	// from JFK airport to Heathrow airport.
+	s12, azi1, _ := geodesic.WGS84.Inverse(40.64, -73.78, 51.47, -0.46)
+	fmt.Printf("distance: %.3f km\n", s12/1000)
+	fmt.Printf("bearing: %.3f°\n", azi1)
+
+	// Compare with the spherical approximation.
+	d := geodesic.Haversine(40.64, -73.78, 51.47, -0.46, geodesic.EarthRadius)
+	fmt.Printf("haversine distance: %.3f km\n", d/1000)
+
+	// Output:
+	// distance: 5554.748 km
+	// bearing: 51.382°
+	// haversine distance: 5539.859 km
+}
+
+// city is a vptree.Comparable geographic location.
+type city struct {
+	name     string
+	lat, lon float64
+}
+
+// Distance returns the geodesic distance between the receiver and c
+// on the WGS84 ellipsoid.
+func (p city) Distance(c vptree.Comparable) float64 {
+	q := c.(city)
+	return geodesic.WGS84.Distance(p.lat, p.lon, q.lat, q.lon)
+}
+
+func Example_nearestCity() {
+	cities := []vptree.Comparable{
+		city{name: "Auckland", lat: -36.85, lon: 174.76},
+		city{name: "Buenos Aires", lat: -34.60, lon: -58.38},
+		city{name: "Cairo", lat: 30.04, lon: 31.24},
+		city{name: "Honolulu", lat: 21.31, lon: -157.86},
+		city{name: "London", lat: 51.51, lon: -0.13},
+		city{name: "Nairobi", lat: -1.29, lon: 36.82},
+		city{name: "Reykjavík", lat: 64.15, lon: -21.94},
+		city{name: "Singapore", lat: 1.35, lon: 103.82},
+		city{name: "Tokyo", lat: 35.68, lon: 139.69},
+		city{name: "Vancouver", lat: 49.28, lon: -123.12},
+	}
+	t, err := vptree.New(cities, 3, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Find the three cities closest to a point
+	// in the North Pacific.
+	keep := vptree.NewNKeeper(3)
+	t.NearestSet(keep, city{lat: 40, lon: -170})
+	for _, c := range keep.Heap {
+		fmt.Printf("%s: %.0f km\n", c.Comparable.(city).name, c.Dist/1000)
+	}
+
+	// Output:
+	// Honolulu: 2371 km
+	// Vancouver: 3793 km
+	// Tokyo: 4396 km
+}
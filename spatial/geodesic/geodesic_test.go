@@ -0,0 +1,224 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geodesic
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// Reference values from GeographicLib and Karney, "Algorithms for
+// geodesics", J. Geod. 87:43-55, 2013.
+var inverseTests = []struct {
+	name                   string
+	lat1, lon1, lat2, lon2 float64
+
+	s12, azi1, azi2 float64
+}{
+	{
+		name: "JFK-LHR",
+		lat1: 40.6, lon1: -73.8, lat2: 51.6, lon2: -0.5,
+		s12: 5551759.400319, azi1: 51.198882845579824, azi2: 107.821776735514248,
+	},
+	{
+		name: "nearly antipodal",
+		lat1: -30, lon1: 0, lat2: 29.9, lon2: 179.8,
+		s12: 19989832.827610, azi1: 161.890524736, azi2: 18.090737246,
+	},
+	{
+		name: "quarter meridian",
+		lat1: 0, lon1: 0, lat2: 90, lon2: 0,
+		s12: 10001965.7293127228, azi1: 0, azi2: 0,
+	},
+	{
+		name: "quarter equator",
+		lat1: 0, lon1: 0, lat2: 0, lon2: 90,
+		s12: 6378137 * math.Pi / 2, azi1: 90, azi2: 90,
+	},
+	{
+		name: "antipodal equatorial",
+		lat1: 0, lon1: 0, lat2: 0, lon2: 180,
+		s12: 2 * 10001965.7293127228, azi1: 0, azi2: 180,
+	},
+	{
+		name: "coincident",
+		lat1: 12, lon1: 34, lat2: 12, lon2: 34,
+		s12: 0, azi1: 180, azi2: 180,
+	},
+}
+
+func TestInverse(t *testing.T) {
+	const (
+		distTol = 1e-6
+		aziTol  = 1e-9
+	)
+	for _, test := range inverseTests {
+		s12, azi1, azi2 := WGS84.Inverse(test.lat1, test.lon1, test.lat2, test.lon2)
+		if !scalar.EqualWithinAbs(s12, test.s12, distTol) {
+			t.Errorf("unexpected distance for %s: got:%.6f want:%.6f", test.name, s12, test.s12)
+		}
+		if test.s12 == 0 {
+			continue
+		}
+		if !scalar.EqualWithinAbs(azi1, test.azi1, aziTol) || !scalar.EqualWithinAbs(azi2, test.azi2, aziTol) {
+			t.Errorf("unexpected azimuths for %s: got:(%v, %v) want:(%v, %v)",
+				test.name, azi1, azi2, test.azi1, test.azi2)
+		}
+
+		// The reverse geodesic has the same length and
+		// reversed azimuths.
+		s21, azi1r, azi2r := WGS84.Inverse(test.lat2, test.lon2, test.lat1, test.lon1)
+		if !scalar.EqualWithinAbs(s21, s12, distTol) {
+			t.Errorf("asymmetric distance for %s: got:%.6f want:%.6f", test.name, s21, s12)
+		}
+		if math.Abs(test.lat1) == 90 || math.Abs(test.lat2) == 90 {
+			continue
+		}
+		if !sameAzimuth(azi1r, azi2+180, aziTol) || !sameAzimuth(azi2r, azi1+180, aziTol) {
+			t.Errorf("unexpected reverse azimuths for %s: got:(%v, %v) want:(%v, %v)",
+				test.name, azi1r, azi2r, azi2+180, azi1+180)
+		}
+	}
+}
+
+func TestDirect(t *testing.T) {
+	const tol = 1e-9
+	for _, test := range inverseTests {
+		if test.s12 == 0 || math.Abs(test.lat2) == 90 {
+			continue
+		}
+		lat2, lon2, azi2 := WGS84.Direct(test.lat1, test.lon1, test.azi1, test.s12)
+		if !scalar.EqualWithinAbs(lat2, test.lat2, tol) || !sameAzimuth(lon2, test.lon2, tol) || !sameAzimuth(azi2, test.azi2, tol) {
+			t.Errorf("unexpected direct solution for %s: got:(%v, %v, %v) want:(%v, %v, %v)",
+				test.name, lat2, lon2, azi2, test.lat2, test.lon2, test.azi2)
+		}
+	}
+
+	lat2, lon2, azi2 := WGS84.Direct(40.63972222, -73.77888889, 53.5, 5850e3)
+	wantLat, wantLon, wantAzi := 49.01467, 2.56106, 111.62947
+	if !scalar.EqualWithinAbs(lat2, wantLat, 1e-5) || !scalar.EqualWithinAbs(lon2, wantLon, 1e-5) || !scalar.EqualWithinAbs(azi2, wantAzi, 1e-5) {
+		t.Errorf("unexpected direct solution: got:(%v, %v, %v) want:(%v, %v, %v)",
+			lat2, lon2, azi2, wantLat, wantLon, wantAzi)
+	}
+}
+
+func TestDirectInverseRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, e := range []*Ellipsoid{WGS84, NewEllipsoid(1, 0), NewEllipsoid(6378137, 1.0/150)} {
+		for i := 0; i < 2000; i++ {
+			lat1 := 180*rnd.Float64() - 90
+			lon1 := 360*rnd.Float64() - 180
+			lat2 := 180*rnd.Float64() - 90
+			lon2 := 360*rnd.Float64() - 180
+			if i%10 == 0 {
+				// Nearly antipodal points.
+				lat2 = -lat1 + rnd.Float64() - 0.5
+				lon2 = lon1 + 180 + rnd.Float64() - 0.5
+			}
+			s12, azi1, azi2 := e.Inverse(lat1, lon1, lat2, lon2)
+			if s12 < 0 || s12 > math.Pi*e.Radius() {
+				t.Errorf("distance out of range for (%v, %v)-(%v, %v): %v", lat1, lon1, lat2, lon2, s12)
+			}
+			gotLat, gotLon, gotAzi := e.Direct(lat1, lon1, azi1, s12)
+			tol := 1e-12 * e.Radius()
+			if !scalar.EqualWithinAbs(gotLat, lat2, 1e-9) || !sameAzimuth(gotLon, lon2, 1e-9) {
+				t.Errorf("round trip mismatch for (%v, %v)-(%v, %v): got:(%v, %v)",
+					lat1, lon1, lat2, lon2, gotLat, gotLon)
+			}
+			if math.Abs(lat2) < 89.9 && !sameAzimuth(gotAzi, azi2, 1e-7) {
+				t.Errorf("azimuth mismatch for (%v, %v)-(%v, %v): got:%v want:%v",
+					lat1, lon1, lat2, lon2, gotAzi, azi2)
+			}
+
+			// The triangle inequality holds via the
+			// midpoint of the geodesic.
+			midLat, midLon, _ := e.Direct(lat1, lon1, azi1, s12/2)
+			s1m := e.Distance(lat1, lon1, midLat, midLon)
+			sm2 := e.Distance(midLat, midLon, lat2, lon2)
+			if !scalar.EqualWithinAbs(s1m+sm2, s12, tol) {
+				t.Errorf("geodesic not shortest for (%v, %v)-(%v, %v): got:%v want:%v",
+					lat1, lon1, lat2, lon2, s1m+sm2, s12)
+			}
+		}
+	}
+}
+
+func TestSphere(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const r = 6371e3
+	sphere := NewEllipsoid(r, 0)
+	for i := 0; i < 1000; i++ {
+		lat1 := 180*rnd.Float64() - 90
+		lon1 := 360*rnd.Float64() - 180
+		lat2 := 180*rnd.Float64() - 90
+		lon2 := 360*rnd.Float64() - 180
+		s12, azi1, _ := sphere.Inverse(lat1, lon1, lat2, lon2)
+		d := Haversine(lat1, lon1, lat2, lon2, r)
+		if !scalar.EqualWithinAbs(d, s12, 1e-3) {
+			t.Errorf("unexpected haversine distance for (%v, %v)-(%v, %v): got:%v want:%v",
+				lat1, lon1, lat2, lon2, d, s12)
+		}
+		if b := Bearing(lat1, lon1, lat2, lon2); !sameAzimuth(b, azi1, 1e-6) {
+			t.Errorf("unexpected bearing for (%v, %v)-(%v, %v): got:%v want:%v",
+				lat1, lon1, lat2, lon2, b, azi1)
+		}
+		gotLat, gotLon := Destination(lat1, lon1, azi1, s12, r)
+		wantLat, wantLon, _ := sphere.Direct(lat1, lon1, azi1, s12)
+		if !scalar.EqualWithinAbs(gotLat, wantLat, 1e-6) || !sameAzimuth(gotLon, wantLon, 1e-6) {
+			t.Errorf("unexpected destination from (%v, %v): got:(%v, %v) want:(%v, %v)",
+				lat1, lon1, gotLat, gotLon, wantLat, wantLon)
+		}
+	}
+}
+
+func TestHaversine(t *testing.T) {
+	for _, test := range []struct {
+		lat1, lon1, lat2, lon2 float64
+		want                   float64
+	}{
+		{lat1: 0, lon1: 0, lat2: 0, lon2: 90, want: math.Pi / 2},
+		{lat1: 0, lon1: 0, lat2: 90, lon2: 45, want: math.Pi / 2},
+		{lat1: -90, lon1: 0, lat2: 90, lon2: 0, want: math.Pi},
+		{lat1: 10, lon1: 170, lat2: -10, lon2: -10, want: math.Pi},
+		{lat1: 0, lon1: 179, lat2: 0, lon2: -179, want: math.Pi / 90},
+		{lat1: 51.5, lon1: -0.1, lat2: 51.5, lon2: -0.1, want: 0},
+	} {
+		got := Haversine(test.lat1, test.lon1, test.lat2, test.lon2, 1)
+		if !scalar.EqualWithinAbs(got, test.want, 1e-14) {
+			t.Errorf("unexpected distance for (%v, %v)-(%v, %v): got:%v want:%v",
+				test.lat1, test.lon1, test.lat2, test.lon2, got, test.want)
+		}
+	}
+}
+
+func TestNewEllipsoidPanics(t *testing.T) {
+	for _, test := range []struct{ a, f float64 }{
+		{a: 0, f: 0},
+		{a: -1, f: 0},
+		{a: math.Inf(1), f: 0},
+		{a: math.NaN(), f: 0},
+		{a: 1, f: -0.1},
+		{a: 1, f: 1},
+		{a: 1, f: math.NaN()},
+	} {
+		panicked := func() (panicked bool) {
+			defer func() { panicked = recover() != nil }()
+			NewEllipsoid(test.a, test.f)
+			return false
+		}()
+		if !panicked {
+			t.Errorf("expected panic for a=%v f=%v", test.a, test.f)
+		}
+	}
+}
+
+// sameAzimuth returns whether the angles a and b in degrees are equal
+// within tol modulo 360.
+func sameAzimuth(a, b, tol float64) bool {
+	return math.Abs(math.Remainder(a-b, 360)) <= tol
+}
@@ -0,0 +1,58 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geodesic
+
+import "math"
+
+// EarthRadius is the mean radius of the Earth in metres,
+// the IUGG mean radius of the WGS84 ellipsoid.
+const EarthRadius = 6371008.8
+
+// Haversine returns the great circle distance between the points at lat1,
+// lon1 and lat2, lon2 on a sphere of radius r, calculated by the haversine
+// formula.
+func Haversine(lat1, lon1, lat2, lon2, r float64) float64 {
+	return r * centralAngle(lat1, lon1, lat2, lon2)
+}
+
+// centralAngle returns the angle in radians subtended at the centre of a
+// sphere by the points at lat1, lon1 and lat2, lon2.
+func centralAngle(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1 := lat1 * math.Pi / 180
+	phi2 := lat2 * math.Pi / 180
+	dphi := phi2 - phi1
+	dlam := (lon2 - lon1) * math.Pi / 180
+	h := hav(dphi) + math.Cos(phi1)*math.Cos(phi2)*hav(dlam)
+	return 2 * math.Asin(math.Sqrt(math.Min(1, h)))
+}
+
+// hav returns the haversine of theta.
+func hav(theta float64) float64 {
+	s := math.Sin(theta / 2)
+	return s * s
+}
+
+// Bearing returns the initial azimuth of the great circle path from the
+// point at lat1, lon1 to the point at lat2, lon2 on a sphere. The returned
+// azimuth is in (-180, 180].
+func Bearing(lat1, lon1, lat2, lon2 float64) float64 {
+	sphi1, cphi1 := sincosd(lat1)
+	sphi2, cphi2 := sincosd(lat2)
+	slam, clam := sincosd(lon2 - lon1)
+	return atan2d(slam*cphi2, cphi1*sphi2-sphi1*cphi2*clam)
+}
+
+// Destination returns the point reached by following the great circle from
+// the point at lat1, lon1 with the initial azimuth azi1 for the distance d
+// on a sphere of radius r. The returned longitude is in [-180, 180].
+func Destination(lat1, lon1, azi1, d, r float64) (lat2, lon2 float64) {
+	sphi1, cphi1 := sincosd(lat1)
+	salp, calp := sincosd(azi1)
+	sdel, cdel := math.Sincos(d / r)
+	sphi2 := sphi1*cdel + cphi1*sdel*calp
+	lat2 = math.Asin(math.Max(-1, math.Min(1, sphi2))) * 180 / math.Pi
+	dlam := math.Atan2(salp*sdel*cphi1, cdel-sphi1*sphi2) * 180 / math.Pi
+	return lat2, angNormalize(lon1 + dlam)
+}
@@ -0,0 +1,12 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hnsw implements approximate nearest neighbor search over vectors
+// using hierarchical navigable small world graphs.
+//
+// Exact search structures such as the k-d tree degrade to linear scans in
+// high dimensions. A hierarchical navigable small world graph trades exactness
+// for search times that grow approximately logarithmically with the number of
+// indexed vectors, with recall controlled by the search effort.
+package hnsw // import "gonum.org/v1/gonum/spatial/hnsw"
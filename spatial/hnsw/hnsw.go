@@ -0,0 +1,343 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hnsw
+
+import (
+	"container/heap"
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// Metric is a distance metric between vectors.
+type Metric int
+
+const (
+	// Euclidean is the Euclidean distance.
+	Euclidean Metric = iota
+
+	// Cosine is the cosine distance, one minus
+	// the cosine of the angle between vectors.
+	Cosine
+)
+
+// Index is a hierarchical navigable small world graph index of vectors, as
+// described in Malkov and Yashunin, "Efficient and robust approximate nearest
+// neighbor search using Hierarchical Navigable Small World graphs", IEEE
+// Trans. Pattern Anal. Mach. Intell. 42:824-836, 2020,
+// doi:10.1109/TPAMI.2018.2889473.
+//
+// Search may be called concurrently, but Add must not be called concurrently
+// with Add or Search.
+type Index struct {
+	dim    int
+	metric Metric
+
+	// m is the maximum number of links per
+	// node above layer zero and mMax0 is the
+	// maximum at layer zero.
+	m, mMax0 int
+
+	efConstruction int
+
+	// levelMult is the normalization
+	// factor of the level distribution.
+	levelMult float64
+	uniform   func() float64
+
+	nodes []node
+	entry int
+	top   int
+}
+
+// node is an indexed vector and its links at each layer.
+type node struct {
+	vec   []float64
+	links [][]int
+}
+
+// Neighbor is an indexed vector and its distance from a query.
+type Neighbor struct {
+	// ID is the identifier of the
+	// vector returned by Add.
+	ID int
+
+	// Dist is the distance from
+	// the query to the vector.
+	Dist float64
+}
+
+// New returns an empty index of vectors with the given dimension using the
+// provided distance metric. The m parameter is the number of links made by
+// each added vector at each layer of the graph, with up to 2m links at the
+// bottom layer, and efConstruction is the number of candidate neighbors
+// considered when adding a vector. Larger values improve recall at the cost
+// of memory and time. Values of m between 8 and 48 and of efConstruction
+// between 100 and 400 are typical.
+//
+// The src parameter provides the source of randomness for the assignment of
+// vectors to layers. If src is nil, global rand package functions are used.
+// New will panic if dim is less than one, m is less than two or
+// efConstruction is less than one.
+func New(dim int, metric Metric, m, efConstruction int, src rand.Source) *Index {
+	if dim < 1 {
+		panic("hnsw: invalid dimension")
+	}
+	if m < 2 || efConstruction < 1 {
+		panic("hnsw: invalid parameter")
+	}
+	if metric != Euclidean && metric != Cosine {
+		panic("hnsw: unknown metric")
+	}
+	uniform := rand.Float64
+	if src != nil {
+		uniform = rand.New(src).Float64
+	}
+	return &Index{
+		dim:            dim,
+		metric:         metric,
+		m:              m,
+		mMax0:          2 * m,
+		efConstruction: efConstruction,
+		levelMult:      1 / math.Log(float64(m)),
+		uniform:        uniform,
+		entry:          -1,
+	}
+}
+
+// Len returns the number of vectors in the index.
+func (idx *Index) Len() int { return len(idx.nodes) }
+
+// Vector returns the vector with the given ID. For the Cosine metric the
+// returned vector is normalized to unit length. The returned slice must
+// not be modified.
+func (idx *Index) Vector(id int) []float64 { return idx.nodes[id].vec }
+
+// Add adds a copy of the vector v to the index and returns its ID. IDs are
+// assigned consecutively from zero. Add will panic if the length of v is not
+// the dimension of the index, or if v is the zero vector and the index uses
+// the Cosine metric.
+func (idx *Index) Add(v []float64) int {
+	q := idx.prepare(v)
+	id := len(idx.nodes)
+	level := int(-math.Log(1-idx.uniform()) * idx.levelMult)
+	idx.nodes = append(idx.nodes, node{vec: q, links: make([][]int, level+1)})
+	if idx.entry < 0 {
+		idx.entry, idx.top = id, level
+		return id
+	}
+
+	ep := []Neighbor{{ID: idx.entry, Dist: idx.dist(q, idx.entry)}}
+	for l := idx.top; l > level; l-- {
+		ep = idx.searchLayer(q, ep, 1, l)
+	}
+	for l := min(level, idx.top); l >= 0; l-- {
+		candidates := idx.searchLayer(q, ep, idx.efConstruction, l)
+		mMax := idx.m
+		if l == 0 {
+			mMax = idx.mMax0
+		}
+		neighbors := idx.selectNeighbors(candidates, idx.m)
+		idx.nodes[id].links[l] = ids(neighbors)
+		for _, nb := range neighbors {
+			links := append(idx.nodes[nb.ID].links[l], id)
+			if len(links) > mMax {
+				links = idx.shrink(nb.ID, links, mMax)
+			}
+			idx.nodes[nb.ID].links[l] = links
+		}
+		ep = candidates
+	}
+	if level > idx.top {
+		idx.entry, idx.top = id, level
+	}
+	return id
+}
+
+// Search returns the approximate k nearest vectors to q in order of
+// increasing distance. The ef parameter is the number of candidates
+// retained during the search; larger values improve recall at the cost of
+// search time. If ef is less than k, k candidates are retained. Search will
+// panic if the length of q is not the dimension of the index, or if q is the
+// zero vector and the index uses the Cosine metric.
+func (idx *Index) Search(q []float64, k, ef int) []Neighbor {
+	q = idx.prepare(q)
+	if k <= 0 || idx.entry < 0 {
+		return nil
+	}
+	ep := []Neighbor{{ID: idx.entry, Dist: idx.dist(q, idx.entry)}}
+	for l := idx.top; l > 0; l-- {
+		ep = idx.searchLayer(q, ep, 1, l)
+	}
+	res := idx.searchLayer(q, ep, max(ef, k), 0)
+	if len(res) > k {
+		res = res[:k]
+	}
+	if idx.metric == Euclidean {
+		for i := range res {
+			res[i].Dist = math.Sqrt(res[i].Dist)
+		}
+	}
+	return res
+}
+
+// prepare checks the dimension of v and returns a copy
+// normalized as required by the metric of the index.
+func (idx *Index) prepare(v []float64) []float64 {
+	if len(v) != idx.dim {
+		panic("hnsw: vector dimension mismatch")
+	}
+	q := make([]float64, len(v))
+	copy(q, v)
+	if idx.metric == Cosine {
+		n := floats.Norm(q, 2)
+		if n == 0 {
+			panic("hnsw: zero vector with cosine metric")
+		}
+		floats.Scale(1/n, q)
+	}
+	return q
+}
+
+// dist returns the distance between q and the indexed vector id. The
+// Euclidean distance is squared to avoid computing square roots during
+// graph traversal.
+func (idx *Index) dist(q []float64, id int) float64 {
+	return idx.distVec(q, idx.nodes[id].vec)
+}
+
+func (idx *Index) distVec(a, b []float64) float64 {
+	if idx.metric == Cosine {
+		return 1 - floats.Dot(a, b)
+	}
+	var d float64
+	for i, v := range a {
+		diff := v - b[i]
+		d += diff * diff
+	}
+	return d
+}
+
+// searchLayer returns the ef nearest vectors to q found by a best-first
+// search of layer l starting from the entry points ep, in order of
+// increasing distance.
+func (idx *Index) searchLayer(q []float64, ep []Neighbor, ef, l int) []Neighbor {
+	visited := make(map[int]bool, ef*idx.mMax0)
+	candidates := make(nearestFirst, 0, ef)
+	results := make(furthestFirst, 0, ef+1)
+	for _, e := range ep {
+		visited[e.ID] = true
+		heap.Push(&candidates, e)
+		heap.Push(&results, e)
+		if len(results) > ef {
+			heap.Pop(&results)
+		}
+	}
+	for len(candidates) != 0 {
+		c := heap.Pop(&candidates).(Neighbor)
+		if len(results) == ef && c.Dist > results[0].Dist {
+			break
+		}
+		for _, nb := range idx.nodes[c.ID].links[l] {
+			if visited[nb] {
+				continue
+			}
+			visited[nb] = true
+			d := idx.dist(q, nb)
+			if len(results) < ef || d < results[0].Dist {
+				n := Neighbor{ID: nb, Dist: d}
+				heap.Push(&candidates, n)
+				heap.Push(&results, n)
+				if len(results) > ef {
+					heap.Pop(&results)
+				}
+			}
+		}
+	}
+	res := []Neighbor(results)
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Dist != res[j].Dist {
+			return res[i].Dist < res[j].Dist
+		}
+		return res[i].ID < res[j].ID
+	})
+	return res
+}
+
+// selectNeighbors returns up to m of the candidates, which must be sorted by
+// increasing distance, chosen by the neighbor selection heuristic. A candidate
+// is selected if it is closer to the query than to any already selected
+// candidate, which keeps links to distinct regions of the graph.
+func (idx *Index) selectNeighbors(candidates []Neighbor, m int) []Neighbor {
+	selected := make([]Neighbor, 0, m)
+	for _, c := range candidates {
+		if len(selected) == m {
+			break
+		}
+		keep := true
+		for _, s := range selected {
+			if idx.distVec(idx.nodes[c.ID].vec, idx.nodes[s.ID].vec) < c.Dist {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			selected = append(selected, c)
+		}
+	}
+	return selected
+}
+
+// shrink returns at most mMax of the links of node id chosen by the neighbor
+// selection heuristic.
+func (idx *Index) shrink(id int, links []int, mMax int) []int {
+	v := idx.nodes[id].vec
+	candidates := make([]Neighbor, len(links))
+	for i, nb := range links {
+		candidates[i] = Neighbor{ID: nb, Dist: idx.dist(v, nb)}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Dist < candidates[j].Dist })
+	return ids(idx.selectNeighbors(candidates, mMax))
+}
+
+func ids(n []Neighbor) []int {
+	id := make([]int, len(n))
+	for i, v := range n {
+		id[i] = v.ID
+	}
+	return id
+}
+
+// nearestFirst is a priority queue of neighbors
+// with the nearest neighbor at the top.
+type nearestFirst []Neighbor
+
+func (q nearestFirst) Len() int            { return len(q) }
+func (q nearestFirst) Less(i, j int) bool  { return q[i].Dist < q[j].Dist }
+func (q nearestFirst) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *nearestFirst) Push(x interface{}) { *q = append(*q, x.(Neighbor)) }
+func (q *nearestFirst) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
+
+// furthestFirst is a priority queue of neighbors
+// with the furthest neighbor at the top.
+type furthestFirst []Neighbor
+
+func (q furthestFirst) Len() int            { return len(q) }
+func (q furthestFirst) Less(i, j int) bool  { return q[i].Dist > q[j].Dist }
+func (q furthestFirst) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *furthestFirst) Push(x interface{}) { *q = append(*q, x.(Neighbor)) }
+func (q *furthestFirst) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
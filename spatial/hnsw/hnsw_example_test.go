@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hnsw_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/spatial/hnsw"
+)
+
+func Example() {
+	words := []string{"cat", "dog", "car", "bus"}
+	vecs := [][]float64{
+		{0.9, 0.8, 0.1},
+		{0.8, 0.9, 0.2},
+		{0.1, 0.2, 0.9},
+		{0.2, 0.1, 0.8},
+	}
+	idx := hnsw.New(3, hnsw.Cosine, 8, 64, rand.NewPCG(1, 1))
+	for _, v := range vecs {
+		idx.Add(v)
+	}
+
+	// Find the two words nearest to a query.
+	for _, n := range idx.Search([]float64{0.15, 0.2, 1}, 2, 16) {
+		fmt.Printf("%s %.4f\n", words[n.ID], n.Dist)
+	}
+
+	// Output:
+	// car 0.0009
+	// bus 0.0073
+}
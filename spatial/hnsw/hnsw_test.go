@@ -0,0 +1,177 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hnsw
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func randomVectors(n, dim int, rnd *rand.Rand) [][]float64 {
+	v := make([][]float64, n)
+	for i := range v {
+		v[i] = make([]float64, dim)
+		for j := range v[i] {
+			v[i][j] = rnd.NormFloat64()
+		}
+	}
+	return v
+}
+
+// bruteForce returns the k nearest vectors to q by exhaustive search.
+func bruteForce(vecs [][]float64, q []float64, k int, metric Metric) []Neighbor {
+	n := make([]Neighbor, len(vecs))
+	for i, v := range vecs {
+		var d float64
+		switch metric {
+		case Euclidean:
+			d = floats.Distance(v, q, 2)
+		case Cosine:
+			d = 1 - floats.Dot(v, q)/(floats.Norm(v, 2)*floats.Norm(q, 2))
+		}
+		n[i] = Neighbor{ID: i, Dist: d}
+	}
+	sort.Slice(n, func(i, j int) bool { return n[i].Dist < n[j].Dist })
+	return n[:k]
+}
+
+func TestRecall(t *testing.T) {
+	const (
+		n       = 3000
+		dim     = 24
+		queries = 100
+		k       = 10
+	)
+	for _, metric := range []Metric{Euclidean, Cosine} {
+		rnd := rand.New(rand.NewPCG(1, 1))
+		vecs := randomVectors(n, dim, rnd)
+		idx := New(dim, metric, 16, 200, rand.NewPCG(1, 2))
+		for i, v := range vecs {
+			if id := idx.Add(v); id != i {
+				t.Fatalf("unexpected ID: got:%d want:%d", id, i)
+			}
+		}
+		if idx.Len() != n {
+			t.Errorf("unexpected length: got:%d want:%d", idx.Len(), n)
+		}
+
+		for _, test := range []struct {
+			ef        int
+			minRecall float64
+		}{
+			{ef: k, minRecall: 0.6},
+			{ef: 100, minRecall: 0.95},
+			{ef: 400, minRecall: 0.99},
+		} {
+			var found int
+			for _, q := range randomVectors(queries, dim, rnd) {
+				got := idx.Search(q, k, test.ef)
+				if len(got) != k {
+					t.Fatalf("unexpected number of results: got:%d want:%d", len(got), k)
+				}
+				for i := 1; i < len(got); i++ {
+					if got[i].Dist < got[i-1].Dist {
+						t.Errorf("results not sorted by distance")
+					}
+				}
+				want := bruteForce(vecs, q, k, metric)
+				inWant := make(map[int]bool)
+				for _, w := range want {
+					inWant[w.ID] = true
+				}
+				for _, g := range got {
+					if inWant[g.ID] {
+						found++
+						if i := indexOf(want, g.ID); math.Abs(want[i].Dist-g.Dist) > 1e-12 {
+							t.Errorf("unexpected distance for %d: got:%v want:%v", g.ID, g.Dist, want[i].Dist)
+						}
+					}
+				}
+			}
+			recall := float64(found) / (queries * k)
+			if recall < test.minRecall {
+				t.Errorf("unexpected recall for metric %d with ef=%d: got:%.3f want>=%.3f", metric, test.ef, recall, test.minRecall)
+			}
+		}
+	}
+}
+
+func indexOf(n []Neighbor, id int) int {
+	for i, v := range n {
+		if v.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestSmall(t *testing.T) {
+	// With ef at least the number of vectors, the
+	// search of a small index is exact.
+	rnd := rand.New(rand.NewPCG(1, 3))
+	vecs := randomVectors(40, 3, rnd)
+	idx := New(3, Euclidean, 4, 50, rand.NewPCG(1, 4))
+	if got := idx.Search(vecs[0], 5, 10); got != nil {
+		t.Errorf("unexpected result for empty index: %v", got)
+	}
+	for _, v := range vecs {
+		idx.Add(v)
+	}
+	for _, q := range randomVectors(20, 3, rnd) {
+		got := idx.Search(q, 40, 40)
+		want := bruteForce(vecs, q, 40, Euclidean)
+		if !reflect.DeepEqual(ids(got), ids(want)) {
+			t.Errorf("unexpected exhaustive search result: got:%v want:%v", ids(got), ids(want))
+		}
+	}
+	if got := idx.Search(vecs[7], 1, 1); got[0].ID != 7 || got[0].Dist != 0 {
+		t.Errorf("unexpected nearest vector to indexed vector: got:%v", got)
+	}
+}
+
+func TestDeterministic(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 5))
+	vecs := randomVectors(500, 8, rnd)
+	q := randomVectors(1, 8, rnd)[0]
+	var results [2][]Neighbor
+	for i := range results {
+		idx := New(8, Cosine, 8, 32, rand.NewPCG(1, 6))
+		for _, v := range vecs {
+			idx.Add(v)
+		}
+		results[i] = idx.Search(q, 10, 20)
+	}
+	if !reflect.DeepEqual(results[0], results[1]) {
+		t.Errorf("search results differ with the same source:\n%v\n%v", results[0], results[1])
+	}
+}
+
+func TestPanics(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "dimension", fn: func() { New(0, Euclidean, 16, 100, nil) }},
+		{name: "m", fn: func() { New(2, Euclidean, 1, 100, nil) }},
+		{name: "ef", fn: func() { New(2, Euclidean, 16, 0, nil) }},
+		{name: "metric", fn: func() { New(2, Metric(5), 16, 100, nil) }},
+		{name: "mismatch", fn: func() { New(2, Euclidean, 16, 100, nil).Add([]float64{1}) }},
+		{name: "zero", fn: func() { New(2, Cosine, 16, 100, nil).Add([]float64{0, 0}) }},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %s", test.name)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
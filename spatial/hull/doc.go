@@ -0,0 +1,9 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hull provides convex hulls of points in two and three dimensions.
+//
+// The hulls are constructed using exact orientation predicates, so the
+// combinatorial structure of a hull is correct for all finite input.
+package hull // import "gonum.org/v1/gonum/spatial/hull"
@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hull
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/spatial/internal/predicate"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// Hull2 is the convex hull of a set of points in the plane.
+type Hull2 struct {
+	// Points holds the points of the set.
+	Points []r2.Vec
+
+	// Vertices holds the indices into Points of
+	// the vertices of the hull in counter-clockwise
+	// order, starting from the vertex with the least
+	// X coordinate, and least Y coordinate among
+	// those. Points lying on the interior of hull
+	// edges are not included. Vertices has fewer
+	// than three elements if the points are all
+	// collinear.
+	Vertices []int
+}
+
+// New2 returns the convex hull of the given points. The points are retained
+// by the returned Hull2 and must not be altered. When a point is repeated, the
+// index of its first occurrence is used. New2 will panic if any point has a
+// non-finite coordinate.
+//
+// The hull is constructed by Andrew's monotone chain algorithm, described in
+// Andrew, "Another efficient algorithm for convex hulls in two dimensions",
+// Inf. Process. Lett. 9:216-219, 1979, doi:10.1016/0020-0190(79)90072-3.
+// The time complexity of New2 is O(n log n) in the number of points.
+func New2(points []r2.Vec) *Hull2 {
+	order := make([]int, 0, len(points))
+	for i, p := range points {
+		if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) {
+			panic("hull: non-finite point")
+		}
+		order = append(order, i)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := points[order[i]], points[order[j]]
+		if a.X != b.X {
+			return a.X < b.X
+		}
+		return a.Y < b.Y
+	})
+	// Remove duplicates, keeping the first occurrence.
+	n := 0
+	for _, i := range order {
+		if n != 0 && points[order[n-1]] == points[i] {
+			continue
+		}
+		order[n] = i
+		n++
+	}
+	order = order[:n]
+	if len(order) < 3 {
+		return &Hull2{Points: points, Vertices: order}
+	}
+
+	// Build the lower hull from left to right
+	// and then the upper hull from right to left.
+	h := make([]int, 0, 2*len(order))
+	for _, i := range order {
+		h = popRight(h, points, points[i], 1)
+		h = append(h, i)
+	}
+	lower := len(h)
+	for k := len(order) - 2; k >= 0; k-- {
+		i := order[k]
+		h = popRight(h, points, points[i], lower)
+		h = append(h, i)
+	}
+	// The last point is the first.
+	h = h[:len(h)-1]
+	return &Hull2{Points: points, Vertices: h}
+}
+
+// popRight removes vertices from the end of the chain h while the last two
+// vertices do not make a strict left turn towards p, retaining at least keep
+// vertices.
+func popRight(h []int, points []r2.Vec, p r2.Vec, keep int) []int {
+	for len(h) > keep && predicate.Orient2D(points[h[len(h)-2]], points[h[len(h)-1]], p) <= 0 {
+		h = h[:len(h)-1]
+	}
+	return h
+}
+
+// Area returns the area of the hull.
+func (h *Hull2) Area() float64 {
+	if len(h.Vertices) < 3 {
+		return 0
+	}
+	o := h.Points[h.Vertices[0]]
+	var a float64
+	for k := 1; k < len(h.Vertices)-1; k++ {
+		u := r2.Sub(h.Points[h.Vertices[k]], o)
+		v := r2.Sub(h.Points[h.Vertices[k+1]], o)
+		a += r2.Cross(u, v)
+	}
+	return a / 2
+}
+
+// Perimeter returns the perimeter of the hull. The perimeter of
+// a hull of collinear points is twice the length of the segment
+// they span.
+func (h *Hull2) Perimeter() float64 {
+	if len(h.Vertices) < 2 {
+		return 0
+	}
+	var l float64
+	for k, i := range h.Vertices {
+		j := h.Vertices[(k+1)%len(h.Vertices)]
+		l += r2.Norm(r2.Sub(h.Points[j], h.Points[i]))
+	}
+	return l
+}
+
+// Contains returns whether p is inside or on the boundary of the hull.
+// The time complexity of Contains is O(log n) in the number of hull
+// vertices.
+func (h *Hull2) Contains(p r2.Vec) bool {
+	switch len(h.Vertices) {
+	case 0:
+		return false
+	case 1:
+		return h.Points[h.Vertices[0]] == p
+	case 2:
+		a, b := h.Points[h.Vertices[0]], h.Points[h.Vertices[1]]
+		if predicate.Orient2D(a, b, p) != 0 {
+			return false
+		}
+		// a is lexicographically less than b.
+		if a.X != b.X {
+			return a.X <= p.X && p.X <= b.X
+		}
+		return a.Y <= p.Y && p.Y <= b.Y
+	}
+
+	// Find the wedge from the first vertex
+	// containing p by binary search and test
+	// against the wedge's hull edge.
+	v := h.Vertices
+	o := h.Points[v[0]]
+	if predicate.Orient2D(o, h.Points[v[1]], p) < 0 || predicate.Orient2D(o, h.Points[v[len(v)-1]], p) > 0 {
+		return false
+	}
+	k := sort.Search(len(v)-2, func(k int) bool {
+		return predicate.Orient2D(o, h.Points[v[k+2]], p) <= 0
+	}) + 1
+	return predicate.Orient2D(h.Points[v[k]], h.Points[v[k+1]], p) >= 0
+}
@@ -0,0 +1,154 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hull
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/spatial/internal/predicate"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+var hull2Tests = []struct {
+	name   string
+	points []r2.Vec
+
+	want      []int
+	area      float64
+	perimeter float64
+}{
+	{
+		name: "empty",
+	},
+	{
+		name:   "single",
+		points: []r2.Vec{{X: 1, Y: 2}},
+		want:   []int{0},
+	},
+	{
+		name:   "repeated",
+		points: []r2.Vec{{X: 1, Y: 2}, {X: 1, Y: 2}, {X: 1, Y: 2}},
+		want:   []int{0},
+	},
+	{
+		name:      "segment",
+		points:    []r2.Vec{{X: 2, Y: 2}, {X: 1, Y: 1}, {X: 0, Y: 0}, {X: 3, Y: 3}, {X: 1, Y: 1}},
+		want:      []int{2, 3},
+		perimeter: 6 * math.Sqrt2,
+	},
+	{
+		name: "square",
+		points: []r2.Vec{
+			{X: 0.5, Y: 0.5}, {X: 1, Y: 1}, {X: 0, Y: 1}, {X: 0, Y: 0}, {X: 1, Y: 0},
+			{X: 0.5, Y: 0}, {X: 1, Y: 0.5}, {X: 0, Y: 1}, {X: 0.25, Y: 0.75},
+		},
+		want:      []int{3, 4, 1, 2},
+		area:      1,
+		perimeter: 4,
+	},
+	{
+		name:      "triangle",
+		points:    []r2.Vec{{X: 0, Y: 0}, {X: 0, Y: 3}, {X: 4, Y: 0}},
+		want:      []int{0, 2, 1},
+		area:      6,
+		perimeter: 12,
+	},
+}
+
+func TestNew2(t *testing.T) {
+	const tol = 1e-14
+	for _, test := range hull2Tests {
+		h := New2(test.points)
+		if !reflect.DeepEqual(h.Vertices, test.want) && (len(h.Vertices) != 0 || len(test.want) != 0) {
+			t.Errorf("unexpected hull for %s: got:%v want:%v", test.name, h.Vertices, test.want)
+		}
+		if got := h.Area(); !scalar.EqualWithinAbsOrRel(got, test.area, tol, tol) {
+			t.Errorf("unexpected area for %s: got:%v want:%v", test.name, got, test.area)
+		}
+		if got := h.Perimeter(); !scalar.EqualWithinAbsOrRel(got, test.perimeter, tol, tol) {
+			t.Errorf("unexpected perimeter for %s: got:%v want:%v", test.name, got, test.perimeter)
+		}
+		for _, p := range test.points {
+			if !h.Contains(p) {
+				t.Errorf("%s: hull does not contain %v", test.name, p)
+			}
+		}
+	}
+}
+
+func TestNew2Random(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{3, 10, 100, 1000} {
+		for _, gen := range []func() r2.Vec{
+			func() r2.Vec { return r2.Vec{X: rnd.Float64(), Y: rnd.Float64()} },
+			func() r2.Vec {
+				theta := rnd.Float64() * 2 * math.Pi
+				return r2.Vec{X: math.Cos(theta), Y: math.Sin(theta)}
+			},
+			func() r2.Vec { return r2.Vec{X: float64(rnd.IntN(5)), Y: float64(rnd.IntN(5))} },
+			func() r2.Vec {
+				// Nearly collinear points.
+				x := rnd.Float64()
+				return r2.Vec{X: x, Y: math.Nextafter(0.1*x, math.Inf(2*rnd.IntN(2)-1))}
+			},
+		} {
+			points := make([]r2.Vec, n)
+			for i := range points {
+				points[i] = gen()
+			}
+			h := New2(points)
+			checkHull2(t, h)
+
+			for range 100 {
+				q := r2.Vec{X: rnd.Float64()*3 - 1, Y: rnd.Float64()*3 - 1}
+				if rnd.IntN(4) == 0 {
+					// Points on hull edges.
+					k := rnd.IntN(len(h.Vertices))
+					a, b := points[h.Vertices[k]], points[h.Vertices[(k+1)%len(h.Vertices)]]
+					q = r2.Add(a, r2.Scale(rnd.Float64(), r2.Sub(b, a)))
+				}
+				if got, want := h.Contains(q), contains2(h, q); got != want {
+					t.Errorf("unexpected containment of %v: got:%t want:%t", q, got, want)
+				}
+			}
+		}
+	}
+}
+
+// checkHull2 checks that h is strictly convex and contains all its points.
+func checkHull2(t *testing.T, h *Hull2) {
+	t.Helper()
+	v := h.Vertices
+	if len(v) < 3 {
+		return
+	}
+	for k := range v {
+		a, b, c := h.Points[v[k]], h.Points[v[(k+1)%len(v)]], h.Points[v[(k+2)%len(v)]]
+		if predicate.Orient2D(a, b, c) <= 0 {
+			t.Errorf("hull is not strictly convex at vertex %d", v[(k+1)%len(v)])
+		}
+	}
+	for i, p := range h.Points {
+		if !contains2(h, p) {
+			t.Errorf("point %d is outside the hull", i)
+		}
+	}
+}
+
+// contains2 is a linear time implementation of Hull2.Contains
+// for hulls with at least three vertices.
+func contains2(h *Hull2, p r2.Vec) bool {
+	v := h.Vertices
+	for k := range v {
+		if predicate.Orient2D(h.Points[v[k]], h.Points[v[(k+1)%len(v)]], p) < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,379 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hull
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/spatial/internal/predicate"
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+// ErrDegenerate is returned by New3 when the points
+// are coplanar and so do not have a solid hull.
+var ErrDegenerate = errors.New("hull: points do not span three dimensions")
+
+// Hull3 is the convex hull of a set of points in space.
+type Hull3 struct {
+	// Points holds the points of the set.
+	Points []r3.Vec
+
+	// Faces holds the indices into Points of the
+	// vertices of each triangular face of the hull
+	// in counter-clockwise order when viewed from
+	// outside the hull. Planar facets of the hull
+	// with more than three vertices are split into
+	// triangles.
+	Faces [][3]int
+}
+
+// New3 returns the convex hull of the given points. The points are retained
+// by the returned Hull3 and must not be altered. New3 returns ErrDegenerate
+// if the points are coplanar. New3 will panic if any point has a non-finite
+// coordinate.
+//
+// The hull is constructed by the Quickhull algorithm described in Barber,
+// Dobkin and Huhdanpaa, "The Quickhull algorithm for convex hulls", ACM Trans.
+// Math. Softw. 22:469-483, 1996, doi:10.1145/235815.235821. Points lying on
+// the boundary of the hull may be vertices of the hull faces even if they are
+// not extreme points of the set. The expected time complexity of New3 is
+// O(n log n) in the number of points.
+func New3(points []r3.Vec) (*Hull3, error) {
+	for _, p := range points {
+		if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.IsNaN(p.Z) ||
+			math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) || math.IsInf(p.Z, 0) {
+			panic("hull: non-finite point")
+		}
+	}
+	simplex, ok := initialSimplex(points)
+	if !ok {
+		return nil, ErrDegenerate
+	}
+	q := newQuickhull(points, simplex)
+	for i := 0; i < len(q.faces); i++ {
+		if !q.faces[i].dead && len(q.faces[i].outside) != 0 {
+			q.addPoint(i)
+		}
+	}
+
+	h := &Hull3{Points: points}
+	for _, f := range q.faces {
+		if !f.dead {
+			h.Faces = append(h.Faces, f.v)
+		}
+	}
+	return h, nil
+}
+
+// initialSimplex returns the indices of four points of the set that are not
+// coplanar, chosen to be approximately extreme. It returns false if all the
+// points are coplanar.
+func initialSimplex(points []r3.Vec) (simplex [4]int, ok bool) {
+	if len(points) < 4 {
+		return simplex, false
+	}
+	i0 := 0
+	for i, p := range points {
+		if p.X < points[i0].X {
+			i0 = i
+		}
+	}
+	p0 := points[i0]
+
+	i1 := argmax(points, func(p r3.Vec) float64 { return r3.Norm2(r3.Sub(p, p0)) })
+	p1 := points[i1]
+	if p1 == p0 {
+		return simplex, false
+	}
+
+	i2 := argmax(points, func(p r3.Vec) float64 {
+		return r3.Norm2(r3.Cross(r3.Sub(p1, p0), r3.Sub(p, p0)))
+	})
+	if collinear(p0, p1, points[i2]) {
+		i2 = -1
+		for i, p := range points {
+			if !collinear(p0, p1, p) {
+				i2 = i
+				break
+			}
+		}
+		if i2 < 0 {
+			return simplex, false
+		}
+	}
+	p2 := points[i2]
+
+	i3 := argmax(points, func(p r3.Vec) float64 {
+		return math.Abs(predicate.Orient3D(p0, p1, p2, p))
+	})
+	if predicate.Orient3D(p0, p1, p2, points[i3]) == 0 {
+		return simplex, false
+	}
+	return [4]int{i0, i1, i2, i3}, true
+}
+
+// argmax returns the index of the point maximizing fn.
+func argmax(points []r3.Vec, fn func(r3.Vec) float64) int {
+	arg, val := 0, math.Inf(-1)
+	for i, p := range points {
+		if v := fn(p); v > val {
+			arg, val = i, v
+		}
+	}
+	return arg
+}
+
+// collinear returns whether the points a, b and c are collinear,
+// determined exactly from their projections onto the coordinate
+// planes.
+func collinear(a, b, c r3.Vec) bool {
+	xy := func(p r3.Vec) r2.Vec { return r2.Vec{X: p.X, Y: p.Y} }
+	yz := func(p r3.Vec) r2.Vec { return r2.Vec{X: p.Y, Y: p.Z} }
+	zx := func(p r3.Vec) r2.Vec { return r2.Vec{X: p.Z, Y: p.X} }
+	return predicate.Orient2D(xy(a), xy(b), xy(c)) == 0 &&
+		predicate.Orient2D(yz(a), yz(b), yz(c)) == 0 &&
+		predicate.Orient2D(zx(a), zx(b), zx(c)) == 0
+}
+
+// quickhull is a convex hull under construction.
+type quickhull struct {
+	points []r3.Vec
+	faces  []face
+
+	// stamp is used to mark the faces
+	// visible from the point being added.
+	stamp int
+
+	visible []int
+	horizon []horizonEdge
+	orphans []int
+	start   map[int]int
+}
+
+// face is a hull face. The vertices in v are in counter-clockwise order
+// viewed from outside, and n[k] is the face sharing the edge from v[k] to
+// v[k+1]. The points outside the hull that are above the face and have not
+// been assigned to another face are held in outside.
+type face struct {
+	v, n    [3]int
+	outside []int
+	dead    bool
+	mark    int
+}
+
+// horizonEdge is an edge between a face visible
+// from a point and a face that is not visible.
+type horizonEdge struct {
+	a, b int
+	// hidden is the face
+	// that is not visible.
+	hidden int
+}
+
+// newQuickhull returns a quickhull holding the tetrahedron of the simplex
+// points, with all other points assigned to the faces they are above.
+func newQuickhull(points []r3.Vec, simplex [4]int) *quickhull {
+	q := &quickhull{points: points, start: make(map[int]int)}
+	for k := range simplex {
+		v := [3]int{simplex[(k+1)%4], simplex[(k+2)%4], simplex[(k+3)%4]}
+		if predicate.Orient3D(points[v[0]], points[v[1]], points[v[2]], points[simplex[k]]) > 0 {
+			v[1], v[2] = v[2], v[1]
+		}
+		q.faces = append(q.faces, face{v: v})
+	}
+	for i := range q.faces {
+		for j := range q.faces {
+			if i == j {
+				continue
+			}
+			for k, a := range q.faces[i].v {
+				b := q.faces[i].v[(k+1)%3]
+				if q.faces[j].hasEdge(b, a) {
+					q.faces[i].n[k] = j
+				}
+			}
+		}
+	}
+
+	inSimplex := func(i int) bool {
+		for _, s := range simplex {
+			if i == s {
+				return true
+			}
+		}
+		return false
+	}
+	for i := range points {
+		if !inSimplex(i) {
+			q.assign(i, []int{0, 1, 2, 3})
+		}
+	}
+	return q
+}
+
+// hasEdge returns whether f has the directed edge from a to b.
+func (f *face) hasEdge(a, b int) bool {
+	for k, u := range f.v {
+		if u == a && f.v[(k+1)%3] == b {
+			return true
+		}
+	}
+	return false
+}
+
+// setNeighbor sets the neighbor of f across its edge from a to b to nb.
+func (f *face) setNeighbor(a, b, nb int) {
+	for k, u := range f.v {
+		if u == a && f.v[(k+1)%3] == b {
+			f.n[k] = nb
+			return
+		}
+	}
+	panic("hull: edge not in face")
+}
+
+// above returns whether the point p is strictly above the face f.
+func (q *quickhull) above(f int, p r3.Vec) bool {
+	v := q.faces[f].v
+	return predicate.Orient3D(q.points[v[0]], q.points[v[1]], q.points[v[2]], p) > 0
+}
+
+// assign adds the point i to the outside set of the first of the faces that
+// it is above. Points that are above none of the faces are discarded.
+func (q *quickhull) assign(i int, faces []int) {
+	for _, f := range faces {
+		if q.above(f, q.points[i]) {
+			q.faces[f].outside = append(q.faces[f].outside, i)
+			return
+		}
+	}
+}
+
+// addPoint adds the furthest point of the outside set of face f to the hull.
+func (q *quickhull) addPoint(f int) {
+	v := q.faces[f].v
+	a, b, c := q.points[v[0]], q.points[v[1]], q.points[v[2]]
+	eye, far := -1, math.Inf(-1)
+	for _, i := range q.faces[f].outside {
+		if d := predicate.Orient3D(a, b, c, q.points[i]); d > far {
+			eye, far = i, d
+		}
+	}
+	p := q.points[eye]
+
+	// Find the faces visible from the eye point
+	// and the horizon edges bounding them.
+	q.stamp++
+	q.visible = append(q.visible[:0], f)
+	q.horizon = q.horizon[:0]
+	q.faces[f].mark = q.stamp
+	for k := 0; k < len(q.visible); k++ {
+		t := q.visible[k]
+		for e, nb := range q.faces[t].n {
+			if q.faces[nb].mark == q.stamp {
+				continue
+			}
+			if q.above(nb, p) {
+				q.faces[nb].mark = q.stamp
+				q.visible = append(q.visible, nb)
+				continue
+			}
+			q.horizon = append(q.horizon, horizonEdge{
+				a:      q.faces[t].v[e],
+				b:      q.faces[t].v[(e+1)%3],
+				hidden: nb,
+			})
+		}
+	}
+
+	q.orphans = q.orphans[:0]
+	for _, t := range q.visible {
+		for _, i := range q.faces[t].outside {
+			if i != eye {
+				q.orphans = append(q.orphans, i)
+			}
+		}
+		q.faces[t].outside = nil
+		q.faces[t].dead = true
+	}
+
+	// Join the horizon to the eye point.
+	clear(q.start)
+	first := len(q.faces)
+	for _, e := range q.horizon {
+		nf := len(q.faces)
+		q.faces = append(q.faces, face{v: [3]int{e.a, e.b, eye}, n: [3]int{e.hidden, -1, -1}})
+		q.faces[e.hidden].setNeighbor(e.b, e.a, nf)
+		q.start[e.a] = nf
+	}
+	for _, e := range q.horizon {
+		t, next := q.start[e.a], q.start[e.b]
+		q.faces[t].n[1] = next
+		q.faces[next].n[2] = t
+	}
+
+	created := make([]int, 0, len(q.horizon))
+	for nf := first; nf < len(q.faces); nf++ {
+		created = append(created, nf)
+	}
+	for _, i := range q.orphans {
+		q.assign(i, created)
+	}
+}
+
+// Vertices returns the indices into Points of the vertices of the hull
+// faces in ascending order.
+func (h *Hull3) Vertices() []int {
+	seen := make(map[int]bool)
+	var v []int
+	for _, f := range h.Faces {
+		for _, i := range f {
+			if !seen[i] {
+				seen[i] = true
+				v = append(v, i)
+			}
+		}
+	}
+	sort.Ints(v)
+	return v
+}
+
+// Area returns the surface area of the hull.
+func (h *Hull3) Area() float64 {
+	var a float64
+	for _, f := range h.Faces {
+		u := r3.Sub(h.Points[f[1]], h.Points[f[0]])
+		v := r3.Sub(h.Points[f[2]], h.Points[f[0]])
+		a += r3.Norm(r3.Cross(u, v))
+	}
+	return a / 2
+}
+
+// Volume returns the volume of the hull.
+func (h *Hull3) Volume() float64 {
+	o := h.Points[h.Faces[0][0]]
+	var vol float64
+	for _, f := range h.Faces {
+		a := r3.Sub(h.Points[f[0]], o)
+		b := r3.Sub(h.Points[f[1]], o)
+		c := r3.Sub(h.Points[f[2]], o)
+		vol += r3.Dot(a, r3.Cross(b, c))
+	}
+	return vol / 6
+}
+
+// Contains returns whether p is inside or on the boundary of the hull.
+// The time complexity of Contains is O(n) in the number of hull faces.
+func (h *Hull3) Contains(p r3.Vec) bool {
+	for _, f := range h.Faces {
+		if predicate.Orient3D(h.Points[f[0]], h.Points[f[1]], h.Points[f[2]], p) > 0 {
+			return false
+		}
+	}
+	return true
+}
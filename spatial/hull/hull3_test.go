@@ -0,0 +1,189 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hull
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/spatial/internal/predicate"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+func cubePoints(n int) []r3.Vec {
+	var p []r3.Vec
+	for i := 0; i <= n; i++ {
+		for j := 0; j <= n; j++ {
+			for k := 0; k <= n; k++ {
+				p = append(p, r3.Vec{X: float64(i) / float64(n), Y: float64(j) / float64(n), Z: float64(k) / float64(n)})
+			}
+		}
+	}
+	return p
+}
+
+func TestNew3(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for _, test := range []struct {
+		name   string
+		points []r3.Vec
+
+		vertices int // Zero if not checked.
+		area     float64
+		volume   float64 // Zero if not checked.
+	}{
+		{
+			name:     "tetrahedron",
+			points:   []r3.Vec{{X: 0, Y: 0, Z: 0}, {X: 1, Y: 0, Z: 0}, {X: 0, Y: 1, Z: 0}, {X: 0, Y: 0, Z: 1}},
+			vertices: 4,
+			area:     1.5 + math.Sqrt(3)/2,
+			volume:   1.0 / 6,
+		},
+		{
+			name:     "cube",
+			points:   cubePoints(1),
+			vertices: 8,
+			area:     6,
+			volume:   1,
+		},
+		{
+			name:   "cube_grid",
+			points: cubePoints(6),
+			area:   6,
+			volume: 1,
+		},
+		{
+			name: "sphere",
+			points: func() []r3.Vec {
+				p := make([]r3.Vec, 500)
+				for i := range p {
+					p[i] = r3.Unit(r3.Vec{X: rnd.NormFloat64(), Y: rnd.NormFloat64(), Z: rnd.NormFloat64()})
+				}
+				return p
+			}(),
+			vertices: 500,
+		},
+		{
+			name: "uniform",
+			points: func() []r3.Vec {
+				p := make([]r3.Vec, 2000)
+				for i := range p {
+					p[i] = r3.Vec{X: rnd.Float64(), Y: rnd.Float64(), Z: rnd.Float64()}
+				}
+				return p
+			}(),
+		},
+		{
+			name: "near_coplanar",
+			points: func() []r3.Vec {
+				p := make([]r3.Vec, 200)
+				for i := range p {
+					x, y := rnd.Float64(), rnd.Float64()
+					p[i] = r3.Vec{X: x, Y: y, Z: math.Nextafter(0.5*x+0.25*y, math.Inf(2*rnd.IntN(2)-1))}
+				}
+				return p
+			}(),
+		},
+	} {
+		h, err := New3(test.points)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		checkHull3(t, test.name, h)
+		if test.vertices != 0 && len(h.Vertices()) != test.vertices {
+			t.Errorf("unexpected number of vertices for %s: got:%d want:%d", test.name, len(h.Vertices()), test.vertices)
+		}
+		if test.area != 0 && !scalar.EqualWithinAbsOrRel(h.Area(), test.area, tol, tol) {
+			t.Errorf("unexpected area for %s: got:%v want:%v", test.name, h.Area(), test.area)
+		}
+		if test.volume != 0 && !scalar.EqualWithinAbsOrRel(h.Volume(), test.volume, tol, tol) {
+			t.Errorf("unexpected volume for %s: got:%v want:%v", test.name, h.Volume(), test.volume)
+		}
+	}
+}
+
+// checkHull3 checks that every point is inside or on each face of h and
+// that the faces form a closed oriented surface of genus zero.
+func checkHull3(t *testing.T, name string, h *Hull3) {
+	t.Helper()
+	for i, p := range h.Points {
+		if !h.Contains(p) {
+			t.Errorf("%s: point %d is outside the hull", name, i)
+		}
+	}
+	for i, f := range h.Faces {
+		if collinear(h.Points[f[0]], h.Points[f[1]], h.Points[f[2]]) {
+			t.Errorf("%s: face %d is degenerate", name, i)
+		}
+	}
+
+	edges := make(map[[2]int]int)
+	for _, f := range h.Faces {
+		for k := range f {
+			edges[[2]int{f[k], f[(k+1)%3]}]++
+		}
+	}
+	for e, n := range edges {
+		if n != 1 || edges[[2]int{e[1], e[0]}] != 1 {
+			t.Errorf("%s: edge %v is not shared by exactly two consistently oriented faces", name, e)
+		}
+	}
+	if euler := len(h.Vertices()) - len(edges)/2 + len(h.Faces); euler != 2 {
+		t.Errorf("%s: unexpected Euler characteristic: got:%d want:2", name, euler)
+	}
+	if h.Volume() <= 0 {
+		t.Errorf("%s: non-positive volume: %v", name, h.Volume())
+	}
+
+	// The hull is convex if no vertex is above any face.
+	for i, f := range h.Faces {
+		for _, v := range h.Vertices() {
+			if predicate.Orient3D(h.Points[f[0]], h.Points[f[1]], h.Points[f[2]], h.Points[v]) > 0 {
+				t.Errorf("%s: vertex %d is above face %d", name, v, i)
+			}
+		}
+	}
+}
+
+func TestNew3Contains(t *testing.T) {
+	h, err := New3(cubePoints(3))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rnd := rand.New(rand.NewPCG(1, 3))
+	for range 1000 {
+		p := r3.Vec{X: rnd.Float64()*1.5 - 0.25, Y: rnd.Float64()*1.5 - 0.25, Z: rnd.Float64()*1.5 - 0.25}
+		switch rnd.IntN(4) {
+		case 0:
+			p.X = 0
+		case 1:
+			p.Z = 1
+		}
+		want := 0 <= p.X && p.X <= 1 && 0 <= p.Y && p.Y <= 1 && 0 <= p.Z && p.Z <= 1
+		if got := h.Contains(p); got != want {
+			t.Errorf("unexpected containment of %v: got:%t want:%t", p, got, want)
+		}
+	}
+}
+
+func TestNew3Degenerate(t *testing.T) {
+	for _, points := range [][]r3.Vec{
+		nil,
+		{{X: 0, Y: 0, Z: 0}, {X: 1, Y: 0, Z: 0}, {X: 0, Y: 1, Z: 0}},
+		{{X: 1, Y: 1, Z: 1}, {X: 1, Y: 1, Z: 1}, {X: 1, Y: 1, Z: 1}, {X: 1, Y: 1, Z: 1}},
+		{{X: 0, Y: 0, Z: 0}, {X: 1, Y: 1, Z: 1}, {X: 2, Y: 2, Z: 2}, {X: 0.5, Y: 0.5, Z: 0.5}},
+		{{X: 0, Y: 0, Z: 1}, {X: 1, Y: 0, Z: 1}, {X: 0, Y: 1, Z: 1}, {X: 0.3, Y: 0.7, Z: 1}, {X: 5, Y: -2, Z: 1}},
+		{{X: 0.125, Y: 0.25, Z: 0.375}, {X: 0.25, Y: 0.5, Z: 0.75}, {X: 0.375, Y: 0.125, Z: 0.5}, {X: 0.5, Y: 0.375, Z: 0.875}},
+	} {
+		_, err := New3(points)
+		if err != ErrDegenerate {
+			t.Errorf("unexpected error for %v: got:%v want:%v", points, err, ErrDegenerate)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hull_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/spatial/hull"
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+func ExampleNew2() {
+	points := []r2.Vec{
+		{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 2, Y: 1}, {X: 4, Y: 3}, {X: 2, Y: 3}, {X: 0, Y: 3},
+	}
+	h := hull.New2(points)
+	fmt.Printf("vertices: %v\n", h.Vertices)
+	fmt.Printf("area: %v\n", h.Area())
+	fmt.Printf("contains (1, 2): %t\n", h.Contains(r2.Vec{X: 1, Y: 2}))
+	fmt.Printf("contains (5, 2): %t\n", h.Contains(r2.Vec{X: 5, Y: 2}))
+
+	// Output:
+	// vertices: [0 1 3 5]
+	// area: 12
+	// contains (1, 2): true
+	// contains (5, 2): false
+}
+
+func ExampleNew3() {
+	// The vertices of an octahedron and its center.
+	points := []r3.Vec{
+		{X: 1}, {X: -1}, {Y: 1}, {Y: -1}, {Z: 1}, {Z: -1}, {},
+	}
+	h, err := hull.New3(points)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("vertices: %v\n", h.Vertices())
+	fmt.Printf("faces: %d\n", len(h.Faces))
+	fmt.Printf("volume: %.4f\n", h.Volume())
+
+	// Output:
+	// vertices: [0 1 2 3 4 5]
+	// faces: 8
+	// volume: 1.3333
+}
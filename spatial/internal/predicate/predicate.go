@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package predicate provides robust geometric predicates.
+//
+// The predicates are evaluated in floating point arithmetic with a filter
+// on the rounding error, and are recomputed in exact rational arithmetic
+// when the sign of the floating point result cannot be guaranteed. The
+// filters are described in Shewchuk, "Adaptive precision floating-point
+// arithmetic and fast robust geometric predicates", Discrete Comput. Geom.
+// 18:305-363, 1997, doi:10.1007/PL00009321.
+package predicate // import "gonum.org/v1/gonum/spatial/internal/predicate"
+
+import (
+	"math"
+	"math/big"
+
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+const (
+	epsilon = 0x1p-53
+
+	// ccwErrBound, o3dErrBound and iccErrBound
+	// are the relative error bounds of the
+	// floating point evaluation of Orient2D,
+	// Orient3D and InCircle.
+	ccwErrBound = (3 + 16*epsilon) * epsilon
+	o3dErrBound = (7 + 56*epsilon) * epsilon
+	iccErrBound = (10 + 96*epsilon) * epsilon
+)
+
+// Orient2D returns a positive value if the points a, b and c are in
+// counter-clockwise order, a negative value if they are in clockwise order
+// and zero if they are collinear. The sign of the returned value is exact
+// and its magnitude approximates twice the signed area of the triangle abc.
+func Orient2D(a, b, c r2.Vec) float64 {
+	detLeft := (a.X - c.X) * (b.Y - c.Y)
+	detRight := (a.Y - c.Y) * (b.X - c.X)
+	det := detLeft - detRight
+	if math.Abs(det) > ccwErrBound*(math.Abs(detLeft)+math.Abs(detRight)) {
+		return det
+	}
+	return orient2DExact(a, b, c)
+}
+
+func orient2DExact(a, b, c r2.Vec) float64 {
+	ax, ay := rat(a.X), rat(a.Y)
+	acx := new(big.Rat).Sub(ax, rat(c.X))
+	acy := new(big.Rat).Sub(ay, rat(c.Y))
+	bcx := new(big.Rat).Sub(rat(b.X), rat(c.X))
+	bcy := new(big.Rat).Sub(rat(b.Y), rat(c.Y))
+	det := new(big.Rat).Mul(acx, bcy)
+	det.Sub(det, bcy.Mul(acy, bcx))
+	return float64(det.Sign())
+}
+
+// Orient3D returns a positive value if the point d lies above the plane
+// through the points a, b and c, a negative value if it lies below the plane
+// and zero if the four points are coplanar. Above is the side of the plane
+// from which a, b and c appear in counter-clockwise order. The sign of the
+// returned value is exact and its magnitude approximates six times the
+// signed volume of the tetrahedron abcd.
+func Orient3D(a, b, c, d r3.Vec) float64 {
+	adx, ady, adz := a.X-d.X, a.Y-d.Y, a.Z-d.Z
+	bdx, bdy, bdz := b.X-d.X, b.Y-d.Y, b.Z-d.Z
+	cdx, cdy, cdz := c.X-d.X, c.Y-d.Y, c.Z-d.Z
+
+	bdxcdy, cdxbdy := bdx*cdy, cdx*bdy
+	cdxady, adxcdy := cdx*ady, adx*cdy
+	adxbdy, bdxady := adx*bdy, bdx*ady
+
+	det := adz*(bdxcdy-cdxbdy) + bdz*(cdxady-adxcdy) + cdz*(adxbdy-bdxady)
+	permanent := (math.Abs(bdxcdy)+math.Abs(cdxbdy))*math.Abs(adz) +
+		(math.Abs(cdxady)+math.Abs(adxcdy))*math.Abs(bdz) +
+		(math.Abs(adxbdy)+math.Abs(bdxady))*math.Abs(cdz)
+	if math.Abs(det) > o3dErrBound*permanent {
+		// The determinant of the rows a-d, b-d and
+		// c-d is positive when d is below the plane.
+		return -det
+	}
+	return orient3DExact(a, b, c, d)
+}
+
+func orient3DExact(a, b, c, d r3.Vec) float64 {
+	dx, dy, dz := rat(d.X), rat(d.Y), rat(d.Z)
+	var rows [3][3]*big.Rat
+	for i, p := range [3]r3.Vec{a, b, c} {
+		rows[i] = [3]*big.Rat{
+			new(big.Rat).Sub(rat(p.X), dx),
+			new(big.Rat).Sub(rat(p.Y), dy),
+			new(big.Rat).Sub(rat(p.Z), dz),
+		}
+	}
+	return float64(-det3(rows).Sign())
+}
+
+// InCircle returns a positive value if the point d lies inside the circle
+// through the points a, b and c, a negative value if it lies outside and
+// zero if the four points are cocircular. The points a, b and c must be in
+// counter-clockwise order for the sign to have this meaning; for clockwise
+// order the sign is reversed. The sign of the returned value is exact.
+func InCircle(a, b, c, d r2.Vec) float64 {
+	adx, ady := a.X-d.X, a.Y-d.Y
+	bdx, bdy := b.X-d.X, b.Y-d.Y
+	cdx, cdy := c.X-d.X, c.Y-d.Y
+
+	bdxcdy, cdxbdy := bdx*cdy, cdx*bdy
+	alift := adx*adx + ady*ady
+	cdxady, adxcdy := cdx*ady, adx*cdy
+	blift := bdx*bdx + bdy*bdy
+	adxbdy, bdxady := adx*bdy, bdx*ady
+	clift := cdx*cdx + cdy*cdy
+
+	det := alift*(bdxcdy-cdxbdy) + blift*(cdxady-adxcdy) + clift*(adxbdy-bdxady)
+	permanent := (math.Abs(bdxcdy)+math.Abs(cdxbdy))*alift +
+		(math.Abs(cdxady)+math.Abs(adxcdy))*blift +
+		(math.Abs(adxbdy)+math.Abs(bdxady))*clift
+	if math.Abs(det) > iccErrBound*permanent {
+		return det
+	}
+	return inCircleExact(a, b, c, d)
+}
+
+func inCircleExact(a, b, c, d r2.Vec) float64 {
+	dx, dy := rat(d.X), rat(d.Y)
+	var rows [3][3]*big.Rat
+	for i, p := range [3]r2.Vec{a, b, c} {
+		x := new(big.Rat).Sub(rat(p.X), dx)
+		y := new(big.Rat).Sub(rat(p.Y), dy)
+		lift := new(big.Rat).Mul(x, x)
+		lift.Add(lift, new(big.Rat).Mul(y, y))
+		rows[i] = [3]*big.Rat{x, y, lift}
+	}
+	return float64(det3(rows).Sign())
+}
+
+// det3 returns the determinant of the 3×3 matrix m.
+func det3(m [3][3]*big.Rat) *big.Rat {
+	det := new(big.Rat)
+	tmp := new(big.Rat)
+	for i := 0; i < 3; i++ {
+		j, k := (i+1)%3, (i+2)%3
+		minor := new(big.Rat).Mul(m[1][j], m[2][k])
+		minor.Sub(minor, tmp.Mul(m[1][k], m[2][j]))
+		det.Add(det, minor.Mul(minor, m[0][i]))
+	}
+	return det
+}
+
+// rat returns x as an exact rational. It panics if x is not finite.
+func rat(x float64) *big.Rat {
+	r := new(big.Rat).SetFloat64(x)
+	if r == nil {
+		panic("predicate: non-finite coordinate")
+	}
+	return r
+}
@@ -0,0 +1,161 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package predicate
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+func sign(x float64) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// nearlyCollinear returns points that are collinear or nearly collinear,
+// generated by perturbing a point on a line through two others by a few
+// units in the last place.
+func nearlyCollinear(rnd *rand.Rand) (a, b, c r2.Vec) {
+	a = r2.Vec{X: rnd.Float64() * 100, Y: rnd.Float64() * 100}
+	b = r2.Vec{X: rnd.Float64() * 100, Y: rnd.Float64() * 100}
+	t := rnd.Float64()*3 - 1
+	c = r2.Add(a, r2.Scale(t, r2.Sub(b, a)))
+	for range rnd.IntN(3) {
+		c.X = math.Nextafter(c.X, math.Inf(2*rnd.IntN(2)-1))
+		c.Y = math.Nextafter(c.Y, math.Inf(2*rnd.IntN(2)-1))
+	}
+	return a, b, c
+}
+
+func TestOrient2D(t *testing.T) {
+	for _, test := range []struct {
+		a, b, c r2.Vec
+		want    int
+	}{
+		{a: r2.Vec{X: 0, Y: 0}, b: r2.Vec{X: 1, Y: 0}, c: r2.Vec{X: 0, Y: 1}, want: 1},
+		{a: r2.Vec{X: 0, Y: 0}, b: r2.Vec{X: 0, Y: 1}, c: r2.Vec{X: 1, Y: 0}, want: -1},
+		{a: r2.Vec{X: 0, Y: 0}, b: r2.Vec{X: 1, Y: 1}, c: r2.Vec{X: 2, Y: 2}, want: 0},
+		// A classic failure of naive evaluation.
+		{a: r2.Vec{X: 0.5, Y: 0.5}, b: r2.Vec{X: 12, Y: 12}, c: r2.Vec{X: 24, Y: 24}, want: 0},
+		{a: r2.Vec{X: 0.1, Y: 0.1}, b: r2.Vec{X: 0.2, Y: 0.2}, c: r2.Vec{X: 0.3, Y: 0.3}, want: sign(orient2DExact(r2.Vec{X: 0.1, Y: 0.1}, r2.Vec{X: 0.2, Y: 0.2}, r2.Vec{X: 0.3, Y: 0.3}))},
+	} {
+		if got := sign(Orient2D(test.a, test.b, test.c)); got != test.want {
+			t.Errorf("unexpected orientation for %v %v %v: got:%d want:%d", test.a, test.b, test.c, got, test.want)
+		}
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 10000; i++ {
+		a, b, c := nearlyCollinear(rnd)
+		got := sign(Orient2D(a, b, c))
+		want := sign(orient2DExact(a, b, c))
+		if got != want {
+			t.Fatalf("unexpected orientation for %v %v %v: got:%d want:%d", a, b, c, got, want)
+		}
+		if sign(Orient2D(b, a, c)) != -want || sign(Orient2D(b, c, a)) != want {
+			t.Fatalf("inconsistent orientation under permutation for %v %v %v", a, b, c)
+		}
+	}
+}
+
+func TestOrient3D(t *testing.T) {
+	a, b, c := r3.Vec{X: 0, Y: 0, Z: 0}, r3.Vec{X: 1, Y: 0, Z: 0}, r3.Vec{X: 0, Y: 1, Z: 0}
+	for _, test := range []struct {
+		d    r3.Vec
+		want int
+	}{
+		{d: r3.Vec{X: 0, Y: 0, Z: 1}, want: 1},
+		{d: r3.Vec{X: 0, Y: 0, Z: -1}, want: -1},
+		{d: r3.Vec{X: 5, Y: -3, Z: 0}, want: 0},
+	} {
+		if got := sign(Orient3D(a, b, c, test.d)); got != test.want {
+			t.Errorf("unexpected orientation for %v: got:%d want:%d", test.d, got, test.want)
+		}
+		if got := sign(Orient3D(b, a, c, test.d)); got != -test.want {
+			t.Errorf("unexpected orientation for reversed plane and %v: got:%d want:%d", test.d, got, -test.want)
+		}
+	}
+
+	// Points near a plane through three
+	// random points.
+	rnd := rand.New(rand.NewPCG(1, 3))
+	for i := 0; i < 10000; i++ {
+		var p [4]r3.Vec
+		for j := range p[:3] {
+			p[j] = r3.Vec{X: rnd.Float64() * 100, Y: rnd.Float64() * 100, Z: rnd.Float64() * 100}
+		}
+		s, u := rnd.Float64()*2-0.5, rnd.Float64()*2-0.5
+		p[3] = r3.Add(p[0], r3.Add(r3.Scale(s, r3.Sub(p[1], p[0])), r3.Scale(u, r3.Sub(p[2], p[0]))))
+		if rnd.IntN(2) == 0 {
+			p[3].Z = math.Nextafter(p[3].Z, math.Inf(2*rnd.IntN(2)-1))
+		}
+		got := sign(Orient3D(p[0], p[1], p[2], p[3]))
+		want := sign(orient3DExact(p[0], p[1], p[2], p[3]))
+		if got != want {
+			t.Fatalf("unexpected orientation for %v: got:%d want:%d", p, got, want)
+		}
+		if got := sign(Orient3D(p[1], p[2], p[0], p[3])); got != want {
+			t.Fatalf("inconsistent orientation under rotation for %v", p)
+		}
+	}
+}
+
+func TestInCircle(t *testing.T) {
+	a, b, c := r2.Vec{X: 1, Y: 0}, r2.Vec{X: 0, Y: 1}, r2.Vec{X: -1, Y: 0}
+	for _, test := range []struct {
+		d    r2.Vec
+		want int
+	}{
+		{d: r2.Vec{X: 0, Y: 0}, want: 1},
+		{d: r2.Vec{X: 0, Y: -1}, want: 0},
+		{d: r2.Vec{X: 0, Y: -1.5}, want: -1},
+		{d: r2.Vec{X: 0.6, Y: -0.8}, want: sign(inCircleExact(a, b, c, r2.Vec{X: 0.6, Y: -0.8}))},
+	} {
+		if got := sign(InCircle(a, b, c, test.d)); got != test.want {
+			t.Errorf("unexpected in-circle result for %v: got:%d want:%d", test.d, got, test.want)
+		}
+		if got := sign(InCircle(c, b, a, test.d)); got != -test.want {
+			t.Errorf("unexpected in-circle result for clockwise circle and %v: got:%d want:%d", test.d, got, -test.want)
+		}
+	}
+
+	// Points near a circle through three points on a
+	// circle of random center and radius.
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 10000; i++ {
+		center := r2.Vec{X: rnd.Float64() * 10, Y: rnd.Float64() * 10}
+		r := 1 + rnd.Float64()*10
+		var p [4]r2.Vec
+		for j := range p {
+			theta := rnd.Float64() * 2 * math.Pi
+			p[j] = r2.Vec{X: center.X + r*math.Cos(theta), Y: center.Y + r*math.Sin(theta)}
+		}
+		got := sign(InCircle(p[0], p[1], p[2], p[3]))
+		want := sign(inCircleExact(p[0], p[1], p[2], p[3]))
+		if got != want {
+			t.Fatalf("unexpected in-circle result for %v: got:%d want:%d", p, got, want)
+		}
+	}
+}
+
+func TestNonFinitePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for non-finite coordinate")
+		}
+	}()
+	nan := math.NaN()
+	orient2DExact(r2.Vec{X: nan}, r2.Vec{}, r2.Vec{})
+}
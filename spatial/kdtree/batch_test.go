@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kdtree
+
+import (
+	"math/rand/v2"
+	"sort"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const (
+		dims    = 3
+		setSize = 2000
+		queries = 200
+		k       = 7
+		r       = 0.01
+	)
+	data := make(Points, setSize)
+	for i := range data {
+		data[i] = Point{rnd.Float64(), rnd.Float64(), rnd.Float64()}
+	}
+	qs := make([]Comparable, queries)
+	for i := range qs {
+		qs[i] = Point{rnd.Float64(), rnd.Float64(), rnd.Float64()}
+	}
+	tree := New(append(Points(nil), data...), false)
+
+	for _, workers := range []int{0, 1, 3} {
+		nearest := tree.NearestBatch(qs, k, workers)
+		within := tree.RadiusBatch(qs, r, workers)
+		for i, q := range qs {
+			dists := make([]float64, len(data))
+			for j, p := range data {
+				dists[j] = q.Distance(p)
+			}
+			sort.Float64s(dists)
+
+			if len(nearest[i]) != k {
+				t.Fatalf("unexpected number of nearest values: got:%d want:%d", len(nearest[i]), k)
+			}
+			for j, cd := range nearest[i] {
+				if cd.Dist != dists[j] || q.Distance(cd.Comparable) != cd.Dist {
+					t.Errorf("unexpected %dth nearest distance for query %d: got:%v want:%v", j, i, cd.Dist, dists[j])
+				}
+			}
+
+			want := sort.SearchFloat64s(dists, r)
+			for want < len(dists) && dists[want] == r {
+				want++
+			}
+			if len(within[i]) != want {
+				t.Errorf("unexpected number of values within radius for query %d: got:%d want:%d", i, len(within[i]), want)
+			}
+			for j, cd := range within[i] {
+				if cd.Dist != dists[j] {
+					t.Errorf("unexpected %dth distance within radius for query %d: got:%v want:%v", j, i, cd.Dist, dists[j])
+				}
+			}
+		}
+	}
+
+	if got := tree.NearestBatch(qs[:1], setSize+10, 1); len(got[0]) != setSize {
+		t.Errorf("unexpected number of values for large k: got:%d want:%d", len(got[0]), setSize)
+	}
+	empty := New(Points{}, false)
+	for _, got := range [][][]ComparableDist{empty.NearestBatch(qs, k, 2), empty.RadiusBatch(qs, r, 2)} {
+		for _, res := range got {
+			if len(res) != 0 {
+				t.Errorf("unexpected result for empty tree: %v", res)
+			}
+		}
+	}
+}
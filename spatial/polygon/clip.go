@@ -0,0 +1,360 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package polygon
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"gonum.org/v1/gonum/spatial/internal/predicate"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// ClipConvex returns the part of the polygon subject inside the convex
+// polygon clip. The vertices of clip may be in either order. The returned
+// polygon has the orientation of subject. If subject is not convex and the
+// clipped region has more than one part, the parts are joined by edges
+// along the boundary of clip. ClipConvex returns nil if the clipped region
+// is empty.
+//
+// ClipConvex uses the Sutherland-Hodgman algorithm described in Sutherland
+// and Hodgman, "Reentrant polygon clipping", Commun. ACM 17:32-42, 1974,
+// doi:10.1145/360767.360802. The time complexity of ClipConvex is O(nm)
+// where n and m are the number of vertices of subject and clip.
+func ClipConvex(subject, clip []r2.Vec) []r2.Vec {
+	if len(clip) < 3 {
+		return nil
+	}
+	sign := 1.0
+	if Area(clip) < 0 {
+		sign = -1
+	}
+	out := slices.Clone(subject)
+	var in []r2.Vec
+	for k, a := range clip {
+		if len(out) == 0 {
+			break
+		}
+		b := clip[(k+1)%len(clip)]
+		in, out = out, in[:0]
+		s := in[len(in)-1]
+		sIn := sign*predicate.Orient2D(a, b, s) >= 0
+		for _, e := range in {
+			eIn := sign*predicate.Orient2D(a, b, e) >= 0
+			if eIn != sIn {
+				out = append(out, lineIntersection(s, e, a, b))
+			}
+			if eIn {
+				out = append(out, e)
+			}
+			s, sIn = e, eIn
+		}
+	}
+	if len(out) < 3 {
+		return nil
+	}
+	return out
+}
+
+// lineIntersection returns the intersection of the segment pq with the
+// line through a and b. The segment must cross the line.
+func lineIntersection(p, q, a, b r2.Vec) r2.Vec {
+	d := r2.Sub(q, p)
+	ab := r2.Sub(b, a)
+	t := r2.Cross(ab, r2.Sub(a, p)) / r2.Cross(ab, d)
+	t = math.Max(0, math.Min(1, t))
+	return r2.Add(p, r2.Scale(t, d))
+}
+
+// Intersection returns the region inside both of the simple polygons a and
+// b as a set of polygons. Outer boundaries in the result have
+// counter-clockwise orientation and the boundaries of holes have clockwise
+// orientation. The vertices of a and b may be in either order.
+//
+// See Union for a description of the algorithm.
+func Intersection(a, b []r2.Vec) [][]r2.Vec {
+	return boolean(a, b, intersection)
+}
+
+// Union returns the region inside either of the simple polygons a and b as
+// a set of polygons. Outer boundaries in the result have counter-clockwise
+// orientation and the boundaries of holes have clockwise orientation. The
+// vertices of a and b may be in either order.
+//
+// The boolean operations on polygons split the edges of each polygon where
+// they meet the boundary of the other, and classify each of the resulting
+// edges as inside, outside or on the boundary of the other polygon using
+// exact predicates. The edges bounding the result are then selected and
+// linked into closed boundaries. Unlike ClipConvex, the operations handle
+// concave polygons and results with several parts or holes. Intersection
+// points are rounded to the nearest representable point, so very short
+// edges in the result may be misclassified. The time complexity of the
+// operations is O(nm + k log k) where n and m are the number of vertices of
+// a and b and k is the number of edge intersections.
+func Union(a, b []r2.Vec) [][]r2.Vec {
+	return boolean(a, b, union)
+}
+
+// Difference returns the region inside the simple polygon a and outside the
+// simple polygon b as a set of polygons. Outer boundaries in the result have
+// counter-clockwise orientation and the boundaries of holes have clockwise
+// orientation. The vertices of a and b may be in either order.
+//
+// See Union for a description of the algorithm.
+func Difference(a, b []r2.Vec) [][]r2.Vec {
+	return boolean(a, b, difference)
+}
+
+// Xor returns the region inside exactly one of the simple polygons a and b
+// as a set of polygons. Outer boundaries in the result have
+// counter-clockwise orientation and the boundaries of holes have clockwise
+// orientation. The vertices of a and b may be in either order.
+//
+// See Union for a description of the algorithm.
+func Xor(a, b []r2.Vec) [][]r2.Vec {
+	return boolean(a, b, xor)
+}
+
+type operation int
+
+const (
+	intersection operation = iota
+	union
+	difference
+	xor
+)
+
+// edge is a directed edge of a polygon boundary.
+type edge struct {
+	from, to r2.Vec
+}
+
+func boolean(a, b []r2.Vec, op operation) [][]r2.Vec {
+	a = canonical(a)
+	b = canonical(b)
+	ea, eb := split(a, b)
+
+	inB := make(map[edge]bool, len(eb))
+	for _, e := range eb {
+		inB[e] = true
+	}
+	var selected []edge
+	for _, e := range ea {
+		var (
+			loc  = Locate(b, midpoint(e))
+			same = inB[e]
+			rev  = inB[edge{from: e.to, to: e.from}]
+		)
+		switch {
+		case same || rev:
+			// The edge is shared by both boundaries and is kept
+			// only once, if the regions it separates differ.
+			if (same && (op == intersection || op == union)) || (rev && op == difference) {
+				selected = append(selected, e)
+			}
+		case loc == Inside && op == intersection:
+			selected = append(selected, e)
+		case loc == Inside && op == xor:
+			selected = append(selected, edge{from: e.to, to: e.from})
+		case loc == Outside && op != intersection:
+			selected = append(selected, e)
+		}
+	}
+	inA := make(map[edge]bool, len(ea))
+	for _, e := range ea {
+		inA[e] = true
+	}
+	for _, e := range eb {
+		if inA[e] || inA[edge{from: e.to, to: e.from}] {
+			continue
+		}
+		switch loc := Locate(a, midpoint(e)); {
+		case loc == Inside && op == intersection:
+			selected = append(selected, e)
+		case loc == Inside && (op == difference || op == xor):
+			selected = append(selected, edge{from: e.to, to: e.from})
+		case loc == Outside && (op == union || op == xor):
+			selected = append(selected, e)
+		}
+	}
+	return link(selected)
+}
+
+// canonical returns p with repeated vertices removed and with
+// counter-clockwise orientation.
+func canonical(p []r2.Vec) []r2.Vec {
+	c := make([]r2.Vec, 0, len(p))
+	for i, v := range p {
+		if v == p[(i+1)%len(p)] {
+			continue
+		}
+		c = append(c, v)
+	}
+	if len(c) < 3 {
+		return nil
+	}
+	if Area(c) < 0 {
+		slices.Reverse(c)
+	}
+	return c
+}
+
+// split returns the edges of the polygons a and b split at every point
+// where they meet the boundary of the other polygon.
+func split(a, b []r2.Vec) (ea, eb []edge) {
+	cuts := func(p []r2.Vec) [][]r2.Vec {
+		c := make([][]r2.Vec, len(p))
+		for i, v := range p {
+			c[i] = []r2.Vec{v, p[(i+1)%len(p)]}
+		}
+		return c
+	}
+	ca := cuts(a)
+	cb := cuts(b)
+
+	for i, a0 := range a {
+		a1 := a[(i+1)%len(a)]
+		for j, b0 := range b {
+			b1 := b[(j+1)%len(b)]
+			if max(a0.X, a1.X) < min(b0.X, b1.X) || max(b0.X, b1.X) < min(a0.X, a1.X) ||
+				max(a0.Y, a1.Y) < min(b0.Y, b1.Y) || max(b0.Y, b1.Y) < min(a0.Y, a1.Y) {
+				continue
+			}
+			o0 := predicate.Orient2D(a0, a1, b0)
+			o1 := predicate.Orient2D(a0, a1, b1)
+			o2 := predicate.Orient2D(b0, b1, a0)
+			o3 := predicate.Orient2D(b0, b1, a1)
+			if opposite(o0, o1) && opposite(o2, o3) {
+				// The edges cross at a point interior to both.
+				p := lineIntersection(a0, a1, b0, b1)
+				ca[i] = append(ca[i], p)
+				cb[j] = append(cb[j], p)
+				continue
+			}
+			// Split each edge at the vertices of the
+			// other lying on it.
+			if o0 == 0 && between(a0, a1, b0) {
+				ca[i] = append(ca[i], b0)
+			}
+			if o2 == 0 && between(b0, b1, a0) {
+				cb[j] = append(cb[j], a0)
+			}
+		}
+	}
+	return edges(ca), edges(cb)
+}
+
+// edges returns the edges formed by the points in each element of c, which
+// hold the end points of a segment followed by points on the segment.
+func edges(c [][]r2.Vec) []edge {
+	var e []edge
+	for _, pts := range c {
+		o := pts[0]
+		sort.Slice(pts[2:], func(i, j int) bool {
+			return r2.Norm2(r2.Sub(pts[2+i], o)) < r2.Norm2(r2.Sub(pts[2+j], o))
+		})
+		prev := o
+		for _, p := range append(pts[2:], pts[1]) {
+			if p == prev {
+				continue
+			}
+			e = append(e, edge{from: prev, to: p})
+			prev = p
+		}
+	}
+	return e
+}
+
+// opposite returns whether x and y are non-zero with opposite signs.
+func opposite(x, y float64) bool {
+	return (x < 0 && y > 0) || (x > 0 && y < 0)
+}
+
+func midpoint(e edge) r2.Vec {
+	return r2.Scale(0.5, r2.Add(e.from, e.to))
+}
+
+// link joins the directed edges into closed boundaries. Where a vertex has
+// more than one outgoing edge, the edge turning furthest left is followed so
+// that boundaries touching at a vertex are kept separate.
+func link(edges []edge) [][]r2.Vec {
+	out := make(map[r2.Vec][]int)
+	for i, e := range edges {
+		out[e.from] = append(out[e.from], i)
+	}
+	used := make([]bool, len(edges))
+	var rings [][]r2.Vec
+	for i := range edges {
+		if used[i] {
+			continue
+		}
+		start := edges[i].from
+		var ring []r2.Vec
+		cur := i
+		for {
+			used[cur] = true
+			e := edges[cur]
+			ring = append(ring, e.from)
+			if e.to == start {
+				break
+			}
+			cur = next(edges, out[e.to], used, e)
+			if cur < 0 {
+				// The boundary is not closed.
+				ring = nil
+				break
+			}
+		}
+		ring = simplifyRing(ring)
+		if len(ring) >= 3 && Area(ring) != 0 {
+			rings = append(rings, ring)
+		}
+	}
+	return rings
+}
+
+// next returns the index of the unused edge in cand that turns furthest left
+// from e, or -1 if there is none.
+func next(edges []edge, cand []int, used []bool, e edge) int {
+	d := r2.Sub(e.to, e.from)
+	best := -1
+	var bestAngle float64
+	for _, j := range cand {
+		if used[j] {
+			continue
+		}
+		f := r2.Sub(edges[j].to, edges[j].from)
+		angle := math.Atan2(r2.Cross(d, f), r2.Dot(d, f))
+		if best < 0 || angle > bestAngle {
+			best = j
+			bestAngle = angle
+		}
+	}
+	return best
+}
+
+// simplifyRing removes vertices of the closed ring p that lie on the
+// straight line joining their neighbours.
+func simplifyRing(p []r2.Vec) []r2.Vec {
+	for changed := true; changed && len(p) >= 3; {
+		changed = false
+		q := p[:0]
+		for i, v := range p {
+			u := p[(i+len(p)-1)%len(p)]
+			if len(q) != 0 {
+				u = q[len(q)-1]
+			}
+			w := p[(i+1)%len(p)]
+			if predicate.Orient2D(u, v, w) == 0 && r2.Dot(r2.Sub(v, u), r2.Sub(w, v)) > 0 {
+				changed = true
+				continue
+			}
+			q = append(q, v)
+		}
+		p = q
+	}
+	return p
+}
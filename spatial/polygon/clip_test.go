@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package polygon
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+func TestClipConvex(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		subject, clip []r2.Vec
+		area          float64
+	}{
+		{name: "overlapping squares", subject: square, clip: clipped, area: 1},
+		{name: "clockwise clip", subject: square, clip: reversed(clipped), area: 1},
+		{name: "clockwise subject", subject: reversed(square), clip: clipped, area: -1},
+		{name: "L-shape", subject: lShape, clip: square, area: 3},
+		{name: "contained", subject: clipped, clip: translate(scaled(square, 3), r2.Vec{X: -1, Y: -1}), area: 4},
+		{name: "disjoint", subject: square, clip: translate(square, r2.Vec{X: 5}), area: 0},
+		{name: "degenerate clip", subject: square, clip: square[:2], area: 0},
+	} {
+		got := ClipConvex(test.subject, test.clip)
+		if a := Area(got); !scalar.EqualWithinAbs(a, test.area, 1e-12) {
+			t.Errorf("unexpected clipped area for %s: got:%v want:%v", test.name, a, test.area)
+		}
+		for _, v := range got {
+			if !Contains(test.clip, v) && Locate(test.clip, v) != OnBoundary {
+				// Intersection points may be rounded off the
+				// boundary, so allow a small distance.
+				if d := boundaryDistance(test.clip, v); d > 1e-12 {
+					t.Errorf("clipped vertex %v outside clip for %s", v, test.name)
+				}
+			}
+		}
+	}
+}
+
+var booleanTests = []struct {
+	name string
+	a, b []r2.Vec
+
+	intersection, union, difference float64
+	parts                           [4]int
+}{
+	{
+		name: "overlapping squares", a: square, b: clipped,
+		intersection: 1, union: 7, difference: 3,
+		parts: [4]int{1, 1, 1, 2},
+	},
+	{
+		name: "opposite orientation", a: square, b: reversed(clipped),
+		intersection: 1, union: 7, difference: 3,
+		parts: [4]int{1, 1, 1, 2},
+	},
+	{
+		name: "disjoint", a: square, b: translate(square, r2.Vec{X: 5}),
+		intersection: 0, union: 8, difference: 4,
+		parts: [4]int{0, 2, 1, 2},
+	},
+	{
+		name: "shared edge", a: square, b: translate(square, r2.Vec{X: 2}),
+		intersection: 0, union: 8, difference: 4,
+		parts: [4]int{0, 1, 1, 1},
+	},
+	{
+		name: "shared partial edge", a: square, b: translate(square, r2.Vec{X: 2, Y: 1}),
+		intersection: 0, union: 8, difference: 4,
+		parts: [4]int{0, 1, 1, 1},
+	},
+	{
+		name: "touching corner", a: square, b: translate(square, r2.Vec{X: 2, Y: 2}),
+		intersection: 0, union: 8, difference: 4,
+		parts: [4]int{0, 2, 1, 2},
+	},
+	{
+		name: "identical", a: square, b: square,
+		intersection: 4, union: 4, difference: 0,
+		parts: [4]int{1, 1, 0, 0},
+	},
+	{
+		name: "hole", a: scaled(square, 2), b: translate(square, r2.Vec{X: 1, Y: 1}),
+		intersection: 4, union: 16, difference: 12,
+		parts: [4]int{1, 1, 2, 2},
+	},
+	{
+		name: "notch", a: lShape, b: translate(square, r2.Vec{X: 1, Y: 1}),
+		intersection: 0, union: 9, difference: 5,
+		parts: [4]int{0, 1, 1, 1},
+	},
+	{
+		name: "L-shapes", a: lShape, b: translate(reversed(lShape), r2.Vec{X: 0.5, Y: 0.5}),
+		intersection: 2.25, union: 7.75, difference: 2.75,
+		parts: [4]int{1, 1, 1, 2},
+	},
+}
+
+func TestBoolean(t *testing.T) {
+	ops := []struct {
+		name string
+		fn   func(a, b []r2.Vec) [][]r2.Vec
+	}{
+		{name: "intersection", fn: Intersection},
+		{name: "union", fn: Union},
+		{name: "difference", fn: Difference},
+		{name: "xor", fn: Xor},
+	}
+	for _, test := range booleanTests {
+		want := [4]float64{
+			test.intersection,
+			test.union,
+			test.difference,
+			test.union - test.intersection,
+		}
+		for i, op := range ops {
+			got := op.fn(test.a, test.b)
+			if a := totalArea(got); !scalar.EqualWithinAbs(a, want[i], 1e-12) {
+				t.Errorf("unexpected %s area for %s: got:%v want:%v", op.name, test.name, a, want[i])
+			}
+			if len(got) != test.parts[i] {
+				t.Errorf("unexpected number of %s parts for %s: got:%d want:%d\n%v",
+					op.name, test.name, len(got), test.parts[i], got)
+			}
+		}
+	}
+}
+
+func TestBooleanRandom(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for iter := 0; iter < 200; iter++ {
+		a := randomPolygon(rnd, 3+rnd.IntN(20), r2.Vec{}, 1)
+		b := randomPolygon(rnd, 3+rnd.IntN(20), r2.Vec{X: rnd.Float64() - 0.5, Y: rnd.Float64() - 0.5}, 1)
+		inter := Intersection(a, b)
+		union := Union(a, b)
+		diff := Difference(a, b)
+		xor := Xor(a, b)
+
+		areaA, areaB := math.Abs(Area(a)), math.Abs(Area(b))
+		ai, au, ad, ax := totalArea(inter), totalArea(union), totalArea(diff), totalArea(xor)
+		const tol = 1e-12
+		if !scalar.EqualWithinAbs(ai+au, areaA+areaB, tol) {
+			t.Errorf("iteration %d: intersection and union areas do not sum: got:%v want:%v", iter, ai+au, areaA+areaB)
+		}
+		if !scalar.EqualWithinAbs(ad, areaA-ai, tol) {
+			t.Errorf("iteration %d: unexpected difference area: got:%v want:%v", iter, ad, areaA-ai)
+		}
+		if !scalar.EqualWithinAbs(ax, au-ai, tol) {
+			t.Errorf("iteration %d: unexpected xor area: got:%v want:%v", iter, ax, au-ai)
+		}
+
+		for k := 0; k < 50; k++ {
+			q := r2.Vec{X: 4*rnd.Float64() - 2, Y: 4*rnd.Float64() - 2}
+			la, lb := Locate(a, q), Locate(b, q)
+			if la == OnBoundary || lb == OnBoundary {
+				continue
+			}
+			inA, inB := la == Inside, lb == Inside
+			for _, c := range []struct {
+				name string
+				p    [][]r2.Vec
+				want bool
+			}{
+				{name: "intersection", p: inter, want: inA && inB},
+				{name: "union", p: union, want: inA || inB},
+				{name: "difference", p: diff, want: inA && !inB},
+				{name: "xor", p: xor, want: inA != inB},
+			} {
+				got, ok := winding(c.p, q)
+				if !ok {
+					continue
+				}
+				if got != c.want {
+					t.Errorf("iteration %d: unexpected %s containment of %v: got:%t want:%t",
+						iter, c.name, q, got, c.want)
+				}
+			}
+		}
+	}
+}
+
+// totalArea returns the sum of the signed areas of the polygons in p.
+func totalArea(p [][]r2.Vec) float64 {
+	var a float64
+	for _, r := range p {
+		a += Area(r)
+	}
+	return a
+}
+
+// winding returns whether q is inside the region bounded by the set of
+// polygons p. The returned ok is false if q is on a boundary.
+func winding(p [][]r2.Vec, q r2.Vec) (inside, ok bool) {
+	var w int
+	for _, r := range p {
+		switch Locate(r, q) {
+		case OnBoundary:
+			return false, false
+		case Inside:
+			if Area(r) > 0 {
+				w++
+			} else {
+				w--
+			}
+		}
+	}
+	return w > 0, true
+}
+
+// boundaryDistance returns the distance from q to the boundary of p.
+func boundaryDistance(p []r2.Vec, q r2.Vec) float64 {
+	d := math.Inf(1)
+	for i, v := range p {
+		d = math.Min(d, segmentDistance(v, p[(i+1)%len(p)], q))
+	}
+	return d
+}
+
+func scaled(p []r2.Vec, f float64) []r2.Vec {
+	s := make([]r2.Vec, len(p))
+	for i, v := range p {
+		s[i] = r2.Scale(f, v)
+	}
+	return s
+}
@@ -0,0 +1,10 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package polygon provides operations on polygons and polylines in the plane.
+//
+// A polygon is represented by the sequence of its vertices with the closing
+// edge from the last vertex to the first implied, so the first vertex is not
+// repeated. Polygons with counter-clockwise vertex order have positive area.
+package polygon // import "gonum.org/v1/gonum/spatial/polygon"
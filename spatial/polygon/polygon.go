@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package polygon
+
+import (
+	"gonum.org/v1/gonum/spatial/internal/predicate"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// Area returns the signed area of the polygon p. The area is positive if the
+// vertices of p are in counter-clockwise order and negative if they are in
+// clockwise order. The area of a self-intersecting polygon is the sum of the
+// areas of its parts weighted by their winding numbers.
+func Area(p []r2.Vec) float64 {
+	if len(p) < 3 {
+		return 0
+	}
+	// Measure vertices relative to the first
+	// to reduce cancellation.
+	o := p[0]
+	var a float64
+	for k := 1; k < len(p)-1; k++ {
+		a += r2.Cross(r2.Sub(p[k], o), r2.Sub(p[k+1], o))
+	}
+	return a / 2
+}
+
+// Centroid returns the centroid of the region enclosed by the polygon p.
+// If p has zero area, the mean of the vertices of p is returned. Centroid
+// will panic if p has no vertices.
+func Centroid(p []r2.Vec) r2.Vec {
+	if len(p) == 0 {
+		panic("polygon: no vertices")
+	}
+	o := p[0]
+	var (
+		a float64
+		c r2.Vec
+	)
+	for k := 1; k < len(p)-1; k++ {
+		u := r2.Sub(p[k], o)
+		v := r2.Sub(p[k+1], o)
+		w := r2.Cross(u, v)
+		a += w
+		c = r2.Add(c, r2.Scale(w, r2.Add(u, v)))
+	}
+	if a == 0 {
+		var m r2.Vec
+		for _, v := range p {
+			m = r2.Add(m, r2.Sub(v, o))
+		}
+		return r2.Add(o, r2.Scale(1/float64(len(p)), m))
+	}
+	return r2.Add(o, r2.Scale(1/(3*a), c))
+}
+
+// Perimeter returns the length of the boundary of the polygon p.
+func Perimeter(p []r2.Vec) float64 {
+	if len(p) < 2 {
+		return 0
+	}
+	var l float64
+	for k, u := range p {
+		l += r2.Norm(r2.Sub(p[(k+1)%len(p)], u))
+	}
+	return l
+}
+
+// Location is the location of a point relative to a polygon.
+type Location int
+
+const (
+	// Outside indicates the point is outside the polygon.
+	Outside Location = iota
+	// OnBoundary indicates the point lies on an edge of the polygon.
+	OnBoundary
+	// Inside indicates the point is inside the polygon.
+	Inside
+)
+
+// Locate returns the location of q relative to the polygon p. Points with a
+// non-zero winding number with respect to p are inside p, so the regions of
+// a self-intersecting polygon that are enclosed more than once are inside.
+//
+// Locate uses exact geometric predicates, so its result is correct for all
+// finite coordinates. The time complexity of Locate is O(n) in the number of
+// vertices of p.
+func Locate(p []r2.Vec, q r2.Vec) Location {
+	var wind int
+	for k, a := range p {
+		b := p[(k+1)%len(p)]
+		o := predicate.Orient2D(a, b, q)
+		if o == 0 && between(a, b, q) {
+			return OnBoundary
+		}
+		if a.Y <= q.Y {
+			if b.Y > q.Y && o > 0 {
+				// Upward crossing with q left of the edge.
+				wind++
+			}
+		} else if b.Y <= q.Y && o < 0 {
+			// Downward crossing with q right of the edge.
+			wind--
+		}
+	}
+	if wind != 0 {
+		return Inside
+	}
+	return Outside
+}
+
+// Contains returns whether q is inside or on the boundary of the polygon p.
+func Contains(p []r2.Vec, q r2.Vec) bool {
+	return Locate(p, q) != Outside
+}
+
+// between returns whether q, which must be collinear with a and b, lies on
+// the closed segment ab.
+func between(a, b, q r2.Vec) bool {
+	return min(a.X, b.X) <= q.X && q.X <= max(a.X, b.X) &&
+		min(a.Y, b.Y) <= q.Y && q.Y <= max(a.Y, b.Y)
+}
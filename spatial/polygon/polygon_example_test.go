@@ -0,0 +1,53 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package polygon_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/spatial/polygon"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+func Example() {
+	// A field with a pond in one corner.
+	field := []r2.Vec{{X: 0, Y: 0}, {X: 40, Y: 0}, {X: 40, Y: 30}, {X: 20, Y: 40}, {X: 0, Y: 30}}
+	pond := []r2.Vec{{X: 30, Y: -5}, {X: 50, Y: -5}, {X: 50, Y: 10}, {X: 30, Y: 10}}
+
+	fmt.Printf("field area: %.0f\n", polygon.Area(field))
+	fmt.Printf("field centroid: %.2f\n", polygon.Centroid(field))
+
+	flooded := polygon.Intersection(field, pond)
+	for _, p := range flooded {
+		fmt.Printf("flooded area: %.0f\n", polygon.Area(p))
+	}
+	for _, p := range polygon.Difference(field, pond) {
+		fmt.Printf("dry area: %.0f\n", polygon.Area(p))
+	}
+
+	for _, q := range []r2.Vec{{X: 20, Y: 20}, {X: 40, Y: 20}, {X: 35, Y: 5}} {
+		fmt.Printf("%v dry: %t\n", q, polygon.Contains(field, q) && !polygon.Contains(pond, q))
+	}
+
+	// Output:
+	// field area: 1400
+	// field centroid: {20.00 17.62}
+	// flooded area: 100
+	// dry area: 1300
+	// {20 20} dry: true
+	// {40 20} dry: true
+	// {35 5} dry: false
+}
+
+func ExampleSimplify() {
+	track := []r2.Vec{
+		{X: 0, Y: 0}, {X: 1, Y: 0.1}, {X: 2, Y: -0.1}, {X: 3, Y: 5},
+		{X: 4, Y: 6}, {X: 5, Y: 7}, {X: 6, Y: 8.1}, {X: 7, Y: 9},
+	}
+	fmt.Println(polygon.Simplify(track, 0.5))
+
+	// Output:
+	// [{0 0} {2 -0.1} {3 5} {7 9}]
+}
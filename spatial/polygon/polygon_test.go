@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package polygon
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+var (
+	square  = []r2.Vec{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}
+	lShape  = []r2.Vec{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 3, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 3}, {X: 0, Y: 3}}
+	bowtie  = []r2.Vec{{X: 0, Y: 0}, {X: 2, Y: 2}, {X: 2, Y: 0}, {X: 0, Y: 2}}
+	clipped = []r2.Vec{{X: 1, Y: 1}, {X: 3, Y: 1}, {X: 3, Y: 3}, {X: 1, Y: 3}}
+)
+
+func TestArea(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		p        []r2.Vec
+		area     float64
+		centroid r2.Vec
+	}{
+		{name: "empty", p: nil, area: 0},
+		{name: "square", p: square, area: 4, centroid: r2.Vec{X: 1, Y: 1}},
+		{name: "L-shape", p: lShape, area: 5, centroid: r2.Vec{X: 1.1, Y: 1.1}},
+		{name: "clockwise square", p: reversed(square), area: -4, centroid: r2.Vec{X: 1, Y: 1}},
+		{name: "segment", p: []r2.Vec{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 4, Y: 0}}, area: 0, centroid: r2.Vec{X: 2, Y: 0}},
+		{
+			name: "far from origin",
+			p:    translate(square, r2.Vec{X: 1e9, Y: -1e9}),
+			area: 4, centroid: r2.Vec{X: 1e9 + 1, Y: -1e9 + 1},
+		},
+	} {
+		if got := Area(test.p); !scalar.EqualWithinAbs(got, test.area, 1e-12) {
+			t.Errorf("unexpected area for %s: got:%v want:%v", test.name, got, test.area)
+		}
+		if len(test.p) == 0 {
+			continue
+		}
+		got := Centroid(test.p)
+		if !scalar.EqualWithinAbs(got.X, test.centroid.X, 1e-9) || !scalar.EqualWithinAbs(got.Y, test.centroid.Y, 1e-9) {
+			t.Errorf("unexpected centroid for %s: got:%v want:%v", test.name, got, test.centroid)
+		}
+	}
+	if got := Perimeter(lShape); got != 12 {
+		t.Errorf("unexpected perimeter: got:%v want:12", got)
+	}
+}
+
+func TestLocate(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		p    []r2.Vec
+		q    r2.Vec
+		want Location
+	}{
+		{name: "square inside", p: square, q: r2.Vec{X: 1, Y: 1}, want: Inside},
+		{name: "square outside", p: square, q: r2.Vec{X: 3, Y: 1}, want: Outside},
+		{name: "square vertex", p: square, q: r2.Vec{X: 2, Y: 2}, want: OnBoundary},
+		{name: "square edge", p: square, q: r2.Vec{X: 0, Y: 1.5}, want: OnBoundary},
+		{name: "square edge extension", p: square, q: r2.Vec{X: 0, Y: 3}, want: Outside},
+		{name: "L notch", p: lShape, q: r2.Vec{X: 2, Y: 2}, want: Outside},
+		{name: "L arm", p: lShape, q: r2.Vec{X: 2.5, Y: 0.5}, want: Inside},
+		{name: "L ray through vertex", p: lShape, q: r2.Vec{X: 0.5, Y: 1}, want: Inside},
+		{name: "bowtie lobe", p: bowtie, q: r2.Vec{X: 1.5, Y: 1}, want: Inside},
+		{name: "bowtie waist", p: bowtie, q: r2.Vec{X: 1, Y: 0.5}, want: Outside},
+		{name: "bowtie crossing", p: bowtie, q: r2.Vec{X: 1, Y: 1}, want: OnBoundary},
+		{name: "clockwise", p: reversed(lShape), q: r2.Vec{X: 0.5, Y: 2.5}, want: Inside},
+		{
+			// The point is just above the edge from (0, 0) to (3, 1),
+			// closer than floating point evaluation can resolve.
+			name: "near edge", p: []r2.Vec{{X: 0, Y: 0}, {X: 3, Y: 1}, {X: 0, Y: 1}},
+			q: r2.Vec{X: 0.1, Y: math.Nextafter(0.1/3, 1)}, want: Inside,
+		},
+	} {
+		if got := Locate(test.p, test.q); got != test.want {
+			t.Errorf("unexpected location for %s: got:%v want:%v", test.name, got, test.want)
+		}
+		if got := Contains(test.p, test.q); got != (test.want != Outside) {
+			t.Errorf("unexpected containment for %s: got:%t", test.name, got)
+		}
+	}
+}
+
+// randomPolygon returns a random simple polygon with n vertices that is
+// star-shaped about c.
+func randomPolygon(rnd *rand.Rand, n int, c r2.Vec, r float64) []r2.Vec {
+	p := make([]r2.Vec, n)
+	for i := range p {
+		a := 2 * math.Pi * (float64(i) + 0.9*rnd.Float64()) / float64(n)
+		l := r * (0.2 + 0.8*rnd.Float64())
+		p[i] = r2.Vec{X: c.X + l*math.Cos(a), Y: c.Y + l*math.Sin(a)}
+	}
+	return p
+}
+
+func reversed(p []r2.Vec) []r2.Vec {
+	r := slices.Clone(p)
+	slices.Reverse(r)
+	return r
+}
+
+func translate(p []r2.Vec, d r2.Vec) []r2.Vec {
+	t := make([]r2.Vec, len(p))
+	for i, v := range p {
+		t[i] = r2.Add(v, d)
+	}
+	return t
+}
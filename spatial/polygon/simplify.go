@@ -0,0 +1,68 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package polygon
+
+import "gonum.org/v1/gonum/spatial/r2"
+
+// Simplify returns a simplification of the polyline p in which every vertex
+// of p removed is within the distance tol of the simplified polyline. The
+// end points of p are always retained. To simplify a closed polygon, pass
+// the polygon with its first vertex repeated at the end.
+//
+// Simplify uses the Ramer-Douglas-Peucker algorithm described in Douglas and
+// Peucker, "Algorithms for the reduction of the number of points required to
+// represent a digitized line or its caricature", Cartographica 10:112-122,
+// 1973, doi:10.3138/FM57-6770-U75U-7727. The worst case time complexity of
+// Simplify is O(n^2) in the number of vertices of p, with O(n log n)
+// typical.
+func Simplify(p []r2.Vec, tol float64) []r2.Vec {
+	if len(p) < 3 {
+		return append([]r2.Vec(nil), p...)
+	}
+	keep := make([]bool, len(p))
+	keep[0] = true
+	keep[len(p)-1] = true
+
+	type span struct{ i, j int }
+	stack := []span{{0, len(p) - 1}}
+	for len(stack) != 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		far := -1
+		var dist float64
+		for k := s.i + 1; k < s.j; k++ {
+			d := segmentDistance(p[s.i], p[s.j], p[k])
+			if d > dist {
+				far = k
+				dist = d
+			}
+		}
+		if far < 0 || dist <= tol {
+			continue
+		}
+		keep[far] = true
+		stack = append(stack, span{s.i, far}, span{far, s.j})
+	}
+
+	var simple []r2.Vec
+	for i, v := range p {
+		if keep[i] {
+			simple = append(simple, v)
+		}
+	}
+	return simple
+}
+
+// segmentDistance returns the distance from q to the closed segment ab.
+func segmentDistance(a, b, q r2.Vec) float64 {
+	d := r2.Sub(b, a)
+	l2 := r2.Norm2(d)
+	if l2 == 0 {
+		return r2.Norm(r2.Sub(q, a))
+	}
+	t := r2.Dot(r2.Sub(q, a), d) / l2
+	t = max(0, min(1, t))
+	return r2.Norm(r2.Sub(q, r2.Add(a, r2.Scale(t, d))))
+}
@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package polygon
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+func TestSimplify(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		p    []r2.Vec
+		tol  float64
+		want []r2.Vec
+	}{
+		{name: "empty", p: nil, tol: 1, want: nil},
+		{
+			name: "two points",
+			p:    []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 1}}, tol: 1,
+			want: []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 1}},
+		},
+		{
+			name: "collinear",
+			p:    []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 0}}, tol: 0,
+			want: []r2.Vec{{X: 0, Y: 0}, {X: 3, Y: 0}},
+		},
+		{
+			name: "zigzag small tolerance",
+			p:    []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 0.1}, {X: 2, Y: -0.1}, {X: 3, Y: 5}, {X: 4, Y: 6}, {X: 5, Y: 7}, {X: 6, Y: 8.1}, {X: 7, Y: 9}},
+			tol:  0.05,
+			want: []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 0.1}, {X: 2, Y: -0.1}, {X: 3, Y: 5}, {X: 6, Y: 8.1}, {X: 7, Y: 9}},
+		},
+		{
+			name: "zigzag large tolerance",
+			p:    []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 0.1}, {X: 2, Y: -0.1}, {X: 3, Y: 5}, {X: 4, Y: 6}, {X: 5, Y: 7}, {X: 6, Y: 8.1}, {X: 7, Y: 9}},
+			tol:  0.2,
+			want: []r2.Vec{{X: 0, Y: 0}, {X: 2, Y: -0.1}, {X: 3, Y: 5}, {X: 7, Y: 9}},
+		},
+		{
+			name: "closed ring",
+			p:    []r2.Vec{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}, {X: 0, Y: 1}, {X: 0, Y: 0}},
+			tol:  0.5,
+			want: []r2.Vec{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}, {X: 0, Y: 0}},
+		},
+	} {
+		got := Simplify(test.p, test.tol)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected simplification for %s:\ngot: %v\nwant:%v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestSimplifyTolerance(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	p := make([]r2.Vec, 1000)
+	var v r2.Vec
+	for i := range p {
+		v = r2.Add(v, r2.Vec{X: rnd.Float64(), Y: rnd.NormFloat64()})
+		p[i] = v
+	}
+	for _, tol := range []float64{0, 0.5, 2, 10} {
+		s := Simplify(p, tol)
+		if s[0] != p[0] || s[len(s)-1] != p[len(p)-1] {
+			t.Errorf("end points not retained for tol=%v", tol)
+		}
+		// Every removed point is within tol of the segment of
+		// the simplification spanning it.
+		k := 0
+		for _, q := range p {
+			if q == s[k] {
+				if k < len(s)-1 {
+					k++
+				}
+				continue
+			}
+			if d := segmentDistance(s[k-1], s[k], q); d > tol {
+				t.Errorf("point %v at distance %v exceeding tol=%v", q, d, tol)
+			}
+		}
+		if tol == 0 && len(s) != len(p) {
+			t.Errorf("unexpected removal of vertices with zero tolerance: got:%d want:%d", len(s), len(p))
+		}
+	}
+	if d := segmentDistance(r2.Vec{}, r2.Vec{}, r2.Vec{X: 3, Y: 4}); d != 5 {
+		t.Errorf("unexpected distance to degenerate segment: got:%v want:5", d)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const badLength = "r2: mismatched slice lengths"
+
+// Transform is a rigid transformation of the plane, a rotation about
+// the origin followed by a translation. Transform values should be
+// created with NewTransform; the zero value is not a valid
+// transformation.
+type Transform struct {
+	sin, cos float64
+	trans    Vec
+}
+
+// NewTransform returns the rigid transformation that rotates by
+// alpha about the origin and then translates by trans.
+func NewTransform(alpha float64, trans Vec) Transform {
+	sin, cos := math.Sincos(alpha)
+	return Transform{sin: sin, cos: cos, trans: trans}
+}
+
+// IdentityTransform returns the identity transformation.
+func IdentityTransform() Transform {
+	return Transform{cos: 1}
+}
+
+// Angle returns the angle of rotation of the transformation
+// in (-π, π].
+func (t Transform) Angle() float64 {
+	return math.Atan2(t.sin, t.cos)
+}
+
+// Translation returns the translation of the transformation.
+func (t Transform) Translation() Vec {
+	return t.trans
+}
+
+// Apply returns p transformed by t.
+func (t Transform) Apply(p Vec) Vec {
+	return Vec{
+		X: t.cos*p.X - t.sin*p.Y + t.trans.X,
+		Y: t.sin*p.X + t.cos*p.Y + t.trans.Y,
+	}
+}
+
+// ApplyAll places the elements of src transformed by t into dst and
+// returns dst. If dst is nil, a new slice is allocated. ApplyAll panics
+// if dst is not nil and its length is not the length of src. The
+// elements of dst and src may be the same.
+func (t Transform) ApplyAll(dst, src []Vec) []Vec {
+	if dst == nil {
+		dst = make([]Vec, len(src))
+	}
+	if len(dst) != len(src) {
+		panic(badLength)
+	}
+	mulVecs(dst, src, &[2][2]float64{{t.cos, -t.sin}, {t.sin, t.cos}}, t.trans)
+	return dst
+}
+
+// Compose returns the transformation that applies u followed by t, so
+// that t.Compose(u).Apply(p) is t.Apply(u.Apply(p)).
+func (t Transform) Compose(u Transform) Transform {
+	return Transform{
+		sin:   t.sin*u.cos + t.cos*u.sin,
+		cos:   t.cos*u.cos - t.sin*u.sin,
+		trans: t.Apply(u.trans),
+	}
+}
+
+// Inverse returns the inverse of the transformation.
+func (t Transform) Inverse() Transform {
+	inv := Transform{sin: -t.sin, cos: t.cos}
+	inv.trans = Scale(-1, inv.Apply(t.trans))
+	return inv
+}
+
+// Affine returns the affine transformation equal to t.
+func (t Transform) Affine() Affine {
+	return Affine{m: [2][2]float64{{t.cos, -t.sin}, {t.sin, t.cos}}, trans: t.trans}
+}
+
+// InterpolateTransform returns the interpolation between the
+// transformations t0 and t1 at w, with the angle of rotation
+// interpolated linearly along the shorter arc and the translation
+// interpolated linearly. The result is t0 when w is zero and t1
+// when w is one.
+func InterpolateTransform(t0, t1 Transform, w float64) Transform {
+	// The angle of the relative rotation in (-π, π].
+	delta := math.Atan2(t0.cos*t1.sin-t0.sin*t1.cos, t0.cos*t1.cos+t0.sin*t1.sin)
+	rot := NewTransform(w*delta, Vec{})
+	return Transform{
+		sin:   rot.sin*t0.cos + rot.cos*t0.sin,
+		cos:   rot.cos*t0.cos - rot.sin*t0.sin,
+		trans: Add(Scale(1-w, t0.trans), Scale(w, t1.trans)),
+	}
+}
+
+// Affine is an affine transformation of the plane, a linear map
+// followed by a translation. The zero value is the transformation
+// mapping all points to the origin.
+type Affine struct {
+	m     [2][2]float64
+	trans Vec
+}
+
+// NewAffine returns the affine transformation that maps p to m×p+trans.
+// NewAffine panics if m is not 2×2.
+func NewAffine(m mat.Matrix, trans Vec) Affine {
+	r, c := m.Dims()
+	if r != 2 || c != 2 {
+		panic(mat.ErrShape)
+	}
+	a := Affine{trans: trans}
+	for i := range a.m {
+		for j := range a.m[i] {
+			a.m[i][j] = m.At(i, j)
+		}
+	}
+	return a
+}
+
+// Translation returns the translation of the transformation.
+func (a Affine) Translation() Vec {
+	return a.trans
+}
+
+// Homogeneous returns the 3×3 matrix of the transformation acting
+// on homogeneous coordinates.
+func (a Affine) Homogeneous() *mat.Dense {
+	return mat.NewDense(3, 3, []float64{
+		a.m[0][0], a.m[0][1], a.trans.X,
+		a.m[1][0], a.m[1][1], a.trans.Y,
+		0, 0, 1,
+	})
+}
+
+// Apply returns p transformed by a.
+func (a Affine) Apply(p Vec) Vec {
+	return Vec{
+		X: a.m[0][0]*p.X + a.m[0][1]*p.Y + a.trans.X,
+		Y: a.m[1][0]*p.X + a.m[1][1]*p.Y + a.trans.Y,
+	}
+}
+
+// ApplyAll places the elements of src transformed by a into dst and
+// returns dst. If dst is nil, a new slice is allocated. ApplyAll panics
+// if dst is not nil and its length is not the length of src. The
+// elements of dst and src may be the same.
+func (a Affine) ApplyAll(dst, src []Vec) []Vec {
+	if dst == nil {
+		dst = make([]Vec, len(src))
+	}
+	if len(dst) != len(src) {
+		panic(badLength)
+	}
+	mulVecs(dst, src, &a.m, a.trans)
+	return dst
+}
+
+// Compose returns the transformation that applies b followed by a, so
+// that a.Compose(b).Apply(p) is a.Apply(b.Apply(p)).
+func (a Affine) Compose(b Affine) Affine {
+	var c Affine
+	for i := range c.m {
+		for j := range c.m[i] {
+			c.m[i][j] = a.m[i][0]*b.m[0][j] + a.m[i][1]*b.m[1][j]
+		}
+	}
+	c.trans = a.Apply(b.trans)
+	return c
+}
+
+// Inverse returns the inverse of the transformation. If the linear part
+// of the transformation is singular, Inverse returns mat.ErrSingular.
+func (a Affine) Inverse() (Affine, error) {
+	det := a.m[0][0]*a.m[1][1] - a.m[0][1]*a.m[1][0]
+	if det == 0 {
+		return Affine{}, mat.ErrSingular
+	}
+	inv := Affine{m: [2][2]float64{
+		{a.m[1][1] / det, -a.m[0][1] / det},
+		{-a.m[1][0] / det, a.m[0][0] / det},
+	}}
+	lin := Affine{m: inv.m}
+	inv.trans = Scale(-1, lin.Apply(a.trans))
+	return inv, nil
+}
+
+// mulVecs places m×p+t for each p in src into the corresponding
+// element of dst. The elements of the matrix are held in locals so
+// that the loop body is free of loads other than the points.
+func mulVecs(dst, src []Vec, m *[2][2]float64, t Vec) {
+	m00, m01 := m[0][0], m[0][1]
+	m10, m11 := m[1][0], m[1][1]
+	for i, p := range src {
+		dst[i] = Vec{
+			X: m00*p.X + m01*p.Y + t.X,
+			Y: m10*p.X + m11*p.Y + t.Y,
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestRotationOps(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 100; i++ {
+		r := NewRotation(rnd.Float64()*2*math.Pi, randomVec(rnd))
+		p := randomVec(rnd)
+		if got := r.Inverse().Rotate(r.Rotate(p)); !vecApproxEqual(got, p, tol) {
+			t.Errorf("unexpected inverse rotation: got:%v want:%v", got, p)
+		}
+		if got, want := r.Transform().Apply(p), r.Rotate(p); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected rotation as transform: got:%v want:%v", got, want)
+		}
+		src := []Vec{randomVec(rnd), randomVec(rnd), randomVec(rnd)}
+		dst := r.RotateAll(nil, src)
+		for j, v := range src {
+			if want := r.Rotate(v); !vecApproxEqual(dst[j], want, tol) {
+				t.Errorf("unexpected batched rotation: got:%v want:%v", dst[j], want)
+			}
+		}
+	}
+}
+
+func TestTransform(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 100; i++ {
+		alpha := (rnd.Float64() - 0.5) * 2 * math.Pi
+		beta := (rnd.Float64() - 0.5) * 2 * math.Pi
+		a := NewTransform(alpha, randomVec(rnd))
+		b := NewTransform(beta, randomVec(rnd))
+		p := randomVec(rnd)
+
+		if math.Abs(a.Angle()-alpha) > tol {
+			t.Errorf("unexpected angle: got:%v want:%v", a.Angle(), alpha)
+		}
+		if got, want := a.Apply(p), Add(Rotate(p, alpha, Vec{}), a.Translation()); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected transformed point: got:%v want:%v", got, want)
+		}
+		if got, want := a.Compose(b).Apply(p), a.Apply(b.Apply(p)); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected composed transform: got:%v want:%v", got, want)
+		}
+		if got := a.Inverse().Apply(a.Apply(p)); !vecApproxEqual(got, p, tol) {
+			t.Errorf("unexpected inverse transform: got:%v want:%v", got, p)
+		}
+		if got := IdentityTransform().Apply(p); got != p {
+			t.Errorf("unexpected identity transform: got:%v want:%v", got, p)
+		}
+		if got, want := a.Affine().Apply(p), a.Apply(p); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected affine transform: got:%v want:%v", got, want)
+		}
+
+		src := []Vec{randomVec(rnd), randomVec(rnd), randomVec(rnd)}
+		want := make([]Vec, len(src))
+		for j, v := range src {
+			want[j] = a.Apply(v)
+		}
+		got := a.ApplyAll(src, src)
+		for j := range got {
+			if !vecApproxEqual(got[j], want[j], tol) {
+				t.Errorf("unexpected batched transform: got:%v want:%v", got[j], want[j])
+			}
+		}
+
+		if got, want := InterpolateTransform(a, b, 0).Apply(p), a.Apply(p); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected interpolation start: got:%v want:%v", got, want)
+		}
+		if got, want := InterpolateTransform(a, b, 1).Apply(p), b.Apply(p); !vecApproxEqual(got, want, 1e-11) {
+			t.Errorf("unexpected interpolation end: got:%v want:%v", got, want)
+		}
+		// The midpoint angle lies on the shorter arc.
+		mid := InterpolateTransform(a, b, 0.5)
+		d0 := math.Remainder(mid.Angle()-alpha, 2*math.Pi)
+		d1 := math.Remainder(beta-mid.Angle(), 2*math.Pi)
+		if math.Abs(d0-d1) > tol || math.Abs(d0) > math.Pi/2+tol {
+			t.Errorf("unexpected interpolated angle: %v between %v and %v", mid.Angle(), alpha, beta)
+		}
+	}
+}
+
+func TestAffine(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	randMat := func() *mat.Dense {
+		return mat.NewDense(2, 2, []float64{rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64()})
+	}
+	for i := 0; i < 100; i++ {
+		a := NewAffine(randMat(), randomVec(rnd))
+		b := NewAffine(randMat(), randomVec(rnd))
+		p := randomVec(rnd)
+
+		var want mat.VecDense
+		want.MulVec(a.Homogeneous(), mat.NewVecDense(3, []float64{p.X, p.Y, 1}))
+		if got := a.Apply(p); !vecApproxEqual(got, Vec{want.AtVec(0), want.AtVec(1)}, tol) || want.AtVec(2) != 1 {
+			t.Errorf("unexpected affine transform: got:%v want:%v", got, mat.Formatted(want.T()))
+		}
+		if got, want := a.Compose(b).Apply(p), a.Apply(b.Apply(p)); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected composed transform: got:%v want:%v", got, want)
+		}
+		inv, err := a.Inverse()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := inv.Apply(a.Apply(p)); !vecApproxEqual(got, p, tol) {
+			t.Errorf("unexpected inverse transform: got:%v want:%v", got, p)
+		}
+		dst := a.ApplyAll(nil, []Vec{p})
+		if want := a.Apply(p); dst[0] != want {
+			t.Errorf("unexpected batched transform: got:%v want:%v", dst[0], want)
+		}
+	}
+
+	singular := NewAffine(mat.NewDense(2, 2, []float64{1, 2, 2, 4}), Vec{})
+	if _, err := singular.Inverse(); err != mat.ErrSingular {
+		t.Errorf("unexpected error for singular transform: got:%v want:%v", err, mat.ErrSingular)
+	}
+}
@@ -106,6 +106,27 @@ func (r Rotation) Rotate(p Vec) Vec {
 	}, r.p)
 }
 
+// Inverse returns the inverse of the rotation, the rotation by the
+// opposite angle around the same point.
+func (r Rotation) Inverse() Rotation {
+	return Rotation{sin: -r.sin, cos: r.cos, p: r.p}
+}
+
+// RotateAll places the elements of src rotated by r into dst and returns
+// dst. If dst is nil, a new slice is allocated. RotateAll panics if dst is
+// not nil and its length is not the length of src. The elements of dst
+// and src may be the same.
+func (r Rotation) RotateAll(dst, src []Vec) []Vec {
+	return r.Transform().ApplyAll(dst, src)
+}
+
+// Transform returns the rigid transformation equal to the rotation.
+func (r Rotation) Transform() Transform {
+	// Rotation about p maps x to R(x-p)+p = Rx + (p-Rp).
+	t := Transform{sin: r.sin, cos: r.cos}
+	return Transform{sin: r.sin, cos: r.cos, trans: Sub(r.p, t.Apply(r.p))}
+}
+
 func (r Rotation) isIdentity() bool {
 	return r.sin == 0 && r.cos == 1
 }
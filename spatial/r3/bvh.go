@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"sort"
+)
+
+// bvhLeafSize is the maximum number of triangles held by a leaf of a BVH.
+const bvhLeafSize = 4
+
+// BVH is a bounding volume hierarchy of axis-aligned bounding boxes
+// over a set of triangles. It accelerates ray intersection and box
+// overlap queries.
+type BVH struct {
+	tris []Triangle
+	// idx holds the indices of the triangles in the order
+	// in which they are referenced by the leaves.
+	idx   []int
+	nodes []bvhNode
+}
+
+// bvhNode is a node of a BVH. Leaves hold the triangles idx[start:end].
+// Internal nodes have end equal to zero and hold the index of their
+// right child in start; the left child immediately follows the node.
+type bvhNode struct {
+	bounds     Box
+	start, end int
+}
+
+// isLeaf returns whether the node is a leaf.
+func (n bvhNode) isLeaf() bool {
+	return n.end != 0
+}
+
+// NewBVH returns a new BVH over the triangles in tris. The
+// triangles are referred to by their index in tris, and tris
+// must not be modified while the BVH is in use.
+func NewBVH(tris []Triangle) *BVH {
+	b := &BVH{tris: tris, idx: make([]int, len(tris))}
+	for i := range b.idx {
+		b.idx[i] = i
+	}
+	if len(tris) != 0 {
+		b.build(0, len(tris))
+	}
+	return b
+}
+
+// build adds the subtree holding the triangles idx[start:end]
+// and returns the index of its root.
+func (b *BVH) build(start, end int) int {
+	node := len(b.nodes)
+	b.nodes = append(b.nodes, bvhNode{})
+
+	bounds := b.tris[b.idx[start]].Bounds()
+	centroids := Box{Min: b.tris[b.idx[start]].Centroid(), Max: b.tris[b.idx[start]].Centroid()}
+	for _, i := range b.idx[start+1 : end] {
+		bounds = merge(bounds, b.tris[i].Bounds())
+		c := b.tris[i].Centroid()
+		centroids = merge(centroids, Box{Min: c, Max: c})
+	}
+	b.nodes[node].bounds = bounds
+
+	size := centroids.Size()
+	if end-start <= bvhLeafSize || size == (Vec{}) {
+		b.nodes[node].start = start
+		b.nodes[node].end = end
+		return node
+	}
+
+	// Split at the median centroid along the axis of
+	// largest centroid extent.
+	axis := 0
+	if size.Y > size.X {
+		axis = 1
+	}
+	if size.Z > elem(size, axis) {
+		axis = 2
+	}
+	idx := b.idx[start:end]
+	sort.Slice(idx, func(i, j int) bool {
+		return elem(b.tris[idx[i]].Centroid(), axis) < elem(b.tris[idx[j]].Centroid(), axis)
+	})
+	mid := start + (end-start)/2
+	b.build(start, mid)
+	right := b.build(mid, end)
+	b.nodes[node].start = right
+	return node
+}
+
+// Len returns the number of triangles in the BVH.
+func (b *BVH) Len() int {
+	return len(b.tris)
+}
+
+// Bounds returns the bounding box of all the triangles in the BVH.
+// Bounds returns the zero Box if the BVH is empty.
+func (b *BVH) Bounds() Box {
+	if len(b.nodes) == 0 {
+		return Box{}
+	}
+	return b.nodes[0].bounds
+}
+
+// Intersect returns the index of the triangle nearest to the origin of
+// the ray that is intersected by the ray, along with the parameter t of
+// the intersection. Ties are broken in favor of the lowest index. If the
+// ray intersects no triangle, ok is false.
+func (b *BVH) Intersect(r Ray) (index int, t float64, ok bool) {
+	if len(b.nodes) == 0 {
+		return -1, 0, false
+	}
+	index = -1
+	best := math.Inf(1)
+	stack := []int{0}
+	for len(stack) != 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		n := b.nodes[node]
+		if tmin, _, hit := r.IntersectBox(n.bounds); !hit || tmin > best {
+			continue
+		}
+		if n.isLeaf() {
+			for _, i := range b.idx[n.start:n.end] {
+				ti, _, _, hit := r.IntersectTriangle(b.tris[i])
+				if hit && (ti < best || (ti == best && i < index)) {
+					index = i
+					best = ti
+				}
+			}
+			continue
+		}
+		// Visit the nearer child first so that the farther
+		// child is more likely to be pruned.
+		left, right := node+1, n.start
+		tl, _, hitl := r.IntersectBox(b.nodes[left].bounds)
+		tr, _, hitr := r.IntersectBox(b.nodes[right].bounds)
+		switch {
+		case hitl && hitr:
+			if tl < tr {
+				stack = append(stack, right, left)
+			} else {
+				stack = append(stack, left, right)
+			}
+		case hitl:
+			stack = append(stack, left)
+		case hitr:
+			stack = append(stack, right)
+		}
+	}
+	if index < 0 {
+		return -1, 0, false
+	}
+	return index, best, true
+}
+
+// Overlap appends to dst the indices of the triangles whose bounding
+// boxes intersect the closed box q and returns the result. The order
+// of the indices is unspecified.
+func (b *BVH) Overlap(dst []int, q Box) []int {
+	if len(b.nodes) == 0 {
+		return dst
+	}
+	stack := []int{0}
+	for len(stack) != 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		n := b.nodes[node]
+		if !overlaps(n.bounds, q) {
+			continue
+		}
+		if n.isLeaf() {
+			for _, i := range b.idx[n.start:n.end] {
+				if overlaps(b.tris[i].Bounds(), q) {
+					dst = append(dst, i)
+				}
+			}
+			continue
+		}
+		stack = append(stack, n.start, node+1)
+	}
+	return dst
+}
+
+// merge returns the smallest box containing a and b. Unlike Box.Union,
+// boxes with zero extent in some dimensions are retained.
+func merge(a, b Box) Box {
+	return Box{Min: minElem(a.Min, b.Min), Max: maxElem(a.Max, b.Max)}
+}
+
+// overlaps returns whether the closed boxes a and b intersect.
+func overlaps(a, b Box) bool {
+	return a.Min.X <= b.Max.X && b.Min.X <= a.Max.X &&
+		a.Min.Y <= b.Max.Y && b.Min.Y <= a.Max.Y &&
+		a.Min.Z <= b.Max.Z && b.Min.Z <= a.Max.Z
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// randomTriangles returns n small random triangles within the unit cube.
+func randomTriangles(rnd *rand.Rand, n int) []Triangle {
+	tris := make([]Triangle, n)
+	for i := range tris {
+		c := Vec{rnd.Float64(), rnd.Float64(), rnd.Float64()}
+		for j := range tris[i] {
+			tris[i][j] = Add(c, Scale(0.05, randomVec(rnd)))
+		}
+	}
+	return tris
+}
+
+func TestBVHIntersect(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 3, 10, 100, 1000} {
+		tris := randomTriangles(rnd, n)
+		bvh := NewBVH(tris)
+		if bvh.Len() != n {
+			t.Errorf("unexpected length: got:%d want:%d", bvh.Len(), n)
+		}
+		var hits int
+		for i := 0; i < 200; i++ {
+			o := Scale(3, randomVec(rnd))
+			target := Vec{rnd.Float64(), rnd.Float64(), rnd.Float64()}
+			r := Ray{Origin: o, Dir: Sub(target, o)}
+
+			wantIdx := -1
+			wantT := math.Inf(1)
+			for j, tri := range tris {
+				tt, _, _, ok := r.IntersectTriangle(tri)
+				if ok && tt < wantT {
+					wantIdx = j
+					wantT = tt
+				}
+			}
+			idx, tt, ok := bvh.Intersect(r)
+			if ok != (wantIdx >= 0) {
+				t.Errorf("unexpected intersection status for n=%d: got:%t want:%t", n, ok, wantIdx >= 0)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			hits++
+			if idx != wantIdx || tt != wantT {
+				t.Errorf("unexpected intersection for n=%d: got:(%d, %v) want:(%d, %v)", n, idx, tt, wantIdx, wantT)
+			}
+		}
+		if n >= 100 && hits == 0 {
+			t.Errorf("no rays hit for n=%d", n)
+		}
+	}
+}
+
+func TestBVHOverlap(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	tris := randomTriangles(rnd, 500)
+	bvh := NewBVH(tris)
+	for i := 0; i < 100; i++ {
+		c := Vec{rnd.Float64(), rnd.Float64(), rnd.Float64()}
+		q := centeredBox(c, Scale(0.2*rnd.Float64(), Vec{1, 1, 1}))
+		var want []int
+		for j, tri := range tris {
+			if overlaps(tri.Bounds(), q) {
+				want = append(want, j)
+			}
+		}
+		got := bvh.Overlap(nil, q)
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("unexpected overlap for %v: got:%v want:%v", q, got, want)
+		}
+	}
+
+	// Axis-aligned triangles have flat bounding boxes that
+	// are retained in the hierarchy.
+	flat := make([]Triangle, 20)
+	for i := range flat {
+		z := float64(i)
+		flat[i] = Triangle{{0, 0, z}, {1, 0, z}, {0, 1, z}}
+	}
+	bvh = NewBVH(flat)
+	if got, want := bvh.Bounds(), NewBox(0, 0, 0, 1, 1, 19); got != want {
+		t.Errorf("unexpected bounds: got:%v want:%v", got, want)
+	}
+	idx, tt, ok := bvh.Intersect(Ray{Origin: Vec{0.1, 0.1, 7.5}, Dir: Vec{0, 0, 1}})
+	if !ok || idx != 8 || tt != 0.5 {
+		t.Errorf("unexpected intersection: got:(%d, %v, %t) want:(8, 0.5, true)", idx, tt, ok)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+// Mesh is a triangle mesh with shared vertices. Each face
+// holds the indices into Vertices of the vertices of a
+// triangle. The ordering of the vertices of a face decides
+// the direction of its normal as for Triangle.
+type Mesh struct {
+	Vertices []Vec
+	Faces    [][3]int
+}
+
+// Triangle returns the ith face of the mesh as a Triangle.
+func (m *Mesh) Triangle(i int) Triangle {
+	f := m.Faces[i]
+	return Triangle{m.Vertices[f[0]], m.Vertices[f[1]], m.Vertices[f[2]]}
+}
+
+// Triangles returns the faces of the mesh as a slice of Triangle.
+func (m *Mesh) Triangles() []Triangle {
+	tris := make([]Triangle, len(m.Faces))
+	for i := range m.Faces {
+		tris[i] = m.Triangle(i)
+	}
+	return tris
+}
+
+// Bounds returns the smallest box containing all the vertices of the
+// mesh. The returned box may have zero extent in some dimensions. Bounds
+// returns the zero Box if the mesh has no vertices.
+func (m *Mesh) Bounds() Box {
+	if len(m.Vertices) == 0 {
+		return Box{}
+	}
+	b := Box{Min: m.Vertices[0], Max: m.Vertices[0]}
+	for _, v := range m.Vertices[1:] {
+		b.Min = minElem(b.Min, v)
+		b.Max = maxElem(b.Max, v)
+	}
+	return b
+}
+
+// Area returns the total surface area of the faces of the mesh.
+func (m *Mesh) Area() float64 {
+	var area float64
+	for i := range m.Faces {
+		area += m.Triangle(i).Area()
+	}
+	return area
+}
+
+// Volume returns the signed volume enclosed by the mesh. The mesh must be
+// closed and its faces consistently oriented for the result to be
+// meaningful. The volume is positive when the face normals point out of
+// the enclosed region.
+func (m *Mesh) Volume() float64 {
+	// By the divergence theorem, the volume is the sum of the
+	// signed volumes of the tetrahedra formed by each face and
+	// the origin. The vertices are taken relative to the first
+	// vertex of the mesh to reduce cancellation for meshes far
+	// from the origin.
+	if len(m.Vertices) == 0 {
+		return 0
+	}
+	o := m.Vertices[0]
+	var vol float64
+	for _, f := range m.Faces {
+		a := Sub(m.Vertices[f[0]], o)
+		b := Sub(m.Vertices[f[1]], o)
+		c := Sub(m.Vertices[f[2]], o)
+		vol += Dot(a, Cross(b, c))
+	}
+	return vol / 6
+}
+
+// Centroid returns the centroid of the volume enclosed by the mesh. As
+// for Volume, the mesh must be closed and consistently oriented.
+func (m *Mesh) Centroid() Vec {
+	if len(m.Vertices) == 0 {
+		return Vec{}
+	}
+	o := m.Vertices[0]
+	var (
+		vol float64
+		sum Vec
+	)
+	for _, f := range m.Faces {
+		a := Sub(m.Vertices[f[0]], o)
+		b := Sub(m.Vertices[f[1]], o)
+		c := Sub(m.Vertices[f[2]], o)
+		// The centroid of the tetrahedron with vertices at
+		// the origin and a, b, c is (a+b+c)/4, weighted by
+		// its signed volume.
+		v := Dot(a, Cross(b, c))
+		vol += v
+		sum = Add(sum, Scale(v, Add(Add(a, b), c)))
+	}
+	return Add(o, Scale(1/(4*vol), sum))
+}
@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// cubeMesh returns a closed mesh of the box b with
+// outward facing normals.
+func cubeMesh(b Box) *Mesh {
+	return &Mesh{
+		Vertices: b.Vertices(),
+		Faces: [][3]int{
+			{0, 2, 1}, {0, 3, 2}, // Bottom.
+			{4, 5, 6}, {4, 6, 7}, // Top.
+			{0, 1, 5}, {0, 5, 4}, // Front.
+			{1, 2, 6}, {1, 6, 5}, // Right.
+			{2, 3, 7}, {2, 7, 6}, // Back.
+			{3, 0, 4}, {3, 4, 7}, // Left.
+		},
+	}
+}
+
+func TestMesh(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 20; i++ {
+		b := NewBox(
+			rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64(),
+			rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64(),
+		)
+		m := cubeMesh(b)
+		size := b.Size()
+		wantVol := size.X * size.Y * size.Z
+		wantArea := 2 * (size.X*size.Y + size.Y*size.Z + size.Z*size.X)
+		if got := m.Volume(); math.Abs(got-wantVol) > tol {
+			t.Errorf("unexpected volume: got:%v want:%v", got, wantVol)
+		}
+		if got := m.Area(); math.Abs(got-wantArea) > tol {
+			t.Errorf("unexpected area: got:%v want:%v", got, wantArea)
+		}
+		if got := m.Bounds(); got != b {
+			t.Errorf("unexpected bounds: got:%v want:%v", got, b)
+		}
+		if got, want := m.Centroid(), b.Center(); Norm(Sub(got, want)) > tol {
+			t.Errorf("unexpected centroid: got:%v want:%v", got, want)
+		}
+
+		// Reversing the orientation of the faces negates the volume.
+		for j, f := range m.Faces {
+			m.Faces[j] = [3]int{f[0], f[2], f[1]}
+		}
+		if got := m.Volume(); math.Abs(got+wantVol) > tol {
+			t.Errorf("unexpected volume of inverted mesh: got:%v want:%v", got, -wantVol)
+		}
+	}
+
+	tetra := &Mesh{
+		Vertices: []Vec{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {0, 0, 1}},
+		Faces:    [][3]int{{0, 2, 1}, {0, 1, 3}, {0, 3, 2}, {1, 2, 3}},
+	}
+	if got := tetra.Volume(); math.Abs(got-1.0/6) > tol {
+		t.Errorf("unexpected tetrahedron volume: got:%v want:%v", got, 1.0/6)
+	}
+	if got, want := tetra.Area(), 1.5+math.Sqrt(3)/2; math.Abs(got-want) > tol {
+		t.Errorf("unexpected tetrahedron area: got:%v want:%v", got, want)
+	}
+	if got, want := tetra.Centroid(), (Vec{0.25, 0.25, 0.25}); Norm(Sub(got, want)) > tol {
+		t.Errorf("unexpected tetrahedron centroid: got:%v want:%v", got, want)
+	}
+	tris := tetra.Triangles()
+	if len(tris) != 4 || tris[3] != (Triangle{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}) {
+		t.Errorf("unexpected triangles: %v", tris)
+	}
+
+	var empty Mesh
+	if empty.Volume() != 0 || empty.Area() != 0 || empty.Bounds() != (Box{}) {
+		t.Error("unexpected properties of empty mesh")
+	}
+}
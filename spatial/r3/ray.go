@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import "math"
+
+// Ray is a half-line in 3D space starting at Origin and
+// extending in the direction Dir. Points on the ray are
+// parameterized by t ≥ 0 as Origin + t*Dir, so distances
+// along the ray are in units of the length of Dir.
+type Ray struct {
+	Origin, Dir Vec
+}
+
+// At returns the point on the ray at parameter t.
+func (r Ray) At(t float64) Vec {
+	return Add(r.Origin, Scale(t, r.Dir))
+}
+
+// IntersectTriangle returns the parameter t ≥ 0 of the intersection of
+// the ray with the triangle and the barycentric coordinates u and v of
+// the intersection point, which is
+//
+//	(1-u-v)*tri[0] + u*tri[1] + v*tri[2].
+//
+// Both faces of the triangle are intersected. If the ray does not hit the
+// triangle or is parallel to its plane, ok is false. Degenerate triangles
+// are never hit.
+func (r Ray) IntersectTriangle(tri Triangle) (t, u, v float64, ok bool) {
+	// Möller and Trumbore, "Fast, minimum storage ray-triangle
+	// intersection", Journal of Graphics Tools 2(1), 1997.
+	e1 := Sub(tri[1], tri[0])
+	e2 := Sub(tri[2], tri[0])
+	p := Cross(r.Dir, e2)
+	det := Dot(e1, p)
+	if det == 0 {
+		return 0, 0, 0, false
+	}
+	inv := 1 / det
+	s := Sub(r.Origin, tri[0])
+	u = Dot(s, p) * inv
+	if u < 0 || u > 1 {
+		return 0, 0, 0, false
+	}
+	q := Cross(s, e1)
+	v = Dot(r.Dir, q) * inv
+	if v < 0 || u+v > 1 {
+		return 0, 0, 0, false
+	}
+	t = Dot(e2, q) * inv
+	if t < 0 || math.IsNaN(t) {
+		return 0, 0, 0, false
+	}
+	return t, u, v, true
+}
+
+// IntersectBox returns the parameters tmin ≤ tmax of the segment of the
+// ray within the box b. The box is treated as closed and may have zero
+// extent in any dimension. If the ray does not hit the box, ok is false.
+// If the ray starts inside the box, tmin is zero.
+func (r Ray) IntersectBox(b Box) (tmin, tmax float64, ok bool) {
+	// Kay and Kajiya slab method.
+	tmin = 0
+	tmax = math.Inf(1)
+	for axis := 0; axis < 3; axis++ {
+		o := elem(r.Origin, axis)
+		d := elem(r.Dir, axis)
+		lo := elem(b.Min, axis)
+		hi := elem(b.Max, axis)
+		if d == 0 {
+			// The ray is parallel to the slab.
+			if o < lo || hi < o {
+				return 0, 0, false
+			}
+			continue
+		}
+		t0 := (lo - o) / d
+		t1 := (hi - o) / d
+		if t0 > t1 {
+			t0, t1 = t1, t0
+		}
+		tmin = math.Max(tmin, t0)
+		tmax = math.Min(tmax, t1)
+		if tmin > tmax {
+			return 0, 0, false
+		}
+	}
+	return tmin, tmax, true
+}
+
+// elem returns the component of v along the given axis,
+// with 0, 1 and 2 corresponding to X, Y and Z.
+func elem(v Vec, axis int) float64 {
+	switch axis {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	case 2:
+		return v.Z
+	default:
+		panic("r3: invalid axis")
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestRayIntersectTriangle(t *testing.T) {
+	tri := Triangle{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}}
+	for _, test := range []struct {
+		ray     Ray
+		ok      bool
+		t, u, v float64
+	}{
+		{ray: Ray{Origin: Vec{0.25, 0.25, 1}, Dir: Vec{0, 0, -1}}, ok: true, t: 1, u: 0.25, v: 0.25},
+		{ray: Ray{Origin: Vec{0.25, 0.25, -2}, Dir: Vec{0, 0, 1}}, ok: true, t: 2, u: 0.25, v: 0.25},
+		{ray: Ray{Origin: Vec{0.5, 0, 1}, Dir: Vec{0, 0, -2}}, ok: true, t: 0.5, u: 0.5, v: 0},
+		{ray: Ray{Origin: Vec{0, 0, 1}, Dir: Vec{0, 0, -1}}, ok: true, t: 1, u: 0, v: 0},
+		{ray: Ray{Origin: Vec{0.25, 0.25, 1}, Dir: Vec{0, 0, 1}}},
+		{ray: Ray{Origin: Vec{0.75, 0.75, 1}, Dir: Vec{0, 0, -1}}},
+		{ray: Ray{Origin: Vec{-0.1, 0.5, 1}, Dir: Vec{0, 0, -1}}},
+		{ray: Ray{Origin: Vec{-1, 0.25, 0}, Dir: Vec{1, 0, 0}}},
+	} {
+		tt, u, v, ok := test.ray.IntersectTriangle(tri)
+		if ok != test.ok {
+			t.Errorf("unexpected intersection status for %v: got:%t want:%t", test.ray, ok, test.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if tt != test.t || u != test.u || v != test.v {
+			t.Errorf("unexpected intersection for %v: got:(%v, %v, %v) want:(%v, %v, %v)",
+				test.ray, tt, u, v, test.t, test.u, test.v)
+		}
+	}
+
+	if _, _, _, ok := (Ray{Dir: Vec{0, 0, 1}}).IntersectTriangle(Triangle{{0, 0, 1}, {1, 1, 1}, {2, 2, 1}}); ok {
+		t.Error("unexpected intersection with degenerate triangle")
+	}
+
+	// Rays through random points of random triangles hit the
+	// triangle at those points.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-9
+	for i := 0; i < 200; i++ {
+		tri := Triangle{randomVec(rnd), randomVec(rnd), randomVec(rnd)}
+		u := rnd.Float64()
+		v := rnd.Float64() * (1 - u)
+		p := Add(Add(Scale(1-u-v, tri[0]), Scale(u, tri[1])), Scale(v, tri[2]))
+		o := Add(p, Scale(1+rnd.Float64(), randomVec(rnd)))
+		r := Ray{Origin: o, Dir: Sub(p, o)}
+		tt, gu, gv, ok := r.IntersectTriangle(tri)
+		if !ok {
+			t.Errorf("ray %v does not hit triangle %v", r, tri)
+			continue
+		}
+		if math.Abs(tt-1) > tol || math.Abs(gu-u) > tol || math.Abs(gv-v) > tol {
+			t.Errorf("unexpected intersection: got:(%v, %v, %v) want:(1, %v, %v)", tt, gu, gv, u, v)
+		}
+		if d := Norm(Sub(r.At(tt), p)); d > tol {
+			t.Errorf("intersection point %v not at %v", r.At(tt), p)
+		}
+	}
+}
+
+func TestRayIntersectBox(t *testing.T) {
+	box := NewBox(0, 0, 0, 1, 2, 3)
+	for _, test := range []struct {
+		ray        Ray
+		ok         bool
+		tmin, tmax float64
+	}{
+		{ray: Ray{Origin: Vec{-1, 1, 1}, Dir: Vec{1, 0, 0}}, ok: true, tmin: 1, tmax: 2},
+		{ray: Ray{Origin: Vec{0.5, 1, 1}, Dir: Vec{1, 0, 0}}, ok: true, tmin: 0, tmax: 0.5},
+		{ray: Ray{Origin: Vec{0.5, 1, 5}, Dir: Vec{0, 0, -2}}, ok: true, tmin: 1, tmax: 2.5},
+		{ray: Ray{Origin: Vec{-1, -1, -1}, Dir: Vec{1, 1, 1}}, ok: true, tmin: 1, tmax: 2},
+		{ray: Ray{Origin: Vec{0, 0, -1}, Dir: Vec{0, 0, 1}}, ok: true, tmin: 1, tmax: 4},
+		{ray: Ray{Origin: Vec{2, 1, 1}, Dir: Vec{1, 0, 0}}},
+		{ray: Ray{Origin: Vec{-1, 3, 1}, Dir: Vec{1, 0, 0}}},
+		{ray: Ray{Origin: Vec{-1, 1, 1}, Dir: Vec{1, 3, 0}}},
+	} {
+		tmin, tmax, ok := test.ray.IntersectBox(box)
+		if ok != test.ok {
+			t.Errorf("unexpected intersection status for %v: got:%t want:%t", test.ray, ok, test.ok)
+			continue
+		}
+		if ok && (tmin != test.tmin || tmax != test.tmax) {
+			t.Errorf("unexpected intersection for %v: got:(%v, %v) want:(%v, %v)",
+				test.ray, tmin, tmax, test.tmin, test.tmax)
+		}
+	}
+
+	// Boxes with zero extent are intersected.
+	flat := Box{Min: Vec{0, 0, 1}, Max: Vec{1, 1, 1}}
+	tmin, tmax, ok := (Ray{Origin: Vec{0.5, 0.5, 0}, Dir: Vec{0, 0, 1}}).IntersectBox(flat)
+	if !ok || tmin != 1 || tmax != 1 {
+		t.Errorf("unexpected intersection with flat box: got:(%v, %v, %t) want:(1, 1, true)", tmin, tmax, ok)
+	}
+}
@@ -7,12 +7,12 @@ package r3
 import (
 	"math"
 
+	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/gonum/num/quat"
 )
 
 // TODO: possibly useful additions to the current rotation API:
 //  - create rotations from Euler angles (NewRotationFromEuler?)
-//  - create rotations from rotation matrices (NewRotationFromMatrix?)
 //  - return the equivalent Euler angles from a Rotation
 //
 // Euler angles have issues (see [1] for a discussion).
@@ -49,6 +49,74 @@ func (r Rotation) Rotate(p Vec) Vec {
 	return Vec{X: pp.Imag, Y: pp.Jmag, Z: pp.Kmag}
 }
 
+// NewRotationFromMat returns the rotation corresponding to the 3×3
+// rotation matrix m. The result is normalized, so matrices that are
+// close to rotations give close rotations. NewRotationFromMat panics
+// if m is not 3×3.
+func NewRotationFromMat(m mat.Matrix) Rotation {
+	r, c := m.Dims()
+	if r != 3 || c != 3 {
+		panic(mat.ErrShape)
+	}
+	var a [3][3]float64
+	for i := range a {
+		for j := range a[i] {
+			a[i][j] = m.At(i, j)
+		}
+	}
+	return Rotation(quat.FromRotationMatrix(a))
+}
+
+// AxisAngle returns the angle alpha in [0, π] and the unit axis of the
+// rotation. The axis of the identity rotation is the zero vector.
+func (r Rotation) AxisAngle() (alpha float64, axis Vec) {
+	q := quat.Number(r)
+	if q.Real < 0 {
+		q = quat.Scale(-1, q)
+	}
+	v := Vec{X: q.Imag, Y: q.Jmag, Z: q.Kmag}
+	n := Norm(v)
+	if n == 0 {
+		return 0, Vec{}
+	}
+	return 2 * math.Atan2(n, q.Real), Scale(1/n, v)
+}
+
+// Inverse returns the inverse of the rotation.
+func (r Rotation) Inverse() Rotation {
+	return Rotation(quat.Conj(quat.Number(r)))
+}
+
+// Compose returns the rotation that applies s followed by r, so that
+// r.Compose(s).Rotate(p) is r.Rotate(s.Rotate(p)).
+func (r Rotation) Compose(s Rotation) Rotation {
+	return Rotation(quat.Mul(quat.Number(r), quat.Number(s)))
+}
+
+// RotateAll places the elements of src rotated by r into dst and returns
+// dst. If dst is nil, a new slice is allocated. RotateAll panics if dst is
+// not nil and its length is not the length of src. The elements of dst
+// and src may be the same.
+func (r Rotation) RotateAll(dst, src []Vec) []Vec {
+	if dst == nil {
+		dst = make([]Vec, len(src))
+	}
+	if len(dst) != len(src) {
+		panic(badLength)
+	}
+	m := quat.RotationMatrix(quat.Number(r))
+	mulVecs(dst, src, &m, Vec{})
+	return dst
+}
+
+// Slerp returns the spherical linear interpolation between the rotations
+// r0 and r1 at t, with Slerp(r0, r1, 0) equal to r0 and Slerp(r0, r1, 1)
+// equivalent to r1. The interpolation follows the shorter of the two
+// arcs between the rotations, at constant angular velocity.
+func Slerp(r0, r1 Rotation, t float64) Rotation {
+	return Rotation(quat.Slerp(quat.Number(r0), quat.Number(r1), t))
+}
+
 func (r Rotation) isIdentity() bool {
 	return r == Rotation{Real: 1}
 }
@@ -0,0 +1,238 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/quat"
+)
+
+const badLength = "r3: mismatched slice lengths"
+
+// Transform is a rigid transformation of space, a rotation about
+// the origin followed by a translation. Transform values should
+// be created with NewTransform; the zero value is not a valid
+// transformation.
+type Transform struct {
+	rot   Rotation
+	trans Vec
+}
+
+// NewTransform returns the rigid transformation that rotates by
+// rot about the origin and then translates by trans. The rotation
+// must be a unit quaternion.
+func NewTransform(rot Rotation, trans Vec) Transform {
+	return Transform{rot: rot, trans: trans}
+}
+
+// IdentityTransform returns the identity transformation.
+func IdentityTransform() Transform {
+	return Transform{rot: Rotation{Real: 1}}
+}
+
+// Rotation returns the rotation of the transformation.
+func (t Transform) Rotation() Rotation {
+	return t.rot
+}
+
+// Translation returns the translation of the transformation.
+func (t Transform) Translation() Vec {
+	return t.trans
+}
+
+// Apply returns p transformed by t.
+func (t Transform) Apply(p Vec) Vec {
+	return Add(t.rot.Rotate(p), t.trans)
+}
+
+// ApplyAll places the elements of src transformed by t into dst and
+// returns dst. If dst is nil, a new slice is allocated. ApplyAll panics
+// if dst is not nil and its length is not the length of src. The
+// elements of dst and src may be the same.
+func (t Transform) ApplyAll(dst, src []Vec) []Vec {
+	if dst == nil {
+		dst = make([]Vec, len(src))
+	}
+	if len(dst) != len(src) {
+		panic(badLength)
+	}
+	m := quat.RotationMatrix(quat.Number(t.rot))
+	mulVecs(dst, src, &m, t.trans)
+	return dst
+}
+
+// Compose returns the transformation that applies u followed by t, so
+// that t.Compose(u).Apply(p) is t.Apply(u.Apply(p)).
+func (t Transform) Compose(u Transform) Transform {
+	return Transform{
+		rot:   t.rot.Compose(u.rot),
+		trans: t.Apply(u.trans),
+	}
+}
+
+// Inverse returns the inverse of the transformation.
+func (t Transform) Inverse() Transform {
+	inv := t.rot.Inverse()
+	return Transform{rot: inv, trans: Scale(-1, inv.Rotate(t.trans))}
+}
+
+// Affine returns the affine transformation equal to t.
+func (t Transform) Affine() Affine {
+	return Affine{m: quat.RotationMatrix(quat.Number(t.rot)), trans: t.trans}
+}
+
+// InterpolateTransform returns the interpolation between the
+// transformations t0 and t1 at w, with the rotation interpolated
+// by Slerp and the translation interpolated linearly. The result is
+// t0 when w is zero and t1 when w is one. Unlike the screw interpolation
+// of dualquat.Sclerp, the intermediate transformations depend on the
+// choice of origin.
+func InterpolateTransform(t0, t1 Transform, w float64) Transform {
+	return Transform{
+		rot:   Slerp(t0.rot, t1.rot, w),
+		trans: Add(Scale(1-w, t0.trans), Scale(w, t1.trans)),
+	}
+}
+
+// Affine is an affine transformation of space, a linear map
+// followed by a translation. The zero value is the transformation
+// mapping all points to the origin.
+type Affine struct {
+	m     [3][3]float64
+	trans Vec
+}
+
+// NewAffine returns the affine transformation that maps p to m×p+trans.
+// NewAffine panics if m is not 3×3.
+func NewAffine(m mat.Matrix, trans Vec) Affine {
+	r, c := m.Dims()
+	if r != 3 || c != 3 {
+		panic(mat.ErrShape)
+	}
+	a := Affine{trans: trans}
+	for i := range a.m {
+		for j := range a.m[i] {
+			a.m[i][j] = m.At(i, j)
+		}
+	}
+	return a
+}
+
+// Mat returns the linear part of the transformation.
+func (a Affine) Mat() *Mat {
+	m := new(Mat)
+	for i := range a.m {
+		for j, v := range a.m[i] {
+			m.Set(i, j, v)
+		}
+	}
+	return m
+}
+
+// Translation returns the translation of the transformation.
+func (a Affine) Translation() Vec {
+	return a.trans
+}
+
+// Homogeneous returns the 4×4 matrix of the transformation acting
+// on homogeneous coordinates.
+func (a Affine) Homogeneous() *mat.Dense {
+	h := mat.NewDense(4, 4, nil)
+	for i := range a.m {
+		for j, v := range a.m[i] {
+			h.Set(i, j, v)
+		}
+	}
+	h.Set(0, 3, a.trans.X)
+	h.Set(1, 3, a.trans.Y)
+	h.Set(2, 3, a.trans.Z)
+	h.Set(3, 3, 1)
+	return h
+}
+
+// Apply returns p transformed by a.
+func (a Affine) Apply(p Vec) Vec {
+	return Add(mulVec(&a.m, p), a.trans)
+}
+
+// ApplyAll places the elements of src transformed by a into dst and
+// returns dst. If dst is nil, a new slice is allocated. ApplyAll panics
+// if dst is not nil and its length is not the length of src. The
+// elements of dst and src may be the same.
+func (a Affine) ApplyAll(dst, src []Vec) []Vec {
+	if dst == nil {
+		dst = make([]Vec, len(src))
+	}
+	if len(dst) != len(src) {
+		panic(badLength)
+	}
+	mulVecs(dst, src, &a.m, a.trans)
+	return dst
+}
+
+// Compose returns the transformation that applies b followed by a, so
+// that a.Compose(b).Apply(p) is a.Apply(b.Apply(p)).
+func (a Affine) Compose(b Affine) Affine {
+	var c Affine
+	for i := range c.m {
+		for j := range c.m[i] {
+			c.m[i][j] = a.m[i][0]*b.m[0][j] + a.m[i][1]*b.m[1][j] + a.m[i][2]*b.m[2][j]
+		}
+	}
+	c.trans = a.Apply(b.trans)
+	return c
+}
+
+// Inverse returns the inverse of the transformation. If the linear part
+// of the transformation is singular, Inverse returns mat.ErrSingular.
+func (a Affine) Inverse() (Affine, error) {
+	m := &a.m
+	// The inverse is the adjugate divided by the determinant.
+	var adj [3][3]float64
+	for i := 0; i < 3; i++ {
+		i1, i2 := (i+1)%3, (i+2)%3
+		for j := 0; j < 3; j++ {
+			j1, j2 := (j+1)%3, (j+2)%3
+			adj[j][i] = m[i1][j1]*m[i2][j2] - m[i1][j2]*m[i2][j1]
+		}
+	}
+	det := m[0][0]*adj[0][0] + m[0][1]*adj[1][0] + m[0][2]*adj[2][0]
+	if det == 0 {
+		return Affine{}, mat.ErrSingular
+	}
+	var inv Affine
+	for i := range adj {
+		for j, v := range adj[i] {
+			inv.m[i][j] = v / det
+		}
+	}
+	inv.trans = Scale(-1, mulVec(&inv.m, a.trans))
+	return inv, nil
+}
+
+// mulVec returns m×p.
+func mulVec(m *[3][3]float64, p Vec) Vec {
+	return Vec{
+		X: m[0][0]*p.X + m[0][1]*p.Y + m[0][2]*p.Z,
+		Y: m[1][0]*p.X + m[1][1]*p.Y + m[1][2]*p.Z,
+		Z: m[2][0]*p.X + m[2][1]*p.Y + m[2][2]*p.Z,
+	}
+}
+
+// mulVecs places m×p+t for each p in src into the corresponding
+// element of dst. The elements of the matrix are held in locals so
+// that the loop body is free of loads other than the points.
+func mulVecs(dst, src []Vec, m *[3][3]float64, t Vec) {
+	m00, m01, m02 := m[0][0], m[0][1], m[0][2]
+	m10, m11, m12 := m[1][0], m[1][1], m[1][2]
+	m20, m21, m22 := m[2][0], m[2][1], m[2][2]
+	for i, p := range src {
+		dst[i] = Vec{
+			X: m00*p.X + m01*p.Y + m02*p.Z + t.X,
+			Y: m10*p.X + m11*p.Y + m12*p.Z + t.Y,
+			Z: m20*p.X + m21*p.Y + m22*p.Z + t.Z,
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// randomRotation returns a random rotation.
+func randomRotation(rnd *rand.Rand) Rotation {
+	return NewRotation(rnd.Float64()*2*math.Pi, randomVec(rnd))
+}
+
+func TestRotationOps(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 100; i++ {
+		r := randomRotation(rnd)
+		s := randomRotation(rnd)
+		p := randomVec(rnd)
+
+		if got, want := r.Compose(s).Rotate(p), r.Rotate(s.Rotate(p)); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected composed rotation: got:%v want:%v", got, want)
+		}
+		if got := r.Inverse().Rotate(r.Rotate(p)); !vecApproxEqual(got, p, tol) {
+			t.Errorf("unexpected inverse rotation: got:%v want:%v", got, p)
+		}
+
+		alpha, axis := r.AxisAngle()
+		if alpha < 0 || alpha > math.Pi || math.Abs(Norm(axis)-1) > tol {
+			t.Errorf("unexpected axis-angle: %v %v", alpha, axis)
+		}
+		if got, want := NewRotation(alpha, axis).Rotate(p), r.Rotate(p); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected axis-angle rotation: got:%v want:%v", got, want)
+		}
+
+		if got, want := NewRotationFromMat(r.Mat()).Rotate(p), r.Rotate(p); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected rotation from matrix: got:%v want:%v", got, want)
+		}
+
+		src := []Vec{randomVec(rnd), randomVec(rnd), randomVec(rnd)}
+		dst := r.RotateAll(nil, src)
+		for j, v := range src {
+			if want := r.Rotate(v); !vecApproxEqual(dst[j], want, tol) {
+				t.Errorf("unexpected batched rotation: got:%v want:%v", dst[j], want)
+			}
+		}
+
+		// Interpolation at the end points and the midpoint.
+		if got, want := Slerp(r, s, 0).Rotate(p), r.Rotate(p); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected slerp start: got:%v want:%v", got, want)
+		}
+		if got, want := Slerp(r, s, 1).Rotate(p), s.Rotate(p); !vecApproxEqual(got, want, 1e-11) {
+			t.Errorf("unexpected slerp end: got:%v want:%v", got, want)
+		}
+		mid := Slerp(r, s, 0.5)
+		a0, _ := mid.Compose(r.Inverse()).AxisAngle()
+		a1, _ := s.Compose(mid.Inverse()).AxisAngle()
+		if math.Abs(a0-a1) > 1e-10 {
+			t.Errorf("slerp midpoint not equidistant: %v != %v", a0, a1)
+		}
+	}
+
+	_, axis := Rotation{Real: 1}.AxisAngle()
+	if axis != (Vec{}) {
+		t.Errorf("unexpected axis of identity: %v", axis)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	const tol = 1e-11
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 100; i++ {
+		a := NewTransform(randomRotation(rnd), randomVec(rnd))
+		b := NewTransform(randomRotation(rnd), randomVec(rnd))
+		p := randomVec(rnd)
+
+		if got, want := a.Apply(p), Add(a.Rotation().Rotate(p), a.Translation()); got != want {
+			t.Errorf("unexpected transformed point: got:%v want:%v", got, want)
+		}
+		if got, want := a.Compose(b).Apply(p), a.Apply(b.Apply(p)); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected composed transform: got:%v want:%v", got, want)
+		}
+		if got := a.Inverse().Apply(a.Apply(p)); !vecApproxEqual(got, p, tol) {
+			t.Errorf("unexpected inverse transform: got:%v want:%v", got, p)
+		}
+		if got := IdentityTransform().Apply(p); got != p {
+			t.Errorf("unexpected identity transform: got:%v want:%v", got, p)
+		}
+		if got, want := a.Affine().Apply(p), a.Apply(p); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected affine transform: got:%v want:%v", got, want)
+		}
+
+		src := []Vec{randomVec(rnd), randomVec(rnd), randomVec(rnd)}
+		want := make([]Vec, len(src))
+		for j, v := range src {
+			want[j] = a.Apply(v)
+		}
+		// The destination may be the source.
+		got := a.ApplyAll(src, src)
+		for j := range got {
+			if !vecApproxEqual(got[j], want[j], tol) {
+				t.Errorf("unexpected batched transform: got:%v want:%v", got[j], want[j])
+			}
+		}
+
+		for _, w := range []float64{0, 1} {
+			want := a
+			if w == 1 {
+				want = b
+			}
+			if got, want := InterpolateTransform(a, b, w).Apply(p), want.Apply(p); !vecApproxEqual(got, want, tol) {
+				t.Errorf("unexpected interpolation at %v: got:%v want:%v", w, got, want)
+			}
+		}
+		mid := InterpolateTransform(a, b, 0.5)
+		if got, want := mid.Translation(), Scale(0.5, Add(a.Translation(), b.Translation())); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected interpolated translation: got:%v want:%v", got, want)
+		}
+	}
+
+	if !panics(func() { NewTransform(Rotation{Real: 1}, Vec{}).ApplyAll(make([]Vec, 1), make([]Vec, 2)) }) {
+		t.Error("expected panic for mismatched lengths")
+	}
+}
+
+func TestAffine(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	randMat := func() *mat.Dense {
+		m := mat.NewDense(3, 3, nil)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				m.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		return m
+	}
+	for i := 0; i < 100; i++ {
+		ma := randMat()
+		a := NewAffine(ma, randomVec(rnd))
+		b := NewAffine(randMat(), randomVec(rnd))
+		p := randomVec(rnd)
+
+		var want mat.VecDense
+		want.MulVec(a.Homogeneous(), mat.NewVecDense(4, []float64{p.X, p.Y, p.Z, 1}))
+		got := a.Apply(p)
+		if !vecApproxEqual(got, Vec{want.AtVec(0), want.AtVec(1), want.AtVec(2)}, tol) || want.AtVec(3) != 1 {
+			t.Errorf("unexpected affine transform: got:%v want:%v", got, mat.Formatted(want.T()))
+		}
+		if !mat.Equal(a.Mat(), ma) {
+			t.Error("unexpected linear part")
+		}
+		if got, want := a.Compose(b).Apply(p), a.Apply(b.Apply(p)); !vecApproxEqual(got, want, tol) {
+			t.Errorf("unexpected composed transform: got:%v want:%v", got, want)
+		}
+		inv, err := a.Inverse()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := inv.Apply(a.Apply(p)); !vecApproxEqual(got, p, tol) {
+			t.Errorf("unexpected inverse transform: got:%v want:%v", got, p)
+		}
+		src := []Vec{randomVec(rnd), randomVec(rnd)}
+		dst := a.ApplyAll(nil, src)
+		for j, v := range src {
+			if want := a.Apply(v); !vecApproxEqual(dst[j], want, tol) {
+				t.Errorf("unexpected batched transform: got:%v want:%v", dst[j], want)
+			}
+		}
+	}
+
+	singular := NewAffine(mat.NewDense(3, 3, []float64{1, 2, 3, 2, 4, 6, 0, 0, 1}), Vec{})
+	if _, err := singular.Inverse(); err != mat.ErrSingular {
+		t.Errorf("unexpected error for singular transform: got:%v want:%v", err, mat.ErrSingular)
+	}
+	if !panics(func() { NewAffine(mat.NewDense(2, 3, nil), Vec{}) }) {
+		t.Error("expected panic for non-3×3 matrix")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
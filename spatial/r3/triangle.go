@@ -39,6 +39,15 @@ func (t Triangle) IsDegenerate(tol float64) bool {
 	return dist <= tol
 }
 
+// Bounds returns the smallest axis-aligned box containing the triangle.
+// The returned box may have zero extent in some dimensions.
+func (t Triangle) Bounds() Box {
+	return Box{
+		Min: minElem(minElem(t[0], t[1]), t[2]),
+		Max: maxElem(maxElem(t[0], t[1]), t[2]),
+	}
+}
+
 // longIdx returns index of the longest side. The sides
 // of the triangles are as follows:
 //   - Side 0 formed by vertices 0 and 1
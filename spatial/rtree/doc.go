@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rtree implements an R*-tree spatial index of rectangles in the
+// plane.
+//
+// Unlike the point indexes of the kdtree and vptree packages, an R*-tree
+// indexes items with spatial extent by their bounding boxes and supports
+// dynamic insertion and deletion.
+package rtree // import "gonum.org/v1/gonum/spatial/rtree"
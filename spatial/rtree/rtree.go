@@ -0,0 +1,519 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rtree
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// Item is the element interface for values stored in an R*-tree.
+type Item interface {
+	// Bounds returns the bounding box of the item.
+	// The bounding box of an item must not change
+	// while the item is held in a tree.
+	Bounds() r2.Box
+}
+
+// Tree is an R*-tree, as described in Beckmann, Kriegel, Schneider and
+// Seeger, "The R*-tree: an efficient and robust access method for points and
+// rectangles", SIGMOD '90, doi:10.1145/93597.98741.
+type Tree struct {
+	root *node
+
+	// height is the level of the root.
+	// Leaves are at level zero.
+	height int
+
+	// min and max are the minimum and
+	// maximum number of entries in a
+	// non-root node.
+	min, max int
+
+	count int
+}
+
+// node is an R*-tree node.
+type node struct {
+	leaf    bool
+	entries []entry
+}
+
+// entry is a node entry. Entries of leaf nodes hold an item
+// and entries of internal nodes hold a child node.
+type entry struct {
+	box   r2.Box
+	child *node
+	item  Item
+}
+
+// New returns an empty R*-tree with nodes holding at most maxEntries
+// entries. New will panic if maxEntries is less than 4.
+func New(maxEntries int) *Tree {
+	if maxEntries < 4 {
+		panic("rtree: invalid node capacity")
+	}
+	return &Tree{
+		root: &node{leaf: true},
+		// The minimum fill of 40% is recommended
+		// by Beckmann et al.
+		min: max(2, maxEntries*2/5),
+		max: maxEntries,
+	}
+}
+
+// Len returns the number of items in the tree.
+func (t *Tree) Len() int { return t.count }
+
+// Bounds returns the bounding box of all the items in the tree. Bounds
+// returns the zero Box if the tree is empty.
+func (t *Tree) Bounds() r2.Box {
+	if len(t.root.entries) == 0 {
+		return r2.Box{}
+	}
+	return t.root.bounds()
+}
+
+// bounds returns the bounding box of the entries of n.
+func (n *node) bounds() r2.Box {
+	b := n.entries[0].box
+	for _, e := range n.entries[1:] {
+		b = union(b, e.box)
+	}
+	return b
+}
+
+// Insert inserts the item into the tree.
+func (t *Tree) Insert(it Item) {
+	t.insert(entry{box: it.Bounds(), item: it}, 0, make(map[int]bool))
+	t.count++
+}
+
+// insert inserts the entry e into a node at the given level. Levels that
+// have had entries forcibly reinserted during the current top-level insertion
+// are marked in reinserted.
+func (t *Tree) insert(e entry, level int, reinserted map[int]bool) {
+	path := []*node{t.root}
+	var idx []int
+	n := t.root
+	for l := t.height; l > level; l-- {
+		i := chooseSubtree(n, e.box, l == 1)
+		n.entries[i].box = union(n.entries[i].box, e.box)
+		n = n.entries[i].child
+		path = append(path, n)
+		idx = append(idx, i)
+	}
+	n.entries = append(n.entries, e)
+
+	// Treat overflowing nodes from the bottom
+	// of the path upwards.
+	for k := len(path) - 1; k >= 0; k-- {
+		n := path[k]
+		if len(n.entries) <= t.max {
+			return
+		}
+		l := t.height - k
+		if k != 0 && !reinserted[l] {
+			reinserted[l] = true
+			removed := t.removeFarthest(n)
+			for j := k - 1; j >= 0; j-- {
+				path[j].entries[idx[j]].box = path[j+1].bounds()
+			}
+			for _, r := range removed {
+				t.insert(r, l, reinserted)
+			}
+			return
+		}
+
+		sibling := t.split(n)
+		if k == 0 {
+			t.root = &node{entries: []entry{
+				{box: n.bounds(), child: n},
+				{box: sibling.bounds(), child: sibling},
+			}}
+			t.height++
+			return
+		}
+		parent := path[k-1]
+		parent.entries[idx[k-1]].box = n.bounds()
+		parent.entries = append(parent.entries, entry{box: sibling.bounds(), child: sibling})
+	}
+}
+
+// chooseSubtree returns the index of the entry of n whose subtree should hold
+// a new entry with bounding box b. If the children of n are leaves, the entry
+// needing least enlargement of its overlap with the other entries is chosen;
+// otherwise the entry needing least enlargement of its area is chosen. Ties
+// are broken by least area enlargement and then least area.
+func chooseSubtree(n *node, b r2.Box, leafChildren bool) int {
+	best := -1
+	var bestOverlap, bestEnlarge, bestArea float64
+	for i, e := range n.entries {
+		grown := union(e.box, b)
+		a := area(e.box)
+		enlarge := area(grown) - a
+		var overlap float64
+		if leafChildren {
+			for j, o := range n.entries {
+				if j != i {
+					overlap += intersection(grown, o.box) - intersection(e.box, o.box)
+				}
+			}
+		}
+		if best < 0 ||
+			overlap < bestOverlap ||
+			(overlap == bestOverlap && enlarge < bestEnlarge) ||
+			(overlap == bestOverlap && enlarge == bestEnlarge && a < bestArea) {
+			best, bestOverlap, bestEnlarge, bestArea = i, overlap, enlarge, a
+		}
+	}
+	return best
+}
+
+// removeFarthest removes the 30% of the entries of the overflowing node n
+// whose centers are farthest from the center of n, and returns them in order
+// of increasing distance for reinsertion.
+func (t *Tree) removeFarthest(n *node) []entry {
+	c := n.bounds().Center()
+	sort.SliceStable(n.entries, func(i, j int) bool {
+		return r2.Norm2(r2.Sub(n.entries[i].box.Center(), c)) < r2.Norm2(r2.Sub(n.entries[j].box.Center(), c))
+	})
+	p := max(1, (t.max+1)*3/10)
+	keep := len(n.entries) - p
+	removed := make([]entry, p)
+	copy(removed, n.entries[keep:])
+	n.entries = n.entries[:keep]
+	return removed
+}
+
+// split splits the overflowing node n by the R* topological split, leaving
+// the first group of entries in n and returning a new node holding the
+// second group.
+func (t *Tree) split(n *node) *node {
+	entries := n.entries
+	distributions := len(entries) - 2*t.min + 1
+
+	// Choose the split axis as the axis with the
+	// least sum of margins over all distributions.
+	bestAxis, bestMargin := 0, math.Inf(1)
+	for axis := range 2 {
+		var margin float64
+		for _, byMax := range []bool{false, true} {
+			sortEntries(entries, axis, byMax)
+			for k := range distributions {
+				a, b := groupBounds(entries, t.min+k)
+				margin += perimeter(a) + perimeter(b)
+			}
+		}
+		if margin < bestMargin {
+			bestAxis, bestMargin = axis, margin
+		}
+	}
+
+	// Choose the distribution on the split axis
+	// with least overlap between the groups, and
+	// least total area among those.
+	bestByMax, bestSplit := false, -1
+	var bestOverlap, bestArea float64
+	for _, byMax := range []bool{false, true} {
+		sortEntries(entries, bestAxis, byMax)
+		for k := range distributions {
+			a, b := groupBounds(entries, t.min+k)
+			overlap := intersection(a, b)
+			total := area(a) + area(b)
+			if bestSplit < 0 || overlap < bestOverlap || (overlap == bestOverlap && total < bestArea) {
+				bestByMax, bestSplit, bestOverlap, bestArea = byMax, t.min+k, overlap, total
+			}
+		}
+	}
+	sortEntries(entries, bestAxis, bestByMax)
+
+	sibling := &node{leaf: n.leaf, entries: make([]entry, len(entries)-bestSplit, t.max+1)}
+	copy(sibling.entries, entries[bestSplit:])
+	n.entries = entries[:bestSplit]
+	return sibling
+}
+
+// sortEntries sorts the entries by the lower or upper bound of their boxes
+// on the given axis, with ties broken by the other bound.
+func sortEntries(entries []entry, axis int, byMax bool) {
+	lo := func(b r2.Box) float64 {
+		if axis == 0 {
+			return b.Min.X
+		}
+		return b.Min.Y
+	}
+	hi := func(b r2.Box) float64 {
+		if axis == 0 {
+			return b.Max.X
+		}
+		return b.Max.Y
+	}
+	if byMax {
+		lo, hi = hi, lo
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].box, entries[j].box
+		if lo(a) != lo(b) {
+			return lo(a) < lo(b)
+		}
+		return hi(a) < hi(b)
+	})
+}
+
+// groupBounds returns the bounding boxes of the
+// entries before and after the kth entry.
+func groupBounds(entries []entry, k int) (a, b r2.Box) {
+	a, b = entries[0].box, entries[k].box
+	for _, e := range entries[1:k] {
+		a = union(a, e.box)
+	}
+	for _, e := range entries[k+1:] {
+		b = union(b, e.box)
+	}
+	return a, b
+}
+
+// Delete removes the item from the tree and returns whether it was found.
+// The item is found by comparing items with ==, so items must be of a
+// comparable type.
+func (t *Tree) Delete(it Item) bool {
+	b := it.Bounds()
+	path := []*node{t.root}
+	var idx []int
+	if !find(t.root, b, it, &path, &idx) {
+		return false
+	}
+	leaf := path[len(path)-1]
+	i := idx[len(idx)-1]
+	leaf.entries = append(leaf.entries[:i], leaf.entries[i+1:]...)
+	idx = idx[:len(idx)-1]
+	t.count--
+
+	// Remove underfull nodes on the path and
+	// collect their entries for reinsertion.
+	type orphan struct {
+		e     entry
+		level int
+	}
+	var orphans []orphan
+	for k := len(path) - 1; k > 0; k-- {
+		n, parent := path[k], path[k-1]
+		j := idx[k-1]
+		if len(n.entries) < t.min {
+			parent.entries = append(parent.entries[:j], parent.entries[j+1:]...)
+			for _, e := range n.entries {
+				orphans = append(orphans, orphan{e: e, level: t.height - k})
+			}
+			continue
+		}
+		parent.entries[j].box = n.bounds()
+	}
+	for !t.root.leaf && len(t.root.entries) == 1 {
+		t.root = t.root.entries[0].child
+		t.height--
+	}
+	if !t.root.leaf && len(t.root.entries) == 0 {
+		t.root = &node{leaf: true}
+		t.height = 0
+	}
+	for _, o := range orphans {
+		t.insert(o.e, o.level, make(map[int]bool))
+	}
+	return true
+}
+
+// find searches the subtree rooted at n for a leaf entry holding the item it
+// with bounding box b. If the entry is found, the nodes on the path to its
+// leaf are appended to path and the entry indices at each node are appended
+// to idx.
+func find(n *node, b r2.Box, it Item, path *[]*node, idx *[]int) bool {
+	for i, e := range n.entries {
+		if !containsBox(e.box, b) {
+			continue
+		}
+		if n.leaf {
+			if e.item == it {
+				*idx = append(*idx, i)
+				return true
+			}
+			continue
+		}
+		*path = append(*path, e.child)
+		*idx = append(*idx, i)
+		if find(e.child, b, it, path, idx) {
+			return true
+		}
+		*path = (*path)[:len(*path)-1]
+		*idx = (*idx)[:len(*idx)-1]
+	}
+	return false
+}
+
+// Search calls fn for each item in the tree whose bounding box intersects
+// the box b, including boxes that only touch b. The search is terminated
+// if fn returns false.
+func (t *Tree) Search(b r2.Box, fn func(Item) bool) {
+	t.root.search(b, fn)
+}
+
+func (n *node) search(b r2.Box, fn func(Item) bool) bool {
+	for _, e := range n.entries {
+		if !intersects(e.box, b) {
+			continue
+		}
+		if n.leaf {
+			if !fn(e.item) {
+				return false
+			}
+			continue
+		}
+		if !e.child.search(b, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// Do calls fn for each item in the tree. The iteration is terminated
+// if fn returns false.
+func (t *Tree) Do(fn func(Item) bool) {
+	t.root.do(fn)
+}
+
+func (n *node) do(fn func(Item) bool) bool {
+	for _, e := range n.entries {
+		if n.leaf {
+			if !fn(e.item) {
+				return false
+			}
+			continue
+		}
+		if !e.child.do(fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// ItemDist holds an Item and a distance.
+type ItemDist struct {
+	Item Item
+	Dist float64
+}
+
+// Nearest returns the item in the tree whose bounding box is nearest to p
+// and the Euclidean distance from p to the bounding box. The distance is
+// zero if p is within the bounding box. Nearest returns a nil Item and +Inf
+// if the tree is empty.
+func (t *Tree) Nearest(p r2.Vec) (Item, float64) {
+	n := t.NearestN(p, 1)
+	if len(n) == 0 {
+		return nil, math.Inf(1)
+	}
+	return n[0].Item, n[0].Dist
+}
+
+// NearestN returns the k items in the tree whose bounding boxes are nearest
+// to p, in order of increasing Euclidean distance from p to their bounding
+// boxes. If the tree holds fewer than k items, all the items are returned.
+//
+// The search is the incremental best-first search described in Hjaltason
+// and Samet, "Distance browsing in spatial databases", ACM Trans. Database
+// Syst. 24:265-318, 1999, doi:10.1145/320248.320255.
+func (t *Tree) NearestN(p r2.Vec, k int) []ItemDist {
+	if k <= 0 {
+		return nil
+	}
+	q := queue{{node: t.root}}
+	var nearest []ItemDist
+	for len(q) != 0 && len(nearest) < k {
+		c := heap.Pop(&q).(candidate)
+		if c.node == nil {
+			nearest = append(nearest, ItemDist{Item: c.item, Dist: math.Sqrt(c.dist)})
+			continue
+		}
+		for _, e := range c.node.entries {
+			heap.Push(&q, candidate{dist: dist2(p, e.box), node: e.child, item: e.item})
+		}
+	}
+	return nearest
+}
+
+// candidate is a node or item awaiting
+// examination in a nearest neighbor search.
+type candidate struct {
+	dist float64
+	node *node
+	item Item
+}
+
+// queue is a priority queue of candidates keyed on distance. Items are
+// ordered before nodes at the same distance.
+type queue []candidate
+
+func (q queue) Len() int { return len(q) }
+func (q queue) Less(i, j int) bool {
+	if q[i].dist != q[j].dist {
+		return q[i].dist < q[j].dist
+	}
+	return q[i].node == nil && q[j].node != nil
+}
+func (q queue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(candidate)) }
+func (q *queue) Pop() interface{} {
+	t := *q
+	var c interface{}
+	c, *q = t[len(t)-1], t[:len(t)-1]
+	return c
+}
+
+// dist2 returns the squared distance from p to the box b.
+func dist2(p r2.Vec, b r2.Box) float64 {
+	dx := math.Max(0, math.Max(b.Min.X-p.X, p.X-b.Max.X))
+	dy := math.Max(0, math.Max(b.Min.Y-p.Y, p.Y-b.Max.Y))
+	return dx*dx + dy*dy
+}
+
+// union returns the bounding box of a and b.
+func union(a, b r2.Box) r2.Box {
+	return r2.Box{
+		Min: r2.Vec{X: math.Min(a.Min.X, b.Min.X), Y: math.Min(a.Min.Y, b.Min.Y)},
+		Max: r2.Vec{X: math.Max(a.Max.X, b.Max.X), Y: math.Max(a.Max.Y, b.Max.Y)},
+	}
+}
+
+// area returns the area of b.
+func area(b r2.Box) float64 {
+	return (b.Max.X - b.Min.X) * (b.Max.Y - b.Min.Y)
+}
+
+// perimeter returns half the perimeter of b.
+func perimeter(b r2.Box) float64 {
+	return (b.Max.X - b.Min.X) + (b.Max.Y - b.Min.Y)
+}
+
+// intersection returns the area of the intersection of a and b.
+func intersection(a, b r2.Box) float64 {
+	dx := math.Min(a.Max.X, b.Max.X) - math.Max(a.Min.X, b.Min.X)
+	dy := math.Min(a.Max.Y, b.Max.Y) - math.Max(a.Min.Y, b.Min.Y)
+	if dx <= 0 || dy <= 0 {
+		return 0
+	}
+	return dx * dy
+}
+
+// intersects returns whether a and b share any point.
+func intersects(a, b r2.Box) bool {
+	return a.Min.X <= b.Max.X && b.Min.X <= a.Max.X && a.Min.Y <= b.Max.Y && b.Min.Y <= a.Max.Y
+}
+
+// containsBox returns whether b is within a.
+func containsBox(a, b r2.Box) bool {
+	return a.Min.X <= b.Min.X && b.Max.X <= a.Max.X && a.Min.Y <= b.Min.Y && b.Max.Y <= a.Max.Y
+}
@@ -0,0 +1,50 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rtree_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/spatial/rtree"
+)
+
+// building is a named rectangle.
+type building struct {
+	name string
+	r2.Box
+}
+
+func (b building) Bounds() r2.Box { return b.Box }
+
+func Example() {
+	t := rtree.New(8)
+	for _, b := range []building{
+		{name: "library", Box: r2.NewBox(0, 0, 2, 3)},
+		{name: "station", Box: r2.NewBox(5, 1, 9, 2)},
+		{name: "school", Box: r2.NewBox(1, 6, 4, 8)},
+		{name: "market", Box: r2.NewBox(6, 5, 7, 9)},
+	} {
+		t.Insert(b)
+	}
+
+	// Find the buildings intersecting a window.
+	t.Search(r2.NewBox(1, 1, 5.5, 6), func(it rtree.Item) bool {
+		fmt.Println("in window:", it.(building).name)
+		return true
+	})
+
+	// Find the buildings nearest to a point.
+	for _, n := range t.NearestN(r2.Vec{X: 5, Y: 5}, 2) {
+		fmt.Printf("near: %s at %.2f\n", n.Item.(building).name, n.Dist)
+	}
+
+	// Unordered output:
+	// in window: library
+	// in window: station
+	// in window: school
+	// near: market at 1.00
+	// near: school at 1.41
+}
@@ -0,0 +1,214 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rtree
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// rect is a rectangle Item.
+type rect struct {
+	id  int
+	box r2.Box
+}
+
+func (r rect) Bounds() r2.Box { return r.box }
+
+func randomRects(n int, rnd *rand.Rand) []rect {
+	r := make([]rect, n)
+	for i := range r {
+		x, y := rnd.Float64()*100, rnd.Float64()*100
+		w, h := rnd.ExpFloat64(), rnd.ExpFloat64()
+		if i%5 == 0 {
+			// Include points.
+			w, h = 0, 0
+		}
+		r[i] = rect{id: i, box: r2.Box{Min: r2.Vec{X: x, Y: y}, Max: r2.Vec{X: x + w, Y: y + h}}}
+	}
+	return r
+}
+
+// checkTree checks the structural invariants of t.
+func checkTree(t *testing.T, tr *Tree, want int) {
+	t.Helper()
+	if tr.Len() != want {
+		t.Errorf("unexpected length: got:%d want:%d", tr.Len(), want)
+	}
+	var count int
+	var walk func(n *node, level int)
+	walk = func(n *node, level int) {
+		if n.leaf != (level == 0) {
+			t.Errorf("leaf at level %d", level)
+		}
+		if n != tr.root && (len(n.entries) < tr.min || len(n.entries) > tr.max) {
+			t.Errorf("node at level %d has %d entries, outside [%d,%d]", level, len(n.entries), tr.min, tr.max)
+		}
+		for _, e := range n.entries {
+			if n.leaf {
+				count++
+				if e.box != e.item.Bounds() {
+					t.Errorf("leaf entry box does not match item bounds")
+				}
+				continue
+			}
+			if e.box != e.child.bounds() {
+				t.Errorf("entry box at level %d does not match child bounds: got:%v want:%v", level, e.box, e.child.bounds())
+			}
+			walk(e.child, level-1)
+		}
+	}
+	walk(tr.root, tr.height)
+	if count != want {
+		t.Errorf("unexpected number of leaf entries: got:%d want:%d", count, want)
+	}
+}
+
+func TestTree(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, maxEntries := range []int{4, 5, 8, 16, 50} {
+		rects := randomRects(2000, rnd)
+		tr := New(maxEntries)
+		for i, r := range rects {
+			tr.Insert(r)
+			if i%500 == 0 {
+				checkTree(t, tr, i+1)
+			}
+		}
+		checkTree(t, tr, len(rects))
+		checkQueries(t, tr, rects, rnd)
+
+		// Delete half the items in random order.
+		rnd.Shuffle(len(rects), func(i, j int) { rects[i], rects[j] = rects[j], rects[i] })
+		for i, r := range rects[:len(rects)/2] {
+			if !tr.Delete(r) {
+				t.Fatalf("failed to delete item %d", r.id)
+			}
+			if tr.Delete(r) {
+				t.Fatalf("unexpected second deletion of item %d", r.id)
+			}
+			if i%250 == 0 {
+				checkTree(t, tr, len(rects)-i-1)
+			}
+		}
+		rects = rects[len(rects)/2:]
+		checkTree(t, tr, len(rects))
+		checkQueries(t, tr, rects, rnd)
+
+		for _, r := range rects {
+			if !tr.Delete(r) {
+				t.Fatalf("failed to delete item %d", r.id)
+			}
+		}
+		checkTree(t, tr, 0)
+		if it, d := tr.Nearest(r2.Vec{}); it != nil || !math.IsInf(d, 1) {
+			t.Errorf("unexpected nearest item in empty tree: %v %v", it, d)
+		}
+		if b := tr.Bounds(); b != (r2.Box{}) {
+			t.Errorf("unexpected bounds of empty tree: %v", b)
+		}
+	}
+}
+
+// checkQueries compares window and nearest neighbor queries
+// on tr with brute force results over rects.
+func checkQueries(t *testing.T, tr *Tree, rects []rect, rnd *rand.Rand) {
+	t.Helper()
+	for range 50 {
+		x, y := rnd.Float64()*110-5, rnd.Float64()*110-5
+		w := r2.Box{Min: r2.Vec{X: x, Y: y}, Max: r2.Vec{X: x + rnd.Float64()*20, Y: y + rnd.Float64()*20}}
+		got := make(map[int]bool)
+		tr.Search(w, func(it Item) bool {
+			got[it.(rect).id] = true
+			return true
+		})
+		for _, r := range rects {
+			if intersects(r.box, w) != got[r.id] {
+				t.Errorf("unexpected window query result for item %d in %v: got:%t", r.id, w, got[r.id])
+			}
+		}
+
+		var n int
+		tr.Search(w, func(Item) bool {
+			n++
+			return n < 2
+		})
+		if n > 2 {
+			t.Errorf("search did not terminate")
+		}
+
+		p := r2.Vec{X: x, Y: y}
+		want := make([]float64, len(rects))
+		for i, r := range rects {
+			want[i] = math.Sqrt(dist2(p, r.box))
+		}
+		sort.Float64s(want)
+		const k = 10
+		nearest := tr.NearestN(p, k)
+		if len(nearest) != min(k, len(rects)) {
+			t.Errorf("unexpected number of nearest items: got:%d want:%d", len(nearest), min(k, len(rects)))
+		}
+		for i, nd := range nearest {
+			if nd.Dist != want[i] {
+				t.Errorf("unexpected distance for %dth nearest item: got:%v want:%v", i, nd.Dist, want[i])
+			}
+			if d := math.Sqrt(dist2(p, nd.Item.Bounds())); d != nd.Dist {
+				t.Errorf("distance does not match item: got:%v want:%v", nd.Dist, d)
+			}
+		}
+		if _, d := tr.Nearest(p); d != want[0] {
+			t.Errorf("unexpected nearest distance: got:%v want:%v", d, want[0])
+		}
+	}
+
+	var all int
+	tr.Do(func(Item) bool {
+		all++
+		return true
+	})
+	if all != len(rects) {
+		t.Errorf("unexpected number of items iterated: got:%d want:%d", all, len(rects))
+	}
+	want := rects[0].box
+	for _, r := range rects[1:] {
+		want = union(want, r.box)
+	}
+	if got := tr.Bounds(); got != want {
+		t.Errorf("unexpected bounds: got:%v want:%v", got, want)
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	// Many items with the same bounds stress
+	// the split and deletion paths.
+	tr := New(4)
+	b := r2.Box{Max: r2.Vec{X: 1, Y: 1}}
+	var rects []rect
+	for i := range 100 {
+		r := rect{id: i, box: b}
+		rects = append(rects, r)
+		tr.Insert(r)
+	}
+	checkTree(t, tr, len(rects))
+	for i, r := range rects {
+		if !tr.Delete(r) {
+			t.Fatalf("failed to delete item %d", r.id)
+		}
+		checkTree(t, tr, len(rects)-i-1)
+	}
+}
+
+func TestNewPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for invalid capacity")
+		}
+	}()
+	New(3)
+}
@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/cluster"
+)
+
+func ExampleKMeans() {
+	// Two groups of points in the plane.
+	x := mat.NewDense(8, 2, []float64{
+		1.0, 1.1,
+		0.9, 1.3,
+		1.2, 0.8,
+		1.1, 1.0,
+		5.0, 5.2,
+		5.3, 4.9,
+		4.8, 5.1,
+		5.1, 4.8,
+	})
+	centers, labels, _ := cluster.KMeans(x, 2, &cluster.KMeansSettings{Src: rand.NewPCG(1, 1)})
+	fmt.Println("same cluster:", labels[0] == labels[3], labels[4] == labels[7], labels[0] == labels[4])
+	fmt.Printf("center of first group: %.3f\n", mat.Formatted(centers.RowView(labels[0]).T()))
+	fmt.Printf("silhouette: %.2f\n", cluster.Silhouette(nil, labels, cluster.Euclidean(x)))
+
+	// Output:
+	// same cluster: true true false
+	// center of first group: [1.050  1.050]
+	// silhouette: 0.94
+}
+
+func ExampleKMedoids() {
+	// Cluster words by the difference in their lengths. The medoids
+	// are members of the data, so any dissimilarity can be used.
+	words := []string{"a", "an", "the", "elephant", "hippopotamus", "giraffe", "of"}
+	dist := func(i, j int) float64 {
+		return math.Abs(float64(len(words[i]) - len(words[j])))
+	}
+	medoids, labels, cost := cluster.KMedoids(len(words), 2, dist)
+	for i, w := range words {
+		fmt.Printf("%s: %s\n", w, words[medoids[labels[i]]])
+	}
+	fmt.Println("cost:", cost)
+
+	// Output:
+	// a: an
+	// an: an
+	// the: an
+	// elephant: elephant
+	// hippopotamus: elephant
+	// giraffe: elephant
+	// of: an
+	// cost: 7
+}
+
+func ExampleAgglomerative() {
+	// Points on a line in three groups.
+	x := mat.NewDense(7, 1, []float64{0, 0.5, 1, 5, 5.5, 12, 12.2})
+	d := cluster.Agglomerative(7, cluster.Euclidean(x), cluster.Average)
+	for _, m := range d.Merges {
+		fmt.Printf("merge %d and %d at %.2f\n", m.A, m.B, m.Height)
+	}
+	fmt.Println("three clusters:", d.CutK(3))
+	fmt.Println("cut at height 2:", d.CutHeight(2))
+
+	// Output:
+	// merge 5 and 6 at 0.20
+	// merge 0 and 1 at 0.50
+	// merge 3 and 4 at 0.50
+	// merge 2 and 8 at 0.75
+	// merge 9 and 10 at 4.75
+	// merge 7 and 11 at 9.70
+	// three clusters: [0 0 0 1 1 2 2]
+	// cut at height 2: [0 0 0 1 1 2 2]
+}
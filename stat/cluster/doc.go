@@ -0,0 +1,14 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cluster provides routines for partitioning observations into
+// clusters.
+//
+// Observations are held in the rows of a matrix for routines working in
+// Euclidean space, such as k-means. Routines that work with arbitrary
+// dissimilarities, such as k-medoids and agglomerative clustering, take a
+// function returning the distance between observations i and j, such as
+// the At method of a symmetric matrix of precomputed distances, or the
+// function returned by Euclidean for observations held in a matrix.
+package cluster // import "gonum.org/v1/gonum/stat/cluster"
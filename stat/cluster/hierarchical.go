@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"sort"
+)
+
+// Linkage specifies the dissimilarity between clusters used by
+// Agglomerative.
+type Linkage int
+
+const (
+	// Single linkage is the least dissimilarity between
+	// members of the clusters.
+	Single Linkage = iota
+	// Complete linkage is the greatest dissimilarity between
+	// members of the clusters.
+	Complete
+	// Average linkage is the mean dissimilarity between
+	// members of the clusters.
+	Average
+	// Ward linkage is the Ward variance criterion,
+	// sqrt(2·nᵢnⱼ/(nᵢ+nⱼ))·‖cᵢ-cⱼ‖ for clusters of sizes nᵢ
+	// and nⱼ with centroids cᵢ and cⱼ. Ward linkage is only
+	// meaningful for Euclidean distances.
+	Ward
+)
+
+// Merge is a merge of two clusters in a Dendrogram.
+type Merge struct {
+	// A and B are the merged clusters, with A < B. Clusters
+	// with indices less than n are the single observations,
+	// and cluster n+i is the cluster formed by merge i.
+	A, B int
+
+	// Height is the linkage between the merged clusters.
+	Height float64
+
+	// Size is the number of observations in the merged
+	// cluster.
+	Size int
+}
+
+// Dendrogram is the result of hierarchical clustering of n observations.
+type Dendrogram struct {
+	// Merges holds the n-1 merges in order of
+	// non-decreasing height.
+	Merges []Merge
+}
+
+// Len returns the number of observations in the dendrogram.
+func (d *Dendrogram) Len() int {
+	return len(d.Merges) + 1
+}
+
+// Agglomerative returns the dendrogram of the hierarchical agglomerative
+// clustering of n observations with the given linkage, where dist returns
+// the dissimilarity between observations i and j. The dissimilarity must
+// be non-negative and symmetric. The At method of a mat.Symmetric holding
+// precomputed distances may be used as dist, and Euclidean may be used for
+// observations held in the rows of a matrix.
+//
+// The clustering is found by the nearest-neighbor chain algorithm in O(n²)
+// time, and the n×n dissimilarities are evaluated once and retained.
+//
+// See Müllner, "Modern hierarchical, agglomerative clustering algorithms",
+// arXiv:1109.2378, 2011.
+//
+// Agglomerative panics if n is less than one or if the linkage is unknown.
+func Agglomerative(n int, dist func(i, j int) float64, link Linkage) *Dendrogram {
+	if n < 1 {
+		panic("cluster: no observations")
+	}
+	if link < Single || Ward < link {
+		panic("cluster: unknown linkage")
+	}
+	d := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			v := dist(i, j)
+			d[i*n+j] = v
+			d[j*n+i] = v
+		}
+	}
+
+	// Clusters are held in the slot of one of their members
+	// while they are active.
+	active := make([]bool, n)
+	size := make([]int, n)
+	for i := range active {
+		active[i] = true
+		size[i] = 1
+	}
+	type pair struct {
+		a, b   int
+		height float64
+	}
+	merges := make([]pair, 0, n-1)
+	chain := make([]int, 0, n)
+	for len(merges) < n-1 {
+		if len(chain) == 0 {
+			for i, ok := range active {
+				if ok {
+					chain = append(chain, i)
+					break
+				}
+			}
+		}
+		// Extend the chain of nearest neighbors until it
+		// reaches a pair of reciprocal nearest neighbors.
+		var a, b int
+		for {
+			a = chain[len(chain)-1]
+			b = -1
+			min := math.Inf(1)
+			if len(chain) > 1 {
+				// Prefer the previous element on ties so
+				// that the chain terminates.
+				b = chain[len(chain)-2]
+				min = d[a*n+b]
+			}
+			for c, ok := range active {
+				if ok && c != a && d[a*n+c] < min {
+					b = c
+					min = d[a*n+c]
+				}
+			}
+			if len(chain) > 1 && b == chain[len(chain)-2] {
+				break
+			}
+			chain = append(chain, b)
+		}
+		chain = chain[:len(chain)-2]
+
+		// Merge a into b and update the dissimilarities by the
+		// Lance-Williams formula.
+		merges = append(merges, pair{a: a, b: b, height: d[a*n+b]})
+		na, nb := float64(size[a]), float64(size[b])
+		dab := d[a*n+b]
+		active[a] = false
+		for k, ok := range active {
+			if !ok || k == b {
+				continue
+			}
+			dak, dbk := d[a*n+k], d[b*n+k]
+			var v float64
+			switch link {
+			case Single:
+				v = math.Min(dak, dbk)
+			case Complete:
+				v = math.Max(dak, dbk)
+			case Average:
+				v = (na*dak + nb*dbk) / (na + nb)
+			case Ward:
+				nk := float64(size[k])
+				v = math.Sqrt(math.Max(0, ((na+nk)*dak*dak+(nb+nk)*dbk*dbk-nk*dab*dab)/(na+nb+nk)))
+			}
+			d[b*n+k] = v
+			d[k*n+b] = v
+		}
+		size[b] += size[a]
+	}
+
+	// The merges are found out of order, so sort them by height
+	// and label the clusters using a union-find.
+	sort.SliceStable(merges, func(i, j int) bool {
+		return merges[i].height < merges[j].height
+	})
+	uf := newUnionFind(n)
+	dend := &Dendrogram{Merges: make([]Merge, len(merges))}
+	for i, m := range merges {
+		a, b := uf.find(m.a), uf.find(m.b)
+		ca, cb := uf.label[a], uf.label[b]
+		if cb < ca {
+			ca, cb = cb, ca
+		}
+		root := uf.union(a, b)
+		uf.label[root] = n + i
+		dend.Merges[i] = Merge{A: ca, B: cb, Height: m.height, Size: uf.size[root]}
+	}
+	return dend
+}
+
+// CutK returns the cluster of each observation when the dendrogram is cut
+// into k clusters. Clusters are numbered in order of their first
+// observation. CutK panics if k is not in [1, n] where n is the number of
+// observations.
+func (d *Dendrogram) CutK(k int) []int {
+	n := d.Len()
+	if k < 1 || k > n {
+		panic("cluster: invalid number of clusters")
+	}
+	return d.cut(n - k)
+}
+
+// CutHeight returns the cluster of each observation when the dendrogram is
+// cut at the given height, so that clusters merged at heights no greater
+// than height are joined. Clusters are numbered in order of their first
+// observation.
+func (d *Dendrogram) CutHeight(height float64) []int {
+	m := sort.Search(len(d.Merges), func(i int) bool {
+		return d.Merges[i].Height > height
+	})
+	return d.cut(m)
+}
+
+// cut returns the clusters formed by the first m merges.
+func (d *Dendrogram) cut(m int) []int {
+	n := d.Len()
+	uf := newUnionFind(n)
+	// member holds an observation of each cluster.
+	member := make([]int, n+m)
+	for i := 0; i < n; i++ {
+		member[i] = i
+	}
+	for i, mg := range d.Merges[:m] {
+		uf.union(uf.find(member[mg.A]), uf.find(member[mg.B]))
+		member[n+i] = member[mg.A]
+	}
+	labels := make([]int, n)
+	ids := make(map[int]int)
+	for i := range labels {
+		r := uf.find(i)
+		id, ok := ids[r]
+		if !ok {
+			id = len(ids)
+			ids[r] = id
+		}
+		labels[i] = id
+	}
+	return labels
+}
+
+// unionFind is a disjoint set forest with a label for each set.
+type unionFind struct {
+	parent []int
+	size   []int
+	label  []int
+}
+
+func newUnionFind(n int) *unionFind {
+	uf := &unionFind{
+		parent: make([]int, n),
+		size:   make([]int, n),
+		label:  make([]int, n),
+	}
+	for i := range uf.parent {
+		uf.parent[i] = i
+		uf.size[i] = 1
+		uf.label[i] = i
+	}
+	return uf
+}
+
+// find returns the root of the set containing x.
+func (uf *unionFind) find(x int) int {
+	for uf.parent[x] != x {
+		uf.parent[x] = uf.parent[uf.parent[x]]
+		x = uf.parent[x]
+	}
+	return x
+}
+
+// union joins the sets with roots a and b and returns the new root.
+func (uf *unionFind) union(a, b int) int {
+	if a == b {
+		return a
+	}
+	if uf.size[a] < uf.size[b] {
+		a, b = b, a
+	}
+	uf.parent[b] = a
+	uf.size[a] += uf.size[b]
+	return a
+}
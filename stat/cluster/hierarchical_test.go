@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+var linkages = []struct {
+	name string
+	link Linkage
+}{
+	{name: "single", link: Single},
+	{name: "complete", link: Complete},
+	{name: "average", link: Average},
+	{name: "ward", link: Ward},
+}
+
+// naiveLinkage returns the linkage between the clusters of rows of x with
+// the given members computed from its definition.
+func naiveLinkage(x *mat.Dense, a, b []int, link Linkage) float64 {
+	dist := Euclidean(x)
+	switch link {
+	case Single, Complete, Average:
+		min, max, sum := math.Inf(1), math.Inf(-1), 0.0
+		for _, i := range a {
+			for _, j := range b {
+				d := dist(i, j)
+				min = math.Min(min, d)
+				max = math.Max(max, d)
+				sum += d
+			}
+		}
+		switch link {
+		case Single:
+			return min
+		case Complete:
+			return max
+		}
+		return sum / float64(len(a)*len(b))
+	case Ward:
+		_, c := x.Dims()
+		ca := make([]float64, c)
+		cb := make([]float64, c)
+		for _, i := range a {
+			floats.AddScaled(ca, 1/float64(len(a)), x.RawRowView(i))
+		}
+		for _, j := range b {
+			floats.AddScaled(cb, 1/float64(len(b)), x.RawRowView(j))
+		}
+		na, nb := float64(len(a)), float64(len(b))
+		return math.Sqrt(2*na*nb/(na+nb)) * floats.Distance(ca, cb, 2)
+	}
+	panic("unknown linkage")
+}
+
+// naiveAgglomerative returns the heights and members of the merges of the
+// agglomerative clustering of the rows of x, found by repeatedly merging
+// the closest pair of clusters.
+func naiveAgglomerative(x *mat.Dense, link Linkage) (heights []float64, members [][]int) {
+	n, _ := x.Dims()
+	var clusters [][]int
+	for i := 0; i < n; i++ {
+		clusters = append(clusters, []int{i})
+	}
+	for len(clusters) > 1 {
+		bi, bj := -1, -1
+		min := math.Inf(1)
+		for i := range clusters {
+			for j := i + 1; j < len(clusters); j++ {
+				if d := naiveLinkage(x, clusters[i], clusters[j], link); d < min {
+					bi, bj, min = i, j, d
+				}
+			}
+		}
+		merged := append(append([]int(nil), clusters[bi]...), clusters[bj]...)
+		slices.Sort(merged)
+		heights = append(heights, min)
+		members = append(members, merged)
+		clusters[bi] = merged
+		clusters = append(clusters[:bj], clusters[bj+1:]...)
+	}
+	return heights, members
+}
+
+// mergeMembers returns the observations in each merged cluster of d.
+func mergeMembers(d *Dendrogram) [][]int {
+	n := d.Len()
+	members := make([][]int, n, 2*n-1)
+	for i := range members {
+		members[i] = []int{i}
+	}
+	for _, m := range d.Merges {
+		merged := append(append([]int(nil), members[m.A]...), members[m.B]...)
+		slices.Sort(merged)
+		members = append(members, merged)
+	}
+	return members[n:]
+}
+
+func TestAgglomerative(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 10, 30} {
+		x := mat.NewDense(n, 2, nil)
+		for i := 0; i < n; i++ {
+			x.Set(i, 0, rnd.Float64())
+			x.Set(i, 1, rnd.Float64())
+		}
+		for _, test := range linkages {
+			d := Agglomerative(n, Euclidean(x), test.link)
+			if d.Len() != n {
+				t.Errorf("unexpected length for %s linkage with n=%d: got:%d want:%d", test.name, n, d.Len(), n)
+			}
+			heights, members := naiveAgglomerative(x, test.link)
+			got := mergeMembers(d)
+			for i, m := range d.Merges {
+				if !scalar.EqualWithinAbsOrRel(m.Height, heights[i], tol, tol) {
+					t.Errorf("unexpected height of merge %d for %s linkage with n=%d: got:%v want:%v", i, test.name, n, m.Height, heights[i])
+				}
+				if !slices.Equal(got[i], members[i]) {
+					t.Errorf("unexpected members of merge %d for %s linkage with n=%d: got:%v want:%v", i, test.name, n, got[i], members[i])
+				}
+				if m.A >= m.B || m.B >= n+i || m.Size != len(members[i]) {
+					t.Errorf("invalid merge %d for %s linkage with n=%d: %+v", i, test.name, n, m)
+				}
+			}
+		}
+	}
+}
+
+func TestDendrogramCut(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, truth := blobs(rnd, blobMeans, 0.5, 20)
+	n, _ := x.Dims()
+
+	// Clustering precomputed distances is the same as clustering
+	// the points.
+	dist := Euclidean(x)
+	sym := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			sym.SetSym(i, j, dist(i, j))
+		}
+	}
+	for _, test := range linkages {
+		d := Agglomerative(n, sym.At, test.link)
+		labels := d.CutK(len(blobMeans))
+		if !samePartition(labels, truth) {
+			t.Errorf("unexpected partition for %s linkage", test.name)
+		}
+		if labels[0] != 0 || slices.Max(labels) != len(blobMeans)-1 {
+			t.Errorf("unexpected labels for %s linkage: %v", test.name, labels)
+		}
+
+		for k := 1; k <= n; k++ {
+			labels := d.CutK(k)
+			if got := slices.Max(labels) + 1; got != k {
+				t.Errorf("unexpected number of clusters for %s linkage: got:%d want:%d", test.name, got, k)
+			}
+			if k == 1 || k == n {
+				continue
+			}
+			// Cutting between the heights of the merges gives
+			// the same clusters.
+			lo, hi := d.Merges[n-k-1].Height, d.Merges[n-k].Height
+			if lo == hi {
+				continue
+			}
+			if !slices.Equal(d.CutHeight((lo+hi)/2), labels) {
+				t.Errorf("mismatched cuts for %s linkage with k=%d", test.name, k)
+			}
+		}
+		if got := d.CutHeight(-1); slices.Max(got) != n-1 {
+			t.Errorf("unexpected cut below all merges for %s linkage", test.name)
+		}
+		if got := d.CutHeight(math.Inf(1)); slices.Max(got) != 0 {
+			t.Errorf("unexpected cut above all merges for %s linkage", test.name)
+		}
+	}
+}
+
+func TestAgglomerativeTies(t *testing.T) {
+	t.Parallel()
+	// Points on a grid have many equal distances.
+	x := mat.NewDense(16, 2, nil)
+	for i := 0; i < 16; i++ {
+		x.Set(i, 0, float64(i%4))
+		x.Set(i, 1, float64(i/4))
+	}
+	for _, test := range linkages {
+		d := Agglomerative(16, Euclidean(x), test.link)
+		for i := 1; i < len(d.Merges); i++ {
+			if d.Merges[i].Height < d.Merges[i-1].Height {
+				t.Errorf("merge heights not sorted for %s linkage", test.name)
+			}
+		}
+		if last := d.Merges[len(d.Merges)-1]; last.Size != 16 {
+			t.Errorf("unexpected size of final merge for %s linkage: %d", test.name, last.Size)
+		}
+	}
+}
+
+func TestAgglomerativePanics(t *testing.T) {
+	t.Parallel()
+	dist := func(i, j int) float64 { return math.Abs(float64(i - j)) }
+	if !panics(func() { Agglomerative(0, dist, Single) }) {
+		t.Error("expected panic for no observations")
+	}
+	if !panics(func() { Agglomerative(3, dist, Ward+1) }) {
+		t.Error("expected panic for unknown linkage")
+	}
+	d := Agglomerative(3, dist, Single)
+	for _, k := range []int{0, 4} {
+		if !panics(func() { d.CutK(k) }) {
+			t.Errorf("expected panic for cut into %d clusters", k)
+		}
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// KMeansSettings holds settings for KMeans and MiniBatchKMeans.
+type KMeansSettings struct {
+	// Iterations is the maximum number of iterations of each run.
+	// If Iterations is zero, 300 is used for KMeans and 100 for
+	// MiniBatchKMeans.
+	Iterations int
+
+	// Restarts is the number of runs from different k-means++
+	// initializations. The clustering with the lowest inertia
+	// is returned. If Restarts is zero, one run is made.
+	Restarts int
+
+	// Src is the source of randomness for initialization and
+	// sampling. If Src is nil, the global random source is used.
+	Src rand.Source
+}
+
+// KMeans partitions the observations held in the rows of x into k clusters
+// by Lloyd's algorithm, starting from centers chosen by KMeansPlusPlus. It
+// returns the k×c matrix of cluster centers, the cluster of each
+// observation and the inertia of the clustering, the sum of squared
+// distances from the observations to the centers of their clusters. If
+// settings is nil, default settings are used.
+//
+// Each iteration assigns the observations to their nearest centers and
+// moves the centers to the means of their clusters, until the assignments
+// do not change. A center whose cluster becomes empty is moved to the
+// observation farthest from its own center. The algorithm converges to a
+// local minimum of the inertia, so it may be run from several
+// initializations by setting settings.Restarts.
+//
+// KMeans panics if k is not in [1, r] where r is the number of rows of x.
+func KMeans(x mat.Matrix, k int, settings *KMeansSettings) (centers *mat.Dense, labels []int, inertia float64) {
+	iters := 300
+	restarts := 1
+	var src rand.Source
+	if settings != nil {
+		if settings.Iterations != 0 {
+			iters = settings.Iterations
+		}
+		if settings.Restarts != 0 {
+			restarts = settings.Restarts
+		}
+		src = settings.Src
+	}
+	data := mat.DenseCopyOf(x)
+	checkK(data, k)
+	inertia = math.Inf(1)
+	for range restarts {
+		c, l, in := lloyd(data, k, iters, src)
+		if in < inertia {
+			centers, labels, inertia = c, l, in
+		}
+	}
+	return centers, labels, inertia
+}
+
+// lloyd runs Lloyd's algorithm on the rows of x from a k-means++
+// initialization.
+func lloyd(x *mat.Dense, k, iters int, src rand.Source) (*mat.Dense, []int, float64) {
+	r, _ := x.Dims()
+	centers := rowsOf(x, KMeansPlusPlus(x, k, src))
+	labels := make([]int, r)
+	dist := make([]float64, r)
+	counts := make([]int, k)
+	for i := range labels {
+		labels[i] = -1
+	}
+	for it := 0; ; it++ {
+		changed := assign(labels, dist, x, centers)
+		if !changed || it == iters {
+			break
+		}
+
+		// Move the centers to the means of their clusters.
+		centers.Zero()
+		for i := range counts {
+			counts[i] = 0
+		}
+		for i, l := range labels {
+			floats.Add(centers.RawRowView(l), x.RawRowView(i))
+			counts[l]++
+		}
+		for j, n := range counts {
+			if n != 0 {
+				floats.Scale(1/float64(n), centers.RawRowView(j))
+				continue
+			}
+			// Move the center of an empty cluster to the
+			// observation farthest from its center.
+			far := floats.MaxIdx(dist)
+			copy(centers.RawRowView(j), x.RawRowView(far))
+			dist[far] = 0
+		}
+	}
+	return centers, labels, floats.Sum(dist)
+}
+
+// MiniBatchKMeans partitions the observations held in the rows of x into k
+// clusters by the mini-batch k-means algorithm, starting from centers
+// chosen by KMeansPlusPlus. It returns the k×c matrix of cluster centers,
+// the cluster of each observation and the inertia of the clustering. If
+// settings is nil, default settings are used.
+//
+// Each iteration samples batchSize observations with replacement and moves
+// the center nearest to each sampled observation towards it by a step
+// inversely proportional to the number of observations assigned to the
+// center so far, so each iteration costs O(batchSize·k) rather than the
+// O(r·k) of a Lloyd iteration. The clustering is usually slightly worse than
+// that found by KMeans.
+//
+// See Sculley, "Web-scale k-means clustering", Proceedings of the 19th
+// International Conference on World Wide Web, 2010.
+//
+// MiniBatchKMeans panics if k is not in [1, r] where r is the number of
+// rows of x, or if batchSize is not positive.
+func MiniBatchKMeans(x mat.Matrix, k, batchSize int, settings *KMeansSettings) (centers *mat.Dense, labels []int, inertia float64) {
+	iters := 100
+	restarts := 1
+	var src rand.Source
+	if settings != nil {
+		if settings.Iterations != 0 {
+			iters = settings.Iterations
+		}
+		if settings.Restarts != 0 {
+			restarts = settings.Restarts
+		}
+		src = settings.Src
+	}
+	if batchSize < 1 {
+		panic("cluster: non-positive batch size")
+	}
+	data := mat.DenseCopyOf(x)
+	checkK(data, k)
+	intN := rand.IntN
+	if src != nil {
+		intN = rand.New(src).IntN
+	}
+	r, _ := data.Dims()
+	inertia = math.Inf(1)
+	for range restarts {
+		c := rowsOf(data, KMeansPlusPlus(data, k, src))
+		counts := make([]int, k)
+		batch := make([]int, batchSize)
+		nearest := make([]int, batchSize)
+		for range iters {
+			for i := range batch {
+				batch[i] = intN(r)
+				nearest[i], _ = nearestRow(c, data.RawRowView(batch[i]))
+			}
+			for i, b := range batch {
+				j := nearest[i]
+				counts[j]++
+				center := c.RawRowView(j)
+				eta := 1 / float64(counts[j])
+				floats.Scale(1-eta, center)
+				floats.AddScaled(center, eta, data.RawRowView(b))
+			}
+		}
+		l := make([]int, r)
+		dist := make([]float64, r)
+		for i := range l {
+			l[i] = -1
+		}
+		assign(l, dist, data, c)
+		if in := floats.Sum(dist); in < inertia {
+			centers, labels, inertia = c, l, in
+		}
+	}
+	return centers, labels, inertia
+}
+
+// KMeansPlusPlus returns the indices of k rows of x chosen as initial
+// cluster centers by the k-means++ algorithm. The first center is chosen
+// uniformly at random, and each subsequent center is chosen with
+// probability proportional to the squared distance from the nearest center
+// already chosen. If src is nil, the global random source is used.
+//
+// See Arthur and Vassilvitskii, "k-means++: the advantages of careful
+// seeding", Proceedings of the 18th Annual ACM-SIAM Symposium on Discrete
+// Algorithms, 2007.
+//
+// KMeansPlusPlus panics if k is not in [1, r] where r is the number of rows
+// of x.
+func KMeansPlusPlus(x mat.Matrix, k int, src rand.Source) []int {
+	var (
+		intN func(int) int
+		f64  func() float64
+	)
+	if src != nil {
+		rnd := rand.New(src)
+		intN, f64 = rnd.IntN, rnd.Float64
+	} else {
+		intN, f64 = rand.IntN, rand.Float64
+	}
+	data, ok := x.(*mat.Dense)
+	if !ok {
+		data = mat.DenseCopyOf(x)
+	}
+	checkK(data, k)
+	r, _ := data.Dims()
+
+	idx := make([]int, 0, k)
+	idx = append(idx, intN(r))
+	dist := make([]float64, r)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	for len(idx) < k {
+		last := data.RawRowView(idx[len(idx)-1])
+		for i := range dist {
+			dist[i] = math.Min(dist[i], sqDist(data.RawRowView(i), last))
+		}
+		total := floats.Sum(dist)
+		next := -1
+		if total > 0 {
+			u := f64() * total
+			for i, d := range dist {
+				if d == 0 {
+					continue
+				}
+				// Taking the last candidate guards against
+				// rounding at the end of the cumulative sum.
+				next = i
+				u -= d
+				if u < 0 {
+					break
+				}
+			}
+		} else {
+			// All remaining observations coincide with chosen
+			// centers, so choose any unchosen row.
+			next = unchosen(idx, r, intN)
+		}
+		idx = append(idx, next)
+	}
+	return idx
+}
+
+// unchosen returns a uniformly chosen integer in [0, n) that is not in idx.
+func unchosen(idx []int, n int, intN func(int) int) int {
+	taken := make(map[int]bool, len(idx))
+	for _, i := range idx {
+		taken[i] = true
+	}
+	j := intN(n - len(idx))
+	for i := 0; i < n; i++ {
+		if taken[i] {
+			continue
+		}
+		if j == 0 {
+			return i
+		}
+		j--
+	}
+	panic("cluster: no unchosen rows")
+}
+
+// Assign returns the index of the nearest row of centers to each row of x
+// and the inertia of the assignment, the sum of squared distances from the
+// rows of x to their nearest centers. Assign panics if the numbers of
+// columns of x and centers differ.
+func Assign(x, centers mat.Matrix) (labels []int, inertia float64) {
+	data := mat.DenseCopyOf(x)
+	c := mat.DenseCopyOf(centers)
+	_, xc := data.Dims()
+	_, cc := c.Dims()
+	if xc != cc {
+		panic(mat.ErrShape)
+	}
+	r, _ := data.Dims()
+	labels = make([]int, r)
+	dist := make([]float64, r)
+	for i := range labels {
+		labels[i] = -1
+	}
+	assign(labels, dist, data, c)
+	return labels, floats.Sum(dist)
+}
+
+// assign sets the labels of the rows of x to their nearest centers and the
+// elements of dist to the squared distances, and returns whether any label
+// changed.
+func assign(labels []int, dist []float64, x, centers *mat.Dense) bool {
+	var changed bool
+	for i := range labels {
+		j, d := nearestRow(centers, x.RawRowView(i))
+		if labels[i] != j {
+			labels[i] = j
+			changed = true
+		}
+		dist[i] = d
+	}
+	return changed
+}
+
+// nearestRow returns the index of the row of m nearest to v and the
+// squared distance to it.
+func nearestRow(m *mat.Dense, v []float64) (int, float64) {
+	r, _ := m.Dims()
+	best := -1
+	min := math.Inf(1)
+	for i := 0; i < r; i++ {
+		if d := sqDist(m.RawRowView(i), v); d < min {
+			best = i
+			min = d
+		}
+	}
+	return best, min
+}
+
+// rowsOf returns a new matrix holding the rows of x with the given indices.
+func rowsOf(x *mat.Dense, idx []int) *mat.Dense {
+	_, c := x.Dims()
+	m := mat.NewDense(len(idx), c, nil)
+	for i, j := range idx {
+		copy(m.RawRowView(i), x.RawRowView(j))
+	}
+	return m
+}
+
+// sqDist returns the squared Euclidean distance between a and b.
+func sqDist(a, b []float64) float64 {
+	var d float64
+	for i, v := range a {
+		v -= b[i]
+		d += v * v
+	}
+	return d
+}
+
+// checkK panics if k is not a valid number of clusters for the rows of x.
+func checkK(x mat.Matrix, k int) {
+	r, _ := x.Dims()
+	if k < 1 || k > r {
+		panic("cluster: invalid number of clusters")
+	}
+}
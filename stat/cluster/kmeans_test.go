@@ -0,0 +1,226 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// blobs returns n observations from each of the normal distributions with
+// the given means and standard deviation, and the index of the mean of
+// each observation.
+func blobs(rnd *rand.Rand, means [][]float64, sigma float64, n int) (*mat.Dense, []int) {
+	d := len(means[0])
+	x := mat.NewDense(n*len(means), d, nil)
+	truth := make([]int, n*len(means))
+	for i := range truth {
+		truth[i] = i % len(means)
+		row := x.RawRowView(i)
+		for j, m := range means[truth[i]] {
+			row[j] = m + sigma*rnd.NormFloat64()
+		}
+	}
+	return x, truth
+}
+
+// samePartition returns whether the labels a and b describe the same
+// partition up to relabeling.
+func samePartition(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ab := make(map[int]int)
+	ba := make(map[int]int)
+	for i := range a {
+		if l, ok := ab[a[i]]; ok && l != b[i] {
+			return false
+		}
+		if l, ok := ba[b[i]]; ok && l != a[i] {
+			return false
+		}
+		ab[a[i]] = b[i]
+		ba[b[i]] = a[i]
+	}
+	return true
+}
+
+var blobMeans = [][]float64{
+	{0, 0},
+	{10, 0},
+	{0, 10},
+	{10, 10},
+	{5, 5},
+}
+
+func TestKMeans(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, truth := blobs(rnd, blobMeans, 0.5, 100)
+	for _, test := range []struct {
+		name string
+		fn   func(x mat.Matrix, k int, settings *KMeansSettings) (*mat.Dense, []int, float64)
+	}{
+		{name: "lloyd", fn: KMeans},
+		{
+			name: "mini-batch",
+			fn: func(x mat.Matrix, k int, settings *KMeansSettings) (*mat.Dense, []int, float64) {
+				return MiniBatchKMeans(x, k, 50, settings)
+			},
+		},
+	} {
+		settings := &KMeansSettings{Restarts: 5, Src: rand.NewPCG(1, 1)}
+		centers, labels, inertia := test.fn(x, len(blobMeans), settings)
+		if !samePartition(labels, truth) {
+			t.Errorf("unexpected partition for %s k-means", test.name)
+		}
+		for i, l := range labels {
+			if !floats.EqualApprox(centers.RawRowView(l), blobMeans[truth[i]], 0.2) {
+				t.Errorf("unexpected center for %s k-means: got:%v want:%v", test.name, centers.RawRowView(l), blobMeans[truth[i]])
+				break
+			}
+		}
+		if want := Inertia(x, centers, labels); !scalar.EqualWithinAbsOrRel(inertia, want, 1e-10, 1e-10) {
+			t.Errorf("unexpected inertia for %s k-means: got:%v want:%v", test.name, inertia, want)
+		}
+		got, in := Assign(x, centers)
+		if !samePartition(got, labels) || in != inertia {
+			t.Errorf("assignment does not match clustering for %s k-means", test.name)
+		}
+	}
+}
+
+func TestKMeansFixedPoint(t *testing.T) {
+	t.Parallel()
+	// The centers returned by Lloyd's algorithm are the means of their
+	// clusters, and each observation is assigned to its nearest center.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x := mat.NewDense(200, 3, nil)
+	for i := 0; i < 200; i++ {
+		for j := 0; j < 3; j++ {
+			x.Set(i, j, rnd.Float64())
+		}
+	}
+	for _, k := range []int{1, 2, 7, 20} {
+		centers, labels, _ := KMeans(x, k, &KMeansSettings{Src: rand.NewPCG(1, 1)})
+		mean := mat.NewDense(k, 3, nil)
+		counts := make([]float64, k)
+		for i, l := range labels {
+			floats.Add(mean.RawRowView(l), x.RawRowView(i))
+			counts[l]++
+		}
+		for j := range counts {
+			if counts[j] == 0 {
+				t.Errorf("empty cluster %d for k=%d", j, k)
+				continue
+			}
+			floats.Scale(1/counts[j], mean.RawRowView(j))
+		}
+		if !mat.EqualApprox(centers, mean, 1e-12) {
+			t.Errorf("centers are not cluster means for k=%d", k)
+		}
+		for i, l := range labels {
+			n, _ := nearestRow(centers, x.RawRowView(i))
+			if n != l {
+				t.Errorf("observation %d not assigned to nearest center for k=%d", i, k)
+				break
+			}
+		}
+	}
+}
+
+func TestKMeansPlusPlus(t *testing.T) {
+	t.Parallel()
+	// Observations with few distinct values still give distinct rows.
+	x := mat.NewDense(10, 1, []float64{0, 0, 0, 1, 1, 1, 1, 2, 2, 2})
+	for seed := uint64(0); seed < 20; seed++ {
+		idx := KMeansPlusPlus(x, 6, rand.NewPCG(seed, seed))
+		seen := make(map[int]bool)
+		values := make(map[float64]bool)
+		for _, i := range idx {
+			if seen[i] {
+				t.Errorf("repeated index %d for seed %d: %v", i, seed, idx)
+			}
+			seen[i] = true
+			values[x.At(i, 0)] = true
+		}
+		// The first three centers are the distinct values since
+		// coincident observations have zero probability.
+		if len(values) != 3 {
+			t.Errorf("not all distinct values chosen for seed %d: %v", seed, idx)
+		}
+	}
+
+	// Seeding picks one observation from each well separated blob.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	b, truth := blobs(rnd, [][]float64{{0}, {1000}, {-1000}}, 1, 20)
+	var hits int
+	for seed := uint64(0); seed < 20; seed++ {
+		idx := KMeansPlusPlus(b, 3, rand.NewPCG(seed, seed))
+		got := make(map[int]bool)
+		for _, i := range idx {
+			got[truth[i]] = true
+		}
+		if len(got) == 3 {
+			hits++
+		}
+	}
+	if hits < 19 {
+		t.Errorf("k-means++ seeding did not spread centers: %d of 20", hits)
+	}
+}
+
+func TestMiniBatchKMeansInertia(t *testing.T) {
+	t.Parallel()
+	// Mini-batch k-means is close to Lloyd's algorithm on uniform data.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x := mat.NewDense(2000, 2, nil)
+	for i := 0; i < 2000; i++ {
+		x.Set(i, 0, rnd.Float64())
+		x.Set(i, 1, rnd.Float64())
+	}
+	_, _, lloyd := KMeans(x, 8, &KMeansSettings{Src: rand.NewPCG(1, 1)})
+	_, _, mini := MiniBatchKMeans(x, 8, 100, &KMeansSettings{Iterations: 200, Src: rand.NewPCG(1, 1)})
+	if mini > 1.1*lloyd {
+		t.Errorf("unexpected mini-batch inertia: got:%v lloyd:%v", mini, lloyd)
+	}
+}
+
+func TestKMeansPanics(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(3, 1, []float64{0, 1, 2})
+	for _, k := range []int{0, 4} {
+		if !panics(func() { KMeans(x, k, nil) }) {
+			t.Errorf("expected panic for k=%d", k)
+		}
+		if !panics(func() { KMeansPlusPlus(x, k, nil) }) {
+			t.Errorf("expected panic for k-means++ with k=%d", k)
+		}
+	}
+	if !panics(func() { MiniBatchKMeans(x, 2, 0, nil) }) {
+		t.Error("expected panic for zero batch size")
+	}
+	if !panics(func() { Assign(x, mat.NewDense(2, 2, nil)) }) {
+		t.Error("expected panic for mismatched dimensions")
+	}
+	// k equal to the number of observations gives zero inertia.
+	_, _, in := KMeans(x, 3, nil)
+	if in != 0 || math.IsNaN(in) {
+		t.Errorf("unexpected inertia for one cluster per observation: %v", in)
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// KMedoids partitions n observations into k clusters by the partitioning
+// around medoids (PAM) algorithm, where dist returns the dissimilarity
+// between observations i and j. The dissimilarity need not be a metric, but
+// must be non-negative, symmetric and zero for identical observations. The
+// At method of a mat.Symmetric holding precomputed distances may be used
+// as dist.
+//
+// KMedoids returns the indices of the medoids, the observations at the
+// centers of the clusters, the cluster of each observation as an index into
+// medoids, and the cost of the clustering, the sum of the dissimilarities
+// between the observations and the medoids of their clusters.
+//
+// The initial medoids are chosen greedily by the BUILD phase, and then
+// the swap of a medoid and a non-medoid that most reduces the cost is made
+// until no swap reduces it. Each swap pass costs O(k(n-k)n) time, and the
+// n×n dissimilarities are evaluated once and retained, so KMedoids is
+// suited to moderate numbers of observations.
+//
+// See Kaufman and Rousseeuw, "Finding Groups in Data: An Introduction to
+// Cluster Analysis", Wiley, 1990, chapter 2.
+//
+// KMedoids panics if k is not in [1, n].
+func KMedoids(n, k int, dist func(i, j int) float64) (medoids, labels []int, cost float64) {
+	if k < 1 || k > n {
+		panic("cluster: invalid number of clusters")
+	}
+	d := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			d.SetSym(i, j, dist(i, j))
+		}
+	}
+
+	isMedoid := make([]bool, n)
+	// near and second hold the dissimilarities to the nearest and
+	// second nearest medoids, and nearest holds the nearest medoid.
+	near := make([]float64, n)
+	second := make([]float64, n)
+	nearest := make([]int, n)
+	for i := range near {
+		near[i] = math.Inf(1)
+	}
+
+	// BUILD: add the medoid that most reduces the cost at each step.
+	for len(medoids) < k {
+		best := -1
+		bestCost := math.Inf(1)
+		for h := 0; h < n; h++ {
+			if isMedoid[h] {
+				continue
+			}
+			var c float64
+			for j := 0; j < n; j++ {
+				c += math.Min(near[j], d.At(j, h))
+			}
+			if c < bestCost {
+				best = h
+				bestCost = c
+			}
+		}
+		medoids = append(medoids, best)
+		isMedoid[best] = true
+		for j := range near {
+			near[j] = math.Min(near[j], d.At(j, best))
+		}
+	}
+
+	// SWAP: make the best improving swap until none remains.
+	cost = updateNearest(nearest, near, second, d, medoids)
+	for {
+		bestDelta := 0.0
+		bestM, bestH := -1, -1
+		for mi := range medoids {
+			for h := 0; h < n; h++ {
+				if isMedoid[h] {
+					continue
+				}
+				var delta float64
+				for j := 0; j < n; j++ {
+					djh := d.At(j, h)
+					if nearest[j] == mi {
+						delta += math.Min(djh, second[j]) - near[j]
+					} else if djh < near[j] {
+						delta += djh - near[j]
+					}
+				}
+				if delta < bestDelta {
+					bestDelta = delta
+					bestM, bestH = mi, h
+				}
+			}
+		}
+		if bestM < 0 {
+			break
+		}
+		old := medoids[bestM]
+		medoids[bestM] = bestH
+		c := updateNearest(nearest, near, second, d, medoids)
+		if c >= cost {
+			// The improvement was lost to rounding.
+			medoids[bestM] = old
+			updateNearest(nearest, near, second, d, medoids)
+			break
+		}
+		isMedoid[old] = false
+		isMedoid[bestH] = true
+		cost = c
+	}
+	return medoids, nearest, cost
+}
+
+// updateNearest sets the nearest medoid to each observation and the
+// dissimilarities to the nearest and second nearest medoids, and returns
+// the cost of the clustering.
+func updateNearest(nearest []int, near, second []float64, d *mat.SymDense, medoids []int) float64 {
+	var cost float64
+	for j := range nearest {
+		near[j] = math.Inf(1)
+		second[j] = math.Inf(1)
+		for mi, m := range medoids {
+			v := d.At(j, m)
+			switch {
+			case v < near[j]:
+				second[j] = near[j]
+				near[j] = v
+				nearest[j] = mi
+			case v < second[j]:
+				second[j] = v
+			}
+		}
+		cost += near[j]
+	}
+	return cost
+}
@@ -0,0 +1,144 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// manhattan returns the city block distance between rows of x.
+func manhattan(x *mat.Dense) func(i, j int) float64 {
+	return func(i, j int) float64 {
+		var d float64
+		for k, v := range x.RawRowView(i) {
+			d += math.Abs(v - x.At(j, k))
+		}
+		return d
+	}
+}
+
+// medoidCost returns the cost of clustering n observations with the given
+// medoids.
+func medoidCost(n int, medoids []int, dist func(i, j int) float64) float64 {
+	var cost float64
+	for j := 0; j < n; j++ {
+		min := math.Inf(1)
+		for _, m := range medoids {
+			min = math.Min(min, dist(j, m))
+		}
+		cost += min
+	}
+	return cost
+}
+
+func TestKMedoids(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, truth := blobs(rnd, blobMeans, 0.7, 30)
+	dist := manhattan(x)
+	n, _ := x.Dims()
+	medoids, labels, cost := KMedoids(n, len(blobMeans), dist)
+	if !samePartition(labels, truth) {
+		t.Error("unexpected partition")
+	}
+	if want := medoidCost(n, medoids, dist); !scalar.EqualWithinAbsOrRel(cost, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected cost: got:%v want:%v", cost, want)
+	}
+	for i, l := range labels {
+		for _, m := range medoids {
+			if dist(i, m) < dist(i, medoids[l]) {
+				t.Errorf("observation %d not assigned to nearest medoid", i)
+			}
+		}
+	}
+}
+
+func TestKMedoidsLocalOptimum(t *testing.T) {
+	t.Parallel()
+	// No swap of a medoid and a non-medoid reduces the cost, and the
+	// result is the global optimum for small problems.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ n, k int }{
+		{n: 1, k: 1},
+		{n: 5, k: 5},
+		{n: 10, k: 1},
+		{n: 12, k: 3},
+		{n: 15, k: 4},
+	} {
+		x := mat.NewDense(test.n, 2, nil)
+		for i := 0; i < test.n; i++ {
+			x.Set(i, 0, rnd.Float64())
+			x.Set(i, 1, rnd.Float64())
+		}
+		dist := Euclidean(x)
+		sym := mat.NewSymDense(test.n, nil)
+		for i := 0; i < test.n; i++ {
+			for j := i; j < test.n; j++ {
+				sym.SetSym(i, j, dist(i, j))
+			}
+		}
+		medoids, _, cost := KMedoids(test.n, test.k, sym.At)
+		isMedoid := make(map[int]bool)
+		for _, m := range medoids {
+			isMedoid[m] = true
+		}
+		if len(isMedoid) != test.k {
+			t.Errorf("unexpected medoids for n=%d k=%d: %v", test.n, test.k, medoids)
+			continue
+		}
+		for mi := range medoids {
+			for h := 0; h < test.n; h++ {
+				if isMedoid[h] {
+					continue
+				}
+				swapped := append([]int(nil), medoids...)
+				swapped[mi] = h
+				if c := medoidCost(test.n, swapped, sym.At); c < cost-1e-12 {
+					t.Errorf("swap improves cost for n=%d k=%d: %v < %v", test.n, test.k, c, cost)
+				}
+			}
+		}
+
+		best := math.Inf(1)
+		subsets(test.n, test.k, func(s []int) {
+			best = math.Min(best, medoidCost(test.n, s, sym.At))
+		})
+		if !scalar.EqualWithinAbsOrRel(cost, best, 1e-12, 1e-12) {
+			t.Errorf("unexpected cost for n=%d k=%d: got:%v want:%v", test.n, test.k, cost, best)
+		}
+	}
+}
+
+// subsets calls fn with each k-subset of [0, n).
+func subsets(n, k int, fn func([]int)) {
+	s := make([]int, k)
+	var rec func(i, start int)
+	rec = func(i, start int) {
+		if i == k {
+			fn(s)
+			return
+		}
+		for j := start; j < n; j++ {
+			s[i] = j
+			rec(i+1, j+1)
+		}
+	}
+	rec(0, 0)
+}
+
+func TestKMedoidsPanics(t *testing.T) {
+	t.Parallel()
+	dist := func(i, j int) float64 { return math.Abs(float64(i - j)) }
+	for _, k := range []int{0, 4} {
+		if !panics(func() { KMedoids(3, k, dist) }) {
+			t.Errorf("expected panic for k=%d", k)
+		}
+	}
+}
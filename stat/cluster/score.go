@@ -0,0 +1,161 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Euclidean returns a function returning the Euclidean distance between
+// rows i and j of x.
+func Euclidean(x mat.Matrix) func(i, j int) float64 {
+	data := mat.DenseCopyOf(x)
+	return func(i, j int) float64 {
+		return math.Sqrt(sqDist(data.RawRowView(i), data.RawRowView(j)))
+	}
+}
+
+// Silhouette returns the mean silhouette coefficient of the clustering of
+// observations given by labels, where dist returns the dissimilarity
+// between observations i and j. Clusters are labeled 0, 1, ..., and the
+// observations are indexed by their position in labels. If dst is not nil,
+// the silhouette coefficient of each observation is stored in it.
+//
+// The silhouette coefficient of observation i is
+//
+//	s(i) = (b(i) - a(i)) / max(a(i), b(i)),
+//
+// where a(i) is the mean dissimilarity between i and the other members of
+// its cluster and b(i) is the least mean dissimilarity between i and the
+// members of another cluster. It is zero for observations in clusters of
+// one member. Coefficients range from -1 to 1, with larger values
+// indicating that the observation is better matched to its own cluster.
+//
+// See Rousseeuw, "Silhouettes: a graphical aid to the interpretation and
+// validation of cluster analysis", Journal of Computational and Applied
+// Mathematics 20, 1987.
+//
+// Silhouette panics if a label is negative, if there are fewer than two
+// non-empty clusters, or if dst is not nil and its length differs from
+// that of labels.
+func Silhouette(dst []float64, labels []int, dist func(i, j int) float64) float64 {
+	n := len(labels)
+	if dst != nil && len(dst) != n {
+		panic("cluster: mismatched slice lengths")
+	}
+	k := 0
+	for _, l := range labels {
+		if l < 0 {
+			panic("cluster: negative label")
+		}
+		k = max(k, l+1)
+	}
+	size := make([]int, k)
+	for _, l := range labels {
+		size[l]++
+	}
+	var nonEmpty int
+	for _, s := range size {
+		if s != 0 {
+			nonEmpty++
+		}
+	}
+	if nonEmpty < 2 {
+		panic("cluster: fewer than two clusters")
+	}
+
+	sums := make([]float64, k)
+	var total float64
+	for i, li := range labels {
+		for c := range sums {
+			sums[c] = 0
+		}
+		for j, lj := range labels {
+			if j != i {
+				sums[lj] += dist(i, j)
+			}
+		}
+		var s float64
+		if size[li] > 1 {
+			a := sums[li] / float64(size[li]-1)
+			b := math.Inf(1)
+			for c, v := range sums {
+				if c != li && size[c] != 0 {
+					b = math.Min(b, v/float64(size[c]))
+				}
+			}
+			if m := math.Max(a, b); m > 0 {
+				s = (b - a) / m
+			}
+		}
+		if dst != nil {
+			dst[i] = s
+		}
+		total += s
+	}
+	return total / float64(n)
+}
+
+// Elbow returns the inertia of the k-means clusterings of the observations
+// held in the rows of x into 1, 2, ..., maxK clusters, with inertia[i]
+// holding the inertia for i+1 clusters, and the number of clusters at the
+// elbow of the inertia curve. The clusterings are found by KMeans with the
+// given settings.
+//
+// The elbow is the number of clusters whose inertia lies farthest below the
+// line joining the first and last points of the curve after scaling both
+// axes to [0, 1], the point beyond which adding clusters gives diminishing
+// returns. The heuristic assumes the inertia decreases with the number of
+// clusters and should be checked against the returned curve.
+//
+// Elbow panics if maxK is not in [1, r] where r is the number of rows of x.
+func Elbow(x mat.Matrix, maxK int, settings *KMeansSettings) (k int, inertia []float64) {
+	checkK(x, maxK)
+	inertia = make([]float64, maxK)
+	for i := range inertia {
+		_, _, inertia[i] = KMeans(x, i+1, settings)
+	}
+	k = 1
+	first, last := inertia[0], inertia[maxK-1]
+	if maxK < 3 || first == last {
+		return k, inertia
+	}
+	best := 0.0
+	for i, v := range inertia {
+		// Distance below the line joining the scaled end points.
+		t := float64(i) / float64(maxK-1)
+		d := (1 - t) - (v-last)/(first-last)
+		if d > best {
+			best = d
+			k = i + 1
+		}
+	}
+	return k, inertia
+}
+
+// Inertia returns the sum of squared distances from the observations held
+// in the rows of x to the rows of centers given by labels. Inertia panics
+// if the length of labels does not match the number of rows of x or if the
+// numbers of columns of x and centers differ.
+func Inertia(x, centers mat.Matrix, labels []int) float64 {
+	r, c := x.Dims()
+	_, cc := centers.Dims()
+	if len(labels) != r || c != cc {
+		panic(mat.ErrShape)
+	}
+	row := make([]float64, c)
+	center := make([]float64, c)
+	var sum float64
+	for i, l := range labels {
+		mat.Row(row, i, x)
+		mat.Row(center, l, centers)
+		floats.Sub(row, center)
+		sum += floats.Dot(row, row)
+	}
+	return sum
+}
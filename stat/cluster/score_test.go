@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cluster
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestSilhouette(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(5, 1, []float64{0, 1, 4, 5, 10})
+	labels := []int{0, 0, 1, 1, 2}
+	// Observation 0: a = 1, b = min(4.5, 10) = 4.5.
+	// Observation 1: a = 1, b = min(3.5, 9) = 3.5.
+	// Observation 2: a = 1, b = min(3.5, 6) = 3.5.
+	// Observation 3: a = 1, b = min(4.5, 5) = 4.5.
+	// Observation 4 is a singleton.
+	want := []float64{3.5 / 4.5, 2.5 / 3.5, 2.5 / 3.5, 3.5 / 4.5, 0}
+	got := make([]float64, 5)
+	mean := Silhouette(got, labels, Euclidean(x))
+	if !floats.EqualApprox(got, want, 1e-14) {
+		t.Errorf("unexpected silhouettes:\ngot: %v\nwant:%v", got, want)
+	}
+	if math.Abs(mean-floats.Sum(want)/5) > 1e-14 {
+		t.Errorf("unexpected mean silhouette: got:%v want:%v", mean, floats.Sum(want)/5)
+	}
+
+	// A poor clustering has a lower score than the true one.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	b, truth := blobs(rnd, blobMeans[:3], 1, 30)
+	bad := make([]int, len(truth))
+	for i := range bad {
+		bad[i] = i % 2
+	}
+	if good, poor := Silhouette(nil, truth, Euclidean(b)), Silhouette(nil, bad, Euclidean(b)); good < 0.7 || poor > 0.1 {
+		t.Errorf("unexpected silhouette scores: true:%v poor:%v", good, poor)
+	}
+
+	if !panics(func() { Silhouette(nil, []int{1, 1, 1}, Euclidean(x)) }) {
+		t.Error("expected panic for one cluster")
+	}
+	if !panics(func() { Silhouette(nil, []int{0, -1, 1}, Euclidean(x)) }) {
+		t.Error("expected panic for negative label")
+	}
+	if !panics(func() { Silhouette(make([]float64, 2), labels, Euclidean(x)) }) {
+		t.Error("expected panic for mismatched length")
+	}
+}
+
+func TestElbow(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, _ := blobs(rnd, blobMeans[:4], 0.5, 50)
+	k, inertia := Elbow(x, 8, &KMeansSettings{Restarts: 3, Src: rand.NewPCG(1, 1)})
+	if k != 4 {
+		t.Errorf("unexpected elbow: got:%d want:4 inertia:%v", k, inertia)
+	}
+	if len(inertia) != 8 {
+		t.Errorf("unexpected number of inertias: got:%d want:8", len(inertia))
+	}
+	for i := 1; i < len(inertia); i++ {
+		if inertia[i] > inertia[i-1] {
+			t.Errorf("inertia increased at %d clusters: %v", i+1, inertia)
+		}
+	}
+}
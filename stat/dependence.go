@@ -0,0 +1,468 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// MissingPolicy specifies the treatment of missing values, represented by
+// NaN, when computing pairwise dependence matrices.
+type MissingPolicy int
+
+const (
+	// MissingPropagate propagates missing values, so the
+	// dependence between two columns is NaN if either column
+	// has a missing value.
+	MissingPropagate MissingPolicy = iota
+
+	// MissingPairwise computes the dependence between each pair
+	// of columns from the rows in which neither column has a
+	// missing value. The resulting matrix may not be positive
+	// semi-definite.
+	MissingPairwise
+
+	// MissingComplete computes the dependence between all
+	// columns from the rows in which no column has a missing
+	// value.
+	MissingComplete
+)
+
+// SpearmanMatrix computes the matrix of Spearman rank correlations between
+// the columns of x and stores the result in dst. The Spearman correlation is
+// the Pearson correlation of the ranks of the observations, with tied values
+// given the mean of their ranks. Missing values in x are treated according to
+// missing.
+//
+// The correlation involving a constant column is NaN. The pairs of columns
+// are computed concurrently.
+//
+// The dst matrix must either be empty or have the same number of columns as x.
+func SpearmanMatrix(dst *mat.SymDense, x mat.Matrix, missing MissingPolicy) {
+	cols := dependenceColumns(dst, x, missing)
+	ranks := make([][]float64, len(cols))
+	for j, c := range cols {
+		if !hasNaN(c) {
+			ranks[j] = rank(nil, c)
+		}
+	}
+	pairwiseDependence(dst, len(cols), func(i, j int) float64 {
+		if ranks[i] != nil && ranks[j] != nil {
+			return Correlation(ranks[i], ranks[j], nil)
+		}
+		if missing == MissingPropagate {
+			return math.NaN()
+		}
+		x, y := completePairs(cols[i], cols[j])
+		return Correlation(rank(nil, x), rank(nil, y), nil)
+	})
+}
+
+// KendallMatrix computes the matrix of Kendall τ_b rank correlations between
+// the columns of x and stores the result in dst. τ_b is
+//
+//	τ_b = (n_c - n_d) / sqrt((n₀ - n₁) (n₀ - n₂)),
+//
+// where n_c and n_d are the numbers of concordant and discordant pairs of
+// observations, n₀ is the number of pairs and n₁ and n₂ are the numbers of
+// pairs tied in each column. Missing values in x are treated according to
+// missing.
+//
+// Each correlation is computed in O(n log n) time for n observations by
+// Knight's algorithm. The correlation involving a constant column is NaN.
+// The pairs of columns are computed concurrently.
+//
+// The dst matrix must either be empty or have the same number of columns as x.
+func KendallMatrix(dst *mat.SymDense, x mat.Matrix, missing MissingPolicy) {
+	cols := dependenceColumns(dst, x, missing)
+	pairwiseDependence(dst, len(cols), func(i, j int) float64 {
+		x, y := cols[i], cols[j]
+		if hasNaN(x) || hasNaN(y) {
+			if missing == MissingPropagate {
+				return math.NaN()
+			}
+			x, y = completePairs(x, y)
+		}
+		return kendallTauB(x, y)
+	})
+}
+
+// DistanceCorrelationMatrix computes the matrix of distance correlations
+// between the columns of x and stores the result in dst. The distance
+// correlation of Székely, Rizzo and Bakirov is
+//
+//	R(x, y) = sqrt(dCov²(x, y) / sqrt(dVar²(x) dVar²(y))),
+//
+// where dCov² is the mean of the elementwise product of the double-centered
+// matrices of pairwise distances between the observations of each column.
+// Unlike the Pearson and rank correlations, the distance correlation is zero
+// only if the columns are independent, and it is between zero and one.
+// Missing values in x are treated according to missing.
+//
+// Each correlation is computed in O(n²) time and O(n) space for n
+// observations. The correlation involving a constant column is NaN. The pairs
+// of columns are computed concurrently.
+//
+// The dst matrix must either be empty or have the same number of columns as x.
+func DistanceCorrelationMatrix(dst *mat.SymDense, x mat.Matrix, missing MissingPolicy) {
+	cols := dependenceColumns(dst, x, missing)
+	means := make([][]float64, len(cols))
+	for j, c := range cols {
+		if !hasNaN(c) {
+			means[j] = distanceMeans(c)
+		}
+	}
+	dcov := func(x, y, mx, my []float64) float64 {
+		if mx == nil {
+			mx = distanceMeans(x)
+		}
+		if my == nil {
+			my = distanceMeans(y)
+		}
+		return distanceCovariance(x, y, mx, my)
+	}
+	pairwiseDependence(dst, len(cols), func(i, j int) float64 {
+		x, y := cols[i], cols[j]
+		mx, my := means[i], means[j]
+		if mx == nil || my == nil {
+			if missing == MissingPropagate {
+				return math.NaN()
+			}
+			x, y = completePairs(x, y)
+			mx, my = nil, nil
+		}
+		if i == j {
+			// The diagonal is one unless the column is constant.
+			v := dcov(x, x, mx, mx)
+			return v / v
+		}
+		xy := dcov(x, y, mx, my)
+		xx := dcov(x, x, mx, mx)
+		yy := dcov(y, y, my, my)
+		return math.Sqrt(math.Max(0, xy) / math.Sqrt(xx*yy))
+	})
+}
+
+// PseudoObservations stores the pseudo-observations of the empirical copula
+// of x into dst. The pseudo-observations are the ranks of the values in each
+// column of x divided by one more than the number of values in the column,
+// so they lie in the open interval (0, 1). Tied values are given the mean of
+// their ranks. Missing values, represented by NaN, are not ranked and remain
+// missing in dst.
+//
+// If dst is empty, PseudoObservations will resize dst to have the dimensions
+// of x. When dst is non-empty, PseudoObservations will panic if dst does not
+// have the dimensions of x.
+func PseudoObservations(dst *mat.Dense, x mat.Matrix) {
+	r, c := x.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else if r2, c2 := dst.Dims(); r2 != r || c2 != c {
+		panic(mat.ErrShape)
+	}
+	col := make([]float64, r)
+	var idx []int
+	var vals []float64
+	for j := 0; j < c; j++ {
+		mat.Col(col, j, x)
+		idx = idx[:0]
+		vals = vals[:0]
+		for i, v := range col {
+			if !math.IsNaN(v) {
+				idx = append(idx, i)
+				vals = append(vals, v)
+			}
+		}
+		ranks := rank(nil, vals)
+		floats.Scale(1/float64(len(vals)+1), ranks)
+		for i := range col {
+			col[i] = math.NaN()
+		}
+		for k, i := range idx {
+			col[i] = ranks[k]
+		}
+		dst.SetCol(j, col)
+	}
+}
+
+// EmpiricalCopula returns the value of the empirical copula of the
+// pseudo-observations held in the rows of u at the point p,
+//
+//	C(p) = 1/n ∑_i ∏_j 1{u_ij ≤ p_j},
+//
+// the proportion of the n pseudo-observations that are at most p in every
+// coordinate. The pseudo-observations of data are computed by
+// PseudoObservations. Rows of u with missing values are ignored.
+//
+// EmpiricalCopula panics if the length of p does not match the number of
+// columns of u.
+func EmpiricalCopula(p []float64, u mat.Matrix) float64 {
+	r, c := u.Dims()
+	if len(p) != c {
+		panic("stat: slice length mismatch")
+	}
+	var count, n int
+outer:
+	for i := 0; i < r; i++ {
+		below := true
+		for j, pj := range p {
+			v := u.At(i, j)
+			if math.IsNaN(v) {
+				continue outer
+			}
+			below = below && v <= pj
+		}
+		n++
+		if below {
+			count++
+		}
+	}
+	return float64(count) / float64(n)
+}
+
+// dependenceColumns returns the columns of x, removing the rows with missing
+// values if missing is MissingComplete, after checking that dst is empty or
+// has the same number of columns as x. If dst is empty, it is resized.
+func dependenceColumns(dst *mat.SymDense, x mat.Matrix, missing MissingPolicy) [][]float64 {
+	switch missing {
+	default:
+		panic("stat: unknown missing value policy")
+	case MissingPropagate, MissingPairwise, MissingComplete:
+	}
+	r, c := x.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(c)
+	} else if dst.SymmetricDim() != c {
+		panic(mat.ErrShape)
+	}
+
+	keep := make([]bool, r)
+	for i := range keep {
+		keep[i] = true
+		if missing != MissingComplete {
+			continue
+		}
+		for j := 0; j < c; j++ {
+			if math.IsNaN(x.At(i, j)) {
+				keep[i] = false
+				break
+			}
+		}
+	}
+	cols := make([][]float64, c)
+	for j := range cols {
+		col := make([]float64, 0, r)
+		for i, k := range keep {
+			if k {
+				col = append(col, x.At(i, j))
+			}
+		}
+		cols[j] = col
+	}
+	return cols
+}
+
+// pairwiseDependence stores fn(i, j) into the upper triangle of the n×n
+// matrix dst, computing the elements concurrently.
+func pairwiseDependence(dst *mat.SymDense, n int, fn func(i, j int) float64) {
+	// Rows of the upper triangle are handed out in order to a
+	// pool of workers, so the longest rows are computed first.
+	var next atomic.Int64
+	work := func() {
+		for {
+			i := int(next.Add(1) - 1)
+			if i >= n {
+				return
+			}
+			for j := i; j < n; j++ {
+				// Each worker writes distinct elements
+				// of the upper triangle of dst.
+				dst.SetSym(i, j, fn(i, j))
+			}
+		}
+	}
+	workers := min(runtime.GOMAXPROCS(0), n)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			work()
+		}()
+	}
+	wg.Wait()
+}
+
+// hasNaN returns whether x contains a NaN.
+func hasNaN(x []float64) bool {
+	for _, v := range x {
+		if math.IsNaN(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// completePairs returns the elements of x and y at the indices where
+// neither is NaN.
+func completePairs(x, y []float64) (xc, yc []float64) {
+	for i, v := range x {
+		if !math.IsNaN(v) && !math.IsNaN(y[i]) {
+			xc = append(xc, v)
+			yc = append(yc, y[i])
+		}
+	}
+	return xc, yc
+}
+
+// rank stores the ranks of the values of x, starting from one, into dst
+// and returns it. Tied values are given the mean of their ranks. If dst is
+// nil, a new slice is allocated.
+func rank(dst, x []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	idx := make([]int, len(x))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return x[idx[i]] < x[idx[j]] })
+	for lo := 0; lo < len(idx); {
+		hi := lo + 1
+		for hi < len(idx) && x[idx[hi]] == x[idx[lo]] {
+			hi++
+		}
+		r := float64(lo+hi+1) / 2
+		for _, i := range idx[lo:hi] {
+			dst[i] = r
+		}
+		lo = hi
+	}
+	return dst
+}
+
+// kendallTauB returns Kendall's τ_b between x and y computed by Knight's
+// algorithm.
+func kendallTauB(x, y []float64) float64 {
+	n := len(x)
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool {
+		a, b := idx[i], idx[j]
+		if x[a] != x[b] {
+			return x[a] < x[b]
+		}
+		return y[a] < y[b]
+	})
+
+	// Count the pairs tied in x and the pairs tied in both.
+	var xTies, jointTies float64
+	for lo := 0; lo < n; {
+		hi := lo + 1
+		for hi < n && x[idx[hi]] == x[idx[lo]] {
+			hi++
+		}
+		t := float64(hi - lo)
+		xTies += t * (t - 1) / 2
+		for jlo := lo; jlo < hi; {
+			jhi := jlo + 1
+			for jhi < hi && y[idx[jhi]] == y[idx[jlo]] {
+				jhi++
+			}
+			t := float64(jhi - jlo)
+			jointTies += t * (t - 1) / 2
+			jlo = jhi
+		}
+		lo = hi
+	}
+
+	// Sorting y by a stable merge sort counts the discordant
+	// pairs as the number of swaps.
+	ys := make([]float64, n)
+	for i, j := range idx {
+		ys[i] = y[j]
+	}
+	discordant := mergeCount(ys, make([]float64, n))
+
+	var yTies float64
+	for lo := 0; lo < n; {
+		hi := lo + 1
+		for hi < n && ys[hi] == ys[lo] {
+			hi++
+		}
+		t := float64(hi - lo)
+		yTies += t * (t - 1) / 2
+		lo = hi
+	}
+
+	pairs := float64(n) * float64(n-1) / 2
+	num := pairs - xTies - yTies + jointTies - 2*discordant
+	return num / math.Sqrt((pairs-xTies)*(pairs-yTies))
+}
+
+// mergeCount sorts x in increasing order using work as scratch space and
+// returns the number of pairs i < j with x[i] > x[j] in the input.
+func mergeCount(x, work []float64) float64 {
+	n := len(x)
+	if n < 2 {
+		return 0
+	}
+	mid := n / 2
+	swaps := mergeCount(x[:mid], work[:mid]) + mergeCount(x[mid:], work[mid:])
+	i, j := 0, mid
+	for k := range work {
+		if j == n || (i < mid && x[i] <= x[j]) {
+			work[k] = x[i]
+			i++
+		} else {
+			work[k] = x[j]
+			swaps += float64(mid - i)
+			j++
+		}
+	}
+	copy(x, work)
+	return swaps
+}
+
+// distanceMeans returns the means of the rows of the matrix of distances
+// between the values of x, with the grand mean as its last element.
+func distanceMeans(x []float64) []float64 {
+	n := len(x)
+	m := make([]float64, n+1)
+	for i, xi := range x {
+		var s float64
+		for _, xk := range x {
+			s += math.Abs(xi - xk)
+		}
+		m[i] = s / float64(n)
+		m[n] += m[i]
+	}
+	m[n] /= float64(n)
+	return m
+}
+
+// distanceCovariance returns the squared distance covariance of x and y
+// given the row and grand means of their distance matrices.
+func distanceCovariance(x, y, mx, my []float64) float64 {
+	n := len(x)
+	var s float64
+	for i := range x {
+		for k := range x {
+			a := math.Abs(x[i]-x[k]) - mx[i] - mx[k] + mx[n]
+			b := math.Abs(y[i]-y[k]) - my[i] - my[k] + my[n]
+			s += a * b
+		}
+	}
+	return s / float64(n*n)
+}
@@ -0,0 +1,325 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// naiveRank returns the mean ranks of x computed by counting.
+func naiveRank(x []float64) []float64 {
+	r := make([]float64, len(x))
+	for i, xi := range x {
+		var less, equal float64
+		for _, xj := range x {
+			switch {
+			case xj < xi:
+				less++
+			case xj == xi:
+				equal++
+			}
+		}
+		r[i] = less + (equal+1)/2
+	}
+	return r
+}
+
+// naiveKendallTauB returns Kendall's τ_b computed over all pairs.
+func naiveKendallTauB(x, y []float64) float64 {
+	var s, tx, ty float64
+	for i := range x {
+		for j := i + 1; j < len(x); j++ {
+			dx := x[i] - x[j]
+			dy := y[i] - y[j]
+			switch {
+			case dx == 0 && dy == 0:
+			case dx == 0:
+				ty++
+			case dy == 0:
+				tx++
+			case dx*dy > 0:
+				s++
+				tx++
+				ty++
+			default:
+				s--
+				tx++
+				ty++
+			}
+		}
+	}
+	return s / math.Sqrt(tx*ty)
+}
+
+// naiveDistanceCorrelation returns the distance correlation of x and y
+// computed from the double-centered distance matrices.
+func naiveDistanceCorrelation(x, y []float64) float64 {
+	center := func(x []float64) *mat.Dense {
+		n := len(x)
+		d := mat.NewDense(n, n, nil)
+		for i := range x {
+			for j := range x {
+				d.Set(i, j, math.Abs(x[i]-x[j]))
+			}
+		}
+		c := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				c.Set(i, j, d.At(i, j)-mat.Sum(d.RowView(i))/float64(n)-mat.Sum(d.ColView(j))/float64(n)+mat.Sum(d)/float64(n*n))
+			}
+		}
+		return c
+	}
+	a := center(x)
+	b := center(y)
+	dcov := func(a, b *mat.Dense) float64 {
+		var m mat.Dense
+		m.MulElem(a, b)
+		return mat.Sum(&m)
+	}
+	return math.Sqrt(dcov(a, b) / math.Sqrt(dcov(a, a)*dcov(b, b)))
+}
+
+// dependenceData returns an n×p matrix of correlated data with ties, with
+// each element replaced by NaN with probability missing.
+func dependenceData(rnd *rand.Rand, n, p int, missing float64) *mat.Dense {
+	x := mat.NewDense(n, p, nil)
+	for i := 0; i < n; i++ {
+		z := rnd.NormFloat64()
+		for j := 0; j < p; j++ {
+			v := z*float64(j%3) + rnd.NormFloat64()
+			if j%2 == 1 {
+				// Introduce ties and a nonlinear relationship.
+				v = math.Round(v * v)
+			}
+			if rnd.Float64() < missing {
+				v = math.NaN()
+			}
+			x.Set(i, j, v)
+		}
+	}
+	return x
+}
+
+func TestDependenceMatrix(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name  string
+		fn    func(dst *mat.SymDense, x mat.Matrix, missing MissingPolicy)
+		naive func(x, y []float64) float64
+	}{
+		{
+			name: "Spearman",
+			fn:   SpearmanMatrix,
+			naive: func(x, y []float64) float64 {
+				return Correlation(naiveRank(x), naiveRank(y), nil)
+			},
+		},
+		{name: "Kendall", fn: KendallMatrix, naive: naiveKendallTauB},
+		{name: "DistanceCorrelation", fn: DistanceCorrelationMatrix, naive: naiveDistanceCorrelation},
+	} {
+		for _, n := range []int{2, 7, 40} {
+			for _, p := range []int{1, 5} {
+				for _, policy := range []MissingPolicy{MissingPropagate, MissingPairwise, MissingComplete} {
+					name := fmt.Sprintf("%s,n=%d,p=%d,policy=%d", test.name, n, p, policy)
+					x := dependenceData(rnd, n, p, 0.05)
+
+					// Compute the expected values from the
+					// complete rows of each pair.
+					complete := make([]bool, n)
+					for i := range complete {
+						complete[i] = !floats.HasNaN(mat.Row(nil, i, x))
+					}
+					want := mat.NewSymDense(p, nil)
+					for i := 0; i < p; i++ {
+						for j := i; j < p; j++ {
+							var xc, yc []float64
+							var missing bool
+							for k := 0; k < n; k++ {
+								xi, yi := x.At(k, i), x.At(k, j)
+								if math.IsNaN(xi) || math.IsNaN(yi) {
+									missing = true
+									continue
+								}
+								if policy == MissingComplete && !complete[k] {
+									continue
+								}
+								xc = append(xc, xi)
+								yc = append(yc, yi)
+							}
+							v := test.naive(xc, yc)
+							if policy == MissingPropagate && missing {
+								v = math.NaN()
+							}
+							want.SetSym(i, j, v)
+						}
+					}
+
+					var got mat.SymDense
+					test.fn(&got, x, policy)
+					if got.SymmetricDim() != p {
+						t.Fatalf("%s: unexpected dimension: got:%d want:%d", name, got.SymmetricDim(), p)
+					}
+					for i := 0; i < p; i++ {
+						for j := 0; j < p; j++ {
+							g, w := got.At(i, j), want.At(i, j)
+							if math.IsNaN(g) != math.IsNaN(w) || (!math.IsNaN(w) && !scalar.EqualWithinAbsOrRel(g, w, 1e-12, 1e-12)) {
+								t.Errorf("%s: unexpected element [%d,%d]: got:%v want:%v", name, i, j, g, w)
+							}
+						}
+					}
+
+					// A non-empty destination of the right size is reused.
+					dst := mat.NewSymDense(p, nil)
+					test.fn(dst, x, policy)
+					if !floats.Same(dst.RawSymmetric().Data, got.RawSymmetric().Data) {
+						t.Errorf("%s: result depends on destination", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDependenceMatrixProperties(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 50
+	x := mat.NewDense(n, 4, nil)
+	for i := 0; i < n; i++ {
+		v := rnd.NormFloat64()
+		x.Set(i, 0, v)
+		x.Set(i, 1, math.Exp(v))       // Monotone in column 0.
+		x.Set(i, 2, -v*v*v)            // Decreasing in column 0.
+		x.Set(i, 3, rnd.NormFloat64()) // Independent.
+	}
+	var s, k, d mat.SymDense
+	SpearmanMatrix(&s, x, MissingPropagate)
+	KendallMatrix(&k, x, MissingPropagate)
+	DistanceCorrelationMatrix(&d, x, MissingPropagate)
+	for _, m := range []struct {
+		name string
+		m    *mat.SymDense
+	}{{"Spearman", &s}, {"Kendall", &k}} {
+		for i, want := range []float64{1, 1, 1, 1} {
+			if got := m.m.At(i, i); math.Abs(got-want) > 1e-14 {
+				t.Errorf("%s: unexpected diagonal element %d: got:%v want:%v", m.name, i, got, want)
+			}
+		}
+		if got := m.m.At(0, 1); math.Abs(got-1) > 1e-14 {
+			t.Errorf("%s: unexpected correlation of monotone columns: got:%v want:1", m.name, got)
+		}
+		if got := m.m.At(0, 2); math.Abs(got+1) > 1e-14 {
+			t.Errorf("%s: unexpected correlation of decreasing columns: got:%v want:-1", m.name, got)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if v := d.At(i, j); v < 0 || v > 1+1e-14 {
+				t.Errorf("distance correlation [%d,%d] out of range: %v", i, j, v)
+			}
+		}
+	}
+	if v := d.At(0, 3); v > 0.3 {
+		t.Errorf("unexpectedly large distance correlation of independent columns: %v", v)
+	}
+
+	// Constant columns give NaN.
+	c := mat.NewDense(5, 2, []float64{1, 2, 3, 2, 4, 2, 5, 2, 6, 2})
+	for _, fn := range []func(*mat.SymDense, mat.Matrix, MissingPolicy){SpearmanMatrix, KendallMatrix, DistanceCorrelationMatrix} {
+		var dst mat.SymDense
+		fn(&dst, c, MissingPropagate)
+		if !math.IsNaN(dst.At(0, 1)) || !math.IsNaN(dst.At(1, 1)) || dst.At(0, 0) != 1 {
+			t.Errorf("unexpected result for constant column: %v", mat.Formatted(&dst))
+		}
+	}
+}
+
+func TestPseudoObservations(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	x := mat.NewDense(5, 2, []float64{
+		3, 10,
+		1, nan,
+		4, 30,
+		1, 20,
+		5, 40,
+	})
+	var u mat.Dense
+	PseudoObservations(&u, x)
+	want := []float64{
+		3.0 / 6, 1.0 / 5,
+		1.5 / 6, nan,
+		4.0 / 6, 3.0 / 5,
+		1.5 / 6, 2.0 / 5,
+		5.0 / 6, 4.0 / 5,
+	}
+	for i, w := range want {
+		g := u.RawMatrix().Data[i]
+		if math.IsNaN(g) != math.IsNaN(w) || (!math.IsNaN(w) && math.Abs(g-w) > 1e-15) {
+			t.Errorf("unexpected pseudo-observations: got:%v want:%v", u.RawMatrix().Data, want)
+			break
+		}
+	}
+
+	// The rows with missing values are ignored.
+	for _, test := range []struct {
+		p    []float64
+		want float64
+	}{
+		{p: []float64{0, 0}, want: 0},
+		{p: []float64{1, 1}, want: 1},
+		{p: []float64{0.5, 0.4}, want: 2.0 / 4},
+		{p: []float64{0.5, 1}, want: 2.0 / 4},
+		{p: []float64{0.7, 0.3}, want: 1.0 / 4},
+	} {
+		if got := EmpiricalCopula(test.p, &u); got != test.want {
+			t.Errorf("unexpected empirical copula at %v: got:%v want:%v", test.p, got, test.want)
+		}
+	}
+
+	// The empirical copula of independent uniform margins
+	// approaches the independence copula.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 2000
+	y := mat.NewDense(n, 2, nil)
+	for i := 0; i < n; i++ {
+		y.Set(i, 0, rnd.ExpFloat64())
+		y.Set(i, 1, rnd.NormFloat64())
+	}
+	u.Reset()
+	PseudoObservations(&u, y)
+	for _, p := range [][]float64{{0.2, 0.5}, {0.5, 0.5}, {0.9, 0.3}} {
+		if got, want := EmpiricalCopula(p, &u), p[0]*p[1]; math.Abs(got-want) > 0.03 {
+			t.Errorf("unexpected empirical copula at %v: got:%v want:%v", p, got, want)
+		}
+	}
+}
+
+func TestDependencePanics(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(3, 2, []float64{1, 2, 3, 4, 5, 7})
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "Spearman shape", fn: func() { SpearmanMatrix(mat.NewSymDense(3, nil), x, MissingPropagate) }},
+		{name: "Kendall policy", fn: func() { KendallMatrix(&mat.SymDense{}, x, MissingPolicy(5)) }},
+		{name: "pseudo-observations shape", fn: func() { PseudoObservations(mat.NewDense(2, 2, nil), x) }},
+		{name: "copula length", fn: func() { EmpiricalCopula([]float64{0.5}, x) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
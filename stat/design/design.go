@@ -0,0 +1,474 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package design
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+var (
+	errNoObservations = errors.New("design: no observations")
+	errLength         = errors.New("design: column length mismatch")
+)
+
+// Table is a table of observations of named continuous and categorical
+// variables. All the columns of a table must have the same length, the
+// number of observations.
+type Table struct {
+	Continuous  map[string][]float64
+	Categorical map[string][]string
+}
+
+// rows returns the number of observations in the table.
+func (t Table) rows() (int, error) {
+	n := -1
+	for _, c := range t.Continuous {
+		if n >= 0 && len(c) != n {
+			return 0, errLength
+		}
+		n = len(c)
+	}
+	for _, c := range t.Categorical {
+		if n >= 0 && len(c) != n {
+			return 0, errLength
+		}
+		n = len(c)
+	}
+	if n <= 0 {
+		return 0, errNoObservations
+	}
+	return n, nil
+}
+
+func (t Table) continuous(name string) ([]float64, error) {
+	x, ok := t.Continuous[name]
+	if !ok {
+		return nil, fmt.Errorf("design: no continuous variable %q", name)
+	}
+	return x, nil
+}
+
+func (t Table) categorical(name string) ([]string, error) {
+	x, ok := t.Categorical[name]
+	if !ok {
+		return nil, fmt.Errorf("design: no categorical variable %q", name)
+	}
+	return x, nil
+}
+
+// Term is a term of a design, contributing one or more columns to a design
+// matrix. Terms are created by Intercept, Numeric, Poly, Dummy, OneHot,
+// Interaction and Standardize.
+type Term interface {
+	// fit returns the encoder of the term fitted to
+	// the n observations of t.
+	fit(t Table, n int) (encoder, error)
+}
+
+// encoder computes the columns of a fitted term.
+type encoder interface {
+	// names returns the names of the columns.
+	names() []string
+
+	// encode returns the columns for the n
+	// observations of t.
+	encode(t Table, n int) ([][]float64, error)
+}
+
+// Intercept returns a term with a single column of ones, named
+// "(Intercept)".
+func Intercept() Term {
+	return intercept{}
+}
+
+type intercept struct{}
+
+func (intercept) fit(Table, int) (encoder, error) { return intercept{}, nil }
+
+func (intercept) names() []string { return []string{"(Intercept)"} }
+
+func (intercept) encode(_ Table, n int) ([][]float64, error) {
+	col := make([]float64, n)
+	for i := range col {
+		col[i] = 1
+	}
+	return [][]float64{col}, nil
+}
+
+// Numeric returns a term with a single column holding the values of the
+// named continuous variable. The column has the name of the variable.
+func Numeric(name string) Term {
+	return poly{name: name, degree: 1}
+}
+
+// Poly returns a term with columns holding the powers 1 to degree of the
+// named continuous variable x, named x, x^2, x^3 and so on. Poly panics if
+// degree is less than one.
+func Poly(name string, degree int) Term {
+	if degree < 1 {
+		panic("design: polynomial degree less than one")
+	}
+	return poly{name: name, degree: degree}
+}
+
+type poly struct {
+	name   string
+	degree int
+}
+
+func (p poly) fit(t Table, _ int) (encoder, error) {
+	_, err := t.continuous(p.name)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p poly) names() []string {
+	names := []string{p.name}
+	for k := 2; k <= p.degree; k++ {
+		names = append(names, p.name+"^"+strconv.Itoa(k))
+	}
+	return names
+}
+
+func (p poly) encode(t Table, n int) ([][]float64, error) {
+	x, err := t.continuous(p.name)
+	if err != nil {
+		return nil, err
+	}
+	cols := make([][]float64, p.degree)
+	cols[0] = append([]float64(nil), x...)
+	for k := 1; k < p.degree; k++ {
+		cols[k] = make([]float64, n)
+		for i, v := range x {
+			cols[k][i] = cols[k-1][i] * v
+		}
+	}
+	return cols, nil
+}
+
+// Dummy returns a term coding the named categorical variable by indicator
+// columns for each of its levels except the reference level, so an
+// observation at the reference level has zeros in all the columns. The
+// levels are those observed when the design is fitted, in sorted order. If
+// reference is empty, the first level is the reference. The column for
+// level l of variable x is named x[l].
+//
+// Dummy coding is appropriate for designs with an intercept, where the
+// indicators of all the levels would be collinear with it.
+func Dummy(name, reference string) Term {
+	return categorical{name: name, reference: reference}
+}
+
+// OneHot returns a term coding the named categorical variable by indicator
+// columns for each of its levels. The levels are those observed when the
+// design is fitted, in sorted order. The column for level l of variable x is
+// named x[l].
+func OneHot(name string) Term {
+	return categorical{name: name, full: true}
+}
+
+type categorical struct {
+	name      string
+	reference string
+	full      bool
+}
+
+func (c categorical) fit(t Table, _ int) (encoder, error) {
+	x, err := t.categorical(c.name)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var levels []string
+	for _, v := range x {
+		if !seen[v] {
+			seen[v] = true
+			levels = append(levels, v)
+		}
+	}
+	sort.Strings(levels)
+
+	e := &levelEncoder{name: c.name, index: make(map[string]int)}
+	if !c.full {
+		ref := c.reference
+		if ref == "" {
+			ref = levels[0]
+		}
+		if !seen[ref] {
+			return nil, fmt.Errorf("design: reference level %q of %q not observed", ref, c.name)
+		}
+		e.index[ref] = -1
+	}
+	for _, l := range levels {
+		if _, ok := e.index[l]; ok {
+			continue
+		}
+		e.index[l] = len(e.levels)
+		e.levels = append(e.levels, l)
+	}
+	return e, nil
+}
+
+type levelEncoder struct {
+	name string
+	// levels holds the levels with columns, and
+	// index maps levels to their column, or -1
+	// for the reference level.
+	levels []string
+	index  map[string]int
+}
+
+func (e *levelEncoder) names() []string {
+	names := make([]string, len(e.levels))
+	for i, l := range e.levels {
+		names[i] = e.name + "[" + l + "]"
+	}
+	return names
+}
+
+func (e *levelEncoder) encode(t Table, n int) ([][]float64, error) {
+	x, err := t.categorical(e.name)
+	if err != nil {
+		return nil, err
+	}
+	cols := make([][]float64, len(e.levels))
+	for j := range cols {
+		cols[j] = make([]float64, n)
+	}
+	for i, v := range x {
+		j, ok := e.index[v]
+		if !ok {
+			return nil, fmt.Errorf("design: unknown level %q of %q", v, e.name)
+		}
+		if j >= 0 {
+			cols[j][i] = 1
+		}
+	}
+	return cols, nil
+}
+
+// Interaction returns a term with the products of the columns of the given
+// terms, one column for each combination of a column from each term, with
+// the columns of the first term varying slowest. The column names are the
+// names of the combined columns joined by colons. Interaction panics if
+// fewer than two terms are given.
+func Interaction(terms ...Term) Term {
+	if len(terms) < 2 {
+		panic("design: interaction of fewer than two terms")
+	}
+	return append(interaction(nil), terms...)
+}
+
+type interaction []Term
+
+func (in interaction) fit(t Table, n int) (encoder, error) {
+	e := make(interactionEncoder, len(in))
+	for i, term := range in {
+		var err error
+		e[i], err = term.fit(t, n)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+type interactionEncoder []encoder
+
+func (e interactionEncoder) names() []string {
+	names := e[0].names()
+	for _, enc := range e[1:] {
+		var next []string
+		for _, a := range names {
+			for _, b := range enc.names() {
+				next = append(next, a+":"+b)
+			}
+		}
+		names = next
+	}
+	return names
+}
+
+func (e interactionEncoder) encode(t Table, n int) ([][]float64, error) {
+	cols, err := e[0].encode(t, n)
+	if err != nil {
+		return nil, err
+	}
+	for _, enc := range e[1:] {
+		other, err := enc.encode(t, n)
+		if err != nil {
+			return nil, err
+		}
+		next := make([][]float64, 0, len(cols)*len(other))
+		for _, a := range cols {
+			for _, b := range other {
+				col := make([]float64, n)
+				for i := range col {
+					col[i] = a[i] * b[i]
+				}
+				next = append(next, col)
+			}
+		}
+		cols = next
+	}
+	return cols, nil
+}
+
+// Standardize returns a term with the columns of term centered and scaled
+// by the mean and standard deviation of the columns in the data to which the
+// design is fitted. The same mean and standard deviation are used when the
+// design is applied to other data. Columns with zero standard deviation are
+// centered but not scaled. The column names are those of term.
+func Standardize(term Term) Term {
+	return standardize{term: term}
+}
+
+type standardize struct {
+	term Term
+}
+
+func (s standardize) fit(t Table, n int) (encoder, error) {
+	enc, err := s.term.fit(t, n)
+	if err != nil {
+		return nil, err
+	}
+	cols, err := enc.encode(t, n)
+	if err != nil {
+		return nil, err
+	}
+	e := &standardized{
+		encoder: enc,
+		center:  make([]float64, len(cols)),
+		scale:   make([]float64, len(cols)),
+	}
+	for j, col := range cols {
+		mean, std := stat.MeanStdDev(col, nil)
+		if !(std > 0) {
+			std = 1
+		}
+		e.center[j] = mean
+		e.scale[j] = std
+	}
+	return e, nil
+}
+
+type standardized struct {
+	encoder
+	center, scale []float64
+}
+
+func (s *standardized) encode(t Table, n int) ([][]float64, error) {
+	cols, err := s.encoder.encode(t, n)
+	if err != nil {
+		return nil, err
+	}
+	for j, col := range cols {
+		for i, v := range col {
+			col[i] = (v - s.center[j]) / s.scale[j]
+		}
+	}
+	return cols, nil
+}
+
+// Design is a design fitted to a table of training data. It constructs
+// design matrices with the columns of its terms from tables with the same
+// variables.
+type Design struct {
+	encoders []encoder
+	names    []string
+}
+
+// Fit returns the design with the given terms fitted to the table t,
+// fixing the levels of categorical variables and the parameters of
+// standardized terms. Fit returns an error if the columns of t have
+// different lengths or no observations, if a variable of a term is not in
+// t, or if the reference level of a Dummy term is not observed.
+func Fit(t Table, terms ...Term) (*Design, error) {
+	n, err := t.rows()
+	if err != nil {
+		return nil, err
+	}
+	d := &Design{encoders: make([]encoder, len(terms))}
+	for i, term := range terms {
+		d.encoders[i], err = term.fit(t, n)
+		if err != nil {
+			return nil, err
+		}
+		d.names = append(d.names, d.encoders[i].names()...)
+	}
+	if len(d.names) == 0 {
+		return nil, errors.New("design: no columns")
+	}
+	return d, nil
+}
+
+// Names returns the names of the columns of the design matrix.
+func (d *Design) Names() []string {
+	return append([]string(nil), d.names...)
+}
+
+// Standardization returns the centers and scales of the columns of the design
+// matrix. Columns of terms created by Standardize are computed from the values
+// x of the unstandardized term as (x - center) / scale. The centers and scales
+// of other columns are zero and one.
+func (d *Design) Standardization() (center, scale []float64) {
+	center = make([]float64, 0, len(d.names))
+	scale = make([]float64, 0, len(d.names))
+	for _, enc := range d.encoders {
+		if s, ok := enc.(*standardized); ok {
+			center = append(center, s.center...)
+			scale = append(scale, s.scale...)
+			continue
+		}
+		for range enc.names() {
+			center = append(center, 0)
+			scale = append(scale, 1)
+		}
+	}
+	return center, scale
+}
+
+// MatrixTo stores the design matrix for the observations in t into dst,
+// with one row for each observation and the columns named by Names.
+//
+// If dst is empty, MatrixTo will resize dst to be n×p where n is the number
+// of observations and p is the number of columns. When dst is non-empty,
+// MatrixTo will panic if dst is not n×p. MatrixTo returns an error if the
+// columns of t have different lengths or no observations, if a variable of
+// the design is not in t, or if t contains a level of a categorical variable
+// that was not observed when the design was fitted, in which case the
+// contents of dst are unspecified.
+func (d *Design) MatrixTo(dst *mat.Dense, t Table) error {
+	n, err := t.rows()
+	if err != nil {
+		return err
+	}
+	p := len(d.names)
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, p)
+	} else if r, c := dst.Dims(); r != n || c != p {
+		panic(mat.ErrShape)
+	}
+	var j int
+	for _, enc := range d.encoders {
+		cols, err := enc.encode(t, n)
+		if err != nil {
+			return err
+		}
+		for _, col := range cols {
+			dst.SetCol(j, col)
+			j++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package design_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/design"
+)
+
+func ExampleFit() {
+	train := design.Table{
+		Continuous: map[string][]float64{
+			"age": {23, 35, 47, 59},
+		},
+		Categorical: map[string][]string{
+			"group": {"control", "treated", "treated", "control"},
+		},
+	}
+
+	// Build a design with an intercept, the standardized age,
+	// a dummy coding of the group and their interaction.
+	d, err := design.Fit(train,
+		design.Intercept(),
+		design.Standardize(design.Numeric("age")),
+		design.Dummy("group", "control"),
+		design.Interaction(design.Dummy("group", "control"), design.Standardize(design.Numeric("age"))),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(d.Names())
+
+	// Apply the design fitted to the training
+	// data to new observations.
+	test := design.Table{
+		Continuous: map[string][]float64{
+			"age": {41},
+		},
+		Categorical: map[string][]string{
+			"group": {"treated"},
+		},
+	}
+	var x mat.Dense
+	err = d.MatrixTo(&x, test)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%.4v\n", mat.Formatted(&x))
+
+	// Output:
+	// [(Intercept) age group[treated] group[treated]:age]
+	// [1  0  1  0]
+}
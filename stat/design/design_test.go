@@ -0,0 +1,264 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package design
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+var train = Table{
+	Continuous: map[string][]float64{
+		"x": {1, 2, 3, 4},
+		"y": {0.5, -1, 2, 0},
+	},
+	Categorical: map[string][]string{
+		"c": {"b", "a", "c", "a"},
+	},
+}
+
+func TestDesign(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		terms []Term
+		data  Table
+
+		wantNames []string
+		want      *mat.Dense
+	}{
+		{
+			name:      "intercept and numeric",
+			terms:     []Term{Intercept(), Numeric("x"), Numeric("y")},
+			data:      train,
+			wantNames: []string{"(Intercept)", "x", "y"},
+			want: mat.NewDense(4, 3, []float64{
+				1, 1, 0.5,
+				1, 2, -1,
+				1, 3, 2,
+				1, 4, 0,
+			}),
+		},
+		{
+			name:      "poly",
+			terms:     []Term{Poly("x", 3)},
+			data:      train,
+			wantNames: []string{"x", "x^2", "x^3"},
+			want: mat.NewDense(4, 3, []float64{
+				1, 1, 1,
+				2, 4, 8,
+				3, 9, 27,
+				4, 16, 64,
+			}),
+		},
+		{
+			name:      "dummy default reference",
+			terms:     []Term{Intercept(), Dummy("c", "")},
+			data:      train,
+			wantNames: []string{"(Intercept)", "c[b]", "c[c]"},
+			want: mat.NewDense(4, 3, []float64{
+				1, 1, 0,
+				1, 0, 0,
+				1, 0, 1,
+				1, 0, 0,
+			}),
+		},
+		{
+			name:      "dummy reference",
+			terms:     []Term{Dummy("c", "b")},
+			data:      train,
+			wantNames: []string{"c[a]", "c[c]"},
+			want: mat.NewDense(4, 2, []float64{
+				0, 0,
+				1, 0,
+				0, 1,
+				1, 0,
+			}),
+		},
+		{
+			name:      "one-hot",
+			terms:     []Term{OneHot("c")},
+			data:      train,
+			wantNames: []string{"c[a]", "c[b]", "c[c]"},
+			want: mat.NewDense(4, 3, []float64{
+				0, 1, 0,
+				1, 0, 0,
+				0, 0, 1,
+				1, 0, 0,
+			}),
+		},
+		{
+			name:      "interaction",
+			terms:     []Term{Interaction(Dummy("c", ""), Numeric("x"))},
+			data:      train,
+			wantNames: []string{"c[b]:x", "c[c]:x"},
+			want: mat.NewDense(4, 2, []float64{
+				1, 0,
+				0, 0,
+				0, 3,
+				0, 0,
+			}),
+		},
+		{
+			name:      "three-way interaction",
+			terms:     []Term{Interaction(Numeric("x"), Poly("y", 2), Numeric("x"))},
+			data:      train,
+			wantNames: []string{"x:y:x", "x:y^2:x"},
+			want: mat.NewDense(4, 2, []float64{
+				0.5, 0.25,
+				-4, 4,
+				18, 36,
+				0, 0,
+			}),
+		},
+		{
+			name:  "standardize",
+			terms: []Term{Standardize(Numeric("x"))},
+			data: Table{
+				Continuous: map[string][]float64{
+					"x": {0, 5, 10},
+				},
+			},
+			wantNames: []string{"x"},
+			want: mat.NewDense(3, 1, []float64{
+				-1,
+				0,
+				1,
+			}),
+		},
+		{
+			name:      "standardize constant",
+			terms:     []Term{Standardize(Intercept())},
+			data:      train,
+			wantNames: []string{"(Intercept)"},
+			want:      mat.NewDense(4, 1, nil),
+		},
+	} {
+		d, err := Fit(test.data, test.terms...)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		if got := d.Names(); !reflect.DeepEqual(got, test.wantNames) {
+			t.Errorf("unexpected names for %s: got:%q want:%q", test.name, got, test.wantNames)
+		}
+		var got mat.Dense
+		err = d.MatrixTo(&got, test.data)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		if !mat.EqualApprox(&got, test.want, 1e-14) {
+			t.Errorf("unexpected design matrix for %s:\ngot:\n%v\nwant:\n%v",
+				test.name, mat.Formatted(&got), mat.Formatted(test.want))
+		}
+	}
+}
+
+func TestDesignApply(t *testing.T) {
+	t.Parallel()
+	d, err := Fit(train, Intercept(), Standardize(Numeric("x")), Dummy("c", "c"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mean := 2.5
+	std := math.Sqrt(5.0 / 3)
+	center, scale := d.Standardization()
+	wantCenter := []float64{0, mean, 0, 0}
+	wantScale := []float64{1, std, 1, 1}
+	for i := range center {
+		if !scalar.EqualWithinAbsOrRel(center[i], wantCenter[i], 1e-14, 1e-14) ||
+			!scalar.EqualWithinAbsOrRel(scale[i], wantScale[i], 1e-14, 1e-14) {
+			t.Errorf("unexpected standardization: got:%v %v want:%v %v", center, scale, wantCenter, wantScale)
+			break
+		}
+	}
+
+	// Test data are coded with the levels and
+	// standardization of the training data.
+	test := Table{
+		Continuous:  map[string][]float64{"x": {10, 2.5}},
+		Categorical: map[string][]string{"c": {"c", "b"}},
+	}
+	var got mat.Dense
+	err = d.MatrixTo(&got, test)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := mat.NewDense(2, 4, []float64{
+		1, (10 - mean) / std, 0, 0,
+		1, 0, 0, 1,
+	})
+	if !mat.EqualApprox(&got, want, 1e-14) {
+		t.Errorf("unexpected design matrix for test data:\ngot:\n%v\nwant:\n%v",
+			mat.Formatted(&got), mat.Formatted(want))
+	}
+
+	test.Categorical["c"][1] = "d"
+	got.Reset()
+	if err := d.MatrixTo(&got, test); err == nil {
+		t.Error("expected error for unknown level")
+	}
+}
+
+func TestDesignErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		data  Table
+		terms []Term
+	}{
+		{name: "empty table", data: Table{}, terms: []Term{Intercept()}},
+		{
+			name: "length mismatch",
+			data: Table{
+				Continuous:  map[string][]float64{"x": {1, 2}},
+				Categorical: map[string][]string{"c": {"a"}},
+			},
+			terms: []Term{Numeric("x")},
+		},
+		{name: "no columns", data: train},
+		{name: "missing continuous", data: train, terms: []Term{Numeric("c")}},
+		{name: "missing categorical", data: train, terms: []Term{OneHot("x")}},
+		{name: "missing reference", data: train, terms: []Term{Dummy("c", "d")}},
+		{name: "missing in interaction", data: train, terms: []Term{Interaction(Numeric("x"), Numeric("z"))}},
+		{name: "missing in standardize", data: train, terms: []Term{Standardize(Numeric("z"))}},
+	} {
+		_, err := Fit(test.data, test.terms...)
+		if err == nil {
+			t.Errorf("expected error for %s", test.name)
+		}
+	}
+
+	d, err := Fit(train, Numeric("x"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = d.MatrixTo(&mat.Dense{}, Table{Continuous: map[string][]float64{"y": {1}}})
+	if err == nil {
+		t.Error("expected error for missing variable")
+	}
+	if !panics(func() { _ = d.MatrixTo(mat.NewDense(3, 1, nil), train) }) {
+		t.Error("expected panic for bad destination shape")
+	}
+	if !panics(func() { Poly("x", 0) }) {
+		t.Error("expected panic for zero degree")
+	}
+	if !panics(func() { Interaction(Numeric("x")) }) {
+		t.Error("expected panic for single term interaction")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
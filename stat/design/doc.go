@@ -0,0 +1,18 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package design provides construction of design matrices for regression
+// from tables of continuous and categorical variables.
+//
+// A design is described by a list of terms, each of which contributes one
+// or more columns to the design matrix: an intercept, continuous variables
+// and their powers, dummy or one-hot codings of categorical variables, and
+// interactions between other terms. Terms may be standardized to zero mean
+// and unit variance.
+//
+// Fitting a design to a table of training data fixes the levels of its
+// categorical variables and the parameters of its standardizations, so the
+// same design can then be applied to test data to obtain matrices with
+// identically defined columns.
+package design // import "gonum.org/v1/gonum/stat/design"
@@ -0,0 +1,378 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmat
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// LKJMethod is the method used to generate matrices from an LKJ distribution.
+type LKJMethod int
+
+const (
+	// Onion generates a matrix by extending a correlation
+	// matrix one row and column at a time.
+	Onion LKJMethod = iota
+	// Vine generates a matrix from partial correlations on
+	// a C-vine.
+	Vine
+)
+
+// LKJ is the distribution of Lewandowski, Kurowicka and Joe over d×d
+// correlation matrices. It is parametrized by a shape parameter η > 0, and
+// its density is proportional to det(C)^(η-1). With η equal to one the
+// distribution is uniform over correlation matrices, with larger η it is
+// concentrated about the identity and with smaller η it favors strong
+// correlations. The marginal distribution of each off-diagonal element is a
+// Beta(η-1+d/2, η-1+d/2) distribution scaled to [-1, 1].
+//
+// See D. Lewandowski, D. Kurowicka and H. Joe, "Generating random correlation
+// matrices based on vines and extended onion method", Journal of Multivariate
+// Analysis 100(9), 2009 for details.
+type LKJ struct {
+	dim    int
+	eta    float64
+	method LKJMethod
+	src    rand.Source
+
+	logNorm float64
+}
+
+// NewLKJ returns a new LKJ distribution over dim×dim correlation matrices with
+// shape parameter eta, generating matrices by the given method.
+//
+// NewLKJ panics if dim is less than one or eta is not positive.
+func NewLKJ(dim int, eta float64, method LKJMethod, src rand.Source) *LKJ {
+	if dim < 1 {
+		panic(zeroDim)
+	}
+	if !(eta > 0) {
+		panic("lkj: eta must be positive")
+	}
+	switch method {
+	default:
+		panic("lkj: unknown method")
+	case Onion, Vine:
+	}
+
+	// The normalizing constant is
+	//  c_d = ∏_{k=1}^{d-1} [2^(2η-2+d-k) B(η+(d-k-1)/2, η+(d-k-1)/2)]^(d-k).
+	var logNorm float64
+	for k := 1; k < dim; k++ {
+		b := eta + float64(dim-k-1)/2
+		lb := 2*lgamma(b) - lgamma(2*b)
+		logNorm += float64(dim-k) * ((2*eta-2+float64(dim-k))*math.Ln2 + lb)
+	}
+	return &LKJ{dim: dim, eta: eta, method: method, src: src, logNorm: logNorm}
+}
+
+// LogProbSym returns the log of the probability density of the correlation
+// matrix x. The diagonal of x is assumed to be one.
+//
+// LogProbSym returns -∞ if x is not positive definite. LogProbSym panics if
+// the order of x is not the dimension of the receiver.
+func (l *LKJ) LogProbSym(x mat.Symmetric) float64 {
+	if x.SymmetricDim() != l.dim {
+		panic(badDim)
+	}
+	var chol mat.Cholesky
+	if !chol.Factorize(x) {
+		return math.Inf(-1)
+	}
+	return (l.eta-1)*chol.LogDet() - l.logNorm
+}
+
+// ProbSym returns the probability density of the correlation matrix x.
+// The diagonal of x is assumed to be one.
+func (l *LKJ) ProbSym(x mat.Symmetric) float64 {
+	return math.Exp(l.LogProbSym(x))
+}
+
+// RandSymTo generates a random correlation matrix from the distribution.
+// If dst is empty, it is resized to be a d×d symmetric matrix where d is the
+// order of the receiver. When dst is non-empty, RandSymTo panics if dst is
+// not d×d.
+func (l *LKJ) RandSymTo(dst *mat.SymDense) {
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(l.dim)
+	} else if dst.SymmetricDim() != l.dim {
+		panic(badDim)
+	}
+	switch l.method {
+	case Onion:
+		l.onion(dst)
+	case Vine:
+		l.vine(dst)
+	}
+}
+
+// onion generates a correlation matrix by the extended onion method.
+func (l *LKJ) onion(dst *mat.SymDense) {
+	d := l.dim
+	dst.SetSym(0, 0, 1)
+	if d == 1 {
+		return
+	}
+	beta := l.eta + float64(d-2)/2
+	dst.SetSym(0, 1, 2*distuv.Beta{Alpha: beta, Beta: beta, Src: l.src}.Rand()-1)
+	dst.SetSym(1, 1, 1)
+
+	unit := NewUnitVector(l.src)
+	var chol mat.Cholesky
+	var lower mat.TriDense
+	for k := 2; k < d; k++ {
+		beta -= 0.5
+		// Draw the new column z = L w, where L is the Cholesky
+		// factor of the leading k×k block and w is uniform in
+		// direction with squared length distributed as
+		// Beta(k/2, β).
+		y := distuv.Beta{Alpha: float64(k) / 2, Beta: beta, Src: l.src}.Rand()
+		w := mat.NewVecDense(k, nil)
+		unit.UnitVecTo(w)
+		w.ScaleVec(math.Sqrt(y), w)
+		if !chol.Factorize(dst.SliceSym(0, k)) {
+			panic("lkj: non-positive definite correlation matrix")
+		}
+		lower.Reset()
+		chol.LTo(&lower)
+		var z mat.VecDense
+		z.MulVec(&lower, w)
+		for i := 0; i < k; i++ {
+			dst.SetSym(i, k, z.AtVec(i))
+		}
+		dst.SetSym(k, k, 1)
+	}
+}
+
+// vine generates a correlation matrix from partial correlations on a C-vine.
+func (l *LKJ) vine(dst *mat.SymDense) {
+	d := l.dim
+	// p holds the partial correlation of k and i given the
+	// variables before k in row k.
+	p := mat.NewDense(d, d, nil)
+	beta := l.eta + float64(d-1)/2
+	for k := 0; k < d; k++ {
+		dst.SetSym(k, k, 1)
+	}
+	for k := 0; k < d-1; k++ {
+		beta -= 0.5
+		b := distuv.Beta{Alpha: beta, Beta: beta, Src: l.src}
+		for i := k + 1; i < d; i++ {
+			r := 2*b.Rand() - 1
+			p.Set(k, i, r)
+			// Convert the partial correlation to a correlation
+			// by recursively conditioning on fewer variables.
+			for m := k - 1; m >= 0; m-- {
+				r = r*math.Sqrt((1-p.At(m, i)*p.At(m, i))*(1-p.At(m, k)*p.At(m, k))) + p.At(m, i)*p.At(m, k)
+			}
+			dst.SetSym(k, i, r)
+		}
+	}
+}
+
+// SpectralCorr is a distribution over correlation matrices with a given
+// spectrum of eigenvalues. A matrix is generated by rotating the diagonal
+// matrix of eigenvalues by a random orthogonal matrix, uniformly distributed
+// according to the Haar measure, and then applying a sequence of Givens
+// rotations that make the diagonal one while preserving the eigenvalues.
+//
+// See P. I. Davies and N. J. Higham, "Numerically stable generation of
+// correlation matrices and their factors", BIT Numerical Mathematics 40(4),
+// 2000 for details.
+type SpectralCorr struct {
+	eig []float64
+	src rand.Source
+}
+
+// NewSpectralCorr returns a new distribution over correlation matrices with
+// eigenvalues proportional to eig. The eigenvalues are scaled so their sum is
+// their number, the trace of a correlation matrix.
+//
+// NewSpectralCorr panics if eig is empty, has a negative element or sums to
+// zero.
+func NewSpectralCorr(eig []float64, src rand.Source) *SpectralCorr {
+	if len(eig) == 0 {
+		panic(zeroDim)
+	}
+	for _, v := range eig {
+		if v < 0 {
+			panic("spectralcorr: negative eigenvalue")
+		}
+	}
+	sum := floats.Sum(eig)
+	if !(sum > 0) {
+		panic("spectralcorr: zero eigenvalues")
+	}
+	e := make([]float64, len(eig))
+	floats.ScaleTo(e, float64(len(eig))/sum, eig)
+	return &SpectralCorr{eig: e, src: src}
+}
+
+// RandSymTo generates a random correlation matrix from the distribution.
+// If dst is empty, it is resized to be a d×d symmetric matrix where d is the
+// number of eigenvalues of the receiver. When dst is non-empty, RandSymTo
+// panics if dst is not d×d.
+func (s *SpectralCorr) RandSymTo(dst *mat.SymDense) {
+	d := len(s.eig)
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(d)
+	} else if dst.SymmetricDim() != d {
+		panic(badDim)
+	}
+
+	a := mat.NewDense(d, d, nil)
+	rotateSpectrum(a, s.eig, s.src)
+	for i := 0; i < d-1; i++ {
+		aii := a.At(i, i)
+		if aii == 1 {
+			continue
+		}
+		// Find a later diagonal element on the other side
+		// of one, which exists since the trace is d.
+		j := -1
+		for k := i + 1; k < d; k++ {
+			if (aii-1)*(a.At(k, k)-1) < 0 {
+				j = k
+				break
+			}
+		}
+		if j < 0 {
+			// The remaining diagonal differs from one only
+			// by rounding.
+			break
+		}
+		// Choose the rotation so the new element i of the
+		// diagonal is one, computing the root of
+		//  (a_ii-1) - 2 t a_ij + t² (a_jj-1) = 0
+		// without cancellation.
+		aij := a.At(i, j)
+		disc := math.Sqrt(aij*aij - (aii-1)*(a.At(j, j)-1))
+		t := (aii - 1) / (aij + math.Copysign(disc, aij))
+		c := 1 / math.Sqrt(1+t*t)
+		sn := c * t
+		rotate(a, i, j, c, sn)
+	}
+	for i := 0; i < d; i++ {
+		dst.SetSym(i, i, 1)
+		for j := i + 1; j < d; j++ {
+			dst.SetSym(i, j, a.At(i, j))
+		}
+	}
+}
+
+// ConditionedSPD is a distribution over symmetric positive definite matrices
+// with a given condition number. A matrix is generated by rotating a diagonal
+// matrix of eigenvalues by a random orthogonal matrix, uniformly distributed
+// according to the Haar measure. The largest eigenvalue is one and the
+// smallest is the inverse of the condition number, with the remaining
+// eigenvalues distributed log-uniformly between them.
+type ConditionedSPD struct {
+	dim  int
+	cond float64
+	src  rand.Source
+}
+
+// NewConditionedSPD returns a new distribution over dim×dim symmetric positive
+// definite matrices with 2-norm condition number cond.
+//
+// NewConditionedSPD panics if dim is less than one or cond is less than one.
+func NewConditionedSPD(dim int, cond float64, src rand.Source) *ConditionedSPD {
+	if dim < 1 {
+		panic(zeroDim)
+	}
+	if !(cond >= 1) {
+		panic("conditionedspd: condition number less than one")
+	}
+	return &ConditionedSPD{dim: dim, cond: cond, src: src}
+}
+
+// RandSymTo generates a random symmetric positive definite matrix from the
+// distribution. If dst is empty, it is resized to be a d×d symmetric matrix
+// where d is the order of the receiver. When dst is non-empty, RandSymTo
+// panics if dst is not d×d.
+func (c *ConditionedSPD) RandSymTo(dst *mat.SymDense) {
+	d := c.dim
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(d)
+	} else if dst.SymmetricDim() != d {
+		panic(badDim)
+	}
+	eig := make([]float64, d)
+	eig[0] = 1
+	if d > 1 {
+		eig[d-1] = 1 / c.cond
+		u := distuv.Uniform{Min: -math.Log(c.cond), Max: 0, Src: c.src}
+		for i := 1; i < d-1; i++ {
+			eig[i] = math.Exp(u.Rand())
+		}
+	}
+	a := mat.NewDense(d, d, nil)
+	rotateSpectrum(a, eig, c.src)
+	for i := 0; i < d; i++ {
+		for j := i; j < d; j++ {
+			dst.SetSym(i, j, a.At(i, j))
+		}
+	}
+}
+
+// rotateSpectrum stores Q diag(eig) Qᵀ into dst, where Q is a random
+// orthogonal matrix distributed according to the Haar measure.
+func rotateSpectrum(dst *mat.Dense, eig []float64, src rand.Source) {
+	d := len(eig)
+	// The Q factor of a Gaussian matrix, with the signs of
+	// its columns fixed by the diagonal of R, is Haar
+	// distributed.
+	norm := distuv.Normal{Mu: 0, Sigma: 1, Src: src}
+	g := mat.NewDense(d, d, nil)
+	for i := 0; i < d; i++ {
+		for j := 0; j < d; j++ {
+			g.Set(i, j, norm.Rand())
+		}
+	}
+	var qr mat.QR
+	qr.Factorize(g)
+	var q, r mat.Dense
+	qr.QTo(&q)
+	qr.RTo(&r)
+	for j := 0; j < d; j++ {
+		if r.At(j, j) < 0 {
+			for i := 0; i < d; i++ {
+				q.Set(i, j, -q.At(i, j))
+			}
+		}
+	}
+	var qd mat.Dense
+	qd.Mul(&q, mat.NewDiagDense(d, eig))
+	dst.Mul(&qd, q.T())
+}
+
+// rotate replaces the symmetric matrix a with Gᵀ a G, where G is the Givens
+// rotation in the (i, j) plane that replaces column i of a with c a_i - s a_j
+// and column j with s a_i + c a_j.
+func rotate(a *mat.Dense, i, j int, c, s float64) {
+	ri := a.RawRowView(i)
+	rj := a.RawRowView(j)
+	for k := range ri {
+		x, y := ri[k], rj[k]
+		ri[k] = c*x - s*y
+		rj[k] = s*x + c*y
+	}
+	n, _ := a.Dims()
+	for k := 0; k < n; k++ {
+		x, y := a.At(k, i), a.At(k, j)
+		a.Set(k, i, c*x-s*y)
+		a.Set(k, j, s*x+c*y)
+	}
+}
+
+func lgamma(x float64) float64 {
+	v, _ := math.Lgamma(x)
+	return v
+}
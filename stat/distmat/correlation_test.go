@@ -0,0 +1,216 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmat
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestLKJ(t *testing.T) {
+	t.Parallel()
+	const n = 4000
+	for _, test := range []struct {
+		dim int
+		eta float64
+	}{
+		{dim: 2, eta: 1},
+		{dim: 3, eta: 0.5},
+		{dim: 4, eta: 1},
+		{dim: 5, eta: 3},
+	} {
+		for _, method := range []LKJMethod{Onion, Vine} {
+			src := rand.NewPCG(1, 1)
+			l := NewLKJ(test.dim, test.eta, method, src)
+			d := test.dim
+			var x mat.SymDense
+			var chol mat.Cholesky
+			var mean, sq float64
+			var count int
+			for s := 0; s < n; s++ {
+				x.Reset()
+				l.RandSymTo(&x)
+				for i := 0; i < d; i++ {
+					if x.At(i, i) != 1 {
+						t.Fatalf("unexpected diagonal for dim=%d eta=%v method=%d: got:%v want:1",
+							d, test.eta, method, x.At(i, i))
+					}
+					for j := i + 1; j < d; j++ {
+						v := x.At(i, j)
+						mean += v
+						sq += v * v
+						count++
+					}
+				}
+				if !chol.Factorize(&x) {
+					t.Fatalf("matrix not positive definite for dim=%d eta=%v method=%d", d, test.eta, method)
+				}
+				if math.IsInf(l.LogProbSym(&x), 0) {
+					t.Fatalf("unexpected infinite density for dim=%d eta=%v method=%d", d, test.eta, method)
+				}
+			}
+			mean /= float64(count)
+			variance := sq/float64(count) - mean*mean
+			if math.Abs(mean) > 0.02 {
+				t.Errorf("unexpected off-diagonal mean for dim=%d eta=%v method=%d: got:%v want:0",
+					d, test.eta, method, mean)
+			}
+			want := 1 / (2*test.eta + float64(d) - 1)
+			if !scalar.EqualWithinRel(variance, want, 0.05) {
+				t.Errorf("unexpected off-diagonal variance for dim=%d eta=%v method=%d: got:%v want:%v",
+					d, test.eta, method, variance, want)
+			}
+		}
+	}
+}
+
+func TestLKJLogProb(t *testing.T) {
+	t.Parallel()
+	// For two dimensions the density of the correlation r is
+	// (1-r²)^(η-1) / (2^(2η-1) B(η, η)).
+	for _, eta := range []float64{0.5, 1, 2.5} {
+		l := NewLKJ(2, eta, Onion, nil)
+		for _, r := range []float64{-0.9, 0, 0.3} {
+			x := mat.NewSymDense(2, []float64{1, r, r, 1})
+			lb := 2*lgamma(eta) - lgamma(2*eta)
+			want := (eta-1)*math.Log(1-r*r) - (2*eta-1)*math.Ln2 - lb
+			got := l.LogProbSym(x)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected log probability for eta=%v r=%v: got:%v want:%v", eta, r, got, want)
+			}
+		}
+	}
+
+	// The volume of 3×3 correlation matrices is π²/2.
+	l := NewLKJ(3, 1, Vine, nil)
+	x := mat.NewSymDense(3, []float64{
+		1, 0.2, -0.1,
+		0.2, 1, 0.4,
+		-0.1, 0.4, 1,
+	})
+	got := l.LogProbSym(x)
+	want := -math.Log(math.Pi * math.Pi / 2)
+	if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected log probability for uniform 3×3: got:%v want:%v", got, want)
+	}
+
+	// Matrices that are not positive definite have zero density.
+	x = mat.NewSymDense(2, []float64{1, 1, 1, 1})
+	if p := NewLKJ(2, 2, Onion, nil).ProbSym(x); p != 0 {
+		t.Errorf("unexpected probability of singular matrix: got:%v want:0", p)
+	}
+}
+
+func TestSpectralCorr(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, eig := range [][]float64{
+		{1},
+		{3, 1},
+		{2, 1, 0.5, 0.5},
+		{10, 1, 1, 0.1, 0.01},
+		{4, 1, 0, 0, 0},
+	} {
+		d := len(eig)
+		want := make([]float64, d)
+		floats.ScaleTo(want, float64(d)/floats.Sum(eig), eig)
+		sort.Float64s(want)
+		s := NewSpectralCorr(eig, src)
+		for trial := 0; trial < 10; trial++ {
+			var x mat.SymDense
+			s.RandSymTo(&x)
+			for i := 0; i < d; i++ {
+				if x.At(i, i) != 1 {
+					t.Errorf("unexpected diagonal for eig=%v: got:%v want:1", eig, x.At(i, i))
+				}
+				for j := i + 1; j < d; j++ {
+					if math.Abs(x.At(i, j)) > 1+1e-12 {
+						t.Errorf("correlation out of range for eig=%v: %v", eig, x.At(i, j))
+					}
+				}
+			}
+			var es mat.EigenSym
+			if !es.Factorize(&x, false) {
+				t.Fatalf("eigendecomposition failed for eig=%v", eig)
+			}
+			got := es.Values(nil)
+			sort.Float64s(got)
+			if !floats.EqualApprox(got, want, 1e-10) {
+				t.Errorf("unexpected eigenvalues for eig=%v: got:%v want:%v", eig, got, want)
+			}
+		}
+	}
+}
+
+func TestConditionedSPD(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, test := range []struct {
+		dim  int
+		cond float64
+	}{
+		{dim: 1, cond: 1},
+		{dim: 2, cond: 10},
+		{dim: 5, cond: 1e3},
+		{dim: 10, cond: 1e8},
+	} {
+		c := NewConditionedSPD(test.dim, test.cond, src)
+		for trial := 0; trial < 10; trial++ {
+			var x mat.SymDense
+			c.RandSymTo(&x)
+			var es mat.EigenSym
+			if !es.Factorize(&x, false) {
+				t.Fatalf("eigendecomposition failed for dim=%d cond=%v", test.dim, test.cond)
+			}
+			eig := es.Values(nil)
+			min, max := floats.Min(eig), floats.Max(eig)
+			if !scalar.EqualWithinAbsOrRel(max, 1, 1e-12, 1e-12) {
+				t.Errorf("unexpected largest eigenvalue for dim=%d cond=%v: got:%v want:1",
+					test.dim, test.cond, max)
+			}
+			if !scalar.EqualWithinRel(max/min, test.cond, 1e-6) {
+				t.Errorf("unexpected condition number for dim=%d cond=%v: got:%v",
+					test.dim, test.cond, max/min)
+			}
+		}
+	}
+}
+
+func TestCorrelationPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "LKJ zero dim", fn: func() { NewLKJ(0, 1, Onion, nil) }},
+		{name: "LKJ zero eta", fn: func() { NewLKJ(2, 0, Onion, nil) }},
+		{name: "LKJ bad method", fn: func() { NewLKJ(2, 1, LKJMethod(-1), nil) }},
+		{name: "LKJ bad dst", fn: func() { NewLKJ(2, 1, Vine, nil).RandSymTo(mat.NewSymDense(3, nil)) }},
+		{name: "LKJ bad x", fn: func() { NewLKJ(2, 1, Vine, nil).LogProbSym(mat.NewSymDense(3, nil)) }},
+		{name: "SpectralCorr empty", fn: func() { NewSpectralCorr(nil, nil) }},
+		{name: "SpectralCorr negative", fn: func() { NewSpectralCorr([]float64{2, -1}, nil) }},
+		{name: "SpectralCorr zero", fn: func() { NewSpectralCorr([]float64{0, 0}, nil) }},
+		{name: "ConditionedSPD zero dim", fn: func() { NewConditionedSPD(0, 1, nil) }},
+		{name: "ConditionedSPD small cond", fn: func() { NewConditionedSPD(2, 0.5, nil) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
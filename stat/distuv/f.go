@@ -83,6 +83,11 @@ func (f F) Quantile(p float64) float64 {
 	if p < 0 || p > 1 {
 		panic(badPercentile)
 	}
+	if p > 0.5 {
+		// Compute 1-y directly to avoid cancellation in the upper tail.
+		yc := mathext.InvRegIncBeta(0.5*f.D2, 0.5*f.D1, 1-p)
+		return f.D2 * (1 - yc) / (f.D1 * yc)
+	}
 	y := mathext.InvRegIncBeta(0.5*f.D1, 0.5*f.D2, p)
 	return f.D2 * y / (f.D1 * (1 - y))
 }
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mathext"
 )
 
 func TestFProb(t *testing.T) {
@@ -97,6 +98,26 @@ func testF(t *testing.T, f F, i int) {
 	}
 }
 
+func TestFQuantileUpperTail(t *testing.T) {
+	t.Parallel()
+	for _, f := range []F{
+		{D1: 3, D2: 5},
+		{D1: 42, D2: 31},
+	} {
+		for _, q := range []float64{1e-5, 1e-10, 1e-15} {
+			p := 1 - q
+			q = 1 - p // Exactly representable upper tail.
+			x := f.Quantile(p)
+			// The survival function of the F distribution in terms of the
+			// complemented regularized incomplete beta function.
+			got := mathext.RegIncBeta(f.D2/2, f.D1/2, f.D2/(f.D1*x+f.D2))
+			if !scalar.EqualWithinRel(got, q, 1e-12) {
+				t.Errorf("mismatch between survival function and Quantile for %+v at p=1-%g: got %v", f, q, got)
+			}
+		}
+	}
+}
+
 func TestFUndefined(t *testing.T) {
 	t.Parallel()
 	for _, d1 := range []float64{1, 100} {
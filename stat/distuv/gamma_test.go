@@ -113,6 +113,24 @@ func testGamma(t *testing.T, f Gamma, i int) {
 	}
 }
 
+func TestGammaQuantileTails(t *testing.T) {
+	t.Parallel()
+	for _, g := range []Gamma{
+		{Alpha: 2, Beta: 2},
+		{Alpha: 3, Beta: 0.5},
+		{Alpha: 100, Beta: 1},
+	} {
+		for _, p := range []float64{1e-300, 1e-200, 1e-100, 1e-20} {
+			x := g.Quantile(p)
+			// The relative error of the CDF is amplified by
+			// approximately Alpha in the lower tail.
+			if got := g.CDF(x); math.Abs(got-p) > 1e-11*p {
+				t.Errorf("mismatch between CDF and Quantile for %+v at p=%g: got %v", g, p, got)
+			}
+		}
+	}
+}
+
 func TestGammaPanics(t *testing.T) {
 	t.Parallel()
 	g := Gamma{1, 0, nil}
@@ -0,0 +1,269 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// MixtureComponent is a component distribution of a Mixture.
+type MixtureComponent interface {
+	// CDF returns the value of the cumulative distribution
+	// function at x.
+	CDF(x float64) float64
+
+	RandLogProber
+}
+
+// Fitter is a distribution whose parameters can be set from weighted
+// samples. It is implemented by pointers to distributions such as Normal
+// and Exponential.
+type Fitter interface {
+	// Fit sets the parameters of the distribution to their
+	// maximum likelihood estimates from the samples with
+	// relative weights.
+	Fit(samples, weights []float64)
+}
+
+// EMSettings holds settings for fitting a Mixture by expectation
+// maximization. The zero value uses the default convergence criteria.
+type EMSettings struct {
+	// MaxIterations is the maximum number of iterations.
+	// If it is zero, a default of 500 is used.
+	MaxIterations int
+
+	// Tolerance is the relative change in the log-likelihood
+	// at which the iterations are considered to have converged.
+	// If it is zero, a default of 1e-10 is used.
+	Tolerance float64
+}
+
+// Mixture represents a finite mixture distribution, which draws a sample
+// from component i with probability Weights[i]. The weights must be
+// non-negative and sum to one, and Weights and Components must have the
+// same length.
+//
+// Src is used to choose the component of a sample in Rand. The sample is
+// then drawn by the Rand method of the component, using its source.
+type Mixture struct {
+	Weights    []float64
+	Components []MixtureComponent
+	Src        rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (m Mixture) CDF(x float64) float64 {
+	m.check()
+	var cdf float64
+	for i, c := range m.Components {
+		cdf += m.Weights[i] * c.CDF(x)
+	}
+	return cdf
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density or probability mass function at x.
+func (m Mixture) LogProb(x float64) float64 {
+	m.check()
+	lp := make([]float64, len(m.Components))
+	for i, c := range m.Components {
+		lp[i] = math.Log(m.Weights[i]) + c.LogProb(x)
+	}
+	return floats.LogSumExp(lp)
+}
+
+// Mean returns the mean of the probability distribution. Mean panics if a
+// component does not implement
+//
+//	Mean() float64
+func (m Mixture) Mean() float64 {
+	m.check()
+	var mean float64
+	for i, c := range m.Components {
+		mean += m.Weights[i] * componentMean(c)
+	}
+	return mean
+}
+
+// Prob computes the value of the probability density or probability mass
+// function at x.
+func (m Mixture) Prob(x float64) float64 {
+	return math.Exp(m.LogProb(x))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (m Mixture) Rand() float64 {
+	m.check()
+	var u float64
+	if m.Src == nil {
+		u = rand.Float64()
+	} else {
+		u = rand.New(m.Src).Float64()
+	}
+	i := len(m.Weights) - 1
+	for k, w := range m.Weights {
+		u -= w
+		if u < 0 {
+			i = k
+			break
+		}
+	}
+	return m.Components[i].Rand()
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+// StdDev panics if a component does not implement
+//
+//	Mean() float64
+//	Variance() float64
+func (m Mixture) StdDev() float64 {
+	return math.Sqrt(m.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (m Mixture) Survival(x float64) float64 {
+	return 1 - m.CDF(x)
+}
+
+// Variance returns the variance of the probability distribution. Variance
+// panics if a component does not implement
+//
+//	Mean() float64
+//	Variance() float64
+func (m Mixture) Variance() float64 {
+	m.check()
+	var mean, moment float64
+	for i, c := range m.Components {
+		mu := componentMean(c)
+		v, ok := c.(interface{ Variance() float64 })
+		if !ok {
+			panic("distuv: mixture component has no variance")
+		}
+		mean += m.Weights[i] * mu
+		moment += m.Weights[i] * (v.Variance() + mu*mu)
+	}
+	return moment - mean*mean
+}
+
+// Fit sets the weights and component parameters of the mixture to maximum
+// likelihood estimates from the samples with relative weights, using
+// expectation maximization with the default settings. See EM for details.
+func (m *Mixture) Fit(samples, weights []float64) {
+	m.EM(samples, weights, nil)
+}
+
+// EM sets the weights and component parameters of the mixture to maximum
+// likelihood estimates from the samples with relative weights by
+// expectation maximization, starting from the current parameters. If
+// weights is nil, all the weights are one, otherwise len(weights) must
+// equal len(samples). Each component must implement Fitter, as pointers to
+// exponential family distributions such as *Normal and *Exponential do. The
+// maximization step fits each component to the samples weighted by the
+// posterior probability that they were drawn from it.
+//
+// The log-likelihood increases at each iteration, but the maximum found is
+// local, so the result depends on the initial parameters, which should be
+// distinct. The likelihood of a continuous mixture is unbounded as a
+// component collapses onto a single sample.
+//
+// If settings is nil, the zero value of EMSettings is used. EM returns the
+// weighted log-likelihood of the samples under the fitted mixture and
+// whether the iterations converged.
+func (m *Mixture) EM(samples, weights []float64, settings *EMSettings) (logLikelihood float64, converged bool) {
+	m.check()
+	if weights != nil && len(weights) != len(samples) {
+		panic(badLength)
+	}
+	if len(samples) == 0 {
+		panic(errNoSamples)
+	}
+	fitters := make([]Fitter, len(m.Components))
+	for i, c := range m.Components {
+		f, ok := c.(Fitter)
+		if !ok {
+			panic("distuv: mixture component cannot be fit")
+		}
+		fitters[i] = f
+	}
+	if settings == nil {
+		settings = &EMSettings{}
+	}
+	maxIter := settings.MaxIterations
+	if maxIter == 0 {
+		maxIter = 500
+	}
+	tol := settings.Tolerance
+	if tol == 0 {
+		tol = 1e-10
+	}
+
+	n, k := len(samples), len(m.Components)
+	// resp holds the weighted posterior probability of
+	// each component for each sample in its rows.
+	resp := make([][]float64, k)
+	for j := range resp {
+		resp[j] = make([]float64, n)
+	}
+	lp := make([]float64, k)
+	estep := func() float64 {
+		var ll float64
+		for i, x := range samples {
+			for j, c := range m.Components {
+				lp[j] = math.Log(m.Weights[j]) + c.LogProb(x)
+			}
+			total := floats.LogSumExp(lp)
+			w := 1.0
+			if weights != nil {
+				w = weights[i]
+			}
+			ll += w * total
+			for j := range lp {
+				resp[j][i] = w * math.Exp(lp[j]-total)
+			}
+		}
+		return ll
+	}
+
+	total := float64(n)
+	if weights != nil {
+		total = floats.Sum(weights)
+	}
+	logLikelihood = estep()
+	for it := 0; it < maxIter; it++ {
+		for j, f := range fitters {
+			sum := floats.Sum(resp[j])
+			m.Weights[j] = sum / total
+			if sum > 0 {
+				f.Fit(samples, resp[j])
+			}
+		}
+		prev := logLikelihood
+		logLikelihood = estep()
+		if math.Abs(logLikelihood-prev) <= tol*math.Abs(logLikelihood) {
+			return logLikelihood, true
+		}
+	}
+	return logLikelihood, false
+}
+
+func (m Mixture) check() {
+	if len(m.Weights) != len(m.Components) {
+		panic(badLength)
+	}
+	if len(m.Components) == 0 {
+		panic("distuv: mixture has no components")
+	}
+}
+
+func componentMean(c MixtureComponent) float64 {
+	mc, ok := c.(interface{ Mean() float64 })
+	if !ok {
+		panic("distuv: mixture component has no mean")
+	}
+	return mc.Mean()
+}
@@ -0,0 +1,212 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestMixture(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for i, dist := range []Mixture{
+		{
+			Weights: []float64{0.3, 0.7},
+			Components: []MixtureComponent{
+				Normal{Mu: -2, Sigma: 0.5, Src: src},
+				Normal{Mu: 3, Sigma: 1, Src: src},
+			},
+			Src: src,
+		},
+		{
+			Weights: []float64{0.5, 0.25, 0.25},
+			Components: []MixtureComponent{
+				Exponential{Rate: 1, Src: src},
+				Exponential{Rate: 5, Src: src},
+				Gamma{Alpha: 4, Beta: 2, Src: src},
+			},
+			Src: src,
+		},
+	} {
+		const (
+			tol = 1e-2
+			n   = 1e5
+		)
+		x := make([]float64, n)
+		generateSamples(x, dist)
+		sort.Float64s(x)
+
+		checkMean(t, i, x, dist, 2*tol)
+		checkVarAndStd(t, i, x, dist, 2*tol)
+		checkProbContinuous(t, i, x, math.Inf(-1), math.Inf(1), dist, 1e-6)
+		for _, p := range []float64{0.1, 0.25, 0.5, 0.75, 0.9} {
+			v := x[int(p*n)]
+			if got := dist.CDF(v); math.Abs(got-p) > tol {
+				t.Errorf("CDF mismatch case %d at %v: got:%v want:%v", i, v, got, p)
+			}
+			if got, want := dist.Survival(v), 1-dist.CDF(v); got != want {
+				t.Errorf("Survival mismatch case %d at %v: got:%v want:%v", i, v, got, want)
+			}
+		}
+		for _, v := range []float64{-3, 0.1, 1, 4} {
+			var want float64
+			for k, c := range dist.Components {
+				want += dist.Weights[k] * math.Exp(c.LogProb(v))
+			}
+			if got := dist.Prob(v); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+				t.Errorf("Prob mismatch case %d at %v: got:%v want:%v", i, v, got, want)
+			}
+		}
+	}
+}
+
+func TestMixtureEM(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	truth := Mixture{
+		Weights: []float64{0.3, 0.7},
+		Components: []MixtureComponent{
+			Normal{Mu: -2, Sigma: 0.5, Src: src},
+			Normal{Mu: 3, Sigma: 1, Src: src},
+		},
+		Src: src,
+	}
+	x := make([]float64, 5000)
+	generateSamples(x, truth)
+
+	m := Mixture{
+		Weights:    []float64{0.5, 0.5},
+		Components: []MixtureComponent{&Normal{Mu: -1, Sigma: 1}, &Normal{Mu: 1, Sigma: 1}},
+	}
+	// Each iteration does not decrease the log-likelihood.
+	prev := math.Inf(-1)
+	for it := 0; it < 5; it++ {
+		ll, _ := m.EM(x, nil, &EMSettings{MaxIterations: 1})
+		if ll < prev {
+			t.Errorf("log-likelihood decreased at iteration %d: %v < %v", it, ll, prev)
+		}
+		prev = ll
+	}
+	ll, converged := m.EM(x, nil, nil)
+	if !converged {
+		t.Error("EM did not converge")
+	}
+	if ll < prev {
+		t.Errorf("log-likelihood decreased: %v < %v", ll, prev)
+	}
+	var want float64
+	for _, v := range x {
+		want += m.LogProb(v)
+	}
+	if !scalar.EqualWithinAbsOrRel(ll, want, 1e-8, 1e-8) {
+		t.Errorf("unexpected log-likelihood: got:%v want:%v", ll, want)
+	}
+	for k, c := range m.Components {
+		got := c.(*Normal)
+		wantC := truth.Components[k].(Normal)
+		if math.Abs(got.Mu-wantC.Mu) > 0.05 || math.Abs(got.Sigma-wantC.Sigma) > 0.05 {
+			t.Errorf("unexpected component %d: got:%+v want:%+v", k, *got, wantC)
+		}
+		if math.Abs(m.Weights[k]-truth.Weights[k]) > 0.02 {
+			t.Errorf("unexpected weight %d: got:%v want:%v", k, m.Weights[k], truth.Weights[k])
+		}
+	}
+
+	// Weighting a sample is equivalent to repeating it.
+	short := x[:200]
+	weights := make([]float64, len(short))
+	var repeated []float64
+	for i, v := range short {
+		weights[i] = float64(1 + i%3)
+		for j := 0; j < 1+i%3; j++ {
+			repeated = append(repeated, v)
+		}
+	}
+	newMix := func() *Mixture {
+		return &Mixture{
+			Weights:    []float64{0.5, 0.5},
+			Components: []MixtureComponent{&Exponential{Rate: 1}, &Normal{Mu: 1, Sigma: 1}},
+		}
+	}
+	a, b := newMix(), newMix()
+	settings := &EMSettings{MaxIterations: 20}
+	lla, _ := a.EM(short, weights, settings)
+	llb, _ := b.EM(repeated, nil, settings)
+	if !scalar.EqualWithinAbsOrRel(lla, llb, 1e-9, 1e-9) {
+		t.Errorf("weighted log-likelihood differs from repeated: %v != %v", lla, llb)
+	}
+	if !scalar.EqualWithinAbsOrRel(a.Components[1].(*Normal).Mu, b.Components[1].(*Normal).Mu, 1e-9, 1e-9) {
+		t.Error("weighted fit differs from repeated fit")
+	}
+}
+
+func TestMixtureEMExponential(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	truth := Mixture{
+		Weights:    []float64{0.6, 0.4},
+		Components: []MixtureComponent{Exponential{Rate: 0.5, Src: src}, Exponential{Rate: 10, Src: src}},
+		Src:        src,
+	}
+	x := make([]float64, 20000)
+	generateSamples(x, truth)
+	m := Mixture{
+		Weights:    []float64{0.5, 0.5},
+		Components: []MixtureComponent{&Exponential{Rate: 1}, &Exponential{Rate: 2}},
+	}
+	m.Fit(x, nil)
+	for k, c := range m.Components {
+		got := c.(*Exponential).Rate
+		want := truth.Components[k].(Exponential).Rate
+		if math.Abs(got-want) > 0.1*want {
+			t.Errorf("unexpected rate %d: got:%v want:%v", k, got, want)
+		}
+	}
+}
+
+func TestMixturePanics(t *testing.T) {
+	t.Parallel()
+	x := []float64{1, 2, 3}
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "length mismatch", fn: func() {
+			Mixture{Weights: []float64{1}, Components: []MixtureComponent{UnitNormal, UnitNormal}}.Prob(0)
+		}},
+		{name: "no components", fn: func() { Mixture{}.CDF(0) }},
+		{name: "unfittable component", fn: func() {
+			m := Mixture{Weights: []float64{1}, Components: []MixtureComponent{UnitNormal}}
+			m.Fit(x, nil)
+		}},
+		{name: "no samples", fn: func() {
+			m := Mixture{Weights: []float64{1}, Components: []MixtureComponent{&Normal{Mu: 0, Sigma: 1}}}
+			m.Fit(nil, nil)
+		}},
+		{name: "weights length", fn: func() {
+			m := Mixture{Weights: []float64{1}, Components: []MixtureComponent{&Normal{Mu: 0, Sigma: 1}}}
+			m.Fit(x, []float64{1})
+		}},
+		{name: "no mean", fn: func() {
+			Mixture{Weights: []float64{1}, Components: []MixtureComponent{noMoments{}}}.Mean()
+		}},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+// noMoments is a mixture component without a mean or variance.
+type noMoments struct{}
+
+func (noMoments) CDF(x float64) float64     { return UnitNormal.CDF(x) }
+func (noMoments) LogProb(x float64) float64 { return UnitNormal.LogProb(x) }
+func (noMoments) Rand() float64             { return UnitNormal.Rand() }
@@ -224,7 +224,16 @@ func (Normal) SuffStat(suffStat, samples, weights []float64) (nSamples float64)
 
 // Survival returns the survival function (complementary CDF) at x.
 func (n Normal) Survival(x float64) float64 {
-	return 0.5 * (1 - math.Erf((x-n.Mu)/(n.Sigma*math.Sqrt2)))
+	return 0.5 * math.Erfc((x-n.Mu)/(n.Sigma*math.Sqrt2))
+}
+
+// SurvivalQuantile returns the inverse of the survival function. It is
+// accurate in the upper tail where Quantile(1-q) is not.
+func (n Normal) SurvivalQuantile(q float64) float64 {
+	if q < 0 || q > 1 {
+		panic(badPercentile)
+	}
+	return n.Mu - n.Sigma*mathext.NormalQuantile(q)
 }
 
 // setParameters modifies the parameters of the distribution.
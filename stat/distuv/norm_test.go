@@ -188,6 +188,17 @@ func TestNormalQuantile(t *testing.T) {
 		if !scalar.EqualWithinAbsOrRel(got, ans[i], 1e-10, 1e-10) {
 			t.Errorf("Quantile mismatch. Case %d, want: %v, got: %v", i, ans[i], got)
 		}
+		got = UnitNormal.SurvivalQuantile(1 - v)
+		if !scalar.EqualWithinAbsOrRel(got, ans[i], 1e-8, 1e-8) {
+			t.Errorf("SurvivalQuantile mismatch. Case %d, want: %v, got: %v", i, ans[i], got)
+		}
+	}
+	for _, x := range []float64{5, 10, 20, 35} {
+		n := Normal{Mu: 1, Sigma: 2}
+		x := n.Mu + n.Sigma*x
+		if got := n.SurvivalQuantile(n.Survival(x)); !scalar.EqualWithinAbsOrRel(got, x, 1e-12, 1e-12) {
+			t.Errorf("SurvivalQuantile mismatch in upper tail: want: %v, got: %v", x, got)
+		}
 	}
 }
 
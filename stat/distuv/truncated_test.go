@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestTruncatedNormal(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for i, test := range []struct {
+		mu, sigma    float64
+		lower, upper float64
+	}{
+		{mu: 0, sigma: 1, lower: -1, upper: 2},
+		{mu: 0, sigma: 1, lower: 0, upper: inf},
+		{mu: 2, sigma: 3, lower: -inf, upper: -1},
+		{mu: 0, sigma: 1, lower: 3, upper: 5},
+		{mu: 0, sigma: 1, lower: -6, upper: -4},
+		{mu: 1, sigma: 0.1, lower: -100, upper: 100},
+		{mu: 0, sigma: 1, lower: 10, upper: 11},
+		{mu: 0, sigma: 1, lower: -11, upper: -10},
+		{mu: 0, sigma: 1, lower: 8, upper: inf},
+		{mu: 3, sigma: 2, lower: 40, upper: 41},
+	} {
+		norm := Normal{Mu: test.mu, Sigma: test.sigma}
+		dist := Truncated{Dist: norm, Lower: test.lower, Upper: test.upper}
+
+		// The closed form mean of the truncated normal.
+		alpha := (test.lower - test.mu) / test.sigma
+		beta := (test.upper - test.mu) / test.sigma
+		unit := Normal{Mu: 0, Sigma: 1}
+		z := unit.CDF(beta) - unit.CDF(alpha)
+		if alpha > 0 {
+			z = unit.Survival(alpha) - unit.Survival(beta)
+		}
+		want := test.mu + test.sigma*(unit.Prob(alpha)-unit.Prob(beta))/z
+		if got := dist.Mean(); !scalar.EqualWithinAbsOrRel(got, want, 1e-8, 1e-8) {
+			t.Errorf("unexpected mean for case %d: got:%v want:%v", i, got, want)
+		}
+
+		for _, x := range []float64{test.lower, (test.lower + test.upper) / 2, test.mu, test.upper - 0.5} {
+			if x < test.lower || x > test.upper || math.IsInf(x, 0) {
+				continue
+			}
+			if got, want := dist.Prob(x), norm.Prob(x)/z; !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected density for case %d at %v: got:%v want:%v", i, x, got, want)
+			}
+			p := dist.CDF(x)
+			if p == 0 || p == 1 {
+				continue
+			}
+			if got := dist.Quantile(p); !scalar.EqualWithinAbsOrRel(got, x, 1e-8, 1e-8) {
+				t.Errorf("unexpected quantile for case %d at %v: got:%v want:%v", i, p, got, x)
+			}
+		}
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	inf := math.Inf(1)
+	for i, dist := range []Truncated{
+		{Dist: Normal{Mu: 0, Sigma: 1}, Lower: -1, Upper: 2, Src: src},
+		{Dist: Normal{Mu: 0, Sigma: 1}, Lower: 1.5, Upper: inf, Src: src},
+		{Dist: Exponential{Rate: 2}, Lower: 0.5, Upper: 3, Src: src},
+		{Dist: Gamma{Alpha: 3, Beta: 1}, Lower: 0, Upper: 2, Src: src},
+		{Dist: StudentsT{Mu: 0, Sigma: 1, Nu: 3}, Lower: -inf, Upper: 0.5, Src: src},
+	} {
+		testTruncated(t, dist, i)
+	}
+}
+
+func testTruncated(t *testing.T, dist Truncated, i int) {
+	const (
+		tol = 1e-2
+		n   = 1e5
+	)
+	x := make([]float64, n)
+	generateSamples(x, dist)
+	sort.Float64s(x)
+	if x[0] < dist.Lower || x[len(x)-1] > dist.Upper {
+		t.Errorf("sample outside support for case %d: [%v, %v]", i, x[0], x[len(x)-1])
+	}
+
+	checkMean(t, i, x, dist, tol)
+	checkMedian(t, i, x, dist, tol)
+	checkQuantileCDFSurvival(t, i, x, dist, tol)
+	checkProbContinuous(t, i, x, dist.Lower, dist.Upper, dist, 1e-6)
+	checkProbQuantContinuous(t, i, x, dist, tol)
+
+	if got := dist.Prob(dist.Lower - 1); got != 0 {
+		t.Errorf("unexpected density below support for case %d: %v", i, got)
+	}
+	if got := dist.CDF(dist.Upper); got != 1 {
+		t.Errorf("unexpected CDF at upper bound for case %d: %v", i, got)
+	}
+}
+
+// upperNormal is a Truncatable implemented outside Normal that provides an
+// accurate upper tail through its Survival and SurvivalQuantile methods.
+type upperNormal struct {
+	mu, sigma float64
+}
+
+func (n upperNormal) CDF(x float64) float64 {
+	return Normal{Mu: n.mu, Sigma: n.sigma}.CDF(x)
+}
+func (n upperNormal) LogProb(x float64) float64 {
+	return Normal{Mu: n.mu, Sigma: n.sigma}.LogProb(x)
+}
+func (n upperNormal) Quantile(p float64) float64 {
+	return Normal{Mu: n.mu, Sigma: n.sigma}.Quantile(p)
+}
+func (n upperNormal) Survival(x float64) float64 {
+	return Normal{Mu: n.mu, Sigma: n.sigma}.Survival(x)
+}
+func (n upperNormal) SurvivalQuantile(q float64) float64 {
+	return Normal{Mu: n.mu, Sigma: n.sigma}.SurvivalQuantile(q)
+}
+
+func TestTruncatedSurvivalQuantile(t *testing.T) {
+	t.Parallel()
+	dist := Truncated{Dist: upperNormal{mu: 0, sigma: 1}, Lower: 10, Upper: 11}
+	want := Truncated{Dist: UnitNormal, Lower: 10, Upper: 11}
+	for _, p := range []float64{0, 0.1, 0.5, 0.9, 1} {
+		got := dist.Quantile(p)
+		if got != want.Quantile(p) {
+			t.Errorf("unexpected quantile at %v: got:%v want:%v", p, got, want.Quantile(p))
+		}
+		if p != 0 && p != 1 && !(10 < got && got < 11) {
+			t.Errorf("quantile at %v outside interior of support: %v", p, got)
+		}
+	}
+}
+
+func TestTruncatedEmpty(t *testing.T) {
+	t.Parallel()
+	for i, dist := range []Truncated{
+		{Dist: UnitNormal, Lower: 40, Upper: 41},
+		{Dist: UnitNormal, Lower: -41, Upper: -40},
+		{Dist: UnitNormal, Lower: 2, Upper: 1},
+		{Dist: UnitNormal, Lower: 1, Upper: 1},
+		{Dist: Exponential{Rate: 1}, Lower: 3, Upper: 2},
+	} {
+		for _, test := range []struct {
+			name string
+			fn   func() float64
+		}{
+			{name: "CDF below", fn: func() float64 { return dist.CDF(dist.Lower - 1) }},
+			{name: "CDF above", fn: func() float64 { return dist.CDF(dist.Upper + 1) }},
+			{name: "LogProb", fn: func() float64 { return dist.LogProb(dist.Lower) }},
+			{name: "Prob", fn: func() float64 { return dist.Prob(dist.Upper - 1) }},
+			{name: "Quantile", fn: func() float64 { return dist.Quantile(0.5) }},
+			{name: "Mean", fn: dist.Mean},
+			{name: "Median", fn: dist.Median},
+			{name: "Rand", fn: dist.Rand},
+			{name: "Survival", fn: func() float64 { return dist.Survival(dist.Lower) }},
+		} {
+			if got := test.fn(); !math.IsNaN(got) {
+				t.Errorf("unexpected %s for case %d: got:%v want:NaN", test.name, i, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package glasso provides the graphical lasso estimator of sparse precision
+// matrices, and the conditional independence graphs they imply, for
+// multivariate Gaussian data.
+package glasso // import "gonum.org/v1/gonum/stat/glasso"
@@ -0,0 +1,403 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package glasso
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrNoConvergence is returned when the graphical lasso does not converge
+// within the maximum number of iterations. The returned estimate is the
+// last iterate.
+var ErrNoConvergence = errors.New("glasso: did not converge")
+
+// lassoIterations is the maximum number of coordinate descent
+// sweeps of each lasso subproblem.
+const lassoIterations = 1000
+
+// Settings holds settings for the graphical lasso. The zero value uses the
+// default convergence criteria.
+type Settings struct {
+	// MaxIterations is the maximum number of sweeps over
+	// the columns of the covariance. If it is zero, a default
+	// of 100 is used.
+	MaxIterations int
+
+	// Tolerance is the mean absolute change in the off-diagonal
+	// elements of the estimated covariance in a sweep, relative
+	// to the mean absolute off-diagonal element of the sample
+	// covariance, at which the iterations are considered to have
+	// converged. If it is zero, a default of 1e-4 is used.
+	Tolerance float64
+}
+
+// Estimate is a graphical lasso estimate of a covariance matrix and its
+// sparse inverse.
+type Estimate struct {
+	// Lambda is the penalty of the estimate.
+	Lambda float64
+
+	// Precision is the estimated precision matrix, the
+	// inverse of Covariance. Its zero off-diagonal elements
+	// correspond to pairs of variables that are conditionally
+	// independent given the others.
+	Precision *mat.SymDense
+
+	// Covariance is the estimated covariance matrix.
+	Covariance *mat.SymDense
+
+	// Iterations is the number of sweeps over the columns
+	// of the covariance.
+	Iterations int
+
+	// beta holds the lasso coefficients of each column
+	// in its columns for warm starts.
+	beta *mat.Dense
+}
+
+// Fit estimates a sparse precision matrix from the sample covariance matrix s
+// by the graphical lasso, maximizing the penalized Gaussian log-likelihood
+//
+//	log det Θ - tr(S Θ) - λ ∑_{i≠j} |Θ_ij|
+//
+// over positive definite Θ, where λ is lambda. The diagonal of Θ is not
+// penalized. s is usually the maximum likelihood estimate of the covariance,
+// normalized by the number of observations, and it need not be positive
+// definite when lambda is positive. The estimate is computed by the block
+// coordinate descent algorithm of Friedman, Hastie and Tibshirani, solving a
+// lasso problem for each column in turn.
+//
+// If settings is nil, the zero value of Settings is used. Fit panics if lambda
+// is negative. If the iterations do not converge, Fit returns the last iterate
+// with ErrNoConvergence.
+//
+// See J. Friedman, T. Hastie and R. Tibshirani, "Sparse inverse covariance
+// estimation with the graphical lasso", Biostatistics 9(3), 2008 for details.
+func Fit(s mat.Symmetric, lambda float64, settings *Settings) (*Estimate, error) {
+	return fit(s, lambda, nil, settings)
+}
+
+// Path computes graphical lasso estimates from the sample covariance matrix s
+// for each penalty in lambda, as described for Fit. Each estimate is started
+// from the previous one, so lambda is best given in decreasing order, as
+// returned by Penalties.
+//
+// If settings is nil, the zero value of Settings is used. Path panics if any
+// penalty is negative. If the iterations for a penalty do not converge, Path
+// returns the estimates up to and including that penalty with
+// ErrNoConvergence.
+func Path(s mat.Symmetric, lambda []float64, settings *Settings) ([]*Estimate, error) {
+	path := make([]*Estimate, 0, len(lambda))
+	var warm *Estimate
+	for _, l := range lambda {
+		e, err := fit(s, l, warm, settings)
+		path = append(path, e)
+		if err != nil {
+			return path, err
+		}
+		warm = e
+	}
+	return path, nil
+}
+
+// Penalties returns n penalties for the graphical lasso of the covariance
+// matrix s, spaced evenly on a log scale in decreasing order from the
+// smallest penalty giving a diagonal precision matrix, the largest absolute
+// off-diagonal element of s, to ratio times that penalty. Penalties panics
+// if n is less than two or ratio is not in (0, 1).
+func Penalties(s mat.Symmetric, n int, ratio float64) []float64 {
+	if n < 2 {
+		panic("glasso: too few penalties")
+	}
+	if ratio <= 0 || ratio >= 1 {
+		panic("glasso: penalty ratio out of range")
+	}
+	max := maxOffDiag(s)
+	lambda := make([]float64, n)
+	for i := range lambda {
+		lambda[i] = max * math.Pow(ratio, float64(i)/float64(n-1))
+	}
+	return lambda
+}
+
+// Edges returns the number of non-zero off-diagonal elements in the upper
+// triangle of the precision matrix, the number of edges in the conditional
+// independence graph.
+func (e *Estimate) Edges() int {
+	p := e.Precision.SymmetricDim()
+	var n int
+	for i := 0; i < p; i++ {
+		for j := i + 1; j < p; j++ {
+			if e.Precision.At(i, j) != 0 {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// LogLikelihood returns the Gaussian log-likelihood of the precision matrix
+// of the estimate for n observations with sample covariance s, omitting the
+// constant term,
+//
+//	n/2 (log det Θ - tr(S Θ)).
+func (e *Estimate) LogLikelihood(s mat.Symmetric, n int) float64 {
+	var chol mat.Cholesky
+	if !chol.Factorize(e.Precision) {
+		return math.Inf(-1)
+	}
+	var tr float64
+	p := s.SymmetricDim()
+	for i := 0; i < p; i++ {
+		for j := 0; j < p; j++ {
+			tr += s.At(i, j) * e.Precision.At(j, i)
+		}
+	}
+	return float64(n) / 2 * (chol.LogDet() - tr)
+}
+
+// EBIC returns the extended Bayesian information criterion of the estimate
+// for n observations with sample covariance s,
+//
+//	-2 ℓ + |E| log n + 4 γ |E| log p,
+//
+// where ℓ is the log-likelihood, |E| is the number of edges and p is the
+// number of variables. With γ, gamma, equal to zero it is the Bayesian
+// information criterion, and larger values of γ in [0, 1] favor sparser
+// graphs. A value of 0.5 is commonly used.
+//
+// See R. Foygel and M. Drton, "Extended Bayesian information criteria for
+// Gaussian graphical models", Advances in Neural Information Processing
+// Systems 23, 2010 for details.
+func (e *Estimate) EBIC(s mat.Symmetric, n int, gamma float64) float64 {
+	edges := float64(e.Edges())
+	p := float64(s.SymmetricDim())
+	return -2*e.LogLikelihood(s, n) + edges*math.Log(float64(n)) + 4*gamma*edges*math.Log(p)
+}
+
+// SelectEBIC returns the index of the estimate in path with the smallest
+// extended Bayesian information criterion for n observations with sample
+// covariance s and parameter gamma, as computed by the EBIC method.
+// SelectEBIC panics if path is empty.
+func SelectEBIC(path []*Estimate, s mat.Symmetric, n int, gamma float64) int {
+	if len(path) == 0 {
+		panic("glasso: empty path")
+	}
+	best := 0
+	min := math.Inf(1)
+	for i, e := range path {
+		if v := e.EBIC(s, n, gamma); v < min {
+			best, min = i, v
+		}
+	}
+	return best
+}
+
+// Graph returns the conditional independence graph of the estimate. Node i
+// of the graph corresponds to variable i, and variables are joined by an
+// edge when their element of the precision matrix is non-zero. The weight
+// of the edge between i and j is their partial correlation given the other
+// variables,
+//
+//	-Θ_ij / sqrt(Θ_ii Θ_jj).
+func (e *Estimate) Graph() *simple.WeightedUndirectedGraph {
+	g := simple.NewWeightedUndirectedGraph(1, 0)
+	p := e.Precision.SymmetricDim()
+	for i := 0; i < p; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for i := 0; i < p; i++ {
+		for j := i + 1; j < p; j++ {
+			v := e.Precision.At(i, j)
+			if v == 0 {
+				continue
+			}
+			w := -v / math.Sqrt(e.Precision.At(i, i)*e.Precision.At(j, j))
+			g.SetWeightedEdge(g.NewWeightedEdge(simple.Node(i), simple.Node(j), w))
+		}
+	}
+	return g
+}
+
+func fit(s mat.Symmetric, lambda float64, warm *Estimate, settings *Settings) (*Estimate, error) {
+	if lambda < 0 {
+		panic("glasso: negative penalty")
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	maxIter := settings.MaxIterations
+	if maxIter == 0 {
+		maxIter = 100
+	}
+	tol := settings.Tolerance
+	if tol == 0 {
+		tol = 1e-4
+	}
+
+	p := s.SymmetricDim()
+	e := &Estimate{
+		Lambda:     lambda,
+		Precision:  mat.NewSymDense(p, nil),
+		Covariance: mat.NewSymDense(p, nil),
+		beta:       mat.NewDense(p, p, nil),
+	}
+
+	// The covariance is held in full so each column
+	// is a contiguous row.
+	w := mat.NewDense(p, p, nil)
+	if warm != nil && warm.Covariance.SymmetricDim() == p {
+		w.Copy(warm.Covariance)
+		e.beta.Copy(warm.beta)
+	} else {
+		w.Copy(s)
+	}
+	for i := 0; i < p; i++ {
+		w.Set(i, i, s.At(i, i))
+	}
+
+	var scale float64
+	for i := 0; i < p; i++ {
+		for j := i + 1; j < p; j++ {
+			scale += math.Abs(s.At(i, j))
+		}
+	}
+	var err error
+	if lambda >= maxOffDiag(s) {
+		// The solution is diagonal.
+		w.Zero()
+		e.beta.Zero()
+		for i := 0; i < p; i++ {
+			w.Set(i, i, s.At(i, i))
+		}
+	} else {
+		scale /= float64(p * (p - 1) / 2)
+		err = ErrNoConvergence
+		beta := make([]float64, p)
+		for e.Iterations < maxIter {
+			e.Iterations++
+			var change float64
+			for j := 0; j < p; j++ {
+				for k := 0; k < p; k++ {
+					beta[k] = e.beta.At(k, j)
+				}
+				lasso(beta, w, s, j, lambda, tol)
+				e.beta.SetCol(j, beta)
+
+				// Update the off-diagonal elements of column j
+				// to W₁₁ β.
+				wj := w.RawRowView(j)
+				for k := 0; k < p; k++ {
+					if k == j {
+						continue
+					}
+					var v float64
+					wk := w.RawRowView(k)
+					for l, b := range beta {
+						if l != j {
+							v += wk[l] * b
+						}
+					}
+					change += math.Abs(v - wj[k])
+					wj[k] = v
+					wk[j] = v
+				}
+			}
+			if change/float64(p*(p-1)) < tol*scale {
+				err = nil
+				break
+			}
+		}
+	}
+
+	// Compute the precision from the lasso coefficients
+	// and the covariance.
+	theta := mat.NewDense(p, p, nil)
+	for j := 0; j < p; j++ {
+		d := w.At(j, j)
+		for k := 0; k < p; k++ {
+			if k != j {
+				d -= w.At(k, j) * e.beta.At(k, j)
+			}
+		}
+		t := 1 / d
+		theta.Set(j, j, t)
+		for k := 0; k < p; k++ {
+			if k != j {
+				theta.Set(k, j, -e.beta.At(k, j)*t)
+			}
+		}
+	}
+	for i := 0; i < p; i++ {
+		e.Precision.SetSym(i, i, theta.At(i, i))
+		for j := i; j < p; j++ {
+			e.Covariance.SetSym(i, j, w.At(i, j))
+			if j != i {
+				e.Precision.SetSym(i, j, (theta.At(i, j)+theta.At(j, i))/2)
+			}
+		}
+	}
+	return e, err
+}
+
+// lasso solves the lasso subproblem for column j of the covariance w,
+//
+//	minimize ½ βᵀ W₁₁ β - s₁₂ᵀ β + λ ‖β‖₁,
+//
+// by coordinate descent starting from beta, where W₁₁ is w without row and
+// column j and s₁₂ is column j of s without row j. Element j of beta is
+// ignored.
+func lasso(beta []float64, w *mat.Dense, s mat.Symmetric, j int, lambda, tol float64) {
+	p := len(beta)
+	beta[j] = 0
+	for it := 0; it < lassoIterations; it++ {
+		var delta float64
+		for k := 0; k < p; k++ {
+			if k == j {
+				continue
+			}
+			wk := w.RawRowView(k)
+			r := s.At(k, j)
+			for l, b := range beta {
+				if l != j && l != k {
+					r -= wk[l] * b
+				}
+			}
+			b := softThreshold(r, lambda) / wk[k]
+			delta = math.Max(delta, math.Abs(b-beta[k]))
+			beta[k] = b
+		}
+		if delta < tol*1e-2 {
+			return
+		}
+	}
+}
+
+// softThreshold returns sign(x) max(|x| - lambda, 0).
+func softThreshold(x, lambda float64) float64 {
+	switch {
+	case x > lambda:
+		return x - lambda
+	case x < -lambda:
+		return x + lambda
+	}
+	return 0
+}
+
+// maxOffDiag returns the largest absolute off-diagonal element of s.
+func maxOffDiag(s mat.Symmetric) float64 {
+	p := s.SymmetricDim()
+	var max float64
+	for i := 0; i < p; i++ {
+		for j := i + 1; j < p; j++ {
+			max = math.Max(max, math.Abs(s.At(i, j)))
+		}
+	}
+	return max
+}
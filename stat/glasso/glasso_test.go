@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package glasso
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+// chain returns the p×p tridiagonal precision matrix of a Gaussian
+// Markov chain and n observations drawn from it with the maximum
+// likelihood covariance of the observations.
+func chain(p, n int, src rand.Source) (prec, s *mat.SymDense) {
+	prec = mat.NewSymDense(p, nil)
+	for i := 0; i < p; i++ {
+		prec.SetSym(i, i, 1)
+		if i+1 < p {
+			prec.SetSym(i, i+1, 0.4)
+		}
+	}
+	var chol mat.Cholesky
+	if !chol.Factorize(prec) {
+		panic("bad precision")
+	}
+	var sigma mat.SymDense
+	chol.InverseTo(&sigma)
+	dist, ok := distmv.NewNormal(make([]float64, p), &sigma, src)
+	if !ok {
+		panic("bad covariance")
+	}
+	x := mat.NewDense(n, p, nil)
+	for i := 0; i < n; i++ {
+		dist.Rand(x.RawRowView(i))
+	}
+	s = &mat.SymDense{}
+	stat.CovarianceMatrix(s, x, nil)
+	s.ScaleSym(float64(n-1)/float64(n), s)
+	return prec, s
+}
+
+func TestFit(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		p, n int
+	}{
+		{p: 2, n: 20},
+		{p: 8, n: 50},
+		{p: 20, n: 10},
+	} {
+		_, s := chain(test.p, test.n, rand.NewPCG(1, 1))
+		for _, ratio := range []float64{0.9, 0.5, 0.2, 0.05} {
+			lambda := ratio * maxOffDiag(s)
+			name := fmt.Sprintf("p=%d,n=%d,lambda=%.3g", test.p, test.n, lambda)
+			e, err := Fit(s, lambda, &Settings{Tolerance: 1e-8})
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+
+			// The estimated covariance is the inverse of the
+			// estimated precision.
+			var prod mat.Dense
+			prod.Mul(e.Covariance, e.Precision)
+			eye := mat.NewDiagDense(test.p, nil)
+			for i := 0; i < test.p; i++ {
+				eye.SetDiag(i, 1)
+			}
+			if !mat.EqualApprox(&prod, eye, 1e-6) {
+				t.Errorf("%s: covariance is not the inverse of precision", name)
+			}
+
+			// Check the optimality conditions W - S = λ Γ, where
+			// Γ is in the subdifferential of the l1 norm of Θ.
+			const tol = 1e-5
+			for i := 0; i < test.p; i++ {
+				if d := e.Covariance.At(i, i) - s.At(i, i); math.Abs(d) > tol {
+					t.Errorf("%s: unexpected diagonal element %d: got:%v want:%v", name, i, e.Covariance.At(i, i), s.At(i, i))
+				}
+				for j := i + 1; j < test.p; j++ {
+					d := e.Covariance.At(i, j) - s.At(i, j)
+					theta := e.Precision.At(i, j)
+					switch {
+					case theta == 0:
+						if math.Abs(d) > lambda+tol {
+							t.Errorf("%s: optimality violated for zero element [%d,%d]: |%v| > %v", name, i, j, d, lambda)
+						}
+					default:
+						want := math.Copysign(lambda, theta)
+						if !scalar.EqualWithinAbsOrRel(d, want, tol, tol) {
+							t.Errorf("%s: optimality violated for element [%d,%d]: got:%v want:%v", name, i, j, d, want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestFitDiagonal(t *testing.T) {
+	t.Parallel()
+	_, s := chain(5, 30, rand.NewPCG(1, 1))
+	e, err := Fit(s, maxOffDiag(s), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := e.Edges(); n != 0 {
+		t.Errorf("unexpected number of edges: got:%d want:0", n)
+	}
+	for i := 0; i < 5; i++ {
+		if got, want := e.Precision.At(i, i), 1/s.At(i, i); !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("unexpected precision element %d: got:%v want:%v", i, got, want)
+		}
+	}
+	if e.Graph().Edges().Len() != 0 {
+		t.Error("unexpected edges in graph")
+	}
+}
+
+func TestPath(t *testing.T) {
+	t.Parallel()
+	const p, n = 10, 400
+	prec, s := chain(p, n, rand.NewPCG(1, 1))
+	lambda := Penalties(s, 20, 0.01)
+	if lambda[0] != maxOffDiag(s) || !scalar.EqualWithinAbsOrRel(lambda[19], 0.01*lambda[0], 1e-14, 1e-14) {
+		t.Errorf("unexpected penalties: %v", lambda)
+	}
+	settings := &Settings{Tolerance: 1e-8}
+	path, err := Path(s, lambda, settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(path) != len(lambda) {
+		t.Fatalf("unexpected path length: got:%d want:%d", len(path), len(lambda))
+	}
+	for i, e := range path {
+		// Warm starts do not change the solution.
+		cold, err := Fit(s, lambda[i], settings)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !mat.EqualApprox(e.Precision, cold.Precision, 1e-5) {
+			t.Errorf("warm started estimate %d differs from cold started estimate", i)
+		}
+		if i > 0 && e.LogLikelihood(s, n) < path[i-1].LogLikelihood(s, n)-1e-6 {
+			t.Errorf("log-likelihood decreased along path at %d", i)
+		}
+	}
+
+	// EBIC recovers the edges of the chain, with weaker
+	// spurious edges, and is sparser than BIC.
+	best := SelectEBIC(path, s, n, 0.5)
+	if bic := SelectEBIC(path, s, n, 0); best > bic {
+		t.Errorf("EBIC selected denser graph than BIC: %d > %d", best, bic)
+	}
+	e := path[best]
+	g := e.Graph()
+	if got := g.Nodes().Len(); got != p {
+		t.Errorf("unexpected number of nodes: got:%d want:%d", got, p)
+	}
+	minTrue := math.Inf(1)
+	maxFalse := 0.0
+	for i := 0; i < p; i++ {
+		for j := i + 1; j < p; j++ {
+			w, ok := g.Weight(int64(i), int64(j))
+			if ok {
+				pc := -e.Precision.At(i, j) / math.Sqrt(e.Precision.At(i, i)*e.Precision.At(j, j))
+				if w != pc {
+					t.Errorf("unexpected edge weight %d-%d: got:%v want:%v", i, j, w, pc)
+				}
+			}
+			if prec.At(i, j) == 0 {
+				maxFalse = math.Max(maxFalse, math.Abs(w))
+				continue
+			}
+			if w >= 0 {
+				t.Errorf("missing or wrongly signed edge %d-%d: %v", i, j, w)
+			}
+			minTrue = math.Min(minTrue, math.Abs(w))
+		}
+	}
+	if maxFalse >= minTrue {
+		t.Errorf("spurious edge stronger than true edge: %v >= %v", maxFalse, minTrue)
+	}
+}
+
+func TestNoConvergence(t *testing.T) {
+	t.Parallel()
+	_, s := chain(10, 50, rand.NewPCG(1, 1))
+	e, err := Fit(s, 0.01*maxOffDiag(s), &Settings{MaxIterations: 1, Tolerance: 1e-12})
+	if err != ErrNoConvergence {
+		t.Errorf("unexpected error: got:%v want:%v", err, ErrNoConvergence)
+	}
+	if e == nil || e.Iterations != 1 {
+		t.Error("expected last iterate")
+	}
+}
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	s := mat.NewSymDense(2, []float64{1, 0.5, 0.5, 1})
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "negative penalty", fn: func() { Fit(s, -1, nil) }},
+		{name: "one penalty", fn: func() { Penalties(s, 1, 0.1) }},
+		{name: "bad ratio", fn: func() { Penalties(s, 5, 1) }},
+		{name: "empty path", fn: func() { SelectEBIC(nil, s, 10, 0.5) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,22 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gp provides Gaussian process regression.
+//
+// A Gaussian process is a distribution over functions f such that the
+// values of f at any finite set of points have a joint normal distribution.
+// With zero mean, the process is specified by its kernel, the covariance
+// k(x, x′) between the values of f at x and x′. Regression with noisy
+// observations y_i = f(x_i) + ε_i, ε_i ~ N(0, σ²), gives a normal
+// predictive distribution for f at new points that is computed exactly
+// from the Cholesky factorization of the covariance of the observations.
+//
+// The kernels provided by the package are stationary, and can be combined
+// by sums and products. Their hyperparameters, together with the noise
+// variance, can be estimated by maximizing the marginal likelihood of the
+// observations.
+//
+// See Rasmussen and Williams, "Gaussian Processes for Machine Learning",
+// MIT Press, 2006, for an introduction.
+package gp // import "gonum.org/v1/gonum/stat/gp"
@@ -0,0 +1,54 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/gp"
+)
+
+func ExampleRegression() {
+	// Noisy observations of sin(x) at random points in [0, 2π).
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 30
+	x := mat.NewDense(n, 1, nil)
+	y := make([]float64, n)
+	for i := range y {
+		xi := 2 * math.Pi * rnd.Float64()
+		x.Set(i, 0, xi)
+		y[i] = math.Sin(xi) + 0.1*rnd.NormFloat64()
+	}
+
+	// Fit the hyperparameters of the kernel and the noise variance,
+	// starting from rough guesses.
+	r, err := gp.NewRegression(&gp.RBF{Variance: 1, LengthScale: 1}, 0.1, x, y)
+	if err != nil {
+		log.Fatal(err)
+	}
+	_, err = r.Optimize(nil, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	k := r.Kernel().(*gp.RBF)
+	fmt.Printf("length scale: %.2f\n", k.LengthScale)
+	fmt.Printf("noise standard deviation: %.3f\n", math.Sqrt(r.Noise()))
+
+	for _, xi := range []float64{math.Pi / 2, math.Pi, 3 * math.Pi / 2} {
+		mean, v := r.Predict([]float64{xi})
+		fmt.Printf("f(%.3f) = %6.3f ± %.3f (sin: %6.3f)\n", xi, mean, math.Sqrt(v), math.Sin(xi))
+	}
+
+	// Output:
+	// length scale: 1.77
+	// noise standard deviation: 0.095
+	// f(1.571) =  1.011 ± 0.044 (sin:  1.000)
+	// f(3.142) =  0.023 ± 0.037 (sin:  0.000)
+	// f(4.712) = -1.000 ± 0.042 (sin: -1.000)
+}
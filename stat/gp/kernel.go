@@ -0,0 +1,371 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// Kernel is a covariance function with hyperparameters.
+//
+// The hyperparameters of the kernels in this package are the logarithms
+// of their positive parameters, so that they can be optimized without
+// constraints.
+type Kernel interface {
+	// Cov returns the covariance between the values of
+	// the process at x and y.
+	Cov(x, y []float64) float64
+
+	// NumHyper returns the number of hyperparameters.
+	NumHyper() int
+
+	// Hyper stores the hyperparameters in dst and returns it.
+	// If dst is nil, a new slice is allocated.
+	Hyper(dst []float64) []float64
+
+	// SetHyper sets the hyperparameters to the values in h.
+	SetHyper(h []float64)
+
+	// CovGrad stores in dst the partial derivatives of
+	// Cov(x, y) with respect to the hyperparameters.
+	CovGrad(dst, x, y []float64)
+}
+
+// RBF is the radial basis function, or squared exponential, kernel
+//
+//	k(x, y) = σ² exp(-|x - y|² / 2ℓ²),
+//
+// whose sample functions are infinitely differentiable. The hyperparameters
+// are log σ² and log ℓ.
+type RBF struct {
+	// Variance is the variance σ² of the process.
+	Variance float64
+	// LengthScale is the length scale ℓ of variations of the process.
+	LengthScale float64
+}
+
+// Cov returns the covariance between the values of the process at x and y.
+func (k *RBF) Cov(x, y []float64) float64 {
+	return k.Variance * math.Exp(-sqDist(x, y)/(2*k.LengthScale*k.LengthScale))
+}
+
+// NumHyper returns 2.
+func (k *RBF) NumHyper() int { return 2 }
+
+// Hyper stores log σ² and log ℓ in dst and returns it.
+func (k *RBF) Hyper(dst []float64) []float64 {
+	return setHyper(dst, k.Variance, k.LengthScale)
+}
+
+// SetHyper sets σ² and ℓ to the exponentials of the elements of h.
+func (k *RBF) SetHyper(h []float64) {
+	checkHyper(h, 2)
+	k.Variance = math.Exp(h[0])
+	k.LengthScale = math.Exp(h[1])
+}
+
+// CovGrad stores the derivatives of Cov(x, y) with respect to log σ² and
+// log ℓ in dst.
+func (k *RBF) CovGrad(dst, x, y []float64) {
+	checkHyper(dst, 2)
+	r2 := sqDist(x, y) / (k.LengthScale * k.LengthScale)
+	c := k.Variance * math.Exp(-r2/2)
+	dst[0] = c
+	dst[1] = c * r2
+}
+
+// Matern is the Matérn kernel with smoothness ν of 1/2, 3/2 or 5/2,
+//
+//	k(x, y) = σ² exp(-a),                ν = 1/2,
+//	k(x, y) = σ² (1 + a) exp(-a),        ν = 3/2,
+//	k(x, y) = σ² (1 + a + a²/3) exp(-a), ν = 5/2,
+//
+// where a = √(2ν) |x - y| / ℓ. The sample functions are ⌈ν⌉-1 times
+// differentiable, and ν = 1/2 gives the Ornstein-Uhlenbeck process. The
+// hyperparameters are log σ² and log ℓ; the smoothness is fixed.
+type Matern struct {
+	// Nu is the smoothness ν of the process, which must be
+	// 0.5, 1.5 or 2.5.
+	Nu float64
+	// Variance is the variance σ² of the process.
+	Variance float64
+	// LengthScale is the length scale ℓ of variations of the process.
+	LengthScale float64
+}
+
+// Cov returns the covariance between the values of the process at x and y.
+// Cov panics if Nu is not 0.5, 1.5 or 2.5.
+func (k *Matern) Cov(x, y []float64) float64 {
+	a := k.scaled(x, y)
+	e := k.Variance * math.Exp(-a)
+	switch k.Nu {
+	case 0.5:
+		return e
+	case 1.5:
+		return (1 + a) * e
+	default:
+		return (1 + a + a*a/3) * e
+	}
+}
+
+// NumHyper returns 2.
+func (k *Matern) NumHyper() int { return 2 }
+
+// Hyper stores log σ² and log ℓ in dst and returns it.
+func (k *Matern) Hyper(dst []float64) []float64 {
+	return setHyper(dst, k.Variance, k.LengthScale)
+}
+
+// SetHyper sets σ² and ℓ to the exponentials of the elements of h.
+func (k *Matern) SetHyper(h []float64) {
+	checkHyper(h, 2)
+	k.Variance = math.Exp(h[0])
+	k.LengthScale = math.Exp(h[1])
+}
+
+// CovGrad stores the derivatives of Cov(x, y) with respect to log σ² and
+// log ℓ in dst. CovGrad panics if Nu is not 0.5, 1.5 or 2.5.
+func (k *Matern) CovGrad(dst, x, y []float64) {
+	checkHyper(dst, 2)
+	a := k.scaled(x, y)
+	e := k.Variance * math.Exp(-a)
+	// The derivative with respect to log ℓ is -a dk/da.
+	switch k.Nu {
+	case 0.5:
+		dst[0] = e
+		dst[1] = a * e
+	case 1.5:
+		dst[0] = (1 + a) * e
+		dst[1] = a * a * e
+	default:
+		dst[0] = (1 + a + a*a/3) * e
+		dst[1] = a * a * (1 + a) / 3 * e
+	}
+}
+
+// scaled returns √(2ν) |x - y| / ℓ.
+func (k *Matern) scaled(x, y []float64) float64 {
+	var s float64
+	switch k.Nu {
+	case 0.5:
+		s = 1
+	case 1.5:
+		s = math.Sqrt(3)
+	case 2.5:
+		s = math.Sqrt(5)
+	default:
+		panic("gp: unsupported Matérn smoothness")
+	}
+	return s * math.Sqrt(sqDist(x, y)) / k.LengthScale
+}
+
+// Periodic is the periodic kernel
+//
+//	k(x, y) = σ² exp(-2 Σ_i sin²(π (x_i - y_i) / p) / ℓ²),
+//
+// whose sample functions repeat with period p along each coordinate. The
+// hyperparameters are log σ², log ℓ and log p.
+type Periodic struct {
+	// Variance is the variance σ² of the process.
+	Variance float64
+	// LengthScale is the length scale ℓ of variations of the
+	// process within a period.
+	LengthScale float64
+	// Period is the period p of the process.
+	Period float64
+}
+
+// Cov returns the covariance between the values of the process at x and y.
+func (k *Periodic) Cov(x, y []float64) float64 {
+	if len(x) != len(y) {
+		panic("gp: mismatched dimensions")
+	}
+	var s2 float64
+	for i, v := range x {
+		s := math.Sin(math.Pi * (v - y[i]) / k.Period)
+		s2 += s * s
+	}
+	return k.Variance * math.Exp(-2*s2/(k.LengthScale*k.LengthScale))
+}
+
+// NumHyper returns 3.
+func (k *Periodic) NumHyper() int { return 3 }
+
+// Hyper stores log σ², log ℓ and log p in dst and returns it.
+func (k *Periodic) Hyper(dst []float64) []float64 {
+	return setHyper(dst, k.Variance, k.LengthScale, k.Period)
+}
+
+// SetHyper sets σ², ℓ and p to the exponentials of the elements of h.
+func (k *Periodic) SetHyper(h []float64) {
+	checkHyper(h, 3)
+	k.Variance = math.Exp(h[0])
+	k.LengthScale = math.Exp(h[1])
+	k.Period = math.Exp(h[2])
+}
+
+// CovGrad stores the derivatives of Cov(x, y) with respect to log σ²,
+// log ℓ and log p in dst.
+func (k *Periodic) CovGrad(dst, x, y []float64) {
+	checkHyper(dst, 3)
+	if len(x) != len(y) {
+		panic("gp: mismatched dimensions")
+	}
+	l2 := k.LengthScale * k.LengthScale
+	var s2, sc float64
+	for i, v := range x {
+		d := math.Pi * (v - y[i]) / k.Period
+		s, c := math.Sincos(d)
+		s2 += s * s
+		sc += s * c * d
+	}
+	cov := k.Variance * math.Exp(-2*s2/l2)
+	dst[0] = cov
+	dst[1] = cov * 4 * s2 / l2
+	dst[2] = cov * 4 * sc / l2
+}
+
+// Sum is the sum of kernels, the covariance of the sum of independent
+// processes. Its hyperparameters are those of the kernels in order.
+type Sum []Kernel
+
+// Cov returns the sum of the covariances of the kernels.
+func (k Sum) Cov(x, y []float64) float64 {
+	var c float64
+	for _, kern := range k {
+		c += kern.Cov(x, y)
+	}
+	return c
+}
+
+// NumHyper returns the total number of hyperparameters of the kernels.
+func (k Sum) NumHyper() int { return numHyper(k) }
+
+// Hyper stores the hyperparameters of the kernels in dst and returns it.
+func (k Sum) Hyper(dst []float64) []float64 { return hyper(k, dst) }
+
+// SetHyper sets the hyperparameters of the kernels.
+func (k Sum) SetHyper(h []float64) { setKernels(k, h) }
+
+// CovGrad stores the derivatives of the covariance with respect to the
+// hyperparameters of the kernels in dst.
+func (k Sum) CovGrad(dst, x, y []float64) {
+	checkHyper(dst, k.NumHyper())
+	for _, kern := range k {
+		n := kern.NumHyper()
+		kern.CovGrad(dst[:n], x, y)
+		dst = dst[n:]
+	}
+}
+
+// Product is the product of kernels, the covariance of the product of
+// independent processes. Its hyperparameters are those of the kernels in
+// order.
+type Product []Kernel
+
+// Cov returns the product of the covariances of the kernels.
+func (k Product) Cov(x, y []float64) float64 {
+	c := 1.0
+	for _, kern := range k {
+		c *= kern.Cov(x, y)
+	}
+	return c
+}
+
+// NumHyper returns the total number of hyperparameters of the kernels.
+func (k Product) NumHyper() int { return numHyper(k) }
+
+// Hyper stores the hyperparameters of the kernels in dst and returns it.
+func (k Product) Hyper(dst []float64) []float64 { return hyper(k, dst) }
+
+// SetHyper sets the hyperparameters of the kernels.
+func (k Product) SetHyper(h []float64) { setKernels(k, h) }
+
+// CovGrad stores the derivatives of the covariance with respect to the
+// hyperparameters of the kernels in dst.
+func (k Product) CovGrad(dst, x, y []float64) {
+	checkHyper(dst, k.NumHyper())
+	covs := make([]float64, len(k))
+	for i, kern := range k {
+		covs[i] = kern.Cov(x, y)
+	}
+	for i, kern := range k {
+		n := kern.NumHyper()
+		d := dst[:n]
+		kern.CovGrad(d, x, y)
+		// The derivative of the product is the derivative of
+		// the factor times the product of the other factors.
+		for j, c := range covs {
+			if j != i {
+				floats.Scale(c, d)
+			}
+		}
+		dst = dst[n:]
+	}
+}
+
+func numHyper(kernels []Kernel) int {
+	var n int
+	for _, k := range kernels {
+		n += k.NumHyper()
+	}
+	return n
+}
+
+func hyper(kernels []Kernel, dst []float64) []float64 {
+	n := numHyper(kernels)
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	checkHyper(dst, n)
+	h := dst
+	for _, k := range kernels {
+		m := k.NumHyper()
+		k.Hyper(h[:m])
+		h = h[m:]
+	}
+	return dst
+}
+
+func setKernels(kernels []Kernel, h []float64) {
+	checkHyper(h, numHyper(kernels))
+	for _, k := range kernels {
+		m := k.NumHyper()
+		k.SetHyper(h[:m])
+		h = h[m:]
+	}
+}
+
+// setHyper stores the logarithms of params in dst, allocating it if nil.
+func setHyper(dst []float64, params ...float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(params))
+	}
+	checkHyper(dst, len(params))
+	for i, p := range params {
+		dst[i] = math.Log(p)
+	}
+	return dst
+}
+
+func checkHyper(h []float64, n int) {
+	if len(h) != n {
+		panic("gp: wrong number of hyperparameters")
+	}
+}
+
+// sqDist returns the squared Euclidean distance between x and y.
+func sqDist(x, y []float64) float64 {
+	if len(x) != len(y) {
+		panic("gp: mismatched dimensions")
+	}
+	var d float64
+	for i, v := range x {
+		d += (v - y[i]) * (v - y[i])
+	}
+	return d
+}
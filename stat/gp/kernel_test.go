@@ -0,0 +1,176 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// kernels returns a new set of kernels with the given names.
+func kernels() []struct {
+	name   string
+	kernel Kernel
+} {
+	return []struct {
+		name   string
+		kernel Kernel
+	}{
+		{name: "rbf", kernel: &RBF{Variance: 2, LengthScale: 0.7}},
+		{name: "matern 1/2", kernel: &Matern{Nu: 0.5, Variance: 1.5, LengthScale: 1.3}},
+		{name: "matern 3/2", kernel: &Matern{Nu: 1.5, Variance: 0.5, LengthScale: 0.9}},
+		{name: "matern 5/2", kernel: &Matern{Nu: 2.5, Variance: 1, LengthScale: 2}},
+		{name: "periodic", kernel: &Periodic{Variance: 1.2, LengthScale: 0.8, Period: 1.7}},
+		{name: "sum", kernel: Sum{&RBF{Variance: 2, LengthScale: 3}, &Periodic{Variance: 0.5, LengthScale: 1, Period: 0.9}}},
+		{name: "product", kernel: Product{
+			&RBF{Variance: 2, LengthScale: 3},
+			&Periodic{Variance: 0.5, LengthScale: 1, Period: 0.9},
+			&Matern{Nu: 1.5, Variance: 1, LengthScale: 1.1},
+		}},
+		{name: "nested", kernel: Sum{
+			Product{&RBF{Variance: 1, LengthScale: 2}, &Periodic{Variance: 1, LengthScale: 0.5, Period: 1}},
+			&Matern{Nu: 0.5, Variance: 0.1, LengthScale: 0.3},
+		}},
+	}
+}
+
+func TestKernelValues(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, test := range []struct {
+		name   string
+		kernel Kernel
+		x, y   []float64
+		want   float64
+	}{
+		{name: "rbf", kernel: &RBF{Variance: 2, LengthScale: 5}, want: 2 * math.Exp(-0.5)},
+		{name: "matern 1/2", kernel: &Matern{Nu: 0.5, Variance: 2, LengthScale: 5}, want: 2 * math.Exp(-1)},
+		{name: "matern 3/2", kernel: &Matern{Nu: 1.5, Variance: 2, LengthScale: 5}, want: 2 * (1 + math.Sqrt(3)) * math.Exp(-math.Sqrt(3))},
+		{name: "matern 5/2", kernel: &Matern{Nu: 2.5, Variance: 2, LengthScale: 5}, want: 2 * (1 + math.Sqrt(5) + 5.0/3) * math.Exp(-math.Sqrt(5))},
+		{name: "periodic", kernel: &Periodic{Variance: 2, LengthScale: 1, Period: 20}, x: []float64{1}, y: []float64{6}, want: 2 * math.Exp(-1)},
+		{name: "periodic full period", kernel: &Periodic{Variance: 2, LengthScale: 1, Period: 2.5}, x: []float64{1}, y: []float64{6}, want: 2},
+		{name: "periodic plane", kernel: &Periodic{Variance: 2, LengthScale: 1, Period: 12}, want: 2 * math.Exp(-2*(0.5+0.75))},
+		{name: "sum", kernel: Sum{&RBF{Variance: 2, LengthScale: 5}, &Matern{Nu: 0.5, Variance: 1, LengthScale: 5}}, want: 2*math.Exp(-0.5) + math.Exp(-1)},
+		{name: "product", kernel: Product{&RBF{Variance: 2, LengthScale: 5}, &Matern{Nu: 0.5, Variance: 3, LengthScale: 5}}, want: 6 * math.Exp(-1.5)},
+	} {
+		x, y := test.x, test.y
+		if x == nil {
+			x = []float64{1, 2}
+			y = []float64{4, 6} // |x - y| = 5.
+		}
+		got := test.kernel.Cov(x, y)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, tol, tol) {
+			t.Errorf("unexpected covariance for %s kernel: got:%v want:%v", test.name, got, test.want)
+		}
+		if got := test.kernel.Cov(y, x); !scalar.EqualWithinAbsOrRel(got, test.want, tol, tol) {
+			t.Errorf("asymmetric covariance for %s kernel", test.name)
+		}
+	}
+}
+
+func TestKernelHyper(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range kernels() {
+		k := test.kernel
+		n := k.NumHyper()
+		h := k.Hyper(nil)
+		if len(h) != n {
+			t.Errorf("unexpected number of hyperparameters for %s kernel: got:%d want:%d", test.name, len(h), n)
+			continue
+		}
+		want := make([]float64, n)
+		for i := range want {
+			want[i] = rnd.NormFloat64()
+		}
+		k.SetHyper(want)
+		got := k.Hyper(make([]float64, n))
+		if !floats.EqualApprox(got, want, 1e-14) {
+			t.Errorf("unexpected hyperparameters for %s kernel: got:%v want:%v", test.name, got, want)
+		}
+		if !panics(func() { k.SetHyper(make([]float64, n+1)) }) {
+			t.Errorf("expected panic for wrong number of hyperparameters for %s kernel", test.name)
+		}
+	}
+}
+
+func TestKernelGrad(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-7
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range kernels() {
+		k := test.kernel
+		h := k.Hyper(nil)
+		for i := 0; i < 20; i++ {
+			x := []float64{rnd.NormFloat64(), rnd.NormFloat64()}
+			y := []float64{rnd.NormFloat64(), rnd.NormFloat64()}
+			if i == 0 {
+				copy(y, x)
+			}
+			got := make([]float64, len(h))
+			k.CovGrad(got, x, y)
+			want := fd.Gradient(nil, func(p []float64) float64 {
+				k.SetHyper(p)
+				defer k.SetHyper(h)
+				return k.Cov(x, y)
+			}, h, &fd.Settings{Formula: fd.Central})
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("unexpected gradient for %s kernel at %v, %v:\ngot: %v\nwant:%v", test.name, x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestKernelPosDef(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 30
+	x := mat.NewDense(n, 2, nil)
+	for i := 0; i < n; i++ {
+		x.Set(i, 0, 3*rnd.Float64())
+		x.Set(i, 1, 3*rnd.Float64())
+	}
+	for _, test := range kernels() {
+		k := mat.NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				k.SetSym(i, j, test.kernel.Cov(x.RawRowView(i), x.RawRowView(j)))
+			}
+		}
+		var eig mat.EigenSym
+		if !eig.Factorize(k, false) {
+			t.Fatalf("eigendecomposition failed for %s kernel", test.name)
+		}
+		vals := eig.Values(nil)
+		if vals[0] < -1e-10*vals[n-1] {
+			t.Errorf("covariance of %s kernel not positive semi-definite: minimum eigenvalue %v", test.name, vals[0])
+		}
+	}
+}
+
+func TestMaternPanics(t *testing.T) {
+	t.Parallel()
+	k := &Matern{Nu: 1, Variance: 1, LengthScale: 1}
+	if !panics(func() { k.Cov([]float64{0}, []float64{1}) }) {
+		t.Error("expected panic for unsupported smoothness")
+	}
+	if !panics(func() { (&RBF{Variance: 1, LengthScale: 1}).Cov([]float64{0}, []float64{1, 2}) }) {
+		t.Error("expected panic for mismatched dimensions")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
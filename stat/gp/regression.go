@@ -0,0 +1,250 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+var errNotPosDef = errors.New("gp: covariance not positive definite")
+
+// Regression is a zero mean Gaussian process conditioned on noisy
+// observations.
+type Regression struct {
+	kernel Kernel
+	noise  float64
+
+	x *mat.Dense
+	y *mat.VecDense
+
+	// chol is the Cholesky factorization of the covariance
+	// of the observations, K + σ²I, and alpha is (K + σ²I)⁻¹ y.
+	chol  mat.Cholesky
+	alpha *mat.VecDense
+}
+
+// NewRegression returns the Gaussian process with the given kernel
+// conditioned on the observations y at the points held in the rows of x,
+// with observation noise of the given variance. The process has zero mean,
+// so observations with a non-zero mean should be centered. The kernel is
+// retained by the returned Regression and must not be modified.
+//
+// NewRegression returns an error if the covariance of the observations is
+// not positive definite, which may happen for noise-free observations at
+// nearby points. NewRegression panics if the number of rows of x does not
+// match the length of y or if noise is negative.
+func NewRegression(kernel Kernel, noise float64, x mat.Matrix, y []float64) (*Regression, error) {
+	n, _ := x.Dims()
+	if n != len(y) {
+		panic("gp: mismatched number of observations")
+	}
+	if noise < 0 {
+		panic("gp: negative noise variance")
+	}
+	r := &Regression{
+		kernel: kernel,
+		noise:  noise,
+		x:      mat.DenseCopyOf(x),
+		y:      mat.NewVecDense(n, append([]float64(nil), y...)),
+	}
+	err := r.factorize()
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// factorize computes the Cholesky factorization of the covariance of the
+// observations and the weights of the predictive mean.
+func (r *Regression) factorize() error {
+	n, _ := r.x.Dims()
+	cov := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		xi := r.x.RawRowView(i)
+		for j := i; j < n; j++ {
+			cov.SetSym(i, j, r.kernel.Cov(xi, r.x.RawRowView(j)))
+		}
+		cov.SetSym(i, i, cov.At(i, i)+r.noise)
+	}
+	if !r.chol.Factorize(cov) {
+		return errNotPosDef
+	}
+	r.alpha = &mat.VecDense{}
+	r.chol.SolveVecTo(r.alpha, r.y)
+	return nil
+}
+
+// Kernel returns the kernel of the process.
+func (r *Regression) Kernel() Kernel {
+	return r.kernel
+}
+
+// Noise returns the variance of the observation noise.
+func (r *Regression) Noise() float64 {
+	return r.noise
+}
+
+// LogLikelihood returns the log marginal likelihood of the observations,
+//
+//	-yᵀ(K + σ²I)⁻¹y/2 - log|K + σ²I|/2 - n log(2π)/2,
+//
+// where K is the covariance of the process at the observed points.
+func (r *Regression) LogLikelihood() float64 {
+	n := r.y.Len()
+	return -0.5 * (mat.Dot(r.y, r.alpha) + r.chol.LogDet() + float64(n)*math.Log(2*math.Pi))
+}
+
+// Predict returns the mean and variance of the value of the process at x
+// given the observations. The variance is that of the process, and does not
+// include the observation noise. Predict panics if the length of x does not
+// match the dimension of the observed points.
+func (r *Regression) Predict(x []float64) (mean, variance float64) {
+	k := r.cross(mat.NewDense(1, len(x), x))
+	kv := k.RowView(0)
+	mean = mat.Dot(kv, r.alpha)
+	var v mat.VecDense
+	r.chol.SolveVecTo(&v, kv)
+	variance = r.kernel.Cov(x, x) - mat.Dot(kv, &v)
+	return mean, math.Max(variance, 0)
+}
+
+// PredictJoint returns the mean and covariance of the joint distribution
+// of the values of the process at the points held in the rows of x given
+// the observations. The covariance is that of the process, and does not
+// include the observation noise. PredictJoint panics if the number of
+// columns of x does not match the dimension of the observed points.
+func (r *Regression) PredictJoint(x mat.Matrix) (mean []float64, cov *mat.SymDense) {
+	m, _ := x.Dims()
+	xd := mat.DenseCopyOf(x)
+	k := r.cross(xd)
+	var mv mat.VecDense
+	mv.MulVec(k, r.alpha)
+
+	var v, kv mat.Dense
+	r.chol.SolveTo(&v, k.T())
+	kv.Mul(k, &v)
+	cov = mat.NewSymDense(m, nil)
+	for i := 0; i < m; i++ {
+		xi := xd.RawRowView(i)
+		for j := i; j < m; j++ {
+			cov.SetSym(i, j, r.kernel.Cov(xi, xd.RawRowView(j))-(kv.At(i, j)+kv.At(j, i))/2)
+		}
+	}
+	return mv.RawVector().Data, cov
+}
+
+// cross returns the covariance between the values of the process at the
+// rows of x and at the observed points.
+func (r *Regression) cross(x *mat.Dense) *mat.Dense {
+	m, c := x.Dims()
+	n, d := r.x.Dims()
+	if c != d {
+		panic("gp: mismatched dimensions")
+	}
+	k := mat.NewDense(m, n, nil)
+	for i := 0; i < m; i++ {
+		xi := x.RawRowView(i)
+		for j := 0; j < n; j++ {
+			k.Set(i, j, r.kernel.Cov(xi, r.x.RawRowView(j)))
+		}
+	}
+	return k
+}
+
+// Optimize sets the hyperparameters of the kernel and the noise variance to
+// those maximizing the log marginal likelihood of the observations, and
+// conditions the process on the observations with the new values. The
+// parameters are optimized in the coordinates of the kernel hyperparameters
+// followed by the logarithm of the noise variance, starting from their
+// current values.
+//
+// The negative log marginal likelihood is minimized by optimize.Minimize
+// with the given settings and method using its analytic gradient. If
+// settings is nil, the optimization stops when the infinity norm of the
+// gradient is below 1e-4. The returned result holds the optimal parameters
+// and the negative log marginal likelihood at them. The likelihood may have
+// multiple local maxima, particularly for periodic kernels, so the result
+// depends on the starting values.
+func (r *Regression) Optimize(settings *optimize.Settings, method optimize.Method) (*optimize.Result, error) {
+	nk := r.kernel.NumHyper()
+	init := make([]float64, nk+1)
+	r.kernel.Hyper(init[:nk])
+	init[nk] = math.Log(r.noise)
+
+	set := func(h []float64) error {
+		r.kernel.SetHyper(h[:nk])
+		r.noise = math.Exp(h[nk])
+		return r.factorize()
+	}
+	p := optimize.Problem{
+		Func: func(h []float64) float64 {
+			if set(h) != nil {
+				return math.Inf(1)
+			}
+			return -r.LogLikelihood()
+		},
+		Grad: func(grad, h []float64) {
+			if set(h) != nil {
+				for i := range grad {
+					grad[i] = math.NaN()
+				}
+				return
+			}
+			r.gradient(grad)
+			floats.Scale(-1, grad)
+		},
+	}
+	if settings == nil {
+		settings = &optimize.Settings{GradientThreshold: 1e-4}
+	}
+	res, err := optimize.Minimize(p, init, settings, method)
+	h := init
+	if res != nil {
+		h = res.X
+	}
+	if ferr := set(h); ferr != nil {
+		return res, ferr
+	}
+	return res, err
+}
+
+// gradient stores the gradient of the log marginal likelihood with respect
+// to the kernel hyperparameters and the log noise variance in dst,
+//
+//	∂L/∂θ = tr((ααᵀ - (K + σ²I)⁻¹) ∂K/∂θ)/2.
+func (r *Regression) gradient(dst []float64) {
+	n, _ := r.x.Dims()
+	nk := r.kernel.NumHyper()
+	var inv mat.SymDense
+	r.chol.InverseTo(&inv)
+
+	for i := range dst {
+		dst[i] = 0
+	}
+	dk := make([]float64, nk)
+	var trace float64
+	for i := 0; i < n; i++ {
+		xi := r.x.RawRowView(i)
+		ai := r.alpha.AtVec(i)
+		for j := i; j < n; j++ {
+			w := ai*r.alpha.AtVec(j) - inv.At(i, j)
+			r.kernel.CovGrad(dk, xi, r.x.RawRowView(j))
+			if i != j {
+				// Off-diagonal elements appear twice in the trace.
+				w *= 2
+			} else {
+				trace += w
+			}
+			floats.AddScaled(dst[:nk], w, dk)
+		}
+	}
+	dst[nk] = r.noise * trace
+	floats.Scale(0.5, dst)
+}
@@ -0,0 +1,258 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gp
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+// sample returns n points uniformly distributed in [0, width)^d and the
+// values at them of a sample function of a zero mean process with the
+// given kernel, observed with noise of the given variance.
+func sample(rnd *rand.Rand, kernel Kernel, noise float64, n, d int, width float64) (*mat.Dense, []float64) {
+	x := mat.NewDense(n, d, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < d; j++ {
+			x.Set(i, j, width*rnd.Float64())
+		}
+	}
+	cov := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			cov.SetSym(i, j, kernel.Cov(x.RawRowView(i), x.RawRowView(j)))
+		}
+		cov.SetSym(i, i, cov.At(i, i)+noise+1e-10)
+	}
+	dist, ok := distmv.NewNormal(make([]float64, n), cov, rnd)
+	if !ok {
+		panic("covariance not positive definite")
+	}
+	return x, dist.Rand(nil)
+}
+
+func TestRegressionPredict(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-8
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range kernels() {
+		const noise = 0.1
+		x, y := sample(rnd, test.kernel, noise, 15, 2, 3)
+		r, err := NewRegression(test.kernel, noise, x, y)
+		if err != nil {
+			t.Fatalf("unexpected error for %s kernel: %v", test.name, err)
+		}
+
+		// The predictive distribution is the conditional distribution
+		// of the process at the new points given the observations.
+		const m = 4
+		xs, _ := sample(rnd, test.kernel, noise, m, 2, 3)
+		all := mat.NewDense(15+m, 2, nil)
+		all.Slice(0, 15, 0, 2).(*mat.Dense).Copy(x)
+		all.Slice(15, 15+m, 0, 2).(*mat.Dense).Copy(xs)
+		cov := mat.NewSymDense(15+m, nil)
+		for i := 0; i < 15+m; i++ {
+			for j := i; j < 15+m; j++ {
+				cov.SetSym(i, j, test.kernel.Cov(all.RawRowView(i), all.RawRowView(j)))
+			}
+			if i < 15 {
+				cov.SetSym(i, i, cov.At(i, i)+noise)
+			}
+		}
+		dist, ok := distmv.NewNormal(make([]float64, 15+m), cov, nil)
+		if !ok {
+			t.Fatalf("unexpected non-positive definite covariance for %s kernel", test.name)
+		}
+		cond, ok := dist.ConditionNormal(indices(0, 15), y, nil)
+		if !ok {
+			t.Fatalf("conditioning failed for %s kernel", test.name)
+		}
+		wantMean := cond.Mean(nil)
+		var wantCov mat.SymDense
+		cond.CovarianceMatrix(&wantCov)
+
+		mean, got := r.PredictJoint(xs)
+		if !floats.EqualApprox(mean, wantMean, tol) {
+			t.Errorf("unexpected joint mean for %s kernel:\ngot: %v\nwant:%v", test.name, mean, wantMean)
+		}
+		if !mat.EqualApprox(got, &wantCov, tol) {
+			t.Errorf("unexpected joint covariance for %s kernel", test.name)
+		}
+		for i := 0; i < m; i++ {
+			mu, v := r.Predict(xs.RawRowView(i))
+			if !scalar.EqualWithinAbsOrRel(mu, wantMean[i], tol, tol) || !scalar.EqualWithinAbsOrRel(v, wantCov.At(i, i), tol, tol) {
+				t.Errorf("unexpected prediction %d for %s kernel: got:(%v, %v) want:(%v, %v)", i, test.name, mu, v, wantMean[i], wantCov.At(i, i))
+			}
+		}
+
+		obs, ok := distmv.NewNormal(make([]float64, 15), cov.SliceSym(0, 15), nil)
+		if !ok {
+			t.Fatalf("unexpected non-positive definite covariance for %s kernel", test.name)
+		}
+		if ll := r.LogLikelihood(); !scalar.EqualWithinAbsOrRel(ll, obs.LogProb(y), tol, tol) {
+			t.Errorf("unexpected log likelihood for %s kernel: got:%v want:%v", test.name, ll, obs.LogProb(y))
+		}
+	}
+}
+
+// indices returns the integers in [from, to).
+func indices(from, to int) []int {
+	idx := make([]int, to-from)
+	for i := range idx {
+		idx[i] = from + i
+	}
+	return idx
+}
+
+func TestRegressionInterpolate(t *testing.T) {
+	t.Parallel()
+	// With negligible noise the predictive mean interpolates the
+	// observations and the variance vanishes at the observed points.
+	x := mat.NewDense(5, 1, []float64{0, 1, 2, 3, 4})
+	y := []float64{1, -1, 0.5, 2, 0}
+	r, err := NewRegression(&Matern{Nu: 2.5, Variance: 1, LengthScale: 1}, 1e-12, x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, want := range y {
+		mean, v := r.Predict(x.RawRowView(i))
+		if math.Abs(mean-want) > 1e-8 || v > 1e-8 {
+			t.Errorf("unexpected prediction at observed point %d: got:(%v, %v) want:(%v, 0)", i, mean, v, want)
+		}
+	}
+	// Far from the observations the prediction reverts to the prior.
+	mean, v := r.Predict([]float64{100})
+	if math.Abs(mean) > 1e-10 || math.Abs(v-1) > 1e-10 {
+		t.Errorf("unexpected prediction far from observations: got:(%v, %v) want:(0, 1)", mean, v)
+	}
+}
+
+func TestRegressionGradient(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range kernels() {
+		const noise = 0.2
+		x, y := sample(rnd, test.kernel, noise, 12, 2, 3)
+		r, err := NewRegression(test.kernel, noise, x, y)
+		if err != nil {
+			t.Fatalf("unexpected error for %s kernel: %v", test.name, err)
+		}
+		nk := test.kernel.NumHyper()
+		h := append(test.kernel.Hyper(nil), math.Log(noise))
+		got := make([]float64, nk+1)
+		r.gradient(got)
+
+		want := fd.Gradient(nil, func(p []float64) float64 {
+			k := test.kernel
+			old := k.Hyper(nil)
+			defer k.SetHyper(old)
+			k.SetHyper(p[:nk])
+			r, err := NewRegression(k, math.Exp(p[nk]), x, y)
+			if err != nil {
+				panic(err)
+			}
+			return r.LogLikelihood()
+		}, h, &fd.Settings{Formula: fd.Central})
+		if !floats.EqualApprox(got, want, 1e-5) {
+			t.Errorf("unexpected gradient for %s kernel:\ngot: %v\nwant:%v", test.name, got, want)
+		}
+	}
+}
+
+func TestRegressionOptimize(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name  string
+		truth Kernel
+		init  Kernel
+		noise float64
+		d     int
+		width float64
+
+		initNoise float64
+	}{
+		{
+			name:  "rbf",
+			truth: &RBF{Variance: 1, LengthScale: 0.5},
+			init:  &RBF{Variance: 0.2, LengthScale: 2},
+			noise: 0.01, d: 1, width: 10,
+			initNoise: 1,
+		},
+		{
+			name:  "matern",
+			truth: &Matern{Nu: 1.5, Variance: 2, LengthScale: 1},
+			init:  &Matern{Nu: 1.5, Variance: 1, LengthScale: 0.3},
+			noise: 0.05, d: 2, width: 8,
+			initNoise: 1,
+		},
+		{
+			name:  "periodic",
+			truth: &Periodic{Variance: 1, LengthScale: 1, Period: 2},
+			init:  &Periodic{Variance: 0.5, LengthScale: 0.7, Period: 2.1},
+			noise: 0.01, d: 1, width: 10,
+			initNoise: 0.1,
+		},
+	} {
+		x, y := sample(rnd, test.truth, test.noise, 150, test.d, test.width)
+		r, err := NewRegression(test.init, test.initNoise, x, y)
+		if err != nil {
+			t.Fatalf("unexpected error for %s kernel: %v", test.name, err)
+		}
+		before := r.LogLikelihood()
+		res, err := r.Optimize(nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error optimizing %s kernel: %v", test.name, err)
+		}
+		after := r.LogLikelihood()
+		if after <= before || !scalar.EqualWithinAbsOrRel(after, -res.F, 1e-10, 1e-10) {
+			t.Errorf("unexpected log likelihood for %s kernel: before:%v after:%v result:%v", test.name, before, after, -res.F)
+		}
+
+		got := r.Kernel().Hyper(nil)
+		want := test.truth.Hyper(nil)
+		for i, v := range got {
+			// The hyperparameters are logarithms, so this compares
+			// the parameters to within a factor of 2.
+			if math.Abs(v-want[i]) > math.Log(2) {
+				t.Errorf("unexpected parameter %d for %s kernel: got:%v want:%v", i, test.name, math.Exp(v), math.Exp(want[i]))
+			}
+		}
+		if math.Abs(math.Log(r.Noise()/test.noise)) > math.Log(2) {
+			t.Errorf("unexpected noise for %s kernel: got:%v want:%v", test.name, r.Noise(), test.noise)
+		}
+	}
+}
+
+func TestNewRegressionPanics(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(3, 1, []float64{0, 1, 2})
+	k := &RBF{Variance: 1, LengthScale: 1}
+	if !panics(func() { NewRegression(k, 0.1, x, []float64{1, 2}) }) {
+		t.Error("expected panic for mismatched observations")
+	}
+	if !panics(func() { NewRegression(k, -1, x, []float64{1, 2, 3}) }) {
+		t.Error("expected panic for negative noise")
+	}
+	r, err := NewRegression(k, 0.1, x, []float64{1, 2, 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !panics(func() { r.Predict([]float64{1, 2}) }) {
+		t.Error("expected panic for mismatched dimension")
+	}
+
+	dup := mat.NewDense(2, 1, []float64{1, 1})
+	if _, err := NewRegression(k, 0, dup, []float64{1, 2}); err == nil {
+		t.Error("expected error for singular covariance")
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hmm provides hidden Markov models.
+//
+// A hidden Markov model describes a sequence of observations emitted by
+// a sequence of hidden states that form a Markov chain. The probability of
+// an observation sequence and the posterior probabilities of the states are
+// computed by the forward-backward algorithm, the most probable sequence of
+// states by the Viterbi algorithm, and the parameters of a model may be
+// estimated from observation sequences by the Baum-Welch algorithm. The
+// emission distributions of the states are provided by the distuv and
+// distmv packages.
+//
+// See Rabiner, "A tutorial on hidden Markov models and selected
+// applications in speech recognition", Proceedings of the IEEE 77(2), 1989,
+// for an introduction.
+package hmm // import "gonum.org/v1/gonum/stat/hmm"
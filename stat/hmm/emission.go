@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmm
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Emission is the set of emission distributions of the states of a model.
+// Observations are held in slices, which have length one for univariate
+// distributions.
+type Emission interface {
+	// Len returns the number of states.
+	Len() int
+
+	// LogProb returns the log probability density of the
+	// observation x in the given state.
+	LogProb(state int, x []float64) float64
+}
+
+// Fitter is an Emission whose distributions can be estimated from data.
+type Fitter interface {
+	Emission
+
+	// Fit sets the parameters of the emission distribution of the
+	// given state to their maximum likelihood estimates from the
+	// observations held in the rows of x with the given weights.
+	Fit(state int, x mat.Matrix, weights []float64)
+}
+
+// Discrete holds categorical emission distributions over the symbols
+// 0, 1, ..., n-1, one for each state.
+type Discrete []distuv.Categorical
+
+// Len returns the number of states.
+func (e Discrete) Len() int { return len(e) }
+
+// LogProb returns the log probability of the symbol x[0] in the given
+// state.
+func (e Discrete) LogProb(state int, x []float64) float64 {
+	return e[state].LogProb(x[0])
+}
+
+// Fit sets the probabilities of the symbols in the given state to their
+// weighted frequencies in the first column of x. If the total weight is
+// zero, the distribution is unchanged.
+func (e Discrete) Fit(state int, x mat.Matrix, weights []float64) {
+	c := e[state]
+	counts := make([]float64, c.Len())
+	var sum float64
+	for i, w := range weights {
+		v := x.At(i, 0)
+		j := int(v)
+		if float64(j) != v || j < 0 || j >= len(counts) {
+			continue
+		}
+		counts[j] += w
+		sum += w
+	}
+	if sum > 0 {
+		c.ReweightAll(counts)
+	}
+}
+
+// Univariate holds univariate emission distributions, one for each state.
+// Distributions that have a Fit(samples, weights []float64) method, such
+// as *distuv.Normal, are estimated by Fit.
+type Univariate []distuv.LogProber
+
+// Len returns the number of states.
+func (e Univariate) Len() int { return len(e) }
+
+// LogProb returns the log probability density of x[0] in the given state.
+func (e Univariate) LogProb(state int, x []float64) float64 {
+	return e[state].LogProb(x[0])
+}
+
+// Fit fits the distribution of the given state to the first column of x
+// with the given weights if the distribution has a Fit method, and leaves
+// it unchanged otherwise. Fit also leaves the distribution unchanged if the
+// total weight is zero.
+func (e Univariate) Fit(state int, x mat.Matrix, weights []float64) {
+	f, ok := e[state].(interface {
+		Fit(samples, weights []float64)
+	})
+	if !ok || sum(weights) == 0 {
+		return
+	}
+	r, _ := x.Dims()
+	samples := make([]float64, r)
+	mat.Col(samples, 0, x)
+	f.Fit(samples, weights)
+}
+
+// Multivariate holds multivariate emission distributions, one for each
+// state. Distributions of type *distmv.Normal are estimated by Fit.
+type Multivariate []distmv.LogProber
+
+// Len returns the number of states.
+func (e Multivariate) Len() int { return len(e) }
+
+// LogProb returns the log probability density of x in the given state.
+func (e Multivariate) LogProb(state int, x []float64) float64 {
+	return e[state].LogProb(x)
+}
+
+// Fit replaces the distribution of the given state with the normal
+// distribution with the weighted mean and covariance of the rows of x if
+// the distribution is a *distmv.Normal, and leaves it unchanged otherwise.
+// The new distribution uses the global random source. Fit also leaves the
+// distribution unchanged if the total weight is zero or if the weighted
+// covariance is not positive definite.
+func (e Multivariate) Fit(state int, x mat.Matrix, weights []float64) {
+	if _, ok := e[state].(*distmv.Normal); !ok {
+		return
+	}
+	w := sum(weights)
+	if w == 0 {
+		return
+	}
+	r, c := x.Dims()
+	mean := make([]float64, c)
+	for i := 0; i < r; i++ {
+		for j := range mean {
+			mean[j] += weights[i] * x.At(i, j)
+		}
+	}
+	for j := range mean {
+		mean[j] /= w
+	}
+	cov := mat.NewSymDense(c, nil)
+	d := mat.NewVecDense(c, nil)
+	for i := 0; i < r; i++ {
+		for j := range mean {
+			d.SetVec(j, x.At(i, j)-mean[j])
+		}
+		cov.SymRankOne(cov, weights[i]/w, d)
+	}
+	n, ok := distmv.NewNormal(mean, cov, nil)
+	if ok {
+		e[state] = n
+	}
+}
+
+// sum returns the sum of the elements of s.
+func sum(s []float64) float64 {
+	var v float64
+	for _, x := range s {
+		v += x
+	}
+	return v
+}
+
+// logProbs stores in dst the log probabilities of each observation in the
+// rows of obs in each state and returns the largest log probability of each
+// observation.
+func logProbs(dst *mat.Dense, e Emission, obs mat.Matrix) []float64 {
+	t, c := obs.Dims()
+	n := e.Len()
+	x := make([]float64, c)
+	max := make([]float64, t)
+	for i := 0; i < t; i++ {
+		mat.Row(x, i, obs)
+		max[i] = math.Inf(-1)
+		for j := 0; j < n; j++ {
+			lp := e.LogProb(j, x)
+			dst.Set(i, j, lp)
+			max[i] = math.Max(max[i], lp)
+		}
+	}
+	return max
+}
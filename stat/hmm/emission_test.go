@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmm
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distmv"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestDiscreteFit(t *testing.T) {
+	t.Parallel()
+	e := Discrete{
+		distuv.NewCategorical([]float64{1, 1, 1}, nil),
+		distuv.NewCategorical([]float64{1, 2, 3}, nil),
+	}
+	x := mat.NewDense(5, 1, []float64{0, 2, 2, 1, 2})
+	e.Fit(0, x, []float64{1, 0.5, 0.5, 2, 0})
+	for i, want := range []float64{0.25, 0.5, 0.25} {
+		if got := e[0].Prob(float64(i)); math.Abs(got-want) > 1e-14 {
+			t.Errorf("unexpected probability of %d: got:%v want:%v", i, got, want)
+		}
+	}
+	e.Fit(1, x, make([]float64, 5))
+	for i, want := range []float64{1.0 / 6, 2.0 / 6, 3.0 / 6} {
+		if got := e[1].Prob(float64(i)); math.Abs(got-want) > 1e-14 {
+			t.Errorf("unexpected probability of %d after zero weight fit: got:%v want:%v", i, got, want)
+		}
+	}
+}
+
+func TestUnivariateFit(t *testing.T) {
+	t.Parallel()
+	e := Univariate{
+		&distuv.Normal{Mu: 0, Sigma: 1},
+		distuv.Exponential{Rate: 1},
+	}
+	x := mat.NewDense(4, 1, []float64{1, 2, 4, 7})
+	w := []float64{1, 2, 1, 0.5}
+	e.Fit(0, x, w)
+	mean, std := stat.MeanStdDev(x.RawMatrix().Data, w)
+	// The fit is the maximum likelihood estimate.
+	std *= math.Sqrt((floats.Sum(w) - 1) / floats.Sum(w))
+	n := e[0].(*distuv.Normal)
+	if math.Abs(n.Mu-mean) > 1e-14 || math.Abs(n.Sigma-std) > 1e-14 {
+		t.Errorf("unexpected fit: got:N(%v, %v) want:N(%v, %v)", n.Mu, n.Sigma, mean, std)
+	}
+	// Distributions without a Fit method are unchanged.
+	e.Fit(1, x, w)
+	if e[1].(distuv.Exponential).Rate != 1 {
+		t.Error("unexpected change of distribution without Fit method")
+	}
+}
+
+func TestMultivariateFit(t *testing.T) {
+	t.Parallel()
+	n, _ := distmv.NewNormal([]float64{0, 0}, mat.NewSymDense(2, []float64{1, 0, 0, 1}), nil)
+	e := Multivariate{n}
+	x := mat.NewDense(4, 2, []float64{
+		1, 2,
+		3, 1,
+		0, 0,
+		2, 5,
+	})
+	w := []float64{1, 2, 0.5, 1.5}
+	e.Fit(0, x, w)
+
+	wantMean := make([]float64, 2)
+	for i := range wantMean {
+		wantMean[i] = stat.Mean(mat.Col(nil, i, x), w)
+	}
+	var wantCov mat.SymDense
+	stat.CovarianceMatrix(&wantCov, x, w)
+	// stat.CovarianceMatrix is unbiased for frequency weights, while Fit
+	// returns the maximum likelihood estimate.
+	wantCov.ScaleSym((floats.Sum(w)-1)/floats.Sum(w), &wantCov)
+
+	got := e[0].(*distmv.Normal)
+	if !floats.EqualApprox(got.Mean(nil), wantMean, 1e-14) {
+		t.Errorf("unexpected mean: got:%v want:%v", got.Mean(nil), wantMean)
+	}
+	var cov mat.SymDense
+	got.CovarianceMatrix(&cov)
+	if !mat.EqualApprox(&cov, &wantCov, 1e-14) {
+		t.Errorf("unexpected covariance:\ngot:\n%v\nwant:\n%v", mat.Formatted(&cov), mat.Formatted(&wantCov))
+	}
+
+	// Degenerate data leave the distribution unchanged.
+	e.Fit(0, mat.NewDense(2, 2, []float64{1, 1, 2, 2}), []float64{1, 1})
+	if e[0] != got {
+		t.Error("unexpected change of distribution for singular covariance")
+	}
+}
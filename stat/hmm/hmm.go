@@ -0,0 +1,348 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmm
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var errZeroProb = errors.New("hmm: observation sequence has zero probability")
+
+// Model is a hidden Markov model with n states.
+type Model struct {
+	// Init holds the probabilities of the states at the
+	// first time.
+	Init []float64
+
+	// Trans is the n×n matrix of transition probabilities,
+	// with element i, j the probability of a transition
+	// from state i to state j.
+	Trans *mat.Dense
+
+	// Emission holds the emission distributions of the states.
+	Emission Emission
+}
+
+// Len returns the number of states of the model. Len panics with
+// mat.ErrShape if the dimensions of the model parameters do not match.
+func (m *Model) Len() int {
+	n := len(m.Init)
+	r, c := m.Trans.Dims()
+	if r != n || c != n || m.Emission.Len() != n {
+		panic(mat.ErrShape)
+	}
+	return n
+}
+
+// LogLikelihood returns the log probability of the sequence of observations
+// held in the rows of obs. The result is -Inf if the sequence is impossible
+// under the model.
+func (m *Model) LogLikelihood(obs mat.Matrix) float64 {
+	f := m.forward(obs)
+	return f.logLike
+}
+
+// Posterior returns the posterior probabilities of the states at each time
+// given the sequence of observations held in the rows of obs, with element
+// t, i of the returned matrix the probability of state i at time t, and the
+// log probability of the sequence. Posterior returns an error if the
+// sequence is impossible under the model.
+func (m *Model) Posterior(obs mat.Matrix) (*mat.Dense, float64, error) {
+	f := m.forward(obs)
+	if math.IsInf(f.logLike, -1) {
+		return nil, f.logLike, errZeroProb
+	}
+	beta := m.backward(f)
+	var gamma mat.Dense
+	gamma.MulElem(f.alpha, beta)
+	return &gamma, f.logLike, nil
+}
+
+// Viterbi returns the most probable sequence of states given the sequence of
+// observations held in the rows of obs and its joint log probability with
+// the observations. Viterbi returns an error if the sequence is impossible
+// under the model.
+func (m *Model) Viterbi(obs mat.Matrix) ([]int, float64, error) {
+	n := m.Len()
+	t, _ := obs.Dims()
+	if t == 0 {
+		panic("hmm: empty observation sequence")
+	}
+	lp := mat.NewDense(t, n, nil)
+	logProbs(lp, m.Emission, obs)
+	logTrans := mat.NewDense(n, n, nil)
+	logTrans.Apply(func(_, _ int, v float64) float64 { return math.Log(v) }, m.Trans)
+
+	delta := make([]float64, n)
+	next := make([]float64, n)
+	back := make([][]int, t)
+	for j := range delta {
+		delta[j] = math.Log(m.Init[j]) + lp.At(0, j)
+	}
+	for s := 1; s < t; s++ {
+		back[s] = make([]int, n)
+		for j := 0; j < n; j++ {
+			best := math.Inf(-1)
+			arg := 0
+			for i, d := range delta {
+				if v := d + logTrans.At(i, j); v > best {
+					best = v
+					arg = i
+				}
+			}
+			next[j] = best + lp.At(s, j)
+			back[s][j] = arg
+		}
+		delta, next = next, delta
+	}
+
+	last := floats.MaxIdx(delta)
+	logProb := delta[last]
+	if math.IsInf(logProb, -1) {
+		return nil, logProb, errZeroProb
+	}
+	path := make([]int, t)
+	path[t-1] = last
+	for s := t - 1; s > 0; s-- {
+		path[s-1] = back[s][path[s]]
+	}
+	return path, logProb, nil
+}
+
+// forwardResult holds the scaled forward variables of a sequence.
+type forwardResult struct {
+	// lp holds the emission probabilities of the observations
+	// in each state, scaled by the largest at each time.
+	lp *mat.Dense
+	// alpha holds the forward probabilities normalized to sum
+	// to one at each time, and scale holds the normalizing
+	// factors.
+	alpha *mat.Dense
+	scale []float64
+
+	logLike float64
+}
+
+// forward runs the scaled forward algorithm over obs.
+func (m *Model) forward(obs mat.Matrix) forwardResult {
+	n := m.Len()
+	t, _ := obs.Dims()
+	if t == 0 {
+		panic("hmm: empty observation sequence")
+	}
+	b := mat.NewDense(t, n, nil)
+	max := logProbs(b, m.Emission, obs)
+	f := forwardResult{
+		lp:    b,
+		alpha: mat.NewDense(t, n, nil),
+		scale: make([]float64, t),
+	}
+	for s := 0; s < t; s++ {
+		if math.IsInf(max[s], -1) {
+			f.logLike = math.Inf(-1)
+			return f
+		}
+		for j := 0; j < n; j++ {
+			b.Set(s, j, math.Exp(b.At(s, j)-max[s]))
+		}
+	}
+
+	for s := 0; s < t; s++ {
+		row := f.alpha.RawRowView(s)
+		if s == 0 {
+			copy(row, m.Init)
+		} else {
+			mat.NewVecDense(n, row).MulVec(m.Trans.T(), f.alpha.RowView(s-1))
+		}
+		floats.Mul(row, b.RawRowView(s))
+		c := floats.Sum(row)
+		if c == 0 {
+			f.logLike = math.Inf(-1)
+			return f
+		}
+		floats.Scale(1/c, row)
+		f.scale[s] = c
+		f.logLike += math.Log(c) + max[s]
+	}
+	return f
+}
+
+// backward returns the scaled backward variables for the forward
+// variables f, normalized so that their elementwise product with the
+// scaled forward variables gives the posterior state probabilities.
+func (m *Model) backward(f forwardResult) *mat.Dense {
+	t, n := f.alpha.Dims()
+	beta := mat.NewDense(t, n, nil)
+	for j := 0; j < n; j++ {
+		beta.Set(t-1, j, 1)
+	}
+	tmp := make([]float64, n)
+	for s := t - 2; s >= 0; s-- {
+		copy(tmp, beta.RawRowView(s+1))
+		floats.Mul(tmp, f.lp.RawRowView(s+1))
+		row := mat.NewVecDense(n, beta.RawRowView(s))
+		row.MulVec(m.Trans, mat.NewVecDense(n, tmp))
+		row.ScaleVec(1/f.scale[s+1], row)
+	}
+	return beta
+}
+
+// BaumWelchSettings holds settings for BaumWelch.
+type BaumWelchSettings struct {
+	// Iterations is the maximum number of iterations.
+	// If Iterations is zero, 100 is used.
+	Iterations int
+
+	// Tolerance is the relative increase of the log likelihood
+	// below which the iterations are considered converged.
+	// If Tolerance is zero, 1e-8 is used.
+	Tolerance float64
+
+	// FixEmission specifies that the emission distributions
+	// are not estimated.
+	FixEmission bool
+}
+
+// BaumWelch estimates the parameters of the model from the observation
+// sequences in seqs by the Baum-Welch algorithm, updating the receiver, and
+// returns the total log likelihood of the sequences under the estimated
+// model. The observations of each sequence are held in the rows of a
+// matrix. If settings is nil, default settings are used.
+//
+// Each iteration sets the initial and transition probabilities and the
+// emission distributions to those maximizing the expected complete-data
+// log likelihood given the posterior state probabilities of the current
+// model, so that the log likelihood does not decrease. The emission
+// distributions are only estimated if m.Emission is a Fitter. The algorithm
+// converges to a local maximum of the likelihood, so the result depends on
+// the initial parameters. Transition probabilities that are zero remain
+// zero.
+//
+// BaumWelch returns an error if a sequence is impossible under the model.
+func (m *Model) BaumWelch(seqs []mat.Matrix, settings *BaumWelchSettings) (float64, error) {
+	iters := 100
+	tol := 1e-8
+	fitter, fit := m.Emission.(Fitter)
+	if settings != nil {
+		if settings.Iterations != 0 {
+			iters = settings.Iterations
+		}
+		if settings.Tolerance != 0 {
+			tol = settings.Tolerance
+		}
+		if settings.FixEmission {
+			fit = false
+		}
+	}
+	if len(seqs) == 0 {
+		panic("hmm: no observation sequences")
+	}
+	n := m.Len()
+
+	var stacked *mat.Dense
+	if fit {
+		var total int
+		_, c := seqs[0].Dims()
+		for _, obs := range seqs {
+			r, oc := obs.Dims()
+			if oc != c {
+				panic(mat.ErrShape)
+			}
+			total += r
+		}
+		stacked = mat.NewDense(total, c, nil)
+		var off int
+		for _, obs := range seqs {
+			r, _ := obs.Dims()
+			stacked.Slice(off, off+r, 0, c).(*mat.Dense).Copy(obs)
+			off += r
+		}
+	}
+
+	prev := math.Inf(-1)
+	init := make([]float64, n)
+	trans := mat.NewDense(n, n, nil)
+	occupancy := make([]float64, n)
+	var weights *mat.Dense
+	if fit {
+		r, _ := stacked.Dims()
+		weights = mat.NewDense(n, r, nil)
+	}
+	for i := 0; ; i++ {
+		for j := range init {
+			init[j] = 0
+			occupancy[j] = 0
+		}
+		trans.Zero()
+		var ll float64
+		var off int
+		for _, obs := range seqs {
+			f := m.forward(obs)
+			if math.IsInf(f.logLike, -1) {
+				return f.logLike, errZeroProb
+			}
+			ll += f.logLike
+			beta := m.backward(f)
+			t, _ := obs.Dims()
+			var gamma mat.Dense
+			gamma.MulElem(f.alpha, beta)
+			floats.Add(init, gamma.RawRowView(0))
+			for s := 0; s < t; s++ {
+				if s < t-1 {
+					floats.Add(occupancy, gamma.RawRowView(s))
+					m.addTransitions(trans, f, beta, s)
+				}
+				if fit {
+					for j := 0; j < n; j++ {
+						weights.Set(j, off+s, gamma.At(s, j))
+					}
+				}
+			}
+			off += t
+		}
+		if i == iters || ll-prev <= tol*math.Abs(ll) {
+			return ll, nil
+		}
+		prev = ll
+
+		floats.Scale(1/float64(len(seqs)), init)
+		copy(m.Init, init)
+		for j := 0; j < n; j++ {
+			if occupancy[j] == 0 {
+				continue
+			}
+			row := m.Trans.RawRowView(j)
+			copy(row, trans.RawRowView(j))
+			floats.Scale(1/floats.Sum(row), row)
+		}
+		if fit {
+			for j := 0; j < n; j++ {
+				fitter.Fit(j, stacked, weights.RawRowView(j))
+			}
+		}
+	}
+}
+
+// addTransitions adds the expected numbers of transitions between times s
+// and s+1 to dst.
+func (m *Model) addTransitions(dst *mat.Dense, f forwardResult, beta *mat.Dense, s int) {
+	n := len(m.Init)
+	b := f.lp.RawRowView(s + 1)
+	bt := beta.RawRowView(s + 1)
+	c := f.scale[s+1]
+	for i := 0; i < n; i++ {
+		a := f.alpha.At(s, i)
+		if a == 0 {
+			continue
+		}
+		for j := 0; j < n; j++ {
+			dst.Set(i, j, dst.At(i, j)+a*m.Trans.At(i, j)*b[j]*bt[j]/c)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmm_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distuv"
+	"gonum.org/v1/gonum/stat/hmm"
+)
+
+func ExampleModel_Viterbi() {
+	// A casino usually uses a fair die, but occasionally switches
+	// to a loaded die that rolls a six half of the time.
+	m := &hmm.Model{
+		Init: []float64{1, 0},
+		Trans: mat.NewDense(2, 2, []float64{
+			0.95, 0.05,
+			0.1, 0.9,
+		}),
+		Emission: hmm.Discrete{
+			distuv.NewCategorical([]float64{1, 1, 1, 1, 1, 1}, nil),
+			distuv.NewCategorical([]float64{1, 1, 1, 1, 1, 5}, nil),
+		},
+	}
+
+	// Rolls of the die, with faces numbered from zero.
+	rolls := []float64{
+		2, 0, 4, 3, 1, 2, 0, 5, 3, 1,
+		5, 5, 2, 5, 5, 5, 0, 5, 5, 5,
+		3, 1, 0, 4, 2, 1, 3, 0, 2, 4,
+	}
+	obs := mat.NewDense(len(rolls), 1, rolls)
+
+	path, _, err := m.Viterbi(obs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	gamma, _, err := m.Posterior(obs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("most probable dice:", path)
+	fmt.Printf("P(loaded) at roll 15: %.2f\n", gamma.At(15, 1))
+
+	// Output:
+	// most probable dice: [0 0 0 0 0 0 0 0 0 0 1 1 1 1 1 1 1 1 1 1 0 0 0 0 0 0 0 0 0 0]
+	// P(loaded) at roll 15: 0.96
+}
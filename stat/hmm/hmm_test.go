@@ -0,0 +1,568 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmm
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// models returns a new set of models with the given names, and a function
+// returning random observations for each.
+func models() []struct {
+	name  string
+	model *Model
+	obs   func(rnd *rand.Rand, t int) *mat.Dense
+} {
+	discrete := func(k int) func(rnd *rand.Rand, t int) *mat.Dense {
+		return func(rnd *rand.Rand, t int) *mat.Dense {
+			obs := mat.NewDense(t, 1, nil)
+			for i := 0; i < t; i++ {
+				obs.Set(i, 0, float64(rnd.IntN(k)))
+			}
+			return obs
+		}
+	}
+	normal := func(d int) func(rnd *rand.Rand, t int) *mat.Dense {
+		return func(rnd *rand.Rand, t int) *mat.Dense {
+			obs := mat.NewDense(t, d, nil)
+			for i := 0; i < t; i++ {
+				for j := 0; j < d; j++ {
+					obs.Set(i, j, 2*rnd.NormFloat64())
+				}
+			}
+			return obs
+		}
+	}
+	mvn := func(mu []float64, cov []float64) distmv.LogProber {
+		n, ok := distmv.NewNormal(mu, mat.NewSymDense(len(mu), cov), nil)
+		if !ok {
+			panic("bad covariance")
+		}
+		return n
+	}
+	return []struct {
+		name  string
+		model *Model
+		obs   func(rnd *rand.Rand, t int) *mat.Dense
+	}{
+		{
+			name: "discrete",
+			model: &Model{
+				Init: []float64{0.6, 0.4},
+				Trans: mat.NewDense(2, 2, []float64{
+					0.7, 0.3,
+					0.4, 0.6,
+				}),
+				Emission: Discrete{
+					distuv.NewCategorical([]float64{0.5, 0.4, 0.1}, nil),
+					distuv.NewCategorical([]float64{0.1, 0.3, 0.6}, nil),
+				},
+			},
+			obs: discrete(3),
+		},
+		{
+			name: "discrete sparse",
+			model: &Model{
+				Init: []float64{1, 0, 0},
+				Trans: mat.NewDense(3, 3, []float64{
+					0.5, 0.5, 0,
+					0, 0.5, 0.5,
+					0.2, 0, 0.8,
+				}),
+				Emission: Discrete{
+					distuv.NewCategorical([]float64{0.9, 0.1}, nil),
+					distuv.NewCategorical([]float64{0.2, 0.8}, nil),
+					distuv.NewCategorical([]float64{0.5, 0.5}, nil),
+				},
+			},
+			obs: discrete(2),
+		},
+		{
+			name: "univariate normal",
+			model: &Model{
+				Init: []float64{0.2, 0.5, 0.3},
+				Trans: mat.NewDense(3, 3, []float64{
+					0.8, 0.1, 0.1,
+					0.2, 0.6, 0.2,
+					0.3, 0.3, 0.4,
+				}),
+				Emission: Univariate{
+					&distuv.Normal{Mu: -2, Sigma: 1},
+					&distuv.Normal{Mu: 0, Sigma: 0.5},
+					&distuv.Normal{Mu: 3, Sigma: 2},
+				},
+			},
+			obs: normal(1),
+		},
+		{
+			name: "multivariate normal",
+			model: &Model{
+				Init: []float64{0.5, 0.5},
+				Trans: mat.NewDense(2, 2, []float64{
+					0.9, 0.1,
+					0.2, 0.8,
+				}),
+				Emission: Multivariate{
+					mvn([]float64{-1, 1}, []float64{1, 0.3, 0.3, 2}),
+					mvn([]float64{2, 0}, []float64{0.5, -0.1, -0.1, 1}),
+				},
+			},
+			obs: normal(2),
+		},
+	}
+}
+
+// enumerate calls fn with each sequence of t states of an n-state model.
+func enumerate(n, t int, fn func(states []int)) {
+	states := make([]int, t)
+	for {
+		fn(states)
+		i := t - 1
+		for ; i >= 0; i-- {
+			states[i]++
+			if states[i] < n {
+				break
+			}
+			states[i] = 0
+		}
+		if i < 0 {
+			return
+		}
+	}
+}
+
+// jointLogProb returns the joint log probability of the given states and
+// observations.
+func jointLogProb(m *Model, states []int, obs mat.Matrix) float64 {
+	_, c := obs.Dims()
+	x := make([]float64, c)
+	var lp float64
+	for i, s := range states {
+		if i == 0 {
+			lp += math.Log(m.Init[s])
+		} else {
+			lp += math.Log(m.Trans.At(states[i-1], s))
+		}
+		mat.Row(x, i, obs)
+		lp += m.Emission.LogProb(s, x)
+	}
+	return lp
+}
+
+func TestInference(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range models() {
+		m := test.model
+		n := m.Len()
+		for _, steps := range []int{1, 2, 5} {
+			obs := test.obs(rnd, steps)
+
+			// Compute the likelihood, posterior and most probable
+			// path by brute force enumeration of the paths.
+			var like float64
+			post := mat.NewDense(steps, n, nil)
+			best := math.Inf(-1)
+			var path []int
+			enumerate(n, steps, func(states []int) {
+				lp := jointLogProb(m, states, obs)
+				p := math.Exp(lp)
+				like += p
+				for i, s := range states {
+					post.Set(i, s, post.At(i, s)+p)
+				}
+				if lp > best {
+					best = lp
+					path = append(path[:0], states...)
+				}
+			})
+			post.Scale(1/like, post)
+
+			got := m.LogLikelihood(obs)
+			if !scalar.EqualWithinAbsOrRel(got, math.Log(like), tol, tol) {
+				t.Errorf("unexpected log likelihood for %s model with %d steps: got:%v want:%v", test.name, steps, got, math.Log(like))
+			}
+			gamma, ll, err := m.Posterior(obs)
+			if err != nil {
+				t.Fatalf("unexpected error for %s model with %d steps: %v", test.name, steps, err)
+			}
+			if ll != got {
+				t.Errorf("mismatched log likelihood from posterior for %s model with %d steps: got:%v want:%v", test.name, steps, ll, got)
+			}
+			if !mat.EqualApprox(gamma, post, tol) {
+				t.Errorf("unexpected posterior for %s model with %d steps:\ngot:\n%v\nwant:\n%v", test.name, steps, mat.Formatted(gamma), mat.Formatted(post))
+			}
+			vpath, vlp, err := m.Viterbi(obs)
+			if err != nil {
+				t.Fatalf("unexpected Viterbi error for %s model with %d steps: %v", test.name, steps, err)
+			}
+			if !scalar.EqualWithinAbsOrRel(vlp, best, tol, tol) {
+				t.Errorf("unexpected Viterbi log probability for %s model with %d steps: got:%v want:%v", test.name, steps, vlp, best)
+			}
+			if lp := jointLogProb(m, vpath, obs); !scalar.EqualWithinAbsOrRel(lp, best, tol, tol) {
+				t.Errorf("unexpected Viterbi path for %s model with %d steps: got:%v want:%v", test.name, steps, vpath, path)
+			}
+		}
+	}
+}
+
+func TestLongSequence(t *testing.T) {
+	t.Parallel()
+	// The scaled recursions remain accurate for sequences whose
+	// probability underflows.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range models() {
+		m := test.model
+		obs := test.obs(rnd, 5000)
+		ll := m.LogLikelihood(obs)
+		if math.IsInf(ll, 0) || math.IsNaN(ll) || ll > -1000 {
+			t.Errorf("unexpected log likelihood for %s model: %v", test.name, ll)
+		}
+		gamma, _, err := m.Posterior(obs)
+		if err != nil {
+			t.Fatalf("unexpected error for %s model: %v", test.name, err)
+		}
+		r, _ := gamma.Dims()
+		for i := 0; i < r; i++ {
+			if s := floats.Sum(gamma.RawRowView(i)); math.Abs(s-1) > 1e-10 {
+				t.Errorf("posterior for %s model at time %d does not sum to one: %v", test.name, i, s)
+				break
+			}
+		}
+		path, vlp, err := m.Viterbi(obs)
+		if err != nil {
+			t.Fatalf("unexpected Viterbi error for %s model: %v", test.name, err)
+		}
+		if vlp > ll || !scalar.EqualWithinAbsOrRel(jointLogProb(m, path, obs), vlp, 1e-10, 1e-10) {
+			t.Errorf("unexpected Viterbi log probability for %s model: got:%v likelihood:%v", test.name, vlp, ll)
+		}
+	}
+}
+
+func TestZeroProbability(t *testing.T) {
+	t.Parallel()
+	m := &Model{
+		Init:  []float64{1, 0},
+		Trans: mat.NewDense(2, 2, []float64{1, 0, 0, 1}),
+		Emission: Discrete{
+			distuv.NewCategorical([]float64{1, 0}, nil),
+			distuv.NewCategorical([]float64{0, 1}, nil),
+		},
+	}
+	obs := mat.NewDense(3, 1, []float64{0, 0, 1})
+	if ll := m.LogLikelihood(obs); !math.IsInf(ll, -1) {
+		t.Errorf("unexpected log likelihood: got:%v want:-Inf", ll)
+	}
+	if _, _, err := m.Posterior(obs); err == nil {
+		t.Error("expected error for impossible sequence from Posterior")
+	}
+	if _, _, err := m.Viterbi(obs); err == nil {
+		t.Error("expected error for impossible sequence from Viterbi")
+	}
+	if _, err := m.BaumWelch([]mat.Matrix{obs}, nil); err == nil {
+		t.Error("expected error for impossible sequence from BaumWelch")
+	}
+}
+
+// simulate returns a sequence of t states and observations from m using
+// the given emission samplers.
+func simulate(rnd *rand.Rand, m *Model, t int, emit func(state int, dst []float64), d int) ([]int, *mat.Dense) {
+	n := len(m.Init)
+	cat := func(p []float64) int {
+		u := rnd.Float64()
+		for i, v := range p {
+			u -= v
+			if u < 0 {
+				return i
+			}
+		}
+		return n - 1
+	}
+	states := make([]int, t)
+	obs := mat.NewDense(t, d, nil)
+	for i := range states {
+		if i == 0 {
+			states[i] = cat(m.Init)
+		} else {
+			states[i] = cat(m.Trans.RawRowView(states[i-1]))
+		}
+		emit(states[i], obs.RawRowView(i))
+	}
+	return states, obs
+}
+
+func TestBaumWelchDiscrete(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	truth := &Model{
+		Init: []float64{0.5, 0.5},
+		Trans: mat.NewDense(2, 2, []float64{
+			0.95, 0.05,
+			0.1, 0.9,
+		}),
+	}
+	emit := [][]float64{
+		{0.7, 0.2, 0.1},
+		{0.1, 0.2, 0.7},
+	}
+	truth.Emission = Discrete{
+		distuv.NewCategorical(emit[0], nil),
+		distuv.NewCategorical(emit[1], nil),
+	}
+	var seqs []mat.Matrix
+	for i := 0; i < 20; i++ {
+		_, obs := simulate(rnd, truth, 500, func(s int, dst []float64) {
+			p := emit[s]
+			u := rnd.Float64()
+			for j, v := range p {
+				u -= v
+				if u < 0 {
+					dst[0] = float64(j)
+					return
+				}
+			}
+			dst[0] = float64(len(p) - 1)
+		}, 1)
+		seqs = append(seqs, obs)
+	}
+
+	m := &Model{
+		Init: []float64{0.5, 0.5},
+		Trans: mat.NewDense(2, 2, []float64{
+			0.8, 0.2,
+			0.2, 0.8,
+		}),
+		Emission: Discrete{
+			distuv.NewCategorical([]float64{0.4, 0.3, 0.3}, nil),
+			distuv.NewCategorical([]float64{0.3, 0.3, 0.4}, nil),
+		},
+	}
+	// The log likelihood does not decrease over iterations.
+	prev := math.Inf(-1)
+	for i := 0; i < 5; i++ {
+		ll, err := m.BaumWelch(seqs, &BaumWelchSettings{Iterations: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if ll < prev-1e-8*math.Abs(prev) {
+			t.Errorf("log likelihood decreased at iteration %d: %v < %v", i, ll, prev)
+		}
+		prev = ll
+	}
+	ll, err := m.BaumWelch(seqs, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var total float64
+	for _, obs := range seqs {
+		total += m.LogLikelihood(obs)
+	}
+	if !scalar.EqualWithinAbsOrRel(ll, total, 1e-6, 1e-6) {
+		t.Errorf("unexpected returned log likelihood: got:%v want:%v", ll, total)
+	}
+
+	const tol = 0.05
+	if !mat.EqualApprox(m.Trans, truth.Trans, tol) {
+		t.Errorf("unexpected transition probabilities:\ngot:\n%v\nwant:\n%v", mat.Formatted(m.Trans), mat.Formatted(truth.Trans))
+	}
+	for s, want := range emit {
+		c := m.Emission.(Discrete)[s]
+		for j, p := range want {
+			if got := c.Prob(float64(j)); math.Abs(got-p) > tol {
+				t.Errorf("unexpected emission probability of %d in state %d: got:%v want:%v", j, s, got, p)
+			}
+		}
+	}
+}
+
+func TestBaumWelchNormal(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	truth := &Model{
+		Init: []float64{1, 0, 0},
+		Trans: mat.NewDense(3, 3, []float64{
+			0.9, 0.05, 0.05,
+			0.1, 0.8, 0.1,
+			0.05, 0.15, 0.8,
+		}),
+	}
+	mu := []float64{-3, 0, 4}
+	sigma := []float64{1, 0.5, 1.5}
+	_, obs := simulate(rnd, truth, 5000, func(s int, dst []float64) {
+		dst[0] = mu[s] + sigma[s]*rnd.NormFloat64()
+	}, 1)
+
+	m := &Model{
+		Init: []float64{1.0 / 3, 1.0 / 3, 1.0 / 3},
+		Trans: mat.NewDense(3, 3, []float64{
+			0.6, 0.2, 0.2,
+			0.2, 0.6, 0.2,
+			0.2, 0.2, 0.6,
+		}),
+		Emission: Univariate{
+			&distuv.Normal{Mu: -1, Sigma: 2},
+			&distuv.Normal{Mu: 0.5, Sigma: 2},
+			&distuv.Normal{Mu: 2, Sigma: 2},
+		},
+	}
+	_, err := m.BaumWelch([]mat.Matrix{obs}, &BaumWelchSettings{Iterations: 500})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !mat.EqualApprox(m.Trans, truth.Trans, 0.05) {
+		t.Errorf("unexpected transition probabilities:\ngot:\n%v\nwant:\n%v", mat.Formatted(m.Trans), mat.Formatted(truth.Trans))
+	}
+	for s := range mu {
+		n := m.Emission.(Univariate)[s].(*distuv.Normal)
+		if math.Abs(n.Mu-mu[s]) > 0.1 || math.Abs(n.Sigma-sigma[s]) > 0.1 {
+			t.Errorf("unexpected emission in state %d: got:N(%v, %v) want:N(%v, %v)", s, n.Mu, n.Sigma, mu[s], sigma[s])
+		}
+	}
+
+	// Decoding with the estimated model recovers most of the states.
+	states, obs := simulate(rnd, truth, 1000, func(s int, dst []float64) {
+		dst[0] = mu[s] + sigma[s]*rnd.NormFloat64()
+	}, 1)
+	path, _, err := m.Viterbi(obs)
+	if err != nil {
+		t.Fatalf("unexpected Viterbi error: %v", err)
+	}
+	var wrong int
+	for i, s := range states {
+		if path[i] != s {
+			wrong++
+		}
+	}
+	if wrong > len(states)/20 {
+		t.Errorf("too many incorrectly decoded states: %d of %d", wrong, len(states))
+	}
+}
+
+func TestBaumWelchMultivariate(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	truth := &Model{
+		Init: []float64{0.5, 0.5},
+		Trans: mat.NewDense(2, 2, []float64{
+			0.9, 0.1,
+			0.2, 0.8,
+		}),
+	}
+	mu := [][]float64{{-2, 1}, {2, -1}}
+	covs := []*mat.SymDense{
+		mat.NewSymDense(2, []float64{1, 0.5, 0.5, 1}),
+		mat.NewSymDense(2, []float64{0.5, 0, 0, 2}),
+	}
+	var dists []*distmv.Normal
+	for s := range mu {
+		n, ok := distmv.NewNormal(mu[s], covs[s], rnd)
+		if !ok {
+			t.Fatal("bad covariance")
+		}
+		dists = append(dists, n)
+	}
+	_, obs := simulate(rnd, truth, 4000, func(s int, dst []float64) {
+		dists[s].Rand(dst)
+	}, 2)
+
+	id := mat.NewSymDense(2, []float64{2, 0, 0, 2})
+	n0, _ := distmv.NewNormal([]float64{-1, 0}, id, nil)
+	n1, _ := distmv.NewNormal([]float64{1, 0}, id, nil)
+	m := &Model{
+		Init: []float64{0.5, 0.5},
+		Trans: mat.NewDense(2, 2, []float64{
+			0.5, 0.5,
+			0.5, 0.5,
+		}),
+		Emission: Multivariate{n0, n1},
+	}
+	_, err := m.BaumWelch([]mat.Matrix{obs}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !mat.EqualApprox(m.Trans, truth.Trans, 0.05) {
+		t.Errorf("unexpected transition probabilities:\ngot:\n%v\nwant:\n%v", mat.Formatted(m.Trans), mat.Formatted(truth.Trans))
+	}
+	for s := range mu {
+		n := m.Emission.(Multivariate)[s].(*distmv.Normal)
+		if !floats.EqualApprox(n.Mean(nil), mu[s], 0.1) {
+			t.Errorf("unexpected mean in state %d: got:%v want:%v", s, n.Mean(nil), mu[s])
+		}
+		var cov mat.SymDense
+		n.CovarianceMatrix(&cov)
+		if !mat.EqualApprox(&cov, covs[s], 0.15) {
+			t.Errorf("unexpected covariance in state %d:\ngot:\n%v\nwant:\n%v", s, mat.Formatted(&cov), mat.Formatted(covs[s]))
+		}
+	}
+}
+
+func TestBaumWelchFixEmission(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range models() {
+		m := test.model
+		obs := test.obs(rnd, 200)
+		x := obs.RawRowView(0)
+		want := make([]float64, m.Len())
+		for s := range want {
+			want[s] = m.Emission.LogProb(s, x)
+		}
+		_, err := m.BaumWelch([]mat.Matrix{obs}, &BaumWelchSettings{Iterations: 10, FixEmission: true})
+		if err != nil {
+			t.Fatalf("unexpected error for %s model: %v", test.name, err)
+		}
+		for s, w := range want {
+			if got := m.Emission.LogProb(s, x); got != w {
+				t.Errorf("emission of state %d changed for %s model", s, test.name)
+			}
+		}
+		for i := 0; i < m.Len(); i++ {
+			if s := floats.Sum(m.Trans.RawRowView(i)); math.Abs(s-1) > 1e-12 {
+				t.Errorf("transition probabilities of state %d for %s model do not sum to one: %v", i, test.name, s)
+			}
+		}
+		if s := floats.Sum(m.Init); math.Abs(s-1) > 1e-12 {
+			t.Errorf("initial probabilities for %s model do not sum to one: %v", test.name, s)
+		}
+	}
+}
+
+func TestModelPanics(t *testing.T) {
+	t.Parallel()
+	m := &Model{
+		Init:  []float64{0.5, 0.5},
+		Trans: mat.NewDense(2, 2, []float64{0.5, 0.5, 0.5, 0.5}),
+		Emission: Discrete{
+			distuv.NewCategorical([]float64{1, 1}, nil),
+		},
+	}
+	if !panics(func() { m.LogLikelihood(mat.NewDense(1, 1, nil)) }) {
+		t.Error("expected panic for mismatched emissions")
+	}
+	m.Emission = append(m.Emission.(Discrete), distuv.NewCategorical([]float64{1, 1}, nil))
+	if !panics(func() { m.LogLikelihood(&mat.Dense{}) }) {
+		t.Error("expected panic for empty sequence")
+	}
+	if !panics(func() { m.BaumWelch(nil, nil) }) {
+		t.Error("expected panic for no sequences")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
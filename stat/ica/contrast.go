@@ -0,0 +1,61 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ica
+
+import "math"
+
+// Contrast is a contrast function G used by FastICA to measure the
+// non-Gaussianity of a projection of the whitened data.
+type Contrast interface {
+	// Derivatives returns the first and second
+	// derivatives of the contrast function at u.
+	Derivatives(u float64) (g, dg float64)
+}
+
+// LogCosh is the contrast function
+//
+//	G(u) = log(cosh(Alpha*u)) / Alpha,
+//
+// a good general-purpose choice. Alpha must be in the interval [1, 2]; if
+// Alpha is zero, a value of 1 is used.
+type LogCosh struct {
+	Alpha float64
+}
+
+// Derivatives returns the first and second derivatives of G at u.
+func (c LogCosh) Derivatives(u float64) (g, dg float64) {
+	a := c.Alpha
+	if a == 0 {
+		a = 1
+	}
+	g = math.Tanh(a * u)
+	return g, a * (1 - g*g)
+}
+
+// Exp is the contrast function
+//
+//	G(u) = -exp(-u²/2),
+//
+// which is more robust than LogCosh when the sources are highly
+// super-Gaussian.
+type Exp struct{}
+
+// Derivatives returns the first and second derivatives of G at u.
+func (Exp) Derivatives(u float64) (g, dg float64) {
+	e := math.Exp(-u * u / 2)
+	return u * e, (1 - u*u) * e
+}
+
+// Cube is the kurtosis-based contrast function
+//
+//	G(u) = u⁴/4.
+//
+// It is fast to compute but sensitive to outliers.
+type Cube struct{}
+
+// Derivatives returns the first and second derivatives of G at u.
+func (Cube) Derivatives(u float64) (g, dg float64) {
+	return u * u * u, 3 * u * u
+}
@@ -0,0 +1,18 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ica provides independent component analysis for the blind
+// separation of linearly mixed non-Gaussian sources.
+//
+// The observations are centered and whitened using a principal components
+// analysis, and the independent components are then found by the FastICA
+// fixed-point algorithm, either one component at a time (deflation) or all
+// components simultaneously with symmetric decorrelation. The non-Gaussianity
+// of the components is measured by a Contrast function.
+//
+// See Hyvärinen, "Fast and robust fixed-point algorithms for independent
+// component analysis", IEEE Transactions on Neural Networks 10(3), 1999, and
+// Hyvärinen and Oja, "Independent component analysis: algorithms and
+// applications", Neural Networks 13(4-5), 2000.
+package ica // import "gonum.org/v1/gonum/stat/ica"
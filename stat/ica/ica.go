@@ -0,0 +1,393 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ica
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+const (
+	badComponents = "ica: invalid number of components"
+	badInit       = "ica: initial unmixing matrix shape mismatch"
+	tooFewObs     = "ica: fewer than two observations"
+)
+
+// ErrRankDeficient is returned by FastICA when the whitened data would have
+// fewer linearly independent directions than the requested number of
+// components.
+var ErrRankDeficient = errors.New("ica: data are rank deficient")
+
+// rankTol is the smallest ratio of the variance of a retained
+// principal component to the largest variance.
+const rankTol = 1e-12
+
+// Settings holds settings for FastICA. Zero values of the fields other than
+// Deflation, Init and Src specify the default values.
+type Settings struct {
+	// Components is the number of independent components
+	// to estimate. It must not be greater than the number
+	// of variables or observations. The default is the
+	// smaller of the number of variables and one less than
+	// the number of observations.
+	Components int
+
+	// Deflation specifies that the components are estimated
+	// one at a time, each orthogonal to those found before
+	// it. Otherwise all components are estimated in parallel
+	// with symmetric decorrelation, so that no component is
+	// favored and errors do not accumulate.
+	Deflation bool
+
+	// Contrast is the contrast function used to measure the
+	// non-Gaussianity of the components. The default is
+	// LogCosh{Alpha: 1}.
+	Contrast Contrast
+
+	// MaxIterations is the maximum number of fixed-point
+	// iterations, for each component when Deflation is true.
+	// The default is 200.
+	MaxIterations int
+
+	// Tolerance is the convergence tolerance on the change
+	// in direction of the unmixing vectors. The default
+	// is 1e-4.
+	Tolerance float64
+
+	// Init is the initial k×k unmixing matrix for the
+	// whitened data, where k is the number of components.
+	// If Init is nil, its elements are drawn from the
+	// standard normal distribution.
+	Init mat.Matrix
+
+	// Src is the source of randomness for the initial
+	// unmixing matrix. If Src is nil, the global random
+	// source is used.
+	Src rand.Source
+}
+
+// Result holds the result of an independent component analysis of n
+// observations of d variables into k components.
+type Result struct {
+	// Mean holds the means of the d variables.
+	Mean []float64
+
+	// Whitening is the k×d matrix that transforms the
+	// centered observations to uncorrelated variables
+	// with unit variance.
+	Whitening *mat.Dense
+
+	// Unmixing is the k×d matrix that transforms the
+	// centered observations to the independent
+	// components, and Mixing is the d×k matrix that
+	// transforms the components back to the centered
+	// observations, so that
+	//  Unmixing * Mixing = I.
+	Unmixing *mat.Dense
+	Mixing   *mat.Dense
+
+	// Sources is the n×k matrix of the estimated
+	// independent components of the observations,
+	// scaled to unit variance.
+	Sources *mat.Dense
+
+	// Iterations is the number of fixed-point iterations,
+	// the largest over the components when the components
+	// are estimated by deflation.
+	Iterations int
+
+	// Converged is whether the iterations converged
+	// for all the components.
+	Converged bool
+}
+
+// FastICA performs an independent component analysis of the observations
+// held in the rows of x, estimating statistically independent non-Gaussian
+// sources s and a mixing matrix A such that each observation is
+//
+//	x = mean + A * s.
+//
+// If settings is nil, default settings are used.
+//
+// The observations are centered and whitened using their principal
+// components, and the unmixing matrix of the whitened data is found by the
+// FastICA fixed-point iteration, which maximizes the non-Gaussianity of each
+// component as measured by the contrast function. The independent components
+// are determined only up to their order and sign.
+//
+// FastICA returns ErrRankDeficient if the data do not have the requested
+// number of linearly independent directions. If the iterations do not
+// converge, the result of the last iteration is returned with its Converged
+// field set to false. FastICA panics if x has fewer than two rows, if the
+// number of components is invalid or if the dimensions of settings.Init do
+// not match the number of components.
+func FastICA(x mat.Matrix, settings *Settings) (*Result, error) {
+	n, d := x.Dims()
+	if n < 2 {
+		panic(tooFewObs)
+	}
+	s := Settings{
+		Components:    min(n-1, d),
+		Contrast:      LogCosh{Alpha: 1},
+		MaxIterations: 200,
+		Tolerance:     1e-4,
+	}
+	if settings != nil {
+		if settings.Components != 0 {
+			s.Components = settings.Components
+		}
+		s.Deflation = settings.Deflation
+		if settings.Contrast != nil {
+			s.Contrast = settings.Contrast
+		}
+		if settings.MaxIterations != 0 {
+			s.MaxIterations = settings.MaxIterations
+		}
+		if settings.Tolerance != 0 {
+			s.Tolerance = settings.Tolerance
+		}
+		s.Init = settings.Init
+		s.Src = settings.Src
+	}
+	k := s.Components
+	if k < 1 || k > min(n, d) {
+		panic(badComponents)
+	}
+	if s.Init != nil {
+		if r, c := s.Init.Dims(); r != k || c != k {
+			panic(badInit)
+		}
+	}
+
+	// Whiten the centered observations using
+	// their first k principal components.
+	var pc stat.PC
+	if !pc.PrincipalComponents(x, nil) {
+		return nil, errors.New("ica: failed to compute principal components")
+	}
+	vars := pc.VarsTo(nil)
+	if !(vars[k-1] > rankTol*vars[0]) {
+		return nil, ErrRankDeficient
+	}
+	var vecs mat.Dense
+	pc.VectorsTo(&vecs)
+	whitening := mat.NewDense(k, d, nil)
+	dewhitening := mat.NewDense(d, k, nil)
+	for j := 0; j < k; j++ {
+		sd := math.Sqrt(vars[j])
+		for i := 0; i < d; i++ {
+			v := vecs.At(i, j)
+			whitening.Set(j, i, v/sd)
+			dewhitening.Set(i, j, v*sd)
+		}
+	}
+	mean := make([]float64, d)
+	xc := mat.DenseCopyOf(x)
+	for i := 0; i < n; i++ {
+		floats.Add(mean, xc.RawRowView(i))
+	}
+	floats.Scale(1/float64(n), mean)
+	for i := 0; i < n; i++ {
+		floats.Sub(xc.RawRowView(i), mean)
+	}
+	var z mat.Dense
+	z.Mul(xc, whitening.T())
+
+	w := mat.NewDense(k, k, nil)
+	if s.Init != nil {
+		w.Copy(s.Init)
+	} else {
+		norm := rand.NormFloat64
+		if s.Src != nil {
+			norm = rand.New(s.Src).NormFloat64
+		}
+		raw := w.RawMatrix().Data
+		for i := range raw {
+			raw[i] = norm()
+		}
+	}
+
+	var iter int
+	var converged bool
+	if s.Deflation {
+		iter, converged = deflation(w, &z, s.Contrast, s.MaxIterations, s.Tolerance)
+	} else {
+		iter, converged = symmetric(w, &z, s.Contrast, s.MaxIterations, s.Tolerance)
+	}
+
+	r := &Result{
+		Mean:       mean,
+		Whitening:  whitening,
+		Unmixing:   &mat.Dense{},
+		Mixing:     &mat.Dense{},
+		Sources:    &mat.Dense{},
+		Iterations: iter,
+		Converged:  converged,
+	}
+	r.Unmixing.Mul(w, whitening)
+	r.Mixing.Mul(dewhitening, w.T())
+	r.Sources.Mul(&z, w.T())
+	return r, nil
+}
+
+// Transform stores into dst the independent components of the observations
+// held in the rows of x, computed using the mean and unmixing matrix of the
+// receiver. If dst is empty, it is resized to n×k, where n is the number of
+// observations. Transform panics if the number of columns of x does not
+// match the number of variables of the analysis or dst is not empty and is
+// not n×k.
+func (r *Result) Transform(dst *mat.Dense, x mat.Matrix) {
+	n, d := x.Dims()
+	if d != len(r.Mean) {
+		panic(mat.ErrShape)
+	}
+	k, _ := r.Unmixing.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, k)
+	} else if rows, cols := dst.Dims(); rows != n || cols != k {
+		panic(mat.ErrShape)
+	}
+	xc := mat.DenseCopyOf(x)
+	for i := 0; i < n; i++ {
+		floats.Sub(xc.RawRowView(i), r.Mean)
+	}
+	dst.Mul(xc, r.Unmixing.T())
+}
+
+// symmetric estimates all the rows of the unmixing matrix w of the whitened
+// data z in parallel, returning the number of iterations and whether the
+// iterations converged.
+func symmetric(w, z *mat.Dense, c Contrast, maxIter int, tol float64) (iter int, converged bool) {
+	n, k := z.Dims()
+	symDecorrelate(w)
+	var u, wNew mat.Dense
+	dg := make([]float64, k)
+	for iter = 1; iter <= maxIter; iter++ {
+		// Compute u = z * wᵀ and replace it with g(u),
+		// accumulating the means of g'(u).
+		u.Mul(z, w.T())
+		for i := range dg {
+			dg[i] = 0
+		}
+		for i := 0; i < n; i++ {
+			row := u.RawRowView(i)
+			for j, v := range row {
+				var dv float64
+				row[j], dv = c.Derivatives(v)
+				dg[j] += dv
+			}
+		}
+
+		// Apply the fixed-point update
+		//  w_j ← E{z g(w_jᵀz)} - E{g'(w_jᵀz)} w_j
+		// to all the rows of w.
+		wNew.Mul(u.T(), z)
+		for j := 0; j < k; j++ {
+			row := wNew.RawRowView(j)
+			floats.Scale(1/float64(n), row)
+			floats.AddScaled(row, -dg[j]/float64(n), w.RawRowView(j))
+		}
+		symDecorrelate(&wNew)
+
+		// The rows of w and wNew have unit length, so the
+		// change in their directions is measured by the
+		// absolute values of their dot products.
+		var delta float64
+		for j := 0; j < k; j++ {
+			dot := floats.Dot(wNew.RawRowView(j), w.RawRowView(j))
+			delta = math.Max(delta, math.Abs(math.Abs(dot)-1))
+		}
+		w.Copy(&wNew)
+		if delta < tol {
+			return iter, true
+		}
+	}
+	return maxIter, false
+}
+
+// deflation estimates the rows of the unmixing matrix w of the whitened data
+// z one at a time, returning the largest number of iterations for a row and
+// whether the iterations converged for all the rows.
+func deflation(w, z *mat.Dense, c Contrast, maxIter int, tol float64) (iter int, converged bool) {
+	n, k := z.Dims()
+	u := make([]float64, n)
+	wNew := make([]float64, k)
+	converged = true
+	for p := 0; p < k; p++ {
+		wp := w.RawRowView(p)
+		decorrelate(wp, w, p)
+		floats.Scale(1/floats.Norm(wp, 2), wp)
+		var ok bool
+		var it int
+		for it = 1; it <= maxIter; it++ {
+			// Apply the fixed-point update
+			//  w_p ← E{z g(w_pᵀz)} - E{g'(w_pᵀz)} w_p
+			// and orthogonalize w_p against the rows
+			// already found.
+			uv := mat.NewVecDense(n, u)
+			uv.MulVec(z, mat.NewVecDense(k, wp))
+			var dg float64
+			for i, v := range u {
+				var dv float64
+				u[i], dv = c.Derivatives(v)
+				dg += dv
+			}
+			mat.NewVecDense(k, wNew).MulVec(z.T(), uv)
+			floats.Scale(1/float64(n), wNew)
+			floats.AddScaled(wNew, -dg/float64(n), wp)
+			decorrelate(wNew, w, p)
+			floats.Scale(1/floats.Norm(wNew, 2), wNew)
+
+			delta := math.Abs(math.Abs(floats.Dot(wNew, wp)) - 1)
+			copy(wp, wNew)
+			if delta < tol {
+				ok = true
+				break
+			}
+		}
+		iter = max(iter, min(it, maxIter))
+		converged = converged && ok
+	}
+	return iter, converged
+}
+
+// decorrelate removes from v its projections onto the first p rows of w,
+// which must be orthonormal.
+func decorrelate(v []float64, w *mat.Dense, p int) {
+	for j := 0; j < p; j++ {
+		row := w.RawRowView(j)
+		floats.AddScaled(v, -floats.Dot(v, row), row)
+	}
+}
+
+// symDecorrelate replaces w with (w*wᵀ)^{-1/2} * w, the orthogonal matrix
+// closest to w.
+func symDecorrelate(w *mat.Dense) {
+	k, _ := w.Dims()
+	s := mat.NewSymDense(k, nil)
+	s.SymOuterK(1, w)
+	var ed mat.EigenSym
+	ok := ed.Factorize(s, true)
+	if !ok {
+		panic("ica: eigendecomposition failed")
+	}
+	vals := ed.Values(nil)
+	var vecs mat.Dense
+	ed.VectorsTo(&vecs)
+	var scaled mat.Dense
+	scaled.Apply(func(_, j int, v float64) float64 {
+		return v / math.Sqrt(vals[j])
+	}, &vecs)
+	var inv mat.Dense
+	inv.Mul(&scaled, vecs.T())
+	var tmp mat.Dense
+	tmp.Mul(&inv, w)
+	w.Copy(&tmp)
+}
@@ -0,0 +1,50 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ica_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/ica"
+)
+
+func ExampleFastICA() {
+	// Record two mixtures of a sine wave and a sawtooth wave.
+	const n = 1000
+	sine := make([]float64, n)
+	saw := make([]float64, n)
+	x := mat.NewDense(n, 2, nil)
+	for i := range sine {
+		t := float64(i) / n
+		sine[i] = math.Sin(2 * math.Pi * 5 * t)
+		saw[i] = 2*math.Mod(3*t, 1) - 1
+		x.Set(i, 0, 0.6*sine[i]+0.4*saw[i])
+		x.Set(i, 1, 0.3*sine[i]+0.7*saw[i])
+	}
+
+	r, err := ica.FastICA(x, &ica.Settings{Src: rand.NewPCG(1, 1)})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The sources are recovered up to their order and sign.
+	for j, want := range [][]float64{sine, saw} {
+		best := 0.0
+		for k := 0; k < 2; k++ {
+			got := mat.Col(nil, k, r.Sources)
+			best = math.Max(best, math.Abs(stat.Correlation(got, want, nil)))
+		}
+		fmt.Printf("source %d: |correlation| = %.2f\n", j, best)
+	}
+
+	// Output:
+	// source 0: |correlation| = 1.00
+	// source 1: |correlation| = 1.00
+}
@@ -0,0 +1,251 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ica
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+// mixture returns n observations of a random linear mixture of d ≤ 4
+// independent non-Gaussian sources, and the sources.
+func mixture(rnd *rand.Rand, n, d int) (x, s *mat.Dense) {
+	s = mat.NewDense(n, d, nil)
+	for i := 0; i < n; i++ {
+		t := float64(i) / float64(n)
+		row := s.RawRowView(i)
+		for j := range row {
+			switch j {
+			case 0:
+				row[j] = math.Sin(2 * math.Pi * 13 * t)
+			case 1:
+				// A square wave.
+				row[j] = math.Copysign(1, math.Sin(2*math.Pi*7*t))
+			case 2:
+				// Uniform noise.
+				row[j] = 2*rnd.Float64() - 1
+			case 3:
+				// Laplacian noise.
+				row[j] = rnd.ExpFloat64()
+				if rnd.Float64() < 0.5 {
+					row[j] *= -1
+				}
+			}
+		}
+	}
+	a := mat.NewDense(d, d, nil)
+	for i := 0; i < d; i++ {
+		for j := 0; j < d; j++ {
+			a.Set(i, j, rnd.Float64())
+		}
+	}
+	x = &mat.Dense{}
+	x.Mul(s, a.T())
+	for i := 0; i < n; i++ {
+		row := x.RawRowView(i)
+		for j := range row {
+			row[j] += float64(j + 1)
+		}
+	}
+	return x, s
+}
+
+// matchSources returns, for each source in the columns of want, the largest
+// absolute correlation with a column of got, and whether each column of got
+// is matched with a different source.
+func matchSources(got, want *mat.Dense) (corr []float64, distinct bool) {
+	n, d := want.Dims()
+	_, k := got.Dims()
+	corr = make([]float64, d)
+	seen := make(map[int]bool)
+	a := make([]float64, n)
+	b := make([]float64, n)
+	for j := 0; j < d; j++ {
+		mat.Col(a, j, want)
+		best := -1
+		for l := 0; l < k; l++ {
+			mat.Col(b, l, got)
+			c := math.Abs(stat.Correlation(a, b, nil))
+			if c > corr[j] {
+				corr[j] = c
+				best = l
+			}
+		}
+		seen[best] = true
+	}
+	return corr, len(seen) == d
+}
+
+func TestFastICA(t *testing.T) {
+	t.Parallel()
+	const (
+		n       = 2000
+		minCorr = 0.99
+		tol     = 1e-10
+	)
+	for _, d := range []int{2, 3, 4} {
+		for _, deflation := range []bool{false, true} {
+			for _, contrast := range []Contrast{LogCosh{}, LogCosh{Alpha: 1.5}, Exp{}, Cube{}} {
+				name := fmt.Sprintf("d=%d,deflation=%t,contrast=%#v", d, deflation, contrast)
+				rnd := rand.New(rand.NewPCG(1, 1))
+				x, s := mixture(rnd, n, d)
+
+				r, err := FastICA(x, &Settings{
+					Deflation: deflation,
+					Contrast:  contrast,
+					Src:       rand.NewPCG(2, 2),
+				})
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				if !r.Converged {
+					t.Errorf("%s: did not converge in %d iterations", name, r.Iterations)
+				}
+
+				corr, distinct := matchSources(r.Sources, s)
+				if !distinct {
+					t.Errorf("%s: sources not separated: correlations=%v", name, corr)
+				}
+				for j, c := range corr {
+					if c < minCorr {
+						t.Errorf("%s: source %d not recovered: correlation=%v", name, j, c)
+					}
+				}
+
+				var eye mat.Dense
+				eye.Mul(r.Unmixing, r.Mixing)
+				if !mat.EqualApprox(&eye, mat.NewDiagDense(d, ones(d)), tol) {
+					t.Errorf("%s: Unmixing*Mixing is not the identity:\n%v", name, mat.Formatted(&eye))
+				}
+
+				var cov mat.SymDense
+				stat.CovarianceMatrix(&cov, r.Sources, nil)
+				if !mat.EqualApprox(&cov, mat.NewDiagDense(d, ones(d)), tol) {
+					t.Errorf("%s: sources are not white:\n%v", name, mat.Formatted(&cov))
+				}
+
+				var got mat.Dense
+				r.Transform(&got, x)
+				if !mat.EqualApprox(&got, r.Sources, tol) {
+					t.Errorf("%s: Transform does not reproduce the sources", name)
+				}
+
+				var back mat.Dense
+				back.Mul(r.Sources, r.Mixing.T())
+				for i := 0; i < n; i++ {
+					row := back.RawRowView(i)
+					for j := range row {
+						row[j] += r.Mean[j]
+					}
+				}
+				if !mat.EqualApprox(&back, x, tol) {
+					t.Errorf("%s: Mixing does not reconstruct the observations", name)
+				}
+			}
+		}
+	}
+}
+
+func TestFastICAComponents(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, _ := mixture(rnd, 500, 4)
+	for _, deflation := range []bool{false, true} {
+		r, err := FastICA(x, &Settings{Components: 2, Deflation: deflation, Src: rand.NewPCG(1, 1)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rows, cols := r.Unmixing.Dims(); rows != 2 || cols != 4 {
+			t.Errorf("unexpected unmixing dimensions: got %d×%d, want 2×4", rows, cols)
+		}
+		if rows, cols := r.Sources.Dims(); rows != 500 || cols != 2 {
+			t.Errorf("unexpected sources dimensions: got %d×%d, want 500×2", rows, cols)
+		}
+		var eye mat.Dense
+		eye.Mul(r.Unmixing, r.Mixing)
+		if !mat.EqualApprox(&eye, mat.NewDiagDense(2, ones(2)), 1e-10) {
+			t.Errorf("Unmixing*Mixing is not the identity:\n%v", mat.Formatted(&eye))
+		}
+	}
+}
+
+func TestFastICARankDeficient(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, _ := mixture(rnd, 200, 2)
+	dup := mat.NewDense(200, 3, nil)
+	for i := 0; i < 200; i++ {
+		dup.Set(i, 0, x.At(i, 0))
+		dup.Set(i, 1, x.At(i, 1))
+		dup.Set(i, 2, 2*x.At(i, 0))
+	}
+	_, err := FastICA(dup, nil)
+	if err != ErrRankDeficient {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrRankDeficient)
+	}
+	_, err = FastICA(dup, &Settings{Components: 2, Src: rand.NewPCG(1, 1)})
+	if err != nil {
+		t.Errorf("unexpected error with two components: %v", err)
+	}
+}
+
+func TestFastICAPanics(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, _ := mixture(rnd, 50, 3)
+	for _, test := range []struct {
+		name     string
+		x        mat.Matrix
+		settings *Settings
+	}{
+		{name: "one observation", x: mat.NewDense(1, 3, nil)},
+		{name: "negative components", x: x, settings: &Settings{Components: -1}},
+		{name: "too many components", x: x, settings: &Settings{Components: 4}},
+		{name: "bad init", x: x, settings: &Settings{Init: mat.NewDense(2, 2, nil)}},
+	} {
+		if !panics(func() { FastICA(test.x, test.settings) }) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func TestContrastDerivatives(t *testing.T) {
+	t.Parallel()
+	for _, c := range []Contrast{LogCosh{}, LogCosh{Alpha: 2}, Exp{}, Cube{}} {
+		for _, u := range []float64{-3, -1, -0.2, 0, 0.5, 2} {
+			_, dg := c.Derivatives(u)
+			want := fd.Derivative(func(u float64) float64 {
+				g, _ := c.Derivatives(u)
+				return g
+			}, u, &fd.Settings{Formula: fd.Central})
+			if math.Abs(dg-want) > 1e-6 {
+				t.Errorf("%#v: unexpected second derivative at %v: got %v, want %v", c, u, dg, want)
+			}
+		}
+	}
+}
+
+func ones(n int) []float64 {
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = 1
+	}
+	return v
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
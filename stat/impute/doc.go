@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package impute provides simple imputation of missing values in data
+// matrices.
+//
+// Missing values are represented by NaN. The data are held in the rows of a
+// matrix, with one row per observation and one column per variable, and the
+// imputation functions replace the missing values in place by the column
+// mean or median, or by the mean of the values of the nearest neighboring
+// observations.
+package impute // import "gonum.org/v1/gonum/stat/impute"
@@ -0,0 +1,205 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package impute
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+// Mean replaces the missing values in each column of x with the mean of the
+// observed values in the column. Mean returns an error without modifying x
+// if a column with missing values has no observed values.
+func Mean(x *mat.Dense) error {
+	return fill(x, func(col []float64) float64 {
+		return stat.NaNMean(col, nil)
+	})
+}
+
+// Median replaces the missing values in each column of x with the median of
+// the observed values in the column. The median of an even number of values
+// is the mean of the two middle values. Median returns an error without
+// modifying x if a column with missing values has no observed values.
+func Median(x *mat.Dense) error {
+	return fill(x, func(col []float64) float64 {
+		obs := observed(col)
+		sort.Float64s(obs)
+		n := len(obs)
+		if n%2 == 1 {
+			return obs[n/2]
+		}
+		return (obs[n/2-1] + obs[n/2]) / 2
+	})
+}
+
+// fill replaces the missing values in each column of x with the value
+// returned by fn for the column, checking that all the columns with missing
+// values have observed values before modifying x.
+func fill(x *mat.Dense, fn func(col []float64) float64) error {
+	cols, err := columns(x)
+	if err != nil {
+		return err
+	}
+	for j, col := range cols {
+		if col == nil {
+			continue
+		}
+		v := fn(col)
+		for i, xi := range col {
+			if math.IsNaN(xi) {
+				x.Set(i, j, v)
+			}
+		}
+	}
+	return nil
+}
+
+// KNN replaces the missing values in x using k-nearest neighbor imputation.
+// A missing value in column j of a row is replaced with the mean of the
+// values in column j of the k rows nearest to it among the rows in which
+// column j is observed. Fewer than k rows are used if there are not enough
+// rows with column j observed.
+//
+// The distance between two rows is the Euclidean distance over the columns
+// observed in both rows, scaled by the square root of the ratio of the number
+// of columns to the number of columns observed in both,
+//
+//	d(a, b) = sqrt(c/m ∑_j (a_j - b_j)^2),
+//
+// where the sum is over the m columns observed in both rows and c is the
+// number of columns of x. Rows with no columns observed in common are not
+// neighbors, and if a row has no neighbors with column j observed, its
+// missing value in column j is replaced with the mean of the column. Since
+// all the columns contribute equally to the distance, they should be measured
+// on comparable scales.
+//
+// Neighbors are found using the observed values of x, so imputed values do
+// not affect the imputation of other values. KNN returns an error without
+// modifying x if a column with missing values has no observed values. KNN
+// panics if k is less than one.
+func KNN(x *mat.Dense, k int) error {
+	if k < 1 {
+		panic("impute: k less than one")
+	}
+	cols, err := columns(x)
+	if err != nil {
+		return err
+	}
+	r, c := x.Dims()
+	orig := mat.DenseCopyOf(x)
+
+	type neighbor struct {
+		row  int
+		dist float64
+	}
+	var neighbors []neighbor
+	for i := 0; i < r; i++ {
+		a := orig.RawRowView(i)
+		if !hasNaN(a) {
+			continue
+		}
+		// Find the distances to the rows with
+		// columns observed in common with row i.
+		neighbors = neighbors[:0]
+		for l := 0; l < r; l++ {
+			if l == i {
+				continue
+			}
+			b := orig.RawRowView(l)
+			var ss float64
+			var m int
+			for j, v := range a {
+				if math.IsNaN(v) || math.IsNaN(b[j]) {
+					continue
+				}
+				d := v - b[j]
+				ss += d * d
+				m++
+			}
+			if m == 0 {
+				continue
+			}
+			neighbors = append(neighbors, neighbor{row: l, dist: math.Sqrt(float64(c) / float64(m) * ss)})
+		}
+		sort.SliceStable(neighbors, func(p, q int) bool {
+			return neighbors[p].dist < neighbors[q].dist
+		})
+
+		for j, v := range a {
+			if !math.IsNaN(v) {
+				continue
+			}
+			var sum float64
+			var n int
+			for _, nb := range neighbors {
+				if n == k {
+					break
+				}
+				u := orig.At(nb.row, j)
+				if math.IsNaN(u) {
+					continue
+				}
+				sum += u
+				n++
+			}
+			if n == 0 {
+				x.Set(i, j, stat.NaNMean(cols[j], nil))
+				continue
+			}
+			x.Set(i, j, sum/float64(n))
+		}
+	}
+	return nil
+}
+
+// columns returns the columns of x that have missing values, with nil for
+// the complete columns. columns returns an error if a column has no observed
+// values.
+func columns(x *mat.Dense) ([][]float64, error) {
+	r, c := x.Dims()
+	cols := make([][]float64, c)
+	for j := range cols {
+		col := mat.Col(nil, j, x)
+		var missing int
+		for _, v := range col {
+			if math.IsNaN(v) {
+				missing++
+			}
+		}
+		if missing == 0 {
+			continue
+		}
+		if missing == r {
+			return nil, fmt.Errorf("impute: no observed values in column %d", j)
+		}
+		cols[j] = col
+	}
+	return cols, nil
+}
+
+// observed returns the values of x that are not NaN.
+func observed(x []float64) []float64 {
+	var obs []float64
+	for _, v := range x {
+		if !math.IsNaN(v) {
+			obs = append(obs, v)
+		}
+	}
+	return obs
+}
+
+// hasNaN returns whether x contains a NaN.
+func hasNaN(x []float64) bool {
+	for _, v := range x {
+		if math.IsNaN(v) {
+			return true
+		}
+	}
+	return false
+}
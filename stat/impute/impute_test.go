@@ -0,0 +1,161 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package impute
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var nan = math.NaN()
+
+func TestImpute(t *testing.T) {
+	t.Parallel()
+	data := mat.NewDense(4, 3, []float64{
+		1, 2, nan,
+		3, nan, 6,
+		nan, 4, 9,
+		5, 8, 3,
+	})
+	for _, test := range []struct {
+		name string
+		fn   func(*mat.Dense) error
+		x    *mat.Dense
+		want *mat.Dense
+	}{
+		{
+			name: "Mean",
+			fn:   Mean,
+			x:    data,
+			want: mat.NewDense(4, 3, []float64{
+				1, 2, 6,
+				3, 14.0 / 3, 6,
+				3, 4, 9,
+				5, 8, 3,
+			}),
+		},
+		{
+			name: "Median",
+			fn:   Median,
+			x:    data,
+			want: mat.NewDense(4, 3, []float64{
+				1, 2, 6,
+				3, 4, 6,
+				3, 4, 9,
+				5, 8, 3,
+			}),
+		},
+		{
+			name: "Median even",
+			fn:   Median,
+			x: mat.NewDense(3, 1, []float64{
+				4, nan, 1,
+			}),
+			want: mat.NewDense(3, 1, []float64{
+				4, 2.5, 1,
+			}),
+		},
+		{
+			name: "KNN k=1",
+			fn:   func(x *mat.Dense) error { return KNN(x, 1) },
+			x:    data,
+			want: mat.NewDense(4, 3, []float64{
+				1, 2, 6,
+				3, 2, 6,
+				1, 4, 9,
+				5, 8, 3,
+			}),
+		},
+		{
+			name: "KNN k=2",
+			fn:   func(x *mat.Dense) error { return KNN(x, 2) },
+			x:    data,
+			want: mat.NewDense(4, 3, []float64{
+				1, 2, 7.5,
+				3, 5, 6,
+				2, 4, 9,
+				5, 8, 3,
+			}),
+		},
+		{
+			name: "KNN large k",
+			fn:   func(x *mat.Dense) error { return KNN(x, 10) },
+			x:    data,
+			want: mat.NewDense(4, 3, []float64{
+				1, 2, 6,
+				3, 14.0 / 3, 6,
+				3, 4, 9,
+				5, 8, 3,
+			}),
+		},
+		{
+			name: "KNN no neighbors",
+			fn:   func(x *mat.Dense) error { return KNN(x, 1) },
+			x: mat.NewDense(2, 2, []float64{
+				1, nan,
+				nan, 2,
+			}),
+			want: mat.NewDense(2, 2, []float64{
+				1, 2,
+				1, 2,
+			}),
+		},
+		{
+			name: "complete",
+			fn:   Mean,
+			x:    mat.NewDense(2, 2, []float64{1, 2, 3, 4}),
+			want: mat.NewDense(2, 2, []float64{1, 2, 3, 4}),
+		},
+	} {
+		x := mat.DenseCopyOf(test.x)
+		err := test.fn(x)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		if !mat.EqualApprox(x, test.want, 1e-14) {
+			t.Errorf("unexpected result for %s:\ngot:\n%v\nwant:\n%v",
+				test.name, mat.Formatted(x), mat.Formatted(test.want))
+		}
+	}
+}
+
+func TestImputeErrors(t *testing.T) {
+	t.Parallel()
+	data := mat.NewDense(2, 2, []float64{
+		nan, nan,
+		nan, 1,
+	})
+	for _, test := range []struct {
+		name string
+		fn   func(*mat.Dense) error
+	}{
+		{name: "Mean", fn: Mean},
+		{name: "Median", fn: Median},
+		{name: "KNN", fn: func(x *mat.Dense) error { return KNN(x, 1) }},
+	} {
+		x := mat.DenseCopyOf(data)
+		err := test.fn(x)
+		if err == nil {
+			t.Errorf("expected error for %s", test.name)
+		}
+		if !math.IsNaN(x.At(0, 1)) {
+			t.Errorf("unexpected modification of data for %s", test.name)
+		}
+	}
+	if !panics(func() { _ = KNN(mat.NewDense(1, 1, nil), 0) }) {
+		t.Error("expected panic for zero k")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
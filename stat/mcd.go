@@ -0,0 +1,353 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+)
+
+const (
+	// mcdTrials is the number of random initial subsets
+	// drawn by the FastMCD algorithm.
+	mcdTrials = 500
+	// mcdBest is the number of subsets with the smallest
+	// determinant that are iterated to convergence.
+	mcdBest = 10
+	// mcdQuantile is the quantile of the χ² distribution
+	// beyond which observations are excluded from the
+	// reweighted estimate.
+	mcdQuantile = 0.975
+)
+
+// MinCovDet is a type for computing the minimum covariance determinant (MCD)
+// robust estimate of location and covariance of a matrix of data, and the
+// robust distances of the observations that identify outliers. The results
+// are only valid if the call to Fit was successful.
+type MinCovDet struct {
+	n, p int
+
+	rawLoc []float64
+	rawCov mat.SymDense
+
+	loc     []float64
+	cov     mat.SymDense
+	dist    []float64
+	support []bool
+
+	ok bool
+}
+
+// Fit computes the minimum covariance determinant estimate for the n×p matrix
+// of data x, where each row is an observation and each column is a variable,
+// using the FastMCD algorithm of Rousseeuw and Van Driessen.
+//
+// The raw MCD estimate is the mean and covariance of the h observations whose
+// covariance has the smallest determinant, scaled to be consistent for
+// Gaussian data. If h is zero, the default of (n+p+1)/2 is used, which gives
+// the estimate its highest breakdown point. Fit panics if h is not zero and
+// not in (p, n]. The observations whose robust distance from the raw estimate
+// is within the 97.5% quantile of the χ² distribution with p degrees of
+// freedom form the support of the reweighted estimate, which is the maximum
+// likelihood estimate of the mean and covariance of the support.
+//
+// Random initial subsets are drawn from src. If src is nil, the global
+// source is used.
+//
+// Fit returns whether the estimate was successful. It is not if the data are
+// concentrated on a hyperplane, so the covariance of h observations is
+// singular.
+//
+// See P. J. Rousseeuw and K. Van Driessen, "A fast algorithm for the minimum
+// covariance determinant estimator", Technometrics 41(3), 1999 for details.
+func (m *MinCovDet) Fit(x mat.Matrix, h int, src rand.Source) (ok bool) {
+	n, p := x.Dims()
+	if h == 0 {
+		h = (n + p + 1) / 2
+	}
+	if h <= p || h > n {
+		panic("stat: invalid MCD subset size")
+	}
+	m.n, m.p = n, p
+	m.ok = false
+
+	perm := rand.Perm
+	if src != nil {
+		perm = rand.New(src).Perm
+	}
+	var xd mat.Dense
+	xd.CloneFrom(x)
+	var best []*mcdSubset
+	if h == n {
+		// All the observations are used.
+		best = append(best, newMCDSubset(&xd, perm(n)))
+	} else {
+		for t := 0; t < mcdTrials; t++ {
+			idx := perm(n)
+			// Start from p+1 observations, adding more until
+			// their covariance is non-singular.
+			var s *mcdSubset
+			for k := p + 1; k <= n; k++ {
+				s = newMCDSubset(&xd, idx[:k])
+				if s.ok {
+					break
+				}
+			}
+			if !s.ok {
+				return false
+			}
+			for i := 0; i < 2 && s.ok; i++ {
+				s = s.step(&xd, h)
+			}
+			if !s.ok {
+				// The h observations lie on a hyperplane.
+				return false
+			}
+			best = append(best, s)
+			sort.SliceStable(best, func(i, j int) bool { return best[i].logDet < best[j].logDet })
+			if len(best) > mcdBest {
+				best = best[:mcdBest]
+			}
+		}
+	}
+
+	var opt *mcdSubset
+	for _, s := range best {
+		for {
+			next := s.step(&xd, h)
+			if !next.ok {
+				return false
+			}
+			if next.logDet >= s.logDet {
+				break
+			}
+			s = next
+		}
+		if opt == nil || s.logDet < opt.logDet {
+			opt = s
+		}
+	}
+
+	// Scale the raw estimate so the median squared distance
+	// matches that of the χ² distribution with p degrees of
+	// freedom.
+	dist := opt.distances(&xd, nil)
+	med := append([]float64(nil), dist...)
+	sort.Float64s(med)
+	scale := (med[(n-1)/2] + med[n/2]) / 2 / chiSquareQuantile(0.5, p)
+	m.rawLoc = append(m.rawLoc[:0], opt.loc...)
+	m.rawCov.Reset()
+	m.rawCov.ScaleSym(scale, opt.cov)
+	floats.Scale(1/scale, dist)
+
+	// Reweight by excluding the observations far from the
+	// raw estimate.
+	limit := chiSquareQuantile(mcdQuantile, p)
+	var idx []int
+	m.support = m.support[:0]
+	for i, d := range dist {
+		in := d <= limit
+		m.support = append(m.support, in)
+		if in {
+			idx = append(idx, i)
+		}
+	}
+	s := newMCDSubset(&xd, idx)
+	if !s.ok {
+		return false
+	}
+	m.loc = append(m.loc[:0], s.loc...)
+	m.cov.Reset()
+	m.cov.ScaleSym(1, s.cov)
+	m.dist = s.distances(&xd, m.dist)
+	for i, d := range m.dist {
+		m.dist[i] = math.Sqrt(d)
+	}
+	m.ok = true
+	return true
+}
+
+// LocationTo returns the reweighted robust estimate of the mean.
+//
+// If dst is not nil, the location is stored in dst and returned, otherwise
+// a new slice is allocated first. If dst is not nil, it must have length
+// equal to the number of variables. LocationTo will panic if the receiver
+// does not contain a successful estimate.
+func (m *MinCovDet) LocationTo(dst []float64) []float64 {
+	return m.vecTo(dst, m.loc)
+}
+
+// CovarianceTo stores the reweighted robust estimate of the covariance in dst.
+//
+// If dst is empty, CovarianceTo will resize dst to be p×p. When dst is
+// non-empty, CovarianceTo will panic if dst is not p×p. CovarianceTo will
+// also panic if the receiver does not contain a successful estimate.
+func (m *MinCovDet) CovarianceTo(dst *mat.SymDense) {
+	m.symTo(dst, &m.cov)
+}
+
+// RawLocationTo returns the raw MCD estimate of the mean, the mean of the h
+// observations with the smallest covariance determinant.
+//
+// If dst is not nil, the location is stored in dst and returned, otherwise
+// a new slice is allocated first. If dst is not nil, it must have length
+// equal to the number of variables. RawLocationTo will panic if the receiver
+// does not contain a successful estimate.
+func (m *MinCovDet) RawLocationTo(dst []float64) []float64 {
+	return m.vecTo(dst, m.rawLoc)
+}
+
+// RawCovarianceTo stores the raw MCD estimate of the covariance, the
+// consistency-corrected covariance of the h observations with the smallest
+// covariance determinant, in dst.
+//
+// If dst is empty, RawCovarianceTo will resize dst to be p×p. When dst is
+// non-empty, RawCovarianceTo will panic if dst is not p×p. RawCovarianceTo
+// will also panic if the receiver does not contain a successful estimate.
+func (m *MinCovDet) RawCovarianceTo(dst *mat.SymDense) {
+	m.symTo(dst, &m.rawCov)
+}
+
+// DistancesTo returns the robust distances of the observations, their
+// Mahalanobis distances from the reweighted location under the reweighted
+// covariance. For Gaussian data the squared distances approximately follow
+// the χ² distribution with p degrees of freedom, so observations with large
+// distances are outliers.
+//
+// If dst is not nil, the distances are stored in dst and returned, otherwise
+// a new slice is allocated first. If dst is not nil, it must have length
+// equal to the number of observations. DistancesTo will panic if the receiver
+// does not contain a successful estimate.
+func (m *MinCovDet) DistancesTo(dst []float64) []float64 {
+	return m.vecTo(dst, m.dist)
+}
+
+// SupportTo returns whether each observation is in the support of the
+// reweighted estimate.
+//
+// If dst is not nil, the support is stored in dst and returned, otherwise
+// a new slice is allocated first. If dst is not nil, it must have length
+// equal to the number of observations. SupportTo will panic if the receiver
+// does not contain a successful estimate.
+func (m *MinCovDet) SupportTo(dst []bool) []bool {
+	if !m.ok {
+		panic("stat: use of unsuccessful minimum covariance determinant estimate")
+	}
+	if dst == nil {
+		dst = make([]bool, m.n)
+	}
+	if len(dst) != m.n {
+		panic("stat: slice length mismatch")
+	}
+	copy(dst, m.support)
+	return dst
+}
+
+func (m *MinCovDet) vecTo(dst, src []float64) []float64 {
+	if !m.ok {
+		panic("stat: use of unsuccessful minimum covariance determinant estimate")
+	}
+	if dst == nil {
+		dst = make([]float64, len(src))
+	}
+	if len(dst) != len(src) {
+		panic("stat: slice length mismatch")
+	}
+	copy(dst, src)
+	return dst
+}
+
+func (m *MinCovDet) symTo(dst, src *mat.SymDense) {
+	if !m.ok {
+		panic("stat: use of unsuccessful minimum covariance determinant estimate")
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(m.p)
+	} else if dst.SymmetricDim() != m.p {
+		panic(mat.ErrShape)
+	}
+	dst.CopySym(src)
+}
+
+// mcdSubset is the maximum likelihood estimate of the mean and
+// covariance of a subset of observations.
+type mcdSubset struct {
+	loc    []float64
+	cov    *mat.SymDense
+	chol   mat.Cholesky
+	logDet float64
+	ok     bool
+}
+
+// newMCDSubset returns the estimate for the rows of x in idx.
+func newMCDSubset(x *mat.Dense, idx []int) *mcdSubset {
+	_, p := x.Dims()
+	s := &mcdSubset{loc: make([]float64, p)}
+	for _, i := range idx {
+		floats.Add(s.loc, x.RawRowView(i))
+	}
+	floats.Scale(1/float64(len(idx)), s.loc)
+	xc := mat.NewDense(len(idx), p, nil)
+	for k, i := range idx {
+		floats.SubTo(xc.RawRowView(k), x.RawRowView(i), s.loc)
+	}
+	s.cov = mat.NewSymDense(p, nil)
+	s.cov.SymOuterK(1/float64(len(idx)), xc.T())
+	s.ok = s.chol.Factorize(s.cov)
+	if s.ok {
+		s.logDet = s.chol.LogDet()
+		s.ok = !math.IsInf(s.logDet, -1)
+	}
+	return s
+}
+
+// distances stores the squared Mahalanobis distances of the rows of x
+// under the estimate into dst and returns it.
+func (s *mcdSubset) distances(x *mat.Dense, dst []float64) []float64 {
+	n, p := x.Dims()
+	z := mat.NewDense(n, p, nil)
+	for i := 0; i < n; i++ {
+		floats.SubTo(z.RawRowView(i), x.RawRowView(i), s.loc)
+	}
+	// With the covariance factorized as Uᵀ U, the squared
+	// distance of d is the squared norm of dᵀ U⁻¹.
+	var u mat.TriDense
+	s.chol.UTo(&u)
+	blas64.Trsm(blas.Right, blas.NoTrans, 1, u.RawTriangular(), z.RawMatrix())
+	if cap(dst) < n {
+		dst = make([]float64, n)
+	}
+	dst = dst[:n]
+	for i := range dst {
+		r := z.RawRowView(i)
+		dst[i] = floats.Dot(r, r)
+	}
+	return dst
+}
+
+// step performs a concentration step, returning the estimate for the h
+// observations closest to s.
+func (s *mcdSubset) step(x *mat.Dense, h int) *mcdSubset {
+	dist := s.distances(x, nil)
+	idx := make([]int, len(dist))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return dist[idx[i]] < dist[idx[j]] })
+	return newMCDSubset(x, idx[:h])
+}
+
+// chiSquareQuantile returns the q quantile of the χ² distribution with k
+// degrees of freedom.
+func chiSquareQuantile(q float64, k int) float64 {
+	return 2 * mathext.GammaIncRegInv(float64(k)/2, q)
+}
@@ -0,0 +1,159 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestMinCovDet(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const (
+		n        = 200
+		p        = 3
+		outliers = 40
+	)
+	// Correlated Gaussian data with a cluster of outliers.
+	x := mat.NewDense(n, p, nil)
+	for i := 0; i < n; i++ {
+		z := rnd.NormFloat64()
+		for j := 0; j < p; j++ {
+			v := z + rnd.NormFloat64()
+			if i < outliers {
+				v = 0.5*rnd.NormFloat64() + 8
+			}
+			x.Set(i, j, v)
+		}
+	}
+
+	var mcd MinCovDet
+	if !mcd.Fit(x, 0, rand.NewPCG(1, 1)) {
+		t.Fatal("unexpected failure")
+	}
+	loc := mcd.LocationTo(nil)
+	for j, v := range loc {
+		if math.Abs(v) > 0.3 {
+			t.Errorf("unexpected robust location %d: got:%v want:0", j, v)
+		}
+	}
+	// The true covariance has 2 on the diagonal and 1 elsewhere.
+	var cov mat.SymDense
+	mcd.CovarianceTo(&cov)
+	for i := 0; i < p; i++ {
+		for j := 0; j < p; j++ {
+			want := 1.0
+			if i == j {
+				want = 2
+			}
+			if math.Abs(cov.At(i, j)-want) > 0.6 {
+				t.Errorf("unexpected robust covariance [%d,%d]: got:%v want:%v", i, j, cov.At(i, j), want)
+			}
+		}
+	}
+
+	support := mcd.SupportTo(nil)
+	dist := mcd.DistancesTo(nil)
+	limit := math.Sqrt(chiSquareQuantile(mcdQuantile, p))
+	var excluded int
+	for i, in := range support {
+		if i < outliers {
+			if in {
+				t.Errorf("outlier %d in support", i)
+			}
+			if dist[i] < 2*limit {
+				t.Errorf("unexpectedly small distance of outlier %d: %v", i, dist[i])
+			}
+		} else if !in {
+			excluded++
+		}
+	}
+	if excluded > n/10 {
+		t.Errorf("too many inliers excluded from support: %d", excluded)
+	}
+
+	// The raw estimate also resists the outliers.
+	var rawCov mat.SymDense
+	mcd.RawCovarianceTo(&rawCov)
+	rawLoc := mcd.RawLocationTo(make([]float64, p))
+	if floats.Norm(rawLoc, 2) > 0.5 {
+		t.Errorf("unexpected raw location: %v", rawLoc)
+	}
+	var chol mat.Cholesky
+	if !chol.Factorize(&rawCov) {
+		t.Errorf("raw covariance not positive definite")
+	}
+
+	// The classical estimate does not.
+	if m := Mean(mat.Col(nil, 0, x), nil); m < 1 {
+		t.Errorf("unexpected classical mean: %v", m)
+	}
+
+	// The estimate is reproducible.
+	var again MinCovDet
+	again.Fit(x, 0, rand.NewPCG(1, 1))
+	if !floats.Same(again.DistancesTo(nil), dist) {
+		t.Errorf("estimate not reproducible")
+	}
+}
+
+func TestMinCovDetAll(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n, p = 30, 2
+	x := mat.NewDense(n, p, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < p; j++ {
+			x.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	// With h equal to n, the raw estimate is the scaled
+	// classical estimate.
+	var mcd MinCovDet
+	if !mcd.Fit(x, n, nil) {
+		t.Fatal("unexpected failure")
+	}
+	for j, v := range mcd.RawLocationTo(nil) {
+		if want := Mean(mat.Col(nil, j, x), nil); math.Abs(v-want) > 1e-14 {
+			t.Errorf("unexpected raw location %d: got:%v want:%v", j, v, want)
+		}
+	}
+	var raw, cov mat.SymDense
+	mcd.RawCovarianceTo(&raw)
+	CovarianceMatrix(&cov, x, nil)
+	ratio := raw.At(0, 0) / cov.At(0, 0)
+	var want mat.SymDense
+	want.ScaleSym(ratio, &cov)
+	if !mat.EqualApprox(&raw, &want, 1e-12) {
+		t.Errorf("raw covariance not proportional to classical covariance:\ngot: %v\nwant:%v", mat.Formatted(&raw), mat.Formatted(&want))
+	}
+}
+
+func TestMinCovDetSingular(t *testing.T) {
+	t.Parallel()
+	// The observations lie on a line.
+	x := mat.NewDense(10, 2, nil)
+	for i := 0; i < 10; i++ {
+		x.Set(i, 0, float64(i))
+		x.Set(i, 1, 2*float64(i)+1)
+	}
+	var mcd MinCovDet
+	if mcd.Fit(x, 0, rand.NewPCG(1, 1)) {
+		t.Error("unexpected success for singular data")
+	}
+	if !panics(func() { mcd.LocationTo(nil) }) {
+		t.Error("expected panic for use of unsuccessful estimate")
+	}
+	for _, h := range []int{2, 11} {
+		if !panics(func() { mcd.Fit(x, h, nil) }) {
+			t.Errorf("expected panic for subset size %d", h)
+		}
+	}
+}
@@ -2,5 +2,6 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package mds provides multidimensional scaling functions.
+// Package mds provides multidimensional scaling functions and Procrustes
+// alignment of point configurations.
 package mds // import "gonum.org/v1/gonum/stat/mds"
@@ -0,0 +1,220 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mds
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+// ProcrustesKind specifies the transforms allowed by a Procrustes alignment.
+// The zero value allows rotation and translation.
+type ProcrustesKind int
+
+const (
+	// ProcrustesReflection allows the orthogonal transform to
+	// be a reflection as well as a rotation.
+	ProcrustesReflection ProcrustesKind = 1 << iota
+
+	// ProcrustesScaling allows uniform scaling.
+	ProcrustesScaling
+)
+
+// Procrustes is an alignment of one configuration of points to another by
+// the transform
+//
+//	x ↦ s·x·Q + t,
+//
+// for row vectors x, where Q is an orthogonal matrix, s is a scale factor
+// and t is a translation.
+type Procrustes struct {
+	kind  ProcrustesKind
+	q     *mat.Dense
+	scale float64
+	trans []float64
+
+	residual  float64
+	disparity float64
+}
+
+// Fit finds the transform of the allowed kind that minimizes the sum of
+// squared distances between the transformed rows of x and the
+// corresponding rows of y, and stores it in the receiver. The points of
+// each configuration are held in the rows of x and y. Fit returns whether
+// the alignment was successful.
+//
+// The solution is the orthogonal Procrustes solution Q = U·Vᵀ from the
+// singular value decomposition U·Σ·Vᵀ of x̃ᵀ·ỹ, where x̃ and ỹ are the
+// centered configurations. If reflections are not allowed and U·Vᵀ has a
+// negative determinant, the sign of the singular vector of the least
+// singular value is changed to give the best rotation.
+//
+// See Gower and Dijksterhuis, "Procrustes Problems", Oxford University
+// Press, 2004, chapters 3 and 4.
+//
+// Fit panics if x and y do not have the same dimensions or are empty.
+func (p *Procrustes) Fit(x, y mat.Matrix, kind ProcrustesKind) (ok bool) {
+	r, c := x.Dims()
+	ry, cy := y.Dims()
+	if r != ry || c != cy {
+		panic(mat.ErrShape)
+	}
+	if r == 0 || c == 0 {
+		panic(mat.ErrZeroLength)
+	}
+	*p = Procrustes{kind: kind}
+
+	xc, xMean := center(x)
+	yc, yMean := center(y)
+
+	var m mat.Dense
+	m.Mul(xc.T(), yc)
+	var svd mat.SVD
+	if !svd.Factorize(&m, mat.SVDFull) {
+		return false
+	}
+	var u, v mat.Dense
+	svd.UTo(&u)
+	svd.VTo(&v)
+	sigma := svd.Values(nil)
+	if kind&ProcrustesReflection == 0 {
+		var q mat.Dense
+		q.Mul(&u, v.T())
+		if mat.Det(&q) < 0 {
+			col := u.ColView(c - 1).(*mat.VecDense)
+			col.ScaleVec(-1, col)
+			sigma[c-1] = -sigma[c-1]
+		}
+	}
+	p.q = &mat.Dense{}
+	p.q.Mul(&u, v.T())
+
+	var trace float64
+	for _, s := range sigma {
+		trace += s
+	}
+	xss := mat.Dot(vec(xc), vec(xc))
+	yss := mat.Dot(vec(yc), vec(yc))
+	p.scale = 1
+	if kind&ProcrustesScaling != 0 && xss > 0 {
+		p.scale = trace / xss
+	}
+
+	// t = ȳ - s·x̄·Q.
+	p.trans = make([]float64, c)
+	t := mat.NewVecDense(c, p.trans)
+	t.MulVec(p.q.T(), mat.NewVecDense(c, xMean))
+	t.AddScaledVec(mat.NewVecDense(c, yMean), -p.scale, t)
+
+	var fit mat.Dense
+	fit.Mul(xc, p.q)
+	fit.Scale(p.scale, &fit)
+	fit.Sub(yc, &fit)
+	p.residual = mat.Dot(vec(&fit), vec(&fit))
+	if yss > 0 {
+		p.disparity = p.residual / yss
+	}
+	return true
+}
+
+// center returns the centered rows of m and their mean.
+func center(m mat.Matrix) (*mat.Dense, []float64) {
+	r, c := m.Dims()
+	d := mat.DenseCopyOf(m)
+	mean := make([]float64, c)
+	for j := range mean {
+		col := d.ColView(j).(*mat.VecDense)
+		mean[j] = mat.Sum(col) / float64(r)
+		for i := 0; i < r; i++ {
+			col.SetVec(i, col.AtVec(i)-mean[j])
+		}
+	}
+	return d, mean
+}
+
+// vec returns the elements of the contiguous matrix m as a vector.
+func vec(m *mat.Dense) *mat.VecDense {
+	raw := m.RawMatrix()
+	return mat.NewVecDense(raw.Rows*raw.Cols, raw.Data)
+}
+
+// Kind returns the kind of transforms allowed by the alignment.
+func (p *Procrustes) Kind() ProcrustesKind {
+	return p.kind
+}
+
+// RotationTo stores the orthogonal matrix Q of the transform into dst.
+// If dst is empty, RotationTo will resize dst to be c×c. When dst is
+// non-empty, RotationTo will panic if dst is not c×c. RotationTo will also
+// panic if the receiver does not contain a successful alignment.
+func (p *Procrustes) RotationTo(dst *mat.Dense) {
+	if p.q == nil {
+		panic("mds: no alignment")
+	}
+	if dst.IsEmpty() {
+		c, _ := p.q.Dims()
+		dst.ReuseAs(c, c)
+	}
+	dst.Copy(p.q)
+}
+
+// Scale returns the scale factor s of the transform, which is one unless
+// scaling is allowed.
+func (p *Procrustes) Scale() float64 {
+	return p.scale
+}
+
+// Translation returns the translation t of the transform. If dst is not
+// nil, the translation is stored in dst, which must have length c.
+func (p *Procrustes) Translation(dst []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(p.trans))
+	}
+	if len(dst) != len(p.trans) {
+		panic(mat.ErrShape)
+	}
+	copy(dst, p.trans)
+	return dst
+}
+
+// Residual returns the sum of squared distances between the transformed
+// points of x and the points of y.
+func (p *Procrustes) Residual() float64 {
+	return p.residual
+}
+
+// Disparity returns the residual of the alignment relative to the sum of
+// squared distances of the points of y from their mean. When scaling is
+// allowed, the disparity is between zero, for configurations that match
+// exactly, and one, for configurations with no relation.
+func (p *Procrustes) Disparity() float64 {
+	return p.disparity
+}
+
+// TransformTo stores the transforms of the points held in the rows of x
+// into dst. If dst is empty, TransformTo will resize dst to have the
+// dimensions of x. When dst is non-empty, TransformTo will panic if its
+// dimensions do not match those of x. TransformTo will also panic if the
+// receiver does not contain a successful alignment or if the number of
+// columns of x does not match the alignment.
+func (p *Procrustes) TransformTo(dst *mat.Dense, x mat.Matrix) {
+	if p.q == nil {
+		panic("mds: no alignment")
+	}
+	r, c := x.Dims()
+	if c != len(p.trans) {
+		panic(mat.ErrShape)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	}
+	dst.Mul(x, p.q)
+	dst.Scale(p.scale, dst)
+	for i := 0; i < r; i++ {
+		row := dst.RawRowView(i)
+		for j, t := range p.trans {
+			row[j] += t
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mds_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/mds"
+)
+
+func ExampleProcrustes() {
+	// A triangle and a copy rotated by 90°, doubled in size and
+	// shifted.
+	x := mat.NewDense(3, 2, []float64{
+		0, 0,
+		1, 0,
+		0, 1,
+	})
+	y := mat.NewDense(3, 2, []float64{
+		5, 5,
+		5, 7,
+		3, 5,
+	})
+
+	var p mds.Procrustes
+	if !p.Fit(x, y, mds.ProcrustesScaling) {
+		log.Fatal("alignment failed")
+	}
+	var q mat.Dense
+	p.RotationTo(&q)
+	fmt.Printf("rotation:\n%.3f\n", mat.Formatted(&q, mat.Squeeze()))
+	fmt.Printf("scale: %.3f\n", p.Scale())
+	fmt.Printf("translation: %.3f\n", p.Translation(nil))
+	fmt.Printf("disparity: %.3f\n", p.Disparity())
+
+	// Output:
+	// rotation:
+	// ⎡ 0.000  1.000⎤
+	// ⎣-1.000  0.000⎦
+	// scale: 2.000
+	// translation: [5.000 5.000]
+	// disparity: 0.000
+}
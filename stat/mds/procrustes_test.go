@@ -0,0 +1,237 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mds
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// randomOrthogonal returns a random c×c orthogonal matrix with the given
+// sign of its determinant.
+func randomOrthogonal(rnd *rand.Rand, c int, sign float64) *mat.Dense {
+	a := mat.NewDense(c, c, nil)
+	for i := 0; i < c; i++ {
+		for j := 0; j < c; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var qr mat.QR
+	qr.Factorize(a)
+	var q mat.Dense
+	qr.QTo(&q)
+	if math.Signbit(mat.Det(&q)) != math.Signbit(sign) {
+		col := q.ColView(0).(*mat.VecDense)
+		col.ScaleVec(-1, col)
+	}
+	return &q
+}
+
+func randomConfig(rnd *rand.Rand, r, c int) *mat.Dense {
+	m := mat.NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	return m
+}
+
+func TestProcrustesRecover(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		c     int
+		kind  ProcrustesKind
+		sign  float64
+		scale float64
+	}{
+		{c: 2, kind: 0, sign: 1, scale: 1},
+		{c: 3, kind: 0, sign: 1, scale: 1},
+		{c: 3, kind: ProcrustesReflection, sign: -1, scale: 1},
+		{c: 3, kind: ProcrustesScaling, sign: 1, scale: 2.5},
+		{c: 5, kind: ProcrustesScaling | ProcrustesReflection, sign: -1, scale: 0.3},
+	} {
+		x := randomConfig(rnd, 20, test.c)
+		q := randomOrthogonal(rnd, test.c, test.sign)
+		shift := make([]float64, test.c)
+		for i := range shift {
+			shift[i] = rnd.NormFloat64()
+		}
+		var y mat.Dense
+		y.Mul(x, q)
+		y.Scale(test.scale, &y)
+		for i := 0; i < 20; i++ {
+			floats.Add(y.RawRowView(i), shift)
+		}
+
+		var p Procrustes
+		if !p.Fit(x, &y, test.kind) {
+			t.Fatalf("unexpected failure for c=%d kind=%d", test.c, test.kind)
+		}
+		var got mat.Dense
+		p.RotationTo(&got)
+		if !mat.EqualApprox(&got, q, tol) {
+			t.Errorf("unexpected rotation for c=%d kind=%d", test.c, test.kind)
+		}
+		if math.Abs(p.Scale()-test.scale) > tol {
+			t.Errorf("unexpected scale for c=%d kind=%d: got:%v want:%v", test.c, test.kind, p.Scale(), test.scale)
+		}
+		if !floats.EqualApprox(p.Translation(nil), shift, tol) {
+			t.Errorf("unexpected translation for c=%d kind=%d: got:%v want:%v", test.c, test.kind, p.Translation(nil), shift)
+		}
+		if p.Residual() > tol || p.Disparity() > tol {
+			t.Errorf("unexpected residual for c=%d kind=%d: %v %v", test.c, test.kind, p.Residual(), p.Disparity())
+		}
+		var fit mat.Dense
+		p.TransformTo(&fit, x)
+		if !mat.EqualApprox(&fit, &y, tol) {
+			t.Errorf("unexpected transformed configuration for c=%d kind=%d", test.c, test.kind)
+		}
+	}
+}
+
+func TestProcrustesOptimal(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, kind := range []ProcrustesKind{0, ProcrustesReflection, ProcrustesScaling, ProcrustesReflection | ProcrustesScaling} {
+		for _, c := range []int{2, 3, 4} {
+			x := randomConfig(rnd, 15, c)
+			// A reflected and noisy copy of x.
+			var y mat.Dense
+			y.Mul(x, randomOrthogonal(rnd, c, -1))
+			noise := randomConfig(rnd, 15, c)
+			noise.Scale(0.3, noise)
+			y.Add(&y, noise)
+
+			var p Procrustes
+			if !p.Fit(x, &y, kind) {
+				t.Fatalf("unexpected failure for c=%d kind=%d", c, kind)
+			}
+			var q mat.Dense
+			p.RotationTo(&q)
+			var qtq mat.Dense
+			qtq.Mul(q.T(), &q)
+			if !mat.EqualApprox(&qtq, eye(c), 1e-12) {
+				t.Errorf("rotation not orthogonal for c=%d kind=%d", c, kind)
+			}
+			if kind&ProcrustesReflection == 0 && mat.Det(&q) < 0 {
+				t.Errorf("unexpected reflection for c=%d kind=%d", c, kind)
+			}
+			if kind&ProcrustesScaling == 0 && p.Scale() != 1 {
+				t.Errorf("unexpected scaling for c=%d kind=%d: %v", c, kind, p.Scale())
+			}
+
+			var fit mat.Dense
+			p.TransformTo(&fit, x)
+			if r := residual(&fit, &y); math.Abs(r-p.Residual()) > 1e-10*r {
+				t.Errorf("unexpected residual for c=%d kind=%d: got:%v want:%v", c, kind, p.Residual(), r)
+			}
+			if kind&ProcrustesScaling != 0 && (p.Disparity() < 0 || p.Disparity() > 1) {
+				t.Errorf("disparity out of range for c=%d kind=%d: %v", c, kind, p.Disparity())
+			}
+
+			// Perturbing the transform within the allowed kind
+			// does not reduce the residual.
+			for range 20 {
+				// Perturb the rotation by the orthogonal
+				// factor of a matrix near the identity.
+				d := randomConfig(rnd, c, c)
+				d.Scale(0.01, d)
+				d.Add(d, eye(c))
+				var svd mat.SVD
+				svd.Factorize(d, mat.SVDFull)
+				var u, v, pq mat.Dense
+				svd.UTo(&u)
+				svd.VTo(&v)
+				pq.Mul(&u, v.T())
+				pq.Mul(&q, &pq)
+				s := p.Scale()
+				if kind&ProcrustesScaling != 0 {
+					s *= 1 + 0.01*rnd.NormFloat64()
+				}
+
+				var alt mat.Dense
+				alt.Mul(x, &pq)
+				alt.Scale(s, &alt)
+				trans := p.Translation(nil)
+				for i := 0; i < 15; i++ {
+					floats.Add(alt.RawRowView(i), trans)
+				}
+				if got := residual(&alt, &y); got < p.Residual()*(1-1e-12) {
+					t.Errorf("perturbed transform improves residual for c=%d kind=%d: %v < %v", c, kind, got, p.Residual())
+					break
+				}
+			}
+		}
+	}
+}
+
+// eye returns the n×n identity matrix.
+func eye(n int) *mat.Dense {
+	m := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
+
+// residual returns the sum of squared differences between a and b.
+func residual(a, b *mat.Dense) float64 {
+	var d mat.Dense
+	d.Sub(a, b)
+	n := mat.Norm(&d, 2)
+	return n * n
+}
+
+func TestProcrustesReflection(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x := randomConfig(rnd, 10, 2)
+	// Mirror the configuration in the first axis.
+	y := mat.DenseCopyOf(x)
+	col := y.ColView(0).(*mat.VecDense)
+	col.ScaleVec(-1, col)
+
+	var rot, refl Procrustes
+	rot.Fit(x, y, 0)
+	refl.Fit(x, y, ProcrustesReflection)
+	if refl.Residual() > 1e-12 {
+		t.Errorf("unexpected residual with reflection: %v", refl.Residual())
+	}
+	if rot.Residual() < 1e-3 {
+		t.Errorf("unexpected residual without reflection: %v", rot.Residual())
+	}
+	var q mat.Dense
+	refl.RotationTo(&q)
+	if !mat.EqualApprox(&q, mat.NewDense(2, 2, []float64{-1, 0, 0, 1}), 1e-12) {
+		t.Errorf("unexpected reflection:\n%v", mat.Formatted(&q))
+	}
+}
+
+func TestProcrustesPanics(t *testing.T) {
+	var p Procrustes
+	if !panics(func() { p.TransformTo(&mat.Dense{}, mat.NewDense(2, 2, nil)) }) {
+		t.Error("expected panic for transform without alignment")
+	}
+	if !panics(func() { p.Fit(mat.NewDense(3, 2, nil), mat.NewDense(3, 3, nil), 0) }) {
+		t.Error("expected panic for mismatched dimensions")
+	}
+	rnd := rand.New(rand.NewPCG(1, 1))
+	p.Fit(randomConfig(rnd, 4, 2), randomConfig(rnd, 4, 2), 0)
+	if !panics(func() { p.TransformTo(&mat.Dense{}, mat.NewDense(2, 3, nil)) }) {
+		t.Error("expected panic for mismatched transform dimensions")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// observed returns the elements of x and the corresponding weights for which
+// none of the values in x and y are NaN. If y is nil only x is considered.
+// If weights is nil the returned weights are nil.
+func observed(x, y, weights []float64) (xo, yo, wo []float64) {
+	if y != nil && len(x) != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(x) != len(weights) {
+		panic("stat: slice length mismatch")
+	}
+	for i, v := range x {
+		if math.IsNaN(v) || (y != nil && math.IsNaN(y[i])) {
+			continue
+		}
+		xo = append(xo, v)
+		if y != nil {
+			yo = append(yo, y[i])
+		}
+		if weights != nil {
+			wo = append(wo, weights[i])
+		}
+	}
+	return xo, yo, wo
+}
+
+// NaNMean returns the weighted mean of the elements of x that are not NaN.
+// See Mean for the definition of the mean. NaNMean returns NaN if all the
+// elements of x are NaN.
+//
+// If weights is nil then all of the weights are 1. If weights is not nil, then
+// len(x) must equal len(weights).
+func NaNMean(x, weights []float64) float64 {
+	x, _, weights = observed(x, nil, weights)
+	if len(x) == 0 {
+		return math.NaN()
+	}
+	return Mean(x, weights)
+}
+
+// NaNVariance returns the unbiased weighted sample variance of the elements
+// of x that are not NaN. See Variance for the definition of the variance.
+// NaNVariance returns NaN if all the elements of x are NaN.
+//
+// If weights is nil then all of the weights are 1. If weights is not nil, then
+// len(x) must equal len(weights).
+func NaNVariance(x, weights []float64) float64 {
+	x, _, weights = observed(x, nil, weights)
+	if len(x) == 0 {
+		return math.NaN()
+	}
+	return Variance(x, weights)
+}
+
+// NaNCovariance returns the weighted covariance between the samples of x and
+// y, computed from the observations in which neither x nor y is NaN. See
+// Covariance for the definition of the covariance. NaNCovariance returns NaN
+// if there are no such observations.
+//
+// The lengths of x and y must be equal. If weights is nil then all of the
+// weights are 1. If weights is not nil, then len(x) must equal len(weights).
+func NaNCovariance(x, y, weights []float64) float64 {
+	x, y, weights = observed(x, y, weights)
+	if len(x) == 0 {
+		return math.NaN()
+	}
+	return Covariance(x, y, weights)
+}
+
+// NaNCorrelation returns the weighted correlation between the samples of x
+// and y, computed from the observations in which neither x nor y is NaN. See
+// Correlation for the definition of the correlation. NaNCorrelation returns
+// NaN if there are no such observations.
+//
+// The lengths of x and y must be equal. If weights is nil then all of the
+// weights are 1. If weights is not nil, then len(x) must equal len(weights).
+func NaNCorrelation(x, y, weights []float64) float64 {
+	x, y, weights = observed(x, y, weights)
+	if len(x) == 0 {
+		return math.NaN()
+	}
+	return Correlation(x, y, weights)
+}
+
+// NaNQuantile returns the quantile of the elements of x that are not NaN.
+// See Quantile for the definition of the quantile and the behavior of the
+// CumulantKind. NaNQuantile returns NaN if all the elements of x are NaN.
+//
+// The elements of x that are not NaN must be sorted in increasing order. If
+// weights is nil then all of the weights are 1. If weights is not nil, then
+// len(x) must equal len(weights). NaNQuantile will panic if the length of x
+// is zero.
+func NaNQuantile(p float64, c CumulantKind, x, weights []float64) float64 {
+	if len(x) == 0 {
+		panic("stat: zero length slice")
+	}
+	x, _, weights = observed(x, nil, weights)
+	if len(x) == 0 {
+		if !(p >= 0 && p <= 1) {
+			panic("stat: percentile out of bounds")
+		}
+		return math.NaN()
+	}
+	return Quantile(p, c, x, weights)
+}
+
+// NaNCovarianceMatrix calculates the covariance matrix of the columns of x,
+// with missing values, represented by NaN, treated according to missing. The
+// result is stored in dst. With MissingPairwise, element i, j of the result
+// is the covariance of columns i and j computed as by NaNCovariance. With
+// MissingComplete, the result is computed as by CovarianceMatrix from the rows
+// of x that contain no NaN.
+//
+// If weights is not nil the weighted covariance of x is calculated. weights
+// must have length equal to the number of rows in input data matrix and
+// must not contain negative elements.
+// The dst matrix must either be empty or have the same number of
+// columns as the input data matrix.
+func NaNCovarianceMatrix(dst *mat.SymDense, x mat.Matrix, weights []float64, missing MissingPolicy) {
+	cols, weights := nanColumns(dst, x, weights, missing)
+	fn := Covariance
+	if missing == MissingPairwise {
+		fn = NaNCovariance
+	}
+	pairwiseDependence(dst, len(cols), func(i, j int) float64 {
+		return fn(cols[i], cols[j], weights)
+	})
+}
+
+// NaNCorrelationMatrix calculates the correlation matrix of the columns of x,
+// with missing values, represented by NaN, treated according to missing. The
+// result is stored in dst. With MissingPairwise, element i, j of the result
+// is the correlation of columns i and j computed as by NaNCorrelation, so each
+// correlation uses the means and standard deviations of the observations
+// common to the pair. With MissingComplete, the result is computed as by
+// CorrelationMatrix from the rows of x that contain no NaN. The diagonal of
+// the result is one.
+//
+// If weights is not nil the weighted correlation of x is calculated. weights
+// must have length equal to the number of rows in input data matrix and
+// must not contain negative elements.
+// The dst matrix must either be empty or have the same number of
+// columns as the input data matrix.
+func NaNCorrelationMatrix(dst *mat.SymDense, x mat.Matrix, weights []float64, missing MissingPolicy) {
+	if missing != MissingPairwise {
+		NaNCovarianceMatrix(dst, x, weights, missing)
+		covToCorr(dst)
+		return
+	}
+	cols, weights := nanColumns(dst, x, weights, missing)
+	pairwiseDependence(dst, len(cols), func(i, j int) float64 {
+		if i == j {
+			return 1
+		}
+		return NaNCorrelation(cols[i], cols[j], weights)
+	})
+}
+
+// nanColumns returns the columns of x and the weights, removing the rows with
+// missing values if missing is MissingComplete, after checking the weights and
+// that dst is empty or has the same number of columns as x. If dst is empty,
+// it is resized.
+func nanColumns(dst *mat.SymDense, x mat.Matrix, weights []float64, missing MissingPolicy) ([][]float64, []float64) {
+	r, _ := x.Dims()
+	if weights != nil && len(weights) != r {
+		panic("stat: slice length mismatch")
+	}
+	for _, w := range weights {
+		if w < 0 {
+			panic("stat: negative covariance matrix weights")
+		}
+	}
+	cols := dependenceColumns(dst, x, missing)
+	if missing != MissingComplete || weights == nil {
+		return cols, weights
+	}
+	var w []float64
+outer:
+	for i, wi := range weights {
+		for j := range cols {
+			if math.IsNaN(x.At(i, j)) {
+				continue outer
+			}
+		}
+		w = append(w, wi)
+	}
+	return cols, w
+}
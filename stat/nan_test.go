@@ -0,0 +1,268 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestNaNFunctions(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 20} {
+		for _, missing := range []float64{0, 0.2, 1} {
+			for _, weighted := range []bool{false, true} {
+				name := fmt.Sprintf("n=%d,missing=%v,weighted=%t", n, missing, weighted)
+				x := make([]float64, n)
+				y := make([]float64, n)
+				var weights []float64
+				if weighted {
+					weights = make([]float64, n)
+				}
+				for i := range x {
+					x[i] = rnd.NormFloat64()
+					y[i] = x[i] + rnd.NormFloat64()
+					if rnd.Float64() < missing {
+						x[i] = math.NaN()
+					}
+					if rnd.Float64() < missing {
+						y[i] = math.NaN()
+					}
+					if weighted {
+						weights[i] = 1 + rnd.Float64()
+					}
+				}
+
+				// Compute the expected values from the
+				// observed values.
+				var xo, xc, yc, wo, wc []float64
+				for i, v := range x {
+					if math.IsNaN(v) {
+						continue
+					}
+					xo = append(xo, v)
+					if weighted {
+						wo = append(wo, weights[i])
+					}
+					if math.IsNaN(y[i]) {
+						continue
+					}
+					xc = append(xc, v)
+					yc = append(yc, y[i])
+					if weighted {
+						wc = append(wc, weights[i])
+					}
+				}
+				nan := math.NaN()
+				wantMean, wantVar, wantQuant := nan, nan, nan
+				if len(xo) != 0 {
+					wantMean = Mean(xo, wo)
+					wantVar = Variance(xo, wo)
+				}
+				wantCov, wantCorr := nan, nan
+				if len(xc) != 0 {
+					wantCov = Covariance(xc, yc, wc)
+					wantCorr = Correlation(xc, yc, wc)
+				}
+
+				// Quantiles require the observed values
+				// to be sorted, so sort them in place in
+				// a copy of x.
+				xs := append([]float64(nil), x...)
+				ws := append([]float64(nil), weights...)
+				if !weighted {
+					ws = nil
+				}
+				var idx []int
+				for i, v := range x {
+					if !math.IsNaN(v) {
+						idx = append(idx, i)
+					}
+				}
+				SortWeighted(xo, wo)
+				for k, i := range idx {
+					xs[i] = xo[k]
+					if weighted {
+						ws[i] = wo[k]
+					}
+				}
+				if len(xo) != 0 {
+					wantQuant = Quantile(0.3, LinInterp, xo, wo)
+				}
+
+				for _, test := range []struct {
+					fn        string
+					got, want float64
+				}{
+					{fn: "NaNMean", got: NaNMean(x, weights), want: wantMean},
+					{fn: "NaNVariance", got: NaNVariance(x, weights), want: wantVar},
+					{fn: "NaNCovariance", got: NaNCovariance(x, y, weights), want: wantCov},
+					{fn: "NaNCorrelation", got: NaNCorrelation(x, y, weights), want: wantCorr},
+					{fn: "NaNQuantile", got: NaNQuantile(0.3, LinInterp, xs, ws), want: wantQuant},
+				} {
+					if !sameFloat(test.got, test.want, 1e-14) {
+						t.Errorf("%s: unexpected %s: got:%v want:%v", name, test.fn, test.got, test.want)
+					}
+				}
+			}
+		}
+	}
+
+	x := []float64{3, math.NaN(), 1}
+	if !panics(func() { NaNMean(x, []float64{1}) }) {
+		t.Error("expected panic for weight length mismatch")
+	}
+	if !panics(func() { NaNCovariance(x, []float64{1}, nil) }) {
+		t.Error("expected panic for slice length mismatch")
+	}
+	if !panics(func() { NaNQuantile(0.5, Empirical, x, nil) }) {
+		t.Error("expected panic for unsorted data")
+	}
+	if !panics(func() { NaNQuantile(0.5, Empirical, nil, nil) }) {
+		t.Error("expected panic for empty data")
+	}
+	if !panics(func() { NaNQuantile(2, Empirical, []float64{math.NaN()}, nil) }) {
+		t.Error("expected panic for bad percentile")
+	}
+}
+
+// sameFloat returns whether a and b are both NaN or are equal within tol.
+func sameFloat(a, b, tol float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	return scalar.EqualWithinAbsOrRel(a, b, tol, tol)
+}
+
+func TestNaNMatrix(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name   string
+		fn     func(dst *mat.SymDense, x mat.Matrix, weights []float64, missing MissingPolicy)
+		pair   func(x, y, weights []float64) float64
+		matrix func(dst *mat.SymDense, x mat.Matrix, weights []float64)
+	}{
+		{name: "Covariance", fn: NaNCovarianceMatrix, pair: NaNCovariance, matrix: CovarianceMatrix},
+		{name: "Correlation", fn: NaNCorrelationMatrix, pair: NaNCorrelation, matrix: CorrelationMatrix},
+	} {
+		for _, n := range []int{3, 10, 40} {
+			for _, p := range []int{1, 4} {
+				for _, missing := range []float64{0, 0.1} {
+					for _, weighted := range []bool{false, true} {
+						name := fmt.Sprintf("%s,n=%d,p=%d,missing=%v,weighted=%t", test.name, n, p, missing, weighted)
+						x := dependenceData(rnd, n, p, missing)
+						var weights []float64
+						if weighted {
+							weights = make([]float64, n)
+							for i := range weights {
+								weights[i] = 1 + rnd.Float64()
+							}
+						}
+
+						// Pairwise deletion matches the
+						// NaN-aware pair functions.
+						var got mat.SymDense
+						test.fn(&got, x, weights, MissingPairwise)
+						want := mat.NewSymDense(p, nil)
+						for i := 0; i < p; i++ {
+							for j := i; j < p; j++ {
+								v := test.pair(mat.Col(nil, i, x), mat.Col(nil, j, x), weights)
+								if i == j && test.name == "Correlation" {
+									v = 1
+								}
+								want.SetSym(i, j, v)
+							}
+						}
+						checkSameSym(t, name+",pairwise", &got, want)
+
+						// Complete case deletion matches the
+						// matrix functions on the complete rows.
+						var rows [][]float64
+						var w []float64
+						for i := 0; i < n; i++ {
+							row := mat.Row(nil, i, x)
+							if floats.HasNaN(row) {
+								continue
+							}
+							rows = append(rows, row)
+							if weighted {
+								w = append(w, weights[i])
+							}
+						}
+						got.Reset()
+						test.fn(&got, x, weights, MissingComplete)
+						if len(rows) != 0 {
+							data := mat.NewDense(len(rows), p, nil)
+							for i, row := range rows {
+								data.SetRow(i, row)
+							}
+							want.Reset()
+							test.matrix(want, data, w)
+							checkSameSym(t, name+",complete", &got, want)
+						} else {
+							for i := 0; i < p; i++ {
+								for j := 0; j < p; j++ {
+									if v := got.At(i, j); !math.IsNaN(v) && (i != j || test.name == "Covariance") {
+										t.Errorf("%s,complete: unexpected element [%d,%d] for no complete rows: got:%v want:NaN", name, i, j, v)
+									}
+								}
+							}
+						}
+
+						if missing == 0 {
+							// Without missing values all
+							// policies match the matrix functions.
+							want.Reset()
+							test.matrix(want, x, weights)
+							got.Reset()
+							test.fn(&got, x, weights, MissingPropagate)
+							checkSameSym(t, name+",propagate", &got, want)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	x := mat.NewDense(3, 2, nil)
+	if !panics(func() { NaNCovarianceMatrix(&mat.SymDense{}, x, []float64{1}, MissingPairwise) }) {
+		t.Error("expected panic for weight length mismatch")
+	}
+	if !panics(func() { NaNCovarianceMatrix(&mat.SymDense{}, x, []float64{1, -1, 1}, MissingPairwise) }) {
+		t.Error("expected panic for negative weight")
+	}
+	if !panics(func() { NaNCorrelationMatrix(mat.NewSymDense(3, nil), x, nil, MissingPairwise) }) {
+		t.Error("expected panic for dst shape mismatch")
+	}
+	if !panics(func() { NaNCorrelationMatrix(&mat.SymDense{}, x, nil, MissingPolicy(5)) }) {
+		t.Error("expected panic for unknown policy")
+	}
+}
+
+// checkSameSym checks that got and want are equal, treating NaN elements as
+// equal.
+func checkSameSym(t *testing.T, name string, got, want *mat.SymDense) {
+	t.Helper()
+	if got.SymmetricDim() != want.SymmetricDim() {
+		t.Errorf("%s: unexpected dimension: got:%d want:%d", name, got.SymmetricDim(), want.SymmetricDim())
+		return
+	}
+	n := want.SymmetricDim()
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if g, w := got.At(i, j), want.At(i, j); !sameFloat(g, w, 1e-12) {
+				t.Errorf("%s: unexpected element [%d,%d]: got:%v want:%v", name, i, j, g, w)
+			}
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"math"
 	"slices"
 	"sort"
+
+	"gonum.org/v1/gonum/mathext"
 )
 
 // ROC returns paired false positive rate (FPR) and true positive rate
@@ -196,3 +198,315 @@ func TOC(classes []bool, weights []float64) (min, ntp, max []float64) {
 	}
 	return min, ntp, max
 }
+
+// DeLongAUC returns the area under the receiver operator characteristic (ROC)
+// curve (AUC) obtained when y is treated as a binary classifier for classes
+// with weights, and the variance of the AUC estimate computed by the method of
+// DeLong et al. The AUC is the weighted probability that an observation of
+// class true has a greater value of y than an observation of class false,
+// with ties counted as one half,
+//
+//	AUC = 1/(W₁ W₀) ∑_{i:classes_i} ∑_{j:!classes_j} weights_i weights_j ψ(y_i, y_j),
+//
+// where ψ(a, b) is 1 if a > b, 1/2 if a == b and 0 otherwise, and W₁ and W₀
+// are the total weights of the observations of class true and false. This is
+// the area under the curve returned by ROC when cutoffs is nil.
+//
+// The weights are treated as frequency weights when computing the variance,
+// so the variance is NaN if the total weight of either class is not greater
+// than one. The AUC is NaN if the total weight of either class is zero.
+//
+// The input y does not need to be sorted and must not contain NaN. If weights
+// is nil, all weights are treated as 1. If weights is not nil it must have the
+// same length as y and classes, otherwise DeLongAUC will panic.
+//
+// The reference for the method is
+//
+//	DeLong, E.R., DeLong, D.M. and Clarke-Pearson, D.L.: Comparing the areas
+//	under two or more correlated receiver operating characteristic curves:
+//	a nonparametric approach. Biometrics 44(3) (1988), 837-845
+func DeLongAUC(y []float64, classes []bool, weights []float64) (auc, variance float64) {
+	p := newPlacements(y, classes, weights)
+	return p.auc, p.covariance(p, classes, weights)
+}
+
+// DeLongTest compares the AUCs of two classifiers y1 and y2 for the same
+// observations with classes and weights using the method of DeLong et al.
+// See DeLongAUC for the definition of the AUC.
+//
+// DeLongTest returns the difference between the AUC of y1 and the AUC of y2,
+// the z statistic of the difference, which accounts for the correlation
+// between the two AUCs, and the two-sided p-value of the test that the AUCs
+// are equal under the normal approximation. The z statistic and p-value are
+// NaN if the variance of the difference is zero or NaN.
+//
+// The inputs y1 and y2 do not need to be sorted and must not contain NaN. If
+// weights is nil, all weights are treated as 1. If weights is not nil it must
+// have the same length as y1, y2 and classes, otherwise DeLongTest will panic.
+func DeLongTest(y1, y2 []float64, classes []bool, weights []float64) (diff, z, p float64) {
+	if len(y1) != len(y2) {
+		panic("stat: slice length mismatch")
+	}
+	p1 := newPlacements(y1, classes, weights)
+	p2 := newPlacements(y2, classes, weights)
+	diff = p1.auc - p2.auc
+	variance := p1.covariance(p1, classes, weights) +
+		p2.covariance(p2, classes, weights) -
+		2*p1.covariance(p2, classes, weights)
+	if !(variance > 0) {
+		return diff, math.NaN(), math.NaN()
+	}
+	z = diff / math.Sqrt(variance)
+	return diff, z, math.Erfc(math.Abs(z) / math.Sqrt2)
+}
+
+// AUCConfidenceInterval returns the two-sided confidence interval for an AUC
+// estimate with the given variance, such as returned by DeLongAUC, at the
+// given confidence level, using the normal approximation. The interval is
+// clipped to [0, 1]. AUCConfidenceInterval will panic if level is not between
+// zero and one.
+func AUCConfidenceInterval(auc, variance, level float64) (lo, hi float64) {
+	if !(0 < level && level < 1) {
+		panic("stat: confidence level out of range")
+	}
+	h := mathext.NormalQuantile(0.5+level/2) * math.Sqrt(variance)
+	return math.Max(0, auc-h), math.Min(1, auc+h)
+}
+
+// placements holds the placement values of observations, the weighted
+// fraction of the observations of the other class that are ranked below an
+// observation of class true or above an observation of class false, with ties
+// counted as one half.
+type placements struct {
+	v      []float64
+	auc    float64
+	w1, w0 float64 // Total weights of the classes.
+}
+
+func newPlacements(y []float64, classes []bool, weights []float64) placements {
+	if len(y) != len(classes) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(y) != len(weights) {
+		panic("stat: slice length mismatch")
+	}
+	weight := func(i int) float64 {
+		if weights == nil {
+			return 1
+		}
+		return weights[i]
+	}
+
+	idx := make([]int, len(y))
+	var p placements
+	for i, v := range y {
+		if math.IsNaN(v) {
+			panic("stat: NaN value")
+		}
+		idx[i] = i
+		if classes[i] {
+			p.w1 += weight(i)
+		} else {
+			p.w0 += weight(i)
+		}
+	}
+	sort.Slice(idx, func(a, b int) bool { return y[idx[a]] < y[idx[b]] })
+
+	p.v = make([]float64, len(y))
+	var below1, below0 float64
+	for start := 0; start < len(idx); {
+		// Find the weights of the classes in
+		// the run of observations tied with start.
+		var tie1, tie0 float64
+		end := start
+		for ; end < len(idx) && y[idx[end]] == y[idx[start]]; end++ {
+			if i := idx[end]; classes[i] {
+				tie1 += weight(i)
+			} else {
+				tie0 += weight(i)
+			}
+		}
+		for _, i := range idx[start:end] {
+			if classes[i] {
+				p.v[i] = (below0 + tie0/2) / p.w0
+				p.auc += weight(i) * p.v[i]
+			} else {
+				p.v[i] = (p.w1 - below1 - tie1/2) / p.w1
+			}
+		}
+		below1 += tie1
+		below0 += tie0
+		start = end
+	}
+	p.auc /= p.w1
+	return p
+}
+
+// covariance returns the DeLong covariance of the AUCs with placement values
+// p and q for the same observations.
+func (p placements) covariance(q placements, classes []bool, weights []float64) float64 {
+	if !(p.w1 > 1 && p.w0 > 1) {
+		return math.NaN()
+	}
+	var s1, s0 float64
+	for i, c := range classes {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		d := w * (p.v[i] - p.auc) * (q.v[i] - q.auc)
+		if c {
+			s1 += d
+		} else {
+			s0 += d
+		}
+	}
+	return s1/((p.w1-1)*p.w1) + s0/((p.w0-1)*p.w0)
+}
+
+// BinnedROC accumulates the weights of observations of each class in bins of
+// classifier values so that the receiver operator characteristic (ROC) curve
+// and its AUC can be computed for observations that arrive in a stream,
+// without storing or sorting them. BinnedROC values with the same cutoffs can
+// be merged, for example to combine observations accumulated concurrently.
+//
+// The zero value of BinnedROC is not usable; use NewBinnedROC to create one.
+type BinnedROC struct {
+	cutoffs []float64
+
+	// pos and neg hold the weights of the observations
+	// of class true and false in each bin. Bin b holds
+	// the values y with cutoffs[b-1] <= y < cutoffs[b].
+	pos, neg []float64
+	// maxBin is the highest bin holding an observation,
+	// or -1 if there are no observations.
+	maxBin int
+}
+
+// NewBinnedROC returns a new BinnedROC with bins separated by the given cutoff
+// values. The cutoffs must be sorted in strictly ascending order and must not
+// be empty, otherwise NewBinnedROC will panic. floats.Span can be used to
+// generate equally spaced cutoffs.
+func NewBinnedROC(cutoffs []float64) *BinnedROC {
+	if len(cutoffs) == 0 {
+		panic("stat: no cutoff values")
+	}
+	for i, c := range cutoffs {
+		if math.IsNaN(c) || (i > 0 && !(cutoffs[i-1] < c)) {
+			panic("stat: cutoff values must be sorted ascending")
+		}
+	}
+	return &BinnedROC{
+		cutoffs: slices.Clone(cutoffs),
+		pos:     make([]float64, len(cutoffs)+1),
+		neg:     make([]float64, len(cutoffs)+1),
+		maxBin:  -1,
+	}
+}
+
+// Add adds an observation with classifier value y, class and weight to the
+// receiver. Add will panic if y is NaN.
+func (r *BinnedROC) Add(y float64, class bool, weight float64) {
+	if math.IsNaN(y) {
+		panic("stat: NaN value")
+	}
+	b := sort.Search(len(r.cutoffs), func(i int) bool { return y < r.cutoffs[i] })
+	if class {
+		r.pos[b] += weight
+	} else {
+		r.neg[b] += weight
+	}
+	r.maxBin = max(r.maxBin, b)
+}
+
+// Merge adds the observations accumulated in src to the receiver. Merge will
+// panic if the cutoffs of the receiver and src are not equal.
+func (r *BinnedROC) Merge(src *BinnedROC) {
+	if !slices.Equal(r.cutoffs, src.cutoffs) {
+		panic("stat: cutoff values mismatch")
+	}
+	for b := range r.pos {
+		r.pos[b] += src.pos[b]
+		r.neg[b] += src.neg[b]
+	}
+	r.maxBin = max(r.maxBin, src.maxBin)
+}
+
+// Reset removes all the observations from the receiver.
+func (r *BinnedROC) Reset() {
+	clear(r.pos)
+	clear(r.neg)
+	r.maxBin = -1
+}
+
+// ROC returns the true positive rate (TPR), false positive rate (FPR) and
+// cutoff thresholds of the ROC curve for the observations added to the
+// receiver, as returned by the ROC function called with the cutoffs of the
+// receiver and the observations. If no observations have been added, ROC
+// returns nil slices.
+func (r *BinnedROC) ROC() (tpr, fpr, thresh []float64) {
+	if r.maxBin < 0 {
+		return nil, nil, nil
+	}
+	n := len(r.cutoffs)
+	tpr = make([]float64, n)
+	fpr = make([]float64, n)
+	var nPos, nNeg float64
+	for b := range r.pos {
+		nPos += r.pos[b]
+		nNeg += r.neg[b]
+	}
+	invPos := 1 / nPos
+	invNeg := 1 / nNeg
+	// Rates for cutoffs beyond the highest observed
+	// bin are left as zero, matching ROC.
+	var belowPos, belowNeg float64
+	for b := 0; b <= min(r.maxBin, n-1); b++ {
+		belowPos += r.pos[b]
+		belowNeg += r.neg[b]
+		// Prevent fused float operations by
+		// making explicit float64 conversions.
+		tpr[b] = 1 - float64(belowPos*invPos)
+		fpr[b] = 1 - float64(belowNeg*invNeg)
+	}
+	thresh = slices.Clone(r.cutoffs)
+	slices.Reverse(tpr)
+	slices.Reverse(fpr)
+	slices.Reverse(thresh)
+	return tpr, fpr, thresh
+}
+
+// AUC returns the area under the ROC curve for the observations added to the
+// receiver and its variance, as returned by DeLongAUC for the observations
+// with their values replaced by the index of their bin. Observations in the
+// same bin are treated as tied.
+func (r *BinnedROC) AUC() (auc, variance float64) {
+	var w1, w0 float64
+	for b := range r.pos {
+		w1 += r.pos[b]
+		w0 += r.neg[b]
+	}
+	// Compute the placement values of each bin.
+	v1 := make([]float64, len(r.pos))
+	v0 := make([]float64, len(r.neg))
+	var below1, below0 float64
+	for b := range r.pos {
+		v1[b] = (below0 + r.neg[b]/2) / w0
+		v0[b] = (w1 - below1 - r.pos[b]/2) / w1
+		auc += r.pos[b] * v1[b]
+		below1 += r.pos[b]
+		below0 += r.neg[b]
+	}
+	auc /= w1
+	if !(w1 > 1 && w0 > 1) {
+		return auc, math.NaN()
+	}
+	var s1, s0 float64
+	for b := range r.pos {
+		d1 := v1[b] - auc
+		d0 := v0[b] - auc
+		s1 += r.pos[b] * d1 * d1
+		s0 += r.neg[b] * d0 * d0
+	}
+	return auc, s1/((w1-1)*w1) + s0/((w0-1)*w0)
+}
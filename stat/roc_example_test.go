@@ -248,3 +248,45 @@ func ExampleTOC_aUC_weighted() {
 	// number of true positives: [0 0 2 2 3]
 	// auc: 0.444444
 }
+
+func ExampleDeLongAUC() {
+	y := []float64{0.1, 0.35, 0.4, 0.5, 0.6, 0.65, 0.7, 0.8, 0.9, 0.95}
+	classes := []bool{false, false, true, false, true, false, true, true, false, true}
+
+	auc, variance := stat.DeLongAUC(y, classes, nil)
+	lo, hi := stat.AUCConfidenceInterval(auc, variance, 0.95)
+	fmt.Printf("AUC: %.2f\n", auc)
+	fmt.Printf("95%% confidence interval: [%.3f, %.3f]\n", lo, hi)
+
+	// Output:
+	// AUC: 0.72
+	// 95% confidence interval: [0.365, 1.000]
+}
+
+func ExampleBinnedROC() {
+	// Accumulate observations from two streams
+	// in bins of width 0.25 and merge them.
+	cutoffs := floats.Span(make([]float64, 5), 0, 1)
+	a := stat.NewBinnedROC(cutoffs)
+	b := stat.NewBinnedROC(cutoffs)
+	for i, y := range []float64{0.1, 0.35, 0.4, 0.5, 0.6} {
+		a.Add(y, []bool{false, false, true, false, true}[i], 1)
+	}
+	for i, y := range []float64{0.65, 0.7, 0.8, 0.9, 0.95} {
+		b.Add(y, []bool{false, true, true, false, true}[i], 1)
+	}
+	a.Merge(b)
+
+	tpr, fpr, thresh := a.ROC()
+	auc, _ := a.AUC()
+	fmt.Printf("true  positive rate: %.2f\n", tpr)
+	fmt.Printf("false positive rate: %.2f\n", fpr)
+	fmt.Printf("cutoff thresholds: %v\n", thresh)
+	fmt.Printf("AUC: %.2f\n", auc)
+
+	// Output:
+	// true  positive rate: [0.00 0.40 0.80 1.00 1.00]
+	// false positive rate: [0.00 0.20 0.60 0.80 1.00]
+	// cutoff thresholds: [1 0.75 0.5 0.25 0]
+	// AUC: 0.66
+}
@@ -9,9 +9,11 @@ import (
 	"math"
 	"math/rand/v2"
 	"slices"
+	"sort"
 	"testing"
 
 	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
 )
 
 func TestROC(t *testing.T) {
@@ -385,3 +387,287 @@ func randomBools(l int, src rand.Source) []bool {
 	}
 	return s
 }
+
+func TestDeLongAUC(t *testing.T) {
+	t.Parallel()
+
+	// Hand-computed example.
+	y := []float64{0.1, 0.4, 0.35, 0.8}
+	classes := []bool{false, false, true, true}
+	auc, variance := DeLongAUC(y, classes, nil)
+	if auc != 0.75 || variance != 0.125 {
+		t.Errorf("unexpected result for simple example: got:(%v, %v) want:(0.75, 0.125)", auc, variance)
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{2, 5, 30, 200} {
+		for _, weighted := range []bool{false, true} {
+			y1, y2, classes, weights := aucTestData(rnd, n, weighted)
+			wantAUC, wantCov := deLongBrute([][]float64{y1, y2}, classes, weights)
+
+			name := fmt.Sprintf("n=%d,weighted=%t", n, weighted)
+			auc, variance := DeLongAUC(y1, classes, weights)
+			if !sameFloat(auc, wantAUC[0], 1e-14) {
+				t.Errorf("%s: unexpected AUC: got:%v want:%v", name, auc, wantAUC[0])
+			}
+			if !sameFloat(variance, wantCov[0][0], 1e-12) {
+				t.Errorf("%s: unexpected variance: got:%v want:%v", name, variance, wantCov[0][0])
+			}
+
+			// The AUC is the area under the ROC curve.
+			sorted := slices.Clone(y1)
+			c := slices.Clone(classes)
+			w := slices.Clone(weights)
+			SortWeightedLabeled(sorted, c, w)
+			tpr, fpr, _ := ROC(nil, sorted, c, w)
+			var area float64
+			for i := 1; i < len(tpr); i++ {
+				area += (fpr[i] - fpr[i-1]) * (tpr[i] + tpr[i-1]) / 2
+			}
+			if !sameFloat(auc, area, 1e-12) {
+				t.Errorf("%s: AUC does not match area under ROC curve: got:%v want:%v", name, auc, area)
+			}
+
+			diff, z, p := DeLongTest(y1, y2, classes, weights)
+			wantDiff := wantAUC[0] - wantAUC[1]
+			wantZ := wantDiff / math.Sqrt(wantCov[0][0]+wantCov[1][1]-2*wantCov[0][1])
+			if !sameFloat(diff, wantDiff, 1e-14) {
+				t.Errorf("%s: unexpected difference: got:%v want:%v", name, diff, wantDiff)
+			}
+			if !sameFloat(z, wantZ, 1e-10) {
+				t.Errorf("%s: unexpected z statistic: got:%v want:%v", name, z, wantZ)
+			}
+			if !math.IsNaN(z) && !sameFloat(p, 2*(1-normalCDF(math.Abs(z))), 1e-12) {
+				t.Errorf("%s: unexpected p-value: got:%v want:%v", name, p, 2*(1-normalCDF(math.Abs(z))))
+			}
+
+			_, z, p = DeLongTest(y1, y1, classes, weights)
+			if !math.IsNaN(z) || !math.IsNaN(p) {
+				t.Errorf("%s: unexpected test result for identical classifiers: got:(%v, %v) want:(NaN, NaN)", name, z, p)
+			}
+		}
+	}
+
+	if !panics(func() { DeLongAUC([]float64{1, 2}, []bool{true}, nil) }) {
+		t.Error("expected panic for classes length mismatch")
+	}
+	if !panics(func() { DeLongAUC([]float64{1, 2}, []bool{true, false}, []float64{1}) }) {
+		t.Error("expected panic for weights length mismatch")
+	}
+	if !panics(func() { DeLongAUC([]float64{1, math.NaN()}, []bool{true, false}, nil) }) {
+		t.Error("expected panic for NaN value")
+	}
+	if !panics(func() { DeLongTest([]float64{1, 2}, []float64{1}, []bool{true, false}, nil) }) {
+		t.Error("expected panic for classifier length mismatch")
+	}
+}
+
+// aucTestData returns the values of two correlated classifiers for n
+// observations with random classes, and weights if weighted is true.
+// The values are rounded to produce ties.
+func aucTestData(rnd *rand.Rand, n int, weighted bool) (y1, y2 []float64, classes []bool, weights []float64) {
+	y1 = make([]float64, n)
+	y2 = make([]float64, n)
+	classes = make([]bool, n)
+	if weighted {
+		weights = make([]float64, n)
+	}
+	for i := range y1 {
+		classes[i] = rnd.IntN(2) == 1
+		var shift float64
+		if classes[i] {
+			shift = 1
+		}
+		y1[i] = math.Round(4*(rnd.NormFloat64()+shift)) / 4
+		y2[i] = math.Round(4*(y1[i]+rnd.NormFloat64())) / 4
+		if weighted {
+			weights[i] = 0.5 + rnd.Float64()
+		}
+	}
+	return y1, y2, classes, weights
+}
+
+// deLongBrute returns the AUCs of the classifiers ys and their DeLong
+// covariance computed directly from the definitions.
+func deLongBrute(ys [][]float64, classes []bool, weights []float64) (auc []float64, cov [][]float64) {
+	w := func(i int) float64 {
+		if weights == nil {
+			return 1
+		}
+		return weights[i]
+	}
+	psi := func(a, b float64) float64 {
+		switch {
+		case a > b:
+			return 1
+		case a == b:
+			return 0.5
+		}
+		return 0
+	}
+	var w1, w0 float64
+	for i, c := range classes {
+		if c {
+			w1 += w(i)
+		} else {
+			w0 += w(i)
+		}
+	}
+	v := make([][]float64, len(ys))
+	auc = make([]float64, len(ys))
+	for k, y := range ys {
+		v[k] = make([]float64, len(y))
+		for i, ci := range classes {
+			for j, cj := range classes {
+				switch {
+				case ci && !cj:
+					v[k][i] += w(j) * psi(y[i], y[j]) / w0
+				case !ci && cj:
+					v[k][i] += w(j) * psi(y[j], y[i]) / w1
+				}
+			}
+			if ci {
+				auc[k] += w(i) * v[k][i] / w1
+			}
+		}
+		if w1 == 0 || w0 == 0 {
+			auc[k] = math.NaN()
+		}
+	}
+	cov = make([][]float64, len(ys))
+	for a := range ys {
+		cov[a] = make([]float64, len(ys))
+		for b := range ys {
+			var s1, s0 float64
+			for i, c := range classes {
+				d := w(i) * (v[a][i] - auc[a]) * (v[b][i] - auc[b])
+				if c {
+					s1 += d
+				} else {
+					s0 += d
+				}
+			}
+			cov[a][b] = s1/(w1-1)/w1 + s0/(w0-1)/w0
+			if !(w1 > 1 && w0 > 1) {
+				cov[a][b] = math.NaN()
+			}
+		}
+	}
+	return auc, cov
+}
+
+func normalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func TestAUCConfidenceInterval(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		auc, variance, level float64
+		wantLo, wantHi       float64
+	}{
+		{auc: 0.8, variance: 0.0025, level: 0.95, wantLo: 0.7020018007729973, wantHi: 0.8979981992270027},
+		{auc: 0.8, variance: 0.0025, level: 0.5, wantLo: 0.7662755124901341, wantHi: 0.8337244875098659},
+		{auc: 0.95, variance: 0.01, level: 0.95, wantLo: 0.7540036015459947, wantHi: 1},
+		{auc: 0.1, variance: 0.01, level: 0.9, wantLo: 0, wantHi: 0.26448536269514726},
+	} {
+		lo, hi := AUCConfidenceInterval(test.auc, test.variance, test.level)
+		if !scalar.EqualWithinAbsOrRel(lo, test.wantLo, 1e-12, 1e-12) || !scalar.EqualWithinAbsOrRel(hi, test.wantHi, 1e-12, 1e-12) {
+			t.Errorf("unexpected interval for auc=%v variance=%v level=%v: got:[%v, %v] want:[%v, %v]",
+				test.auc, test.variance, test.level, lo, hi, test.wantLo, test.wantHi)
+		}
+	}
+	for _, level := range []float64{0, 1, -0.5, math.NaN()} {
+		if !panics(func() { AUCConfidenceInterval(0.5, 0.01, level) }) {
+			t.Errorf("expected panic for level %v", level)
+		}
+	}
+}
+
+func TestBinnedROC(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, cutoffs := range [][]float64{
+		{0},
+		{-1, 0, 0.5, 1, 2},
+		floats.Span(make([]float64, 17), -2, 2),
+		{3, 4, 5},
+	} {
+		for _, n := range []int{1, 10, 100} {
+			for _, weighted := range []bool{false, true} {
+				name := fmt.Sprintf("cutoffs=%v,n=%d,weighted=%t", cutoffs, n, weighted)
+				y, _, classes, weights := aucTestData(rnd, n, weighted)
+
+				// Accumulate the observations in two halves
+				// and merge them.
+				r := NewBinnedROC(cutoffs)
+				half := NewBinnedROC(cutoffs)
+				for i, v := range y {
+					w := 1.0
+					if weighted {
+						w = weights[i]
+					}
+					if i < n/2 {
+						r.Add(v, classes[i], w)
+					} else {
+						half.Add(v, classes[i], w)
+					}
+				}
+				r.Merge(half)
+
+				sorted := slices.Clone(y)
+				c := slices.Clone(classes)
+				w := slices.Clone(weights)
+				SortWeightedLabeled(sorted, c, w)
+				wantTPR, wantFPR, wantThresh := ROC(cutoffs, sorted, c, w)
+				tpr, fpr, thresh := r.ROC()
+				if !floats.EqualApprox(tpr, wantTPR, 1e-14) && !floats.Same(tpr, wantTPR) {
+					t.Errorf("%s: unexpected TPR: got:%v want:%v", name, tpr, wantTPR)
+				}
+				if !floats.EqualApprox(fpr, wantFPR, 1e-14) && !floats.Same(fpr, wantFPR) {
+					t.Errorf("%s: unexpected FPR: got:%v want:%v", name, fpr, wantFPR)
+				}
+				if !floats.Same(thresh, wantThresh) {
+					t.Errorf("%s: unexpected thresholds: got:%v want:%v", name, thresh, wantThresh)
+				}
+
+				// The AUC treats the observations in each
+				// bin as tied.
+				bins := make([]float64, n)
+				for i, v := range y {
+					bins[i] = float64(sort.Search(len(cutoffs), func(j int) bool { return v < cutoffs[j] }))
+				}
+				wantAUC, wantVar := DeLongAUC(bins, classes, weights)
+				auc, variance := r.AUC()
+				if !sameFloat(auc, wantAUC, 1e-14) {
+					t.Errorf("%s: unexpected AUC: got:%v want:%v", name, auc, wantAUC)
+				}
+				if !sameFloat(variance, wantVar, 1e-12) {
+					t.Errorf("%s: unexpected variance: got:%v want:%v", name, variance, wantVar)
+				}
+
+				r.Reset()
+				tpr, fpr, thresh = r.ROC()
+				if tpr != nil || fpr != nil || thresh != nil {
+					t.Errorf("%s: unexpected ROC after reset", name)
+				}
+			}
+		}
+	}
+
+	if !panics(func() { NewBinnedROC(nil) }) {
+		t.Error("expected panic for empty cutoffs")
+	}
+	if !panics(func() { NewBinnedROC([]float64{1, 0}) }) {
+		t.Error("expected panic for unsorted cutoffs")
+	}
+	if !panics(func() { NewBinnedROC([]float64{0, 0}) }) {
+		t.Error("expected panic for repeated cutoffs")
+	}
+	if !panics(func() { NewBinnedROC([]float64{0}).Add(math.NaN(), true, 1) }) {
+		t.Error("expected panic for NaN value")
+	}
+	if !panics(func() { NewBinnedROC([]float64{0}).Merge(NewBinnedROC([]float64{1})) }) {
+		t.Error("expected panic for cutoff mismatch")
+	}
+}
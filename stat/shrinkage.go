@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// LedoitWolf calculates the Ledoit-Wolf shrinkage estimate of the covariance
+// matrix of a matrix of data, x, and stores the result in dst. The estimate is
+//
+//	(1 - δ) S + δ μ I,
+//
+// where S is the maximum likelihood estimate of the covariance, normalized by
+// the number of observations rather than one fewer, μ is the mean of the
+// diagonal of S and the shrinkage intensity δ in [0, 1] asymptotically
+// minimizes the expected squared Frobenius norm of the error. LedoitWolf
+// returns δ.
+//
+// The estimate is positive definite when δ > 0 even when x has fewer rows than
+// columns, so it can be factorized by mat.Cholesky where the sample covariance
+// cannot.
+//
+// The dst matrix must either be empty or have the same number of
+// columns as the input data matrix.
+//
+// See O. Ledoit and M. Wolf, "A well-conditioned estimator for
+// large-dimensional covariance matrices", Journal of Multivariate Analysis
+// 88(2), 2004 for details.
+func LedoitWolf(dst *mat.SymDense, x mat.Matrix) (shrinkage float64) {
+	xc := shrinkageCovariance(dst, x)
+	n, p := xc.Dims()
+	mu, s2 := shrinkageMoments(dst)
+
+	// The sum of the squared norms of the outer products of
+	// the observations gives the variance of the elements of
+	// the sample covariance.
+	var b float64
+	for i := 0; i < n; i++ {
+		r := xc.RawRowView(i)
+		v := floats.Dot(r, r)
+		b += v * v
+	}
+	beta := (b/float64(n) - s2) / float64(n*p)
+	delta := s2/float64(p) - mu*mu
+	beta = min(beta, delta)
+	if beta > 0 {
+		shrinkage = beta / delta
+	}
+	shrinkTo(dst, shrinkage, mu)
+	return shrinkage
+}
+
+// OAS calculates the oracle approximating shrinkage estimate of the
+// covariance matrix of a matrix of data, x, and stores the result in dst.
+// The estimate is
+//
+//	(1 - δ) S + δ μ I,
+//
+// where S is the maximum likelihood estimate of the covariance, normalized by
+// the number of observations rather than one fewer, and μ is the mean of the
+// diagonal of S. The shrinkage intensity is
+//
+//	δ = min(1, (α + μ²) / ((n + 1) (α - μ²/p))),
+//
+// where α is the mean of the squared elements of S, n is the number of
+// observations and p is the number of variables. For Gaussian data, δ
+// converges faster than the Ledoit-Wolf intensity and is more accurate for
+// small samples. OAS returns δ.
+//
+// The dst matrix must either be empty or have the same number of
+// columns as the input data matrix.
+//
+// See Y. Chen, A. Wiesel, Y. C. Eldar and A. O. Hero, "Shrinkage algorithms
+// for MMSE covariance estimation", IEEE Transactions on Signal Processing
+// 58(10), 2010 for details.
+func OAS(dst *mat.SymDense, x mat.Matrix) (shrinkage float64) {
+	xc := shrinkageCovariance(dst, x)
+	n, p := xc.Dims()
+	mu, s2 := shrinkageMoments(dst)
+
+	alpha := s2 / float64(p*p)
+	num := alpha + mu*mu
+	den := float64(n+1) * (alpha - mu*mu/float64(p))
+	shrinkage = 1
+	if den > 0 {
+		shrinkage = min(num/den, 1)
+	}
+	shrinkTo(dst, shrinkage, mu)
+	return shrinkage
+}
+
+// shrinkageCovariance stores the maximum likelihood estimate of the
+// covariance of x into dst and returns x with its columns centered.
+func shrinkageCovariance(dst *mat.SymDense, x mat.Matrix) *mat.Dense {
+	r, c := x.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(c)
+	} else if dst.SymmetricDim() != c {
+		panic(mat.ErrShape)
+	}
+	var xc mat.Dense
+	xc.CloneFrom(x)
+	mean := make([]float64, c)
+	for i := 0; i < r; i++ {
+		floats.Add(mean, xc.RawRowView(i))
+	}
+	floats.Scale(1/float64(r), mean)
+	for i := 0; i < r; i++ {
+		floats.Sub(xc.RawRowView(i), mean)
+	}
+	dst.SymOuterK(1/float64(r), xc.T())
+	return &xc
+}
+
+// shrinkageMoments returns the mean of the diagonal of s and the sum of the
+// squares of the elements of s.
+func shrinkageMoments(s *mat.SymDense) (mu, s2 float64) {
+	n := s.SymmetricDim()
+	for i := 0; i < n; i++ {
+		v := s.At(i, i)
+		mu += v
+		s2 += v * v
+		for j := i + 1; j < n; j++ {
+			v := s.At(i, j)
+			s2 += 2 * v * v
+		}
+	}
+	return mu / float64(n), s2
+}
+
+// shrinkTo replaces s with (1-shrinkage) s + shrinkage mu I.
+func shrinkTo(s *mat.SymDense, shrinkage, mu float64) {
+	s.ScaleSym(1-shrinkage, s)
+	for i := 0; i < s.SymmetricDim(); i++ {
+		s.SetSym(i, i, s.At(i, i)+shrinkage*mu)
+	}
+}
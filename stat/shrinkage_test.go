@@ -0,0 +1,116 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestShrinkageCovariance(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ n, p int }{
+		{n: 2, p: 1},
+		{n: 10, p: 3},
+		{n: 100, p: 5},
+		{n: 10, p: 50},
+	} {
+		n, p := test.n, test.p
+		x := mat.NewDense(n, p, nil)
+		for i := 0; i < n; i++ {
+			z := rnd.NormFloat64()
+			for j := 0; j < p; j++ {
+				x.Set(i, j, float64(j+1)*rnd.NormFloat64()+z+float64(j))
+			}
+		}
+
+		// Compute the maximum likelihood covariance and its
+		// moments directly.
+		var s mat.SymDense
+		CovarianceMatrix(&s, x, nil)
+		s.ScaleSym(float64(n-1)/float64(n), &s)
+		mu := mat.Trace(&s) / float64(p)
+		var ss mat.Dense
+		ss.Mul(&s, &s)
+		tr2 := mat.Trace(&ss)
+		target := mat.NewDiagDense(p, nil)
+		for i := 0; i < p; i++ {
+			target.SetDiag(i, mu)
+		}
+		var diff mat.Dense
+		diff.Sub(&s, target)
+		d2 := math.Pow(mat.Norm(&diff, 2), 2) / float64(p)
+		mean := mat.NewVecDense(p, nil)
+		for j := 0; j < p; j++ {
+			mean.SetVec(j, Mean(mat.Col(nil, j, x), nil))
+		}
+		var b2 float64
+		for i := 0; i < n; i++ {
+			var r mat.VecDense
+			r.SubVec(x.RowView(i), mean)
+			var outer mat.Dense
+			outer.Outer(1, &r, &r)
+			outer.Sub(&outer, &s)
+			b2 += math.Pow(mat.Norm(&outer, 2), 2)
+		}
+		b2 /= float64(n*n) * float64(p)
+		wantLW := min(b2, d2) / d2
+		trS := mu * float64(p)
+		wantOAS := min(1, (tr2+trS*trS)/(float64(n+1)*(tr2-trS*trS/float64(p))))
+		if p == 1 {
+			wantLW = 0
+			wantOAS = 1
+		}
+
+		for _, est := range []struct {
+			name string
+			fn   func(*mat.SymDense, mat.Matrix) float64
+			want float64
+		}{
+			{name: "LedoitWolf", fn: LedoitWolf, want: wantLW},
+			{name: "OAS", fn: OAS, want: wantOAS},
+		} {
+			name := fmt.Sprintf("%s,n=%d,p=%d", est.name, n, p)
+			var got mat.SymDense
+			shrinkage := est.fn(&got, x)
+			if !scalar.EqualWithinAbsOrRel(shrinkage, est.want, 1e-12, 1e-12) {
+				t.Errorf("%s: unexpected shrinkage: got:%v want:%v", name, shrinkage, est.want)
+			}
+			if shrinkage < 0 || shrinkage > 1 {
+				t.Errorf("%s: shrinkage out of range: %v", name, shrinkage)
+			}
+			var want mat.Dense
+			want.Scale(1-shrinkage, &s)
+			for i := 0; i < p; i++ {
+				want.Set(i, i, want.At(i, i)+shrinkage*mu)
+			}
+			if !mat.EqualApprox(&got, &want, 1e-12) {
+				t.Errorf("%s: unexpected estimate:\ngot: %v\nwant:%v", name, mat.Formatted(&got), mat.Formatted(&want))
+			}
+
+			// The estimate of wide data can be factorized.
+			var chol mat.Cholesky
+			if ok := chol.Factorize(&got); !ok && shrinkage > 0 {
+				t.Errorf("%s: estimate not positive definite", name)
+			}
+			if p > n {
+				if ok := chol.Factorize(&s); ok {
+					t.Errorf("%s: unexpected positive definite sample covariance", name)
+				}
+			}
+
+			dst := mat.NewSymDense(p+1, nil)
+			if !panics(func() { est.fn(dst, x) }) {
+				t.Errorf("%s: expected panic for mismatched destination", name)
+			}
+		}
+	}
+}
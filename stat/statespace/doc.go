@@ -0,0 +1,27 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package statespace provides state space models with Kalman filtering
+// and smoothing.
+//
+// A linear Gaussian state space model describes a series of observations
+// y_t of a hidden state x_t that evolves as
+//
+//	x_{t+1} = F x_t + w_t,  w_t ~ N(0, Q),
+//	y_t     = H x_t + v_t,  v_t ~ N(0, R),
+//
+// with the initial state x_0 ~ N(μ_0, Σ_0). The Kalman filter computes the
+// distribution of each state given the observations up to that time, the
+// Rauch-Tung-Striebel smoother computes the distribution of each state given
+// all the observations, and the parameters of a model may be estimated from
+// observations by expectation maximization or by maximizing the likelihood
+// with the optimize package. Missing observations are represented by NaN.
+//
+// Models with nonlinear transition and observation functions are filtered
+// approximately by the extended and unscented Kalman filters.
+//
+// See Durbin and Koopman, "Time Series Analysis by State Space Methods",
+// Oxford University Press, 2012, and Särkkä, "Bayesian Filtering and
+// Smoothing", Cambridge University Press, 2013, for an introduction.
+package statespace // import "gonum.org/v1/gonum/stat/statespace"
@@ -0,0 +1,276 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package statespace
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// Params is a set of parameters of a Model.
+type Params uint
+
+// The parameters of a Model, named by its fields.
+const (
+	Transition Params = 1 << iota
+	TransitionCov
+	Observation
+	ObservationCov
+	InitMean
+	InitCov
+)
+
+// EMSettings holds settings for EM.
+type EMSettings struct {
+	// Iterations is the maximum number of iterations.
+	// If Iterations is zero, 100 is used.
+	Iterations int
+
+	// Tolerance is the relative increase of the log likelihood
+	// below which the iterations are considered converged.
+	// If Tolerance is zero, 1e-8 is used.
+	Tolerance float64
+
+	// Fixed holds the parameters that are not estimated.
+	Fixed Params
+}
+
+// EM estimates the parameters of the model from the observations in obs by
+// expectation maximization, updating the parameters of the receiver, and
+// returns the log likelihood of the observations under the estimated model.
+// The observations are as described for Filter. If settings is nil, default
+// settings are used.
+//
+// Each iteration smooths the states under the current parameters and sets
+// the parameters to those maximizing the expected complete-data log
+// likelihood, as described in Shumway and Stoffer, "An approach to time
+// series smoothing and forecasting using the EM algorithm", Journal of Time
+// Series Analysis 3(4), 1982. The log likelihood does not decrease over the
+// iterations. The mean and covariance of the initial state are poorly
+// determined by a single series, so it is common to fix InitCov.
+//
+// The observation parameters can only be estimated when the elements of each
+// observation are either all observed or all missing. EM returns an error if
+// this does not hold, if obs has fewer than two rows, or if the filter or
+// smoother fails.
+func (m *Model) EM(obs mat.Matrix, settings *EMSettings) (float64, error) {
+	iters := 100
+	tol := 1e-8
+	var fixed Params
+	if settings != nil {
+		if settings.Iterations != 0 {
+			iters = settings.Iterations
+		}
+		if settings.Tolerance != 0 {
+			tol = settings.Tolerance
+		}
+		fixed = settings.Fixed
+	}
+	steps, k := obs.Dims()
+	if steps < 2 {
+		return math.NaN(), errors.New("statespace: too few observations")
+	}
+	if fixed&(Observation|ObservationCov) != Observation|ObservationCov {
+		for t := 0; t < steps; t++ {
+			var n int
+			for j := 0; j < k; j++ {
+				if math.IsNaN(obs.At(t, j)) {
+					n++
+				}
+			}
+			if n != 0 && n != k {
+				return math.NaN(), errors.New("statespace: partially missing observation")
+			}
+		}
+	}
+
+	prev := math.Inf(-1)
+	for i := 0; ; i++ {
+		f, err := m.Filter(obs)
+		if err != nil {
+			return math.NaN(), err
+		}
+		ll := f.LogLikelihood
+		if i == iters || ll-prev <= tol*math.Abs(ll) {
+			return ll, nil
+		}
+		prev = ll
+		s, err := m.Smooth(f)
+		if err != nil {
+			return math.NaN(), err
+		}
+		err = m.maximize(obs, s, fixed)
+		if err != nil {
+			return math.NaN(), err
+		}
+	}
+}
+
+// maximize sets the parameters of the model that are not fixed to those
+// maximizing the expected complete-data log likelihood given the smoothed
+// states s.
+func (m *Model) maximize(obs mat.Matrix, s *Smoothed, fixed Params) error {
+	steps, k := obs.Dims()
+	n := len(m.InitMean)
+
+	// second returns the second moment E[x_t x_tᵀ] of the
+	// smoothed state at time t.
+	second := func(t int) *mat.Dense {
+		var e mat.Dense
+		e.Outer(1, s.Mean[t], s.Mean[t])
+		e.Add(&e, s.Cov[t])
+		return &e
+	}
+
+	if fixed&(Transition|TransitionCov) != Transition|TransitionCov {
+		s11 := mat.NewDense(n, n, nil)
+		s10 := mat.NewDense(n, n, nil)
+		s00 := mat.NewDense(n, n, nil)
+		var outer mat.Dense
+		for t := 1; t < steps; t++ {
+			s11.Add(s11, second(t))
+			s00.Add(s00, second(t-1))
+			outer.Outer(1, s.Mean[t], s.Mean[t-1])
+			s10.Add(s10, &outer)
+			s10.Add(s10, s.LagCov[t])
+		}
+
+		if fixed&Transition == 0 {
+			// F = S10 S00⁻¹, so S00 Fᵀ = S10ᵀ.
+			var ft mat.Dense
+			err := ft.Solve(s00, s10.T())
+			if err != nil && !isCondition(err) {
+				return err
+			}
+			m.Transition = mat.DenseCopyOf(ft.T())
+		}
+		if fixed&TransitionCov == 0 {
+			// Q = (S11 - F S10ᵀ - S10 Fᵀ + F S00 Fᵀ) / (T-1).
+			var fs, q, fss, fsf mat.Dense
+			fs.Mul(m.Transition, s10.T())
+			q.Sub(s11, &fs)
+			q.Sub(&q, fs.T())
+			fss.Mul(m.Transition, s00)
+			fsf.Mul(&fss, m.Transition.T())
+			q.Add(&q, &fsf)
+			q.Scale(1/float64(steps-1), &q)
+			m.TransitionCov = symmetric(&q)
+		}
+	}
+
+	if fixed&(Observation|ObservationCov) != Observation|ObservationCov {
+		syx := mat.NewDense(k, n, nil)
+		sxx := mat.NewDense(n, n, nil)
+		y := mat.NewVecDense(k, nil)
+		var outer mat.Dense
+		var count int
+		for t := 0; t < steps; t++ {
+			if math.IsNaN(obs.At(t, 0)) {
+				continue
+			}
+			count++
+			mat.Row(y.RawVector().Data, t, obs)
+			outer.Outer(1, y, s.Mean[t])
+			syx.Add(syx, &outer)
+			sxx.Add(sxx, second(t))
+		}
+		if count == 0 {
+			return errors.New("statespace: no observations")
+		}
+
+		if fixed&Observation == 0 {
+			// H = Syx Sxx⁻¹, so Sxx Hᵀ = Syxᵀ.
+			var ht mat.Dense
+			err := ht.Solve(sxx, syx.T())
+			if err != nil && !isCondition(err) {
+				return err
+			}
+			m.Observation = mat.DenseCopyOf(ht.T())
+		}
+		if fixed&ObservationCov == 0 {
+			// R = Σ (y - H x)(y - H x)ᵀ + H P Hᵀ over the observed times,
+			// divided by their number.
+			r := mat.NewDense(k, k, nil)
+			var v mat.VecDense
+			var vv, hp, hph mat.Dense
+			for t := 0; t < steps; t++ {
+				if math.IsNaN(obs.At(t, 0)) {
+					continue
+				}
+				mat.Row(y.RawVector().Data, t, obs)
+				v.MulVec(m.Observation, s.Mean[t])
+				v.SubVec(y, &v)
+				vv.Outer(1, &v, &v)
+				r.Add(r, &vv)
+				hp.Mul(m.Observation, s.Cov[t])
+				hph.Mul(&hp, m.Observation.T())
+				r.Add(r, &hph)
+			}
+			r.Scale(1/float64(count), r)
+			m.ObservationCov = symmetric(r)
+		}
+	}
+
+	if fixed&InitCov == 0 {
+		mu := mat.NewVecDense(n, append([]float64(nil), m.InitMean...))
+		if fixed&InitMean == 0 {
+			mu = s.Mean[0]
+		}
+		var d mat.VecDense
+		d.SubVec(s.Mean[0], mu)
+		cov := mat.NewSymDense(n, nil)
+		cov.SymOuterK(1, &d)
+		cov.AddSym(cov, s.Cov[0])
+		m.InitCov = cov
+	}
+	if fixed&InitMean == 0 {
+		m.InitMean = append([]float64(nil), s.Mean[0].RawVector().Data...)
+	}
+	return nil
+}
+
+// isCondition returns whether err is a mat.Condition warning.
+func isCondition(err error) bool {
+	var c mat.Condition
+	return errors.As(err, &c)
+}
+
+// Fit returns the parameters of a family of models that maximize the
+// likelihood of the observations in obs. The function model returns the
+// model for a set of parameters, and initial holds the parameters at which
+// the optimization starts. The observations are as described for Filter.
+//
+// The negative log likelihood is minimized by optimize.Minimize with the
+// given settings and method, using a central finite difference approximation
+// of its gradient. If settings is nil, the optimization stops when the
+// infinity norm of the gradient is below 1e-4, which is attainable with the
+// accuracy of the approximation. The returned result holds the estimated
+// parameters and the negative log likelihood at them. Parameters for which the filter fails,
+// for example because a covariance is not positive definite, have infinite
+// negative log likelihood, so parameterizations for which every model is
+// valid, such as log variances, work best.
+func Fit(obs mat.Matrix, model func(params []float64) *Model, initial []float64, settings *optimize.Settings, method optimize.Method) (*optimize.Result, error) {
+	nll := func(x []float64) float64 {
+		f, err := model(x).Filter(obs)
+		if err != nil {
+			return math.Inf(1)
+		}
+		return -f.LogLikelihood
+	}
+	p := optimize.Problem{
+		Func: nll,
+		Grad: func(grad, x []float64) {
+			fd.Gradient(grad, nll, x, &fd.Settings{Formula: fd.Central})
+		},
+	}
+	if settings == nil {
+		settings = &optimize.Settings{GradientThreshold: 1e-4}
+	}
+	return optimize.Minimize(p, initial, settings, method)
+}
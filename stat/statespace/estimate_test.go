@@ -0,0 +1,121 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package statespace
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ar1 returns a scalar autoregressive model observed with noise.
+func ar1(phi, q, r float64) *Model {
+	return &Model{
+		Transition:     mat.NewDense(1, 1, []float64{phi}),
+		TransitionCov:  mat.NewSymDense(1, []float64{q}),
+		Observation:    mat.NewDense(1, 1, []float64{1}),
+		ObservationCov: mat.NewSymDense(1, []float64{r}),
+		InitMean:       []float64{0},
+		InitCov:        mat.NewSymDense(1, []float64{1}),
+	}
+}
+
+func TestEMIncreasing(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, k  int
+		fixed Params
+	}{
+		{n: 1, k: 1, fixed: InitCov},
+		{n: 2, k: 2, fixed: InitCov},
+		{n: 2, k: 3, fixed: InitMean | InitCov},
+		{n: 3, k: 2, fixed: Observation},
+		{n: 2, k: 2},
+	} {
+		truth := randModel(rnd, test.n, test.k)
+		_, obs := truth.Simulate(100, rnd)
+		for _, t := range []int{10, 11, 12, 50} {
+			for j := 0; j < test.k; j++ {
+				obs.Set(t, j, math.NaN())
+			}
+		}
+
+		m := randModel(rnd, test.n, test.k)
+		prev := math.Inf(-1)
+		for i := 0; i < 20; i++ {
+			ll, err := m.EM(obs, &EMSettings{Iterations: 1, Fixed: test.fixed})
+			if err != nil {
+				t.Fatalf("unexpected error for n=%d k=%d: %v", test.n, test.k, err)
+			}
+			if ll < prev-1e-8*math.Abs(prev) {
+				t.Errorf("log likelihood decreased at iteration %d for n=%d k=%d: %v < %v", i, test.n, test.k, ll, prev)
+			}
+			prev = ll
+		}
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	t.Parallel()
+	const (
+		phi = 0.8
+		q   = 1
+		r   = 0.5
+	)
+	_, obs := ar1(phi, q, r).Simulate(1000, rand.NewPCG(1, 1))
+	for t := 100; t < 120; t++ {
+		obs.Set(t, 0, math.NaN())
+	}
+
+	m := ar1(0.1, 2, 2)
+	llEM, err := m.EM(obs, &EMSettings{
+		Iterations: 500,
+		Tolerance:  1e-9,
+		Fixed:      Observation | InitMean | InitCov,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	em := []float64{m.Transition.At(0, 0), m.TransitionCov.At(0, 0), m.ObservationCov.At(0, 0)}
+
+	res, err := Fit(obs, func(p []float64) *Model {
+		return ar1(p[0], math.Exp(p[1]), math.Exp(p[2]))
+	}, []float64{0.1, math.Log(2), math.Log(2)}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ml := []float64{res.X[0], math.Exp(res.X[1]), math.Exp(res.X[2])}
+
+	for i, want := range []float64{phi, q, r} {
+		if math.Abs(em[i]-want) > 0.1 {
+			t.Errorf("unexpected EM estimate of parameter %d: got:%v want:%v", i, em[i], want)
+		}
+		if math.Abs(ml[i]-em[i]) > 1e-3 {
+			t.Errorf("mismatched estimates of parameter %d: EM:%v ML:%v", i, em[i], ml[i])
+		}
+	}
+	if math.Abs(llEM+res.F) > 1e-6*math.Abs(llEM) {
+		t.Errorf("mismatched maximum log likelihood: EM:%v ML:%v", llEM, -res.F)
+	}
+}
+
+func TestEMErrors(t *testing.T) {
+	t.Parallel()
+	m := randModel(rand.New(rand.NewPCG(1, 1)), 2, 2)
+	obs := mat.NewDense(5, 2, nil)
+	obs.Set(2, 1, math.NaN())
+	if _, err := m.EM(obs, nil); err == nil {
+		t.Error("expected error for partially missing observation")
+	}
+	if _, err := m.EM(obs, &EMSettings{Fixed: Observation | ObservationCov}); err != nil {
+		t.Errorf("unexpected error with fixed observation parameters: %v", err)
+	}
+	if _, err := m.EM(mat.NewDense(1, 2, nil), nil); err == nil {
+		t.Error("expected error for too few observations")
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package statespace
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	errInnovation = errors.New("statespace: innovation covariance not positive definite")
+	errPredicted  = errors.New("statespace: predicted covariance not positive definite")
+)
+
+// Filtered holds the state distributions computed by a Kalman filter.
+// Element t of each slice corresponds to the observation at time t.
+type Filtered struct {
+	// Mean and Cov hold the mean and covariance of the state
+	// given the observations up to and including each time.
+	Mean []*mat.VecDense
+	Cov  []*mat.SymDense
+
+	// PredMean and PredCov hold the mean and covariance of the
+	// state given the observations before each time.
+	PredMean []*mat.VecDense
+	PredCov  []*mat.SymDense
+
+	// LogLikelihood is the log likelihood of the observations.
+	LogLikelihood float64
+}
+
+// Smoothed holds the state distributions computed by a Kalman smoother.
+// Element t of each slice corresponds to the observation at time t.
+type Smoothed struct {
+	// Mean and Cov hold the mean and covariance of the state
+	// given all the observations.
+	Mean []*mat.VecDense
+	Cov  []*mat.SymDense
+
+	// LagCov holds the cross covariance of the states at times
+	// t and t-1 given all the observations. LagCov[0] is nil.
+	LagCov []*mat.Dense
+}
+
+// Filter returns the distributions of the states of the model given the
+// observations in obs, with the observation at time t held in row t. NaN
+// elements of obs are treated as missing, so the filter uses only the
+// observed elements at each time and the predicted distribution of the
+// state at times with no observed elements.
+//
+// Filter returns an error if the covariance of an innovation is not
+// positive definite. Filter panics with mat.ErrShape if the number of
+// columns of obs does not match the observation dimension of the model.
+func (m *Model) Filter(obs mat.Matrix) (*Filtered, error) {
+	_, k := m.Dims()
+	return filter(obs, k, m.InitMean, m.InitCov, linear{m})
+}
+
+// Smooth returns the distributions of the states of the model given all
+// the observations, computed by the Rauch-Tung-Striebel smoother from the
+// output of Filter. Smooth returns an error if a predicted covariance in f
+// is not positive definite.
+func (m *Model) Smooth(f *Filtered) (*Smoothed, error) {
+	steps := len(f.Mean)
+	if steps == 0 {
+		panic("statespace: no filtered states")
+	}
+	s := &Smoothed{
+		Mean:   make([]*mat.VecDense, steps),
+		Cov:    make([]*mat.SymDense, steps),
+		LagCov: make([]*mat.Dense, steps),
+	}
+	s.Mean[steps-1] = mat.VecDenseCopyOf(f.Mean[steps-1])
+	s.Cov[steps-1] = mat.NewSymDense(f.Cov[steps-1].SymmetricDim(), nil)
+	s.Cov[steps-1].CopySym(f.Cov[steps-1])
+	for t := steps - 2; t >= 0; t-- {
+		// The smoother gain is J = P Fᵀ P⁻⁻¹ where P is the filtered
+		// covariance at t and P⁻ the predicted covariance at t+1.
+		var chol mat.Cholesky
+		if !chol.Factorize(f.PredCov[t+1]) {
+			return nil, errPredicted
+		}
+		var fp, jt mat.Dense
+		fp.Mul(m.Transition, f.Cov[t])
+		chol.SolveTo(&jt, &fp)
+
+		var d mat.VecDense
+		d.SubVec(s.Mean[t+1], f.PredMean[t+1])
+		mean := mat.NewVecDense(f.Mean[t].Len(), nil)
+		mean.MulVec(jt.T(), &d)
+		mean.AddVec(mean, f.Mean[t])
+
+		var dc, jdc, cov mat.Dense
+		dc.Sub(s.Cov[t+1], f.PredCov[t+1])
+		jdc.Mul(jt.T(), &dc)
+		cov.Mul(&jdc, &jt)
+		cov.Add(&cov, f.Cov[t])
+
+		lag := &mat.Dense{}
+		lag.Mul(s.Cov[t+1], &jt)
+
+		s.Mean[t] = mean
+		s.Cov[t] = symmetric(&cov)
+		s.LagCov[t+1] = lag
+	}
+	return s, nil
+}
+
+// stepper is a state space model that can be filtered.
+type stepper interface {
+	// predict returns the mean and covariance of the state at the
+	// next time given the mean and covariance at the current time.
+	predict(x *mat.VecDense, p *mat.SymDense) (*mat.VecDense, *mat.SymDense, error)
+
+	// innovation returns the innovation of the observed elements y
+	// with indices idx given the predicted mean and covariance of the
+	// state, the covariance of the innovation and the cross covariance
+	// of the state and the observation.
+	innovation(x *mat.VecDense, p *mat.SymDense, y []float64, idx []int) (v *mat.VecDense, s, c *mat.Dense, err error)
+}
+
+// filter runs a Kalman filter over obs for the model st with the given
+// initial state distribution.
+func filter(obs mat.Matrix, k int, mean []float64, cov mat.Symmetric, st stepper) (*Filtered, error) {
+	steps, c := obs.Dims()
+	if c != k {
+		panic(mat.ErrShape)
+	}
+	f := &Filtered{
+		Mean:     make([]*mat.VecDense, steps),
+		Cov:      make([]*mat.SymDense, steps),
+		PredMean: make([]*mat.VecDense, steps),
+		PredCov:  make([]*mat.SymDense, steps),
+	}
+	x := mat.NewVecDense(len(mean), append([]float64(nil), mean...))
+	p := mat.NewSymDense(len(mean), nil)
+	p.CopySym(cov)
+	idx := make([]int, 0, k)
+	y := make([]float64, 0, k)
+	for t := 0; t < steps; t++ {
+		if t > 0 {
+			var err error
+			x, p, err = st.predict(f.Mean[t-1], f.Cov[t-1])
+			if err != nil {
+				return nil, err
+			}
+		}
+		f.PredMean[t] = x
+		f.PredCov[t] = p
+
+		idx, y = observed(idx[:0], y[:0], obs, t)
+		if len(idx) == 0 {
+			f.Mean[t] = mat.VecDenseCopyOf(x)
+			f.Cov[t] = mat.NewSymDense(p.SymmetricDim(), nil)
+			f.Cov[t].CopySym(p)
+			continue
+		}
+		v, s, c, err := st.innovation(x, p, y, idx)
+		if err != nil {
+			return nil, err
+		}
+		var ll float64
+		f.Mean[t], f.Cov[t], ll, err = correct(x, p, v, s, c)
+		if err != nil {
+			return nil, err
+		}
+		f.LogLikelihood += ll
+	}
+	return f, nil
+}
+
+// correct returns the mean and covariance of the state updated with the
+// innovation v with covariance s and cross covariance c, and the log
+// likelihood of the innovation.
+func correct(x *mat.VecDense, p *mat.SymDense, v *mat.VecDense, s, c *mat.Dense) (*mat.VecDense, *mat.SymDense, float64, error) {
+	var chol mat.Cholesky
+	if !chol.Factorize(symmetric(s)) {
+		return nil, nil, 0, errInnovation
+	}
+
+	// The gain is K = C S⁻¹, so Kᵀ = S⁻¹ Cᵀ.
+	var kt mat.Dense
+	chol.SolveTo(&kt, c.T())
+	mean := mat.NewVecDense(x.Len(), nil)
+	mean.MulVec(kt.T(), v)
+	mean.AddVec(mean, x)
+	var kc mat.Dense
+	kc.Mul(c, &kt)
+	kc.Sub(p, &kc)
+
+	var sv mat.VecDense
+	chol.SolveVecTo(&sv, v)
+	k := v.Len()
+	ll := -0.5 * (float64(k)*math.Log(2*math.Pi) + chol.LogDet() + mat.Dot(v, &sv))
+	return mean, symmetric(&kc), ll, nil
+}
+
+// linear is the stepper for a linear model.
+type linear struct {
+	*Model
+}
+
+func (m linear) predict(x *mat.VecDense, p *mat.SymDense) (*mat.VecDense, *mat.SymDense, error) {
+	mean := mat.NewVecDense(x.Len(), nil)
+	mean.MulVec(m.Transition, x)
+	var fp, cov mat.Dense
+	fp.Mul(m.Transition, p)
+	cov.Mul(&fp, m.Transition.T())
+	cov.Add(&cov, m.TransitionCov)
+	return mean, symmetric(&cov), nil
+}
+
+func (m linear) innovation(x *mat.VecDense, p *mat.SymDense, y []float64, idx []int) (v *mat.VecDense, s, c *mat.Dense, err error) {
+	h := selectRows(m.Observation, idx)
+	v = mat.NewVecDense(len(y), append([]float64(nil), y...))
+	var yhat mat.VecDense
+	yhat.MulVec(h, x)
+	v.SubVec(v, &yhat)
+	c = &mat.Dense{}
+	c.Mul(p, h.T())
+	s = &mat.Dense{}
+	s.Mul(h, c)
+	s.Add(s, selectSym(m.ObservationCov, idx))
+	return v, s, c, nil
+}
+
+// observed appends to idx and y the indices and values of the elements of
+// row t of obs that are not NaN.
+func observed(idx []int, y []float64, obs mat.Matrix, t int) ([]int, []float64) {
+	_, c := obs.Dims()
+	for j := 0; j < c; j++ {
+		v := obs.At(t, j)
+		if math.IsNaN(v) {
+			continue
+		}
+		idx = append(idx, j)
+		y = append(y, v)
+	}
+	return idx, y
+}
+
+// selectRows returns the rows of a with the indices idx.
+func selectRows(a mat.Matrix, idx []int) *mat.Dense {
+	_, c := a.Dims()
+	m := mat.NewDense(len(idx), c, nil)
+	for i, r := range idx {
+		for j := 0; j < c; j++ {
+			m.Set(i, j, a.At(r, j))
+		}
+	}
+	return m
+}
+
+// selectSym returns the submatrix of a with the rows and columns idx.
+func selectSym(a mat.Symmetric, idx []int) *mat.SymDense {
+	m := mat.NewSymDense(len(idx), nil)
+	for i, r := range idx {
+		for j := i; j < len(idx); j++ {
+			m.SetSym(i, j, a.At(r, idx[j]))
+		}
+	}
+	return m
+}
+
+// symmetric returns the symmetric part of the square matrix a.
+func symmetric(a mat.Matrix) *mat.SymDense {
+	n, _ := a.Dims()
+	s := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.SetSym(i, j, (a.At(i, j)+a.At(j, i))/2)
+		}
+	}
+	return s
+}
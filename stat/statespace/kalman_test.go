@@ -0,0 +1,359 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package statespace
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distmv"
+)
+
+// randModel returns a random stable model with the given dimensions.
+func randModel(rnd *rand.Rand, n, k int) *Model {
+	randCov := func(n int) *mat.SymDense {
+		a := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		c := mat.NewSymDense(n, nil)
+		c.SymOuterK(1, a)
+		for i := 0; i < n; i++ {
+			c.SetSym(i, i, c.At(i, i)+0.5)
+		}
+		return c
+	}
+	f := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			f.Set(i, j, 0.8*rnd.NormFloat64()/math.Sqrt(float64(n)))
+		}
+	}
+	h := mat.NewDense(k, n, nil)
+	for i := 0; i < k; i++ {
+		for j := 0; j < n; j++ {
+			h.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	mu := make([]float64, n)
+	for i := range mu {
+		mu[i] = rnd.NormFloat64()
+	}
+	return &Model{
+		Transition:     f,
+		TransitionCov:  randCov(n),
+		Observation:    h,
+		ObservationCov: randCov(k),
+		InitMean:       mu,
+		InitCov:        randCov(n),
+	}
+}
+
+// joint returns the mean and covariance of the states and observations of
+// the model over the given number of steps, ordered as the states at each
+// time followed by the observations at each time.
+func joint(m *Model, steps int) ([]float64, *mat.SymDense) {
+	n, k := m.Dims()
+	means := make([]*mat.VecDense, steps)
+	covs := make([][]*mat.Dense, steps) // covs[t][u] = Cov(x_t, x_u) for u ≤ t.
+	for t := 0; t < steps; t++ {
+		covs[t] = make([]*mat.Dense, t+1)
+		if t == 0 {
+			means[0] = mat.NewVecDense(n, append([]float64(nil), m.InitMean...))
+			covs[0][0] = mat.DenseCopyOf(m.InitCov)
+			continue
+		}
+		means[t] = &mat.VecDense{}
+		means[t].MulVec(m.Transition, means[t-1])
+		for u := 0; u < t; u++ {
+			covs[t][u] = &mat.Dense{}
+			covs[t][u].Mul(m.Transition, covs[t-1][u])
+		}
+		var c mat.Dense
+		c.Mul(covs[t][t-1], m.Transition.T())
+		c.Add(&c, m.TransitionCov)
+		covs[t][t] = &c
+	}
+	cov := func(t, u int) mat.Matrix {
+		if u <= t {
+			return covs[t][u]
+		}
+		return covs[u][t].T()
+	}
+
+	dim := steps * (n + k)
+	mean := make([]float64, dim)
+	sigma := mat.NewSymDense(dim, nil)
+	xoff := func(t int) int { return t * n }
+	yoff := func(t int) int { return steps*n + t*k }
+	for t := 0; t < steps; t++ {
+		copy(mean[xoff(t):], means[t].RawVector().Data)
+		var y mat.VecDense
+		y.MulVec(m.Observation, means[t])
+		copy(mean[yoff(t):], y.RawVector().Data)
+		for u := 0; u < steps; u++ {
+			// Cov(x_t, y_u) = Cov(x_t, x_u) Hᵀ and
+			// Cov(y_t, y_u) = H Cov(x_t, x_u) Hᵀ + δ_tu R.
+			c := cov(t, u)
+			var ch, hch mat.Dense
+			ch.Mul(c, m.Observation.T())
+			hch.Mul(m.Observation, &ch)
+			if t == u {
+				hch.Add(&hch, m.ObservationCov)
+			}
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					sigma.SetSym(xoff(t)+i, xoff(u)+j, c.At(i, j))
+				}
+				for j := 0; j < k; j++ {
+					sigma.SetSym(xoff(t)+i, yoff(u)+j, ch.At(i, j))
+				}
+			}
+			for i := 0; i < k; i++ {
+				for j := 0; j < k; j++ {
+					sigma.SetSym(yoff(t)+i, yoff(u)+j, hch.At(i, j))
+				}
+			}
+		}
+	}
+	return mean, sigma
+}
+
+// condition returns the mean and covariance of the elements a of the
+// normal distribution with the given mean and covariance conditional on
+// the elements o taking the values z.
+func condition(mean []float64, cov mat.Symmetric, a, o []int, z []float64) (*mat.VecDense, *mat.Dense) {
+	sub := func(rows, cols []int) *mat.Dense {
+		m := mat.NewDense(len(rows), len(cols), nil)
+		for i, r := range rows {
+			for j, c := range cols {
+				m.Set(i, j, cov.At(r, c))
+			}
+		}
+		return m
+	}
+	saa := sub(a, a)
+	if len(o) == 0 {
+		mu := mat.NewVecDense(len(a), nil)
+		for i, r := range a {
+			mu.SetVec(i, mean[r])
+		}
+		return mu, saa
+	}
+	sao := sub(a, o)
+	soo := sub(o, o)
+	d := mat.NewVecDense(len(o), nil)
+	for i, r := range o {
+		d.SetVec(i, z[i]-mean[r])
+	}
+	var w mat.Dense
+	err := w.Solve(soo, sao.T())
+	if err != nil {
+		panic(err)
+	}
+	mu := mat.NewVecDense(len(a), nil)
+	mu.MulVec(w.T(), d)
+	for i, r := range a {
+		mu.SetVec(i, mu.AtVec(i)+mean[r])
+	}
+	var c mat.Dense
+	c.Mul(sao, &w)
+	c.Sub(saa, &c)
+	return mu, &c
+}
+
+func TestFilterSmooth(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-8
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, k, steps int
+		missing     float64 // missing is the probability of a missing element.
+	}{
+		{n: 1, k: 1, steps: 1},
+		{n: 1, k: 1, steps: 6},
+		{n: 2, k: 1, steps: 5},
+		{n: 2, k: 3, steps: 5, missing: 0.3},
+		{n: 3, k: 2, steps: 6, missing: 0.5},
+		{n: 3, k: 2, steps: 4, missing: 1},
+	} {
+		m := randModel(rnd, test.n, test.k)
+		_, obs := m.Simulate(test.steps, rnd)
+		for t := 0; t < test.steps; t++ {
+			for j := 0; j < test.k; j++ {
+				if rnd.Float64() < test.missing {
+					obs.Set(t, j, math.NaN())
+				}
+			}
+		}
+
+		f, err := m.Filter(obs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		s, err := m.Smooth(f)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		mean, sigma := joint(m, test.steps)
+		n, k := test.n, test.k
+		stateIdx := func(t int) []int {
+			idx := make([]int, n)
+			for i := range idx {
+				idx[i] = t*n + i
+			}
+			return idx
+		}
+		// obsIdx returns the joint indices and values of the observed
+		// elements up to and including time t.
+		obsIdx := func(t int) ([]int, []float64) {
+			var idx []int
+			var z []float64
+			for u := 0; u <= t; u++ {
+				for j := 0; j < k; j++ {
+					v := obs.At(u, j)
+					if !math.IsNaN(v) {
+						idx = append(idx, test.steps*n+u*k+j)
+						z = append(z, v)
+					}
+				}
+			}
+			return idx, z
+		}
+
+		for ti := 0; ti < test.steps; ti++ {
+			o, z := obsIdx(ti)
+			wantMean, wantCov := condition(mean, sigma, stateIdx(ti), o, z)
+			if !mat.EqualApprox(f.Mean[ti], wantMean, tol) {
+				t.Errorf("unexpected filtered mean at %d for n=%d k=%d:\ngot: %v\nwant:%v", ti, n, k, mat.Formatted(f.Mean[ti].T()), mat.Formatted(wantMean.T()))
+			}
+			if !mat.EqualApprox(f.Cov[ti], wantCov, tol) {
+				t.Errorf("unexpected filtered covariance at %d for n=%d k=%d", ti, n, k)
+			}
+			if ti > 0 {
+				o, z := obsIdx(ti - 1)
+				wantMean, wantCov := condition(mean, sigma, stateIdx(ti), o, z)
+				if !mat.EqualApprox(f.PredMean[ti], wantMean, tol) || !mat.EqualApprox(f.PredCov[ti], wantCov, tol) {
+					t.Errorf("unexpected predicted distribution at %d for n=%d k=%d", ti, n, k)
+				}
+			}
+		}
+
+		o, z := obsIdx(test.steps - 1)
+		for ti := 0; ti < test.steps; ti++ {
+			wantMean, wantCov := condition(mean, sigma, stateIdx(ti), o, z)
+			if !mat.EqualApprox(s.Mean[ti], wantMean, tol) {
+				t.Errorf("unexpected smoothed mean at %d for n=%d k=%d:\ngot: %v\nwant:%v", ti, n, k, mat.Formatted(s.Mean[ti].T()), mat.Formatted(wantMean.T()))
+			}
+			if !mat.EqualApprox(s.Cov[ti], wantCov, tol) {
+				t.Errorf("unexpected smoothed covariance at %d for n=%d k=%d", ti, n, k)
+			}
+			if ti == 0 {
+				if s.LagCov[0] != nil {
+					t.Errorf("unexpected non-nil lag covariance at 0")
+				}
+				continue
+			}
+			_, both := condition(mean, sigma, append(stateIdx(ti), stateIdx(ti-1)...), o, z)
+			wantLag := both.Slice(0, n, n, 2*n)
+			if !mat.EqualApprox(s.LagCov[ti], wantLag, tol) {
+				t.Errorf("unexpected lag covariance at %d for n=%d k=%d", ti, n, k)
+			}
+		}
+
+		var want float64
+		if len(o) != 0 {
+			mu := make([]float64, len(o))
+			cov := mat.NewSymDense(len(o), nil)
+			for i, r := range o {
+				mu[i] = mean[r]
+				for j, c := range o {
+					cov.SetSym(i, j, sigma.At(r, c))
+				}
+			}
+			dist, ok := distmv.NewNormal(mu, cov, nil)
+			if !ok {
+				t.Fatal("unexpected non-positive definite covariance")
+			}
+			want = dist.LogProb(z)
+		}
+		if !scalar.EqualWithinAbsOrRel(f.LogLikelihood, want, tol, tol) {
+			t.Errorf("unexpected log likelihood for n=%d k=%d: got:%v want:%v", n, k, f.LogLikelihood, want)
+		}
+	}
+}
+
+func TestFilterPanics(t *testing.T) {
+	t.Parallel()
+	m := randModel(rand.New(rand.NewPCG(1, 1)), 2, 2)
+	if !panics(func() { m.Filter(mat.NewDense(3, 3, nil)) }) {
+		t.Error("expected panic for mismatched observation dimension")
+	}
+	bad := *m
+	bad.InitMean = []float64{0}
+	if !panics(func() { bad.Filter(mat.NewDense(3, 2, nil)) }) {
+		t.Error("expected panic for mismatched state dimension")
+	}
+	if !panics(func() { m.Simulate(0, nil) }) {
+		t.Error("expected panic for non-positive length")
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+	// The sample moments of the observations of a stationary scalar
+	// model match their stationary values.
+	const (
+		phi   = 0.7
+		q     = 0.5
+		r     = 0.25
+		steps = 100000
+	)
+	m := &Model{
+		Transition:     mat.NewDense(1, 1, []float64{phi}),
+		TransitionCov:  mat.NewSymDense(1, []float64{q}),
+		Observation:    mat.NewDense(1, 1, []float64{1}),
+		ObservationCov: mat.NewSymDense(1, []float64{r}),
+		InitMean:       []float64{0},
+		InitCov:        mat.NewSymDense(1, []float64{q / (1 - phi*phi)}),
+	}
+	_, obs := m.Simulate(steps, rand.NewPCG(1, 1))
+	var sum, sum2, lag float64
+	for t := 0; t < steps; t++ {
+		y := obs.At(t, 0)
+		sum += y
+		sum2 += y * y
+		if t > 0 {
+			lag += y * obs.At(t-1, 0)
+		}
+	}
+	v := q / (1 - phi*phi)
+	for _, test := range []struct {
+		name      string
+		got, want float64
+	}{
+		{name: "mean", got: sum / steps, want: 0},
+		{name: "variance", got: sum2 / steps, want: v + r},
+		{name: "autocovariance", got: lag / (steps - 1), want: phi * v},
+	} {
+		if math.Abs(test.got-test.want) > 0.03 {
+			t.Errorf("unexpected %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
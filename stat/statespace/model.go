@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package statespace
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Model is a linear Gaussian state space model with an n-dimensional
+// state and m-dimensional observations,
+//
+//	x_{t+1} = F x_t + w_t,  w_t ~ N(0, Q),
+//	y_t     = H x_t + v_t,  v_t ~ N(0, R),
+//
+// and x_0 ~ N(μ_0, Σ_0), where x_0 is the state at the time of the first
+// observation.
+type Model struct {
+	// Transition is the n×n state transition matrix F.
+	Transition mat.Matrix
+	// TransitionCov is the n×n covariance Q of the state noise.
+	// It may be singular.
+	TransitionCov mat.Symmetric
+
+	// Observation is the m×n observation matrix H.
+	Observation mat.Matrix
+	// ObservationCov is the m×m covariance R of the observation
+	// noise.
+	ObservationCov mat.Symmetric
+
+	// InitMean is the mean μ_0 of the initial state.
+	InitMean []float64
+	// InitCov is the n×n covariance Σ_0 of the initial state.
+	InitCov mat.Symmetric
+}
+
+// Dims returns the dimensions of the state and the observations of the
+// model. Dims panics with mat.ErrShape if the dimensions of the model
+// parameters do not match.
+func (m *Model) Dims() (state, obs int) {
+	n := len(m.InitMean)
+	r, c := m.Transition.Dims()
+	if r != n || c != n || m.TransitionCov.SymmetricDim() != n || m.InitCov.SymmetricDim() != n {
+		panic(mat.ErrShape)
+	}
+	k, c := m.Observation.Dims()
+	if c != n || m.ObservationCov.SymmetricDim() != k {
+		panic(mat.ErrShape)
+	}
+	return n, k
+}
+
+// Simulate returns a realization of the model over the given number of time
+// steps. The states and observations at time t are held in row t of the
+// returned matrices. If src is nil, the global random source is used.
+// Simulate panics if steps is not positive.
+func (m *Model) Simulate(steps int, src rand.Source) (states, obs *mat.Dense) {
+	if steps <= 0 {
+		panic("statespace: non-positive length")
+	}
+	n, k := m.Dims()
+	normal := rand.NormFloat64
+	if src != nil {
+		normal = rand.New(src).NormFloat64
+	}
+	states = mat.NewDense(steps, n, nil)
+	obs = mat.NewDense(steps, k, nil)
+
+	init := newSampler(m.InitCov)
+	trans := newSampler(m.TransitionCov)
+	noise := newSampler(m.ObservationCov)
+	x := mat.NewVecDense(n, nil)
+	init.sample(x, normal)
+	x.AddVec(x, mat.NewVecDense(n, m.InitMean))
+	w := mat.NewVecDense(n, nil)
+	y := mat.NewVecDense(k, nil)
+	v := mat.NewVecDense(k, nil)
+	for t := 0; t < steps; t++ {
+		if t > 0 {
+			trans.sample(w, normal)
+			x.MulVec(m.Transition, x)
+			x.AddVec(x, w)
+		}
+		noise.sample(v, normal)
+		y.MulVec(m.Observation, x)
+		y.AddVec(y, v)
+		states.SetRow(t, x.RawVector().Data)
+		obs.SetRow(t, y.RawVector().Data)
+	}
+	return states, obs
+}
+
+// sampler generates normal vectors with a positive semi-definite covariance.
+type sampler struct {
+	root *mat.Dense
+	z    *mat.VecDense
+}
+
+// newSampler returns a sampler for the covariance cov, which may be
+// singular. newSampler panics if cov is not positive semi-definite.
+func newSampler(cov mat.Symmetric) sampler {
+	root, ok := sqrtm(cov)
+	if !ok {
+		panic("statespace: covariance not positive semi-definite")
+	}
+	return sampler{root: root, z: mat.NewVecDense(cov.SymmetricDim(), nil)}
+}
+
+// sqrtm returns a square root L of the positive semi-definite matrix a, such
+// that a = L Lᵀ, computed from the eigendecomposition of a. sqrtm returns
+// false if a is not positive semi-definite.
+func sqrtm(a mat.Symmetric) (*mat.Dense, bool) {
+	n := a.SymmetricDim()
+	var eig mat.EigenSym
+	if !eig.Factorize(a, true) {
+		return nil, false
+	}
+	var root mat.Dense
+	eig.VectorsTo(&root)
+	vals := eig.Values(nil)
+	for j, v := range vals {
+		if v < 0 {
+			if v < -1e-12*math.Abs(vals[n-1]) {
+				return nil, false
+			}
+			v = 0
+		}
+		s := math.Sqrt(v)
+		for i := 0; i < n; i++ {
+			root.Set(i, j, root.At(i, j)*s)
+		}
+	}
+	return &root, true
+}
+
+// sample stores a random vector in dst.
+func (s sampler) sample(dst *mat.VecDense, normal func() float64) {
+	for i := 0; i < s.z.Len(); i++ {
+		s.z.SetVec(i, normal())
+	}
+	dst.MulVec(s.root, s.z)
+}
@@ -0,0 +1,258 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package statespace
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/mat"
+)
+
+var errCovariance = errors.New("statespace: state covariance not positive semi-definite")
+
+// NonlinearModel is a state space model with additive Gaussian noise and
+// nonlinear transition and observation functions,
+//
+//	x_{t+1} = f(x_t) + w_t,  w_t ~ N(0, Q),
+//	y_t     = h(x_t) + v_t,  v_t ~ N(0, R),
+//
+// and x_0 ~ N(μ_0, Σ_0), where x_0 is the state at the time of the first
+// observation.
+type NonlinearModel struct {
+	// Transition stores the transition function f of x in dst.
+	Transition func(dst, x []float64)
+	// TransitionJacobian stores the Jacobian of the transition function
+	// at x in dst. If TransitionJacobian is nil, the Jacobian is
+	// approximated by finite differences.
+	TransitionJacobian func(dst *mat.Dense, x []float64)
+	// TransitionCov is the n×n covariance Q of the state noise.
+	TransitionCov mat.Symmetric
+
+	// Observation stores the observation function h of x in dst.
+	Observation func(dst, x []float64)
+	// ObservationJacobian stores the Jacobian of the observation function
+	// at x in dst. If ObservationJacobian is nil, the Jacobian is
+	// approximated by finite differences.
+	ObservationJacobian func(dst *mat.Dense, x []float64)
+	// ObservationCov is the m×m covariance R of the observation noise.
+	ObservationCov mat.Symmetric
+
+	// InitMean is the mean μ_0 of the initial state.
+	InitMean []float64
+	// InitCov is the n×n covariance Σ_0 of the initial state.
+	InitCov mat.Symmetric
+}
+
+// Dims returns the dimensions of the state and the observations of the
+// model. Dims panics with mat.ErrShape if the dimensions of the model
+// parameters do not match.
+func (m *NonlinearModel) Dims() (state, obs int) {
+	n := len(m.InitMean)
+	if m.TransitionCov.SymmetricDim() != n || m.InitCov.SymmetricDim() != n {
+		panic(mat.ErrShape)
+	}
+	return n, m.ObservationCov.SymmetricDim()
+}
+
+// ExtendedFilter returns approximate distributions of the states of the
+// model given the observations in obs, computed by the extended Kalman
+// filter. The extended filter applies the Kalman filter to the model
+// linearized about the current estimate of the state at each time, and is
+// accurate when the functions of the model are close to linear over the
+// spread of the state distribution. The observations and returned values are
+// as described for Model.Filter, and the returned log likelihood is that of
+// the linearized model.
+func (m *NonlinearModel) ExtendedFilter(obs mat.Matrix) (*Filtered, error) {
+	_, k := m.Dims()
+	return filter(obs, k, m.InitMean, m.InitCov, extended{m})
+}
+
+// UnscentedFilter returns approximate distributions of the states of the
+// model given the observations in obs, computed by the unscented Kalman
+// filter. The unscented filter propagates a set of sigma points chosen to
+// match the mean and covariance of the state through the functions of the
+// model, which captures the effect of the nonlinearity on the mean and
+// covariance more accurately than the linearization of the extended filter
+// and does not need derivatives. The observations and returned values are as
+// described for Model.Filter.
+//
+// The 2n+1 sigma points for an n-dimensional state are spread according to
+// the parameters alpha, beta and kappa of the scaled unscented transform,
+// described in Wan and van der Merwe, "The unscented Kalman filter for
+// nonlinear estimation", IEEE AS-SPCC, 2000. The spread of the points
+// around the mean is controlled by alpha in (0, 1] and kappa, which is
+// usually 0 or 3-n, and beta incorporates prior knowledge of the
+// distribution, with beta = 2 optimal for Gaussian distributions.
+// UnscentedFilter panics if α²(n+κ) is not positive.
+func (m *NonlinearModel) UnscentedFilter(obs mat.Matrix, alpha, beta, kappa float64) (*Filtered, error) {
+	n, k := m.Dims()
+	c := alpha * alpha * (float64(n) + kappa)
+	if c <= 0 {
+		panic("statespace: invalid unscented transform parameters")
+	}
+	lambda := c - float64(n)
+	u := unscented{
+		NonlinearModel: m,
+		scale:          c,
+		wm0:            lambda / c,
+		wc0:            lambda/c + 1 - alpha*alpha + beta,
+		wi:             1 / (2 * c),
+	}
+	return filter(obs, k, m.InitMean, m.InitCov, u)
+}
+
+// extended is the stepper for the extended Kalman filter.
+type extended struct {
+	*NonlinearModel
+}
+
+func (m extended) predict(x *mat.VecDense, p *mat.SymDense) (*mat.VecDense, *mat.SymDense, error) {
+	n := x.Len()
+	mean := mat.NewVecDense(n, nil)
+	m.Transition(mean.RawVector().Data, x.RawVector().Data)
+	jac := mat.NewDense(n, n, nil)
+	if m.TransitionJacobian != nil {
+		m.TransitionJacobian(jac, x.RawVector().Data)
+	} else {
+		fd.Jacobian(jac, m.Transition, x.RawVector().Data, &fd.JacobianSettings{Formula: fd.Central})
+	}
+	var fp, cov mat.Dense
+	fp.Mul(jac, p)
+	cov.Mul(&fp, jac.T())
+	cov.Add(&cov, m.TransitionCov)
+	return mean, symmetric(&cov), nil
+}
+
+func (m extended) innovation(x *mat.VecDense, p *mat.SymDense, y []float64, idx []int) (v *mat.VecDense, s, c *mat.Dense, err error) {
+	k := m.ObservationCov.SymmetricDim()
+	yhat := make([]float64, k)
+	m.Observation(yhat, x.RawVector().Data)
+	jac := mat.NewDense(k, x.Len(), nil)
+	if m.ObservationJacobian != nil {
+		m.ObservationJacobian(jac, x.RawVector().Data)
+	} else {
+		fd.Jacobian(jac, m.Observation, x.RawVector().Data, &fd.JacobianSettings{Formula: fd.Central})
+	}
+	h := selectRows(jac, idx)
+	v = mat.NewVecDense(len(idx), nil)
+	for i, j := range idx {
+		v.SetVec(i, y[i]-yhat[j])
+	}
+	c = &mat.Dense{}
+	c.Mul(p, h.T())
+	s = &mat.Dense{}
+	s.Mul(h, c)
+	s.Add(s, selectSym(m.ObservationCov, idx))
+	return v, s, c, nil
+}
+
+// unscented is the stepper for the unscented Kalman filter.
+type unscented struct {
+	*NonlinearModel
+
+	// scale is the squared spread of the sigma points, n+λ.
+	scale float64
+	// wm0 and wc0 are the weights of the central sigma point for
+	// the mean and covariance, and wi is the weight of the others.
+	wm0, wc0, wi float64
+}
+
+// sigmaPoints returns the sigma points of the distribution with mean x and
+// covariance p in the columns of the returned matrix.
+func (u unscented) sigmaPoints(x *mat.VecDense, p *mat.SymDense) (*mat.Dense, error) {
+	n := x.Len()
+	root, ok := sqrtm(p)
+	if !ok {
+		return nil, errCovariance
+	}
+	root.Scale(math.Sqrt(u.scale), root)
+	pts := mat.NewDense(n, 2*n+1, nil)
+	pts.SetCol(0, x.RawVector().Data)
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			xi := x.AtVec(i)
+			d := root.At(i, j)
+			pts.Set(i, 1+j, xi+d)
+			pts.Set(i, 1+n+j, xi-d)
+		}
+	}
+	return pts, nil
+}
+
+// transform applies fn to each column of pts and returns the transformed
+// points in the columns of the returned matrix, with their weighted mean.
+func (u unscented) transform(fn func(dst, x []float64), dim int, pts *mat.Dense) (*mat.Dense, *mat.VecDense) {
+	n, np := pts.Dims()
+	out := mat.NewDense(dim, np, nil)
+	x := make([]float64, n)
+	y := make([]float64, dim)
+	mean := mat.NewVecDense(dim, nil)
+	for j := 0; j < np; j++ {
+		mat.Col(x, j, pts)
+		fn(y, x)
+		out.SetCol(j, y)
+		w := u.wi
+		if j == 0 {
+			w = u.wm0
+		}
+		mean.AddScaledVec(mean, w, mat.NewVecDense(dim, y))
+	}
+	return out, mean
+}
+
+// cross returns the weighted cross covariance of the columns of a and b
+// about their means ma and mb.
+func (u unscented) cross(a *mat.Dense, ma *mat.VecDense, b *mat.Dense, mb *mat.VecDense) *mat.Dense {
+	ra, np := a.Dims()
+	rb, _ := b.Dims()
+	c := mat.NewDense(ra, rb, nil)
+	da := mat.NewVecDense(ra, nil)
+	db := mat.NewVecDense(rb, nil)
+	var outer mat.Dense
+	for j := 0; j < np; j++ {
+		da.SubVec(a.ColView(j), ma)
+		db.SubVec(b.ColView(j), mb)
+		w := u.wi
+		if j == 0 {
+			w = u.wc0
+		}
+		outer.Outer(w, da, db)
+		c.Add(c, &outer)
+	}
+	return c
+}
+
+func (u unscented) predict(x *mat.VecDense, p *mat.SymDense) (*mat.VecDense, *mat.SymDense, error) {
+	pts, err := u.sigmaPoints(x, p)
+	if err != nil {
+		return nil, nil, err
+	}
+	out, mean := u.transform(u.Transition, x.Len(), pts)
+	cov := u.cross(out, mean, out, mean)
+	cov.Add(cov, u.TransitionCov)
+	return mean, symmetric(cov), nil
+}
+
+func (u unscented) innovation(x *mat.VecDense, p *mat.SymDense, y []float64, idx []int) (v *mat.VecDense, s, c *mat.Dense, err error) {
+	pts, err := u.sigmaPoints(x, p)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	k := u.ObservationCov.SymmetricDim()
+	out, mean := u.transform(u.Observation, k, pts)
+	out = selectRows(out, idx)
+	yhat := mat.NewVecDense(len(idx), nil)
+	v = mat.NewVecDense(len(idx), nil)
+	for i, j := range idx {
+		yhat.SetVec(i, mean.AtVec(j))
+		v.SetVec(i, y[i]-mean.AtVec(j))
+	}
+	s = u.cross(out, yhat, out, yhat)
+	s.Add(s, selectSym(u.ObservationCov, idx))
+	c = u.cross(pts, x, out, yhat)
+	return v, s, c, nil
+}
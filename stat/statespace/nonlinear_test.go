@@ -0,0 +1,220 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package statespace
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// nonlinear returns the linear model m as a NonlinearModel, with analytic
+// Jacobians if jac is true.
+func nonlinear(m *Model, jac bool) *NonlinearModel {
+	apply := func(a mat.Matrix) func(dst, x []float64) {
+		return func(dst, x []float64) {
+			r, c := a.Dims()
+			mat.NewVecDense(r, dst).MulVec(a, mat.NewVecDense(c, x))
+		}
+	}
+	nm := &NonlinearModel{
+		Transition:     apply(m.Transition),
+		TransitionCov:  m.TransitionCov,
+		Observation:    apply(m.Observation),
+		ObservationCov: m.ObservationCov,
+		InitMean:       m.InitMean,
+		InitCov:        m.InitCov,
+	}
+	if jac {
+		nm.TransitionJacobian = func(dst *mat.Dense, _ []float64) { dst.Copy(m.Transition) }
+		nm.ObservationJacobian = func(dst *mat.Dense, _ []float64) { dst.Copy(m.Observation) }
+	}
+	return nm
+}
+
+func TestNonlinearLinear(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, k int
+	}{
+		{n: 1, k: 1},
+		{n: 2, k: 3},
+		{n: 3, k: 2},
+	} {
+		m := randModel(rnd, test.n, test.k)
+		_, obs := m.Simulate(20, rnd)
+		obs.Set(3, 0, math.NaN())
+		for j := 0; j < test.k; j++ {
+			obs.Set(7, j, math.NaN())
+		}
+		want, err := m.Filter(obs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, filter := range []struct {
+			name string
+			fn   func() (*Filtered, error)
+			tol  float64
+		}{
+			{name: "extended", tol: 1e-10, fn: func() (*Filtered, error) {
+				return nonlinear(m, true).ExtendedFilter(obs)
+			}},
+			{name: "extended finite difference", tol: 1e-6, fn: func() (*Filtered, error) {
+				return nonlinear(m, false).ExtendedFilter(obs)
+			}},
+			{name: "unscented", tol: 1e-8, fn: func() (*Filtered, error) {
+				return nonlinear(m, false).UnscentedFilter(obs, 1, 2, 0)
+			}},
+			{name: "unscented small alpha", tol: 1e-6, fn: func() (*Filtered, error) {
+				return nonlinear(m, false).UnscentedFilter(obs, 1e-2, 2, 0)
+			}},
+		} {
+			got, err := filter.fn()
+			if err != nil {
+				t.Fatalf("unexpected error for %s filter: %v", filter.name, err)
+			}
+			for i := range want.Mean {
+				if !mat.EqualApprox(got.Mean[i], want.Mean[i], filter.tol) || !mat.EqualApprox(got.Cov[i], want.Cov[i], filter.tol) {
+					t.Errorf("unexpected %s filter state at %d for n=%d k=%d", filter.name, i, test.n, test.k)
+				}
+				if !mat.EqualApprox(got.PredMean[i], want.PredMean[i], filter.tol) || !mat.EqualApprox(got.PredCov[i], want.PredCov[i], filter.tol) {
+					t.Errorf("unexpected %s filter prediction at %d for n=%d k=%d", filter.name, i, test.n, test.k)
+				}
+			}
+			if !scalar.EqualWithinAbsOrRel(got.LogLikelihood, want.LogLikelihood, filter.tol, filter.tol) {
+				t.Errorf("unexpected %s filter log likelihood for n=%d k=%d: got:%v want:%v", filter.name, test.n, test.k, got.LogLikelihood, want.LogLikelihood)
+			}
+		}
+	}
+}
+
+func TestNonlinearTracking(t *testing.T) {
+	t.Parallel()
+	// A target moving with nearly constant velocity in the plane is
+	// tracked from noisy range and bearing observations made from the
+	// origin.
+	const (
+		dt    = 0.1
+		steps = 200
+	)
+	transition := func(dst, x []float64) {
+		dst[0] = x[0] + dt*x[2]
+		dst[1] = x[1] + dt*x[3]
+		dst[2] = x[2]
+		dst[3] = x[3]
+	}
+	observation := func(dst, x []float64) {
+		dst[0] = math.Hypot(x[0], x[1])
+		dst[1] = math.Atan2(x[1], x[0])
+	}
+	q := mat.NewSymDense(4, nil)
+	for i := 0; i < 2; i++ {
+		q.SetSym(i, i, dt*dt*dt/3*0.1)
+		q.SetSym(i, i+2, dt*dt/2*0.1)
+		q.SetSym(i+2, i+2, dt*0.1)
+	}
+	r := mat.NewSymDense(2, []float64{0.1 * 0.1, 0, 0, 0.01 * 0.01})
+	m := &NonlinearModel{
+		Transition:     transition,
+		TransitionCov:  q,
+		Observation:    observation,
+		ObservationCov: r,
+		InitMean:       []float64{10, 0, 0, 1},
+		InitCov:        mat.NewSymDense(4, []float64{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0.1, 0, 0, 0, 0, 0.1}),
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	normal := func(cov mat.Symmetric) []float64 {
+		root, _ := sqrtm(cov)
+		n := cov.SymmetricDim()
+		z := mat.NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			z.SetVec(i, rnd.NormFloat64())
+		}
+		z.MulVec(root, z)
+		return z.RawVector().Data
+	}
+	states := mat.NewDense(steps, 4, nil)
+	obs := mat.NewDense(steps, 2, nil)
+	x := normal(m.InitCov)
+	for i, v := range m.InitMean {
+		x[i] += v
+	}
+	y := make([]float64, 2)
+	for t := 0; t < steps; t++ {
+		if t > 0 {
+			transition(x, append([]float64(nil), x...))
+			for i, w := range normal(q) {
+				x[i] += w
+			}
+		}
+		observation(y, x)
+		for i, v := range normal(r) {
+			y[i] += v
+		}
+		states.SetRow(t, x)
+		obs.SetRow(t, y)
+	}
+
+	for _, filter := range []struct {
+		name string
+		fn   func() (*Filtered, error)
+	}{
+		{name: "extended", fn: func() (*Filtered, error) { return m.ExtendedFilter(obs) }},
+		{name: "unscented", fn: func() (*Filtered, error) { return m.UnscentedFilter(obs, 1, 2, -1) }},
+	} {
+		f, err := filter.fn()
+		if err != nil {
+			t.Fatalf("unexpected error for %s filter: %v", filter.name, err)
+		}
+		// The position error over the second half of the track is
+		// smaller than that of the positions computed from the
+		// observations alone, and consistent with the filtered
+		// covariance.
+		var sse, raw, nees float64
+		for i := steps / 2; i < steps; i++ {
+			sin, cos := math.Sincos(obs.At(i, 1))
+			raw += math.Pow(obs.At(i, 0)*cos-states.At(i, 0), 2) + math.Pow(obs.At(i, 0)*sin-states.At(i, 1), 2)
+
+			d := mat.NewVecDense(2, []float64{
+				f.Mean[i].AtVec(0) - states.At(i, 0),
+				f.Mean[i].AtVec(1) - states.At(i, 1),
+			})
+			sse += mat.Dot(d, d)
+			var chol mat.Cholesky
+			if !chol.Factorize(mat.NewSymDense(2, []float64{
+				f.Cov[i].At(0, 0), f.Cov[i].At(0, 1),
+				f.Cov[i].At(1, 0), f.Cov[i].At(1, 1),
+			})) {
+				t.Fatalf("unexpected non-positive definite covariance for %s filter", filter.name)
+			}
+			var s mat.VecDense
+			chol.SolveVecTo(&s, d)
+			nees += mat.Dot(d, &s)
+		}
+		if sse > 0.5*raw {
+			t.Errorf("unexpected %s filter position error: got:%v want:<%v", filter.name, math.Sqrt(sse/(steps/2)), math.Sqrt(0.5*raw/(steps/2)))
+		}
+		// The normalized estimation error squared has mean 2 for a
+		// consistent filter.
+		if mean := nees / (steps / 2); mean > 4 {
+			t.Errorf("inconsistent %s filter: mean normalized error:%v", filter.name, mean)
+		}
+	}
+}
+
+func TestUnscentedPanics(t *testing.T) {
+	t.Parallel()
+	m := nonlinear(randModel(rand.New(rand.NewPCG(1, 1)), 2, 2), false)
+	obs := mat.NewDense(3, 2, nil)
+	if !panics(func() { m.UnscentedFilter(obs, 1, 2, -2) }) {
+		t.Error("expected panic for invalid unscented transform parameters")
+	}
+}
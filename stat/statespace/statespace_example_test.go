@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package statespace_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/statespace"
+)
+
+func ExampleModel_EM() {
+	// A level that follows a random walk is observed with noise,
+	// with the observations at times 40 to 59 missing.
+	truth := &statespace.Model{
+		Transition:     mat.NewDense(1, 1, []float64{1}),
+		TransitionCov:  mat.NewSymDense(1, []float64{0.1}),
+		Observation:    mat.NewDense(1, 1, []float64{1}),
+		ObservationCov: mat.NewSymDense(1, []float64{1}),
+		InitMean:       []float64{0},
+		InitCov:        mat.NewSymDense(1, []float64{1}),
+	}
+	states, obs := truth.Simulate(500, rand.NewPCG(1, 1))
+	for t := 40; t < 60; t++ {
+		obs.Set(t, 0, math.NaN())
+	}
+
+	// Estimate the noise variances starting from a poor guess.
+	m := &statespace.Model{
+		Transition:     mat.NewDense(1, 1, []float64{1}),
+		TransitionCov:  mat.NewSymDense(1, []float64{1}),
+		Observation:    mat.NewDense(1, 1, []float64{1}),
+		ObservationCov: mat.NewSymDense(1, []float64{10}),
+		InitMean:       []float64{0},
+		InitCov:        mat.NewSymDense(1, []float64{1}),
+	}
+	_, err := m.EM(obs, &statespace.EMSettings{
+		Fixed: statespace.Transition | statespace.Observation | statespace.InitMean | statespace.InitCov,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("state noise variance: %.2f\n", m.TransitionCov.At(0, 0))
+	fmt.Printf("observation noise variance: %.2f\n", m.ObservationCov.At(0, 0))
+
+	// Interpolate the level over the missing observations.
+	f, err := m.Filter(obs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s, err := m.Smooth(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, t := range []int{40, 50, 59} {
+		fmt.Printf("level at %d: %5.2f ± %.2f (true %5.2f)\n",
+			t, s.Mean[t].AtVec(0), math.Sqrt(s.Cov[t].At(0, 0)), states.At(t, 0))
+	}
+
+	// Output:
+	// state noise variance: 0.08
+	// observation noise variance: 1.01
+	// level at 40: -1.42 ± 0.54 (true -0.74)
+	// level at 50: -1.29 ± 0.76 (true -1.43)
+	// level at 59: -1.17 ± 0.54 (true -1.28)
+}
@@ -0,0 +1,259 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package streaming
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Covariance accumulates the weight, means, variances and covariance of a
+// stream of weighted pairs of observations.
+//
+// The zero value of Covariance is ready to use and holds no observations.
+type Covariance struct {
+	weight       float64
+	meanX, meanY float64
+
+	// m2X and m2Y hold the sums of the weighted squared
+	// deviations from the means and c holds the sum of
+	// the weighted products of the deviations.
+	m2X, m2Y, c float64
+}
+
+// Add adds the pair of observations x and y with the given weight to the
+// receiver. Add panics if x or y is NaN or weight is negative. Observations
+// with zero weight are ignored.
+func (c *Covariance) Add(x, y, weight float64) {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		panic(nanValue)
+	}
+	if weight < 0 {
+		panic(negWeight)
+	}
+	c.merge(weight, x, y, 0, 0, 0)
+}
+
+// Merge adds the observations accumulated in src to the receiver.
+func (c *Covariance) Merge(src *Covariance) {
+	c.merge(src.weight, src.meanX, src.meanY, src.m2X, src.m2Y, src.c)
+}
+
+// merge adds a set of observations with the given weight, means and
+// moment sums to the receiver.
+func (c *Covariance) merge(wb, mxb, myb, m2xb, m2yb, cb float64) {
+	if wb == 0 {
+		return
+	}
+	if c.weight == 0 {
+		c.weight, c.meanX, c.meanY, c.m2X, c.m2Y, c.c = wb, mxb, myb, m2xb, m2yb, cb
+		return
+	}
+	wa := c.weight
+	w := wa + wb
+	dx := mxb - c.meanX
+	dy := myb - c.meanY
+	f := wa * wb / w
+	c.m2X += m2xb + dx*dx*f
+	c.m2Y += m2yb + dy*dy*f
+	c.c += cb + dx*dy*f
+	c.meanX += dx * wb / w
+	c.meanY += dy * wb / w
+	c.weight = w
+}
+
+// Reset removes all the observations from the receiver.
+func (c *Covariance) Reset() {
+	*c = Covariance{}
+}
+
+// Weight returns the sum of the weights of the observations.
+func (c *Covariance) Weight() float64 {
+	return c.weight
+}
+
+// Means returns the weighted means of the x and y observations. Means
+// returns NaN values if there are no observations.
+func (c *Covariance) Means() (x, y float64) {
+	if c.weight == 0 {
+		return math.NaN(), math.NaN()
+	}
+	return c.meanX, c.meanY
+}
+
+// Variances returns the unbiased weighted variances of the x and y
+// observations.
+func (c *Covariance) Variances() (x, y float64) {
+	return c.m2X / (c.weight - 1), c.m2Y / (c.weight - 1)
+}
+
+// Covariance returns the weighted covariance of the observations,
+//
+//	sum_i {w_i (x_i - meanX) * (y_i - meanY)} / (sum_j {w_j} - 1),
+//
+// as computed by stat.Covariance.
+func (c *Covariance) Covariance() float64 {
+	return c.c / (c.weight - 1)
+}
+
+// Correlation returns the weighted correlation of the observations as
+// computed by stat.Correlation.
+func (c *Covariance) Correlation() float64 {
+	return c.c / math.Sqrt(c.m2X*c.m2Y)
+}
+
+// CovarianceMatrix accumulates the weight, mean and covariance matrix of a
+// stream of weighted vector observations.
+//
+// The zero value of CovarianceMatrix is not usable; use NewCovarianceMatrix
+// to create one.
+type CovarianceMatrix struct {
+	weight float64
+	mean   []float64
+
+	// c holds the sum of the weighted outer products
+	// of the deviations from the mean.
+	c *mat.SymDense
+
+	d []float64
+}
+
+// NewCovarianceMatrix returns a new CovarianceMatrix for observations of
+// dimension n. NewCovarianceMatrix panics if n is not positive.
+func NewCovarianceMatrix(n int) *CovarianceMatrix {
+	if n <= 0 {
+		panic(badDim)
+	}
+	return &CovarianceMatrix{
+		mean: make([]float64, n),
+		c:    mat.NewSymDense(n, nil),
+		d:    make([]float64, n),
+	}
+}
+
+// Dim returns the dimension of the observations.
+func (c *CovarianceMatrix) Dim() int {
+	return len(c.mean)
+}
+
+// Add adds the observation x with the given weight to the receiver. Add
+// panics if the length of x does not match the dimension of the receiver,
+// if x contains a NaN or if weight is negative. Observations with zero
+// weight are ignored.
+func (c *CovarianceMatrix) Add(x []float64, weight float64) {
+	if len(x) != len(c.mean) {
+		panic(badLength)
+	}
+	if floats.HasNaN(x) {
+		panic(nanValue)
+	}
+	if weight < 0 {
+		panic(negWeight)
+	}
+	if weight == 0 {
+		return
+	}
+	if c.weight == 0 {
+		c.weight = weight
+		copy(c.mean, x)
+		return
+	}
+	w := c.weight + weight
+	floats.SubTo(c.d, x, c.mean)
+	c.c.SymRankOne(c.c, c.weight*weight/w, mat.NewVecDense(len(c.d), c.d))
+	floats.AddScaled(c.mean, weight/w, c.d)
+	c.weight = w
+}
+
+// Merge adds the observations accumulated in src to the receiver. Merge
+// panics if the dimensions of the receiver and src differ.
+func (c *CovarianceMatrix) Merge(src *CovarianceMatrix) {
+	if len(src.mean) != len(c.mean) {
+		panic(badLength)
+	}
+	if src.weight == 0 {
+		return
+	}
+	if c.weight == 0 {
+		c.weight = src.weight
+		copy(c.mean, src.mean)
+		c.c.CopySym(src.c)
+		return
+	}
+	w := c.weight + src.weight
+	floats.SubTo(c.d, src.mean, c.mean)
+	c.c.AddSym(c.c, src.c)
+	c.c.SymRankOne(c.c, c.weight*src.weight/w, mat.NewVecDense(len(c.d), c.d))
+	floats.AddScaled(c.mean, src.weight/w, c.d)
+	c.weight = w
+}
+
+// Reset removes all the observations from the receiver.
+func (c *CovarianceMatrix) Reset() {
+	c.weight = 0
+	clear(c.mean)
+	c.c.Zero()
+}
+
+// Weight returns the sum of the weights of the observations.
+func (c *CovarianceMatrix) Weight() float64 {
+	return c.weight
+}
+
+// Mean stores the weighted mean of the observations into dst and returns
+// it. If dst is nil, a new slice is allocated. Mean panics if dst is not nil
+// and its length does not match the dimension of the receiver.
+func (c *CovarianceMatrix) Mean(dst []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(c.mean))
+	}
+	if len(dst) != len(c.mean) {
+		panic(badLength)
+	}
+	if c.weight == 0 {
+		for i := range dst {
+			dst[i] = math.NaN()
+		}
+		return dst
+	}
+	copy(dst, c.mean)
+	return dst
+}
+
+// CovarianceMatrix stores the weighted covariance matrix of the observations,
+// as computed by stat.CovarianceMatrix, into dst. If dst is empty, it is
+// resized to the dimension of the receiver. CovarianceMatrix panics if dst is
+// not empty and its dimension does not match the receiver.
+func (c *CovarianceMatrix) CovarianceMatrix(dst *mat.SymDense) {
+	n := len(c.mean)
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(n)
+	} else if dst.SymmetricDim() != n {
+		panic(mat.ErrShape)
+	}
+	dst.ScaleSym(1/(c.weight-1), c.c)
+}
+
+// CorrelationMatrix stores the weighted correlation matrix of the
+// observations, as computed by stat.CorrelationMatrix, into dst. If dst is
+// empty, it is resized to the dimension of the receiver. CorrelationMatrix
+// panics if dst is not empty and its dimension does not match the receiver.
+func (c *CovarianceMatrix) CorrelationMatrix(dst *mat.SymDense) {
+	c.CovarianceMatrix(dst)
+	raw := dst.RawSymmetric()
+	n := raw.N
+	for i := 0; i < n; i++ {
+		c.d[i] = 1 / math.Sqrt(raw.Data[i*raw.Stride+i])
+	}
+	for i := 0; i < n; i++ {
+		row := raw.Data[i*raw.Stride : i*raw.Stride+n]
+		for j := i; j < n; j++ {
+			row[j] *= c.d[i] * c.d[j]
+		}
+		row[i] = 1
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package streaming
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+func TestCovariance(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-10
+	for _, n := range []int{3, 10, 1000} {
+		for _, weighted := range []bool{false, true} {
+			x := make([]float64, n)
+			y := make([]float64, n)
+			for i := range x {
+				x[i] = 100 + rnd.NormFloat64()
+				y[i] = -50 + 0.5*x[i] + rnd.NormFloat64()
+			}
+			var weights []float64
+			if weighted {
+				weights = make([]float64, n)
+				for i := range weights {
+					weights[i] = 2 * rnd.Float64()
+				}
+			}
+
+			var all Covariance
+			parts := make([]Covariance, 4)
+			for i := range x {
+				w := 1.0
+				if weighted {
+					w = weights[i]
+				}
+				all.Add(x[i], y[i], w)
+				parts[i%len(parts)].Add(x[i], y[i], w)
+			}
+			var merged Covariance
+			for i := range parts {
+				merged.Merge(&parts[i])
+			}
+
+			for _, c := range []struct {
+				name string
+				c    *Covariance
+			}{{"added", &all}, {"merged", &merged}} {
+				mx, my := c.c.Means()
+				vx, vy := c.c.Variances()
+				for _, test := range []struct {
+					stat      string
+					got, want float64
+				}{
+					{"mean x", mx, stat.Mean(x, weights)},
+					{"mean y", my, stat.Mean(y, weights)},
+					{"variance x", vx, stat.Variance(x, weights)},
+					{"variance y", vy, stat.Variance(y, weights)},
+					{"covariance", c.c.Covariance(), stat.Covariance(x, y, weights)},
+					{"correlation", c.c.Correlation(), stat.Correlation(x, y, weights)},
+				} {
+					if !scalar.EqualWithinAbsOrRel(test.got, test.want, tol, tol) {
+						t.Errorf("n=%d,weighted=%t,%s: unexpected %s: got:%v want:%v",
+							n, weighted, c.name, test.stat, test.got, test.want)
+					}
+				}
+			}
+		}
+	}
+
+	var c Covariance
+	if mx, my := c.Means(); !math.IsNaN(mx) || !math.IsNaN(my) {
+		t.Errorf("unexpected means of empty covariance: %v %v", mx, my)
+	}
+	if !panics(func() { c.Add(math.NaN(), 1, 1) }) {
+		t.Error("expected panic for NaN value")
+	}
+	if !panics(func() { c.Add(1, 1, -1) }) {
+		t.Error("expected panic for negative weight")
+	}
+}
+
+func TestCovarianceMatrix(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-10
+	for _, test := range []struct {
+		r, c     int
+		weighted bool
+	}{
+		{r: 2, c: 1},
+		{r: 10, c: 3},
+		{r: 10, c: 3, weighted: true},
+		{r: 500, c: 5, weighted: true},
+	} {
+		x := mat.NewDense(test.r, test.c, nil)
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c; j++ {
+				x.Set(i, j, float64(10*j)+rnd.NormFloat64()+0.3*x.At(i, max(j-1, 0)))
+			}
+		}
+		var weights []float64
+		if test.weighted {
+			weights = make([]float64, test.r)
+			for i := range weights {
+				weights[i] = 2 * rnd.Float64()
+			}
+		}
+
+		all := NewCovarianceMatrix(test.c)
+		parts := []*CovarianceMatrix{NewCovarianceMatrix(test.c), NewCovarianceMatrix(test.c), NewCovarianceMatrix(test.c)}
+		for i := 0; i < test.r; i++ {
+			w := 1.0
+			if test.weighted {
+				w = weights[i]
+			}
+			all.Add(x.RawRowView(i), w)
+			parts[i%len(parts)].Add(x.RawRowView(i), w)
+		}
+		merged := NewCovarianceMatrix(test.c)
+		for _, p := range parts {
+			merged.Merge(p)
+		}
+
+		var wantCov, wantCorr mat.SymDense
+		stat.CovarianceMatrix(&wantCov, x, weights)
+		stat.CorrelationMatrix(&wantCorr, x, weights)
+		wantMean := make([]float64, test.c)
+		for j := range wantMean {
+			wantMean[j] = stat.Mean(mat.Col(nil, j, x), weights)
+		}
+		for _, c := range []struct {
+			name string
+			c    *CovarianceMatrix
+		}{{"added", all}, {"merged", merged}} {
+			if !floats.EqualApprox(c.c.Mean(nil), wantMean, tol) {
+				t.Errorf("r=%d,c=%d,weighted=%t,%s: unexpected mean: got:%v want:%v",
+					test.r, test.c, test.weighted, c.name, c.c.Mean(nil), wantMean)
+			}
+			var cov, corr mat.SymDense
+			c.c.CovarianceMatrix(&cov)
+			if !mat.EqualApprox(&cov, &wantCov, tol) {
+				t.Errorf("r=%d,c=%d,weighted=%t,%s: unexpected covariance matrix:\ngot:\n%v\nwant:\n%v",
+					test.r, test.c, test.weighted, c.name, mat.Formatted(&cov), mat.Formatted(&wantCov))
+			}
+			c.c.CorrelationMatrix(&corr)
+			if !mat.EqualApprox(&corr, &wantCorr, tol) {
+				t.Errorf("r=%d,c=%d,weighted=%t,%s: unexpected correlation matrix:\ngot:\n%v\nwant:\n%v",
+					test.r, test.c, test.weighted, c.name, mat.Formatted(&corr), mat.Formatted(&wantCorr))
+			}
+		}
+
+		all.Reset()
+		if all.Weight() != 0 || !math.IsNaN(all.Mean(nil)[0]) {
+			t.Errorf("r=%d,c=%d,weighted=%t: unexpected reset covariance matrix", test.r, test.c, test.weighted)
+		}
+	}
+}
+
+func TestCovarianceMatrixPanics(t *testing.T) {
+	t.Parallel()
+	c := NewCovarianceMatrix(2)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "zero dimension", fn: func() { NewCovarianceMatrix(0) }},
+		{name: "add length", fn: func() { c.Add([]float64{1}, 1) }},
+		{name: "NaN value", fn: func() { c.Add([]float64{1, math.NaN()}, 1) }},
+		{name: "negative weight", fn: func() { c.Add([]float64{1, 2}, -1) }},
+		{name: "merge dimension", fn: func() { c.Merge(NewCovarianceMatrix(3)) }},
+		{name: "mean length", fn: func() { c.Mean(make([]float64, 3)) }},
+		{name: "covariance dimension", fn: func() { c.CovarianceMatrix(mat.NewSymDense(3, nil)) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package streaming provides single-pass accumulators for statistics of data
+// that arrive in a stream and are not stored.
+//
+// Each accumulator is updated one observation at a time by its Add method and
+// uses memory that does not grow with the number of observations, or grows
+// only logarithmically for quantile sketches. The moment, covariance and
+// t-digest accumulators can be merged, so that observations accumulated
+// separately, for example concurrently or on different machines, can be
+// combined into the statistics of all the observations.
+//
+// Moments, Covariance and CovarianceMatrix compute the same weighted
+// statistics as the corresponding functions of the stat package, using the
+// numerically stable update and merge formulae of Pébay (2008). P2Quantile
+// estimates a single quantile with constant memory and TDigest estimates
+// arbitrary quantiles and the cumulative distribution function.
+//
+// References:
+//   - Pébay, P. Formulas for robust, one-pass parallel computation of
+//     covariances and arbitrary-order statistical moments. Sandia Report
+//     SAND2008-6212 (2008)
+//   - Jain, R. and Chlamtac, I. The P² algorithm for dynamic calculation of
+//     quantiles and histograms without storing observations. Communications
+//     of the ACM 28(10) (1985)
+//   - Dunning, T. and Ertl, O. Computing extremely accurate quantiles using
+//     t-digests. arXiv:1902.04023 (2019)
+package streaming // import "gonum.org/v1/gonum/stat/streaming"
@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package streaming
+
+import "math"
+
+const (
+	nanValue  = "streaming: NaN value"
+	negWeight = "streaming: negative weight"
+	badLength = "streaming: dimension mismatch"
+	badDim    = "streaming: non-positive dimension"
+)
+
+// Moments accumulates the weight, mean, extrema and the second, third and
+// fourth central moments of a stream of weighted observations.
+//
+// The zero value of Moments is ready to use and holds no observations.
+type Moments struct {
+	weight float64
+	mean   float64
+
+	// m2, m3 and m4 hold the sums of the weighted
+	// powers of the deviations from the mean.
+	m2, m3, m4 float64
+
+	min, max float64
+}
+
+// Add adds the observation x with the given weight to the receiver. Add
+// panics if x is NaN or weight is negative. Observations with zero weight
+// are ignored.
+func (m *Moments) Add(x, weight float64) {
+	if math.IsNaN(x) {
+		panic(nanValue)
+	}
+	if weight < 0 {
+		panic(negWeight)
+	}
+	m.merge(weight, x, 0, 0, 0, x, x)
+}
+
+// Merge adds the observations accumulated in src to the receiver.
+func (m *Moments) Merge(src *Moments) {
+	m.merge(src.weight, src.mean, src.m2, src.m3, src.m4, src.min, src.max)
+}
+
+// merge adds a set of observations with the given weight, mean, central
+// moment sums and extrema to the receiver.
+func (m *Moments) merge(wb, mb, m2b, m3b, m4b, minb, maxb float64) {
+	if wb == 0 {
+		return
+	}
+	if m.weight == 0 {
+		m.weight, m.mean, m.m2, m.m3, m.m4, m.min, m.max = wb, mb, m2b, m3b, m4b, minb, maxb
+		return
+	}
+	wa := m.weight
+	w := wa + wb
+	d := mb - m.mean
+	dw := d / w
+	m.m4 += m4b + d*dw*dw*dw*wa*wb*(wa*wa-wa*wb+wb*wb) +
+		6*dw*dw*(wa*wa*m2b+wb*wb*m.m2) + 4*dw*(wa*m3b-wb*m.m3)
+	m.m3 += m3b + d*dw*dw*wa*wb*(wa-wb) + 3*dw*(wa*m2b-wb*m.m2)
+	m.m2 += m2b + d*dw*wa*wb
+	m.mean += dw * wb
+	m.weight = w
+	m.min = math.Min(m.min, minb)
+	m.max = math.Max(m.max, maxb)
+}
+
+// Reset removes all the observations from the receiver.
+func (m *Moments) Reset() {
+	*m = Moments{}
+}
+
+// Weight returns the sum of the weights of the observations.
+func (m *Moments) Weight() float64 {
+	return m.weight
+}
+
+// Mean returns the weighted mean of the observations. Mean returns NaN if
+// there are no observations.
+func (m *Moments) Mean() float64 {
+	if m.weight == 0 {
+		return math.NaN()
+	}
+	return m.mean
+}
+
+// Min returns the smallest observation. Min returns NaN if there are no
+// observations.
+func (m *Moments) Min() float64 {
+	if m.weight == 0 {
+		return math.NaN()
+	}
+	return m.min
+}
+
+// Max returns the largest observation. Max returns NaN if there are no
+// observations.
+func (m *Moments) Max() float64 {
+	if m.weight == 0 {
+		return math.NaN()
+	}
+	return m.max
+}
+
+// Variance returns the unbiased weighted variance of the observations,
+//
+//	\sum_i w_i (x_i - mean)^2 / (sum_i w_i - 1),
+//
+// as computed by stat.Variance.
+func (m *Moments) Variance() float64 {
+	return m.m2 / (m.weight - 1)
+}
+
+// PopVariance returns the biased weighted variance of the observations,
+//
+//	\sum_i w_i (x_i - mean)^2 / (sum_i w_i),
+//
+// as computed by stat.PopVariance.
+func (m *Moments) PopVariance() float64 {
+	return m.m2 / m.weight
+}
+
+// StdDev returns the square root of the unbiased variance of the
+// observations.
+func (m *Moments) StdDev() float64 {
+	return math.Sqrt(m.Variance())
+}
+
+// Skew returns the skewness of the observations as computed by stat.Skew.
+func (m *Moments) Skew() float64 {
+	w := m.weight
+	std := m.StdDev()
+	return m.m3 / (std * std * std) * (w / (w - 1)) / (w - 2)
+}
+
+// ExKurtosis returns the population excess kurtosis of the observations as
+// computed by stat.ExKurtosis.
+func (m *Moments) ExKurtosis() float64 {
+	w := m.weight
+	v := m.Variance()
+	mul := ((w + 1) / (w - 1)) * (w / (w - 2)) * (1 / (w - 3))
+	offset := 3 * ((w - 1) / (w - 2)) * ((w - 1) / (w - 3))
+	return m.m4/(v*v)*mul - offset
+}
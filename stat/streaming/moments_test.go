@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package streaming
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/stat"
+)
+
+func TestMoments(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-10
+	for _, n := range []int{5, 10, 100, 1000} {
+		for _, weighted := range []bool{false, true} {
+			x := make([]float64, n)
+			for i := range x {
+				// Use a skewed, offset distribution so
+				// that all moments are non-trivial.
+				x[i] = 1e3 + rnd.ExpFloat64()
+			}
+			var weights []float64
+			if weighted {
+				weights = make([]float64, n)
+				for i := range weights {
+					weights[i] = 3 * rnd.Float64()
+				}
+			}
+
+			var all Moments
+			parts := make([]Moments, 3)
+			for i, v := range x {
+				w := 1.0
+				if weighted {
+					w = weights[i]
+				}
+				all.Add(v, w)
+				parts[i%len(parts)].Add(v, w)
+			}
+			var merged Moments
+			for i := range parts {
+				merged.Merge(&parts[i])
+			}
+
+			wantWeight := float64(n)
+			if weighted {
+				wantWeight = floats.Sum(weights)
+			}
+			for _, m := range []struct {
+				name string
+				m    *Moments
+			}{{"added", &all}, {"merged", &merged}} {
+				for _, test := range []struct {
+					stat      string
+					got, want float64
+				}{
+					{"weight", m.m.Weight(), wantWeight},
+					{"mean", m.m.Mean(), stat.Mean(x, weights)},
+					{"min", m.m.Min(), floats.Min(x)},
+					{"max", m.m.Max(), floats.Max(x)},
+					{"variance", m.m.Variance(), stat.Variance(x, weights)},
+					{"population variance", m.m.PopVariance(), stat.PopVariance(x, weights)},
+					{"standard deviation", m.m.StdDev(), stat.StdDev(x, weights)},
+					{"skew", m.m.Skew(), stat.Skew(x, weights)},
+					{"excess kurtosis", m.m.ExKurtosis(), stat.ExKurtosis(x, weights)},
+				} {
+					if !scalar.EqualWithinAbsOrRel(test.got, test.want, tol, tol) {
+						t.Errorf("n=%d,weighted=%t,%s: unexpected %s: got:%v want:%v",
+							n, weighted, m.name, test.stat, test.got, test.want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestMomentsEmpty(t *testing.T) {
+	t.Parallel()
+	var m Moments
+	m.Add(1, 0)
+	if m.Weight() != 0 || !math.IsNaN(m.Mean()) || !math.IsNaN(m.Min()) || !math.IsNaN(m.Max()) {
+		t.Errorf("unexpected statistics of empty moments: weight=%v mean=%v min=%v max=%v", m.Weight(), m.Mean(), m.Min(), m.Max())
+	}
+
+	// Merging into and from empty moments.
+	var a, b Moments
+	a.Add(2, 1)
+	a.Add(4, 1)
+	b.Merge(&a)
+	a.Merge(&Moments{})
+	if b != a {
+		t.Errorf("unexpected merge into empty moments: got:%+v want:%+v", b, a)
+	}
+	b.Reset()
+	if b != (Moments{}) {
+		t.Errorf("unexpected reset moments: %+v", b)
+	}
+}
+
+func TestMomentsPanics(t *testing.T) {
+	t.Parallel()
+	var m Moments
+	if !panics(func() { m.Add(math.NaN(), 1) }) {
+		t.Error("expected panic for NaN value")
+	}
+	if !panics(func() { m.Add(1, -1) }) {
+		t.Error("expected panic for negative weight")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,392 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package streaming
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+// P2Quantile estimates a single quantile of a stream of observations using
+// the P² algorithm of Jain and Chlamtac, which maintains five markers whose
+// heights approximate the minimum, the p/2, p and (1+p)/2 quantiles and the
+// maximum of the observations.
+//
+// P2Quantile uses constant memory, but its estimates cannot be merged.
+// The zero value of P2Quantile is not usable; use NewP2Quantile to create
+// one.
+type P2Quantile struct {
+	p     float64
+	count int
+
+	// q holds the marker heights, n the marker positions
+	// and np the desired marker positions. Positions are
+	// one-based.
+	q  [5]float64
+	n  [5]int
+	np [5]float64
+	dn [5]float64
+}
+
+// NewP2Quantile returns a new P2Quantile estimating the p quantile of the
+// observations. NewP2Quantile panics if p is not strictly between zero and
+// one.
+func NewP2Quantile(p float64) *P2Quantile {
+	if !(0 < p && p < 1) {
+		panic("streaming: quantile out of range")
+	}
+	return &P2Quantile{
+		p:  p,
+		dn: [5]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}
+}
+
+// Add adds the observation x to the receiver. Add panics if x is NaN.
+func (e *P2Quantile) Add(x float64) {
+	if math.IsNaN(x) {
+		panic(nanValue)
+	}
+	if e.count < 5 {
+		e.q[e.count] = x
+		e.count++
+		if e.count == 5 {
+			sort.Float64s(e.q[:])
+			p := e.p
+			e.n = [5]int{1, 2, 3, 4, 5}
+			e.np = [5]float64{1, 1 + 2*p, 1 + 4*p, 3 + 2*p, 5}
+		}
+		return
+	}
+	e.count++
+
+	// Find the cell containing x, adjusting the extreme
+	// markers if necessary.
+	var k int
+	switch {
+	case x < e.q[0]:
+		e.q[0] = x
+		k = 0
+	case x >= e.q[4]:
+		e.q[4] = x
+		k = 3
+	default:
+		for k = 0; x >= e.q[k+1]; k++ {
+		}
+	}
+	for i := k + 1; i < 5; i++ {
+		e.n[i]++
+	}
+	for i := range e.np {
+		e.np[i] += e.dn[i]
+	}
+
+	// Adjust the heights of the middle markers.
+	for i := 1; i < 4; i++ {
+		d := e.np[i] - float64(e.n[i])
+		if (d >= 1 && e.n[i+1]-e.n[i] > 1) || (d <= -1 && e.n[i-1]-e.n[i] < -1) {
+			s := 1
+			if d < 0 {
+				s = -1
+			}
+			q := e.parabolic(i, float64(s))
+			if !(e.q[i-1] < q && q < e.q[i+1]) {
+				q = e.q[i] + float64(s)*(e.q[i+s]-e.q[i])/float64(e.n[i+s]-e.n[i])
+			}
+			e.q[i] = q
+			e.n[i] += s
+		}
+	}
+}
+
+// parabolic returns the piecewise-parabolic prediction of the height of
+// marker i moved by d positions.
+func (e *P2Quantile) parabolic(i int, d float64) float64 {
+	n0, n1, n2 := float64(e.n[i-1]), float64(e.n[i]), float64(e.n[i+1])
+	q0, q1, q2 := e.q[i-1], e.q[i], e.q[i+1]
+	return q1 + d/(n2-n0)*((n1-n0+d)*(q2-q1)/(n2-n1)+(n2-n1-d)*(q1-q0)/(n1-n0))
+}
+
+// Reset removes all the observations from the receiver.
+func (e *P2Quantile) Reset() {
+	*e = *NewP2Quantile(e.p)
+}
+
+// Count returns the number of observations.
+func (e *P2Quantile) Count() int {
+	return e.count
+}
+
+// Quantile returns the estimate of the quantile of the observations. If
+// there are fewer than five observations, Quantile returns their empirical
+// quantile as computed by stat.Quantile. Quantile returns NaN if there are
+// no observations.
+func (e *P2Quantile) Quantile() float64 {
+	switch {
+	case e.count == 0:
+		return math.NaN()
+	case e.count < 5:
+		x := make([]float64, e.count)
+		copy(x, e.q[:e.count])
+		sort.Float64s(x)
+		return stat.Quantile(e.p, stat.Empirical, x, nil)
+	default:
+		return e.q[2]
+	}
+}
+
+// TDigest is a merging t-digest, a sketch of the distribution of a stream of
+// weighted observations that estimates quantiles and the cumulative
+// distribution function with an accuracy that is highest in the tails of
+// the distribution.
+//
+// A t-digest summarizes the observations as a sorted set of centroids, each
+// holding the mean and the total weight of a set of adjacent observations.
+// The weight of each centroid is limited by the arcsine scale function
+// according to its position in the distribution, so that the number of
+// centroids is bounded by the compression parameter. Added observations are
+// buffered and merged into the centroids when the buffer is full or when an
+// estimate is requested.
+//
+// The zero value of TDigest is not usable; use NewTDigest to create one.
+type TDigest struct {
+	compression float64
+
+	// means and weights hold the centroids
+	// sorted by their means.
+	means, weights []float64
+
+	// bufMeans and bufWeights hold the observations
+	// and centroids not yet merged into the digest.
+	bufMeans, bufWeights []float64
+
+	weight   float64
+	min, max float64
+}
+
+// NewTDigest returns a new TDigest with the given compression. Larger values
+// of compression give more accurate estimates at the cost of more centroids;
+// the number of centroids is at most approximately compression, and a value
+// of 100 gives quantile estimates accurate to a fraction of a percent.
+// NewTDigest panics if compression is less than 1.
+func NewTDigest(compression float64) *TDigest {
+	if !(compression >= 1) {
+		panic("streaming: invalid compression")
+	}
+	return &TDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Add adds the observation x with the given weight to the receiver. Add
+// panics if x is NaN or weight is negative. Observations with zero weight
+// are ignored.
+func (t *TDigest) Add(x, weight float64) {
+	if math.IsNaN(x) {
+		panic(nanValue)
+	}
+	if weight < 0 {
+		panic(negWeight)
+	}
+	if weight == 0 {
+		return
+	}
+	t.add(x, weight)
+	t.min = math.Min(t.min, x)
+	t.max = math.Max(t.max, x)
+}
+
+// add buffers a centroid with the mean x and the given weight, compressing
+// the digest if the buffer is full.
+func (t *TDigest) add(x, weight float64) {
+	t.bufMeans = append(t.bufMeans, x)
+	t.bufWeights = append(t.bufWeights, weight)
+	t.weight += weight
+	if float64(len(t.bufMeans)) >= 5*math.Ceil(t.compression) {
+		t.compress()
+	}
+}
+
+// Merge adds the observations summarized by src to the receiver.
+func (t *TDigest) Merge(src *TDigest) {
+	for i, m := range src.means {
+		t.add(m, src.weights[i])
+	}
+	for i, m := range src.bufMeans {
+		t.add(m, src.bufWeights[i])
+	}
+	t.min = math.Min(t.min, src.min)
+	t.max = math.Max(t.max, src.max)
+}
+
+// Reset removes all the observations from the receiver.
+func (t *TDigest) Reset() {
+	t.means = t.means[:0]
+	t.weights = t.weights[:0]
+	t.bufMeans = t.bufMeans[:0]
+	t.bufWeights = t.bufWeights[:0]
+	t.weight = 0
+	t.min = math.Inf(1)
+	t.max = math.Inf(-1)
+}
+
+// Weight returns the sum of the weights of the observations.
+func (t *TDigest) Weight() float64 {
+	return t.weight
+}
+
+// Min returns the smallest observation. Min returns NaN if there are no
+// observations.
+func (t *TDigest) Min() float64 {
+	if t.weight == 0 {
+		return math.NaN()
+	}
+	return t.min
+}
+
+// Max returns the largest observation. Max returns NaN if there are no
+// observations.
+func (t *TDigest) Max() float64 {
+	if t.weight == 0 {
+		return math.NaN()
+	}
+	return t.max
+}
+
+// Centroids returns the number of centroids in the digest after merging
+// any buffered observations.
+func (t *TDigest) Centroids() int {
+	t.compress()
+	return len(t.means)
+}
+
+// scale returns the value of the arcsine scale function at the quantile q.
+func (t *TDigest) scale(q float64) float64 {
+	return t.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// invScale returns the quantile at which the scale function has the value k.
+func (t *TDigest) invScale(k float64) float64 {
+	return (math.Sin(2*math.Pi*k/t.compression) + 1) / 2
+}
+
+// compress merges the buffered centroids into the digest.
+func (t *TDigest) compress() {
+	if len(t.bufMeans) == 0 {
+		return
+	}
+	means := append(t.bufMeans, t.means...)
+	weights := append(t.bufWeights, t.weights...)
+	sort.Sort(centroids{means: means, weights: weights})
+
+	t.means = t.means[:0]
+	t.weights = t.weights[:0]
+	mean, weight := means[0], weights[0]
+	var before float64
+	limit := t.invScale(t.scale(0) + 1)
+	for i := 1; i < len(means); i++ {
+		if (before+weight+weights[i])/t.weight <= limit {
+			weight += weights[i]
+			mean += (means[i] - mean) * weights[i] / weight
+			continue
+		}
+		t.means = append(t.means, mean)
+		t.weights = append(t.weights, weight)
+		before += weight
+		limit = t.invScale(t.scale(math.Min(before/t.weight, 1)) + 1)
+		mean, weight = means[i], weights[i]
+	}
+	t.means = append(t.means, mean)
+	t.weights = append(t.weights, weight)
+	t.bufMeans = means[:0]
+	t.bufWeights = weights[:0]
+}
+
+// centroids sorts centroids by their means.
+type centroids struct {
+	means, weights []float64
+}
+
+func (c centroids) Len() int           { return len(c.means) }
+func (c centroids) Less(i, j int) bool { return c.means[i] < c.means[j] }
+func (c centroids) Swap(i, j int) {
+	c.means[i], c.means[j] = c.means[j], c.means[i]
+	c.weights[i], c.weights[j] = c.weights[j], c.weights[i]
+}
+
+// Quantile returns the estimate of the q quantile of the observations,
+// interpolating linearly between the centroids and, in the tails, between
+// the extreme centroids and the minimum and maximum observations. Quantile
+// returns NaN if there are no observations and panics if q is not in the
+// interval [0, 1].
+func (t *TDigest) Quantile(q float64) float64 {
+	if !(0 <= q && q <= 1) {
+		panic("streaming: quantile out of range")
+	}
+	if t.weight == 0 {
+		return math.NaN()
+	}
+	t.compress()
+	n := len(t.means)
+	if n == 1 {
+		return t.min + q*(t.max-t.min)
+	}
+
+	index := q * t.weight
+	first := t.weights[0] / 2
+	if index < first {
+		return t.min + (t.means[0]-t.min)*index/first
+	}
+	last := t.weights[n-1] / 2
+	if index >= t.weight-last {
+		return t.means[n-1] + (t.max-t.means[n-1])*(index-(t.weight-last))/last
+	}
+	cum := first
+	for i := 0; i < n-1; i++ {
+		dw := (t.weights[i] + t.weights[i+1]) / 2
+		if index < cum+dw {
+			return t.means[i] + (t.means[i+1]-t.means[i])*(index-cum)/dw
+		}
+		cum += dw
+	}
+	return t.means[n-1]
+}
+
+// CDF returns the estimate of the fraction of the total weight of the
+// observations that are less than or equal to x, interpolating as described
+// for Quantile. CDF returns NaN if there are no observations.
+func (t *TDigest) CDF(x float64) float64 {
+	if t.weight == 0 {
+		return math.NaN()
+	}
+	switch {
+	case x < t.min:
+		return 0
+	case x >= t.max:
+		return 1
+	}
+	t.compress()
+	n := len(t.means)
+	if n == 1 {
+		return (x - t.min) / (t.max - t.min)
+	}
+
+	if x < t.means[0] {
+		return (x - t.min) / (t.means[0] - t.min) * t.weights[0] / 2 / t.weight
+	}
+	cum := t.weights[0] / 2
+	for i := 0; i < n-1; i++ {
+		dw := (t.weights[i] + t.weights[i+1]) / 2
+		if x < t.means[i+1] {
+			return (cum + dw*(x-t.means[i])/(t.means[i+1]-t.means[i])) / t.weight
+		}
+		cum += dw
+	}
+	last := t.weights[n-1] / 2
+	return (cum + last*(x-t.means[n-1])/(t.max-t.means[n-1])) / t.weight
+}
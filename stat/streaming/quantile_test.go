@@ -0,0 +1,210 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package streaming
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+// rankError returns the absolute difference between q and the fraction of
+// the sorted data x that is less than or equal to v.
+func rankError(x []float64, q, v float64) float64 {
+	return math.Abs(stat.CDF(v, stat.Empirical, x, nil) - q)
+}
+
+func TestP2Quantile(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 100000
+	for _, dist := range []struct {
+		name string
+		rand func() float64
+	}{
+		{name: "normal", rand: rnd.NormFloat64},
+		{name: "exponential", rand: rnd.ExpFloat64},
+		{name: "uniform", rand: rnd.Float64},
+	} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = dist.rand()
+		}
+		sorted := append([]float64(nil), x...)
+		sort.Float64s(sorted)
+		for _, p := range []float64{0.01, 0.1, 0.5, 0.9, 0.99} {
+			e := NewP2Quantile(p)
+			for _, v := range x {
+				e.Add(v)
+			}
+			if e.Count() != n {
+				t.Errorf("%s,p=%v: unexpected count: got:%d want:%d", dist.name, p, e.Count(), n)
+			}
+			if err := rankError(sorted, p, e.Quantile()); err > 0.005 {
+				t.Errorf("%s,p=%v: quantile rank error too large: %v", dist.name, p, err)
+			}
+		}
+	}
+
+	// Fewer than five observations give the empirical quantile.
+	e := NewP2Quantile(0.5)
+	if !math.IsNaN(e.Quantile()) {
+		t.Errorf("unexpected quantile of no observations: %v", e.Quantile())
+	}
+	for _, v := range []float64{3, 1, 4, 2} {
+		e.Add(v)
+	}
+	if got := e.Quantile(); got != 2 {
+		t.Errorf("unexpected quantile of four observations: got:%v want:2", got)
+	}
+	e.Reset()
+	if e.Count() != 0 || !math.IsNaN(e.Quantile()) {
+		t.Error("unexpected reset estimator")
+	}
+}
+
+func TestTDigest(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 100000
+	for _, dist := range []struct {
+		name string
+		rand func() float64
+	}{
+		{name: "normal", rand: rnd.NormFloat64},
+		{name: "exponential", rand: rnd.ExpFloat64},
+		{name: "discrete", rand: func() float64 { return float64(rnd.IntN(10)) }},
+	} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = dist.rand()
+		}
+		sorted := append([]float64(nil), x...)
+		sort.Float64s(sorted)
+
+		all := NewTDigest(100)
+		parts := []*TDigest{NewTDigest(100), NewTDigest(100), NewTDigest(100), NewTDigest(100)}
+		for i, v := range x {
+			all.Add(v, 1)
+			parts[i%len(parts)].Add(v, 1)
+		}
+		merged := NewTDigest(100)
+		for _, p := range parts {
+			merged.Merge(p)
+		}
+
+		for _, d := range []struct {
+			name string
+			d    *TDigest
+		}{{"added", all}, {"merged", merged}} {
+			if d.d.Weight() != n {
+				t.Errorf("%s,%s: unexpected weight: got:%v want:%d", dist.name, d.name, d.d.Weight(), n)
+			}
+			if d.d.Min() != sorted[0] || d.d.Max() != sorted[n-1] {
+				t.Errorf("%s,%s: unexpected extrema: got:%v,%v want:%v,%v", dist.name, d.name, d.d.Min(), d.d.Max(), sorted[0], sorted[n-1])
+			}
+			if c := d.d.Centroids(); c > 100 {
+				t.Errorf("%s,%s: too many centroids: %d", dist.name, d.name, c)
+			}
+			if d.d.Quantile(0) != sorted[0] || d.d.Quantile(1) != sorted[n-1] {
+				t.Errorf("%s,%s: extreme quantiles are not the extrema", dist.name, d.name)
+			}
+			if dist.name == "discrete" {
+				continue
+			}
+			for _, q := range []float64{0.001, 0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.99, 0.999} {
+				// The accuracy of the estimate
+				// increases towards the tails.
+				tol := 0.001 + 0.004*math.Sqrt(4*q*(1-q))
+				if err := rankError(sorted, q, d.d.Quantile(q)); err > tol {
+					t.Errorf("%s,%s,q=%v: quantile rank error too large: %v > %v", dist.name, d.name, q, err, tol)
+				}
+				v := stat.Quantile(q, stat.Empirical, sorted, nil)
+				if err := math.Abs(d.d.CDF(v) - stat.CDF(v, stat.Empirical, sorted, nil)); err > tol {
+					t.Errorf("%s,%s,q=%v: CDF error too large: %v > %v", dist.name, d.name, q, err, tol)
+				}
+			}
+		}
+	}
+}
+
+func TestTDigestWeighted(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 20000
+	x := make([]float64, n)
+	weights := make([]float64, n)
+	d := NewTDigest(200)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+		weights[i] = rnd.Float64()
+		d.Add(x[i], weights[i])
+	}
+	stat.SortWeighted(x, weights)
+	for _, q := range []float64{0.05, 0.5, 0.95} {
+		got := d.Quantile(q)
+		if err := math.Abs(stat.CDF(got, stat.Empirical, x, weights) - q); err > 0.005 {
+			t.Errorf("q=%v: weighted quantile rank error too large: %v", q, err)
+		}
+	}
+}
+
+func TestTDigestSmall(t *testing.T) {
+	t.Parallel()
+	d := NewTDigest(100)
+	if !math.IsNaN(d.Quantile(0.5)) || !math.IsNaN(d.CDF(0)) || !math.IsNaN(d.Min()) || !math.IsNaN(d.Max()) {
+		t.Error("unexpected estimates for empty digest")
+	}
+	d.Add(2, 1)
+	if got := d.Quantile(0.3); got != 2 {
+		t.Errorf("unexpected quantile of single observation: got:%v want:2", got)
+	}
+	if d.CDF(1.9) != 0 || d.CDF(2) != 1 {
+		t.Errorf("unexpected CDF of single observation: %v %v", d.CDF(1.9), d.CDF(2))
+	}
+	for _, v := range []float64{4, 6, 8} {
+		d.Add(v, 1)
+	}
+	for _, test := range []struct {
+		q, want float64
+	}{
+		{q: 0, want: 2}, {q: 0.25, want: 3}, {q: 0.5, want: 5}, {q: 0.75, want: 7}, {q: 1, want: 8},
+	} {
+		if got := d.Quantile(test.q); math.Abs(got-test.want) > 1e-14 {
+			t.Errorf("unexpected quantile for q=%v: got:%v want:%v", test.q, got, test.want)
+		}
+		if got := d.CDF(test.want); 0 < test.q && test.q < 1 && math.Abs(got-test.q) > 1e-14 {
+			t.Errorf("unexpected CDF for x=%v: got:%v want:%v", test.want, got, test.q)
+		}
+	}
+	d.Reset()
+	if d.Weight() != 0 || d.Centroids() != 0 || !math.IsNaN(d.Quantile(0.5)) {
+		t.Error("unexpected reset digest")
+	}
+}
+
+func TestQuantilePanics(t *testing.T) {
+	t.Parallel()
+	d := NewTDigest(100)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "P2 zero quantile", fn: func() { NewP2Quantile(0) }},
+		{name: "P2 NaN quantile", fn: func() { NewP2Quantile(math.NaN()) }},
+		{name: "P2 NaN value", fn: func() { NewP2Quantile(0.5).Add(math.NaN()) }},
+		{name: "small compression", fn: func() { NewTDigest(0.5) }},
+		{name: "NaN value", fn: func() { d.Add(math.NaN(), 1) }},
+		{name: "negative weight", fn: func() { d.Add(1, -1) }},
+		{name: "large quantile", fn: func() { d.Quantile(1.5) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
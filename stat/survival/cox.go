@@ -0,0 +1,534 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var errNotPosDef = errors.New("survival: information matrix not positive definite")
+
+// Ties specifies the approximation to the partial likelihood used for
+// events at tied times in Cox regression.
+type Ties int
+
+const (
+	// Efron is Efron's approximation, which is more accurate
+	// than Breslow's when there are many tied events.
+	Efron Ties = iota
+
+	// Breslow is Breslow's approximation.
+	Breslow
+)
+
+// CoxSettings holds settings for fitting a Cox proportional hazards model.
+// The zero value uses Efron's approximation for ties with the default
+// convergence criteria.
+type CoxSettings struct {
+	// Ties is the approximation used for tied events.
+	Ties Ties
+
+	// Cluster, if not nil, holds the cluster label of each
+	// subject. The robust covariance is then computed from
+	// the score residuals summed within each cluster, which
+	// allows for correlation between subjects in a cluster.
+	Cluster []int
+
+	// MaxIterations is the maximum number of Newton-Raphson
+	// iterations. If it is zero, a default of 20 is used.
+	MaxIterations int
+
+	// Tolerance is the relative change in the log partial
+	// likelihood at which the iterations are considered to
+	// have converged. If it is zero, a default of 1e-9 is used.
+	Tolerance float64
+}
+
+// CoxPH is a Cox proportional hazards regression model, in which the
+// hazard of a subject with covariates x is
+//
+//	h(t | x) = h₀(t) exp(xᵀβ),
+//
+// for an unspecified baseline hazard h₀. The coefficients β are estimated
+// by maximizing the partial likelihood.
+type CoxPH struct {
+	coef []float64
+
+	cov    *mat.SymDense
+	robust *mat.SymDense
+
+	logLik     float64
+	nullLogLik float64
+	iterations int
+}
+
+// NewCoxPH fits a Cox proportional hazards model to subjects with the
+// covariates held in the rows of x and the given times of an event or of
+// censoring, where event indicates whether the event was observed for each
+// subject. If settings is nil, the zero value of CoxSettings is used.
+//
+// The coefficients are found by Newton-Raphson iteration from zero, with
+// step halving when a step does not increase the log partial likelihood.
+// The model-based covariance of the coefficients is the inverse of the
+// observed information. The robust covariance is the sandwich estimate
+// of Lin and Wei, formed from the score residuals of the subjects or of
+// the clusters given in settings.
+//
+// NewCoxPH returns an error if the information matrix is not positive
+// definite, which happens when there are no events or the covariates are
+// collinear, or if the iterations do not converge. NewCoxPH panics if the
+// number of rows of x, the lengths of time and event, and the length of a
+// non-nil settings.Cluster differ.
+func NewCoxPH(x mat.Matrix, time []float64, event []bool, settings *CoxSettings) (*CoxPH, error) {
+	n, p := x.Dims()
+	if len(time) != n {
+		panic("survival: mismatched length of time")
+	}
+	checkObservations(time, event, nil)
+	if settings == nil {
+		settings = &CoxSettings{}
+	}
+	if settings.Cluster != nil && len(settings.Cluster) != n {
+		panic("survival: mismatched length of cluster")
+	}
+	maxIter := settings.MaxIterations
+	if maxIter == 0 {
+		maxIter = 20
+	}
+	tol := settings.Tolerance
+	if tol == 0 {
+		tol = 1e-9
+	}
+
+	// The coefficients are invariant to the centering of the
+	// covariates, which improves the conditioning of exp(xᵀβ).
+	xc := mat.DenseCopyOf(x)
+	for j := 0; j < p; j++ {
+		col := xc.ColView(j).(*mat.VecDense)
+		mean := mat.Sum(col) / float64(n)
+		for i := 0; i < n; i++ {
+			col.SetVec(i, col.AtVec(i)-mean)
+		}
+	}
+	m := &coxModel{
+		x:     xc,
+		time:  time,
+		event: event,
+		ties:  settings.Ties,
+		idx:   sortedIndex(time),
+	}
+
+	beta := make([]float64, p)
+	score := make([]float64, p)
+	info := mat.NewSymDense(p, nil)
+	ll := m.partial(beta, score, info)
+	c := &CoxPH{nullLogLik: ll}
+
+	var chol mat.Cholesky
+	step := mat.NewVecDense(p, nil)
+	trial := make([]float64, p)
+	var converged bool
+	for c.iterations < maxIter && !converged {
+		c.iterations++
+		if !chol.Factorize(info) {
+			return nil, errNotPosDef
+		}
+		err := chol.SolveVecTo(step, mat.NewVecDense(p, score))
+		if err != nil {
+			return nil, err
+		}
+		var llTrial float64
+		for halving := 0; ; halving++ {
+			floats.AddTo(trial, beta, step.RawVector().Data)
+			llTrial = m.partial(trial, nil, nil)
+			if llTrial >= ll || halving == 30 {
+				break
+			}
+			step.ScaleVec(0.5, step)
+		}
+		converged = math.Abs(llTrial-ll) <= tol*math.Abs(llTrial)
+		copy(beta, trial)
+		ll = m.partial(beta, score, info)
+	}
+	if !converged {
+		return nil, errors.New("survival: Cox regression did not converge")
+	}
+	c.coef = beta
+	c.logLik = ll
+
+	if !chol.Factorize(info) {
+		return nil, errNotPosDef
+	}
+	c.cov = &mat.SymDense{}
+	err := chol.InverseTo(c.cov)
+	if err != nil {
+		return nil, err
+	}
+
+	// The robust covariance is Dᵀ D, where the rows of D are
+	// the score residuals of the subjects or clusters scaled
+	// by the model-based covariance.
+	resid := m.scoreResiduals(beta)
+	if settings.Cluster != nil {
+		resid = sumClusters(resid, settings.Cluster)
+	}
+	var dfbeta mat.Dense
+	dfbeta.Mul(resid, c.cov)
+	c.robust = &mat.SymDense{}
+	c.robust.SymOuterK(1, dfbeta.T())
+	return c, nil
+}
+
+// Coef returns the estimated coefficients of the model. If dst is not nil,
+// the coefficients are stored in dst, which must have length equal to the
+// number of covariates.
+func (c *CoxPH) Coef(dst []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(c.coef))
+	}
+	if len(dst) != len(c.coef) {
+		panic(mat.ErrShape)
+	}
+	copy(dst, c.coef)
+	return dst
+}
+
+// HazardRatio returns the hazard ratios of the model, exp(βᵢ), the factor
+// by which the hazard is multiplied by a unit increase in each covariate.
+// If dst is not nil, the hazard ratios are stored in dst, which must have
+// length equal to the number of covariates.
+func (c *CoxPH) HazardRatio(dst []float64) []float64 {
+	dst = c.Coef(dst)
+	for i, b := range dst {
+		dst[i] = math.Exp(b)
+	}
+	return dst
+}
+
+// Covariance stores the model-based covariance of the coefficients, the
+// inverse of the observed information, into dst. If dst is empty,
+// Covariance will resize dst to be p×p. When dst is non-empty, Covariance
+// will panic if dst is not p×p.
+func (c *CoxPH) Covariance(dst *mat.SymDense) {
+	copySym(dst, c.cov)
+}
+
+// RobustCovariance stores the robust sandwich estimate of the covariance of
+// the coefficients into dst. If dst is empty, RobustCovariance will resize
+// dst to be p×p. When dst is non-empty, RobustCovariance will panic if dst
+// is not p×p.
+func (c *CoxPH) RobustCovariance(dst *mat.SymDense) {
+	copySym(dst, c.robust)
+}
+
+// StdErr returns the model-based standard errors of the coefficients. If dst
+// is not nil, the standard errors are stored in dst, which must have length
+// equal to the number of covariates.
+func (c *CoxPH) StdErr(dst []float64) []float64 {
+	return diagSqrt(dst, c.cov)
+}
+
+// RobustStdErr returns the robust standard errors of the coefficients. If
+// dst is not nil, the standard errors are stored in dst, which must have
+// length equal to the number of covariates.
+func (c *CoxPH) RobustStdErr(dst []float64) []float64 {
+	return diagSqrt(dst, c.robust)
+}
+
+// LogLikelihood returns the log partial likelihood of the fitted model.
+func (c *CoxPH) LogLikelihood() float64 {
+	return c.logLik
+}
+
+// NullLogLikelihood returns the log partial likelihood of the model with
+// all coefficients zero. Twice the difference between the log likelihoods
+// of the fitted and null models is the likelihood ratio statistic for the
+// hypothesis that all coefficients are zero.
+func (c *CoxPH) NullLogLikelihood() float64 {
+	return c.nullLogLik
+}
+
+// Iterations returns the number of Newton-Raphson iterations used to fit
+// the model.
+func (c *CoxPH) Iterations() int {
+	return c.iterations
+}
+
+// coxModel holds the data for evaluating the partial likelihood of a Cox
+// proportional hazards model.
+type coxModel struct {
+	x     *mat.Dense
+	time  []float64
+	event []bool
+	ties  Ties
+
+	// idx holds the indices of the subjects in
+	// increasing order of time.
+	idx []int
+}
+
+// groups calls fn with the half-open interval of m.idx holding each
+// distinct time in decreasing order of time.
+func (m *coxModel) groups(fn func(lo, hi int)) {
+	for hi := len(m.idx); hi > 0; {
+		t := m.time[m.idx[hi-1]]
+		lo := hi - 1
+		for lo > 0 && m.time[m.idx[lo-1]] == t {
+			lo--
+		}
+		fn(lo, hi)
+		hi = lo
+	}
+}
+
+// fraction returns the fraction of the weight of the subjects with an event
+// at a time with d tied events that is removed from the risk set at the k-th
+// of the d steps of the approximation to the partial likelihood.
+func (m *coxModel) fraction(k, d int) float64 {
+	if m.ties == Breslow {
+		return 0
+	}
+	return float64(k) / float64(d)
+}
+
+// partial returns the log partial likelihood at beta. If score and info
+// are not nil, the score vector and observed information matrix are stored
+// in them.
+func (m *coxModel) partial(beta, score []float64, info *mat.SymDense) float64 {
+	_, p := m.x.Dims()
+	derivs := score != nil
+	var (
+		s0, d0     float64
+		s1, d1     []float64
+		s2, d2, v2 []float64
+		v1         = make([]float64, p)
+	)
+	if derivs {
+		s1 = make([]float64, p)
+		d1 = make([]float64, p)
+		s2 = make([]float64, p*p)
+		d2 = make([]float64, p*p)
+		v2 = make([]float64, p*p)
+		for i := range score {
+			score[i] = 0
+		}
+		info.Zero()
+	}
+	var ll float64
+	m.groups(func(lo, hi int) {
+		d0 = 0
+		if derivs {
+			zero(d1)
+			zero(d2)
+		}
+		var d int
+		for _, i := range m.idx[lo:hi] {
+			xi := m.x.RawRowView(i)
+			eta := floats.Dot(xi, beta)
+			r := math.Exp(eta)
+			s0 += r
+			if derivs {
+				addOuter(s1, s2, r, xi)
+			}
+			if !m.event[i] {
+				continue
+			}
+			d++
+			ll += eta
+			d0 += r
+			if derivs {
+				floats.Add(score, xi)
+				addOuter(d1, d2, r, xi)
+			}
+		}
+		for k := 0; k < d; k++ {
+			f := m.fraction(k, d)
+			v0 := s0 - f*d0
+			ll -= math.Log(v0)
+			if !derivs {
+				continue
+			}
+			floats.AddScaledTo(v1, s1, -f, d1)
+			floats.AddScaledTo(v2, s2, -f, d2)
+			floats.AddScaled(score, -1/v0, v1)
+			for a := 0; a < p; a++ {
+				for b := a; b < p; b++ {
+					info.SetSym(a, b, info.At(a, b)+v2[a*p+b]/v0-v1[a]*v1[b]/(v0*v0))
+				}
+			}
+		}
+	})
+	return ll
+}
+
+// scoreResiduals returns the score residuals of the subjects at beta,
+//
+//	Lᵢ = ∫ (xᵢ - x̄(t)) dMᵢ(t),
+//
+// where Mᵢ is the martingale of the counting process of subject i and x̄(t)
+// is the risk-weighted mean of the covariates at t, with tied events split
+// according to the approximation for ties.
+func (m *coxModel) scoreResiduals(beta []float64) *mat.Dense {
+	n, p := m.x.Dims()
+	resid := mat.NewDense(n, p, nil)
+	risk := make([]float64, n)
+	for i := range risk {
+		risk[i] = math.Exp(floats.Dot(m.x.RawRowView(i), beta))
+	}
+
+	// The sums over the risk set are accumulated from the latest
+	// time, and the increments of the compensator at each time,
+	// Σₖ 1/s0ₖ and Σₖ x̄ₖ/s0ₖ, for subjects at risk throughout the
+	// time and for subjects with an event at the time are stored.
+	type increment struct {
+		lo, hi  int
+		a, aw   float64
+		b, bw   []float64
+		meanBar []float64
+	}
+	var incs []increment
+	var s0 float64
+	s1 := make([]float64, p)
+	d1 := make([]float64, p)
+	xbar := make([]float64, p)
+	m.groups(func(lo, hi int) {
+		var d0 float64
+		zero(d1)
+		var d int
+		for _, i := range m.idx[lo:hi] {
+			xi := m.x.RawRowView(i)
+			s0 += risk[i]
+			floats.AddScaled(s1, risk[i], xi)
+			if m.event[i] {
+				d++
+				d0 += risk[i]
+				floats.AddScaled(d1, risk[i], xi)
+			}
+		}
+		if d == 0 {
+			return
+		}
+		inc := increment{
+			lo: lo, hi: hi,
+			b:       make([]float64, p),
+			bw:      make([]float64, p),
+			meanBar: make([]float64, p),
+		}
+		for k := 0; k < d; k++ {
+			f := m.fraction(k, d)
+			v0 := s0 - f*d0
+			floats.AddScaledTo(xbar, s1, -f, d1)
+			floats.Scale(1/v0, xbar)
+			inc.a += 1 / v0
+			inc.aw += (1 - f) / v0
+			floats.AddScaled(inc.b, 1/v0, xbar)
+			floats.AddScaled(inc.bw, (1-f)/v0, xbar)
+			floats.AddScaled(inc.meanBar, 1/float64(d), xbar)
+		}
+		incs = append(incs, inc)
+	})
+
+	// The compensators are accumulated forward in time from the
+	// increments, which are held in decreasing order of time.
+	var cumA float64
+	cumB := make([]float64, p)
+	pos := 0
+	for j := len(incs) - 1; j >= -1; j-- {
+		// Subjects with times before the j-th event time
+		// are at risk at all earlier event times.
+		end := len(m.idx)
+		if j >= 0 {
+			end = incs[j].lo
+		}
+		for ; pos < end; pos++ {
+			i := m.idx[pos]
+			row := resid.RawRowView(i)
+			floats.AddScaledTo(row, cumB, -cumA, m.x.RawRowView(i))
+			floats.Scale(risk[i], row)
+		}
+		if j < 0 {
+			break
+		}
+		inc := incs[j]
+		for ; pos < inc.hi; pos++ {
+			i := m.idx[pos]
+			xi := m.x.RawRowView(i)
+			row := resid.RawRowView(i)
+			if m.event[i] {
+				for a := range row {
+					row[a] = xi[a] - inc.meanBar[a] - risk[i]*(xi[a]*(cumA+inc.aw)-(cumB[a]+inc.bw[a]))
+				}
+			} else {
+				for a := range row {
+					row[a] = -risk[i] * (xi[a]*(cumA+inc.a) - (cumB[a] + inc.b[a]))
+				}
+			}
+		}
+		cumA += inc.a
+		floats.Add(cumB, inc.b)
+	}
+	return resid
+}
+
+// sumClusters returns the sums of the rows of resid within each cluster.
+func sumClusters(resid *mat.Dense, cluster []int) *mat.Dense {
+	_, p := resid.Dims()
+	index := make(map[int]int)
+	for _, c := range cluster {
+		if _, ok := index[c]; !ok {
+			index[c] = len(index)
+		}
+	}
+	sum := mat.NewDense(len(index), p, nil)
+	for i, c := range cluster {
+		floats.Add(sum.RawRowView(index[c]), resid.RawRowView(i))
+	}
+	return sum
+}
+
+// addOuter adds r·x to v and r·x·xᵀ to the row-major p×p matrix m.
+func addOuter(v, m []float64, r float64, x []float64) {
+	p := len(x)
+	for a, xa := range x {
+		v[a] += r * xa
+		floats.AddScaled(m[a*p:(a+1)*p], r*xa, x)
+	}
+}
+
+func zero(f []float64) {
+	for i := range f {
+		f[i] = 0
+	}
+}
+
+// copySym copies the p×p matrix src into dst, resizing dst if it is empty.
+func copySym(dst, src *mat.SymDense) {
+	p := src.SymmetricDim()
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(p)
+	} else if dst.SymmetricDim() != p {
+		panic(mat.ErrShape)
+	}
+	dst.CopySym(src)
+}
+
+// diagSqrt returns the square roots of the diagonal of m.
+func diagSqrt(dst []float64, m *mat.SymDense) []float64 {
+	p := m.SymmetricDim()
+	if dst == nil {
+		dst = make([]float64, p)
+	}
+	if len(dst) != p {
+		panic(mat.ErrShape)
+	}
+	for i := range dst {
+		dst[i] = math.Sqrt(m.At(i, i))
+	}
+	return dst
+}
@@ -0,0 +1,351 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestCoxPHGehan(t *testing.T) {
+	t.Parallel()
+	time, event, arm := gehan()
+	x := mat.NewDense(len(arm), 1, nil)
+	for i, a := range arm {
+		x.Set(i, 0, float64(a))
+	}
+	// Values reported by R's coxph.
+	for _, test := range []struct {
+		ties   Ties
+		coef   float64
+		se     float64
+		lrStat float64
+	}{
+		{ties: Efron, coef: 1.5721, se: 0.4124, lrStat: 16.35},
+		{ties: Breslow, coef: 1.5092, se: 0.4096, lrStat: 15.21},
+	} {
+		c, err := NewCoxPH(x, time, event, &CoxSettings{Ties: test.ties})
+		if err != nil {
+			t.Errorf("unexpected error for ties=%d: %v", test.ties, err)
+			continue
+		}
+		if got := c.Coef(nil)[0]; !scalar.EqualWithinAbs(got, test.coef, 1e-4) {
+			t.Errorf("unexpected coefficient for ties=%d: got:%v want:%v", test.ties, got, test.coef)
+		}
+		if got := c.HazardRatio(nil)[0]; !scalar.EqualWithinAbsOrRel(got, math.Exp(c.Coef(nil)[0]), 1e-15, 1e-15) {
+			t.Errorf("unexpected hazard ratio for ties=%d: got:%v", test.ties, got)
+		}
+		if got := c.StdErr(nil)[0]; !scalar.EqualWithinAbs(got, test.se, 1e-4) {
+			t.Errorf("unexpected standard error for ties=%d: got:%v want:%v", test.ties, got, test.se)
+		}
+		if got := 2 * (c.LogLikelihood() - c.NullLogLikelihood()); !scalar.EqualWithinAbs(got, test.lrStat, 1e-2) {
+			t.Errorf("unexpected likelihood ratio statistic for ties=%d: got:%v want:%v", test.ties, got, test.lrStat)
+		}
+	}
+}
+
+// coxData returns n subjects with p covariates and integer times with
+// many ties.
+func coxData(rnd *rand.Rand, n, p int) (x *mat.Dense, time []float64, event []bool) {
+	x = mat.NewDense(n, p, nil)
+	time = make([]float64, n)
+	event = make([]bool, n)
+	beta := []float64{0.8, -0.5, 0.3, 1}
+	for i := 0; i < n; i++ {
+		row := x.RawRowView(i)
+		for j := range row {
+			row[j] = rnd.NormFloat64()
+		}
+		rate := math.Exp(floats.Dot(row, beta[:p]))
+		time[i] = math.Ceil(4 * rnd.ExpFloat64() / rate)
+		event[i] = rnd.Float64() < 0.7
+	}
+	return x, time, event
+}
+
+func TestCoxPartialDerivatives(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, ties := range []Ties{Efron, Breslow} {
+		for _, p := range []int{1, 3} {
+			x, time, event := coxData(rnd, 50, p)
+			m := &coxModel{x: x, time: time, event: event, ties: ties, idx: sortedIndex(time)}
+			beta := make([]float64, p)
+			for i := range beta {
+				beta[i] = 0.3 * rnd.NormFloat64()
+			}
+			score := make([]float64, p)
+			info := mat.NewSymDense(p, nil)
+			ll := m.partial(beta, score, info)
+			if got := m.partial(beta, nil, nil); got != ll {
+				t.Errorf("log likelihood depends on derivatives for ties=%d p=%d: %v != %v", ties, p, got, ll)
+			}
+
+			f := func(b []float64) float64 { return m.partial(b, nil, nil) }
+			grad := fd.Gradient(nil, f, beta, nil)
+			if !floats.EqualApprox(score, grad, 1e-6) {
+				t.Errorf("unexpected score for ties=%d p=%d: got:%v want:%v", ties, p, score, grad)
+			}
+			// The information is the negative Jacobian of the score.
+			jac := mat.NewDense(p, p, nil)
+			fd.Jacobian(jac, func(dst, b []float64) {
+				m.partial(b, dst, mat.NewSymDense(p, nil))
+			}, beta, &fd.JacobianSettings{Formula: fd.Central})
+			jac.Scale(-1, jac)
+			if !mat.EqualApprox(info, jac, 1e-6) {
+				t.Errorf("unexpected information for ties=%d p=%d:\ngot: %v\nwant:%v", ties, p, mat.Formatted(info), mat.Formatted(jac))
+			}
+		}
+	}
+}
+
+// naiveScoreResiduals returns the score residuals of the subjects at beta
+// computed directly from the risk sets at each event time.
+func naiveScoreResiduals(m *coxModel, beta []float64) *mat.Dense {
+	n, p := m.x.Dims()
+	resid := mat.NewDense(n, p, nil)
+	risk := make([]float64, n)
+	for i := range risk {
+		risk[i] = math.Exp(floats.Dot(m.x.RawRowView(i), beta))
+	}
+	seen := make(map[float64]bool)
+	for _, t := range m.time {
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		var dead []int
+		for i, ti := range m.time {
+			if ti == t && m.event[i] {
+				dead = append(dead, i)
+			}
+		}
+		d := len(dead)
+		for k := 0; k < d; k++ {
+			f := m.fraction(k, d)
+			// The weight of each subject in the k-th step.
+			w := make([]float64, n)
+			var s0 float64
+			xbar := make([]float64, p)
+			for i, ti := range m.time {
+				if ti < t {
+					continue
+				}
+				w[i] = 1
+				if ti == t && m.event[i] {
+					w[i] = 1 - f
+				}
+				s0 += w[i] * risk[i]
+				floats.AddScaled(xbar, w[i]*risk[i], m.x.RawRowView(i))
+			}
+			floats.Scale(1/s0, xbar)
+			for i := range m.time {
+				row := resid.RawRowView(i)
+				xi := m.x.RawRowView(i)
+				for a := range row {
+					dev := xi[a] - xbar[a]
+					if m.time[i] == t && m.event[i] {
+						row[a] += dev / float64(d)
+					}
+					row[a] -= w[i] * risk[i] * dev / s0
+				}
+			}
+		}
+	}
+	return resid
+}
+
+func TestCoxScoreResiduals(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, ties := range []Ties{Efron, Breslow} {
+		x, time, event := coxData(rnd, 40, 2)
+		m := &coxModel{x: x, time: time, event: event, ties: ties, idx: sortedIndex(time)}
+		beta := []float64{0.4, -0.2}
+		got := m.scoreResiduals(beta)
+		want := naiveScoreResiduals(m, beta)
+		if !mat.EqualApprox(got, want, 1e-12) {
+			t.Errorf("unexpected score residuals for ties=%d", ties)
+		}
+
+		// The score residuals sum to the score.
+		score := make([]float64, 2)
+		m.partial(beta, score, mat.NewSymDense(2, nil))
+		for j := range score {
+			if sum := mat.Sum(got.ColView(j)); !scalar.EqualWithinAbsOrRel(sum, score[j], 1e-12, 1e-12) {
+				t.Errorf("score residuals do not sum to score for ties=%d: %v != %v", ties, sum, score[j])
+			}
+		}
+	}
+}
+
+func TestCoxPH(t *testing.T) {
+	t.Parallel()
+	for _, ties := range []Ties{Efron, Breslow} {
+		t.Run(fmt.Sprint(ties), func(t *testing.T) {
+			t.Parallel()
+			x, time, event := coxData(rand.New(rand.NewPCG(uint64(ties), 1)), 400, 3)
+			c, err := NewCoxPH(x, time, event, &CoxSettings{Ties: ties})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			coef := c.Coef(nil)
+			se := c.StdErr(nil)
+			for i, want := range []float64{0.8, -0.5, 0.3} {
+				if math.Abs(coef[i]-want) > 4*se[i] {
+					t.Errorf("coefficient %d far from true value: got:%v±%v want:%v", i, coef[i], se[i], want)
+				}
+			}
+			if c.LogLikelihood() <= c.NullLogLikelihood() {
+				t.Errorf("fitted log likelihood not greater than null: %v <= %v", c.LogLikelihood(), c.NullLogLikelihood())
+			}
+
+			// The covariates are not correlated between
+			// subjects so the robust and model-based standard
+			// errors are similar.
+			robust := c.RobustStdErr(nil)
+			for i := range se {
+				if r := robust[i] / se[i]; r < 0.8 || 1.25 < r {
+					t.Errorf("robust standard error %d far from model-based: %v vs %v", i, robust[i], se[i])
+				}
+			}
+
+			// Each subject in its own cluster gives the
+			// unclustered robust covariance.
+			cluster := make([]int, len(time))
+			for i := range cluster {
+				cluster[i] = -i
+			}
+			cc, err := NewCoxPH(x, time, event, &CoxSettings{Ties: ties, Cluster: cluster})
+			if err != nil {
+				t.Fatalf("unexpected error with clusters: %v", err)
+			}
+			var want, got mat.SymDense
+			c.RobustCovariance(&want)
+			cc.RobustCovariance(&got)
+			if !mat.EqualApprox(&got, &want, 1e-12) {
+				t.Errorf("unexpected robust covariance with singleton clusters")
+			}
+
+			// The model-based covariance is the inverse of
+			// the information.
+			var cov mat.SymDense
+			c.Covariance(&cov)
+			var prod mat.Dense
+			prod.Mul(&cov, coxInfo(t, x, time, event, ties, c.Coef(nil)))
+			if !mat.EqualApprox(&prod, eye(len(se)), 1e-10) {
+				t.Errorf("covariance is not the inverse of the information")
+			}
+			for i := range se {
+				if !scalar.EqualWithinAbsOrRel(math.Sqrt(cov.At(i, i)), se[i], 1e-15, 1e-15) {
+					t.Errorf("standard error %d does not match covariance", i)
+				}
+			}
+		})
+	}
+}
+
+func TestCoxPHCluster(t *testing.T) {
+	t.Parallel()
+	// Duplicated subjects in the same cluster give the same
+	// coefficients as the original subjects, a model-based
+	// standard error reduced by √2, and a robust standard
+	// error close to that of the original subjects.
+	x, time, event := coxData(rand.New(rand.NewPCG(1, 1)), 200, 2)
+	n, _ := x.Dims()
+	var x2 mat.Dense
+	x2.Stack(x, x)
+	time2 := append(append([]float64(nil), time...), time...)
+	event2 := append(append([]bool(nil), event...), event...)
+	cluster := make([]int, 2*n)
+	for i := range cluster {
+		cluster[i] = i % n
+	}
+
+	c, err := NewCoxPH(x, time, event, &CoxSettings{Ties: Breslow})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c2, err := NewCoxPH(&x2, time2, event2, &CoxSettings{Ties: Breslow, Cluster: cluster})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(c.Coef(nil), c2.Coef(nil), 1e-8) {
+		t.Errorf("unexpected coefficients: got:%v want:%v", c2.Coef(nil), c.Coef(nil))
+	}
+	se, se2 := c.StdErr(nil), c2.StdErr(nil)
+	floats.Scale(math.Sqrt2, se2)
+	if !floats.EqualApprox(se, se2, 1e-8) {
+		t.Errorf("unexpected model-based standard errors: got:%v want:%v", se2, se)
+	}
+	if !floats.EqualApprox(c.RobustStdErr(nil), c2.RobustStdErr(nil), 1e-8) {
+		t.Errorf("unexpected robust standard errors: got:%v want:%v", c2.RobustStdErr(nil), c.RobustStdErr(nil))
+	}
+}
+
+func TestCoxPHErrors(t *testing.T) {
+	t.Parallel()
+	time := []float64{1, 2, 3, 4}
+	x := mat.NewDense(4, 2, []float64{
+		1, 2,
+		2, 4,
+		3, 6,
+		4, 8,
+	})
+	_, err := NewCoxPH(x, time, []bool{true, true, false, true}, nil)
+	if err == nil {
+		t.Error("expected error for collinear covariates")
+	}
+	_, err = NewCoxPH(x.Slice(0, 4, 0, 1), time, []bool{false, false, false, false}, nil)
+	if err == nil {
+		t.Error("expected error for no events")
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "short time", fn: func() { NewCoxPH(x, time[:3], []bool{true, true, true}, nil) }},
+		{name: "short event", fn: func() { NewCoxPH(x, time, []bool{true, true, true}, nil) }},
+		{name: "short cluster", fn: func() { NewCoxPH(x, time, []bool{true, true, true, true}, &CoxSettings{Cluster: []int{1}}) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+// coxInfo returns the observed information at beta of the Cox model for the
+// given data, with the covariates centered as in NewCoxPH.
+func coxInfo(t *testing.T, x *mat.Dense, time []float64, event []bool, ties Ties, beta []float64) *mat.SymDense {
+	t.Helper()
+	n, p := x.Dims()
+	xc := mat.DenseCopyOf(x)
+	for j := 0; j < p; j++ {
+		mean := mat.Sum(xc.ColView(j)) / float64(n)
+		for i := 0; i < n; i++ {
+			xc.Set(i, j, xc.At(i, j)-mean)
+		}
+	}
+	m := &coxModel{x: xc, time: time, event: event, ties: ties, idx: sortedIndex(time)}
+	info := mat.NewSymDense(p, nil)
+	m.partial(beta, make([]float64, p), info)
+	return info
+}
+
+func eye(n int) *mat.Dense {
+	m := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
@@ -0,0 +1,18 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package survival provides routines for the analysis of time-to-event
+// data.
+//
+// Observations are given as the time of an event or of censoring for each
+// subject, and whether the event was observed. A subject whose event was
+// not observed is right-censored at its time, and is known only to have
+// survived to that time. At tied times, events are taken to precede
+// censoring, so a subject censored at time t is at risk at t.
+//
+// The package provides the Kaplan–Meier and Nelson–Aalen estimators of the
+// survival and cumulative hazard functions, the log-rank test comparing
+// the survival of groups of subjects, and Cox proportional hazards
+// regression.
+package survival // import "gonum.org/v1/gonum/stat/survival"
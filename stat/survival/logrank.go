@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// LogRankTest is the result of a log-rank test of the hypothesis that
+// groups of subjects have the same survival function.
+type LogRankTest struct {
+	// Groups holds the distinct group labels in increasing order.
+	Groups []int
+
+	// Observed and Expected hold the observed number of events
+	// in each group and the number expected under the
+	// hypothesis of equal survival.
+	Observed, Expected []float64
+
+	// Statistic is the test statistic, which is asymptotically
+	// χ² distributed with DoF degrees of freedom under the
+	// hypothesis, and PValue is the probability of a statistic
+	// at least as large under the hypothesis.
+	Statistic float64
+	DoF       int
+	PValue    float64
+}
+
+// LogRank performs the log-rank test of the hypothesis that subjects in
+// the groups identified by the labels in group have the same survival
+// function. The times of an event or of censoring for each subject are
+// given in time, and event indicates whether the event was observed.
+//
+// At each time at which events are observed, the events are compared with
+// the number expected if the hazard were the same in all groups. With G
+// groups, the statistic is the quadratic form of the differences between
+// the observed and expected numbers of events in the first G-1 groups in
+// the inverse of their covariance,
+//
+//	χ² = (O - E)ᵀ V⁻¹ (O - E).
+//
+// The statistic is NaN if the covariance is singular, which happens when
+// no subject of a group is at risk at the time of any event.
+//
+// LogRank panics if the lengths of time, event and group differ, or if
+// there are fewer than two groups.
+func LogRank(time []float64, event []bool, group []int) LogRankTest {
+	checkObservations(time, event, nil)
+	if len(group) != len(time) {
+		panic("survival: mismatched length of group")
+	}
+	labels := slices.Clone(group)
+	slices.Sort(labels)
+	labels = slices.Compact(labels)
+	g := len(labels)
+	if g < 2 {
+		panic("survival: fewer than two groups")
+	}
+	index := make(map[int]int, g)
+	for i, l := range labels {
+		index[l] = i
+	}
+
+	atRisk := make([]float64, g)
+	for _, l := range group {
+		atRisk[index[l]]++
+	}
+	n := float64(len(time))
+
+	test := LogRankTest{
+		Groups:   labels,
+		Observed: make([]float64, g),
+		Expected: make([]float64, g),
+		DoF:      g - 1,
+	}
+	v := mat.NewSymDense(g-1, nil)
+	events := make([]float64, g)
+	left := make([]float64, g)
+	idx := sortedIndex(time)
+	for i := 0; i < len(idx); {
+		ti := time[idx[i]]
+		for j := range events {
+			events[j] = 0
+			left[j] = 0
+		}
+		var d float64
+		for ; i < len(idx) && time[idx[i]] == ti; i++ {
+			j := index[group[idx[i]]]
+			if event[idx[i]] {
+				events[j]++
+				d++
+			}
+			left[j]++
+		}
+		if d > 0 {
+			for j, nj := range atRisk {
+				test.Observed[j] += events[j]
+				test.Expected[j] += d * nj / n
+			}
+			if n > 1 {
+				f := d * (n - d) / (n - 1)
+				for j := 0; j < g-1; j++ {
+					pj := atRisk[j] / n
+					for k := j; k < g-1; k++ {
+						c := -pj * atRisk[k] / n
+						if j == k {
+							c += pj
+						}
+						v.SetSym(j, k, v.At(j, k)+f*c)
+					}
+				}
+			}
+		}
+		for j, l := range left {
+			atRisk[j] -= l
+			n -= l
+		}
+	}
+
+	diff := mat.NewVecDense(g-1, nil)
+	for j := 0; j < g-1; j++ {
+		diff.SetVec(j, test.Observed[j]-test.Expected[j])
+	}
+	var chol mat.Cholesky
+	if !chol.Factorize(v) {
+		test.Statistic = math.NaN()
+		test.PValue = math.NaN()
+		return test
+	}
+	var x mat.VecDense
+	err := chol.SolveVecTo(&x, diff)
+	if err != nil {
+		test.Statistic = math.NaN()
+		test.PValue = math.NaN()
+		return test
+	}
+	test.Statistic = mat.Dot(diff, &x)
+	test.PValue = distuv.ChiSquared{K: float64(g - 1)}.Survival(test.Statistic)
+	return test
+}
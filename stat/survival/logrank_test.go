@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestLogRank(t *testing.T) {
+	t.Parallel()
+	time, event, arm := gehan()
+	test := LogRank(time, event, arm)
+
+	// Values reported by R's survdiff.
+	if !slices.Equal(test.Groups, []int{0, 1}) {
+		t.Errorf("unexpected groups: got:%v", test.Groups)
+	}
+	if !floats.Equal(test.Observed, []float64{9, 21}) {
+		t.Errorf("unexpected observed events: got:%v want:[9 21]", test.Observed)
+	}
+	wantExpected := []float64{19.2505, 10.7495}
+	if !floats.EqualApprox(test.Expected, wantExpected, 1e-4) {
+		t.Errorf("unexpected expected events: got:%v want:%v", test.Expected, wantExpected)
+	}
+	if !scalar.EqualWithinAbs(test.Statistic, 16.7929, 1e-4) {
+		t.Errorf("unexpected statistic: got:%v want:16.7929", test.Statistic)
+	}
+	if test.DoF != 1 {
+		t.Errorf("unexpected degrees of freedom: got:%d want:1", test.DoF)
+	}
+	if !scalar.EqualWithinAbs(test.PValue, 4.169e-5, 1e-8) {
+		t.Errorf("unexpected p-value: got:%v want:4.169e-5", test.PValue)
+	}
+}
+
+func TestLogRankGroups(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 90
+	time := make([]float64, n)
+	event := make([]bool, n)
+	group := make([]int, n)
+	for i := range time {
+		group[i] = 5 * (i % 3)
+		time[i] = math.Ceil(rnd.ExpFloat64() * float64(10+group[i]))
+		event[i] = rnd.Float64() < 0.8
+	}
+	test := LogRank(time, event, group)
+	if !slices.Equal(test.Groups, []int{0, 5, 10}) {
+		t.Errorf("unexpected groups: got:%v", test.Groups)
+	}
+	if test.DoF != 2 {
+		t.Errorf("unexpected degrees of freedom: got:%d want:2", test.DoF)
+	}
+	if got, want := floats.Sum(test.Observed), floats.Sum(test.Expected); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+		t.Errorf("total observed and expected events differ: %v != %v", got, want)
+	}
+
+	// The statistic does not depend on which group is
+	// omitted from the quadratic form.
+	relabeled := make([]int, n)
+	for i, g := range group {
+		relabeled[i] = 10 - g
+	}
+	got := LogRank(time, event, relabeled)
+	if !scalar.EqualWithinAbsOrRel(got.Statistic, test.Statistic, 1e-10, 1e-10) {
+		t.Errorf("statistic depends on omitted group: %v != %v", got.Statistic, test.Statistic)
+	}
+	if !(0 < test.PValue && test.PValue < 1) {
+		t.Errorf("unexpected p-value: %v", test.PValue)
+	}
+}
+
+func TestLogRankPanics(t *testing.T) {
+	t.Parallel()
+	time := []float64{1, 2, 3}
+	event := []bool{true, false, true}
+	for _, test := range []struct {
+		name  string
+		group []int
+	}{
+		{name: "short group", group: []int{0, 1}},
+		{name: "one group", group: []int{2, 2, 2}},
+	} {
+		if !panics(func() { LogRank(time, event, test.group) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
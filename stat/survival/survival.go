@@ -0,0 +1,281 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// KaplanMeier is the Kaplan–Meier product-limit estimate of a survival
+// function,
+//
+//	Ŝ(t) = ∏_{tᵢ ≤ t} (1 - dᵢ/nᵢ),
+//
+// where dᵢ is the number of events at the time tᵢ and nᵢ is the number of
+// subjects at risk at tᵢ. The estimate is a step function that changes
+// only at the times of events.
+type KaplanMeier struct {
+	// Time holds the distinct times at which events were
+	// observed in increasing order.
+	Time []float64
+
+	// AtRisk and Events hold the number of subjects at risk
+	// and the number of events at each time. They are sums
+	// of the weights of the subjects when weights are given.
+	AtRisk, Events []float64
+
+	// Survival holds the estimate of the survival function
+	// from each time until the next.
+	Survival []float64
+
+	// StdErr holds Greenwood's estimate of the standard error
+	// of the survival estimate at each time,
+	//
+	//	se(Ŝ(t)) = Ŝ(t) sqrt(∑_{tᵢ ≤ t} dᵢ / (nᵢ (nᵢ - dᵢ))).
+	StdErr []float64
+}
+
+// NewKaplanMeier returns the Kaplan–Meier estimate of the survival function
+// of subjects with the given times of an event or of censoring, where event
+// indicates whether the event was observed for each subject. If weights is
+// not nil, it holds the weight of each subject.
+//
+// NewKaplanMeier panics if the lengths of time, event and a non-nil weights
+// differ, or if any weight is negative.
+func NewKaplanMeier(time []float64, event []bool, weights []float64) *KaplanMeier {
+	t, n, d := tabulate(time, event, weights)
+	km := &KaplanMeier{
+		Time:     t,
+		AtRisk:   n,
+		Events:   d,
+		Survival: make([]float64, len(t)),
+		StdErr:   make([]float64, len(t)),
+	}
+	s := 1.0
+	var v float64
+	for i := range t {
+		s *= 1 - d[i]/n[i]
+		v += d[i] / (n[i] * (n[i] - d[i]))
+		km.Survival[i] = s
+		km.StdErr[i] = s * math.Sqrt(v)
+	}
+	return km
+}
+
+// At returns the estimate of the survival function at time t.
+func (km *KaplanMeier) At(t float64) float64 {
+	i := stepIndex(km.Time, t)
+	if i < 0 {
+		return 1
+	}
+	return km.Survival[i]
+}
+
+// ConfInt returns pointwise confidence intervals at the given level for
+// the survival function at each time in km.Time. The intervals are found
+// from Greenwood's standard error on the log(-log(S)) scale, which keeps
+// their bounds within [0, 1]. The bounds are NaN where the estimate of the
+// survival function is zero.
+//
+// ConfInt panics if level is not between zero and one.
+func (km *KaplanMeier) ConfInt(level float64) (lower, upper []float64) {
+	z := normalQuantile(level)
+	lower = make([]float64, len(km.Time))
+	upper = make([]float64, len(km.Time))
+	for i, s := range km.Survival {
+		if s == 0 {
+			lower[i] = math.NaN()
+			upper[i] = math.NaN()
+			continue
+		}
+		// The standard error of log(-log(Ŝ)) by the delta method.
+		se := km.StdErr[i] / (s * math.Abs(math.Log(s)))
+		lower[i] = math.Pow(s, math.Exp(z*se))
+		upper[i] = math.Pow(s, math.Exp(-z*se))
+	}
+	return lower, upper
+}
+
+// Median returns the estimated median survival time, the earliest time at
+// which the estimate of the survival function is at most one half. Median
+// returns +Inf if the estimate does not fall to one half.
+func (km *KaplanMeier) Median() float64 {
+	for i, s := range km.Survival {
+		if s <= 0.5 {
+			return km.Time[i]
+		}
+	}
+	return math.Inf(1)
+}
+
+// NelsonAalen is the Nelson–Aalen estimate of a cumulative hazard function,
+//
+//	Ĥ(t) = ∑_{tᵢ ≤ t} dᵢ/nᵢ,
+//
+// where dᵢ is the number of events at the time tᵢ and nᵢ is the number of
+// subjects at risk at tᵢ. The estimate is a step function that changes
+// only at the times of events.
+type NelsonAalen struct {
+	// Time holds the distinct times at which events were
+	// observed in increasing order.
+	Time []float64
+
+	// AtRisk and Events hold the number of subjects at risk
+	// and the number of events at each time. They are sums
+	// of the weights of the subjects when weights are given.
+	AtRisk, Events []float64
+
+	// CumHazard holds the estimate of the cumulative hazard
+	// function from each time until the next.
+	CumHazard []float64
+
+	// StdErr holds the estimate of the standard error of the
+	// cumulative hazard estimate at each time,
+	//
+	//	se(Ĥ(t)) = sqrt(∑_{tᵢ ≤ t} dᵢ / nᵢ²).
+	StdErr []float64
+}
+
+// NewNelsonAalen returns the Nelson–Aalen estimate of the cumulative hazard
+// function of subjects with the given times of an event or of censoring,
+// where event indicates whether the event was observed for each subject.
+// If weights is not nil, it holds the weight of each subject.
+//
+// NewNelsonAalen panics if the lengths of time, event and a non-nil weights
+// differ, or if any weight is negative.
+func NewNelsonAalen(time []float64, event []bool, weights []float64) *NelsonAalen {
+	t, n, d := tabulate(time, event, weights)
+	na := &NelsonAalen{
+		Time:      t,
+		AtRisk:    n,
+		Events:    d,
+		CumHazard: make([]float64, len(t)),
+		StdErr:    make([]float64, len(t)),
+	}
+	var h, v float64
+	for i := range t {
+		h += d[i] / n[i]
+		v += d[i] / (n[i] * n[i])
+		na.CumHazard[i] = h
+		na.StdErr[i] = math.Sqrt(v)
+	}
+	return na
+}
+
+// At returns the estimate of the cumulative hazard function at time t.
+func (na *NelsonAalen) At(t float64) float64 {
+	i := stepIndex(na.Time, t)
+	if i < 0 {
+		return 0
+	}
+	return na.CumHazard[i]
+}
+
+// Survival returns the Fleming–Harrington estimate of the survival function
+// at time t, exp(-Ĥ(t)).
+func (na *NelsonAalen) Survival(t float64) float64 {
+	return math.Exp(-na.At(t))
+}
+
+// ConfInt returns pointwise confidence intervals at the given level for
+// the cumulative hazard function at each time in na.Time. The intervals
+// are found on the log scale, which keeps their bounds positive.
+//
+// ConfInt panics if level is not between zero and one.
+func (na *NelsonAalen) ConfInt(level float64) (lower, upper []float64) {
+	z := normalQuantile(level)
+	lower = make([]float64, len(na.Time))
+	upper = make([]float64, len(na.Time))
+	for i, h := range na.CumHazard {
+		f := math.Exp(z * na.StdErr[i] / h)
+		lower[i] = h / f
+		upper[i] = h * f
+	}
+	return lower, upper
+}
+
+// tabulate returns the distinct times at which events are observed in
+// increasing order with the number of subjects at risk and the number of
+// events at each time.
+func tabulate(time []float64, event []bool, weights []float64) (t, atRisk, events []float64) {
+	checkObservations(time, event, weights)
+	idx := sortedIndex(time)
+	var n float64
+	for i := range time {
+		n += weight(weights, i)
+	}
+	for i := 0; i < len(idx); {
+		ti := time[idx[i]]
+		var d, left float64
+		for ; i < len(idx) && time[idx[i]] == ti; i++ {
+			w := weight(weights, idx[i])
+			if event[idx[i]] {
+				d += w
+			}
+			left += w
+		}
+		if d > 0 {
+			t = append(t, ti)
+			atRisk = append(atRisk, n)
+			events = append(events, d)
+		}
+		n -= left
+	}
+	return t, atRisk, events
+}
+
+// checkObservations panics if the lengths of time, event and a non-nil
+// weights differ, or if any weight is negative.
+func checkObservations(time []float64, event []bool, weights []float64) {
+	if len(event) != len(time) {
+		panic("survival: mismatched length of event")
+	}
+	if weights != nil {
+		if len(weights) != len(time) {
+			panic("survival: mismatched length of weights")
+		}
+		for _, w := range weights {
+			if w < 0 {
+				panic("survival: negative weight")
+			}
+		}
+	}
+}
+
+// sortedIndex returns the indices of time in increasing order of time.
+func sortedIndex(time []float64) []int {
+	idx := make([]int, len(time))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return time[idx[i]] < time[idx[j]] })
+	return idx
+}
+
+// weight returns the weight of subject i, which is one if weights is nil.
+func weight(weights []float64, i int) float64 {
+	if weights == nil {
+		return 1
+	}
+	return weights[i]
+}
+
+// stepIndex returns the index of the last element of the sorted times that
+// is at most t, or -1 if there is no such element.
+func stepIndex(times []float64, t float64) int {
+	return sort.Search(len(times), func(i int) bool { return times[i] > t }) - 1
+}
+
+// normalQuantile returns the quantile of the standard normal distribution
+// for two-sided intervals at the given level.
+func normalQuantile(level float64) float64 {
+	if !(0 < level && level < 1) {
+		panic("survival: confidence level out of range")
+	}
+	return distuv.UnitNormal.Quantile(0.5 + level/2)
+}
@@ -0,0 +1,68 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/survival"
+)
+
+func Example() {
+	// Weeks of remission of leukaemia patients treated with
+	// 6-mercaptopurine or placebo, from Gehan, Biometrika, 1965.
+	// Patients in the treatment arm with a false event were
+	// still in remission at the end of the trial.
+	drug := []float64{6, 6, 6, 6, 7, 9, 10, 10, 11, 13, 16, 17, 19, 20, 22, 23, 25, 32, 32, 34, 35}
+	drugEvent := []bool{true, true, true, false, true, false, true, false, false, true, true, false, false, false, true, true, false, false, false, false, false}
+	placebo := []float64{1, 1, 2, 2, 3, 4, 4, 5, 5, 8, 8, 8, 8, 11, 11, 12, 12, 15, 17, 22, 23}
+
+	km := survival.NewKaplanMeier(drug, drugEvent, nil)
+	lower, upper := km.ConfInt(0.95)
+	fmt.Println("time  at risk  survival  95% interval")
+	for i, t := range km.Time {
+		fmt.Printf("%4v  %7v  %8.3f  [%.3f, %.3f]\n", t, km.AtRisk[i], km.Survival[i], lower[i], upper[i])
+	}
+	fmt.Printf("median remission: %v weeks\n\n", km.Median())
+
+	time := append(append([]float64(nil), drug...), placebo...)
+	event := append([]bool(nil), drugEvent...)
+	arm := make([]int, len(time))
+	x := mat.NewDense(len(time), 1, nil)
+	for i := len(drug); i < len(time); i++ {
+		event = append(event, true)
+		arm[i] = 1
+		x.Set(i, 0, 1)
+	}
+
+	lr := survival.LogRank(time, event, arm)
+	fmt.Printf("log-rank χ² = %.2f on %d degree of freedom, p = %.1e\n", lr.Statistic, lr.DoF, lr.PValue)
+
+	cox, err := survival.NewCoxPH(x, time, event, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	beta := cox.Coef(nil)[0]
+	se := cox.RobustStdErr(nil)[0]
+	fmt.Printf("hazard ratio for placebo = %.2f, robust 95%% interval [%.2f, %.2f]\n",
+		math.Exp(beta), math.Exp(beta-1.96*se), math.Exp(beta+1.96*se))
+
+	// Output:
+	// time  at risk  survival  95% interval
+	//    6       21     0.857  [0.620, 0.952]
+	//    7       17     0.807  [0.563, 0.923]
+	//   10       15     0.753  [0.503, 0.889]
+	//   13       12     0.690  [0.432, 0.849]
+	//   16       11     0.627  [0.368, 0.805]
+	//   22        7     0.538  [0.268, 0.747]
+	//   23        6     0.448  [0.188, 0.680]
+	// median remission: 23 weeks
+	//
+	// log-rank χ² = 16.79 on 1 degree of freedom, p = 4.2e-05
+	// hazard ratio for placebo = 4.82, robust 95% interval [2.30, 10.09]
+}
@@ -0,0 +1,204 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package survival
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// gehan returns the remission times of leukaemia patients in the
+// 6-mercaptopurine and placebo arms of the trial reported by Gehan,
+// Biometrika 52(1/2), 1965, with whether remission ended and the arm of
+// each patient, one for placebo.
+func gehan() (time []float64, event []bool, arm []int) {
+	time = []float64{
+		6, 6, 6, 6, 7, 9, 10, 10, 11, 13, 16, 17, 19, 20, 22, 23, 25, 32, 32, 34, 35,
+		1, 1, 2, 2, 3, 4, 4, 5, 5, 8, 8, 8, 8, 11, 11, 12, 12, 15, 17, 22, 23,
+	}
+	censored := map[int]bool{3: true, 5: true, 7: true, 8: true, 11: true, 12: true, 13: true, 16: true, 17: true, 18: true, 19: true, 20: true}
+	event = make([]bool, len(time))
+	arm = make([]int, len(time))
+	for i := range time {
+		event[i] = !censored[i]
+		if i >= 21 {
+			arm[i] = 1
+		}
+	}
+	return time, event, arm
+}
+
+func TestKaplanMeier(t *testing.T) {
+	t.Parallel()
+	time, event, _ := gehan()
+	km := NewKaplanMeier(time[:21], event[:21], nil)
+
+	wantTime := []float64{6, 7, 10, 13, 16, 22, 23}
+	wantRisk := []float64{21, 17, 15, 12, 11, 7, 6}
+	wantEvents := []float64{3, 1, 1, 1, 1, 1, 1}
+	if !floats.Equal(km.Time, wantTime) {
+		t.Errorf("unexpected times: got:%v want:%v", km.Time, wantTime)
+	}
+	if !floats.Equal(km.AtRisk, wantRisk) {
+		t.Errorf("unexpected number at risk: got:%v want:%v", km.AtRisk, wantRisk)
+	}
+	if !floats.Equal(km.Events, wantEvents) {
+		t.Errorf("unexpected number of events: got:%v want:%v", km.Events, wantEvents)
+	}
+	wantSurv := []float64{18.0 / 21, 18.0 / 21 * 16 / 17, 18.0 / 21 * 16 / 17 * 14 / 15}
+	wantSurv = append(wantSurv, wantSurv[2]*11/12)
+	wantSurv = append(wantSurv, wantSurv[3]*10/11)
+	wantSurv = append(wantSurv, wantSurv[4]*6/7)
+	wantSurv = append(wantSurv, wantSurv[5]*5/6)
+	if !floats.EqualApprox(km.Survival, wantSurv, 1e-14) {
+		t.Errorf("unexpected survival: got:%v want:%v", km.Survival, wantSurv)
+	}
+	// Greenwood standard errors and log-log confidence intervals
+	// reported by R's survfit(..., conf.type = "log-log").
+	wantSE := []float64{0.07636, 0.08694, 0.09635, 0.10681, 0.11405, 0.12823, 0.13459}
+	if !floats.EqualApprox(km.StdErr, wantSE, 1e-5) {
+		t.Errorf("unexpected standard errors: got:%v want:%v", km.StdErr, wantSE)
+	}
+	lower, upper := km.ConfInt(0.95)
+	wantLower := []float64{0.6197, 0.5631, 0.5032, 0.4316, 0.3675, 0.2678, 0.1881}
+	wantUpper := []float64{0.9516, 0.9228, 0.8894, 0.8491, 0.8049, 0.7468, 0.6801}
+	if !floats.EqualApprox(lower, wantLower, 1e-4) {
+		t.Errorf("unexpected lower confidence bounds: got:%v want:%v", lower, wantLower)
+	}
+	if !floats.EqualApprox(upper, wantUpper, 1e-4) {
+		t.Errorf("unexpected upper confidence bounds: got:%v want:%v", upper, wantUpper)
+	}
+
+	for _, test := range []struct {
+		t, want float64
+	}{
+		{t: 0, want: 1},
+		{t: 5.9, want: 1},
+		{t: 6, want: wantSurv[0]},
+		{t: 12, want: wantSurv[2]},
+		{t: 23, want: wantSurv[6]},
+		{t: 100, want: wantSurv[6]},
+	} {
+		if got := km.At(test.t); !scalar.EqualWithinAbsOrRel(got, test.want, 1e-15, 1e-15) {
+			t.Errorf("unexpected survival at %v: got:%v want:%v", test.t, got, test.want)
+		}
+	}
+	if got := km.Median(); got != 23 {
+		t.Errorf("unexpected median: got:%v want:23", got)
+	}
+	if got := NewKaplanMeier(time[:3], []bool{true, false, false}, nil).Median(); !math.IsInf(got, 1) {
+		t.Errorf("unexpected median for heavily censored data: got:%v want:+Inf", got)
+	}
+}
+
+func TestKaplanMeierAllEvents(t *testing.T) {
+	t.Parallel()
+	km := NewKaplanMeier([]float64{3, 1, 2}, []bool{true, true, true}, nil)
+	if !floats.EqualApprox(km.Survival, []float64{2.0 / 3, 1.0 / 3, 0}, 1e-15) {
+		t.Errorf("unexpected survival: got:%v", km.Survival)
+	}
+	lower, upper := km.ConfInt(0.9)
+	if !math.IsNaN(lower[2]) || !math.IsNaN(upper[2]) {
+		t.Errorf("expected NaN bounds for zero survival: got:[%v, %v]", lower[2], upper[2])
+	}
+}
+
+func TestNelsonAalen(t *testing.T) {
+	t.Parallel()
+	time, event, _ := gehan()
+	na := NewNelsonAalen(time[:21], event[:21], nil)
+
+	var h, v float64
+	for i, n := range []float64{21, 17, 15, 12, 11, 7, 6} {
+		d := na.Events[i]
+		h += d / n
+		v += d / (n * n)
+		if !scalar.EqualWithinAbsOrRel(na.CumHazard[i], h, 1e-15, 1e-15) {
+			t.Errorf("unexpected cumulative hazard at %v: got:%v want:%v", na.Time[i], na.CumHazard[i], h)
+		}
+		if !scalar.EqualWithinAbsOrRel(na.StdErr[i], math.Sqrt(v), 1e-15, 1e-15) {
+			t.Errorf("unexpected standard error at %v: got:%v want:%v", na.Time[i], na.StdErr[i], math.Sqrt(v))
+		}
+	}
+	if got := na.At(5); got != 0 {
+		t.Errorf("unexpected cumulative hazard before the first event: got:%v", got)
+	}
+	if got, want := na.Survival(15), math.Exp(-na.CumHazard[3]); got != want {
+		t.Errorf("unexpected survival: got:%v want:%v", got, want)
+	}
+	lower, upper := na.ConfInt(0.95)
+	for i, h := range na.CumHazard {
+		if !(0 < lower[i] && lower[i] < h && h < upper[i]) {
+			t.Errorf("cumulative hazard %v not within confidence interval [%v, %v]", h, lower[i], upper[i])
+		}
+		// The interval is symmetric on the log scale.
+		if !scalar.EqualWithinAbsOrRel(lower[i]*upper[i], h*h, 1e-14, 1e-14) {
+			t.Errorf("interval not symmetric on log scale at %v", na.Time[i])
+		}
+	}
+}
+
+func TestWeights(t *testing.T) {
+	t.Parallel()
+	// Doubling the weight of a subject is the same as
+	// duplicating it.
+	time := []float64{1, 3, 3, 4, 5, 7, 8, 8}
+	event := []bool{true, true, false, false, true, true, false, true}
+	weights := []float64{1, 2, 1, 1, 2, 1, 2, 1}
+	var dupTime []float64
+	var dupEvent []bool
+	for i, w := range weights {
+		for j := 0; j < int(w); j++ {
+			dupTime = append(dupTime, time[i])
+			dupEvent = append(dupEvent, event[i])
+		}
+	}
+
+	km := NewKaplanMeier(time, event, weights)
+	want := NewKaplanMeier(dupTime, dupEvent, nil)
+	if !floats.Equal(km.AtRisk, want.AtRisk) || !floats.Equal(km.Events, want.Events) {
+		t.Errorf("unexpected weighted counts: got:%v %v want:%v %v", km.AtRisk, km.Events, want.AtRisk, want.Events)
+	}
+	if !floats.EqualApprox(km.Survival, want.Survival, 1e-15) {
+		t.Errorf("unexpected weighted survival: got:%v want:%v", km.Survival, want.Survival)
+	}
+
+	na := NewNelsonAalen(time, event, weights)
+	wantNA := NewNelsonAalen(dupTime, dupEvent, nil)
+	if !floats.EqualApprox(na.CumHazard, wantNA.CumHazard, 1e-15) {
+		t.Errorf("unexpected weighted cumulative hazard: got:%v want:%v", na.CumHazard, wantNA.CumHazard)
+	}
+}
+
+func TestEstimatorPanics(t *testing.T) {
+	t.Parallel()
+	time := []float64{1, 2, 3}
+	event := []bool{true, false, true}
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "short event", fn: func() { NewKaplanMeier(time, event[:2], nil) }},
+		{name: "short weights", fn: func() { NewNelsonAalen(time, event, []float64{1, 1}) }},
+		{name: "negative weight", fn: func() { NewKaplanMeier(time, event, []float64{1, -1, 1}) }},
+		{name: "level zero", fn: func() { NewKaplanMeier(time, event, nil).ConfInt(0) }},
+		{name: "level one", fn: func() { NewNelsonAalen(time, event, nil).ConfInt(1) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
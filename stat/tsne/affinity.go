@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsne
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/spatial/vptree"
+)
+
+// affinities is a sparse symmetric matrix of joint probabilities held in
+// compressed row form.
+type affinities struct {
+	// The non-zero elements of row i are at columns
+	// col[start[i]:start[i+1]] with values val[start[i]:start[i+1]].
+	start []int
+	col   []int
+	val   []float64
+}
+
+// indexedPoint is a point held in a vp-tree that records its row.
+type indexedPoint struct {
+	vptree.Point
+	row int
+}
+
+// Distance returns the Euclidean distance between p and c, which must be
+// an indexedPoint.
+func (p indexedPoint) Distance(c vptree.Comparable) float64 {
+	return p.Point.Distance(c.(indexedPoint).Point)
+}
+
+// neighbors returns the indices of the k nearest neighbors of each row of
+// x, excluding the row itself, and their squared distances.
+func neighbors(x *mat.Dense, k int, src rand.Source) (idx [][]int, dist [][]float64) {
+	n, _ := x.Dims()
+	idx = make([][]int, n)
+	dist = make([][]float64, n)
+	if k == n-1 {
+		// All other points are neighbors.
+		for i := 0; i < n; i++ {
+			idx[i] = make([]int, 0, k)
+			dist[i] = make([]float64, 0, k)
+			for j := 0; j < n; j++ {
+				if j != i {
+					idx[i] = append(idx[i], j)
+					dist[i] = append(dist[i], sqDist(x.RawRowView(i), x.RawRowView(j)))
+				}
+			}
+		}
+		return idx, dist
+	}
+
+	pts := make([]vptree.Comparable, n)
+	for i := range pts {
+		pts[i] = indexedPoint{Point: x.RawRowView(i), row: i}
+	}
+	t, err := vptree.New(pts, 3, src)
+	if err != nil {
+		panic(err)
+	}
+	for i := 0; i < n; i++ {
+		q := indexedPoint{Point: x.RawRowView(i), row: i}
+		keep := vptree.NewNKeeper(k + 1)
+		t.NearestSet(keep, q)
+		idx[i] = make([]int, 0, k)
+		dist[i] = make([]float64, 0, k)
+		for _, c := range keep.Heap {
+			j := c.Comparable.(indexedPoint).row
+			if j == i || len(idx[i]) == k {
+				continue
+			}
+			idx[i] = append(idx[i], j)
+			dist[i] = append(dist[i], c.Dist*c.Dist)
+		}
+	}
+	return idx, dist
+}
+
+// conditional stores in dst the conditional probabilities p(j|i) of the
+// neighbors of a point at the given squared distances, with the Gaussian
+// bandwidth chosen so that the perplexity of the distribution is the given
+// value.
+func conditional(dst, dist []float64, perplexity float64) {
+	const (
+		maxIter = 200
+		tol     = 1e-5
+	)
+	if len(dist) == 0 {
+		return
+	}
+	min := math.Inf(1)
+	for _, d := range dist {
+		min = math.Min(min, d)
+	}
+	target := math.Log(perplexity)
+	beta := 1.0
+	lo, hi := 0.0, math.Inf(1)
+	for range maxIter {
+		// Distances are shifted by their minimum, which does not
+		// change the normalized probabilities but avoids underflow.
+		var sum, dsum float64
+		for j, d := range dist {
+			p := math.Exp(-beta * (d - min))
+			dst[j] = p
+			sum += p
+			dsum += (d - min) * p
+		}
+		entropy := math.Log(sum) + beta*dsum/sum
+		for j := range dst[:len(dist)] {
+			dst[j] /= sum
+		}
+		diff := entropy - target
+		if math.Abs(diff) < tol {
+			return
+		}
+		if diff > 0 {
+			lo = beta
+			if math.IsInf(hi, 1) {
+				beta *= 2
+			} else {
+				beta = (beta + hi) / 2
+			}
+		} else {
+			hi = beta
+			beta = (beta + lo) / 2
+		}
+	}
+}
+
+// jointProbabilities returns the symmetrized joint probabilities
+// p_ij = (p(j|i) + p(i|j))/2n of the rows of x computed from the k nearest
+// neighbors of each row.
+func jointProbabilities(x *mat.Dense, perplexity float64, k int, src rand.Source) *affinities {
+	n, _ := x.Dims()
+	idx, dist := neighbors(x, k, src)
+	rows := make([]map[int]float64, n)
+	for i := range rows {
+		rows[i] = make(map[int]float64, k)
+	}
+	p := make([]float64, k)
+	for i := 0; i < n; i++ {
+		conditional(p, dist[i], perplexity)
+		for jj, j := range idx[i] {
+			v := p[jj] / float64(2*n)
+			rows[i][j] += v
+			rows[j][i] += v
+		}
+	}
+
+	a := &affinities{start: make([]int, n+1)}
+	for i, r := range rows {
+		cols := make([]int, 0, len(r))
+		for j := range r {
+			cols = append(cols, j)
+		}
+		sort.Ints(cols)
+		for _, j := range cols {
+			a.col = append(a.col, j)
+			a.val = append(a.val, r[j])
+		}
+		a.start[i+1] = len(a.col)
+	}
+	return a
+}
+
+// sqDist returns the squared Euclidean distance between a and b.
+func sqDist(a, b []float64) float64 {
+	var d float64
+	for i, v := range a {
+		v -= b[i]
+		d += v * v
+	}
+	return d
+}
@@ -0,0 +1,18 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tsne provides t-distributed stochastic neighbor embedding, a
+// nonlinear dimensionality reduction method for visualizing
+// high-dimensional data in two or three dimensions.
+//
+// The similarities between observations are computed from their nearest
+// neighbors found with a vantage point tree, and the repulsive forces
+// between points in the embedding are approximated with the Barnes-Hut
+// trees of the spatial/barneshut package.
+//
+// See van der Maaten and Hinton, "Visualizing data using t-SNE", Journal
+// of Machine Learning Research 9, 2008, and van der Maaten, "Accelerating
+// t-SNE using tree-based algorithms", Journal of Machine Learning Research
+// 15, 2014.
+package tsne // import "gonum.org/v1/gonum/stat/tsne"
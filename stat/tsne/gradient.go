@@ -0,0 +1,167 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsne
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/spatial/barneshut"
+	"gonum.org/v1/gonum/spatial/r2"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+// gradient stores in dst the gradient of the Kullback-Leibler divergence
+// between the joint probabilities p, scaled by exaggeration, and those of
+// the embedding y, and returns the normalization of the Student-t kernel,
+//
+//	Z = Σ_{k≠l} (1 + ‖y_k - y_l‖²)⁻¹.
+//
+// The repulsive forces are computed by the given function.
+func gradient(dst *mat.Dense, p *affinities, y *mat.Dense, exaggeration float64, repulse func(dst, y *mat.Dense) float64) float64 {
+	n, d := y.Dims()
+	z := repulse(dst, y)
+	for i := 0; i < n; i++ {
+		g := dst.RawRowView(i)
+		for k := range g {
+			g[k] /= -z
+		}
+		yi := y.RawRowView(i)
+		for jj := p.start[i]; jj < p.start[i+1]; jj++ {
+			yj := y.RawRowView(p.col[jj])
+			w := exaggeration * p.val[jj] / (1 + sqDist(yi, yj))
+			for k := 0; k < d; k++ {
+				g[k] += w * (yi[k] - yj[k])
+			}
+		}
+		for k := range g {
+			g[k] *= 4
+		}
+	}
+	return z
+}
+
+// exactRepulsion stores in dst the unnormalized repulsive forces
+//
+//	Σ_{j≠i} (1 + ‖y_i - y_j‖²)⁻² (y_i - y_j)
+//
+// on each point of the embedding y and returns the normalization Z.
+func exactRepulsion(dst, y *mat.Dense) float64 {
+	n, d := y.Dims()
+	dst.Zero()
+	var z float64
+	for i := 0; i < n; i++ {
+		yi := y.RawRowView(i)
+		fi := dst.RawRowView(i)
+		for j := i + 1; j < n; j++ {
+			yj := y.RawRowView(j)
+			fj := dst.RawRowView(j)
+			q := 1 / (1 + sqDist(yi, yj))
+			z += 2 * q
+			q *= q
+			for k := 0; k < d; k++ {
+				f := q * (yi[k] - yj[k])
+				fi[k] += f
+				fj[k] -= f
+			}
+		}
+	}
+	return z
+}
+
+// particle is a point of an embedding in a Barnes-Hut tree.
+type particle struct {
+	y []float64
+}
+
+func (p *particle) Coord2() r2.Vec { return r2.Vec{X: p.y[0], Y: p.y[1]} }
+func (p *particle) Coord3() r3.Vec { return r3.Vec{X: p.y[0], Y: p.y[1], Z: p.y[2]} }
+func (p *particle) Mass() float64  { return 1 }
+
+// barnesHut returns a function computing the repulsive forces of an
+// embedding in two or three dimensions with the Barnes-Hut approximation
+// with the given accuracy parameter. The function falls back to the exact
+// forces if a tree cannot be built.
+func barnesHut(theta float64) func(dst, y *mat.Dense) float64 {
+	var (
+		particles []*particle
+		plane     barneshut.Plane
+		volume    barneshut.Volume
+	)
+	return func(dst, y *mat.Dense) float64 {
+		n, d := y.Dims()
+		if particles == nil {
+			particles = make([]*particle, n)
+			p2 := make([]barneshut.Particle2, n)
+			p3 := make([]barneshut.Particle3, n)
+			for i := range particles {
+				particles[i] = &particle{y: y.RawRowView(i)}
+				p2[i] = particles[i]
+				p3[i] = particles[i]
+			}
+			plane.Particles = p2
+			volume.Particles = p3
+		}
+
+		var z float64
+		switch d {
+		case 2:
+			if plane.Reset() != nil {
+				return exactRepulsion(dst, y)
+			}
+			for i, p := range particles {
+				f := plane.ForceOn(p, theta, func(p1, p2 barneshut.Particle2, _, m2 float64, v r2.Vec) r2.Vec {
+					if p2 == p1 {
+						return r2.Vec{}
+					}
+					q := 1 / (1 + r2.Norm2(v))
+					z += m2 * q
+					return r2.Scale(-m2*q*q, v)
+				})
+				dst.Set(i, 0, f.X)
+				dst.Set(i, 1, f.Y)
+			}
+		case 3:
+			if volume.Reset() != nil {
+				return exactRepulsion(dst, y)
+			}
+			for i, p := range particles {
+				f := volume.ForceOn(p, theta, func(p1, p2 barneshut.Particle3, _, m2 float64, v r3.Vec) r3.Vec {
+					if p2 == p1 {
+						return r3.Vec{}
+					}
+					q := 1 / (1 + r3.Norm2(v))
+					z += m2 * q
+					return r3.Scale(-m2*q*q, v)
+				})
+				dst.Set(i, 0, f.X)
+				dst.Set(i, 1, f.Y)
+				dst.Set(i, 2, f.Z)
+			}
+		default:
+			panic("tsne: Barnes-Hut approximation requires two or three dimensions")
+		}
+		return z
+	}
+}
+
+// divergence returns the Kullback-Leibler divergence between the joint
+// probabilities p and those of the embedding y with kernel normalization z.
+func divergence(p *affinities, y *mat.Dense, z float64) float64 {
+	n, _ := y.Dims()
+	var kl float64
+	for i := 0; i < n; i++ {
+		yi := y.RawRowView(i)
+		for jj := p.start[i]; jj < p.start[i+1]; jj++ {
+			pij := p.val[jj]
+			if pij == 0 {
+				continue
+			}
+			q := 1 / (1 + sqDist(yi, y.RawRowView(p.col[jj]))) / z
+			kl += pij * math.Log(pij/q)
+		}
+	}
+	return kl
+}
@@ -0,0 +1,206 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsne
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Settings holds settings for Embed. Zero values of the fields other than
+// Exact, Init and Src specify the default values.
+type Settings struct {
+	// Dims is the dimension of the embedding. The Barnes-Hut
+	// approximation requires two or three dimensions.
+	// The default is 2.
+	Dims int
+
+	// Perplexity is the effective number of neighbors of each
+	// observation, typically between 5 and 50. The default is 30.
+	Perplexity float64
+
+	// Iterations is the number of gradient descent iterations.
+	// The default is 1000.
+	Iterations int
+
+	// LearningRate is the step size of the gradient descent.
+	// The default is max(n/Exaggeration/4, 50) for n observations.
+	LearningRate float64
+
+	// Exaggeration is the factor by which the joint probabilities
+	// are multiplied during the first ExaggerationIterations
+	// iterations to form well separated clusters early in the
+	// optimization. The defaults are 12 and 250.
+	Exaggeration           float64
+	ExaggerationIterations int
+
+	// Theta is the accuracy parameter of the Barnes-Hut
+	// approximation, with smaller values giving more accurate
+	// and slower computation of the gradient. The default is 0.5.
+	Theta float64
+
+	// Exact specifies that the joint probabilities are computed
+	// from all pairs of observations and the gradient is computed
+	// exactly, taking O(n²) time per iteration rather than
+	// O(n log n). Exact embeddings may have any dimension.
+	Exact bool
+
+	// Init is the initial embedding. If Init is nil, the points
+	// are initialized from an isotropic normal distribution with
+	// standard deviation 1e-4.
+	Init mat.Matrix
+
+	// Src is the source of randomness for the initialization and
+	// neighbor search. If Src is nil, the global random source
+	// is used.
+	Src rand.Source
+}
+
+// Embed returns the t-SNE embedding of the observations held in the rows of
+// x, with the rows of y holding the coordinates of the observations in the
+// embedding, and the Kullback-Leibler divergence between the similarities
+// of the observations and those of the embedded points. If settings is nil,
+// default settings are used.
+//
+// The similarity of observation j to observation i is a Gaussian function
+// of their distance, with the bandwidth chosen for each i to give the
+// specified perplexity, and only the 3·perplexity nearest neighbors of each
+// observation are considered unless settings.Exact is true. The similarity
+// of embedded points is a Student-t function of their distance, so that
+// moderately dissimilar observations are placed far apart. The embedding
+// is found by gradient descent with momentum from a random start, so
+// distances between clusters in the embedding are not meaningful and
+// different starts give different embeddings.
+//
+// Embed panics if x has fewer than two rows, if the perplexity is not less
+// than the number of rows of x, if the dimension of the embedding is not
+// supported, or if the dimensions of settings.Init do not match.
+func Embed(x mat.Matrix, settings *Settings) (y *mat.Dense, kl float64) {
+	s := Settings{
+		Dims:                   2,
+		Perplexity:             30,
+		Iterations:             1000,
+		Exaggeration:           12,
+		ExaggerationIterations: 250,
+		Theta:                  0.5,
+	}
+	if settings != nil {
+		if settings.Dims != 0 {
+			s.Dims = settings.Dims
+		}
+		if settings.Perplexity != 0 {
+			s.Perplexity = settings.Perplexity
+		}
+		if settings.Iterations != 0 {
+			s.Iterations = settings.Iterations
+		}
+		if settings.LearningRate != 0 {
+			s.LearningRate = settings.LearningRate
+		}
+		if settings.Exaggeration != 0 {
+			s.Exaggeration = settings.Exaggeration
+		}
+		if settings.ExaggerationIterations != 0 {
+			s.ExaggerationIterations = settings.ExaggerationIterations
+		}
+		if settings.Theta != 0 {
+			s.Theta = settings.Theta
+		}
+		s.Exact = settings.Exact
+		s.Init = settings.Init
+		s.Src = settings.Src
+	}
+
+	data := mat.DenseCopyOf(x)
+	n, _ := data.Dims()
+	if n < 2 {
+		panic("tsne: too few observations")
+	}
+	if s.Perplexity <= 0 || s.Perplexity >= float64(n) {
+		panic("tsne: perplexity out of range")
+	}
+	if s.Dims < 1 || (!s.Exact && s.Dims != 2 && s.Dims != 3) {
+		panic("tsne: unsupported embedding dimension")
+	}
+	if s.LearningRate == 0 {
+		s.LearningRate = math.Max(float64(n)/s.Exaggeration/4, 50)
+	}
+
+	k := n - 1
+	repulse := exactRepulsion
+	if !s.Exact {
+		k = min(k, int(3*s.Perplexity))
+		repulse = barnesHut(s.Theta)
+	}
+	p := jointProbabilities(data, s.Perplexity, k, s.Src)
+
+	if s.Init != nil {
+		r, c := s.Init.Dims()
+		if r != n || c != s.Dims {
+			panic(mat.ErrShape)
+		}
+		y = mat.DenseCopyOf(s.Init)
+	} else {
+		norm := rand.NormFloat64
+		if s.Src != nil {
+			norm = rand.New(s.Src).NormFloat64
+		}
+		y = mat.NewDense(n, s.Dims, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < s.Dims; j++ {
+				y.Set(i, j, 1e-4*norm())
+			}
+		}
+	}
+
+	// Minimize the divergence by gradient descent with momentum
+	// and per-coordinate adaptive gains.
+	grad := mat.NewDense(n, s.Dims, nil)
+	update := mat.NewDense(n, s.Dims, nil)
+	gains := make([]float64, n*s.Dims)
+	for i := range gains {
+		gains[i] = 1
+	}
+	mean := make([]float64, s.Dims)
+	for it := 0; it < s.Iterations; it++ {
+		exaggeration, momentum := 1.0, 0.8
+		if it < s.ExaggerationIterations {
+			exaggeration, momentum = s.Exaggeration, 0.5
+		}
+		gradient(grad, p, y, exaggeration, repulse)
+		for i := 0; i < n; i++ {
+			g := grad.RawRowView(i)
+			u := update.RawRowView(i)
+			gi := gains[i*s.Dims : (i+1)*s.Dims]
+			for j := range g {
+				if (g[j] > 0) != (u[j] > 0) {
+					gi[j] += 0.2
+				} else {
+					gi[j] = math.Max(gi[j]*0.8, 0.01)
+				}
+				u[j] = momentum*u[j] - s.LearningRate*gi[j]*g[j]
+			}
+		}
+		y.Add(y, update)
+
+		// Keep the embedding centered.
+		for j := range mean {
+			mean[j] = 0
+		}
+		for i := 0; i < n; i++ {
+			floats.Add(mean, y.RawRowView(i))
+		}
+		floats.Scale(-1/float64(n), mean)
+		for i := 0; i < n; i++ {
+			floats.Add(y.RawRowView(i), mean)
+		}
+	}
+
+	z := repulse(grad, y)
+	return y, divergence(p, y, z)
+}
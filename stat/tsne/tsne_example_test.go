@@ -0,0 +1,52 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsne_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat/tsne"
+)
+
+func ExampleEmbed() {
+	// Observations in 20 dimensions from three well separated
+	// groups of 40.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n, d = 120, 20
+	x := mat.NewDense(n, d, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < d; j++ {
+			x.Set(i, j, rnd.NormFloat64())
+		}
+		x.Set(i, i%3, x.At(i, i%3)+10)
+	}
+
+	y, _ := tsne.Embed(x, &tsne.Settings{Perplexity: 20, Src: rand.NewPCG(1, 1)})
+
+	// Count the points whose nearest neighbor in the
+	// embedding is from the same group.
+	var same int
+	for i := 0; i < n; i++ {
+		nearest := -1
+		min := math.Inf(1)
+		for j := 0; j < n; j++ {
+			dx := y.At(i, 0) - y.At(j, 0)
+			dy := y.At(i, 1) - y.At(j, 1)
+			if dist := dx*dx + dy*dy; j != i && dist < min {
+				nearest, min = j, dist
+			}
+		}
+		if nearest%3 == i%3 {
+			same++
+		}
+	}
+	fmt.Printf("%d of %d points are nearest to a point of their group\n", same, n)
+
+	// Output:
+	// 120 of 120 points are nearest to a point of their group
+}
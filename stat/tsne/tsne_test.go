@@ -0,0 +1,276 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsne
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// blobs returns n observations in d dimensions from each of k ≤ d normal
+// distributions with means on the coordinate axes at distance 10 from
+// the origin, and the cluster of each observation.
+func blobs(rnd *rand.Rand, k, n, d int) (*mat.Dense, []int) {
+	x := mat.NewDense(k*n, d, nil)
+	labels := make([]int, k*n)
+	for i := range labels {
+		labels[i] = i % k
+		row := x.RawRowView(i)
+		for j := range row {
+			row[j] = rnd.NormFloat64()
+		}
+		row[labels[i]] += 10
+	}
+	return x, labels
+}
+
+func randomDense(rnd *rand.Rand, r, c int) *mat.Dense {
+	m := mat.NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	return m
+}
+
+func TestConditional(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, perplexity := range []float64{2, 5, 30} {
+		dist := make([]float64, 90)
+		for i := range dist {
+			dist[i] = 100 + 50*rnd.Float64()
+		}
+		p := make([]float64, len(dist))
+		conditional(p, dist, perplexity)
+		if s := floats.Sum(p); math.Abs(s-1) > 1e-12 {
+			t.Errorf("conditional probabilities do not sum to one for perplexity %v: %v", perplexity, s)
+		}
+		var h float64
+		for _, v := range p {
+			if v > 0 {
+				h -= v * math.Log(v)
+			}
+		}
+		if got := math.Exp(h); math.Abs(got-perplexity) > 1e-3*perplexity {
+			t.Errorf("unexpected perplexity: got:%v want:%v", got, perplexity)
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x := randomDense(rnd, 100, 5)
+	const k = 7
+	idx, dist := neighbors(x, k, rand.NewPCG(1, 1))
+	for i := 0; i < 100; i++ {
+		want := make([]int, 0, 99)
+		for j := 0; j < 100; j++ {
+			if j != i {
+				want = append(want, j)
+			}
+		}
+		sort.Slice(want, func(a, b int) bool {
+			return sqDist(x.RawRowView(i), x.RawRowView(want[a])) < sqDist(x.RawRowView(i), x.RawRowView(want[b]))
+		})
+		got := append([]int(nil), idx[i]...)
+		sort.Ints(got)
+		want = want[:k]
+		sort.Ints(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("unexpected neighbors of %d: got:%v want:%v", i, got, want)
+		}
+		for jj, j := range idx[i] {
+			if d := sqDist(x.RawRowView(i), x.RawRowView(j)); !scalar.EqualWithinAbsOrRel(dist[i][jj], d, 1e-12, 1e-12) {
+				t.Errorf("unexpected distance from %d to %d: got:%v want:%v", i, j, dist[i][jj], d)
+			}
+		}
+	}
+}
+
+func TestJointProbabilities(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x := randomDense(rnd, 60, 4)
+	for _, k := range []int{10, 59} {
+		p := jointProbabilities(x, 5, k, rand.NewPCG(1, 1))
+		dense := mat.NewDense(60, 60, nil)
+		for i := 0; i < 60; i++ {
+			for jj := p.start[i]; jj < p.start[i+1]; jj++ {
+				if p.col[jj] == i {
+					t.Errorf("self affinity for row %d with k=%d", i, k)
+				}
+				dense.Set(i, p.col[jj], p.val[jj])
+			}
+		}
+		if !mat.Equal(dense, dense.T()) {
+			t.Errorf("joint probabilities not symmetric for k=%d", k)
+		}
+		if s := mat.Sum(dense); math.Abs(s-1) > 1e-12 {
+			t.Errorf("joint probabilities do not sum to one for k=%d: %v", k, s)
+		}
+	}
+}
+
+// exactDivergence returns the divergence between p and the embedding y.
+func exactDivergence(p *affinities, y *mat.Dense) float64 {
+	n, d := y.Dims()
+	return divergence(p, y, exactRepulsion(mat.NewDense(n, d, nil), y))
+}
+
+func TestGradient(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x := randomDense(rnd, 20, 5)
+	p := jointProbabilities(x, 4, 19, nil)
+	for _, d := range []int{1, 2, 3} {
+		y := randomDense(rnd, 20, d)
+		got := mat.NewDense(20, d, nil)
+		z := gradient(got, p, y, 1, exactRepulsion)
+
+		want := fd.Gradient(nil, func(v []float64) float64 {
+			return exactDivergence(p, mat.NewDense(20, d, v))
+		}, mat.DenseCopyOf(y).RawMatrix().Data, &fd.Settings{Formula: fd.Central})
+		if !floats.EqualApprox(got.RawMatrix().Data, want, 1e-6) {
+			t.Errorf("unexpected gradient in %d dimensions", d)
+		}
+		if kl := divergence(p, y, z); !scalar.EqualWithinAbsOrRel(kl, exactDivergence(p, y), 1e-12, 1e-12) {
+			t.Errorf("unexpected normalization in %d dimensions", d)
+		}
+	}
+}
+
+func TestBarnesHut(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x := randomDense(rnd, 200, 5)
+	p := jointProbabilities(x, 10, 30, nil)
+	for _, d := range []int{2, 3} {
+		y := randomDense(rnd, 200, d)
+		y.Scale(5, y)
+		want := mat.NewDense(200, d, nil)
+		wantZ := gradient(want, p, y, 1, exactRepulsion)
+		for _, test := range []struct {
+			theta float64
+			tol   float64
+		}{
+			{theta: 0, tol: 1e-10},
+			{theta: 0.5, tol: 0.05},
+		} {
+			got := mat.NewDense(200, d, nil)
+			z := gradient(got, p, y, 1, barnesHut(test.theta))
+			if math.Abs(z-wantZ) > test.tol*wantZ {
+				t.Errorf("unexpected normalization in %d dimensions with theta=%v: got:%v want:%v", d, test.theta, z, wantZ)
+			}
+			var diff mat.Dense
+			diff.Sub(got, want)
+			if e := mat.Norm(&diff, 2) / mat.Norm(want, 2); e > test.tol {
+				t.Errorf("unexpected gradient error in %d dimensions with theta=%v: %v", d, test.theta, e)
+			}
+		}
+	}
+}
+
+func TestEmbed(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, labels := blobs(rnd, 5, 30, 10)
+	n := len(labels)
+	for _, test := range []struct {
+		name     string
+		settings *Settings
+	}{
+		{name: "barnes-hut 2-d", settings: &Settings{Iterations: 500, Src: rand.NewPCG(1, 1)}},
+		{name: "barnes-hut 3-d", settings: &Settings{Dims: 3, Iterations: 500, Src: rand.NewPCG(1, 1)}},
+		{name: "exact 2-d", settings: &Settings{Exact: true, Iterations: 500, Src: rand.NewPCG(1, 1)}},
+		{name: "exact 1-d", settings: &Settings{Dims: 1, Exact: true, Iterations: 500, Src: rand.NewPCG(1, 1)}},
+	} {
+		y, kl := Embed(x, test.settings)
+		r, c := y.Dims()
+		want := test.settings.Dims
+		if want == 0 {
+			want = 2
+		}
+		if r != n || c != want {
+			t.Errorf("unexpected embedding dimensions for %s: got:%d×%d want:%d×%d", test.name, r, c, n, want)
+			continue
+		}
+		if kl < 0 || kl > 1 || math.IsNaN(kl) {
+			t.Errorf("unexpected divergence for %s: %v", test.name, kl)
+		}
+
+		// The nearest neighbor of each point in the embedding
+		// is in the same cluster.
+		var wrong int
+		for i := 0; i < n; i++ {
+			best := -1
+			min := math.Inf(1)
+			for j := 0; j < n; j++ {
+				if d := sqDist(y.RawRowView(i), y.RawRowView(j)); j != i && d < min {
+					best, min = j, d
+				}
+			}
+			if labels[best] != labels[i] {
+				wrong++
+			}
+		}
+		if wrong > n/20 {
+			t.Errorf("too many points near other clusters for %s: %d of %d", test.name, wrong, n)
+		}
+	}
+}
+
+func TestEmbedInit(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	x, _ := blobs(rnd, 3, 20, 5)
+	init := randomDense(rnd, 60, 2)
+	a, klA := Embed(x, &Settings{Init: init, Iterations: 50, Perplexity: 10})
+	b, klB := Embed(x, &Settings{Init: init, Iterations: 50, Perplexity: 10})
+	if !mat.Equal(a, b) || klA != klB {
+		t.Error("embeddings from the same initialization differ")
+	}
+	if init.At(0, 0) == a.At(0, 0) {
+		t.Error("embedding unchanged from initialization")
+	}
+}
+
+func TestEmbedPanics(t *testing.T) {
+	t.Parallel()
+	x := randomDense(rand.New(rand.NewPCG(1, 1)), 10, 3)
+	for _, test := range []struct {
+		name     string
+		x        mat.Matrix
+		settings *Settings
+	}{
+		{name: "one observation", x: mat.NewDense(1, 3, nil), settings: &Settings{Perplexity: 0.5}},
+		{name: "large perplexity", x: x, settings: nil},
+		{name: "negative perplexity", x: x, settings: &Settings{Perplexity: -1}},
+		{name: "four dimensions", x: x, settings: &Settings{Perplexity: 3, Dims: 4}},
+		{name: "mismatched init", x: x, settings: &Settings{Perplexity: 3, Init: mat.NewDense(10, 3, nil)}},
+	} {
+		if !panics(func() { Embed(test.x, test.settings) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
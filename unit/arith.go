@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"errors"
+	"math"
+)
+
+// The functions in this file are counterparts of the Unit methods that do
+// not modify their arguments and that return an error rather than panic
+// when the dimensions of the operands are incompatible.
+
+// Add returns a new Unit holding the sum of a and b. Add returns an error
+// if the dimensions of a and b do not match.
+func Add(a, b Uniter) (*Unit, error) {
+	if !DimensionsMatch(a, b) {
+		return nil, errors.New("unit: dimension mismatch")
+	}
+	u := a.Unit()
+	return New(u.value+b.Unit().value, u.dimensions), nil
+}
+
+// Sub returns a new Unit holding the difference a-b. Sub returns an error
+// if the dimensions of a and b do not match.
+func Sub(a, b Uniter) (*Unit, error) {
+	if !DimensionsMatch(a, b) {
+		return nil, errors.New("unit: dimension mismatch")
+	}
+	u := a.Unit()
+	return New(u.value-b.Unit().value, u.dimensions), nil
+}
+
+// Mul returns a new Unit holding the product a*b, with the dimensions of
+// a and b combined. For example, the product of two lengths is an area.
+func Mul(a, b Uniter) *Unit {
+	ua := a.Unit()
+	ub := b.Unit()
+	return &Unit{
+		dimensions: combine(ua.dimensions, 1, ub.dimensions, 1),
+		value:      ua.value * ub.value,
+	}
+}
+
+// Div returns a new Unit holding the quotient a/b, with the dimensions of
+// a and b combined. For example, a length divided by a time is a velocity.
+func Div(a, b Uniter) *Unit {
+	ua := a.Unit()
+	ub := b.Unit()
+	return &Unit{
+		dimensions: combine(ua.dimensions, 1, ub.dimensions, -1),
+		value:      ua.value / ub.value,
+	}
+}
+
+// Pow returns a new Unit holding a raised to the integer power n, with the
+// powers of the dimensions of a multiplied by n.
+func Pow(a Uniter, n int) *Unit {
+	u := a.Unit()
+	return &Unit{
+		dimensions: combine(u.dimensions, n, nil, 0),
+		value:      math.Pow(u.value, float64(n)),
+	}
+}
+
+// Root returns a new Unit holding the n-th root of a, with the powers of the
+// dimensions of a divided by n. Root returns an error if n is not positive or
+// if any power of the dimensions of a is not divisible by n, so the square
+// root of an area is a length, while the square root of a length is an error.
+// The value of the root is computed as by math.Pow, so even roots of negative
+// values are NaN.
+func Root(a Uniter, n int) (*Unit, error) {
+	if n <= 0 {
+		return nil, errors.New("unit: non-positive root")
+	}
+	u := a.Unit()
+	dims := make(Dimensions, len(u.dimensions))
+	for dim, pow := range u.dimensions {
+		if pow%n != 0 {
+			return nil, errors.New("unit: dimension mismatch")
+		}
+		if pow != 0 {
+			dims[dim] = pow / n
+		}
+	}
+	var v float64
+	switch n {
+	case 1:
+		v = u.value
+	case 2:
+		v = math.Sqrt(u.value)
+	case 3:
+		v = math.Cbrt(u.value)
+	default:
+		v = math.Pow(u.value, 1/float64(n))
+	}
+	return &Unit{dimensions: dims, value: v}, nil
+}
+
+// combine returns the dimensions with powers na*pow_a + nb*pow_b for each
+// dimension in a and b, omitting zero powers.
+func combine(a Dimensions, na int, b Dimensions, nb int) Dimensions {
+	c := make(Dimensions, len(a)+len(b))
+	for dim, pow := range a {
+		c[dim] += na * pow
+	}
+	for dim, pow := range b {
+		c[dim] += nb * pow
+	}
+	for dim, pow := range c {
+		if pow == 0 {
+			delete(c, dim)
+		}
+	}
+	return c
+}
@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+	add := func(a, b Uniter) (*Unit, error) { return Add(a, b) }
+	sub := func(a, b Uniter) (*Unit, error) { return Sub(a, b) }
+	mul := func(a, b Uniter) (*Unit, error) { return Mul(a, b), nil }
+	div := func(a, b Uniter) (*Unit, error) { return Div(a, b), nil }
+	pow := func(n int) func(a, _ Uniter) (*Unit, error) {
+		return func(a, _ Uniter) (*Unit, error) { return Pow(a, n), nil }
+	}
+	root := func(n int) func(a, _ Uniter) (*Unit, error) {
+		return func(a, _ Uniter) (*Unit, error) { return Root(a, n) }
+	}
+	for i, test := range []struct {
+		op      func(a, b Uniter) (*Unit, error)
+		a, b    Uniter
+		want    Uniter
+		wantErr bool
+	}{
+		{op: add, a: Length(1), b: Length(2), want: Length(3)},
+		{op: add, a: Dimless(1), b: New(2, nil), want: Dimless(3)},
+		{op: add, a: Length(1), b: Time(2), wantErr: true},
+		{op: add, a: Length(1), b: Dimless(2), wantErr: true},
+		{op: sub, a: Energy(5), b: Torque(2), want: Energy(3)},
+		{op: sub, a: Mass(1), b: Area(1), wantErr: true},
+		{op: mul, a: Length(2), b: Length(3), want: Area(6)},
+		{op: mul, a: Force(2), b: Length(3), want: Energy(6)},
+		{op: mul, a: Frequency(2), b: Time(3), want: Dimless(6)},
+		{op: mul, a: New(2, nil), b: Mass(3), want: Mass(6)},
+		{op: div, a: Length(6), b: Time(3), want: Velocity(2)},
+		{op: div, a: Length(6), b: Length(3), want: Dimless(2)},
+		{op: div, a: Dimless(1), b: Time(4), want: Frequency(0.25)},
+		{op: pow(3), a: Length(2), want: Volume(8)},
+		{op: pow(-1), a: Time(4), want: Frequency(0.25)},
+		{op: pow(0), a: Time(4), want: Dimless(1)},
+		{op: root(2), a: Area(9), want: Length(3)},
+		{op: root(3), a: Volume(-8), want: Length(-2)},
+		{op: root(1), a: Volume(8), want: Volume(8)},
+		{op: root(4), a: New(16, Dimensions{LengthDim: 4, TimeDim: -8}), want: New(2, Dimensions{LengthDim: 1, TimeDim: -2})},
+		{op: root(2), a: Length(4), wantErr: true},
+		{op: root(0), a: Area(4), wantErr: true},
+	} {
+		got, err := test.op(test.a, test.b)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("expected error for test %d", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for test %d: %v", i, err)
+			continue
+		}
+		if !DimensionsMatch(got, test.want) {
+			t.Errorf("dimension mismatch for test %d: got:%v want:%v", i, got, test.want.Unit())
+		}
+		if got.Value() != test.want.Unit().Value() {
+			t.Errorf("value mismatch for test %d: got:%v want:%v", i, got.Value(), test.want.Unit().Value())
+		}
+	}
+}
+
+func TestArithmeticNoMutation(t *testing.T) {
+	t.Parallel()
+	a := New(2, Dimensions{LengthDim: 1})
+	b := New(3, Dimensions{LengthDim: 1})
+	Mul(a, b)
+	Div(a, b)
+	Pow(a, 2)
+	Add(a, b)
+	Sub(a, b)
+	if a.Value() != 2 || !DimensionsMatch(a, Length(0)) {
+		t.Errorf("first argument modified: %v", a)
+	}
+	if b.Value() != 3 || !DimensionsMatch(b, Length(0)) {
+		t.Errorf("second argument modified: %v", b)
+	}
+
+	// The result must not share dimensions with the arguments.
+	sum, _ := Add(a, b)
+	sum.Mul(Length(1))
+	if !DimensionsMatch(a, Length(0)) {
+		t.Errorf("argument dimensions modified through result: %v", a)
+	}
+}
+
+func TestRootNaN(t *testing.T) {
+	t.Parallel()
+	got, err := Root(Area(-4), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !math.IsNaN(got.Value()) {
+		t.Errorf("unexpected value for square root of negative area: got:%v want:NaN", got.Value())
+	}
+}
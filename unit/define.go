@@ -0,0 +1,116 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"errors"
+	"strings"
+)
+
+// definedUnits holds the units defined by DefineUnit. It is
+// protected by mu.
+var definedUnits = make(map[string]*Unit)
+
+// DefineUnit defines a named unit with the given symbol and value, for
+// example a non-SI unit such as the foot,
+//
+//	unit.DefineUnit("ft", unit.Length(0.3048))
+//
+// a unit of a natural unit system, such as the electronvolt,
+//
+//	unit.DefineUnit("eV", unit.Energy(1.602176634e-19))
+//
+// or a multiple of a dimension created by NewDimension,
+//
+//	bit := unit.NewDimension("bit")
+//	unit.DefineUnit("B", unit.New(8, unit.Dimensions{bit: 1}))
+//
+// Defined units are accepted by Parse, without SI prefixes, and can be used as
+// the target of ValueIn. The symbol must consist of letters and must not be
+// in use by an SI unit symbol, an SI prefix, a dimension created by
+// NewDimension or another defined unit with a different value. DefineUnit
+// panics if these conditions are not met. As for NewDimension, DefineUnit
+// should typically be called within an init function.
+func DefineUnit(symbol string, value Uniter) {
+	if symbol == "" || strings.IndexFunc(symbol, isNotLetter) >= 0 {
+		panic("unit: invalid unit symbol \"" + symbol + "\"")
+	}
+	u := value.Unit()
+	defer mu.Unlock()
+	mu.Lock()
+	if d, ok := definedUnits[symbol]; ok {
+		if d.value == u.value && d.dimensions.matches(u.dimensions) {
+			return
+		}
+		panic("unit: unit symbol \"" + symbol + "\" already used")
+	}
+	_, isDim := dimensions[symbol]
+	if _, _, _, ok := lookupBuiltin(symbol); ok || isDim {
+		panic("unit: unit symbol \"" + symbol + "\" already used")
+	}
+	definedUnits[symbol] = u.Copy()
+}
+
+// DefineUnits calls DefineUnit for each of the symbols and values in units.
+func DefineUnits(units map[string]Uniter) {
+	for sym, u := range units {
+		DefineUnit(sym, u)
+	}
+}
+
+// ValueIn returns the value of u expressed in the unit given by the unit
+// expression unit, which has the syntax of the unit part accepted by Parse.
+// For example,
+//
+//	v, err := unit.ValueIn(unit.Velocity(10), "mi/h")
+//
+// returns the speed of 10 m/s in miles per hour, provided that the mile has
+// been defined. ValueIn returns an error if unit cannot be parsed or if the
+// dimensions of u and unit do not match.
+func ValueIn(u Uniter, unit string) (float64, error) {
+	p := unitParser{s: unit}
+	scale, exp, dims, err := p.product()
+	if err != nil {
+		return 0, err
+	}
+	if !p.done() {
+		return 0, p.errorf("unexpected %q", p.s[p.pos:])
+	}
+	x := u.Unit()
+	if !x.dimensions.matches(combine(dims, 1, nil, 0)) {
+		return 0, errors.New("unit: dimension mismatch")
+	}
+	return shiftDecimal(x.value/scale, -exp), nil
+}
+
+// Imperial holds the common units of the British imperial system, with
+// their definitions in the International Yard and Pound Agreement of 1959
+// and the Weights and Measures Act 1985. They may be made available to
+// Parse with DefineUnits.
+var Imperial = map[string]Uniter{
+	"in":  Length(0.0254),
+	"ft":  Length(0.3048),
+	"yd":  Length(0.9144),
+	"mi":  Length(1609.344),
+	"oz":  Mass(0.028349523125),
+	"lb":  Mass(0.45359237),
+	"st":  Mass(6.35029318),
+	"pt":  Volume(0.56826125e-3),
+	"gal": Volume(4.54609e-3),
+	"lbf": Force(4.4482216152605),
+	"psi": Pressure(6894.757293168361),
+	"mph": Velocity(0.44704),
+}
+
+// CGS holds the named units of the centimetre-gram-second system of
+// mechanical units. They may be made available to Parse with DefineUnits.
+var CGS = map[string]Uniter{
+	"dyn":   Force(1e-5),
+	"erg":   Energy(1e-7),
+	"Gal":   Acceleration(1e-2),
+	"Ba":    Pressure(0.1),
+	"St":    New(1e-4, Dimensions{LengthDim: 2, TimeDim: -1}),
+	"poise": New(0.1, Dimensions{MassDim: 1, LengthDim: -1, TimeDim: -1}),
+}
@@ -0,0 +1,129 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestDefineUnit(t *testing.T) {
+	t.Parallel()
+	DefineUnits(Imperial)
+	DefineUnits(CGS)
+	// Redefinition with the same value is allowed.
+	DefineUnits(Imperial)
+
+	const tol = 1e-14
+	for _, test := range []struct {
+		in   string
+		want Uniter
+	}{
+		{"12 in", Length(0.3048)},
+		{"3 ft", Length(0.9144)},
+		{"1 mi", Length(1609.344)},
+		{"60 mph", Velocity(26.8224)},
+		{"60 mi/h", Velocity(26.8224)},
+		{"1 lbf/in^2", Pressure(6894.757293168361)},
+		{"16 oz", Mass(0.45359237)},
+		{"14 lb", Mass(6.35029318)},
+		{"1 gal", Volume(4.54609e-3)},
+		{"981 Gal", Acceleration(9.81)},
+		{"1e5 dyn", Force(1)},
+		{"1e7 erg/s", Power(1)},
+		{"10 Ba", Pressure(1)},
+	} {
+		got, err := Parse(test.in)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.in, err)
+			continue
+		}
+		want := test.want.Unit()
+		if !DimensionsMatch(got, want) || !scalar.EqualWithinRel(got.Value(), want.Value(), tol) {
+			t.Errorf("unexpected result for %q: got:%v want:%v", test.in, got, want)
+		}
+	}
+
+	for _, test := range []struct {
+		u    Uniter
+		in   string
+		want float64
+	}{
+		{Velocity(26.8224), "mph", 60},
+		{Velocity(26.8224), "mi/h", 60},
+		{Velocity(1), "km/h", 3.6},
+		{Length(1), "in", 1 / 0.0254},
+		{Mass(1), "g", 1000},
+		{Mass(1), "lb", 1 / 0.45359237},
+		{Energy(1), "erg", 1e7},
+		{Pressure(101325), "psi", 14.695948775513449},
+		{Pressure(101325), "mbar", 1013.25},
+		{Capacitance(4.7e-9), "nF", 4.7},
+	} {
+		got, err := ValueIn(test.u, test.in)
+		if err != nil {
+			t.Errorf("unexpected error for %v in %q: %v", test.u, test.in, err)
+			continue
+		}
+		if !scalar.EqualWithinRel(got, test.want, tol) {
+			t.Errorf("unexpected value for %v in %q: got:%v want:%v", test.u, test.in, got, test.want)
+		}
+	}
+	if _, err := ValueIn(Length(1), "lb"); err == nil {
+		t.Error("expected error for dimension mismatch")
+	}
+	if _, err := ValueIn(Length(1), "furlong"); err == nil {
+		t.Error("expected error for unknown unit")
+	}
+	if !SymbolExists("ft") {
+		t.Error("defined unit symbol not reported as existing")
+	}
+}
+
+func TestDefineUnitCustomDimension(t *testing.T) {
+	t.Parallel()
+	bit := NewDimension("definebit")
+	DefineUnit("definebyte", New(8, Dimensions{bit: 1}))
+	got, err := Parse("2 definebyte/s")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := New(16, Dimensions{bit: 1, TimeDim: -1})
+	if !DimensionsMatch(got, want) || got.Value() != want.Value() {
+		t.Errorf("unexpected result: got:%v want:%v", got, want)
+	}
+	v, err := ValueIn(New(64, Dimensions{bit: 1}), "definebyte")
+	if err != nil || v != 8 {
+		t.Errorf("unexpected conversion: got:%v %v want:8", v, err)
+	}
+}
+
+func TestDefineUnitPanics(t *testing.T) {
+	t.Parallel()
+	DefineUnit("definepanicunit", Length(2))
+	for _, test := range []struct {
+		symbol string
+		value  Uniter
+	}{
+		{"", Length(1)},
+		{"ft2", Length(1)},
+		{"m s", Length(1)},
+		{"m", Length(1)},
+		{"km", Length(1000)},
+		{"N", Force(1)},
+		{"k", Dimless(1000)},
+		{"kg", Mass(1)},
+		{"rad", Angle(1)},
+		{"definepanicunit", Length(3)},
+	} {
+		if !panics(func() { DefineUnit(test.symbol, test.value) }) {
+			t.Errorf("expected panic for symbol %q", test.symbol)
+		}
+	}
+	if !panics(func() { NewDimension("definepanicunit") }) {
+		t.Error("expected panic for dimension with defined unit symbol")
+	}
+}
@@ -77,6 +77,13 @@
 //
 //	rate.Mul(1 * unit.Centi * unit.Metre).Div(1 * unit.Milli * unit.Volt)
 //
+// The functions Add, Sub, Mul, Div, Pow and Root perform the same operations
+// without modifying their arguments, and Add and Sub return an error rather
+// than panic when the dimensions of their arguments do not match.
+//
+//	area := unit.Mul(unit.Length(2), unit.Length(3)) // 6 m^2
+//	_, err := unit.Add(area, unit.Length(1))         // dimension mismatch
+//
 // To convert the unit back into a typed float64 value, the From methods
 // of the dimensional types should be used. From will return an error if the
 // dimensions do not match.
@@ -84,6 +91,21 @@
 //	var energy unit.Energy
 //	err := energy.From(acc)
 //
+// Values with units given as text, for example in configuration files or
+// command line arguments, can be read with the Parse function and written
+// with SI prefixes and derived unit symbols by the FormatSI function.
+//
+//	u, err := unit.Parse("9.81 m/s^2")
+//	fmt.Println(unit.FormatSI(unit.Force(9810), 'g', -1)) // 9.81 kN
+//
+// Measured values with standard uncertainties are represented by the
+// Uncertain type, whose arithmetic methods propagate the uncertainties to
+// first order while tracking dimensions and correlations between values.
+//
+//	g := unit.NewUncertain(9.81, 0.02, unit.Dimensions{unit.LengthDim: 1, unit.TimeDim: -2})
+//	t := unit.NewUncertain(1.5, 0.01, unit.Dimensions{unit.TimeDim: 1})
+//	fmt.Printf("%.3f\n", g.Mul(t.Pow(2)).Scale(0.5)) // 11.036 ± 0.149 m
+//
 // Domain-specific problems may need custom dimensions, and for this purpose
 // NewDimension should be used to help avoid accidental overlap between
 // packages. For example, results from a blood test may be measured in
@@ -100,6 +122,13 @@
 //
 //	const Slide unit.Volume =  0.1 * unit.Micro * unit.Litre
 //
+// Named non-SI units, including units of custom dimensions, can be made
+// available to Parse and ValueIn with DefineUnit. The Imperial and CGS
+// variables hold the units of those systems.
+//
+//	unit.DefineUnits(unit.Imperial)
+//	v, err := unit.ValueIn(unit.Velocity(10), "mph")
+//
 // Note that unit cannot catch all errors related to dimensionality.
 // Different physical ideas are sometimes expressed with the same dimensions
 // and unit is incapable of catching these mismatches. For example, energy and
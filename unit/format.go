@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// siSymbols lists the unit symbols FormatSI may use, in order of
+// preference for units with equal dimensions.
+var siSymbols = []string{
+	"m", "kg", "s", "A", "K", "mol", "cd", "rad",
+	"N", "Pa", "J", "W", "C", "V", "F", "Ω", "S", "Wb", "T", "H", "Hz", "kat",
+}
+
+// prefixSymbols holds the symbols of the SI prefixes that are powers of
+// 1000, from 1e-24 to 1e24.
+var prefixSymbols = [...]string{"y", "z", "a", "f", "p", "n", "μ", "m", "", "k", "M", "G", "T", "P", "E", "Z", "Y"}
+
+// FormatSI returns a string representation of u using SI unit symbols and
+// prefixes, for example "9.81 kN" for a force of 9810 N. The verb and prec
+// arguments control the formatting of the value as for strconv.FormatFloat.
+//
+// If the dimensions of u are those of an SI base unit, the radian or an SI
+// derived unit with a special symbol, the value is written with that symbol
+// and the SI prefix that is a power of 1000 placing the value in [1, 1000),
+// if there is one. Masses are written in grams, so that the kilogram is
+// written as kg. Frequencies and radioactivities are both written in Hz,
+// and energy and torque are both written in J. Otherwise, for example for
+// absorbed doses or velocities, the value is written in SI base units as
+// by the Format method of Unit. The string returned by FormatSI is accepted by
+// Parse.
+func FormatSI(u Uniter, verb byte, prec int) string {
+	x := u.Unit()
+	if len(x.dimensions) == 0 {
+		return strconv.FormatFloat(x.value, verb, prec, 64)
+	}
+	for _, sym := range siSymbols {
+		if !unitSymbols[sym].dims.matches(x.dimensions) {
+			continue
+		}
+		if sym == "kg" {
+			// The prefix of the kilogram is held by the gram.
+			return formatPrefixed(x.value, 1, verb, prec) + "g"
+		}
+		return formatPrefixed(x.value, 0, verb, prec) + sym
+	}
+	return strconv.FormatFloat(x.value, verb, prec, 64) + " " + x.dimensions.String()
+}
+
+// formatPrefixed returns v formatted with strconv.FormatFloat followed by
+// a space and the SI prefix that places the value in [1, 1000). The unit
+// of v is taken to carry the prefix 1000^offset.
+func formatPrefixed(v float64, offset int, verb byte, prec int) string {
+	const maxExp = len(prefixSymbols) / 2
+	if v == 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+		return strconv.FormatFloat(v, verb, prec, 64) + " " + prefixSymbols[maxExp+offset]
+	}
+
+	exp := int(math.Floor(math.Log10(math.Abs(v)) / 3))
+	if exp > -maxExp-offset && math.Abs(shiftDecimal(v, -3*exp)) < 1 {
+		// Correct for rounding in the logarithm.
+		exp--
+	}
+	exp = max(-maxExp-offset, min(exp, maxExp-offset))
+	for {
+		s := strconv.FormatFloat(shiftDecimal(v, -3*exp), verb, prec, 64)
+		// Rounding may carry the value up to the next prefix.
+		if exp < maxExp-offset {
+			if r, _ := strconv.ParseFloat(s, 64); math.Abs(r) >= 1000 {
+				exp++
+				continue
+			}
+		}
+		return s + " " + prefixSymbols[exp+maxExp+offset]
+	}
+}
+
+// shiftDecimal returns v×10^n, correctly rounded from the shortest decimal
+// representation of v.
+func shiftDecimal(v float64, n int) float64 {
+	if n == 0 || v == 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+		return v
+	}
+	s := strconv.FormatFloat(v, 'e', -1, 64)
+	mant, e, _ := strings.Cut(s, "e")
+	exp, _ := strconv.Atoi(e)
+	r, _ := strconv.ParseFloat(mant+"e"+strconv.Itoa(exp+n), 64)
+	return r
+}
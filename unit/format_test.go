@@ -0,0 +1,56 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFormatSI(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		u    Uniter
+		verb byte
+		prec int
+		want string
+	}{
+		{Force(9810), 'g', -1, "9.81 kN"},
+		{Force(-9810), 'g', -1, "-9.81 kN"},
+		{Force(9810), 'f', 1, "9.8 kN"},
+		{Force(0), 'g', -1, "0 N"},
+		{Force(math.Inf(1)), 'g', -1, "+Inf N"},
+		{Length(1), 'g', -1, "1 m"},
+		{Length(1000), 'g', -1, "1 km"},
+		{Length(999.9996), 'g', 3, "1 km"},
+		{Length(999.9996), 'g', -1, "999.9996 m"},
+		{Length(0.0047), 'g', -1, "4.7 mm"},
+		{Length(4.7e-6), 'g', -1, "4.7 μm"},
+		{Length(1e-30), 'g', -1, "1e-06 ym"},
+		{Length(1e30), 'g', -1, "1e+06 Ym"},
+		{Mass(1), 'g', -1, "1 kg"},
+		{Mass(0.0025), 'g', -1, "2.5 g"},
+		{Mass(2.5e-6), 'g', -1, "2.5 mg"},
+		{Mass(1500), 'g', -1, "1.5 Mg"},
+		{Mass(1e22), 'g', -1, "10 Yg"},
+		{Mass(1e-30), 'g', -1, "0.001 yg"},
+		{Capacitance(4.7e-9), 'g', -1, "4.7 nF"},
+		{Resistance(2.2e6), 'g', -1, "2.2 MΩ"},
+		{Frequency(50), 'g', -1, "50 Hz"},
+		{Radioactivity(3.7e10), 'g', -1, "37 GHz"},
+		{Torque(1.5), 'g', -1, "1.5 J"},
+		{Pressure(101325), 'f', 2, "101.33 kPa"},
+		{Voltage(0.001), 'e', 2, "1.00e+00 mV"},
+		{Velocity(3e8), 'g', -1, "3e+08 m s^-1"},
+		{AbsorbedRadioactiveDose(2e-3), 'g', -1, "0.002 m^2 s^-2"},
+		{Dimless(0.5), 'g', -1, "0.5"},
+	} {
+		got := FormatSI(test.u, test.verb, test.prec)
+		if got != test.want {
+			t.Errorf("unexpected result for %v with %c and precision %d: got:%q want:%q",
+				test.u, test.verb, test.prec, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Parse parses a dimensional value from a string of the form
+//
+//	value [unit]
+//
+// where value is a floating point number accepted by strconv.ParseFloat and
+// unit is an expression of unit symbols, for example "9.81 m/s^2",
+// "1.2 kg·m^2", "300 K" or "4.7 µF". The returned Unit holds the value
+// converted to SI base units, so Parse("3 km") returns a Unit with value
+// 3000 and dimension m.
+//
+// The symbols are the SI base units with the kilogram written as kg, the
+// radian, the SI derived units with special names (N, Pa, J, W, C, V, F,
+// Ω, S, Wb, T, H, Hz, Bq, Gy, Sv and kat), the litre (L or l), the bar, the
+// minute (min), the hour (h) and the day (d). The SI units may carry an SI
+// prefix from Y to y, with u or µ accepted for μ and the gram (g) taking
+// the prefix in place of the kilogram. The symbols of dimensions created
+// by NewDimension and of units defined by DefineUnit are also accepted,
+// without prefixes.
+//
+// Symbols are combined by multiplication, written as a space, * or ·, and
+// division, written as /, and may be grouped with parentheses. A division
+// applies only to the symbol or group immediately following it, so J/(kg K)
+// is the specific heat capacity unit, while J/kg K is J K kg^-1. A symbol
+// or group may be raised to an integer power with ^, as in m^2 or s^-1, or
+// with superscript digits, as in m² or s⁻¹. The power applies to a prefixed
+// symbol as a whole, so cm^2 is 1e-4 m^2. The output of the Format method
+// of Unit is accepted by Parse.
+//
+// Parse returns an error if the string is not a well formed value and unit
+// expression, or if it contains an unknown symbol. To check the dimensions
+// of the result, convert it with the From method of the expected type,
+//
+//	var g unit.Acceleration
+//	u, err := unit.Parse(s)
+//	if err == nil {
+//		err = g.From(u)
+//	}
+func Parse(s string) (*Unit, error) {
+	s = strings.TrimSpace(s)
+	value, n, err := parseValue(s)
+	if err != nil {
+		return nil, err
+	}
+	p := unitParser{s: s, pos: n}
+	p.skipSpace()
+	if p.done() {
+		return New(value, nil), nil
+	}
+	scale, exp, dims, err := p.product()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, p.errorf("unexpected %q", p.s[p.pos:])
+	}
+	return New(shiftDecimal(value*scale, exp), dims), nil
+}
+
+// parseValue parses the longest prefix of s that is a floating point number
+// and returns its value and length.
+func parseValue(s string) (value float64, n int, err error) {
+	for n = len(s); n > 0; n-- {
+		value, err = strconv.ParseFloat(s[:n], 64)
+		if err == nil {
+			return value, n, nil
+		}
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, 0, fmt.Errorf("unit: value out of range in %q", s)
+		}
+	}
+	return 0, 0, fmt.Errorf("unit: missing value in %q", s)
+}
+
+// symbolUnit is a unit symbol accepted by Parse.
+type symbolUnit struct {
+	scale  float64    // scale and exp give the value of the unit
+	exp    int        // in SI base units as scale×10^exp
+	dims   Dimensions // dimensions of the unit
+	prefix bool       // whether the unit takes SI prefixes
+}
+
+var (
+	pressureDims = Dimensions{MassDim: 1, LengthDim: -1, TimeDim: -2}
+
+	// unitSymbols holds the unit symbols accepted by Parse other than
+	// the symbols of dimensions created by NewDimension.
+	unitSymbols = map[string]symbolUnit{
+		// SI base units and the radian.
+		"A":   {1, 0, Dimensions{CurrentDim: 1}, true},
+		"m":   {1, 0, Dimensions{LengthDim: 1}, true},
+		"cd":  {1, 0, Dimensions{LuminousIntensityDim: 1}, true},
+		"kg":  {1, 0, Dimensions{MassDim: 1}, false},
+		"g":   {1, -3, Dimensions{MassDim: 1}, true},
+		"mol": {1, 0, Dimensions{MoleDim: 1}, true},
+		"K":   {1, 0, Dimensions{TemperatureDim: 1}, true},
+		"s":   {1, 0, Dimensions{TimeDim: 1}, true},
+		"rad": {1, 0, Dimensions{AngleDim: 1}, true},
+
+		// SI derived units with special symbols.
+		"Hz":  {1, 0, Dimensions{TimeDim: -1}, true},
+		"N":   {1, 0, Dimensions{MassDim: 1, LengthDim: 1, TimeDim: -2}, true},
+		"Pa":  {1, 0, pressureDims, true},
+		"J":   {1, 0, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2}, true},
+		"W":   {1, 0, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3}, true},
+		"C":   {1, 0, Dimensions{CurrentDim: 1, TimeDim: 1}, true},
+		"V":   {1, 0, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -1}, true},
+		"F":   {1, 0, Dimensions{MassDim: -1, LengthDim: -2, TimeDim: 4, CurrentDim: 2}, true},
+		"Ω":   {1, 0, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -2}, true},
+		"S":   {1, 0, Dimensions{MassDim: -1, LengthDim: -2, TimeDim: 3, CurrentDim: 2}, true},
+		"Wb":  {1, 0, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2, CurrentDim: -1}, true},
+		"T":   {1, 0, Dimensions{MassDim: 1, TimeDim: -2, CurrentDim: -1}, true},
+		"H":   {1, 0, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2, CurrentDim: -2}, true},
+		"Bq":  {1, 0, Dimensions{TimeDim: -1}, true},
+		"Gy":  {1, 0, Dimensions{LengthDim: 2, TimeDim: -2}, true},
+		"Sv":  {1, 0, Dimensions{LengthDim: 2, TimeDim: -2}, true},
+		"kat": {1, 0, Dimensions{MoleDim: 1, TimeDim: -1}, true},
+
+		// Units in use with SI.
+		"L":   {1, -3, Dimensions{LengthDim: 3}, true},
+		"l":   {1, -3, Dimensions{LengthDim: 3}, true},
+		"bar": {1, 5, pressureDims, true},
+		"min": {60, 0, Dimensions{TimeDim: 1}, false},
+		"h":   {3600, 0, Dimensions{TimeDim: 1}, false},
+		"d":   {86400, 0, Dimensions{TimeDim: 1}, false},
+	}
+
+	// siPrefixes holds the SI prefixes accepted by Parse. The two
+	// letter prefix is first so that it is matched before d.
+	siPrefixes = []struct {
+		symbol string
+		exp    int
+	}{
+		{"da", 1},
+		{"Y", 24}, {"Z", 21}, {"E", 18}, {"P", 15}, {"T", 12},
+		{"G", 9}, {"M", 6}, {"k", 3}, {"h", 2},
+		{"d", -1}, {"c", -2}, {"m", -3},
+		{"μ", -6}, {"µ", -6}, {"u", -6},
+		{"n", -9}, {"p", -12}, {"f", -15}, {"a", -18}, {"z", -21}, {"y", -24},
+	}
+)
+
+// lookupSymbol returns the value in SI base units, scale×10^exp, and the
+// dimensions of the unit symbol sym. Symbols without a prefix take precedence, so
+// for example cd is the candela and not the centiday.
+func lookupSymbol(sym string) (scale float64, exp int, dims Dimensions, ok bool) {
+	if u, ok := unitSymbols[sym]; ok {
+		return u.scale, u.exp, u.dims, true
+	}
+	mu.RLock()
+	d, isDim := dimensions[sym]
+	u, isDefined := definedUnits[sym]
+	mu.RUnlock()
+	switch {
+	case isDim && d != reserved:
+		return 1, 0, Dimensions{d: 1}, true
+	case isDefined:
+		return u.value, 0, u.dimensions, true
+	}
+	return lookupBuiltin(sym)
+}
+
+// lookupBuiltin returns the value in SI base units, scale×10^exp, and the
+// dimensions of the possibly prefixed built in unit symbol sym.
+func lookupBuiltin(sym string) (scale float64, exp int, dims Dimensions, ok bool) {
+	if u, ok := unitSymbols[sym]; ok {
+		return u.scale, u.exp, u.dims, true
+	}
+	for _, p := range siPrefixes {
+		base, ok := strings.CutPrefix(sym, p.symbol)
+		if !ok {
+			continue
+		}
+		if u, ok := unitSymbols[base]; ok && u.prefix {
+			return u.scale, p.exp + u.exp, u.dims, true
+		}
+	}
+	return 0, 0, nil, false
+}
+
+// isNotLetter reports whether r is not a letter, and so cannot be part
+// of a unit symbol.
+func isNotLetter(r rune) bool {
+	return !unicode.IsLetter(r)
+}
+
+// unitParser is a recursive descent parser for unit expressions.
+type unitParser struct {
+	s   string
+	pos int
+}
+
+func (p *unitParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("unit: cannot parse %q: %s", p.s, fmt.Sprintf(format, args...))
+}
+
+func (p *unitParser) done() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *unitParser) peek() rune {
+	r, _ := utf8.DecodeRuneInString(p.s[p.pos:])
+	return r
+}
+
+func (p *unitParser) next() rune {
+	r, n := utf8.DecodeRuneInString(p.s[p.pos:])
+	p.pos += n
+	return r
+}
+
+func (p *unitParser) skipSpace() {
+	for !p.done() && unicode.IsSpace(p.peek()) {
+		p.next()
+	}
+}
+
+// product parses a sequence of factors separated by multiplication and
+// division operators, ending at the end of the input or a closing
+// parenthesis. The value of the product in SI base units is scale×10^exp.
+func (p *unitParser) product() (scale float64, exp int, dims Dimensions, err error) {
+	scale = 1
+	dims = make(Dimensions)
+	for i := 0; ; i++ {
+		p.skipSpace()
+		if p.done() || p.peek() == ')' {
+			if i == 0 {
+				return 0, 0, nil, p.errorf("missing unit")
+			}
+			return scale, exp, dims, nil
+		}
+		div := false
+		if i > 0 {
+			switch p.peek() {
+			case '*', '·', '⋅':
+				p.next()
+			case '/':
+				p.next()
+				div = true
+			}
+			p.skipSpace()
+		}
+		s, e, d, err := p.factor()
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		sign := 1
+		if div {
+			sign = -1
+			s = 1 / s
+		}
+		scale *= s
+		exp += sign * e
+		for dim, pow := range d {
+			dims[dim] += sign * pow
+		}
+	}
+}
+
+// factor parses a unit symbol or a parenthesized product, optionally
+// raised to an integer power. The value of the factor in SI base units
+// is scale×10^exp.
+func (p *unitParser) factor() (scale float64, exp int, dims Dimensions, err error) {
+	switch r := p.peek(); {
+	case r == '(':
+		p.next()
+		scale, exp, dims, err = p.product()
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		if p.done() || p.next() != ')' {
+			return 0, 0, nil, p.errorf("missing closing parenthesis")
+		}
+	case unicode.IsLetter(r):
+		start := p.pos
+		for !p.done() && unicode.IsLetter(p.peek()) {
+			p.next()
+		}
+		sym := p.s[start:p.pos]
+		var ok bool
+		scale, exp, dims, ok = lookupSymbol(sym)
+		if !ok {
+			return 0, 0, nil, p.errorf("unknown unit symbol %q", sym)
+		}
+	default:
+		return 0, 0, nil, p.errorf("unexpected %q", p.s[p.pos:])
+	}
+
+	pow, err := p.power()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if pow == 1 {
+		return scale, exp, dims, nil
+	}
+	powDims := make(Dimensions, len(dims))
+	for dim, d := range dims {
+		powDims[dim] = pow * d
+	}
+	return math.Pow(scale, float64(pow)), pow * exp, powDims, nil
+}
+
+// superscripts maps superscript digits to their values.
+var superscripts = map[rune]int{
+	'⁰': 0, '¹': 1, '²': 2, '³': 3, '⁴': 4,
+	'⁵': 5, '⁶': 6, '⁷': 7, '⁸': 8, '⁹': 9,
+}
+
+// power parses an optional integer power written with ^ or with
+// superscript digits. It returns 1 if there is no power.
+func (p *unitParser) power() (int, error) {
+	if p.done() {
+		return 1, nil
+	}
+	if p.peek() == '^' {
+		p.next()
+		start := p.pos
+		if !p.done() && (p.peek() == '-' || p.peek() == '+') {
+			p.next()
+		}
+		for !p.done() && '0' <= p.peek() && p.peek() <= '9' {
+			p.next()
+		}
+		pow, err := strconv.Atoi(p.s[start:p.pos])
+		if err != nil {
+			return 0, p.errorf("invalid power %q", p.s[start:p.pos])
+		}
+		return pow, nil
+	}
+
+	sign := 1
+	if p.peek() == '⁻' {
+		p.next()
+		sign = -1
+	}
+	pow, digits := 0, 0
+	for !p.done() {
+		d, ok := superscripts[p.peek()]
+		if !ok {
+			break
+		}
+		p.next()
+		pow = 10*pow + d
+		digits++
+	}
+	switch {
+	case digits > 0:
+		return sign * pow, nil
+	case sign < 0:
+		return 0, p.errorf("invalid power")
+	default:
+		return 1, nil
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		in   string
+		want *Unit
+	}{
+		{"9.81 m/s^2", New(9.81, Dimensions{LengthDim: 1, TimeDim: -2})},
+		{"9.81 m s^-2", New(9.81, Dimensions{LengthDim: 1, TimeDim: -2})},
+		{"9.81m·s⁻²", New(9.81, Dimensions{LengthDim: 1, TimeDim: -2})},
+		{"  -2.5e3  ", New(-2500, nil)},
+		{"0x1p-2", New(0.25, nil)},
+		{"3 km", New(3000, Dimensions{LengthDim: 1})},
+		{"3km", New(3000, Dimensions{LengthDim: 1})},
+		{"1 dam", New(10, Dimensions{LengthDim: 1})},
+		{"1 dm", New(0.1, Dimensions{LengthDim: 1})},
+		{"2 cm^2", New(2e-4, Dimensions{LengthDim: 2})},
+		{"2 cm²", New(2e-4, Dimensions{LengthDim: 2})},
+		{"5 g", New(5e-3, Dimensions{MassDim: 1})},
+		{"5 mg", New(5e-6, Dimensions{MassDim: 1})},
+		{"5 kg", New(5, Dimensions{MassDim: 1})},
+		{"5 Mg", New(5e3, Dimensions{MassDim: 1})},
+		{"4.7 µF", New(4.7e-6, Dimensions{MassDim: -1, LengthDim: -2, TimeDim: 4, CurrentDim: 2})},
+		{"4.7 μF", New(4.7e-6, Dimensions{MassDim: -1, LengthDim: -2, TimeDim: 4, CurrentDim: 2})},
+		{"4.7 uF", New(4.7e-6, Dimensions{MassDim: -1, LengthDim: -2, TimeDim: 4, CurrentDim: 2})},
+		{"1 cd", New(1, Dimensions{LuminousIntensityDim: 1})},
+		{"1 mcd", New(1e-3, Dimensions{LuminousIntensityDim: 1})},
+		{"1 Pa", New(1, Dimensions{MassDim: 1, LengthDim: -1, TimeDim: -2})},
+		{"1 hPa", New(100, Dimensions{MassDim: 1, LengthDim: -1, TimeDim: -2})},
+		{"1 mbar", New(100, Dimensions{MassDim: 1, LengthDim: -1, TimeDim: -2})},
+		{"1 T", New(1, Dimensions{MassDim: 1, TimeDim: -2, CurrentDim: -1})},
+		{"1 Ts", New(1e12, Dimensions{TimeDim: 1})},
+		{"1 ms", New(1e-3, Dimensions{TimeDim: 1})},
+		{"2 min", New(120, Dimensions{TimeDim: 1})},
+		{"2 h", New(7200, Dimensions{TimeDim: 1})},
+		{"2 d", New(172800, Dimensions{TimeDim: 1})},
+		{"250 mL", New(2.5e-4, Dimensions{LengthDim: 3})},
+		{"3 kat", New(3, Dimensions{MoleDim: 1, TimeDim: -1})},
+		{"1 mmol/L", New(1, Dimensions{MoleDim: 1, LengthDim: -3})},
+		{"1 N m", New(1, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2})},
+		{"1 N*m", New(1, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2})},
+		{"1 kW h", New(3.6e6, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2})},
+		{"4186 J/(kg K)", New(4186, Dimensions{LengthDim: 2, TimeDim: -2, TemperatureDim: -1})},
+		{"4186 J/kg/K", New(4186, Dimensions{LengthDim: 2, TimeDim: -2, TemperatureDim: -1})},
+		{"4186 J/kg K", New(4186, Dimensions{LengthDim: 2, TimeDim: -2, TemperatureDim: 1})},
+		{"1 (m/s)^2", New(1, Dimensions{LengthDim: 2, TimeDim: -2})},
+		{"1 kg m^2 s^-3 A^-1", New(1, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -1})},
+		{"1 V/A", New(1, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -2})},
+		{"1 kΩ", New(1000, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -2})},
+		{"1 m/m", New(1, nil)},
+		{"1 Hz^+1", New(1, Dimensions{TimeDim: -1})},
+	} {
+		got, err := Parse(test.in)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.in, err)
+			continue
+		}
+		if !DimensionsMatch(got, test.want) {
+			t.Errorf("unexpected dimensions for %q: got:%v want:%v", test.in, got, test.want)
+		}
+		if !scalar.EqualWithinRel(got.Value(), test.want.Value(), 1e-15) {
+			t.Errorf("unexpected value for %q: got:%v want:%v", test.in, got.Value(), test.want.Value())
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+	for _, in := range []string{
+		"",
+		"m",
+		"1e400 m",
+		"1 furlong",
+		"1 mkg",
+		"1 kmin",
+		"1 m/",
+		"1 m^",
+		"1 m^x",
+		"1 m⁻",
+		"1 (m s",
+		"1 m)",
+		"1 ()",
+		"1 m + s",
+	} {
+		_, err := Parse(in)
+		if err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+	}
+}
+
+func TestParseNewDimension(t *testing.T) {
+	t.Parallel()
+	cell := NewDimension("parsecell")
+	got, err := Parse("3e6 parsecell/mL")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := New(3e12, Dimensions{cell: 1, LengthDim: -3})
+	if !DimensionsMatch(got, want) || !scalar.EqualWithinRel(got.Value(), want.Value(), 1e-15) {
+		t.Errorf("unexpected result: got:%v want:%v", got, want)
+	}
+	if _, err := Parse("1 kparsecell"); err == nil {
+		t.Error("expected error for prefixed dimension symbol")
+	}
+}
+
+func TestParseFrom(t *testing.T) {
+	t.Parallel()
+	u, err := Parse("9.81 m/s^2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var a Acceleration
+	err = a.From(u)
+	if err != nil {
+		t.Errorf("unexpected error converting to acceleration: %v", err)
+	}
+	if a != 9.81 {
+		t.Errorf("unexpected acceleration: got:%v want:9.81", float64(a))
+	}
+	var f Force
+	err = f.From(u)
+	if err == nil {
+		t.Error("expected dimension mismatch converting to force")
+	}
+}
+
+func TestParseFormatRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, u := range []Uniter{
+		Acceleration(9.81),
+		Force(-1234.5),
+		Mass(0.0025),
+		Capacitance(4.7e-9),
+		Resistance(2.2e6),
+		Frequency(50),
+		Velocity(3e8),
+		Torque(1.5),
+		AbsorbedRadioactiveDose(2e-3),
+		New(6.62607015e-34, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -1}),
+		New(math.Pi, nil),
+	} {
+		want := u.Unit()
+		for _, s := range []string{
+			FormatSI(u, 'g', -1),
+			FormatSI(u, 'e', -1),
+		} {
+			got, err := Parse(s)
+			if err != nil {
+				t.Errorf("unexpected error parsing %q: %v", s, err)
+				continue
+			}
+			if !DimensionsMatch(got, want) || got.Value() != want.Value() {
+				t.Errorf("round trip mismatch for %q: got:%v want:%v", s, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Uncertain represents a dimensional value with a standard uncertainty.
+//
+// The uncertainty of an Uncertain is held as its components along a set of
+// independent sources of error, so that the correlations between values
+// computed from common inputs are retained. The arithmetic methods of
+// Uncertain propagate the uncertainties to first order, as described in
+// the Guide to the Expression of Uncertainty in Measurement (GUM), JCGM
+// 100:2008, section 5. For example, the difference of an Uncertain and
+// itself has zero uncertainty, while the difference of two independent
+// values with equal uncertainty u has uncertainty √2 u.
+//
+// The first order propagation is accurate when the uncertainties are small
+// relative to the scale on which the operations are non-linear, for example
+// when the uncertainty of a divisor is small compared to its value.
+type Uncertain struct {
+	dimensions Dimensions
+	value      float64
+
+	// components holds the sensitivity of the value to each
+	// independent source of error, scaled by the standard
+	// uncertainty of the source.
+	components map[*errorSource]float64
+}
+
+// errorSource identifies an independent source of error. It is not zero
+// size so that distinct sources have distinct addresses.
+type errorSource struct{ _ byte }
+
+// NewUncertain returns a new Uncertain with the given value, standard
+// uncertainty and dimensions. The error of the returned value is
+// independent of the errors of all other values created by NewUncertain.
+// NewUncertain panics if std is negative or NaN.
+func NewUncertain(value, std float64, d Dimensions) *Uncertain {
+	if !(std >= 0) {
+		panic("unit: negative standard uncertainty")
+	}
+	u := &Uncertain{
+		dimensions: d.clone(),
+		value:      value,
+		components: make(map[*errorSource]float64),
+	}
+	if std != 0 {
+		u.components[&errorSource{}] = std
+	}
+	return u
+}
+
+// NewCorrelated returns values with the given values, standard uncertainties
+// and dimensions whose errors are correlated with the correlation matrix
+// corr. The errors of the returned values are independent of the errors of
+// all other values created by NewUncertain or NewCorrelated.
+//
+// NewCorrelated panics if the lengths of values, stds and dims and the size of
+// corr do not match, if any standard uncertainty is negative or if corr is
+// not positive semi-definite with unit diagonal.
+func NewCorrelated(values, stds []float64, dims []Dimensions, corr mat.Symmetric) []*Uncertain {
+	n := len(values)
+	if len(stds) != n || len(dims) != n || corr.SymmetricDim() != n {
+		panic("unit: length mismatch")
+	}
+	for i := 0; i < n; i++ {
+		if !(stds[i] >= 0) {
+			panic("unit: negative standard uncertainty")
+		}
+		if corr.At(i, i) != 1 {
+			panic("unit: correlation matrix diagonal not one")
+		}
+	}
+
+	// Factorize corr = V Λ Vᵀ so that the columns of V √Λ are the
+	// contributions of n independent sources of unit variance.
+	var eig mat.EigenSym
+	ok := eig.Factorize(corr, true)
+	if !ok {
+		panic("unit: eigendecomposition failed")
+	}
+	var vecs mat.Dense
+	eig.VectorsTo(&vecs)
+	vals := eig.Values(nil)
+	const tol = 1e-12
+	sources := make([]*errorSource, n)
+	for j, ev := range vals {
+		if ev < -tol*float64(n) {
+			panic("unit: correlation matrix not positive semi-definite")
+		}
+		vals[j] = math.Sqrt(math.Max(ev, 0))
+		sources[j] = &errorSource{}
+	}
+
+	u := make([]*Uncertain, n)
+	for i := range u {
+		u[i] = &Uncertain{
+			dimensions: dims[i].clone(),
+			value:      values[i],
+			components: make(map[*errorSource]float64),
+		}
+		for j, src := range sources {
+			if c := stds[i] * vecs.At(i, j) * vals[j]; c != 0 {
+				u[i].components[src] = c
+			}
+		}
+	}
+	return u
+}
+
+// Unit returns the value of the receiver as a Unit, discarding
+// its uncertainty.
+func (u *Uncertain) Unit() *Unit {
+	return New(u.value, u.dimensions)
+}
+
+// Value returns the value of the receiver.
+func (u *Uncertain) Value() float64 {
+	return u.value
+}
+
+// Std returns the standard uncertainty of the receiver.
+func (u *Uncertain) Std() float64 {
+	var ss float64
+	for _, c := range u.components {
+		ss += c * c
+	}
+	return math.Sqrt(ss)
+}
+
+// Dimensions returns a copy of the dimensions of the receiver.
+func (u *Uncertain) Dimensions() Dimensions {
+	return u.dimensions.clone()
+}
+
+// Covariance returns the covariance of the errors of a and b.
+func Covariance(a, b *Uncertain) float64 {
+	var cov float64
+	for src, c := range a.components {
+		cov += c * b.components[src]
+	}
+	return cov
+}
+
+// Correlation returns the correlation coefficient of the errors of a and b.
+// Correlation returns NaN if either a or b has zero uncertainty.
+func Correlation(a, b *Uncertain) float64 {
+	sa := a.Std()
+	sb := b.Std()
+	if sa == 0 || sb == 0 {
+		return math.NaN()
+	}
+	return Covariance(a, b) / (sa * sb)
+}
+
+// Add returns a new Uncertain holding the sum of the receiver and v.
+// Add panics if the dimensions of the receiver and v do not match.
+func (u *Uncertain) Add(v *Uncertain) *Uncertain {
+	if !u.dimensions.matches(v.dimensions) {
+		panic("unit: mismatched dimensions in addition")
+	}
+	return &Uncertain{
+		dimensions: u.dimensions.clone(),
+		value:      u.value + v.value,
+		components: linear(1, u.components, 1, v.components),
+	}
+}
+
+// Sub returns a new Uncertain holding the difference of the receiver and v.
+// Sub panics if the dimensions of the receiver and v do not match.
+func (u *Uncertain) Sub(v *Uncertain) *Uncertain {
+	if !u.dimensions.matches(v.dimensions) {
+		panic("unit: mismatched dimensions in subtraction")
+	}
+	return &Uncertain{
+		dimensions: u.dimensions.clone(),
+		value:      u.value - v.value,
+		components: linear(1, u.components, -1, v.components),
+	}
+}
+
+// Mul returns a new Uncertain holding the product of the receiver and v.
+func (u *Uncertain) Mul(v *Uncertain) *Uncertain {
+	return &Uncertain{
+		dimensions: combine(u.dimensions, 1, v.dimensions, 1),
+		value:      u.value * v.value,
+		components: linear(v.value, u.components, u.value, v.components),
+	}
+}
+
+// Div returns a new Uncertain holding the quotient of the receiver and v.
+func (u *Uncertain) Div(v *Uncertain) *Uncertain {
+	q := u.value / v.value
+	return &Uncertain{
+		dimensions: combine(u.dimensions, 1, v.dimensions, -1),
+		value:      q,
+		components: linear(1/v.value, u.components, -q/v.value, v.components),
+	}
+}
+
+// Scale returns a new Uncertain holding the receiver multiplied by the
+// exact dimensionless factor f.
+func (u *Uncertain) Scale(f float64) *Uncertain {
+	return &Uncertain{
+		dimensions: u.dimensions.clone(),
+		value:      f * u.value,
+		components: linear(f, u.components, 0, nil),
+	}
+}
+
+// Pow returns a new Uncertain holding the receiver raised to the integer
+// power n.
+func (u *Uncertain) Pow(n int) *Uncertain {
+	var deriv float64
+	if n != 0 {
+		deriv = float64(n) * math.Pow(u.value, float64(n-1))
+	}
+	return &Uncertain{
+		dimensions: combine(u.dimensions, n, nil, 0),
+		value:      math.Pow(u.value, float64(n)),
+		components: linear(deriv, u.components, 0, nil),
+	}
+}
+
+// linear returns the components of a linear combination of two
+// values with components ca and cb, omitting zero components.
+func linear(a float64, ca map[*errorSource]float64, b float64, cb map[*errorSource]float64) map[*errorSource]float64 {
+	c := make(map[*errorSource]float64, len(ca)+len(cb))
+	for src, v := range ca {
+		c[src] += a * v
+	}
+	for src, v := range cb {
+		c[src] += b * v
+	}
+	for src, v := range c {
+		if v == 0 {
+			delete(c, src)
+		}
+	}
+	return c
+}
+
+// Format makes Uncertain satisfy the fmt.Formatter interface. The value and
+// the standard uncertainty are formatted with the verb and precision, separated
+// by ±, and followed by the dimensions as for the Format method of Unit, for
+// example "9.81 ± 0.02 m s^-2". The width pads the whole representation.
+func (u *Uncertain) Format(fs fmt.State, c rune) {
+	if u == nil {
+		fmt.Fprint(fs, "<nil>")
+		return
+	}
+	switch c {
+	case 'v', 'e', 'E', 'f', 'F', 'g', 'G':
+		format := "%" + string(c)
+		if p, ok := fs.Precision(); ok {
+			format = fmt.Sprintf("%%.%d%c", p, c)
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, format+" ± "+format, u.value, u.Std())
+		if units := u.dimensions.String(); units != "" {
+			b.WriteByte(' ')
+			b.WriteString(units)
+		}
+		s := b.String()
+		if w, ok := fs.Width(); ok {
+			fmt.Fprintf(fs, "%*s", w, s)
+			return
+		}
+		fmt.Fprint(fs, s)
+	default:
+		fmt.Fprintf(fs, "%%!%c(*Uncertain=%g)", c, u)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestUncertainPropagation(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	length := Dimensions{LengthDim: 1}
+	time := Dimensions{TimeDim: 1}
+
+	x := NewUncertain(3, 0.3, length)
+	y := NewUncertain(4, 0.4, length)
+	s := NewUncertain(2, 0.1, time)
+	for _, test := range []struct {
+		name    string
+		got     *Uncertain
+		want    float64
+		wantStd float64
+		dims    Dimensions
+	}{
+		{name: "x+y", got: x.Add(y), want: 7, wantStd: 0.5, dims: length},
+		{name: "x-y", got: x.Sub(y), want: -1, wantStd: 0.5, dims: length},
+		{name: "x-x", got: x.Sub(x), want: 0, wantStd: 0, dims: length},
+		{name: "x+x", got: x.Add(x), want: 6, wantStd: 0.6, dims: length},
+		{name: "2x", got: x.Scale(2), want: 6, wantStd: 0.6, dims: length},
+		// Relative uncertainties of 0.1 add in quadrature.
+		{name: "xy", got: x.Mul(y), want: 12, wantStd: 12 * math.Sqrt(0.02), dims: Dimensions{LengthDim: 2}},
+		{name: "x/y", got: x.Div(y), want: 0.75, wantStd: 0.75 * math.Sqrt(0.02), dims: nil},
+		{name: "x/x", got: x.Div(x), want: 1, wantStd: 0, dims: nil},
+		{name: "x/s", got: x.Div(s), want: 1.5, wantStd: 1.5 * math.Sqrt(0.01+0.0025), dims: Dimensions{LengthDim: 1, TimeDim: -1}},
+		{name: "xx", got: x.Mul(x), want: 9, wantStd: 1.8, dims: Dimensions{LengthDim: 2}},
+		{name: "x^2", got: x.Pow(2), want: 9, wantStd: 1.8, dims: Dimensions{LengthDim: 2}},
+		{name: "x^-1", got: x.Pow(-1), want: 1.0 / 3, wantStd: 0.1 / 3, dims: Dimensions{LengthDim: -1}},
+		{name: "x^0", got: x.Pow(0), want: 1, wantStd: 0, dims: nil},
+		{name: "x^2-xx", got: x.Pow(2).Sub(x.Mul(x)), want: 0, wantStd: 0, dims: Dimensions{LengthDim: 2}},
+	} {
+		if !scalar.EqualWithinAbsOrRel(test.got.Value(), test.want, tol, tol) {
+			t.Errorf("unexpected value for %s: got:%v want:%v", test.name, test.got.Value(), test.want)
+		}
+		if !scalar.EqualWithinAbsOrRel(test.got.Std(), test.wantStd, tol, tol) {
+			t.Errorf("unexpected standard uncertainty for %s: got:%v want:%v", test.name, test.got.Std(), test.wantStd)
+		}
+		if !test.got.dimensions.matches(test.dims.clone()) {
+			t.Errorf("unexpected dimensions for %s: got:%v want:%v", test.name, test.got.dimensions, test.dims)
+		}
+	}
+
+	if !panics(func() { x.Add(s) }) {
+		t.Error("expected panic for addition with mismatched dimensions")
+	}
+	if !panics(func() { x.Sub(s) }) {
+		t.Error("expected panic for subtraction with mismatched dimensions")
+	}
+	if !panics(func() { NewUncertain(1, -1, nil) }) {
+		t.Error("expected panic for negative standard uncertainty")
+	}
+}
+
+func TestUncertainCorrelated(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	length := Dimensions{LengthDim: 1}
+	for _, rho := range []float64{-1, -0.5, 0, 0.3, 1} {
+		corr := mat.NewSymDense(2, []float64{1, rho, rho, 1})
+		u := NewCorrelated([]float64{3, 4}, []float64{0.3, 0.4}, []Dimensions{length, length}, corr)
+		a, b := u[0], u[1]
+		if a.Value() != 3 || b.Value() != 4 {
+			t.Errorf("unexpected values for rho=%v: got:%v %v", rho, a.Value(), b.Value())
+		}
+		if !scalar.EqualWithinAbsOrRel(a.Std(), 0.3, tol, tol) || !scalar.EqualWithinAbsOrRel(b.Std(), 0.4, tol, tol) {
+			t.Errorf("unexpected standard uncertainties for rho=%v: got:%v %v", rho, a.Std(), b.Std())
+		}
+		if got := Correlation(a, b); !scalar.EqualWithinAbsOrRel(got, rho, tol, tol) {
+			t.Errorf("unexpected correlation: got:%v want:%v", got, rho)
+		}
+		wantStd := math.Sqrt(0.09 + 0.16 + 2*rho*0.12)
+		if got := a.Add(b).Std(); !scalar.EqualWithinAbsOrRel(got, wantStd, tol, tol) {
+			t.Errorf("unexpected standard uncertainty of sum for rho=%v: got:%v want:%v", rho, got, wantStd)
+		}
+		// The correlated values are independent of other values.
+		c := NewUncertain(1, 1, length)
+		if got := Covariance(a, c); got != 0 {
+			t.Errorf("unexpected covariance with independent value: got:%v want:0", got)
+		}
+	}
+
+	if !panics(func() {
+		NewCorrelated([]float64{1, 2}, []float64{1, 1}, []Dimensions{nil, nil}, mat.NewSymDense(2, []float64{1, 2, 2, 1}))
+	}) {
+		t.Error("expected panic for indefinite correlation matrix")
+	}
+	if !panics(func() {
+		NewCorrelated([]float64{1, 2}, []float64{1, 1}, []Dimensions{nil, nil}, mat.NewSymDense(2, []float64{2, 0, 0, 1}))
+	}) {
+		t.Error("expected panic for correlation matrix with non-unit diagonal")
+	}
+	if !panics(func() {
+		NewCorrelated([]float64{1}, []float64{1, 1}, []Dimensions{nil, nil}, mat.NewSymDense(2, nil))
+	}) {
+		t.Error("expected panic for length mismatch")
+	}
+}
+
+func TestUncertainUnit(t *testing.T) {
+	t.Parallel()
+	g := NewUncertain(9.81, 0.02, Dimensions{LengthDim: 1, TimeDim: -2})
+	var a Acceleration
+	if err := a.From(g); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if a != 9.81 {
+		t.Errorf("unexpected acceleration: got:%v want:9.81", float64(a))
+	}
+	var f Force
+	if err := f.From(g); err == nil {
+		t.Error("expected error for dimension mismatch")
+	}
+	if !DimensionsMatch(Mul(g, Mass(2)), Force(0)) {
+		t.Error("unexpected dimensions of product with mass")
+	}
+}
+
+func TestUncertainFormat(t *testing.T) {
+	t.Parallel()
+	g := NewUncertain(9.81, 0.02, Dimensions{LengthDim: 1, TimeDim: -2})
+	for _, test := range []struct {
+		format string
+		u      *Uncertain
+		want   string
+	}{
+		{"%v", g, "9.81 ± 0.02 m s^-2"},
+		{"%.3f", g, "9.810 ± 0.020 m s^-2"},
+		{"%.2e", g, "9.81e+00 ± 2.00e-02 m s^-2"},
+		{"%22v", g, "    9.81 ± 0.02 m s^-2"},
+		{"%v", NewUncertain(0.5, 0.1, nil), "0.5 ± 0.1"},
+		{"%s", g, "%!s(*Uncertain=9.81 ± 0.02 m s^-2)"},
+	} {
+		if got := fmt.Sprintf(test.format, test.u); got != test.want {
+			t.Errorf("unexpected result for %q: got:%q want:%q", test.format, got, test.want)
+		}
+	}
+}
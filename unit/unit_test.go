@@ -192,6 +192,9 @@ func TestOperations(t *testing.T) {
 		want   Uniter
 	}{
 		{Dimless(1).Unit().Add, Dimless(2), Dimless(3)},
+		{Length(3).Unit().Sub, Length(1), Length(2)},
+		{New(2, nil).Mul, Length(3), Length(6)},
+		{New(2, nil).Div, Time(4), Frequency(0.5)},
 		{Dimless(1).Unit().Mul, Dimless(2), Dimless(2)},
 		{Dimless(1).Unit().Mul, Length(2), Length(2)},
 		{Length(1).Unit().Mul, Dimless(2), Length(2)},
@@ -47,3 +47,43 @@ func Example_horsepower() {
 	// 1 hp = 745.6998715822701 kg m^2 s^-3
 	// W is equivalent to hp? true
 }
+
+func ExampleParse() {
+	u, err := unit.Parse("9.81 m/s^2")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(u)
+
+	// Check the dimensions of the parsed value.
+	var g unit.Acceleration
+	fmt.Println(g.From(u))
+	var f unit.Force
+	fmt.Println(f.From(u))
+
+	_, err = unit.Parse("9.81 m/furlong")
+	fmt.Println(err)
+
+	// Output:
+	// 9.81 m s^-2
+	// <nil>
+	// unit: dimension mismatch
+	// unit: cannot parse "9.81 m/furlong": unknown unit symbol "furlong"
+}
+
+func ExampleFormatSI() {
+	fmt.Println(unit.FormatSI(unit.Force(9810), 'g', -1))
+	fmt.Println(unit.FormatSI(unit.Mass(0.0025), 'g', -1))
+	fmt.Println(unit.FormatSI(unit.New(4.7e-9, unit.Dimensions{
+		unit.MassDim:    -1,
+		unit.LengthDim:  -2,
+		unit.TimeDim:    4,
+		unit.CurrentDim: 2,
+	}), 'f', 1))
+
+	// Output:
+	// 9.81 kN
+	// 2.5 g
+	// 4.7 nF
+}
@@ -36,8 +36,9 @@ type Dimension int
 func NewDimension(symbol string) Dimension {
 	defer mu.Unlock()
 	mu.Lock()
-	_, ok := dimensions[symbol]
-	if ok {
+	_, isDim := dimensions[symbol]
+	_, isDefined := definedUnits[symbol]
+	if isDim || isDefined {
 		panic("unit: dimension string \"" + symbol + "\" already used")
 	}
 	d := Dimension(len(symbols))
@@ -59,12 +60,14 @@ func (d Dimension) String() string {
 	panic("unit: illegal dimension")
 }
 
-// SymbolExists returns whether the given symbol is already in use.
+// SymbolExists returns whether the given symbol is already in use by a
+// dimension or by a unit defined by DefineUnit.
 func SymbolExists(symbol string) bool {
 	mu.RLock()
-	_, ok := dimensions[symbol]
+	_, isDim := dimensions[symbol]
+	_, isDefined := definedUnits[symbol]
 	mu.RUnlock()
-	return ok
+	return isDim || isDefined
 }
 
 const (
@@ -149,9 +152,11 @@ var (
 		"Sv":  reserved,
 		"kat": reserved,
 		// Units in use with SI
-		"ha": reserved,
-		"L":  reserved,
-		"l":  reserved,
+		"ha":  reserved,
+		"L":   reserved,
+		"l":   reserved,
+		"g":   reserved,
+		"min": reserved,
 		// Units in Use Temporarily with SI
 		"bar": reserved,
 		"b":   reserved,
@@ -272,6 +277,17 @@ func (u *Unit) Add(uniter Uniter) *Unit {
 	return u
 }
 
+// Sub subtracts the function argument from the receiver. Panics if the units
+// of the receiver and the argument don't match.
+func (u *Unit) Sub(uniter Uniter) *Unit {
+	a := uniter.Unit()
+	if !DimensionsMatch(u, a) {
+		panic("unit: mismatched dimensions in subtraction")
+	}
+	u.value -= a.value
+	return u
+}
+
 // Unit implements the Uniter interface, returning the receiver. If a
 // copy of the receiver is required, use the Copy method.
 func (u *Unit) Unit() *Unit {
@@ -291,6 +307,9 @@ func (u *Unit) Copy() *Unit {
 // of the receiver as appropriate. The input is not changed.
 func (u *Unit) Mul(uniter Uniter) *Unit {
 	a := uniter.Unit()
+	if u.dimensions == nil {
+		u.dimensions = make(Dimensions)
+	}
 	for key, val := range a.dimensions {
 		if d := u.dimensions[key]; d == -val {
 			delete(u.dimensions, key)
@@ -306,6 +325,9 @@ func (u *Unit) Mul(uniter Uniter) *Unit {
 // dimensions of the receiver as appropriate.
 func (u *Unit) Div(uniter Uniter) *Unit {
 	a := uniter.Unit()
+	if u.dimensions == nil {
+		u.dimensions = make(Dimensions)
+	}
 	u.value /= a.value
 	for key, val := range a.dimensions {
 		if d := u.dimensions[key]; d == val {